@@ -0,0 +1,102 @@
+package flexprice
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ---------------------------------------------------------------------------
+// Webhook signature verification
+// ---------------------------------------------------------------------------
+
+// WebhookSignatureHeader is the header FlexPrice signs webhook deliveries in. Its value has
+// the form "t=<unix seconds>,v1=<hex signature>" with one v1 entry per active signing secret.
+const WebhookSignatureHeader = "FlexPrice-Signature"
+
+// DefaultWebhookTolerance is the maximum age of a delivery accepted by VerifyWebhookSignature
+// when no tolerance is given.
+const DefaultWebhookTolerance = 5 * time.Minute
+
+var (
+	// ErrWebhookSignatureMissing is returned when the signature header is empty or malformed.
+	ErrWebhookSignatureMissing = errors.New("flexprice: webhook signature header is missing or malformed")
+	// ErrWebhookSignatureMismatch is returned when no signature matches the signing secret.
+	ErrWebhookSignatureMismatch = errors.New("flexprice: webhook signature does not match")
+	// ErrWebhookTimestampOutOfRange is returned when the delivery is older than the tolerance.
+	ErrWebhookTimestampOutOfRange = errors.New("flexprice: webhook timestamp is outside the tolerance")
+)
+
+// VerifyWebhookSignature checks the FlexPrice-Signature header of a webhook delivery against
+// the raw request body and the endpoint signing secret. Pass the body exactly as received,
+// before any JSON decoding. A tolerance of zero uses DefaultWebhookTolerance.
+func VerifyWebhookSignature(payload []byte, header, secret string, tolerance time.Duration) error {
+	return verifyWebhookSignatureAt(payload, header, secret, tolerance, time.Now())
+}
+
+// VerifyWebhookRequest reads the body of an incoming webhook request and verifies its
+// signature. It returns the body so it can be decoded after verification.
+func VerifyWebhookRequest(r *http.Request, secret string, tolerance time.Duration) ([]byte, error) {
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("flexprice: reading webhook body: %w", err)
+	}
+	if err := VerifyWebhookSignature(payload, r.Header.Get(WebhookSignatureHeader), secret, tolerance); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+func verifyWebhookSignatureAt(payload []byte, header, secret string, tolerance time.Duration, now time.Time) error {
+	if tolerance <= 0 {
+		tolerance = DefaultWebhookTolerance
+	}
+
+	var timestamp int64
+	var hasTimestamp bool
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			ts, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return ErrWebhookSignatureMissing
+			}
+			timestamp, hasTimestamp = ts, true
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	if !hasTimestamp || len(signatures) == 0 {
+		return ErrWebhookSignatureMissing
+	}
+
+	age := now.Sub(time.Unix(timestamp, 0))
+	if age > tolerance || age < -tolerance {
+		return ErrWebhookTimestampOutOfRange
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	expected := []byte(hex.EncodeToString(mac.Sum(nil)))
+
+	for _, sig := range signatures {
+		if hmac.Equal(expected, []byte(sig)) {
+			return nil
+		}
+	}
+	return ErrWebhookSignatureMismatch
+}
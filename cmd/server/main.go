@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/flexprice/flexprice/internal/api"
@@ -12,6 +13,7 @@ import (
 	"github.com/flexprice/flexprice/internal/clickhouse"
 	"github.com/flexprice/flexprice/internal/config"
 	"github.com/flexprice/flexprice/internal/dynamodb"
+	"github.com/flexprice/flexprice/internal/email"
 	"github.com/flexprice/flexprice/internal/httpclient"
 	integrationevents "github.com/flexprice/flexprice/internal/integration/events"
 	"github.com/flexprice/flexprice/internal/kafka"
@@ -136,6 +138,7 @@ func main() {
 			repository.NewFeatureUsageRepository,
 			repository.NewCostSheetUsageRepository,
 			repository.NewMeterUsageRepository,
+			repository.NewUsageRollupRepository,
 			repository.NewUsageBenchmarkRepository,
 			repository.NewMeterRepository,
 			repository.NewUserRepository,
@@ -168,6 +171,18 @@ func main() {
 			repository.NewCouponRepository,
 			repository.NewCouponAssociationRepository,
 			repository.NewCouponApplicationRepository,
+			repository.NewPromotionCodeRepository,
+			repository.NewFXRateRepository,
+			repository.NewPriceBookRepository,
+			repository.NewCommitmentContractRepository,
+			repository.NewSubscriptionSeatChangeRepository,
+			repository.NewWebhookEndpointRepository,
+			repository.NewWebhookDeliveryAttemptRepository,
+			repository.NewRBACRoleRepository,
+			repository.NewAuditLogRepository,
+			repository.NewSSOConnectionRepository,
+			repository.NewInvitationRepository,
+			repository.NewTenantMembershipRepository,
 			repository.NewAddonRepository,
 			repository.NewAddonAssociationRepository,
 			repository.NewSubscriptionLineItemRepository,
@@ -202,6 +217,7 @@ func main() {
 		fx.Provide(
 			provideWalletBalanceAlertPubSub,
 			provideUsageBenchmarkPubSub,
+			provideUsageStreamPubSub,
 		),
 	)
 
@@ -217,6 +233,7 @@ func main() {
 			service.NewTenantService,
 			service.NewAuthService,
 			provideSupabaseClient,
+			provideEmail,
 			service.NewUserService,
 			service.NewEnvAccessService,
 			service.NewEnvironmentService,
@@ -231,6 +248,9 @@ func main() {
 			service.NewMeterUsageTrackingService,
 			service.NewUsageBenchmarkService,
 			service.NewMeterUsageService,
+			service.NewUsageStreamService,
+			service.NewUsageLimitService,
+			service.NewEntitlementCheckService,
 			service.NewPriceService,
 			service.NewPriceUnitService,
 			service.NewCustomerService,
@@ -255,11 +275,21 @@ func main() {
 			service.NewEntityIntegrationMappingService,
 			service.NewTaxService,
 			service.NewCouponService,
+			service.NewPromotionCodeService,
+			service.NewFXRateService,
+			service.NewPriceBookService,
+			service.NewCommitmentContractService,
+			service.NewWebhookEndpointService,
+			service.NewRBACRoleService,
+			service.NewAuditLogService,
+			service.NewSSOService,
+			service.NewMembershipService,
 			service.NewAddonService,
 			service.NewSettingsService,
 			service.NewSubscriptionChangeService,
 			service.NewSubscriptionModificationService,
 			service.NewSubscriptionScheduleService,
+			service.NewSubscriptionSeatService,
 			service.NewAlertLogsService,
 			service.NewGroupService,
 			service.NewScheduledTaskService,
@@ -296,6 +326,7 @@ func main() {
 			provideRouter,
 		),
 		fx.Invoke(
+			validateRetiredConfig,
 			sentry.RegisterHooks,
 			pyroscope.RegisterHooks,
 			startServer,
@@ -306,6 +337,11 @@ func main() {
 	app.Run()
 }
 
+// validateRetiredConfig refuses to start with configuration that is no longer applied
+func validateRetiredConfig(cfg *config.Configuration) error {
+	return cfg.Webhook.ValidateRetiredTenants()
+}
+
 func provideHandlers(
 	cfg *config.Configuration,
 	logger *logger.Logger,
@@ -341,11 +377,17 @@ func provideHandlers(
 	svixClient *svix.Client,
 	taxService service.TaxService,
 	couponService service.CouponService,
+	promotionCodeService service.PromotionCodeService,
+	fxRateService service.FXRateService,
+	priceBookService service.PriceBookService,
+	commitmentContractService service.CommitmentContractService,
+	webhookEndpointService service.WebhookEndpointService,
 	addonService service.AddonService,
 	settingsService service.SettingsService,
 	subscriptionChangeService service.SubscriptionChangeService,
 	subscriptionModificationService service.SubscriptionModificationService,
 	subscriptionScheduleService service.SubscriptionScheduleService,
+	subscriptionSeatService service.SubscriptionSeatService,
 	featureUsageTrackingService service.FeatureUsageTrackingService,
 	rawEventsReprocessingService service.RawEventsReprocessingService,
 	rawEventConsumptionService service.RawEventConsumptionService,
@@ -355,12 +397,19 @@ func provideHandlers(
 	db postgres.IClient,
 	scheduledTaskService service.ScheduledTaskService,
 	rbacService *rbac.RBACService,
+	rbacRoleService service.RBACRoleService,
+	auditLogService service.AuditLogService,
+	ssoService service.SSOService,
+	membershipService service.MembershipService,
 	oauthService service.OAuthService,
 	costsheetUsageTrackingService service.CostSheetUsageTrackingService,
 	customerPortalService service.CustomerPortalService,
 	dashboardService service.DashboardService,
 	workflowService service.WorkflowService,
 	meterUsageService service.MeterUsageService,
+	usageStreamService service.UsageStreamService,
+	usageLimitService service.UsageLimitService,
+	entitlementCheckService service.EntitlementCheckService,
 	geminiPricingService service.GeminiPricingService,
 	webhookService *webhook.WebhookService,
 ) api.Handlers {
@@ -379,6 +428,7 @@ func provideHandlers(
 		SubscriptionChange:       v1.NewSubscriptionChangeHandler(subscriptionChangeService, logger),
 		SubscriptionModification: v1.NewSubscriptionModificationHandler(subscriptionModificationService, logger),
 		SubscriptionSchedule:     v1.NewSubscriptionScheduleHandler(subscriptionScheduleService),
+		SubscriptionSeat:         v1.NewSubscriptionSeatHandler(subscriptionSeatService, logger),
 		Wallet:                   v1.NewWalletHandler(walletService, logger),
 		Tenant:                   v1.NewTenantHandler(tenantService, logger),
 		Invoice:                  v1.NewInvoiceHandler(invoiceService, logger),
@@ -402,24 +452,45 @@ func provideHandlers(
 		IntegrationMappingLink:   v1.NewIntegrationMappingLinkHandler(entityIntegrationMappingService, logger),
 		Webhook:                  v1.NewWebhookHandler(cfg, svixClient, logger, integrationFactory, customerService, paymentService, invoiceService, planService, subscriptionService, entityIntegrationMappingService, db, webhookService),
 		Coupon:                   v1.NewCouponHandler(couponService, logger),
+		PromotionCode:            v1.NewPromotionCodeHandler(promotionCodeService, logger),
+		FXRate:                   v1.NewFXRateHandler(fxRateService, logger),
+		PriceBook:                v1.NewPriceBookHandler(priceBookService, logger),
+		CommitmentContract:       v1.NewCommitmentContractHandler(commitmentContractService, logger),
+		WebhookEndpoint:          v1.NewWebhookEndpointHandler(webhookEndpointService, logger),
 		Addon:                    v1.NewAddonHandler(addonService, entitlementService, logger),
 		Settings:                 v1.NewSettingsHandler(settingsService, logger),
 		SetupIntent:              v1.NewSetupIntentHandler(integrationFactory, customerService, logger),
 		Group:                    v1.NewGroupHandler(groupService, logger),
 		ScheduledTask:            v1.NewScheduledTaskHandler(scheduledTaskService, logger),
 		AlertLogsHandler:         v1.NewAlertLogsHandler(alertLogsService, customerService, walletService, featureService, logger),
-		RBAC:                     v1.NewRBACHandler(rbacService, userService, logger),
+		RBAC:                     v1.NewRBACHandler(rbacService, rbacRoleService, userService, logger),
+		AuditLog:                 v1.NewAuditLogHandler(auditLogService, logger),
+		SSO:                      v1.NewSSOHandler(cfg, ssoService, logger),
+		Membership:               v1.NewMembershipHandler(membershipService, logger),
 		OAuth:                    v1.NewOAuthHandler(oauthService, cfg.OAuth.RedirectURI, logger),
 		CronKafkaLagMonitoring:   cron.NewKafkaLagMonitoringHandler(logger, eventService),
 		CustomerPortal:           v1.NewCustomerPortalHandler(customerPortalService, logger),
 		Dashboard:                v1.NewDashboardHandler(dashboardService, logger),
 		Workflow:                 v1.NewWorkflowHandler(workflowService, logger),
 		MeterUsage:               v1.NewMeterUsageHandler(meterUsageService, logger),
+		UsageStream:              v1.NewUsageStreamHandler(usageStreamService, cfg, logger),
+		UsageLimit:               v1.NewUsageLimitHandler(usageLimitService, logger),
+		EntitlementCheck:         v1.NewEntitlementCheckHandler(entitlementCheckService, cfg, logger),
 	}
 }
 
-func provideRouter(handlers api.Handlers, cfg *config.Configuration, logger *logger.Logger, secretService service.SecretService, envAccessService service.EnvAccessService, rbacService *rbac.RBACService) *gin.Engine {
-	return api.NewRouter(handlers, cfg, logger, secretService, envAccessService, rbacService)
+func provideRouter(handlers api.Handlers, cfg *config.Configuration, logger *logger.Logger, secretService service.SecretService, envAccessService service.EnvAccessService, membershipService service.MembershipService, rbacService *rbac.RBACService) *gin.Engine {
+	return api.NewRouter(handlers, cfg, logger, secretService, envAccessService, membershipService, rbacService)
+}
+
+func provideEmail(cfg *config.Configuration, log *logger.Logger) *email.Email {
+	client := email.NewEmailClient(email.Config{
+		Enabled:     cfg.Email.Enabled,
+		APIKey:      cfg.Email.ResendAPIKey,
+		FromAddress: cfg.Email.FromAddress,
+		ReplyTo:     cfg.Email.ReplyTo,
+	})
+	return email.NewEmail(client, log.Desugar())
 }
 
 func provideSupabaseClient(cfg *config.Configuration) *supabase.Client {
@@ -502,6 +573,7 @@ func startServer(
 	rawEventConsumptionSvc service.RawEventConsumptionService,
 	meterUsageTrackingSvc service.MeterUsageTrackingService,
 	usageBenchmarkSvc service.UsageBenchmarkService,
+	usageStreamSvc service.UsageStreamService,
 	params service.ServiceParams,
 ) {
 	mode := cfg.Deployment.Mode
@@ -518,6 +590,7 @@ func startServer(
 
 		// Register all handlers and start router once
 		registerRouterHandlers(router, webhookService, integrationEventService, onboardingService, eventPostProcessingSvc, eventConsumptionSvc, featureUsageSvc, costSheetUsageSvc, walletBalanceAlertSvc, rawEventConsumptionSvc, meterUsageTrackingSvc, usageBenchmarkSvc, cfg, true)
+		usageStreamSvc.RegisterHandler(router, cfg)
 		startRouter(lc, router, log)
 		startTemporalWorker(lc, log, temporalClient, temporalService, params, webhookService)
 	case types.ModeAPI:
@@ -525,6 +598,8 @@ func startServer(
 
 		// Register all handlers and start router once (no event consumption)
 		registerRouterHandlers(router, webhookService, integrationEventService, onboardingService, eventPostProcessingSvc, eventConsumptionSvc, featureUsageSvc, costSheetUsageSvc, walletBalanceAlertSvc, rawEventConsumptionSvc, meterUsageTrackingSvc, usageBenchmarkSvc, cfg, false)
+		// Usage stream fan-out runs on every node serving HTTP
+		usageStreamSvc.RegisterHandler(router, cfg)
 		startRouter(lc, router, log)
 
 	case types.ModeTemporalWorker:
@@ -695,3 +770,30 @@ func provideUsageBenchmarkPubSub(
 	}
 	return types.UsageBenchmarkPubSub{PubSub: pubSub}
 }
+
+// provideUsageStreamPubSub creates the usage stream pubsub. Every API node consumes the
+// full stream, so the consumer group is suffixed with the hostname to make it unique.
+func provideUsageStreamPubSub(
+	cfg *config.Configuration,
+	logger *logger.Logger,
+) types.UsageStreamPubSub {
+	if !cfg.UsageStream.Enabled {
+		return types.UsageStreamPubSub{}
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = types.GenerateUUID()
+	}
+
+	pubSub, err := kafkaPubsub.NewPubSubFromConfig(
+		cfg,
+		logger,
+		fmt.Sprintf("%s_%s", cfg.UsageStream.ConsumerGroupPrefix, hostname),
+	)
+	if err != nil {
+		logger.Fatalw("failed to create pubsub for usage stream", "error", err)
+		return types.UsageStreamPubSub{}
+	}
+	return types.UsageStreamPubSub{PubSub: pubSub}
+}
@@ -18,8 +18,11 @@ import (
 	"github.com/flexprice/flexprice/ent/addon"
 	"github.com/flexprice/flexprice/ent/addonassociation"
 	"github.com/flexprice/flexprice/ent/alertlogs"
+	"github.com/flexprice/flexprice/ent/auditlog"
 	"github.com/flexprice/flexprice/ent/auth"
 	"github.com/flexprice/flexprice/ent/billingsequence"
+	"github.com/flexprice/flexprice/ent/commitmentcontract"
+	"github.com/flexprice/flexprice/ent/commitmentdrawdown"
 	"github.com/flexprice/flexprice/ent/connection"
 	"github.com/flexprice/flexprice/ent/costsheet"
 	"github.com/flexprice/flexprice/ent/coupon"
@@ -34,7 +37,9 @@ import (
 	"github.com/flexprice/flexprice/ent/entityintegrationmapping"
 	"github.com/flexprice/flexprice/ent/environment"
 	"github.com/flexprice/flexprice/ent/feature"
+	"github.com/flexprice/flexprice/ent/fxrate"
 	"github.com/flexprice/flexprice/ent/group"
+	"github.com/flexprice/flexprice/ent/invitation"
 	"github.com/flexprice/flexprice/ent/invoice"
 	"github.com/flexprice/flexprice/ent/invoicelineitem"
 	"github.com/flexprice/flexprice/ent/invoicesequence"
@@ -43,24 +48,32 @@ import (
 	"github.com/flexprice/flexprice/ent/paymentattempt"
 	"github.com/flexprice/flexprice/ent/plan"
 	"github.com/flexprice/flexprice/ent/price"
+	"github.com/flexprice/flexprice/ent/pricebook"
 	"github.com/flexprice/flexprice/ent/priceunit"
+	"github.com/flexprice/flexprice/ent/promotioncode"
+	"github.com/flexprice/flexprice/ent/rbacrole"
 	"github.com/flexprice/flexprice/ent/scheduledtask"
 	"github.com/flexprice/flexprice/ent/secret"
 	"github.com/flexprice/flexprice/ent/settings"
+	"github.com/flexprice/flexprice/ent/ssoconnection"
 	"github.com/flexprice/flexprice/ent/subscription"
 	"github.com/flexprice/flexprice/ent/subscriptionlineitem"
 	"github.com/flexprice/flexprice/ent/subscriptionpause"
 	"github.com/flexprice/flexprice/ent/subscriptionphase"
 	"github.com/flexprice/flexprice/ent/subscriptionschedule"
+	"github.com/flexprice/flexprice/ent/subscriptionseatchange"
 	"github.com/flexprice/flexprice/ent/systemevent"
 	"github.com/flexprice/flexprice/ent/task"
 	"github.com/flexprice/flexprice/ent/taxapplied"
 	"github.com/flexprice/flexprice/ent/taxassociation"
 	"github.com/flexprice/flexprice/ent/taxrate"
 	"github.com/flexprice/flexprice/ent/tenant"
+	"github.com/flexprice/flexprice/ent/tenantmembership"
 	"github.com/flexprice/flexprice/ent/user"
 	"github.com/flexprice/flexprice/ent/wallet"
 	"github.com/flexprice/flexprice/ent/wallettransaction"
+	"github.com/flexprice/flexprice/ent/webhookdeliveryattempt"
+	"github.com/flexprice/flexprice/ent/webhookendpoint"
 	"github.com/flexprice/flexprice/ent/workflowexecution"
 
 	stdsql "database/sql"
@@ -77,10 +90,16 @@ type Client struct {
 	AddonAssociation *AddonAssociationClient
 	// AlertLogs is the client for interacting with the AlertLogs builders.
 	AlertLogs *AlertLogsClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// Auth is the client for interacting with the Auth builders.
 	Auth *AuthClient
 	// BillingSequence is the client for interacting with the BillingSequence builders.
 	BillingSequence *BillingSequenceClient
+	// CommitmentContract is the client for interacting with the CommitmentContract builders.
+	CommitmentContract *CommitmentContractClient
+	// CommitmentDrawdown is the client for interacting with the CommitmentDrawdown builders.
+	CommitmentDrawdown *CommitmentDrawdownClient
 	// Connection is the client for interacting with the Connection builders.
 	Connection *ConnectionClient
 	// Costsheet is the client for interacting with the Costsheet builders.
@@ -107,10 +126,14 @@ type Client struct {
 	EntityIntegrationMapping *EntityIntegrationMappingClient
 	// Environment is the client for interacting with the Environment builders.
 	Environment *EnvironmentClient
+	// FXRate is the client for interacting with the FXRate builders.
+	FXRate *FXRateClient
 	// Feature is the client for interacting with the Feature builders.
 	Feature *FeatureClient
 	// Group is the client for interacting with the Group builders.
 	Group *GroupClient
+	// Invitation is the client for interacting with the Invitation builders.
+	Invitation *InvitationClient
 	// Invoice is the client for interacting with the Invoice builders.
 	Invoice *InvoiceClient
 	// InvoiceLineItem is the client for interacting with the InvoiceLineItem builders.
@@ -127,8 +150,16 @@ type Client struct {
 	Plan *PlanClient
 	// Price is the client for interacting with the Price builders.
 	Price *PriceClient
+	// PriceBook is the client for interacting with the PriceBook builders.
+	PriceBook *PriceBookClient
 	// PriceUnit is the client for interacting with the PriceUnit builders.
 	PriceUnit *PriceUnitClient
+	// PromotionCode is the client for interacting with the PromotionCode builders.
+	PromotionCode *PromotionCodeClient
+	// RBACRole is the client for interacting with the RBACRole builders.
+	RBACRole *RBACRoleClient
+	// SSOConnection is the client for interacting with the SSOConnection builders.
+	SSOConnection *SSOConnectionClient
 	// ScheduledTask is the client for interacting with the ScheduledTask builders.
 	ScheduledTask *ScheduledTaskClient
 	// Secret is the client for interacting with the Secret builders.
@@ -145,6 +176,8 @@ type Client struct {
 	SubscriptionPhase *SubscriptionPhaseClient
 	// SubscriptionSchedule is the client for interacting with the SubscriptionSchedule builders.
 	SubscriptionSchedule *SubscriptionScheduleClient
+	// SubscriptionSeatChange is the client for interacting with the SubscriptionSeatChange builders.
+	SubscriptionSeatChange *SubscriptionSeatChangeClient
 	// SystemEvent is the client for interacting with the SystemEvent builders.
 	SystemEvent *SystemEventClient
 	// Task is the client for interacting with the Task builders.
@@ -157,12 +190,18 @@ type Client struct {
 	TaxRate *TaxRateClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
+	// TenantMembership is the client for interacting with the TenantMembership builders.
+	TenantMembership *TenantMembershipClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// Wallet is the client for interacting with the Wallet builders.
 	Wallet *WalletClient
 	// WalletTransaction is the client for interacting with the WalletTransaction builders.
 	WalletTransaction *WalletTransactionClient
+	// WebhookDeliveryAttempt is the client for interacting with the WebhookDeliveryAttempt builders.
+	WebhookDeliveryAttempt *WebhookDeliveryAttemptClient
+	// WebhookEndpoint is the client for interacting with the WebhookEndpoint builders.
+	WebhookEndpoint *WebhookEndpointClient
 	// WorkflowExecution is the client for interacting with the WorkflowExecution builders.
 	WorkflowExecution *WorkflowExecutionClient
 }
@@ -179,8 +218,11 @@ func (c *Client) init() {
 	c.Addon = NewAddonClient(c.config)
 	c.AddonAssociation = NewAddonAssociationClient(c.config)
 	c.AlertLogs = NewAlertLogsClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
 	c.Auth = NewAuthClient(c.config)
 	c.BillingSequence = NewBillingSequenceClient(c.config)
+	c.CommitmentContract = NewCommitmentContractClient(c.config)
+	c.CommitmentDrawdown = NewCommitmentDrawdownClient(c.config)
 	c.Connection = NewConnectionClient(c.config)
 	c.Costsheet = NewCostsheetClient(c.config)
 	c.Coupon = NewCouponClient(c.config)
@@ -194,8 +236,10 @@ func (c *Client) init() {
 	c.Entitlement = NewEntitlementClient(c.config)
 	c.EntityIntegrationMapping = NewEntityIntegrationMappingClient(c.config)
 	c.Environment = NewEnvironmentClient(c.config)
+	c.FXRate = NewFXRateClient(c.config)
 	c.Feature = NewFeatureClient(c.config)
 	c.Group = NewGroupClient(c.config)
+	c.Invitation = NewInvitationClient(c.config)
 	c.Invoice = NewInvoiceClient(c.config)
 	c.InvoiceLineItem = NewInvoiceLineItemClient(c.config)
 	c.InvoiceSequence = NewInvoiceSequenceClient(c.config)
@@ -204,7 +248,11 @@ func (c *Client) init() {
 	c.PaymentAttempt = NewPaymentAttemptClient(c.config)
 	c.Plan = NewPlanClient(c.config)
 	c.Price = NewPriceClient(c.config)
+	c.PriceBook = NewPriceBookClient(c.config)
 	c.PriceUnit = NewPriceUnitClient(c.config)
+	c.PromotionCode = NewPromotionCodeClient(c.config)
+	c.RBACRole = NewRBACRoleClient(c.config)
+	c.SSOConnection = NewSSOConnectionClient(c.config)
 	c.ScheduledTask = NewScheduledTaskClient(c.config)
 	c.Secret = NewSecretClient(c.config)
 	c.Settings = NewSettingsClient(c.config)
@@ -213,15 +261,19 @@ func (c *Client) init() {
 	c.SubscriptionPause = NewSubscriptionPauseClient(c.config)
 	c.SubscriptionPhase = NewSubscriptionPhaseClient(c.config)
 	c.SubscriptionSchedule = NewSubscriptionScheduleClient(c.config)
+	c.SubscriptionSeatChange = NewSubscriptionSeatChangeClient(c.config)
 	c.SystemEvent = NewSystemEventClient(c.config)
 	c.Task = NewTaskClient(c.config)
 	c.TaxApplied = NewTaxAppliedClient(c.config)
 	c.TaxAssociation = NewTaxAssociationClient(c.config)
 	c.TaxRate = NewTaxRateClient(c.config)
 	c.Tenant = NewTenantClient(c.config)
+	c.TenantMembership = NewTenantMembershipClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.Wallet = NewWalletClient(c.config)
 	c.WalletTransaction = NewWalletTransactionClient(c.config)
+	c.WebhookDeliveryAttempt = NewWebhookDeliveryAttemptClient(c.config)
+	c.WebhookEndpoint = NewWebhookEndpointClient(c.config)
 	c.WorkflowExecution = NewWorkflowExecutionClient(c.config)
 }
 
@@ -318,8 +370,11 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Addon:                    NewAddonClient(cfg),
 		AddonAssociation:         NewAddonAssociationClient(cfg),
 		AlertLogs:                NewAlertLogsClient(cfg),
+		AuditLog:                 NewAuditLogClient(cfg),
 		Auth:                     NewAuthClient(cfg),
 		BillingSequence:          NewBillingSequenceClient(cfg),
+		CommitmentContract:       NewCommitmentContractClient(cfg),
+		CommitmentDrawdown:       NewCommitmentDrawdownClient(cfg),
 		Connection:               NewConnectionClient(cfg),
 		Costsheet:                NewCostsheetClient(cfg),
 		Coupon:                   NewCouponClient(cfg),
@@ -333,8 +388,10 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Entitlement:              NewEntitlementClient(cfg),
 		EntityIntegrationMapping: NewEntityIntegrationMappingClient(cfg),
 		Environment:              NewEnvironmentClient(cfg),
+		FXRate:                   NewFXRateClient(cfg),
 		Feature:                  NewFeatureClient(cfg),
 		Group:                    NewGroupClient(cfg),
+		Invitation:               NewInvitationClient(cfg),
 		Invoice:                  NewInvoiceClient(cfg),
 		InvoiceLineItem:          NewInvoiceLineItemClient(cfg),
 		InvoiceSequence:          NewInvoiceSequenceClient(cfg),
@@ -343,7 +400,11 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		PaymentAttempt:           NewPaymentAttemptClient(cfg),
 		Plan:                     NewPlanClient(cfg),
 		Price:                    NewPriceClient(cfg),
+		PriceBook:                NewPriceBookClient(cfg),
 		PriceUnit:                NewPriceUnitClient(cfg),
+		PromotionCode:            NewPromotionCodeClient(cfg),
+		RBACRole:                 NewRBACRoleClient(cfg),
+		SSOConnection:            NewSSOConnectionClient(cfg),
 		ScheduledTask:            NewScheduledTaskClient(cfg),
 		Secret:                   NewSecretClient(cfg),
 		Settings:                 NewSettingsClient(cfg),
@@ -352,15 +413,19 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		SubscriptionPause:        NewSubscriptionPauseClient(cfg),
 		SubscriptionPhase:        NewSubscriptionPhaseClient(cfg),
 		SubscriptionSchedule:     NewSubscriptionScheduleClient(cfg),
+		SubscriptionSeatChange:   NewSubscriptionSeatChangeClient(cfg),
 		SystemEvent:              NewSystemEventClient(cfg),
 		Task:                     NewTaskClient(cfg),
 		TaxApplied:               NewTaxAppliedClient(cfg),
 		TaxAssociation:           NewTaxAssociationClient(cfg),
 		TaxRate:                  NewTaxRateClient(cfg),
 		Tenant:                   NewTenantClient(cfg),
+		TenantMembership:         NewTenantMembershipClient(cfg),
 		User:                     NewUserClient(cfg),
 		Wallet:                   NewWalletClient(cfg),
 		WalletTransaction:        NewWalletTransactionClient(cfg),
+		WebhookDeliveryAttempt:   NewWebhookDeliveryAttemptClient(cfg),
+		WebhookEndpoint:          NewWebhookEndpointClient(cfg),
 		WorkflowExecution:        NewWorkflowExecutionClient(cfg),
 	}, nil
 }
@@ -384,8 +449,11 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Addon:                    NewAddonClient(cfg),
 		AddonAssociation:         NewAddonAssociationClient(cfg),
 		AlertLogs:                NewAlertLogsClient(cfg),
+		AuditLog:                 NewAuditLogClient(cfg),
 		Auth:                     NewAuthClient(cfg),
 		BillingSequence:          NewBillingSequenceClient(cfg),
+		CommitmentContract:       NewCommitmentContractClient(cfg),
+		CommitmentDrawdown:       NewCommitmentDrawdownClient(cfg),
 		Connection:               NewConnectionClient(cfg),
 		Costsheet:                NewCostsheetClient(cfg),
 		Coupon:                   NewCouponClient(cfg),
@@ -399,8 +467,10 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Entitlement:              NewEntitlementClient(cfg),
 		EntityIntegrationMapping: NewEntityIntegrationMappingClient(cfg),
 		Environment:              NewEnvironmentClient(cfg),
+		FXRate:                   NewFXRateClient(cfg),
 		Feature:                  NewFeatureClient(cfg),
 		Group:                    NewGroupClient(cfg),
+		Invitation:               NewInvitationClient(cfg),
 		Invoice:                  NewInvoiceClient(cfg),
 		InvoiceLineItem:          NewInvoiceLineItemClient(cfg),
 		InvoiceSequence:          NewInvoiceSequenceClient(cfg),
@@ -409,7 +479,11 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		PaymentAttempt:           NewPaymentAttemptClient(cfg),
 		Plan:                     NewPlanClient(cfg),
 		Price:                    NewPriceClient(cfg),
+		PriceBook:                NewPriceBookClient(cfg),
 		PriceUnit:                NewPriceUnitClient(cfg),
+		PromotionCode:            NewPromotionCodeClient(cfg),
+		RBACRole:                 NewRBACRoleClient(cfg),
+		SSOConnection:            NewSSOConnectionClient(cfg),
 		ScheduledTask:            NewScheduledTaskClient(cfg),
 		Secret:                   NewSecretClient(cfg),
 		Settings:                 NewSettingsClient(cfg),
@@ -418,15 +492,19 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		SubscriptionPause:        NewSubscriptionPauseClient(cfg),
 		SubscriptionPhase:        NewSubscriptionPhaseClient(cfg),
 		SubscriptionSchedule:     NewSubscriptionScheduleClient(cfg),
+		SubscriptionSeatChange:   NewSubscriptionSeatChangeClient(cfg),
 		SystemEvent:              NewSystemEventClient(cfg),
 		Task:                     NewTaskClient(cfg),
 		TaxApplied:               NewTaxAppliedClient(cfg),
 		TaxAssociation:           NewTaxAssociationClient(cfg),
 		TaxRate:                  NewTaxRateClient(cfg),
 		Tenant:                   NewTenantClient(cfg),
+		TenantMembership:         NewTenantMembershipClient(cfg),
 		User:                     NewUserClient(cfg),
 		Wallet:                   NewWalletClient(cfg),
 		WalletTransaction:        NewWalletTransactionClient(cfg),
+		WebhookDeliveryAttempt:   NewWebhookDeliveryAttemptClient(cfg),
+		WebhookEndpoint:          NewWebhookEndpointClient(cfg),
 		WorkflowExecution:        NewWorkflowExecutionClient(cfg),
 	}, nil
 }
@@ -457,16 +535,20 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Addon, c.AddonAssociation, c.AlertLogs, c.Auth, c.BillingSequence,
-		c.Connection, c.Costsheet, c.Coupon, c.CouponApplication, c.CouponAssociation,
-		c.CreditGrant, c.CreditGrantApplication, c.CreditNote, c.CreditNoteLineItem,
-		c.Customer, c.Entitlement, c.EntityIntegrationMapping, c.Environment,
-		c.Feature, c.Group, c.Invoice, c.InvoiceLineItem, c.InvoiceSequence, c.Meter,
-		c.Payment, c.PaymentAttempt, c.Plan, c.Price, c.PriceUnit, c.ScheduledTask,
+		c.Addon, c.AddonAssociation, c.AlertLogs, c.AuditLog, c.Auth, c.BillingSequence,
+		c.CommitmentContract, c.CommitmentDrawdown, c.Connection, c.Costsheet,
+		c.Coupon, c.CouponApplication, c.CouponAssociation, c.CreditGrant,
+		c.CreditGrantApplication, c.CreditNote, c.CreditNoteLineItem, c.Customer,
+		c.Entitlement, c.EntityIntegrationMapping, c.Environment, c.FXRate, c.Feature,
+		c.Group, c.Invitation, c.Invoice, c.InvoiceLineItem, c.InvoiceSequence,
+		c.Meter, c.Payment, c.PaymentAttempt, c.Plan, c.Price, c.PriceBook,
+		c.PriceUnit, c.PromotionCode, c.RBACRole, c.SSOConnection, c.ScheduledTask,
 		c.Secret, c.Settings, c.Subscription, c.SubscriptionLineItem,
 		c.SubscriptionPause, c.SubscriptionPhase, c.SubscriptionSchedule,
-		c.SystemEvent, c.Task, c.TaxApplied, c.TaxAssociation, c.TaxRate, c.Tenant,
-		c.User, c.Wallet, c.WalletTransaction, c.WorkflowExecution,
+		c.SubscriptionSeatChange, c.SystemEvent, c.Task, c.TaxApplied,
+		c.TaxAssociation, c.TaxRate, c.Tenant, c.TenantMembership, c.User, c.Wallet,
+		c.WalletTransaction, c.WebhookDeliveryAttempt, c.WebhookEndpoint,
+		c.WorkflowExecution,
 	} {
 		n.Use(hooks...)
 	}
@@ -476,16 +558,20 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Addon, c.AddonAssociation, c.AlertLogs, c.Auth, c.BillingSequence,
-		c.Connection, c.Costsheet, c.Coupon, c.CouponApplication, c.CouponAssociation,
-		c.CreditGrant, c.CreditGrantApplication, c.CreditNote, c.CreditNoteLineItem,
-		c.Customer, c.Entitlement, c.EntityIntegrationMapping, c.Environment,
-		c.Feature, c.Group, c.Invoice, c.InvoiceLineItem, c.InvoiceSequence, c.Meter,
-		c.Payment, c.PaymentAttempt, c.Plan, c.Price, c.PriceUnit, c.ScheduledTask,
+		c.Addon, c.AddonAssociation, c.AlertLogs, c.AuditLog, c.Auth, c.BillingSequence,
+		c.CommitmentContract, c.CommitmentDrawdown, c.Connection, c.Costsheet,
+		c.Coupon, c.CouponApplication, c.CouponAssociation, c.CreditGrant,
+		c.CreditGrantApplication, c.CreditNote, c.CreditNoteLineItem, c.Customer,
+		c.Entitlement, c.EntityIntegrationMapping, c.Environment, c.FXRate, c.Feature,
+		c.Group, c.Invitation, c.Invoice, c.InvoiceLineItem, c.InvoiceSequence,
+		c.Meter, c.Payment, c.PaymentAttempt, c.Plan, c.Price, c.PriceBook,
+		c.PriceUnit, c.PromotionCode, c.RBACRole, c.SSOConnection, c.ScheduledTask,
 		c.Secret, c.Settings, c.Subscription, c.SubscriptionLineItem,
 		c.SubscriptionPause, c.SubscriptionPhase, c.SubscriptionSchedule,
-		c.SystemEvent, c.Task, c.TaxApplied, c.TaxAssociation, c.TaxRate, c.Tenant,
-		c.User, c.Wallet, c.WalletTransaction, c.WorkflowExecution,
+		c.SubscriptionSeatChange, c.SystemEvent, c.Task, c.TaxApplied,
+		c.TaxAssociation, c.TaxRate, c.Tenant, c.TenantMembership, c.User, c.Wallet,
+		c.WalletTransaction, c.WebhookDeliveryAttempt, c.WebhookEndpoint,
+		c.WorkflowExecution,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -500,10 +586,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.AddonAssociation.mutate(ctx, m)
 	case *AlertLogsMutation:
 		return c.AlertLogs.mutate(ctx, m)
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
 	case *AuthMutation:
 		return c.Auth.mutate(ctx, m)
 	case *BillingSequenceMutation:
 		return c.BillingSequence.mutate(ctx, m)
+	case *CommitmentContractMutation:
+		return c.CommitmentContract.mutate(ctx, m)
+	case *CommitmentDrawdownMutation:
+		return c.CommitmentDrawdown.mutate(ctx, m)
 	case *ConnectionMutation:
 		return c.Connection.mutate(ctx, m)
 	case *CostsheetMutation:
@@ -530,10 +622,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.EntityIntegrationMapping.mutate(ctx, m)
 	case *EnvironmentMutation:
 		return c.Environment.mutate(ctx, m)
+	case *FXRateMutation:
+		return c.FXRate.mutate(ctx, m)
 	case *FeatureMutation:
 		return c.Feature.mutate(ctx, m)
 	case *GroupMutation:
 		return c.Group.mutate(ctx, m)
+	case *InvitationMutation:
+		return c.Invitation.mutate(ctx, m)
 	case *InvoiceMutation:
 		return c.Invoice.mutate(ctx, m)
 	case *InvoiceLineItemMutation:
@@ -550,8 +646,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Plan.mutate(ctx, m)
 	case *PriceMutation:
 		return c.Price.mutate(ctx, m)
+	case *PriceBookMutation:
+		return c.PriceBook.mutate(ctx, m)
 	case *PriceUnitMutation:
 		return c.PriceUnit.mutate(ctx, m)
+	case *PromotionCodeMutation:
+		return c.PromotionCode.mutate(ctx, m)
+	case *RBACRoleMutation:
+		return c.RBACRole.mutate(ctx, m)
+	case *SSOConnectionMutation:
+		return c.SSOConnection.mutate(ctx, m)
 	case *ScheduledTaskMutation:
 		return c.ScheduledTask.mutate(ctx, m)
 	case *SecretMutation:
@@ -568,6 +672,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.SubscriptionPhase.mutate(ctx, m)
 	case *SubscriptionScheduleMutation:
 		return c.SubscriptionSchedule.mutate(ctx, m)
+	case *SubscriptionSeatChangeMutation:
+		return c.SubscriptionSeatChange.mutate(ctx, m)
 	case *SystemEventMutation:
 		return c.SystemEvent.mutate(ctx, m)
 	case *TaskMutation:
@@ -580,12 +686,18 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.TaxRate.mutate(ctx, m)
 	case *TenantMutation:
 		return c.Tenant.mutate(ctx, m)
+	case *TenantMembershipMutation:
+		return c.TenantMembership.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *WalletMutation:
 		return c.Wallet.mutate(ctx, m)
 	case *WalletTransactionMutation:
 		return c.WalletTransaction.mutate(ctx, m)
+	case *WebhookDeliveryAttemptMutation:
+		return c.WebhookDeliveryAttempt.mutate(ctx, m)
+	case *WebhookEndpointMutation:
+		return c.WebhookEndpoint.mutate(ctx, m)
 	case *WorkflowExecutionMutation:
 		return c.WorkflowExecution.mutate(ctx, m)
 	default:
@@ -1008,6 +1120,139 @@ func (c *AlertLogsClient) mutate(ctx context.Context, m *AlertLogsMutation) (Val
 	}
 }
 
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
+	config
+}
+
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
+}
+
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogClient) UpdateOne(al *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(al))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogClient) UpdateOneID(id string) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogClient) DeleteOne(al *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(al.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditLogClient) DeleteOneID(id string) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id string) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogClient) GetX(ctx context.Context, id string) *AuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	return c.hooks.AuditLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
+}
+
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditLog mutation op: %q", m.Op())
+	}
+}
+
 // AuthClient is a client for the Auth schema.
 type AuthClient struct {
 	config
@@ -1274,6 +1519,272 @@ func (c *BillingSequenceClient) mutate(ctx context.Context, m *BillingSequenceMu
 	}
 }
 
+// CommitmentContractClient is a client for the CommitmentContract schema.
+type CommitmentContractClient struct {
+	config
+}
+
+// NewCommitmentContractClient returns a client for the CommitmentContract from the given config.
+func NewCommitmentContractClient(c config) *CommitmentContractClient {
+	return &CommitmentContractClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `commitmentcontract.Hooks(f(g(h())))`.
+func (c *CommitmentContractClient) Use(hooks ...Hook) {
+	c.hooks.CommitmentContract = append(c.hooks.CommitmentContract, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `commitmentcontract.Intercept(f(g(h())))`.
+func (c *CommitmentContractClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CommitmentContract = append(c.inters.CommitmentContract, interceptors...)
+}
+
+// Create returns a builder for creating a CommitmentContract entity.
+func (c *CommitmentContractClient) Create() *CommitmentContractCreate {
+	mutation := newCommitmentContractMutation(c.config, OpCreate)
+	return &CommitmentContractCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CommitmentContract entities.
+func (c *CommitmentContractClient) CreateBulk(builders ...*CommitmentContractCreate) *CommitmentContractCreateBulk {
+	return &CommitmentContractCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CommitmentContractClient) MapCreateBulk(slice any, setFunc func(*CommitmentContractCreate, int)) *CommitmentContractCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CommitmentContractCreateBulk{err: fmt.Errorf("calling to CommitmentContractClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CommitmentContractCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CommitmentContractCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CommitmentContract.
+func (c *CommitmentContractClient) Update() *CommitmentContractUpdate {
+	mutation := newCommitmentContractMutation(c.config, OpUpdate)
+	return &CommitmentContractUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CommitmentContractClient) UpdateOne(cc *CommitmentContract) *CommitmentContractUpdateOne {
+	mutation := newCommitmentContractMutation(c.config, OpUpdateOne, withCommitmentContract(cc))
+	return &CommitmentContractUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CommitmentContractClient) UpdateOneID(id string) *CommitmentContractUpdateOne {
+	mutation := newCommitmentContractMutation(c.config, OpUpdateOne, withCommitmentContractID(id))
+	return &CommitmentContractUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CommitmentContract.
+func (c *CommitmentContractClient) Delete() *CommitmentContractDelete {
+	mutation := newCommitmentContractMutation(c.config, OpDelete)
+	return &CommitmentContractDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CommitmentContractClient) DeleteOne(cc *CommitmentContract) *CommitmentContractDeleteOne {
+	return c.DeleteOneID(cc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CommitmentContractClient) DeleteOneID(id string) *CommitmentContractDeleteOne {
+	builder := c.Delete().Where(commitmentcontract.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CommitmentContractDeleteOne{builder}
+}
+
+// Query returns a query builder for CommitmentContract.
+func (c *CommitmentContractClient) Query() *CommitmentContractQuery {
+	return &CommitmentContractQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCommitmentContract},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CommitmentContract entity by its id.
+func (c *CommitmentContractClient) Get(ctx context.Context, id string) (*CommitmentContract, error) {
+	return c.Query().Where(commitmentcontract.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CommitmentContractClient) GetX(ctx context.Context, id string) *CommitmentContract {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CommitmentContractClient) Hooks() []Hook {
+	return c.hooks.CommitmentContract
+}
+
+// Interceptors returns the client interceptors.
+func (c *CommitmentContractClient) Interceptors() []Interceptor {
+	return c.inters.CommitmentContract
+}
+
+func (c *CommitmentContractClient) mutate(ctx context.Context, m *CommitmentContractMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommitmentContractCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommitmentContractUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommitmentContractUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommitmentContractDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CommitmentContract mutation op: %q", m.Op())
+	}
+}
+
+// CommitmentDrawdownClient is a client for the CommitmentDrawdown schema.
+type CommitmentDrawdownClient struct {
+	config
+}
+
+// NewCommitmentDrawdownClient returns a client for the CommitmentDrawdown from the given config.
+func NewCommitmentDrawdownClient(c config) *CommitmentDrawdownClient {
+	return &CommitmentDrawdownClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `commitmentdrawdown.Hooks(f(g(h())))`.
+func (c *CommitmentDrawdownClient) Use(hooks ...Hook) {
+	c.hooks.CommitmentDrawdown = append(c.hooks.CommitmentDrawdown, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `commitmentdrawdown.Intercept(f(g(h())))`.
+func (c *CommitmentDrawdownClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CommitmentDrawdown = append(c.inters.CommitmentDrawdown, interceptors...)
+}
+
+// Create returns a builder for creating a CommitmentDrawdown entity.
+func (c *CommitmentDrawdownClient) Create() *CommitmentDrawdownCreate {
+	mutation := newCommitmentDrawdownMutation(c.config, OpCreate)
+	return &CommitmentDrawdownCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CommitmentDrawdown entities.
+func (c *CommitmentDrawdownClient) CreateBulk(builders ...*CommitmentDrawdownCreate) *CommitmentDrawdownCreateBulk {
+	return &CommitmentDrawdownCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CommitmentDrawdownClient) MapCreateBulk(slice any, setFunc func(*CommitmentDrawdownCreate, int)) *CommitmentDrawdownCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CommitmentDrawdownCreateBulk{err: fmt.Errorf("calling to CommitmentDrawdownClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CommitmentDrawdownCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CommitmentDrawdownCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CommitmentDrawdown.
+func (c *CommitmentDrawdownClient) Update() *CommitmentDrawdownUpdate {
+	mutation := newCommitmentDrawdownMutation(c.config, OpUpdate)
+	return &CommitmentDrawdownUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CommitmentDrawdownClient) UpdateOne(cd *CommitmentDrawdown) *CommitmentDrawdownUpdateOne {
+	mutation := newCommitmentDrawdownMutation(c.config, OpUpdateOne, withCommitmentDrawdown(cd))
+	return &CommitmentDrawdownUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CommitmentDrawdownClient) UpdateOneID(id string) *CommitmentDrawdownUpdateOne {
+	mutation := newCommitmentDrawdownMutation(c.config, OpUpdateOne, withCommitmentDrawdownID(id))
+	return &CommitmentDrawdownUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CommitmentDrawdown.
+func (c *CommitmentDrawdownClient) Delete() *CommitmentDrawdownDelete {
+	mutation := newCommitmentDrawdownMutation(c.config, OpDelete)
+	return &CommitmentDrawdownDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CommitmentDrawdownClient) DeleteOne(cd *CommitmentDrawdown) *CommitmentDrawdownDeleteOne {
+	return c.DeleteOneID(cd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CommitmentDrawdownClient) DeleteOneID(id string) *CommitmentDrawdownDeleteOne {
+	builder := c.Delete().Where(commitmentdrawdown.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CommitmentDrawdownDeleteOne{builder}
+}
+
+// Query returns a query builder for CommitmentDrawdown.
+func (c *CommitmentDrawdownClient) Query() *CommitmentDrawdownQuery {
+	return &CommitmentDrawdownQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCommitmentDrawdown},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CommitmentDrawdown entity by its id.
+func (c *CommitmentDrawdownClient) Get(ctx context.Context, id string) (*CommitmentDrawdown, error) {
+	return c.Query().Where(commitmentdrawdown.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CommitmentDrawdownClient) GetX(ctx context.Context, id string) *CommitmentDrawdown {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CommitmentDrawdownClient) Hooks() []Hook {
+	return c.hooks.CommitmentDrawdown
+}
+
+// Interceptors returns the client interceptors.
+func (c *CommitmentDrawdownClient) Interceptors() []Interceptor {
+	return c.inters.CommitmentDrawdown
+}
+
+func (c *CommitmentDrawdownClient) mutate(ctx context.Context, m *CommitmentDrawdownMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommitmentDrawdownCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommitmentDrawdownUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommitmentDrawdownUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommitmentDrawdownDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CommitmentDrawdown mutation op: %q", m.Op())
+	}
+}
+
 // ConnectionClient is a client for the Connection schema.
 type ConnectionClient struct {
 	config
@@ -3243,73 +3754,206 @@ func (c *EnvironmentClient) mutate(ctx context.Context, m *EnvironmentMutation)
 	}
 }
 
-// FeatureClient is a client for the Feature schema.
-type FeatureClient struct {
+// FXRateClient is a client for the FXRate schema.
+type FXRateClient struct {
 	config
 }
 
-// NewFeatureClient returns a client for the Feature from the given config.
-func NewFeatureClient(c config) *FeatureClient {
-	return &FeatureClient{config: c}
+// NewFXRateClient returns a client for the FXRate from the given config.
+func NewFXRateClient(c config) *FXRateClient {
+	return &FXRateClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `feature.Hooks(f(g(h())))`.
-func (c *FeatureClient) Use(hooks ...Hook) {
-	c.hooks.Feature = append(c.hooks.Feature, hooks...)
+// A call to `Use(f, g, h)` equals to `fxrate.Hooks(f(g(h())))`.
+func (c *FXRateClient) Use(hooks ...Hook) {
+	c.hooks.FXRate = append(c.hooks.FXRate, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `feature.Intercept(f(g(h())))`.
-func (c *FeatureClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Feature = append(c.inters.Feature, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `fxrate.Intercept(f(g(h())))`.
+func (c *FXRateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FXRate = append(c.inters.FXRate, interceptors...)
 }
 
-// Create returns a builder for creating a Feature entity.
-func (c *FeatureClient) Create() *FeatureCreate {
-	mutation := newFeatureMutation(c.config, OpCreate)
-	return &FeatureCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a FXRate entity.
+func (c *FXRateClient) Create() *FXRateCreate {
+	mutation := newFXRateMutation(c.config, OpCreate)
+	return &FXRateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Feature entities.
-func (c *FeatureClient) CreateBulk(builders ...*FeatureCreate) *FeatureCreateBulk {
-	return &FeatureCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of FXRate entities.
+func (c *FXRateClient) CreateBulk(builders ...*FXRateCreate) *FXRateCreateBulk {
+	return &FXRateCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FeatureClient) MapCreateBulk(slice any, setFunc func(*FeatureCreate, int)) *FeatureCreateBulk {
+func (c *FXRateClient) MapCreateBulk(slice any, setFunc func(*FXRateCreate, int)) *FXRateCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FeatureCreateBulk{err: fmt.Errorf("calling to FeatureClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &FXRateCreateBulk{err: fmt.Errorf("calling to FXRateClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FeatureCreate, rv.Len())
+	builders := make([]*FXRateCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FeatureCreateBulk{config: c.config, builders: builders}
+	return &FXRateCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Feature.
-func (c *FeatureClient) Update() *FeatureUpdate {
-	mutation := newFeatureMutation(c.config, OpUpdate)
-	return &FeatureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for FXRate.
+func (c *FXRateClient) Update() *FXRateUpdate {
+	mutation := newFXRateMutation(c.config, OpUpdate)
+	return &FXRateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FeatureClient) UpdateOne(f *Feature) *FeatureUpdateOne {
-	mutation := newFeatureMutation(c.config, OpUpdateOne, withFeature(f))
-	return &FeatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FXRateClient) UpdateOne(fr *FXRate) *FXRateUpdateOne {
+	mutation := newFXRateMutation(c.config, OpUpdateOne, withFXRate(fr))
+	return &FXRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FeatureClient) UpdateOneID(id string) *FeatureUpdateOne {
-	mutation := newFeatureMutation(c.config, OpUpdateOne, withFeatureID(id))
-	return &FeatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FXRateClient) UpdateOneID(id string) *FXRateUpdateOne {
+	mutation := newFXRateMutation(c.config, OpUpdateOne, withFXRateID(id))
+	return &FXRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Feature.
+// Delete returns a delete builder for FXRate.
+func (c *FXRateClient) Delete() *FXRateDelete {
+	mutation := newFXRateMutation(c.config, OpDelete)
+	return &FXRateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FXRateClient) DeleteOne(fr *FXRate) *FXRateDeleteOne {
+	return c.DeleteOneID(fr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FXRateClient) DeleteOneID(id string) *FXRateDeleteOne {
+	builder := c.Delete().Where(fxrate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FXRateDeleteOne{builder}
+}
+
+// Query returns a query builder for FXRate.
+func (c *FXRateClient) Query() *FXRateQuery {
+	return &FXRateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFXRate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FXRate entity by its id.
+func (c *FXRateClient) Get(ctx context.Context, id string) (*FXRate, error) {
+	return c.Query().Where(fxrate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FXRateClient) GetX(ctx context.Context, id string) *FXRate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FXRateClient) Hooks() []Hook {
+	return c.hooks.FXRate
+}
+
+// Interceptors returns the client interceptors.
+func (c *FXRateClient) Interceptors() []Interceptor {
+	return c.inters.FXRate
+}
+
+func (c *FXRateClient) mutate(ctx context.Context, m *FXRateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FXRateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FXRateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FXRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FXRateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FXRate mutation op: %q", m.Op())
+	}
+}
+
+// FeatureClient is a client for the Feature schema.
+type FeatureClient struct {
+	config
+}
+
+// NewFeatureClient returns a client for the Feature from the given config.
+func NewFeatureClient(c config) *FeatureClient {
+	return &FeatureClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `feature.Hooks(f(g(h())))`.
+func (c *FeatureClient) Use(hooks ...Hook) {
+	c.hooks.Feature = append(c.hooks.Feature, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `feature.Intercept(f(g(h())))`.
+func (c *FeatureClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Feature = append(c.inters.Feature, interceptors...)
+}
+
+// Create returns a builder for creating a Feature entity.
+func (c *FeatureClient) Create() *FeatureCreate {
+	mutation := newFeatureMutation(c.config, OpCreate)
+	return &FeatureCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Feature entities.
+func (c *FeatureClient) CreateBulk(builders ...*FeatureCreate) *FeatureCreateBulk {
+	return &FeatureCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FeatureClient) MapCreateBulk(slice any, setFunc func(*FeatureCreate, int)) *FeatureCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FeatureCreateBulk{err: fmt.Errorf("calling to FeatureClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FeatureCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FeatureCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Feature.
+func (c *FeatureClient) Update() *FeatureUpdate {
+	mutation := newFeatureMutation(c.config, OpUpdate)
+	return &FeatureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FeatureClient) UpdateOne(f *Feature) *FeatureUpdateOne {
+	mutation := newFeatureMutation(c.config, OpUpdateOne, withFeature(f))
+	return &FeatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FeatureClient) UpdateOneID(id string) *FeatureUpdateOne {
+	mutation := newFeatureMutation(c.config, OpUpdateOne, withFeatureID(id))
+	return &FeatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Feature.
 func (c *FeatureClient) Delete() *FeatureDelete {
 	mutation := newFeatureMutation(c.config, OpDelete)
 	return &FeatureDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
@@ -3509,6 +4153,139 @@ func (c *GroupClient) mutate(ctx context.Context, m *GroupMutation) (Value, erro
 	}
 }
 
+// InvitationClient is a client for the Invitation schema.
+type InvitationClient struct {
+	config
+}
+
+// NewInvitationClient returns a client for the Invitation from the given config.
+func NewInvitationClient(c config) *InvitationClient {
+	return &InvitationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invitation.Hooks(f(g(h())))`.
+func (c *InvitationClient) Use(hooks ...Hook) {
+	c.hooks.Invitation = append(c.hooks.Invitation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invitation.Intercept(f(g(h())))`.
+func (c *InvitationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invitation = append(c.inters.Invitation, interceptors...)
+}
+
+// Create returns a builder for creating a Invitation entity.
+func (c *InvitationClient) Create() *InvitationCreate {
+	mutation := newInvitationMutation(c.config, OpCreate)
+	return &InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Invitation entities.
+func (c *InvitationClient) CreateBulk(builders ...*InvitationCreate) *InvitationCreateBulk {
+	return &InvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvitationClient) MapCreateBulk(slice any, setFunc func(*InvitationCreate, int)) *InvitationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvitationCreateBulk{err: fmt.Errorf("calling to InvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvitationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Invitation.
+func (c *InvitationClient) Update() *InvitationUpdate {
+	mutation := newInvitationMutation(c.config, OpUpdate)
+	return &InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvitationClient) UpdateOne(i *Invitation) *InvitationUpdateOne {
+	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitation(i))
+	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvitationClient) UpdateOneID(id string) *InvitationUpdateOne {
+	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitationID(id))
+	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Invitation.
+func (c *InvitationClient) Delete() *InvitationDelete {
+	mutation := newInvitationMutation(c.config, OpDelete)
+	return &InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvitationClient) DeleteOne(i *Invitation) *InvitationDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvitationClient) DeleteOneID(id string) *InvitationDeleteOne {
+	builder := c.Delete().Where(invitation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvitationDeleteOne{builder}
+}
+
+// Query returns a query builder for Invitation.
+func (c *InvitationClient) Query() *InvitationQuery {
+	return &InvitationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvitation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Invitation entity by its id.
+func (c *InvitationClient) Get(ctx context.Context, id string) (*Invitation, error) {
+	return c.Query().Where(invitation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvitationClient) GetX(ctx context.Context, id string) *Invitation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InvitationClient) Hooks() []Hook {
+	return c.hooks.Invitation
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvitationClient) Interceptors() []Interceptor {
+	return c.inters.Invitation
+}
+
+func (c *InvitationClient) mutate(ctx context.Context, m *InvitationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Invitation mutation op: %q", m.Op())
+	}
+}
+
 // InvoiceClient is a client for the Invoice schema.
 type InvoiceClient struct {
 	config
@@ -4717,6 +5494,139 @@ func (c *PriceClient) mutate(ctx context.Context, m *PriceMutation) (Value, erro
 	}
 }
 
+// PriceBookClient is a client for the PriceBook schema.
+type PriceBookClient struct {
+	config
+}
+
+// NewPriceBookClient returns a client for the PriceBook from the given config.
+func NewPriceBookClient(c config) *PriceBookClient {
+	return &PriceBookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pricebook.Hooks(f(g(h())))`.
+func (c *PriceBookClient) Use(hooks ...Hook) {
+	c.hooks.PriceBook = append(c.hooks.PriceBook, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pricebook.Intercept(f(g(h())))`.
+func (c *PriceBookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PriceBook = append(c.inters.PriceBook, interceptors...)
+}
+
+// Create returns a builder for creating a PriceBook entity.
+func (c *PriceBookClient) Create() *PriceBookCreate {
+	mutation := newPriceBookMutation(c.config, OpCreate)
+	return &PriceBookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PriceBook entities.
+func (c *PriceBookClient) CreateBulk(builders ...*PriceBookCreate) *PriceBookCreateBulk {
+	return &PriceBookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PriceBookClient) MapCreateBulk(slice any, setFunc func(*PriceBookCreate, int)) *PriceBookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PriceBookCreateBulk{err: fmt.Errorf("calling to PriceBookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PriceBookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PriceBookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PriceBook.
+func (c *PriceBookClient) Update() *PriceBookUpdate {
+	mutation := newPriceBookMutation(c.config, OpUpdate)
+	return &PriceBookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PriceBookClient) UpdateOne(pb *PriceBook) *PriceBookUpdateOne {
+	mutation := newPriceBookMutation(c.config, OpUpdateOne, withPriceBook(pb))
+	return &PriceBookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PriceBookClient) UpdateOneID(id string) *PriceBookUpdateOne {
+	mutation := newPriceBookMutation(c.config, OpUpdateOne, withPriceBookID(id))
+	return &PriceBookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PriceBook.
+func (c *PriceBookClient) Delete() *PriceBookDelete {
+	mutation := newPriceBookMutation(c.config, OpDelete)
+	return &PriceBookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PriceBookClient) DeleteOne(pb *PriceBook) *PriceBookDeleteOne {
+	return c.DeleteOneID(pb.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PriceBookClient) DeleteOneID(id string) *PriceBookDeleteOne {
+	builder := c.Delete().Where(pricebook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PriceBookDeleteOne{builder}
+}
+
+// Query returns a query builder for PriceBook.
+func (c *PriceBookClient) Query() *PriceBookQuery {
+	return &PriceBookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePriceBook},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PriceBook entity by its id.
+func (c *PriceBookClient) Get(ctx context.Context, id string) (*PriceBook, error) {
+	return c.Query().Where(pricebook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PriceBookClient) GetX(ctx context.Context, id string) *PriceBook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PriceBookClient) Hooks() []Hook {
+	return c.hooks.PriceBook
+}
+
+// Interceptors returns the client interceptors.
+func (c *PriceBookClient) Interceptors() []Interceptor {
+	return c.inters.PriceBook
+}
+
+func (c *PriceBookClient) mutate(ctx context.Context, m *PriceBookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PriceBookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PriceBookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PriceBookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PriceBookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PriceBook mutation op: %q", m.Op())
+	}
+}
+
 // PriceUnitClient is a client for the PriceUnit schema.
 type PriceUnitClient struct {
 	config
@@ -4866,6 +5776,405 @@ func (c *PriceUnitClient) mutate(ctx context.Context, m *PriceUnitMutation) (Val
 	}
 }
 
+// PromotionCodeClient is a client for the PromotionCode schema.
+type PromotionCodeClient struct {
+	config
+}
+
+// NewPromotionCodeClient returns a client for the PromotionCode from the given config.
+func NewPromotionCodeClient(c config) *PromotionCodeClient {
+	return &PromotionCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `promotioncode.Hooks(f(g(h())))`.
+func (c *PromotionCodeClient) Use(hooks ...Hook) {
+	c.hooks.PromotionCode = append(c.hooks.PromotionCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `promotioncode.Intercept(f(g(h())))`.
+func (c *PromotionCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PromotionCode = append(c.inters.PromotionCode, interceptors...)
+}
+
+// Create returns a builder for creating a PromotionCode entity.
+func (c *PromotionCodeClient) Create() *PromotionCodeCreate {
+	mutation := newPromotionCodeMutation(c.config, OpCreate)
+	return &PromotionCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PromotionCode entities.
+func (c *PromotionCodeClient) CreateBulk(builders ...*PromotionCodeCreate) *PromotionCodeCreateBulk {
+	return &PromotionCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PromotionCodeClient) MapCreateBulk(slice any, setFunc func(*PromotionCodeCreate, int)) *PromotionCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PromotionCodeCreateBulk{err: fmt.Errorf("calling to PromotionCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PromotionCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PromotionCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PromotionCode.
+func (c *PromotionCodeClient) Update() *PromotionCodeUpdate {
+	mutation := newPromotionCodeMutation(c.config, OpUpdate)
+	return &PromotionCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PromotionCodeClient) UpdateOne(pc *PromotionCode) *PromotionCodeUpdateOne {
+	mutation := newPromotionCodeMutation(c.config, OpUpdateOne, withPromotionCode(pc))
+	return &PromotionCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PromotionCodeClient) UpdateOneID(id string) *PromotionCodeUpdateOne {
+	mutation := newPromotionCodeMutation(c.config, OpUpdateOne, withPromotionCodeID(id))
+	return &PromotionCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PromotionCode.
+func (c *PromotionCodeClient) Delete() *PromotionCodeDelete {
+	mutation := newPromotionCodeMutation(c.config, OpDelete)
+	return &PromotionCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PromotionCodeClient) DeleteOne(pc *PromotionCode) *PromotionCodeDeleteOne {
+	return c.DeleteOneID(pc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PromotionCodeClient) DeleteOneID(id string) *PromotionCodeDeleteOne {
+	builder := c.Delete().Where(promotioncode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PromotionCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for PromotionCode.
+func (c *PromotionCodeClient) Query() *PromotionCodeQuery {
+	return &PromotionCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePromotionCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PromotionCode entity by its id.
+func (c *PromotionCodeClient) Get(ctx context.Context, id string) (*PromotionCode, error) {
+	return c.Query().Where(promotioncode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PromotionCodeClient) GetX(ctx context.Context, id string) *PromotionCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PromotionCodeClient) Hooks() []Hook {
+	return c.hooks.PromotionCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *PromotionCodeClient) Interceptors() []Interceptor {
+	return c.inters.PromotionCode
+}
+
+func (c *PromotionCodeClient) mutate(ctx context.Context, m *PromotionCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PromotionCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PromotionCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PromotionCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PromotionCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PromotionCode mutation op: %q", m.Op())
+	}
+}
+
+// RBACRoleClient is a client for the RBACRole schema.
+type RBACRoleClient struct {
+	config
+}
+
+// NewRBACRoleClient returns a client for the RBACRole from the given config.
+func NewRBACRoleClient(c config) *RBACRoleClient {
+	return &RBACRoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rbacrole.Hooks(f(g(h())))`.
+func (c *RBACRoleClient) Use(hooks ...Hook) {
+	c.hooks.RBACRole = append(c.hooks.RBACRole, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rbacrole.Intercept(f(g(h())))`.
+func (c *RBACRoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RBACRole = append(c.inters.RBACRole, interceptors...)
+}
+
+// Create returns a builder for creating a RBACRole entity.
+func (c *RBACRoleClient) Create() *RBACRoleCreate {
+	mutation := newRBACRoleMutation(c.config, OpCreate)
+	return &RBACRoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RBACRole entities.
+func (c *RBACRoleClient) CreateBulk(builders ...*RBACRoleCreate) *RBACRoleCreateBulk {
+	return &RBACRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RBACRoleClient) MapCreateBulk(slice any, setFunc func(*RBACRoleCreate, int)) *RBACRoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RBACRoleCreateBulk{err: fmt.Errorf("calling to RBACRoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RBACRoleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RBACRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RBACRole.
+func (c *RBACRoleClient) Update() *RBACRoleUpdate {
+	mutation := newRBACRoleMutation(c.config, OpUpdate)
+	return &RBACRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RBACRoleClient) UpdateOne(rr *RBACRole) *RBACRoleUpdateOne {
+	mutation := newRBACRoleMutation(c.config, OpUpdateOne, withRBACRole(rr))
+	return &RBACRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RBACRoleClient) UpdateOneID(id string) *RBACRoleUpdateOne {
+	mutation := newRBACRoleMutation(c.config, OpUpdateOne, withRBACRoleID(id))
+	return &RBACRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RBACRole.
+func (c *RBACRoleClient) Delete() *RBACRoleDelete {
+	mutation := newRBACRoleMutation(c.config, OpDelete)
+	return &RBACRoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RBACRoleClient) DeleteOne(rr *RBACRole) *RBACRoleDeleteOne {
+	return c.DeleteOneID(rr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RBACRoleClient) DeleteOneID(id string) *RBACRoleDeleteOne {
+	builder := c.Delete().Where(rbacrole.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RBACRoleDeleteOne{builder}
+}
+
+// Query returns a query builder for RBACRole.
+func (c *RBACRoleClient) Query() *RBACRoleQuery {
+	return &RBACRoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRBACRole},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RBACRole entity by its id.
+func (c *RBACRoleClient) Get(ctx context.Context, id string) (*RBACRole, error) {
+	return c.Query().Where(rbacrole.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RBACRoleClient) GetX(ctx context.Context, id string) *RBACRole {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RBACRoleClient) Hooks() []Hook {
+	return c.hooks.RBACRole
+}
+
+// Interceptors returns the client interceptors.
+func (c *RBACRoleClient) Interceptors() []Interceptor {
+	return c.inters.RBACRole
+}
+
+func (c *RBACRoleClient) mutate(ctx context.Context, m *RBACRoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RBACRoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RBACRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RBACRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RBACRoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RBACRole mutation op: %q", m.Op())
+	}
+}
+
+// SSOConnectionClient is a client for the SSOConnection schema.
+type SSOConnectionClient struct {
+	config
+}
+
+// NewSSOConnectionClient returns a client for the SSOConnection from the given config.
+func NewSSOConnectionClient(c config) *SSOConnectionClient {
+	return &SSOConnectionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ssoconnection.Hooks(f(g(h())))`.
+func (c *SSOConnectionClient) Use(hooks ...Hook) {
+	c.hooks.SSOConnection = append(c.hooks.SSOConnection, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ssoconnection.Intercept(f(g(h())))`.
+func (c *SSOConnectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SSOConnection = append(c.inters.SSOConnection, interceptors...)
+}
+
+// Create returns a builder for creating a SSOConnection entity.
+func (c *SSOConnectionClient) Create() *SSOConnectionCreate {
+	mutation := newSSOConnectionMutation(c.config, OpCreate)
+	return &SSOConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SSOConnection entities.
+func (c *SSOConnectionClient) CreateBulk(builders ...*SSOConnectionCreate) *SSOConnectionCreateBulk {
+	return &SSOConnectionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SSOConnectionClient) MapCreateBulk(slice any, setFunc func(*SSOConnectionCreate, int)) *SSOConnectionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SSOConnectionCreateBulk{err: fmt.Errorf("calling to SSOConnectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SSOConnectionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SSOConnectionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SSOConnection.
+func (c *SSOConnectionClient) Update() *SSOConnectionUpdate {
+	mutation := newSSOConnectionMutation(c.config, OpUpdate)
+	return &SSOConnectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SSOConnectionClient) UpdateOne(sc *SSOConnection) *SSOConnectionUpdateOne {
+	mutation := newSSOConnectionMutation(c.config, OpUpdateOne, withSSOConnection(sc))
+	return &SSOConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SSOConnectionClient) UpdateOneID(id string) *SSOConnectionUpdateOne {
+	mutation := newSSOConnectionMutation(c.config, OpUpdateOne, withSSOConnectionID(id))
+	return &SSOConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SSOConnection.
+func (c *SSOConnectionClient) Delete() *SSOConnectionDelete {
+	mutation := newSSOConnectionMutation(c.config, OpDelete)
+	return &SSOConnectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SSOConnectionClient) DeleteOne(sc *SSOConnection) *SSOConnectionDeleteOne {
+	return c.DeleteOneID(sc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SSOConnectionClient) DeleteOneID(id string) *SSOConnectionDeleteOne {
+	builder := c.Delete().Where(ssoconnection.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SSOConnectionDeleteOne{builder}
+}
+
+// Query returns a query builder for SSOConnection.
+func (c *SSOConnectionClient) Query() *SSOConnectionQuery {
+	return &SSOConnectionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSSOConnection},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SSOConnection entity by its id.
+func (c *SSOConnectionClient) Get(ctx context.Context, id string) (*SSOConnection, error) {
+	return c.Query().Where(ssoconnection.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SSOConnectionClient) GetX(ctx context.Context, id string) *SSOConnection {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SSOConnectionClient) Hooks() []Hook {
+	return c.hooks.SSOConnection
+}
+
+// Interceptors returns the client interceptors.
+func (c *SSOConnectionClient) Interceptors() []Interceptor {
+	return c.inters.SSOConnection
+}
+
+func (c *SSOConnectionClient) mutate(ctx context.Context, m *SSOConnectionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SSOConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SSOConnectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SSOConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SSOConnectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SSOConnection mutation op: %q", m.Op())
+	}
+}
+
 // ScheduledTaskClient is a client for the ScheduledTask schema.
 type ScheduledTaskClient struct {
 	config
@@ -6138,74 +7447,207 @@ func (c *SubscriptionScheduleClient) mutate(ctx context.Context, m *Subscription
 	}
 }
 
-// SystemEventClient is a client for the SystemEvent schema.
-type SystemEventClient struct {
+// SubscriptionSeatChangeClient is a client for the SubscriptionSeatChange schema.
+type SubscriptionSeatChangeClient struct {
 	config
 }
 
-// NewSystemEventClient returns a client for the SystemEvent from the given config.
-func NewSystemEventClient(c config) *SystemEventClient {
-	return &SystemEventClient{config: c}
+// NewSubscriptionSeatChangeClient returns a client for the SubscriptionSeatChange from the given config.
+func NewSubscriptionSeatChangeClient(c config) *SubscriptionSeatChangeClient {
+	return &SubscriptionSeatChangeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `systemevent.Hooks(f(g(h())))`.
-func (c *SystemEventClient) Use(hooks ...Hook) {
-	c.hooks.SystemEvent = append(c.hooks.SystemEvent, hooks...)
+// A call to `Use(f, g, h)` equals to `subscriptionseatchange.Hooks(f(g(h())))`.
+func (c *SubscriptionSeatChangeClient) Use(hooks ...Hook) {
+	c.hooks.SubscriptionSeatChange = append(c.hooks.SubscriptionSeatChange, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `systemevent.Intercept(f(g(h())))`.
-func (c *SystemEventClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SystemEvent = append(c.inters.SystemEvent, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `subscriptionseatchange.Intercept(f(g(h())))`.
+func (c *SubscriptionSeatChangeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SubscriptionSeatChange = append(c.inters.SubscriptionSeatChange, interceptors...)
 }
 
-// Create returns a builder for creating a SystemEvent entity.
-func (c *SystemEventClient) Create() *SystemEventCreate {
-	mutation := newSystemEventMutation(c.config, OpCreate)
-	return &SystemEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SubscriptionSeatChange entity.
+func (c *SubscriptionSeatChangeClient) Create() *SubscriptionSeatChangeCreate {
+	mutation := newSubscriptionSeatChangeMutation(c.config, OpCreate)
+	return &SubscriptionSeatChangeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of SystemEvent entities.
-func (c *SystemEventClient) CreateBulk(builders ...*SystemEventCreate) *SystemEventCreateBulk {
-	return &SystemEventCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SubscriptionSeatChange entities.
+func (c *SubscriptionSeatChangeClient) CreateBulk(builders ...*SubscriptionSeatChangeCreate) *SubscriptionSeatChangeCreateBulk {
+	return &SubscriptionSeatChangeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SystemEventClient) MapCreateBulk(slice any, setFunc func(*SystemEventCreate, int)) *SystemEventCreateBulk {
+func (c *SubscriptionSeatChangeClient) MapCreateBulk(slice any, setFunc func(*SubscriptionSeatChangeCreate, int)) *SubscriptionSeatChangeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SystemEventCreateBulk{err: fmt.Errorf("calling to SystemEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SubscriptionSeatChangeCreateBulk{err: fmt.Errorf("calling to SubscriptionSeatChangeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SystemEventCreate, rv.Len())
+	builders := make([]*SubscriptionSeatChangeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SystemEventCreateBulk{config: c.config, builders: builders}
+	return &SubscriptionSeatChangeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for SystemEvent.
-func (c *SystemEventClient) Update() *SystemEventUpdate {
-	mutation := newSystemEventMutation(c.config, OpUpdate)
-	return &SystemEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SubscriptionSeatChange.
+func (c *SubscriptionSeatChangeClient) Update() *SubscriptionSeatChangeUpdate {
+	mutation := newSubscriptionSeatChangeMutation(c.config, OpUpdate)
+	return &SubscriptionSeatChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SystemEventClient) UpdateOne(se *SystemEvent) *SystemEventUpdateOne {
-	mutation := newSystemEventMutation(c.config, OpUpdateOne, withSystemEvent(se))
-	return &SystemEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SubscriptionSeatChangeClient) UpdateOne(ssc *SubscriptionSeatChange) *SubscriptionSeatChangeUpdateOne {
+	mutation := newSubscriptionSeatChangeMutation(c.config, OpUpdateOne, withSubscriptionSeatChange(ssc))
+	return &SubscriptionSeatChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SystemEventClient) UpdateOneID(id string) *SystemEventUpdateOne {
-	mutation := newSystemEventMutation(c.config, OpUpdateOne, withSystemEventID(id))
-	return &SystemEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SubscriptionSeatChangeClient) UpdateOneID(id string) *SubscriptionSeatChangeUpdateOne {
+	mutation := newSubscriptionSeatChangeMutation(c.config, OpUpdateOne, withSubscriptionSeatChangeID(id))
+	return &SubscriptionSeatChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for SystemEvent.
-func (c *SystemEventClient) Delete() *SystemEventDelete {
+// Delete returns a delete builder for SubscriptionSeatChange.
+func (c *SubscriptionSeatChangeClient) Delete() *SubscriptionSeatChangeDelete {
+	mutation := newSubscriptionSeatChangeMutation(c.config, OpDelete)
+	return &SubscriptionSeatChangeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SubscriptionSeatChangeClient) DeleteOne(ssc *SubscriptionSeatChange) *SubscriptionSeatChangeDeleteOne {
+	return c.DeleteOneID(ssc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SubscriptionSeatChangeClient) DeleteOneID(id string) *SubscriptionSeatChangeDeleteOne {
+	builder := c.Delete().Where(subscriptionseatchange.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SubscriptionSeatChangeDeleteOne{builder}
+}
+
+// Query returns a query builder for SubscriptionSeatChange.
+func (c *SubscriptionSeatChangeClient) Query() *SubscriptionSeatChangeQuery {
+	return &SubscriptionSeatChangeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSubscriptionSeatChange},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SubscriptionSeatChange entity by its id.
+func (c *SubscriptionSeatChangeClient) Get(ctx context.Context, id string) (*SubscriptionSeatChange, error) {
+	return c.Query().Where(subscriptionseatchange.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SubscriptionSeatChangeClient) GetX(ctx context.Context, id string) *SubscriptionSeatChange {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SubscriptionSeatChangeClient) Hooks() []Hook {
+	return c.hooks.SubscriptionSeatChange
+}
+
+// Interceptors returns the client interceptors.
+func (c *SubscriptionSeatChangeClient) Interceptors() []Interceptor {
+	return c.inters.SubscriptionSeatChange
+}
+
+func (c *SubscriptionSeatChangeClient) mutate(ctx context.Context, m *SubscriptionSeatChangeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SubscriptionSeatChangeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SubscriptionSeatChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SubscriptionSeatChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SubscriptionSeatChangeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SubscriptionSeatChange mutation op: %q", m.Op())
+	}
+}
+
+// SystemEventClient is a client for the SystemEvent schema.
+type SystemEventClient struct {
+	config
+}
+
+// NewSystemEventClient returns a client for the SystemEvent from the given config.
+func NewSystemEventClient(c config) *SystemEventClient {
+	return &SystemEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `systemevent.Hooks(f(g(h())))`.
+func (c *SystemEventClient) Use(hooks ...Hook) {
+	c.hooks.SystemEvent = append(c.hooks.SystemEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `systemevent.Intercept(f(g(h())))`.
+func (c *SystemEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SystemEvent = append(c.inters.SystemEvent, interceptors...)
+}
+
+// Create returns a builder for creating a SystemEvent entity.
+func (c *SystemEventClient) Create() *SystemEventCreate {
+	mutation := newSystemEventMutation(c.config, OpCreate)
+	return &SystemEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SystemEvent entities.
+func (c *SystemEventClient) CreateBulk(builders ...*SystemEventCreate) *SystemEventCreateBulk {
+	return &SystemEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SystemEventClient) MapCreateBulk(slice any, setFunc func(*SystemEventCreate, int)) *SystemEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SystemEventCreateBulk{err: fmt.Errorf("calling to SystemEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SystemEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SystemEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SystemEvent.
+func (c *SystemEventClient) Update() *SystemEventUpdate {
+	mutation := newSystemEventMutation(c.config, OpUpdate)
+	return &SystemEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SystemEventClient) UpdateOne(se *SystemEvent) *SystemEventUpdateOne {
+	mutation := newSystemEventMutation(c.config, OpUpdateOne, withSystemEvent(se))
+	return &SystemEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SystemEventClient) UpdateOneID(id string) *SystemEventUpdateOne {
+	mutation := newSystemEventMutation(c.config, OpUpdateOne, withSystemEventID(id))
+	return &SystemEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SystemEvent.
+func (c *SystemEventClient) Delete() *SystemEventDelete {
 	mutation := newSystemEventMutation(c.config, OpDelete)
 	return &SystemEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
@@ -6936,6 +8378,139 @@ func (c *TenantClient) mutate(ctx context.Context, m *TenantMutation) (Value, er
 	}
 }
 
+// TenantMembershipClient is a client for the TenantMembership schema.
+type TenantMembershipClient struct {
+	config
+}
+
+// NewTenantMembershipClient returns a client for the TenantMembership from the given config.
+func NewTenantMembershipClient(c config) *TenantMembershipClient {
+	return &TenantMembershipClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tenantmembership.Hooks(f(g(h())))`.
+func (c *TenantMembershipClient) Use(hooks ...Hook) {
+	c.hooks.TenantMembership = append(c.hooks.TenantMembership, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tenantmembership.Intercept(f(g(h())))`.
+func (c *TenantMembershipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TenantMembership = append(c.inters.TenantMembership, interceptors...)
+}
+
+// Create returns a builder for creating a TenantMembership entity.
+func (c *TenantMembershipClient) Create() *TenantMembershipCreate {
+	mutation := newTenantMembershipMutation(c.config, OpCreate)
+	return &TenantMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TenantMembership entities.
+func (c *TenantMembershipClient) CreateBulk(builders ...*TenantMembershipCreate) *TenantMembershipCreateBulk {
+	return &TenantMembershipCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TenantMembershipClient) MapCreateBulk(slice any, setFunc func(*TenantMembershipCreate, int)) *TenantMembershipCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TenantMembershipCreateBulk{err: fmt.Errorf("calling to TenantMembershipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TenantMembershipCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TenantMembershipCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TenantMembership.
+func (c *TenantMembershipClient) Update() *TenantMembershipUpdate {
+	mutation := newTenantMembershipMutation(c.config, OpUpdate)
+	return &TenantMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TenantMembershipClient) UpdateOne(tm *TenantMembership) *TenantMembershipUpdateOne {
+	mutation := newTenantMembershipMutation(c.config, OpUpdateOne, withTenantMembership(tm))
+	return &TenantMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TenantMembershipClient) UpdateOneID(id string) *TenantMembershipUpdateOne {
+	mutation := newTenantMembershipMutation(c.config, OpUpdateOne, withTenantMembershipID(id))
+	return &TenantMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TenantMembership.
+func (c *TenantMembershipClient) Delete() *TenantMembershipDelete {
+	mutation := newTenantMembershipMutation(c.config, OpDelete)
+	return &TenantMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TenantMembershipClient) DeleteOne(tm *TenantMembership) *TenantMembershipDeleteOne {
+	return c.DeleteOneID(tm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TenantMembershipClient) DeleteOneID(id string) *TenantMembershipDeleteOne {
+	builder := c.Delete().Where(tenantmembership.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TenantMembershipDeleteOne{builder}
+}
+
+// Query returns a query builder for TenantMembership.
+func (c *TenantMembershipClient) Query() *TenantMembershipQuery {
+	return &TenantMembershipQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTenantMembership},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TenantMembership entity by its id.
+func (c *TenantMembershipClient) Get(ctx context.Context, id string) (*TenantMembership, error) {
+	return c.Query().Where(tenantmembership.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TenantMembershipClient) GetX(ctx context.Context, id string) *TenantMembership {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TenantMembershipClient) Hooks() []Hook {
+	return c.hooks.TenantMembership
+}
+
+// Interceptors returns the client interceptors.
+func (c *TenantMembershipClient) Interceptors() []Interceptor {
+	return c.inters.TenantMembership
+}
+
+func (c *TenantMembershipClient) mutate(ctx context.Context, m *TenantMembershipMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TenantMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TenantMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TenantMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TenantMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TenantMembership mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -7335,6 +8910,272 @@ func (c *WalletTransactionClient) mutate(ctx context.Context, m *WalletTransacti
 	}
 }
 
+// WebhookDeliveryAttemptClient is a client for the WebhookDeliveryAttempt schema.
+type WebhookDeliveryAttemptClient struct {
+	config
+}
+
+// NewWebhookDeliveryAttemptClient returns a client for the WebhookDeliveryAttempt from the given config.
+func NewWebhookDeliveryAttemptClient(c config) *WebhookDeliveryAttemptClient {
+	return &WebhookDeliveryAttemptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdeliveryattempt.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryAttemptClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDeliveryAttempt = append(c.hooks.WebhookDeliveryAttempt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdeliveryattempt.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryAttemptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDeliveryAttempt = append(c.inters.WebhookDeliveryAttempt, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDeliveryAttempt entity.
+func (c *WebhookDeliveryAttemptClient) Create() *WebhookDeliveryAttemptCreate {
+	mutation := newWebhookDeliveryAttemptMutation(c.config, OpCreate)
+	return &WebhookDeliveryAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDeliveryAttempt entities.
+func (c *WebhookDeliveryAttemptClient) CreateBulk(builders ...*WebhookDeliveryAttemptCreate) *WebhookDeliveryAttemptCreateBulk {
+	return &WebhookDeliveryAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryAttemptClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryAttemptCreate, int)) *WebhookDeliveryAttemptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryAttemptCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryAttemptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryAttemptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDeliveryAttempt.
+func (c *WebhookDeliveryAttemptClient) Update() *WebhookDeliveryAttemptUpdate {
+	mutation := newWebhookDeliveryAttemptMutation(c.config, OpUpdate)
+	return &WebhookDeliveryAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryAttemptClient) UpdateOne(wda *WebhookDeliveryAttempt) *WebhookDeliveryAttemptUpdateOne {
+	mutation := newWebhookDeliveryAttemptMutation(c.config, OpUpdateOne, withWebhookDeliveryAttempt(wda))
+	return &WebhookDeliveryAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryAttemptClient) UpdateOneID(id string) *WebhookDeliveryAttemptUpdateOne {
+	mutation := newWebhookDeliveryAttemptMutation(c.config, OpUpdateOne, withWebhookDeliveryAttemptID(id))
+	return &WebhookDeliveryAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDeliveryAttempt.
+func (c *WebhookDeliveryAttemptClient) Delete() *WebhookDeliveryAttemptDelete {
+	mutation := newWebhookDeliveryAttemptMutation(c.config, OpDelete)
+	return &WebhookDeliveryAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryAttemptClient) DeleteOne(wda *WebhookDeliveryAttempt) *WebhookDeliveryAttemptDeleteOne {
+	return c.DeleteOneID(wda.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryAttemptClient) DeleteOneID(id string) *WebhookDeliveryAttemptDeleteOne {
+	builder := c.Delete().Where(webhookdeliveryattempt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryAttemptDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDeliveryAttempt.
+func (c *WebhookDeliveryAttemptClient) Query() *WebhookDeliveryAttemptQuery {
+	return &WebhookDeliveryAttemptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDeliveryAttempt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDeliveryAttempt entity by its id.
+func (c *WebhookDeliveryAttemptClient) Get(ctx context.Context, id string) (*WebhookDeliveryAttempt, error) {
+	return c.Query().Where(webhookdeliveryattempt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryAttemptClient) GetX(ctx context.Context, id string) *WebhookDeliveryAttempt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryAttemptClient) Hooks() []Hook {
+	return c.hooks.WebhookDeliveryAttempt
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryAttemptClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDeliveryAttempt
+}
+
+func (c *WebhookDeliveryAttemptClient) mutate(ctx context.Context, m *WebhookDeliveryAttemptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookDeliveryAttempt mutation op: %q", m.Op())
+	}
+}
+
+// WebhookEndpointClient is a client for the WebhookEndpoint schema.
+type WebhookEndpointClient struct {
+	config
+}
+
+// NewWebhookEndpointClient returns a client for the WebhookEndpoint from the given config.
+func NewWebhookEndpointClient(c config) *WebhookEndpointClient {
+	return &WebhookEndpointClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookendpoint.Hooks(f(g(h())))`.
+func (c *WebhookEndpointClient) Use(hooks ...Hook) {
+	c.hooks.WebhookEndpoint = append(c.hooks.WebhookEndpoint, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookendpoint.Intercept(f(g(h())))`.
+func (c *WebhookEndpointClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookEndpoint = append(c.inters.WebhookEndpoint, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookEndpoint entity.
+func (c *WebhookEndpointClient) Create() *WebhookEndpointCreate {
+	mutation := newWebhookEndpointMutation(c.config, OpCreate)
+	return &WebhookEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookEndpoint entities.
+func (c *WebhookEndpointClient) CreateBulk(builders ...*WebhookEndpointCreate) *WebhookEndpointCreateBulk {
+	return &WebhookEndpointCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookEndpointClient) MapCreateBulk(slice any, setFunc func(*WebhookEndpointCreate, int)) *WebhookEndpointCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookEndpointCreateBulk{err: fmt.Errorf("calling to WebhookEndpointClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookEndpointCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookEndpointCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Update() *WebhookEndpointUpdate {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdate)
+	return &WebhookEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookEndpointClient) UpdateOne(we *WebhookEndpoint) *WebhookEndpointUpdateOne {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdateOne, withWebhookEndpoint(we))
+	return &WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookEndpointClient) UpdateOneID(id string) *WebhookEndpointUpdateOne {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdateOne, withWebhookEndpointID(id))
+	return &WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Delete() *WebhookEndpointDelete {
+	mutation := newWebhookEndpointMutation(c.config, OpDelete)
+	return &WebhookEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookEndpointClient) DeleteOne(we *WebhookEndpoint) *WebhookEndpointDeleteOne {
+	return c.DeleteOneID(we.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookEndpointClient) DeleteOneID(id string) *WebhookEndpointDeleteOne {
+	builder := c.Delete().Where(webhookendpoint.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookEndpointDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Query() *WebhookEndpointQuery {
+	return &WebhookEndpointQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookEndpoint},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookEndpoint entity by its id.
+func (c *WebhookEndpointClient) Get(ctx context.Context, id string) (*WebhookEndpoint, error) {
+	return c.Query().Where(webhookendpoint.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookEndpointClient) GetX(ctx context.Context, id string) *WebhookEndpoint {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookEndpointClient) Hooks() []Hook {
+	return c.hooks.WebhookEndpoint
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookEndpointClient) Interceptors() []Interceptor {
+	return c.inters.WebhookEndpoint
+}
+
+func (c *WebhookEndpointClient) mutate(ctx context.Context, m *WebhookEndpointMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookEndpoint mutation op: %q", m.Op())
+	}
+}
+
 // WorkflowExecutionClient is a client for the WorkflowExecution schema.
 type WorkflowExecutionClient struct {
 	config
@@ -7471,26 +9312,32 @@ func (c *WorkflowExecutionClient) mutate(ctx context.Context, m *WorkflowExecuti
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Addon, AddonAssociation, AlertLogs, Auth, BillingSequence, Connection,
-		Costsheet, Coupon, CouponApplication, CouponAssociation, CreditGrant,
-		CreditGrantApplication, CreditNote, CreditNoteLineItem, Customer, Entitlement,
-		EntityIntegrationMapping, Environment, Feature, Group, Invoice,
-		InvoiceLineItem, InvoiceSequence, Meter, Payment, PaymentAttempt, Plan, Price,
-		PriceUnit, ScheduledTask, Secret, Settings, Subscription, SubscriptionLineItem,
-		SubscriptionPause, SubscriptionPhase, SubscriptionSchedule, SystemEvent, Task,
-		TaxApplied, TaxAssociation, TaxRate, Tenant, User, Wallet, WalletTransaction,
-		WorkflowExecution []ent.Hook
+		Addon, AddonAssociation, AlertLogs, AuditLog, Auth, BillingSequence,
+		CommitmentContract, CommitmentDrawdown, Connection, Costsheet, Coupon,
+		CouponApplication, CouponAssociation, CreditGrant, CreditGrantApplication,
+		CreditNote, CreditNoteLineItem, Customer, Entitlement,
+		EntityIntegrationMapping, Environment, FXRate, Feature, Group, Invitation,
+		Invoice, InvoiceLineItem, InvoiceSequence, Meter, Payment, PaymentAttempt,
+		Plan, Price, PriceBook, PriceUnit, PromotionCode, RBACRole, SSOConnection,
+		ScheduledTask, Secret, Settings, Subscription, SubscriptionLineItem,
+		SubscriptionPause, SubscriptionPhase, SubscriptionSchedule,
+		SubscriptionSeatChange, SystemEvent, Task, TaxApplied, TaxAssociation, TaxRate,
+		Tenant, TenantMembership, User, Wallet, WalletTransaction,
+		WebhookDeliveryAttempt, WebhookEndpoint, WorkflowExecution []ent.Hook
 	}
 	inters struct {
-		Addon, AddonAssociation, AlertLogs, Auth, BillingSequence, Connection,
-		Costsheet, Coupon, CouponApplication, CouponAssociation, CreditGrant,
-		CreditGrantApplication, CreditNote, CreditNoteLineItem, Customer, Entitlement,
-		EntityIntegrationMapping, Environment, Feature, Group, Invoice,
-		InvoiceLineItem, InvoiceSequence, Meter, Payment, PaymentAttempt, Plan, Price,
-		PriceUnit, ScheduledTask, Secret, Settings, Subscription, SubscriptionLineItem,
-		SubscriptionPause, SubscriptionPhase, SubscriptionSchedule, SystemEvent, Task,
-		TaxApplied, TaxAssociation, TaxRate, Tenant, User, Wallet, WalletTransaction,
-		WorkflowExecution []ent.Interceptor
+		Addon, AddonAssociation, AlertLogs, AuditLog, Auth, BillingSequence,
+		CommitmentContract, CommitmentDrawdown, Connection, Costsheet, Coupon,
+		CouponApplication, CouponAssociation, CreditGrant, CreditGrantApplication,
+		CreditNote, CreditNoteLineItem, Customer, Entitlement,
+		EntityIntegrationMapping, Environment, FXRate, Feature, Group, Invitation,
+		Invoice, InvoiceLineItem, InvoiceSequence, Meter, Payment, PaymentAttempt,
+		Plan, Price, PriceBook, PriceUnit, PromotionCode, RBACRole, SSOConnection,
+		ScheduledTask, Secret, Settings, Subscription, SubscriptionLineItem,
+		SubscriptionPause, SubscriptionPhase, SubscriptionSchedule,
+		SubscriptionSeatChange, SystemEvent, Task, TaxApplied, TaxAssociation, TaxRate,
+		Tenant, TenantMembership, User, Wallet, WalletTransaction,
+		WebhookDeliveryAttempt, WebhookEndpoint, WorkflowExecution []ent.Interceptor
 	}
 )
 
@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/commitmentcontract"
+	"github.com/shopspring/decimal"
+)
+
+// CommitmentContract is the model entity for the CommitmentContract schema.
+type CommitmentContract struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// CustomerID holds the value of the "customer_id" field.
+	CustomerID string `json:"customer_id,omitempty"`
+	// Subscriptions whose usage draws down the contract
+	SubscriptionIds []string `json:"subscription_ids,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Minimum spend over the contract term
+	CommitmentAmount decimal.Decimal `json:"commitment_amount,omitempty"`
+	// Amount invoiced upfront and drawn down by usage
+	PrepaidAmount decimal.Decimal `json:"prepaid_amount,omitempty"`
+	// Multiplier on usage charged after the prepaid amount is exhausted
+	OverageFactor decimal.Decimal `json:"overage_factor,omitempty"`
+	// DrawnDownAmount holds the value of the "drawn_down_amount" field.
+	DrawnDownAmount decimal.Decimal `json:"drawn_down_amount,omitempty"`
+	// OverageAmount holds the value of the "overage_amount" field.
+	OverageAmount decimal.Decimal `json:"overage_amount,omitempty"`
+	// StartDate holds the value of the "start_date" field.
+	StartDate time.Time `json:"start_date,omitempty"`
+	// EndDate holds the value of the "end_date" field.
+	EndDate time.Time `json:"end_date,omitempty"`
+	// EnableTrueUp holds the value of the "enable_true_up" field.
+	EnableTrueUp bool `json:"enable_true_up,omitempty"`
+	// ContractStatus holds the value of the "contract_status" field.
+	ContractStatus string `json:"contract_status,omitempty"`
+	// PrepaymentInvoiceID holds the value of the "prepayment_invoice_id" field.
+	PrepaymentInvoiceID *string `json:"prepayment_invoice_id,omitempty"`
+	// TrueUpInvoiceID holds the value of the "true_up_invoice_id" field.
+	TrueUpInvoiceID *string `json:"true_up_invoice_id,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata     map[string]string `json:"metadata,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CommitmentContract) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case commitmentcontract.FieldSubscriptionIds, commitmentcontract.FieldMetadata:
+			values[i] = new([]byte)
+		case commitmentcontract.FieldCommitmentAmount, commitmentcontract.FieldPrepaidAmount, commitmentcontract.FieldOverageFactor, commitmentcontract.FieldDrawnDownAmount, commitmentcontract.FieldOverageAmount:
+			values[i] = new(decimal.Decimal)
+		case commitmentcontract.FieldEnableTrueUp:
+			values[i] = new(sql.NullBool)
+		case commitmentcontract.FieldID, commitmentcontract.FieldTenantID, commitmentcontract.FieldStatus, commitmentcontract.FieldCreatedBy, commitmentcontract.FieldUpdatedBy, commitmentcontract.FieldEnvironmentID, commitmentcontract.FieldName, commitmentcontract.FieldCustomerID, commitmentcontract.FieldCurrency, commitmentcontract.FieldContractStatus, commitmentcontract.FieldPrepaymentInvoiceID, commitmentcontract.FieldTrueUpInvoiceID:
+			values[i] = new(sql.NullString)
+		case commitmentcontract.FieldCreatedAt, commitmentcontract.FieldUpdatedAt, commitmentcontract.FieldStartDate, commitmentcontract.FieldEndDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CommitmentContract fields.
+func (cc *CommitmentContract) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case commitmentcontract.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				cc.ID = value.String
+			}
+		case commitmentcontract.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				cc.TenantID = value.String
+			}
+		case commitmentcontract.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				cc.Status = value.String
+			}
+		case commitmentcontract.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cc.CreatedAt = value.Time
+			}
+		case commitmentcontract.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				cc.UpdatedAt = value.Time
+			}
+		case commitmentcontract.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				cc.CreatedBy = value.String
+			}
+		case commitmentcontract.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				cc.UpdatedBy = value.String
+			}
+		case commitmentcontract.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				cc.EnvironmentID = value.String
+			}
+		case commitmentcontract.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				cc.Name = value.String
+			}
+		case commitmentcontract.FieldCustomerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value.Valid {
+				cc.CustomerID = value.String
+			}
+		case commitmentcontract.FieldSubscriptionIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cc.SubscriptionIds); err != nil {
+					return fmt.Errorf("unmarshal field subscription_ids: %w", err)
+				}
+			}
+		case commitmentcontract.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				cc.Currency = value.String
+			}
+		case commitmentcontract.FieldCommitmentAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field commitment_amount", values[i])
+			} else if value != nil {
+				cc.CommitmentAmount = *value
+			}
+		case commitmentcontract.FieldPrepaidAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field prepaid_amount", values[i])
+			} else if value != nil {
+				cc.PrepaidAmount = *value
+			}
+		case commitmentcontract.FieldOverageFactor:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field overage_factor", values[i])
+			} else if value != nil {
+				cc.OverageFactor = *value
+			}
+		case commitmentcontract.FieldDrawnDownAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field drawn_down_amount", values[i])
+			} else if value != nil {
+				cc.DrawnDownAmount = *value
+			}
+		case commitmentcontract.FieldOverageAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field overage_amount", values[i])
+			} else if value != nil {
+				cc.OverageAmount = *value
+			}
+		case commitmentcontract.FieldStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_date", values[i])
+			} else if value.Valid {
+				cc.StartDate = value.Time
+			}
+		case commitmentcontract.FieldEndDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_date", values[i])
+			} else if value.Valid {
+				cc.EndDate = value.Time
+			}
+		case commitmentcontract.FieldEnableTrueUp:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enable_true_up", values[i])
+			} else if value.Valid {
+				cc.EnableTrueUp = value.Bool
+			}
+		case commitmentcontract.FieldContractStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field contract_status", values[i])
+			} else if value.Valid {
+				cc.ContractStatus = value.String
+			}
+		case commitmentcontract.FieldPrepaymentInvoiceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prepayment_invoice_id", values[i])
+			} else if value.Valid {
+				cc.PrepaymentInvoiceID = new(string)
+				*cc.PrepaymentInvoiceID = value.String
+			}
+		case commitmentcontract.FieldTrueUpInvoiceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field true_up_invoice_id", values[i])
+			} else if value.Valid {
+				cc.TrueUpInvoiceID = new(string)
+				*cc.TrueUpInvoiceID = value.String
+			}
+		case commitmentcontract.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cc.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		default:
+			cc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CommitmentContract.
+// This includes values selected through modifiers, order, etc.
+func (cc *CommitmentContract) Value(name string) (ent.Value, error) {
+	return cc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CommitmentContract.
+// Note that you need to call CommitmentContract.Unwrap() before calling this method if this CommitmentContract
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cc *CommitmentContract) Update() *CommitmentContractUpdateOne {
+	return NewCommitmentContractClient(cc.config).UpdateOne(cc)
+}
+
+// Unwrap unwraps the CommitmentContract entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cc *CommitmentContract) Unwrap() *CommitmentContract {
+	_tx, ok := cc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CommitmentContract is not a transactional entity")
+	}
+	cc.config.driver = _tx.drv
+	return cc
+}
+
+// String implements the fmt.Stringer.
+func (cc *CommitmentContract) String() string {
+	var builder strings.Builder
+	builder.WriteString("CommitmentContract(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cc.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(cc.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(cc.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(cc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(cc.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(cc.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(cc.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(cc.Name)
+	builder.WriteString(", ")
+	builder.WriteString("customer_id=")
+	builder.WriteString(cc.CustomerID)
+	builder.WriteString(", ")
+	builder.WriteString("subscription_ids=")
+	builder.WriteString(fmt.Sprintf("%v", cc.SubscriptionIds))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(cc.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("commitment_amount=")
+	builder.WriteString(fmt.Sprintf("%v", cc.CommitmentAmount))
+	builder.WriteString(", ")
+	builder.WriteString("prepaid_amount=")
+	builder.WriteString(fmt.Sprintf("%v", cc.PrepaidAmount))
+	builder.WriteString(", ")
+	builder.WriteString("overage_factor=")
+	builder.WriteString(fmt.Sprintf("%v", cc.OverageFactor))
+	builder.WriteString(", ")
+	builder.WriteString("drawn_down_amount=")
+	builder.WriteString(fmt.Sprintf("%v", cc.DrawnDownAmount))
+	builder.WriteString(", ")
+	builder.WriteString("overage_amount=")
+	builder.WriteString(fmt.Sprintf("%v", cc.OverageAmount))
+	builder.WriteString(", ")
+	builder.WriteString("start_date=")
+	builder.WriteString(cc.StartDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_date=")
+	builder.WriteString(cc.EndDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("enable_true_up=")
+	builder.WriteString(fmt.Sprintf("%v", cc.EnableTrueUp))
+	builder.WriteString(", ")
+	builder.WriteString("contract_status=")
+	builder.WriteString(cc.ContractStatus)
+	builder.WriteString(", ")
+	if v := cc.PrepaymentInvoiceID; v != nil {
+		builder.WriteString("prepayment_invoice_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := cc.TrueUpInvoiceID; v != nil {
+		builder.WriteString("true_up_invoice_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", cc.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CommitmentContracts is a parsable slice of CommitmentContract.
+type CommitmentContracts []*CommitmentContract
@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package commitmentcontract
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the commitmentcontract type in the database.
+	Label = "commitment_contract"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldEnvironmentID holds the string denoting the environment_id field in the database.
+	FieldEnvironmentID = "environment_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldCustomerID holds the string denoting the customer_id field in the database.
+	FieldCustomerID = "customer_id"
+	// FieldSubscriptionIds holds the string denoting the subscription_ids field in the database.
+	FieldSubscriptionIds = "subscription_ids"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldCommitmentAmount holds the string denoting the commitment_amount field in the database.
+	FieldCommitmentAmount = "commitment_amount"
+	// FieldPrepaidAmount holds the string denoting the prepaid_amount field in the database.
+	FieldPrepaidAmount = "prepaid_amount"
+	// FieldOverageFactor holds the string denoting the overage_factor field in the database.
+	FieldOverageFactor = "overage_factor"
+	// FieldDrawnDownAmount holds the string denoting the drawn_down_amount field in the database.
+	FieldDrawnDownAmount = "drawn_down_amount"
+	// FieldOverageAmount holds the string denoting the overage_amount field in the database.
+	FieldOverageAmount = "overage_amount"
+	// FieldStartDate holds the string denoting the start_date field in the database.
+	FieldStartDate = "start_date"
+	// FieldEndDate holds the string denoting the end_date field in the database.
+	FieldEndDate = "end_date"
+	// FieldEnableTrueUp holds the string denoting the enable_true_up field in the database.
+	FieldEnableTrueUp = "enable_true_up"
+	// FieldContractStatus holds the string denoting the contract_status field in the database.
+	FieldContractStatus = "contract_status"
+	// FieldPrepaymentInvoiceID holds the string denoting the prepayment_invoice_id field in the database.
+	FieldPrepaymentInvoiceID = "prepayment_invoice_id"
+	// FieldTrueUpInvoiceID holds the string denoting the true_up_invoice_id field in the database.
+	FieldTrueUpInvoiceID = "true_up_invoice_id"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// Table holds the table name of the commitmentcontract in the database.
+	Table = "commitment_contracts"
+)
+
+// Columns holds all SQL columns for commitmentcontract fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldEnvironmentID,
+	FieldName,
+	FieldCustomerID,
+	FieldSubscriptionIds,
+	FieldCurrency,
+	FieldCommitmentAmount,
+	FieldPrepaidAmount,
+	FieldOverageFactor,
+	FieldDrawnDownAmount,
+	FieldOverageAmount,
+	FieldStartDate,
+	FieldEndDate,
+	FieldEnableTrueUp,
+	FieldContractStatus,
+	FieldPrepaymentInvoiceID,
+	FieldTrueUpInvoiceID,
+	FieldMetadata,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultEnvironmentID holds the default value on creation for the "environment_id" field.
+	DefaultEnvironmentID string
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// CustomerIDValidator is a validator for the "customer_id" field. It is called by the builders before save.
+	CustomerIDValidator func(string) error
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// DefaultOverageFactor holds the default value on creation for the "overage_factor" field.
+	DefaultOverageFactor decimal.Decimal
+	// DefaultDrawnDownAmount holds the default value on creation for the "drawn_down_amount" field.
+	DefaultDrawnDownAmount decimal.Decimal
+	// DefaultOverageAmount holds the default value on creation for the "overage_amount" field.
+	DefaultOverageAmount decimal.Decimal
+	// DefaultEnableTrueUp holds the default value on creation for the "enable_true_up" field.
+	DefaultEnableTrueUp bool
+	// DefaultContractStatus holds the default value on creation for the "contract_status" field.
+	DefaultContractStatus string
+)
+
+// OrderOption defines the ordering options for the CommitmentContract queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByEnvironmentID orders the results by the environment_id field.
+func ByEnvironmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvironmentID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByCustomerID orders the results by the customer_id field.
+func ByCustomerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCustomerID, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByCommitmentAmount orders the results by the commitment_amount field.
+func ByCommitmentAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommitmentAmount, opts...).ToFunc()
+}
+
+// ByPrepaidAmount orders the results by the prepaid_amount field.
+func ByPrepaidAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrepaidAmount, opts...).ToFunc()
+}
+
+// ByOverageFactor orders the results by the overage_factor field.
+func ByOverageFactor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverageFactor, opts...).ToFunc()
+}
+
+// ByDrawnDownAmount orders the results by the drawn_down_amount field.
+func ByDrawnDownAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDrawnDownAmount, opts...).ToFunc()
+}
+
+// ByOverageAmount orders the results by the overage_amount field.
+func ByOverageAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverageAmount, opts...).ToFunc()
+}
+
+// ByStartDate orders the results by the start_date field.
+func ByStartDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartDate, opts...).ToFunc()
+}
+
+// ByEndDate orders the results by the end_date field.
+func ByEndDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndDate, opts...).ToFunc()
+}
+
+// ByEnableTrueUp orders the results by the enable_true_up field.
+func ByEnableTrueUp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnableTrueUp, opts...).ToFunc()
+}
+
+// ByContractStatus orders the results by the contract_status field.
+func ByContractStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContractStatus, opts...).ToFunc()
+}
+
+// ByPrepaymentInvoiceID orders the results by the prepayment_invoice_id field.
+func ByPrepaymentInvoiceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrepaymentInvoiceID, opts...).ToFunc()
+}
+
+// ByTrueUpInvoiceID orders the results by the true_up_invoice_id field.
+func ByTrueUpInvoiceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrueUpInvoiceID, opts...).ToFunc()
+}
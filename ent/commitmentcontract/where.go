@@ -0,0 +1,1341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package commitmentcontract
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldName, v))
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CommitmentAmount applies equality check predicate on the "commitment_amount" field. It's identical to CommitmentAmountEQ.
+func CommitmentAmount(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldCommitmentAmount, v))
+}
+
+// PrepaidAmount applies equality check predicate on the "prepaid_amount" field. It's identical to PrepaidAmountEQ.
+func PrepaidAmount(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldPrepaidAmount, v))
+}
+
+// OverageFactor applies equality check predicate on the "overage_factor" field. It's identical to OverageFactorEQ.
+func OverageFactor(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldOverageFactor, v))
+}
+
+// DrawnDownAmount applies equality check predicate on the "drawn_down_amount" field. It's identical to DrawnDownAmountEQ.
+func DrawnDownAmount(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldDrawnDownAmount, v))
+}
+
+// OverageAmount applies equality check predicate on the "overage_amount" field. It's identical to OverageAmountEQ.
+func OverageAmount(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldOverageAmount, v))
+}
+
+// StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
+func StartDate(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldStartDate, v))
+}
+
+// EndDate applies equality check predicate on the "end_date" field. It's identical to EndDateEQ.
+func EndDate(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldEndDate, v))
+}
+
+// EnableTrueUp applies equality check predicate on the "enable_true_up" field. It's identical to EnableTrueUpEQ.
+func EnableTrueUp(v bool) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldEnableTrueUp, v))
+}
+
+// ContractStatus applies equality check predicate on the "contract_status" field. It's identical to ContractStatusEQ.
+func ContractStatus(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldContractStatus, v))
+}
+
+// PrepaymentInvoiceID applies equality check predicate on the "prepayment_invoice_id" field. It's identical to PrepaymentInvoiceIDEQ.
+func PrepaymentInvoiceID(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldPrepaymentInvoiceID, v))
+}
+
+// TrueUpInvoiceID applies equality check predicate on the "true_up_invoice_id" field. It's identical to TrueUpInvoiceIDEQ.
+func TrueUpInvoiceID(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldTrueUpInvoiceID, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContains(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasPrefix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasSuffix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIsNil applies the IsNil predicate on the "environment_id" field.
+func EnvironmentIDIsNil() predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIsNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDNotNil applies the NotNil predicate on the "environment_id" field.
+func EnvironmentIDNotNil() predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEqualFold(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContainsFold(FieldEnvironmentID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContainsFold(FieldName, v))
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldCustomerID, v))
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDGT applies the GT predicate on the "customer_id" field.
+func CustomerIDGT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldCustomerID, v))
+}
+
+// CustomerIDGTE applies the GTE predicate on the "customer_id" field.
+func CustomerIDGTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldCustomerID, v))
+}
+
+// CustomerIDLT applies the LT predicate on the "customer_id" field.
+func CustomerIDLT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldCustomerID, v))
+}
+
+// CustomerIDLTE applies the LTE predicate on the "customer_id" field.
+func CustomerIDLTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldCustomerID, v))
+}
+
+// CustomerIDContains applies the Contains predicate on the "customer_id" field.
+func CustomerIDContains(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContains(FieldCustomerID, v))
+}
+
+// CustomerIDHasPrefix applies the HasPrefix predicate on the "customer_id" field.
+func CustomerIDHasPrefix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasPrefix(FieldCustomerID, v))
+}
+
+// CustomerIDHasSuffix applies the HasSuffix predicate on the "customer_id" field.
+func CustomerIDHasSuffix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasSuffix(FieldCustomerID, v))
+}
+
+// CustomerIDEqualFold applies the EqualFold predicate on the "customer_id" field.
+func CustomerIDEqualFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEqualFold(FieldCustomerID, v))
+}
+
+// CustomerIDContainsFold applies the ContainsFold predicate on the "customer_id" field.
+func CustomerIDContainsFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContainsFold(FieldCustomerID, v))
+}
+
+// SubscriptionIdsIsNil applies the IsNil predicate on the "subscription_ids" field.
+func SubscriptionIdsIsNil() predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIsNull(FieldSubscriptionIds))
+}
+
+// SubscriptionIdsNotNil applies the NotNil predicate on the "subscription_ids" field.
+func SubscriptionIdsNotNil() predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotNull(FieldSubscriptionIds))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// CommitmentAmountEQ applies the EQ predicate on the "commitment_amount" field.
+func CommitmentAmountEQ(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountNEQ applies the NEQ predicate on the "commitment_amount" field.
+func CommitmentAmountNEQ(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountIn applies the In predicate on the "commitment_amount" field.
+func CommitmentAmountIn(vs ...decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldCommitmentAmount, vs...))
+}
+
+// CommitmentAmountNotIn applies the NotIn predicate on the "commitment_amount" field.
+func CommitmentAmountNotIn(vs ...decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldCommitmentAmount, vs...))
+}
+
+// CommitmentAmountGT applies the GT predicate on the "commitment_amount" field.
+func CommitmentAmountGT(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountGTE applies the GTE predicate on the "commitment_amount" field.
+func CommitmentAmountGTE(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountLT applies the LT predicate on the "commitment_amount" field.
+func CommitmentAmountLT(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountLTE applies the LTE predicate on the "commitment_amount" field.
+func CommitmentAmountLTE(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldCommitmentAmount, v))
+}
+
+// PrepaidAmountEQ applies the EQ predicate on the "prepaid_amount" field.
+func PrepaidAmountEQ(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldPrepaidAmount, v))
+}
+
+// PrepaidAmountNEQ applies the NEQ predicate on the "prepaid_amount" field.
+func PrepaidAmountNEQ(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldPrepaidAmount, v))
+}
+
+// PrepaidAmountIn applies the In predicate on the "prepaid_amount" field.
+func PrepaidAmountIn(vs ...decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldPrepaidAmount, vs...))
+}
+
+// PrepaidAmountNotIn applies the NotIn predicate on the "prepaid_amount" field.
+func PrepaidAmountNotIn(vs ...decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldPrepaidAmount, vs...))
+}
+
+// PrepaidAmountGT applies the GT predicate on the "prepaid_amount" field.
+func PrepaidAmountGT(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldPrepaidAmount, v))
+}
+
+// PrepaidAmountGTE applies the GTE predicate on the "prepaid_amount" field.
+func PrepaidAmountGTE(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldPrepaidAmount, v))
+}
+
+// PrepaidAmountLT applies the LT predicate on the "prepaid_amount" field.
+func PrepaidAmountLT(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldPrepaidAmount, v))
+}
+
+// PrepaidAmountLTE applies the LTE predicate on the "prepaid_amount" field.
+func PrepaidAmountLTE(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldPrepaidAmount, v))
+}
+
+// OverageFactorEQ applies the EQ predicate on the "overage_factor" field.
+func OverageFactorEQ(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldOverageFactor, v))
+}
+
+// OverageFactorNEQ applies the NEQ predicate on the "overage_factor" field.
+func OverageFactorNEQ(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldOverageFactor, v))
+}
+
+// OverageFactorIn applies the In predicate on the "overage_factor" field.
+func OverageFactorIn(vs ...decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldOverageFactor, vs...))
+}
+
+// OverageFactorNotIn applies the NotIn predicate on the "overage_factor" field.
+func OverageFactorNotIn(vs ...decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldOverageFactor, vs...))
+}
+
+// OverageFactorGT applies the GT predicate on the "overage_factor" field.
+func OverageFactorGT(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldOverageFactor, v))
+}
+
+// OverageFactorGTE applies the GTE predicate on the "overage_factor" field.
+func OverageFactorGTE(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldOverageFactor, v))
+}
+
+// OverageFactorLT applies the LT predicate on the "overage_factor" field.
+func OverageFactorLT(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldOverageFactor, v))
+}
+
+// OverageFactorLTE applies the LTE predicate on the "overage_factor" field.
+func OverageFactorLTE(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldOverageFactor, v))
+}
+
+// DrawnDownAmountEQ applies the EQ predicate on the "drawn_down_amount" field.
+func DrawnDownAmountEQ(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldDrawnDownAmount, v))
+}
+
+// DrawnDownAmountNEQ applies the NEQ predicate on the "drawn_down_amount" field.
+func DrawnDownAmountNEQ(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldDrawnDownAmount, v))
+}
+
+// DrawnDownAmountIn applies the In predicate on the "drawn_down_amount" field.
+func DrawnDownAmountIn(vs ...decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldDrawnDownAmount, vs...))
+}
+
+// DrawnDownAmountNotIn applies the NotIn predicate on the "drawn_down_amount" field.
+func DrawnDownAmountNotIn(vs ...decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldDrawnDownAmount, vs...))
+}
+
+// DrawnDownAmountGT applies the GT predicate on the "drawn_down_amount" field.
+func DrawnDownAmountGT(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldDrawnDownAmount, v))
+}
+
+// DrawnDownAmountGTE applies the GTE predicate on the "drawn_down_amount" field.
+func DrawnDownAmountGTE(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldDrawnDownAmount, v))
+}
+
+// DrawnDownAmountLT applies the LT predicate on the "drawn_down_amount" field.
+func DrawnDownAmountLT(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldDrawnDownAmount, v))
+}
+
+// DrawnDownAmountLTE applies the LTE predicate on the "drawn_down_amount" field.
+func DrawnDownAmountLTE(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldDrawnDownAmount, v))
+}
+
+// OverageAmountEQ applies the EQ predicate on the "overage_amount" field.
+func OverageAmountEQ(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldOverageAmount, v))
+}
+
+// OverageAmountNEQ applies the NEQ predicate on the "overage_amount" field.
+func OverageAmountNEQ(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldOverageAmount, v))
+}
+
+// OverageAmountIn applies the In predicate on the "overage_amount" field.
+func OverageAmountIn(vs ...decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldOverageAmount, vs...))
+}
+
+// OverageAmountNotIn applies the NotIn predicate on the "overage_amount" field.
+func OverageAmountNotIn(vs ...decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldOverageAmount, vs...))
+}
+
+// OverageAmountGT applies the GT predicate on the "overage_amount" field.
+func OverageAmountGT(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldOverageAmount, v))
+}
+
+// OverageAmountGTE applies the GTE predicate on the "overage_amount" field.
+func OverageAmountGTE(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldOverageAmount, v))
+}
+
+// OverageAmountLT applies the LT predicate on the "overage_amount" field.
+func OverageAmountLT(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldOverageAmount, v))
+}
+
+// OverageAmountLTE applies the LTE predicate on the "overage_amount" field.
+func OverageAmountLTE(v decimal.Decimal) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldOverageAmount, v))
+}
+
+// StartDateEQ applies the EQ predicate on the "start_date" field.
+func StartDateEQ(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldStartDate, v))
+}
+
+// StartDateNEQ applies the NEQ predicate on the "start_date" field.
+func StartDateNEQ(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldStartDate, v))
+}
+
+// StartDateIn applies the In predicate on the "start_date" field.
+func StartDateIn(vs ...time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldStartDate, vs...))
+}
+
+// StartDateNotIn applies the NotIn predicate on the "start_date" field.
+func StartDateNotIn(vs ...time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldStartDate, vs...))
+}
+
+// StartDateGT applies the GT predicate on the "start_date" field.
+func StartDateGT(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldStartDate, v))
+}
+
+// StartDateGTE applies the GTE predicate on the "start_date" field.
+func StartDateGTE(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldStartDate, v))
+}
+
+// StartDateLT applies the LT predicate on the "start_date" field.
+func StartDateLT(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldStartDate, v))
+}
+
+// StartDateLTE applies the LTE predicate on the "start_date" field.
+func StartDateLTE(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldStartDate, v))
+}
+
+// EndDateEQ applies the EQ predicate on the "end_date" field.
+func EndDateEQ(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldEndDate, v))
+}
+
+// EndDateNEQ applies the NEQ predicate on the "end_date" field.
+func EndDateNEQ(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldEndDate, v))
+}
+
+// EndDateIn applies the In predicate on the "end_date" field.
+func EndDateIn(vs ...time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldEndDate, vs...))
+}
+
+// EndDateNotIn applies the NotIn predicate on the "end_date" field.
+func EndDateNotIn(vs ...time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldEndDate, vs...))
+}
+
+// EndDateGT applies the GT predicate on the "end_date" field.
+func EndDateGT(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldEndDate, v))
+}
+
+// EndDateGTE applies the GTE predicate on the "end_date" field.
+func EndDateGTE(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldEndDate, v))
+}
+
+// EndDateLT applies the LT predicate on the "end_date" field.
+func EndDateLT(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldEndDate, v))
+}
+
+// EndDateLTE applies the LTE predicate on the "end_date" field.
+func EndDateLTE(v time.Time) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldEndDate, v))
+}
+
+// EnableTrueUpEQ applies the EQ predicate on the "enable_true_up" field.
+func EnableTrueUpEQ(v bool) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldEnableTrueUp, v))
+}
+
+// EnableTrueUpNEQ applies the NEQ predicate on the "enable_true_up" field.
+func EnableTrueUpNEQ(v bool) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldEnableTrueUp, v))
+}
+
+// ContractStatusEQ applies the EQ predicate on the "contract_status" field.
+func ContractStatusEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldContractStatus, v))
+}
+
+// ContractStatusNEQ applies the NEQ predicate on the "contract_status" field.
+func ContractStatusNEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldContractStatus, v))
+}
+
+// ContractStatusIn applies the In predicate on the "contract_status" field.
+func ContractStatusIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldContractStatus, vs...))
+}
+
+// ContractStatusNotIn applies the NotIn predicate on the "contract_status" field.
+func ContractStatusNotIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldContractStatus, vs...))
+}
+
+// ContractStatusGT applies the GT predicate on the "contract_status" field.
+func ContractStatusGT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldContractStatus, v))
+}
+
+// ContractStatusGTE applies the GTE predicate on the "contract_status" field.
+func ContractStatusGTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldContractStatus, v))
+}
+
+// ContractStatusLT applies the LT predicate on the "contract_status" field.
+func ContractStatusLT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldContractStatus, v))
+}
+
+// ContractStatusLTE applies the LTE predicate on the "contract_status" field.
+func ContractStatusLTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldContractStatus, v))
+}
+
+// ContractStatusContains applies the Contains predicate on the "contract_status" field.
+func ContractStatusContains(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContains(FieldContractStatus, v))
+}
+
+// ContractStatusHasPrefix applies the HasPrefix predicate on the "contract_status" field.
+func ContractStatusHasPrefix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasPrefix(FieldContractStatus, v))
+}
+
+// ContractStatusHasSuffix applies the HasSuffix predicate on the "contract_status" field.
+func ContractStatusHasSuffix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasSuffix(FieldContractStatus, v))
+}
+
+// ContractStatusEqualFold applies the EqualFold predicate on the "contract_status" field.
+func ContractStatusEqualFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEqualFold(FieldContractStatus, v))
+}
+
+// ContractStatusContainsFold applies the ContainsFold predicate on the "contract_status" field.
+func ContractStatusContainsFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContainsFold(FieldContractStatus, v))
+}
+
+// PrepaymentInvoiceIDEQ applies the EQ predicate on the "prepayment_invoice_id" field.
+func PrepaymentInvoiceIDEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldPrepaymentInvoiceID, v))
+}
+
+// PrepaymentInvoiceIDNEQ applies the NEQ predicate on the "prepayment_invoice_id" field.
+func PrepaymentInvoiceIDNEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldPrepaymentInvoiceID, v))
+}
+
+// PrepaymentInvoiceIDIn applies the In predicate on the "prepayment_invoice_id" field.
+func PrepaymentInvoiceIDIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldPrepaymentInvoiceID, vs...))
+}
+
+// PrepaymentInvoiceIDNotIn applies the NotIn predicate on the "prepayment_invoice_id" field.
+func PrepaymentInvoiceIDNotIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldPrepaymentInvoiceID, vs...))
+}
+
+// PrepaymentInvoiceIDGT applies the GT predicate on the "prepayment_invoice_id" field.
+func PrepaymentInvoiceIDGT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldPrepaymentInvoiceID, v))
+}
+
+// PrepaymentInvoiceIDGTE applies the GTE predicate on the "prepayment_invoice_id" field.
+func PrepaymentInvoiceIDGTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldPrepaymentInvoiceID, v))
+}
+
+// PrepaymentInvoiceIDLT applies the LT predicate on the "prepayment_invoice_id" field.
+func PrepaymentInvoiceIDLT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldPrepaymentInvoiceID, v))
+}
+
+// PrepaymentInvoiceIDLTE applies the LTE predicate on the "prepayment_invoice_id" field.
+func PrepaymentInvoiceIDLTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldPrepaymentInvoiceID, v))
+}
+
+// PrepaymentInvoiceIDContains applies the Contains predicate on the "prepayment_invoice_id" field.
+func PrepaymentInvoiceIDContains(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContains(FieldPrepaymentInvoiceID, v))
+}
+
+// PrepaymentInvoiceIDHasPrefix applies the HasPrefix predicate on the "prepayment_invoice_id" field.
+func PrepaymentInvoiceIDHasPrefix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasPrefix(FieldPrepaymentInvoiceID, v))
+}
+
+// PrepaymentInvoiceIDHasSuffix applies the HasSuffix predicate on the "prepayment_invoice_id" field.
+func PrepaymentInvoiceIDHasSuffix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasSuffix(FieldPrepaymentInvoiceID, v))
+}
+
+// PrepaymentInvoiceIDIsNil applies the IsNil predicate on the "prepayment_invoice_id" field.
+func PrepaymentInvoiceIDIsNil() predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIsNull(FieldPrepaymentInvoiceID))
+}
+
+// PrepaymentInvoiceIDNotNil applies the NotNil predicate on the "prepayment_invoice_id" field.
+func PrepaymentInvoiceIDNotNil() predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotNull(FieldPrepaymentInvoiceID))
+}
+
+// PrepaymentInvoiceIDEqualFold applies the EqualFold predicate on the "prepayment_invoice_id" field.
+func PrepaymentInvoiceIDEqualFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEqualFold(FieldPrepaymentInvoiceID, v))
+}
+
+// PrepaymentInvoiceIDContainsFold applies the ContainsFold predicate on the "prepayment_invoice_id" field.
+func PrepaymentInvoiceIDContainsFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContainsFold(FieldPrepaymentInvoiceID, v))
+}
+
+// TrueUpInvoiceIDEQ applies the EQ predicate on the "true_up_invoice_id" field.
+func TrueUpInvoiceIDEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEQ(FieldTrueUpInvoiceID, v))
+}
+
+// TrueUpInvoiceIDNEQ applies the NEQ predicate on the "true_up_invoice_id" field.
+func TrueUpInvoiceIDNEQ(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNEQ(FieldTrueUpInvoiceID, v))
+}
+
+// TrueUpInvoiceIDIn applies the In predicate on the "true_up_invoice_id" field.
+func TrueUpInvoiceIDIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIn(FieldTrueUpInvoiceID, vs...))
+}
+
+// TrueUpInvoiceIDNotIn applies the NotIn predicate on the "true_up_invoice_id" field.
+func TrueUpInvoiceIDNotIn(vs ...string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotIn(FieldTrueUpInvoiceID, vs...))
+}
+
+// TrueUpInvoiceIDGT applies the GT predicate on the "true_up_invoice_id" field.
+func TrueUpInvoiceIDGT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGT(FieldTrueUpInvoiceID, v))
+}
+
+// TrueUpInvoiceIDGTE applies the GTE predicate on the "true_up_invoice_id" field.
+func TrueUpInvoiceIDGTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldGTE(FieldTrueUpInvoiceID, v))
+}
+
+// TrueUpInvoiceIDLT applies the LT predicate on the "true_up_invoice_id" field.
+func TrueUpInvoiceIDLT(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLT(FieldTrueUpInvoiceID, v))
+}
+
+// TrueUpInvoiceIDLTE applies the LTE predicate on the "true_up_invoice_id" field.
+func TrueUpInvoiceIDLTE(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldLTE(FieldTrueUpInvoiceID, v))
+}
+
+// TrueUpInvoiceIDContains applies the Contains predicate on the "true_up_invoice_id" field.
+func TrueUpInvoiceIDContains(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContains(FieldTrueUpInvoiceID, v))
+}
+
+// TrueUpInvoiceIDHasPrefix applies the HasPrefix predicate on the "true_up_invoice_id" field.
+func TrueUpInvoiceIDHasPrefix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasPrefix(FieldTrueUpInvoiceID, v))
+}
+
+// TrueUpInvoiceIDHasSuffix applies the HasSuffix predicate on the "true_up_invoice_id" field.
+func TrueUpInvoiceIDHasSuffix(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldHasSuffix(FieldTrueUpInvoiceID, v))
+}
+
+// TrueUpInvoiceIDIsNil applies the IsNil predicate on the "true_up_invoice_id" field.
+func TrueUpInvoiceIDIsNil() predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIsNull(FieldTrueUpInvoiceID))
+}
+
+// TrueUpInvoiceIDNotNil applies the NotNil predicate on the "true_up_invoice_id" field.
+func TrueUpInvoiceIDNotNil() predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotNull(FieldTrueUpInvoiceID))
+}
+
+// TrueUpInvoiceIDEqualFold applies the EqualFold predicate on the "true_up_invoice_id" field.
+func TrueUpInvoiceIDEqualFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldEqualFold(FieldTrueUpInvoiceID, v))
+}
+
+// TrueUpInvoiceIDContainsFold applies the ContainsFold predicate on the "true_up_invoice_id" field.
+func TrueUpInvoiceIDContainsFold(v string) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldContainsFold(FieldTrueUpInvoiceID, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.FieldNotNull(FieldMetadata))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CommitmentContract) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CommitmentContract) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CommitmentContract) predicate.CommitmentContract {
+	return predicate.CommitmentContract(sql.NotPredicates(p))
+}
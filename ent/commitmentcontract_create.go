@@ -0,0 +1,627 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/commitmentcontract"
+	"github.com/shopspring/decimal"
+)
+
+// CommitmentContractCreate is the builder for creating a CommitmentContract entity.
+type CommitmentContractCreate struct {
+	config
+	mutation *CommitmentContractMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ccc *CommitmentContractCreate) SetTenantID(s string) *CommitmentContractCreate {
+	ccc.mutation.SetTenantID(s)
+	return ccc
+}
+
+// SetStatus sets the "status" field.
+func (ccc *CommitmentContractCreate) SetStatus(s string) *CommitmentContractCreate {
+	ccc.mutation.SetStatus(s)
+	return ccc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ccc *CommitmentContractCreate) SetNillableStatus(s *string) *CommitmentContractCreate {
+	if s != nil {
+		ccc.SetStatus(*s)
+	}
+	return ccc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ccc *CommitmentContractCreate) SetCreatedAt(t time.Time) *CommitmentContractCreate {
+	ccc.mutation.SetCreatedAt(t)
+	return ccc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ccc *CommitmentContractCreate) SetNillableCreatedAt(t *time.Time) *CommitmentContractCreate {
+	if t != nil {
+		ccc.SetCreatedAt(*t)
+	}
+	return ccc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ccc *CommitmentContractCreate) SetUpdatedAt(t time.Time) *CommitmentContractCreate {
+	ccc.mutation.SetUpdatedAt(t)
+	return ccc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ccc *CommitmentContractCreate) SetNillableUpdatedAt(t *time.Time) *CommitmentContractCreate {
+	if t != nil {
+		ccc.SetUpdatedAt(*t)
+	}
+	return ccc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ccc *CommitmentContractCreate) SetCreatedBy(s string) *CommitmentContractCreate {
+	ccc.mutation.SetCreatedBy(s)
+	return ccc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ccc *CommitmentContractCreate) SetNillableCreatedBy(s *string) *CommitmentContractCreate {
+	if s != nil {
+		ccc.SetCreatedBy(*s)
+	}
+	return ccc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ccc *CommitmentContractCreate) SetUpdatedBy(s string) *CommitmentContractCreate {
+	ccc.mutation.SetUpdatedBy(s)
+	return ccc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ccc *CommitmentContractCreate) SetNillableUpdatedBy(s *string) *CommitmentContractCreate {
+	if s != nil {
+		ccc.SetUpdatedBy(*s)
+	}
+	return ccc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (ccc *CommitmentContractCreate) SetEnvironmentID(s string) *CommitmentContractCreate {
+	ccc.mutation.SetEnvironmentID(s)
+	return ccc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (ccc *CommitmentContractCreate) SetNillableEnvironmentID(s *string) *CommitmentContractCreate {
+	if s != nil {
+		ccc.SetEnvironmentID(*s)
+	}
+	return ccc
+}
+
+// SetName sets the "name" field.
+func (ccc *CommitmentContractCreate) SetName(s string) *CommitmentContractCreate {
+	ccc.mutation.SetName(s)
+	return ccc
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (ccc *CommitmentContractCreate) SetCustomerID(s string) *CommitmentContractCreate {
+	ccc.mutation.SetCustomerID(s)
+	return ccc
+}
+
+// SetSubscriptionIds sets the "subscription_ids" field.
+func (ccc *CommitmentContractCreate) SetSubscriptionIds(s []string) *CommitmentContractCreate {
+	ccc.mutation.SetSubscriptionIds(s)
+	return ccc
+}
+
+// SetCurrency sets the "currency" field.
+func (ccc *CommitmentContractCreate) SetCurrency(s string) *CommitmentContractCreate {
+	ccc.mutation.SetCurrency(s)
+	return ccc
+}
+
+// SetCommitmentAmount sets the "commitment_amount" field.
+func (ccc *CommitmentContractCreate) SetCommitmentAmount(d decimal.Decimal) *CommitmentContractCreate {
+	ccc.mutation.SetCommitmentAmount(d)
+	return ccc
+}
+
+// SetPrepaidAmount sets the "prepaid_amount" field.
+func (ccc *CommitmentContractCreate) SetPrepaidAmount(d decimal.Decimal) *CommitmentContractCreate {
+	ccc.mutation.SetPrepaidAmount(d)
+	return ccc
+}
+
+// SetOverageFactor sets the "overage_factor" field.
+func (ccc *CommitmentContractCreate) SetOverageFactor(d decimal.Decimal) *CommitmentContractCreate {
+	ccc.mutation.SetOverageFactor(d)
+	return ccc
+}
+
+// SetNillableOverageFactor sets the "overage_factor" field if the given value is not nil.
+func (ccc *CommitmentContractCreate) SetNillableOverageFactor(d *decimal.Decimal) *CommitmentContractCreate {
+	if d != nil {
+		ccc.SetOverageFactor(*d)
+	}
+	return ccc
+}
+
+// SetDrawnDownAmount sets the "drawn_down_amount" field.
+func (ccc *CommitmentContractCreate) SetDrawnDownAmount(d decimal.Decimal) *CommitmentContractCreate {
+	ccc.mutation.SetDrawnDownAmount(d)
+	return ccc
+}
+
+// SetNillableDrawnDownAmount sets the "drawn_down_amount" field if the given value is not nil.
+func (ccc *CommitmentContractCreate) SetNillableDrawnDownAmount(d *decimal.Decimal) *CommitmentContractCreate {
+	if d != nil {
+		ccc.SetDrawnDownAmount(*d)
+	}
+	return ccc
+}
+
+// SetOverageAmount sets the "overage_amount" field.
+func (ccc *CommitmentContractCreate) SetOverageAmount(d decimal.Decimal) *CommitmentContractCreate {
+	ccc.mutation.SetOverageAmount(d)
+	return ccc
+}
+
+// SetNillableOverageAmount sets the "overage_amount" field if the given value is not nil.
+func (ccc *CommitmentContractCreate) SetNillableOverageAmount(d *decimal.Decimal) *CommitmentContractCreate {
+	if d != nil {
+		ccc.SetOverageAmount(*d)
+	}
+	return ccc
+}
+
+// SetStartDate sets the "start_date" field.
+func (ccc *CommitmentContractCreate) SetStartDate(t time.Time) *CommitmentContractCreate {
+	ccc.mutation.SetStartDate(t)
+	return ccc
+}
+
+// SetEndDate sets the "end_date" field.
+func (ccc *CommitmentContractCreate) SetEndDate(t time.Time) *CommitmentContractCreate {
+	ccc.mutation.SetEndDate(t)
+	return ccc
+}
+
+// SetEnableTrueUp sets the "enable_true_up" field.
+func (ccc *CommitmentContractCreate) SetEnableTrueUp(b bool) *CommitmentContractCreate {
+	ccc.mutation.SetEnableTrueUp(b)
+	return ccc
+}
+
+// SetNillableEnableTrueUp sets the "enable_true_up" field if the given value is not nil.
+func (ccc *CommitmentContractCreate) SetNillableEnableTrueUp(b *bool) *CommitmentContractCreate {
+	if b != nil {
+		ccc.SetEnableTrueUp(*b)
+	}
+	return ccc
+}
+
+// SetContractStatus sets the "contract_status" field.
+func (ccc *CommitmentContractCreate) SetContractStatus(s string) *CommitmentContractCreate {
+	ccc.mutation.SetContractStatus(s)
+	return ccc
+}
+
+// SetNillableContractStatus sets the "contract_status" field if the given value is not nil.
+func (ccc *CommitmentContractCreate) SetNillableContractStatus(s *string) *CommitmentContractCreate {
+	if s != nil {
+		ccc.SetContractStatus(*s)
+	}
+	return ccc
+}
+
+// SetPrepaymentInvoiceID sets the "prepayment_invoice_id" field.
+func (ccc *CommitmentContractCreate) SetPrepaymentInvoiceID(s string) *CommitmentContractCreate {
+	ccc.mutation.SetPrepaymentInvoiceID(s)
+	return ccc
+}
+
+// SetNillablePrepaymentInvoiceID sets the "prepayment_invoice_id" field if the given value is not nil.
+func (ccc *CommitmentContractCreate) SetNillablePrepaymentInvoiceID(s *string) *CommitmentContractCreate {
+	if s != nil {
+		ccc.SetPrepaymentInvoiceID(*s)
+	}
+	return ccc
+}
+
+// SetTrueUpInvoiceID sets the "true_up_invoice_id" field.
+func (ccc *CommitmentContractCreate) SetTrueUpInvoiceID(s string) *CommitmentContractCreate {
+	ccc.mutation.SetTrueUpInvoiceID(s)
+	return ccc
+}
+
+// SetNillableTrueUpInvoiceID sets the "true_up_invoice_id" field if the given value is not nil.
+func (ccc *CommitmentContractCreate) SetNillableTrueUpInvoiceID(s *string) *CommitmentContractCreate {
+	if s != nil {
+		ccc.SetTrueUpInvoiceID(*s)
+	}
+	return ccc
+}
+
+// SetMetadata sets the "metadata" field.
+func (ccc *CommitmentContractCreate) SetMetadata(m map[string]string) *CommitmentContractCreate {
+	ccc.mutation.SetMetadata(m)
+	return ccc
+}
+
+// SetID sets the "id" field.
+func (ccc *CommitmentContractCreate) SetID(s string) *CommitmentContractCreate {
+	ccc.mutation.SetID(s)
+	return ccc
+}
+
+// Mutation returns the CommitmentContractMutation object of the builder.
+func (ccc *CommitmentContractCreate) Mutation() *CommitmentContractMutation {
+	return ccc.mutation
+}
+
+// Save creates the CommitmentContract in the database.
+func (ccc *CommitmentContractCreate) Save(ctx context.Context) (*CommitmentContract, error) {
+	ccc.defaults()
+	return withHooks(ctx, ccc.sqlSave, ccc.mutation, ccc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ccc *CommitmentContractCreate) SaveX(ctx context.Context) *CommitmentContract {
+	v, err := ccc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccc *CommitmentContractCreate) Exec(ctx context.Context) error {
+	_, err := ccc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccc *CommitmentContractCreate) ExecX(ctx context.Context) {
+	if err := ccc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ccc *CommitmentContractCreate) defaults() {
+	if _, ok := ccc.mutation.Status(); !ok {
+		v := commitmentcontract.DefaultStatus
+		ccc.mutation.SetStatus(v)
+	}
+	if _, ok := ccc.mutation.CreatedAt(); !ok {
+		v := commitmentcontract.DefaultCreatedAt()
+		ccc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ccc.mutation.UpdatedAt(); !ok {
+		v := commitmentcontract.DefaultUpdatedAt()
+		ccc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ccc.mutation.EnvironmentID(); !ok {
+		v := commitmentcontract.DefaultEnvironmentID
+		ccc.mutation.SetEnvironmentID(v)
+	}
+	if _, ok := ccc.mutation.OverageFactor(); !ok {
+		v := commitmentcontract.DefaultOverageFactor
+		ccc.mutation.SetOverageFactor(v)
+	}
+	if _, ok := ccc.mutation.DrawnDownAmount(); !ok {
+		v := commitmentcontract.DefaultDrawnDownAmount
+		ccc.mutation.SetDrawnDownAmount(v)
+	}
+	if _, ok := ccc.mutation.OverageAmount(); !ok {
+		v := commitmentcontract.DefaultOverageAmount
+		ccc.mutation.SetOverageAmount(v)
+	}
+	if _, ok := ccc.mutation.EnableTrueUp(); !ok {
+		v := commitmentcontract.DefaultEnableTrueUp
+		ccc.mutation.SetEnableTrueUp(v)
+	}
+	if _, ok := ccc.mutation.ContractStatus(); !ok {
+		v := commitmentcontract.DefaultContractStatus
+		ccc.mutation.SetContractStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccc *CommitmentContractCreate) check() error {
+	if _, ok := ccc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "CommitmentContract.tenant_id"`)}
+	}
+	if v, ok := ccc.mutation.TenantID(); ok {
+		if err := commitmentcontract.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "CommitmentContract.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := ccc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CommitmentContract.status"`)}
+	}
+	if _, ok := ccc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CommitmentContract.created_at"`)}
+	}
+	if _, ok := ccc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CommitmentContract.updated_at"`)}
+	}
+	if _, ok := ccc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "CommitmentContract.name"`)}
+	}
+	if v, ok := ccc.mutation.Name(); ok {
+		if err := commitmentcontract.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CommitmentContract.name": %w`, err)}
+		}
+	}
+	if _, ok := ccc.mutation.CustomerID(); !ok {
+		return &ValidationError{Name: "customer_id", err: errors.New(`ent: missing required field "CommitmentContract.customer_id"`)}
+	}
+	if v, ok := ccc.mutation.CustomerID(); ok {
+		if err := commitmentcontract.CustomerIDValidator(v); err != nil {
+			return &ValidationError{Name: "customer_id", err: fmt.Errorf(`ent: validator failed for field "CommitmentContract.customer_id": %w`, err)}
+		}
+	}
+	if _, ok := ccc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "CommitmentContract.currency"`)}
+	}
+	if v, ok := ccc.mutation.Currency(); ok {
+		if err := commitmentcontract.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "CommitmentContract.currency": %w`, err)}
+		}
+	}
+	if _, ok := ccc.mutation.CommitmentAmount(); !ok {
+		return &ValidationError{Name: "commitment_amount", err: errors.New(`ent: missing required field "CommitmentContract.commitment_amount"`)}
+	}
+	if _, ok := ccc.mutation.PrepaidAmount(); !ok {
+		return &ValidationError{Name: "prepaid_amount", err: errors.New(`ent: missing required field "CommitmentContract.prepaid_amount"`)}
+	}
+	if _, ok := ccc.mutation.OverageFactor(); !ok {
+		return &ValidationError{Name: "overage_factor", err: errors.New(`ent: missing required field "CommitmentContract.overage_factor"`)}
+	}
+	if _, ok := ccc.mutation.DrawnDownAmount(); !ok {
+		return &ValidationError{Name: "drawn_down_amount", err: errors.New(`ent: missing required field "CommitmentContract.drawn_down_amount"`)}
+	}
+	if _, ok := ccc.mutation.OverageAmount(); !ok {
+		return &ValidationError{Name: "overage_amount", err: errors.New(`ent: missing required field "CommitmentContract.overage_amount"`)}
+	}
+	if _, ok := ccc.mutation.StartDate(); !ok {
+		return &ValidationError{Name: "start_date", err: errors.New(`ent: missing required field "CommitmentContract.start_date"`)}
+	}
+	if _, ok := ccc.mutation.EndDate(); !ok {
+		return &ValidationError{Name: "end_date", err: errors.New(`ent: missing required field "CommitmentContract.end_date"`)}
+	}
+	if _, ok := ccc.mutation.EnableTrueUp(); !ok {
+		return &ValidationError{Name: "enable_true_up", err: errors.New(`ent: missing required field "CommitmentContract.enable_true_up"`)}
+	}
+	if _, ok := ccc.mutation.ContractStatus(); !ok {
+		return &ValidationError{Name: "contract_status", err: errors.New(`ent: missing required field "CommitmentContract.contract_status"`)}
+	}
+	return nil
+}
+
+func (ccc *CommitmentContractCreate) sqlSave(ctx context.Context) (*CommitmentContract, error) {
+	if err := ccc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ccc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ccc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CommitmentContract.ID type: %T", _spec.ID.Value)
+		}
+	}
+	ccc.mutation.id = &_node.ID
+	ccc.mutation.done = true
+	return _node, nil
+}
+
+func (ccc *CommitmentContractCreate) createSpec() (*CommitmentContract, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CommitmentContract{config: ccc.config}
+		_spec = sqlgraph.NewCreateSpec(commitmentcontract.Table, sqlgraph.NewFieldSpec(commitmentcontract.FieldID, field.TypeString))
+	)
+	if id, ok := ccc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ccc.mutation.TenantID(); ok {
+		_spec.SetField(commitmentcontract.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := ccc.mutation.Status(); ok {
+		_spec.SetField(commitmentcontract.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := ccc.mutation.CreatedAt(); ok {
+		_spec.SetField(commitmentcontract.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ccc.mutation.UpdatedAt(); ok {
+		_spec.SetField(commitmentcontract.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ccc.mutation.CreatedBy(); ok {
+		_spec.SetField(commitmentcontract.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := ccc.mutation.UpdatedBy(); ok {
+		_spec.SetField(commitmentcontract.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := ccc.mutation.EnvironmentID(); ok {
+		_spec.SetField(commitmentcontract.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := ccc.mutation.Name(); ok {
+		_spec.SetField(commitmentcontract.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ccc.mutation.CustomerID(); ok {
+		_spec.SetField(commitmentcontract.FieldCustomerID, field.TypeString, value)
+		_node.CustomerID = value
+	}
+	if value, ok := ccc.mutation.SubscriptionIds(); ok {
+		_spec.SetField(commitmentcontract.FieldSubscriptionIds, field.TypeJSON, value)
+		_node.SubscriptionIds = value
+	}
+	if value, ok := ccc.mutation.Currency(); ok {
+		_spec.SetField(commitmentcontract.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := ccc.mutation.CommitmentAmount(); ok {
+		_spec.SetField(commitmentcontract.FieldCommitmentAmount, field.TypeOther, value)
+		_node.CommitmentAmount = value
+	}
+	if value, ok := ccc.mutation.PrepaidAmount(); ok {
+		_spec.SetField(commitmentcontract.FieldPrepaidAmount, field.TypeOther, value)
+		_node.PrepaidAmount = value
+	}
+	if value, ok := ccc.mutation.OverageFactor(); ok {
+		_spec.SetField(commitmentcontract.FieldOverageFactor, field.TypeOther, value)
+		_node.OverageFactor = value
+	}
+	if value, ok := ccc.mutation.DrawnDownAmount(); ok {
+		_spec.SetField(commitmentcontract.FieldDrawnDownAmount, field.TypeOther, value)
+		_node.DrawnDownAmount = value
+	}
+	if value, ok := ccc.mutation.OverageAmount(); ok {
+		_spec.SetField(commitmentcontract.FieldOverageAmount, field.TypeOther, value)
+		_node.OverageAmount = value
+	}
+	if value, ok := ccc.mutation.StartDate(); ok {
+		_spec.SetField(commitmentcontract.FieldStartDate, field.TypeTime, value)
+		_node.StartDate = value
+	}
+	if value, ok := ccc.mutation.EndDate(); ok {
+		_spec.SetField(commitmentcontract.FieldEndDate, field.TypeTime, value)
+		_node.EndDate = value
+	}
+	if value, ok := ccc.mutation.EnableTrueUp(); ok {
+		_spec.SetField(commitmentcontract.FieldEnableTrueUp, field.TypeBool, value)
+		_node.EnableTrueUp = value
+	}
+	if value, ok := ccc.mutation.ContractStatus(); ok {
+		_spec.SetField(commitmentcontract.FieldContractStatus, field.TypeString, value)
+		_node.ContractStatus = value
+	}
+	if value, ok := ccc.mutation.PrepaymentInvoiceID(); ok {
+		_spec.SetField(commitmentcontract.FieldPrepaymentInvoiceID, field.TypeString, value)
+		_node.PrepaymentInvoiceID = &value
+	}
+	if value, ok := ccc.mutation.TrueUpInvoiceID(); ok {
+		_spec.SetField(commitmentcontract.FieldTrueUpInvoiceID, field.TypeString, value)
+		_node.TrueUpInvoiceID = &value
+	}
+	if value, ok := ccc.mutation.Metadata(); ok {
+		_spec.SetField(commitmentcontract.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	return _node, _spec
+}
+
+// CommitmentContractCreateBulk is the builder for creating many CommitmentContract entities in bulk.
+type CommitmentContractCreateBulk struct {
+	config
+	err      error
+	builders []*CommitmentContractCreate
+}
+
+// Save creates the CommitmentContract entities in the database.
+func (cccb *CommitmentContractCreateBulk) Save(ctx context.Context) ([]*CommitmentContract, error) {
+	if cccb.err != nil {
+		return nil, cccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cccb.builders))
+	nodes := make([]*CommitmentContract, len(cccb.builders))
+	mutators := make([]Mutator, len(cccb.builders))
+	for i := range cccb.builders {
+		func(i int, root context.Context) {
+			builder := cccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommitmentContractMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cccb *CommitmentContractCreateBulk) SaveX(ctx context.Context) []*CommitmentContract {
+	v, err := cccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cccb *CommitmentContractCreateBulk) Exec(ctx context.Context) error {
+	_, err := cccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cccb *CommitmentContractCreateBulk) ExecX(ctx context.Context) {
+	if err := cccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
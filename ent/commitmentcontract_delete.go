@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/commitmentcontract"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// CommitmentContractDelete is the builder for deleting a CommitmentContract entity.
+type CommitmentContractDelete struct {
+	config
+	hooks    []Hook
+	mutation *CommitmentContractMutation
+}
+
+// Where appends a list predicates to the CommitmentContractDelete builder.
+func (ccd *CommitmentContractDelete) Where(ps ...predicate.CommitmentContract) *CommitmentContractDelete {
+	ccd.mutation.Where(ps...)
+	return ccd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ccd *CommitmentContractDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ccd.sqlExec, ccd.mutation, ccd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccd *CommitmentContractDelete) ExecX(ctx context.Context) int {
+	n, err := ccd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ccd *CommitmentContractDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(commitmentcontract.Table, sqlgraph.NewFieldSpec(commitmentcontract.FieldID, field.TypeString))
+	if ps := ccd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ccd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ccd.mutation.done = true
+	return affected, err
+}
+
+// CommitmentContractDeleteOne is the builder for deleting a single CommitmentContract entity.
+type CommitmentContractDeleteOne struct {
+	ccd *CommitmentContractDelete
+}
+
+// Where appends a list predicates to the CommitmentContractDelete builder.
+func (ccdo *CommitmentContractDeleteOne) Where(ps ...predicate.CommitmentContract) *CommitmentContractDeleteOne {
+	ccdo.ccd.mutation.Where(ps...)
+	return ccdo
+}
+
+// Exec executes the deletion query.
+func (ccdo *CommitmentContractDeleteOne) Exec(ctx context.Context) error {
+	n, err := ccdo.ccd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{commitmentcontract.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccdo *CommitmentContractDeleteOne) ExecX(ctx context.Context) {
+	if err := ccdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/commitmentcontract"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// CommitmentContractQuery is the builder for querying CommitmentContract entities.
+type CommitmentContractQuery struct {
+	config
+	ctx        *QueryContext
+	order      []commitmentcontract.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CommitmentContract
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CommitmentContractQuery builder.
+func (ccq *CommitmentContractQuery) Where(ps ...predicate.CommitmentContract) *CommitmentContractQuery {
+	ccq.predicates = append(ccq.predicates, ps...)
+	return ccq
+}
+
+// Limit the number of records to be returned by this query.
+func (ccq *CommitmentContractQuery) Limit(limit int) *CommitmentContractQuery {
+	ccq.ctx.Limit = &limit
+	return ccq
+}
+
+// Offset to start from.
+func (ccq *CommitmentContractQuery) Offset(offset int) *CommitmentContractQuery {
+	ccq.ctx.Offset = &offset
+	return ccq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ccq *CommitmentContractQuery) Unique(unique bool) *CommitmentContractQuery {
+	ccq.ctx.Unique = &unique
+	return ccq
+}
+
+// Order specifies how the records should be ordered.
+func (ccq *CommitmentContractQuery) Order(o ...commitmentcontract.OrderOption) *CommitmentContractQuery {
+	ccq.order = append(ccq.order, o...)
+	return ccq
+}
+
+// First returns the first CommitmentContract entity from the query.
+// Returns a *NotFoundError when no CommitmentContract was found.
+func (ccq *CommitmentContractQuery) First(ctx context.Context) (*CommitmentContract, error) {
+	nodes, err := ccq.Limit(1).All(setContextOp(ctx, ccq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{commitmentcontract.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ccq *CommitmentContractQuery) FirstX(ctx context.Context) *CommitmentContract {
+	node, err := ccq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CommitmentContract ID from the query.
+// Returns a *NotFoundError when no CommitmentContract ID was found.
+func (ccq *CommitmentContractQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ccq.Limit(1).IDs(setContextOp(ctx, ccq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{commitmentcontract.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ccq *CommitmentContractQuery) FirstIDX(ctx context.Context) string {
+	id, err := ccq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CommitmentContract entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CommitmentContract entity is found.
+// Returns a *NotFoundError when no CommitmentContract entities are found.
+func (ccq *CommitmentContractQuery) Only(ctx context.Context) (*CommitmentContract, error) {
+	nodes, err := ccq.Limit(2).All(setContextOp(ctx, ccq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{commitmentcontract.Label}
+	default:
+		return nil, &NotSingularError{commitmentcontract.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ccq *CommitmentContractQuery) OnlyX(ctx context.Context) *CommitmentContract {
+	node, err := ccq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CommitmentContract ID in the query.
+// Returns a *NotSingularError when more than one CommitmentContract ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ccq *CommitmentContractQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ccq.Limit(2).IDs(setContextOp(ctx, ccq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{commitmentcontract.Label}
+	default:
+		err = &NotSingularError{commitmentcontract.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ccq *CommitmentContractQuery) OnlyIDX(ctx context.Context) string {
+	id, err := ccq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CommitmentContracts.
+func (ccq *CommitmentContractQuery) All(ctx context.Context) ([]*CommitmentContract, error) {
+	ctx = setContextOp(ctx, ccq.ctx, ent.OpQueryAll)
+	if err := ccq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CommitmentContract, *CommitmentContractQuery]()
+	return withInterceptors[[]*CommitmentContract](ctx, ccq, qr, ccq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ccq *CommitmentContractQuery) AllX(ctx context.Context) []*CommitmentContract {
+	nodes, err := ccq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CommitmentContract IDs.
+func (ccq *CommitmentContractQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if ccq.ctx.Unique == nil && ccq.path != nil {
+		ccq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ccq.ctx, ent.OpQueryIDs)
+	if err = ccq.Select(commitmentcontract.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ccq *CommitmentContractQuery) IDsX(ctx context.Context) []string {
+	ids, err := ccq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ccq *CommitmentContractQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ccq.ctx, ent.OpQueryCount)
+	if err := ccq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ccq, querierCount[*CommitmentContractQuery](), ccq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ccq *CommitmentContractQuery) CountX(ctx context.Context) int {
+	count, err := ccq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ccq *CommitmentContractQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ccq.ctx, ent.OpQueryExist)
+	switch _, err := ccq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ccq *CommitmentContractQuery) ExistX(ctx context.Context) bool {
+	exist, err := ccq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CommitmentContractQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ccq *CommitmentContractQuery) Clone() *CommitmentContractQuery {
+	if ccq == nil {
+		return nil
+	}
+	return &CommitmentContractQuery{
+		config:     ccq.config,
+		ctx:        ccq.ctx.Clone(),
+		order:      append([]commitmentcontract.OrderOption{}, ccq.order...),
+		inters:     append([]Interceptor{}, ccq.inters...),
+		predicates: append([]predicate.CommitmentContract{}, ccq.predicates...),
+		// clone intermediate query.
+		sql:  ccq.sql.Clone(),
+		path: ccq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CommitmentContract.Query().
+//		GroupBy(commitmentcontract.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ccq *CommitmentContractQuery) GroupBy(field string, fields ...string) *CommitmentContractGroupBy {
+	ccq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CommitmentContractGroupBy{build: ccq}
+	grbuild.flds = &ccq.ctx.Fields
+	grbuild.label = commitmentcontract.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.CommitmentContract.Query().
+//		Select(commitmentcontract.FieldTenantID).
+//		Scan(ctx, &v)
+func (ccq *CommitmentContractQuery) Select(fields ...string) *CommitmentContractSelect {
+	ccq.ctx.Fields = append(ccq.ctx.Fields, fields...)
+	sbuild := &CommitmentContractSelect{CommitmentContractQuery: ccq}
+	sbuild.label = commitmentcontract.Label
+	sbuild.flds, sbuild.scan = &ccq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CommitmentContractSelect configured with the given aggregations.
+func (ccq *CommitmentContractQuery) Aggregate(fns ...AggregateFunc) *CommitmentContractSelect {
+	return ccq.Select().Aggregate(fns...)
+}
+
+func (ccq *CommitmentContractQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ccq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ccq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ccq.ctx.Fields {
+		if !commitmentcontract.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ccq.path != nil {
+		prev, err := ccq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ccq.sql = prev
+	}
+	return nil
+}
+
+func (ccq *CommitmentContractQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CommitmentContract, error) {
+	var (
+		nodes = []*CommitmentContract{}
+		_spec = ccq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CommitmentContract).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CommitmentContract{config: ccq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ccq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ccq *CommitmentContractQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ccq.querySpec()
+	_spec.Node.Columns = ccq.ctx.Fields
+	if len(ccq.ctx.Fields) > 0 {
+		_spec.Unique = ccq.ctx.Unique != nil && *ccq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ccq.driver, _spec)
+}
+
+func (ccq *CommitmentContractQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(commitmentcontract.Table, commitmentcontract.Columns, sqlgraph.NewFieldSpec(commitmentcontract.FieldID, field.TypeString))
+	_spec.From = ccq.sql
+	if unique := ccq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ccq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ccq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, commitmentcontract.FieldID)
+		for i := range fields {
+			if fields[i] != commitmentcontract.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ccq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ccq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ccq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ccq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ccq *CommitmentContractQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ccq.driver.Dialect())
+	t1 := builder.Table(commitmentcontract.Table)
+	columns := ccq.ctx.Fields
+	if len(columns) == 0 {
+		columns = commitmentcontract.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ccq.sql != nil {
+		selector = ccq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ccq.ctx.Unique != nil && *ccq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ccq.predicates {
+		p(selector)
+	}
+	for _, p := range ccq.order {
+		p(selector)
+	}
+	if offset := ccq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ccq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CommitmentContractGroupBy is the group-by builder for CommitmentContract entities.
+type CommitmentContractGroupBy struct {
+	selector
+	build *CommitmentContractQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ccgb *CommitmentContractGroupBy) Aggregate(fns ...AggregateFunc) *CommitmentContractGroupBy {
+	ccgb.fns = append(ccgb.fns, fns...)
+	return ccgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ccgb *CommitmentContractGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ccgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ccgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CommitmentContractQuery, *CommitmentContractGroupBy](ctx, ccgb.build, ccgb, ccgb.build.inters, v)
+}
+
+func (ccgb *CommitmentContractGroupBy) sqlScan(ctx context.Context, root *CommitmentContractQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ccgb.fns))
+	for _, fn := range ccgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ccgb.flds)+len(ccgb.fns))
+		for _, f := range *ccgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ccgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ccgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CommitmentContractSelect is the builder for selecting fields of CommitmentContract entities.
+type CommitmentContractSelect struct {
+	*CommitmentContractQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ccs *CommitmentContractSelect) Aggregate(fns ...AggregateFunc) *CommitmentContractSelect {
+	ccs.fns = append(ccs.fns, fns...)
+	return ccs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ccs *CommitmentContractSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ccs.ctx, ent.OpQuerySelect)
+	if err := ccs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CommitmentContractQuery, *CommitmentContractSelect](ctx, ccs.CommitmentContractQuery, ccs, ccs.inters, v)
+}
+
+func (ccs *CommitmentContractSelect) sqlScan(ctx context.Context, root *CommitmentContractQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ccs.fns))
+	for _, fn := range ccs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ccs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ccs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,774 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/commitmentcontract"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// CommitmentContractUpdate is the builder for updating CommitmentContract entities.
+type CommitmentContractUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CommitmentContractMutation
+}
+
+// Where appends a list predicates to the CommitmentContractUpdate builder.
+func (ccu *CommitmentContractUpdate) Where(ps ...predicate.CommitmentContract) *CommitmentContractUpdate {
+	ccu.mutation.Where(ps...)
+	return ccu
+}
+
+// SetStatus sets the "status" field.
+func (ccu *CommitmentContractUpdate) SetStatus(s string) *CommitmentContractUpdate {
+	ccu.mutation.SetStatus(s)
+	return ccu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ccu *CommitmentContractUpdate) SetNillableStatus(s *string) *CommitmentContractUpdate {
+	if s != nil {
+		ccu.SetStatus(*s)
+	}
+	return ccu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ccu *CommitmentContractUpdate) SetUpdatedAt(t time.Time) *CommitmentContractUpdate {
+	ccu.mutation.SetUpdatedAt(t)
+	return ccu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ccu *CommitmentContractUpdate) SetUpdatedBy(s string) *CommitmentContractUpdate {
+	ccu.mutation.SetUpdatedBy(s)
+	return ccu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ccu *CommitmentContractUpdate) SetNillableUpdatedBy(s *string) *CommitmentContractUpdate {
+	if s != nil {
+		ccu.SetUpdatedBy(*s)
+	}
+	return ccu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (ccu *CommitmentContractUpdate) ClearUpdatedBy() *CommitmentContractUpdate {
+	ccu.mutation.ClearUpdatedBy()
+	return ccu
+}
+
+// SetName sets the "name" field.
+func (ccu *CommitmentContractUpdate) SetName(s string) *CommitmentContractUpdate {
+	ccu.mutation.SetName(s)
+	return ccu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ccu *CommitmentContractUpdate) SetNillableName(s *string) *CommitmentContractUpdate {
+	if s != nil {
+		ccu.SetName(*s)
+	}
+	return ccu
+}
+
+// SetSubscriptionIds sets the "subscription_ids" field.
+func (ccu *CommitmentContractUpdate) SetSubscriptionIds(s []string) *CommitmentContractUpdate {
+	ccu.mutation.SetSubscriptionIds(s)
+	return ccu
+}
+
+// AppendSubscriptionIds appends s to the "subscription_ids" field.
+func (ccu *CommitmentContractUpdate) AppendSubscriptionIds(s []string) *CommitmentContractUpdate {
+	ccu.mutation.AppendSubscriptionIds(s)
+	return ccu
+}
+
+// ClearSubscriptionIds clears the value of the "subscription_ids" field.
+func (ccu *CommitmentContractUpdate) ClearSubscriptionIds() *CommitmentContractUpdate {
+	ccu.mutation.ClearSubscriptionIds()
+	return ccu
+}
+
+// SetOverageFactor sets the "overage_factor" field.
+func (ccu *CommitmentContractUpdate) SetOverageFactor(d decimal.Decimal) *CommitmentContractUpdate {
+	ccu.mutation.SetOverageFactor(d)
+	return ccu
+}
+
+// SetNillableOverageFactor sets the "overage_factor" field if the given value is not nil.
+func (ccu *CommitmentContractUpdate) SetNillableOverageFactor(d *decimal.Decimal) *CommitmentContractUpdate {
+	if d != nil {
+		ccu.SetOverageFactor(*d)
+	}
+	return ccu
+}
+
+// SetDrawnDownAmount sets the "drawn_down_amount" field.
+func (ccu *CommitmentContractUpdate) SetDrawnDownAmount(d decimal.Decimal) *CommitmentContractUpdate {
+	ccu.mutation.SetDrawnDownAmount(d)
+	return ccu
+}
+
+// SetNillableDrawnDownAmount sets the "drawn_down_amount" field if the given value is not nil.
+func (ccu *CommitmentContractUpdate) SetNillableDrawnDownAmount(d *decimal.Decimal) *CommitmentContractUpdate {
+	if d != nil {
+		ccu.SetDrawnDownAmount(*d)
+	}
+	return ccu
+}
+
+// SetOverageAmount sets the "overage_amount" field.
+func (ccu *CommitmentContractUpdate) SetOverageAmount(d decimal.Decimal) *CommitmentContractUpdate {
+	ccu.mutation.SetOverageAmount(d)
+	return ccu
+}
+
+// SetNillableOverageAmount sets the "overage_amount" field if the given value is not nil.
+func (ccu *CommitmentContractUpdate) SetNillableOverageAmount(d *decimal.Decimal) *CommitmentContractUpdate {
+	if d != nil {
+		ccu.SetOverageAmount(*d)
+	}
+	return ccu
+}
+
+// SetEndDate sets the "end_date" field.
+func (ccu *CommitmentContractUpdate) SetEndDate(t time.Time) *CommitmentContractUpdate {
+	ccu.mutation.SetEndDate(t)
+	return ccu
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (ccu *CommitmentContractUpdate) SetNillableEndDate(t *time.Time) *CommitmentContractUpdate {
+	if t != nil {
+		ccu.SetEndDate(*t)
+	}
+	return ccu
+}
+
+// SetEnableTrueUp sets the "enable_true_up" field.
+func (ccu *CommitmentContractUpdate) SetEnableTrueUp(b bool) *CommitmentContractUpdate {
+	ccu.mutation.SetEnableTrueUp(b)
+	return ccu
+}
+
+// SetNillableEnableTrueUp sets the "enable_true_up" field if the given value is not nil.
+func (ccu *CommitmentContractUpdate) SetNillableEnableTrueUp(b *bool) *CommitmentContractUpdate {
+	if b != nil {
+		ccu.SetEnableTrueUp(*b)
+	}
+	return ccu
+}
+
+// SetContractStatus sets the "contract_status" field.
+func (ccu *CommitmentContractUpdate) SetContractStatus(s string) *CommitmentContractUpdate {
+	ccu.mutation.SetContractStatus(s)
+	return ccu
+}
+
+// SetNillableContractStatus sets the "contract_status" field if the given value is not nil.
+func (ccu *CommitmentContractUpdate) SetNillableContractStatus(s *string) *CommitmentContractUpdate {
+	if s != nil {
+		ccu.SetContractStatus(*s)
+	}
+	return ccu
+}
+
+// SetPrepaymentInvoiceID sets the "prepayment_invoice_id" field.
+func (ccu *CommitmentContractUpdate) SetPrepaymentInvoiceID(s string) *CommitmentContractUpdate {
+	ccu.mutation.SetPrepaymentInvoiceID(s)
+	return ccu
+}
+
+// SetNillablePrepaymentInvoiceID sets the "prepayment_invoice_id" field if the given value is not nil.
+func (ccu *CommitmentContractUpdate) SetNillablePrepaymentInvoiceID(s *string) *CommitmentContractUpdate {
+	if s != nil {
+		ccu.SetPrepaymentInvoiceID(*s)
+	}
+	return ccu
+}
+
+// ClearPrepaymentInvoiceID clears the value of the "prepayment_invoice_id" field.
+func (ccu *CommitmentContractUpdate) ClearPrepaymentInvoiceID() *CommitmentContractUpdate {
+	ccu.mutation.ClearPrepaymentInvoiceID()
+	return ccu
+}
+
+// SetTrueUpInvoiceID sets the "true_up_invoice_id" field.
+func (ccu *CommitmentContractUpdate) SetTrueUpInvoiceID(s string) *CommitmentContractUpdate {
+	ccu.mutation.SetTrueUpInvoiceID(s)
+	return ccu
+}
+
+// SetNillableTrueUpInvoiceID sets the "true_up_invoice_id" field if the given value is not nil.
+func (ccu *CommitmentContractUpdate) SetNillableTrueUpInvoiceID(s *string) *CommitmentContractUpdate {
+	if s != nil {
+		ccu.SetTrueUpInvoiceID(*s)
+	}
+	return ccu
+}
+
+// ClearTrueUpInvoiceID clears the value of the "true_up_invoice_id" field.
+func (ccu *CommitmentContractUpdate) ClearTrueUpInvoiceID() *CommitmentContractUpdate {
+	ccu.mutation.ClearTrueUpInvoiceID()
+	return ccu
+}
+
+// SetMetadata sets the "metadata" field.
+func (ccu *CommitmentContractUpdate) SetMetadata(m map[string]string) *CommitmentContractUpdate {
+	ccu.mutation.SetMetadata(m)
+	return ccu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (ccu *CommitmentContractUpdate) ClearMetadata() *CommitmentContractUpdate {
+	ccu.mutation.ClearMetadata()
+	return ccu
+}
+
+// Mutation returns the CommitmentContractMutation object of the builder.
+func (ccu *CommitmentContractUpdate) Mutation() *CommitmentContractMutation {
+	return ccu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ccu *CommitmentContractUpdate) Save(ctx context.Context) (int, error) {
+	ccu.defaults()
+	return withHooks(ctx, ccu.sqlSave, ccu.mutation, ccu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccu *CommitmentContractUpdate) SaveX(ctx context.Context) int {
+	affected, err := ccu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ccu *CommitmentContractUpdate) Exec(ctx context.Context) error {
+	_, err := ccu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccu *CommitmentContractUpdate) ExecX(ctx context.Context) {
+	if err := ccu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ccu *CommitmentContractUpdate) defaults() {
+	if _, ok := ccu.mutation.UpdatedAt(); !ok {
+		v := commitmentcontract.UpdateDefaultUpdatedAt()
+		ccu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccu *CommitmentContractUpdate) check() error {
+	if v, ok := ccu.mutation.Name(); ok {
+		if err := commitmentcontract.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CommitmentContract.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ccu *CommitmentContractUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ccu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(commitmentcontract.Table, commitmentcontract.Columns, sqlgraph.NewFieldSpec(commitmentcontract.FieldID, field.TypeString))
+	if ps := ccu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ccu.mutation.Status(); ok {
+		_spec.SetField(commitmentcontract.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := ccu.mutation.UpdatedAt(); ok {
+		_spec.SetField(commitmentcontract.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ccu.mutation.CreatedByCleared() {
+		_spec.ClearField(commitmentcontract.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := ccu.mutation.UpdatedBy(); ok {
+		_spec.SetField(commitmentcontract.FieldUpdatedBy, field.TypeString, value)
+	}
+	if ccu.mutation.UpdatedByCleared() {
+		_spec.ClearField(commitmentcontract.FieldUpdatedBy, field.TypeString)
+	}
+	if ccu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(commitmentcontract.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := ccu.mutation.Name(); ok {
+		_spec.SetField(commitmentcontract.FieldName, field.TypeString, value)
+	}
+	if value, ok := ccu.mutation.SubscriptionIds(); ok {
+		_spec.SetField(commitmentcontract.FieldSubscriptionIds, field.TypeJSON, value)
+	}
+	if value, ok := ccu.mutation.AppendedSubscriptionIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, commitmentcontract.FieldSubscriptionIds, value)
+		})
+	}
+	if ccu.mutation.SubscriptionIdsCleared() {
+		_spec.ClearField(commitmentcontract.FieldSubscriptionIds, field.TypeJSON)
+	}
+	if value, ok := ccu.mutation.OverageFactor(); ok {
+		_spec.SetField(commitmentcontract.FieldOverageFactor, field.TypeOther, value)
+	}
+	if value, ok := ccu.mutation.DrawnDownAmount(); ok {
+		_spec.SetField(commitmentcontract.FieldDrawnDownAmount, field.TypeOther, value)
+	}
+	if value, ok := ccu.mutation.OverageAmount(); ok {
+		_spec.SetField(commitmentcontract.FieldOverageAmount, field.TypeOther, value)
+	}
+	if value, ok := ccu.mutation.EndDate(); ok {
+		_spec.SetField(commitmentcontract.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := ccu.mutation.EnableTrueUp(); ok {
+		_spec.SetField(commitmentcontract.FieldEnableTrueUp, field.TypeBool, value)
+	}
+	if value, ok := ccu.mutation.ContractStatus(); ok {
+		_spec.SetField(commitmentcontract.FieldContractStatus, field.TypeString, value)
+	}
+	if value, ok := ccu.mutation.PrepaymentInvoiceID(); ok {
+		_spec.SetField(commitmentcontract.FieldPrepaymentInvoiceID, field.TypeString, value)
+	}
+	if ccu.mutation.PrepaymentInvoiceIDCleared() {
+		_spec.ClearField(commitmentcontract.FieldPrepaymentInvoiceID, field.TypeString)
+	}
+	if value, ok := ccu.mutation.TrueUpInvoiceID(); ok {
+		_spec.SetField(commitmentcontract.FieldTrueUpInvoiceID, field.TypeString, value)
+	}
+	if ccu.mutation.TrueUpInvoiceIDCleared() {
+		_spec.ClearField(commitmentcontract.FieldTrueUpInvoiceID, field.TypeString)
+	}
+	if value, ok := ccu.mutation.Metadata(); ok {
+		_spec.SetField(commitmentcontract.FieldMetadata, field.TypeJSON, value)
+	}
+	if ccu.mutation.MetadataCleared() {
+		_spec.ClearField(commitmentcontract.FieldMetadata, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ccu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commitmentcontract.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ccu.mutation.done = true
+	return n, nil
+}
+
+// CommitmentContractUpdateOne is the builder for updating a single CommitmentContract entity.
+type CommitmentContractUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CommitmentContractMutation
+}
+
+// SetStatus sets the "status" field.
+func (ccuo *CommitmentContractUpdateOne) SetStatus(s string) *CommitmentContractUpdateOne {
+	ccuo.mutation.SetStatus(s)
+	return ccuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ccuo *CommitmentContractUpdateOne) SetNillableStatus(s *string) *CommitmentContractUpdateOne {
+	if s != nil {
+		ccuo.SetStatus(*s)
+	}
+	return ccuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ccuo *CommitmentContractUpdateOne) SetUpdatedAt(t time.Time) *CommitmentContractUpdateOne {
+	ccuo.mutation.SetUpdatedAt(t)
+	return ccuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ccuo *CommitmentContractUpdateOne) SetUpdatedBy(s string) *CommitmentContractUpdateOne {
+	ccuo.mutation.SetUpdatedBy(s)
+	return ccuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ccuo *CommitmentContractUpdateOne) SetNillableUpdatedBy(s *string) *CommitmentContractUpdateOne {
+	if s != nil {
+		ccuo.SetUpdatedBy(*s)
+	}
+	return ccuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (ccuo *CommitmentContractUpdateOne) ClearUpdatedBy() *CommitmentContractUpdateOne {
+	ccuo.mutation.ClearUpdatedBy()
+	return ccuo
+}
+
+// SetName sets the "name" field.
+func (ccuo *CommitmentContractUpdateOne) SetName(s string) *CommitmentContractUpdateOne {
+	ccuo.mutation.SetName(s)
+	return ccuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ccuo *CommitmentContractUpdateOne) SetNillableName(s *string) *CommitmentContractUpdateOne {
+	if s != nil {
+		ccuo.SetName(*s)
+	}
+	return ccuo
+}
+
+// SetSubscriptionIds sets the "subscription_ids" field.
+func (ccuo *CommitmentContractUpdateOne) SetSubscriptionIds(s []string) *CommitmentContractUpdateOne {
+	ccuo.mutation.SetSubscriptionIds(s)
+	return ccuo
+}
+
+// AppendSubscriptionIds appends s to the "subscription_ids" field.
+func (ccuo *CommitmentContractUpdateOne) AppendSubscriptionIds(s []string) *CommitmentContractUpdateOne {
+	ccuo.mutation.AppendSubscriptionIds(s)
+	return ccuo
+}
+
+// ClearSubscriptionIds clears the value of the "subscription_ids" field.
+func (ccuo *CommitmentContractUpdateOne) ClearSubscriptionIds() *CommitmentContractUpdateOne {
+	ccuo.mutation.ClearSubscriptionIds()
+	return ccuo
+}
+
+// SetOverageFactor sets the "overage_factor" field.
+func (ccuo *CommitmentContractUpdateOne) SetOverageFactor(d decimal.Decimal) *CommitmentContractUpdateOne {
+	ccuo.mutation.SetOverageFactor(d)
+	return ccuo
+}
+
+// SetNillableOverageFactor sets the "overage_factor" field if the given value is not nil.
+func (ccuo *CommitmentContractUpdateOne) SetNillableOverageFactor(d *decimal.Decimal) *CommitmentContractUpdateOne {
+	if d != nil {
+		ccuo.SetOverageFactor(*d)
+	}
+	return ccuo
+}
+
+// SetDrawnDownAmount sets the "drawn_down_amount" field.
+func (ccuo *CommitmentContractUpdateOne) SetDrawnDownAmount(d decimal.Decimal) *CommitmentContractUpdateOne {
+	ccuo.mutation.SetDrawnDownAmount(d)
+	return ccuo
+}
+
+// SetNillableDrawnDownAmount sets the "drawn_down_amount" field if the given value is not nil.
+func (ccuo *CommitmentContractUpdateOne) SetNillableDrawnDownAmount(d *decimal.Decimal) *CommitmentContractUpdateOne {
+	if d != nil {
+		ccuo.SetDrawnDownAmount(*d)
+	}
+	return ccuo
+}
+
+// SetOverageAmount sets the "overage_amount" field.
+func (ccuo *CommitmentContractUpdateOne) SetOverageAmount(d decimal.Decimal) *CommitmentContractUpdateOne {
+	ccuo.mutation.SetOverageAmount(d)
+	return ccuo
+}
+
+// SetNillableOverageAmount sets the "overage_amount" field if the given value is not nil.
+func (ccuo *CommitmentContractUpdateOne) SetNillableOverageAmount(d *decimal.Decimal) *CommitmentContractUpdateOne {
+	if d != nil {
+		ccuo.SetOverageAmount(*d)
+	}
+	return ccuo
+}
+
+// SetEndDate sets the "end_date" field.
+func (ccuo *CommitmentContractUpdateOne) SetEndDate(t time.Time) *CommitmentContractUpdateOne {
+	ccuo.mutation.SetEndDate(t)
+	return ccuo
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (ccuo *CommitmentContractUpdateOne) SetNillableEndDate(t *time.Time) *CommitmentContractUpdateOne {
+	if t != nil {
+		ccuo.SetEndDate(*t)
+	}
+	return ccuo
+}
+
+// SetEnableTrueUp sets the "enable_true_up" field.
+func (ccuo *CommitmentContractUpdateOne) SetEnableTrueUp(b bool) *CommitmentContractUpdateOne {
+	ccuo.mutation.SetEnableTrueUp(b)
+	return ccuo
+}
+
+// SetNillableEnableTrueUp sets the "enable_true_up" field if the given value is not nil.
+func (ccuo *CommitmentContractUpdateOne) SetNillableEnableTrueUp(b *bool) *CommitmentContractUpdateOne {
+	if b != nil {
+		ccuo.SetEnableTrueUp(*b)
+	}
+	return ccuo
+}
+
+// SetContractStatus sets the "contract_status" field.
+func (ccuo *CommitmentContractUpdateOne) SetContractStatus(s string) *CommitmentContractUpdateOne {
+	ccuo.mutation.SetContractStatus(s)
+	return ccuo
+}
+
+// SetNillableContractStatus sets the "contract_status" field if the given value is not nil.
+func (ccuo *CommitmentContractUpdateOne) SetNillableContractStatus(s *string) *CommitmentContractUpdateOne {
+	if s != nil {
+		ccuo.SetContractStatus(*s)
+	}
+	return ccuo
+}
+
+// SetPrepaymentInvoiceID sets the "prepayment_invoice_id" field.
+func (ccuo *CommitmentContractUpdateOne) SetPrepaymentInvoiceID(s string) *CommitmentContractUpdateOne {
+	ccuo.mutation.SetPrepaymentInvoiceID(s)
+	return ccuo
+}
+
+// SetNillablePrepaymentInvoiceID sets the "prepayment_invoice_id" field if the given value is not nil.
+func (ccuo *CommitmentContractUpdateOne) SetNillablePrepaymentInvoiceID(s *string) *CommitmentContractUpdateOne {
+	if s != nil {
+		ccuo.SetPrepaymentInvoiceID(*s)
+	}
+	return ccuo
+}
+
+// ClearPrepaymentInvoiceID clears the value of the "prepayment_invoice_id" field.
+func (ccuo *CommitmentContractUpdateOne) ClearPrepaymentInvoiceID() *CommitmentContractUpdateOne {
+	ccuo.mutation.ClearPrepaymentInvoiceID()
+	return ccuo
+}
+
+// SetTrueUpInvoiceID sets the "true_up_invoice_id" field.
+func (ccuo *CommitmentContractUpdateOne) SetTrueUpInvoiceID(s string) *CommitmentContractUpdateOne {
+	ccuo.mutation.SetTrueUpInvoiceID(s)
+	return ccuo
+}
+
+// SetNillableTrueUpInvoiceID sets the "true_up_invoice_id" field if the given value is not nil.
+func (ccuo *CommitmentContractUpdateOne) SetNillableTrueUpInvoiceID(s *string) *CommitmentContractUpdateOne {
+	if s != nil {
+		ccuo.SetTrueUpInvoiceID(*s)
+	}
+	return ccuo
+}
+
+// ClearTrueUpInvoiceID clears the value of the "true_up_invoice_id" field.
+func (ccuo *CommitmentContractUpdateOne) ClearTrueUpInvoiceID() *CommitmentContractUpdateOne {
+	ccuo.mutation.ClearTrueUpInvoiceID()
+	return ccuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (ccuo *CommitmentContractUpdateOne) SetMetadata(m map[string]string) *CommitmentContractUpdateOne {
+	ccuo.mutation.SetMetadata(m)
+	return ccuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (ccuo *CommitmentContractUpdateOne) ClearMetadata() *CommitmentContractUpdateOne {
+	ccuo.mutation.ClearMetadata()
+	return ccuo
+}
+
+// Mutation returns the CommitmentContractMutation object of the builder.
+func (ccuo *CommitmentContractUpdateOne) Mutation() *CommitmentContractMutation {
+	return ccuo.mutation
+}
+
+// Where appends a list predicates to the CommitmentContractUpdate builder.
+func (ccuo *CommitmentContractUpdateOne) Where(ps ...predicate.CommitmentContract) *CommitmentContractUpdateOne {
+	ccuo.mutation.Where(ps...)
+	return ccuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ccuo *CommitmentContractUpdateOne) Select(field string, fields ...string) *CommitmentContractUpdateOne {
+	ccuo.fields = append([]string{field}, fields...)
+	return ccuo
+}
+
+// Save executes the query and returns the updated CommitmentContract entity.
+func (ccuo *CommitmentContractUpdateOne) Save(ctx context.Context) (*CommitmentContract, error) {
+	ccuo.defaults()
+	return withHooks(ctx, ccuo.sqlSave, ccuo.mutation, ccuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccuo *CommitmentContractUpdateOne) SaveX(ctx context.Context) *CommitmentContract {
+	node, err := ccuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ccuo *CommitmentContractUpdateOne) Exec(ctx context.Context) error {
+	_, err := ccuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccuo *CommitmentContractUpdateOne) ExecX(ctx context.Context) {
+	if err := ccuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ccuo *CommitmentContractUpdateOne) defaults() {
+	if _, ok := ccuo.mutation.UpdatedAt(); !ok {
+		v := commitmentcontract.UpdateDefaultUpdatedAt()
+		ccuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccuo *CommitmentContractUpdateOne) check() error {
+	if v, ok := ccuo.mutation.Name(); ok {
+		if err := commitmentcontract.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CommitmentContract.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ccuo *CommitmentContractUpdateOne) sqlSave(ctx context.Context) (_node *CommitmentContract, err error) {
+	if err := ccuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(commitmentcontract.Table, commitmentcontract.Columns, sqlgraph.NewFieldSpec(commitmentcontract.FieldID, field.TypeString))
+	id, ok := ccuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CommitmentContract.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ccuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, commitmentcontract.FieldID)
+		for _, f := range fields {
+			if !commitmentcontract.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != commitmentcontract.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ccuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ccuo.mutation.Status(); ok {
+		_spec.SetField(commitmentcontract.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := ccuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(commitmentcontract.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ccuo.mutation.CreatedByCleared() {
+		_spec.ClearField(commitmentcontract.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := ccuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(commitmentcontract.FieldUpdatedBy, field.TypeString, value)
+	}
+	if ccuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(commitmentcontract.FieldUpdatedBy, field.TypeString)
+	}
+	if ccuo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(commitmentcontract.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := ccuo.mutation.Name(); ok {
+		_spec.SetField(commitmentcontract.FieldName, field.TypeString, value)
+	}
+	if value, ok := ccuo.mutation.SubscriptionIds(); ok {
+		_spec.SetField(commitmentcontract.FieldSubscriptionIds, field.TypeJSON, value)
+	}
+	if value, ok := ccuo.mutation.AppendedSubscriptionIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, commitmentcontract.FieldSubscriptionIds, value)
+		})
+	}
+	if ccuo.mutation.SubscriptionIdsCleared() {
+		_spec.ClearField(commitmentcontract.FieldSubscriptionIds, field.TypeJSON)
+	}
+	if value, ok := ccuo.mutation.OverageFactor(); ok {
+		_spec.SetField(commitmentcontract.FieldOverageFactor, field.TypeOther, value)
+	}
+	if value, ok := ccuo.mutation.DrawnDownAmount(); ok {
+		_spec.SetField(commitmentcontract.FieldDrawnDownAmount, field.TypeOther, value)
+	}
+	if value, ok := ccuo.mutation.OverageAmount(); ok {
+		_spec.SetField(commitmentcontract.FieldOverageAmount, field.TypeOther, value)
+	}
+	if value, ok := ccuo.mutation.EndDate(); ok {
+		_spec.SetField(commitmentcontract.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := ccuo.mutation.EnableTrueUp(); ok {
+		_spec.SetField(commitmentcontract.FieldEnableTrueUp, field.TypeBool, value)
+	}
+	if value, ok := ccuo.mutation.ContractStatus(); ok {
+		_spec.SetField(commitmentcontract.FieldContractStatus, field.TypeString, value)
+	}
+	if value, ok := ccuo.mutation.PrepaymentInvoiceID(); ok {
+		_spec.SetField(commitmentcontract.FieldPrepaymentInvoiceID, field.TypeString, value)
+	}
+	if ccuo.mutation.PrepaymentInvoiceIDCleared() {
+		_spec.ClearField(commitmentcontract.FieldPrepaymentInvoiceID, field.TypeString)
+	}
+	if value, ok := ccuo.mutation.TrueUpInvoiceID(); ok {
+		_spec.SetField(commitmentcontract.FieldTrueUpInvoiceID, field.TypeString, value)
+	}
+	if ccuo.mutation.TrueUpInvoiceIDCleared() {
+		_spec.ClearField(commitmentcontract.FieldTrueUpInvoiceID, field.TypeString)
+	}
+	if value, ok := ccuo.mutation.Metadata(); ok {
+		_spec.SetField(commitmentcontract.FieldMetadata, field.TypeJSON, value)
+	}
+	if ccuo.mutation.MetadataCleared() {
+		_spec.ClearField(commitmentcontract.FieldMetadata, field.TypeJSON)
+	}
+	_node = &CommitmentContract{config: ccuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ccuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commitmentcontract.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ccuo.mutation.done = true
+	return _node, nil
+}
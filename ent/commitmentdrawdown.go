@@ -0,0 +1,272 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/commitmentdrawdown"
+	"github.com/shopspring/decimal"
+)
+
+// CommitmentDrawdown is the model entity for the CommitmentDrawdown schema.
+type CommitmentDrawdown struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// ContractID holds the value of the "contract_id" field.
+	ContractID string `json:"contract_id,omitempty"`
+	// SubscriptionID holds the value of the "subscription_id" field.
+	SubscriptionID string `json:"subscription_id,omitempty"`
+	// InvoiceID holds the value of the "invoice_id" field.
+	InvoiceID string `json:"invoice_id,omitempty"`
+	// PeriodStart holds the value of the "period_start" field.
+	PeriodStart time.Time `json:"period_start,omitempty"`
+	// PeriodEnd holds the value of the "period_end" field.
+	PeriodEnd time.Time `json:"period_end,omitempty"`
+	// Usage charges of the invoice before the drawdown
+	UsageAmount decimal.Decimal `json:"usage_amount,omitempty"`
+	// Usage covered by the prepaid amount
+	DrawdownAmount decimal.Decimal `json:"drawdown_amount,omitempty"`
+	// Usage charged after the prepaid amount was exhausted, at the overage factor
+	OverageAmount decimal.Decimal `json:"overage_amount,omitempty"`
+	// BalanceAfter holds the value of the "balance_after" field.
+	BalanceAfter decimal.Decimal `json:"balance_after,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CommitmentDrawdown) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case commitmentdrawdown.FieldUsageAmount, commitmentdrawdown.FieldDrawdownAmount, commitmentdrawdown.FieldOverageAmount, commitmentdrawdown.FieldBalanceAfter:
+			values[i] = new(decimal.Decimal)
+		case commitmentdrawdown.FieldID, commitmentdrawdown.FieldTenantID, commitmentdrawdown.FieldStatus, commitmentdrawdown.FieldCreatedBy, commitmentdrawdown.FieldUpdatedBy, commitmentdrawdown.FieldEnvironmentID, commitmentdrawdown.FieldContractID, commitmentdrawdown.FieldSubscriptionID, commitmentdrawdown.FieldInvoiceID:
+			values[i] = new(sql.NullString)
+		case commitmentdrawdown.FieldCreatedAt, commitmentdrawdown.FieldUpdatedAt, commitmentdrawdown.FieldPeriodStart, commitmentdrawdown.FieldPeriodEnd:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CommitmentDrawdown fields.
+func (cd *CommitmentDrawdown) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case commitmentdrawdown.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				cd.ID = value.String
+			}
+		case commitmentdrawdown.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				cd.TenantID = value.String
+			}
+		case commitmentdrawdown.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				cd.Status = value.String
+			}
+		case commitmentdrawdown.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cd.CreatedAt = value.Time
+			}
+		case commitmentdrawdown.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				cd.UpdatedAt = value.Time
+			}
+		case commitmentdrawdown.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				cd.CreatedBy = value.String
+			}
+		case commitmentdrawdown.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				cd.UpdatedBy = value.String
+			}
+		case commitmentdrawdown.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				cd.EnvironmentID = value.String
+			}
+		case commitmentdrawdown.FieldContractID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field contract_id", values[i])
+			} else if value.Valid {
+				cd.ContractID = value.String
+			}
+		case commitmentdrawdown.FieldSubscriptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value.Valid {
+				cd.SubscriptionID = value.String
+			}
+		case commitmentdrawdown.FieldInvoiceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value.Valid {
+				cd.InvoiceID = value.String
+			}
+		case commitmentdrawdown.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				cd.PeriodStart = value.Time
+			}
+		case commitmentdrawdown.FieldPeriodEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_end", values[i])
+			} else if value.Valid {
+				cd.PeriodEnd = value.Time
+			}
+		case commitmentdrawdown.FieldUsageAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field usage_amount", values[i])
+			} else if value != nil {
+				cd.UsageAmount = *value
+			}
+		case commitmentdrawdown.FieldDrawdownAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field drawdown_amount", values[i])
+			} else if value != nil {
+				cd.DrawdownAmount = *value
+			}
+		case commitmentdrawdown.FieldOverageAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field overage_amount", values[i])
+			} else if value != nil {
+				cd.OverageAmount = *value
+			}
+		case commitmentdrawdown.FieldBalanceAfter:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field balance_after", values[i])
+			} else if value != nil {
+				cd.BalanceAfter = *value
+			}
+		default:
+			cd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CommitmentDrawdown.
+// This includes values selected through modifiers, order, etc.
+func (cd *CommitmentDrawdown) Value(name string) (ent.Value, error) {
+	return cd.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CommitmentDrawdown.
+// Note that you need to call CommitmentDrawdown.Unwrap() before calling this method if this CommitmentDrawdown
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cd *CommitmentDrawdown) Update() *CommitmentDrawdownUpdateOne {
+	return NewCommitmentDrawdownClient(cd.config).UpdateOne(cd)
+}
+
+// Unwrap unwraps the CommitmentDrawdown entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cd *CommitmentDrawdown) Unwrap() *CommitmentDrawdown {
+	_tx, ok := cd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CommitmentDrawdown is not a transactional entity")
+	}
+	cd.config.driver = _tx.drv
+	return cd
+}
+
+// String implements the fmt.Stringer.
+func (cd *CommitmentDrawdown) String() string {
+	var builder strings.Builder
+	builder.WriteString("CommitmentDrawdown(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cd.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(cd.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(cd.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cd.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(cd.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(cd.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(cd.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(cd.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("contract_id=")
+	builder.WriteString(cd.ContractID)
+	builder.WriteString(", ")
+	builder.WriteString("subscription_id=")
+	builder.WriteString(cd.SubscriptionID)
+	builder.WriteString(", ")
+	builder.WriteString("invoice_id=")
+	builder.WriteString(cd.InvoiceID)
+	builder.WriteString(", ")
+	builder.WriteString("period_start=")
+	builder.WriteString(cd.PeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("period_end=")
+	builder.WriteString(cd.PeriodEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("usage_amount=")
+	builder.WriteString(fmt.Sprintf("%v", cd.UsageAmount))
+	builder.WriteString(", ")
+	builder.WriteString("drawdown_amount=")
+	builder.WriteString(fmt.Sprintf("%v", cd.DrawdownAmount))
+	builder.WriteString(", ")
+	builder.WriteString("overage_amount=")
+	builder.WriteString(fmt.Sprintf("%v", cd.OverageAmount))
+	builder.WriteString(", ")
+	builder.WriteString("balance_after=")
+	builder.WriteString(fmt.Sprintf("%v", cd.BalanceAfter))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CommitmentDrawdowns is a parsable slice of CommitmentDrawdown.
+type CommitmentDrawdowns []*CommitmentDrawdown
@@ -0,0 +1,1031 @@
+// Code generated by ent, DO NOT EDIT.
+
+package commitmentdrawdown
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// ContractID applies equality check predicate on the "contract_id" field. It's identical to ContractIDEQ.
+func ContractID(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldContractID, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// InvoiceID applies equality check predicate on the "invoice_id" field. It's identical to InvoiceIDEQ.
+func InvoiceID(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodEnd applies equality check predicate on the "period_end" field. It's identical to PeriodEndEQ.
+func PeriodEnd(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// UsageAmount applies equality check predicate on the "usage_amount" field. It's identical to UsageAmountEQ.
+func UsageAmount(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldUsageAmount, v))
+}
+
+// DrawdownAmount applies equality check predicate on the "drawdown_amount" field. It's identical to DrawdownAmountEQ.
+func DrawdownAmount(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldDrawdownAmount, v))
+}
+
+// OverageAmount applies equality check predicate on the "overage_amount" field. It's identical to OverageAmountEQ.
+func OverageAmount(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldOverageAmount, v))
+}
+
+// BalanceAfter applies equality check predicate on the "balance_after" field. It's identical to BalanceAfterEQ.
+func BalanceAfter(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldBalanceAfter, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldContains(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldHasPrefix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldHasSuffix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIsNil applies the IsNil predicate on the "environment_id" field.
+func EnvironmentIDIsNil() predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIsNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDNotNil applies the NotNil predicate on the "environment_id" field.
+func EnvironmentIDNotNil() predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEqualFold(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldContainsFold(FieldEnvironmentID, v))
+}
+
+// ContractIDEQ applies the EQ predicate on the "contract_id" field.
+func ContractIDEQ(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldContractID, v))
+}
+
+// ContractIDNEQ applies the NEQ predicate on the "contract_id" field.
+func ContractIDNEQ(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNEQ(FieldContractID, v))
+}
+
+// ContractIDIn applies the In predicate on the "contract_id" field.
+func ContractIDIn(vs ...string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIn(FieldContractID, vs...))
+}
+
+// ContractIDNotIn applies the NotIn predicate on the "contract_id" field.
+func ContractIDNotIn(vs ...string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotIn(FieldContractID, vs...))
+}
+
+// ContractIDGT applies the GT predicate on the "contract_id" field.
+func ContractIDGT(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGT(FieldContractID, v))
+}
+
+// ContractIDGTE applies the GTE predicate on the "contract_id" field.
+func ContractIDGTE(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGTE(FieldContractID, v))
+}
+
+// ContractIDLT applies the LT predicate on the "contract_id" field.
+func ContractIDLT(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLT(FieldContractID, v))
+}
+
+// ContractIDLTE applies the LTE predicate on the "contract_id" field.
+func ContractIDLTE(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLTE(FieldContractID, v))
+}
+
+// ContractIDContains applies the Contains predicate on the "contract_id" field.
+func ContractIDContains(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldContains(FieldContractID, v))
+}
+
+// ContractIDHasPrefix applies the HasPrefix predicate on the "contract_id" field.
+func ContractIDHasPrefix(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldHasPrefix(FieldContractID, v))
+}
+
+// ContractIDHasSuffix applies the HasSuffix predicate on the "contract_id" field.
+func ContractIDHasSuffix(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldHasSuffix(FieldContractID, v))
+}
+
+// ContractIDEqualFold applies the EqualFold predicate on the "contract_id" field.
+func ContractIDEqualFold(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEqualFold(FieldContractID, v))
+}
+
+// ContractIDContainsFold applies the ContainsFold predicate on the "contract_id" field.
+func ContractIDContainsFold(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldContainsFold(FieldContractID, v))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDGT applies the GT predicate on the "subscription_id" field.
+func SubscriptionIDGT(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDGTE applies the GTE predicate on the "subscription_id" field.
+func SubscriptionIDGTE(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLT applies the LT predicate on the "subscription_id" field.
+func SubscriptionIDLT(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLTE applies the LTE predicate on the "subscription_id" field.
+func SubscriptionIDLTE(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContains applies the Contains predicate on the "subscription_id" field.
+func SubscriptionIDContains(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldContains(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasPrefix applies the HasPrefix predicate on the "subscription_id" field.
+func SubscriptionIDHasPrefix(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldHasPrefix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasSuffix applies the HasSuffix predicate on the "subscription_id" field.
+func SubscriptionIDHasSuffix(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldHasSuffix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDEqualFold applies the EqualFold predicate on the "subscription_id" field.
+func SubscriptionIDEqualFold(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEqualFold(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContainsFold applies the ContainsFold predicate on the "subscription_id" field.
+func SubscriptionIDContainsFold(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldContainsFold(FieldSubscriptionID, v))
+}
+
+// InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
+func InvoiceIDEQ(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDNEQ applies the NEQ predicate on the "invoice_id" field.
+func InvoiceIDNEQ(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDIn applies the In predicate on the "invoice_id" field.
+func InvoiceIDIn(vs ...string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDNotIn applies the NotIn predicate on the "invoice_id" field.
+func InvoiceIDNotIn(vs ...string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDGT applies the GT predicate on the "invoice_id" field.
+func InvoiceIDGT(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGT(FieldInvoiceID, v))
+}
+
+// InvoiceIDGTE applies the GTE predicate on the "invoice_id" field.
+func InvoiceIDGTE(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGTE(FieldInvoiceID, v))
+}
+
+// InvoiceIDLT applies the LT predicate on the "invoice_id" field.
+func InvoiceIDLT(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLT(FieldInvoiceID, v))
+}
+
+// InvoiceIDLTE applies the LTE predicate on the "invoice_id" field.
+func InvoiceIDLTE(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLTE(FieldInvoiceID, v))
+}
+
+// InvoiceIDContains applies the Contains predicate on the "invoice_id" field.
+func InvoiceIDContains(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldContains(FieldInvoiceID, v))
+}
+
+// InvoiceIDHasPrefix applies the HasPrefix predicate on the "invoice_id" field.
+func InvoiceIDHasPrefix(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldHasPrefix(FieldInvoiceID, v))
+}
+
+// InvoiceIDHasSuffix applies the HasSuffix predicate on the "invoice_id" field.
+func InvoiceIDHasSuffix(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldHasSuffix(FieldInvoiceID, v))
+}
+
+// InvoiceIDEqualFold applies the EqualFold predicate on the "invoice_id" field.
+func InvoiceIDEqualFold(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEqualFold(FieldInvoiceID, v))
+}
+
+// InvoiceIDContainsFold applies the ContainsFold predicate on the "invoice_id" field.
+func InvoiceIDContainsFold(v string) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldContainsFold(FieldInvoiceID, v))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// PeriodEndEQ applies the EQ predicate on the "period_end" field.
+func PeriodEndEQ(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndNEQ applies the NEQ predicate on the "period_end" field.
+func PeriodEndNEQ(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndIn applies the In predicate on the "period_end" field.
+func PeriodEndIn(vs ...time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndNotIn applies the NotIn predicate on the "period_end" field.
+func PeriodEndNotIn(vs ...time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndGT applies the GT predicate on the "period_end" field.
+func PeriodEndGT(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGT(FieldPeriodEnd, v))
+}
+
+// PeriodEndGTE applies the GTE predicate on the "period_end" field.
+func PeriodEndGTE(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndLT applies the LT predicate on the "period_end" field.
+func PeriodEndLT(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLT(FieldPeriodEnd, v))
+}
+
+// PeriodEndLTE applies the LTE predicate on the "period_end" field.
+func PeriodEndLTE(v time.Time) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLTE(FieldPeriodEnd, v))
+}
+
+// UsageAmountEQ applies the EQ predicate on the "usage_amount" field.
+func UsageAmountEQ(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldUsageAmount, v))
+}
+
+// UsageAmountNEQ applies the NEQ predicate on the "usage_amount" field.
+func UsageAmountNEQ(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNEQ(FieldUsageAmount, v))
+}
+
+// UsageAmountIn applies the In predicate on the "usage_amount" field.
+func UsageAmountIn(vs ...decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIn(FieldUsageAmount, vs...))
+}
+
+// UsageAmountNotIn applies the NotIn predicate on the "usage_amount" field.
+func UsageAmountNotIn(vs ...decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotIn(FieldUsageAmount, vs...))
+}
+
+// UsageAmountGT applies the GT predicate on the "usage_amount" field.
+func UsageAmountGT(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGT(FieldUsageAmount, v))
+}
+
+// UsageAmountGTE applies the GTE predicate on the "usage_amount" field.
+func UsageAmountGTE(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGTE(FieldUsageAmount, v))
+}
+
+// UsageAmountLT applies the LT predicate on the "usage_amount" field.
+func UsageAmountLT(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLT(FieldUsageAmount, v))
+}
+
+// UsageAmountLTE applies the LTE predicate on the "usage_amount" field.
+func UsageAmountLTE(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLTE(FieldUsageAmount, v))
+}
+
+// DrawdownAmountEQ applies the EQ predicate on the "drawdown_amount" field.
+func DrawdownAmountEQ(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldDrawdownAmount, v))
+}
+
+// DrawdownAmountNEQ applies the NEQ predicate on the "drawdown_amount" field.
+func DrawdownAmountNEQ(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNEQ(FieldDrawdownAmount, v))
+}
+
+// DrawdownAmountIn applies the In predicate on the "drawdown_amount" field.
+func DrawdownAmountIn(vs ...decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIn(FieldDrawdownAmount, vs...))
+}
+
+// DrawdownAmountNotIn applies the NotIn predicate on the "drawdown_amount" field.
+func DrawdownAmountNotIn(vs ...decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotIn(FieldDrawdownAmount, vs...))
+}
+
+// DrawdownAmountGT applies the GT predicate on the "drawdown_amount" field.
+func DrawdownAmountGT(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGT(FieldDrawdownAmount, v))
+}
+
+// DrawdownAmountGTE applies the GTE predicate on the "drawdown_amount" field.
+func DrawdownAmountGTE(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGTE(FieldDrawdownAmount, v))
+}
+
+// DrawdownAmountLT applies the LT predicate on the "drawdown_amount" field.
+func DrawdownAmountLT(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLT(FieldDrawdownAmount, v))
+}
+
+// DrawdownAmountLTE applies the LTE predicate on the "drawdown_amount" field.
+func DrawdownAmountLTE(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLTE(FieldDrawdownAmount, v))
+}
+
+// OverageAmountEQ applies the EQ predicate on the "overage_amount" field.
+func OverageAmountEQ(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldOverageAmount, v))
+}
+
+// OverageAmountNEQ applies the NEQ predicate on the "overage_amount" field.
+func OverageAmountNEQ(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNEQ(FieldOverageAmount, v))
+}
+
+// OverageAmountIn applies the In predicate on the "overage_amount" field.
+func OverageAmountIn(vs ...decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIn(FieldOverageAmount, vs...))
+}
+
+// OverageAmountNotIn applies the NotIn predicate on the "overage_amount" field.
+func OverageAmountNotIn(vs ...decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotIn(FieldOverageAmount, vs...))
+}
+
+// OverageAmountGT applies the GT predicate on the "overage_amount" field.
+func OverageAmountGT(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGT(FieldOverageAmount, v))
+}
+
+// OverageAmountGTE applies the GTE predicate on the "overage_amount" field.
+func OverageAmountGTE(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGTE(FieldOverageAmount, v))
+}
+
+// OverageAmountLT applies the LT predicate on the "overage_amount" field.
+func OverageAmountLT(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLT(FieldOverageAmount, v))
+}
+
+// OverageAmountLTE applies the LTE predicate on the "overage_amount" field.
+func OverageAmountLTE(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLTE(FieldOverageAmount, v))
+}
+
+// BalanceAfterEQ applies the EQ predicate on the "balance_after" field.
+func BalanceAfterEQ(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldEQ(FieldBalanceAfter, v))
+}
+
+// BalanceAfterNEQ applies the NEQ predicate on the "balance_after" field.
+func BalanceAfterNEQ(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNEQ(FieldBalanceAfter, v))
+}
+
+// BalanceAfterIn applies the In predicate on the "balance_after" field.
+func BalanceAfterIn(vs ...decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldIn(FieldBalanceAfter, vs...))
+}
+
+// BalanceAfterNotIn applies the NotIn predicate on the "balance_after" field.
+func BalanceAfterNotIn(vs ...decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldNotIn(FieldBalanceAfter, vs...))
+}
+
+// BalanceAfterGT applies the GT predicate on the "balance_after" field.
+func BalanceAfterGT(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGT(FieldBalanceAfter, v))
+}
+
+// BalanceAfterGTE applies the GTE predicate on the "balance_after" field.
+func BalanceAfterGTE(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldGTE(FieldBalanceAfter, v))
+}
+
+// BalanceAfterLT applies the LT predicate on the "balance_after" field.
+func BalanceAfterLT(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLT(FieldBalanceAfter, v))
+}
+
+// BalanceAfterLTE applies the LTE predicate on the "balance_after" field.
+func BalanceAfterLTE(v decimal.Decimal) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.FieldLTE(FieldBalanceAfter, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CommitmentDrawdown) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CommitmentDrawdown) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CommitmentDrawdown) predicate.CommitmentDrawdown {
+	return predicate.CommitmentDrawdown(sql.NotPredicates(p))
+}
@@ -0,0 +1,472 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/commitmentdrawdown"
+	"github.com/shopspring/decimal"
+)
+
+// CommitmentDrawdownCreate is the builder for creating a CommitmentDrawdown entity.
+type CommitmentDrawdownCreate struct {
+	config
+	mutation *CommitmentDrawdownMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (cdc *CommitmentDrawdownCreate) SetTenantID(s string) *CommitmentDrawdownCreate {
+	cdc.mutation.SetTenantID(s)
+	return cdc
+}
+
+// SetStatus sets the "status" field.
+func (cdc *CommitmentDrawdownCreate) SetStatus(s string) *CommitmentDrawdownCreate {
+	cdc.mutation.SetStatus(s)
+	return cdc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cdc *CommitmentDrawdownCreate) SetNillableStatus(s *string) *CommitmentDrawdownCreate {
+	if s != nil {
+		cdc.SetStatus(*s)
+	}
+	return cdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cdc *CommitmentDrawdownCreate) SetCreatedAt(t time.Time) *CommitmentDrawdownCreate {
+	cdc.mutation.SetCreatedAt(t)
+	return cdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cdc *CommitmentDrawdownCreate) SetNillableCreatedAt(t *time.Time) *CommitmentDrawdownCreate {
+	if t != nil {
+		cdc.SetCreatedAt(*t)
+	}
+	return cdc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cdc *CommitmentDrawdownCreate) SetUpdatedAt(t time.Time) *CommitmentDrawdownCreate {
+	cdc.mutation.SetUpdatedAt(t)
+	return cdc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cdc *CommitmentDrawdownCreate) SetNillableUpdatedAt(t *time.Time) *CommitmentDrawdownCreate {
+	if t != nil {
+		cdc.SetUpdatedAt(*t)
+	}
+	return cdc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (cdc *CommitmentDrawdownCreate) SetCreatedBy(s string) *CommitmentDrawdownCreate {
+	cdc.mutation.SetCreatedBy(s)
+	return cdc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (cdc *CommitmentDrawdownCreate) SetNillableCreatedBy(s *string) *CommitmentDrawdownCreate {
+	if s != nil {
+		cdc.SetCreatedBy(*s)
+	}
+	return cdc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (cdc *CommitmentDrawdownCreate) SetUpdatedBy(s string) *CommitmentDrawdownCreate {
+	cdc.mutation.SetUpdatedBy(s)
+	return cdc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (cdc *CommitmentDrawdownCreate) SetNillableUpdatedBy(s *string) *CommitmentDrawdownCreate {
+	if s != nil {
+		cdc.SetUpdatedBy(*s)
+	}
+	return cdc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (cdc *CommitmentDrawdownCreate) SetEnvironmentID(s string) *CommitmentDrawdownCreate {
+	cdc.mutation.SetEnvironmentID(s)
+	return cdc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (cdc *CommitmentDrawdownCreate) SetNillableEnvironmentID(s *string) *CommitmentDrawdownCreate {
+	if s != nil {
+		cdc.SetEnvironmentID(*s)
+	}
+	return cdc
+}
+
+// SetContractID sets the "contract_id" field.
+func (cdc *CommitmentDrawdownCreate) SetContractID(s string) *CommitmentDrawdownCreate {
+	cdc.mutation.SetContractID(s)
+	return cdc
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (cdc *CommitmentDrawdownCreate) SetSubscriptionID(s string) *CommitmentDrawdownCreate {
+	cdc.mutation.SetSubscriptionID(s)
+	return cdc
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (cdc *CommitmentDrawdownCreate) SetInvoiceID(s string) *CommitmentDrawdownCreate {
+	cdc.mutation.SetInvoiceID(s)
+	return cdc
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (cdc *CommitmentDrawdownCreate) SetPeriodStart(t time.Time) *CommitmentDrawdownCreate {
+	cdc.mutation.SetPeriodStart(t)
+	return cdc
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (cdc *CommitmentDrawdownCreate) SetPeriodEnd(t time.Time) *CommitmentDrawdownCreate {
+	cdc.mutation.SetPeriodEnd(t)
+	return cdc
+}
+
+// SetUsageAmount sets the "usage_amount" field.
+func (cdc *CommitmentDrawdownCreate) SetUsageAmount(d decimal.Decimal) *CommitmentDrawdownCreate {
+	cdc.mutation.SetUsageAmount(d)
+	return cdc
+}
+
+// SetDrawdownAmount sets the "drawdown_amount" field.
+func (cdc *CommitmentDrawdownCreate) SetDrawdownAmount(d decimal.Decimal) *CommitmentDrawdownCreate {
+	cdc.mutation.SetDrawdownAmount(d)
+	return cdc
+}
+
+// SetOverageAmount sets the "overage_amount" field.
+func (cdc *CommitmentDrawdownCreate) SetOverageAmount(d decimal.Decimal) *CommitmentDrawdownCreate {
+	cdc.mutation.SetOverageAmount(d)
+	return cdc
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (cdc *CommitmentDrawdownCreate) SetBalanceAfter(d decimal.Decimal) *CommitmentDrawdownCreate {
+	cdc.mutation.SetBalanceAfter(d)
+	return cdc
+}
+
+// SetID sets the "id" field.
+func (cdc *CommitmentDrawdownCreate) SetID(s string) *CommitmentDrawdownCreate {
+	cdc.mutation.SetID(s)
+	return cdc
+}
+
+// Mutation returns the CommitmentDrawdownMutation object of the builder.
+func (cdc *CommitmentDrawdownCreate) Mutation() *CommitmentDrawdownMutation {
+	return cdc.mutation
+}
+
+// Save creates the CommitmentDrawdown in the database.
+func (cdc *CommitmentDrawdownCreate) Save(ctx context.Context) (*CommitmentDrawdown, error) {
+	cdc.defaults()
+	return withHooks(ctx, cdc.sqlSave, cdc.mutation, cdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cdc *CommitmentDrawdownCreate) SaveX(ctx context.Context) *CommitmentDrawdown {
+	v, err := cdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cdc *CommitmentDrawdownCreate) Exec(ctx context.Context) error {
+	_, err := cdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdc *CommitmentDrawdownCreate) ExecX(ctx context.Context) {
+	if err := cdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cdc *CommitmentDrawdownCreate) defaults() {
+	if _, ok := cdc.mutation.Status(); !ok {
+		v := commitmentdrawdown.DefaultStatus
+		cdc.mutation.SetStatus(v)
+	}
+	if _, ok := cdc.mutation.CreatedAt(); !ok {
+		v := commitmentdrawdown.DefaultCreatedAt()
+		cdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cdc.mutation.UpdatedAt(); !ok {
+		v := commitmentdrawdown.DefaultUpdatedAt()
+		cdc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := cdc.mutation.EnvironmentID(); !ok {
+		v := commitmentdrawdown.DefaultEnvironmentID
+		cdc.mutation.SetEnvironmentID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cdc *CommitmentDrawdownCreate) check() error {
+	if _, ok := cdc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "CommitmentDrawdown.tenant_id"`)}
+	}
+	if v, ok := cdc.mutation.TenantID(); ok {
+		if err := commitmentdrawdown.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "CommitmentDrawdown.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := cdc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CommitmentDrawdown.status"`)}
+	}
+	if _, ok := cdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CommitmentDrawdown.created_at"`)}
+	}
+	if _, ok := cdc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CommitmentDrawdown.updated_at"`)}
+	}
+	if _, ok := cdc.mutation.ContractID(); !ok {
+		return &ValidationError{Name: "contract_id", err: errors.New(`ent: missing required field "CommitmentDrawdown.contract_id"`)}
+	}
+	if v, ok := cdc.mutation.ContractID(); ok {
+		if err := commitmentdrawdown.ContractIDValidator(v); err != nil {
+			return &ValidationError{Name: "contract_id", err: fmt.Errorf(`ent: validator failed for field "CommitmentDrawdown.contract_id": %w`, err)}
+		}
+	}
+	if _, ok := cdc.mutation.SubscriptionID(); !ok {
+		return &ValidationError{Name: "subscription_id", err: errors.New(`ent: missing required field "CommitmentDrawdown.subscription_id"`)}
+	}
+	if v, ok := cdc.mutation.SubscriptionID(); ok {
+		if err := commitmentdrawdown.SubscriptionIDValidator(v); err != nil {
+			return &ValidationError{Name: "subscription_id", err: fmt.Errorf(`ent: validator failed for field "CommitmentDrawdown.subscription_id": %w`, err)}
+		}
+	}
+	if _, ok := cdc.mutation.InvoiceID(); !ok {
+		return &ValidationError{Name: "invoice_id", err: errors.New(`ent: missing required field "CommitmentDrawdown.invoice_id"`)}
+	}
+	if v, ok := cdc.mutation.InvoiceID(); ok {
+		if err := commitmentdrawdown.InvoiceIDValidator(v); err != nil {
+			return &ValidationError{Name: "invoice_id", err: fmt.Errorf(`ent: validator failed for field "CommitmentDrawdown.invoice_id": %w`, err)}
+		}
+	}
+	if _, ok := cdc.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`ent: missing required field "CommitmentDrawdown.period_start"`)}
+	}
+	if _, ok := cdc.mutation.PeriodEnd(); !ok {
+		return &ValidationError{Name: "period_end", err: errors.New(`ent: missing required field "CommitmentDrawdown.period_end"`)}
+	}
+	if _, ok := cdc.mutation.UsageAmount(); !ok {
+		return &ValidationError{Name: "usage_amount", err: errors.New(`ent: missing required field "CommitmentDrawdown.usage_amount"`)}
+	}
+	if _, ok := cdc.mutation.DrawdownAmount(); !ok {
+		return &ValidationError{Name: "drawdown_amount", err: errors.New(`ent: missing required field "CommitmentDrawdown.drawdown_amount"`)}
+	}
+	if _, ok := cdc.mutation.OverageAmount(); !ok {
+		return &ValidationError{Name: "overage_amount", err: errors.New(`ent: missing required field "CommitmentDrawdown.overage_amount"`)}
+	}
+	if _, ok := cdc.mutation.BalanceAfter(); !ok {
+		return &ValidationError{Name: "balance_after", err: errors.New(`ent: missing required field "CommitmentDrawdown.balance_after"`)}
+	}
+	return nil
+}
+
+func (cdc *CommitmentDrawdownCreate) sqlSave(ctx context.Context) (*CommitmentDrawdown, error) {
+	if err := cdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CommitmentDrawdown.ID type: %T", _spec.ID.Value)
+		}
+	}
+	cdc.mutation.id = &_node.ID
+	cdc.mutation.done = true
+	return _node, nil
+}
+
+func (cdc *CommitmentDrawdownCreate) createSpec() (*CommitmentDrawdown, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CommitmentDrawdown{config: cdc.config}
+		_spec = sqlgraph.NewCreateSpec(commitmentdrawdown.Table, sqlgraph.NewFieldSpec(commitmentdrawdown.FieldID, field.TypeString))
+	)
+	if id, ok := cdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cdc.mutation.TenantID(); ok {
+		_spec.SetField(commitmentdrawdown.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := cdc.mutation.Status(); ok {
+		_spec.SetField(commitmentdrawdown.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := cdc.mutation.CreatedAt(); ok {
+		_spec.SetField(commitmentdrawdown.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cdc.mutation.UpdatedAt(); ok {
+		_spec.SetField(commitmentdrawdown.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := cdc.mutation.CreatedBy(); ok {
+		_spec.SetField(commitmentdrawdown.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := cdc.mutation.UpdatedBy(); ok {
+		_spec.SetField(commitmentdrawdown.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := cdc.mutation.EnvironmentID(); ok {
+		_spec.SetField(commitmentdrawdown.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := cdc.mutation.ContractID(); ok {
+		_spec.SetField(commitmentdrawdown.FieldContractID, field.TypeString, value)
+		_node.ContractID = value
+	}
+	if value, ok := cdc.mutation.SubscriptionID(); ok {
+		_spec.SetField(commitmentdrawdown.FieldSubscriptionID, field.TypeString, value)
+		_node.SubscriptionID = value
+	}
+	if value, ok := cdc.mutation.InvoiceID(); ok {
+		_spec.SetField(commitmentdrawdown.FieldInvoiceID, field.TypeString, value)
+		_node.InvoiceID = value
+	}
+	if value, ok := cdc.mutation.PeriodStart(); ok {
+		_spec.SetField(commitmentdrawdown.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := cdc.mutation.PeriodEnd(); ok {
+		_spec.SetField(commitmentdrawdown.FieldPeriodEnd, field.TypeTime, value)
+		_node.PeriodEnd = value
+	}
+	if value, ok := cdc.mutation.UsageAmount(); ok {
+		_spec.SetField(commitmentdrawdown.FieldUsageAmount, field.TypeOther, value)
+		_node.UsageAmount = value
+	}
+	if value, ok := cdc.mutation.DrawdownAmount(); ok {
+		_spec.SetField(commitmentdrawdown.FieldDrawdownAmount, field.TypeOther, value)
+		_node.DrawdownAmount = value
+	}
+	if value, ok := cdc.mutation.OverageAmount(); ok {
+		_spec.SetField(commitmentdrawdown.FieldOverageAmount, field.TypeOther, value)
+		_node.OverageAmount = value
+	}
+	if value, ok := cdc.mutation.BalanceAfter(); ok {
+		_spec.SetField(commitmentdrawdown.FieldBalanceAfter, field.TypeOther, value)
+		_node.BalanceAfter = value
+	}
+	return _node, _spec
+}
+
+// CommitmentDrawdownCreateBulk is the builder for creating many CommitmentDrawdown entities in bulk.
+type CommitmentDrawdownCreateBulk struct {
+	config
+	err      error
+	builders []*CommitmentDrawdownCreate
+}
+
+// Save creates the CommitmentDrawdown entities in the database.
+func (cdcb *CommitmentDrawdownCreateBulk) Save(ctx context.Context) ([]*CommitmentDrawdown, error) {
+	if cdcb.err != nil {
+		return nil, cdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cdcb.builders))
+	nodes := make([]*CommitmentDrawdown, len(cdcb.builders))
+	mutators := make([]Mutator, len(cdcb.builders))
+	for i := range cdcb.builders {
+		func(i int, root context.Context) {
+			builder := cdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommitmentDrawdownMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdcb *CommitmentDrawdownCreateBulk) SaveX(ctx context.Context) []*CommitmentDrawdown {
+	v, err := cdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cdcb *CommitmentDrawdownCreateBulk) Exec(ctx context.Context) error {
+	_, err := cdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdcb *CommitmentDrawdownCreateBulk) ExecX(ctx context.Context) {
+	if err := cdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
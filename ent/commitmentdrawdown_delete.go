@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/commitmentdrawdown"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// CommitmentDrawdownDelete is the builder for deleting a CommitmentDrawdown entity.
+type CommitmentDrawdownDelete struct {
+	config
+	hooks    []Hook
+	mutation *CommitmentDrawdownMutation
+}
+
+// Where appends a list predicates to the CommitmentDrawdownDelete builder.
+func (cdd *CommitmentDrawdownDelete) Where(ps ...predicate.CommitmentDrawdown) *CommitmentDrawdownDelete {
+	cdd.mutation.Where(ps...)
+	return cdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cdd *CommitmentDrawdownDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cdd.sqlExec, cdd.mutation, cdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdd *CommitmentDrawdownDelete) ExecX(ctx context.Context) int {
+	n, err := cdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cdd *CommitmentDrawdownDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(commitmentdrawdown.Table, sqlgraph.NewFieldSpec(commitmentdrawdown.FieldID, field.TypeString))
+	if ps := cdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cdd.mutation.done = true
+	return affected, err
+}
+
+// CommitmentDrawdownDeleteOne is the builder for deleting a single CommitmentDrawdown entity.
+type CommitmentDrawdownDeleteOne struct {
+	cdd *CommitmentDrawdownDelete
+}
+
+// Where appends a list predicates to the CommitmentDrawdownDelete builder.
+func (cddo *CommitmentDrawdownDeleteOne) Where(ps ...predicate.CommitmentDrawdown) *CommitmentDrawdownDeleteOne {
+	cddo.cdd.mutation.Where(ps...)
+	return cddo
+}
+
+// Exec executes the deletion query.
+func (cddo *CommitmentDrawdownDeleteOne) Exec(ctx context.Context) error {
+	n, err := cddo.cdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{commitmentdrawdown.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cddo *CommitmentDrawdownDeleteOne) ExecX(ctx context.Context) {
+	if err := cddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/commitmentdrawdown"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// CommitmentDrawdownQuery is the builder for querying CommitmentDrawdown entities.
+type CommitmentDrawdownQuery struct {
+	config
+	ctx        *QueryContext
+	order      []commitmentdrawdown.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CommitmentDrawdown
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CommitmentDrawdownQuery builder.
+func (cdq *CommitmentDrawdownQuery) Where(ps ...predicate.CommitmentDrawdown) *CommitmentDrawdownQuery {
+	cdq.predicates = append(cdq.predicates, ps...)
+	return cdq
+}
+
+// Limit the number of records to be returned by this query.
+func (cdq *CommitmentDrawdownQuery) Limit(limit int) *CommitmentDrawdownQuery {
+	cdq.ctx.Limit = &limit
+	return cdq
+}
+
+// Offset to start from.
+func (cdq *CommitmentDrawdownQuery) Offset(offset int) *CommitmentDrawdownQuery {
+	cdq.ctx.Offset = &offset
+	return cdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cdq *CommitmentDrawdownQuery) Unique(unique bool) *CommitmentDrawdownQuery {
+	cdq.ctx.Unique = &unique
+	return cdq
+}
+
+// Order specifies how the records should be ordered.
+func (cdq *CommitmentDrawdownQuery) Order(o ...commitmentdrawdown.OrderOption) *CommitmentDrawdownQuery {
+	cdq.order = append(cdq.order, o...)
+	return cdq
+}
+
+// First returns the first CommitmentDrawdown entity from the query.
+// Returns a *NotFoundError when no CommitmentDrawdown was found.
+func (cdq *CommitmentDrawdownQuery) First(ctx context.Context) (*CommitmentDrawdown, error) {
+	nodes, err := cdq.Limit(1).All(setContextOp(ctx, cdq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{commitmentdrawdown.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cdq *CommitmentDrawdownQuery) FirstX(ctx context.Context) *CommitmentDrawdown {
+	node, err := cdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CommitmentDrawdown ID from the query.
+// Returns a *NotFoundError when no CommitmentDrawdown ID was found.
+func (cdq *CommitmentDrawdownQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = cdq.Limit(1).IDs(setContextOp(ctx, cdq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{commitmentdrawdown.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cdq *CommitmentDrawdownQuery) FirstIDX(ctx context.Context) string {
+	id, err := cdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CommitmentDrawdown entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CommitmentDrawdown entity is found.
+// Returns a *NotFoundError when no CommitmentDrawdown entities are found.
+func (cdq *CommitmentDrawdownQuery) Only(ctx context.Context) (*CommitmentDrawdown, error) {
+	nodes, err := cdq.Limit(2).All(setContextOp(ctx, cdq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{commitmentdrawdown.Label}
+	default:
+		return nil, &NotSingularError{commitmentdrawdown.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cdq *CommitmentDrawdownQuery) OnlyX(ctx context.Context) *CommitmentDrawdown {
+	node, err := cdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CommitmentDrawdown ID in the query.
+// Returns a *NotSingularError when more than one CommitmentDrawdown ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cdq *CommitmentDrawdownQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = cdq.Limit(2).IDs(setContextOp(ctx, cdq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{commitmentdrawdown.Label}
+	default:
+		err = &NotSingularError{commitmentdrawdown.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cdq *CommitmentDrawdownQuery) OnlyIDX(ctx context.Context) string {
+	id, err := cdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CommitmentDrawdowns.
+func (cdq *CommitmentDrawdownQuery) All(ctx context.Context) ([]*CommitmentDrawdown, error) {
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryAll)
+	if err := cdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CommitmentDrawdown, *CommitmentDrawdownQuery]()
+	return withInterceptors[[]*CommitmentDrawdown](ctx, cdq, qr, cdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cdq *CommitmentDrawdownQuery) AllX(ctx context.Context) []*CommitmentDrawdown {
+	nodes, err := cdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CommitmentDrawdown IDs.
+func (cdq *CommitmentDrawdownQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if cdq.ctx.Unique == nil && cdq.path != nil {
+		cdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryIDs)
+	if err = cdq.Select(commitmentdrawdown.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cdq *CommitmentDrawdownQuery) IDsX(ctx context.Context) []string {
+	ids, err := cdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cdq *CommitmentDrawdownQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryCount)
+	if err := cdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cdq, querierCount[*CommitmentDrawdownQuery](), cdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cdq *CommitmentDrawdownQuery) CountX(ctx context.Context) int {
+	count, err := cdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cdq *CommitmentDrawdownQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryExist)
+	switch _, err := cdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cdq *CommitmentDrawdownQuery) ExistX(ctx context.Context) bool {
+	exist, err := cdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CommitmentDrawdownQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cdq *CommitmentDrawdownQuery) Clone() *CommitmentDrawdownQuery {
+	if cdq == nil {
+		return nil
+	}
+	return &CommitmentDrawdownQuery{
+		config:     cdq.config,
+		ctx:        cdq.ctx.Clone(),
+		order:      append([]commitmentdrawdown.OrderOption{}, cdq.order...),
+		inters:     append([]Interceptor{}, cdq.inters...),
+		predicates: append([]predicate.CommitmentDrawdown{}, cdq.predicates...),
+		// clone intermediate query.
+		sql:  cdq.sql.Clone(),
+		path: cdq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CommitmentDrawdown.Query().
+//		GroupBy(commitmentdrawdown.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cdq *CommitmentDrawdownQuery) GroupBy(field string, fields ...string) *CommitmentDrawdownGroupBy {
+	cdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CommitmentDrawdownGroupBy{build: cdq}
+	grbuild.flds = &cdq.ctx.Fields
+	grbuild.label = commitmentdrawdown.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.CommitmentDrawdown.Query().
+//		Select(commitmentdrawdown.FieldTenantID).
+//		Scan(ctx, &v)
+func (cdq *CommitmentDrawdownQuery) Select(fields ...string) *CommitmentDrawdownSelect {
+	cdq.ctx.Fields = append(cdq.ctx.Fields, fields...)
+	sbuild := &CommitmentDrawdownSelect{CommitmentDrawdownQuery: cdq}
+	sbuild.label = commitmentdrawdown.Label
+	sbuild.flds, sbuild.scan = &cdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CommitmentDrawdownSelect configured with the given aggregations.
+func (cdq *CommitmentDrawdownQuery) Aggregate(fns ...AggregateFunc) *CommitmentDrawdownSelect {
+	return cdq.Select().Aggregate(fns...)
+}
+
+func (cdq *CommitmentDrawdownQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cdq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cdq.ctx.Fields {
+		if !commitmentdrawdown.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cdq.path != nil {
+		prev, err := cdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cdq.sql = prev
+	}
+	return nil
+}
+
+func (cdq *CommitmentDrawdownQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CommitmentDrawdown, error) {
+	var (
+		nodes = []*CommitmentDrawdown{}
+		_spec = cdq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CommitmentDrawdown).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CommitmentDrawdown{config: cdq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (cdq *CommitmentDrawdownQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cdq.querySpec()
+	_spec.Node.Columns = cdq.ctx.Fields
+	if len(cdq.ctx.Fields) > 0 {
+		_spec.Unique = cdq.ctx.Unique != nil && *cdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cdq.driver, _spec)
+}
+
+func (cdq *CommitmentDrawdownQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(commitmentdrawdown.Table, commitmentdrawdown.Columns, sqlgraph.NewFieldSpec(commitmentdrawdown.FieldID, field.TypeString))
+	_spec.From = cdq.sql
+	if unique := cdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, commitmentdrawdown.FieldID)
+		for i := range fields {
+			if fields[i] != commitmentdrawdown.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cdq *CommitmentDrawdownQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cdq.driver.Dialect())
+	t1 := builder.Table(commitmentdrawdown.Table)
+	columns := cdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = commitmentdrawdown.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cdq.sql != nil {
+		selector = cdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cdq.ctx.Unique != nil && *cdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cdq.predicates {
+		p(selector)
+	}
+	for _, p := range cdq.order {
+		p(selector)
+	}
+	if offset := cdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CommitmentDrawdownGroupBy is the group-by builder for CommitmentDrawdown entities.
+type CommitmentDrawdownGroupBy struct {
+	selector
+	build *CommitmentDrawdownQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cdgb *CommitmentDrawdownGroupBy) Aggregate(fns ...AggregateFunc) *CommitmentDrawdownGroupBy {
+	cdgb.fns = append(cdgb.fns, fns...)
+	return cdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cdgb *CommitmentDrawdownGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cdgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CommitmentDrawdownQuery, *CommitmentDrawdownGroupBy](ctx, cdgb.build, cdgb, cdgb.build.inters, v)
+}
+
+func (cdgb *CommitmentDrawdownGroupBy) sqlScan(ctx context.Context, root *CommitmentDrawdownQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cdgb.fns))
+	for _, fn := range cdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cdgb.flds)+len(cdgb.fns))
+		for _, f := range *cdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CommitmentDrawdownSelect is the builder for selecting fields of CommitmentDrawdown entities.
+type CommitmentDrawdownSelect struct {
+	*CommitmentDrawdownQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cds *CommitmentDrawdownSelect) Aggregate(fns ...AggregateFunc) *CommitmentDrawdownSelect {
+	cds.fns = append(cds.fns, fns...)
+	return cds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cds *CommitmentDrawdownSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cds.ctx, ent.OpQuerySelect)
+	if err := cds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CommitmentDrawdownQuery, *CommitmentDrawdownSelect](ctx, cds.CommitmentDrawdownQuery, cds, cds.inters, v)
+}
+
+func (cds *CommitmentDrawdownSelect) sqlScan(ctx context.Context, root *CommitmentDrawdownQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cds.fns))
+	for _, fn := range cds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/commitmentdrawdown"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// CommitmentDrawdownUpdate is the builder for updating CommitmentDrawdown entities.
+type CommitmentDrawdownUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CommitmentDrawdownMutation
+}
+
+// Where appends a list predicates to the CommitmentDrawdownUpdate builder.
+func (cdu *CommitmentDrawdownUpdate) Where(ps ...predicate.CommitmentDrawdown) *CommitmentDrawdownUpdate {
+	cdu.mutation.Where(ps...)
+	return cdu
+}
+
+// SetStatus sets the "status" field.
+func (cdu *CommitmentDrawdownUpdate) SetStatus(s string) *CommitmentDrawdownUpdate {
+	cdu.mutation.SetStatus(s)
+	return cdu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cdu *CommitmentDrawdownUpdate) SetNillableStatus(s *string) *CommitmentDrawdownUpdate {
+	if s != nil {
+		cdu.SetStatus(*s)
+	}
+	return cdu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cdu *CommitmentDrawdownUpdate) SetUpdatedAt(t time.Time) *CommitmentDrawdownUpdate {
+	cdu.mutation.SetUpdatedAt(t)
+	return cdu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (cdu *CommitmentDrawdownUpdate) SetUpdatedBy(s string) *CommitmentDrawdownUpdate {
+	cdu.mutation.SetUpdatedBy(s)
+	return cdu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (cdu *CommitmentDrawdownUpdate) SetNillableUpdatedBy(s *string) *CommitmentDrawdownUpdate {
+	if s != nil {
+		cdu.SetUpdatedBy(*s)
+	}
+	return cdu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (cdu *CommitmentDrawdownUpdate) ClearUpdatedBy() *CommitmentDrawdownUpdate {
+	cdu.mutation.ClearUpdatedBy()
+	return cdu
+}
+
+// Mutation returns the CommitmentDrawdownMutation object of the builder.
+func (cdu *CommitmentDrawdownUpdate) Mutation() *CommitmentDrawdownMutation {
+	return cdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cdu *CommitmentDrawdownUpdate) Save(ctx context.Context) (int, error) {
+	cdu.defaults()
+	return withHooks(ctx, cdu.sqlSave, cdu.mutation, cdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdu *CommitmentDrawdownUpdate) SaveX(ctx context.Context) int {
+	affected, err := cdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cdu *CommitmentDrawdownUpdate) Exec(ctx context.Context) error {
+	_, err := cdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdu *CommitmentDrawdownUpdate) ExecX(ctx context.Context) {
+	if err := cdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cdu *CommitmentDrawdownUpdate) defaults() {
+	if _, ok := cdu.mutation.UpdatedAt(); !ok {
+		v := commitmentdrawdown.UpdateDefaultUpdatedAt()
+		cdu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (cdu *CommitmentDrawdownUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(commitmentdrawdown.Table, commitmentdrawdown.Columns, sqlgraph.NewFieldSpec(commitmentdrawdown.FieldID, field.TypeString))
+	if ps := cdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cdu.mutation.Status(); ok {
+		_spec.SetField(commitmentdrawdown.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := cdu.mutation.UpdatedAt(); ok {
+		_spec.SetField(commitmentdrawdown.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cdu.mutation.CreatedByCleared() {
+		_spec.ClearField(commitmentdrawdown.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := cdu.mutation.UpdatedBy(); ok {
+		_spec.SetField(commitmentdrawdown.FieldUpdatedBy, field.TypeString, value)
+	}
+	if cdu.mutation.UpdatedByCleared() {
+		_spec.ClearField(commitmentdrawdown.FieldUpdatedBy, field.TypeString)
+	}
+	if cdu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(commitmentdrawdown.FieldEnvironmentID, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commitmentdrawdown.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cdu.mutation.done = true
+	return n, nil
+}
+
+// CommitmentDrawdownUpdateOne is the builder for updating a single CommitmentDrawdown entity.
+type CommitmentDrawdownUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CommitmentDrawdownMutation
+}
+
+// SetStatus sets the "status" field.
+func (cduo *CommitmentDrawdownUpdateOne) SetStatus(s string) *CommitmentDrawdownUpdateOne {
+	cduo.mutation.SetStatus(s)
+	return cduo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cduo *CommitmentDrawdownUpdateOne) SetNillableStatus(s *string) *CommitmentDrawdownUpdateOne {
+	if s != nil {
+		cduo.SetStatus(*s)
+	}
+	return cduo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cduo *CommitmentDrawdownUpdateOne) SetUpdatedAt(t time.Time) *CommitmentDrawdownUpdateOne {
+	cduo.mutation.SetUpdatedAt(t)
+	return cduo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (cduo *CommitmentDrawdownUpdateOne) SetUpdatedBy(s string) *CommitmentDrawdownUpdateOne {
+	cduo.mutation.SetUpdatedBy(s)
+	return cduo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (cduo *CommitmentDrawdownUpdateOne) SetNillableUpdatedBy(s *string) *CommitmentDrawdownUpdateOne {
+	if s != nil {
+		cduo.SetUpdatedBy(*s)
+	}
+	return cduo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (cduo *CommitmentDrawdownUpdateOne) ClearUpdatedBy() *CommitmentDrawdownUpdateOne {
+	cduo.mutation.ClearUpdatedBy()
+	return cduo
+}
+
+// Mutation returns the CommitmentDrawdownMutation object of the builder.
+func (cduo *CommitmentDrawdownUpdateOne) Mutation() *CommitmentDrawdownMutation {
+	return cduo.mutation
+}
+
+// Where appends a list predicates to the CommitmentDrawdownUpdate builder.
+func (cduo *CommitmentDrawdownUpdateOne) Where(ps ...predicate.CommitmentDrawdown) *CommitmentDrawdownUpdateOne {
+	cduo.mutation.Where(ps...)
+	return cduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cduo *CommitmentDrawdownUpdateOne) Select(field string, fields ...string) *CommitmentDrawdownUpdateOne {
+	cduo.fields = append([]string{field}, fields...)
+	return cduo
+}
+
+// Save executes the query and returns the updated CommitmentDrawdown entity.
+func (cduo *CommitmentDrawdownUpdateOne) Save(ctx context.Context) (*CommitmentDrawdown, error) {
+	cduo.defaults()
+	return withHooks(ctx, cduo.sqlSave, cduo.mutation, cduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cduo *CommitmentDrawdownUpdateOne) SaveX(ctx context.Context) *CommitmentDrawdown {
+	node, err := cduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cduo *CommitmentDrawdownUpdateOne) Exec(ctx context.Context) error {
+	_, err := cduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cduo *CommitmentDrawdownUpdateOne) ExecX(ctx context.Context) {
+	if err := cduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cduo *CommitmentDrawdownUpdateOne) defaults() {
+	if _, ok := cduo.mutation.UpdatedAt(); !ok {
+		v := commitmentdrawdown.UpdateDefaultUpdatedAt()
+		cduo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (cduo *CommitmentDrawdownUpdateOne) sqlSave(ctx context.Context) (_node *CommitmentDrawdown, err error) {
+	_spec := sqlgraph.NewUpdateSpec(commitmentdrawdown.Table, commitmentdrawdown.Columns, sqlgraph.NewFieldSpec(commitmentdrawdown.FieldID, field.TypeString))
+	id, ok := cduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CommitmentDrawdown.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, commitmentdrawdown.FieldID)
+		for _, f := range fields {
+			if !commitmentdrawdown.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != commitmentdrawdown.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cduo.mutation.Status(); ok {
+		_spec.SetField(commitmentdrawdown.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := cduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(commitmentdrawdown.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cduo.mutation.CreatedByCleared() {
+		_spec.ClearField(commitmentdrawdown.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := cduo.mutation.UpdatedBy(); ok {
+		_spec.SetField(commitmentdrawdown.FieldUpdatedBy, field.TypeString, value)
+	}
+	if cduo.mutation.UpdatedByCleared() {
+		_spec.ClearField(commitmentdrawdown.FieldUpdatedBy, field.TypeString)
+	}
+	if cduo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(commitmentdrawdown.FieldEnvironmentID, field.TypeString)
+	}
+	_node = &CommitmentDrawdown{config: cduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commitmentdrawdown.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cduo.mutation.done = true
+	return _node, nil
+}
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/flexprice/flexprice/ent/coupon"
+	"github.com/flexprice/flexprice/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -43,8 +44,8 @@ type Coupon struct {
 	MaxRedemptions *int `json:"max_redemptions,omitempty"`
 	// Coupon total redemptions
 	TotalRedemptions int `json:"total_redemptions,omitempty"`
-	// Rule engine configuration for discount application
-	Rules map[string]interface{} `json:"rules,omitempty"`
+	// Targeting and stacking rules for discount application
+	Rules *types.CouponRules `json:"rules,omitempty"`
 	// Coupon amount off
 	AmountOff decimal.Decimal `json:"amount_off,omitempty"`
 	// Coupon percentage off
@@ -13,6 +13,7 @@ import (
 	"github.com/flexprice/flexprice/ent/coupon"
 	"github.com/flexprice/flexprice/ent/couponapplication"
 	"github.com/flexprice/flexprice/ent/couponassociation"
+	"github.com/flexprice/flexprice/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -176,8 +177,8 @@ func (cc *CouponCreate) SetNillableTotalRedemptions(i *int) *CouponCreate {
 }
 
 // SetRules sets the "rules" field.
-func (cc *CouponCreate) SetRules(m map[string]interface{}) *CouponCreate {
-	cc.mutation.SetRules(m)
+func (cc *CouponCreate) SetRules(tr *types.CouponRules) *CouponCreate {
+	cc.mutation.SetRules(tr)
 	return cc
 }
 
@@ -410,6 +411,11 @@ func (cc *CouponCreate) check() error {
 	if _, ok := cc.mutation.TotalRedemptions(); !ok {
 		return &ValidationError{Name: "total_redemptions", err: errors.New(`ent: missing required field "Coupon.total_redemptions"`)}
 	}
+	if v, ok := cc.mutation.Rules(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "rules", err: fmt.Errorf(`ent: validator failed for field "Coupon.rules": %w`, err)}
+		}
+	}
 	if _, ok := cc.mutation.GetType(); !ok {
 		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Coupon.type"`)}
 	}
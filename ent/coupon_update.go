@@ -15,6 +15,7 @@ import (
 	"github.com/flexprice/flexprice/ent/couponapplication"
 	"github.com/flexprice/flexprice/ent/couponassociation"
 	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -174,8 +175,8 @@ func (cu *CouponUpdate) AddTotalRedemptions(i int) *CouponUpdate {
 }
 
 // SetRules sets the "rules" field.
-func (cu *CouponUpdate) SetRules(m map[string]interface{}) *CouponUpdate {
-	cu.mutation.SetRules(m)
+func (cu *CouponUpdate) SetRules(tr *types.CouponRules) *CouponUpdate {
+	cu.mutation.SetRules(tr)
 	return cu
 }
 
@@ -432,6 +433,11 @@ func (cu *CouponUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Coupon.name": %w`, err)}
 		}
 	}
+	if v, ok := cu.mutation.Rules(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "rules", err: fmt.Errorf(`ent: validator failed for field "Coupon.rules": %w`, err)}
+		}
+	}
 	if v, ok := cu.mutation.GetType(); ok {
 		if err := coupon.TypeValidator(v); err != nil {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Coupon.type": %w`, err)}
@@ -803,8 +809,8 @@ func (cuo *CouponUpdateOne) AddTotalRedemptions(i int) *CouponUpdateOne {
 }
 
 // SetRules sets the "rules" field.
-func (cuo *CouponUpdateOne) SetRules(m map[string]interface{}) *CouponUpdateOne {
-	cuo.mutation.SetRules(m)
+func (cuo *CouponUpdateOne) SetRules(tr *types.CouponRules) *CouponUpdateOne {
+	cuo.mutation.SetRules(tr)
 	return cuo
 }
 
@@ -1074,6 +1080,11 @@ func (cuo *CouponUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Coupon.name": %w`, err)}
 		}
 	}
+	if v, ok := cuo.mutation.Rules(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "rules", err: fmt.Errorf(`ent: validator failed for field "Coupon.rules": %w`, err)}
+		}
+	}
 	if v, ok := cuo.mutation.GetType(); ok {
 		if err := coupon.TypeValidator(v); err != nil {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Coupon.type": %w`, err)}
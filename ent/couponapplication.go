@@ -65,6 +65,8 @@ type CouponApplication struct {
 	Metadata map[string]string `json:"metadata,omitempty"`
 	// Subscription ID this coupon application is associated with
 	SubscriptionID *string `json:"subscription_id,omitempty"`
+	// Promotion code the coupon was redeemed through
+	PromotionCodeID *string `json:"promotion_code_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CouponApplicationQuery when eager-loading is set.
 	Edges        CouponApplicationEdges `json:"edges"`
@@ -152,7 +154,7 @@ func (*CouponApplication) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case couponapplication.FieldOriginalPrice, couponapplication.FieldFinalPrice, couponapplication.FieldDiscountedAmount:
 			values[i] = new(decimal.Decimal)
-		case couponapplication.FieldID, couponapplication.FieldTenantID, couponapplication.FieldStatus, couponapplication.FieldCreatedBy, couponapplication.FieldUpdatedBy, couponapplication.FieldEnvironmentID, couponapplication.FieldCouponID, couponapplication.FieldCouponAssociationID, couponapplication.FieldInvoiceID, couponapplication.FieldInvoiceLineItemID, couponapplication.FieldDiscountType, couponapplication.FieldCurrency, couponapplication.FieldSubscriptionID:
+		case couponapplication.FieldID, couponapplication.FieldTenantID, couponapplication.FieldStatus, couponapplication.FieldCreatedBy, couponapplication.FieldUpdatedBy, couponapplication.FieldEnvironmentID, couponapplication.FieldCouponID, couponapplication.FieldCouponAssociationID, couponapplication.FieldInvoiceID, couponapplication.FieldInvoiceLineItemID, couponapplication.FieldDiscountType, couponapplication.FieldCurrency, couponapplication.FieldSubscriptionID, couponapplication.FieldPromotionCodeID:
 			values[i] = new(sql.NullString)
 		case couponapplication.FieldCreatedAt, couponapplication.FieldUpdatedAt, couponapplication.FieldAppliedAt:
 			values[i] = new(sql.NullTime)
@@ -312,6 +314,13 @@ func (ca *CouponApplication) assignValues(columns []string, values []any) error
 				ca.SubscriptionID = new(string)
 				*ca.SubscriptionID = value.String
 			}
+		case couponapplication.FieldPromotionCodeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field promotion_code_id", values[i])
+			} else if value.Valid {
+				ca.PromotionCodeID = new(string)
+				*ca.PromotionCodeID = value.String
+			}
 		default:
 			ca.selectValues.Set(columns[i], values[i])
 		}
@@ -445,6 +454,11 @@ func (ca *CouponApplication) String() string {
 		builder.WriteString("subscription_id=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := ca.PromotionCodeID; v != nil {
+		builder.WriteString("promotion_code_id=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -56,6 +56,8 @@ const (
 	FieldMetadata = "metadata"
 	// FieldSubscriptionID holds the string denoting the subscription_id field in the database.
 	FieldSubscriptionID = "subscription_id"
+	// FieldPromotionCodeID holds the string denoting the promotion_code_id field in the database.
+	FieldPromotionCodeID = "promotion_code_id"
 	// EdgeCoupon holds the string denoting the coupon edge name in mutations.
 	EdgeCoupon = "coupon"
 	// EdgeCouponAssociation holds the string denoting the coupon_association edge name in mutations.
@@ -127,6 +129,7 @@ var Columns = []string{
 	FieldCouponSnapshot,
 	FieldMetadata,
 	FieldSubscriptionID,
+	FieldPromotionCodeID,
 }
 
 var (
@@ -271,6 +274,11 @@ func BySubscriptionID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSubscriptionID, opts...).ToFunc()
 }
 
+// ByPromotionCodeID orders the results by the promotion_code_id field.
+func ByPromotionCodeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromotionCodeID, opts...).ToFunc()
+}
+
 // ByCouponField orders the results by coupon field.
 func ByCouponField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -161,6 +161,11 @@ func SubscriptionID(v string) predicate.CouponApplication {
 	return predicate.CouponApplication(sql.FieldEQ(FieldSubscriptionID, v))
 }
 
+// PromotionCodeID applies equality check predicate on the "promotion_code_id" field. It's identical to PromotionCodeIDEQ.
+func PromotionCodeID(v string) predicate.CouponApplication {
+	return predicate.CouponApplication(sql.FieldEQ(FieldPromotionCodeID, v))
+}
+
 // TenantIDEQ applies the EQ predicate on the "tenant_id" field.
 func TenantIDEQ(v string) predicate.CouponApplication {
 	return predicate.CouponApplication(sql.FieldEQ(FieldTenantID, v))
@@ -1321,6 +1326,81 @@ func SubscriptionIDContainsFold(v string) predicate.CouponApplication {
 	return predicate.CouponApplication(sql.FieldContainsFold(FieldSubscriptionID, v))
 }
 
+// PromotionCodeIDEQ applies the EQ predicate on the "promotion_code_id" field.
+func PromotionCodeIDEQ(v string) predicate.CouponApplication {
+	return predicate.CouponApplication(sql.FieldEQ(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDNEQ applies the NEQ predicate on the "promotion_code_id" field.
+func PromotionCodeIDNEQ(v string) predicate.CouponApplication {
+	return predicate.CouponApplication(sql.FieldNEQ(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDIn applies the In predicate on the "promotion_code_id" field.
+func PromotionCodeIDIn(vs ...string) predicate.CouponApplication {
+	return predicate.CouponApplication(sql.FieldIn(FieldPromotionCodeID, vs...))
+}
+
+// PromotionCodeIDNotIn applies the NotIn predicate on the "promotion_code_id" field.
+func PromotionCodeIDNotIn(vs ...string) predicate.CouponApplication {
+	return predicate.CouponApplication(sql.FieldNotIn(FieldPromotionCodeID, vs...))
+}
+
+// PromotionCodeIDGT applies the GT predicate on the "promotion_code_id" field.
+func PromotionCodeIDGT(v string) predicate.CouponApplication {
+	return predicate.CouponApplication(sql.FieldGT(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDGTE applies the GTE predicate on the "promotion_code_id" field.
+func PromotionCodeIDGTE(v string) predicate.CouponApplication {
+	return predicate.CouponApplication(sql.FieldGTE(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDLT applies the LT predicate on the "promotion_code_id" field.
+func PromotionCodeIDLT(v string) predicate.CouponApplication {
+	return predicate.CouponApplication(sql.FieldLT(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDLTE applies the LTE predicate on the "promotion_code_id" field.
+func PromotionCodeIDLTE(v string) predicate.CouponApplication {
+	return predicate.CouponApplication(sql.FieldLTE(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDContains applies the Contains predicate on the "promotion_code_id" field.
+func PromotionCodeIDContains(v string) predicate.CouponApplication {
+	return predicate.CouponApplication(sql.FieldContains(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDHasPrefix applies the HasPrefix predicate on the "promotion_code_id" field.
+func PromotionCodeIDHasPrefix(v string) predicate.CouponApplication {
+	return predicate.CouponApplication(sql.FieldHasPrefix(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDHasSuffix applies the HasSuffix predicate on the "promotion_code_id" field.
+func PromotionCodeIDHasSuffix(v string) predicate.CouponApplication {
+	return predicate.CouponApplication(sql.FieldHasSuffix(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDIsNil applies the IsNil predicate on the "promotion_code_id" field.
+func PromotionCodeIDIsNil() predicate.CouponApplication {
+	return predicate.CouponApplication(sql.FieldIsNull(FieldPromotionCodeID))
+}
+
+// PromotionCodeIDNotNil applies the NotNil predicate on the "promotion_code_id" field.
+func PromotionCodeIDNotNil() predicate.CouponApplication {
+	return predicate.CouponApplication(sql.FieldNotNull(FieldPromotionCodeID))
+}
+
+// PromotionCodeIDEqualFold applies the EqualFold predicate on the "promotion_code_id" field.
+func PromotionCodeIDEqualFold(v string) predicate.CouponApplication {
+	return predicate.CouponApplication(sql.FieldEqualFold(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDContainsFold applies the ContainsFold predicate on the "promotion_code_id" field.
+func PromotionCodeIDContainsFold(v string) predicate.CouponApplication {
+	return predicate.CouponApplication(sql.FieldContainsFold(FieldPromotionCodeID, v))
+}
+
 // HasCoupon applies the HasEdge predicate on the "coupon" edge.
 func HasCoupon() predicate.CouponApplication {
 	return predicate.CouponApplication(func(s *sql.Selector) {
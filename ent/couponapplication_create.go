@@ -248,6 +248,20 @@ func (cac *CouponApplicationCreate) SetNillableSubscriptionID(s *string) *Coupon
 	return cac
 }
 
+// SetPromotionCodeID sets the "promotion_code_id" field.
+func (cac *CouponApplicationCreate) SetPromotionCodeID(s string) *CouponApplicationCreate {
+	cac.mutation.SetPromotionCodeID(s)
+	return cac
+}
+
+// SetNillablePromotionCodeID sets the "promotion_code_id" field if the given value is not nil.
+func (cac *CouponApplicationCreate) SetNillablePromotionCodeID(s *string) *CouponApplicationCreate {
+	if s != nil {
+		cac.SetPromotionCodeID(*s)
+	}
+	return cac
+}
+
 // SetID sets the "id" field.
 func (cac *CouponApplicationCreate) SetID(s string) *CouponApplicationCreate {
 	cac.mutation.SetID(s)
@@ -510,6 +524,10 @@ func (cac *CouponApplicationCreate) createSpec() (*CouponApplication, *sqlgraph.
 		_spec.SetField(couponapplication.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := cac.mutation.PromotionCodeID(); ok {
+		_spec.SetField(couponapplication.FieldPromotionCodeID, field.TypeString, value)
+		_node.PromotionCodeID = &value
+	}
 	if nodes := cac.mutation.CouponIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
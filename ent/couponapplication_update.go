@@ -416,6 +416,9 @@ func (cau *CouponApplicationUpdate) sqlSave(ctx context.Context) (n int, err err
 	if cau.mutation.MetadataCleared() {
 		_spec.ClearField(couponapplication.FieldMetadata, field.TypeJSON)
 	}
+	if cau.mutation.PromotionCodeIDCleared() {
+		_spec.ClearField(couponapplication.FieldPromotionCodeID, field.TypeString)
+	}
 	if cau.mutation.CouponAssociationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -953,6 +956,9 @@ func (cauo *CouponApplicationUpdateOne) sqlSave(ctx context.Context) (_node *Cou
 	if cauo.mutation.MetadataCleared() {
 		_spec.ClearField(couponapplication.FieldMetadata, field.TypeJSON)
 	}
+	if cauo.mutation.PromotionCodeIDCleared() {
+		_spec.ClearField(couponapplication.FieldPromotionCodeID, field.TypeString)
+	}
 	if cauo.mutation.CouponAssociationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
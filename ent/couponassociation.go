@@ -47,6 +47,8 @@ type CouponAssociation struct {
 	StartDate time.Time `json:"start_date,omitempty"`
 	// EndDate holds the value of the "end_date" field.
 	EndDate *time.Time `json:"end_date,omitempty"`
+	// Promotion code redeemed to create this association
+	PromotionCodeID *string `json:"promotion_code_id,omitempty"`
 	// Additional metadata for coupon association
 	Metadata map[string]string `json:"metadata,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -119,7 +121,7 @@ func (*CouponAssociation) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case couponassociation.FieldMetadata:
 			values[i] = new([]byte)
-		case couponassociation.FieldID, couponassociation.FieldTenantID, couponassociation.FieldStatus, couponassociation.FieldCreatedBy, couponassociation.FieldUpdatedBy, couponassociation.FieldEnvironmentID, couponassociation.FieldCouponID, couponassociation.FieldSubscriptionID, couponassociation.FieldSubscriptionLineItemID, couponassociation.FieldSubscriptionPhaseID:
+		case couponassociation.FieldID, couponassociation.FieldTenantID, couponassociation.FieldStatus, couponassociation.FieldCreatedBy, couponassociation.FieldUpdatedBy, couponassociation.FieldEnvironmentID, couponassociation.FieldCouponID, couponassociation.FieldSubscriptionID, couponassociation.FieldSubscriptionLineItemID, couponassociation.FieldSubscriptionPhaseID, couponassociation.FieldPromotionCodeID:
 			values[i] = new(sql.NullString)
 		case couponassociation.FieldCreatedAt, couponassociation.FieldUpdatedAt, couponassociation.FieldStartDate, couponassociation.FieldEndDate:
 			values[i] = new(sql.NullTime)
@@ -225,6 +227,13 @@ func (ca *CouponAssociation) assignValues(columns []string, values []any) error
 				ca.EndDate = new(time.Time)
 				*ca.EndDate = value.Time
 			}
+		case couponassociation.FieldPromotionCodeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field promotion_code_id", values[i])
+			} else if value.Valid {
+				ca.PromotionCodeID = new(string)
+				*ca.PromotionCodeID = value.String
+			}
 		case couponassociation.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -334,6 +343,11 @@ func (ca *CouponAssociation) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := ca.PromotionCodeID; v != nil {
+		builder.WriteString("promotion_code_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", ca.Metadata))
 	builder.WriteByte(')')
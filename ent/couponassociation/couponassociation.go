@@ -40,6 +40,8 @@ const (
 	FieldStartDate = "start_date"
 	// FieldEndDate holds the string denoting the end_date field in the database.
 	FieldEndDate = "end_date"
+	// FieldPromotionCodeID holds the string denoting the promotion_code_id field in the database.
+	FieldPromotionCodeID = "promotion_code_id"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
 	// EdgeCoupon holds the string denoting the coupon edge name in mutations.
@@ -96,6 +98,7 @@ var Columns = []string{
 	FieldSubscriptionPhaseID,
 	FieldStartDate,
 	FieldEndDate,
+	FieldPromotionCodeID,
 	FieldMetadata,
 }
 
@@ -209,6 +212,11 @@ func ByEndDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEndDate, opts...).ToFunc()
 }
 
+// ByPromotionCodeID orders the results by the promotion_code_id field.
+func ByPromotionCodeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromotionCodeID, opts...).ToFunc()
+}
+
 // ByCouponField orders the results by coupon field.
 func ByCouponField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
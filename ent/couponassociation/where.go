@@ -130,6 +130,11 @@ func EndDate(v time.Time) predicate.CouponAssociation {
 	return predicate.CouponAssociation(sql.FieldEQ(FieldEndDate, v))
 }
 
+// PromotionCodeID applies equality check predicate on the "promotion_code_id" field. It's identical to PromotionCodeIDEQ.
+func PromotionCodeID(v string) predicate.CouponAssociation {
+	return predicate.CouponAssociation(sql.FieldEQ(FieldPromotionCodeID, v))
+}
+
 // TenantIDEQ applies the EQ predicate on the "tenant_id" field.
 func TenantIDEQ(v string) predicate.CouponAssociation {
 	return predicate.CouponAssociation(sql.FieldEQ(FieldTenantID, v))
@@ -935,6 +940,81 @@ func EndDateNotNil() predicate.CouponAssociation {
 	return predicate.CouponAssociation(sql.FieldNotNull(FieldEndDate))
 }
 
+// PromotionCodeIDEQ applies the EQ predicate on the "promotion_code_id" field.
+func PromotionCodeIDEQ(v string) predicate.CouponAssociation {
+	return predicate.CouponAssociation(sql.FieldEQ(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDNEQ applies the NEQ predicate on the "promotion_code_id" field.
+func PromotionCodeIDNEQ(v string) predicate.CouponAssociation {
+	return predicate.CouponAssociation(sql.FieldNEQ(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDIn applies the In predicate on the "promotion_code_id" field.
+func PromotionCodeIDIn(vs ...string) predicate.CouponAssociation {
+	return predicate.CouponAssociation(sql.FieldIn(FieldPromotionCodeID, vs...))
+}
+
+// PromotionCodeIDNotIn applies the NotIn predicate on the "promotion_code_id" field.
+func PromotionCodeIDNotIn(vs ...string) predicate.CouponAssociation {
+	return predicate.CouponAssociation(sql.FieldNotIn(FieldPromotionCodeID, vs...))
+}
+
+// PromotionCodeIDGT applies the GT predicate on the "promotion_code_id" field.
+func PromotionCodeIDGT(v string) predicate.CouponAssociation {
+	return predicate.CouponAssociation(sql.FieldGT(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDGTE applies the GTE predicate on the "promotion_code_id" field.
+func PromotionCodeIDGTE(v string) predicate.CouponAssociation {
+	return predicate.CouponAssociation(sql.FieldGTE(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDLT applies the LT predicate on the "promotion_code_id" field.
+func PromotionCodeIDLT(v string) predicate.CouponAssociation {
+	return predicate.CouponAssociation(sql.FieldLT(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDLTE applies the LTE predicate on the "promotion_code_id" field.
+func PromotionCodeIDLTE(v string) predicate.CouponAssociation {
+	return predicate.CouponAssociation(sql.FieldLTE(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDContains applies the Contains predicate on the "promotion_code_id" field.
+func PromotionCodeIDContains(v string) predicate.CouponAssociation {
+	return predicate.CouponAssociation(sql.FieldContains(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDHasPrefix applies the HasPrefix predicate on the "promotion_code_id" field.
+func PromotionCodeIDHasPrefix(v string) predicate.CouponAssociation {
+	return predicate.CouponAssociation(sql.FieldHasPrefix(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDHasSuffix applies the HasSuffix predicate on the "promotion_code_id" field.
+func PromotionCodeIDHasSuffix(v string) predicate.CouponAssociation {
+	return predicate.CouponAssociation(sql.FieldHasSuffix(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDIsNil applies the IsNil predicate on the "promotion_code_id" field.
+func PromotionCodeIDIsNil() predicate.CouponAssociation {
+	return predicate.CouponAssociation(sql.FieldIsNull(FieldPromotionCodeID))
+}
+
+// PromotionCodeIDNotNil applies the NotNil predicate on the "promotion_code_id" field.
+func PromotionCodeIDNotNil() predicate.CouponAssociation {
+	return predicate.CouponAssociation(sql.FieldNotNull(FieldPromotionCodeID))
+}
+
+// PromotionCodeIDEqualFold applies the EqualFold predicate on the "promotion_code_id" field.
+func PromotionCodeIDEqualFold(v string) predicate.CouponAssociation {
+	return predicate.CouponAssociation(sql.FieldEqualFold(FieldPromotionCodeID, v))
+}
+
+// PromotionCodeIDContainsFold applies the ContainsFold predicate on the "promotion_code_id" field.
+func PromotionCodeIDContainsFold(v string) predicate.CouponAssociation {
+	return predicate.CouponAssociation(sql.FieldContainsFold(FieldPromotionCodeID, v))
+}
+
 // MetadataIsNil applies the IsNil predicate on the "metadata" field.
 func MetadataIsNil() predicate.CouponAssociation {
 	return predicate.CouponAssociation(sql.FieldIsNull(FieldMetadata))
@@ -182,6 +182,20 @@ func (cac *CouponAssociationCreate) SetNillableEndDate(t *time.Time) *CouponAsso
 	return cac
 }
 
+// SetPromotionCodeID sets the "promotion_code_id" field.
+func (cac *CouponAssociationCreate) SetPromotionCodeID(s string) *CouponAssociationCreate {
+	cac.mutation.SetPromotionCodeID(s)
+	return cac
+}
+
+// SetNillablePromotionCodeID sets the "promotion_code_id" field if the given value is not nil.
+func (cac *CouponAssociationCreate) SetNillablePromotionCodeID(s *string) *CouponAssociationCreate {
+	if s != nil {
+		cac.SetPromotionCodeID(*s)
+	}
+	return cac
+}
+
 // SetMetadata sets the "metadata" field.
 func (cac *CouponAssociationCreate) SetMetadata(m map[string]string) *CouponAssociationCreate {
 	cac.mutation.SetMetadata(m)
@@ -400,6 +414,10 @@ func (cac *CouponAssociationCreate) createSpec() (*CouponAssociation, *sqlgraph.
 		_spec.SetField(couponassociation.FieldEndDate, field.TypeTime, value)
 		_node.EndDate = &value
 	}
+	if value, ok := cac.mutation.PromotionCodeID(); ok {
+		_spec.SetField(couponassociation.FieldPromotionCodeID, field.TypeString, value)
+		_node.PromotionCodeID = &value
+	}
 	if value, ok := cac.mutation.Metadata(); ok {
 		_spec.SetField(couponassociation.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -283,6 +283,9 @@ func (cau *CouponAssociationUpdate) sqlSave(ctx context.Context) (n int, err err
 	if cau.mutation.EndDateCleared() {
 		_spec.ClearField(couponassociation.FieldEndDate, field.TypeTime)
 	}
+	if cau.mutation.PromotionCodeIDCleared() {
+		_spec.ClearField(couponassociation.FieldPromotionCodeID, field.TypeString)
+	}
 	if value, ok := cau.mutation.Metadata(); ok {
 		_spec.SetField(couponassociation.FieldMetadata, field.TypeJSON, value)
 	}
@@ -666,6 +669,9 @@ func (cauo *CouponAssociationUpdateOne) sqlSave(ctx context.Context) (_node *Cou
 	if cauo.mutation.EndDateCleared() {
 		_spec.ClearField(couponassociation.FieldEndDate, field.TypeTime)
 	}
+	if cauo.mutation.PromotionCodeIDCleared() {
+		_spec.ClearField(couponassociation.FieldPromotionCodeID, field.TypeString)
+	}
 	if value, ok := cauo.mutation.Metadata(); ok {
 		_spec.SetField(couponassociation.FieldMetadata, field.TypeJSON, value)
 	}
@@ -64,6 +64,8 @@ type CreditGrant struct {
 	ExpirationDurationUnit *types.CreditGrantExpiryDurationUnit `json:"expiration_duration_unit,omitempty"`
 	// Priority holds the value of the "priority" field.
 	Priority *int `json:"priority,omitempty"`
+	// RolloverConfig holds the value of the "rollover_config" field.
+	RolloverConfig *types.CreditGrantRolloverConfig `json:"rollover_config,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]string `json:"metadata,omitempty"`
 	// StartDate holds the value of the "start_date" field.
@@ -118,7 +120,7 @@ func (*CreditGrant) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case creditgrant.FieldConversionRate, creditgrant.FieldTopupConversionRate:
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
-		case creditgrant.FieldMetadata:
+		case creditgrant.FieldRolloverConfig, creditgrant.FieldMetadata:
 			values[i] = new([]byte)
 		case creditgrant.FieldCredits:
 			values[i] = new(decimal.Decimal)
@@ -284,6 +286,14 @@ func (cg *CreditGrant) assignValues(columns []string, values []any) error {
 				cg.Priority = new(int)
 				*cg.Priority = int(value.Int64)
 			}
+		case creditgrant.FieldRolloverConfig:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rollover_config", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cg.RolloverConfig); err != nil {
+					return fmt.Errorf("unmarshal field rollover_config: %w", err)
+				}
+			}
 		case creditgrant.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -440,6 +450,9 @@ func (cg *CreditGrant) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("rollover_config=")
+	builder.WriteString(fmt.Sprintf("%v", cg.RolloverConfig))
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", cg.Metadata))
 	builder.WriteString(", ")
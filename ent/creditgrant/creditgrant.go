@@ -57,6 +57,8 @@ const (
 	FieldExpirationDurationUnit = "expiration_duration_unit"
 	// FieldPriority holds the string denoting the priority field in the database.
 	FieldPriority = "priority"
+	// FieldRolloverConfig holds the string denoting the rollover_config field in the database.
+	FieldRolloverConfig = "rollover_config"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
 	// FieldStartDate holds the string denoting the start_date field in the database.
@@ -111,6 +113,7 @@ var Columns = []string{
 	FieldExpirationDuration,
 	FieldExpirationDurationUnit,
 	FieldPriority,
+	FieldRolloverConfig,
 	FieldMetadata,
 	FieldStartDate,
 	FieldEndDate,
@@ -1572,6 +1572,16 @@ func PriorityNotNil() predicate.CreditGrant {
 	return predicate.CreditGrant(sql.FieldNotNull(FieldPriority))
 }
 
+// RolloverConfigIsNil applies the IsNil predicate on the "rollover_config" field.
+func RolloverConfigIsNil() predicate.CreditGrant {
+	return predicate.CreditGrant(sql.FieldIsNull(FieldRolloverConfig))
+}
+
+// RolloverConfigNotNil applies the NotNil predicate on the "rollover_config" field.
+func RolloverConfigNotNil() predicate.CreditGrant {
+	return predicate.CreditGrant(sql.FieldNotNull(FieldRolloverConfig))
+}
+
 // MetadataIsNil applies the IsNil predicate on the "metadata" field.
 func MetadataIsNil() predicate.CreditGrant {
 	return predicate.CreditGrant(sql.FieldIsNull(FieldMetadata))
@@ -278,6 +278,12 @@ func (cgc *CreditGrantCreate) SetNillablePriority(i *int) *CreditGrantCreate {
 	return cgc
 }
 
+// SetRolloverConfig sets the "rollover_config" field.
+func (cgc *CreditGrantCreate) SetRolloverConfig(tgrc *types.CreditGrantRolloverConfig) *CreditGrantCreate {
+	cgc.mutation.SetRolloverConfig(tgrc)
+	return cgc
+}
+
 // SetMetadata sets the "metadata" field.
 func (cgc *CreditGrantCreate) SetMetadata(m map[string]string) *CreditGrantCreate {
 	cgc.mutation.SetMetadata(m)
@@ -467,6 +473,11 @@ func (cgc *CreditGrantCreate) check() error {
 			return &ValidationError{Name: "expiration_duration_unit", err: fmt.Errorf(`ent: validator failed for field "CreditGrant.expiration_duration_unit": %w`, err)}
 		}
 	}
+	if v, ok := cgc.mutation.RolloverConfig(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "rollover_config", err: fmt.Errorf(`ent: validator failed for field "CreditGrant.rollover_config": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -578,6 +589,10 @@ func (cgc *CreditGrantCreate) createSpec() (*CreditGrant, *sqlgraph.CreateSpec)
 		_spec.SetField(creditgrant.FieldPriority, field.TypeInt, value)
 		_node.Priority = &value
 	}
+	if value, ok := cgc.mutation.RolloverConfig(); ok {
+		_spec.SetField(creditgrant.FieldRolloverConfig, field.TypeJSON, value)
+		_node.RolloverConfig = value
+	}
 	if value, ok := cgc.mutation.Metadata(); ok {
 		_spec.SetField(creditgrant.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
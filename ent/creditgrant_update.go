@@ -139,6 +139,18 @@ func (cgu *CreditGrantUpdate) ClearSubscriptionID() *CreditGrantUpdate {
 	return cgu
 }
 
+// SetRolloverConfig sets the "rollover_config" field.
+func (cgu *CreditGrantUpdate) SetRolloverConfig(tgrc *types.CreditGrantRolloverConfig) *CreditGrantUpdate {
+	cgu.mutation.SetRolloverConfig(tgrc)
+	return cgu
+}
+
+// ClearRolloverConfig clears the value of the "rollover_config" field.
+func (cgu *CreditGrantUpdate) ClearRolloverConfig() *CreditGrantUpdate {
+	cgu.mutation.ClearRolloverConfig()
+	return cgu
+}
+
 // SetMetadata sets the "metadata" field.
 func (cgu *CreditGrantUpdate) SetMetadata(m map[string]string) *CreditGrantUpdate {
 	cgu.mutation.SetMetadata(m)
@@ -246,6 +258,11 @@ func (cgu *CreditGrantUpdate) check() error {
 			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "CreditGrant.scope": %w`, err)}
 		}
 	}
+	if v, ok := cgu.mutation.RolloverConfig(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "rollover_config", err: fmt.Errorf(`ent: validator failed for field "CreditGrant.rollover_config": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -306,6 +323,12 @@ func (cgu *CreditGrantUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cgu.mutation.PriorityCleared() {
 		_spec.ClearField(creditgrant.FieldPriority, field.TypeInt)
 	}
+	if value, ok := cgu.mutation.RolloverConfig(); ok {
+		_spec.SetField(creditgrant.FieldRolloverConfig, field.TypeJSON, value)
+	}
+	if cgu.mutation.RolloverConfigCleared() {
+		_spec.ClearField(creditgrant.FieldRolloverConfig, field.TypeJSON)
+	}
 	if value, ok := cgu.mutation.Metadata(); ok {
 		_spec.SetField(creditgrant.FieldMetadata, field.TypeJSON, value)
 	}
@@ -510,6 +533,18 @@ func (cguo *CreditGrantUpdateOne) ClearSubscriptionID() *CreditGrantUpdateOne {
 	return cguo
 }
 
+// SetRolloverConfig sets the "rollover_config" field.
+func (cguo *CreditGrantUpdateOne) SetRolloverConfig(tgrc *types.CreditGrantRolloverConfig) *CreditGrantUpdateOne {
+	cguo.mutation.SetRolloverConfig(tgrc)
+	return cguo
+}
+
+// ClearRolloverConfig clears the value of the "rollover_config" field.
+func (cguo *CreditGrantUpdateOne) ClearRolloverConfig() *CreditGrantUpdateOne {
+	cguo.mutation.ClearRolloverConfig()
+	return cguo
+}
+
 // SetMetadata sets the "metadata" field.
 func (cguo *CreditGrantUpdateOne) SetMetadata(m map[string]string) *CreditGrantUpdateOne {
 	cguo.mutation.SetMetadata(m)
@@ -630,6 +665,11 @@ func (cguo *CreditGrantUpdateOne) check() error {
 			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "CreditGrant.scope": %w`, err)}
 		}
 	}
+	if v, ok := cguo.mutation.RolloverConfig(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "rollover_config", err: fmt.Errorf(`ent: validator failed for field "CreditGrant.rollover_config": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -707,6 +747,12 @@ func (cguo *CreditGrantUpdateOne) sqlSave(ctx context.Context) (_node *CreditGra
 	if cguo.mutation.PriorityCleared() {
 		_spec.ClearField(creditgrant.FieldPriority, field.TypeInt)
 	}
+	if value, ok := cguo.mutation.RolloverConfig(); ok {
+		_spec.SetField(creditgrant.FieldRolloverConfig, field.TypeJSON, value)
+	}
+	if cguo.mutation.RolloverConfigCleared() {
+		_spec.ClearField(creditgrant.FieldRolloverConfig, field.TypeJSON)
+	}
 	if value, ok := cguo.mutation.Metadata(); ok {
 		_spec.SetField(creditgrant.FieldMetadata, field.TypeJSON, value)
 	}
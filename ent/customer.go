@@ -52,7 +52,13 @@ type Customer struct {
 	AddressPostalCode string `json:"address_postal_code,omitempty"`
 	// AddressCountry holds the value of the "address_country" field.
 	AddressCountry string `json:"address_country,omitempty"`
-	selectValues   sql.SelectValues
+	// Billing currency used when a subscription does not set one
+	Currency string `json:"currency,omitempty"`
+	// ParentCustomerID holds the value of the "parent_customer_id" field.
+	ParentCustomerID *string `json:"parent_customer_id,omitempty"`
+	// InvoiceToParent holds the value of the "invoice_to_parent" field.
+	InvoiceToParent bool `json:"invoice_to_parent,omitempty"`
+	selectValues    sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -62,7 +68,9 @@ func (*Customer) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case customer.FieldMetadata:
 			values[i] = new([]byte)
-		case customer.FieldID, customer.FieldTenantID, customer.FieldStatus, customer.FieldCreatedBy, customer.FieldUpdatedBy, customer.FieldEnvironmentID, customer.FieldExternalID, customer.FieldName, customer.FieldEmail, customer.FieldAddressLine1, customer.FieldAddressLine2, customer.FieldAddressCity, customer.FieldAddressState, customer.FieldAddressPostalCode, customer.FieldAddressCountry:
+		case customer.FieldInvoiceToParent:
+			values[i] = new(sql.NullBool)
+		case customer.FieldID, customer.FieldTenantID, customer.FieldStatus, customer.FieldCreatedBy, customer.FieldUpdatedBy, customer.FieldEnvironmentID, customer.FieldExternalID, customer.FieldName, customer.FieldEmail, customer.FieldAddressLine1, customer.FieldAddressLine2, customer.FieldAddressCity, customer.FieldAddressState, customer.FieldAddressPostalCode, customer.FieldAddressCountry, customer.FieldCurrency, customer.FieldParentCustomerID:
 			values[i] = new(sql.NullString)
 		case customer.FieldCreatedAt, customer.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -191,6 +199,25 @@ func (c *Customer) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.AddressCountry = value.String
 			}
+		case customer.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				c.Currency = value.String
+			}
+		case customer.FieldParentCustomerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_customer_id", values[i])
+			} else if value.Valid {
+				c.ParentCustomerID = new(string)
+				*c.ParentCustomerID = value.String
+			}
+		case customer.FieldInvoiceToParent:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_to_parent", values[i])
+			} else if value.Valid {
+				c.InvoiceToParent = value.Bool
+			}
 		default:
 			c.selectValues.Set(columns[i], values[i])
 		}
@@ -277,6 +304,17 @@ func (c *Customer) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("address_country=")
 	builder.WriteString(c.AddressCountry)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(c.Currency)
+	builder.WriteString(", ")
+	if v := c.ParentCustomerID; v != nil {
+		builder.WriteString("parent_customer_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("invoice_to_parent=")
+	builder.WriteString(fmt.Sprintf("%v", c.InvoiceToParent))
 	builder.WriteByte(')')
 	return builder.String()
 }
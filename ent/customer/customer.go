@@ -47,6 +47,12 @@ const (
 	FieldAddressPostalCode = "address_postal_code"
 	// FieldAddressCountry holds the string denoting the address_country field in the database.
 	FieldAddressCountry = "address_country"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldParentCustomerID holds the string denoting the parent_customer_id field in the database.
+	FieldParentCustomerID = "parent_customer_id"
+	// FieldInvoiceToParent holds the string denoting the invoice_to_parent field in the database.
+	FieldInvoiceToParent = "invoice_to_parent"
 	// Table holds the table name of the customer in the database.
 	Table = "customers"
 )
@@ -71,6 +77,9 @@ var Columns = []string{
 	FieldAddressState,
 	FieldAddressPostalCode,
 	FieldAddressCountry,
+	FieldCurrency,
+	FieldParentCustomerID,
+	FieldInvoiceToParent,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -100,6 +109,8 @@ var (
 	ExternalIDValidator func(string) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultInvoiceToParent holds the default value on creation for the "invoice_to_parent" field.
+	DefaultInvoiceToParent bool
 )
 
 // OrderOption defines the ordering options for the Customer queries.
@@ -189,3 +200,18 @@ func ByAddressPostalCode(opts ...sql.OrderTermOption) OrderOption {
 func ByAddressCountry(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAddressCountry, opts...).ToFunc()
 }
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByParentCustomerID orders the results by the parent_customer_id field.
+func ByParentCustomerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentCustomerID, opts...).ToFunc()
+}
+
+// ByInvoiceToParent orders the results by the invoice_to_parent field.
+func ByInvoiceToParent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceToParent, opts...).ToFunc()
+}
@@ -144,6 +144,21 @@ func AddressCountry(v string) predicate.Customer {
 	return predicate.Customer(sql.FieldEQ(FieldAddressCountry, v))
 }
 
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldCurrency, v))
+}
+
+// ParentCustomerID applies equality check predicate on the "parent_customer_id" field. It's identical to ParentCustomerIDEQ.
+func ParentCustomerID(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldParentCustomerID, v))
+}
+
+// InvoiceToParent applies equality check predicate on the "invoice_to_parent" field. It's identical to InvoiceToParentEQ.
+func InvoiceToParent(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldInvoiceToParent, v))
+}
+
 // TenantIDEQ applies the EQ predicate on the "tenant_id" field.
 func TenantIDEQ(v string) predicate.Customer {
 	return predicate.Customer(sql.FieldEQ(FieldTenantID, v))
@@ -1244,6 +1259,166 @@ func AddressCountryContainsFold(v string) predicate.Customer {
 	return predicate.Customer(sql.FieldContainsFold(FieldAddressCountry, v))
 }
 
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyIsNil applies the IsNil predicate on the "currency" field.
+func CurrencyIsNil() predicate.Customer {
+	return predicate.Customer(sql.FieldIsNull(FieldCurrency))
+}
+
+// CurrencyNotNil applies the NotNil predicate on the "currency" field.
+func CurrencyNotNil() predicate.Customer {
+	return predicate.Customer(sql.FieldNotNull(FieldCurrency))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// ParentCustomerIDEQ applies the EQ predicate on the "parent_customer_id" field.
+func ParentCustomerIDEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldParentCustomerID, v))
+}
+
+// ParentCustomerIDNEQ applies the NEQ predicate on the "parent_customer_id" field.
+func ParentCustomerIDNEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldParentCustomerID, v))
+}
+
+// ParentCustomerIDIn applies the In predicate on the "parent_customer_id" field.
+func ParentCustomerIDIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldParentCustomerID, vs...))
+}
+
+// ParentCustomerIDNotIn applies the NotIn predicate on the "parent_customer_id" field.
+func ParentCustomerIDNotIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldParentCustomerID, vs...))
+}
+
+// ParentCustomerIDGT applies the GT predicate on the "parent_customer_id" field.
+func ParentCustomerIDGT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldParentCustomerID, v))
+}
+
+// ParentCustomerIDGTE applies the GTE predicate on the "parent_customer_id" field.
+func ParentCustomerIDGTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldParentCustomerID, v))
+}
+
+// ParentCustomerIDLT applies the LT predicate on the "parent_customer_id" field.
+func ParentCustomerIDLT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldParentCustomerID, v))
+}
+
+// ParentCustomerIDLTE applies the LTE predicate on the "parent_customer_id" field.
+func ParentCustomerIDLTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldParentCustomerID, v))
+}
+
+// ParentCustomerIDContains applies the Contains predicate on the "parent_customer_id" field.
+func ParentCustomerIDContains(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContains(FieldParentCustomerID, v))
+}
+
+// ParentCustomerIDHasPrefix applies the HasPrefix predicate on the "parent_customer_id" field.
+func ParentCustomerIDHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasPrefix(FieldParentCustomerID, v))
+}
+
+// ParentCustomerIDHasSuffix applies the HasSuffix predicate on the "parent_customer_id" field.
+func ParentCustomerIDHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasSuffix(FieldParentCustomerID, v))
+}
+
+// ParentCustomerIDIsNil applies the IsNil predicate on the "parent_customer_id" field.
+func ParentCustomerIDIsNil() predicate.Customer {
+	return predicate.Customer(sql.FieldIsNull(FieldParentCustomerID))
+}
+
+// ParentCustomerIDNotNil applies the NotNil predicate on the "parent_customer_id" field.
+func ParentCustomerIDNotNil() predicate.Customer {
+	return predicate.Customer(sql.FieldNotNull(FieldParentCustomerID))
+}
+
+// ParentCustomerIDEqualFold applies the EqualFold predicate on the "parent_customer_id" field.
+func ParentCustomerIDEqualFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEqualFold(FieldParentCustomerID, v))
+}
+
+// ParentCustomerIDContainsFold applies the ContainsFold predicate on the "parent_customer_id" field.
+func ParentCustomerIDContainsFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContainsFold(FieldParentCustomerID, v))
+}
+
+// InvoiceToParentEQ applies the EQ predicate on the "invoice_to_parent" field.
+func InvoiceToParentEQ(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldInvoiceToParent, v))
+}
+
+// InvoiceToParentNEQ applies the NEQ predicate on the "invoice_to_parent" field.
+func InvoiceToParentNEQ(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldInvoiceToParent, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Customer) predicate.Customer {
 	return predicate.Customer(sql.AndPredicates(predicates...))
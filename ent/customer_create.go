@@ -226,6 +226,48 @@ func (cc *CustomerCreate) SetNillableAddressCountry(s *string) *CustomerCreate {
 	return cc
 }
 
+// SetCurrency sets the "currency" field.
+func (cc *CustomerCreate) SetCurrency(s string) *CustomerCreate {
+	cc.mutation.SetCurrency(s)
+	return cc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableCurrency(s *string) *CustomerCreate {
+	if s != nil {
+		cc.SetCurrency(*s)
+	}
+	return cc
+}
+
+// SetParentCustomerID sets the "parent_customer_id" field.
+func (cc *CustomerCreate) SetParentCustomerID(s string) *CustomerCreate {
+	cc.mutation.SetParentCustomerID(s)
+	return cc
+}
+
+// SetNillableParentCustomerID sets the "parent_customer_id" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableParentCustomerID(s *string) *CustomerCreate {
+	if s != nil {
+		cc.SetParentCustomerID(*s)
+	}
+	return cc
+}
+
+// SetInvoiceToParent sets the "invoice_to_parent" field.
+func (cc *CustomerCreate) SetInvoiceToParent(b bool) *CustomerCreate {
+	cc.mutation.SetInvoiceToParent(b)
+	return cc
+}
+
+// SetNillableInvoiceToParent sets the "invoice_to_parent" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableInvoiceToParent(b *bool) *CustomerCreate {
+	if b != nil {
+		cc.SetInvoiceToParent(*b)
+	}
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *CustomerCreate) SetID(s string) *CustomerCreate {
 	cc.mutation.SetID(s)
@@ -283,6 +325,10 @@ func (cc *CustomerCreate) defaults() {
 		v := customer.DefaultEnvironmentID
 		cc.mutation.SetEnvironmentID(v)
 	}
+	if _, ok := cc.mutation.InvoiceToParent(); !ok {
+		v := customer.DefaultInvoiceToParent
+		cc.mutation.SetInvoiceToParent(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -320,6 +366,9 @@ func (cc *CustomerCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Customer.name": %w`, err)}
 		}
 	}
+	if _, ok := cc.mutation.InvoiceToParent(); !ok {
+		return &ValidationError{Name: "invoice_to_parent", err: errors.New(`ent: missing required field "Customer.invoice_to_parent"`)}
+	}
 	return nil
 }
 
@@ -423,6 +472,18 @@ func (cc *CustomerCreate) createSpec() (*Customer, *sqlgraph.CreateSpec) {
 		_spec.SetField(customer.FieldAddressCountry, field.TypeString, value)
 		_node.AddressCountry = value
 	}
+	if value, ok := cc.mutation.Currency(); ok {
+		_spec.SetField(customer.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := cc.mutation.ParentCustomerID(); ok {
+		_spec.SetField(customer.FieldParentCustomerID, field.TypeString, value)
+		_node.ParentCustomerID = &value
+	}
+	if value, ok := cc.mutation.InvoiceToParent(); ok {
+		_spec.SetField(customer.FieldInvoiceToParent, field.TypeBool, value)
+		_node.InvoiceToParent = value
+	}
 	return _node, _spec
 }
 
@@ -248,6 +248,60 @@ func (cu *CustomerUpdate) ClearAddressCountry() *CustomerUpdate {
 	return cu
 }
 
+// SetCurrency sets the "currency" field.
+func (cu *CustomerUpdate) SetCurrency(s string) *CustomerUpdate {
+	cu.mutation.SetCurrency(s)
+	return cu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableCurrency(s *string) *CustomerUpdate {
+	if s != nil {
+		cu.SetCurrency(*s)
+	}
+	return cu
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (cu *CustomerUpdate) ClearCurrency() *CustomerUpdate {
+	cu.mutation.ClearCurrency()
+	return cu
+}
+
+// SetParentCustomerID sets the "parent_customer_id" field.
+func (cu *CustomerUpdate) SetParentCustomerID(s string) *CustomerUpdate {
+	cu.mutation.SetParentCustomerID(s)
+	return cu
+}
+
+// SetNillableParentCustomerID sets the "parent_customer_id" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableParentCustomerID(s *string) *CustomerUpdate {
+	if s != nil {
+		cu.SetParentCustomerID(*s)
+	}
+	return cu
+}
+
+// ClearParentCustomerID clears the value of the "parent_customer_id" field.
+func (cu *CustomerUpdate) ClearParentCustomerID() *CustomerUpdate {
+	cu.mutation.ClearParentCustomerID()
+	return cu
+}
+
+// SetInvoiceToParent sets the "invoice_to_parent" field.
+func (cu *CustomerUpdate) SetInvoiceToParent(b bool) *CustomerUpdate {
+	cu.mutation.SetInvoiceToParent(b)
+	return cu
+}
+
+// SetNillableInvoiceToParent sets the "invoice_to_parent" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableInvoiceToParent(b *bool) *CustomerUpdate {
+	if b != nil {
+		cu.SetInvoiceToParent(*b)
+	}
+	return cu
+}
+
 // Mutation returns the CustomerMutation object of the builder.
 func (cu *CustomerUpdate) Mutation() *CustomerMutation {
 	return cu.mutation
@@ -388,6 +442,21 @@ func (cu *CustomerUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.AddressCountryCleared() {
 		_spec.ClearField(customer.FieldAddressCountry, field.TypeString)
 	}
+	if value, ok := cu.mutation.Currency(); ok {
+		_spec.SetField(customer.FieldCurrency, field.TypeString, value)
+	}
+	if cu.mutation.CurrencyCleared() {
+		_spec.ClearField(customer.FieldCurrency, field.TypeString)
+	}
+	if value, ok := cu.mutation.ParentCustomerID(); ok {
+		_spec.SetField(customer.FieldParentCustomerID, field.TypeString, value)
+	}
+	if cu.mutation.ParentCustomerIDCleared() {
+		_spec.ClearField(customer.FieldParentCustomerID, field.TypeString)
+	}
+	if value, ok := cu.mutation.InvoiceToParent(); ok {
+		_spec.SetField(customer.FieldInvoiceToParent, field.TypeBool, value)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{customer.Label}
@@ -628,6 +697,60 @@ func (cuo *CustomerUpdateOne) ClearAddressCountry() *CustomerUpdateOne {
 	return cuo
 }
 
+// SetCurrency sets the "currency" field.
+func (cuo *CustomerUpdateOne) SetCurrency(s string) *CustomerUpdateOne {
+	cuo.mutation.SetCurrency(s)
+	return cuo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableCurrency(s *string) *CustomerUpdateOne {
+	if s != nil {
+		cuo.SetCurrency(*s)
+	}
+	return cuo
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (cuo *CustomerUpdateOne) ClearCurrency() *CustomerUpdateOne {
+	cuo.mutation.ClearCurrency()
+	return cuo
+}
+
+// SetParentCustomerID sets the "parent_customer_id" field.
+func (cuo *CustomerUpdateOne) SetParentCustomerID(s string) *CustomerUpdateOne {
+	cuo.mutation.SetParentCustomerID(s)
+	return cuo
+}
+
+// SetNillableParentCustomerID sets the "parent_customer_id" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableParentCustomerID(s *string) *CustomerUpdateOne {
+	if s != nil {
+		cuo.SetParentCustomerID(*s)
+	}
+	return cuo
+}
+
+// ClearParentCustomerID clears the value of the "parent_customer_id" field.
+func (cuo *CustomerUpdateOne) ClearParentCustomerID() *CustomerUpdateOne {
+	cuo.mutation.ClearParentCustomerID()
+	return cuo
+}
+
+// SetInvoiceToParent sets the "invoice_to_parent" field.
+func (cuo *CustomerUpdateOne) SetInvoiceToParent(b bool) *CustomerUpdateOne {
+	cuo.mutation.SetInvoiceToParent(b)
+	return cuo
+}
+
+// SetNillableInvoiceToParent sets the "invoice_to_parent" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableInvoiceToParent(b *bool) *CustomerUpdateOne {
+	if b != nil {
+		cuo.SetInvoiceToParent(*b)
+	}
+	return cuo
+}
+
 // Mutation returns the CustomerMutation object of the builder.
 func (cuo *CustomerUpdateOne) Mutation() *CustomerMutation {
 	return cuo.mutation
@@ -798,6 +921,21 @@ func (cuo *CustomerUpdateOne) sqlSave(ctx context.Context) (_node *Customer, err
 	if cuo.mutation.AddressCountryCleared() {
 		_spec.ClearField(customer.FieldAddressCountry, field.TypeString)
 	}
+	if value, ok := cuo.mutation.Currency(); ok {
+		_spec.SetField(customer.FieldCurrency, field.TypeString, value)
+	}
+	if cuo.mutation.CurrencyCleared() {
+		_spec.ClearField(customer.FieldCurrency, field.TypeString)
+	}
+	if value, ok := cuo.mutation.ParentCustomerID(); ok {
+		_spec.SetField(customer.FieldParentCustomerID, field.TypeString, value)
+	}
+	if cuo.mutation.ParentCustomerIDCleared() {
+		_spec.ClearField(customer.FieldParentCustomerID, field.TypeString)
+	}
+	if value, ok := cuo.mutation.InvoiceToParent(); ok {
+		_spec.SetField(customer.FieldInvoiceToParent, field.TypeBool, value)
+	}
 	_node = &Customer{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -15,8 +15,11 @@ import (
 	"github.com/flexprice/flexprice/ent/addon"
 	"github.com/flexprice/flexprice/ent/addonassociation"
 	"github.com/flexprice/flexprice/ent/alertlogs"
+	"github.com/flexprice/flexprice/ent/auditlog"
 	"github.com/flexprice/flexprice/ent/auth"
 	"github.com/flexprice/flexprice/ent/billingsequence"
+	"github.com/flexprice/flexprice/ent/commitmentcontract"
+	"github.com/flexprice/flexprice/ent/commitmentdrawdown"
 	"github.com/flexprice/flexprice/ent/connection"
 	"github.com/flexprice/flexprice/ent/costsheet"
 	"github.com/flexprice/flexprice/ent/coupon"
@@ -31,7 +34,9 @@ import (
 	"github.com/flexprice/flexprice/ent/entityintegrationmapping"
 	"github.com/flexprice/flexprice/ent/environment"
 	"github.com/flexprice/flexprice/ent/feature"
+	"github.com/flexprice/flexprice/ent/fxrate"
 	"github.com/flexprice/flexprice/ent/group"
+	"github.com/flexprice/flexprice/ent/invitation"
 	"github.com/flexprice/flexprice/ent/invoice"
 	"github.com/flexprice/flexprice/ent/invoicelineitem"
 	"github.com/flexprice/flexprice/ent/invoicesequence"
@@ -40,24 +45,32 @@ import (
 	"github.com/flexprice/flexprice/ent/paymentattempt"
 	"github.com/flexprice/flexprice/ent/plan"
 	"github.com/flexprice/flexprice/ent/price"
+	"github.com/flexprice/flexprice/ent/pricebook"
 	"github.com/flexprice/flexprice/ent/priceunit"
+	"github.com/flexprice/flexprice/ent/promotioncode"
+	"github.com/flexprice/flexprice/ent/rbacrole"
 	"github.com/flexprice/flexprice/ent/scheduledtask"
 	"github.com/flexprice/flexprice/ent/secret"
 	"github.com/flexprice/flexprice/ent/settings"
+	"github.com/flexprice/flexprice/ent/ssoconnection"
 	"github.com/flexprice/flexprice/ent/subscription"
 	"github.com/flexprice/flexprice/ent/subscriptionlineitem"
 	"github.com/flexprice/flexprice/ent/subscriptionpause"
 	"github.com/flexprice/flexprice/ent/subscriptionphase"
 	"github.com/flexprice/flexprice/ent/subscriptionschedule"
+	"github.com/flexprice/flexprice/ent/subscriptionseatchange"
 	"github.com/flexprice/flexprice/ent/systemevent"
 	"github.com/flexprice/flexprice/ent/task"
 	"github.com/flexprice/flexprice/ent/taxapplied"
 	"github.com/flexprice/flexprice/ent/taxassociation"
 	"github.com/flexprice/flexprice/ent/taxrate"
 	"github.com/flexprice/flexprice/ent/tenant"
+	"github.com/flexprice/flexprice/ent/tenantmembership"
 	"github.com/flexprice/flexprice/ent/user"
 	"github.com/flexprice/flexprice/ent/wallet"
 	"github.com/flexprice/flexprice/ent/wallettransaction"
+	"github.com/flexprice/flexprice/ent/webhookdeliveryattempt"
+	"github.com/flexprice/flexprice/ent/webhookendpoint"
 	"github.com/flexprice/flexprice/ent/workflowexecution"
 )
 
@@ -122,8 +135,11 @@ func checkColumn(table, column string) error {
 			addon.Table:                    addon.ValidColumn,
 			addonassociation.Table:         addonassociation.ValidColumn,
 			alertlogs.Table:                alertlogs.ValidColumn,
+			auditlog.Table:                 auditlog.ValidColumn,
 			auth.Table:                     auth.ValidColumn,
 			billingsequence.Table:          billingsequence.ValidColumn,
+			commitmentcontract.Table:       commitmentcontract.ValidColumn,
+			commitmentdrawdown.Table:       commitmentdrawdown.ValidColumn,
 			connection.Table:               connection.ValidColumn,
 			costsheet.Table:                costsheet.ValidColumn,
 			coupon.Table:                   coupon.ValidColumn,
@@ -137,8 +153,10 @@ func checkColumn(table, column string) error {
 			entitlement.Table:              entitlement.ValidColumn,
 			entityintegrationmapping.Table: entityintegrationmapping.ValidColumn,
 			environment.Table:              environment.ValidColumn,
+			fxrate.Table:                   fxrate.ValidColumn,
 			feature.Table:                  feature.ValidColumn,
 			group.Table:                    group.ValidColumn,
+			invitation.Table:               invitation.ValidColumn,
 			invoice.Table:                  invoice.ValidColumn,
 			invoicelineitem.Table:          invoicelineitem.ValidColumn,
 			invoicesequence.Table:          invoicesequence.ValidColumn,
@@ -147,7 +165,11 @@ func checkColumn(table, column string) error {
 			paymentattempt.Table:           paymentattempt.ValidColumn,
 			plan.Table:                     plan.ValidColumn,
 			price.Table:                    price.ValidColumn,
+			pricebook.Table:                pricebook.ValidColumn,
 			priceunit.Table:                priceunit.ValidColumn,
+			promotioncode.Table:            promotioncode.ValidColumn,
+			rbacrole.Table:                 rbacrole.ValidColumn,
+			ssoconnection.Table:            ssoconnection.ValidColumn,
 			scheduledtask.Table:            scheduledtask.ValidColumn,
 			secret.Table:                   secret.ValidColumn,
 			settings.Table:                 settings.ValidColumn,
@@ -156,15 +178,19 @@ func checkColumn(table, column string) error {
 			subscriptionpause.Table:        subscriptionpause.ValidColumn,
 			subscriptionphase.Table:        subscriptionphase.ValidColumn,
 			subscriptionschedule.Table:     subscriptionschedule.ValidColumn,
+			subscriptionseatchange.Table:   subscriptionseatchange.ValidColumn,
 			systemevent.Table:              systemevent.ValidColumn,
 			task.Table:                     task.ValidColumn,
 			taxapplied.Table:               taxapplied.ValidColumn,
 			taxassociation.Table:           taxassociation.ValidColumn,
 			taxrate.Table:                  taxrate.ValidColumn,
 			tenant.Table:                   tenant.ValidColumn,
+			tenantmembership.Table:         tenantmembership.ValidColumn,
 			user.Table:                     user.ValidColumn,
 			wallet.Table:                   wallet.ValidColumn,
 			wallettransaction.Table:        wallettransaction.ValidColumn,
+			webhookdeliveryattempt.Table:   webhookdeliveryattempt.ValidColumn,
+			webhookendpoint.Table:          webhookendpoint.ValidColumn,
 			workflowexecution.Table:        workflowexecution.ValidColumn,
 		})
 	})
@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/fxrate"
+	"github.com/shopspring/decimal"
+)
+
+// FXRate is the model entity for the FXRate schema.
+type FXRate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// Currency converted from
+	BaseCurrency string `json:"base_currency,omitempty"`
+	// Currency converted to
+	QuoteCurrency string `json:"quote_currency,omitempty"`
+	// Units of quote currency per unit of base currency
+	Rate decimal.Decimal `json:"rate,omitempty"`
+	// Rate applies from this time until a newer rate for the pair
+	EffectiveAt time.Time `json:"effective_at,omitempty"`
+	// Where the rate came from: manual or a rate source
+	Source string `json:"source,omitempty"`
+	// Additional metadata for the rate
+	Metadata     map[string]string `json:"metadata,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FXRate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case fxrate.FieldMetadata:
+			values[i] = new([]byte)
+		case fxrate.FieldRate:
+			values[i] = new(decimal.Decimal)
+		case fxrate.FieldID, fxrate.FieldTenantID, fxrate.FieldStatus, fxrate.FieldCreatedBy, fxrate.FieldUpdatedBy, fxrate.FieldEnvironmentID, fxrate.FieldBaseCurrency, fxrate.FieldQuoteCurrency, fxrate.FieldSource:
+			values[i] = new(sql.NullString)
+		case fxrate.FieldCreatedAt, fxrate.FieldUpdatedAt, fxrate.FieldEffectiveAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FXRate fields.
+func (fr *FXRate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case fxrate.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				fr.ID = value.String
+			}
+		case fxrate.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				fr.TenantID = value.String
+			}
+		case fxrate.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				fr.Status = value.String
+			}
+		case fxrate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				fr.CreatedAt = value.Time
+			}
+		case fxrate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				fr.UpdatedAt = value.Time
+			}
+		case fxrate.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				fr.CreatedBy = value.String
+			}
+		case fxrate.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				fr.UpdatedBy = value.String
+			}
+		case fxrate.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				fr.EnvironmentID = value.String
+			}
+		case fxrate.FieldBaseCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_currency", values[i])
+			} else if value.Valid {
+				fr.BaseCurrency = value.String
+			}
+		case fxrate.FieldQuoteCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field quote_currency", values[i])
+			} else if value.Valid {
+				fr.QuoteCurrency = value.String
+			}
+		case fxrate.FieldRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value != nil {
+				fr.Rate = *value
+			}
+		case fxrate.FieldEffectiveAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_at", values[i])
+			} else if value.Valid {
+				fr.EffectiveAt = value.Time
+			}
+		case fxrate.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				fr.Source = value.String
+			}
+		case fxrate.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &fr.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		default:
+			fr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FXRate.
+// This includes values selected through modifiers, order, etc.
+func (fr *FXRate) Value(name string) (ent.Value, error) {
+	return fr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this FXRate.
+// Note that you need to call FXRate.Unwrap() before calling this method if this FXRate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fr *FXRate) Update() *FXRateUpdateOne {
+	return NewFXRateClient(fr.config).UpdateOne(fr)
+}
+
+// Unwrap unwraps the FXRate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fr *FXRate) Unwrap() *FXRate {
+	_tx, ok := fr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FXRate is not a transactional entity")
+	}
+	fr.config.driver = _tx.drv
+	return fr
+}
+
+// String implements the fmt.Stringer.
+func (fr *FXRate) String() string {
+	var builder strings.Builder
+	builder.WriteString("FXRate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fr.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fr.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fr.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fr.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(fr.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(fr.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("base_currency=")
+	builder.WriteString(fr.BaseCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("quote_currency=")
+	builder.WriteString(fr.QuoteCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", fr.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("effective_at=")
+	builder.WriteString(fr.EffectiveAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fr.Source)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", fr.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FXRates is a parsable slice of FXRate.
+type FXRates []*FXRate
@@ -0,0 +1,437 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/fxrate"
+	"github.com/shopspring/decimal"
+)
+
+// FXRateCreate is the builder for creating a FXRate entity.
+type FXRateCreate struct {
+	config
+	mutation *FXRateMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (frc *FXRateCreate) SetTenantID(s string) *FXRateCreate {
+	frc.mutation.SetTenantID(s)
+	return frc
+}
+
+// SetStatus sets the "status" field.
+func (frc *FXRateCreate) SetStatus(s string) *FXRateCreate {
+	frc.mutation.SetStatus(s)
+	return frc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (frc *FXRateCreate) SetNillableStatus(s *string) *FXRateCreate {
+	if s != nil {
+		frc.SetStatus(*s)
+	}
+	return frc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (frc *FXRateCreate) SetCreatedAt(t time.Time) *FXRateCreate {
+	frc.mutation.SetCreatedAt(t)
+	return frc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (frc *FXRateCreate) SetNillableCreatedAt(t *time.Time) *FXRateCreate {
+	if t != nil {
+		frc.SetCreatedAt(*t)
+	}
+	return frc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (frc *FXRateCreate) SetUpdatedAt(t time.Time) *FXRateCreate {
+	frc.mutation.SetUpdatedAt(t)
+	return frc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (frc *FXRateCreate) SetNillableUpdatedAt(t *time.Time) *FXRateCreate {
+	if t != nil {
+		frc.SetUpdatedAt(*t)
+	}
+	return frc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (frc *FXRateCreate) SetCreatedBy(s string) *FXRateCreate {
+	frc.mutation.SetCreatedBy(s)
+	return frc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (frc *FXRateCreate) SetNillableCreatedBy(s *string) *FXRateCreate {
+	if s != nil {
+		frc.SetCreatedBy(*s)
+	}
+	return frc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (frc *FXRateCreate) SetUpdatedBy(s string) *FXRateCreate {
+	frc.mutation.SetUpdatedBy(s)
+	return frc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (frc *FXRateCreate) SetNillableUpdatedBy(s *string) *FXRateCreate {
+	if s != nil {
+		frc.SetUpdatedBy(*s)
+	}
+	return frc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (frc *FXRateCreate) SetEnvironmentID(s string) *FXRateCreate {
+	frc.mutation.SetEnvironmentID(s)
+	return frc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (frc *FXRateCreate) SetNillableEnvironmentID(s *string) *FXRateCreate {
+	if s != nil {
+		frc.SetEnvironmentID(*s)
+	}
+	return frc
+}
+
+// SetBaseCurrency sets the "base_currency" field.
+func (frc *FXRateCreate) SetBaseCurrency(s string) *FXRateCreate {
+	frc.mutation.SetBaseCurrency(s)
+	return frc
+}
+
+// SetQuoteCurrency sets the "quote_currency" field.
+func (frc *FXRateCreate) SetQuoteCurrency(s string) *FXRateCreate {
+	frc.mutation.SetQuoteCurrency(s)
+	return frc
+}
+
+// SetRate sets the "rate" field.
+func (frc *FXRateCreate) SetRate(d decimal.Decimal) *FXRateCreate {
+	frc.mutation.SetRate(d)
+	return frc
+}
+
+// SetEffectiveAt sets the "effective_at" field.
+func (frc *FXRateCreate) SetEffectiveAt(t time.Time) *FXRateCreate {
+	frc.mutation.SetEffectiveAt(t)
+	return frc
+}
+
+// SetSource sets the "source" field.
+func (frc *FXRateCreate) SetSource(s string) *FXRateCreate {
+	frc.mutation.SetSource(s)
+	return frc
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (frc *FXRateCreate) SetNillableSource(s *string) *FXRateCreate {
+	if s != nil {
+		frc.SetSource(*s)
+	}
+	return frc
+}
+
+// SetMetadata sets the "metadata" field.
+func (frc *FXRateCreate) SetMetadata(m map[string]string) *FXRateCreate {
+	frc.mutation.SetMetadata(m)
+	return frc
+}
+
+// SetID sets the "id" field.
+func (frc *FXRateCreate) SetID(s string) *FXRateCreate {
+	frc.mutation.SetID(s)
+	return frc
+}
+
+// Mutation returns the FXRateMutation object of the builder.
+func (frc *FXRateCreate) Mutation() *FXRateMutation {
+	return frc.mutation
+}
+
+// Save creates the FXRate in the database.
+func (frc *FXRateCreate) Save(ctx context.Context) (*FXRate, error) {
+	frc.defaults()
+	return withHooks(ctx, frc.sqlSave, frc.mutation, frc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (frc *FXRateCreate) SaveX(ctx context.Context) *FXRate {
+	v, err := frc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (frc *FXRateCreate) Exec(ctx context.Context) error {
+	_, err := frc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (frc *FXRateCreate) ExecX(ctx context.Context) {
+	if err := frc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (frc *FXRateCreate) defaults() {
+	if _, ok := frc.mutation.Status(); !ok {
+		v := fxrate.DefaultStatus
+		frc.mutation.SetStatus(v)
+	}
+	if _, ok := frc.mutation.CreatedAt(); !ok {
+		v := fxrate.DefaultCreatedAt()
+		frc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := frc.mutation.UpdatedAt(); !ok {
+		v := fxrate.DefaultUpdatedAt()
+		frc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := frc.mutation.EnvironmentID(); !ok {
+		v := fxrate.DefaultEnvironmentID
+		frc.mutation.SetEnvironmentID(v)
+	}
+	if _, ok := frc.mutation.Source(); !ok {
+		v := fxrate.DefaultSource
+		frc.mutation.SetSource(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (frc *FXRateCreate) check() error {
+	if _, ok := frc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "FXRate.tenant_id"`)}
+	}
+	if v, ok := frc.mutation.TenantID(); ok {
+		if err := fxrate.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "FXRate.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := frc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "FXRate.status"`)}
+	}
+	if _, ok := frc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FXRate.created_at"`)}
+	}
+	if _, ok := frc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "FXRate.updated_at"`)}
+	}
+	if _, ok := frc.mutation.BaseCurrency(); !ok {
+		return &ValidationError{Name: "base_currency", err: errors.New(`ent: missing required field "FXRate.base_currency"`)}
+	}
+	if v, ok := frc.mutation.BaseCurrency(); ok {
+		if err := fxrate.BaseCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "base_currency", err: fmt.Errorf(`ent: validator failed for field "FXRate.base_currency": %w`, err)}
+		}
+	}
+	if _, ok := frc.mutation.QuoteCurrency(); !ok {
+		return &ValidationError{Name: "quote_currency", err: errors.New(`ent: missing required field "FXRate.quote_currency"`)}
+	}
+	if v, ok := frc.mutation.QuoteCurrency(); ok {
+		if err := fxrate.QuoteCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "quote_currency", err: fmt.Errorf(`ent: validator failed for field "FXRate.quote_currency": %w`, err)}
+		}
+	}
+	if _, ok := frc.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "FXRate.rate"`)}
+	}
+	if _, ok := frc.mutation.EffectiveAt(); !ok {
+		return &ValidationError{Name: "effective_at", err: errors.New(`ent: missing required field "FXRate.effective_at"`)}
+	}
+	if _, ok := frc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "FXRate.source"`)}
+	}
+	return nil
+}
+
+func (frc *FXRateCreate) sqlSave(ctx context.Context) (*FXRate, error) {
+	if err := frc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := frc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, frc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected FXRate.ID type: %T", _spec.ID.Value)
+		}
+	}
+	frc.mutation.id = &_node.ID
+	frc.mutation.done = true
+	return _node, nil
+}
+
+func (frc *FXRateCreate) createSpec() (*FXRate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FXRate{config: frc.config}
+		_spec = sqlgraph.NewCreateSpec(fxrate.Table, sqlgraph.NewFieldSpec(fxrate.FieldID, field.TypeString))
+	)
+	if id, ok := frc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := frc.mutation.TenantID(); ok {
+		_spec.SetField(fxrate.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := frc.mutation.Status(); ok {
+		_spec.SetField(fxrate.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := frc.mutation.CreatedAt(); ok {
+		_spec.SetField(fxrate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := frc.mutation.UpdatedAt(); ok {
+		_spec.SetField(fxrate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := frc.mutation.CreatedBy(); ok {
+		_spec.SetField(fxrate.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := frc.mutation.UpdatedBy(); ok {
+		_spec.SetField(fxrate.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := frc.mutation.EnvironmentID(); ok {
+		_spec.SetField(fxrate.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := frc.mutation.BaseCurrency(); ok {
+		_spec.SetField(fxrate.FieldBaseCurrency, field.TypeString, value)
+		_node.BaseCurrency = value
+	}
+	if value, ok := frc.mutation.QuoteCurrency(); ok {
+		_spec.SetField(fxrate.FieldQuoteCurrency, field.TypeString, value)
+		_node.QuoteCurrency = value
+	}
+	if value, ok := frc.mutation.Rate(); ok {
+		_spec.SetField(fxrate.FieldRate, field.TypeOther, value)
+		_node.Rate = value
+	}
+	if value, ok := frc.mutation.EffectiveAt(); ok {
+		_spec.SetField(fxrate.FieldEffectiveAt, field.TypeTime, value)
+		_node.EffectiveAt = value
+	}
+	if value, ok := frc.mutation.Source(); ok {
+		_spec.SetField(fxrate.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := frc.mutation.Metadata(); ok {
+		_spec.SetField(fxrate.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	return _node, _spec
+}
+
+// FXRateCreateBulk is the builder for creating many FXRate entities in bulk.
+type FXRateCreateBulk struct {
+	config
+	err      error
+	builders []*FXRateCreate
+}
+
+// Save creates the FXRate entities in the database.
+func (frcb *FXRateCreateBulk) Save(ctx context.Context) ([]*FXRate, error) {
+	if frcb.err != nil {
+		return nil, frcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(frcb.builders))
+	nodes := make([]*FXRate, len(frcb.builders))
+	mutators := make([]Mutator, len(frcb.builders))
+	for i := range frcb.builders {
+		func(i int, root context.Context) {
+			builder := frcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FXRateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, frcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, frcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, frcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (frcb *FXRateCreateBulk) SaveX(ctx context.Context) []*FXRate {
+	v, err := frcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (frcb *FXRateCreateBulk) Exec(ctx context.Context) error {
+	_, err := frcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (frcb *FXRateCreateBulk) ExecX(ctx context.Context) {
+	if err := frcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/fxrate"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// FXRateDelete is the builder for deleting a FXRate entity.
+type FXRateDelete struct {
+	config
+	hooks    []Hook
+	mutation *FXRateMutation
+}
+
+// Where appends a list predicates to the FXRateDelete builder.
+func (frd *FXRateDelete) Where(ps ...predicate.FXRate) *FXRateDelete {
+	frd.mutation.Where(ps...)
+	return frd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (frd *FXRateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, frd.sqlExec, frd.mutation, frd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (frd *FXRateDelete) ExecX(ctx context.Context) int {
+	n, err := frd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (frd *FXRateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(fxrate.Table, sqlgraph.NewFieldSpec(fxrate.FieldID, field.TypeString))
+	if ps := frd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, frd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	frd.mutation.done = true
+	return affected, err
+}
+
+// FXRateDeleteOne is the builder for deleting a single FXRate entity.
+type FXRateDeleteOne struct {
+	frd *FXRateDelete
+}
+
+// Where appends a list predicates to the FXRateDelete builder.
+func (frdo *FXRateDeleteOne) Where(ps ...predicate.FXRate) *FXRateDeleteOne {
+	frdo.frd.mutation.Where(ps...)
+	return frdo
+}
+
+// Exec executes the deletion query.
+func (frdo *FXRateDeleteOne) Exec(ctx context.Context) error {
+	n, err := frdo.frd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{fxrate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (frdo *FXRateDeleteOne) ExecX(ctx context.Context) {
+	if err := frdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
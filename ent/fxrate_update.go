@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/fxrate"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// FXRateUpdate is the builder for updating FXRate entities.
+type FXRateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FXRateMutation
+}
+
+// Where appends a list predicates to the FXRateUpdate builder.
+func (fru *FXRateUpdate) Where(ps ...predicate.FXRate) *FXRateUpdate {
+	fru.mutation.Where(ps...)
+	return fru
+}
+
+// SetStatus sets the "status" field.
+func (fru *FXRateUpdate) SetStatus(s string) *FXRateUpdate {
+	fru.mutation.SetStatus(s)
+	return fru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (fru *FXRateUpdate) SetNillableStatus(s *string) *FXRateUpdate {
+	if s != nil {
+		fru.SetStatus(*s)
+	}
+	return fru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fru *FXRateUpdate) SetUpdatedAt(t time.Time) *FXRateUpdate {
+	fru.mutation.SetUpdatedAt(t)
+	return fru
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (fru *FXRateUpdate) SetUpdatedBy(s string) *FXRateUpdate {
+	fru.mutation.SetUpdatedBy(s)
+	return fru
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (fru *FXRateUpdate) SetNillableUpdatedBy(s *string) *FXRateUpdate {
+	if s != nil {
+		fru.SetUpdatedBy(*s)
+	}
+	return fru
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (fru *FXRateUpdate) ClearUpdatedBy() *FXRateUpdate {
+	fru.mutation.ClearUpdatedBy()
+	return fru
+}
+
+// SetMetadata sets the "metadata" field.
+func (fru *FXRateUpdate) SetMetadata(m map[string]string) *FXRateUpdate {
+	fru.mutation.SetMetadata(m)
+	return fru
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (fru *FXRateUpdate) ClearMetadata() *FXRateUpdate {
+	fru.mutation.ClearMetadata()
+	return fru
+}
+
+// Mutation returns the FXRateMutation object of the builder.
+func (fru *FXRateUpdate) Mutation() *FXRateMutation {
+	return fru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fru *FXRateUpdate) Save(ctx context.Context) (int, error) {
+	fru.defaults()
+	return withHooks(ctx, fru.sqlSave, fru.mutation, fru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fru *FXRateUpdate) SaveX(ctx context.Context) int {
+	affected, err := fru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fru *FXRateUpdate) Exec(ctx context.Context) error {
+	_, err := fru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fru *FXRateUpdate) ExecX(ctx context.Context) {
+	if err := fru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fru *FXRateUpdate) defaults() {
+	if _, ok := fru.mutation.UpdatedAt(); !ok {
+		v := fxrate.UpdateDefaultUpdatedAt()
+		fru.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (fru *FXRateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(fxrate.Table, fxrate.Columns, sqlgraph.NewFieldSpec(fxrate.FieldID, field.TypeString))
+	if ps := fru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fru.mutation.Status(); ok {
+		_spec.SetField(fxrate.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := fru.mutation.UpdatedAt(); ok {
+		_spec.SetField(fxrate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if fru.mutation.CreatedByCleared() {
+		_spec.ClearField(fxrate.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := fru.mutation.UpdatedBy(); ok {
+		_spec.SetField(fxrate.FieldUpdatedBy, field.TypeString, value)
+	}
+	if fru.mutation.UpdatedByCleared() {
+		_spec.ClearField(fxrate.FieldUpdatedBy, field.TypeString)
+	}
+	if fru.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(fxrate.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := fru.mutation.Metadata(); ok {
+		_spec.SetField(fxrate.FieldMetadata, field.TypeJSON, value)
+	}
+	if fru.mutation.MetadataCleared() {
+		_spec.ClearField(fxrate.FieldMetadata, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fxrate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fru.mutation.done = true
+	return n, nil
+}
+
+// FXRateUpdateOne is the builder for updating a single FXRate entity.
+type FXRateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FXRateMutation
+}
+
+// SetStatus sets the "status" field.
+func (fruo *FXRateUpdateOne) SetStatus(s string) *FXRateUpdateOne {
+	fruo.mutation.SetStatus(s)
+	return fruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (fruo *FXRateUpdateOne) SetNillableStatus(s *string) *FXRateUpdateOne {
+	if s != nil {
+		fruo.SetStatus(*s)
+	}
+	return fruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fruo *FXRateUpdateOne) SetUpdatedAt(t time.Time) *FXRateUpdateOne {
+	fruo.mutation.SetUpdatedAt(t)
+	return fruo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (fruo *FXRateUpdateOne) SetUpdatedBy(s string) *FXRateUpdateOne {
+	fruo.mutation.SetUpdatedBy(s)
+	return fruo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (fruo *FXRateUpdateOne) SetNillableUpdatedBy(s *string) *FXRateUpdateOne {
+	if s != nil {
+		fruo.SetUpdatedBy(*s)
+	}
+	return fruo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (fruo *FXRateUpdateOne) ClearUpdatedBy() *FXRateUpdateOne {
+	fruo.mutation.ClearUpdatedBy()
+	return fruo
+}
+
+// SetMetadata sets the "metadata" field.
+func (fruo *FXRateUpdateOne) SetMetadata(m map[string]string) *FXRateUpdateOne {
+	fruo.mutation.SetMetadata(m)
+	return fruo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (fruo *FXRateUpdateOne) ClearMetadata() *FXRateUpdateOne {
+	fruo.mutation.ClearMetadata()
+	return fruo
+}
+
+// Mutation returns the FXRateMutation object of the builder.
+func (fruo *FXRateUpdateOne) Mutation() *FXRateMutation {
+	return fruo.mutation
+}
+
+// Where appends a list predicates to the FXRateUpdate builder.
+func (fruo *FXRateUpdateOne) Where(ps ...predicate.FXRate) *FXRateUpdateOne {
+	fruo.mutation.Where(ps...)
+	return fruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fruo *FXRateUpdateOne) Select(field string, fields ...string) *FXRateUpdateOne {
+	fruo.fields = append([]string{field}, fields...)
+	return fruo
+}
+
+// Save executes the query and returns the updated FXRate entity.
+func (fruo *FXRateUpdateOne) Save(ctx context.Context) (*FXRate, error) {
+	fruo.defaults()
+	return withHooks(ctx, fruo.sqlSave, fruo.mutation, fruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fruo *FXRateUpdateOne) SaveX(ctx context.Context) *FXRate {
+	node, err := fruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fruo *FXRateUpdateOne) Exec(ctx context.Context) error {
+	_, err := fruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fruo *FXRateUpdateOne) ExecX(ctx context.Context) {
+	if err := fruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fruo *FXRateUpdateOne) defaults() {
+	if _, ok := fruo.mutation.UpdatedAt(); !ok {
+		v := fxrate.UpdateDefaultUpdatedAt()
+		fruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (fruo *FXRateUpdateOne) sqlSave(ctx context.Context) (_node *FXRate, err error) {
+	_spec := sqlgraph.NewUpdateSpec(fxrate.Table, fxrate.Columns, sqlgraph.NewFieldSpec(fxrate.FieldID, field.TypeString))
+	id, ok := fruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FXRate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fxrate.FieldID)
+		for _, f := range fields {
+			if !fxrate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != fxrate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fruo.mutation.Status(); ok {
+		_spec.SetField(fxrate.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := fruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(fxrate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if fruo.mutation.CreatedByCleared() {
+		_spec.ClearField(fxrate.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := fruo.mutation.UpdatedBy(); ok {
+		_spec.SetField(fxrate.FieldUpdatedBy, field.TypeString, value)
+	}
+	if fruo.mutation.UpdatedByCleared() {
+		_spec.ClearField(fxrate.FieldUpdatedBy, field.TypeString)
+	}
+	if fruo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(fxrate.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := fruo.mutation.Metadata(); ok {
+		_spec.SetField(fxrate.FieldMetadata, field.TypeJSON, value)
+	}
+	if fruo.mutation.MetadataCleared() {
+		_spec.ClearField(fxrate.FieldMetadata, field.TypeJSON)
+	}
+	_node = &FXRate{config: fruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fxrate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,501 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/invitation"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// InvitationUpdate is the builder for updating Invitation entities.
+type InvitationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvitationMutation
+}
+
+// Where appends a list predicates to the InvitationUpdate builder.
+func (iu *InvitationUpdate) Where(ps ...predicate.Invitation) *InvitationUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetStatus sets the "status" field.
+func (iu *InvitationUpdate) SetStatus(s string) *InvitationUpdate {
+	iu.mutation.SetStatus(s)
+	return iu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableStatus(s *string) *InvitationUpdate {
+	if s != nil {
+		iu.SetStatus(*s)
+	}
+	return iu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iu *InvitationUpdate) SetUpdatedAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetUpdatedAt(t)
+	return iu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (iu *InvitationUpdate) SetUpdatedBy(s string) *InvitationUpdate {
+	iu.mutation.SetUpdatedBy(s)
+	return iu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableUpdatedBy(s *string) *InvitationUpdate {
+	if s != nil {
+		iu.SetUpdatedBy(*s)
+	}
+	return iu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (iu *InvitationUpdate) ClearUpdatedBy() *InvitationUpdate {
+	iu.mutation.ClearUpdatedBy()
+	return iu
+}
+
+// SetRoles sets the "roles" field.
+func (iu *InvitationUpdate) SetRoles(s []string) *InvitationUpdate {
+	iu.mutation.SetRoles(s)
+	return iu
+}
+
+// AppendRoles appends s to the "roles" field.
+func (iu *InvitationUpdate) AppendRoles(s []string) *InvitationUpdate {
+	iu.mutation.AppendRoles(s)
+	return iu
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (iu *InvitationUpdate) ClearRoles() *InvitationUpdate {
+	iu.mutation.ClearRoles()
+	return iu
+}
+
+// SetInvitationStatus sets the "invitation_status" field.
+func (iu *InvitationUpdate) SetInvitationStatus(s string) *InvitationUpdate {
+	iu.mutation.SetInvitationStatus(s)
+	return iu
+}
+
+// SetNillableInvitationStatus sets the "invitation_status" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableInvitationStatus(s *string) *InvitationUpdate {
+	if s != nil {
+		iu.SetInvitationStatus(*s)
+	}
+	return iu
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (iu *InvitationUpdate) SetRespondedAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetRespondedAt(t)
+	return iu
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableRespondedAt(t *time.Time) *InvitationUpdate {
+	if t != nil {
+		iu.SetRespondedAt(*t)
+	}
+	return iu
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (iu *InvitationUpdate) ClearRespondedAt() *InvitationUpdate {
+	iu.mutation.ClearRespondedAt()
+	return iu
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (iu *InvitationUpdate) SetAcceptedBy(s string) *InvitationUpdate {
+	iu.mutation.SetAcceptedBy(s)
+	return iu
+}
+
+// SetNillableAcceptedBy sets the "accepted_by" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableAcceptedBy(s *string) *InvitationUpdate {
+	if s != nil {
+		iu.SetAcceptedBy(*s)
+	}
+	return iu
+}
+
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (iu *InvitationUpdate) ClearAcceptedBy() *InvitationUpdate {
+	iu.mutation.ClearAcceptedBy()
+	return iu
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (iu *InvitationUpdate) Mutation() *InvitationMutation {
+	return iu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InvitationUpdate) Save(ctx context.Context) (int, error) {
+	iu.defaults()
+	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InvitationUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InvitationUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InvitationUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iu *InvitationUpdate) defaults() {
+	if _, ok := iu.mutation.UpdatedAt(); !ok {
+		v := invitation.UpdateDefaultUpdatedAt()
+		iu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (iu *InvitationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(invitation.Table, invitation.Columns, sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString))
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.Status(); ok {
+		_spec.SetField(invitation.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.UpdatedAt(); ok {
+		_spec.SetField(invitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if iu.mutation.CreatedByCleared() {
+		_spec.ClearField(invitation.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := iu.mutation.UpdatedBy(); ok {
+		_spec.SetField(invitation.FieldUpdatedBy, field.TypeString, value)
+	}
+	if iu.mutation.UpdatedByCleared() {
+		_spec.ClearField(invitation.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := iu.mutation.Roles(); ok {
+		_spec.SetField(invitation.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := iu.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invitation.FieldRoles, value)
+		})
+	}
+	if iu.mutation.RolesCleared() {
+		_spec.ClearField(invitation.FieldRoles, field.TypeJSON)
+	}
+	if value, ok := iu.mutation.InvitationStatus(); ok {
+		_spec.SetField(invitation.FieldInvitationStatus, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.RespondedAt(); ok {
+		_spec.SetField(invitation.FieldRespondedAt, field.TypeTime, value)
+	}
+	if iu.mutation.RespondedAtCleared() {
+		_spec.ClearField(invitation.FieldRespondedAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.AcceptedBy(); ok {
+		_spec.SetField(invitation.FieldAcceptedBy, field.TypeString, value)
+	}
+	if iu.mutation.AcceptedByCleared() {
+		_spec.ClearField(invitation.FieldAcceptedBy, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// InvitationUpdateOne is the builder for updating a single Invitation entity.
+type InvitationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvitationMutation
+}
+
+// SetStatus sets the "status" field.
+func (iuo *InvitationUpdateOne) SetStatus(s string) *InvitationUpdateOne {
+	iuo.mutation.SetStatus(s)
+	return iuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableStatus(s *string) *InvitationUpdateOne {
+	if s != nil {
+		iuo.SetStatus(*s)
+	}
+	return iuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iuo *InvitationUpdateOne) SetUpdatedAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetUpdatedAt(t)
+	return iuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (iuo *InvitationUpdateOne) SetUpdatedBy(s string) *InvitationUpdateOne {
+	iuo.mutation.SetUpdatedBy(s)
+	return iuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableUpdatedBy(s *string) *InvitationUpdateOne {
+	if s != nil {
+		iuo.SetUpdatedBy(*s)
+	}
+	return iuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (iuo *InvitationUpdateOne) ClearUpdatedBy() *InvitationUpdateOne {
+	iuo.mutation.ClearUpdatedBy()
+	return iuo
+}
+
+// SetRoles sets the "roles" field.
+func (iuo *InvitationUpdateOne) SetRoles(s []string) *InvitationUpdateOne {
+	iuo.mutation.SetRoles(s)
+	return iuo
+}
+
+// AppendRoles appends s to the "roles" field.
+func (iuo *InvitationUpdateOne) AppendRoles(s []string) *InvitationUpdateOne {
+	iuo.mutation.AppendRoles(s)
+	return iuo
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (iuo *InvitationUpdateOne) ClearRoles() *InvitationUpdateOne {
+	iuo.mutation.ClearRoles()
+	return iuo
+}
+
+// SetInvitationStatus sets the "invitation_status" field.
+func (iuo *InvitationUpdateOne) SetInvitationStatus(s string) *InvitationUpdateOne {
+	iuo.mutation.SetInvitationStatus(s)
+	return iuo
+}
+
+// SetNillableInvitationStatus sets the "invitation_status" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableInvitationStatus(s *string) *InvitationUpdateOne {
+	if s != nil {
+		iuo.SetInvitationStatus(*s)
+	}
+	return iuo
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (iuo *InvitationUpdateOne) SetRespondedAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetRespondedAt(t)
+	return iuo
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableRespondedAt(t *time.Time) *InvitationUpdateOne {
+	if t != nil {
+		iuo.SetRespondedAt(*t)
+	}
+	return iuo
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (iuo *InvitationUpdateOne) ClearRespondedAt() *InvitationUpdateOne {
+	iuo.mutation.ClearRespondedAt()
+	return iuo
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (iuo *InvitationUpdateOne) SetAcceptedBy(s string) *InvitationUpdateOne {
+	iuo.mutation.SetAcceptedBy(s)
+	return iuo
+}
+
+// SetNillableAcceptedBy sets the "accepted_by" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableAcceptedBy(s *string) *InvitationUpdateOne {
+	if s != nil {
+		iuo.SetAcceptedBy(*s)
+	}
+	return iuo
+}
+
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (iuo *InvitationUpdateOne) ClearAcceptedBy() *InvitationUpdateOne {
+	iuo.mutation.ClearAcceptedBy()
+	return iuo
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (iuo *InvitationUpdateOne) Mutation() *InvitationMutation {
+	return iuo.mutation
+}
+
+// Where appends a list predicates to the InvitationUpdate builder.
+func (iuo *InvitationUpdateOne) Where(ps ...predicate.Invitation) *InvitationUpdateOne {
+	iuo.mutation.Where(ps...)
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *InvitationUpdateOne) Select(field string, fields ...string) *InvitationUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Invitation entity.
+func (iuo *InvitationUpdateOne) Save(ctx context.Context) (*Invitation, error) {
+	iuo.defaults()
+	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InvitationUpdateOne) SaveX(ctx context.Context) *Invitation {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InvitationUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InvitationUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iuo *InvitationUpdateOne) defaults() {
+	if _, ok := iuo.mutation.UpdatedAt(); !ok {
+		v := invitation.UpdateDefaultUpdatedAt()
+		iuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (iuo *InvitationUpdateOne) sqlSave(ctx context.Context) (_node *Invitation, err error) {
+	_spec := sqlgraph.NewUpdateSpec(invitation.Table, invitation.Columns, sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString))
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Invitation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invitation.FieldID)
+		for _, f := range fields {
+			if !invitation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.Status(); ok {
+		_spec.SetField(invitation.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(invitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.CreatedByCleared() {
+		_spec.ClearField(invitation.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := iuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(invitation.FieldUpdatedBy, field.TypeString, value)
+	}
+	if iuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(invitation.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := iuo.mutation.Roles(); ok {
+		_spec.SetField(invitation.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := iuo.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invitation.FieldRoles, value)
+		})
+	}
+	if iuo.mutation.RolesCleared() {
+		_spec.ClearField(invitation.FieldRoles, field.TypeJSON)
+	}
+	if value, ok := iuo.mutation.InvitationStatus(); ok {
+		_spec.SetField(invitation.FieldInvitationStatus, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.RespondedAt(); ok {
+		_spec.SetField(invitation.FieldRespondedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.RespondedAtCleared() {
+		_spec.ClearField(invitation.FieldRespondedAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.AcceptedBy(); ok {
+		_spec.SetField(invitation.FieldAcceptedBy, field.TypeString, value)
+	}
+	if iuo.mutation.AcceptedByCleared() {
+		_spec.ClearField(invitation.FieldAcceptedBy, field.TypeString)
+	}
+	_node = &Invitation{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iuo.mutation.done = true
+	return _node, nil
+}
@@ -125,6 +125,53 @@ var (
 			},
 		},
 	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "entity_type", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "entity_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "action", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "actor_type", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "actor_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "request_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(64)"}},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_tenant_id_environment_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1], AuditLogsColumns[7], AuditLogsColumns[3]},
+			},
+			{
+				Name:    "auditlog_tenant_id_environment_id_entity_type_entity_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1], AuditLogsColumns[7], AuditLogsColumns[8], AuditLogsColumns[9], AuditLogsColumns[3]},
+			},
+			{
+				Name:    "auditlog_tenant_id_environment_id_actor_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1], AuditLogsColumns[7], AuditLogsColumns[12], AuditLogsColumns[3]},
+			},
+			{
+				Name:    "auditlog_tenant_id_request_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1], AuditLogsColumns[14]},
+			},
+		},
+	}
 	// AuthsColumns holds the columns for the "auths" table.
 	AuthsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -188,6 +235,92 @@ var (
 			},
 		},
 	}
+	// CommitmentContractsColumns holds the columns for the "commitment_contracts" table.
+	CommitmentContractsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "customer_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "subscription_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "currency", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(10)"}},
+		{Name: "commitment_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "prepaid_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "overage_factor", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(10,4)"}},
+		{Name: "drawn_down_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "overage_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "start_date", Type: field.TypeTime},
+		{Name: "end_date", Type: field.TypeTime},
+		{Name: "enable_true_up", Type: field.TypeBool, Default: true},
+		{Name: "contract_status", Type: field.TypeString, Default: "active", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "prepayment_invoice_id", Type: field.TypeString, Nullable: true},
+		{Name: "true_up_invoice_id", Type: field.TypeString, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+	}
+	// CommitmentContractsTable holds the schema information for the "commitment_contracts" table.
+	CommitmentContractsTable = &schema.Table{
+		Name:       "commitment_contracts",
+		Columns:    CommitmentContractsColumns,
+		PrimaryKey: []*schema.Column{CommitmentContractsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "commitmentcontract_tenant_id_environment_id_customer_id",
+				Unique:  false,
+				Columns: []*schema.Column{CommitmentContractsColumns[1], CommitmentContractsColumns[7], CommitmentContractsColumns[9]},
+			},
+			{
+				Name:    "commitmentcontract_tenant_id_environment_id_contract_status_end_date",
+				Unique:  false,
+				Columns: []*schema.Column{CommitmentContractsColumns[1], CommitmentContractsColumns[7], CommitmentContractsColumns[20], CommitmentContractsColumns[18]},
+			},
+		},
+	}
+	// CommitmentDrawdownsColumns holds the columns for the "commitment_drawdowns" table.
+	CommitmentDrawdownsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "contract_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "subscription_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "invoice_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "period_start", Type: field.TypeTime},
+		{Name: "period_end", Type: field.TypeTime},
+		{Name: "usage_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "drawdown_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "overage_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "balance_after", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+	}
+	// CommitmentDrawdownsTable holds the schema information for the "commitment_drawdowns" table.
+	CommitmentDrawdownsTable = &schema.Table{
+		Name:       "commitment_drawdowns",
+		Columns:    CommitmentDrawdownsColumns,
+		PrimaryKey: []*schema.Column{CommitmentDrawdownsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "commitmentdrawdown_tenant_id_environment_id_contract_id_period_start",
+				Unique:  false,
+				Columns: []*schema.Column{CommitmentDrawdownsColumns[1], CommitmentDrawdownsColumns[7], CommitmentDrawdownsColumns[8], CommitmentDrawdownsColumns[11]},
+			},
+			{
+				Name:    "commitmentdrawdown_tenant_id_environment_id_contract_id_invoice_id",
+				Unique:  true,
+				Columns: []*schema.Column{CommitmentDrawdownsColumns[1], CommitmentDrawdownsColumns[7], CommitmentDrawdownsColumns[8], CommitmentDrawdownsColumns[10]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'published'",
+				},
+			},
+		},
+	}
 	// ConnectionsColumns holds the columns for the "connections" table.
 	ConnectionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
@@ -319,6 +452,7 @@ var (
 		{Name: "currency", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(10)"}},
 		{Name: "coupon_snapshot", Type: field.TypeJSON, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "promotion_code_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "coupon_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "invoice_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "invoice_line_item_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
@@ -332,25 +466,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "coupon_applications_coupons_coupon_applications",
-				Columns:    []*schema.Column{CouponApplicationsColumns[18]},
+				Columns:    []*schema.Column{CouponApplicationsColumns[19]},
 				RefColumns: []*schema.Column{CouponsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "coupon_applications_invoices_coupon_applications",
-				Columns:    []*schema.Column{CouponApplicationsColumns[19]},
+				Columns:    []*schema.Column{CouponApplicationsColumns[20]},
 				RefColumns: []*schema.Column{InvoicesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "coupon_applications_invoice_line_items_coupon_applications",
-				Columns:    []*schema.Column{CouponApplicationsColumns[20]},
+				Columns:    []*schema.Column{CouponApplicationsColumns[21]},
 				RefColumns: []*schema.Column{InvoiceLineItemsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "coupon_applications_subscriptions_coupon_applications",
-				Columns:    []*schema.Column{CouponApplicationsColumns[21]},
+				Columns:    []*schema.Column{CouponApplicationsColumns[22]},
 				RefColumns: []*schema.Column{SubscriptionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -364,27 +498,32 @@ var (
 			{
 				Name:    "couponapplication_tenant_id_environment_id_coupon_id",
 				Unique:  false,
-				Columns: []*schema.Column{CouponApplicationsColumns[1], CouponApplicationsColumns[7], CouponApplicationsColumns[18]},
+				Columns: []*schema.Column{CouponApplicationsColumns[1], CouponApplicationsColumns[7], CouponApplicationsColumns[19]},
 			},
 			{
 				Name:    "couponapplication_tenant_id_environment_id_invoice_id",
 				Unique:  false,
-				Columns: []*schema.Column{CouponApplicationsColumns[1], CouponApplicationsColumns[7], CouponApplicationsColumns[19]},
+				Columns: []*schema.Column{CouponApplicationsColumns[1], CouponApplicationsColumns[7], CouponApplicationsColumns[20]},
 			},
 			{
 				Name:    "couponapplication_tenant_id_environment_id_invoice_id_invoice_line_item_id",
 				Unique:  false,
-				Columns: []*schema.Column{CouponApplicationsColumns[1], CouponApplicationsColumns[7], CouponApplicationsColumns[19], CouponApplicationsColumns[20]},
+				Columns: []*schema.Column{CouponApplicationsColumns[1], CouponApplicationsColumns[7], CouponApplicationsColumns[20], CouponApplicationsColumns[21]},
 			},
 			{
 				Name:    "couponapplication_tenant_id_environment_id_subscription_id",
 				Unique:  false,
-				Columns: []*schema.Column{CouponApplicationsColumns[1], CouponApplicationsColumns[7], CouponApplicationsColumns[21]},
+				Columns: []*schema.Column{CouponApplicationsColumns[1], CouponApplicationsColumns[7], CouponApplicationsColumns[22]},
 			},
 			{
 				Name:    "couponapplication_tenant_id_environment_id_subscription_id_coupon_id",
 				Unique:  false,
-				Columns: []*schema.Column{CouponApplicationsColumns[1], CouponApplicationsColumns[7], CouponApplicationsColumns[21], CouponApplicationsColumns[18]},
+				Columns: []*schema.Column{CouponApplicationsColumns[1], CouponApplicationsColumns[7], CouponApplicationsColumns[22], CouponApplicationsColumns[19]},
+			},
+			{
+				Name:    "couponapplication_tenant_id_environment_id_promotion_code_id",
+				Unique:  false,
+				Columns: []*schema.Column{CouponApplicationsColumns[1], CouponApplicationsColumns[7], CouponApplicationsColumns[18]},
 			},
 		},
 	}
@@ -401,6 +540,7 @@ var (
 		{Name: "subscription_phase_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "start_date", Type: field.TypeTime},
 		{Name: "end_date", Type: field.TypeTime, Nullable: true},
+		{Name: "promotion_code_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "coupon_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "subscription_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
@@ -414,19 +554,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "coupon_associations_coupons_coupon_associations",
-				Columns:    []*schema.Column{CouponAssociationsColumns[12]},
+				Columns:    []*schema.Column{CouponAssociationsColumns[13]},
 				RefColumns: []*schema.Column{CouponsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "coupon_associations_subscriptions_coupon_associations",
-				Columns:    []*schema.Column{CouponAssociationsColumns[13]},
+				Columns:    []*schema.Column{CouponAssociationsColumns[14]},
 				RefColumns: []*schema.Column{SubscriptionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "coupon_associations_subscription_line_items_coupon_associations",
-				Columns:    []*schema.Column{CouponAssociationsColumns[14]},
+				Columns:    []*schema.Column{CouponAssociationsColumns[15]},
 				RefColumns: []*schema.Column{SubscriptionLineItemsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -440,17 +580,22 @@ var (
 			{
 				Name:    "couponassociation_tenant_id_environment_id_coupon_id",
 				Unique:  false,
-				Columns: []*schema.Column{CouponAssociationsColumns[1], CouponAssociationsColumns[7], CouponAssociationsColumns[12]},
+				Columns: []*schema.Column{CouponAssociationsColumns[1], CouponAssociationsColumns[7], CouponAssociationsColumns[13]},
 			},
 			{
 				Name:    "couponassociation_tenant_id_environment_id_subscription_id",
 				Unique:  false,
-				Columns: []*schema.Column{CouponAssociationsColumns[1], CouponAssociationsColumns[7], CouponAssociationsColumns[13]},
+				Columns: []*schema.Column{CouponAssociationsColumns[1], CouponAssociationsColumns[7], CouponAssociationsColumns[14]},
 			},
 			{
 				Name:    "couponassociation_tenant_id_environment_id_subscription_id_subscription_line_item_id",
 				Unique:  false,
-				Columns: []*schema.Column{CouponAssociationsColumns[1], CouponAssociationsColumns[7], CouponAssociationsColumns[13], CouponAssociationsColumns[14]},
+				Columns: []*schema.Column{CouponAssociationsColumns[1], CouponAssociationsColumns[7], CouponAssociationsColumns[14], CouponAssociationsColumns[15]},
+			},
+			{
+				Name:    "couponassociation_tenant_id_environment_id_promotion_code_id",
+				Unique:  false,
+				Columns: []*schema.Column{CouponAssociationsColumns[1], CouponAssociationsColumns[7], CouponAssociationsColumns[11]},
 			},
 		},
 	}
@@ -476,6 +621,7 @@ var (
 		{Name: "expiration_duration", Type: field.TypeInt, Nullable: true},
 		{Name: "expiration_duration_unit", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "priority", Type: field.TypeInt, Nullable: true},
+		{Name: "rollover_config", Type: field.TypeJSON, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "start_date", Type: field.TypeTime, Nullable: true},
 		{Name: "end_date", Type: field.TypeTime, Nullable: true},
@@ -491,13 +637,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "credit_grants_plans_credit_grants",
-				Columns:    []*schema.Column{CreditGrantsColumns[24]},
+				Columns:    []*schema.Column{CreditGrantsColumns[25]},
 				RefColumns: []*schema.Column{PlansColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "credit_grants_subscriptions_credit_grants",
-				Columns:    []*schema.Column{CreditGrantsColumns[25]},
+				Columns:    []*schema.Column{CreditGrantsColumns[26]},
 				RefColumns: []*schema.Column{SubscriptionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -511,7 +657,7 @@ var (
 			{
 				Name:    "idx_plan_id_not_null",
 				Unique:  false,
-				Columns: []*schema.Column{CreditGrantsColumns[1], CreditGrantsColumns[7], CreditGrantsColumns[9], CreditGrantsColumns[24]},
+				Columns: []*schema.Column{CreditGrantsColumns[1], CreditGrantsColumns[7], CreditGrantsColumns[9], CreditGrantsColumns[25]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "plan_id IS NOT NULL",
 				},
@@ -519,7 +665,7 @@ var (
 			{
 				Name:    "idx_subscription_id_not_null",
 				Unique:  false,
-				Columns: []*schema.Column{CreditGrantsColumns[1], CreditGrantsColumns[7], CreditGrantsColumns[9], CreditGrantsColumns[25]},
+				Columns: []*schema.Column{CreditGrantsColumns[1], CreditGrantsColumns[7], CreditGrantsColumns[9], CreditGrantsColumns[26]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "subscription_id IS NOT NULL",
 				},
@@ -683,6 +829,9 @@ var (
 		{Name: "address_state", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(100)"}},
 		{Name: "address_postal_code", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(20)"}},
 		{Name: "address_country", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(2)"}},
+		{Name: "currency", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(10)"}},
+		{Name: "parent_customer_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "invoice_to_parent", Type: field.TypeBool, Default: false},
 	}
 	// CustomersTable holds the schema information for the "customers" table.
 	CustomersTable = &schema.Table{
@@ -703,6 +852,14 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{CustomersColumns[1], CustomersColumns[7]},
 			},
+			{
+				Name:    "idx_customer_tenant_environment_parent",
+				Unique:  false,
+				Columns: []*schema.Column{CustomersColumns[1], CustomersColumns[7], CustomersColumns[19]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "parent_customer_id IS NOT NULL",
+				},
+			},
 			{
 				Name:    "idx_customer_tenant_environment_email",
 				Unique:  false,
@@ -857,6 +1014,41 @@ var (
 			},
 		},
 	}
+	// FxRatesColumns holds the columns for the "fx_rates" table.
+	FxRatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "base_currency", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(10)"}},
+		{Name: "quote_currency", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(10)"}},
+		{Name: "rate", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(24,12)"}},
+		{Name: "effective_at", Type: field.TypeTime},
+		{Name: "source", Type: field.TypeString, Default: "manual", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+	}
+	// FxRatesTable holds the schema information for the "fx_rates" table.
+	FxRatesTable = &schema.Table{
+		Name:       "fx_rates",
+		Columns:    FxRatesColumns,
+		PrimaryKey: []*schema.Column{FxRatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "fxrate_tenant_id_environment_id",
+				Unique:  false,
+				Columns: []*schema.Column{FxRatesColumns[1], FxRatesColumns[7]},
+			},
+			{
+				Name:    "fxrate_tenant_id_environment_id_base_currency_quote_currency_effective_at",
+				Unique:  false,
+				Columns: []*schema.Column{FxRatesColumns[1], FxRatesColumns[7], FxRatesColumns[8], FxRatesColumns[9], FxRatesColumns[11]},
+			},
+		},
+	}
 	// FeaturesColumns holds the columns for the "features" table.
 	FeaturesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
@@ -961,6 +1153,49 @@ var (
 			},
 		},
 	}
+	// InvitationsColumns holds the columns for the "invitations" table.
+	InvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "email", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "roles", Type: field.TypeJSON, Nullable: true},
+		{Name: "token_hash", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(64)"}},
+		{Name: "invitation_status", Type: field.TypeString, Default: "pending", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "responded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "accepted_by", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+	}
+	// InvitationsTable holds the schema information for the "invitations" table.
+	InvitationsTable = &schema.Table{
+		Name:       "invitations",
+		Columns:    InvitationsColumns,
+		PrimaryKey: []*schema.Column{InvitationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invitation_token_hash",
+				Unique:  true,
+				Columns: []*schema.Column{InvitationsColumns[9]},
+			},
+			{
+				Name:    "invitation_tenant_id_email",
+				Unique:  true,
+				Columns: []*schema.Column{InvitationsColumns[1], InvitationsColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'published' AND invitation_status = 'pending'",
+				},
+			},
+			{
+				Name:    "invitation_tenant_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{InvitationsColumns[1], InvitationsColumns[3]},
+			},
+		},
+	}
 	// InvoicesColumns holds the columns for the "invoices" table.
 	InvoicesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
@@ -1426,6 +1661,43 @@ var (
 			},
 		},
 	}
+	// PriceBooksColumns holds the columns for the "price_books" table.
+	PriceBooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "base_currency", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(10)"}},
+		{Name: "plan_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "addon_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "currencies", Type: field.TypeJSON, Nullable: true},
+		{Name: "overrides", Type: field.TypeJSON, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+	}
+	// PriceBooksTable holds the schema information for the "price_books" table.
+	PriceBooksTable = &schema.Table{
+		Name:       "price_books",
+		Columns:    PriceBooksColumns,
+		PrimaryKey: []*schema.Column{PriceBooksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pricebook_tenant_id_environment_id",
+				Unique:  false,
+				Columns: []*schema.Column{PriceBooksColumns[1], PriceBooksColumns[7]},
+			},
+			{
+				Name:    "pricebook_tenant_id_environment_id_base_currency",
+				Unique:  false,
+				Columns: []*schema.Column{PriceBooksColumns[1], PriceBooksColumns[7], PriceBooksColumns[10]},
+			},
+		},
+	}
 	// PriceUnitsColumns holds the columns for the "price_units" table.
 	PriceUnitsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
@@ -1459,6 +1731,126 @@ var (
 			},
 		},
 	}
+	// PromotionCodesColumns holds the columns for the "promotion_codes" table.
+	PromotionCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "coupon_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "code", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(100)"}},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "max_redemptions", Type: field.TypeInt, Nullable: true},
+		{Name: "max_redemptions_per_customer", Type: field.TypeInt, Nullable: true},
+		{Name: "times_redeemed", Type: field.TypeInt, Default: 0},
+		{Name: "first_time_customer_only", Type: field.TypeBool, Default: false},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "minimum_amount", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "minimum_amount_currency", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(10)"}},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+	}
+	// PromotionCodesTable holds the schema information for the "promotion_codes" table.
+	PromotionCodesTable = &schema.Table{
+		Name:       "promotion_codes",
+		Columns:    PromotionCodesColumns,
+		PrimaryKey: []*schema.Column{PromotionCodesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "promotioncode_tenant_id_environment_id",
+				Unique:  false,
+				Columns: []*schema.Column{PromotionCodesColumns[1], PromotionCodesColumns[7]},
+			},
+			{
+				Name:    "promotioncode_tenant_id_environment_id_coupon_id",
+				Unique:  false,
+				Columns: []*schema.Column{PromotionCodesColumns[1], PromotionCodesColumns[7], PromotionCodesColumns[8]},
+			},
+			{
+				Name:    "idx_promotion_code_tenant_environment_code_unique",
+				Unique:  true,
+				Columns: []*schema.Column{PromotionCodesColumns[1], PromotionCodesColumns[7], PromotionCodesColumns[9]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'published'",
+				},
+			},
+		},
+	}
+	// RbacRolesColumns holds the columns for the "rbac_roles" table.
+	RbacRolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "permissions", Type: field.TypeJSON, Nullable: true},
+		{Name: "environment_ids", Type: field.TypeJSON, Nullable: true},
+	}
+	// RbacRolesTable holds the schema information for the "rbac_roles" table.
+	RbacRolesTable = &schema.Table{
+		Name:       "rbac_roles",
+		Columns:    RbacRolesColumns,
+		PrimaryKey: []*schema.Column{RbacRolesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rbacrole_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{RbacRolesColumns[1], RbacRolesColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'published'",
+				},
+			},
+		},
+	}
+	// SSOConnectionsColumns holds the columns for the "sso_connections" table.
+	SSOConnectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "protocol", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "domains", Type: field.TypeJSON, Nullable: true},
+		{Name: "verified_domains", Type: field.TypeJSON, Nullable: true},
+		{Name: "domain_verification_token", Type: field.TypeString, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "issuer_url", Type: field.TypeString, Nullable: true},
+		{Name: "client_id", Type: field.TypeString, Nullable: true},
+		{Name: "client_secret", Type: field.TypeString, Nullable: true},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "idp_metadata_url", Type: field.TypeString, Nullable: true},
+		{Name: "idp_metadata_xml", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "role_claim", Type: field.TypeString, Nullable: true},
+		{Name: "role_mappings", Type: field.TypeJSON, Nullable: true},
+		{Name: "default_roles", Type: field.TypeJSON, Nullable: true},
+		{Name: "jit_provisioning", Type: field.TypeBool, Default: true},
+	}
+	// SSOConnectionsTable holds the schema information for the "sso_connections" table.
+	SSOConnectionsTable = &schema.Table{
+		Name:       "sso_connections",
+		Columns:    SSOConnectionsColumns,
+		PrimaryKey: []*schema.Column{SSOConnectionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ssoconnection_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{SSOConnectionsColumns[1], SSOConnectionsColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'published'",
+				},
+			},
+		},
+	}
 	// ScheduledTasksColumns holds the columns for the "scheduled_tasks" table.
 	ScheduledTasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
@@ -1524,6 +1916,10 @@ var (
 		{Name: "provider_data", Type: field.TypeJSON, Nullable: true},
 		{Name: "roles", Type: field.TypeJSON, Nullable: true},
 		{Name: "user_type", Type: field.TypeString, Nullable: true, Default: "user"},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "allowed_ips", Type: field.TypeJSON, Nullable: true},
+		{Name: "rate_limit_per_minute", Type: field.TypeInt, Nullable: true},
+		{Name: "rotated_from_id", Type: field.TypeString, Nullable: true},
 	}
 	// SecretsTable holds the schema information for the "secrets" table.
 	SecretsTable = &schema.Table{
@@ -1619,6 +2015,7 @@ var (
 		{Name: "parent_subscription_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "payment_terms", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(20)"}},
 		{Name: "subscription_type", Type: field.TypeString, Default: "standalone", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "seat_config", Type: field.TypeJSON, Nullable: true},
 		{Name: "invoicing_customer_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 	}
 	// SubscriptionsTable holds the schema information for the "subscriptions" table.
@@ -1629,7 +2026,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "subscriptions_customers_invoicing_customer",
-				Columns:    []*schema.Column{SubscriptionsColumns[43]},
+				Columns:    []*schema.Column{SubscriptionsColumns[44]},
 				RefColumns: []*schema.Column{CustomersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -1910,6 +2307,45 @@ var (
 			},
 		},
 	}
+	// SubscriptionSeatChangesColumns holds the columns for the "subscription_seat_changes" table.
+	SubscriptionSeatChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "subscription_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "line_item_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "previous_seats", Type: field.TypeInt64},
+		{Name: "new_seats", Type: field.TypeInt64},
+		{Name: "source", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "proration_behavior", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "effective_date", Type: field.TypeTime},
+		{Name: "proration_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "invoice_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "wallet_transaction_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+	}
+	// SubscriptionSeatChangesTable holds the schema information for the "subscription_seat_changes" table.
+	SubscriptionSeatChangesTable = &schema.Table{
+		Name:       "subscription_seat_changes",
+		Columns:    SubscriptionSeatChangesColumns,
+		PrimaryKey: []*schema.Column{SubscriptionSeatChangesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "subscriptionseatchange_tenant_id_environment_id_subscription_id_effective_date",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionSeatChangesColumns[1], SubscriptionSeatChangesColumns[7], SubscriptionSeatChangesColumns[8], SubscriptionSeatChangesColumns[14]},
+			},
+			{
+				Name:    "subscriptionseatchange_tenant_id_environment_id_proration_behavior_invoice_id",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionSeatChangesColumns[1], SubscriptionSeatChangesColumns[7], SubscriptionSeatChangesColumns[13], SubscriptionSeatChangesColumns[16]},
+			},
+		},
+	}
 	// SystemEventsColumns holds the columns for the "system_events" table.
 	SystemEventsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
@@ -1926,6 +2362,8 @@ var (
 		{Name: "webhook_message_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(128)"}},
 		{Name: "published_at", Type: field.TypeTime, Nullable: true},
 		{Name: "payload", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "sequence_number", Type: field.TypeInt64, Nullable: true},
+		{Name: "snapshot", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "failure_count", Type: field.TypeInt, Default: 0},
 		{Name: "failure_reason", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 	}
@@ -1940,6 +2378,14 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{SystemEventsColumns[1], SystemEventsColumns[7]},
 			},
+			{
+				Name:    "idx_system_events_entity_sequence",
+				Unique:  true,
+				Columns: []*schema.Column{SystemEventsColumns[1], SystemEventsColumns[7], SystemEventsColumns[9], SystemEventsColumns[10], SystemEventsColumns[14]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "sequence_number IS NOT NULL",
+				},
+			},
 		},
 	}
 	// TasksColumns holds the columns for the "tasks" table.
@@ -1965,6 +2411,7 @@ var (
 		{Name: "successful_records", Type: field.TypeInt, Default: 0},
 		{Name: "failed_records", Type: field.TypeInt, Default: 0},
 		{Name: "error_summary", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "error_report", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
@@ -2132,6 +2579,40 @@ var (
 			},
 		},
 	}
+	// TenantMembershipsColumns holds the columns for the "tenant_memberships" table.
+	TenantMembershipsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "email", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "roles", Type: field.TypeJSON, Nullable: true},
+	}
+	// TenantMembershipsTable holds the schema information for the "tenant_memberships" table.
+	TenantMembershipsTable = &schema.Table{
+		Name:       "tenant_memberships",
+		Columns:    TenantMembershipsColumns,
+		PrimaryKey: []*schema.Column{TenantMembershipsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tenantmembership_tenant_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{TenantMembershipsColumns[1], TenantMembershipsColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'published'",
+				},
+			},
+			{
+				Name:    "tenantmembership_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{TenantMembershipsColumns[7], TenantMembershipsColumns[2]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
@@ -2242,6 +2723,10 @@ var (
 		{Name: "currency", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(10)"}},
 		{Name: "conversion_rate", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(10,5)"}},
 		{Name: "topup_conversion_rate", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(10,5)"}},
+		{Name: "fx_rate", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(24,12)"}},
+		{Name: "fx_rate_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "settlement_currency", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(10)"}},
+		{Name: "settlement_amount", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
 		{Name: "idempotency_key", Type: field.TypeString, Nullable: true},
 		{Name: "transaction_reason", Type: field.TypeString, Default: "FREE_CREDIT_GRANT", SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "priority", Type: field.TypeInt, Nullable: true},
@@ -2283,13 +2768,99 @@ var (
 			{
 				Name:    "idx_tenant_environment_idempotency_key",
 				Unique:  true,
-				Columns: []*schema.Column{WalletTransactionsColumns[1], WalletTransactionsColumns[7], WalletTransactionsColumns[25]},
+				Columns: []*schema.Column{WalletTransactionsColumns[1], WalletTransactionsColumns[7], WalletTransactionsColumns[29]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "idempotency_key IS NOT NULL AND idempotency_key <> '' AND status='published'",
 				},
 			},
 		},
 	}
+	// WebhookDeliveryAttemptsColumns holds the columns for the "webhook_delivery_attempts" table.
+	WebhookDeliveryAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "system_event_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "webhook_endpoint_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "event_name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "attempt_number", Type: field.TypeInt, Default: 1},
+		{Name: "request_url", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(2048)"}},
+		{Name: "request_headers", Type: field.TypeJSON, Nullable: true},
+		{Name: "request_body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "response_status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "response_body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "latency_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "success", Type: field.TypeBool, Default: false},
+		{Name: "error_message", Type: field.TypeString, Nullable: true},
+	}
+	// WebhookDeliveryAttemptsTable holds the schema information for the "webhook_delivery_attempts" table.
+	WebhookDeliveryAttemptsTable = &schema.Table{
+		Name:       "webhook_delivery_attempts",
+		Columns:    WebhookDeliveryAttemptsColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveryAttemptsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdeliveryattempt_tenant_id_environment_id_system_event_id_webhook_endpoint_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveryAttemptsColumns[1], WebhookDeliveryAttemptsColumns[7], WebhookDeliveryAttemptsColumns[8], WebhookDeliveryAttemptsColumns[9]},
+			},
+			{
+				Name:    "webhookdeliveryattempt_tenant_id_environment_id_webhook_endpoint_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveryAttemptsColumns[1], WebhookDeliveryAttemptsColumns[7], WebhookDeliveryAttemptsColumns[9], WebhookDeliveryAttemptsColumns[3]},
+			},
+		},
+	}
+	// WebhookEndpointsColumns holds the columns for the "webhook_endpoints" table.
+	WebhookEndpointsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "url", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(2048)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "event_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "headers", Type: field.TypeJSON, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "signing_secret", Type: field.TypeString, Nullable: true},
+		{Name: "previous_signing_secret", Type: field.TypeString, Nullable: true},
+		{Name: "previous_signing_secret_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "consecutive_failures", Type: field.TypeInt, Default: 0},
+		{Name: "failing_since", Type: field.TypeTime, Nullable: true},
+		{Name: "last_success_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_failure_at", Type: field.TypeTime, Nullable: true},
+		{Name: "disabled_reason", Type: field.TypeString, Nullable: true},
+		{Name: "payload_version", Type: field.TypeString, Default: "v1", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "payload_mode", Type: field.TypeString, Default: "full", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+	}
+	// WebhookEndpointsTable holds the schema information for the "webhook_endpoints" table.
+	WebhookEndpointsTable = &schema.Table{
+		Name:       "webhook_endpoints",
+		Columns:    WebhookEndpointsColumns,
+		PrimaryKey: []*schema.Column{WebhookEndpointsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookendpoint_tenant_id_environment_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookEndpointsColumns[1], WebhookEndpointsColumns[7]},
+			},
+			{
+				Name:    "webhookendpoint_tenant_id_environment_id_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookEndpointsColumns[1], WebhookEndpointsColumns[7], WebhookEndpointsColumns[12]},
+			},
+		},
+	}
 	// WorkflowExecutionsColumns holds the columns for the "workflow_executions" table.
 	WorkflowExecutionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(26)"}},
@@ -2385,8 +2956,11 @@ var (
 		AddonsTable,
 		AddonAssociationsTable,
 		AlertLogsTable,
+		AuditLogsTable,
 		AuthsTable,
 		BillingSequencesTable,
+		CommitmentContractsTable,
+		CommitmentDrawdownsTable,
 		ConnectionsTable,
 		CostsheetsTable,
 		CouponsTable,
@@ -2400,8 +2974,10 @@ var (
 		EntitlementsTable,
 		EntityIntegrationMappingsTable,
 		EnvironmentsTable,
+		FxRatesTable,
 		FeaturesTable,
 		GroupsTable,
+		InvitationsTable,
 		InvoicesTable,
 		InvoiceLineItemsTable,
 		InvoiceSequencesTable,
@@ -2410,7 +2986,11 @@ var (
 		PaymentAttemptsTable,
 		PlansTable,
 		PricesTable,
+		PriceBooksTable,
 		PriceUnitsTable,
+		PromotionCodesTable,
+		RbacRolesTable,
+		SSOConnectionsTable,
 		ScheduledTasksTable,
 		SecretsTable,
 		SettingsTable,
@@ -2419,15 +2999,19 @@ var (
 		SubscriptionPausesTable,
 		SubscriptionPhasesTable,
 		SubscriptionSchedulesTable,
+		SubscriptionSeatChangesTable,
 		SystemEventsTable,
 		TasksTable,
 		TaxAppliedsTable,
 		TaxAssociationsTable,
 		TaxRatesTable,
 		TenantsTable,
+		TenantMembershipsTable,
 		UsersTable,
 		WalletsTable,
 		WalletTransactionsTable,
+		WebhookDeliveryAttemptsTable,
+		WebhookEndpointsTable,
 		WorkflowExecutionsTable,
 		CouponAssociationCouponApplicationsTable,
 	}
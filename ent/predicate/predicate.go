@@ -15,12 +15,21 @@ type AddonAssociation func(*sql.Selector)
 // AlertLogs is the predicate function for alertlogs builders.
 type AlertLogs func(*sql.Selector)
 
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
 // Auth is the predicate function for auth builders.
 type Auth func(*sql.Selector)
 
 // BillingSequence is the predicate function for billingsequence builders.
 type BillingSequence func(*sql.Selector)
 
+// CommitmentContract is the predicate function for commitmentcontract builders.
+type CommitmentContract func(*sql.Selector)
+
+// CommitmentDrawdown is the predicate function for commitmentdrawdown builders.
+type CommitmentDrawdown func(*sql.Selector)
+
 // Connection is the predicate function for connection builders.
 type Connection func(*sql.Selector)
 
@@ -60,12 +69,18 @@ type EntityIntegrationMapping func(*sql.Selector)
 // Environment is the predicate function for environment builders.
 type Environment func(*sql.Selector)
 
+// FXRate is the predicate function for fxrate builders.
+type FXRate func(*sql.Selector)
+
 // Feature is the predicate function for feature builders.
 type Feature func(*sql.Selector)
 
 // Group is the predicate function for group builders.
 type Group func(*sql.Selector)
 
+// Invitation is the predicate function for invitation builders.
+type Invitation func(*sql.Selector)
+
 // Invoice is the predicate function for invoice builders.
 type Invoice func(*sql.Selector)
 
@@ -90,9 +105,21 @@ type Plan func(*sql.Selector)
 // Price is the predicate function for price builders.
 type Price func(*sql.Selector)
 
+// PriceBook is the predicate function for pricebook builders.
+type PriceBook func(*sql.Selector)
+
 // PriceUnit is the predicate function for priceunit builders.
 type PriceUnit func(*sql.Selector)
 
+// PromotionCode is the predicate function for promotioncode builders.
+type PromotionCode func(*sql.Selector)
+
+// RBACRole is the predicate function for rbacrole builders.
+type RBACRole func(*sql.Selector)
+
+// SSOConnection is the predicate function for ssoconnection builders.
+type SSOConnection func(*sql.Selector)
+
 // ScheduledTask is the predicate function for scheduledtask builders.
 type ScheduledTask func(*sql.Selector)
 
@@ -117,6 +144,9 @@ type SubscriptionPhase func(*sql.Selector)
 // SubscriptionSchedule is the predicate function for subscriptionschedule builders.
 type SubscriptionSchedule func(*sql.Selector)
 
+// SubscriptionSeatChange is the predicate function for subscriptionseatchange builders.
+type SubscriptionSeatChange func(*sql.Selector)
+
 // SystemEvent is the predicate function for systemevent builders.
 type SystemEvent func(*sql.Selector)
 
@@ -135,6 +165,9 @@ type TaxRate func(*sql.Selector)
 // Tenant is the predicate function for tenant builders.
 type Tenant func(*sql.Selector)
 
+// TenantMembership is the predicate function for tenantmembership builders.
+type TenantMembership func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
@@ -144,5 +177,11 @@ type Wallet func(*sql.Selector)
 // WalletTransaction is the predicate function for wallettransaction builders.
 type WalletTransaction func(*sql.Selector)
 
+// WebhookDeliveryAttempt is the predicate function for webhookdeliveryattempt builders.
+type WebhookDeliveryAttempt func(*sql.Selector)
+
+// WebhookEndpoint is the predicate function for webhookendpoint builders.
+type WebhookEndpoint func(*sql.Selector)
+
 // WorkflowExecution is the predicate function for workflowexecution builders.
 type WorkflowExecution func(*sql.Selector)
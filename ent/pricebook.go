@@ -0,0 +1,272 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/pricebook"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// PriceBook is the model entity for the PriceBook schema.
+type PriceBook struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Currency of the prices the book localizes
+	BaseCurrency string `json:"base_currency,omitempty"`
+	// Plans the book applies to, empty for all plans
+	PlanIds []string `json:"plan_ids,omitempty"`
+	// Addons the book applies to, empty for all addons
+	AddonIds []string `json:"addon_ids,omitempty"`
+	// Conversion rules and rounding per target currency
+	Currencies []types.PriceBookCurrency `json:"currencies,omitempty"`
+	// Explicit localized amounts per price and currency
+	Overrides []types.PriceBookPriceOverride `json:"overrides,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata     map[string]string `json:"metadata,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PriceBook) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pricebook.FieldPlanIds, pricebook.FieldAddonIds, pricebook.FieldCurrencies, pricebook.FieldOverrides, pricebook.FieldMetadata:
+			values[i] = new([]byte)
+		case pricebook.FieldID, pricebook.FieldTenantID, pricebook.FieldStatus, pricebook.FieldCreatedBy, pricebook.FieldUpdatedBy, pricebook.FieldEnvironmentID, pricebook.FieldName, pricebook.FieldDescription, pricebook.FieldBaseCurrency:
+			values[i] = new(sql.NullString)
+		case pricebook.FieldCreatedAt, pricebook.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PriceBook fields.
+func (pb *PriceBook) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pricebook.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				pb.ID = value.String
+			}
+		case pricebook.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				pb.TenantID = value.String
+			}
+		case pricebook.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pb.Status = value.String
+			}
+		case pricebook.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pb.CreatedAt = value.Time
+			}
+		case pricebook.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pb.UpdatedAt = value.Time
+			}
+		case pricebook.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				pb.CreatedBy = value.String
+			}
+		case pricebook.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				pb.UpdatedBy = value.String
+			}
+		case pricebook.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				pb.EnvironmentID = value.String
+			}
+		case pricebook.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pb.Name = value.String
+			}
+		case pricebook.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				pb.Description = value.String
+			}
+		case pricebook.FieldBaseCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_currency", values[i])
+			} else if value.Valid {
+				pb.BaseCurrency = value.String
+			}
+		case pricebook.FieldPlanIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pb.PlanIds); err != nil {
+					return fmt.Errorf("unmarshal field plan_ids: %w", err)
+				}
+			}
+		case pricebook.FieldAddonIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field addon_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pb.AddonIds); err != nil {
+					return fmt.Errorf("unmarshal field addon_ids: %w", err)
+				}
+			}
+		case pricebook.FieldCurrencies:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field currencies", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pb.Currencies); err != nil {
+					return fmt.Errorf("unmarshal field currencies: %w", err)
+				}
+			}
+		case pricebook.FieldOverrides:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field overrides", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pb.Overrides); err != nil {
+					return fmt.Errorf("unmarshal field overrides: %w", err)
+				}
+			}
+		case pricebook.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pb.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		default:
+			pb.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PriceBook.
+// This includes values selected through modifiers, order, etc.
+func (pb *PriceBook) Value(name string) (ent.Value, error) {
+	return pb.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PriceBook.
+// Note that you need to call PriceBook.Unwrap() before calling this method if this PriceBook
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pb *PriceBook) Update() *PriceBookUpdateOne {
+	return NewPriceBookClient(pb.config).UpdateOne(pb)
+}
+
+// Unwrap unwraps the PriceBook entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pb *PriceBook) Unwrap() *PriceBook {
+	_tx, ok := pb.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PriceBook is not a transactional entity")
+	}
+	pb.config.driver = _tx.drv
+	return pb
+}
+
+// String implements the fmt.Stringer.
+func (pb *PriceBook) String() string {
+	var builder strings.Builder
+	builder.WriteString("PriceBook(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pb.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(pb.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(pb.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pb.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pb.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(pb.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(pb.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(pb.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pb.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(pb.Description)
+	builder.WriteString(", ")
+	builder.WriteString("base_currency=")
+	builder.WriteString(pb.BaseCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("plan_ids=")
+	builder.WriteString(fmt.Sprintf("%v", pb.PlanIds))
+	builder.WriteString(", ")
+	builder.WriteString("addon_ids=")
+	builder.WriteString(fmt.Sprintf("%v", pb.AddonIds))
+	builder.WriteString(", ")
+	builder.WriteString("currencies=")
+	builder.WriteString(fmt.Sprintf("%v", pb.Currencies))
+	builder.WriteString(", ")
+	builder.WriteString("overrides=")
+	builder.WriteString(fmt.Sprintf("%v", pb.Overrides))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", pb.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PriceBooks is a parsable slice of PriceBook.
+type PriceBooks []*PriceBook
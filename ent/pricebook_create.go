@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/pricebook"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// PriceBookCreate is the builder for creating a PriceBook entity.
+type PriceBookCreate struct {
+	config
+	mutation *PriceBookMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (pbc *PriceBookCreate) SetTenantID(s string) *PriceBookCreate {
+	pbc.mutation.SetTenantID(s)
+	return pbc
+}
+
+// SetStatus sets the "status" field.
+func (pbc *PriceBookCreate) SetStatus(s string) *PriceBookCreate {
+	pbc.mutation.SetStatus(s)
+	return pbc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pbc *PriceBookCreate) SetNillableStatus(s *string) *PriceBookCreate {
+	if s != nil {
+		pbc.SetStatus(*s)
+	}
+	return pbc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pbc *PriceBookCreate) SetCreatedAt(t time.Time) *PriceBookCreate {
+	pbc.mutation.SetCreatedAt(t)
+	return pbc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pbc *PriceBookCreate) SetNillableCreatedAt(t *time.Time) *PriceBookCreate {
+	if t != nil {
+		pbc.SetCreatedAt(*t)
+	}
+	return pbc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pbc *PriceBookCreate) SetUpdatedAt(t time.Time) *PriceBookCreate {
+	pbc.mutation.SetUpdatedAt(t)
+	return pbc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pbc *PriceBookCreate) SetNillableUpdatedAt(t *time.Time) *PriceBookCreate {
+	if t != nil {
+		pbc.SetUpdatedAt(*t)
+	}
+	return pbc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (pbc *PriceBookCreate) SetCreatedBy(s string) *PriceBookCreate {
+	pbc.mutation.SetCreatedBy(s)
+	return pbc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (pbc *PriceBookCreate) SetNillableCreatedBy(s *string) *PriceBookCreate {
+	if s != nil {
+		pbc.SetCreatedBy(*s)
+	}
+	return pbc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (pbc *PriceBookCreate) SetUpdatedBy(s string) *PriceBookCreate {
+	pbc.mutation.SetUpdatedBy(s)
+	return pbc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (pbc *PriceBookCreate) SetNillableUpdatedBy(s *string) *PriceBookCreate {
+	if s != nil {
+		pbc.SetUpdatedBy(*s)
+	}
+	return pbc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (pbc *PriceBookCreate) SetEnvironmentID(s string) *PriceBookCreate {
+	pbc.mutation.SetEnvironmentID(s)
+	return pbc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (pbc *PriceBookCreate) SetNillableEnvironmentID(s *string) *PriceBookCreate {
+	if s != nil {
+		pbc.SetEnvironmentID(*s)
+	}
+	return pbc
+}
+
+// SetName sets the "name" field.
+func (pbc *PriceBookCreate) SetName(s string) *PriceBookCreate {
+	pbc.mutation.SetName(s)
+	return pbc
+}
+
+// SetDescription sets the "description" field.
+func (pbc *PriceBookCreate) SetDescription(s string) *PriceBookCreate {
+	pbc.mutation.SetDescription(s)
+	return pbc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pbc *PriceBookCreate) SetNillableDescription(s *string) *PriceBookCreate {
+	if s != nil {
+		pbc.SetDescription(*s)
+	}
+	return pbc
+}
+
+// SetBaseCurrency sets the "base_currency" field.
+func (pbc *PriceBookCreate) SetBaseCurrency(s string) *PriceBookCreate {
+	pbc.mutation.SetBaseCurrency(s)
+	return pbc
+}
+
+// SetPlanIds sets the "plan_ids" field.
+func (pbc *PriceBookCreate) SetPlanIds(s []string) *PriceBookCreate {
+	pbc.mutation.SetPlanIds(s)
+	return pbc
+}
+
+// SetAddonIds sets the "addon_ids" field.
+func (pbc *PriceBookCreate) SetAddonIds(s []string) *PriceBookCreate {
+	pbc.mutation.SetAddonIds(s)
+	return pbc
+}
+
+// SetCurrencies sets the "currencies" field.
+func (pbc *PriceBookCreate) SetCurrencies(tbc []types.PriceBookCurrency) *PriceBookCreate {
+	pbc.mutation.SetCurrencies(tbc)
+	return pbc
+}
+
+// SetOverrides sets the "overrides" field.
+func (pbc *PriceBookCreate) SetOverrides(tbpo []types.PriceBookPriceOverride) *PriceBookCreate {
+	pbc.mutation.SetOverrides(tbpo)
+	return pbc
+}
+
+// SetMetadata sets the "metadata" field.
+func (pbc *PriceBookCreate) SetMetadata(m map[string]string) *PriceBookCreate {
+	pbc.mutation.SetMetadata(m)
+	return pbc
+}
+
+// SetID sets the "id" field.
+func (pbc *PriceBookCreate) SetID(s string) *PriceBookCreate {
+	pbc.mutation.SetID(s)
+	return pbc
+}
+
+// Mutation returns the PriceBookMutation object of the builder.
+func (pbc *PriceBookCreate) Mutation() *PriceBookMutation {
+	return pbc.mutation
+}
+
+// Save creates the PriceBook in the database.
+func (pbc *PriceBookCreate) Save(ctx context.Context) (*PriceBook, error) {
+	pbc.defaults()
+	return withHooks(ctx, pbc.sqlSave, pbc.mutation, pbc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pbc *PriceBookCreate) SaveX(ctx context.Context) *PriceBook {
+	v, err := pbc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pbc *PriceBookCreate) Exec(ctx context.Context) error {
+	_, err := pbc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbc *PriceBookCreate) ExecX(ctx context.Context) {
+	if err := pbc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pbc *PriceBookCreate) defaults() {
+	if _, ok := pbc.mutation.Status(); !ok {
+		v := pricebook.DefaultStatus
+		pbc.mutation.SetStatus(v)
+	}
+	if _, ok := pbc.mutation.CreatedAt(); !ok {
+		v := pricebook.DefaultCreatedAt()
+		pbc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pbc.mutation.UpdatedAt(); !ok {
+		v := pricebook.DefaultUpdatedAt()
+		pbc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pbc.mutation.EnvironmentID(); !ok {
+		v := pricebook.DefaultEnvironmentID
+		pbc.mutation.SetEnvironmentID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pbc *PriceBookCreate) check() error {
+	if _, ok := pbc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "PriceBook.tenant_id"`)}
+	}
+	if v, ok := pbc.mutation.TenantID(); ok {
+		if err := pricebook.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "PriceBook.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := pbc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PriceBook.status"`)}
+	}
+	if _, ok := pbc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PriceBook.created_at"`)}
+	}
+	if _, ok := pbc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PriceBook.updated_at"`)}
+	}
+	if _, ok := pbc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PriceBook.name"`)}
+	}
+	if v, ok := pbc.mutation.Name(); ok {
+		if err := pricebook.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PriceBook.name": %w`, err)}
+		}
+	}
+	if _, ok := pbc.mutation.BaseCurrency(); !ok {
+		return &ValidationError{Name: "base_currency", err: errors.New(`ent: missing required field "PriceBook.base_currency"`)}
+	}
+	if v, ok := pbc.mutation.BaseCurrency(); ok {
+		if err := pricebook.BaseCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "base_currency", err: fmt.Errorf(`ent: validator failed for field "PriceBook.base_currency": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pbc *PriceBookCreate) sqlSave(ctx context.Context) (*PriceBook, error) {
+	if err := pbc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pbc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pbc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PriceBook.ID type: %T", _spec.ID.Value)
+		}
+	}
+	pbc.mutation.id = &_node.ID
+	pbc.mutation.done = true
+	return _node, nil
+}
+
+func (pbc *PriceBookCreate) createSpec() (*PriceBook, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PriceBook{config: pbc.config}
+		_spec = sqlgraph.NewCreateSpec(pricebook.Table, sqlgraph.NewFieldSpec(pricebook.FieldID, field.TypeString))
+	)
+	if id, ok := pbc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pbc.mutation.TenantID(); ok {
+		_spec.SetField(pricebook.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := pbc.mutation.Status(); ok {
+		_spec.SetField(pricebook.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := pbc.mutation.CreatedAt(); ok {
+		_spec.SetField(pricebook.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pbc.mutation.UpdatedAt(); ok {
+		_spec.SetField(pricebook.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pbc.mutation.CreatedBy(); ok {
+		_spec.SetField(pricebook.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := pbc.mutation.UpdatedBy(); ok {
+		_spec.SetField(pricebook.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := pbc.mutation.EnvironmentID(); ok {
+		_spec.SetField(pricebook.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := pbc.mutation.Name(); ok {
+		_spec.SetField(pricebook.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := pbc.mutation.Description(); ok {
+		_spec.SetField(pricebook.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := pbc.mutation.BaseCurrency(); ok {
+		_spec.SetField(pricebook.FieldBaseCurrency, field.TypeString, value)
+		_node.BaseCurrency = value
+	}
+	if value, ok := pbc.mutation.PlanIds(); ok {
+		_spec.SetField(pricebook.FieldPlanIds, field.TypeJSON, value)
+		_node.PlanIds = value
+	}
+	if value, ok := pbc.mutation.AddonIds(); ok {
+		_spec.SetField(pricebook.FieldAddonIds, field.TypeJSON, value)
+		_node.AddonIds = value
+	}
+	if value, ok := pbc.mutation.Currencies(); ok {
+		_spec.SetField(pricebook.FieldCurrencies, field.TypeJSON, value)
+		_node.Currencies = value
+	}
+	if value, ok := pbc.mutation.Overrides(); ok {
+		_spec.SetField(pricebook.FieldOverrides, field.TypeJSON, value)
+		_node.Overrides = value
+	}
+	if value, ok := pbc.mutation.Metadata(); ok {
+		_spec.SetField(pricebook.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	return _node, _spec
+}
+
+// PriceBookCreateBulk is the builder for creating many PriceBook entities in bulk.
+type PriceBookCreateBulk struct {
+	config
+	err      error
+	builders []*PriceBookCreate
+}
+
+// Save creates the PriceBook entities in the database.
+func (pbcb *PriceBookCreateBulk) Save(ctx context.Context) ([]*PriceBook, error) {
+	if pbcb.err != nil {
+		return nil, pbcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pbcb.builders))
+	nodes := make([]*PriceBook, len(pbcb.builders))
+	mutators := make([]Mutator, len(pbcb.builders))
+	for i := range pbcb.builders {
+		func(i int, root context.Context) {
+			builder := pbcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PriceBookMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pbcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pbcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pbcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pbcb *PriceBookCreateBulk) SaveX(ctx context.Context) []*PriceBook {
+	v, err := pbcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pbcb *PriceBookCreateBulk) Exec(ctx context.Context) error {
+	_, err := pbcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbcb *PriceBookCreateBulk) ExecX(ctx context.Context) {
+	if err := pbcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/pricebook"
+)
+
+// PriceBookDelete is the builder for deleting a PriceBook entity.
+type PriceBookDelete struct {
+	config
+	hooks    []Hook
+	mutation *PriceBookMutation
+}
+
+// Where appends a list predicates to the PriceBookDelete builder.
+func (pbd *PriceBookDelete) Where(ps ...predicate.PriceBook) *PriceBookDelete {
+	pbd.mutation.Where(ps...)
+	return pbd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pbd *PriceBookDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pbd.sqlExec, pbd.mutation, pbd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbd *PriceBookDelete) ExecX(ctx context.Context) int {
+	n, err := pbd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pbd *PriceBookDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(pricebook.Table, sqlgraph.NewFieldSpec(pricebook.FieldID, field.TypeString))
+	if ps := pbd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pbd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pbd.mutation.done = true
+	return affected, err
+}
+
+// PriceBookDeleteOne is the builder for deleting a single PriceBook entity.
+type PriceBookDeleteOne struct {
+	pbd *PriceBookDelete
+}
+
+// Where appends a list predicates to the PriceBookDelete builder.
+func (pbdo *PriceBookDeleteOne) Where(ps ...predicate.PriceBook) *PriceBookDeleteOne {
+	pbdo.pbd.mutation.Where(ps...)
+	return pbdo
+}
+
+// Exec executes the deletion query.
+func (pbdo *PriceBookDeleteOne) Exec(ctx context.Context) error {
+	n, err := pbdo.pbd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pricebook.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbdo *PriceBookDeleteOne) ExecX(ctx context.Context) {
+	if err := pbdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/pricebook"
+)
+
+// PriceBookQuery is the builder for querying PriceBook entities.
+type PriceBookQuery struct {
+	config
+	ctx        *QueryContext
+	order      []pricebook.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PriceBook
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PriceBookQuery builder.
+func (pbq *PriceBookQuery) Where(ps ...predicate.PriceBook) *PriceBookQuery {
+	pbq.predicates = append(pbq.predicates, ps...)
+	return pbq
+}
+
+// Limit the number of records to be returned by this query.
+func (pbq *PriceBookQuery) Limit(limit int) *PriceBookQuery {
+	pbq.ctx.Limit = &limit
+	return pbq
+}
+
+// Offset to start from.
+func (pbq *PriceBookQuery) Offset(offset int) *PriceBookQuery {
+	pbq.ctx.Offset = &offset
+	return pbq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pbq *PriceBookQuery) Unique(unique bool) *PriceBookQuery {
+	pbq.ctx.Unique = &unique
+	return pbq
+}
+
+// Order specifies how the records should be ordered.
+func (pbq *PriceBookQuery) Order(o ...pricebook.OrderOption) *PriceBookQuery {
+	pbq.order = append(pbq.order, o...)
+	return pbq
+}
+
+// First returns the first PriceBook entity from the query.
+// Returns a *NotFoundError when no PriceBook was found.
+func (pbq *PriceBookQuery) First(ctx context.Context) (*PriceBook, error) {
+	nodes, err := pbq.Limit(1).All(setContextOp(ctx, pbq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{pricebook.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pbq *PriceBookQuery) FirstX(ctx context.Context) *PriceBook {
+	node, err := pbq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PriceBook ID from the query.
+// Returns a *NotFoundError when no PriceBook ID was found.
+func (pbq *PriceBookQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = pbq.Limit(1).IDs(setContextOp(ctx, pbq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{pricebook.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pbq *PriceBookQuery) FirstIDX(ctx context.Context) string {
+	id, err := pbq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PriceBook entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PriceBook entity is found.
+// Returns a *NotFoundError when no PriceBook entities are found.
+func (pbq *PriceBookQuery) Only(ctx context.Context) (*PriceBook, error) {
+	nodes, err := pbq.Limit(2).All(setContextOp(ctx, pbq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{pricebook.Label}
+	default:
+		return nil, &NotSingularError{pricebook.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pbq *PriceBookQuery) OnlyX(ctx context.Context) *PriceBook {
+	node, err := pbq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PriceBook ID in the query.
+// Returns a *NotSingularError when more than one PriceBook ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pbq *PriceBookQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = pbq.Limit(2).IDs(setContextOp(ctx, pbq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{pricebook.Label}
+	default:
+		err = &NotSingularError{pricebook.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pbq *PriceBookQuery) OnlyIDX(ctx context.Context) string {
+	id, err := pbq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PriceBooks.
+func (pbq *PriceBookQuery) All(ctx context.Context) ([]*PriceBook, error) {
+	ctx = setContextOp(ctx, pbq.ctx, ent.OpQueryAll)
+	if err := pbq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PriceBook, *PriceBookQuery]()
+	return withInterceptors[[]*PriceBook](ctx, pbq, qr, pbq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pbq *PriceBookQuery) AllX(ctx context.Context) []*PriceBook {
+	nodes, err := pbq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PriceBook IDs.
+func (pbq *PriceBookQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if pbq.ctx.Unique == nil && pbq.path != nil {
+		pbq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pbq.ctx, ent.OpQueryIDs)
+	if err = pbq.Select(pricebook.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pbq *PriceBookQuery) IDsX(ctx context.Context) []string {
+	ids, err := pbq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pbq *PriceBookQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pbq.ctx, ent.OpQueryCount)
+	if err := pbq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pbq, querierCount[*PriceBookQuery](), pbq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pbq *PriceBookQuery) CountX(ctx context.Context) int {
+	count, err := pbq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pbq *PriceBookQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pbq.ctx, ent.OpQueryExist)
+	switch _, err := pbq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pbq *PriceBookQuery) ExistX(ctx context.Context) bool {
+	exist, err := pbq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PriceBookQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pbq *PriceBookQuery) Clone() *PriceBookQuery {
+	if pbq == nil {
+		return nil
+	}
+	return &PriceBookQuery{
+		config:     pbq.config,
+		ctx:        pbq.ctx.Clone(),
+		order:      append([]pricebook.OrderOption{}, pbq.order...),
+		inters:     append([]Interceptor{}, pbq.inters...),
+		predicates: append([]predicate.PriceBook{}, pbq.predicates...),
+		// clone intermediate query.
+		sql:  pbq.sql.Clone(),
+		path: pbq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PriceBook.Query().
+//		GroupBy(pricebook.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pbq *PriceBookQuery) GroupBy(field string, fields ...string) *PriceBookGroupBy {
+	pbq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PriceBookGroupBy{build: pbq}
+	grbuild.flds = &pbq.ctx.Fields
+	grbuild.label = pricebook.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.PriceBook.Query().
+//		Select(pricebook.FieldTenantID).
+//		Scan(ctx, &v)
+func (pbq *PriceBookQuery) Select(fields ...string) *PriceBookSelect {
+	pbq.ctx.Fields = append(pbq.ctx.Fields, fields...)
+	sbuild := &PriceBookSelect{PriceBookQuery: pbq}
+	sbuild.label = pricebook.Label
+	sbuild.flds, sbuild.scan = &pbq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PriceBookSelect configured with the given aggregations.
+func (pbq *PriceBookQuery) Aggregate(fns ...AggregateFunc) *PriceBookSelect {
+	return pbq.Select().Aggregate(fns...)
+}
+
+func (pbq *PriceBookQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pbq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pbq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pbq.ctx.Fields {
+		if !pricebook.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pbq.path != nil {
+		prev, err := pbq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pbq.sql = prev
+	}
+	return nil
+}
+
+func (pbq *PriceBookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PriceBook, error) {
+	var (
+		nodes = []*PriceBook{}
+		_spec = pbq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PriceBook).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PriceBook{config: pbq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pbq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (pbq *PriceBookQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pbq.querySpec()
+	_spec.Node.Columns = pbq.ctx.Fields
+	if len(pbq.ctx.Fields) > 0 {
+		_spec.Unique = pbq.ctx.Unique != nil && *pbq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pbq.driver, _spec)
+}
+
+func (pbq *PriceBookQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(pricebook.Table, pricebook.Columns, sqlgraph.NewFieldSpec(pricebook.FieldID, field.TypeString))
+	_spec.From = pbq.sql
+	if unique := pbq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pbq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pbq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pricebook.FieldID)
+		for i := range fields {
+			if fields[i] != pricebook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pbq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pbq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pbq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pbq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pbq *PriceBookQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pbq.driver.Dialect())
+	t1 := builder.Table(pricebook.Table)
+	columns := pbq.ctx.Fields
+	if len(columns) == 0 {
+		columns = pricebook.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pbq.sql != nil {
+		selector = pbq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pbq.ctx.Unique != nil && *pbq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range pbq.predicates {
+		p(selector)
+	}
+	for _, p := range pbq.order {
+		p(selector)
+	}
+	if offset := pbq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pbq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PriceBookGroupBy is the group-by builder for PriceBook entities.
+type PriceBookGroupBy struct {
+	selector
+	build *PriceBookQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pbgb *PriceBookGroupBy) Aggregate(fns ...AggregateFunc) *PriceBookGroupBy {
+	pbgb.fns = append(pbgb.fns, fns...)
+	return pbgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pbgb *PriceBookGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pbgb.build.ctx, ent.OpQueryGroupBy)
+	if err := pbgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PriceBookQuery, *PriceBookGroupBy](ctx, pbgb.build, pbgb, pbgb.build.inters, v)
+}
+
+func (pbgb *PriceBookGroupBy) sqlScan(ctx context.Context, root *PriceBookQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pbgb.fns))
+	for _, fn := range pbgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pbgb.flds)+len(pbgb.fns))
+		for _, f := range *pbgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pbgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pbgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PriceBookSelect is the builder for selecting fields of PriceBook entities.
+type PriceBookSelect struct {
+	*PriceBookQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pbs *PriceBookSelect) Aggregate(fns ...AggregateFunc) *PriceBookSelect {
+	pbs.fns = append(pbs.fns, fns...)
+	return pbs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pbs *PriceBookSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pbs.ctx, ent.OpQuerySelect)
+	if err := pbs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PriceBookQuery, *PriceBookSelect](ctx, pbs.PriceBookQuery, pbs, pbs.inters, v)
+}
+
+func (pbs *PriceBookSelect) sqlScan(ctx context.Context, root *PriceBookQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pbs.fns))
+	for _, fn := range pbs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pbs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pbs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
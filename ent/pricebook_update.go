@@ -0,0 +1,692 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/pricebook"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// PriceBookUpdate is the builder for updating PriceBook entities.
+type PriceBookUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PriceBookMutation
+}
+
+// Where appends a list predicates to the PriceBookUpdate builder.
+func (pbu *PriceBookUpdate) Where(ps ...predicate.PriceBook) *PriceBookUpdate {
+	pbu.mutation.Where(ps...)
+	return pbu
+}
+
+// SetStatus sets the "status" field.
+func (pbu *PriceBookUpdate) SetStatus(s string) *PriceBookUpdate {
+	pbu.mutation.SetStatus(s)
+	return pbu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pbu *PriceBookUpdate) SetNillableStatus(s *string) *PriceBookUpdate {
+	if s != nil {
+		pbu.SetStatus(*s)
+	}
+	return pbu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pbu *PriceBookUpdate) SetUpdatedAt(t time.Time) *PriceBookUpdate {
+	pbu.mutation.SetUpdatedAt(t)
+	return pbu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (pbu *PriceBookUpdate) SetUpdatedBy(s string) *PriceBookUpdate {
+	pbu.mutation.SetUpdatedBy(s)
+	return pbu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (pbu *PriceBookUpdate) SetNillableUpdatedBy(s *string) *PriceBookUpdate {
+	if s != nil {
+		pbu.SetUpdatedBy(*s)
+	}
+	return pbu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (pbu *PriceBookUpdate) ClearUpdatedBy() *PriceBookUpdate {
+	pbu.mutation.ClearUpdatedBy()
+	return pbu
+}
+
+// SetName sets the "name" field.
+func (pbu *PriceBookUpdate) SetName(s string) *PriceBookUpdate {
+	pbu.mutation.SetName(s)
+	return pbu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pbu *PriceBookUpdate) SetNillableName(s *string) *PriceBookUpdate {
+	if s != nil {
+		pbu.SetName(*s)
+	}
+	return pbu
+}
+
+// SetDescription sets the "description" field.
+func (pbu *PriceBookUpdate) SetDescription(s string) *PriceBookUpdate {
+	pbu.mutation.SetDescription(s)
+	return pbu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pbu *PriceBookUpdate) SetNillableDescription(s *string) *PriceBookUpdate {
+	if s != nil {
+		pbu.SetDescription(*s)
+	}
+	return pbu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (pbu *PriceBookUpdate) ClearDescription() *PriceBookUpdate {
+	pbu.mutation.ClearDescription()
+	return pbu
+}
+
+// SetPlanIds sets the "plan_ids" field.
+func (pbu *PriceBookUpdate) SetPlanIds(s []string) *PriceBookUpdate {
+	pbu.mutation.SetPlanIds(s)
+	return pbu
+}
+
+// AppendPlanIds appends s to the "plan_ids" field.
+func (pbu *PriceBookUpdate) AppendPlanIds(s []string) *PriceBookUpdate {
+	pbu.mutation.AppendPlanIds(s)
+	return pbu
+}
+
+// ClearPlanIds clears the value of the "plan_ids" field.
+func (pbu *PriceBookUpdate) ClearPlanIds() *PriceBookUpdate {
+	pbu.mutation.ClearPlanIds()
+	return pbu
+}
+
+// SetAddonIds sets the "addon_ids" field.
+func (pbu *PriceBookUpdate) SetAddonIds(s []string) *PriceBookUpdate {
+	pbu.mutation.SetAddonIds(s)
+	return pbu
+}
+
+// AppendAddonIds appends s to the "addon_ids" field.
+func (pbu *PriceBookUpdate) AppendAddonIds(s []string) *PriceBookUpdate {
+	pbu.mutation.AppendAddonIds(s)
+	return pbu
+}
+
+// ClearAddonIds clears the value of the "addon_ids" field.
+func (pbu *PriceBookUpdate) ClearAddonIds() *PriceBookUpdate {
+	pbu.mutation.ClearAddonIds()
+	return pbu
+}
+
+// SetCurrencies sets the "currencies" field.
+func (pbu *PriceBookUpdate) SetCurrencies(tbc []types.PriceBookCurrency) *PriceBookUpdate {
+	pbu.mutation.SetCurrencies(tbc)
+	return pbu
+}
+
+// AppendCurrencies appends tbc to the "currencies" field.
+func (pbu *PriceBookUpdate) AppendCurrencies(tbc []types.PriceBookCurrency) *PriceBookUpdate {
+	pbu.mutation.AppendCurrencies(tbc)
+	return pbu
+}
+
+// ClearCurrencies clears the value of the "currencies" field.
+func (pbu *PriceBookUpdate) ClearCurrencies() *PriceBookUpdate {
+	pbu.mutation.ClearCurrencies()
+	return pbu
+}
+
+// SetOverrides sets the "overrides" field.
+func (pbu *PriceBookUpdate) SetOverrides(tbpo []types.PriceBookPriceOverride) *PriceBookUpdate {
+	pbu.mutation.SetOverrides(tbpo)
+	return pbu
+}
+
+// AppendOverrides appends tbpo to the "overrides" field.
+func (pbu *PriceBookUpdate) AppendOverrides(tbpo []types.PriceBookPriceOverride) *PriceBookUpdate {
+	pbu.mutation.AppendOverrides(tbpo)
+	return pbu
+}
+
+// ClearOverrides clears the value of the "overrides" field.
+func (pbu *PriceBookUpdate) ClearOverrides() *PriceBookUpdate {
+	pbu.mutation.ClearOverrides()
+	return pbu
+}
+
+// SetMetadata sets the "metadata" field.
+func (pbu *PriceBookUpdate) SetMetadata(m map[string]string) *PriceBookUpdate {
+	pbu.mutation.SetMetadata(m)
+	return pbu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (pbu *PriceBookUpdate) ClearMetadata() *PriceBookUpdate {
+	pbu.mutation.ClearMetadata()
+	return pbu
+}
+
+// Mutation returns the PriceBookMutation object of the builder.
+func (pbu *PriceBookUpdate) Mutation() *PriceBookMutation {
+	return pbu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pbu *PriceBookUpdate) Save(ctx context.Context) (int, error) {
+	pbu.defaults()
+	return withHooks(ctx, pbu.sqlSave, pbu.mutation, pbu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pbu *PriceBookUpdate) SaveX(ctx context.Context) int {
+	affected, err := pbu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pbu *PriceBookUpdate) Exec(ctx context.Context) error {
+	_, err := pbu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbu *PriceBookUpdate) ExecX(ctx context.Context) {
+	if err := pbu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pbu *PriceBookUpdate) defaults() {
+	if _, ok := pbu.mutation.UpdatedAt(); !ok {
+		v := pricebook.UpdateDefaultUpdatedAt()
+		pbu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pbu *PriceBookUpdate) check() error {
+	if v, ok := pbu.mutation.Name(); ok {
+		if err := pricebook.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PriceBook.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pbu *PriceBookUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pbu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pricebook.Table, pricebook.Columns, sqlgraph.NewFieldSpec(pricebook.FieldID, field.TypeString))
+	if ps := pbu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pbu.mutation.Status(); ok {
+		_spec.SetField(pricebook.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := pbu.mutation.UpdatedAt(); ok {
+		_spec.SetField(pricebook.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pbu.mutation.CreatedByCleared() {
+		_spec.ClearField(pricebook.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := pbu.mutation.UpdatedBy(); ok {
+		_spec.SetField(pricebook.FieldUpdatedBy, field.TypeString, value)
+	}
+	if pbu.mutation.UpdatedByCleared() {
+		_spec.ClearField(pricebook.FieldUpdatedBy, field.TypeString)
+	}
+	if pbu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(pricebook.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := pbu.mutation.Name(); ok {
+		_spec.SetField(pricebook.FieldName, field.TypeString, value)
+	}
+	if value, ok := pbu.mutation.Description(); ok {
+		_spec.SetField(pricebook.FieldDescription, field.TypeString, value)
+	}
+	if pbu.mutation.DescriptionCleared() {
+		_spec.ClearField(pricebook.FieldDescription, field.TypeString)
+	}
+	if value, ok := pbu.mutation.PlanIds(); ok {
+		_spec.SetField(pricebook.FieldPlanIds, field.TypeJSON, value)
+	}
+	if value, ok := pbu.mutation.AppendedPlanIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pricebook.FieldPlanIds, value)
+		})
+	}
+	if pbu.mutation.PlanIdsCleared() {
+		_spec.ClearField(pricebook.FieldPlanIds, field.TypeJSON)
+	}
+	if value, ok := pbu.mutation.AddonIds(); ok {
+		_spec.SetField(pricebook.FieldAddonIds, field.TypeJSON, value)
+	}
+	if value, ok := pbu.mutation.AppendedAddonIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pricebook.FieldAddonIds, value)
+		})
+	}
+	if pbu.mutation.AddonIdsCleared() {
+		_spec.ClearField(pricebook.FieldAddonIds, field.TypeJSON)
+	}
+	if value, ok := pbu.mutation.Currencies(); ok {
+		_spec.SetField(pricebook.FieldCurrencies, field.TypeJSON, value)
+	}
+	if value, ok := pbu.mutation.AppendedCurrencies(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pricebook.FieldCurrencies, value)
+		})
+	}
+	if pbu.mutation.CurrenciesCleared() {
+		_spec.ClearField(pricebook.FieldCurrencies, field.TypeJSON)
+	}
+	if value, ok := pbu.mutation.Overrides(); ok {
+		_spec.SetField(pricebook.FieldOverrides, field.TypeJSON, value)
+	}
+	if value, ok := pbu.mutation.AppendedOverrides(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pricebook.FieldOverrides, value)
+		})
+	}
+	if pbu.mutation.OverridesCleared() {
+		_spec.ClearField(pricebook.FieldOverrides, field.TypeJSON)
+	}
+	if value, ok := pbu.mutation.Metadata(); ok {
+		_spec.SetField(pricebook.FieldMetadata, field.TypeJSON, value)
+	}
+	if pbu.mutation.MetadataCleared() {
+		_spec.ClearField(pricebook.FieldMetadata, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pbu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pricebook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pbu.mutation.done = true
+	return n, nil
+}
+
+// PriceBookUpdateOne is the builder for updating a single PriceBook entity.
+type PriceBookUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PriceBookMutation
+}
+
+// SetStatus sets the "status" field.
+func (pbuo *PriceBookUpdateOne) SetStatus(s string) *PriceBookUpdateOne {
+	pbuo.mutation.SetStatus(s)
+	return pbuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pbuo *PriceBookUpdateOne) SetNillableStatus(s *string) *PriceBookUpdateOne {
+	if s != nil {
+		pbuo.SetStatus(*s)
+	}
+	return pbuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pbuo *PriceBookUpdateOne) SetUpdatedAt(t time.Time) *PriceBookUpdateOne {
+	pbuo.mutation.SetUpdatedAt(t)
+	return pbuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (pbuo *PriceBookUpdateOne) SetUpdatedBy(s string) *PriceBookUpdateOne {
+	pbuo.mutation.SetUpdatedBy(s)
+	return pbuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (pbuo *PriceBookUpdateOne) SetNillableUpdatedBy(s *string) *PriceBookUpdateOne {
+	if s != nil {
+		pbuo.SetUpdatedBy(*s)
+	}
+	return pbuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (pbuo *PriceBookUpdateOne) ClearUpdatedBy() *PriceBookUpdateOne {
+	pbuo.mutation.ClearUpdatedBy()
+	return pbuo
+}
+
+// SetName sets the "name" field.
+func (pbuo *PriceBookUpdateOne) SetName(s string) *PriceBookUpdateOne {
+	pbuo.mutation.SetName(s)
+	return pbuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pbuo *PriceBookUpdateOne) SetNillableName(s *string) *PriceBookUpdateOne {
+	if s != nil {
+		pbuo.SetName(*s)
+	}
+	return pbuo
+}
+
+// SetDescription sets the "description" field.
+func (pbuo *PriceBookUpdateOne) SetDescription(s string) *PriceBookUpdateOne {
+	pbuo.mutation.SetDescription(s)
+	return pbuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pbuo *PriceBookUpdateOne) SetNillableDescription(s *string) *PriceBookUpdateOne {
+	if s != nil {
+		pbuo.SetDescription(*s)
+	}
+	return pbuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (pbuo *PriceBookUpdateOne) ClearDescription() *PriceBookUpdateOne {
+	pbuo.mutation.ClearDescription()
+	return pbuo
+}
+
+// SetPlanIds sets the "plan_ids" field.
+func (pbuo *PriceBookUpdateOne) SetPlanIds(s []string) *PriceBookUpdateOne {
+	pbuo.mutation.SetPlanIds(s)
+	return pbuo
+}
+
+// AppendPlanIds appends s to the "plan_ids" field.
+func (pbuo *PriceBookUpdateOne) AppendPlanIds(s []string) *PriceBookUpdateOne {
+	pbuo.mutation.AppendPlanIds(s)
+	return pbuo
+}
+
+// ClearPlanIds clears the value of the "plan_ids" field.
+func (pbuo *PriceBookUpdateOne) ClearPlanIds() *PriceBookUpdateOne {
+	pbuo.mutation.ClearPlanIds()
+	return pbuo
+}
+
+// SetAddonIds sets the "addon_ids" field.
+func (pbuo *PriceBookUpdateOne) SetAddonIds(s []string) *PriceBookUpdateOne {
+	pbuo.mutation.SetAddonIds(s)
+	return pbuo
+}
+
+// AppendAddonIds appends s to the "addon_ids" field.
+func (pbuo *PriceBookUpdateOne) AppendAddonIds(s []string) *PriceBookUpdateOne {
+	pbuo.mutation.AppendAddonIds(s)
+	return pbuo
+}
+
+// ClearAddonIds clears the value of the "addon_ids" field.
+func (pbuo *PriceBookUpdateOne) ClearAddonIds() *PriceBookUpdateOne {
+	pbuo.mutation.ClearAddonIds()
+	return pbuo
+}
+
+// SetCurrencies sets the "currencies" field.
+func (pbuo *PriceBookUpdateOne) SetCurrencies(tbc []types.PriceBookCurrency) *PriceBookUpdateOne {
+	pbuo.mutation.SetCurrencies(tbc)
+	return pbuo
+}
+
+// AppendCurrencies appends tbc to the "currencies" field.
+func (pbuo *PriceBookUpdateOne) AppendCurrencies(tbc []types.PriceBookCurrency) *PriceBookUpdateOne {
+	pbuo.mutation.AppendCurrencies(tbc)
+	return pbuo
+}
+
+// ClearCurrencies clears the value of the "currencies" field.
+func (pbuo *PriceBookUpdateOne) ClearCurrencies() *PriceBookUpdateOne {
+	pbuo.mutation.ClearCurrencies()
+	return pbuo
+}
+
+// SetOverrides sets the "overrides" field.
+func (pbuo *PriceBookUpdateOne) SetOverrides(tbpo []types.PriceBookPriceOverride) *PriceBookUpdateOne {
+	pbuo.mutation.SetOverrides(tbpo)
+	return pbuo
+}
+
+// AppendOverrides appends tbpo to the "overrides" field.
+func (pbuo *PriceBookUpdateOne) AppendOverrides(tbpo []types.PriceBookPriceOverride) *PriceBookUpdateOne {
+	pbuo.mutation.AppendOverrides(tbpo)
+	return pbuo
+}
+
+// ClearOverrides clears the value of the "overrides" field.
+func (pbuo *PriceBookUpdateOne) ClearOverrides() *PriceBookUpdateOne {
+	pbuo.mutation.ClearOverrides()
+	return pbuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (pbuo *PriceBookUpdateOne) SetMetadata(m map[string]string) *PriceBookUpdateOne {
+	pbuo.mutation.SetMetadata(m)
+	return pbuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (pbuo *PriceBookUpdateOne) ClearMetadata() *PriceBookUpdateOne {
+	pbuo.mutation.ClearMetadata()
+	return pbuo
+}
+
+// Mutation returns the PriceBookMutation object of the builder.
+func (pbuo *PriceBookUpdateOne) Mutation() *PriceBookMutation {
+	return pbuo.mutation
+}
+
+// Where appends a list predicates to the PriceBookUpdate builder.
+func (pbuo *PriceBookUpdateOne) Where(ps ...predicate.PriceBook) *PriceBookUpdateOne {
+	pbuo.mutation.Where(ps...)
+	return pbuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pbuo *PriceBookUpdateOne) Select(field string, fields ...string) *PriceBookUpdateOne {
+	pbuo.fields = append([]string{field}, fields...)
+	return pbuo
+}
+
+// Save executes the query and returns the updated PriceBook entity.
+func (pbuo *PriceBookUpdateOne) Save(ctx context.Context) (*PriceBook, error) {
+	pbuo.defaults()
+	return withHooks(ctx, pbuo.sqlSave, pbuo.mutation, pbuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pbuo *PriceBookUpdateOne) SaveX(ctx context.Context) *PriceBook {
+	node, err := pbuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pbuo *PriceBookUpdateOne) Exec(ctx context.Context) error {
+	_, err := pbuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbuo *PriceBookUpdateOne) ExecX(ctx context.Context) {
+	if err := pbuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pbuo *PriceBookUpdateOne) defaults() {
+	if _, ok := pbuo.mutation.UpdatedAt(); !ok {
+		v := pricebook.UpdateDefaultUpdatedAt()
+		pbuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pbuo *PriceBookUpdateOne) check() error {
+	if v, ok := pbuo.mutation.Name(); ok {
+		if err := pricebook.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PriceBook.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pbuo *PriceBookUpdateOne) sqlSave(ctx context.Context) (_node *PriceBook, err error) {
+	if err := pbuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pricebook.Table, pricebook.Columns, sqlgraph.NewFieldSpec(pricebook.FieldID, field.TypeString))
+	id, ok := pbuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PriceBook.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pbuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pricebook.FieldID)
+		for _, f := range fields {
+			if !pricebook.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pricebook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pbuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pbuo.mutation.Status(); ok {
+		_spec.SetField(pricebook.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := pbuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(pricebook.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pbuo.mutation.CreatedByCleared() {
+		_spec.ClearField(pricebook.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := pbuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(pricebook.FieldUpdatedBy, field.TypeString, value)
+	}
+	if pbuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(pricebook.FieldUpdatedBy, field.TypeString)
+	}
+	if pbuo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(pricebook.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := pbuo.mutation.Name(); ok {
+		_spec.SetField(pricebook.FieldName, field.TypeString, value)
+	}
+	if value, ok := pbuo.mutation.Description(); ok {
+		_spec.SetField(pricebook.FieldDescription, field.TypeString, value)
+	}
+	if pbuo.mutation.DescriptionCleared() {
+		_spec.ClearField(pricebook.FieldDescription, field.TypeString)
+	}
+	if value, ok := pbuo.mutation.PlanIds(); ok {
+		_spec.SetField(pricebook.FieldPlanIds, field.TypeJSON, value)
+	}
+	if value, ok := pbuo.mutation.AppendedPlanIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pricebook.FieldPlanIds, value)
+		})
+	}
+	if pbuo.mutation.PlanIdsCleared() {
+		_spec.ClearField(pricebook.FieldPlanIds, field.TypeJSON)
+	}
+	if value, ok := pbuo.mutation.AddonIds(); ok {
+		_spec.SetField(pricebook.FieldAddonIds, field.TypeJSON, value)
+	}
+	if value, ok := pbuo.mutation.AppendedAddonIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pricebook.FieldAddonIds, value)
+		})
+	}
+	if pbuo.mutation.AddonIdsCleared() {
+		_spec.ClearField(pricebook.FieldAddonIds, field.TypeJSON)
+	}
+	if value, ok := pbuo.mutation.Currencies(); ok {
+		_spec.SetField(pricebook.FieldCurrencies, field.TypeJSON, value)
+	}
+	if value, ok := pbuo.mutation.AppendedCurrencies(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pricebook.FieldCurrencies, value)
+		})
+	}
+	if pbuo.mutation.CurrenciesCleared() {
+		_spec.ClearField(pricebook.FieldCurrencies, field.TypeJSON)
+	}
+	if value, ok := pbuo.mutation.Overrides(); ok {
+		_spec.SetField(pricebook.FieldOverrides, field.TypeJSON, value)
+	}
+	if value, ok := pbuo.mutation.AppendedOverrides(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pricebook.FieldOverrides, value)
+		})
+	}
+	if pbuo.mutation.OverridesCleared() {
+		_spec.ClearField(pricebook.FieldOverrides, field.TypeJSON)
+	}
+	if value, ok := pbuo.mutation.Metadata(); ok {
+		_spec.SetField(pricebook.FieldMetadata, field.TypeJSON, value)
+	}
+	if pbuo.mutation.MetadataCleared() {
+		_spec.ClearField(pricebook.FieldMetadata, field.TypeJSON)
+	}
+	_node = &PriceBook{config: pbuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pbuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pricebook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pbuo.mutation.done = true
+	return _node, nil
+}
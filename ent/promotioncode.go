@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/promotioncode"
+	"github.com/shopspring/decimal"
+)
+
+// PromotionCode is the model entity for the PromotionCode schema.
+type PromotionCode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// Coupon granted when the code is redeemed
+	CouponID string `json:"coupon_id,omitempty"`
+	// Customer-facing code, stored upper case
+	Code string `json:"code,omitempty"`
+	// Inactive codes cannot be redeemed
+	Active bool `json:"active,omitempty"`
+	// Total redemptions allowed across customers, 1 for one-time codes
+	MaxRedemptions *int `json:"max_redemptions,omitempty"`
+	// Redemptions allowed per customer
+	MaxRedemptionsPerCustomer *int `json:"max_redemptions_per_customer,omitempty"`
+	// Redemptions so far
+	TimesRedeemed int `json:"times_redeemed,omitempty"`
+	// Only customers without prior subscriptions or invoices can redeem
+	FirstTimeCustomerOnly bool `json:"first_time_customer_only,omitempty"`
+	// Code cannot be redeemed after this time
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Minimum order amount the discount applies to
+	MinimumAmount *decimal.Decimal `json:"minimum_amount,omitempty"`
+	// MinimumAmountCurrency holds the value of the "minimum_amount_currency" field.
+	MinimumAmountCurrency *string `json:"minimum_amount_currency,omitempty"`
+	// Additional metadata for promotion code
+	Metadata     map[string]string `json:"metadata,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PromotionCode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case promotioncode.FieldMinimumAmount:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case promotioncode.FieldMetadata:
+			values[i] = new([]byte)
+		case promotioncode.FieldActive, promotioncode.FieldFirstTimeCustomerOnly:
+			values[i] = new(sql.NullBool)
+		case promotioncode.FieldMaxRedemptions, promotioncode.FieldMaxRedemptionsPerCustomer, promotioncode.FieldTimesRedeemed:
+			values[i] = new(sql.NullInt64)
+		case promotioncode.FieldID, promotioncode.FieldTenantID, promotioncode.FieldStatus, promotioncode.FieldCreatedBy, promotioncode.FieldUpdatedBy, promotioncode.FieldEnvironmentID, promotioncode.FieldCouponID, promotioncode.FieldCode, promotioncode.FieldMinimumAmountCurrency:
+			values[i] = new(sql.NullString)
+		case promotioncode.FieldCreatedAt, promotioncode.FieldUpdatedAt, promotioncode.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PromotionCode fields.
+func (pc *PromotionCode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case promotioncode.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				pc.ID = value.String
+			}
+		case promotioncode.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				pc.TenantID = value.String
+			}
+		case promotioncode.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pc.Status = value.String
+			}
+		case promotioncode.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pc.CreatedAt = value.Time
+			}
+		case promotioncode.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pc.UpdatedAt = value.Time
+			}
+		case promotioncode.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				pc.CreatedBy = value.String
+			}
+		case promotioncode.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				pc.UpdatedBy = value.String
+			}
+		case promotioncode.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				pc.EnvironmentID = value.String
+			}
+		case promotioncode.FieldCouponID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon_id", values[i])
+			} else if value.Valid {
+				pc.CouponID = value.String
+			}
+		case promotioncode.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				pc.Code = value.String
+			}
+		case promotioncode.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				pc.Active = value.Bool
+			}
+		case promotioncode.FieldMaxRedemptions:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_redemptions", values[i])
+			} else if value.Valid {
+				pc.MaxRedemptions = new(int)
+				*pc.MaxRedemptions = int(value.Int64)
+			}
+		case promotioncode.FieldMaxRedemptionsPerCustomer:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_redemptions_per_customer", values[i])
+			} else if value.Valid {
+				pc.MaxRedemptionsPerCustomer = new(int)
+				*pc.MaxRedemptionsPerCustomer = int(value.Int64)
+			}
+		case promotioncode.FieldTimesRedeemed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field times_redeemed", values[i])
+			} else if value.Valid {
+				pc.TimesRedeemed = int(value.Int64)
+			}
+		case promotioncode.FieldFirstTimeCustomerOnly:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field first_time_customer_only", values[i])
+			} else if value.Valid {
+				pc.FirstTimeCustomerOnly = value.Bool
+			}
+		case promotioncode.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				pc.ExpiresAt = new(time.Time)
+				*pc.ExpiresAt = value.Time
+			}
+		case promotioncode.FieldMinimumAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field minimum_amount", values[i])
+			} else if value.Valid {
+				pc.MinimumAmount = new(decimal.Decimal)
+				*pc.MinimumAmount = *value.S.(*decimal.Decimal)
+			}
+		case promotioncode.FieldMinimumAmountCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field minimum_amount_currency", values[i])
+			} else if value.Valid {
+				pc.MinimumAmountCurrency = new(string)
+				*pc.MinimumAmountCurrency = value.String
+			}
+		case promotioncode.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pc.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		default:
+			pc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PromotionCode.
+// This includes values selected through modifiers, order, etc.
+func (pc *PromotionCode) Value(name string) (ent.Value, error) {
+	return pc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PromotionCode.
+// Note that you need to call PromotionCode.Unwrap() before calling this method if this PromotionCode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pc *PromotionCode) Update() *PromotionCodeUpdateOne {
+	return NewPromotionCodeClient(pc.config).UpdateOne(pc)
+}
+
+// Unwrap unwraps the PromotionCode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pc *PromotionCode) Unwrap() *PromotionCode {
+	_tx, ok := pc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PromotionCode is not a transactional entity")
+	}
+	pc.config.driver = _tx.drv
+	return pc
+}
+
+// String implements the fmt.Stringer.
+func (pc *PromotionCode) String() string {
+	var builder strings.Builder
+	builder.WriteString("PromotionCode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pc.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(pc.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(pc.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(pc.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(pc.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(pc.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("coupon_id=")
+	builder.WriteString(pc.CouponID)
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(pc.Code)
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", pc.Active))
+	builder.WriteString(", ")
+	if v := pc.MaxRedemptions; v != nil {
+		builder.WriteString("max_redemptions=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pc.MaxRedemptionsPerCustomer; v != nil {
+		builder.WriteString("max_redemptions_per_customer=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("times_redeemed=")
+	builder.WriteString(fmt.Sprintf("%v", pc.TimesRedeemed))
+	builder.WriteString(", ")
+	builder.WriteString("first_time_customer_only=")
+	builder.WriteString(fmt.Sprintf("%v", pc.FirstTimeCustomerOnly))
+	builder.WriteString(", ")
+	if v := pc.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pc.MinimumAmount; v != nil {
+		builder.WriteString("minimum_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pc.MinimumAmountCurrency; v != nil {
+		builder.WriteString("minimum_amount_currency=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", pc.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PromotionCodes is a parsable slice of PromotionCode.
+type PromotionCodes []*PromotionCode
@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package promotioncode
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the promotioncode type in the database.
+	Label = "promotion_code"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldEnvironmentID holds the string denoting the environment_id field in the database.
+	FieldEnvironmentID = "environment_id"
+	// FieldCouponID holds the string denoting the coupon_id field in the database.
+	FieldCouponID = "coupon_id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldActive holds the string denoting the active field in the database.
+	FieldActive = "active"
+	// FieldMaxRedemptions holds the string denoting the max_redemptions field in the database.
+	FieldMaxRedemptions = "max_redemptions"
+	// FieldMaxRedemptionsPerCustomer holds the string denoting the max_redemptions_per_customer field in the database.
+	FieldMaxRedemptionsPerCustomer = "max_redemptions_per_customer"
+	// FieldTimesRedeemed holds the string denoting the times_redeemed field in the database.
+	FieldTimesRedeemed = "times_redeemed"
+	// FieldFirstTimeCustomerOnly holds the string denoting the first_time_customer_only field in the database.
+	FieldFirstTimeCustomerOnly = "first_time_customer_only"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldMinimumAmount holds the string denoting the minimum_amount field in the database.
+	FieldMinimumAmount = "minimum_amount"
+	// FieldMinimumAmountCurrency holds the string denoting the minimum_amount_currency field in the database.
+	FieldMinimumAmountCurrency = "minimum_amount_currency"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// Table holds the table name of the promotioncode in the database.
+	Table = "promotion_codes"
+)
+
+// Columns holds all SQL columns for promotioncode fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldEnvironmentID,
+	FieldCouponID,
+	FieldCode,
+	FieldActive,
+	FieldMaxRedemptions,
+	FieldMaxRedemptionsPerCustomer,
+	FieldTimesRedeemed,
+	FieldFirstTimeCustomerOnly,
+	FieldExpiresAt,
+	FieldMinimumAmount,
+	FieldMinimumAmountCurrency,
+	FieldMetadata,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultEnvironmentID holds the default value on creation for the "environment_id" field.
+	DefaultEnvironmentID string
+	// CouponIDValidator is a validator for the "coupon_id" field. It is called by the builders before save.
+	CouponIDValidator func(string) error
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// DefaultActive holds the default value on creation for the "active" field.
+	DefaultActive bool
+	// DefaultTimesRedeemed holds the default value on creation for the "times_redeemed" field.
+	DefaultTimesRedeemed int
+	// DefaultFirstTimeCustomerOnly holds the default value on creation for the "first_time_customer_only" field.
+	DefaultFirstTimeCustomerOnly bool
+)
+
+// OrderOption defines the ordering options for the PromotionCode queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByEnvironmentID orders the results by the environment_id field.
+func ByEnvironmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvironmentID, opts...).ToFunc()
+}
+
+// ByCouponID orders the results by the coupon_id field.
+func ByCouponID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCouponID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByActive orders the results by the active field.
+func ByActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActive, opts...).ToFunc()
+}
+
+// ByMaxRedemptions orders the results by the max_redemptions field.
+func ByMaxRedemptions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRedemptions, opts...).ToFunc()
+}
+
+// ByMaxRedemptionsPerCustomer orders the results by the max_redemptions_per_customer field.
+func ByMaxRedemptionsPerCustomer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRedemptionsPerCustomer, opts...).ToFunc()
+}
+
+// ByTimesRedeemed orders the results by the times_redeemed field.
+func ByTimesRedeemed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimesRedeemed, opts...).ToFunc()
+}
+
+// ByFirstTimeCustomerOnly orders the results by the first_time_customer_only field.
+func ByFirstTimeCustomerOnly(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstTimeCustomerOnly, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByMinimumAmount orders the results by the minimum_amount field.
+func ByMinimumAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinimumAmount, opts...).ToFunc()
+}
+
+// ByMinimumAmountCurrency orders the results by the minimum_amount_currency field.
+func ByMinimumAmountCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinimumAmountCurrency, opts...).ToFunc()
+}
@@ -0,0 +1,1076 @@
+// Code generated by ent, DO NOT EDIT.
+
+package promotioncode
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// CouponID applies equality check predicate on the "coupon_id" field. It's identical to CouponIDEQ.
+func CouponID(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldCouponID, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldCode, v))
+}
+
+// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
+func Active(v bool) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldActive, v))
+}
+
+// MaxRedemptions applies equality check predicate on the "max_redemptions" field. It's identical to MaxRedemptionsEQ.
+func MaxRedemptions(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsPerCustomer applies equality check predicate on the "max_redemptions_per_customer" field. It's identical to MaxRedemptionsPerCustomerEQ.
+func MaxRedemptionsPerCustomer(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldMaxRedemptionsPerCustomer, v))
+}
+
+// TimesRedeemed applies equality check predicate on the "times_redeemed" field. It's identical to TimesRedeemedEQ.
+func TimesRedeemed(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldTimesRedeemed, v))
+}
+
+// FirstTimeCustomerOnly applies equality check predicate on the "first_time_customer_only" field. It's identical to FirstTimeCustomerOnlyEQ.
+func FirstTimeCustomerOnly(v bool) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldFirstTimeCustomerOnly, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// MinimumAmount applies equality check predicate on the "minimum_amount" field. It's identical to MinimumAmountEQ.
+func MinimumAmount(v decimal.Decimal) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldMinimumAmount, v))
+}
+
+// MinimumAmountCurrency applies equality check predicate on the "minimum_amount_currency" field. It's identical to MinimumAmountCurrencyEQ.
+func MinimumAmountCurrency(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldMinimumAmountCurrency, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldContains(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldHasPrefix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldHasSuffix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIsNil applies the IsNil predicate on the "environment_id" field.
+func EnvironmentIDIsNil() predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIsNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDNotNil applies the NotNil predicate on the "environment_id" field.
+func EnvironmentIDNotNil() predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEqualFold(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldContainsFold(FieldEnvironmentID, v))
+}
+
+// CouponIDEQ applies the EQ predicate on the "coupon_id" field.
+func CouponIDEQ(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldCouponID, v))
+}
+
+// CouponIDNEQ applies the NEQ predicate on the "coupon_id" field.
+func CouponIDNEQ(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNEQ(FieldCouponID, v))
+}
+
+// CouponIDIn applies the In predicate on the "coupon_id" field.
+func CouponIDIn(vs ...string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIn(FieldCouponID, vs...))
+}
+
+// CouponIDNotIn applies the NotIn predicate on the "coupon_id" field.
+func CouponIDNotIn(vs ...string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotIn(FieldCouponID, vs...))
+}
+
+// CouponIDGT applies the GT predicate on the "coupon_id" field.
+func CouponIDGT(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGT(FieldCouponID, v))
+}
+
+// CouponIDGTE applies the GTE predicate on the "coupon_id" field.
+func CouponIDGTE(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGTE(FieldCouponID, v))
+}
+
+// CouponIDLT applies the LT predicate on the "coupon_id" field.
+func CouponIDLT(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLT(FieldCouponID, v))
+}
+
+// CouponIDLTE applies the LTE predicate on the "coupon_id" field.
+func CouponIDLTE(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLTE(FieldCouponID, v))
+}
+
+// CouponIDContains applies the Contains predicate on the "coupon_id" field.
+func CouponIDContains(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldContains(FieldCouponID, v))
+}
+
+// CouponIDHasPrefix applies the HasPrefix predicate on the "coupon_id" field.
+func CouponIDHasPrefix(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldHasPrefix(FieldCouponID, v))
+}
+
+// CouponIDHasSuffix applies the HasSuffix predicate on the "coupon_id" field.
+func CouponIDHasSuffix(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldHasSuffix(FieldCouponID, v))
+}
+
+// CouponIDEqualFold applies the EqualFold predicate on the "coupon_id" field.
+func CouponIDEqualFold(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEqualFold(FieldCouponID, v))
+}
+
+// CouponIDContainsFold applies the ContainsFold predicate on the "coupon_id" field.
+func CouponIDContainsFold(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldContainsFold(FieldCouponID, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldContainsFold(FieldCode, v))
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldActive, v))
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNEQ(FieldActive, v))
+}
+
+// MaxRedemptionsEQ applies the EQ predicate on the "max_redemptions" field.
+func MaxRedemptionsEQ(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsNEQ applies the NEQ predicate on the "max_redemptions" field.
+func MaxRedemptionsNEQ(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNEQ(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsIn applies the In predicate on the "max_redemptions" field.
+func MaxRedemptionsIn(vs ...int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIn(FieldMaxRedemptions, vs...))
+}
+
+// MaxRedemptionsNotIn applies the NotIn predicate on the "max_redemptions" field.
+func MaxRedemptionsNotIn(vs ...int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotIn(FieldMaxRedemptions, vs...))
+}
+
+// MaxRedemptionsGT applies the GT predicate on the "max_redemptions" field.
+func MaxRedemptionsGT(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGT(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsGTE applies the GTE predicate on the "max_redemptions" field.
+func MaxRedemptionsGTE(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGTE(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsLT applies the LT predicate on the "max_redemptions" field.
+func MaxRedemptionsLT(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLT(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsLTE applies the LTE predicate on the "max_redemptions" field.
+func MaxRedemptionsLTE(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLTE(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsIsNil applies the IsNil predicate on the "max_redemptions" field.
+func MaxRedemptionsIsNil() predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIsNull(FieldMaxRedemptions))
+}
+
+// MaxRedemptionsNotNil applies the NotNil predicate on the "max_redemptions" field.
+func MaxRedemptionsNotNil() predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotNull(FieldMaxRedemptions))
+}
+
+// MaxRedemptionsPerCustomerEQ applies the EQ predicate on the "max_redemptions_per_customer" field.
+func MaxRedemptionsPerCustomerEQ(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldMaxRedemptionsPerCustomer, v))
+}
+
+// MaxRedemptionsPerCustomerNEQ applies the NEQ predicate on the "max_redemptions_per_customer" field.
+func MaxRedemptionsPerCustomerNEQ(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNEQ(FieldMaxRedemptionsPerCustomer, v))
+}
+
+// MaxRedemptionsPerCustomerIn applies the In predicate on the "max_redemptions_per_customer" field.
+func MaxRedemptionsPerCustomerIn(vs ...int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIn(FieldMaxRedemptionsPerCustomer, vs...))
+}
+
+// MaxRedemptionsPerCustomerNotIn applies the NotIn predicate on the "max_redemptions_per_customer" field.
+func MaxRedemptionsPerCustomerNotIn(vs ...int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotIn(FieldMaxRedemptionsPerCustomer, vs...))
+}
+
+// MaxRedemptionsPerCustomerGT applies the GT predicate on the "max_redemptions_per_customer" field.
+func MaxRedemptionsPerCustomerGT(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGT(FieldMaxRedemptionsPerCustomer, v))
+}
+
+// MaxRedemptionsPerCustomerGTE applies the GTE predicate on the "max_redemptions_per_customer" field.
+func MaxRedemptionsPerCustomerGTE(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGTE(FieldMaxRedemptionsPerCustomer, v))
+}
+
+// MaxRedemptionsPerCustomerLT applies the LT predicate on the "max_redemptions_per_customer" field.
+func MaxRedemptionsPerCustomerLT(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLT(FieldMaxRedemptionsPerCustomer, v))
+}
+
+// MaxRedemptionsPerCustomerLTE applies the LTE predicate on the "max_redemptions_per_customer" field.
+func MaxRedemptionsPerCustomerLTE(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLTE(FieldMaxRedemptionsPerCustomer, v))
+}
+
+// MaxRedemptionsPerCustomerIsNil applies the IsNil predicate on the "max_redemptions_per_customer" field.
+func MaxRedemptionsPerCustomerIsNil() predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIsNull(FieldMaxRedemptionsPerCustomer))
+}
+
+// MaxRedemptionsPerCustomerNotNil applies the NotNil predicate on the "max_redemptions_per_customer" field.
+func MaxRedemptionsPerCustomerNotNil() predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotNull(FieldMaxRedemptionsPerCustomer))
+}
+
+// TimesRedeemedEQ applies the EQ predicate on the "times_redeemed" field.
+func TimesRedeemedEQ(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldTimesRedeemed, v))
+}
+
+// TimesRedeemedNEQ applies the NEQ predicate on the "times_redeemed" field.
+func TimesRedeemedNEQ(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNEQ(FieldTimesRedeemed, v))
+}
+
+// TimesRedeemedIn applies the In predicate on the "times_redeemed" field.
+func TimesRedeemedIn(vs ...int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIn(FieldTimesRedeemed, vs...))
+}
+
+// TimesRedeemedNotIn applies the NotIn predicate on the "times_redeemed" field.
+func TimesRedeemedNotIn(vs ...int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotIn(FieldTimesRedeemed, vs...))
+}
+
+// TimesRedeemedGT applies the GT predicate on the "times_redeemed" field.
+func TimesRedeemedGT(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGT(FieldTimesRedeemed, v))
+}
+
+// TimesRedeemedGTE applies the GTE predicate on the "times_redeemed" field.
+func TimesRedeemedGTE(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGTE(FieldTimesRedeemed, v))
+}
+
+// TimesRedeemedLT applies the LT predicate on the "times_redeemed" field.
+func TimesRedeemedLT(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLT(FieldTimesRedeemed, v))
+}
+
+// TimesRedeemedLTE applies the LTE predicate on the "times_redeemed" field.
+func TimesRedeemedLTE(v int) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLTE(FieldTimesRedeemed, v))
+}
+
+// FirstTimeCustomerOnlyEQ applies the EQ predicate on the "first_time_customer_only" field.
+func FirstTimeCustomerOnlyEQ(v bool) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldFirstTimeCustomerOnly, v))
+}
+
+// FirstTimeCustomerOnlyNEQ applies the NEQ predicate on the "first_time_customer_only" field.
+func FirstTimeCustomerOnlyNEQ(v bool) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNEQ(FieldFirstTimeCustomerOnly, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// MinimumAmountEQ applies the EQ predicate on the "minimum_amount" field.
+func MinimumAmountEQ(v decimal.Decimal) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldMinimumAmount, v))
+}
+
+// MinimumAmountNEQ applies the NEQ predicate on the "minimum_amount" field.
+func MinimumAmountNEQ(v decimal.Decimal) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNEQ(FieldMinimumAmount, v))
+}
+
+// MinimumAmountIn applies the In predicate on the "minimum_amount" field.
+func MinimumAmountIn(vs ...decimal.Decimal) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIn(FieldMinimumAmount, vs...))
+}
+
+// MinimumAmountNotIn applies the NotIn predicate on the "minimum_amount" field.
+func MinimumAmountNotIn(vs ...decimal.Decimal) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotIn(FieldMinimumAmount, vs...))
+}
+
+// MinimumAmountGT applies the GT predicate on the "minimum_amount" field.
+func MinimumAmountGT(v decimal.Decimal) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGT(FieldMinimumAmount, v))
+}
+
+// MinimumAmountGTE applies the GTE predicate on the "minimum_amount" field.
+func MinimumAmountGTE(v decimal.Decimal) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGTE(FieldMinimumAmount, v))
+}
+
+// MinimumAmountLT applies the LT predicate on the "minimum_amount" field.
+func MinimumAmountLT(v decimal.Decimal) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLT(FieldMinimumAmount, v))
+}
+
+// MinimumAmountLTE applies the LTE predicate on the "minimum_amount" field.
+func MinimumAmountLTE(v decimal.Decimal) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLTE(FieldMinimumAmount, v))
+}
+
+// MinimumAmountIsNil applies the IsNil predicate on the "minimum_amount" field.
+func MinimumAmountIsNil() predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIsNull(FieldMinimumAmount))
+}
+
+// MinimumAmountNotNil applies the NotNil predicate on the "minimum_amount" field.
+func MinimumAmountNotNil() predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotNull(FieldMinimumAmount))
+}
+
+// MinimumAmountCurrencyEQ applies the EQ predicate on the "minimum_amount_currency" field.
+func MinimumAmountCurrencyEQ(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEQ(FieldMinimumAmountCurrency, v))
+}
+
+// MinimumAmountCurrencyNEQ applies the NEQ predicate on the "minimum_amount_currency" field.
+func MinimumAmountCurrencyNEQ(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNEQ(FieldMinimumAmountCurrency, v))
+}
+
+// MinimumAmountCurrencyIn applies the In predicate on the "minimum_amount_currency" field.
+func MinimumAmountCurrencyIn(vs ...string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIn(FieldMinimumAmountCurrency, vs...))
+}
+
+// MinimumAmountCurrencyNotIn applies the NotIn predicate on the "minimum_amount_currency" field.
+func MinimumAmountCurrencyNotIn(vs ...string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotIn(FieldMinimumAmountCurrency, vs...))
+}
+
+// MinimumAmountCurrencyGT applies the GT predicate on the "minimum_amount_currency" field.
+func MinimumAmountCurrencyGT(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGT(FieldMinimumAmountCurrency, v))
+}
+
+// MinimumAmountCurrencyGTE applies the GTE predicate on the "minimum_amount_currency" field.
+func MinimumAmountCurrencyGTE(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldGTE(FieldMinimumAmountCurrency, v))
+}
+
+// MinimumAmountCurrencyLT applies the LT predicate on the "minimum_amount_currency" field.
+func MinimumAmountCurrencyLT(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLT(FieldMinimumAmountCurrency, v))
+}
+
+// MinimumAmountCurrencyLTE applies the LTE predicate on the "minimum_amount_currency" field.
+func MinimumAmountCurrencyLTE(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldLTE(FieldMinimumAmountCurrency, v))
+}
+
+// MinimumAmountCurrencyContains applies the Contains predicate on the "minimum_amount_currency" field.
+func MinimumAmountCurrencyContains(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldContains(FieldMinimumAmountCurrency, v))
+}
+
+// MinimumAmountCurrencyHasPrefix applies the HasPrefix predicate on the "minimum_amount_currency" field.
+func MinimumAmountCurrencyHasPrefix(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldHasPrefix(FieldMinimumAmountCurrency, v))
+}
+
+// MinimumAmountCurrencyHasSuffix applies the HasSuffix predicate on the "minimum_amount_currency" field.
+func MinimumAmountCurrencyHasSuffix(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldHasSuffix(FieldMinimumAmountCurrency, v))
+}
+
+// MinimumAmountCurrencyIsNil applies the IsNil predicate on the "minimum_amount_currency" field.
+func MinimumAmountCurrencyIsNil() predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIsNull(FieldMinimumAmountCurrency))
+}
+
+// MinimumAmountCurrencyNotNil applies the NotNil predicate on the "minimum_amount_currency" field.
+func MinimumAmountCurrencyNotNil() predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotNull(FieldMinimumAmountCurrency))
+}
+
+// MinimumAmountCurrencyEqualFold applies the EqualFold predicate on the "minimum_amount_currency" field.
+func MinimumAmountCurrencyEqualFold(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldEqualFold(FieldMinimumAmountCurrency, v))
+}
+
+// MinimumAmountCurrencyContainsFold applies the ContainsFold predicate on the "minimum_amount_currency" field.
+func MinimumAmountCurrencyContainsFold(v string) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldContainsFold(FieldMinimumAmountCurrency, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.PromotionCode {
+	return predicate.PromotionCode(sql.FieldNotNull(FieldMetadata))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PromotionCode) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PromotionCode) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PromotionCode) predicate.PromotionCode {
+	return predicate.PromotionCode(sql.NotPredicates(p))
+}
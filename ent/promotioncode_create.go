@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/promotioncode"
+	"github.com/shopspring/decimal"
+)
+
+// PromotionCodeCreate is the builder for creating a PromotionCode entity.
+type PromotionCodeCreate struct {
+	config
+	mutation *PromotionCodeMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (pcc *PromotionCodeCreate) SetTenantID(s string) *PromotionCodeCreate {
+	pcc.mutation.SetTenantID(s)
+	return pcc
+}
+
+// SetStatus sets the "status" field.
+func (pcc *PromotionCodeCreate) SetStatus(s string) *PromotionCodeCreate {
+	pcc.mutation.SetStatus(s)
+	return pcc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pcc *PromotionCodeCreate) SetNillableStatus(s *string) *PromotionCodeCreate {
+	if s != nil {
+		pcc.SetStatus(*s)
+	}
+	return pcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pcc *PromotionCodeCreate) SetCreatedAt(t time.Time) *PromotionCodeCreate {
+	pcc.mutation.SetCreatedAt(t)
+	return pcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pcc *PromotionCodeCreate) SetNillableCreatedAt(t *time.Time) *PromotionCodeCreate {
+	if t != nil {
+		pcc.SetCreatedAt(*t)
+	}
+	return pcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pcc *PromotionCodeCreate) SetUpdatedAt(t time.Time) *PromotionCodeCreate {
+	pcc.mutation.SetUpdatedAt(t)
+	return pcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pcc *PromotionCodeCreate) SetNillableUpdatedAt(t *time.Time) *PromotionCodeCreate {
+	if t != nil {
+		pcc.SetUpdatedAt(*t)
+	}
+	return pcc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (pcc *PromotionCodeCreate) SetCreatedBy(s string) *PromotionCodeCreate {
+	pcc.mutation.SetCreatedBy(s)
+	return pcc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (pcc *PromotionCodeCreate) SetNillableCreatedBy(s *string) *PromotionCodeCreate {
+	if s != nil {
+		pcc.SetCreatedBy(*s)
+	}
+	return pcc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (pcc *PromotionCodeCreate) SetUpdatedBy(s string) *PromotionCodeCreate {
+	pcc.mutation.SetUpdatedBy(s)
+	return pcc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (pcc *PromotionCodeCreate) SetNillableUpdatedBy(s *string) *PromotionCodeCreate {
+	if s != nil {
+		pcc.SetUpdatedBy(*s)
+	}
+	return pcc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (pcc *PromotionCodeCreate) SetEnvironmentID(s string) *PromotionCodeCreate {
+	pcc.mutation.SetEnvironmentID(s)
+	return pcc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (pcc *PromotionCodeCreate) SetNillableEnvironmentID(s *string) *PromotionCodeCreate {
+	if s != nil {
+		pcc.SetEnvironmentID(*s)
+	}
+	return pcc
+}
+
+// SetCouponID sets the "coupon_id" field.
+func (pcc *PromotionCodeCreate) SetCouponID(s string) *PromotionCodeCreate {
+	pcc.mutation.SetCouponID(s)
+	return pcc
+}
+
+// SetCode sets the "code" field.
+func (pcc *PromotionCodeCreate) SetCode(s string) *PromotionCodeCreate {
+	pcc.mutation.SetCode(s)
+	return pcc
+}
+
+// SetActive sets the "active" field.
+func (pcc *PromotionCodeCreate) SetActive(b bool) *PromotionCodeCreate {
+	pcc.mutation.SetActive(b)
+	return pcc
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (pcc *PromotionCodeCreate) SetNillableActive(b *bool) *PromotionCodeCreate {
+	if b != nil {
+		pcc.SetActive(*b)
+	}
+	return pcc
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (pcc *PromotionCodeCreate) SetMaxRedemptions(i int) *PromotionCodeCreate {
+	pcc.mutation.SetMaxRedemptions(i)
+	return pcc
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (pcc *PromotionCodeCreate) SetNillableMaxRedemptions(i *int) *PromotionCodeCreate {
+	if i != nil {
+		pcc.SetMaxRedemptions(*i)
+	}
+	return pcc
+}
+
+// SetMaxRedemptionsPerCustomer sets the "max_redemptions_per_customer" field.
+func (pcc *PromotionCodeCreate) SetMaxRedemptionsPerCustomer(i int) *PromotionCodeCreate {
+	pcc.mutation.SetMaxRedemptionsPerCustomer(i)
+	return pcc
+}
+
+// SetNillableMaxRedemptionsPerCustomer sets the "max_redemptions_per_customer" field if the given value is not nil.
+func (pcc *PromotionCodeCreate) SetNillableMaxRedemptionsPerCustomer(i *int) *PromotionCodeCreate {
+	if i != nil {
+		pcc.SetMaxRedemptionsPerCustomer(*i)
+	}
+	return pcc
+}
+
+// SetTimesRedeemed sets the "times_redeemed" field.
+func (pcc *PromotionCodeCreate) SetTimesRedeemed(i int) *PromotionCodeCreate {
+	pcc.mutation.SetTimesRedeemed(i)
+	return pcc
+}
+
+// SetNillableTimesRedeemed sets the "times_redeemed" field if the given value is not nil.
+func (pcc *PromotionCodeCreate) SetNillableTimesRedeemed(i *int) *PromotionCodeCreate {
+	if i != nil {
+		pcc.SetTimesRedeemed(*i)
+	}
+	return pcc
+}
+
+// SetFirstTimeCustomerOnly sets the "first_time_customer_only" field.
+func (pcc *PromotionCodeCreate) SetFirstTimeCustomerOnly(b bool) *PromotionCodeCreate {
+	pcc.mutation.SetFirstTimeCustomerOnly(b)
+	return pcc
+}
+
+// SetNillableFirstTimeCustomerOnly sets the "first_time_customer_only" field if the given value is not nil.
+func (pcc *PromotionCodeCreate) SetNillableFirstTimeCustomerOnly(b *bool) *PromotionCodeCreate {
+	if b != nil {
+		pcc.SetFirstTimeCustomerOnly(*b)
+	}
+	return pcc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (pcc *PromotionCodeCreate) SetExpiresAt(t time.Time) *PromotionCodeCreate {
+	pcc.mutation.SetExpiresAt(t)
+	return pcc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (pcc *PromotionCodeCreate) SetNillableExpiresAt(t *time.Time) *PromotionCodeCreate {
+	if t != nil {
+		pcc.SetExpiresAt(*t)
+	}
+	return pcc
+}
+
+// SetMinimumAmount sets the "minimum_amount" field.
+func (pcc *PromotionCodeCreate) SetMinimumAmount(d decimal.Decimal) *PromotionCodeCreate {
+	pcc.mutation.SetMinimumAmount(d)
+	return pcc
+}
+
+// SetNillableMinimumAmount sets the "minimum_amount" field if the given value is not nil.
+func (pcc *PromotionCodeCreate) SetNillableMinimumAmount(d *decimal.Decimal) *PromotionCodeCreate {
+	if d != nil {
+		pcc.SetMinimumAmount(*d)
+	}
+	return pcc
+}
+
+// SetMinimumAmountCurrency sets the "minimum_amount_currency" field.
+func (pcc *PromotionCodeCreate) SetMinimumAmountCurrency(s string) *PromotionCodeCreate {
+	pcc.mutation.SetMinimumAmountCurrency(s)
+	return pcc
+}
+
+// SetNillableMinimumAmountCurrency sets the "minimum_amount_currency" field if the given value is not nil.
+func (pcc *PromotionCodeCreate) SetNillableMinimumAmountCurrency(s *string) *PromotionCodeCreate {
+	if s != nil {
+		pcc.SetMinimumAmountCurrency(*s)
+	}
+	return pcc
+}
+
+// SetMetadata sets the "metadata" field.
+func (pcc *PromotionCodeCreate) SetMetadata(m map[string]string) *PromotionCodeCreate {
+	pcc.mutation.SetMetadata(m)
+	return pcc
+}
+
+// SetID sets the "id" field.
+func (pcc *PromotionCodeCreate) SetID(s string) *PromotionCodeCreate {
+	pcc.mutation.SetID(s)
+	return pcc
+}
+
+// Mutation returns the PromotionCodeMutation object of the builder.
+func (pcc *PromotionCodeCreate) Mutation() *PromotionCodeMutation {
+	return pcc.mutation
+}
+
+// Save creates the PromotionCode in the database.
+func (pcc *PromotionCodeCreate) Save(ctx context.Context) (*PromotionCode, error) {
+	pcc.defaults()
+	return withHooks(ctx, pcc.sqlSave, pcc.mutation, pcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pcc *PromotionCodeCreate) SaveX(ctx context.Context) *PromotionCode {
+	v, err := pcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcc *PromotionCodeCreate) Exec(ctx context.Context) error {
+	_, err := pcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcc *PromotionCodeCreate) ExecX(ctx context.Context) {
+	if err := pcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcc *PromotionCodeCreate) defaults() {
+	if _, ok := pcc.mutation.Status(); !ok {
+		v := promotioncode.DefaultStatus
+		pcc.mutation.SetStatus(v)
+	}
+	if _, ok := pcc.mutation.CreatedAt(); !ok {
+		v := promotioncode.DefaultCreatedAt()
+		pcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pcc.mutation.UpdatedAt(); !ok {
+		v := promotioncode.DefaultUpdatedAt()
+		pcc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pcc.mutation.EnvironmentID(); !ok {
+		v := promotioncode.DefaultEnvironmentID
+		pcc.mutation.SetEnvironmentID(v)
+	}
+	if _, ok := pcc.mutation.Active(); !ok {
+		v := promotioncode.DefaultActive
+		pcc.mutation.SetActive(v)
+	}
+	if _, ok := pcc.mutation.TimesRedeemed(); !ok {
+		v := promotioncode.DefaultTimesRedeemed
+		pcc.mutation.SetTimesRedeemed(v)
+	}
+	if _, ok := pcc.mutation.FirstTimeCustomerOnly(); !ok {
+		v := promotioncode.DefaultFirstTimeCustomerOnly
+		pcc.mutation.SetFirstTimeCustomerOnly(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcc *PromotionCodeCreate) check() error {
+	if _, ok := pcc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "PromotionCode.tenant_id"`)}
+	}
+	if v, ok := pcc.mutation.TenantID(); ok {
+		if err := promotioncode.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "PromotionCode.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := pcc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PromotionCode.status"`)}
+	}
+	if _, ok := pcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PromotionCode.created_at"`)}
+	}
+	if _, ok := pcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PromotionCode.updated_at"`)}
+	}
+	if _, ok := pcc.mutation.CouponID(); !ok {
+		return &ValidationError{Name: "coupon_id", err: errors.New(`ent: missing required field "PromotionCode.coupon_id"`)}
+	}
+	if v, ok := pcc.mutation.CouponID(); ok {
+		if err := promotioncode.CouponIDValidator(v); err != nil {
+			return &ValidationError{Name: "coupon_id", err: fmt.Errorf(`ent: validator failed for field "PromotionCode.coupon_id": %w`, err)}
+		}
+	}
+	if _, ok := pcc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "PromotionCode.code"`)}
+	}
+	if v, ok := pcc.mutation.Code(); ok {
+		if err := promotioncode.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "PromotionCode.code": %w`, err)}
+		}
+	}
+	if _, ok := pcc.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "PromotionCode.active"`)}
+	}
+	if _, ok := pcc.mutation.TimesRedeemed(); !ok {
+		return &ValidationError{Name: "times_redeemed", err: errors.New(`ent: missing required field "PromotionCode.times_redeemed"`)}
+	}
+	if _, ok := pcc.mutation.FirstTimeCustomerOnly(); !ok {
+		return &ValidationError{Name: "first_time_customer_only", err: errors.New(`ent: missing required field "PromotionCode.first_time_customer_only"`)}
+	}
+	return nil
+}
+
+func (pcc *PromotionCodeCreate) sqlSave(ctx context.Context) (*PromotionCode, error) {
+	if err := pcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PromotionCode.ID type: %T", _spec.ID.Value)
+		}
+	}
+	pcc.mutation.id = &_node.ID
+	pcc.mutation.done = true
+	return _node, nil
+}
+
+func (pcc *PromotionCodeCreate) createSpec() (*PromotionCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PromotionCode{config: pcc.config}
+		_spec = sqlgraph.NewCreateSpec(promotioncode.Table, sqlgraph.NewFieldSpec(promotioncode.FieldID, field.TypeString))
+	)
+	if id, ok := pcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pcc.mutation.TenantID(); ok {
+		_spec.SetField(promotioncode.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := pcc.mutation.Status(); ok {
+		_spec.SetField(promotioncode.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := pcc.mutation.CreatedAt(); ok {
+		_spec.SetField(promotioncode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(promotioncode.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pcc.mutation.CreatedBy(); ok {
+		_spec.SetField(promotioncode.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := pcc.mutation.UpdatedBy(); ok {
+		_spec.SetField(promotioncode.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := pcc.mutation.EnvironmentID(); ok {
+		_spec.SetField(promotioncode.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := pcc.mutation.CouponID(); ok {
+		_spec.SetField(promotioncode.FieldCouponID, field.TypeString, value)
+		_node.CouponID = value
+	}
+	if value, ok := pcc.mutation.Code(); ok {
+		_spec.SetField(promotioncode.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := pcc.mutation.Active(); ok {
+		_spec.SetField(promotioncode.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if value, ok := pcc.mutation.MaxRedemptions(); ok {
+		_spec.SetField(promotioncode.FieldMaxRedemptions, field.TypeInt, value)
+		_node.MaxRedemptions = &value
+	}
+	if value, ok := pcc.mutation.MaxRedemptionsPerCustomer(); ok {
+		_spec.SetField(promotioncode.FieldMaxRedemptionsPerCustomer, field.TypeInt, value)
+		_node.MaxRedemptionsPerCustomer = &value
+	}
+	if value, ok := pcc.mutation.TimesRedeemed(); ok {
+		_spec.SetField(promotioncode.FieldTimesRedeemed, field.TypeInt, value)
+		_node.TimesRedeemed = value
+	}
+	if value, ok := pcc.mutation.FirstTimeCustomerOnly(); ok {
+		_spec.SetField(promotioncode.FieldFirstTimeCustomerOnly, field.TypeBool, value)
+		_node.FirstTimeCustomerOnly = value
+	}
+	if value, ok := pcc.mutation.ExpiresAt(); ok {
+		_spec.SetField(promotioncode.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := pcc.mutation.MinimumAmount(); ok {
+		_spec.SetField(promotioncode.FieldMinimumAmount, field.TypeOther, value)
+		_node.MinimumAmount = &value
+	}
+	if value, ok := pcc.mutation.MinimumAmountCurrency(); ok {
+		_spec.SetField(promotioncode.FieldMinimumAmountCurrency, field.TypeString, value)
+		_node.MinimumAmountCurrency = &value
+	}
+	if value, ok := pcc.mutation.Metadata(); ok {
+		_spec.SetField(promotioncode.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	return _node, _spec
+}
+
+// PromotionCodeCreateBulk is the builder for creating many PromotionCode entities in bulk.
+type PromotionCodeCreateBulk struct {
+	config
+	err      error
+	builders []*PromotionCodeCreate
+}
+
+// Save creates the PromotionCode entities in the database.
+func (pccb *PromotionCodeCreateBulk) Save(ctx context.Context) ([]*PromotionCode, error) {
+	if pccb.err != nil {
+		return nil, pccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pccb.builders))
+	nodes := make([]*PromotionCode, len(pccb.builders))
+	mutators := make([]Mutator, len(pccb.builders))
+	for i := range pccb.builders {
+		func(i int, root context.Context) {
+			builder := pccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PromotionCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pccb *PromotionCodeCreateBulk) SaveX(ctx context.Context) []*PromotionCode {
+	v, err := pccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pccb *PromotionCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := pccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pccb *PromotionCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := pccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
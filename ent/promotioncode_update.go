@@ -0,0 +1,769 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/promotioncode"
+	"github.com/shopspring/decimal"
+)
+
+// PromotionCodeUpdate is the builder for updating PromotionCode entities.
+type PromotionCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PromotionCodeMutation
+}
+
+// Where appends a list predicates to the PromotionCodeUpdate builder.
+func (pcu *PromotionCodeUpdate) Where(ps ...predicate.PromotionCode) *PromotionCodeUpdate {
+	pcu.mutation.Where(ps...)
+	return pcu
+}
+
+// SetStatus sets the "status" field.
+func (pcu *PromotionCodeUpdate) SetStatus(s string) *PromotionCodeUpdate {
+	pcu.mutation.SetStatus(s)
+	return pcu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pcu *PromotionCodeUpdate) SetNillableStatus(s *string) *PromotionCodeUpdate {
+	if s != nil {
+		pcu.SetStatus(*s)
+	}
+	return pcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pcu *PromotionCodeUpdate) SetUpdatedAt(t time.Time) *PromotionCodeUpdate {
+	pcu.mutation.SetUpdatedAt(t)
+	return pcu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (pcu *PromotionCodeUpdate) SetUpdatedBy(s string) *PromotionCodeUpdate {
+	pcu.mutation.SetUpdatedBy(s)
+	return pcu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (pcu *PromotionCodeUpdate) SetNillableUpdatedBy(s *string) *PromotionCodeUpdate {
+	if s != nil {
+		pcu.SetUpdatedBy(*s)
+	}
+	return pcu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (pcu *PromotionCodeUpdate) ClearUpdatedBy() *PromotionCodeUpdate {
+	pcu.mutation.ClearUpdatedBy()
+	return pcu
+}
+
+// SetActive sets the "active" field.
+func (pcu *PromotionCodeUpdate) SetActive(b bool) *PromotionCodeUpdate {
+	pcu.mutation.SetActive(b)
+	return pcu
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (pcu *PromotionCodeUpdate) SetNillableActive(b *bool) *PromotionCodeUpdate {
+	if b != nil {
+		pcu.SetActive(*b)
+	}
+	return pcu
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (pcu *PromotionCodeUpdate) SetMaxRedemptions(i int) *PromotionCodeUpdate {
+	pcu.mutation.ResetMaxRedemptions()
+	pcu.mutation.SetMaxRedemptions(i)
+	return pcu
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (pcu *PromotionCodeUpdate) SetNillableMaxRedemptions(i *int) *PromotionCodeUpdate {
+	if i != nil {
+		pcu.SetMaxRedemptions(*i)
+	}
+	return pcu
+}
+
+// AddMaxRedemptions adds i to the "max_redemptions" field.
+func (pcu *PromotionCodeUpdate) AddMaxRedemptions(i int) *PromotionCodeUpdate {
+	pcu.mutation.AddMaxRedemptions(i)
+	return pcu
+}
+
+// ClearMaxRedemptions clears the value of the "max_redemptions" field.
+func (pcu *PromotionCodeUpdate) ClearMaxRedemptions() *PromotionCodeUpdate {
+	pcu.mutation.ClearMaxRedemptions()
+	return pcu
+}
+
+// SetMaxRedemptionsPerCustomer sets the "max_redemptions_per_customer" field.
+func (pcu *PromotionCodeUpdate) SetMaxRedemptionsPerCustomer(i int) *PromotionCodeUpdate {
+	pcu.mutation.ResetMaxRedemptionsPerCustomer()
+	pcu.mutation.SetMaxRedemptionsPerCustomer(i)
+	return pcu
+}
+
+// SetNillableMaxRedemptionsPerCustomer sets the "max_redemptions_per_customer" field if the given value is not nil.
+func (pcu *PromotionCodeUpdate) SetNillableMaxRedemptionsPerCustomer(i *int) *PromotionCodeUpdate {
+	if i != nil {
+		pcu.SetMaxRedemptionsPerCustomer(*i)
+	}
+	return pcu
+}
+
+// AddMaxRedemptionsPerCustomer adds i to the "max_redemptions_per_customer" field.
+func (pcu *PromotionCodeUpdate) AddMaxRedemptionsPerCustomer(i int) *PromotionCodeUpdate {
+	pcu.mutation.AddMaxRedemptionsPerCustomer(i)
+	return pcu
+}
+
+// ClearMaxRedemptionsPerCustomer clears the value of the "max_redemptions_per_customer" field.
+func (pcu *PromotionCodeUpdate) ClearMaxRedemptionsPerCustomer() *PromotionCodeUpdate {
+	pcu.mutation.ClearMaxRedemptionsPerCustomer()
+	return pcu
+}
+
+// SetTimesRedeemed sets the "times_redeemed" field.
+func (pcu *PromotionCodeUpdate) SetTimesRedeemed(i int) *PromotionCodeUpdate {
+	pcu.mutation.ResetTimesRedeemed()
+	pcu.mutation.SetTimesRedeemed(i)
+	return pcu
+}
+
+// SetNillableTimesRedeemed sets the "times_redeemed" field if the given value is not nil.
+func (pcu *PromotionCodeUpdate) SetNillableTimesRedeemed(i *int) *PromotionCodeUpdate {
+	if i != nil {
+		pcu.SetTimesRedeemed(*i)
+	}
+	return pcu
+}
+
+// AddTimesRedeemed adds i to the "times_redeemed" field.
+func (pcu *PromotionCodeUpdate) AddTimesRedeemed(i int) *PromotionCodeUpdate {
+	pcu.mutation.AddTimesRedeemed(i)
+	return pcu
+}
+
+// SetFirstTimeCustomerOnly sets the "first_time_customer_only" field.
+func (pcu *PromotionCodeUpdate) SetFirstTimeCustomerOnly(b bool) *PromotionCodeUpdate {
+	pcu.mutation.SetFirstTimeCustomerOnly(b)
+	return pcu
+}
+
+// SetNillableFirstTimeCustomerOnly sets the "first_time_customer_only" field if the given value is not nil.
+func (pcu *PromotionCodeUpdate) SetNillableFirstTimeCustomerOnly(b *bool) *PromotionCodeUpdate {
+	if b != nil {
+		pcu.SetFirstTimeCustomerOnly(*b)
+	}
+	return pcu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (pcu *PromotionCodeUpdate) SetExpiresAt(t time.Time) *PromotionCodeUpdate {
+	pcu.mutation.SetExpiresAt(t)
+	return pcu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (pcu *PromotionCodeUpdate) SetNillableExpiresAt(t *time.Time) *PromotionCodeUpdate {
+	if t != nil {
+		pcu.SetExpiresAt(*t)
+	}
+	return pcu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (pcu *PromotionCodeUpdate) ClearExpiresAt() *PromotionCodeUpdate {
+	pcu.mutation.ClearExpiresAt()
+	return pcu
+}
+
+// SetMinimumAmount sets the "minimum_amount" field.
+func (pcu *PromotionCodeUpdate) SetMinimumAmount(d decimal.Decimal) *PromotionCodeUpdate {
+	pcu.mutation.SetMinimumAmount(d)
+	return pcu
+}
+
+// SetNillableMinimumAmount sets the "minimum_amount" field if the given value is not nil.
+func (pcu *PromotionCodeUpdate) SetNillableMinimumAmount(d *decimal.Decimal) *PromotionCodeUpdate {
+	if d != nil {
+		pcu.SetMinimumAmount(*d)
+	}
+	return pcu
+}
+
+// ClearMinimumAmount clears the value of the "minimum_amount" field.
+func (pcu *PromotionCodeUpdate) ClearMinimumAmount() *PromotionCodeUpdate {
+	pcu.mutation.ClearMinimumAmount()
+	return pcu
+}
+
+// SetMinimumAmountCurrency sets the "minimum_amount_currency" field.
+func (pcu *PromotionCodeUpdate) SetMinimumAmountCurrency(s string) *PromotionCodeUpdate {
+	pcu.mutation.SetMinimumAmountCurrency(s)
+	return pcu
+}
+
+// SetNillableMinimumAmountCurrency sets the "minimum_amount_currency" field if the given value is not nil.
+func (pcu *PromotionCodeUpdate) SetNillableMinimumAmountCurrency(s *string) *PromotionCodeUpdate {
+	if s != nil {
+		pcu.SetMinimumAmountCurrency(*s)
+	}
+	return pcu
+}
+
+// ClearMinimumAmountCurrency clears the value of the "minimum_amount_currency" field.
+func (pcu *PromotionCodeUpdate) ClearMinimumAmountCurrency() *PromotionCodeUpdate {
+	pcu.mutation.ClearMinimumAmountCurrency()
+	return pcu
+}
+
+// SetMetadata sets the "metadata" field.
+func (pcu *PromotionCodeUpdate) SetMetadata(m map[string]string) *PromotionCodeUpdate {
+	pcu.mutation.SetMetadata(m)
+	return pcu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (pcu *PromotionCodeUpdate) ClearMetadata() *PromotionCodeUpdate {
+	pcu.mutation.ClearMetadata()
+	return pcu
+}
+
+// Mutation returns the PromotionCodeMutation object of the builder.
+func (pcu *PromotionCodeUpdate) Mutation() *PromotionCodeMutation {
+	return pcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pcu *PromotionCodeUpdate) Save(ctx context.Context) (int, error) {
+	pcu.defaults()
+	return withHooks(ctx, pcu.sqlSave, pcu.mutation, pcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcu *PromotionCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := pcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pcu *PromotionCodeUpdate) Exec(ctx context.Context) error {
+	_, err := pcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcu *PromotionCodeUpdate) ExecX(ctx context.Context) {
+	if err := pcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcu *PromotionCodeUpdate) defaults() {
+	if _, ok := pcu.mutation.UpdatedAt(); !ok {
+		v := promotioncode.UpdateDefaultUpdatedAt()
+		pcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (pcu *PromotionCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(promotioncode.Table, promotioncode.Columns, sqlgraph.NewFieldSpec(promotioncode.FieldID, field.TypeString))
+	if ps := pcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcu.mutation.Status(); ok {
+		_spec.SetField(promotioncode.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := pcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(promotioncode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pcu.mutation.CreatedByCleared() {
+		_spec.ClearField(promotioncode.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := pcu.mutation.UpdatedBy(); ok {
+		_spec.SetField(promotioncode.FieldUpdatedBy, field.TypeString, value)
+	}
+	if pcu.mutation.UpdatedByCleared() {
+		_spec.ClearField(promotioncode.FieldUpdatedBy, field.TypeString)
+	}
+	if pcu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(promotioncode.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := pcu.mutation.Active(); ok {
+		_spec.SetField(promotioncode.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := pcu.mutation.MaxRedemptions(); ok {
+		_spec.SetField(promotioncode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if value, ok := pcu.mutation.AddedMaxRedemptions(); ok {
+		_spec.AddField(promotioncode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if pcu.mutation.MaxRedemptionsCleared() {
+		_spec.ClearField(promotioncode.FieldMaxRedemptions, field.TypeInt)
+	}
+	if value, ok := pcu.mutation.MaxRedemptionsPerCustomer(); ok {
+		_spec.SetField(promotioncode.FieldMaxRedemptionsPerCustomer, field.TypeInt, value)
+	}
+	if value, ok := pcu.mutation.AddedMaxRedemptionsPerCustomer(); ok {
+		_spec.AddField(promotioncode.FieldMaxRedemptionsPerCustomer, field.TypeInt, value)
+	}
+	if pcu.mutation.MaxRedemptionsPerCustomerCleared() {
+		_spec.ClearField(promotioncode.FieldMaxRedemptionsPerCustomer, field.TypeInt)
+	}
+	if value, ok := pcu.mutation.TimesRedeemed(); ok {
+		_spec.SetField(promotioncode.FieldTimesRedeemed, field.TypeInt, value)
+	}
+	if value, ok := pcu.mutation.AddedTimesRedeemed(); ok {
+		_spec.AddField(promotioncode.FieldTimesRedeemed, field.TypeInt, value)
+	}
+	if value, ok := pcu.mutation.FirstTimeCustomerOnly(); ok {
+		_spec.SetField(promotioncode.FieldFirstTimeCustomerOnly, field.TypeBool, value)
+	}
+	if value, ok := pcu.mutation.ExpiresAt(); ok {
+		_spec.SetField(promotioncode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if pcu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(promotioncode.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := pcu.mutation.MinimumAmount(); ok {
+		_spec.SetField(promotioncode.FieldMinimumAmount, field.TypeOther, value)
+	}
+	if pcu.mutation.MinimumAmountCleared() {
+		_spec.ClearField(promotioncode.FieldMinimumAmount, field.TypeOther)
+	}
+	if value, ok := pcu.mutation.MinimumAmountCurrency(); ok {
+		_spec.SetField(promotioncode.FieldMinimumAmountCurrency, field.TypeString, value)
+	}
+	if pcu.mutation.MinimumAmountCurrencyCleared() {
+		_spec.ClearField(promotioncode.FieldMinimumAmountCurrency, field.TypeString)
+	}
+	if value, ok := pcu.mutation.Metadata(); ok {
+		_spec.SetField(promotioncode.FieldMetadata, field.TypeJSON, value)
+	}
+	if pcu.mutation.MetadataCleared() {
+		_spec.ClearField(promotioncode.FieldMetadata, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promotioncode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pcu.mutation.done = true
+	return n, nil
+}
+
+// PromotionCodeUpdateOne is the builder for updating a single PromotionCode entity.
+type PromotionCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PromotionCodeMutation
+}
+
+// SetStatus sets the "status" field.
+func (pcuo *PromotionCodeUpdateOne) SetStatus(s string) *PromotionCodeUpdateOne {
+	pcuo.mutation.SetStatus(s)
+	return pcuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pcuo *PromotionCodeUpdateOne) SetNillableStatus(s *string) *PromotionCodeUpdateOne {
+	if s != nil {
+		pcuo.SetStatus(*s)
+	}
+	return pcuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pcuo *PromotionCodeUpdateOne) SetUpdatedAt(t time.Time) *PromotionCodeUpdateOne {
+	pcuo.mutation.SetUpdatedAt(t)
+	return pcuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (pcuo *PromotionCodeUpdateOne) SetUpdatedBy(s string) *PromotionCodeUpdateOne {
+	pcuo.mutation.SetUpdatedBy(s)
+	return pcuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (pcuo *PromotionCodeUpdateOne) SetNillableUpdatedBy(s *string) *PromotionCodeUpdateOne {
+	if s != nil {
+		pcuo.SetUpdatedBy(*s)
+	}
+	return pcuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (pcuo *PromotionCodeUpdateOne) ClearUpdatedBy() *PromotionCodeUpdateOne {
+	pcuo.mutation.ClearUpdatedBy()
+	return pcuo
+}
+
+// SetActive sets the "active" field.
+func (pcuo *PromotionCodeUpdateOne) SetActive(b bool) *PromotionCodeUpdateOne {
+	pcuo.mutation.SetActive(b)
+	return pcuo
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (pcuo *PromotionCodeUpdateOne) SetNillableActive(b *bool) *PromotionCodeUpdateOne {
+	if b != nil {
+		pcuo.SetActive(*b)
+	}
+	return pcuo
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (pcuo *PromotionCodeUpdateOne) SetMaxRedemptions(i int) *PromotionCodeUpdateOne {
+	pcuo.mutation.ResetMaxRedemptions()
+	pcuo.mutation.SetMaxRedemptions(i)
+	return pcuo
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (pcuo *PromotionCodeUpdateOne) SetNillableMaxRedemptions(i *int) *PromotionCodeUpdateOne {
+	if i != nil {
+		pcuo.SetMaxRedemptions(*i)
+	}
+	return pcuo
+}
+
+// AddMaxRedemptions adds i to the "max_redemptions" field.
+func (pcuo *PromotionCodeUpdateOne) AddMaxRedemptions(i int) *PromotionCodeUpdateOne {
+	pcuo.mutation.AddMaxRedemptions(i)
+	return pcuo
+}
+
+// ClearMaxRedemptions clears the value of the "max_redemptions" field.
+func (pcuo *PromotionCodeUpdateOne) ClearMaxRedemptions() *PromotionCodeUpdateOne {
+	pcuo.mutation.ClearMaxRedemptions()
+	return pcuo
+}
+
+// SetMaxRedemptionsPerCustomer sets the "max_redemptions_per_customer" field.
+func (pcuo *PromotionCodeUpdateOne) SetMaxRedemptionsPerCustomer(i int) *PromotionCodeUpdateOne {
+	pcuo.mutation.ResetMaxRedemptionsPerCustomer()
+	pcuo.mutation.SetMaxRedemptionsPerCustomer(i)
+	return pcuo
+}
+
+// SetNillableMaxRedemptionsPerCustomer sets the "max_redemptions_per_customer" field if the given value is not nil.
+func (pcuo *PromotionCodeUpdateOne) SetNillableMaxRedemptionsPerCustomer(i *int) *PromotionCodeUpdateOne {
+	if i != nil {
+		pcuo.SetMaxRedemptionsPerCustomer(*i)
+	}
+	return pcuo
+}
+
+// AddMaxRedemptionsPerCustomer adds i to the "max_redemptions_per_customer" field.
+func (pcuo *PromotionCodeUpdateOne) AddMaxRedemptionsPerCustomer(i int) *PromotionCodeUpdateOne {
+	pcuo.mutation.AddMaxRedemptionsPerCustomer(i)
+	return pcuo
+}
+
+// ClearMaxRedemptionsPerCustomer clears the value of the "max_redemptions_per_customer" field.
+func (pcuo *PromotionCodeUpdateOne) ClearMaxRedemptionsPerCustomer() *PromotionCodeUpdateOne {
+	pcuo.mutation.ClearMaxRedemptionsPerCustomer()
+	return pcuo
+}
+
+// SetTimesRedeemed sets the "times_redeemed" field.
+func (pcuo *PromotionCodeUpdateOne) SetTimesRedeemed(i int) *PromotionCodeUpdateOne {
+	pcuo.mutation.ResetTimesRedeemed()
+	pcuo.mutation.SetTimesRedeemed(i)
+	return pcuo
+}
+
+// SetNillableTimesRedeemed sets the "times_redeemed" field if the given value is not nil.
+func (pcuo *PromotionCodeUpdateOne) SetNillableTimesRedeemed(i *int) *PromotionCodeUpdateOne {
+	if i != nil {
+		pcuo.SetTimesRedeemed(*i)
+	}
+	return pcuo
+}
+
+// AddTimesRedeemed adds i to the "times_redeemed" field.
+func (pcuo *PromotionCodeUpdateOne) AddTimesRedeemed(i int) *PromotionCodeUpdateOne {
+	pcuo.mutation.AddTimesRedeemed(i)
+	return pcuo
+}
+
+// SetFirstTimeCustomerOnly sets the "first_time_customer_only" field.
+func (pcuo *PromotionCodeUpdateOne) SetFirstTimeCustomerOnly(b bool) *PromotionCodeUpdateOne {
+	pcuo.mutation.SetFirstTimeCustomerOnly(b)
+	return pcuo
+}
+
+// SetNillableFirstTimeCustomerOnly sets the "first_time_customer_only" field if the given value is not nil.
+func (pcuo *PromotionCodeUpdateOne) SetNillableFirstTimeCustomerOnly(b *bool) *PromotionCodeUpdateOne {
+	if b != nil {
+		pcuo.SetFirstTimeCustomerOnly(*b)
+	}
+	return pcuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (pcuo *PromotionCodeUpdateOne) SetExpiresAt(t time.Time) *PromotionCodeUpdateOne {
+	pcuo.mutation.SetExpiresAt(t)
+	return pcuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (pcuo *PromotionCodeUpdateOne) SetNillableExpiresAt(t *time.Time) *PromotionCodeUpdateOne {
+	if t != nil {
+		pcuo.SetExpiresAt(*t)
+	}
+	return pcuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (pcuo *PromotionCodeUpdateOne) ClearExpiresAt() *PromotionCodeUpdateOne {
+	pcuo.mutation.ClearExpiresAt()
+	return pcuo
+}
+
+// SetMinimumAmount sets the "minimum_amount" field.
+func (pcuo *PromotionCodeUpdateOne) SetMinimumAmount(d decimal.Decimal) *PromotionCodeUpdateOne {
+	pcuo.mutation.SetMinimumAmount(d)
+	return pcuo
+}
+
+// SetNillableMinimumAmount sets the "minimum_amount" field if the given value is not nil.
+func (pcuo *PromotionCodeUpdateOne) SetNillableMinimumAmount(d *decimal.Decimal) *PromotionCodeUpdateOne {
+	if d != nil {
+		pcuo.SetMinimumAmount(*d)
+	}
+	return pcuo
+}
+
+// ClearMinimumAmount clears the value of the "minimum_amount" field.
+func (pcuo *PromotionCodeUpdateOne) ClearMinimumAmount() *PromotionCodeUpdateOne {
+	pcuo.mutation.ClearMinimumAmount()
+	return pcuo
+}
+
+// SetMinimumAmountCurrency sets the "minimum_amount_currency" field.
+func (pcuo *PromotionCodeUpdateOne) SetMinimumAmountCurrency(s string) *PromotionCodeUpdateOne {
+	pcuo.mutation.SetMinimumAmountCurrency(s)
+	return pcuo
+}
+
+// SetNillableMinimumAmountCurrency sets the "minimum_amount_currency" field if the given value is not nil.
+func (pcuo *PromotionCodeUpdateOne) SetNillableMinimumAmountCurrency(s *string) *PromotionCodeUpdateOne {
+	if s != nil {
+		pcuo.SetMinimumAmountCurrency(*s)
+	}
+	return pcuo
+}
+
+// ClearMinimumAmountCurrency clears the value of the "minimum_amount_currency" field.
+func (pcuo *PromotionCodeUpdateOne) ClearMinimumAmountCurrency() *PromotionCodeUpdateOne {
+	pcuo.mutation.ClearMinimumAmountCurrency()
+	return pcuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (pcuo *PromotionCodeUpdateOne) SetMetadata(m map[string]string) *PromotionCodeUpdateOne {
+	pcuo.mutation.SetMetadata(m)
+	return pcuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (pcuo *PromotionCodeUpdateOne) ClearMetadata() *PromotionCodeUpdateOne {
+	pcuo.mutation.ClearMetadata()
+	return pcuo
+}
+
+// Mutation returns the PromotionCodeMutation object of the builder.
+func (pcuo *PromotionCodeUpdateOne) Mutation() *PromotionCodeMutation {
+	return pcuo.mutation
+}
+
+// Where appends a list predicates to the PromotionCodeUpdate builder.
+func (pcuo *PromotionCodeUpdateOne) Where(ps ...predicate.PromotionCode) *PromotionCodeUpdateOne {
+	pcuo.mutation.Where(ps...)
+	return pcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pcuo *PromotionCodeUpdateOne) Select(field string, fields ...string) *PromotionCodeUpdateOne {
+	pcuo.fields = append([]string{field}, fields...)
+	return pcuo
+}
+
+// Save executes the query and returns the updated PromotionCode entity.
+func (pcuo *PromotionCodeUpdateOne) Save(ctx context.Context) (*PromotionCode, error) {
+	pcuo.defaults()
+	return withHooks(ctx, pcuo.sqlSave, pcuo.mutation, pcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcuo *PromotionCodeUpdateOne) SaveX(ctx context.Context) *PromotionCode {
+	node, err := pcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pcuo *PromotionCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := pcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcuo *PromotionCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := pcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcuo *PromotionCodeUpdateOne) defaults() {
+	if _, ok := pcuo.mutation.UpdatedAt(); !ok {
+		v := promotioncode.UpdateDefaultUpdatedAt()
+		pcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (pcuo *PromotionCodeUpdateOne) sqlSave(ctx context.Context) (_node *PromotionCode, err error) {
+	_spec := sqlgraph.NewUpdateSpec(promotioncode.Table, promotioncode.Columns, sqlgraph.NewFieldSpec(promotioncode.FieldID, field.TypeString))
+	id, ok := pcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PromotionCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, promotioncode.FieldID)
+		for _, f := range fields {
+			if !promotioncode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != promotioncode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcuo.mutation.Status(); ok {
+		_spec.SetField(promotioncode.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := pcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(promotioncode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pcuo.mutation.CreatedByCleared() {
+		_spec.ClearField(promotioncode.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := pcuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(promotioncode.FieldUpdatedBy, field.TypeString, value)
+	}
+	if pcuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(promotioncode.FieldUpdatedBy, field.TypeString)
+	}
+	if pcuo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(promotioncode.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := pcuo.mutation.Active(); ok {
+		_spec.SetField(promotioncode.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := pcuo.mutation.MaxRedemptions(); ok {
+		_spec.SetField(promotioncode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if value, ok := pcuo.mutation.AddedMaxRedemptions(); ok {
+		_spec.AddField(promotioncode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if pcuo.mutation.MaxRedemptionsCleared() {
+		_spec.ClearField(promotioncode.FieldMaxRedemptions, field.TypeInt)
+	}
+	if value, ok := pcuo.mutation.MaxRedemptionsPerCustomer(); ok {
+		_spec.SetField(promotioncode.FieldMaxRedemptionsPerCustomer, field.TypeInt, value)
+	}
+	if value, ok := pcuo.mutation.AddedMaxRedemptionsPerCustomer(); ok {
+		_spec.AddField(promotioncode.FieldMaxRedemptionsPerCustomer, field.TypeInt, value)
+	}
+	if pcuo.mutation.MaxRedemptionsPerCustomerCleared() {
+		_spec.ClearField(promotioncode.FieldMaxRedemptionsPerCustomer, field.TypeInt)
+	}
+	if value, ok := pcuo.mutation.TimesRedeemed(); ok {
+		_spec.SetField(promotioncode.FieldTimesRedeemed, field.TypeInt, value)
+	}
+	if value, ok := pcuo.mutation.AddedTimesRedeemed(); ok {
+		_spec.AddField(promotioncode.FieldTimesRedeemed, field.TypeInt, value)
+	}
+	if value, ok := pcuo.mutation.FirstTimeCustomerOnly(); ok {
+		_spec.SetField(promotioncode.FieldFirstTimeCustomerOnly, field.TypeBool, value)
+	}
+	if value, ok := pcuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(promotioncode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if pcuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(promotioncode.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := pcuo.mutation.MinimumAmount(); ok {
+		_spec.SetField(promotioncode.FieldMinimumAmount, field.TypeOther, value)
+	}
+	if pcuo.mutation.MinimumAmountCleared() {
+		_spec.ClearField(promotioncode.FieldMinimumAmount, field.TypeOther)
+	}
+	if value, ok := pcuo.mutation.MinimumAmountCurrency(); ok {
+		_spec.SetField(promotioncode.FieldMinimumAmountCurrency, field.TypeString, value)
+	}
+	if pcuo.mutation.MinimumAmountCurrencyCleared() {
+		_spec.ClearField(promotioncode.FieldMinimumAmountCurrency, field.TypeString)
+	}
+	if value, ok := pcuo.mutation.Metadata(); ok {
+		_spec.SetField(promotioncode.FieldMetadata, field.TypeJSON, value)
+	}
+	if pcuo.mutation.MetadataCleared() {
+		_spec.ClearField(promotioncode.FieldMetadata, field.TypeJSON)
+	}
+	_node = &PromotionCode{config: pcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promotioncode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pcuo.mutation.done = true
+	return _node, nil
+}
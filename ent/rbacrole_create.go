@@ -0,0 +1,373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/rbacrole"
+)
+
+// RBACRoleCreate is the builder for creating a RBACRole entity.
+type RBACRoleCreate struct {
+	config
+	mutation *RBACRoleMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (rrc *RBACRoleCreate) SetTenantID(s string) *RBACRoleCreate {
+	rrc.mutation.SetTenantID(s)
+	return rrc
+}
+
+// SetStatus sets the "status" field.
+func (rrc *RBACRoleCreate) SetStatus(s string) *RBACRoleCreate {
+	rrc.mutation.SetStatus(s)
+	return rrc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rrc *RBACRoleCreate) SetNillableStatus(s *string) *RBACRoleCreate {
+	if s != nil {
+		rrc.SetStatus(*s)
+	}
+	return rrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rrc *RBACRoleCreate) SetCreatedAt(t time.Time) *RBACRoleCreate {
+	rrc.mutation.SetCreatedAt(t)
+	return rrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rrc *RBACRoleCreate) SetNillableCreatedAt(t *time.Time) *RBACRoleCreate {
+	if t != nil {
+		rrc.SetCreatedAt(*t)
+	}
+	return rrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rrc *RBACRoleCreate) SetUpdatedAt(t time.Time) *RBACRoleCreate {
+	rrc.mutation.SetUpdatedAt(t)
+	return rrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rrc *RBACRoleCreate) SetNillableUpdatedAt(t *time.Time) *RBACRoleCreate {
+	if t != nil {
+		rrc.SetUpdatedAt(*t)
+	}
+	return rrc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (rrc *RBACRoleCreate) SetCreatedBy(s string) *RBACRoleCreate {
+	rrc.mutation.SetCreatedBy(s)
+	return rrc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (rrc *RBACRoleCreate) SetNillableCreatedBy(s *string) *RBACRoleCreate {
+	if s != nil {
+		rrc.SetCreatedBy(*s)
+	}
+	return rrc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (rrc *RBACRoleCreate) SetUpdatedBy(s string) *RBACRoleCreate {
+	rrc.mutation.SetUpdatedBy(s)
+	return rrc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (rrc *RBACRoleCreate) SetNillableUpdatedBy(s *string) *RBACRoleCreate {
+	if s != nil {
+		rrc.SetUpdatedBy(*s)
+	}
+	return rrc
+}
+
+// SetName sets the "name" field.
+func (rrc *RBACRoleCreate) SetName(s string) *RBACRoleCreate {
+	rrc.mutation.SetName(s)
+	return rrc
+}
+
+// SetDescription sets the "description" field.
+func (rrc *RBACRoleCreate) SetDescription(s string) *RBACRoleCreate {
+	rrc.mutation.SetDescription(s)
+	return rrc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rrc *RBACRoleCreate) SetNillableDescription(s *string) *RBACRoleCreate {
+	if s != nil {
+		rrc.SetDescription(*s)
+	}
+	return rrc
+}
+
+// SetPermissions sets the "permissions" field.
+func (rrc *RBACRoleCreate) SetPermissions(m map[string][]string) *RBACRoleCreate {
+	rrc.mutation.SetPermissions(m)
+	return rrc
+}
+
+// SetEnvironmentIds sets the "environment_ids" field.
+func (rrc *RBACRoleCreate) SetEnvironmentIds(s []string) *RBACRoleCreate {
+	rrc.mutation.SetEnvironmentIds(s)
+	return rrc
+}
+
+// SetID sets the "id" field.
+func (rrc *RBACRoleCreate) SetID(s string) *RBACRoleCreate {
+	rrc.mutation.SetID(s)
+	return rrc
+}
+
+// Mutation returns the RBACRoleMutation object of the builder.
+func (rrc *RBACRoleCreate) Mutation() *RBACRoleMutation {
+	return rrc.mutation
+}
+
+// Save creates the RBACRole in the database.
+func (rrc *RBACRoleCreate) Save(ctx context.Context) (*RBACRole, error) {
+	rrc.defaults()
+	return withHooks(ctx, rrc.sqlSave, rrc.mutation, rrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rrc *RBACRoleCreate) SaveX(ctx context.Context) *RBACRole {
+	v, err := rrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrc *RBACRoleCreate) Exec(ctx context.Context) error {
+	_, err := rrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrc *RBACRoleCreate) ExecX(ctx context.Context) {
+	if err := rrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rrc *RBACRoleCreate) defaults() {
+	if _, ok := rrc.mutation.Status(); !ok {
+		v := rbacrole.DefaultStatus
+		rrc.mutation.SetStatus(v)
+	}
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		v := rbacrole.DefaultCreatedAt()
+		rrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		v := rbacrole.DefaultUpdatedAt()
+		rrc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrc *RBACRoleCreate) check() error {
+	if _, ok := rrc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "RBACRole.tenant_id"`)}
+	}
+	if v, ok := rrc.mutation.TenantID(); ok {
+		if err := rbacrole.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "RBACRole.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "RBACRole.status"`)}
+	}
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RBACRole.created_at"`)}
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RBACRole.updated_at"`)}
+	}
+	if _, ok := rrc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "RBACRole.name"`)}
+	}
+	if v, ok := rrc.mutation.Name(); ok {
+		if err := rbacrole.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RBACRole.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rrc *RBACRoleCreate) sqlSave(ctx context.Context) (*RBACRole, error) {
+	if err := rrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected RBACRole.ID type: %T", _spec.ID.Value)
+		}
+	}
+	rrc.mutation.id = &_node.ID
+	rrc.mutation.done = true
+	return _node, nil
+}
+
+func (rrc *RBACRoleCreate) createSpec() (*RBACRole, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RBACRole{config: rrc.config}
+		_spec = sqlgraph.NewCreateSpec(rbacrole.Table, sqlgraph.NewFieldSpec(rbacrole.FieldID, field.TypeString))
+	)
+	if id, ok := rrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rrc.mutation.TenantID(); ok {
+		_spec.SetField(rbacrole.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := rrc.mutation.Status(); ok {
+		_spec.SetField(rbacrole.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := rrc.mutation.CreatedAt(); ok {
+		_spec.SetField(rbacrole.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(rbacrole.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rrc.mutation.CreatedBy(); ok {
+		_spec.SetField(rbacrole.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := rrc.mutation.UpdatedBy(); ok {
+		_spec.SetField(rbacrole.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := rrc.mutation.Name(); ok {
+		_spec.SetField(rbacrole.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := rrc.mutation.Description(); ok {
+		_spec.SetField(rbacrole.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := rrc.mutation.Permissions(); ok {
+		_spec.SetField(rbacrole.FieldPermissions, field.TypeJSON, value)
+		_node.Permissions = value
+	}
+	if value, ok := rrc.mutation.EnvironmentIds(); ok {
+		_spec.SetField(rbacrole.FieldEnvironmentIds, field.TypeJSON, value)
+		_node.EnvironmentIds = value
+	}
+	return _node, _spec
+}
+
+// RBACRoleCreateBulk is the builder for creating many RBACRole entities in bulk.
+type RBACRoleCreateBulk struct {
+	config
+	err      error
+	builders []*RBACRoleCreate
+}
+
+// Save creates the RBACRole entities in the database.
+func (rrcb *RBACRoleCreateBulk) Save(ctx context.Context) ([]*RBACRole, error) {
+	if rrcb.err != nil {
+		return nil, rrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rrcb.builders))
+	nodes := make([]*RBACRole, len(rrcb.builders))
+	mutators := make([]Mutator, len(rrcb.builders))
+	for i := range rrcb.builders {
+		func(i int, root context.Context) {
+			builder := rrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RBACRoleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrcb *RBACRoleCreateBulk) SaveX(ctx context.Context) []*RBACRole {
+	v, err := rrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrcb *RBACRoleCreateBulk) Exec(ctx context.Context) error {
+	_, err := rrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrcb *RBACRoleCreateBulk) ExecX(ctx context.Context) {
+	if err := rrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
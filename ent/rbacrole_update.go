@@ -0,0 +1,511 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/rbacrole"
+)
+
+// RBACRoleUpdate is the builder for updating RBACRole entities.
+type RBACRoleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RBACRoleMutation
+}
+
+// Where appends a list predicates to the RBACRoleUpdate builder.
+func (rru *RBACRoleUpdate) Where(ps ...predicate.RBACRole) *RBACRoleUpdate {
+	rru.mutation.Where(ps...)
+	return rru
+}
+
+// SetStatus sets the "status" field.
+func (rru *RBACRoleUpdate) SetStatus(s string) *RBACRoleUpdate {
+	rru.mutation.SetStatus(s)
+	return rru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rru *RBACRoleUpdate) SetNillableStatus(s *string) *RBACRoleUpdate {
+	if s != nil {
+		rru.SetStatus(*s)
+	}
+	return rru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rru *RBACRoleUpdate) SetUpdatedAt(t time.Time) *RBACRoleUpdate {
+	rru.mutation.SetUpdatedAt(t)
+	return rru
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (rru *RBACRoleUpdate) SetUpdatedBy(s string) *RBACRoleUpdate {
+	rru.mutation.SetUpdatedBy(s)
+	return rru
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (rru *RBACRoleUpdate) SetNillableUpdatedBy(s *string) *RBACRoleUpdate {
+	if s != nil {
+		rru.SetUpdatedBy(*s)
+	}
+	return rru
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (rru *RBACRoleUpdate) ClearUpdatedBy() *RBACRoleUpdate {
+	rru.mutation.ClearUpdatedBy()
+	return rru
+}
+
+// SetName sets the "name" field.
+func (rru *RBACRoleUpdate) SetName(s string) *RBACRoleUpdate {
+	rru.mutation.SetName(s)
+	return rru
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (rru *RBACRoleUpdate) SetNillableName(s *string) *RBACRoleUpdate {
+	if s != nil {
+		rru.SetName(*s)
+	}
+	return rru
+}
+
+// SetDescription sets the "description" field.
+func (rru *RBACRoleUpdate) SetDescription(s string) *RBACRoleUpdate {
+	rru.mutation.SetDescription(s)
+	return rru
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rru *RBACRoleUpdate) SetNillableDescription(s *string) *RBACRoleUpdate {
+	if s != nil {
+		rru.SetDescription(*s)
+	}
+	return rru
+}
+
+// ClearDescription clears the value of the "description" field.
+func (rru *RBACRoleUpdate) ClearDescription() *RBACRoleUpdate {
+	rru.mutation.ClearDescription()
+	return rru
+}
+
+// SetPermissions sets the "permissions" field.
+func (rru *RBACRoleUpdate) SetPermissions(m map[string][]string) *RBACRoleUpdate {
+	rru.mutation.SetPermissions(m)
+	return rru
+}
+
+// ClearPermissions clears the value of the "permissions" field.
+func (rru *RBACRoleUpdate) ClearPermissions() *RBACRoleUpdate {
+	rru.mutation.ClearPermissions()
+	return rru
+}
+
+// SetEnvironmentIds sets the "environment_ids" field.
+func (rru *RBACRoleUpdate) SetEnvironmentIds(s []string) *RBACRoleUpdate {
+	rru.mutation.SetEnvironmentIds(s)
+	return rru
+}
+
+// AppendEnvironmentIds appends s to the "environment_ids" field.
+func (rru *RBACRoleUpdate) AppendEnvironmentIds(s []string) *RBACRoleUpdate {
+	rru.mutation.AppendEnvironmentIds(s)
+	return rru
+}
+
+// ClearEnvironmentIds clears the value of the "environment_ids" field.
+func (rru *RBACRoleUpdate) ClearEnvironmentIds() *RBACRoleUpdate {
+	rru.mutation.ClearEnvironmentIds()
+	return rru
+}
+
+// Mutation returns the RBACRoleMutation object of the builder.
+func (rru *RBACRoleUpdate) Mutation() *RBACRoleMutation {
+	return rru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rru *RBACRoleUpdate) Save(ctx context.Context) (int, error) {
+	rru.defaults()
+	return withHooks(ctx, rru.sqlSave, rru.mutation, rru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rru *RBACRoleUpdate) SaveX(ctx context.Context) int {
+	affected, err := rru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rru *RBACRoleUpdate) Exec(ctx context.Context) error {
+	_, err := rru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rru *RBACRoleUpdate) ExecX(ctx context.Context) {
+	if err := rru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rru *RBACRoleUpdate) defaults() {
+	if _, ok := rru.mutation.UpdatedAt(); !ok {
+		v := rbacrole.UpdateDefaultUpdatedAt()
+		rru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rru *RBACRoleUpdate) check() error {
+	if v, ok := rru.mutation.Name(); ok {
+		if err := rbacrole.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RBACRole.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rru *RBACRoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rbacrole.Table, rbacrole.Columns, sqlgraph.NewFieldSpec(rbacrole.FieldID, field.TypeString))
+	if ps := rru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rru.mutation.Status(); ok {
+		_spec.SetField(rbacrole.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := rru.mutation.UpdatedAt(); ok {
+		_spec.SetField(rbacrole.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rru.mutation.CreatedByCleared() {
+		_spec.ClearField(rbacrole.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := rru.mutation.UpdatedBy(); ok {
+		_spec.SetField(rbacrole.FieldUpdatedBy, field.TypeString, value)
+	}
+	if rru.mutation.UpdatedByCleared() {
+		_spec.ClearField(rbacrole.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := rru.mutation.Name(); ok {
+		_spec.SetField(rbacrole.FieldName, field.TypeString, value)
+	}
+	if value, ok := rru.mutation.Description(); ok {
+		_spec.SetField(rbacrole.FieldDescription, field.TypeString, value)
+	}
+	if rru.mutation.DescriptionCleared() {
+		_spec.ClearField(rbacrole.FieldDescription, field.TypeString)
+	}
+	if value, ok := rru.mutation.Permissions(); ok {
+		_spec.SetField(rbacrole.FieldPermissions, field.TypeJSON, value)
+	}
+	if rru.mutation.PermissionsCleared() {
+		_spec.ClearField(rbacrole.FieldPermissions, field.TypeJSON)
+	}
+	if value, ok := rru.mutation.EnvironmentIds(); ok {
+		_spec.SetField(rbacrole.FieldEnvironmentIds, field.TypeJSON, value)
+	}
+	if value, ok := rru.mutation.AppendedEnvironmentIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, rbacrole.FieldEnvironmentIds, value)
+		})
+	}
+	if rru.mutation.EnvironmentIdsCleared() {
+		_spec.ClearField(rbacrole.FieldEnvironmentIds, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rbacrole.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rru.mutation.done = true
+	return n, nil
+}
+
+// RBACRoleUpdateOne is the builder for updating a single RBACRole entity.
+type RBACRoleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RBACRoleMutation
+}
+
+// SetStatus sets the "status" field.
+func (rruo *RBACRoleUpdateOne) SetStatus(s string) *RBACRoleUpdateOne {
+	rruo.mutation.SetStatus(s)
+	return rruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rruo *RBACRoleUpdateOne) SetNillableStatus(s *string) *RBACRoleUpdateOne {
+	if s != nil {
+		rruo.SetStatus(*s)
+	}
+	return rruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rruo *RBACRoleUpdateOne) SetUpdatedAt(t time.Time) *RBACRoleUpdateOne {
+	rruo.mutation.SetUpdatedAt(t)
+	return rruo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (rruo *RBACRoleUpdateOne) SetUpdatedBy(s string) *RBACRoleUpdateOne {
+	rruo.mutation.SetUpdatedBy(s)
+	return rruo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (rruo *RBACRoleUpdateOne) SetNillableUpdatedBy(s *string) *RBACRoleUpdateOne {
+	if s != nil {
+		rruo.SetUpdatedBy(*s)
+	}
+	return rruo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (rruo *RBACRoleUpdateOne) ClearUpdatedBy() *RBACRoleUpdateOne {
+	rruo.mutation.ClearUpdatedBy()
+	return rruo
+}
+
+// SetName sets the "name" field.
+func (rruo *RBACRoleUpdateOne) SetName(s string) *RBACRoleUpdateOne {
+	rruo.mutation.SetName(s)
+	return rruo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (rruo *RBACRoleUpdateOne) SetNillableName(s *string) *RBACRoleUpdateOne {
+	if s != nil {
+		rruo.SetName(*s)
+	}
+	return rruo
+}
+
+// SetDescription sets the "description" field.
+func (rruo *RBACRoleUpdateOne) SetDescription(s string) *RBACRoleUpdateOne {
+	rruo.mutation.SetDescription(s)
+	return rruo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rruo *RBACRoleUpdateOne) SetNillableDescription(s *string) *RBACRoleUpdateOne {
+	if s != nil {
+		rruo.SetDescription(*s)
+	}
+	return rruo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (rruo *RBACRoleUpdateOne) ClearDescription() *RBACRoleUpdateOne {
+	rruo.mutation.ClearDescription()
+	return rruo
+}
+
+// SetPermissions sets the "permissions" field.
+func (rruo *RBACRoleUpdateOne) SetPermissions(m map[string][]string) *RBACRoleUpdateOne {
+	rruo.mutation.SetPermissions(m)
+	return rruo
+}
+
+// ClearPermissions clears the value of the "permissions" field.
+func (rruo *RBACRoleUpdateOne) ClearPermissions() *RBACRoleUpdateOne {
+	rruo.mutation.ClearPermissions()
+	return rruo
+}
+
+// SetEnvironmentIds sets the "environment_ids" field.
+func (rruo *RBACRoleUpdateOne) SetEnvironmentIds(s []string) *RBACRoleUpdateOne {
+	rruo.mutation.SetEnvironmentIds(s)
+	return rruo
+}
+
+// AppendEnvironmentIds appends s to the "environment_ids" field.
+func (rruo *RBACRoleUpdateOne) AppendEnvironmentIds(s []string) *RBACRoleUpdateOne {
+	rruo.mutation.AppendEnvironmentIds(s)
+	return rruo
+}
+
+// ClearEnvironmentIds clears the value of the "environment_ids" field.
+func (rruo *RBACRoleUpdateOne) ClearEnvironmentIds() *RBACRoleUpdateOne {
+	rruo.mutation.ClearEnvironmentIds()
+	return rruo
+}
+
+// Mutation returns the RBACRoleMutation object of the builder.
+func (rruo *RBACRoleUpdateOne) Mutation() *RBACRoleMutation {
+	return rruo.mutation
+}
+
+// Where appends a list predicates to the RBACRoleUpdate builder.
+func (rruo *RBACRoleUpdateOne) Where(ps ...predicate.RBACRole) *RBACRoleUpdateOne {
+	rruo.mutation.Where(ps...)
+	return rruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rruo *RBACRoleUpdateOne) Select(field string, fields ...string) *RBACRoleUpdateOne {
+	rruo.fields = append([]string{field}, fields...)
+	return rruo
+}
+
+// Save executes the query and returns the updated RBACRole entity.
+func (rruo *RBACRoleUpdateOne) Save(ctx context.Context) (*RBACRole, error) {
+	rruo.defaults()
+	return withHooks(ctx, rruo.sqlSave, rruo.mutation, rruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rruo *RBACRoleUpdateOne) SaveX(ctx context.Context) *RBACRole {
+	node, err := rruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rruo *RBACRoleUpdateOne) Exec(ctx context.Context) error {
+	_, err := rruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rruo *RBACRoleUpdateOne) ExecX(ctx context.Context) {
+	if err := rruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rruo *RBACRoleUpdateOne) defaults() {
+	if _, ok := rruo.mutation.UpdatedAt(); !ok {
+		v := rbacrole.UpdateDefaultUpdatedAt()
+		rruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rruo *RBACRoleUpdateOne) check() error {
+	if v, ok := rruo.mutation.Name(); ok {
+		if err := rbacrole.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RBACRole.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rruo *RBACRoleUpdateOne) sqlSave(ctx context.Context) (_node *RBACRole, err error) {
+	if err := rruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rbacrole.Table, rbacrole.Columns, sqlgraph.NewFieldSpec(rbacrole.FieldID, field.TypeString))
+	id, ok := rruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RBACRole.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rbacrole.FieldID)
+		for _, f := range fields {
+			if !rbacrole.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rbacrole.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rruo.mutation.Status(); ok {
+		_spec.SetField(rbacrole.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := rruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(rbacrole.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rruo.mutation.CreatedByCleared() {
+		_spec.ClearField(rbacrole.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := rruo.mutation.UpdatedBy(); ok {
+		_spec.SetField(rbacrole.FieldUpdatedBy, field.TypeString, value)
+	}
+	if rruo.mutation.UpdatedByCleared() {
+		_spec.ClearField(rbacrole.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := rruo.mutation.Name(); ok {
+		_spec.SetField(rbacrole.FieldName, field.TypeString, value)
+	}
+	if value, ok := rruo.mutation.Description(); ok {
+		_spec.SetField(rbacrole.FieldDescription, field.TypeString, value)
+	}
+	if rruo.mutation.DescriptionCleared() {
+		_spec.ClearField(rbacrole.FieldDescription, field.TypeString)
+	}
+	if value, ok := rruo.mutation.Permissions(); ok {
+		_spec.SetField(rbacrole.FieldPermissions, field.TypeJSON, value)
+	}
+	if rruo.mutation.PermissionsCleared() {
+		_spec.ClearField(rbacrole.FieldPermissions, field.TypeJSON)
+	}
+	if value, ok := rruo.mutation.EnvironmentIds(); ok {
+		_spec.SetField(rbacrole.FieldEnvironmentIds, field.TypeJSON, value)
+	}
+	if value, ok := rruo.mutation.AppendedEnvironmentIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, rbacrole.FieldEnvironmentIds, value)
+		})
+	}
+	if rruo.mutation.EnvironmentIdsCleared() {
+		_spec.ClearField(rbacrole.FieldEnvironmentIds, field.TypeJSON)
+	}
+	_node = &RBACRole{config: rruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rbacrole.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rruo.mutation.done = true
+	return _node, nil
+}
@@ -8,8 +8,11 @@ import (
 	"github.com/flexprice/flexprice/ent/addon"
 	"github.com/flexprice/flexprice/ent/addonassociation"
 	"github.com/flexprice/flexprice/ent/alertlogs"
+	"github.com/flexprice/flexprice/ent/auditlog"
 	"github.com/flexprice/flexprice/ent/auth"
 	"github.com/flexprice/flexprice/ent/billingsequence"
+	"github.com/flexprice/flexprice/ent/commitmentcontract"
+	"github.com/flexprice/flexprice/ent/commitmentdrawdown"
 	"github.com/flexprice/flexprice/ent/connection"
 	"github.com/flexprice/flexprice/ent/costsheet"
 	"github.com/flexprice/flexprice/ent/coupon"
@@ -24,7 +27,9 @@ import (
 	"github.com/flexprice/flexprice/ent/entityintegrationmapping"
 	"github.com/flexprice/flexprice/ent/environment"
 	"github.com/flexprice/flexprice/ent/feature"
+	"github.com/flexprice/flexprice/ent/fxrate"
 	"github.com/flexprice/flexprice/ent/group"
+	"github.com/flexprice/flexprice/ent/invitation"
 	"github.com/flexprice/flexprice/ent/invoice"
 	"github.com/flexprice/flexprice/ent/invoicelineitem"
 	"github.com/flexprice/flexprice/ent/invoicesequence"
@@ -33,25 +38,33 @@ import (
 	"github.com/flexprice/flexprice/ent/paymentattempt"
 	"github.com/flexprice/flexprice/ent/plan"
 	"github.com/flexprice/flexprice/ent/price"
+	"github.com/flexprice/flexprice/ent/pricebook"
 	"github.com/flexprice/flexprice/ent/priceunit"
+	"github.com/flexprice/flexprice/ent/promotioncode"
+	"github.com/flexprice/flexprice/ent/rbacrole"
 	"github.com/flexprice/flexprice/ent/scheduledtask"
 	"github.com/flexprice/flexprice/ent/schema"
 	"github.com/flexprice/flexprice/ent/secret"
 	"github.com/flexprice/flexprice/ent/settings"
+	"github.com/flexprice/flexprice/ent/ssoconnection"
 	"github.com/flexprice/flexprice/ent/subscription"
 	"github.com/flexprice/flexprice/ent/subscriptionlineitem"
 	"github.com/flexprice/flexprice/ent/subscriptionpause"
 	"github.com/flexprice/flexprice/ent/subscriptionphase"
 	"github.com/flexprice/flexprice/ent/subscriptionschedule"
+	"github.com/flexprice/flexprice/ent/subscriptionseatchange"
 	"github.com/flexprice/flexprice/ent/systemevent"
 	"github.com/flexprice/flexprice/ent/task"
 	"github.com/flexprice/flexprice/ent/taxapplied"
 	"github.com/flexprice/flexprice/ent/taxassociation"
 	"github.com/flexprice/flexprice/ent/taxrate"
 	"github.com/flexprice/flexprice/ent/tenant"
+	"github.com/flexprice/flexprice/ent/tenantmembership"
 	"github.com/flexprice/flexprice/ent/user"
 	"github.com/flexprice/flexprice/ent/wallet"
 	"github.com/flexprice/flexprice/ent/wallettransaction"
+	"github.com/flexprice/flexprice/ent/webhookdeliveryattempt"
+	"github.com/flexprice/flexprice/ent/webhookendpoint"
 	"github.com/flexprice/flexprice/ent/workflowexecution"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/shopspring/decimal"
@@ -194,6 +207,51 @@ func init() {
 	alertlogsDescAlertStatus := alertlogsFields[7].Descriptor()
 	// alertlogs.AlertStatusValidator is a validator for the "alert_status" field. It is called by the builders before save.
 	alertlogs.AlertStatusValidator = alertlogsDescAlertStatus.Validators[0].(func(string) error)
+	auditlogMixin := schema.AuditLog{}.Mixin()
+	auditlogMixinFields0 := auditlogMixin[0].Fields()
+	_ = auditlogMixinFields0
+	auditlogMixinFields1 := auditlogMixin[1].Fields()
+	_ = auditlogMixinFields1
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescTenantID is the schema descriptor for tenant_id field.
+	auditlogDescTenantID := auditlogMixinFields0[0].Descriptor()
+	// auditlog.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	auditlog.TenantIDValidator = auditlogDescTenantID.Validators[0].(func(string) error)
+	// auditlogDescStatus is the schema descriptor for status field.
+	auditlogDescStatus := auditlogMixinFields0[1].Descriptor()
+	// auditlog.DefaultStatus holds the default value on creation for the status field.
+	auditlog.DefaultStatus = auditlogDescStatus.Default.(string)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogMixinFields0[2].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	// auditlogDescUpdatedAt is the schema descriptor for updated_at field.
+	auditlogDescUpdatedAt := auditlogMixinFields0[3].Descriptor()
+	// auditlog.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	auditlog.DefaultUpdatedAt = auditlogDescUpdatedAt.Default.(func() time.Time)
+	// auditlog.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	auditlog.UpdateDefaultUpdatedAt = auditlogDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// auditlogDescEnvironmentID is the schema descriptor for environment_id field.
+	auditlogDescEnvironmentID := auditlogMixinFields1[0].Descriptor()
+	// auditlog.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	auditlog.DefaultEnvironmentID = auditlogDescEnvironmentID.Default.(string)
+	// auditlogDescEntityType is the schema descriptor for entity_type field.
+	auditlogDescEntityType := auditlogFields[1].Descriptor()
+	// auditlog.EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
+	auditlog.EntityTypeValidator = auditlogDescEntityType.Validators[0].(func(string) error)
+	// auditlogDescEntityID is the schema descriptor for entity_id field.
+	auditlogDescEntityID := auditlogFields[2].Descriptor()
+	// auditlog.EntityIDValidator is a validator for the "entity_id" field. It is called by the builders before save.
+	auditlog.EntityIDValidator = auditlogDescEntityID.Validators[0].(func(string) error)
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[3].Descriptor()
+	// auditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditlog.ActionValidator = auditlogDescAction.Validators[0].(func(string) error)
+	// auditlogDescActorType is the schema descriptor for actor_type field.
+	auditlogDescActorType := auditlogFields[4].Descriptor()
+	// auditlog.ActorTypeValidator is a validator for the "actor_type" field. It is called by the builders before save.
+	auditlog.ActorTypeValidator = auditlogDescActorType.Validators[0].(func(string) error)
 	authFields := schema.Auth{}.Fields()
 	_ = authFields
 	// authDescProvider is the schema descriptor for provider field.
@@ -242,6 +300,108 @@ func init() {
 	billingsequence.DefaultUpdatedAt = billingsequenceDescUpdatedAt.Default.(func() time.Time)
 	// billingsequence.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	billingsequence.UpdateDefaultUpdatedAt = billingsequenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	commitmentcontractMixin := schema.CommitmentContract{}.Mixin()
+	commitmentcontractMixinFields0 := commitmentcontractMixin[0].Fields()
+	_ = commitmentcontractMixinFields0
+	commitmentcontractMixinFields1 := commitmentcontractMixin[1].Fields()
+	_ = commitmentcontractMixinFields1
+	commitmentcontractFields := schema.CommitmentContract{}.Fields()
+	_ = commitmentcontractFields
+	// commitmentcontractDescTenantID is the schema descriptor for tenant_id field.
+	commitmentcontractDescTenantID := commitmentcontractMixinFields0[0].Descriptor()
+	// commitmentcontract.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	commitmentcontract.TenantIDValidator = commitmentcontractDescTenantID.Validators[0].(func(string) error)
+	// commitmentcontractDescStatus is the schema descriptor for status field.
+	commitmentcontractDescStatus := commitmentcontractMixinFields0[1].Descriptor()
+	// commitmentcontract.DefaultStatus holds the default value on creation for the status field.
+	commitmentcontract.DefaultStatus = commitmentcontractDescStatus.Default.(string)
+	// commitmentcontractDescCreatedAt is the schema descriptor for created_at field.
+	commitmentcontractDescCreatedAt := commitmentcontractMixinFields0[2].Descriptor()
+	// commitmentcontract.DefaultCreatedAt holds the default value on creation for the created_at field.
+	commitmentcontract.DefaultCreatedAt = commitmentcontractDescCreatedAt.Default.(func() time.Time)
+	// commitmentcontractDescUpdatedAt is the schema descriptor for updated_at field.
+	commitmentcontractDescUpdatedAt := commitmentcontractMixinFields0[3].Descriptor()
+	// commitmentcontract.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	commitmentcontract.DefaultUpdatedAt = commitmentcontractDescUpdatedAt.Default.(func() time.Time)
+	// commitmentcontract.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	commitmentcontract.UpdateDefaultUpdatedAt = commitmentcontractDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// commitmentcontractDescEnvironmentID is the schema descriptor for environment_id field.
+	commitmentcontractDescEnvironmentID := commitmentcontractMixinFields1[0].Descriptor()
+	// commitmentcontract.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	commitmentcontract.DefaultEnvironmentID = commitmentcontractDescEnvironmentID.Default.(string)
+	// commitmentcontractDescName is the schema descriptor for name field.
+	commitmentcontractDescName := commitmentcontractFields[1].Descriptor()
+	// commitmentcontract.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	commitmentcontract.NameValidator = commitmentcontractDescName.Validators[0].(func(string) error)
+	// commitmentcontractDescCustomerID is the schema descriptor for customer_id field.
+	commitmentcontractDescCustomerID := commitmentcontractFields[2].Descriptor()
+	// commitmentcontract.CustomerIDValidator is a validator for the "customer_id" field. It is called by the builders before save.
+	commitmentcontract.CustomerIDValidator = commitmentcontractDescCustomerID.Validators[0].(func(string) error)
+	// commitmentcontractDescCurrency is the schema descriptor for currency field.
+	commitmentcontractDescCurrency := commitmentcontractFields[4].Descriptor()
+	// commitmentcontract.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	commitmentcontract.CurrencyValidator = commitmentcontractDescCurrency.Validators[0].(func(string) error)
+	// commitmentcontractDescOverageFactor is the schema descriptor for overage_factor field.
+	commitmentcontractDescOverageFactor := commitmentcontractFields[7].Descriptor()
+	// commitmentcontract.DefaultOverageFactor holds the default value on creation for the overage_factor field.
+	commitmentcontract.DefaultOverageFactor = commitmentcontractDescOverageFactor.Default.(decimal.Decimal)
+	// commitmentcontractDescDrawnDownAmount is the schema descriptor for drawn_down_amount field.
+	commitmentcontractDescDrawnDownAmount := commitmentcontractFields[8].Descriptor()
+	// commitmentcontract.DefaultDrawnDownAmount holds the default value on creation for the drawn_down_amount field.
+	commitmentcontract.DefaultDrawnDownAmount = commitmentcontractDescDrawnDownAmount.Default.(decimal.Decimal)
+	// commitmentcontractDescOverageAmount is the schema descriptor for overage_amount field.
+	commitmentcontractDescOverageAmount := commitmentcontractFields[9].Descriptor()
+	// commitmentcontract.DefaultOverageAmount holds the default value on creation for the overage_amount field.
+	commitmentcontract.DefaultOverageAmount = commitmentcontractDescOverageAmount.Default.(decimal.Decimal)
+	// commitmentcontractDescEnableTrueUp is the schema descriptor for enable_true_up field.
+	commitmentcontractDescEnableTrueUp := commitmentcontractFields[12].Descriptor()
+	// commitmentcontract.DefaultEnableTrueUp holds the default value on creation for the enable_true_up field.
+	commitmentcontract.DefaultEnableTrueUp = commitmentcontractDescEnableTrueUp.Default.(bool)
+	// commitmentcontractDescContractStatus is the schema descriptor for contract_status field.
+	commitmentcontractDescContractStatus := commitmentcontractFields[13].Descriptor()
+	// commitmentcontract.DefaultContractStatus holds the default value on creation for the contract_status field.
+	commitmentcontract.DefaultContractStatus = commitmentcontractDescContractStatus.Default.(string)
+	commitmentdrawdownMixin := schema.CommitmentDrawdown{}.Mixin()
+	commitmentdrawdownMixinFields0 := commitmentdrawdownMixin[0].Fields()
+	_ = commitmentdrawdownMixinFields0
+	commitmentdrawdownMixinFields1 := commitmentdrawdownMixin[1].Fields()
+	_ = commitmentdrawdownMixinFields1
+	commitmentdrawdownFields := schema.CommitmentDrawdown{}.Fields()
+	_ = commitmentdrawdownFields
+	// commitmentdrawdownDescTenantID is the schema descriptor for tenant_id field.
+	commitmentdrawdownDescTenantID := commitmentdrawdownMixinFields0[0].Descriptor()
+	// commitmentdrawdown.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	commitmentdrawdown.TenantIDValidator = commitmentdrawdownDescTenantID.Validators[0].(func(string) error)
+	// commitmentdrawdownDescStatus is the schema descriptor for status field.
+	commitmentdrawdownDescStatus := commitmentdrawdownMixinFields0[1].Descriptor()
+	// commitmentdrawdown.DefaultStatus holds the default value on creation for the status field.
+	commitmentdrawdown.DefaultStatus = commitmentdrawdownDescStatus.Default.(string)
+	// commitmentdrawdownDescCreatedAt is the schema descriptor for created_at field.
+	commitmentdrawdownDescCreatedAt := commitmentdrawdownMixinFields0[2].Descriptor()
+	// commitmentdrawdown.DefaultCreatedAt holds the default value on creation for the created_at field.
+	commitmentdrawdown.DefaultCreatedAt = commitmentdrawdownDescCreatedAt.Default.(func() time.Time)
+	// commitmentdrawdownDescUpdatedAt is the schema descriptor for updated_at field.
+	commitmentdrawdownDescUpdatedAt := commitmentdrawdownMixinFields0[3].Descriptor()
+	// commitmentdrawdown.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	commitmentdrawdown.DefaultUpdatedAt = commitmentdrawdownDescUpdatedAt.Default.(func() time.Time)
+	// commitmentdrawdown.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	commitmentdrawdown.UpdateDefaultUpdatedAt = commitmentdrawdownDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// commitmentdrawdownDescEnvironmentID is the schema descriptor for environment_id field.
+	commitmentdrawdownDescEnvironmentID := commitmentdrawdownMixinFields1[0].Descriptor()
+	// commitmentdrawdown.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	commitmentdrawdown.DefaultEnvironmentID = commitmentdrawdownDescEnvironmentID.Default.(string)
+	// commitmentdrawdownDescContractID is the schema descriptor for contract_id field.
+	commitmentdrawdownDescContractID := commitmentdrawdownFields[1].Descriptor()
+	// commitmentdrawdown.ContractIDValidator is a validator for the "contract_id" field. It is called by the builders before save.
+	commitmentdrawdown.ContractIDValidator = commitmentdrawdownDescContractID.Validators[0].(func(string) error)
+	// commitmentdrawdownDescSubscriptionID is the schema descriptor for subscription_id field.
+	commitmentdrawdownDescSubscriptionID := commitmentdrawdownFields[2].Descriptor()
+	// commitmentdrawdown.SubscriptionIDValidator is a validator for the "subscription_id" field. It is called by the builders before save.
+	commitmentdrawdown.SubscriptionIDValidator = commitmentdrawdownDescSubscriptionID.Validators[0].(func(string) error)
+	// commitmentdrawdownDescInvoiceID is the schema descriptor for invoice_id field.
+	commitmentdrawdownDescInvoiceID := commitmentdrawdownFields[3].Descriptor()
+	// commitmentdrawdown.InvoiceIDValidator is a validator for the "invoice_id" field. It is called by the builders before save.
+	commitmentdrawdown.InvoiceIDValidator = commitmentdrawdownDescInvoiceID.Validators[0].(func(string) error)
 	connectionMixin := schema.Connection{}.Mixin()
 	connectionMixinFields0 := connectionMixin[0].Fields()
 	_ = connectionMixinFields0
@@ -505,7 +665,7 @@ func init() {
 	// creditgrant.ExpirationTypeValidator is a validator for the "expiration_type" field. It is called by the builders before save.
 	creditgrant.ExpirationTypeValidator = creditgrantDescExpirationType.Validators[0].(func(string) error)
 	// creditgrantDescMetadata is the schema descriptor for metadata field.
-	creditgrantDescMetadata := creditgrantFields[15].Descriptor()
+	creditgrantDescMetadata := creditgrantFields[16].Descriptor()
 	// creditgrant.DefaultMetadata holds the default value on creation for the metadata field.
 	creditgrant.DefaultMetadata = creditgrantDescMetadata.Default.(map[string]string)
 	creditgrantapplicationMixin := schema.CreditGrantApplication{}.Mixin()
@@ -704,6 +864,10 @@ func init() {
 	customerDescName := customerFields[2].Descriptor()
 	// customer.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	customer.NameValidator = customerDescName.Validators[0].(func(string) error)
+	// customerDescInvoiceToParent is the schema descriptor for invoice_to_parent field.
+	customerDescInvoiceToParent := customerFields[12].Descriptor()
+	// customer.DefaultInvoiceToParent holds the default value on creation for the invoice_to_parent field.
+	customer.DefaultInvoiceToParent = customerDescInvoiceToParent.Default.(bool)
 	entitlementMixin := schema.Entitlement{}.Mixin()
 	entitlementMixinFields0 := entitlementMixin[0].Fields()
 	_ = entitlementMixinFields0
@@ -837,6 +1001,47 @@ func init() {
 	environmentDescType := environmentFields[2].Descriptor()
 	// environment.TypeValidator is a validator for the "type" field. It is called by the builders before save.
 	environment.TypeValidator = environmentDescType.Validators[0].(func(string) error)
+	fxrateMixin := schema.FXRate{}.Mixin()
+	fxrateMixinFields0 := fxrateMixin[0].Fields()
+	_ = fxrateMixinFields0
+	fxrateMixinFields1 := fxrateMixin[1].Fields()
+	_ = fxrateMixinFields1
+	fxrateFields := schema.FXRate{}.Fields()
+	_ = fxrateFields
+	// fxrateDescTenantID is the schema descriptor for tenant_id field.
+	fxrateDescTenantID := fxrateMixinFields0[0].Descriptor()
+	// fxrate.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	fxrate.TenantIDValidator = fxrateDescTenantID.Validators[0].(func(string) error)
+	// fxrateDescStatus is the schema descriptor for status field.
+	fxrateDescStatus := fxrateMixinFields0[1].Descriptor()
+	// fxrate.DefaultStatus holds the default value on creation for the status field.
+	fxrate.DefaultStatus = fxrateDescStatus.Default.(string)
+	// fxrateDescCreatedAt is the schema descriptor for created_at field.
+	fxrateDescCreatedAt := fxrateMixinFields0[2].Descriptor()
+	// fxrate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	fxrate.DefaultCreatedAt = fxrateDescCreatedAt.Default.(func() time.Time)
+	// fxrateDescUpdatedAt is the schema descriptor for updated_at field.
+	fxrateDescUpdatedAt := fxrateMixinFields0[3].Descriptor()
+	// fxrate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	fxrate.DefaultUpdatedAt = fxrateDescUpdatedAt.Default.(func() time.Time)
+	// fxrate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	fxrate.UpdateDefaultUpdatedAt = fxrateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// fxrateDescEnvironmentID is the schema descriptor for environment_id field.
+	fxrateDescEnvironmentID := fxrateMixinFields1[0].Descriptor()
+	// fxrate.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	fxrate.DefaultEnvironmentID = fxrateDescEnvironmentID.Default.(string)
+	// fxrateDescBaseCurrency is the schema descriptor for base_currency field.
+	fxrateDescBaseCurrency := fxrateFields[1].Descriptor()
+	// fxrate.BaseCurrencyValidator is a validator for the "base_currency" field. It is called by the builders before save.
+	fxrate.BaseCurrencyValidator = fxrateDescBaseCurrency.Validators[0].(func(string) error)
+	// fxrateDescQuoteCurrency is the schema descriptor for quote_currency field.
+	fxrateDescQuoteCurrency := fxrateFields[2].Descriptor()
+	// fxrate.QuoteCurrencyValidator is a validator for the "quote_currency" field. It is called by the builders before save.
+	fxrate.QuoteCurrencyValidator = fxrateDescQuoteCurrency.Validators[0].(func(string) error)
+	// fxrateDescSource is the schema descriptor for source field.
+	fxrateDescSource := fxrateFields[5].Descriptor()
+	// fxrate.DefaultSource holds the default value on creation for the source field.
+	fxrate.DefaultSource = fxrateDescSource.Default.(string)
 	featureMixin := schema.Feature{}.Mixin()
 	featureMixinFields0 := featureMixin[0].Fields()
 	_ = featureMixinFields0
@@ -911,6 +1116,41 @@ func init() {
 	groupDescEntityType := groupFields[2].Descriptor()
 	// group.DefaultEntityType holds the default value on creation for the entity_type field.
 	group.DefaultEntityType = groupDescEntityType.Default.(string)
+	invitationMixin := schema.Invitation{}.Mixin()
+	invitationMixinFields0 := invitationMixin[0].Fields()
+	_ = invitationMixinFields0
+	invitationFields := schema.Invitation{}.Fields()
+	_ = invitationFields
+	// invitationDescTenantID is the schema descriptor for tenant_id field.
+	invitationDescTenantID := invitationMixinFields0[0].Descriptor()
+	// invitation.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	invitation.TenantIDValidator = invitationDescTenantID.Validators[0].(func(string) error)
+	// invitationDescStatus is the schema descriptor for status field.
+	invitationDescStatus := invitationMixinFields0[1].Descriptor()
+	// invitation.DefaultStatus holds the default value on creation for the status field.
+	invitation.DefaultStatus = invitationDescStatus.Default.(string)
+	// invitationDescCreatedAt is the schema descriptor for created_at field.
+	invitationDescCreatedAt := invitationMixinFields0[2].Descriptor()
+	// invitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invitation.DefaultCreatedAt = invitationDescCreatedAt.Default.(func() time.Time)
+	// invitationDescUpdatedAt is the schema descriptor for updated_at field.
+	invitationDescUpdatedAt := invitationMixinFields0[3].Descriptor()
+	// invitation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	invitation.DefaultUpdatedAt = invitationDescUpdatedAt.Default.(func() time.Time)
+	// invitation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	invitation.UpdateDefaultUpdatedAt = invitationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// invitationDescEmail is the schema descriptor for email field.
+	invitationDescEmail := invitationFields[1].Descriptor()
+	// invitation.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	invitation.EmailValidator = invitationDescEmail.Validators[0].(func(string) error)
+	// invitationDescTokenHash is the schema descriptor for token_hash field.
+	invitationDescTokenHash := invitationFields[3].Descriptor()
+	// invitation.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	invitation.TokenHashValidator = invitationDescTokenHash.Validators[0].(func(string) error)
+	// invitationDescInvitationStatus is the schema descriptor for invitation_status field.
+	invitationDescInvitationStatus := invitationFields[4].Descriptor()
+	// invitation.DefaultInvitationStatus holds the default value on creation for the invitation_status field.
+	invitation.DefaultInvitationStatus = invitationDescInvitationStatus.Default.(string)
 	invoiceMixin := schema.Invoice{}.Mixin()
 	invoiceMixinFields0 := invoiceMixin[0].Fields()
 	_ = invoiceMixinFields0
@@ -1372,6 +1612,43 @@ func init() {
 	priceDescStartDate := priceFields[31].Descriptor()
 	// price.DefaultStartDate holds the default value on creation for the start_date field.
 	price.DefaultStartDate = priceDescStartDate.Default.(func() time.Time)
+	pricebookMixin := schema.PriceBook{}.Mixin()
+	pricebookMixinFields0 := pricebookMixin[0].Fields()
+	_ = pricebookMixinFields0
+	pricebookMixinFields1 := pricebookMixin[1].Fields()
+	_ = pricebookMixinFields1
+	pricebookFields := schema.PriceBook{}.Fields()
+	_ = pricebookFields
+	// pricebookDescTenantID is the schema descriptor for tenant_id field.
+	pricebookDescTenantID := pricebookMixinFields0[0].Descriptor()
+	// pricebook.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	pricebook.TenantIDValidator = pricebookDescTenantID.Validators[0].(func(string) error)
+	// pricebookDescStatus is the schema descriptor for status field.
+	pricebookDescStatus := pricebookMixinFields0[1].Descriptor()
+	// pricebook.DefaultStatus holds the default value on creation for the status field.
+	pricebook.DefaultStatus = pricebookDescStatus.Default.(string)
+	// pricebookDescCreatedAt is the schema descriptor for created_at field.
+	pricebookDescCreatedAt := pricebookMixinFields0[2].Descriptor()
+	// pricebook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pricebook.DefaultCreatedAt = pricebookDescCreatedAt.Default.(func() time.Time)
+	// pricebookDescUpdatedAt is the schema descriptor for updated_at field.
+	pricebookDescUpdatedAt := pricebookMixinFields0[3].Descriptor()
+	// pricebook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pricebook.DefaultUpdatedAt = pricebookDescUpdatedAt.Default.(func() time.Time)
+	// pricebook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	pricebook.UpdateDefaultUpdatedAt = pricebookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// pricebookDescEnvironmentID is the schema descriptor for environment_id field.
+	pricebookDescEnvironmentID := pricebookMixinFields1[0].Descriptor()
+	// pricebook.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	pricebook.DefaultEnvironmentID = pricebookDescEnvironmentID.Default.(string)
+	// pricebookDescName is the schema descriptor for name field.
+	pricebookDescName := pricebookFields[1].Descriptor()
+	// pricebook.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	pricebook.NameValidator = pricebookDescName.Validators[0].(func(string) error)
+	// pricebookDescBaseCurrency is the schema descriptor for base_currency field.
+	pricebookDescBaseCurrency := pricebookFields[3].Descriptor()
+	// pricebook.BaseCurrencyValidator is a validator for the "base_currency" field. It is called by the builders before save.
+	pricebook.BaseCurrencyValidator = pricebookDescBaseCurrency.Validators[0].(func(string) error)
 	priceunitMixin := schema.PriceUnit{}.Mixin()
 	priceunitMixinFields0 := priceunitMixin[0].Fields()
 	_ = priceunitMixinFields0
@@ -1421,6 +1698,121 @@ func init() {
 	priceunitDescConversionRate := priceunitFields[5].Descriptor()
 	// priceunit.DefaultConversionRate holds the default value on creation for the conversion_rate field.
 	priceunit.DefaultConversionRate = priceunitDescConversionRate.Default.(decimal.Decimal)
+	promotioncodeMixin := schema.PromotionCode{}.Mixin()
+	promotioncodeMixinFields0 := promotioncodeMixin[0].Fields()
+	_ = promotioncodeMixinFields0
+	promotioncodeMixinFields1 := promotioncodeMixin[1].Fields()
+	_ = promotioncodeMixinFields1
+	promotioncodeFields := schema.PromotionCode{}.Fields()
+	_ = promotioncodeFields
+	// promotioncodeDescTenantID is the schema descriptor for tenant_id field.
+	promotioncodeDescTenantID := promotioncodeMixinFields0[0].Descriptor()
+	// promotioncode.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	promotioncode.TenantIDValidator = promotioncodeDescTenantID.Validators[0].(func(string) error)
+	// promotioncodeDescStatus is the schema descriptor for status field.
+	promotioncodeDescStatus := promotioncodeMixinFields0[1].Descriptor()
+	// promotioncode.DefaultStatus holds the default value on creation for the status field.
+	promotioncode.DefaultStatus = promotioncodeDescStatus.Default.(string)
+	// promotioncodeDescCreatedAt is the schema descriptor for created_at field.
+	promotioncodeDescCreatedAt := promotioncodeMixinFields0[2].Descriptor()
+	// promotioncode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	promotioncode.DefaultCreatedAt = promotioncodeDescCreatedAt.Default.(func() time.Time)
+	// promotioncodeDescUpdatedAt is the schema descriptor for updated_at field.
+	promotioncodeDescUpdatedAt := promotioncodeMixinFields0[3].Descriptor()
+	// promotioncode.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	promotioncode.DefaultUpdatedAt = promotioncodeDescUpdatedAt.Default.(func() time.Time)
+	// promotioncode.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	promotioncode.UpdateDefaultUpdatedAt = promotioncodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// promotioncodeDescEnvironmentID is the schema descriptor for environment_id field.
+	promotioncodeDescEnvironmentID := promotioncodeMixinFields1[0].Descriptor()
+	// promotioncode.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	promotioncode.DefaultEnvironmentID = promotioncodeDescEnvironmentID.Default.(string)
+	// promotioncodeDescCouponID is the schema descriptor for coupon_id field.
+	promotioncodeDescCouponID := promotioncodeFields[1].Descriptor()
+	// promotioncode.CouponIDValidator is a validator for the "coupon_id" field. It is called by the builders before save.
+	promotioncode.CouponIDValidator = promotioncodeDescCouponID.Validators[0].(func(string) error)
+	// promotioncodeDescCode is the schema descriptor for code field.
+	promotioncodeDescCode := promotioncodeFields[2].Descriptor()
+	// promotioncode.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	promotioncode.CodeValidator = promotioncodeDescCode.Validators[0].(func(string) error)
+	// promotioncodeDescActive is the schema descriptor for active field.
+	promotioncodeDescActive := promotioncodeFields[3].Descriptor()
+	// promotioncode.DefaultActive holds the default value on creation for the active field.
+	promotioncode.DefaultActive = promotioncodeDescActive.Default.(bool)
+	// promotioncodeDescTimesRedeemed is the schema descriptor for times_redeemed field.
+	promotioncodeDescTimesRedeemed := promotioncodeFields[6].Descriptor()
+	// promotioncode.DefaultTimesRedeemed holds the default value on creation for the times_redeemed field.
+	promotioncode.DefaultTimesRedeemed = promotioncodeDescTimesRedeemed.Default.(int)
+	// promotioncodeDescFirstTimeCustomerOnly is the schema descriptor for first_time_customer_only field.
+	promotioncodeDescFirstTimeCustomerOnly := promotioncodeFields[7].Descriptor()
+	// promotioncode.DefaultFirstTimeCustomerOnly holds the default value on creation for the first_time_customer_only field.
+	promotioncode.DefaultFirstTimeCustomerOnly = promotioncodeDescFirstTimeCustomerOnly.Default.(bool)
+	rbacroleMixin := schema.RBACRole{}.Mixin()
+	rbacroleMixinFields0 := rbacroleMixin[0].Fields()
+	_ = rbacroleMixinFields0
+	rbacroleFields := schema.RBACRole{}.Fields()
+	_ = rbacroleFields
+	// rbacroleDescTenantID is the schema descriptor for tenant_id field.
+	rbacroleDescTenantID := rbacroleMixinFields0[0].Descriptor()
+	// rbacrole.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	rbacrole.TenantIDValidator = rbacroleDescTenantID.Validators[0].(func(string) error)
+	// rbacroleDescStatus is the schema descriptor for status field.
+	rbacroleDescStatus := rbacroleMixinFields0[1].Descriptor()
+	// rbacrole.DefaultStatus holds the default value on creation for the status field.
+	rbacrole.DefaultStatus = rbacroleDescStatus.Default.(string)
+	// rbacroleDescCreatedAt is the schema descriptor for created_at field.
+	rbacroleDescCreatedAt := rbacroleMixinFields0[2].Descriptor()
+	// rbacrole.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rbacrole.DefaultCreatedAt = rbacroleDescCreatedAt.Default.(func() time.Time)
+	// rbacroleDescUpdatedAt is the schema descriptor for updated_at field.
+	rbacroleDescUpdatedAt := rbacroleMixinFields0[3].Descriptor()
+	// rbacrole.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rbacrole.DefaultUpdatedAt = rbacroleDescUpdatedAt.Default.(func() time.Time)
+	// rbacrole.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	rbacrole.UpdateDefaultUpdatedAt = rbacroleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// rbacroleDescName is the schema descriptor for name field.
+	rbacroleDescName := rbacroleFields[1].Descriptor()
+	// rbacrole.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	rbacrole.NameValidator = rbacroleDescName.Validators[0].(func(string) error)
+	ssoconnectionMixin := schema.SSOConnection{}.Mixin()
+	ssoconnectionMixinFields0 := ssoconnectionMixin[0].Fields()
+	_ = ssoconnectionMixinFields0
+	ssoconnectionFields := schema.SSOConnection{}.Fields()
+	_ = ssoconnectionFields
+	// ssoconnectionDescTenantID is the schema descriptor for tenant_id field.
+	ssoconnectionDescTenantID := ssoconnectionMixinFields0[0].Descriptor()
+	// ssoconnection.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	ssoconnection.TenantIDValidator = ssoconnectionDescTenantID.Validators[0].(func(string) error)
+	// ssoconnectionDescStatus is the schema descriptor for status field.
+	ssoconnectionDescStatus := ssoconnectionMixinFields0[1].Descriptor()
+	// ssoconnection.DefaultStatus holds the default value on creation for the status field.
+	ssoconnection.DefaultStatus = ssoconnectionDescStatus.Default.(string)
+	// ssoconnectionDescCreatedAt is the schema descriptor for created_at field.
+	ssoconnectionDescCreatedAt := ssoconnectionMixinFields0[2].Descriptor()
+	// ssoconnection.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ssoconnection.DefaultCreatedAt = ssoconnectionDescCreatedAt.Default.(func() time.Time)
+	// ssoconnectionDescUpdatedAt is the schema descriptor for updated_at field.
+	ssoconnectionDescUpdatedAt := ssoconnectionMixinFields0[3].Descriptor()
+	// ssoconnection.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ssoconnection.DefaultUpdatedAt = ssoconnectionDescUpdatedAt.Default.(func() time.Time)
+	// ssoconnection.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ssoconnection.UpdateDefaultUpdatedAt = ssoconnectionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ssoconnectionDescName is the schema descriptor for name field.
+	ssoconnectionDescName := ssoconnectionFields[1].Descriptor()
+	// ssoconnection.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	ssoconnection.NameValidator = ssoconnectionDescName.Validators[0].(func(string) error)
+	// ssoconnectionDescProtocol is the schema descriptor for protocol field.
+	ssoconnectionDescProtocol := ssoconnectionFields[2].Descriptor()
+	// ssoconnection.ProtocolValidator is a validator for the "protocol" field. It is called by the builders before save.
+	ssoconnection.ProtocolValidator = ssoconnectionDescProtocol.Validators[0].(func(string) error)
+	// ssoconnectionDescEnabled is the schema descriptor for enabled field.
+	ssoconnectionDescEnabled := ssoconnectionFields[6].Descriptor()
+	// ssoconnection.DefaultEnabled holds the default value on creation for the enabled field.
+	ssoconnection.DefaultEnabled = ssoconnectionDescEnabled.Default.(bool)
+	// ssoconnectionDescJitProvisioning is the schema descriptor for jit_provisioning field.
+	ssoconnectionDescJitProvisioning := ssoconnectionFields[16].Descriptor()
+	// ssoconnection.DefaultJitProvisioning holds the default value on creation for the jit_provisioning field.
+	ssoconnection.DefaultJitProvisioning = ssoconnectionDescJitProvisioning.Default.(bool)
 	scheduledtaskMixin := schema.ScheduledTask{}.Mixin()
 	scheduledtaskMixinFields0 := scheduledtaskMixin[0].Fields()
 	_ = scheduledtaskMixinFields0
@@ -1515,6 +1907,14 @@ func init() {
 	secretDescUserType := secretFields[10].Descriptor()
 	// secret.DefaultUserType holds the default value on creation for the user_type field.
 	secret.DefaultUserType = secretDescUserType.Default.(string)
+	// secretDescScopes is the schema descriptor for scopes field.
+	secretDescScopes := secretFields[11].Descriptor()
+	// secret.DefaultScopes holds the default value on creation for the scopes field.
+	secret.DefaultScopes = secretDescScopes.Default.([]string)
+	// secretDescAllowedIps is the schema descriptor for allowed_ips field.
+	secretDescAllowedIps := secretFields[12].Descriptor()
+	// secret.DefaultAllowedIps holds the default value on creation for the allowed_ips field.
+	secret.DefaultAllowedIps = secretDescAllowedIps.Default.([]string)
 	settingsMixin := schema.Settings{}.Mixin()
 	settingsMixinFields0 := settingsMixin[0].Fields()
 	_ = settingsMixinFields0
@@ -1871,6 +2271,47 @@ func init() {
 	subscriptionscheduleDescScheduleType := subscriptionscheduleFields[2].Descriptor()
 	// subscriptionschedule.ScheduleTypeValidator is a validator for the "schedule_type" field. It is called by the builders before save.
 	subscriptionschedule.ScheduleTypeValidator = subscriptionscheduleDescScheduleType.Validators[0].(func(string) error)
+	subscriptionseatchangeMixin := schema.SubscriptionSeatChange{}.Mixin()
+	subscriptionseatchangeMixinFields0 := subscriptionseatchangeMixin[0].Fields()
+	_ = subscriptionseatchangeMixinFields0
+	subscriptionseatchangeMixinFields1 := subscriptionseatchangeMixin[1].Fields()
+	_ = subscriptionseatchangeMixinFields1
+	subscriptionseatchangeFields := schema.SubscriptionSeatChange{}.Fields()
+	_ = subscriptionseatchangeFields
+	// subscriptionseatchangeDescTenantID is the schema descriptor for tenant_id field.
+	subscriptionseatchangeDescTenantID := subscriptionseatchangeMixinFields0[0].Descriptor()
+	// subscriptionseatchange.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	subscriptionseatchange.TenantIDValidator = subscriptionseatchangeDescTenantID.Validators[0].(func(string) error)
+	// subscriptionseatchangeDescStatus is the schema descriptor for status field.
+	subscriptionseatchangeDescStatus := subscriptionseatchangeMixinFields0[1].Descriptor()
+	// subscriptionseatchange.DefaultStatus holds the default value on creation for the status field.
+	subscriptionseatchange.DefaultStatus = subscriptionseatchangeDescStatus.Default.(string)
+	// subscriptionseatchangeDescCreatedAt is the schema descriptor for created_at field.
+	subscriptionseatchangeDescCreatedAt := subscriptionseatchangeMixinFields0[2].Descriptor()
+	// subscriptionseatchange.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subscriptionseatchange.DefaultCreatedAt = subscriptionseatchangeDescCreatedAt.Default.(func() time.Time)
+	// subscriptionseatchangeDescUpdatedAt is the schema descriptor for updated_at field.
+	subscriptionseatchangeDescUpdatedAt := subscriptionseatchangeMixinFields0[3].Descriptor()
+	// subscriptionseatchange.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	subscriptionseatchange.DefaultUpdatedAt = subscriptionseatchangeDescUpdatedAt.Default.(func() time.Time)
+	// subscriptionseatchange.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	subscriptionseatchange.UpdateDefaultUpdatedAt = subscriptionseatchangeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// subscriptionseatchangeDescEnvironmentID is the schema descriptor for environment_id field.
+	subscriptionseatchangeDescEnvironmentID := subscriptionseatchangeMixinFields1[0].Descriptor()
+	// subscriptionseatchange.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	subscriptionseatchange.DefaultEnvironmentID = subscriptionseatchangeDescEnvironmentID.Default.(string)
+	// subscriptionseatchangeDescSubscriptionID is the schema descriptor for subscription_id field.
+	subscriptionseatchangeDescSubscriptionID := subscriptionseatchangeFields[1].Descriptor()
+	// subscriptionseatchange.SubscriptionIDValidator is a validator for the "subscription_id" field. It is called by the builders before save.
+	subscriptionseatchange.SubscriptionIDValidator = subscriptionseatchangeDescSubscriptionID.Validators[0].(func(string) error)
+	// subscriptionseatchangeDescLineItemID is the schema descriptor for line_item_id field.
+	subscriptionseatchangeDescLineItemID := subscriptionseatchangeFields[2].Descriptor()
+	// subscriptionseatchange.LineItemIDValidator is a validator for the "line_item_id" field. It is called by the builders before save.
+	subscriptionseatchange.LineItemIDValidator = subscriptionseatchangeDescLineItemID.Validators[0].(func(string) error)
+	// subscriptionseatchangeDescProrationAmount is the schema descriptor for proration_amount field.
+	subscriptionseatchangeDescProrationAmount := subscriptionseatchangeFields[8].Descriptor()
+	// subscriptionseatchange.DefaultProrationAmount holds the default value on creation for the proration_amount field.
+	subscriptionseatchange.DefaultProrationAmount = subscriptionseatchangeDescProrationAmount.Default.(decimal.Decimal)
 	systemeventMixin := schema.SystemEvent{}.Mixin()
 	systemeventMixinFields0 := systemeventMixin[0].Fields()
 	_ = systemeventMixinFields0
@@ -1913,7 +2354,7 @@ func init() {
 	// systemevent.DefaultEntityID holds the default value on creation for the entity_id field.
 	systemevent.DefaultEntityID = systemeventDescEntityID.Default.(string)
 	// systemeventDescFailureCount is the schema descriptor for failure_count field.
-	systemeventDescFailureCount := systemeventFields[7].Descriptor()
+	systemeventDescFailureCount := systemeventFields[9].Descriptor()
 	// systemevent.DefaultFailureCount holds the default value on creation for the failure_count field.
 	systemevent.DefaultFailureCount = systemeventDescFailureCount.Default.(int)
 	taskMixin := schema.Task{}.Mixin()
@@ -2162,6 +2603,37 @@ func init() {
 	tenantDescBillingDetails := tenantFields[5].Descriptor()
 	// tenant.DefaultBillingDetails holds the default value on creation for the billing_details field.
 	tenant.DefaultBillingDetails = tenantDescBillingDetails.Default.(schema.TenantBillingDetails)
+	tenantmembershipMixin := schema.TenantMembership{}.Mixin()
+	tenantmembershipMixinFields0 := tenantmembershipMixin[0].Fields()
+	_ = tenantmembershipMixinFields0
+	tenantmembershipFields := schema.TenantMembership{}.Fields()
+	_ = tenantmembershipFields
+	// tenantmembershipDescTenantID is the schema descriptor for tenant_id field.
+	tenantmembershipDescTenantID := tenantmembershipMixinFields0[0].Descriptor()
+	// tenantmembership.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	tenantmembership.TenantIDValidator = tenantmembershipDescTenantID.Validators[0].(func(string) error)
+	// tenantmembershipDescStatus is the schema descriptor for status field.
+	tenantmembershipDescStatus := tenantmembershipMixinFields0[1].Descriptor()
+	// tenantmembership.DefaultStatus holds the default value on creation for the status field.
+	tenantmembership.DefaultStatus = tenantmembershipDescStatus.Default.(string)
+	// tenantmembershipDescCreatedAt is the schema descriptor for created_at field.
+	tenantmembershipDescCreatedAt := tenantmembershipMixinFields0[2].Descriptor()
+	// tenantmembership.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tenantmembership.DefaultCreatedAt = tenantmembershipDescCreatedAt.Default.(func() time.Time)
+	// tenantmembershipDescUpdatedAt is the schema descriptor for updated_at field.
+	tenantmembershipDescUpdatedAt := tenantmembershipMixinFields0[3].Descriptor()
+	// tenantmembership.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tenantmembership.DefaultUpdatedAt = tenantmembershipDescUpdatedAt.Default.(func() time.Time)
+	// tenantmembership.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tenantmembership.UpdateDefaultUpdatedAt = tenantmembershipDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tenantmembershipDescUserID is the schema descriptor for user_id field.
+	tenantmembershipDescUserID := tenantmembershipFields[1].Descriptor()
+	// tenantmembership.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	tenantmembership.UserIDValidator = tenantmembershipDescUserID.Validators[0].(func(string) error)
+	// tenantmembershipDescEmail is the schema descriptor for email field.
+	tenantmembershipDescEmail := tenantmembershipFields[2].Descriptor()
+	// tenantmembership.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	tenantmembership.EmailValidator = tenantmembershipDescEmail.Validators[0].(func(string) error)
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
@@ -2306,9 +2778,111 @@ func init() {
 	// wallettransaction.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
 	wallettransaction.CurrencyValidator = wallettransactionDescCurrency.Validators[0].(func(string) error)
 	// wallettransactionDescTransactionReason is the schema descriptor for transaction_reason field.
-	wallettransactionDescTransactionReason := wallettransactionFields[19].Descriptor()
+	wallettransactionDescTransactionReason := wallettransactionFields[23].Descriptor()
 	// wallettransaction.DefaultTransactionReason holds the default value on creation for the transaction_reason field.
 	wallettransaction.DefaultTransactionReason = types.TransactionReason(wallettransactionDescTransactionReason.Default.(string))
+	webhookdeliveryattemptMixin := schema.WebhookDeliveryAttempt{}.Mixin()
+	webhookdeliveryattemptMixinFields0 := webhookdeliveryattemptMixin[0].Fields()
+	_ = webhookdeliveryattemptMixinFields0
+	webhookdeliveryattemptMixinFields1 := webhookdeliveryattemptMixin[1].Fields()
+	_ = webhookdeliveryattemptMixinFields1
+	webhookdeliveryattemptFields := schema.WebhookDeliveryAttempt{}.Fields()
+	_ = webhookdeliveryattemptFields
+	// webhookdeliveryattemptDescTenantID is the schema descriptor for tenant_id field.
+	webhookdeliveryattemptDescTenantID := webhookdeliveryattemptMixinFields0[0].Descriptor()
+	// webhookdeliveryattempt.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	webhookdeliveryattempt.TenantIDValidator = webhookdeliveryattemptDescTenantID.Validators[0].(func(string) error)
+	// webhookdeliveryattemptDescStatus is the schema descriptor for status field.
+	webhookdeliveryattemptDescStatus := webhookdeliveryattemptMixinFields0[1].Descriptor()
+	// webhookdeliveryattempt.DefaultStatus holds the default value on creation for the status field.
+	webhookdeliveryattempt.DefaultStatus = webhookdeliveryattemptDescStatus.Default.(string)
+	// webhookdeliveryattemptDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryattemptDescCreatedAt := webhookdeliveryattemptMixinFields0[2].Descriptor()
+	// webhookdeliveryattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdeliveryattempt.DefaultCreatedAt = webhookdeliveryattemptDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryattemptDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryattemptDescUpdatedAt := webhookdeliveryattemptMixinFields0[3].Descriptor()
+	// webhookdeliveryattempt.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdeliveryattempt.DefaultUpdatedAt = webhookdeliveryattemptDescUpdatedAt.Default.(func() time.Time)
+	// webhookdeliveryattempt.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdeliveryattempt.UpdateDefaultUpdatedAt = webhookdeliveryattemptDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryattemptDescEnvironmentID is the schema descriptor for environment_id field.
+	webhookdeliveryattemptDescEnvironmentID := webhookdeliveryattemptMixinFields1[0].Descriptor()
+	// webhookdeliveryattempt.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	webhookdeliveryattempt.DefaultEnvironmentID = webhookdeliveryattemptDescEnvironmentID.Default.(string)
+	// webhookdeliveryattemptDescSystemEventID is the schema descriptor for system_event_id field.
+	webhookdeliveryattemptDescSystemEventID := webhookdeliveryattemptFields[1].Descriptor()
+	// webhookdeliveryattempt.SystemEventIDValidator is a validator for the "system_event_id" field. It is called by the builders before save.
+	webhookdeliveryattempt.SystemEventIDValidator = webhookdeliveryattemptDescSystemEventID.Validators[0].(func(string) error)
+	// webhookdeliveryattemptDescWebhookEndpointID is the schema descriptor for webhook_endpoint_id field.
+	webhookdeliveryattemptDescWebhookEndpointID := webhookdeliveryattemptFields[2].Descriptor()
+	// webhookdeliveryattempt.WebhookEndpointIDValidator is a validator for the "webhook_endpoint_id" field. It is called by the builders before save.
+	webhookdeliveryattempt.WebhookEndpointIDValidator = webhookdeliveryattemptDescWebhookEndpointID.Validators[0].(func(string) error)
+	// webhookdeliveryattemptDescEventName is the schema descriptor for event_name field.
+	webhookdeliveryattemptDescEventName := webhookdeliveryattemptFields[3].Descriptor()
+	// webhookdeliveryattempt.EventNameValidator is a validator for the "event_name" field. It is called by the builders before save.
+	webhookdeliveryattempt.EventNameValidator = webhookdeliveryattemptDescEventName.Validators[0].(func(string) error)
+	// webhookdeliveryattemptDescAttemptNumber is the schema descriptor for attempt_number field.
+	webhookdeliveryattemptDescAttemptNumber := webhookdeliveryattemptFields[4].Descriptor()
+	// webhookdeliveryattempt.DefaultAttemptNumber holds the default value on creation for the attempt_number field.
+	webhookdeliveryattempt.DefaultAttemptNumber = webhookdeliveryattemptDescAttemptNumber.Default.(int)
+	// webhookdeliveryattemptDescLatencyMs is the schema descriptor for latency_ms field.
+	webhookdeliveryattemptDescLatencyMs := webhookdeliveryattemptFields[10].Descriptor()
+	// webhookdeliveryattempt.DefaultLatencyMs holds the default value on creation for the latency_ms field.
+	webhookdeliveryattempt.DefaultLatencyMs = webhookdeliveryattemptDescLatencyMs.Default.(int64)
+	// webhookdeliveryattemptDescSuccess is the schema descriptor for success field.
+	webhookdeliveryattemptDescSuccess := webhookdeliveryattemptFields[11].Descriptor()
+	// webhookdeliveryattempt.DefaultSuccess holds the default value on creation for the success field.
+	webhookdeliveryattempt.DefaultSuccess = webhookdeliveryattemptDescSuccess.Default.(bool)
+	webhookendpointMixin := schema.WebhookEndpoint{}.Mixin()
+	webhookendpointMixinFields0 := webhookendpointMixin[0].Fields()
+	_ = webhookendpointMixinFields0
+	webhookendpointMixinFields1 := webhookendpointMixin[1].Fields()
+	_ = webhookendpointMixinFields1
+	webhookendpointFields := schema.WebhookEndpoint{}.Fields()
+	_ = webhookendpointFields
+	// webhookendpointDescTenantID is the schema descriptor for tenant_id field.
+	webhookendpointDescTenantID := webhookendpointMixinFields0[0].Descriptor()
+	// webhookendpoint.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	webhookendpoint.TenantIDValidator = webhookendpointDescTenantID.Validators[0].(func(string) error)
+	// webhookendpointDescStatus is the schema descriptor for status field.
+	webhookendpointDescStatus := webhookendpointMixinFields0[1].Descriptor()
+	// webhookendpoint.DefaultStatus holds the default value on creation for the status field.
+	webhookendpoint.DefaultStatus = webhookendpointDescStatus.Default.(string)
+	// webhookendpointDescCreatedAt is the schema descriptor for created_at field.
+	webhookendpointDescCreatedAt := webhookendpointMixinFields0[2].Descriptor()
+	// webhookendpoint.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookendpoint.DefaultCreatedAt = webhookendpointDescCreatedAt.Default.(func() time.Time)
+	// webhookendpointDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookendpointDescUpdatedAt := webhookendpointMixinFields0[3].Descriptor()
+	// webhookendpoint.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookendpoint.DefaultUpdatedAt = webhookendpointDescUpdatedAt.Default.(func() time.Time)
+	// webhookendpoint.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookendpoint.UpdateDefaultUpdatedAt = webhookendpointDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookendpointDescEnvironmentID is the schema descriptor for environment_id field.
+	webhookendpointDescEnvironmentID := webhookendpointMixinFields1[0].Descriptor()
+	// webhookendpoint.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	webhookendpoint.DefaultEnvironmentID = webhookendpointDescEnvironmentID.Default.(string)
+	// webhookendpointDescURL is the schema descriptor for url field.
+	webhookendpointDescURL := webhookendpointFields[1].Descriptor()
+	// webhookendpoint.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhookendpoint.URLValidator = webhookendpointDescURL.Validators[0].(func(string) error)
+	// webhookendpointDescEnabled is the schema descriptor for enabled field.
+	webhookendpointDescEnabled := webhookendpointFields[5].Descriptor()
+	// webhookendpoint.DefaultEnabled holds the default value on creation for the enabled field.
+	webhookendpoint.DefaultEnabled = webhookendpointDescEnabled.Default.(bool)
+	// webhookendpointDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
+	webhookendpointDescConsecutiveFailures := webhookendpointFields[10].Descriptor()
+	// webhookendpoint.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
+	webhookendpoint.DefaultConsecutiveFailures = webhookendpointDescConsecutiveFailures.Default.(int)
+	// webhookendpointDescPayloadVersion is the schema descriptor for payload_version field.
+	webhookendpointDescPayloadVersion := webhookendpointFields[15].Descriptor()
+	// webhookendpoint.DefaultPayloadVersion holds the default value on creation for the payload_version field.
+	webhookendpoint.DefaultPayloadVersion = webhookendpointDescPayloadVersion.Default.(string)
+	// webhookendpointDescPayloadMode is the schema descriptor for payload_mode field.
+	webhookendpointDescPayloadMode := webhookendpointFields[16].Descriptor()
+	// webhookendpoint.DefaultPayloadMode holds the default value on creation for the payload_mode field.
+	webhookendpoint.DefaultPayloadMode = webhookendpointDescPayloadMode.Default.(string)
 	workflowexecutionMixin := schema.WorkflowExecution{}.Mixin()
 	workflowexecutionMixinFields0 := workflowexecutionMixin[0].Fields()
 	_ = workflowexecutionMixinFields0
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+// Every create, update and delete performed by the service layer is recorded as one entry.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Mixin of the AuditLog.
+func (AuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the AuditLog. All fields are immutable since the audit log is append-only.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("entity_type").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("entity_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("action").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("actor_type").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("actor_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			Optional().
+			Immutable().
+			Comment("User ID, API key ID or workflow name depending on the actor type"),
+		field.JSON("changes", map[string]types.AuditLogChange{}).
+			Optional().
+			Immutable().
+			Comment("Changed fields with their values before and after the action"),
+		field.String("request_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			Optional().
+			Immutable(),
+		field.String("ip_address").
+			SchemaType(map[string]string{
+				"postgres": "varchar(64)",
+			}).
+			Optional().
+			Immutable(),
+	}
+}
+
+// Edges of the AuditLog.
+func (AuditLog) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "created_at"),
+		index.Fields("tenant_id", "environment_id", "entity_type", "entity_id", "created_at"),
+		index.Fields("tenant_id", "environment_id", "actor_id", "created_at"),
+		index.Fields("tenant_id", "request_id"),
+	}
+}
@@ -0,0 +1,113 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/shopspring/decimal"
+)
+
+// CommitmentContract holds the schema definition for the CommitmentContract entity.
+type CommitmentContract struct {
+	ent.Schema
+}
+
+// Mixin of the CommitmentContract.
+func (CommitmentContract) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the CommitmentContract.
+func (CommitmentContract) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("name").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			NotEmpty(),
+		field.String("customer_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.JSON("subscription_ids", []string{}).
+			Optional().
+			Comment("Subscriptions whose usage draws down the contract"),
+		field.String("currency").
+			SchemaType(map[string]string{
+				"postgres": "varchar(10)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.Other("commitment_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Immutable().
+			Comment("Minimum spend over the contract term"),
+		field.Other("prepaid_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Immutable().
+			Comment("Amount invoiced upfront and drawn down by usage"),
+		field.Other("overage_factor", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(10,4)",
+			}).
+			Default(decimal.NewFromInt(1)).
+			Comment("Multiplier on usage charged after the prepaid amount is exhausted"),
+		field.Other("drawn_down_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero),
+		field.Other("overage_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero),
+		field.Time("start_date").
+			Immutable(),
+		field.Time("end_date"),
+		field.Bool("enable_true_up").
+			Default(true),
+		field.String("contract_status").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			Default("active"),
+		field.String("prepayment_invoice_id").
+			Optional().
+			Nillable(),
+		field.String("true_up_invoice_id").
+			Optional().
+			Nillable(),
+		field.JSON("metadata", map[string]string{}).
+			Optional(),
+	}
+}
+
+// Edges of the CommitmentContract.
+func (CommitmentContract) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the CommitmentContract.
+func (CommitmentContract) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "customer_id"),
+		index.Fields("tenant_id", "environment_id", "contract_status", "end_date"),
+	}
+}
@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/shopspring/decimal"
+)
+
+// CommitmentDrawdown holds the schema definition for the CommitmentDrawdown entity.
+type CommitmentDrawdown struct {
+	ent.Schema
+}
+
+// Mixin of the CommitmentDrawdown.
+func (CommitmentDrawdown) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the CommitmentDrawdown.
+func (CommitmentDrawdown) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("contract_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("subscription_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("invoice_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.Time("period_start").
+			Immutable(),
+		field.Time("period_end").
+			Immutable(),
+		field.Other("usage_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Immutable().
+			Comment("Usage charges of the invoice before the drawdown"),
+		field.Other("drawdown_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Immutable().
+			Comment("Usage covered by the prepaid amount"),
+		field.Other("overage_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Immutable().
+			Comment("Usage charged after the prepaid amount was exhausted, at the overage factor"),
+		field.Other("balance_after", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Immutable(),
+	}
+}
+
+// Edges of the CommitmentDrawdown.
+func (CommitmentDrawdown) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the CommitmentDrawdown.
+func (CommitmentDrawdown) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "contract_id", "period_start"),
+		// An invoice draws down a contract at most once
+		index.Fields("tenant_id", "environment_id", "contract_id", "invoice_id").
+			Unique().
+			Annotations(entsql.IndexWhere("status = 'published'")),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/flexprice/flexprice/internal/types"
 	"github.com/shopspring/decimal"
 )
 
@@ -52,9 +53,9 @@ func (Coupon) Fields() []ent.Field {
 		field.Int("total_redemptions").
 			Default(0).
 			Comment("Coupon total redemptions"),
-		field.JSON("rules", map[string]interface{}{}).
+		field.JSON("rules", &types.CouponRules{}).
 			Optional().
-			Comment("Rule engine configuration for discount application"),
+			Comment("Targeting and stacking rules for discount application"),
 		field.Other("amount_off", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				"postgres": "numeric(20,8)",
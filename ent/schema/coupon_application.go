@@ -105,6 +105,14 @@ func (CouponApplication) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Subscription ID this coupon application is associated with"),
+		field.String("promotion_code_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Promotion code the coupon was redeemed through"),
 	}
 }
 
@@ -146,5 +154,6 @@ func (CouponApplication) Indexes() []ent.Index {
 		index.Fields("tenant_id", "environment_id", "subscription_id"),
 		// Optimized index for subscription + coupon queries (used in cadence validation)
 		index.Fields("tenant_id", "environment_id", "subscription_id", "coupon_id"),
+		index.Fields("tenant_id", "environment_id", "promotion_code_id"),
 	}
 }
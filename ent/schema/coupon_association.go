@@ -62,6 +62,14 @@ func (CouponAssociation) Fields() []ent.Field {
 		field.Time("end_date").
 			Optional().
 			Nillable(),
+		field.String("promotion_code_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Promotion code redeemed to create this association"),
 		field.JSON("metadata", map[string]string{}).
 			Optional().
 			Comment("Additional metadata for coupon association"),
@@ -99,5 +107,6 @@ func (CouponAssociation) Indexes() []ent.Index {
 		index.Fields("tenant_id", "environment_id", "coupon_id"),
 		index.Fields("tenant_id", "environment_id", "subscription_id"),
 		index.Fields("tenant_id", "environment_id", "subscription_id", "subscription_line_item_id"),
+		index.Fields("tenant_id", "environment_id", "promotion_code_id"),
 	}
 }
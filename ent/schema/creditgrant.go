@@ -135,6 +135,10 @@ func (CreditGrant) Fields() []ent.Field {
 			Nillable().
 			Immutable(),
 
+		// rollover_config carries unused credits of a recurring grant into the next period
+		field.JSON("rollover_config", &types.CreditGrantRolloverConfig{}).
+			Optional(),
+
 		field.JSON("metadata", map[string]string{}).
 			Optional().
 			Default(map[string]string{}),
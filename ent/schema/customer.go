@@ -79,6 +79,21 @@ func (Customer) Fields() []ent.Field {
 				"postgres": "varchar(2)",
 			}).
 			Optional(),
+		field.String("currency").
+			SchemaType(map[string]string{
+				"postgres": "varchar(10)",
+			}).
+			Optional().
+			Comment("Billing currency used when a subscription does not set one"),
+		// Hierarchy fields
+		field.String("parent_customer_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable(),
+		field.Bool("invoice_to_parent").
+			Default(false),
 	}
 }
 
@@ -95,6 +110,9 @@ func (Customer) Indexes() []ent.Index {
 			Annotations(entsql.IndexWhere("(external_id IS NOT NULL AND external_id != '') AND status = 'published'")).
 			StorageKey(Idx_tenant_environment_external_id_unique),
 		index.Fields("tenant_id", "environment_id"),
+		index.Fields("tenant_id", "environment_id", "parent_customer_id").
+			Annotations(entsql.IndexWhere("parent_customer_id IS NOT NULL")).
+			StorageKey("idx_customer_tenant_environment_parent"),
 		// Add email index for efficient email-based lookups
 		index.Fields("tenant_id", "environment_id", "email").
 			Annotations(entsql.IndexWhere("email IS NOT NULL AND email != '' AND status = 'published'")).
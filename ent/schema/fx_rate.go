@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/shopspring/decimal"
+)
+
+// FXRate holds the schema definition for the FXRate entity.
+type FXRate struct {
+	ent.Schema
+}
+
+// Mixin of the FXRate.
+func (FXRate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the FXRate.
+func (FXRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("base_currency").
+			SchemaType(map[string]string{
+				"postgres": "varchar(10)",
+			}).
+			NotEmpty().
+			Immutable().
+			Comment("Currency converted from"),
+		field.String("quote_currency").
+			SchemaType(map[string]string{
+				"postgres": "varchar(10)",
+			}).
+			NotEmpty().
+			Immutable().
+			Comment("Currency converted to"),
+		field.Other("rate", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(24,12)",
+			}).
+			Immutable().
+			Comment("Units of quote currency per unit of base currency"),
+		field.Time("effective_at").
+			Immutable().
+			Comment("Rate applies from this time until a newer rate for the pair"),
+		field.String("source").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Default("manual").
+			Immutable().
+			Comment("Where the rate came from: manual or a rate source"),
+		field.JSON("metadata", map[string]string{}).
+			Optional().
+			Comment("Additional metadata for the rate"),
+	}
+}
+
+// Edges of the FXRate.
+func (FXRate) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the FXRate.
+func (FXRate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id"),
+		index.Fields("tenant_id", "environment_id", "base_currency", "quote_currency", "effective_at"),
+	}
+}
@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+)
+
+// Invitation holds the schema definition for the Invitation entity.
+type Invitation struct {
+	ent.Schema
+}
+
+// Mixin of the Invitation.
+func (Invitation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the Invitation.
+func (Invitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("email").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.JSON("roles", []string{}).
+			Optional().
+			Comment("RBAC roles granted once the invitation is accepted"),
+		field.String("token_hash").
+			SchemaType(map[string]string{
+				"postgres": "varchar(64)",
+			}).
+			NotEmpty().
+			Immutable().
+			Sensitive().
+			Comment("SHA-256 of the token sent to the invitee"),
+		field.String("invitation_status").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			Default("pending"),
+		field.Time("expires_at").
+			Immutable(),
+		field.Time("responded_at").
+			Optional().
+			Nillable(),
+		field.String("accepted_by").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Comment("User that joined the tenant by accepting the invitation"),
+	}
+}
+
+// Edges of the Invitation.
+func (Invitation) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Invitation.
+func (Invitation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("token_hash").
+			Unique(),
+		index.Fields("tenant_id", "email").
+			Unique().
+			Annotations(entsql.IndexWhere("status = 'published' AND invitation_status = 'pending'")),
+		index.Fields("tenant_id", "created_at"),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// PriceBook holds the schema definition for the PriceBook entity.
+type PriceBook struct {
+	ent.Schema
+}
+
+// Mixin of the PriceBook.
+func (PriceBook) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the PriceBook.
+func (PriceBook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("name").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			NotEmpty(),
+		field.String("description").
+			Optional(),
+		field.String("base_currency").
+			SchemaType(map[string]string{
+				"postgres": "varchar(10)",
+			}).
+			NotEmpty().
+			Immutable().
+			Comment("Currency of the prices the book localizes"),
+		field.JSON("plan_ids", []string{}).
+			Optional().
+			Comment("Plans the book applies to, empty for all plans"),
+		field.JSON("addon_ids", []string{}).
+			Optional().
+			Comment("Addons the book applies to, empty for all addons"),
+		field.JSON("currencies", []types.PriceBookCurrency{}).
+			Optional().
+			Comment("Conversion rules and rounding per target currency"),
+		field.JSON("overrides", []types.PriceBookPriceOverride{}).
+			Optional().
+			Comment("Explicit localized amounts per price and currency"),
+		field.JSON("metadata", map[string]string{}).
+			Optional(),
+	}
+}
+
+// Edges of the PriceBook.
+func (PriceBook) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the PriceBook.
+func (PriceBook) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id"),
+		index.Fields("tenant_id", "environment_id", "base_currency"),
+	}
+}
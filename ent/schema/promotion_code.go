@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/shopspring/decimal"
+)
+
+// PromotionCode holds the schema definition for the PromotionCode entity.
+type PromotionCode struct {
+	ent.Schema
+}
+
+// Mixin of the PromotionCode.
+func (PromotionCode) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the PromotionCode.
+func (PromotionCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("coupon_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable().
+			Comment("Coupon granted when the code is redeemed"),
+		field.String("code").
+			SchemaType(map[string]string{
+				"postgres": "varchar(100)",
+			}).
+			NotEmpty().
+			Immutable().
+			Comment("Customer-facing code, stored upper case"),
+		field.Bool("active").
+			Default(true).
+			Comment("Inactive codes cannot be redeemed"),
+		field.Int("max_redemptions").
+			Optional().
+			Nillable().
+			Comment("Total redemptions allowed across customers, 1 for one-time codes"),
+		field.Int("max_redemptions_per_customer").
+			Optional().
+			Nillable().
+			Comment("Redemptions allowed per customer"),
+		field.Int("times_redeemed").
+			Default(0).
+			Comment("Redemptions so far"),
+		field.Bool("first_time_customer_only").
+			Default(false).
+			Comment("Only customers without prior subscriptions or invoices can redeem"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("Code cannot be redeemed after this time"),
+		field.Other("minimum_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Optional().
+			Nillable().
+			Comment("Minimum order amount the discount applies to"),
+		field.String("minimum_amount_currency").
+			SchemaType(map[string]string{
+				"postgres": "varchar(10)",
+			}).
+			Optional().
+			Nillable(),
+		field.JSON("metadata", map[string]string{}).
+			Optional().
+			Comment("Additional metadata for promotion code"),
+	}
+}
+
+// Edges of the PromotionCode.
+func (PromotionCode) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the PromotionCode.
+func (PromotionCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id"),
+		index.Fields("tenant_id", "environment_id", "coupon_id"),
+		index.Fields("tenant_id", "environment_id", "code").
+			Unique().
+			Annotations(entsql.IndexWhere("status = 'published'")).
+			StorageKey("idx_promotion_code_tenant_environment_code_unique"),
+	}
+}
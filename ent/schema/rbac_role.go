@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+)
+
+// RBACRole holds the schema definition for the RBACRole entity.
+type RBACRole struct {
+	ent.Schema
+}
+
+// Mixin of the RBACRole.
+func (RBACRole) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the RBACRole.
+func (RBACRole) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("name").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			NotEmpty(),
+		field.String("description").
+			Optional(),
+		field.JSON("permissions", map[string][]string{}).
+			Optional().
+			Comment("Actions the role grants per entity, * matches every entity or action"),
+		field.JSON("environment_ids", []string{}).
+			Optional().
+			Comment("Environments the role grants permissions in, empty for all environments"),
+	}
+}
+
+// Edges of the RBACRole.
+func (RBACRole) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the RBACRole.
+func (RBACRole) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").
+			Unique().
+			Annotations(entsql.IndexWhere("status = 'published'")),
+	}
+}
@@ -64,6 +64,23 @@ func (Secret) Fields() []ent.Field {
 			Optional().
 			Default("user").
 			Comment("User type copied from user at API key creation time"),
+		// Restrictions of API keys
+		field.Strings("scopes").
+			Optional().
+			Default([]string{}).
+			Comment("resource:action pairs such as events:write the key is restricted to, empty for no restriction"),
+		field.Strings("allowed_ips").
+			Optional().
+			Default([]string{}).
+			Comment("IP addresses or CIDR ranges the key may be used from, empty for any address"),
+		field.Int("rate_limit_per_minute").
+			Optional().
+			Nillable().
+			Comment("Maximum requests per minute made with the key"),
+		field.String("rotated_from_id").
+			Optional().
+			Nillable().
+			Comment("Key this key replaced when it was rotated"),
 	}
 }
 
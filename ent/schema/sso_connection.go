@@ -0,0 +1,99 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+)
+
+// SSOConnection holds the schema definition for the SSOConnection entity.
+type SSOConnection struct {
+	ent.Schema
+}
+
+// Mixin of the SSOConnection.
+func (SSOConnection) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the SSOConnection.
+func (SSOConnection) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("name").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			NotEmpty(),
+		field.String("protocol").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			NotEmpty().
+			Immutable().
+			Comment("oidc or saml"),
+		field.JSON("domains", []string{}).
+			Optional().
+			Comment("Email domains whose users sign in through this connection"),
+		field.JSON("verified_domains", []string{}).
+			Optional().
+			Comment("Domains whose ownership was proven through a DNS TXT record"),
+		field.String("domain_verification_token").
+			Optional().
+			Comment("Token published in the DNS TXT records verifying the domains"),
+		field.Bool("enabled").
+			Default(true),
+		field.String("issuer_url").
+			Optional().
+			Comment("OIDC issuer, used for discovery"),
+		field.String("client_id").
+			Optional(),
+		field.String("client_secret").
+			Optional().
+			Sensitive().
+			Comment("Encrypted OIDC client secret"),
+		field.JSON("scopes", []string{}).
+			Optional(),
+		field.String("idp_metadata_url").
+			Optional().
+			Comment("SAML identity provider metadata URL"),
+		field.Text("idp_metadata_xml").
+			Optional().
+			Comment("SAML identity provider metadata, used when no metadata URL is set"),
+		field.String("role_claim").
+			Optional().
+			Comment("Claim or attribute holding the groups of the user"),
+		field.JSON("role_mappings", map[string][]string{}).
+			Optional().
+			Comment("RBAC roles granted per identity provider group"),
+		field.JSON("default_roles", []string{}).
+			Optional().
+			Comment("RBAC roles granted to every user signing in through this connection"),
+		field.Bool("jit_provisioning").
+			Default(true).
+			Comment("Create users on their first sign in"),
+	}
+}
+
+// Edges of the SSOConnection.
+func (SSOConnection) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SSOConnection.
+func (SSOConnection) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").
+			Unique().
+			Annotations(entsql.IndexWhere("status = 'published'")),
+	}
+}
@@ -202,6 +202,9 @@ func (Subscription) Fields() []ent.Field {
 			Default(string(types.SubscriptionTypeStandalone)).
 			GoType(types.SubscriptionType("")).
 			Comment("Subscription type within a customer hierarchy (standalone, parent, inherited)"),
+		field.JSON("seat_config", &types.SeatConfig{}).
+			Optional().
+			Comment("Seat licensing configuration; the seat count is the quantity of the configured fixed price"),
 	}
 }
 
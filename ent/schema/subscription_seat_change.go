@@ -0,0 +1,100 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionSeatChange holds the schema definition for the SubscriptionSeatChange entity.
+type SubscriptionSeatChange struct {
+	ent.Schema
+}
+
+// Mixin of the SubscriptionSeatChange.
+func (SubscriptionSeatChange) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the SubscriptionSeatChange.
+func (SubscriptionSeatChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("subscription_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("line_item_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable().
+			Comment("Line item carrying the new seat count"),
+		field.Int64("previous_seats").
+			Immutable(),
+		field.Int64("new_seats").
+			Immutable(),
+		field.String("source").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			GoType(types.SeatSource("")).
+			Immutable(),
+		field.String("proration_behavior").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			GoType(types.SeatProrationBehavior("")).
+			Immutable(),
+		field.Time("effective_date").
+			Immutable(),
+		field.Other("proration_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero).
+			Immutable().
+			Comment("Prorated charge (positive) or credit (negative) for the rest of the period"),
+		field.String("invoice_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Comment("Invoice that billed the proration, immediately or at true-up"),
+		field.String("wallet_transaction_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Comment("Wallet credit issued for an immediate seat decrease"),
+	}
+}
+
+// Edges of the SubscriptionSeatChange.
+func (SubscriptionSeatChange) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SubscriptionSeatChange.
+func (SubscriptionSeatChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "subscription_id", "effective_date"),
+		index.Fields("tenant_id", "environment_id", "proration_behavior", "invoice_id"),
+	}
+}
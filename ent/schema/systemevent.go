@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/flexprice/flexprice/ent/schema/mixin"
@@ -58,6 +59,17 @@ func (SystemEvent) Fields() []ent.Field {
 				"postgres": "jsonb",
 			}).
 			Optional(),
+		field.Int64("sequence_number").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Position of the event among the events of its entity, starting at 1"),
+		field.JSON("snapshot", map[string]interface{}{}).
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}).
+			Optional().
+			Comment("Webhook payload built when the event was first delivered, served to thin payload receivers"),
 		field.Int("failure_count").
 			Default(0),
 		field.String("failure_reason").
@@ -77,5 +89,9 @@ func (SystemEvent) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("tenant_id", "environment_id").
 			StorageKey("idx_system_events_tenant_env"),
+		index.Fields("tenant_id", "environment_id", "entity_type", "entity_id", "sequence_number").
+			Unique().
+			Annotations(entsql.IndexWhere("sequence_number IS NOT NULL")).
+			StorageKey("idx_system_events_entity_sequence"),
 	}
 }
@@ -85,6 +85,10 @@ func (Task) Fields() []ent.Field {
 		field.Text("error_summary").
 			Optional().
 			Nillable(),
+		field.Text("error_report").
+			Optional().
+			Nillable().
+			Comment("CSV of the rows that failed to import, with the error for each row"),
 		field.JSON("metadata", map[string]interface{}{}).
 			Optional().
 			SchemaType(map[string]string{
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+)
+
+// TenantMembership holds the schema definition for the TenantMembership entity.
+// Users belong to the tenant they were created in, memberships give them access
+// to further tenants.
+type TenantMembership struct {
+	ent.Schema
+}
+
+// Mixin of the TenantMembership.
+func (TenantMembership) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+	}
+}
+
+// Fields of the TenantMembership.
+func (TenantMembership) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("email").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.JSON("roles", []string{}).
+			Optional().
+			Comment("RBAC roles of the user in the tenant of the membership"),
+	}
+}
+
+// Edges of the TenantMembership.
+func (TenantMembership) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the TenantMembership.
+func (TenantMembership) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "user_id").
+			Unique().
+			Annotations(entsql.IndexWhere("status = 'published'")),
+		index.Fields("user_id", "status"),
+	}
+}
@@ -130,6 +130,41 @@ func (WalletTransaction) Fields() []ent.Field {
 			Nillable().
 			Immutable(),
 
+		field.Other("fx_rate", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(24,12)",
+			}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("FX rate from the wallet currency to the settlement currency for converted debits"),
+
+		field.String("fx_rate_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.String("settlement_currency").
+			SchemaType(map[string]string{
+				"postgres": "varchar(10)",
+			}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Currency of the invoice a converted debit settled"),
+
+		field.Other("settlement_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Amount settled in the settlement currency"),
+
 		field.String("idempotency_key").
 			Nillable().
 			Immutable().
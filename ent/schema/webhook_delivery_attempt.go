@@ -0,0 +1,101 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+)
+
+// WebhookDeliveryAttempt holds the schema definition for the WebhookDeliveryAttempt entity.
+// Every native delivery of a system event to a webhook endpoint is recorded as one attempt.
+type WebhookDeliveryAttempt struct {
+	ent.Schema
+}
+
+// Mixin of the WebhookDeliveryAttempt.
+func (WebhookDeliveryAttempt) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the WebhookDeliveryAttempt. All fields are immutable since attempts are
+// point-in-time records.
+func (WebhookDeliveryAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("system_event_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("webhook_endpoint_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("event_name").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.Int("attempt_number").
+			Default(1).
+			Immutable().
+			Comment("1 for the first delivery of the event to the endpoint, incremented on every retry"),
+		field.String("request_url").
+			SchemaType(map[string]string{
+				"postgres": "varchar(2048)",
+			}).
+			Immutable(),
+		field.JSON("request_headers", map[string]string{}).
+			Optional().
+			Immutable().
+			Comment("Headers sent with the request, custom header values are redacted"),
+		field.Text("request_body").
+			Optional().
+			Immutable(),
+		field.Int("response_status_code").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Empty when no response was received"),
+		field.Text("response_body").
+			Optional().
+			Immutable().
+			Comment("Excerpt of the response body"),
+		field.Int64("latency_ms").
+			Default(0).
+			Immutable(),
+		field.Bool("success").
+			Default(false).
+			Immutable(),
+		field.String("error_message").
+			Optional().
+			Nillable().
+			Immutable(),
+	}
+}
+
+// Edges of the WebhookDeliveryAttempt.
+func (WebhookDeliveryAttempt) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the WebhookDeliveryAttempt.
+func (WebhookDeliveryAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "system_event_id", "webhook_endpoint_id"),
+		index.Fields("tenant_id", "environment_id", "webhook_endpoint_id", "created_at"),
+	}
+}
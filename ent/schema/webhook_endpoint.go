@@ -0,0 +1,104 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+)
+
+// WebhookEndpoint holds the schema definition for the WebhookEndpoint entity.
+type WebhookEndpoint struct {
+	ent.Schema
+}
+
+// Mixin of the WebhookEndpoint.
+func (WebhookEndpoint) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the WebhookEndpoint.
+func (WebhookEndpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("url").
+			SchemaType(map[string]string{
+				"postgres": "varchar(2048)",
+			}).
+			NotEmpty(),
+		field.String("description").
+			Optional(),
+		field.JSON("event_types", []string{}).
+			Optional().
+			Comment("Event names or wildcard patterns such as invoice.* the endpoint receives"),
+		field.JSON("headers", map[string]string{}).
+			Optional().
+			Comment("Custom headers sent with every delivery"),
+		field.Bool("enabled").
+			Default(true),
+		field.String("signing_secret").
+			Optional().
+			Sensitive().
+			Comment("Encrypted secret deliveries are signed with"),
+		field.String("previous_signing_secret").
+			Optional().
+			Nillable().
+			Sensitive().
+			Comment("Encrypted secret replaced by the last rotation, still used to sign until it expires"),
+		field.Time("previous_signing_secret_expires_at").
+			Optional().
+			Nillable(),
+		field.JSON("metadata", map[string]string{}).
+			Optional(),
+		field.Int("consecutive_failures").
+			Default(0).
+			Comment("Deliveries that failed since the last successful one"),
+		field.Time("failing_since").
+			Optional().
+			Nillable().
+			Comment("First failed delivery since the last successful one"),
+		field.Time("last_success_at").
+			Optional().
+			Nillable(),
+		field.Time("last_failure_at").
+			Optional().
+			Nillable(),
+		field.String("disabled_reason").
+			Optional().
+			Nillable().
+			Comment("Why the endpoint was disabled automatically"),
+		field.String("payload_version").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			Default("v1").
+			Comment("Schema version payloads delivered to the endpoint are rendered in"),
+		field.String("payload_mode").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			Default("full").
+			Comment("full delivers the entity snapshot, thin only the ids of the event and entity"),
+	}
+}
+
+// Edges of the WebhookEndpoint.
+func (WebhookEndpoint) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the WebhookEndpoint.
+func (WebhookEndpoint) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id"),
+		index.Fields("tenant_id", "environment_id", "enabled"),
+	}
+}
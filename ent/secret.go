@@ -51,8 +51,16 @@ type Secret struct {
 	// Roles copied from user at API key creation time
 	Roles []string `json:"roles,omitempty"`
 	// User type copied from user at API key creation time
-	UserType     string `json:"user_type,omitempty"`
-	selectValues sql.SelectValues
+	UserType string `json:"user_type,omitempty"`
+	// resource:action pairs such as events:write the key is restricted to, empty for no restriction
+	Scopes []string `json:"scopes,omitempty"`
+	// IP addresses or CIDR ranges the key may be used from, empty for any address
+	AllowedIps []string `json:"allowed_ips,omitempty"`
+	// Maximum requests per minute made with the key
+	RateLimitPerMinute *int `json:"rate_limit_per_minute,omitempty"`
+	// Key this key replaced when it was rotated
+	RotatedFromID *string `json:"rotated_from_id,omitempty"`
+	selectValues  sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -60,9 +68,11 @@ func (*Secret) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case secret.FieldProviderData, secret.FieldRoles:
+		case secret.FieldProviderData, secret.FieldRoles, secret.FieldScopes, secret.FieldAllowedIps:
 			values[i] = new([]byte)
-		case secret.FieldID, secret.FieldTenantID, secret.FieldStatus, secret.FieldCreatedBy, secret.FieldUpdatedBy, secret.FieldEnvironmentID, secret.FieldName, secret.FieldType, secret.FieldProvider, secret.FieldValue, secret.FieldDisplayID, secret.FieldUserType:
+		case secret.FieldRateLimitPerMinute:
+			values[i] = new(sql.NullInt64)
+		case secret.FieldID, secret.FieldTenantID, secret.FieldStatus, secret.FieldCreatedBy, secret.FieldUpdatedBy, secret.FieldEnvironmentID, secret.FieldName, secret.FieldType, secret.FieldProvider, secret.FieldValue, secret.FieldDisplayID, secret.FieldUserType, secret.FieldRotatedFromID:
 			values[i] = new(sql.NullString)
 		case secret.FieldCreatedAt, secret.FieldUpdatedAt, secret.FieldExpiresAt, secret.FieldLastUsedAt:
 			values[i] = new(sql.NullTime)
@@ -195,6 +205,36 @@ func (s *Secret) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.UserType = value.String
 			}
+		case secret.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case secret.FieldAllowedIps:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_ips", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.AllowedIps); err != nil {
+					return fmt.Errorf("unmarshal field allowed_ips: %w", err)
+				}
+			}
+		case secret.FieldRateLimitPerMinute:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_limit_per_minute", values[i])
+			} else if value.Valid {
+				s.RateLimitPerMinute = new(int)
+				*s.RateLimitPerMinute = int(value.Int64)
+			}
+		case secret.FieldRotatedFromID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rotated_from_id", values[i])
+			} else if value.Valid {
+				s.RotatedFromID = new(string)
+				*s.RotatedFromID = value.String
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -285,6 +325,22 @@ func (s *Secret) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("user_type=")
 	builder.WriteString(s.UserType)
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", s.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("allowed_ips=")
+	builder.WriteString(fmt.Sprintf("%v", s.AllowedIps))
+	builder.WriteString(", ")
+	if v := s.RateLimitPerMinute; v != nil {
+		builder.WriteString("rate_limit_per_minute=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := s.RotatedFromID; v != nil {
+		builder.WriteString("rotated_from_id=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -47,6 +47,14 @@ const (
 	FieldRoles = "roles"
 	// FieldUserType holds the string denoting the user_type field in the database.
 	FieldUserType = "user_type"
+	// FieldScopes holds the string denoting the scopes field in the database.
+	FieldScopes = "scopes"
+	// FieldAllowedIps holds the string denoting the allowed_ips field in the database.
+	FieldAllowedIps = "allowed_ips"
+	// FieldRateLimitPerMinute holds the string denoting the rate_limit_per_minute field in the database.
+	FieldRateLimitPerMinute = "rate_limit_per_minute"
+	// FieldRotatedFromID holds the string denoting the rotated_from_id field in the database.
+	FieldRotatedFromID = "rotated_from_id"
 	// Table holds the table name of the secret in the database.
 	Table = "secrets"
 )
@@ -71,6 +79,10 @@ var Columns = []string{
 	FieldProviderData,
 	FieldRoles,
 	FieldUserType,
+	FieldScopes,
+	FieldAllowedIps,
+	FieldRateLimitPerMinute,
+	FieldRotatedFromID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -106,6 +118,10 @@ var (
 	DefaultRoles []string
 	// DefaultUserType holds the default value on creation for the "user_type" field.
 	DefaultUserType string
+	// DefaultScopes holds the default value on creation for the "scopes" field.
+	DefaultScopes []string
+	// DefaultAllowedIps holds the default value on creation for the "allowed_ips" field.
+	DefaultAllowedIps []string
 )
 
 // OrderOption defines the ordering options for the Secret queries.
@@ -190,3 +206,13 @@ func ByLastUsedAt(opts ...sql.OrderTermOption) OrderOption {
 func ByUserType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserType, opts...).ToFunc()
 }
+
+// ByRateLimitPerMinute orders the results by the rate_limit_per_minute field.
+func ByRateLimitPerMinute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRateLimitPerMinute, opts...).ToFunc()
+}
+
+// ByRotatedFromID orders the results by the rotated_from_id field.
+func ByRotatedFromID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRotatedFromID, opts...).ToFunc()
+}
@@ -139,6 +139,16 @@ func UserType(v string) predicate.Secret {
 	return predicate.Secret(sql.FieldEQ(FieldUserType, v))
 }
 
+// RateLimitPerMinute applies equality check predicate on the "rate_limit_per_minute" field. It's identical to RateLimitPerMinuteEQ.
+func RateLimitPerMinute(v int) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldRateLimitPerMinute, v))
+}
+
+// RotatedFromID applies equality check predicate on the "rotated_from_id" field. It's identical to RotatedFromIDEQ.
+func RotatedFromID(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldRotatedFromID, v))
+}
+
 // TenantIDEQ applies the EQ predicate on the "tenant_id" field.
 func TenantIDEQ(v string) predicate.Secret {
 	return predicate.Secret(sql.FieldEQ(FieldTenantID, v))
@@ -1114,6 +1124,151 @@ func UserTypeContainsFold(v string) predicate.Secret {
 	return predicate.Secret(sql.FieldContainsFold(FieldUserType, v))
 }
 
+// ScopesIsNil applies the IsNil predicate on the "scopes" field.
+func ScopesIsNil() predicate.Secret {
+	return predicate.Secret(sql.FieldIsNull(FieldScopes))
+}
+
+// ScopesNotNil applies the NotNil predicate on the "scopes" field.
+func ScopesNotNil() predicate.Secret {
+	return predicate.Secret(sql.FieldNotNull(FieldScopes))
+}
+
+// AllowedIpsIsNil applies the IsNil predicate on the "allowed_ips" field.
+func AllowedIpsIsNil() predicate.Secret {
+	return predicate.Secret(sql.FieldIsNull(FieldAllowedIps))
+}
+
+// AllowedIpsNotNil applies the NotNil predicate on the "allowed_ips" field.
+func AllowedIpsNotNil() predicate.Secret {
+	return predicate.Secret(sql.FieldNotNull(FieldAllowedIps))
+}
+
+// RateLimitPerMinuteEQ applies the EQ predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteEQ(v int) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitPerMinuteNEQ applies the NEQ predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteNEQ(v int) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitPerMinuteIn applies the In predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteIn(vs ...int) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldRateLimitPerMinute, vs...))
+}
+
+// RateLimitPerMinuteNotIn applies the NotIn predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteNotIn(vs ...int) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldRateLimitPerMinute, vs...))
+}
+
+// RateLimitPerMinuteGT applies the GT predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteGT(v int) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitPerMinuteGTE applies the GTE predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteGTE(v int) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitPerMinuteLT applies the LT predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteLT(v int) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitPerMinuteLTE applies the LTE predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteLTE(v int) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitPerMinuteIsNil applies the IsNil predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteIsNil() predicate.Secret {
+	return predicate.Secret(sql.FieldIsNull(FieldRateLimitPerMinute))
+}
+
+// RateLimitPerMinuteNotNil applies the NotNil predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteNotNil() predicate.Secret {
+	return predicate.Secret(sql.FieldNotNull(FieldRateLimitPerMinute))
+}
+
+// RotatedFromIDEQ applies the EQ predicate on the "rotated_from_id" field.
+func RotatedFromIDEQ(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldRotatedFromID, v))
+}
+
+// RotatedFromIDNEQ applies the NEQ predicate on the "rotated_from_id" field.
+func RotatedFromIDNEQ(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldRotatedFromID, v))
+}
+
+// RotatedFromIDIn applies the In predicate on the "rotated_from_id" field.
+func RotatedFromIDIn(vs ...string) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldRotatedFromID, vs...))
+}
+
+// RotatedFromIDNotIn applies the NotIn predicate on the "rotated_from_id" field.
+func RotatedFromIDNotIn(vs ...string) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldRotatedFromID, vs...))
+}
+
+// RotatedFromIDGT applies the GT predicate on the "rotated_from_id" field.
+func RotatedFromIDGT(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldRotatedFromID, v))
+}
+
+// RotatedFromIDGTE applies the GTE predicate on the "rotated_from_id" field.
+func RotatedFromIDGTE(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldRotatedFromID, v))
+}
+
+// RotatedFromIDLT applies the LT predicate on the "rotated_from_id" field.
+func RotatedFromIDLT(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldRotatedFromID, v))
+}
+
+// RotatedFromIDLTE applies the LTE predicate on the "rotated_from_id" field.
+func RotatedFromIDLTE(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldRotatedFromID, v))
+}
+
+// RotatedFromIDContains applies the Contains predicate on the "rotated_from_id" field.
+func RotatedFromIDContains(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldContains(FieldRotatedFromID, v))
+}
+
+// RotatedFromIDHasPrefix applies the HasPrefix predicate on the "rotated_from_id" field.
+func RotatedFromIDHasPrefix(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldHasPrefix(FieldRotatedFromID, v))
+}
+
+// RotatedFromIDHasSuffix applies the HasSuffix predicate on the "rotated_from_id" field.
+func RotatedFromIDHasSuffix(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldHasSuffix(FieldRotatedFromID, v))
+}
+
+// RotatedFromIDIsNil applies the IsNil predicate on the "rotated_from_id" field.
+func RotatedFromIDIsNil() predicate.Secret {
+	return predicate.Secret(sql.FieldIsNull(FieldRotatedFromID))
+}
+
+// RotatedFromIDNotNil applies the NotNil predicate on the "rotated_from_id" field.
+func RotatedFromIDNotNil() predicate.Secret {
+	return predicate.Secret(sql.FieldNotNull(FieldRotatedFromID))
+}
+
+// RotatedFromIDEqualFold applies the EqualFold predicate on the "rotated_from_id" field.
+func RotatedFromIDEqualFold(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEqualFold(FieldRotatedFromID, v))
+}
+
+// RotatedFromIDContainsFold applies the ContainsFold predicate on the "rotated_from_id" field.
+func RotatedFromIDContainsFold(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldContainsFold(FieldRotatedFromID, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Secret) predicate.Secret {
 	return predicate.Secret(sql.AndPredicates(predicates...))
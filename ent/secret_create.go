@@ -210,6 +210,46 @@ func (sc *SecretCreate) SetNillableUserType(s *string) *SecretCreate {
 	return sc
 }
 
+// SetScopes sets the "scopes" field.
+func (sc *SecretCreate) SetScopes(s []string) *SecretCreate {
+	sc.mutation.SetScopes(s)
+	return sc
+}
+
+// SetAllowedIps sets the "allowed_ips" field.
+func (sc *SecretCreate) SetAllowedIps(s []string) *SecretCreate {
+	sc.mutation.SetAllowedIps(s)
+	return sc
+}
+
+// SetRateLimitPerMinute sets the "rate_limit_per_minute" field.
+func (sc *SecretCreate) SetRateLimitPerMinute(i int) *SecretCreate {
+	sc.mutation.SetRateLimitPerMinute(i)
+	return sc
+}
+
+// SetNillableRateLimitPerMinute sets the "rate_limit_per_minute" field if the given value is not nil.
+func (sc *SecretCreate) SetNillableRateLimitPerMinute(i *int) *SecretCreate {
+	if i != nil {
+		sc.SetRateLimitPerMinute(*i)
+	}
+	return sc
+}
+
+// SetRotatedFromID sets the "rotated_from_id" field.
+func (sc *SecretCreate) SetRotatedFromID(s string) *SecretCreate {
+	sc.mutation.SetRotatedFromID(s)
+	return sc
+}
+
+// SetNillableRotatedFromID sets the "rotated_from_id" field if the given value is not nil.
+func (sc *SecretCreate) SetNillableRotatedFromID(s *string) *SecretCreate {
+	if s != nil {
+		sc.SetRotatedFromID(*s)
+	}
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *SecretCreate) SetID(s string) *SecretCreate {
 	sc.mutation.SetID(s)
@@ -275,6 +315,14 @@ func (sc *SecretCreate) defaults() {
 		v := secret.DefaultUserType
 		sc.mutation.SetUserType(v)
 	}
+	if _, ok := sc.mutation.Scopes(); !ok {
+		v := secret.DefaultScopes
+		sc.mutation.SetScopes(v)
+	}
+	if _, ok := sc.mutation.AllowedIps(); !ok {
+		v := secret.DefaultAllowedIps
+		sc.mutation.SetAllowedIps(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -423,6 +471,22 @@ func (sc *SecretCreate) createSpec() (*Secret, *sqlgraph.CreateSpec) {
 		_spec.SetField(secret.FieldUserType, field.TypeString, value)
 		_node.UserType = value
 	}
+	if value, ok := sc.mutation.Scopes(); ok {
+		_spec.SetField(secret.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := sc.mutation.AllowedIps(); ok {
+		_spec.SetField(secret.FieldAllowedIps, field.TypeJSON, value)
+		_node.AllowedIps = value
+	}
+	if value, ok := sc.mutation.RateLimitPerMinute(); ok {
+		_spec.SetField(secret.FieldRateLimitPerMinute, field.TypeInt, value)
+		_node.RateLimitPerMinute = &value
+	}
+	if value, ok := sc.mutation.RotatedFromID(); ok {
+		_spec.SetField(secret.FieldRotatedFromID, field.TypeString, value)
+		_node.RotatedFromID = &value
+	}
 	return _node, _spec
 }
 
@@ -241,6 +241,89 @@ func (su *SecretUpdate) ClearUserType() *SecretUpdate {
 	return su
 }
 
+// SetScopes sets the "scopes" field.
+func (su *SecretUpdate) SetScopes(s []string) *SecretUpdate {
+	su.mutation.SetScopes(s)
+	return su
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (su *SecretUpdate) AppendScopes(s []string) *SecretUpdate {
+	su.mutation.AppendScopes(s)
+	return su
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (su *SecretUpdate) ClearScopes() *SecretUpdate {
+	su.mutation.ClearScopes()
+	return su
+}
+
+// SetAllowedIps sets the "allowed_ips" field.
+func (su *SecretUpdate) SetAllowedIps(s []string) *SecretUpdate {
+	su.mutation.SetAllowedIps(s)
+	return su
+}
+
+// AppendAllowedIps appends s to the "allowed_ips" field.
+func (su *SecretUpdate) AppendAllowedIps(s []string) *SecretUpdate {
+	su.mutation.AppendAllowedIps(s)
+	return su
+}
+
+// ClearAllowedIps clears the value of the "allowed_ips" field.
+func (su *SecretUpdate) ClearAllowedIps() *SecretUpdate {
+	su.mutation.ClearAllowedIps()
+	return su
+}
+
+// SetRateLimitPerMinute sets the "rate_limit_per_minute" field.
+func (su *SecretUpdate) SetRateLimitPerMinute(i int) *SecretUpdate {
+	su.mutation.ResetRateLimitPerMinute()
+	su.mutation.SetRateLimitPerMinute(i)
+	return su
+}
+
+// SetNillableRateLimitPerMinute sets the "rate_limit_per_minute" field if the given value is not nil.
+func (su *SecretUpdate) SetNillableRateLimitPerMinute(i *int) *SecretUpdate {
+	if i != nil {
+		su.SetRateLimitPerMinute(*i)
+	}
+	return su
+}
+
+// AddRateLimitPerMinute adds i to the "rate_limit_per_minute" field.
+func (su *SecretUpdate) AddRateLimitPerMinute(i int) *SecretUpdate {
+	su.mutation.AddRateLimitPerMinute(i)
+	return su
+}
+
+// ClearRateLimitPerMinute clears the value of the "rate_limit_per_minute" field.
+func (su *SecretUpdate) ClearRateLimitPerMinute() *SecretUpdate {
+	su.mutation.ClearRateLimitPerMinute()
+	return su
+}
+
+// SetRotatedFromID sets the "rotated_from_id" field.
+func (su *SecretUpdate) SetRotatedFromID(s string) *SecretUpdate {
+	su.mutation.SetRotatedFromID(s)
+	return su
+}
+
+// SetNillableRotatedFromID sets the "rotated_from_id" field if the given value is not nil.
+func (su *SecretUpdate) SetNillableRotatedFromID(s *string) *SecretUpdate {
+	if s != nil {
+		su.SetRotatedFromID(*s)
+	}
+	return su
+}
+
+// ClearRotatedFromID clears the value of the "rotated_from_id" field.
+func (su *SecretUpdate) ClearRotatedFromID() *SecretUpdate {
+	su.mutation.ClearRotatedFromID()
+	return su
+}
+
 // Mutation returns the SecretMutation object of the builder.
 func (su *SecretUpdate) Mutation() *SecretMutation {
 	return su.mutation
@@ -388,6 +471,43 @@ func (su *SecretUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.UserTypeCleared() {
 		_spec.ClearField(secret.FieldUserType, field.TypeString)
 	}
+	if value, ok := su.mutation.Scopes(); ok {
+		_spec.SetField(secret.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, secret.FieldScopes, value)
+		})
+	}
+	if su.mutation.ScopesCleared() {
+		_spec.ClearField(secret.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := su.mutation.AllowedIps(); ok {
+		_spec.SetField(secret.FieldAllowedIps, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.AppendedAllowedIps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, secret.FieldAllowedIps, value)
+		})
+	}
+	if su.mutation.AllowedIpsCleared() {
+		_spec.ClearField(secret.FieldAllowedIps, field.TypeJSON)
+	}
+	if value, ok := su.mutation.RateLimitPerMinute(); ok {
+		_spec.SetField(secret.FieldRateLimitPerMinute, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedRateLimitPerMinute(); ok {
+		_spec.AddField(secret.FieldRateLimitPerMinute, field.TypeInt, value)
+	}
+	if su.mutation.RateLimitPerMinuteCleared() {
+		_spec.ClearField(secret.FieldRateLimitPerMinute, field.TypeInt)
+	}
+	if value, ok := su.mutation.RotatedFromID(); ok {
+		_spec.SetField(secret.FieldRotatedFromID, field.TypeString, value)
+	}
+	if su.mutation.RotatedFromIDCleared() {
+		_spec.ClearField(secret.FieldRotatedFromID, field.TypeString)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{secret.Label}
@@ -620,6 +740,89 @@ func (suo *SecretUpdateOne) ClearUserType() *SecretUpdateOne {
 	return suo
 }
 
+// SetScopes sets the "scopes" field.
+func (suo *SecretUpdateOne) SetScopes(s []string) *SecretUpdateOne {
+	suo.mutation.SetScopes(s)
+	return suo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (suo *SecretUpdateOne) AppendScopes(s []string) *SecretUpdateOne {
+	suo.mutation.AppendScopes(s)
+	return suo
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (suo *SecretUpdateOne) ClearScopes() *SecretUpdateOne {
+	suo.mutation.ClearScopes()
+	return suo
+}
+
+// SetAllowedIps sets the "allowed_ips" field.
+func (suo *SecretUpdateOne) SetAllowedIps(s []string) *SecretUpdateOne {
+	suo.mutation.SetAllowedIps(s)
+	return suo
+}
+
+// AppendAllowedIps appends s to the "allowed_ips" field.
+func (suo *SecretUpdateOne) AppendAllowedIps(s []string) *SecretUpdateOne {
+	suo.mutation.AppendAllowedIps(s)
+	return suo
+}
+
+// ClearAllowedIps clears the value of the "allowed_ips" field.
+func (suo *SecretUpdateOne) ClearAllowedIps() *SecretUpdateOne {
+	suo.mutation.ClearAllowedIps()
+	return suo
+}
+
+// SetRateLimitPerMinute sets the "rate_limit_per_minute" field.
+func (suo *SecretUpdateOne) SetRateLimitPerMinute(i int) *SecretUpdateOne {
+	suo.mutation.ResetRateLimitPerMinute()
+	suo.mutation.SetRateLimitPerMinute(i)
+	return suo
+}
+
+// SetNillableRateLimitPerMinute sets the "rate_limit_per_minute" field if the given value is not nil.
+func (suo *SecretUpdateOne) SetNillableRateLimitPerMinute(i *int) *SecretUpdateOne {
+	if i != nil {
+		suo.SetRateLimitPerMinute(*i)
+	}
+	return suo
+}
+
+// AddRateLimitPerMinute adds i to the "rate_limit_per_minute" field.
+func (suo *SecretUpdateOne) AddRateLimitPerMinute(i int) *SecretUpdateOne {
+	suo.mutation.AddRateLimitPerMinute(i)
+	return suo
+}
+
+// ClearRateLimitPerMinute clears the value of the "rate_limit_per_minute" field.
+func (suo *SecretUpdateOne) ClearRateLimitPerMinute() *SecretUpdateOne {
+	suo.mutation.ClearRateLimitPerMinute()
+	return suo
+}
+
+// SetRotatedFromID sets the "rotated_from_id" field.
+func (suo *SecretUpdateOne) SetRotatedFromID(s string) *SecretUpdateOne {
+	suo.mutation.SetRotatedFromID(s)
+	return suo
+}
+
+// SetNillableRotatedFromID sets the "rotated_from_id" field if the given value is not nil.
+func (suo *SecretUpdateOne) SetNillableRotatedFromID(s *string) *SecretUpdateOne {
+	if s != nil {
+		suo.SetRotatedFromID(*s)
+	}
+	return suo
+}
+
+// ClearRotatedFromID clears the value of the "rotated_from_id" field.
+func (suo *SecretUpdateOne) ClearRotatedFromID() *SecretUpdateOne {
+	suo.mutation.ClearRotatedFromID()
+	return suo
+}
+
 // Mutation returns the SecretMutation object of the builder.
 func (suo *SecretUpdateOne) Mutation() *SecretMutation {
 	return suo.mutation
@@ -797,6 +1000,43 @@ func (suo *SecretUpdateOne) sqlSave(ctx context.Context) (_node *Secret, err err
 	if suo.mutation.UserTypeCleared() {
 		_spec.ClearField(secret.FieldUserType, field.TypeString)
 	}
+	if value, ok := suo.mutation.Scopes(); ok {
+		_spec.SetField(secret.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, secret.FieldScopes, value)
+		})
+	}
+	if suo.mutation.ScopesCleared() {
+		_spec.ClearField(secret.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.AllowedIps(); ok {
+		_spec.SetField(secret.FieldAllowedIps, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.AppendedAllowedIps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, secret.FieldAllowedIps, value)
+		})
+	}
+	if suo.mutation.AllowedIpsCleared() {
+		_spec.ClearField(secret.FieldAllowedIps, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.RateLimitPerMinute(); ok {
+		_spec.SetField(secret.FieldRateLimitPerMinute, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedRateLimitPerMinute(); ok {
+		_spec.AddField(secret.FieldRateLimitPerMinute, field.TypeInt, value)
+	}
+	if suo.mutation.RateLimitPerMinuteCleared() {
+		_spec.ClearField(secret.FieldRateLimitPerMinute, field.TypeInt)
+	}
+	if value, ok := suo.mutation.RotatedFromID(); ok {
+		_spec.SetField(secret.FieldRotatedFromID, field.TypeString, value)
+	}
+	if suo.mutation.RotatedFromIDCleared() {
+		_spec.ClearField(secret.FieldRotatedFromID, field.TypeString)
+	}
 	_node = &Secret{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
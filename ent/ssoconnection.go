@@ -0,0 +1,349 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/ssoconnection"
+)
+
+// SSOConnection is the model entity for the SSOConnection schema.
+type SSOConnection struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// oidc or saml
+	Protocol string `json:"protocol,omitempty"`
+	// Email domains whose users sign in through this connection
+	Domains []string `json:"domains,omitempty"`
+	// Domains whose ownership was proven through a DNS TXT record
+	VerifiedDomains []string `json:"verified_domains,omitempty"`
+	// Token published in the DNS TXT records verifying the domains
+	DomainVerificationToken string `json:"domain_verification_token,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// OIDC issuer, used for discovery
+	IssuerURL string `json:"issuer_url,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID string `json:"client_id,omitempty"`
+	// Encrypted OIDC client secret
+	ClientSecret string `json:"-"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// SAML identity provider metadata URL
+	IdpMetadataURL string `json:"idp_metadata_url,omitempty"`
+	// SAML identity provider metadata, used when no metadata URL is set
+	IdpMetadataXML string `json:"idp_metadata_xml,omitempty"`
+	// Claim or attribute holding the groups of the user
+	RoleClaim string `json:"role_claim,omitempty"`
+	// RBAC roles granted per identity provider group
+	RoleMappings map[string][]string `json:"role_mappings,omitempty"`
+	// RBAC roles granted to every user signing in through this connection
+	DefaultRoles []string `json:"default_roles,omitempty"`
+	// Create users on their first sign in
+	JitProvisioning bool `json:"jit_provisioning,omitempty"`
+	selectValues    sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SSOConnection) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ssoconnection.FieldDomains, ssoconnection.FieldVerifiedDomains, ssoconnection.FieldScopes, ssoconnection.FieldRoleMappings, ssoconnection.FieldDefaultRoles:
+			values[i] = new([]byte)
+		case ssoconnection.FieldEnabled, ssoconnection.FieldJitProvisioning:
+			values[i] = new(sql.NullBool)
+		case ssoconnection.FieldID, ssoconnection.FieldTenantID, ssoconnection.FieldStatus, ssoconnection.FieldCreatedBy, ssoconnection.FieldUpdatedBy, ssoconnection.FieldName, ssoconnection.FieldProtocol, ssoconnection.FieldDomainVerificationToken, ssoconnection.FieldIssuerURL, ssoconnection.FieldClientID, ssoconnection.FieldClientSecret, ssoconnection.FieldIdpMetadataURL, ssoconnection.FieldIdpMetadataXML, ssoconnection.FieldRoleClaim:
+			values[i] = new(sql.NullString)
+		case ssoconnection.FieldCreatedAt, ssoconnection.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SSOConnection fields.
+func (sc *SSOConnection) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ssoconnection.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				sc.ID = value.String
+			}
+		case ssoconnection.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				sc.TenantID = value.String
+			}
+		case ssoconnection.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sc.Status = value.String
+			}
+		case ssoconnection.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sc.CreatedAt = value.Time
+			}
+		case ssoconnection.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sc.UpdatedAt = value.Time
+			}
+		case ssoconnection.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				sc.CreatedBy = value.String
+			}
+		case ssoconnection.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				sc.UpdatedBy = value.String
+			}
+		case ssoconnection.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sc.Name = value.String
+			}
+		case ssoconnection.FieldProtocol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field protocol", values[i])
+			} else if value.Valid {
+				sc.Protocol = value.String
+			}
+		case ssoconnection.FieldDomains:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field domains", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sc.Domains); err != nil {
+					return fmt.Errorf("unmarshal field domains: %w", err)
+				}
+			}
+		case ssoconnection.FieldVerifiedDomains:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_domains", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sc.VerifiedDomains); err != nil {
+					return fmt.Errorf("unmarshal field verified_domains: %w", err)
+				}
+			}
+		case ssoconnection.FieldDomainVerificationToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field domain_verification_token", values[i])
+			} else if value.Valid {
+				sc.DomainVerificationToken = value.String
+			}
+		case ssoconnection.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				sc.Enabled = value.Bool
+			}
+		case ssoconnection.FieldIssuerURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field issuer_url", values[i])
+			} else if value.Valid {
+				sc.IssuerURL = value.String
+			}
+		case ssoconnection.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				sc.ClientID = value.String
+			}
+		case ssoconnection.FieldClientSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_secret", values[i])
+			} else if value.Valid {
+				sc.ClientSecret = value.String
+			}
+		case ssoconnection.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sc.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case ssoconnection.FieldIdpMetadataURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idp_metadata_url", values[i])
+			} else if value.Valid {
+				sc.IdpMetadataURL = value.String
+			}
+		case ssoconnection.FieldIdpMetadataXML:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idp_metadata_xml", values[i])
+			} else if value.Valid {
+				sc.IdpMetadataXML = value.String
+			}
+		case ssoconnection.FieldRoleClaim:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role_claim", values[i])
+			} else if value.Valid {
+				sc.RoleClaim = value.String
+			}
+		case ssoconnection.FieldRoleMappings:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field role_mappings", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sc.RoleMappings); err != nil {
+					return fmt.Errorf("unmarshal field role_mappings: %w", err)
+				}
+			}
+		case ssoconnection.FieldDefaultRoles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field default_roles", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sc.DefaultRoles); err != nil {
+					return fmt.Errorf("unmarshal field default_roles: %w", err)
+				}
+			}
+		case ssoconnection.FieldJitProvisioning:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field jit_provisioning", values[i])
+			} else if value.Valid {
+				sc.JitProvisioning = value.Bool
+			}
+		default:
+			sc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SSOConnection.
+// This includes values selected through modifiers, order, etc.
+func (sc *SSOConnection) Value(name string) (ent.Value, error) {
+	return sc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SSOConnection.
+// Note that you need to call SSOConnection.Unwrap() before calling this method if this SSOConnection
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sc *SSOConnection) Update() *SSOConnectionUpdateOne {
+	return NewSSOConnectionClient(sc.config).UpdateOne(sc)
+}
+
+// Unwrap unwraps the SSOConnection entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sc *SSOConnection) Unwrap() *SSOConnection {
+	_tx, ok := sc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SSOConnection is not a transactional entity")
+	}
+	sc.config.driver = _tx.drv
+	return sc
+}
+
+// String implements the fmt.Stringer.
+func (sc *SSOConnection) String() string {
+	var builder strings.Builder
+	builder.WriteString("SSOConnection(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sc.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(sc.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(sc.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(sc.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(sc.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(sc.Name)
+	builder.WriteString(", ")
+	builder.WriteString("protocol=")
+	builder.WriteString(sc.Protocol)
+	builder.WriteString(", ")
+	builder.WriteString("domains=")
+	builder.WriteString(fmt.Sprintf("%v", sc.Domains))
+	builder.WriteString(", ")
+	builder.WriteString("verified_domains=")
+	builder.WriteString(fmt.Sprintf("%v", sc.VerifiedDomains))
+	builder.WriteString(", ")
+	builder.WriteString("domain_verification_token=")
+	builder.WriteString(sc.DomainVerificationToken)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", sc.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("issuer_url=")
+	builder.WriteString(sc.IssuerURL)
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(sc.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("client_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", sc.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("idp_metadata_url=")
+	builder.WriteString(sc.IdpMetadataURL)
+	builder.WriteString(", ")
+	builder.WriteString("idp_metadata_xml=")
+	builder.WriteString(sc.IdpMetadataXML)
+	builder.WriteString(", ")
+	builder.WriteString("role_claim=")
+	builder.WriteString(sc.RoleClaim)
+	builder.WriteString(", ")
+	builder.WriteString("role_mappings=")
+	builder.WriteString(fmt.Sprintf("%v", sc.RoleMappings))
+	builder.WriteString(", ")
+	builder.WriteString("default_roles=")
+	builder.WriteString(fmt.Sprintf("%v", sc.DefaultRoles))
+	builder.WriteString(", ")
+	builder.WriteString("jit_provisioning=")
+	builder.WriteString(fmt.Sprintf("%v", sc.JitProvisioning))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SSOConnections is a parsable slice of SSOConnection.
+type SSOConnections []*SSOConnection
@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ssoconnection
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the ssoconnection type in the database.
+	Label = "sso_connection"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldProtocol holds the string denoting the protocol field in the database.
+	FieldProtocol = "protocol"
+	// FieldDomains holds the string denoting the domains field in the database.
+	FieldDomains = "domains"
+	// FieldVerifiedDomains holds the string denoting the verified_domains field in the database.
+	FieldVerifiedDomains = "verified_domains"
+	// FieldDomainVerificationToken holds the string denoting the domain_verification_token field in the database.
+	FieldDomainVerificationToken = "domain_verification_token"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldIssuerURL holds the string denoting the issuer_url field in the database.
+	FieldIssuerURL = "issuer_url"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldClientSecret holds the string denoting the client_secret field in the database.
+	FieldClientSecret = "client_secret"
+	// FieldScopes holds the string denoting the scopes field in the database.
+	FieldScopes = "scopes"
+	// FieldIdpMetadataURL holds the string denoting the idp_metadata_url field in the database.
+	FieldIdpMetadataURL = "idp_metadata_url"
+	// FieldIdpMetadataXML holds the string denoting the idp_metadata_xml field in the database.
+	FieldIdpMetadataXML = "idp_metadata_xml"
+	// FieldRoleClaim holds the string denoting the role_claim field in the database.
+	FieldRoleClaim = "role_claim"
+	// FieldRoleMappings holds the string denoting the role_mappings field in the database.
+	FieldRoleMappings = "role_mappings"
+	// FieldDefaultRoles holds the string denoting the default_roles field in the database.
+	FieldDefaultRoles = "default_roles"
+	// FieldJitProvisioning holds the string denoting the jit_provisioning field in the database.
+	FieldJitProvisioning = "jit_provisioning"
+	// Table holds the table name of the ssoconnection in the database.
+	Table = "sso_connections"
+)
+
+// Columns holds all SQL columns for ssoconnection fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldName,
+	FieldProtocol,
+	FieldDomains,
+	FieldVerifiedDomains,
+	FieldDomainVerificationToken,
+	FieldEnabled,
+	FieldIssuerURL,
+	FieldClientID,
+	FieldClientSecret,
+	FieldScopes,
+	FieldIdpMetadataURL,
+	FieldIdpMetadataXML,
+	FieldRoleClaim,
+	FieldRoleMappings,
+	FieldDefaultRoles,
+	FieldJitProvisioning,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// ProtocolValidator is a validator for the "protocol" field. It is called by the builders before save.
+	ProtocolValidator func(string) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultJitProvisioning holds the default value on creation for the "jit_provisioning" field.
+	DefaultJitProvisioning bool
+)
+
+// OrderOption defines the ordering options for the SSOConnection queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByProtocol orders the results by the protocol field.
+func ByProtocol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProtocol, opts...).ToFunc()
+}
+
+// ByDomainVerificationToken orders the results by the domain_verification_token field.
+func ByDomainVerificationToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDomainVerificationToken, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByIssuerURL orders the results by the issuer_url field.
+func ByIssuerURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssuerURL, opts...).ToFunc()
+}
+
+// ByClientID orders the results by the client_id field.
+func ByClientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientID, opts...).ToFunc()
+}
+
+// ByClientSecret orders the results by the client_secret field.
+func ByClientSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientSecret, opts...).ToFunc()
+}
+
+// ByIdpMetadataURL orders the results by the idp_metadata_url field.
+func ByIdpMetadataURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdpMetadataURL, opts...).ToFunc()
+}
+
+// ByIdpMetadataXML orders the results by the idp_metadata_xml field.
+func ByIdpMetadataXML(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdpMetadataXML, opts...).ToFunc()
+}
+
+// ByRoleClaim orders the results by the role_claim field.
+func ByRoleClaim(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoleClaim, opts...).ToFunc()
+}
+
+// ByJitProvisioning orders the results by the jit_provisioning field.
+func ByJitProvisioning(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJitProvisioning, opts...).ToFunc()
+}
@@ -0,0 +1,1250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ssoconnection
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldName, v))
+}
+
+// Protocol applies equality check predicate on the "protocol" field. It's identical to ProtocolEQ.
+func Protocol(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldProtocol, v))
+}
+
+// DomainVerificationToken applies equality check predicate on the "domain_verification_token" field. It's identical to DomainVerificationTokenEQ.
+func DomainVerificationToken(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldDomainVerificationToken, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldEnabled, v))
+}
+
+// IssuerURL applies equality check predicate on the "issuer_url" field. It's identical to IssuerURLEQ.
+func IssuerURL(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldIssuerURL, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientSecret applies equality check predicate on the "client_secret" field. It's identical to ClientSecretEQ.
+func ClientSecret(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldClientSecret, v))
+}
+
+// IdpMetadataURL applies equality check predicate on the "idp_metadata_url" field. It's identical to IdpMetadataURLEQ.
+func IdpMetadataURL(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataXML applies equality check predicate on the "idp_metadata_xml" field. It's identical to IdpMetadataXMLEQ.
+func IdpMetadataXML(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldIdpMetadataXML, v))
+}
+
+// RoleClaim applies equality check predicate on the "role_claim" field. It's identical to RoleClaimEQ.
+func RoleClaim(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldRoleClaim, v))
+}
+
+// JitProvisioning applies equality check predicate on the "jit_provisioning" field. It's identical to JitProvisioningEQ.
+func JitProvisioning(v bool) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldJitProvisioning, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldName, v))
+}
+
+// ProtocolEQ applies the EQ predicate on the "protocol" field.
+func ProtocolEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldProtocol, v))
+}
+
+// ProtocolNEQ applies the NEQ predicate on the "protocol" field.
+func ProtocolNEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldProtocol, v))
+}
+
+// ProtocolIn applies the In predicate on the "protocol" field.
+func ProtocolIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldProtocol, vs...))
+}
+
+// ProtocolNotIn applies the NotIn predicate on the "protocol" field.
+func ProtocolNotIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldProtocol, vs...))
+}
+
+// ProtocolGT applies the GT predicate on the "protocol" field.
+func ProtocolGT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldProtocol, v))
+}
+
+// ProtocolGTE applies the GTE predicate on the "protocol" field.
+func ProtocolGTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldProtocol, v))
+}
+
+// ProtocolLT applies the LT predicate on the "protocol" field.
+func ProtocolLT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldProtocol, v))
+}
+
+// ProtocolLTE applies the LTE predicate on the "protocol" field.
+func ProtocolLTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldProtocol, v))
+}
+
+// ProtocolContains applies the Contains predicate on the "protocol" field.
+func ProtocolContains(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContains(FieldProtocol, v))
+}
+
+// ProtocolHasPrefix applies the HasPrefix predicate on the "protocol" field.
+func ProtocolHasPrefix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasPrefix(FieldProtocol, v))
+}
+
+// ProtocolHasSuffix applies the HasSuffix predicate on the "protocol" field.
+func ProtocolHasSuffix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasSuffix(FieldProtocol, v))
+}
+
+// ProtocolEqualFold applies the EqualFold predicate on the "protocol" field.
+func ProtocolEqualFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldProtocol, v))
+}
+
+// ProtocolContainsFold applies the ContainsFold predicate on the "protocol" field.
+func ProtocolContainsFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldProtocol, v))
+}
+
+// DomainsIsNil applies the IsNil predicate on the "domains" field.
+func DomainsIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldDomains))
+}
+
+// DomainsNotNil applies the NotNil predicate on the "domains" field.
+func DomainsNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldDomains))
+}
+
+// VerifiedDomainsIsNil applies the IsNil predicate on the "verified_domains" field.
+func VerifiedDomainsIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldVerifiedDomains))
+}
+
+// VerifiedDomainsNotNil applies the NotNil predicate on the "verified_domains" field.
+func VerifiedDomainsNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldVerifiedDomains))
+}
+
+// DomainVerificationTokenEQ applies the EQ predicate on the "domain_verification_token" field.
+func DomainVerificationTokenEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenNEQ applies the NEQ predicate on the "domain_verification_token" field.
+func DomainVerificationTokenNEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenIn applies the In predicate on the "domain_verification_token" field.
+func DomainVerificationTokenIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldDomainVerificationToken, vs...))
+}
+
+// DomainVerificationTokenNotIn applies the NotIn predicate on the "domain_verification_token" field.
+func DomainVerificationTokenNotIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldDomainVerificationToken, vs...))
+}
+
+// DomainVerificationTokenGT applies the GT predicate on the "domain_verification_token" field.
+func DomainVerificationTokenGT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenGTE applies the GTE predicate on the "domain_verification_token" field.
+func DomainVerificationTokenGTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenLT applies the LT predicate on the "domain_verification_token" field.
+func DomainVerificationTokenLT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenLTE applies the LTE predicate on the "domain_verification_token" field.
+func DomainVerificationTokenLTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenContains applies the Contains predicate on the "domain_verification_token" field.
+func DomainVerificationTokenContains(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContains(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenHasPrefix applies the HasPrefix predicate on the "domain_verification_token" field.
+func DomainVerificationTokenHasPrefix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasPrefix(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenHasSuffix applies the HasSuffix predicate on the "domain_verification_token" field.
+func DomainVerificationTokenHasSuffix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasSuffix(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenIsNil applies the IsNil predicate on the "domain_verification_token" field.
+func DomainVerificationTokenIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldDomainVerificationToken))
+}
+
+// DomainVerificationTokenNotNil applies the NotNil predicate on the "domain_verification_token" field.
+func DomainVerificationTokenNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldDomainVerificationToken))
+}
+
+// DomainVerificationTokenEqualFold applies the EqualFold predicate on the "domain_verification_token" field.
+func DomainVerificationTokenEqualFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenContainsFold applies the ContainsFold predicate on the "domain_verification_token" field.
+func DomainVerificationTokenContainsFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldDomainVerificationToken, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// IssuerURLEQ applies the EQ predicate on the "issuer_url" field.
+func IssuerURLEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldIssuerURL, v))
+}
+
+// IssuerURLNEQ applies the NEQ predicate on the "issuer_url" field.
+func IssuerURLNEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldIssuerURL, v))
+}
+
+// IssuerURLIn applies the In predicate on the "issuer_url" field.
+func IssuerURLIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldIssuerURL, vs...))
+}
+
+// IssuerURLNotIn applies the NotIn predicate on the "issuer_url" field.
+func IssuerURLNotIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldIssuerURL, vs...))
+}
+
+// IssuerURLGT applies the GT predicate on the "issuer_url" field.
+func IssuerURLGT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldIssuerURL, v))
+}
+
+// IssuerURLGTE applies the GTE predicate on the "issuer_url" field.
+func IssuerURLGTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldIssuerURL, v))
+}
+
+// IssuerURLLT applies the LT predicate on the "issuer_url" field.
+func IssuerURLLT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldIssuerURL, v))
+}
+
+// IssuerURLLTE applies the LTE predicate on the "issuer_url" field.
+func IssuerURLLTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldIssuerURL, v))
+}
+
+// IssuerURLContains applies the Contains predicate on the "issuer_url" field.
+func IssuerURLContains(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContains(FieldIssuerURL, v))
+}
+
+// IssuerURLHasPrefix applies the HasPrefix predicate on the "issuer_url" field.
+func IssuerURLHasPrefix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasPrefix(FieldIssuerURL, v))
+}
+
+// IssuerURLHasSuffix applies the HasSuffix predicate on the "issuer_url" field.
+func IssuerURLHasSuffix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasSuffix(FieldIssuerURL, v))
+}
+
+// IssuerURLIsNil applies the IsNil predicate on the "issuer_url" field.
+func IssuerURLIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldIssuerURL))
+}
+
+// IssuerURLNotNil applies the NotNil predicate on the "issuer_url" field.
+func IssuerURLNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldIssuerURL))
+}
+
+// IssuerURLEqualFold applies the EqualFold predicate on the "issuer_url" field.
+func IssuerURLEqualFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldIssuerURL, v))
+}
+
+// IssuerURLContainsFold applies the ContainsFold predicate on the "issuer_url" field.
+func IssuerURLContainsFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldIssuerURL, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDIsNil applies the IsNil predicate on the "client_id" field.
+func ClientIDIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldClientID))
+}
+
+// ClientIDNotNil applies the NotNil predicate on the "client_id" field.
+func ClientIDNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldClientID))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// ClientSecretEQ applies the EQ predicate on the "client_secret" field.
+func ClientSecretEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldClientSecret, v))
+}
+
+// ClientSecretNEQ applies the NEQ predicate on the "client_secret" field.
+func ClientSecretNEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldClientSecret, v))
+}
+
+// ClientSecretIn applies the In predicate on the "client_secret" field.
+func ClientSecretIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldClientSecret, vs...))
+}
+
+// ClientSecretNotIn applies the NotIn predicate on the "client_secret" field.
+func ClientSecretNotIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldClientSecret, vs...))
+}
+
+// ClientSecretGT applies the GT predicate on the "client_secret" field.
+func ClientSecretGT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldClientSecret, v))
+}
+
+// ClientSecretGTE applies the GTE predicate on the "client_secret" field.
+func ClientSecretGTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldClientSecret, v))
+}
+
+// ClientSecretLT applies the LT predicate on the "client_secret" field.
+func ClientSecretLT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldClientSecret, v))
+}
+
+// ClientSecretLTE applies the LTE predicate on the "client_secret" field.
+func ClientSecretLTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldClientSecret, v))
+}
+
+// ClientSecretContains applies the Contains predicate on the "client_secret" field.
+func ClientSecretContains(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContains(FieldClientSecret, v))
+}
+
+// ClientSecretHasPrefix applies the HasPrefix predicate on the "client_secret" field.
+func ClientSecretHasPrefix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasPrefix(FieldClientSecret, v))
+}
+
+// ClientSecretHasSuffix applies the HasSuffix predicate on the "client_secret" field.
+func ClientSecretHasSuffix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasSuffix(FieldClientSecret, v))
+}
+
+// ClientSecretIsNil applies the IsNil predicate on the "client_secret" field.
+func ClientSecretIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldClientSecret))
+}
+
+// ClientSecretNotNil applies the NotNil predicate on the "client_secret" field.
+func ClientSecretNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldClientSecret))
+}
+
+// ClientSecretEqualFold applies the EqualFold predicate on the "client_secret" field.
+func ClientSecretEqualFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldClientSecret, v))
+}
+
+// ClientSecretContainsFold applies the ContainsFold predicate on the "client_secret" field.
+func ClientSecretContainsFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldClientSecret, v))
+}
+
+// ScopesIsNil applies the IsNil predicate on the "scopes" field.
+func ScopesIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldScopes))
+}
+
+// ScopesNotNil applies the NotNil predicate on the "scopes" field.
+func ScopesNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldScopes))
+}
+
+// IdpMetadataURLEQ applies the EQ predicate on the "idp_metadata_url" field.
+func IdpMetadataURLEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLNEQ applies the NEQ predicate on the "idp_metadata_url" field.
+func IdpMetadataURLNEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLIn applies the In predicate on the "idp_metadata_url" field.
+func IdpMetadataURLIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldIdpMetadataURL, vs...))
+}
+
+// IdpMetadataURLNotIn applies the NotIn predicate on the "idp_metadata_url" field.
+func IdpMetadataURLNotIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldIdpMetadataURL, vs...))
+}
+
+// IdpMetadataURLGT applies the GT predicate on the "idp_metadata_url" field.
+func IdpMetadataURLGT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLGTE applies the GTE predicate on the "idp_metadata_url" field.
+func IdpMetadataURLGTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLLT applies the LT predicate on the "idp_metadata_url" field.
+func IdpMetadataURLLT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLLTE applies the LTE predicate on the "idp_metadata_url" field.
+func IdpMetadataURLLTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLContains applies the Contains predicate on the "idp_metadata_url" field.
+func IdpMetadataURLContains(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContains(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLHasPrefix applies the HasPrefix predicate on the "idp_metadata_url" field.
+func IdpMetadataURLHasPrefix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasPrefix(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLHasSuffix applies the HasSuffix predicate on the "idp_metadata_url" field.
+func IdpMetadataURLHasSuffix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasSuffix(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLIsNil applies the IsNil predicate on the "idp_metadata_url" field.
+func IdpMetadataURLIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldIdpMetadataURL))
+}
+
+// IdpMetadataURLNotNil applies the NotNil predicate on the "idp_metadata_url" field.
+func IdpMetadataURLNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldIdpMetadataURL))
+}
+
+// IdpMetadataURLEqualFold applies the EqualFold predicate on the "idp_metadata_url" field.
+func IdpMetadataURLEqualFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataURLContainsFold applies the ContainsFold predicate on the "idp_metadata_url" field.
+func IdpMetadataURLContainsFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldIdpMetadataURL, v))
+}
+
+// IdpMetadataXMLEQ applies the EQ predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLNEQ applies the NEQ predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLNEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLIn applies the In predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldIdpMetadataXML, vs...))
+}
+
+// IdpMetadataXMLNotIn applies the NotIn predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLNotIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldIdpMetadataXML, vs...))
+}
+
+// IdpMetadataXMLGT applies the GT predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLGT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLGTE applies the GTE predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLGTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLLT applies the LT predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLLT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLLTE applies the LTE predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLLTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLContains applies the Contains predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLContains(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContains(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLHasPrefix applies the HasPrefix predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLHasPrefix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasPrefix(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLHasSuffix applies the HasSuffix predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLHasSuffix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasSuffix(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLIsNil applies the IsNil predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldIdpMetadataXML))
+}
+
+// IdpMetadataXMLNotNil applies the NotNil predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldIdpMetadataXML))
+}
+
+// IdpMetadataXMLEqualFold applies the EqualFold predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLEqualFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldIdpMetadataXML, v))
+}
+
+// IdpMetadataXMLContainsFold applies the ContainsFold predicate on the "idp_metadata_xml" field.
+func IdpMetadataXMLContainsFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldIdpMetadataXML, v))
+}
+
+// RoleClaimEQ applies the EQ predicate on the "role_claim" field.
+func RoleClaimEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldRoleClaim, v))
+}
+
+// RoleClaimNEQ applies the NEQ predicate on the "role_claim" field.
+func RoleClaimNEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldRoleClaim, v))
+}
+
+// RoleClaimIn applies the In predicate on the "role_claim" field.
+func RoleClaimIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldRoleClaim, vs...))
+}
+
+// RoleClaimNotIn applies the NotIn predicate on the "role_claim" field.
+func RoleClaimNotIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldRoleClaim, vs...))
+}
+
+// RoleClaimGT applies the GT predicate on the "role_claim" field.
+func RoleClaimGT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldRoleClaim, v))
+}
+
+// RoleClaimGTE applies the GTE predicate on the "role_claim" field.
+func RoleClaimGTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldRoleClaim, v))
+}
+
+// RoleClaimLT applies the LT predicate on the "role_claim" field.
+func RoleClaimLT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldRoleClaim, v))
+}
+
+// RoleClaimLTE applies the LTE predicate on the "role_claim" field.
+func RoleClaimLTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldRoleClaim, v))
+}
+
+// RoleClaimContains applies the Contains predicate on the "role_claim" field.
+func RoleClaimContains(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContains(FieldRoleClaim, v))
+}
+
+// RoleClaimHasPrefix applies the HasPrefix predicate on the "role_claim" field.
+func RoleClaimHasPrefix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasPrefix(FieldRoleClaim, v))
+}
+
+// RoleClaimHasSuffix applies the HasSuffix predicate on the "role_claim" field.
+func RoleClaimHasSuffix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasSuffix(FieldRoleClaim, v))
+}
+
+// RoleClaimIsNil applies the IsNil predicate on the "role_claim" field.
+func RoleClaimIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldRoleClaim))
+}
+
+// RoleClaimNotNil applies the NotNil predicate on the "role_claim" field.
+func RoleClaimNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldRoleClaim))
+}
+
+// RoleClaimEqualFold applies the EqualFold predicate on the "role_claim" field.
+func RoleClaimEqualFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldRoleClaim, v))
+}
+
+// RoleClaimContainsFold applies the ContainsFold predicate on the "role_claim" field.
+func RoleClaimContainsFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldRoleClaim, v))
+}
+
+// RoleMappingsIsNil applies the IsNil predicate on the "role_mappings" field.
+func RoleMappingsIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldRoleMappings))
+}
+
+// RoleMappingsNotNil applies the NotNil predicate on the "role_mappings" field.
+func RoleMappingsNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldRoleMappings))
+}
+
+// DefaultRolesIsNil applies the IsNil predicate on the "default_roles" field.
+func DefaultRolesIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldDefaultRoles))
+}
+
+// DefaultRolesNotNil applies the NotNil predicate on the "default_roles" field.
+func DefaultRolesNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldDefaultRoles))
+}
+
+// JitProvisioningEQ applies the EQ predicate on the "jit_provisioning" field.
+func JitProvisioningEQ(v bool) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldJitProvisioning, v))
+}
+
+// JitProvisioningNEQ applies the NEQ predicate on the "jit_provisioning" field.
+func JitProvisioningNEQ(v bool) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldJitProvisioning, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SSOConnection) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SSOConnection) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SSOConnection) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.NotPredicates(p))
+}
@@ -0,0 +1,579 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/ssoconnection"
+)
+
+// SSOConnectionCreate is the builder for creating a SSOConnection entity.
+type SSOConnectionCreate struct {
+	config
+	mutation *SSOConnectionMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (scc *SSOConnectionCreate) SetTenantID(s string) *SSOConnectionCreate {
+	scc.mutation.SetTenantID(s)
+	return scc
+}
+
+// SetStatus sets the "status" field.
+func (scc *SSOConnectionCreate) SetStatus(s string) *SSOConnectionCreate {
+	scc.mutation.SetStatus(s)
+	return scc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (scc *SSOConnectionCreate) SetNillableStatus(s *string) *SSOConnectionCreate {
+	if s != nil {
+		scc.SetStatus(*s)
+	}
+	return scc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (scc *SSOConnectionCreate) SetCreatedAt(t time.Time) *SSOConnectionCreate {
+	scc.mutation.SetCreatedAt(t)
+	return scc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (scc *SSOConnectionCreate) SetNillableCreatedAt(t *time.Time) *SSOConnectionCreate {
+	if t != nil {
+		scc.SetCreatedAt(*t)
+	}
+	return scc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (scc *SSOConnectionCreate) SetUpdatedAt(t time.Time) *SSOConnectionCreate {
+	scc.mutation.SetUpdatedAt(t)
+	return scc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (scc *SSOConnectionCreate) SetNillableUpdatedAt(t *time.Time) *SSOConnectionCreate {
+	if t != nil {
+		scc.SetUpdatedAt(*t)
+	}
+	return scc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (scc *SSOConnectionCreate) SetCreatedBy(s string) *SSOConnectionCreate {
+	scc.mutation.SetCreatedBy(s)
+	return scc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (scc *SSOConnectionCreate) SetNillableCreatedBy(s *string) *SSOConnectionCreate {
+	if s != nil {
+		scc.SetCreatedBy(*s)
+	}
+	return scc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (scc *SSOConnectionCreate) SetUpdatedBy(s string) *SSOConnectionCreate {
+	scc.mutation.SetUpdatedBy(s)
+	return scc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (scc *SSOConnectionCreate) SetNillableUpdatedBy(s *string) *SSOConnectionCreate {
+	if s != nil {
+		scc.SetUpdatedBy(*s)
+	}
+	return scc
+}
+
+// SetName sets the "name" field.
+func (scc *SSOConnectionCreate) SetName(s string) *SSOConnectionCreate {
+	scc.mutation.SetName(s)
+	return scc
+}
+
+// SetProtocol sets the "protocol" field.
+func (scc *SSOConnectionCreate) SetProtocol(s string) *SSOConnectionCreate {
+	scc.mutation.SetProtocol(s)
+	return scc
+}
+
+// SetDomains sets the "domains" field.
+func (scc *SSOConnectionCreate) SetDomains(s []string) *SSOConnectionCreate {
+	scc.mutation.SetDomains(s)
+	return scc
+}
+
+// SetVerifiedDomains sets the "verified_domains" field.
+func (scc *SSOConnectionCreate) SetVerifiedDomains(s []string) *SSOConnectionCreate {
+	scc.mutation.SetVerifiedDomains(s)
+	return scc
+}
+
+// SetDomainVerificationToken sets the "domain_verification_token" field.
+func (scc *SSOConnectionCreate) SetDomainVerificationToken(s string) *SSOConnectionCreate {
+	scc.mutation.SetDomainVerificationToken(s)
+	return scc
+}
+
+// SetNillableDomainVerificationToken sets the "domain_verification_token" field if the given value is not nil.
+func (scc *SSOConnectionCreate) SetNillableDomainVerificationToken(s *string) *SSOConnectionCreate {
+	if s != nil {
+		scc.SetDomainVerificationToken(*s)
+	}
+	return scc
+}
+
+// SetEnabled sets the "enabled" field.
+func (scc *SSOConnectionCreate) SetEnabled(b bool) *SSOConnectionCreate {
+	scc.mutation.SetEnabled(b)
+	return scc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (scc *SSOConnectionCreate) SetNillableEnabled(b *bool) *SSOConnectionCreate {
+	if b != nil {
+		scc.SetEnabled(*b)
+	}
+	return scc
+}
+
+// SetIssuerURL sets the "issuer_url" field.
+func (scc *SSOConnectionCreate) SetIssuerURL(s string) *SSOConnectionCreate {
+	scc.mutation.SetIssuerURL(s)
+	return scc
+}
+
+// SetNillableIssuerURL sets the "issuer_url" field if the given value is not nil.
+func (scc *SSOConnectionCreate) SetNillableIssuerURL(s *string) *SSOConnectionCreate {
+	if s != nil {
+		scc.SetIssuerURL(*s)
+	}
+	return scc
+}
+
+// SetClientID sets the "client_id" field.
+func (scc *SSOConnectionCreate) SetClientID(s string) *SSOConnectionCreate {
+	scc.mutation.SetClientID(s)
+	return scc
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (scc *SSOConnectionCreate) SetNillableClientID(s *string) *SSOConnectionCreate {
+	if s != nil {
+		scc.SetClientID(*s)
+	}
+	return scc
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (scc *SSOConnectionCreate) SetClientSecret(s string) *SSOConnectionCreate {
+	scc.mutation.SetClientSecret(s)
+	return scc
+}
+
+// SetNillableClientSecret sets the "client_secret" field if the given value is not nil.
+func (scc *SSOConnectionCreate) SetNillableClientSecret(s *string) *SSOConnectionCreate {
+	if s != nil {
+		scc.SetClientSecret(*s)
+	}
+	return scc
+}
+
+// SetScopes sets the "scopes" field.
+func (scc *SSOConnectionCreate) SetScopes(s []string) *SSOConnectionCreate {
+	scc.mutation.SetScopes(s)
+	return scc
+}
+
+// SetIdpMetadataURL sets the "idp_metadata_url" field.
+func (scc *SSOConnectionCreate) SetIdpMetadataURL(s string) *SSOConnectionCreate {
+	scc.mutation.SetIdpMetadataURL(s)
+	return scc
+}
+
+// SetNillableIdpMetadataURL sets the "idp_metadata_url" field if the given value is not nil.
+func (scc *SSOConnectionCreate) SetNillableIdpMetadataURL(s *string) *SSOConnectionCreate {
+	if s != nil {
+		scc.SetIdpMetadataURL(*s)
+	}
+	return scc
+}
+
+// SetIdpMetadataXML sets the "idp_metadata_xml" field.
+func (scc *SSOConnectionCreate) SetIdpMetadataXML(s string) *SSOConnectionCreate {
+	scc.mutation.SetIdpMetadataXML(s)
+	return scc
+}
+
+// SetNillableIdpMetadataXML sets the "idp_metadata_xml" field if the given value is not nil.
+func (scc *SSOConnectionCreate) SetNillableIdpMetadataXML(s *string) *SSOConnectionCreate {
+	if s != nil {
+		scc.SetIdpMetadataXML(*s)
+	}
+	return scc
+}
+
+// SetRoleClaim sets the "role_claim" field.
+func (scc *SSOConnectionCreate) SetRoleClaim(s string) *SSOConnectionCreate {
+	scc.mutation.SetRoleClaim(s)
+	return scc
+}
+
+// SetNillableRoleClaim sets the "role_claim" field if the given value is not nil.
+func (scc *SSOConnectionCreate) SetNillableRoleClaim(s *string) *SSOConnectionCreate {
+	if s != nil {
+		scc.SetRoleClaim(*s)
+	}
+	return scc
+}
+
+// SetRoleMappings sets the "role_mappings" field.
+func (scc *SSOConnectionCreate) SetRoleMappings(m map[string][]string) *SSOConnectionCreate {
+	scc.mutation.SetRoleMappings(m)
+	return scc
+}
+
+// SetDefaultRoles sets the "default_roles" field.
+func (scc *SSOConnectionCreate) SetDefaultRoles(s []string) *SSOConnectionCreate {
+	scc.mutation.SetDefaultRoles(s)
+	return scc
+}
+
+// SetJitProvisioning sets the "jit_provisioning" field.
+func (scc *SSOConnectionCreate) SetJitProvisioning(b bool) *SSOConnectionCreate {
+	scc.mutation.SetJitProvisioning(b)
+	return scc
+}
+
+// SetNillableJitProvisioning sets the "jit_provisioning" field if the given value is not nil.
+func (scc *SSOConnectionCreate) SetNillableJitProvisioning(b *bool) *SSOConnectionCreate {
+	if b != nil {
+		scc.SetJitProvisioning(*b)
+	}
+	return scc
+}
+
+// SetID sets the "id" field.
+func (scc *SSOConnectionCreate) SetID(s string) *SSOConnectionCreate {
+	scc.mutation.SetID(s)
+	return scc
+}
+
+// Mutation returns the SSOConnectionMutation object of the builder.
+func (scc *SSOConnectionCreate) Mutation() *SSOConnectionMutation {
+	return scc.mutation
+}
+
+// Save creates the SSOConnection in the database.
+func (scc *SSOConnectionCreate) Save(ctx context.Context) (*SSOConnection, error) {
+	scc.defaults()
+	return withHooks(ctx, scc.sqlSave, scc.mutation, scc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (scc *SSOConnectionCreate) SaveX(ctx context.Context) *SSOConnection {
+	v, err := scc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scc *SSOConnectionCreate) Exec(ctx context.Context) error {
+	_, err := scc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scc *SSOConnectionCreate) ExecX(ctx context.Context) {
+	if err := scc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scc *SSOConnectionCreate) defaults() {
+	if _, ok := scc.mutation.Status(); !ok {
+		v := ssoconnection.DefaultStatus
+		scc.mutation.SetStatus(v)
+	}
+	if _, ok := scc.mutation.CreatedAt(); !ok {
+		v := ssoconnection.DefaultCreatedAt()
+		scc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := scc.mutation.UpdatedAt(); !ok {
+		v := ssoconnection.DefaultUpdatedAt()
+		scc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := scc.mutation.Enabled(); !ok {
+		v := ssoconnection.DefaultEnabled
+		scc.mutation.SetEnabled(v)
+	}
+	if _, ok := scc.mutation.JitProvisioning(); !ok {
+		v := ssoconnection.DefaultJitProvisioning
+		scc.mutation.SetJitProvisioning(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scc *SSOConnectionCreate) check() error {
+	if _, ok := scc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "SSOConnection.tenant_id"`)}
+	}
+	if v, ok := scc.mutation.TenantID(); ok {
+		if err := ssoconnection.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "SSOConnection.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := scc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SSOConnection.status"`)}
+	}
+	if _, ok := scc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SSOConnection.created_at"`)}
+	}
+	if _, ok := scc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SSOConnection.updated_at"`)}
+	}
+	if _, ok := scc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SSOConnection.name"`)}
+	}
+	if v, ok := scc.mutation.Name(); ok {
+		if err := ssoconnection.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SSOConnection.name": %w`, err)}
+		}
+	}
+	if _, ok := scc.mutation.Protocol(); !ok {
+		return &ValidationError{Name: "protocol", err: errors.New(`ent: missing required field "SSOConnection.protocol"`)}
+	}
+	if v, ok := scc.mutation.Protocol(); ok {
+		if err := ssoconnection.ProtocolValidator(v); err != nil {
+			return &ValidationError{Name: "protocol", err: fmt.Errorf(`ent: validator failed for field "SSOConnection.protocol": %w`, err)}
+		}
+	}
+	if _, ok := scc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "SSOConnection.enabled"`)}
+	}
+	if _, ok := scc.mutation.JitProvisioning(); !ok {
+		return &ValidationError{Name: "jit_provisioning", err: errors.New(`ent: missing required field "SSOConnection.jit_provisioning"`)}
+	}
+	return nil
+}
+
+func (scc *SSOConnectionCreate) sqlSave(ctx context.Context) (*SSOConnection, error) {
+	if err := scc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := scc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, scc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SSOConnection.ID type: %T", _spec.ID.Value)
+		}
+	}
+	scc.mutation.id = &_node.ID
+	scc.mutation.done = true
+	return _node, nil
+}
+
+func (scc *SSOConnectionCreate) createSpec() (*SSOConnection, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SSOConnection{config: scc.config}
+		_spec = sqlgraph.NewCreateSpec(ssoconnection.Table, sqlgraph.NewFieldSpec(ssoconnection.FieldID, field.TypeString))
+	)
+	if id, ok := scc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := scc.mutation.TenantID(); ok {
+		_spec.SetField(ssoconnection.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := scc.mutation.Status(); ok {
+		_spec.SetField(ssoconnection.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := scc.mutation.CreatedAt(); ok {
+		_spec.SetField(ssoconnection.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := scc.mutation.UpdatedAt(); ok {
+		_spec.SetField(ssoconnection.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := scc.mutation.CreatedBy(); ok {
+		_spec.SetField(ssoconnection.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := scc.mutation.UpdatedBy(); ok {
+		_spec.SetField(ssoconnection.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := scc.mutation.Name(); ok {
+		_spec.SetField(ssoconnection.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := scc.mutation.Protocol(); ok {
+		_spec.SetField(ssoconnection.FieldProtocol, field.TypeString, value)
+		_node.Protocol = value
+	}
+	if value, ok := scc.mutation.Domains(); ok {
+		_spec.SetField(ssoconnection.FieldDomains, field.TypeJSON, value)
+		_node.Domains = value
+	}
+	if value, ok := scc.mutation.VerifiedDomains(); ok {
+		_spec.SetField(ssoconnection.FieldVerifiedDomains, field.TypeJSON, value)
+		_node.VerifiedDomains = value
+	}
+	if value, ok := scc.mutation.DomainVerificationToken(); ok {
+		_spec.SetField(ssoconnection.FieldDomainVerificationToken, field.TypeString, value)
+		_node.DomainVerificationToken = value
+	}
+	if value, ok := scc.mutation.Enabled(); ok {
+		_spec.SetField(ssoconnection.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := scc.mutation.IssuerURL(); ok {
+		_spec.SetField(ssoconnection.FieldIssuerURL, field.TypeString, value)
+		_node.IssuerURL = value
+	}
+	if value, ok := scc.mutation.ClientID(); ok {
+		_spec.SetField(ssoconnection.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := scc.mutation.ClientSecret(); ok {
+		_spec.SetField(ssoconnection.FieldClientSecret, field.TypeString, value)
+		_node.ClientSecret = value
+	}
+	if value, ok := scc.mutation.Scopes(); ok {
+		_spec.SetField(ssoconnection.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := scc.mutation.IdpMetadataURL(); ok {
+		_spec.SetField(ssoconnection.FieldIdpMetadataURL, field.TypeString, value)
+		_node.IdpMetadataURL = value
+	}
+	if value, ok := scc.mutation.IdpMetadataXML(); ok {
+		_spec.SetField(ssoconnection.FieldIdpMetadataXML, field.TypeString, value)
+		_node.IdpMetadataXML = value
+	}
+	if value, ok := scc.mutation.RoleClaim(); ok {
+		_spec.SetField(ssoconnection.FieldRoleClaim, field.TypeString, value)
+		_node.RoleClaim = value
+	}
+	if value, ok := scc.mutation.RoleMappings(); ok {
+		_spec.SetField(ssoconnection.FieldRoleMappings, field.TypeJSON, value)
+		_node.RoleMappings = value
+	}
+	if value, ok := scc.mutation.DefaultRoles(); ok {
+		_spec.SetField(ssoconnection.FieldDefaultRoles, field.TypeJSON, value)
+		_node.DefaultRoles = value
+	}
+	if value, ok := scc.mutation.JitProvisioning(); ok {
+		_spec.SetField(ssoconnection.FieldJitProvisioning, field.TypeBool, value)
+		_node.JitProvisioning = value
+	}
+	return _node, _spec
+}
+
+// SSOConnectionCreateBulk is the builder for creating many SSOConnection entities in bulk.
+type SSOConnectionCreateBulk struct {
+	config
+	err      error
+	builders []*SSOConnectionCreate
+}
+
+// Save creates the SSOConnection entities in the database.
+func (sccb *SSOConnectionCreateBulk) Save(ctx context.Context) ([]*SSOConnection, error) {
+	if sccb.err != nil {
+		return nil, sccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sccb.builders))
+	nodes := make([]*SSOConnection, len(sccb.builders))
+	mutators := make([]Mutator, len(sccb.builders))
+	for i := range sccb.builders {
+		func(i int, root context.Context) {
+			builder := sccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SSOConnectionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sccb *SSOConnectionCreateBulk) SaveX(ctx context.Context) []*SSOConnection {
+	v, err := sccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sccb *SSOConnectionCreateBulk) Exec(ctx context.Context) error {
+	_, err := sccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sccb *SSOConnectionCreateBulk) ExecX(ctx context.Context) {
+	if err := sccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1065 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/ssoconnection"
+)
+
+// SSOConnectionUpdate is the builder for updating SSOConnection entities.
+type SSOConnectionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SSOConnectionMutation
+}
+
+// Where appends a list predicates to the SSOConnectionUpdate builder.
+func (scu *SSOConnectionUpdate) Where(ps ...predicate.SSOConnection) *SSOConnectionUpdate {
+	scu.mutation.Where(ps...)
+	return scu
+}
+
+// SetStatus sets the "status" field.
+func (scu *SSOConnectionUpdate) SetStatus(s string) *SSOConnectionUpdate {
+	scu.mutation.SetStatus(s)
+	return scu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (scu *SSOConnectionUpdate) SetNillableStatus(s *string) *SSOConnectionUpdate {
+	if s != nil {
+		scu.SetStatus(*s)
+	}
+	return scu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (scu *SSOConnectionUpdate) SetUpdatedAt(t time.Time) *SSOConnectionUpdate {
+	scu.mutation.SetUpdatedAt(t)
+	return scu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (scu *SSOConnectionUpdate) SetUpdatedBy(s string) *SSOConnectionUpdate {
+	scu.mutation.SetUpdatedBy(s)
+	return scu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (scu *SSOConnectionUpdate) SetNillableUpdatedBy(s *string) *SSOConnectionUpdate {
+	if s != nil {
+		scu.SetUpdatedBy(*s)
+	}
+	return scu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (scu *SSOConnectionUpdate) ClearUpdatedBy() *SSOConnectionUpdate {
+	scu.mutation.ClearUpdatedBy()
+	return scu
+}
+
+// SetName sets the "name" field.
+func (scu *SSOConnectionUpdate) SetName(s string) *SSOConnectionUpdate {
+	scu.mutation.SetName(s)
+	return scu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (scu *SSOConnectionUpdate) SetNillableName(s *string) *SSOConnectionUpdate {
+	if s != nil {
+		scu.SetName(*s)
+	}
+	return scu
+}
+
+// SetDomains sets the "domains" field.
+func (scu *SSOConnectionUpdate) SetDomains(s []string) *SSOConnectionUpdate {
+	scu.mutation.SetDomains(s)
+	return scu
+}
+
+// AppendDomains appends s to the "domains" field.
+func (scu *SSOConnectionUpdate) AppendDomains(s []string) *SSOConnectionUpdate {
+	scu.mutation.AppendDomains(s)
+	return scu
+}
+
+// ClearDomains clears the value of the "domains" field.
+func (scu *SSOConnectionUpdate) ClearDomains() *SSOConnectionUpdate {
+	scu.mutation.ClearDomains()
+	return scu
+}
+
+// SetVerifiedDomains sets the "verified_domains" field.
+func (scu *SSOConnectionUpdate) SetVerifiedDomains(s []string) *SSOConnectionUpdate {
+	scu.mutation.SetVerifiedDomains(s)
+	return scu
+}
+
+// AppendVerifiedDomains appends s to the "verified_domains" field.
+func (scu *SSOConnectionUpdate) AppendVerifiedDomains(s []string) *SSOConnectionUpdate {
+	scu.mutation.AppendVerifiedDomains(s)
+	return scu
+}
+
+// ClearVerifiedDomains clears the value of the "verified_domains" field.
+func (scu *SSOConnectionUpdate) ClearVerifiedDomains() *SSOConnectionUpdate {
+	scu.mutation.ClearVerifiedDomains()
+	return scu
+}
+
+// SetDomainVerificationToken sets the "domain_verification_token" field.
+func (scu *SSOConnectionUpdate) SetDomainVerificationToken(s string) *SSOConnectionUpdate {
+	scu.mutation.SetDomainVerificationToken(s)
+	return scu
+}
+
+// SetNillableDomainVerificationToken sets the "domain_verification_token" field if the given value is not nil.
+func (scu *SSOConnectionUpdate) SetNillableDomainVerificationToken(s *string) *SSOConnectionUpdate {
+	if s != nil {
+		scu.SetDomainVerificationToken(*s)
+	}
+	return scu
+}
+
+// ClearDomainVerificationToken clears the value of the "domain_verification_token" field.
+func (scu *SSOConnectionUpdate) ClearDomainVerificationToken() *SSOConnectionUpdate {
+	scu.mutation.ClearDomainVerificationToken()
+	return scu
+}
+
+// SetEnabled sets the "enabled" field.
+func (scu *SSOConnectionUpdate) SetEnabled(b bool) *SSOConnectionUpdate {
+	scu.mutation.SetEnabled(b)
+	return scu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (scu *SSOConnectionUpdate) SetNillableEnabled(b *bool) *SSOConnectionUpdate {
+	if b != nil {
+		scu.SetEnabled(*b)
+	}
+	return scu
+}
+
+// SetIssuerURL sets the "issuer_url" field.
+func (scu *SSOConnectionUpdate) SetIssuerURL(s string) *SSOConnectionUpdate {
+	scu.mutation.SetIssuerURL(s)
+	return scu
+}
+
+// SetNillableIssuerURL sets the "issuer_url" field if the given value is not nil.
+func (scu *SSOConnectionUpdate) SetNillableIssuerURL(s *string) *SSOConnectionUpdate {
+	if s != nil {
+		scu.SetIssuerURL(*s)
+	}
+	return scu
+}
+
+// ClearIssuerURL clears the value of the "issuer_url" field.
+func (scu *SSOConnectionUpdate) ClearIssuerURL() *SSOConnectionUpdate {
+	scu.mutation.ClearIssuerURL()
+	return scu
+}
+
+// SetClientID sets the "client_id" field.
+func (scu *SSOConnectionUpdate) SetClientID(s string) *SSOConnectionUpdate {
+	scu.mutation.SetClientID(s)
+	return scu
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (scu *SSOConnectionUpdate) SetNillableClientID(s *string) *SSOConnectionUpdate {
+	if s != nil {
+		scu.SetClientID(*s)
+	}
+	return scu
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (scu *SSOConnectionUpdate) ClearClientID() *SSOConnectionUpdate {
+	scu.mutation.ClearClientID()
+	return scu
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (scu *SSOConnectionUpdate) SetClientSecret(s string) *SSOConnectionUpdate {
+	scu.mutation.SetClientSecret(s)
+	return scu
+}
+
+// SetNillableClientSecret sets the "client_secret" field if the given value is not nil.
+func (scu *SSOConnectionUpdate) SetNillableClientSecret(s *string) *SSOConnectionUpdate {
+	if s != nil {
+		scu.SetClientSecret(*s)
+	}
+	return scu
+}
+
+// ClearClientSecret clears the value of the "client_secret" field.
+func (scu *SSOConnectionUpdate) ClearClientSecret() *SSOConnectionUpdate {
+	scu.mutation.ClearClientSecret()
+	return scu
+}
+
+// SetScopes sets the "scopes" field.
+func (scu *SSOConnectionUpdate) SetScopes(s []string) *SSOConnectionUpdate {
+	scu.mutation.SetScopes(s)
+	return scu
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (scu *SSOConnectionUpdate) AppendScopes(s []string) *SSOConnectionUpdate {
+	scu.mutation.AppendScopes(s)
+	return scu
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (scu *SSOConnectionUpdate) ClearScopes() *SSOConnectionUpdate {
+	scu.mutation.ClearScopes()
+	return scu
+}
+
+// SetIdpMetadataURL sets the "idp_metadata_url" field.
+func (scu *SSOConnectionUpdate) SetIdpMetadataURL(s string) *SSOConnectionUpdate {
+	scu.mutation.SetIdpMetadataURL(s)
+	return scu
+}
+
+// SetNillableIdpMetadataURL sets the "idp_metadata_url" field if the given value is not nil.
+func (scu *SSOConnectionUpdate) SetNillableIdpMetadataURL(s *string) *SSOConnectionUpdate {
+	if s != nil {
+		scu.SetIdpMetadataURL(*s)
+	}
+	return scu
+}
+
+// ClearIdpMetadataURL clears the value of the "idp_metadata_url" field.
+func (scu *SSOConnectionUpdate) ClearIdpMetadataURL() *SSOConnectionUpdate {
+	scu.mutation.ClearIdpMetadataURL()
+	return scu
+}
+
+// SetIdpMetadataXML sets the "idp_metadata_xml" field.
+func (scu *SSOConnectionUpdate) SetIdpMetadataXML(s string) *SSOConnectionUpdate {
+	scu.mutation.SetIdpMetadataXML(s)
+	return scu
+}
+
+// SetNillableIdpMetadataXML sets the "idp_metadata_xml" field if the given value is not nil.
+func (scu *SSOConnectionUpdate) SetNillableIdpMetadataXML(s *string) *SSOConnectionUpdate {
+	if s != nil {
+		scu.SetIdpMetadataXML(*s)
+	}
+	return scu
+}
+
+// ClearIdpMetadataXML clears the value of the "idp_metadata_xml" field.
+func (scu *SSOConnectionUpdate) ClearIdpMetadataXML() *SSOConnectionUpdate {
+	scu.mutation.ClearIdpMetadataXML()
+	return scu
+}
+
+// SetRoleClaim sets the "role_claim" field.
+func (scu *SSOConnectionUpdate) SetRoleClaim(s string) *SSOConnectionUpdate {
+	scu.mutation.SetRoleClaim(s)
+	return scu
+}
+
+// SetNillableRoleClaim sets the "role_claim" field if the given value is not nil.
+func (scu *SSOConnectionUpdate) SetNillableRoleClaim(s *string) *SSOConnectionUpdate {
+	if s != nil {
+		scu.SetRoleClaim(*s)
+	}
+	return scu
+}
+
+// ClearRoleClaim clears the value of the "role_claim" field.
+func (scu *SSOConnectionUpdate) ClearRoleClaim() *SSOConnectionUpdate {
+	scu.mutation.ClearRoleClaim()
+	return scu
+}
+
+// SetRoleMappings sets the "role_mappings" field.
+func (scu *SSOConnectionUpdate) SetRoleMappings(m map[string][]string) *SSOConnectionUpdate {
+	scu.mutation.SetRoleMappings(m)
+	return scu
+}
+
+// ClearRoleMappings clears the value of the "role_mappings" field.
+func (scu *SSOConnectionUpdate) ClearRoleMappings() *SSOConnectionUpdate {
+	scu.mutation.ClearRoleMappings()
+	return scu
+}
+
+// SetDefaultRoles sets the "default_roles" field.
+func (scu *SSOConnectionUpdate) SetDefaultRoles(s []string) *SSOConnectionUpdate {
+	scu.mutation.SetDefaultRoles(s)
+	return scu
+}
+
+// AppendDefaultRoles appends s to the "default_roles" field.
+func (scu *SSOConnectionUpdate) AppendDefaultRoles(s []string) *SSOConnectionUpdate {
+	scu.mutation.AppendDefaultRoles(s)
+	return scu
+}
+
+// ClearDefaultRoles clears the value of the "default_roles" field.
+func (scu *SSOConnectionUpdate) ClearDefaultRoles() *SSOConnectionUpdate {
+	scu.mutation.ClearDefaultRoles()
+	return scu
+}
+
+// SetJitProvisioning sets the "jit_provisioning" field.
+func (scu *SSOConnectionUpdate) SetJitProvisioning(b bool) *SSOConnectionUpdate {
+	scu.mutation.SetJitProvisioning(b)
+	return scu
+}
+
+// SetNillableJitProvisioning sets the "jit_provisioning" field if the given value is not nil.
+func (scu *SSOConnectionUpdate) SetNillableJitProvisioning(b *bool) *SSOConnectionUpdate {
+	if b != nil {
+		scu.SetJitProvisioning(*b)
+	}
+	return scu
+}
+
+// Mutation returns the SSOConnectionMutation object of the builder.
+func (scu *SSOConnectionUpdate) Mutation() *SSOConnectionMutation {
+	return scu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (scu *SSOConnectionUpdate) Save(ctx context.Context) (int, error) {
+	scu.defaults()
+	return withHooks(ctx, scu.sqlSave, scu.mutation, scu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scu *SSOConnectionUpdate) SaveX(ctx context.Context) int {
+	affected, err := scu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (scu *SSOConnectionUpdate) Exec(ctx context.Context) error {
+	_, err := scu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scu *SSOConnectionUpdate) ExecX(ctx context.Context) {
+	if err := scu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scu *SSOConnectionUpdate) defaults() {
+	if _, ok := scu.mutation.UpdatedAt(); !ok {
+		v := ssoconnection.UpdateDefaultUpdatedAt()
+		scu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scu *SSOConnectionUpdate) check() error {
+	if v, ok := scu.mutation.Name(); ok {
+		if err := ssoconnection.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SSOConnection.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (scu *SSOConnectionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := scu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ssoconnection.Table, ssoconnection.Columns, sqlgraph.NewFieldSpec(ssoconnection.FieldID, field.TypeString))
+	if ps := scu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scu.mutation.Status(); ok {
+		_spec.SetField(ssoconnection.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := scu.mutation.UpdatedAt(); ok {
+		_spec.SetField(ssoconnection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if scu.mutation.CreatedByCleared() {
+		_spec.ClearField(ssoconnection.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := scu.mutation.UpdatedBy(); ok {
+		_spec.SetField(ssoconnection.FieldUpdatedBy, field.TypeString, value)
+	}
+	if scu.mutation.UpdatedByCleared() {
+		_spec.ClearField(ssoconnection.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := scu.mutation.Name(); ok {
+		_spec.SetField(ssoconnection.FieldName, field.TypeString, value)
+	}
+	if value, ok := scu.mutation.Domains(); ok {
+		_spec.SetField(ssoconnection.FieldDomains, field.TypeJSON, value)
+	}
+	if value, ok := scu.mutation.AppendedDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ssoconnection.FieldDomains, value)
+		})
+	}
+	if scu.mutation.DomainsCleared() {
+		_spec.ClearField(ssoconnection.FieldDomains, field.TypeJSON)
+	}
+	if value, ok := scu.mutation.VerifiedDomains(); ok {
+		_spec.SetField(ssoconnection.FieldVerifiedDomains, field.TypeJSON, value)
+	}
+	if value, ok := scu.mutation.AppendedVerifiedDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ssoconnection.FieldVerifiedDomains, value)
+		})
+	}
+	if scu.mutation.VerifiedDomainsCleared() {
+		_spec.ClearField(ssoconnection.FieldVerifiedDomains, field.TypeJSON)
+	}
+	if value, ok := scu.mutation.DomainVerificationToken(); ok {
+		_spec.SetField(ssoconnection.FieldDomainVerificationToken, field.TypeString, value)
+	}
+	if scu.mutation.DomainVerificationTokenCleared() {
+		_spec.ClearField(ssoconnection.FieldDomainVerificationToken, field.TypeString)
+	}
+	if value, ok := scu.mutation.Enabled(); ok {
+		_spec.SetField(ssoconnection.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := scu.mutation.IssuerURL(); ok {
+		_spec.SetField(ssoconnection.FieldIssuerURL, field.TypeString, value)
+	}
+	if scu.mutation.IssuerURLCleared() {
+		_spec.ClearField(ssoconnection.FieldIssuerURL, field.TypeString)
+	}
+	if value, ok := scu.mutation.ClientID(); ok {
+		_spec.SetField(ssoconnection.FieldClientID, field.TypeString, value)
+	}
+	if scu.mutation.ClientIDCleared() {
+		_spec.ClearField(ssoconnection.FieldClientID, field.TypeString)
+	}
+	if value, ok := scu.mutation.ClientSecret(); ok {
+		_spec.SetField(ssoconnection.FieldClientSecret, field.TypeString, value)
+	}
+	if scu.mutation.ClientSecretCleared() {
+		_spec.ClearField(ssoconnection.FieldClientSecret, field.TypeString)
+	}
+	if value, ok := scu.mutation.Scopes(); ok {
+		_spec.SetField(ssoconnection.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := scu.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ssoconnection.FieldScopes, value)
+		})
+	}
+	if scu.mutation.ScopesCleared() {
+		_spec.ClearField(ssoconnection.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := scu.mutation.IdpMetadataURL(); ok {
+		_spec.SetField(ssoconnection.FieldIdpMetadataURL, field.TypeString, value)
+	}
+	if scu.mutation.IdpMetadataURLCleared() {
+		_spec.ClearField(ssoconnection.FieldIdpMetadataURL, field.TypeString)
+	}
+	if value, ok := scu.mutation.IdpMetadataXML(); ok {
+		_spec.SetField(ssoconnection.FieldIdpMetadataXML, field.TypeString, value)
+	}
+	if scu.mutation.IdpMetadataXMLCleared() {
+		_spec.ClearField(ssoconnection.FieldIdpMetadataXML, field.TypeString)
+	}
+	if value, ok := scu.mutation.RoleClaim(); ok {
+		_spec.SetField(ssoconnection.FieldRoleClaim, field.TypeString, value)
+	}
+	if scu.mutation.RoleClaimCleared() {
+		_spec.ClearField(ssoconnection.FieldRoleClaim, field.TypeString)
+	}
+	if value, ok := scu.mutation.RoleMappings(); ok {
+		_spec.SetField(ssoconnection.FieldRoleMappings, field.TypeJSON, value)
+	}
+	if scu.mutation.RoleMappingsCleared() {
+		_spec.ClearField(ssoconnection.FieldRoleMappings, field.TypeJSON)
+	}
+	if value, ok := scu.mutation.DefaultRoles(); ok {
+		_spec.SetField(ssoconnection.FieldDefaultRoles, field.TypeJSON, value)
+	}
+	if value, ok := scu.mutation.AppendedDefaultRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ssoconnection.FieldDefaultRoles, value)
+		})
+	}
+	if scu.mutation.DefaultRolesCleared() {
+		_spec.ClearField(ssoconnection.FieldDefaultRoles, field.TypeJSON)
+	}
+	if value, ok := scu.mutation.JitProvisioning(); ok {
+		_spec.SetField(ssoconnection.FieldJitProvisioning, field.TypeBool, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, scu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ssoconnection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	scu.mutation.done = true
+	return n, nil
+}
+
+// SSOConnectionUpdateOne is the builder for updating a single SSOConnection entity.
+type SSOConnectionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SSOConnectionMutation
+}
+
+// SetStatus sets the "status" field.
+func (scuo *SSOConnectionUpdateOne) SetStatus(s string) *SSOConnectionUpdateOne {
+	scuo.mutation.SetStatus(s)
+	return scuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (scuo *SSOConnectionUpdateOne) SetNillableStatus(s *string) *SSOConnectionUpdateOne {
+	if s != nil {
+		scuo.SetStatus(*s)
+	}
+	return scuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (scuo *SSOConnectionUpdateOne) SetUpdatedAt(t time.Time) *SSOConnectionUpdateOne {
+	scuo.mutation.SetUpdatedAt(t)
+	return scuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (scuo *SSOConnectionUpdateOne) SetUpdatedBy(s string) *SSOConnectionUpdateOne {
+	scuo.mutation.SetUpdatedBy(s)
+	return scuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (scuo *SSOConnectionUpdateOne) SetNillableUpdatedBy(s *string) *SSOConnectionUpdateOne {
+	if s != nil {
+		scuo.SetUpdatedBy(*s)
+	}
+	return scuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (scuo *SSOConnectionUpdateOne) ClearUpdatedBy() *SSOConnectionUpdateOne {
+	scuo.mutation.ClearUpdatedBy()
+	return scuo
+}
+
+// SetName sets the "name" field.
+func (scuo *SSOConnectionUpdateOne) SetName(s string) *SSOConnectionUpdateOne {
+	scuo.mutation.SetName(s)
+	return scuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (scuo *SSOConnectionUpdateOne) SetNillableName(s *string) *SSOConnectionUpdateOne {
+	if s != nil {
+		scuo.SetName(*s)
+	}
+	return scuo
+}
+
+// SetDomains sets the "domains" field.
+func (scuo *SSOConnectionUpdateOne) SetDomains(s []string) *SSOConnectionUpdateOne {
+	scuo.mutation.SetDomains(s)
+	return scuo
+}
+
+// AppendDomains appends s to the "domains" field.
+func (scuo *SSOConnectionUpdateOne) AppendDomains(s []string) *SSOConnectionUpdateOne {
+	scuo.mutation.AppendDomains(s)
+	return scuo
+}
+
+// ClearDomains clears the value of the "domains" field.
+func (scuo *SSOConnectionUpdateOne) ClearDomains() *SSOConnectionUpdateOne {
+	scuo.mutation.ClearDomains()
+	return scuo
+}
+
+// SetVerifiedDomains sets the "verified_domains" field.
+func (scuo *SSOConnectionUpdateOne) SetVerifiedDomains(s []string) *SSOConnectionUpdateOne {
+	scuo.mutation.SetVerifiedDomains(s)
+	return scuo
+}
+
+// AppendVerifiedDomains appends s to the "verified_domains" field.
+func (scuo *SSOConnectionUpdateOne) AppendVerifiedDomains(s []string) *SSOConnectionUpdateOne {
+	scuo.mutation.AppendVerifiedDomains(s)
+	return scuo
+}
+
+// ClearVerifiedDomains clears the value of the "verified_domains" field.
+func (scuo *SSOConnectionUpdateOne) ClearVerifiedDomains() *SSOConnectionUpdateOne {
+	scuo.mutation.ClearVerifiedDomains()
+	return scuo
+}
+
+// SetDomainVerificationToken sets the "domain_verification_token" field.
+func (scuo *SSOConnectionUpdateOne) SetDomainVerificationToken(s string) *SSOConnectionUpdateOne {
+	scuo.mutation.SetDomainVerificationToken(s)
+	return scuo
+}
+
+// SetNillableDomainVerificationToken sets the "domain_verification_token" field if the given value is not nil.
+func (scuo *SSOConnectionUpdateOne) SetNillableDomainVerificationToken(s *string) *SSOConnectionUpdateOne {
+	if s != nil {
+		scuo.SetDomainVerificationToken(*s)
+	}
+	return scuo
+}
+
+// ClearDomainVerificationToken clears the value of the "domain_verification_token" field.
+func (scuo *SSOConnectionUpdateOne) ClearDomainVerificationToken() *SSOConnectionUpdateOne {
+	scuo.mutation.ClearDomainVerificationToken()
+	return scuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (scuo *SSOConnectionUpdateOne) SetEnabled(b bool) *SSOConnectionUpdateOne {
+	scuo.mutation.SetEnabled(b)
+	return scuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (scuo *SSOConnectionUpdateOne) SetNillableEnabled(b *bool) *SSOConnectionUpdateOne {
+	if b != nil {
+		scuo.SetEnabled(*b)
+	}
+	return scuo
+}
+
+// SetIssuerURL sets the "issuer_url" field.
+func (scuo *SSOConnectionUpdateOne) SetIssuerURL(s string) *SSOConnectionUpdateOne {
+	scuo.mutation.SetIssuerURL(s)
+	return scuo
+}
+
+// SetNillableIssuerURL sets the "issuer_url" field if the given value is not nil.
+func (scuo *SSOConnectionUpdateOne) SetNillableIssuerURL(s *string) *SSOConnectionUpdateOne {
+	if s != nil {
+		scuo.SetIssuerURL(*s)
+	}
+	return scuo
+}
+
+// ClearIssuerURL clears the value of the "issuer_url" field.
+func (scuo *SSOConnectionUpdateOne) ClearIssuerURL() *SSOConnectionUpdateOne {
+	scuo.mutation.ClearIssuerURL()
+	return scuo
+}
+
+// SetClientID sets the "client_id" field.
+func (scuo *SSOConnectionUpdateOne) SetClientID(s string) *SSOConnectionUpdateOne {
+	scuo.mutation.SetClientID(s)
+	return scuo
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (scuo *SSOConnectionUpdateOne) SetNillableClientID(s *string) *SSOConnectionUpdateOne {
+	if s != nil {
+		scuo.SetClientID(*s)
+	}
+	return scuo
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (scuo *SSOConnectionUpdateOne) ClearClientID() *SSOConnectionUpdateOne {
+	scuo.mutation.ClearClientID()
+	return scuo
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (scuo *SSOConnectionUpdateOne) SetClientSecret(s string) *SSOConnectionUpdateOne {
+	scuo.mutation.SetClientSecret(s)
+	return scuo
+}
+
+// SetNillableClientSecret sets the "client_secret" field if the given value is not nil.
+func (scuo *SSOConnectionUpdateOne) SetNillableClientSecret(s *string) *SSOConnectionUpdateOne {
+	if s != nil {
+		scuo.SetClientSecret(*s)
+	}
+	return scuo
+}
+
+// ClearClientSecret clears the value of the "client_secret" field.
+func (scuo *SSOConnectionUpdateOne) ClearClientSecret() *SSOConnectionUpdateOne {
+	scuo.mutation.ClearClientSecret()
+	return scuo
+}
+
+// SetScopes sets the "scopes" field.
+func (scuo *SSOConnectionUpdateOne) SetScopes(s []string) *SSOConnectionUpdateOne {
+	scuo.mutation.SetScopes(s)
+	return scuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (scuo *SSOConnectionUpdateOne) AppendScopes(s []string) *SSOConnectionUpdateOne {
+	scuo.mutation.AppendScopes(s)
+	return scuo
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (scuo *SSOConnectionUpdateOne) ClearScopes() *SSOConnectionUpdateOne {
+	scuo.mutation.ClearScopes()
+	return scuo
+}
+
+// SetIdpMetadataURL sets the "idp_metadata_url" field.
+func (scuo *SSOConnectionUpdateOne) SetIdpMetadataURL(s string) *SSOConnectionUpdateOne {
+	scuo.mutation.SetIdpMetadataURL(s)
+	return scuo
+}
+
+// SetNillableIdpMetadataURL sets the "idp_metadata_url" field if the given value is not nil.
+func (scuo *SSOConnectionUpdateOne) SetNillableIdpMetadataURL(s *string) *SSOConnectionUpdateOne {
+	if s != nil {
+		scuo.SetIdpMetadataURL(*s)
+	}
+	return scuo
+}
+
+// ClearIdpMetadataURL clears the value of the "idp_metadata_url" field.
+func (scuo *SSOConnectionUpdateOne) ClearIdpMetadataURL() *SSOConnectionUpdateOne {
+	scuo.mutation.ClearIdpMetadataURL()
+	return scuo
+}
+
+// SetIdpMetadataXML sets the "idp_metadata_xml" field.
+func (scuo *SSOConnectionUpdateOne) SetIdpMetadataXML(s string) *SSOConnectionUpdateOne {
+	scuo.mutation.SetIdpMetadataXML(s)
+	return scuo
+}
+
+// SetNillableIdpMetadataXML sets the "idp_metadata_xml" field if the given value is not nil.
+func (scuo *SSOConnectionUpdateOne) SetNillableIdpMetadataXML(s *string) *SSOConnectionUpdateOne {
+	if s != nil {
+		scuo.SetIdpMetadataXML(*s)
+	}
+	return scuo
+}
+
+// ClearIdpMetadataXML clears the value of the "idp_metadata_xml" field.
+func (scuo *SSOConnectionUpdateOne) ClearIdpMetadataXML() *SSOConnectionUpdateOne {
+	scuo.mutation.ClearIdpMetadataXML()
+	return scuo
+}
+
+// SetRoleClaim sets the "role_claim" field.
+func (scuo *SSOConnectionUpdateOne) SetRoleClaim(s string) *SSOConnectionUpdateOne {
+	scuo.mutation.SetRoleClaim(s)
+	return scuo
+}
+
+// SetNillableRoleClaim sets the "role_claim" field if the given value is not nil.
+func (scuo *SSOConnectionUpdateOne) SetNillableRoleClaim(s *string) *SSOConnectionUpdateOne {
+	if s != nil {
+		scuo.SetRoleClaim(*s)
+	}
+	return scuo
+}
+
+// ClearRoleClaim clears the value of the "role_claim" field.
+func (scuo *SSOConnectionUpdateOne) ClearRoleClaim() *SSOConnectionUpdateOne {
+	scuo.mutation.ClearRoleClaim()
+	return scuo
+}
+
+// SetRoleMappings sets the "role_mappings" field.
+func (scuo *SSOConnectionUpdateOne) SetRoleMappings(m map[string][]string) *SSOConnectionUpdateOne {
+	scuo.mutation.SetRoleMappings(m)
+	return scuo
+}
+
+// ClearRoleMappings clears the value of the "role_mappings" field.
+func (scuo *SSOConnectionUpdateOne) ClearRoleMappings() *SSOConnectionUpdateOne {
+	scuo.mutation.ClearRoleMappings()
+	return scuo
+}
+
+// SetDefaultRoles sets the "default_roles" field.
+func (scuo *SSOConnectionUpdateOne) SetDefaultRoles(s []string) *SSOConnectionUpdateOne {
+	scuo.mutation.SetDefaultRoles(s)
+	return scuo
+}
+
+// AppendDefaultRoles appends s to the "default_roles" field.
+func (scuo *SSOConnectionUpdateOne) AppendDefaultRoles(s []string) *SSOConnectionUpdateOne {
+	scuo.mutation.AppendDefaultRoles(s)
+	return scuo
+}
+
+// ClearDefaultRoles clears the value of the "default_roles" field.
+func (scuo *SSOConnectionUpdateOne) ClearDefaultRoles() *SSOConnectionUpdateOne {
+	scuo.mutation.ClearDefaultRoles()
+	return scuo
+}
+
+// SetJitProvisioning sets the "jit_provisioning" field.
+func (scuo *SSOConnectionUpdateOne) SetJitProvisioning(b bool) *SSOConnectionUpdateOne {
+	scuo.mutation.SetJitProvisioning(b)
+	return scuo
+}
+
+// SetNillableJitProvisioning sets the "jit_provisioning" field if the given value is not nil.
+func (scuo *SSOConnectionUpdateOne) SetNillableJitProvisioning(b *bool) *SSOConnectionUpdateOne {
+	if b != nil {
+		scuo.SetJitProvisioning(*b)
+	}
+	return scuo
+}
+
+// Mutation returns the SSOConnectionMutation object of the builder.
+func (scuo *SSOConnectionUpdateOne) Mutation() *SSOConnectionMutation {
+	return scuo.mutation
+}
+
+// Where appends a list predicates to the SSOConnectionUpdate builder.
+func (scuo *SSOConnectionUpdateOne) Where(ps ...predicate.SSOConnection) *SSOConnectionUpdateOne {
+	scuo.mutation.Where(ps...)
+	return scuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (scuo *SSOConnectionUpdateOne) Select(field string, fields ...string) *SSOConnectionUpdateOne {
+	scuo.fields = append([]string{field}, fields...)
+	return scuo
+}
+
+// Save executes the query and returns the updated SSOConnection entity.
+func (scuo *SSOConnectionUpdateOne) Save(ctx context.Context) (*SSOConnection, error) {
+	scuo.defaults()
+	return withHooks(ctx, scuo.sqlSave, scuo.mutation, scuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scuo *SSOConnectionUpdateOne) SaveX(ctx context.Context) *SSOConnection {
+	node, err := scuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (scuo *SSOConnectionUpdateOne) Exec(ctx context.Context) error {
+	_, err := scuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scuo *SSOConnectionUpdateOne) ExecX(ctx context.Context) {
+	if err := scuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scuo *SSOConnectionUpdateOne) defaults() {
+	if _, ok := scuo.mutation.UpdatedAt(); !ok {
+		v := ssoconnection.UpdateDefaultUpdatedAt()
+		scuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scuo *SSOConnectionUpdateOne) check() error {
+	if v, ok := scuo.mutation.Name(); ok {
+		if err := ssoconnection.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SSOConnection.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (scuo *SSOConnectionUpdateOne) sqlSave(ctx context.Context) (_node *SSOConnection, err error) {
+	if err := scuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ssoconnection.Table, ssoconnection.Columns, sqlgraph.NewFieldSpec(ssoconnection.FieldID, field.TypeString))
+	id, ok := scuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SSOConnection.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := scuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ssoconnection.FieldID)
+		for _, f := range fields {
+			if !ssoconnection.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ssoconnection.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := scuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scuo.mutation.Status(); ok {
+		_spec.SetField(ssoconnection.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := scuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(ssoconnection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if scuo.mutation.CreatedByCleared() {
+		_spec.ClearField(ssoconnection.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := scuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(ssoconnection.FieldUpdatedBy, field.TypeString, value)
+	}
+	if scuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(ssoconnection.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := scuo.mutation.Name(); ok {
+		_spec.SetField(ssoconnection.FieldName, field.TypeString, value)
+	}
+	if value, ok := scuo.mutation.Domains(); ok {
+		_spec.SetField(ssoconnection.FieldDomains, field.TypeJSON, value)
+	}
+	if value, ok := scuo.mutation.AppendedDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ssoconnection.FieldDomains, value)
+		})
+	}
+	if scuo.mutation.DomainsCleared() {
+		_spec.ClearField(ssoconnection.FieldDomains, field.TypeJSON)
+	}
+	if value, ok := scuo.mutation.VerifiedDomains(); ok {
+		_spec.SetField(ssoconnection.FieldVerifiedDomains, field.TypeJSON, value)
+	}
+	if value, ok := scuo.mutation.AppendedVerifiedDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ssoconnection.FieldVerifiedDomains, value)
+		})
+	}
+	if scuo.mutation.VerifiedDomainsCleared() {
+		_spec.ClearField(ssoconnection.FieldVerifiedDomains, field.TypeJSON)
+	}
+	if value, ok := scuo.mutation.DomainVerificationToken(); ok {
+		_spec.SetField(ssoconnection.FieldDomainVerificationToken, field.TypeString, value)
+	}
+	if scuo.mutation.DomainVerificationTokenCleared() {
+		_spec.ClearField(ssoconnection.FieldDomainVerificationToken, field.TypeString)
+	}
+	if value, ok := scuo.mutation.Enabled(); ok {
+		_spec.SetField(ssoconnection.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := scuo.mutation.IssuerURL(); ok {
+		_spec.SetField(ssoconnection.FieldIssuerURL, field.TypeString, value)
+	}
+	if scuo.mutation.IssuerURLCleared() {
+		_spec.ClearField(ssoconnection.FieldIssuerURL, field.TypeString)
+	}
+	if value, ok := scuo.mutation.ClientID(); ok {
+		_spec.SetField(ssoconnection.FieldClientID, field.TypeString, value)
+	}
+	if scuo.mutation.ClientIDCleared() {
+		_spec.ClearField(ssoconnection.FieldClientID, field.TypeString)
+	}
+	if value, ok := scuo.mutation.ClientSecret(); ok {
+		_spec.SetField(ssoconnection.FieldClientSecret, field.TypeString, value)
+	}
+	if scuo.mutation.ClientSecretCleared() {
+		_spec.ClearField(ssoconnection.FieldClientSecret, field.TypeString)
+	}
+	if value, ok := scuo.mutation.Scopes(); ok {
+		_spec.SetField(ssoconnection.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := scuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ssoconnection.FieldScopes, value)
+		})
+	}
+	if scuo.mutation.ScopesCleared() {
+		_spec.ClearField(ssoconnection.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := scuo.mutation.IdpMetadataURL(); ok {
+		_spec.SetField(ssoconnection.FieldIdpMetadataURL, field.TypeString, value)
+	}
+	if scuo.mutation.IdpMetadataURLCleared() {
+		_spec.ClearField(ssoconnection.FieldIdpMetadataURL, field.TypeString)
+	}
+	if value, ok := scuo.mutation.IdpMetadataXML(); ok {
+		_spec.SetField(ssoconnection.FieldIdpMetadataXML, field.TypeString, value)
+	}
+	if scuo.mutation.IdpMetadataXMLCleared() {
+		_spec.ClearField(ssoconnection.FieldIdpMetadataXML, field.TypeString)
+	}
+	if value, ok := scuo.mutation.RoleClaim(); ok {
+		_spec.SetField(ssoconnection.FieldRoleClaim, field.TypeString, value)
+	}
+	if scuo.mutation.RoleClaimCleared() {
+		_spec.ClearField(ssoconnection.FieldRoleClaim, field.TypeString)
+	}
+	if value, ok := scuo.mutation.RoleMappings(); ok {
+		_spec.SetField(ssoconnection.FieldRoleMappings, field.TypeJSON, value)
+	}
+	if scuo.mutation.RoleMappingsCleared() {
+		_spec.ClearField(ssoconnection.FieldRoleMappings, field.TypeJSON)
+	}
+	if value, ok := scuo.mutation.DefaultRoles(); ok {
+		_spec.SetField(ssoconnection.FieldDefaultRoles, field.TypeJSON, value)
+	}
+	if value, ok := scuo.mutation.AppendedDefaultRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ssoconnection.FieldDefaultRoles, value)
+		})
+	}
+	if scuo.mutation.DefaultRolesCleared() {
+		_spec.ClearField(ssoconnection.FieldDefaultRoles, field.TypeJSON)
+	}
+	if value, ok := scuo.mutation.JitProvisioning(); ok {
+		_spec.SetField(ssoconnection.FieldJitProvisioning, field.TypeBool, value)
+	}
+	_node = &SSOConnection{config: scuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, scuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ssoconnection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	scuo.mutation.done = true
+	return _node, nil
+}
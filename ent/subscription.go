@@ -107,6 +107,8 @@ type Subscription struct {
 	PaymentTerms *types.PaymentTerms `json:"payment_terms,omitempty"`
 	// Subscription type within a customer hierarchy (standalone, parent, inherited)
 	SubscriptionType types.SubscriptionType `json:"subscription_type,omitempty"`
+	// Seat licensing configuration; the seat count is the quantity of the configured fixed price
+	SeatConfig *types.SeatConfig `json:"seat_config,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SubscriptionQuery when eager-loading is set.
 	Edges        SubscriptionEdges `json:"edges"`
@@ -217,7 +219,7 @@ func (*Subscription) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case subscription.FieldCommitmentAmount, subscription.FieldOverageFactor:
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
-		case subscription.FieldMetadata:
+		case subscription.FieldMetadata, subscription.FieldSeatConfig:
 			values[i] = new([]byte)
 		case subscription.FieldCancelAtPeriodEnd, subscription.FieldEnableTrueUp:
 			values[i] = new(sql.NullBool)
@@ -520,6 +522,14 @@ func (s *Subscription) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.SubscriptionType = types.SubscriptionType(value.String)
 			}
+		case subscription.FieldSeatConfig:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field seat_config", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.SeatConfig); err != nil {
+					return fmt.Errorf("unmarshal field seat_config: %w", err)
+				}
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -748,6 +758,9 @@ func (s *Subscription) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("subscription_type=")
 	builder.WriteString(fmt.Sprintf("%v", s.SubscriptionType))
+	builder.WriteString(", ")
+	builder.WriteString("seat_config=")
+	builder.WriteString(fmt.Sprintf("%v", s.SeatConfig))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -102,6 +102,8 @@ const (
 	FieldPaymentTerms = "payment_terms"
 	// FieldSubscriptionType holds the string denoting the subscription_type field in the database.
 	FieldSubscriptionType = "subscription_type"
+	// FieldSeatConfig holds the string denoting the seat_config field in the database.
+	FieldSeatConfig = "seat_config"
 	// EdgeLineItems holds the string denoting the line_items edge name in mutations.
 	EdgeLineItems = "line_items"
 	// EdgePauses holds the string denoting the pauses edge name in mutations.
@@ -224,6 +226,7 @@ var Columns = []string{
 	FieldParentSubscriptionID,
 	FieldPaymentTerms,
 	FieldSubscriptionType,
+	FieldSeatConfig,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -2922,6 +2922,16 @@ func SubscriptionTypeContainsFold(v types.SubscriptionType) predicate.Subscripti
 	return predicate.Subscription(sql.FieldContainsFold(FieldSubscriptionType, vc))
 }
 
+// SeatConfigIsNil applies the IsNil predicate on the "seat_config" field.
+func SeatConfigIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldSeatConfig))
+}
+
+// SeatConfigNotNil applies the NotNil predicate on the "seat_config" field.
+func SeatConfigNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldSeatConfig))
+}
+
 // HasLineItems applies the HasEdge predicate on the "line_items" edge.
 func HasLineItems() predicate.Subscription {
 	return predicate.Subscription(func(s *sql.Selector) {
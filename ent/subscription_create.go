@@ -576,6 +576,12 @@ func (sc *SubscriptionCreate) SetNillableSubscriptionType(tt *types.Subscription
 	return sc
 }
 
+// SetSeatConfig sets the "seat_config" field.
+func (sc *SubscriptionCreate) SetSeatConfig(tc *types.SeatConfig) *SubscriptionCreate {
+	sc.mutation.SetSeatConfig(tc)
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *SubscriptionCreate) SetID(s string) *SubscriptionCreate {
 	sc.mutation.SetID(s)
@@ -965,6 +971,11 @@ func (sc *SubscriptionCreate) check() error {
 			return &ValidationError{Name: "subscription_type", err: fmt.Errorf(`ent: validator failed for field "Subscription.subscription_type": %w`, err)}
 		}
 	}
+	if v, ok := sc.mutation.SeatConfig(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "seat_config", err: fmt.Errorf(`ent: validator failed for field "Subscription.seat_config": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1168,6 +1179,10 @@ func (sc *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec)
 		_spec.SetField(subscription.FieldSubscriptionType, field.TypeString, value)
 		_node.SubscriptionType = value
 	}
+	if value, ok := sc.mutation.SeatConfig(); ok {
+		_spec.SetField(subscription.FieldSeatConfig, field.TypeJSON, value)
+		_node.SeatConfig = value
+	}
 	if nodes := sc.mutation.LineItemsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
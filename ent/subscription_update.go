@@ -559,6 +559,18 @@ func (su *SubscriptionUpdate) SetNillableSubscriptionType(tt *types.Subscription
 	return su
 }
 
+// SetSeatConfig sets the "seat_config" field.
+func (su *SubscriptionUpdate) SetSeatConfig(tc *types.SeatConfig) *SubscriptionUpdate {
+	su.mutation.SetSeatConfig(tc)
+	return su
+}
+
+// ClearSeatConfig clears the value of the "seat_config" field.
+func (su *SubscriptionUpdate) ClearSeatConfig() *SubscriptionUpdate {
+	su.mutation.ClearSeatConfig()
+	return su
+}
+
 // AddLineItemIDs adds the "line_items" edge to the SubscriptionLineItem entity by IDs.
 func (su *SubscriptionUpdate) AddLineItemIDs(ids ...string) *SubscriptionUpdate {
 	su.mutation.AddLineItemIDs(ids...)
@@ -1016,6 +1028,12 @@ func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.SubscriptionType(); ok {
 		_spec.SetField(subscription.FieldSubscriptionType, field.TypeString, value)
 	}
+	if value, ok := su.mutation.SeatConfig(); ok {
+		_spec.SetField(subscription.FieldSeatConfig, field.TypeJSON, value)
+	}
+	if su.mutation.SeatConfigCleared() {
+		_spec.ClearField(subscription.FieldSeatConfig, field.TypeJSON)
+	}
 	if su.mutation.LineItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1901,6 +1919,18 @@ func (suo *SubscriptionUpdateOne) SetNillableSubscriptionType(tt *types.Subscrip
 	return suo
 }
 
+// SetSeatConfig sets the "seat_config" field.
+func (suo *SubscriptionUpdateOne) SetSeatConfig(tc *types.SeatConfig) *SubscriptionUpdateOne {
+	suo.mutation.SetSeatConfig(tc)
+	return suo
+}
+
+// ClearSeatConfig clears the value of the "seat_config" field.
+func (suo *SubscriptionUpdateOne) ClearSeatConfig() *SubscriptionUpdateOne {
+	suo.mutation.ClearSeatConfig()
+	return suo
+}
+
 // AddLineItemIDs adds the "line_items" edge to the SubscriptionLineItem entity by IDs.
 func (suo *SubscriptionUpdateOne) AddLineItemIDs(ids ...string) *SubscriptionUpdateOne {
 	suo.mutation.AddLineItemIDs(ids...)
@@ -2388,6 +2418,12 @@ func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscript
 	if value, ok := suo.mutation.SubscriptionType(); ok {
 		_spec.SetField(subscription.FieldSubscriptionType, field.TypeString, value)
 	}
+	if value, ok := suo.mutation.SeatConfig(); ok {
+		_spec.SetField(subscription.FieldSeatConfig, field.TypeJSON, value)
+	}
+	if suo.mutation.SeatConfigCleared() {
+		_spec.ClearField(subscription.FieldSeatConfig, field.TypeJSON)
+	}
 	if suo.mutation.LineItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/subscriptionseatchange"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionSeatChange is the model entity for the SubscriptionSeatChange schema.
+type SubscriptionSeatChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// SubscriptionID holds the value of the "subscription_id" field.
+	SubscriptionID string `json:"subscription_id,omitempty"`
+	// Line item carrying the new seat count
+	LineItemID string `json:"line_item_id,omitempty"`
+	// PreviousSeats holds the value of the "previous_seats" field.
+	PreviousSeats int64 `json:"previous_seats,omitempty"`
+	// NewSeats holds the value of the "new_seats" field.
+	NewSeats int64 `json:"new_seats,omitempty"`
+	// Source holds the value of the "source" field.
+	Source types.SeatSource `json:"source,omitempty"`
+	// ProrationBehavior holds the value of the "proration_behavior" field.
+	ProrationBehavior types.SeatProrationBehavior `json:"proration_behavior,omitempty"`
+	// EffectiveDate holds the value of the "effective_date" field.
+	EffectiveDate time.Time `json:"effective_date,omitempty"`
+	// Prorated charge (positive) or credit (negative) for the rest of the period
+	ProrationAmount decimal.Decimal `json:"proration_amount,omitempty"`
+	// Invoice that billed the proration, immediately or at true-up
+	InvoiceID *string `json:"invoice_id,omitempty"`
+	// Wallet credit issued for an immediate seat decrease
+	WalletTransactionID *string `json:"wallet_transaction_id,omitempty"`
+	selectValues        sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SubscriptionSeatChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case subscriptionseatchange.FieldProrationAmount:
+			values[i] = new(decimal.Decimal)
+		case subscriptionseatchange.FieldPreviousSeats, subscriptionseatchange.FieldNewSeats:
+			values[i] = new(sql.NullInt64)
+		case subscriptionseatchange.FieldID, subscriptionseatchange.FieldTenantID, subscriptionseatchange.FieldStatus, subscriptionseatchange.FieldCreatedBy, subscriptionseatchange.FieldUpdatedBy, subscriptionseatchange.FieldEnvironmentID, subscriptionseatchange.FieldSubscriptionID, subscriptionseatchange.FieldLineItemID, subscriptionseatchange.FieldSource, subscriptionseatchange.FieldProrationBehavior, subscriptionseatchange.FieldInvoiceID, subscriptionseatchange.FieldWalletTransactionID:
+			values[i] = new(sql.NullString)
+		case subscriptionseatchange.FieldCreatedAt, subscriptionseatchange.FieldUpdatedAt, subscriptionseatchange.FieldEffectiveDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SubscriptionSeatChange fields.
+func (ssc *SubscriptionSeatChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case subscriptionseatchange.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ssc.ID = value.String
+			}
+		case subscriptionseatchange.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ssc.TenantID = value.String
+			}
+		case subscriptionseatchange.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ssc.Status = value.String
+			}
+		case subscriptionseatchange.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ssc.CreatedAt = value.Time
+			}
+		case subscriptionseatchange.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ssc.UpdatedAt = value.Time
+			}
+		case subscriptionseatchange.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				ssc.CreatedBy = value.String
+			}
+		case subscriptionseatchange.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				ssc.UpdatedBy = value.String
+			}
+		case subscriptionseatchange.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				ssc.EnvironmentID = value.String
+			}
+		case subscriptionseatchange.FieldSubscriptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value.Valid {
+				ssc.SubscriptionID = value.String
+			}
+		case subscriptionseatchange.FieldLineItemID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field line_item_id", values[i])
+			} else if value.Valid {
+				ssc.LineItemID = value.String
+			}
+		case subscriptionseatchange.FieldPreviousSeats:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_seats", values[i])
+			} else if value.Valid {
+				ssc.PreviousSeats = value.Int64
+			}
+		case subscriptionseatchange.FieldNewSeats:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field new_seats", values[i])
+			} else if value.Valid {
+				ssc.NewSeats = value.Int64
+			}
+		case subscriptionseatchange.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				ssc.Source = types.SeatSource(value.String)
+			}
+		case subscriptionseatchange.FieldProrationBehavior:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field proration_behavior", values[i])
+			} else if value.Valid {
+				ssc.ProrationBehavior = types.SeatProrationBehavior(value.String)
+			}
+		case subscriptionseatchange.FieldEffectiveDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_date", values[i])
+			} else if value.Valid {
+				ssc.EffectiveDate = value.Time
+			}
+		case subscriptionseatchange.FieldProrationAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field proration_amount", values[i])
+			} else if value != nil {
+				ssc.ProrationAmount = *value
+			}
+		case subscriptionseatchange.FieldInvoiceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value.Valid {
+				ssc.InvoiceID = new(string)
+				*ssc.InvoiceID = value.String
+			}
+		case subscriptionseatchange.FieldWalletTransactionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field wallet_transaction_id", values[i])
+			} else if value.Valid {
+				ssc.WalletTransactionID = new(string)
+				*ssc.WalletTransactionID = value.String
+			}
+		default:
+			ssc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SubscriptionSeatChange.
+// This includes values selected through modifiers, order, etc.
+func (ssc *SubscriptionSeatChange) Value(name string) (ent.Value, error) {
+	return ssc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SubscriptionSeatChange.
+// Note that you need to call SubscriptionSeatChange.Unwrap() before calling this method if this SubscriptionSeatChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ssc *SubscriptionSeatChange) Update() *SubscriptionSeatChangeUpdateOne {
+	return NewSubscriptionSeatChangeClient(ssc.config).UpdateOne(ssc)
+}
+
+// Unwrap unwraps the SubscriptionSeatChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ssc *SubscriptionSeatChange) Unwrap() *SubscriptionSeatChange {
+	_tx, ok := ssc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SubscriptionSeatChange is not a transactional entity")
+	}
+	ssc.config.driver = _tx.drv
+	return ssc
+}
+
+// String implements the fmt.Stringer.
+func (ssc *SubscriptionSeatChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("SubscriptionSeatChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ssc.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(ssc.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(ssc.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ssc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ssc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(ssc.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(ssc.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(ssc.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("subscription_id=")
+	builder.WriteString(ssc.SubscriptionID)
+	builder.WriteString(", ")
+	builder.WriteString("line_item_id=")
+	builder.WriteString(ssc.LineItemID)
+	builder.WriteString(", ")
+	builder.WriteString("previous_seats=")
+	builder.WriteString(fmt.Sprintf("%v", ssc.PreviousSeats))
+	builder.WriteString(", ")
+	builder.WriteString("new_seats=")
+	builder.WriteString(fmt.Sprintf("%v", ssc.NewSeats))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", ssc.Source))
+	builder.WriteString(", ")
+	builder.WriteString("proration_behavior=")
+	builder.WriteString(fmt.Sprintf("%v", ssc.ProrationBehavior))
+	builder.WriteString(", ")
+	builder.WriteString("effective_date=")
+	builder.WriteString(ssc.EffectiveDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("proration_amount=")
+	builder.WriteString(fmt.Sprintf("%v", ssc.ProrationAmount))
+	builder.WriteString(", ")
+	if v := ssc.InvoiceID; v != nil {
+		builder.WriteString("invoice_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ssc.WalletTransactionID; v != nil {
+		builder.WriteString("wallet_transaction_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SubscriptionSeatChanges is a parsable slice of SubscriptionSeatChange.
+type SubscriptionSeatChanges []*SubscriptionSeatChange
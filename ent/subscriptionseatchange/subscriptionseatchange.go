@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package subscriptionseatchange
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the subscriptionseatchange type in the database.
+	Label = "subscription_seat_change"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldEnvironmentID holds the string denoting the environment_id field in the database.
+	FieldEnvironmentID = "environment_id"
+	// FieldSubscriptionID holds the string denoting the subscription_id field in the database.
+	FieldSubscriptionID = "subscription_id"
+	// FieldLineItemID holds the string denoting the line_item_id field in the database.
+	FieldLineItemID = "line_item_id"
+	// FieldPreviousSeats holds the string denoting the previous_seats field in the database.
+	FieldPreviousSeats = "previous_seats"
+	// FieldNewSeats holds the string denoting the new_seats field in the database.
+	FieldNewSeats = "new_seats"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldProrationBehavior holds the string denoting the proration_behavior field in the database.
+	FieldProrationBehavior = "proration_behavior"
+	// FieldEffectiveDate holds the string denoting the effective_date field in the database.
+	FieldEffectiveDate = "effective_date"
+	// FieldProrationAmount holds the string denoting the proration_amount field in the database.
+	FieldProrationAmount = "proration_amount"
+	// FieldInvoiceID holds the string denoting the invoice_id field in the database.
+	FieldInvoiceID = "invoice_id"
+	// FieldWalletTransactionID holds the string denoting the wallet_transaction_id field in the database.
+	FieldWalletTransactionID = "wallet_transaction_id"
+	// Table holds the table name of the subscriptionseatchange in the database.
+	Table = "subscription_seat_changes"
+)
+
+// Columns holds all SQL columns for subscriptionseatchange fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldEnvironmentID,
+	FieldSubscriptionID,
+	FieldLineItemID,
+	FieldPreviousSeats,
+	FieldNewSeats,
+	FieldSource,
+	FieldProrationBehavior,
+	FieldEffectiveDate,
+	FieldProrationAmount,
+	FieldInvoiceID,
+	FieldWalletTransactionID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultEnvironmentID holds the default value on creation for the "environment_id" field.
+	DefaultEnvironmentID string
+	// SubscriptionIDValidator is a validator for the "subscription_id" field. It is called by the builders before save.
+	SubscriptionIDValidator func(string) error
+	// LineItemIDValidator is a validator for the "line_item_id" field. It is called by the builders before save.
+	LineItemIDValidator func(string) error
+	// DefaultProrationAmount holds the default value on creation for the "proration_amount" field.
+	DefaultProrationAmount decimal.Decimal
+)
+
+// OrderOption defines the ordering options for the SubscriptionSeatChange queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByEnvironmentID orders the results by the environment_id field.
+func ByEnvironmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvironmentID, opts...).ToFunc()
+}
+
+// BySubscriptionID orders the results by the subscription_id field.
+func BySubscriptionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubscriptionID, opts...).ToFunc()
+}
+
+// ByLineItemID orders the results by the line_item_id field.
+func ByLineItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLineItemID, opts...).ToFunc()
+}
+
+// ByPreviousSeats orders the results by the previous_seats field.
+func ByPreviousSeats(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousSeats, opts...).ToFunc()
+}
+
+// ByNewSeats orders the results by the new_seats field.
+func ByNewSeats(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewSeats, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByProrationBehavior orders the results by the proration_behavior field.
+func ByProrationBehavior(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProrationBehavior, opts...).ToFunc()
+}
+
+// ByEffectiveDate orders the results by the effective_date field.
+func ByEffectiveDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEffectiveDate, opts...).ToFunc()
+}
+
+// ByProrationAmount orders the results by the proration_amount field.
+func ByProrationAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProrationAmount, opts...).ToFunc()
+}
+
+// ByInvoiceID orders the results by the invoice_id field.
+func ByInvoiceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceID, opts...).ToFunc()
+}
+
+// ByWalletTransactionID orders the results by the wallet_transaction_id field.
+func ByWalletTransactionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWalletTransactionID, opts...).ToFunc()
+}
@@ -0,0 +1,1212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package subscriptionseatchange
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// LineItemID applies equality check predicate on the "line_item_id" field. It's identical to LineItemIDEQ.
+func LineItemID(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldLineItemID, v))
+}
+
+// PreviousSeats applies equality check predicate on the "previous_seats" field. It's identical to PreviousSeatsEQ.
+func PreviousSeats(v int64) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldPreviousSeats, v))
+}
+
+// NewSeats applies equality check predicate on the "new_seats" field. It's identical to NewSeatsEQ.
+func NewSeats(v int64) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldNewSeats, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v types.SeatSource) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldSource, vc))
+}
+
+// ProrationBehavior applies equality check predicate on the "proration_behavior" field. It's identical to ProrationBehaviorEQ.
+func ProrationBehavior(v types.SeatProrationBehavior) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldProrationBehavior, vc))
+}
+
+// EffectiveDate applies equality check predicate on the "effective_date" field. It's identical to EffectiveDateEQ.
+func EffectiveDate(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldEffectiveDate, v))
+}
+
+// ProrationAmount applies equality check predicate on the "proration_amount" field. It's identical to ProrationAmountEQ.
+func ProrationAmount(v decimal.Decimal) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldProrationAmount, v))
+}
+
+// InvoiceID applies equality check predicate on the "invoice_id" field. It's identical to InvoiceIDEQ.
+func InvoiceID(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// WalletTransactionID applies equality check predicate on the "wallet_transaction_id" field. It's identical to WalletTransactionIDEQ.
+func WalletTransactionID(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldWalletTransactionID, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContains(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldHasPrefix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldHasSuffix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIsNil applies the IsNil predicate on the "environment_id" field.
+func EnvironmentIDIsNil() predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIsNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDNotNil applies the NotNil predicate on the "environment_id" field.
+func EnvironmentIDNotNil() predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEqualFold(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContainsFold(FieldEnvironmentID, v))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDGT applies the GT predicate on the "subscription_id" field.
+func SubscriptionIDGT(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDGTE applies the GTE predicate on the "subscription_id" field.
+func SubscriptionIDGTE(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLT applies the LT predicate on the "subscription_id" field.
+func SubscriptionIDLT(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLTE applies the LTE predicate on the "subscription_id" field.
+func SubscriptionIDLTE(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContains applies the Contains predicate on the "subscription_id" field.
+func SubscriptionIDContains(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContains(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasPrefix applies the HasPrefix predicate on the "subscription_id" field.
+func SubscriptionIDHasPrefix(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldHasPrefix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasSuffix applies the HasSuffix predicate on the "subscription_id" field.
+func SubscriptionIDHasSuffix(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldHasSuffix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDEqualFold applies the EqualFold predicate on the "subscription_id" field.
+func SubscriptionIDEqualFold(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEqualFold(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContainsFold applies the ContainsFold predicate on the "subscription_id" field.
+func SubscriptionIDContainsFold(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContainsFold(FieldSubscriptionID, v))
+}
+
+// LineItemIDEQ applies the EQ predicate on the "line_item_id" field.
+func LineItemIDEQ(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldLineItemID, v))
+}
+
+// LineItemIDNEQ applies the NEQ predicate on the "line_item_id" field.
+func LineItemIDNEQ(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNEQ(FieldLineItemID, v))
+}
+
+// LineItemIDIn applies the In predicate on the "line_item_id" field.
+func LineItemIDIn(vs ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIn(FieldLineItemID, vs...))
+}
+
+// LineItemIDNotIn applies the NotIn predicate on the "line_item_id" field.
+func LineItemIDNotIn(vs ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotIn(FieldLineItemID, vs...))
+}
+
+// LineItemIDGT applies the GT predicate on the "line_item_id" field.
+func LineItemIDGT(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGT(FieldLineItemID, v))
+}
+
+// LineItemIDGTE applies the GTE predicate on the "line_item_id" field.
+func LineItemIDGTE(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGTE(FieldLineItemID, v))
+}
+
+// LineItemIDLT applies the LT predicate on the "line_item_id" field.
+func LineItemIDLT(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLT(FieldLineItemID, v))
+}
+
+// LineItemIDLTE applies the LTE predicate on the "line_item_id" field.
+func LineItemIDLTE(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLTE(FieldLineItemID, v))
+}
+
+// LineItemIDContains applies the Contains predicate on the "line_item_id" field.
+func LineItemIDContains(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContains(FieldLineItemID, v))
+}
+
+// LineItemIDHasPrefix applies the HasPrefix predicate on the "line_item_id" field.
+func LineItemIDHasPrefix(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldHasPrefix(FieldLineItemID, v))
+}
+
+// LineItemIDHasSuffix applies the HasSuffix predicate on the "line_item_id" field.
+func LineItemIDHasSuffix(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldHasSuffix(FieldLineItemID, v))
+}
+
+// LineItemIDEqualFold applies the EqualFold predicate on the "line_item_id" field.
+func LineItemIDEqualFold(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEqualFold(FieldLineItemID, v))
+}
+
+// LineItemIDContainsFold applies the ContainsFold predicate on the "line_item_id" field.
+func LineItemIDContainsFold(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContainsFold(FieldLineItemID, v))
+}
+
+// PreviousSeatsEQ applies the EQ predicate on the "previous_seats" field.
+func PreviousSeatsEQ(v int64) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldPreviousSeats, v))
+}
+
+// PreviousSeatsNEQ applies the NEQ predicate on the "previous_seats" field.
+func PreviousSeatsNEQ(v int64) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNEQ(FieldPreviousSeats, v))
+}
+
+// PreviousSeatsIn applies the In predicate on the "previous_seats" field.
+func PreviousSeatsIn(vs ...int64) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIn(FieldPreviousSeats, vs...))
+}
+
+// PreviousSeatsNotIn applies the NotIn predicate on the "previous_seats" field.
+func PreviousSeatsNotIn(vs ...int64) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotIn(FieldPreviousSeats, vs...))
+}
+
+// PreviousSeatsGT applies the GT predicate on the "previous_seats" field.
+func PreviousSeatsGT(v int64) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGT(FieldPreviousSeats, v))
+}
+
+// PreviousSeatsGTE applies the GTE predicate on the "previous_seats" field.
+func PreviousSeatsGTE(v int64) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGTE(FieldPreviousSeats, v))
+}
+
+// PreviousSeatsLT applies the LT predicate on the "previous_seats" field.
+func PreviousSeatsLT(v int64) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLT(FieldPreviousSeats, v))
+}
+
+// PreviousSeatsLTE applies the LTE predicate on the "previous_seats" field.
+func PreviousSeatsLTE(v int64) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLTE(FieldPreviousSeats, v))
+}
+
+// NewSeatsEQ applies the EQ predicate on the "new_seats" field.
+func NewSeatsEQ(v int64) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldNewSeats, v))
+}
+
+// NewSeatsNEQ applies the NEQ predicate on the "new_seats" field.
+func NewSeatsNEQ(v int64) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNEQ(FieldNewSeats, v))
+}
+
+// NewSeatsIn applies the In predicate on the "new_seats" field.
+func NewSeatsIn(vs ...int64) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIn(FieldNewSeats, vs...))
+}
+
+// NewSeatsNotIn applies the NotIn predicate on the "new_seats" field.
+func NewSeatsNotIn(vs ...int64) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotIn(FieldNewSeats, vs...))
+}
+
+// NewSeatsGT applies the GT predicate on the "new_seats" field.
+func NewSeatsGT(v int64) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGT(FieldNewSeats, v))
+}
+
+// NewSeatsGTE applies the GTE predicate on the "new_seats" field.
+func NewSeatsGTE(v int64) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGTE(FieldNewSeats, v))
+}
+
+// NewSeatsLT applies the LT predicate on the "new_seats" field.
+func NewSeatsLT(v int64) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLT(FieldNewSeats, v))
+}
+
+// NewSeatsLTE applies the LTE predicate on the "new_seats" field.
+func NewSeatsLTE(v int64) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLTE(FieldNewSeats, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v types.SeatSource) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldSource, vc))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v types.SeatSource) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldNEQ(FieldSource, vc))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...types.SeatSource) predicate.SubscriptionSeatChange {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SubscriptionSeatChange(sql.FieldIn(FieldSource, v...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...types.SeatSource) predicate.SubscriptionSeatChange {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SubscriptionSeatChange(sql.FieldNotIn(FieldSource, v...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v types.SeatSource) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldGT(FieldSource, vc))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v types.SeatSource) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldGTE(FieldSource, vc))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v types.SeatSource) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldLT(FieldSource, vc))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v types.SeatSource) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldLTE(FieldSource, vc))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v types.SeatSource) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldContains(FieldSource, vc))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v types.SeatSource) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldHasPrefix(FieldSource, vc))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v types.SeatSource) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldHasSuffix(FieldSource, vc))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v types.SeatSource) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldEqualFold(FieldSource, vc))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v types.SeatSource) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldContainsFold(FieldSource, vc))
+}
+
+// ProrationBehaviorEQ applies the EQ predicate on the "proration_behavior" field.
+func ProrationBehaviorEQ(v types.SeatProrationBehavior) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldProrationBehavior, vc))
+}
+
+// ProrationBehaviorNEQ applies the NEQ predicate on the "proration_behavior" field.
+func ProrationBehaviorNEQ(v types.SeatProrationBehavior) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldNEQ(FieldProrationBehavior, vc))
+}
+
+// ProrationBehaviorIn applies the In predicate on the "proration_behavior" field.
+func ProrationBehaviorIn(vs ...types.SeatProrationBehavior) predicate.SubscriptionSeatChange {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SubscriptionSeatChange(sql.FieldIn(FieldProrationBehavior, v...))
+}
+
+// ProrationBehaviorNotIn applies the NotIn predicate on the "proration_behavior" field.
+func ProrationBehaviorNotIn(vs ...types.SeatProrationBehavior) predicate.SubscriptionSeatChange {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SubscriptionSeatChange(sql.FieldNotIn(FieldProrationBehavior, v...))
+}
+
+// ProrationBehaviorGT applies the GT predicate on the "proration_behavior" field.
+func ProrationBehaviorGT(v types.SeatProrationBehavior) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldGT(FieldProrationBehavior, vc))
+}
+
+// ProrationBehaviorGTE applies the GTE predicate on the "proration_behavior" field.
+func ProrationBehaviorGTE(v types.SeatProrationBehavior) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldGTE(FieldProrationBehavior, vc))
+}
+
+// ProrationBehaviorLT applies the LT predicate on the "proration_behavior" field.
+func ProrationBehaviorLT(v types.SeatProrationBehavior) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldLT(FieldProrationBehavior, vc))
+}
+
+// ProrationBehaviorLTE applies the LTE predicate on the "proration_behavior" field.
+func ProrationBehaviorLTE(v types.SeatProrationBehavior) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldLTE(FieldProrationBehavior, vc))
+}
+
+// ProrationBehaviorContains applies the Contains predicate on the "proration_behavior" field.
+func ProrationBehaviorContains(v types.SeatProrationBehavior) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldContains(FieldProrationBehavior, vc))
+}
+
+// ProrationBehaviorHasPrefix applies the HasPrefix predicate on the "proration_behavior" field.
+func ProrationBehaviorHasPrefix(v types.SeatProrationBehavior) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldHasPrefix(FieldProrationBehavior, vc))
+}
+
+// ProrationBehaviorHasSuffix applies the HasSuffix predicate on the "proration_behavior" field.
+func ProrationBehaviorHasSuffix(v types.SeatProrationBehavior) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldHasSuffix(FieldProrationBehavior, vc))
+}
+
+// ProrationBehaviorEqualFold applies the EqualFold predicate on the "proration_behavior" field.
+func ProrationBehaviorEqualFold(v types.SeatProrationBehavior) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldEqualFold(FieldProrationBehavior, vc))
+}
+
+// ProrationBehaviorContainsFold applies the ContainsFold predicate on the "proration_behavior" field.
+func ProrationBehaviorContainsFold(v types.SeatProrationBehavior) predicate.SubscriptionSeatChange {
+	vc := string(v)
+	return predicate.SubscriptionSeatChange(sql.FieldContainsFold(FieldProrationBehavior, vc))
+}
+
+// EffectiveDateEQ applies the EQ predicate on the "effective_date" field.
+func EffectiveDateEQ(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldEffectiveDate, v))
+}
+
+// EffectiveDateNEQ applies the NEQ predicate on the "effective_date" field.
+func EffectiveDateNEQ(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNEQ(FieldEffectiveDate, v))
+}
+
+// EffectiveDateIn applies the In predicate on the "effective_date" field.
+func EffectiveDateIn(vs ...time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIn(FieldEffectiveDate, vs...))
+}
+
+// EffectiveDateNotIn applies the NotIn predicate on the "effective_date" field.
+func EffectiveDateNotIn(vs ...time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotIn(FieldEffectiveDate, vs...))
+}
+
+// EffectiveDateGT applies the GT predicate on the "effective_date" field.
+func EffectiveDateGT(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGT(FieldEffectiveDate, v))
+}
+
+// EffectiveDateGTE applies the GTE predicate on the "effective_date" field.
+func EffectiveDateGTE(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGTE(FieldEffectiveDate, v))
+}
+
+// EffectiveDateLT applies the LT predicate on the "effective_date" field.
+func EffectiveDateLT(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLT(FieldEffectiveDate, v))
+}
+
+// EffectiveDateLTE applies the LTE predicate on the "effective_date" field.
+func EffectiveDateLTE(v time.Time) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLTE(FieldEffectiveDate, v))
+}
+
+// ProrationAmountEQ applies the EQ predicate on the "proration_amount" field.
+func ProrationAmountEQ(v decimal.Decimal) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldProrationAmount, v))
+}
+
+// ProrationAmountNEQ applies the NEQ predicate on the "proration_amount" field.
+func ProrationAmountNEQ(v decimal.Decimal) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNEQ(FieldProrationAmount, v))
+}
+
+// ProrationAmountIn applies the In predicate on the "proration_amount" field.
+func ProrationAmountIn(vs ...decimal.Decimal) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIn(FieldProrationAmount, vs...))
+}
+
+// ProrationAmountNotIn applies the NotIn predicate on the "proration_amount" field.
+func ProrationAmountNotIn(vs ...decimal.Decimal) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotIn(FieldProrationAmount, vs...))
+}
+
+// ProrationAmountGT applies the GT predicate on the "proration_amount" field.
+func ProrationAmountGT(v decimal.Decimal) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGT(FieldProrationAmount, v))
+}
+
+// ProrationAmountGTE applies the GTE predicate on the "proration_amount" field.
+func ProrationAmountGTE(v decimal.Decimal) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGTE(FieldProrationAmount, v))
+}
+
+// ProrationAmountLT applies the LT predicate on the "proration_amount" field.
+func ProrationAmountLT(v decimal.Decimal) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLT(FieldProrationAmount, v))
+}
+
+// ProrationAmountLTE applies the LTE predicate on the "proration_amount" field.
+func ProrationAmountLTE(v decimal.Decimal) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLTE(FieldProrationAmount, v))
+}
+
+// InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
+func InvoiceIDEQ(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDNEQ applies the NEQ predicate on the "invoice_id" field.
+func InvoiceIDNEQ(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDIn applies the In predicate on the "invoice_id" field.
+func InvoiceIDIn(vs ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDNotIn applies the NotIn predicate on the "invoice_id" field.
+func InvoiceIDNotIn(vs ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDGT applies the GT predicate on the "invoice_id" field.
+func InvoiceIDGT(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGT(FieldInvoiceID, v))
+}
+
+// InvoiceIDGTE applies the GTE predicate on the "invoice_id" field.
+func InvoiceIDGTE(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGTE(FieldInvoiceID, v))
+}
+
+// InvoiceIDLT applies the LT predicate on the "invoice_id" field.
+func InvoiceIDLT(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLT(FieldInvoiceID, v))
+}
+
+// InvoiceIDLTE applies the LTE predicate on the "invoice_id" field.
+func InvoiceIDLTE(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLTE(FieldInvoiceID, v))
+}
+
+// InvoiceIDContains applies the Contains predicate on the "invoice_id" field.
+func InvoiceIDContains(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContains(FieldInvoiceID, v))
+}
+
+// InvoiceIDHasPrefix applies the HasPrefix predicate on the "invoice_id" field.
+func InvoiceIDHasPrefix(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldHasPrefix(FieldInvoiceID, v))
+}
+
+// InvoiceIDHasSuffix applies the HasSuffix predicate on the "invoice_id" field.
+func InvoiceIDHasSuffix(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldHasSuffix(FieldInvoiceID, v))
+}
+
+// InvoiceIDIsNil applies the IsNil predicate on the "invoice_id" field.
+func InvoiceIDIsNil() predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIsNull(FieldInvoiceID))
+}
+
+// InvoiceIDNotNil applies the NotNil predicate on the "invoice_id" field.
+func InvoiceIDNotNil() predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotNull(FieldInvoiceID))
+}
+
+// InvoiceIDEqualFold applies the EqualFold predicate on the "invoice_id" field.
+func InvoiceIDEqualFold(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEqualFold(FieldInvoiceID, v))
+}
+
+// InvoiceIDContainsFold applies the ContainsFold predicate on the "invoice_id" field.
+func InvoiceIDContainsFold(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContainsFold(FieldInvoiceID, v))
+}
+
+// WalletTransactionIDEQ applies the EQ predicate on the "wallet_transaction_id" field.
+func WalletTransactionIDEQ(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEQ(FieldWalletTransactionID, v))
+}
+
+// WalletTransactionIDNEQ applies the NEQ predicate on the "wallet_transaction_id" field.
+func WalletTransactionIDNEQ(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNEQ(FieldWalletTransactionID, v))
+}
+
+// WalletTransactionIDIn applies the In predicate on the "wallet_transaction_id" field.
+func WalletTransactionIDIn(vs ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIn(FieldWalletTransactionID, vs...))
+}
+
+// WalletTransactionIDNotIn applies the NotIn predicate on the "wallet_transaction_id" field.
+func WalletTransactionIDNotIn(vs ...string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotIn(FieldWalletTransactionID, vs...))
+}
+
+// WalletTransactionIDGT applies the GT predicate on the "wallet_transaction_id" field.
+func WalletTransactionIDGT(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGT(FieldWalletTransactionID, v))
+}
+
+// WalletTransactionIDGTE applies the GTE predicate on the "wallet_transaction_id" field.
+func WalletTransactionIDGTE(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldGTE(FieldWalletTransactionID, v))
+}
+
+// WalletTransactionIDLT applies the LT predicate on the "wallet_transaction_id" field.
+func WalletTransactionIDLT(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLT(FieldWalletTransactionID, v))
+}
+
+// WalletTransactionIDLTE applies the LTE predicate on the "wallet_transaction_id" field.
+func WalletTransactionIDLTE(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldLTE(FieldWalletTransactionID, v))
+}
+
+// WalletTransactionIDContains applies the Contains predicate on the "wallet_transaction_id" field.
+func WalletTransactionIDContains(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContains(FieldWalletTransactionID, v))
+}
+
+// WalletTransactionIDHasPrefix applies the HasPrefix predicate on the "wallet_transaction_id" field.
+func WalletTransactionIDHasPrefix(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldHasPrefix(FieldWalletTransactionID, v))
+}
+
+// WalletTransactionIDHasSuffix applies the HasSuffix predicate on the "wallet_transaction_id" field.
+func WalletTransactionIDHasSuffix(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldHasSuffix(FieldWalletTransactionID, v))
+}
+
+// WalletTransactionIDIsNil applies the IsNil predicate on the "wallet_transaction_id" field.
+func WalletTransactionIDIsNil() predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldIsNull(FieldWalletTransactionID))
+}
+
+// WalletTransactionIDNotNil applies the NotNil predicate on the "wallet_transaction_id" field.
+func WalletTransactionIDNotNil() predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldNotNull(FieldWalletTransactionID))
+}
+
+// WalletTransactionIDEqualFold applies the EqualFold predicate on the "wallet_transaction_id" field.
+func WalletTransactionIDEqualFold(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldEqualFold(FieldWalletTransactionID, v))
+}
+
+// WalletTransactionIDContainsFold applies the ContainsFold predicate on the "wallet_transaction_id" field.
+func WalletTransactionIDContainsFold(v string) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.FieldContainsFold(FieldWalletTransactionID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SubscriptionSeatChange) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SubscriptionSeatChange) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SubscriptionSeatChange) predicate.SubscriptionSeatChange {
+	return predicate.SubscriptionSeatChange(sql.NotPredicates(p))
+}
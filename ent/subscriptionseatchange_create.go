@@ -0,0 +1,513 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/subscriptionseatchange"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionSeatChangeCreate is the builder for creating a SubscriptionSeatChange entity.
+type SubscriptionSeatChangeCreate struct {
+	config
+	mutation *SubscriptionSeatChangeMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (sscc *SubscriptionSeatChangeCreate) SetTenantID(s string) *SubscriptionSeatChangeCreate {
+	sscc.mutation.SetTenantID(s)
+	return sscc
+}
+
+// SetStatus sets the "status" field.
+func (sscc *SubscriptionSeatChangeCreate) SetStatus(s string) *SubscriptionSeatChangeCreate {
+	sscc.mutation.SetStatus(s)
+	return sscc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sscc *SubscriptionSeatChangeCreate) SetNillableStatus(s *string) *SubscriptionSeatChangeCreate {
+	if s != nil {
+		sscc.SetStatus(*s)
+	}
+	return sscc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sscc *SubscriptionSeatChangeCreate) SetCreatedAt(t time.Time) *SubscriptionSeatChangeCreate {
+	sscc.mutation.SetCreatedAt(t)
+	return sscc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sscc *SubscriptionSeatChangeCreate) SetNillableCreatedAt(t *time.Time) *SubscriptionSeatChangeCreate {
+	if t != nil {
+		sscc.SetCreatedAt(*t)
+	}
+	return sscc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sscc *SubscriptionSeatChangeCreate) SetUpdatedAt(t time.Time) *SubscriptionSeatChangeCreate {
+	sscc.mutation.SetUpdatedAt(t)
+	return sscc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sscc *SubscriptionSeatChangeCreate) SetNillableUpdatedAt(t *time.Time) *SubscriptionSeatChangeCreate {
+	if t != nil {
+		sscc.SetUpdatedAt(*t)
+	}
+	return sscc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (sscc *SubscriptionSeatChangeCreate) SetCreatedBy(s string) *SubscriptionSeatChangeCreate {
+	sscc.mutation.SetCreatedBy(s)
+	return sscc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (sscc *SubscriptionSeatChangeCreate) SetNillableCreatedBy(s *string) *SubscriptionSeatChangeCreate {
+	if s != nil {
+		sscc.SetCreatedBy(*s)
+	}
+	return sscc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (sscc *SubscriptionSeatChangeCreate) SetUpdatedBy(s string) *SubscriptionSeatChangeCreate {
+	sscc.mutation.SetUpdatedBy(s)
+	return sscc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (sscc *SubscriptionSeatChangeCreate) SetNillableUpdatedBy(s *string) *SubscriptionSeatChangeCreate {
+	if s != nil {
+		sscc.SetUpdatedBy(*s)
+	}
+	return sscc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (sscc *SubscriptionSeatChangeCreate) SetEnvironmentID(s string) *SubscriptionSeatChangeCreate {
+	sscc.mutation.SetEnvironmentID(s)
+	return sscc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (sscc *SubscriptionSeatChangeCreate) SetNillableEnvironmentID(s *string) *SubscriptionSeatChangeCreate {
+	if s != nil {
+		sscc.SetEnvironmentID(*s)
+	}
+	return sscc
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (sscc *SubscriptionSeatChangeCreate) SetSubscriptionID(s string) *SubscriptionSeatChangeCreate {
+	sscc.mutation.SetSubscriptionID(s)
+	return sscc
+}
+
+// SetLineItemID sets the "line_item_id" field.
+func (sscc *SubscriptionSeatChangeCreate) SetLineItemID(s string) *SubscriptionSeatChangeCreate {
+	sscc.mutation.SetLineItemID(s)
+	return sscc
+}
+
+// SetPreviousSeats sets the "previous_seats" field.
+func (sscc *SubscriptionSeatChangeCreate) SetPreviousSeats(i int64) *SubscriptionSeatChangeCreate {
+	sscc.mutation.SetPreviousSeats(i)
+	return sscc
+}
+
+// SetNewSeats sets the "new_seats" field.
+func (sscc *SubscriptionSeatChangeCreate) SetNewSeats(i int64) *SubscriptionSeatChangeCreate {
+	sscc.mutation.SetNewSeats(i)
+	return sscc
+}
+
+// SetSource sets the "source" field.
+func (sscc *SubscriptionSeatChangeCreate) SetSource(ts types.SeatSource) *SubscriptionSeatChangeCreate {
+	sscc.mutation.SetSource(ts)
+	return sscc
+}
+
+// SetProrationBehavior sets the "proration_behavior" field.
+func (sscc *SubscriptionSeatChangeCreate) SetProrationBehavior(tpb types.SeatProrationBehavior) *SubscriptionSeatChangeCreate {
+	sscc.mutation.SetProrationBehavior(tpb)
+	return sscc
+}
+
+// SetEffectiveDate sets the "effective_date" field.
+func (sscc *SubscriptionSeatChangeCreate) SetEffectiveDate(t time.Time) *SubscriptionSeatChangeCreate {
+	sscc.mutation.SetEffectiveDate(t)
+	return sscc
+}
+
+// SetProrationAmount sets the "proration_amount" field.
+func (sscc *SubscriptionSeatChangeCreate) SetProrationAmount(d decimal.Decimal) *SubscriptionSeatChangeCreate {
+	sscc.mutation.SetProrationAmount(d)
+	return sscc
+}
+
+// SetNillableProrationAmount sets the "proration_amount" field if the given value is not nil.
+func (sscc *SubscriptionSeatChangeCreate) SetNillableProrationAmount(d *decimal.Decimal) *SubscriptionSeatChangeCreate {
+	if d != nil {
+		sscc.SetProrationAmount(*d)
+	}
+	return sscc
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (sscc *SubscriptionSeatChangeCreate) SetInvoiceID(s string) *SubscriptionSeatChangeCreate {
+	sscc.mutation.SetInvoiceID(s)
+	return sscc
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (sscc *SubscriptionSeatChangeCreate) SetNillableInvoiceID(s *string) *SubscriptionSeatChangeCreate {
+	if s != nil {
+		sscc.SetInvoiceID(*s)
+	}
+	return sscc
+}
+
+// SetWalletTransactionID sets the "wallet_transaction_id" field.
+func (sscc *SubscriptionSeatChangeCreate) SetWalletTransactionID(s string) *SubscriptionSeatChangeCreate {
+	sscc.mutation.SetWalletTransactionID(s)
+	return sscc
+}
+
+// SetNillableWalletTransactionID sets the "wallet_transaction_id" field if the given value is not nil.
+func (sscc *SubscriptionSeatChangeCreate) SetNillableWalletTransactionID(s *string) *SubscriptionSeatChangeCreate {
+	if s != nil {
+		sscc.SetWalletTransactionID(*s)
+	}
+	return sscc
+}
+
+// SetID sets the "id" field.
+func (sscc *SubscriptionSeatChangeCreate) SetID(s string) *SubscriptionSeatChangeCreate {
+	sscc.mutation.SetID(s)
+	return sscc
+}
+
+// Mutation returns the SubscriptionSeatChangeMutation object of the builder.
+func (sscc *SubscriptionSeatChangeCreate) Mutation() *SubscriptionSeatChangeMutation {
+	return sscc.mutation
+}
+
+// Save creates the SubscriptionSeatChange in the database.
+func (sscc *SubscriptionSeatChangeCreate) Save(ctx context.Context) (*SubscriptionSeatChange, error) {
+	sscc.defaults()
+	return withHooks(ctx, sscc.sqlSave, sscc.mutation, sscc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sscc *SubscriptionSeatChangeCreate) SaveX(ctx context.Context) *SubscriptionSeatChange {
+	v, err := sscc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sscc *SubscriptionSeatChangeCreate) Exec(ctx context.Context) error {
+	_, err := sscc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscc *SubscriptionSeatChangeCreate) ExecX(ctx context.Context) {
+	if err := sscc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sscc *SubscriptionSeatChangeCreate) defaults() {
+	if _, ok := sscc.mutation.Status(); !ok {
+		v := subscriptionseatchange.DefaultStatus
+		sscc.mutation.SetStatus(v)
+	}
+	if _, ok := sscc.mutation.CreatedAt(); !ok {
+		v := subscriptionseatchange.DefaultCreatedAt()
+		sscc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sscc.mutation.UpdatedAt(); !ok {
+		v := subscriptionseatchange.DefaultUpdatedAt()
+		sscc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sscc.mutation.EnvironmentID(); !ok {
+		v := subscriptionseatchange.DefaultEnvironmentID
+		sscc.mutation.SetEnvironmentID(v)
+	}
+	if _, ok := sscc.mutation.ProrationAmount(); !ok {
+		v := subscriptionseatchange.DefaultProrationAmount
+		sscc.mutation.SetProrationAmount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sscc *SubscriptionSeatChangeCreate) check() error {
+	if _, ok := sscc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "SubscriptionSeatChange.tenant_id"`)}
+	}
+	if v, ok := sscc.mutation.TenantID(); ok {
+		if err := subscriptionseatchange.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionSeatChange.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := sscc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SubscriptionSeatChange.status"`)}
+	}
+	if _, ok := sscc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SubscriptionSeatChange.created_at"`)}
+	}
+	if _, ok := sscc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SubscriptionSeatChange.updated_at"`)}
+	}
+	if _, ok := sscc.mutation.SubscriptionID(); !ok {
+		return &ValidationError{Name: "subscription_id", err: errors.New(`ent: missing required field "SubscriptionSeatChange.subscription_id"`)}
+	}
+	if v, ok := sscc.mutation.SubscriptionID(); ok {
+		if err := subscriptionseatchange.SubscriptionIDValidator(v); err != nil {
+			return &ValidationError{Name: "subscription_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionSeatChange.subscription_id": %w`, err)}
+		}
+	}
+	if _, ok := sscc.mutation.LineItemID(); !ok {
+		return &ValidationError{Name: "line_item_id", err: errors.New(`ent: missing required field "SubscriptionSeatChange.line_item_id"`)}
+	}
+	if v, ok := sscc.mutation.LineItemID(); ok {
+		if err := subscriptionseatchange.LineItemIDValidator(v); err != nil {
+			return &ValidationError{Name: "line_item_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionSeatChange.line_item_id": %w`, err)}
+		}
+	}
+	if _, ok := sscc.mutation.PreviousSeats(); !ok {
+		return &ValidationError{Name: "previous_seats", err: errors.New(`ent: missing required field "SubscriptionSeatChange.previous_seats"`)}
+	}
+	if _, ok := sscc.mutation.NewSeats(); !ok {
+		return &ValidationError{Name: "new_seats", err: errors.New(`ent: missing required field "SubscriptionSeatChange.new_seats"`)}
+	}
+	if _, ok := sscc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "SubscriptionSeatChange.source"`)}
+	}
+	if v, ok := sscc.mutation.Source(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "SubscriptionSeatChange.source": %w`, err)}
+		}
+	}
+	if _, ok := sscc.mutation.ProrationBehavior(); !ok {
+		return &ValidationError{Name: "proration_behavior", err: errors.New(`ent: missing required field "SubscriptionSeatChange.proration_behavior"`)}
+	}
+	if v, ok := sscc.mutation.ProrationBehavior(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "proration_behavior", err: fmt.Errorf(`ent: validator failed for field "SubscriptionSeatChange.proration_behavior": %w`, err)}
+		}
+	}
+	if _, ok := sscc.mutation.EffectiveDate(); !ok {
+		return &ValidationError{Name: "effective_date", err: errors.New(`ent: missing required field "SubscriptionSeatChange.effective_date"`)}
+	}
+	if _, ok := sscc.mutation.ProrationAmount(); !ok {
+		return &ValidationError{Name: "proration_amount", err: errors.New(`ent: missing required field "SubscriptionSeatChange.proration_amount"`)}
+	}
+	return nil
+}
+
+func (sscc *SubscriptionSeatChangeCreate) sqlSave(ctx context.Context) (*SubscriptionSeatChange, error) {
+	if err := sscc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sscc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sscc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SubscriptionSeatChange.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sscc.mutation.id = &_node.ID
+	sscc.mutation.done = true
+	return _node, nil
+}
+
+func (sscc *SubscriptionSeatChangeCreate) createSpec() (*SubscriptionSeatChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SubscriptionSeatChange{config: sscc.config}
+		_spec = sqlgraph.NewCreateSpec(subscriptionseatchange.Table, sqlgraph.NewFieldSpec(subscriptionseatchange.FieldID, field.TypeString))
+	)
+	if id, ok := sscc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sscc.mutation.TenantID(); ok {
+		_spec.SetField(subscriptionseatchange.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := sscc.mutation.Status(); ok {
+		_spec.SetField(subscriptionseatchange.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := sscc.mutation.CreatedAt(); ok {
+		_spec.SetField(subscriptionseatchange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sscc.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionseatchange.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sscc.mutation.CreatedBy(); ok {
+		_spec.SetField(subscriptionseatchange.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := sscc.mutation.UpdatedBy(); ok {
+		_spec.SetField(subscriptionseatchange.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := sscc.mutation.EnvironmentID(); ok {
+		_spec.SetField(subscriptionseatchange.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := sscc.mutation.SubscriptionID(); ok {
+		_spec.SetField(subscriptionseatchange.FieldSubscriptionID, field.TypeString, value)
+		_node.SubscriptionID = value
+	}
+	if value, ok := sscc.mutation.LineItemID(); ok {
+		_spec.SetField(subscriptionseatchange.FieldLineItemID, field.TypeString, value)
+		_node.LineItemID = value
+	}
+	if value, ok := sscc.mutation.PreviousSeats(); ok {
+		_spec.SetField(subscriptionseatchange.FieldPreviousSeats, field.TypeInt64, value)
+		_node.PreviousSeats = value
+	}
+	if value, ok := sscc.mutation.NewSeats(); ok {
+		_spec.SetField(subscriptionseatchange.FieldNewSeats, field.TypeInt64, value)
+		_node.NewSeats = value
+	}
+	if value, ok := sscc.mutation.Source(); ok {
+		_spec.SetField(subscriptionseatchange.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := sscc.mutation.ProrationBehavior(); ok {
+		_spec.SetField(subscriptionseatchange.FieldProrationBehavior, field.TypeString, value)
+		_node.ProrationBehavior = value
+	}
+	if value, ok := sscc.mutation.EffectiveDate(); ok {
+		_spec.SetField(subscriptionseatchange.FieldEffectiveDate, field.TypeTime, value)
+		_node.EffectiveDate = value
+	}
+	if value, ok := sscc.mutation.ProrationAmount(); ok {
+		_spec.SetField(subscriptionseatchange.FieldProrationAmount, field.TypeOther, value)
+		_node.ProrationAmount = value
+	}
+	if value, ok := sscc.mutation.InvoiceID(); ok {
+		_spec.SetField(subscriptionseatchange.FieldInvoiceID, field.TypeString, value)
+		_node.InvoiceID = &value
+	}
+	if value, ok := sscc.mutation.WalletTransactionID(); ok {
+		_spec.SetField(subscriptionseatchange.FieldWalletTransactionID, field.TypeString, value)
+		_node.WalletTransactionID = &value
+	}
+	return _node, _spec
+}
+
+// SubscriptionSeatChangeCreateBulk is the builder for creating many SubscriptionSeatChange entities in bulk.
+type SubscriptionSeatChangeCreateBulk struct {
+	config
+	err      error
+	builders []*SubscriptionSeatChangeCreate
+}
+
+// Save creates the SubscriptionSeatChange entities in the database.
+func (ssccb *SubscriptionSeatChangeCreateBulk) Save(ctx context.Context) ([]*SubscriptionSeatChange, error) {
+	if ssccb.err != nil {
+		return nil, ssccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ssccb.builders))
+	nodes := make([]*SubscriptionSeatChange, len(ssccb.builders))
+	mutators := make([]Mutator, len(ssccb.builders))
+	for i := range ssccb.builders {
+		func(i int, root context.Context) {
+			builder := ssccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionSeatChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ssccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ssccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ssccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssccb *SubscriptionSeatChangeCreateBulk) SaveX(ctx context.Context) []*SubscriptionSeatChange {
+	v, err := ssccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ssccb *SubscriptionSeatChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := ssccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssccb *SubscriptionSeatChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := ssccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/subscriptionseatchange"
+)
+
+// SubscriptionSeatChangeDelete is the builder for deleting a SubscriptionSeatChange entity.
+type SubscriptionSeatChangeDelete struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionSeatChangeMutation
+}
+
+// Where appends a list predicates to the SubscriptionSeatChangeDelete builder.
+func (sscd *SubscriptionSeatChangeDelete) Where(ps ...predicate.SubscriptionSeatChange) *SubscriptionSeatChangeDelete {
+	sscd.mutation.Where(ps...)
+	return sscd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sscd *SubscriptionSeatChangeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sscd.sqlExec, sscd.mutation, sscd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscd *SubscriptionSeatChangeDelete) ExecX(ctx context.Context) int {
+	n, err := sscd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sscd *SubscriptionSeatChangeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(subscriptionseatchange.Table, sqlgraph.NewFieldSpec(subscriptionseatchange.FieldID, field.TypeString))
+	if ps := sscd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sscd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sscd.mutation.done = true
+	return affected, err
+}
+
+// SubscriptionSeatChangeDeleteOne is the builder for deleting a single SubscriptionSeatChange entity.
+type SubscriptionSeatChangeDeleteOne struct {
+	sscd *SubscriptionSeatChangeDelete
+}
+
+// Where appends a list predicates to the SubscriptionSeatChangeDelete builder.
+func (sscdo *SubscriptionSeatChangeDeleteOne) Where(ps ...predicate.SubscriptionSeatChange) *SubscriptionSeatChangeDeleteOne {
+	sscdo.sscd.mutation.Where(ps...)
+	return sscdo
+}
+
+// Exec executes the deletion query.
+func (sscdo *SubscriptionSeatChangeDeleteOne) Exec(ctx context.Context) error {
+	n, err := sscdo.sscd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{subscriptionseatchange.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscdo *SubscriptionSeatChangeDeleteOne) ExecX(ctx context.Context) {
+	if err := sscdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
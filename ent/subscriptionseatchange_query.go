@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/subscriptionseatchange"
+)
+
+// SubscriptionSeatChangeQuery is the builder for querying SubscriptionSeatChange entities.
+type SubscriptionSeatChangeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []subscriptionseatchange.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SubscriptionSeatChange
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SubscriptionSeatChangeQuery builder.
+func (sscq *SubscriptionSeatChangeQuery) Where(ps ...predicate.SubscriptionSeatChange) *SubscriptionSeatChangeQuery {
+	sscq.predicates = append(sscq.predicates, ps...)
+	return sscq
+}
+
+// Limit the number of records to be returned by this query.
+func (sscq *SubscriptionSeatChangeQuery) Limit(limit int) *SubscriptionSeatChangeQuery {
+	sscq.ctx.Limit = &limit
+	return sscq
+}
+
+// Offset to start from.
+func (sscq *SubscriptionSeatChangeQuery) Offset(offset int) *SubscriptionSeatChangeQuery {
+	sscq.ctx.Offset = &offset
+	return sscq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (sscq *SubscriptionSeatChangeQuery) Unique(unique bool) *SubscriptionSeatChangeQuery {
+	sscq.ctx.Unique = &unique
+	return sscq
+}
+
+// Order specifies how the records should be ordered.
+func (sscq *SubscriptionSeatChangeQuery) Order(o ...subscriptionseatchange.OrderOption) *SubscriptionSeatChangeQuery {
+	sscq.order = append(sscq.order, o...)
+	return sscq
+}
+
+// First returns the first SubscriptionSeatChange entity from the query.
+// Returns a *NotFoundError when no SubscriptionSeatChange was found.
+func (sscq *SubscriptionSeatChangeQuery) First(ctx context.Context) (*SubscriptionSeatChange, error) {
+	nodes, err := sscq.Limit(1).All(setContextOp(ctx, sscq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{subscriptionseatchange.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sscq *SubscriptionSeatChangeQuery) FirstX(ctx context.Context) *SubscriptionSeatChange {
+	node, err := sscq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SubscriptionSeatChange ID from the query.
+// Returns a *NotFoundError when no SubscriptionSeatChange ID was found.
+func (sscq *SubscriptionSeatChangeQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = sscq.Limit(1).IDs(setContextOp(ctx, sscq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{subscriptionseatchange.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sscq *SubscriptionSeatChangeQuery) FirstIDX(ctx context.Context) string {
+	id, err := sscq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SubscriptionSeatChange entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SubscriptionSeatChange entity is found.
+// Returns a *NotFoundError when no SubscriptionSeatChange entities are found.
+func (sscq *SubscriptionSeatChangeQuery) Only(ctx context.Context) (*SubscriptionSeatChange, error) {
+	nodes, err := sscq.Limit(2).All(setContextOp(ctx, sscq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{subscriptionseatchange.Label}
+	default:
+		return nil, &NotSingularError{subscriptionseatchange.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sscq *SubscriptionSeatChangeQuery) OnlyX(ctx context.Context) *SubscriptionSeatChange {
+	node, err := sscq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SubscriptionSeatChange ID in the query.
+// Returns a *NotSingularError when more than one SubscriptionSeatChange ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (sscq *SubscriptionSeatChangeQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = sscq.Limit(2).IDs(setContextOp(ctx, sscq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{subscriptionseatchange.Label}
+	default:
+		err = &NotSingularError{subscriptionseatchange.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sscq *SubscriptionSeatChangeQuery) OnlyIDX(ctx context.Context) string {
+	id, err := sscq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SubscriptionSeatChanges.
+func (sscq *SubscriptionSeatChangeQuery) All(ctx context.Context) ([]*SubscriptionSeatChange, error) {
+	ctx = setContextOp(ctx, sscq.ctx, ent.OpQueryAll)
+	if err := sscq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SubscriptionSeatChange, *SubscriptionSeatChangeQuery]()
+	return withInterceptors[[]*SubscriptionSeatChange](ctx, sscq, qr, sscq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sscq *SubscriptionSeatChangeQuery) AllX(ctx context.Context) []*SubscriptionSeatChange {
+	nodes, err := sscq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SubscriptionSeatChange IDs.
+func (sscq *SubscriptionSeatChangeQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if sscq.ctx.Unique == nil && sscq.path != nil {
+		sscq.Unique(true)
+	}
+	ctx = setContextOp(ctx, sscq.ctx, ent.OpQueryIDs)
+	if err = sscq.Select(subscriptionseatchange.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sscq *SubscriptionSeatChangeQuery) IDsX(ctx context.Context) []string {
+	ids, err := sscq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sscq *SubscriptionSeatChangeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, sscq.ctx, ent.OpQueryCount)
+	if err := sscq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, sscq, querierCount[*SubscriptionSeatChangeQuery](), sscq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sscq *SubscriptionSeatChangeQuery) CountX(ctx context.Context) int {
+	count, err := sscq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sscq *SubscriptionSeatChangeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, sscq.ctx, ent.OpQueryExist)
+	switch _, err := sscq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sscq *SubscriptionSeatChangeQuery) ExistX(ctx context.Context) bool {
+	exist, err := sscq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SubscriptionSeatChangeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sscq *SubscriptionSeatChangeQuery) Clone() *SubscriptionSeatChangeQuery {
+	if sscq == nil {
+		return nil
+	}
+	return &SubscriptionSeatChangeQuery{
+		config:     sscq.config,
+		ctx:        sscq.ctx.Clone(),
+		order:      append([]subscriptionseatchange.OrderOption{}, sscq.order...),
+		inters:     append([]Interceptor{}, sscq.inters...),
+		predicates: append([]predicate.SubscriptionSeatChange{}, sscq.predicates...),
+		// clone intermediate query.
+		sql:  sscq.sql.Clone(),
+		path: sscq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SubscriptionSeatChange.Query().
+//		GroupBy(subscriptionseatchange.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (sscq *SubscriptionSeatChangeQuery) GroupBy(field string, fields ...string) *SubscriptionSeatChangeGroupBy {
+	sscq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SubscriptionSeatChangeGroupBy{build: sscq}
+	grbuild.flds = &sscq.ctx.Fields
+	grbuild.label = subscriptionseatchange.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.SubscriptionSeatChange.Query().
+//		Select(subscriptionseatchange.FieldTenantID).
+//		Scan(ctx, &v)
+func (sscq *SubscriptionSeatChangeQuery) Select(fields ...string) *SubscriptionSeatChangeSelect {
+	sscq.ctx.Fields = append(sscq.ctx.Fields, fields...)
+	sbuild := &SubscriptionSeatChangeSelect{SubscriptionSeatChangeQuery: sscq}
+	sbuild.label = subscriptionseatchange.Label
+	sbuild.flds, sbuild.scan = &sscq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SubscriptionSeatChangeSelect configured with the given aggregations.
+func (sscq *SubscriptionSeatChangeQuery) Aggregate(fns ...AggregateFunc) *SubscriptionSeatChangeSelect {
+	return sscq.Select().Aggregate(fns...)
+}
+
+func (sscq *SubscriptionSeatChangeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range sscq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sscq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range sscq.ctx.Fields {
+		if !subscriptionseatchange.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if sscq.path != nil {
+		prev, err := sscq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sscq.sql = prev
+	}
+	return nil
+}
+
+func (sscq *SubscriptionSeatChangeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SubscriptionSeatChange, error) {
+	var (
+		nodes = []*SubscriptionSeatChange{}
+		_spec = sscq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SubscriptionSeatChange).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SubscriptionSeatChange{config: sscq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, sscq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (sscq *SubscriptionSeatChangeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sscq.querySpec()
+	_spec.Node.Columns = sscq.ctx.Fields
+	if len(sscq.ctx.Fields) > 0 {
+		_spec.Unique = sscq.ctx.Unique != nil && *sscq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, sscq.driver, _spec)
+}
+
+func (sscq *SubscriptionSeatChangeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(subscriptionseatchange.Table, subscriptionseatchange.Columns, sqlgraph.NewFieldSpec(subscriptionseatchange.FieldID, field.TypeString))
+	_spec.From = sscq.sql
+	if unique := sscq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if sscq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := sscq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscriptionseatchange.FieldID)
+		for i := range fields {
+			if fields[i] != subscriptionseatchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := sscq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sscq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sscq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sscq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sscq *SubscriptionSeatChangeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sscq.driver.Dialect())
+	t1 := builder.Table(subscriptionseatchange.Table)
+	columns := sscq.ctx.Fields
+	if len(columns) == 0 {
+		columns = subscriptionseatchange.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if sscq.sql != nil {
+		selector = sscq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if sscq.ctx.Unique != nil && *sscq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range sscq.predicates {
+		p(selector)
+	}
+	for _, p := range sscq.order {
+		p(selector)
+	}
+	if offset := sscq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sscq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SubscriptionSeatChangeGroupBy is the group-by builder for SubscriptionSeatChange entities.
+type SubscriptionSeatChangeGroupBy struct {
+	selector
+	build *SubscriptionSeatChangeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sscgb *SubscriptionSeatChangeGroupBy) Aggregate(fns ...AggregateFunc) *SubscriptionSeatChangeGroupBy {
+	sscgb.fns = append(sscgb.fns, fns...)
+	return sscgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sscgb *SubscriptionSeatChangeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sscgb.build.ctx, ent.OpQueryGroupBy)
+	if err := sscgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SubscriptionSeatChangeQuery, *SubscriptionSeatChangeGroupBy](ctx, sscgb.build, sscgb, sscgb.build.inters, v)
+}
+
+func (sscgb *SubscriptionSeatChangeGroupBy) sqlScan(ctx context.Context, root *SubscriptionSeatChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sscgb.fns))
+	for _, fn := range sscgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sscgb.flds)+len(sscgb.fns))
+		for _, f := range *sscgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sscgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sscgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SubscriptionSeatChangeSelect is the builder for selecting fields of SubscriptionSeatChange entities.
+type SubscriptionSeatChangeSelect struct {
+	*SubscriptionSeatChangeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sscs *SubscriptionSeatChangeSelect) Aggregate(fns ...AggregateFunc) *SubscriptionSeatChangeSelect {
+	sscs.fns = append(sscs.fns, fns...)
+	return sscs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sscs *SubscriptionSeatChangeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sscs.ctx, ent.OpQuerySelect)
+	if err := sscs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SubscriptionSeatChangeQuery, *SubscriptionSeatChangeSelect](ctx, sscs.SubscriptionSeatChangeQuery, sscs, sscs.inters, v)
+}
+
+func (sscs *SubscriptionSeatChangeSelect) sqlScan(ctx context.Context, root *SubscriptionSeatChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sscs.fns))
+	for _, fn := range sscs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sscs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sscs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/subscriptionseatchange"
+)
+
+// SubscriptionSeatChangeUpdate is the builder for updating SubscriptionSeatChange entities.
+type SubscriptionSeatChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionSeatChangeMutation
+}
+
+// Where appends a list predicates to the SubscriptionSeatChangeUpdate builder.
+func (sscu *SubscriptionSeatChangeUpdate) Where(ps ...predicate.SubscriptionSeatChange) *SubscriptionSeatChangeUpdate {
+	sscu.mutation.Where(ps...)
+	return sscu
+}
+
+// SetStatus sets the "status" field.
+func (sscu *SubscriptionSeatChangeUpdate) SetStatus(s string) *SubscriptionSeatChangeUpdate {
+	sscu.mutation.SetStatus(s)
+	return sscu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sscu *SubscriptionSeatChangeUpdate) SetNillableStatus(s *string) *SubscriptionSeatChangeUpdate {
+	if s != nil {
+		sscu.SetStatus(*s)
+	}
+	return sscu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sscu *SubscriptionSeatChangeUpdate) SetUpdatedAt(t time.Time) *SubscriptionSeatChangeUpdate {
+	sscu.mutation.SetUpdatedAt(t)
+	return sscu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (sscu *SubscriptionSeatChangeUpdate) SetUpdatedBy(s string) *SubscriptionSeatChangeUpdate {
+	sscu.mutation.SetUpdatedBy(s)
+	return sscu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (sscu *SubscriptionSeatChangeUpdate) SetNillableUpdatedBy(s *string) *SubscriptionSeatChangeUpdate {
+	if s != nil {
+		sscu.SetUpdatedBy(*s)
+	}
+	return sscu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (sscu *SubscriptionSeatChangeUpdate) ClearUpdatedBy() *SubscriptionSeatChangeUpdate {
+	sscu.mutation.ClearUpdatedBy()
+	return sscu
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (sscu *SubscriptionSeatChangeUpdate) SetInvoiceID(s string) *SubscriptionSeatChangeUpdate {
+	sscu.mutation.SetInvoiceID(s)
+	return sscu
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (sscu *SubscriptionSeatChangeUpdate) SetNillableInvoiceID(s *string) *SubscriptionSeatChangeUpdate {
+	if s != nil {
+		sscu.SetInvoiceID(*s)
+	}
+	return sscu
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (sscu *SubscriptionSeatChangeUpdate) ClearInvoiceID() *SubscriptionSeatChangeUpdate {
+	sscu.mutation.ClearInvoiceID()
+	return sscu
+}
+
+// SetWalletTransactionID sets the "wallet_transaction_id" field.
+func (sscu *SubscriptionSeatChangeUpdate) SetWalletTransactionID(s string) *SubscriptionSeatChangeUpdate {
+	sscu.mutation.SetWalletTransactionID(s)
+	return sscu
+}
+
+// SetNillableWalletTransactionID sets the "wallet_transaction_id" field if the given value is not nil.
+func (sscu *SubscriptionSeatChangeUpdate) SetNillableWalletTransactionID(s *string) *SubscriptionSeatChangeUpdate {
+	if s != nil {
+		sscu.SetWalletTransactionID(*s)
+	}
+	return sscu
+}
+
+// ClearWalletTransactionID clears the value of the "wallet_transaction_id" field.
+func (sscu *SubscriptionSeatChangeUpdate) ClearWalletTransactionID() *SubscriptionSeatChangeUpdate {
+	sscu.mutation.ClearWalletTransactionID()
+	return sscu
+}
+
+// Mutation returns the SubscriptionSeatChangeMutation object of the builder.
+func (sscu *SubscriptionSeatChangeUpdate) Mutation() *SubscriptionSeatChangeMutation {
+	return sscu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sscu *SubscriptionSeatChangeUpdate) Save(ctx context.Context) (int, error) {
+	sscu.defaults()
+	return withHooks(ctx, sscu.sqlSave, sscu.mutation, sscu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sscu *SubscriptionSeatChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := sscu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sscu *SubscriptionSeatChangeUpdate) Exec(ctx context.Context) error {
+	_, err := sscu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscu *SubscriptionSeatChangeUpdate) ExecX(ctx context.Context) {
+	if err := sscu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sscu *SubscriptionSeatChangeUpdate) defaults() {
+	if _, ok := sscu.mutation.UpdatedAt(); !ok {
+		v := subscriptionseatchange.UpdateDefaultUpdatedAt()
+		sscu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (sscu *SubscriptionSeatChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(subscriptionseatchange.Table, subscriptionseatchange.Columns, sqlgraph.NewFieldSpec(subscriptionseatchange.FieldID, field.TypeString))
+	if ps := sscu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sscu.mutation.Status(); ok {
+		_spec.SetField(subscriptionseatchange.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := sscu.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionseatchange.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sscu.mutation.CreatedByCleared() {
+		_spec.ClearField(subscriptionseatchange.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := sscu.mutation.UpdatedBy(); ok {
+		_spec.SetField(subscriptionseatchange.FieldUpdatedBy, field.TypeString, value)
+	}
+	if sscu.mutation.UpdatedByCleared() {
+		_spec.ClearField(subscriptionseatchange.FieldUpdatedBy, field.TypeString)
+	}
+	if sscu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(subscriptionseatchange.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := sscu.mutation.InvoiceID(); ok {
+		_spec.SetField(subscriptionseatchange.FieldInvoiceID, field.TypeString, value)
+	}
+	if sscu.mutation.InvoiceIDCleared() {
+		_spec.ClearField(subscriptionseatchange.FieldInvoiceID, field.TypeString)
+	}
+	if value, ok := sscu.mutation.WalletTransactionID(); ok {
+		_spec.SetField(subscriptionseatchange.FieldWalletTransactionID, field.TypeString, value)
+	}
+	if sscu.mutation.WalletTransactionIDCleared() {
+		_spec.ClearField(subscriptionseatchange.FieldWalletTransactionID, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sscu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionseatchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sscu.mutation.done = true
+	return n, nil
+}
+
+// SubscriptionSeatChangeUpdateOne is the builder for updating a single SubscriptionSeatChange entity.
+type SubscriptionSeatChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubscriptionSeatChangeMutation
+}
+
+// SetStatus sets the "status" field.
+func (sscuo *SubscriptionSeatChangeUpdateOne) SetStatus(s string) *SubscriptionSeatChangeUpdateOne {
+	sscuo.mutation.SetStatus(s)
+	return sscuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sscuo *SubscriptionSeatChangeUpdateOne) SetNillableStatus(s *string) *SubscriptionSeatChangeUpdateOne {
+	if s != nil {
+		sscuo.SetStatus(*s)
+	}
+	return sscuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sscuo *SubscriptionSeatChangeUpdateOne) SetUpdatedAt(t time.Time) *SubscriptionSeatChangeUpdateOne {
+	sscuo.mutation.SetUpdatedAt(t)
+	return sscuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (sscuo *SubscriptionSeatChangeUpdateOne) SetUpdatedBy(s string) *SubscriptionSeatChangeUpdateOne {
+	sscuo.mutation.SetUpdatedBy(s)
+	return sscuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (sscuo *SubscriptionSeatChangeUpdateOne) SetNillableUpdatedBy(s *string) *SubscriptionSeatChangeUpdateOne {
+	if s != nil {
+		sscuo.SetUpdatedBy(*s)
+	}
+	return sscuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (sscuo *SubscriptionSeatChangeUpdateOne) ClearUpdatedBy() *SubscriptionSeatChangeUpdateOne {
+	sscuo.mutation.ClearUpdatedBy()
+	return sscuo
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (sscuo *SubscriptionSeatChangeUpdateOne) SetInvoiceID(s string) *SubscriptionSeatChangeUpdateOne {
+	sscuo.mutation.SetInvoiceID(s)
+	return sscuo
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (sscuo *SubscriptionSeatChangeUpdateOne) SetNillableInvoiceID(s *string) *SubscriptionSeatChangeUpdateOne {
+	if s != nil {
+		sscuo.SetInvoiceID(*s)
+	}
+	return sscuo
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (sscuo *SubscriptionSeatChangeUpdateOne) ClearInvoiceID() *SubscriptionSeatChangeUpdateOne {
+	sscuo.mutation.ClearInvoiceID()
+	return sscuo
+}
+
+// SetWalletTransactionID sets the "wallet_transaction_id" field.
+func (sscuo *SubscriptionSeatChangeUpdateOne) SetWalletTransactionID(s string) *SubscriptionSeatChangeUpdateOne {
+	sscuo.mutation.SetWalletTransactionID(s)
+	return sscuo
+}
+
+// SetNillableWalletTransactionID sets the "wallet_transaction_id" field if the given value is not nil.
+func (sscuo *SubscriptionSeatChangeUpdateOne) SetNillableWalletTransactionID(s *string) *SubscriptionSeatChangeUpdateOne {
+	if s != nil {
+		sscuo.SetWalletTransactionID(*s)
+	}
+	return sscuo
+}
+
+// ClearWalletTransactionID clears the value of the "wallet_transaction_id" field.
+func (sscuo *SubscriptionSeatChangeUpdateOne) ClearWalletTransactionID() *SubscriptionSeatChangeUpdateOne {
+	sscuo.mutation.ClearWalletTransactionID()
+	return sscuo
+}
+
+// Mutation returns the SubscriptionSeatChangeMutation object of the builder.
+func (sscuo *SubscriptionSeatChangeUpdateOne) Mutation() *SubscriptionSeatChangeMutation {
+	return sscuo.mutation
+}
+
+// Where appends a list predicates to the SubscriptionSeatChangeUpdate builder.
+func (sscuo *SubscriptionSeatChangeUpdateOne) Where(ps ...predicate.SubscriptionSeatChange) *SubscriptionSeatChangeUpdateOne {
+	sscuo.mutation.Where(ps...)
+	return sscuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sscuo *SubscriptionSeatChangeUpdateOne) Select(field string, fields ...string) *SubscriptionSeatChangeUpdateOne {
+	sscuo.fields = append([]string{field}, fields...)
+	return sscuo
+}
+
+// Save executes the query and returns the updated SubscriptionSeatChange entity.
+func (sscuo *SubscriptionSeatChangeUpdateOne) Save(ctx context.Context) (*SubscriptionSeatChange, error) {
+	sscuo.defaults()
+	return withHooks(ctx, sscuo.sqlSave, sscuo.mutation, sscuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sscuo *SubscriptionSeatChangeUpdateOne) SaveX(ctx context.Context) *SubscriptionSeatChange {
+	node, err := sscuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sscuo *SubscriptionSeatChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := sscuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscuo *SubscriptionSeatChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := sscuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sscuo *SubscriptionSeatChangeUpdateOne) defaults() {
+	if _, ok := sscuo.mutation.UpdatedAt(); !ok {
+		v := subscriptionseatchange.UpdateDefaultUpdatedAt()
+		sscuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (sscuo *SubscriptionSeatChangeUpdateOne) sqlSave(ctx context.Context) (_node *SubscriptionSeatChange, err error) {
+	_spec := sqlgraph.NewUpdateSpec(subscriptionseatchange.Table, subscriptionseatchange.Columns, sqlgraph.NewFieldSpec(subscriptionseatchange.FieldID, field.TypeString))
+	id, ok := sscuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SubscriptionSeatChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sscuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscriptionseatchange.FieldID)
+		for _, f := range fields {
+			if !subscriptionseatchange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != subscriptionseatchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sscuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sscuo.mutation.Status(); ok {
+		_spec.SetField(subscriptionseatchange.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := sscuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionseatchange.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sscuo.mutation.CreatedByCleared() {
+		_spec.ClearField(subscriptionseatchange.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := sscuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(subscriptionseatchange.FieldUpdatedBy, field.TypeString, value)
+	}
+	if sscuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(subscriptionseatchange.FieldUpdatedBy, field.TypeString)
+	}
+	if sscuo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(subscriptionseatchange.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := sscuo.mutation.InvoiceID(); ok {
+		_spec.SetField(subscriptionseatchange.FieldInvoiceID, field.TypeString, value)
+	}
+	if sscuo.mutation.InvoiceIDCleared() {
+		_spec.ClearField(subscriptionseatchange.FieldInvoiceID, field.TypeString)
+	}
+	if value, ok := sscuo.mutation.WalletTransactionID(); ok {
+		_spec.SetField(subscriptionseatchange.FieldWalletTransactionID, field.TypeString, value)
+	}
+	if sscuo.mutation.WalletTransactionIDCleared() {
+		_spec.ClearField(subscriptionseatchange.FieldWalletTransactionID, field.TypeString)
+	}
+	_node = &SubscriptionSeatChange{config: sscuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sscuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionseatchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sscuo.mutation.done = true
+	return _node, nil
+}
@@ -44,6 +44,10 @@ type SystemEvent struct {
 	PublishedAt *time.Time `json:"published_at,omitempty"`
 	// Payload holds the value of the "payload" field.
 	Payload map[string]interface{} `json:"payload,omitempty"`
+	// Position of the event among the events of its entity, starting at 1
+	SequenceNumber *int64 `json:"sequence_number,omitempty"`
+	// Webhook payload built when the event was first delivered, served to thin payload receivers
+	Snapshot map[string]interface{} `json:"snapshot,omitempty"`
 	// FailureCount holds the value of the "failure_count" field.
 	FailureCount int `json:"failure_count,omitempty"`
 	// FailureReason holds the value of the "failure_reason" field.
@@ -56,9 +60,9 @@ func (*SystemEvent) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case systemevent.FieldPayload:
+		case systemevent.FieldPayload, systemevent.FieldSnapshot:
 			values[i] = new([]byte)
-		case systemevent.FieldFailureCount:
+		case systemevent.FieldSequenceNumber, systemevent.FieldFailureCount:
 			values[i] = new(sql.NullInt64)
 		case systemevent.FieldID, systemevent.FieldTenantID, systemevent.FieldStatus, systemevent.FieldCreatedBy, systemevent.FieldUpdatedBy, systemevent.FieldEnvironmentID, systemevent.FieldEventName, systemevent.FieldEntityType, systemevent.FieldEntityID, systemevent.FieldWebhookMessageID, systemevent.FieldFailureReason:
 			values[i] = new(sql.NullString)
@@ -167,6 +171,21 @@ func (se *SystemEvent) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field payload: %w", err)
 				}
 			}
+		case systemevent.FieldSequenceNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sequence_number", values[i])
+			} else if value.Valid {
+				se.SequenceNumber = new(int64)
+				*se.SequenceNumber = value.Int64
+			}
+		case systemevent.FieldSnapshot:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &se.Snapshot); err != nil {
+					return fmt.Errorf("unmarshal field snapshot: %w", err)
+				}
+			}
 		case systemevent.FieldFailureCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field failure_count", values[i])
@@ -259,6 +278,14 @@ func (se *SystemEvent) String() string {
 	builder.WriteString("payload=")
 	builder.WriteString(fmt.Sprintf("%v", se.Payload))
 	builder.WriteString(", ")
+	if v := se.SequenceNumber; v != nil {
+		builder.WriteString("sequence_number=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("snapshot=")
+	builder.WriteString(fmt.Sprintf("%v", se.Snapshot))
+	builder.WriteString(", ")
 	builder.WriteString("failure_count=")
 	builder.WriteString(fmt.Sprintf("%v", se.FailureCount))
 	builder.WriteString(", ")
@@ -39,6 +39,10 @@ const (
 	FieldPublishedAt = "published_at"
 	// FieldPayload holds the string denoting the payload field in the database.
 	FieldPayload = "payload"
+	// FieldSequenceNumber holds the string denoting the sequence_number field in the database.
+	FieldSequenceNumber = "sequence_number"
+	// FieldSnapshot holds the string denoting the snapshot field in the database.
+	FieldSnapshot = "snapshot"
 	// FieldFailureCount holds the string denoting the failure_count field in the database.
 	FieldFailureCount = "failure_count"
 	// FieldFailureReason holds the string denoting the failure_reason field in the database.
@@ -63,6 +67,8 @@ var Columns = []string{
 	FieldWebhookMessageID,
 	FieldPublishedAt,
 	FieldPayload,
+	FieldSequenceNumber,
+	FieldSnapshot,
 	FieldFailureCount,
 	FieldFailureReason,
 }
@@ -168,6 +174,11 @@ func ByPublishedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPublishedAt, opts...).ToFunc()
 }
 
+// BySequenceNumber orders the results by the sequence_number field.
+func BySequenceNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSequenceNumber, opts...).ToFunc()
+}
+
 // ByFailureCount orders the results by the failure_count field.
 func ByFailureCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFailureCount, opts...).ToFunc()
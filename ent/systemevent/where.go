@@ -124,6 +124,11 @@ func PublishedAt(v time.Time) predicate.SystemEvent {
 	return predicate.SystemEvent(sql.FieldEQ(FieldPublishedAt, v))
 }
 
+// SequenceNumber applies equality check predicate on the "sequence_number" field. It's identical to SequenceNumberEQ.
+func SequenceNumber(v int64) predicate.SystemEvent {
+	return predicate.SystemEvent(sql.FieldEQ(FieldSequenceNumber, v))
+}
+
 // FailureCount applies equality check predicate on the "failure_count" field. It's identical to FailureCountEQ.
 func FailureCount(v int) predicate.SystemEvent {
 	return predicate.SystemEvent(sql.FieldEQ(FieldFailureCount, v))
@@ -929,6 +934,66 @@ func PayloadNotNil() predicate.SystemEvent {
 	return predicate.SystemEvent(sql.FieldNotNull(FieldPayload))
 }
 
+// SequenceNumberEQ applies the EQ predicate on the "sequence_number" field.
+func SequenceNumberEQ(v int64) predicate.SystemEvent {
+	return predicate.SystemEvent(sql.FieldEQ(FieldSequenceNumber, v))
+}
+
+// SequenceNumberNEQ applies the NEQ predicate on the "sequence_number" field.
+func SequenceNumberNEQ(v int64) predicate.SystemEvent {
+	return predicate.SystemEvent(sql.FieldNEQ(FieldSequenceNumber, v))
+}
+
+// SequenceNumberIn applies the In predicate on the "sequence_number" field.
+func SequenceNumberIn(vs ...int64) predicate.SystemEvent {
+	return predicate.SystemEvent(sql.FieldIn(FieldSequenceNumber, vs...))
+}
+
+// SequenceNumberNotIn applies the NotIn predicate on the "sequence_number" field.
+func SequenceNumberNotIn(vs ...int64) predicate.SystemEvent {
+	return predicate.SystemEvent(sql.FieldNotIn(FieldSequenceNumber, vs...))
+}
+
+// SequenceNumberGT applies the GT predicate on the "sequence_number" field.
+func SequenceNumberGT(v int64) predicate.SystemEvent {
+	return predicate.SystemEvent(sql.FieldGT(FieldSequenceNumber, v))
+}
+
+// SequenceNumberGTE applies the GTE predicate on the "sequence_number" field.
+func SequenceNumberGTE(v int64) predicate.SystemEvent {
+	return predicate.SystemEvent(sql.FieldGTE(FieldSequenceNumber, v))
+}
+
+// SequenceNumberLT applies the LT predicate on the "sequence_number" field.
+func SequenceNumberLT(v int64) predicate.SystemEvent {
+	return predicate.SystemEvent(sql.FieldLT(FieldSequenceNumber, v))
+}
+
+// SequenceNumberLTE applies the LTE predicate on the "sequence_number" field.
+func SequenceNumberLTE(v int64) predicate.SystemEvent {
+	return predicate.SystemEvent(sql.FieldLTE(FieldSequenceNumber, v))
+}
+
+// SequenceNumberIsNil applies the IsNil predicate on the "sequence_number" field.
+func SequenceNumberIsNil() predicate.SystemEvent {
+	return predicate.SystemEvent(sql.FieldIsNull(FieldSequenceNumber))
+}
+
+// SequenceNumberNotNil applies the NotNil predicate on the "sequence_number" field.
+func SequenceNumberNotNil() predicate.SystemEvent {
+	return predicate.SystemEvent(sql.FieldNotNull(FieldSequenceNumber))
+}
+
+// SnapshotIsNil applies the IsNil predicate on the "snapshot" field.
+func SnapshotIsNil() predicate.SystemEvent {
+	return predicate.SystemEvent(sql.FieldIsNull(FieldSnapshot))
+}
+
+// SnapshotNotNil applies the NotNil predicate on the "snapshot" field.
+func SnapshotNotNil() predicate.SystemEvent {
+	return predicate.SystemEvent(sql.FieldNotNull(FieldSnapshot))
+}
+
 // FailureCountEQ applies the EQ predicate on the "failure_count" field.
 func FailureCountEQ(v int) predicate.SystemEvent {
 	return predicate.SystemEvent(sql.FieldEQ(FieldFailureCount, v))
@@ -186,6 +186,26 @@ func (sec *SystemEventCreate) SetPayload(m map[string]interface{}) *SystemEventC
 	return sec
 }
 
+// SetSequenceNumber sets the "sequence_number" field.
+func (sec *SystemEventCreate) SetSequenceNumber(i int64) *SystemEventCreate {
+	sec.mutation.SetSequenceNumber(i)
+	return sec
+}
+
+// SetNillableSequenceNumber sets the "sequence_number" field if the given value is not nil.
+func (sec *SystemEventCreate) SetNillableSequenceNumber(i *int64) *SystemEventCreate {
+	if i != nil {
+		sec.SetSequenceNumber(*i)
+	}
+	return sec
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (sec *SystemEventCreate) SetSnapshot(m map[string]interface{}) *SystemEventCreate {
+	sec.mutation.SetSnapshot(m)
+	return sec
+}
+
 // SetFailureCount sets the "failure_count" field.
 func (sec *SystemEventCreate) SetFailureCount(i int) *SystemEventCreate {
 	sec.mutation.SetFailureCount(i)
@@ -398,6 +418,14 @@ func (sec *SystemEventCreate) createSpec() (*SystemEvent, *sqlgraph.CreateSpec)
 		_spec.SetField(systemevent.FieldPayload, field.TypeJSON, value)
 		_node.Payload = value
 	}
+	if value, ok := sec.mutation.SequenceNumber(); ok {
+		_spec.SetField(systemevent.FieldSequenceNumber, field.TypeInt64, value)
+		_node.SequenceNumber = &value
+	}
+	if value, ok := sec.mutation.Snapshot(); ok {
+		_spec.SetField(systemevent.FieldSnapshot, field.TypeJSON, value)
+		_node.Snapshot = value
+	}
 	if value, ok := sec.mutation.FailureCount(); ok {
 		_spec.SetField(systemevent.FieldFailureCount, field.TypeInt, value)
 		_node.FailureCount = value
@@ -180,6 +180,18 @@ func (seu *SystemEventUpdate) ClearPayload() *SystemEventUpdate {
 	return seu
 }
 
+// SetSnapshot sets the "snapshot" field.
+func (seu *SystemEventUpdate) SetSnapshot(m map[string]interface{}) *SystemEventUpdate {
+	seu.mutation.SetSnapshot(m)
+	return seu
+}
+
+// ClearSnapshot clears the value of the "snapshot" field.
+func (seu *SystemEventUpdate) ClearSnapshot() *SystemEventUpdate {
+	seu.mutation.ClearSnapshot()
+	return seu
+}
+
 // SetFailureCount sets the "failure_count" field.
 func (seu *SystemEventUpdate) SetFailureCount(i int) *SystemEventUpdate {
 	seu.mutation.ResetFailureCount()
@@ -325,6 +337,15 @@ func (seu *SystemEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if seu.mutation.PayloadCleared() {
 		_spec.ClearField(systemevent.FieldPayload, field.TypeJSON)
 	}
+	if seu.mutation.SequenceNumberCleared() {
+		_spec.ClearField(systemevent.FieldSequenceNumber, field.TypeInt64)
+	}
+	if value, ok := seu.mutation.Snapshot(); ok {
+		_spec.SetField(systemevent.FieldSnapshot, field.TypeJSON, value)
+	}
+	if seu.mutation.SnapshotCleared() {
+		_spec.ClearField(systemevent.FieldSnapshot, field.TypeJSON)
+	}
 	if value, ok := seu.mutation.FailureCount(); ok {
 		_spec.SetField(systemevent.FieldFailureCount, field.TypeInt, value)
 	}
@@ -509,6 +530,18 @@ func (seuo *SystemEventUpdateOne) ClearPayload() *SystemEventUpdateOne {
 	return seuo
 }
 
+// SetSnapshot sets the "snapshot" field.
+func (seuo *SystemEventUpdateOne) SetSnapshot(m map[string]interface{}) *SystemEventUpdateOne {
+	seuo.mutation.SetSnapshot(m)
+	return seuo
+}
+
+// ClearSnapshot clears the value of the "snapshot" field.
+func (seuo *SystemEventUpdateOne) ClearSnapshot() *SystemEventUpdateOne {
+	seuo.mutation.ClearSnapshot()
+	return seuo
+}
+
 // SetFailureCount sets the "failure_count" field.
 func (seuo *SystemEventUpdateOne) SetFailureCount(i int) *SystemEventUpdateOne {
 	seuo.mutation.ResetFailureCount()
@@ -684,6 +717,15 @@ func (seuo *SystemEventUpdateOne) sqlSave(ctx context.Context) (_node *SystemEve
 	if seuo.mutation.PayloadCleared() {
 		_spec.ClearField(systemevent.FieldPayload, field.TypeJSON)
 	}
+	if seuo.mutation.SequenceNumberCleared() {
+		_spec.ClearField(systemevent.FieldSequenceNumber, field.TypeInt64)
+	}
+	if value, ok := seuo.mutation.Snapshot(); ok {
+		_spec.SetField(systemevent.FieldSnapshot, field.TypeJSON, value)
+	}
+	if seuo.mutation.SnapshotCleared() {
+		_spec.ClearField(systemevent.FieldSnapshot, field.TypeJSON)
+	}
 	if value, ok := seuo.mutation.FailureCount(); ok {
 		_spec.SetField(systemevent.FieldFailureCount, field.TypeInt, value)
 	}
@@ -58,6 +58,8 @@ type Task struct {
 	FailedRecords int `json:"failed_records,omitempty"`
 	// ErrorSummary holds the value of the "error_summary" field.
 	ErrorSummary *string `json:"error_summary,omitempty"`
+	// CSV of the rows that failed to import, with the error for each row
+	ErrorReport *string `json:"error_report,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// StartedAt holds the value of the "started_at" field.
@@ -78,7 +80,7 @@ func (*Task) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case task.FieldTotalRecords, task.FieldProcessedRecords, task.FieldSuccessfulRecords, task.FieldFailedRecords:
 			values[i] = new(sql.NullInt64)
-		case task.FieldID, task.FieldTenantID, task.FieldStatus, task.FieldCreatedBy, task.FieldUpdatedBy, task.FieldEnvironmentID, task.FieldTaskType, task.FieldEntityType, task.FieldScheduledTaskID, task.FieldWorkflowID, task.FieldFileURL, task.FieldFileName, task.FieldFileType, task.FieldTaskStatus, task.FieldErrorSummary:
+		case task.FieldID, task.FieldTenantID, task.FieldStatus, task.FieldCreatedBy, task.FieldUpdatedBy, task.FieldEnvironmentID, task.FieldTaskType, task.FieldEntityType, task.FieldScheduledTaskID, task.FieldWorkflowID, task.FieldFileURL, task.FieldFileName, task.FieldFileType, task.FieldTaskStatus, task.FieldErrorSummary, task.FieldErrorReport:
 			values[i] = new(sql.NullString)
 		case task.FieldCreatedAt, task.FieldUpdatedAt, task.FieldStartedAt, task.FieldCompletedAt, task.FieldFailedAt:
 			values[i] = new(sql.NullTime)
@@ -227,6 +229,13 @@ func (t *Task) assignValues(columns []string, values []any) error {
 				t.ErrorSummary = new(string)
 				*t.ErrorSummary = value.String
 			}
+		case task.FieldErrorReport:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_report", values[i])
+			} else if value.Valid {
+				t.ErrorReport = new(string)
+				*t.ErrorReport = value.String
+			}
 		case task.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -360,6 +369,11 @@ func (t *Task) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := t.ErrorReport; v != nil {
+		builder.WriteString("error_report=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", t.Metadata))
 	builder.WriteString(", ")
@@ -53,6 +53,8 @@ const (
 	FieldFailedRecords = "failed_records"
 	// FieldErrorSummary holds the string denoting the error_summary field in the database.
 	FieldErrorSummary = "error_summary"
+	// FieldErrorReport holds the string denoting the error_report field in the database.
+	FieldErrorReport = "error_report"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
 	// FieldStartedAt holds the string denoting the started_at field in the database.
@@ -88,6 +90,7 @@ var Columns = []string{
 	FieldSuccessfulRecords,
 	FieldFailedRecords,
 	FieldErrorSummary,
+	FieldErrorReport,
 	FieldMetadata,
 	FieldStartedAt,
 	FieldCompletedAt,
@@ -243,6 +246,11 @@ func ByErrorSummary(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldErrorSummary, opts...).ToFunc()
 }
 
+// ByErrorReport orders the results by the error_report field.
+func ByErrorReport(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorReport, opts...).ToFunc()
+}
+
 // ByStartedAt orders the results by the started_at field.
 func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
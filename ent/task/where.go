@@ -164,6 +164,11 @@ func ErrorSummary(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldErrorSummary, v))
 }
 
+// ErrorReport applies equality check predicate on the "error_report" field. It's identical to ErrorReportEQ.
+func ErrorReport(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldErrorReport, v))
+}
+
 // StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
 func StartedAt(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldStartedAt, v))
@@ -1409,6 +1414,81 @@ func ErrorSummaryContainsFold(v string) predicate.Task {
 	return predicate.Task(sql.FieldContainsFold(FieldErrorSummary, v))
 }
 
+// ErrorReportEQ applies the EQ predicate on the "error_report" field.
+func ErrorReportEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldErrorReport, v))
+}
+
+// ErrorReportNEQ applies the NEQ predicate on the "error_report" field.
+func ErrorReportNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldErrorReport, v))
+}
+
+// ErrorReportIn applies the In predicate on the "error_report" field.
+func ErrorReportIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldErrorReport, vs...))
+}
+
+// ErrorReportNotIn applies the NotIn predicate on the "error_report" field.
+func ErrorReportNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldErrorReport, vs...))
+}
+
+// ErrorReportGT applies the GT predicate on the "error_report" field.
+func ErrorReportGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldErrorReport, v))
+}
+
+// ErrorReportGTE applies the GTE predicate on the "error_report" field.
+func ErrorReportGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldErrorReport, v))
+}
+
+// ErrorReportLT applies the LT predicate on the "error_report" field.
+func ErrorReportLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldErrorReport, v))
+}
+
+// ErrorReportLTE applies the LTE predicate on the "error_report" field.
+func ErrorReportLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldErrorReport, v))
+}
+
+// ErrorReportContains applies the Contains predicate on the "error_report" field.
+func ErrorReportContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldErrorReport, v))
+}
+
+// ErrorReportHasPrefix applies the HasPrefix predicate on the "error_report" field.
+func ErrorReportHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldErrorReport, v))
+}
+
+// ErrorReportHasSuffix applies the HasSuffix predicate on the "error_report" field.
+func ErrorReportHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldErrorReport, v))
+}
+
+// ErrorReportIsNil applies the IsNil predicate on the "error_report" field.
+func ErrorReportIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldErrorReport))
+}
+
+// ErrorReportNotNil applies the NotNil predicate on the "error_report" field.
+func ErrorReportNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldErrorReport))
+}
+
+// ErrorReportEqualFold applies the EqualFold predicate on the "error_report" field.
+func ErrorReportEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldErrorReport, v))
+}
+
+// ErrorReportContainsFold applies the ContainsFold predicate on the "error_report" field.
+func ErrorReportContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldErrorReport, v))
+}
+
 // MetadataIsNil applies the IsNil predicate on the "metadata" field.
 func MetadataIsNil() predicate.Task {
 	return predicate.Task(sql.FieldIsNull(FieldMetadata))
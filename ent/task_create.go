@@ -268,6 +268,20 @@ func (tc *TaskCreate) SetNillableErrorSummary(s *string) *TaskCreate {
 	return tc
 }
 
+// SetErrorReport sets the "error_report" field.
+func (tc *TaskCreate) SetErrorReport(s string) *TaskCreate {
+	tc.mutation.SetErrorReport(s)
+	return tc
+}
+
+// SetNillableErrorReport sets the "error_report" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableErrorReport(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetErrorReport(*s)
+	}
+	return tc
+}
+
 // SetMetadata sets the "metadata" field.
 func (tc *TaskCreate) SetMetadata(m map[string]interface{}) *TaskCreate {
 	tc.mutation.SetMetadata(m)
@@ -568,6 +582,10 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldErrorSummary, field.TypeString, value)
 		_node.ErrorSummary = &value
 	}
+	if value, ok := tc.mutation.ErrorReport(); ok {
+		_spec.SetField(task.FieldErrorReport, field.TypeString, value)
+		_node.ErrorReport = &value
+	}
 	if value, ok := tc.mutation.Metadata(); ok {
 		_spec.SetField(task.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -308,6 +308,26 @@ func (tu *TaskUpdate) ClearErrorSummary() *TaskUpdate {
 	return tu
 }
 
+// SetErrorReport sets the "error_report" field.
+func (tu *TaskUpdate) SetErrorReport(s string) *TaskUpdate {
+	tu.mutation.SetErrorReport(s)
+	return tu
+}
+
+// SetNillableErrorReport sets the "error_report" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableErrorReport(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetErrorReport(*s)
+	}
+	return tu
+}
+
+// ClearErrorReport clears the value of the "error_report" field.
+func (tu *TaskUpdate) ClearErrorReport() *TaskUpdate {
+	tu.mutation.ClearErrorReport()
+	return tu
+}
+
 // SetMetadata sets the "metadata" field.
 func (tu *TaskUpdate) SetMetadata(m map[string]interface{}) *TaskUpdate {
 	tu.mutation.SetMetadata(m)
@@ -537,6 +557,12 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tu.mutation.ErrorSummaryCleared() {
 		_spec.ClearField(task.FieldErrorSummary, field.TypeString)
 	}
+	if value, ok := tu.mutation.ErrorReport(); ok {
+		_spec.SetField(task.FieldErrorReport, field.TypeString, value)
+	}
+	if tu.mutation.ErrorReportCleared() {
+		_spec.ClearField(task.FieldErrorReport, field.TypeString)
+	}
 	if value, ok := tu.mutation.Metadata(); ok {
 		_spec.SetField(task.FieldMetadata, field.TypeJSON, value)
 	}
@@ -861,6 +887,26 @@ func (tuo *TaskUpdateOne) ClearErrorSummary() *TaskUpdateOne {
 	return tuo
 }
 
+// SetErrorReport sets the "error_report" field.
+func (tuo *TaskUpdateOne) SetErrorReport(s string) *TaskUpdateOne {
+	tuo.mutation.SetErrorReport(s)
+	return tuo
+}
+
+// SetNillableErrorReport sets the "error_report" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableErrorReport(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetErrorReport(*s)
+	}
+	return tuo
+}
+
+// ClearErrorReport clears the value of the "error_report" field.
+func (tuo *TaskUpdateOne) ClearErrorReport() *TaskUpdateOne {
+	tuo.mutation.ClearErrorReport()
+	return tuo
+}
+
 // SetMetadata sets the "metadata" field.
 func (tuo *TaskUpdateOne) SetMetadata(m map[string]interface{}) *TaskUpdateOne {
 	tuo.mutation.SetMetadata(m)
@@ -1120,6 +1166,12 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 	if tuo.mutation.ErrorSummaryCleared() {
 		_spec.ClearField(task.FieldErrorSummary, field.TypeString)
 	}
+	if value, ok := tuo.mutation.ErrorReport(); ok {
+		_spec.SetField(task.FieldErrorReport, field.TypeString, value)
+	}
+	if tuo.mutation.ErrorReportCleared() {
+		_spec.ClearField(task.FieldErrorReport, field.TypeString)
+	}
 	if value, ok := tuo.mutation.Metadata(); ok {
 		_spec.SetField(task.FieldMetadata, field.TypeJSON, value)
 	}
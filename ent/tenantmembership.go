@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/tenantmembership"
+)
+
+// TenantMembership is the model entity for the TenantMembership schema.
+type TenantMembership struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// RBAC roles of the user in the tenant of the membership
+	Roles        []string `json:"roles,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TenantMembership) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tenantmembership.FieldRoles:
+			values[i] = new([]byte)
+		case tenantmembership.FieldID, tenantmembership.FieldTenantID, tenantmembership.FieldStatus, tenantmembership.FieldCreatedBy, tenantmembership.FieldUpdatedBy, tenantmembership.FieldUserID, tenantmembership.FieldEmail:
+			values[i] = new(sql.NullString)
+		case tenantmembership.FieldCreatedAt, tenantmembership.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TenantMembership fields.
+func (tm *TenantMembership) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tenantmembership.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				tm.ID = value.String
+			}
+		case tenantmembership.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				tm.TenantID = value.String
+			}
+		case tenantmembership.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				tm.Status = value.String
+			}
+		case tenantmembership.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tm.CreatedAt = value.Time
+			}
+		case tenantmembership.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tm.UpdatedAt = value.Time
+			}
+		case tenantmembership.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				tm.CreatedBy = value.String
+			}
+		case tenantmembership.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				tm.UpdatedBy = value.String
+			}
+		case tenantmembership.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				tm.UserID = value.String
+			}
+		case tenantmembership.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				tm.Email = value.String
+			}
+		case tenantmembership.FieldRoles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field roles", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tm.Roles); err != nil {
+					return fmt.Errorf("unmarshal field roles: %w", err)
+				}
+			}
+		default:
+			tm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TenantMembership.
+// This includes values selected through modifiers, order, etc.
+func (tm *TenantMembership) Value(name string) (ent.Value, error) {
+	return tm.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TenantMembership.
+// Note that you need to call TenantMembership.Unwrap() before calling this method if this TenantMembership
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tm *TenantMembership) Update() *TenantMembershipUpdateOne {
+	return NewTenantMembershipClient(tm.config).UpdateOne(tm)
+}
+
+// Unwrap unwraps the TenantMembership entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tm *TenantMembership) Unwrap() *TenantMembership {
+	_tx, ok := tm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TenantMembership is not a transactional entity")
+	}
+	tm.config.driver = _tx.drv
+	return tm
+}
+
+// String implements the fmt.Stringer.
+func (tm *TenantMembership) String() string {
+	var builder strings.Builder
+	builder.WriteString("TenantMembership(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tm.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(tm.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(tm.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tm.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tm.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(tm.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(tm.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(tm.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(tm.Email)
+	builder.WriteString(", ")
+	builder.WriteString("roles=")
+	builder.WriteString(fmt.Sprintf("%v", tm.Roles))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TenantMemberships is a parsable slice of TenantMembership.
+type TenantMemberships []*TenantMembership
@@ -0,0 +1,363 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/tenantmembership"
+)
+
+// TenantMembershipCreate is the builder for creating a TenantMembership entity.
+type TenantMembershipCreate struct {
+	config
+	mutation *TenantMembershipMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (tmc *TenantMembershipCreate) SetTenantID(s string) *TenantMembershipCreate {
+	tmc.mutation.SetTenantID(s)
+	return tmc
+}
+
+// SetStatus sets the "status" field.
+func (tmc *TenantMembershipCreate) SetStatus(s string) *TenantMembershipCreate {
+	tmc.mutation.SetStatus(s)
+	return tmc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tmc *TenantMembershipCreate) SetNillableStatus(s *string) *TenantMembershipCreate {
+	if s != nil {
+		tmc.SetStatus(*s)
+	}
+	return tmc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tmc *TenantMembershipCreate) SetCreatedAt(t time.Time) *TenantMembershipCreate {
+	tmc.mutation.SetCreatedAt(t)
+	return tmc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tmc *TenantMembershipCreate) SetNillableCreatedAt(t *time.Time) *TenantMembershipCreate {
+	if t != nil {
+		tmc.SetCreatedAt(*t)
+	}
+	return tmc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tmc *TenantMembershipCreate) SetUpdatedAt(t time.Time) *TenantMembershipCreate {
+	tmc.mutation.SetUpdatedAt(t)
+	return tmc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tmc *TenantMembershipCreate) SetNillableUpdatedAt(t *time.Time) *TenantMembershipCreate {
+	if t != nil {
+		tmc.SetUpdatedAt(*t)
+	}
+	return tmc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (tmc *TenantMembershipCreate) SetCreatedBy(s string) *TenantMembershipCreate {
+	tmc.mutation.SetCreatedBy(s)
+	return tmc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (tmc *TenantMembershipCreate) SetNillableCreatedBy(s *string) *TenantMembershipCreate {
+	if s != nil {
+		tmc.SetCreatedBy(*s)
+	}
+	return tmc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (tmc *TenantMembershipCreate) SetUpdatedBy(s string) *TenantMembershipCreate {
+	tmc.mutation.SetUpdatedBy(s)
+	return tmc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (tmc *TenantMembershipCreate) SetNillableUpdatedBy(s *string) *TenantMembershipCreate {
+	if s != nil {
+		tmc.SetUpdatedBy(*s)
+	}
+	return tmc
+}
+
+// SetUserID sets the "user_id" field.
+func (tmc *TenantMembershipCreate) SetUserID(s string) *TenantMembershipCreate {
+	tmc.mutation.SetUserID(s)
+	return tmc
+}
+
+// SetEmail sets the "email" field.
+func (tmc *TenantMembershipCreate) SetEmail(s string) *TenantMembershipCreate {
+	tmc.mutation.SetEmail(s)
+	return tmc
+}
+
+// SetRoles sets the "roles" field.
+func (tmc *TenantMembershipCreate) SetRoles(s []string) *TenantMembershipCreate {
+	tmc.mutation.SetRoles(s)
+	return tmc
+}
+
+// SetID sets the "id" field.
+func (tmc *TenantMembershipCreate) SetID(s string) *TenantMembershipCreate {
+	tmc.mutation.SetID(s)
+	return tmc
+}
+
+// Mutation returns the TenantMembershipMutation object of the builder.
+func (tmc *TenantMembershipCreate) Mutation() *TenantMembershipMutation {
+	return tmc.mutation
+}
+
+// Save creates the TenantMembership in the database.
+func (tmc *TenantMembershipCreate) Save(ctx context.Context) (*TenantMembership, error) {
+	tmc.defaults()
+	return withHooks(ctx, tmc.sqlSave, tmc.mutation, tmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tmc *TenantMembershipCreate) SaveX(ctx context.Context) *TenantMembership {
+	v, err := tmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tmc *TenantMembershipCreate) Exec(ctx context.Context) error {
+	_, err := tmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmc *TenantMembershipCreate) ExecX(ctx context.Context) {
+	if err := tmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tmc *TenantMembershipCreate) defaults() {
+	if _, ok := tmc.mutation.Status(); !ok {
+		v := tenantmembership.DefaultStatus
+		tmc.mutation.SetStatus(v)
+	}
+	if _, ok := tmc.mutation.CreatedAt(); !ok {
+		v := tenantmembership.DefaultCreatedAt()
+		tmc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tmc.mutation.UpdatedAt(); !ok {
+		v := tenantmembership.DefaultUpdatedAt()
+		tmc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tmc *TenantMembershipCreate) check() error {
+	if _, ok := tmc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TenantMembership.tenant_id"`)}
+	}
+	if v, ok := tmc.mutation.TenantID(); ok {
+		if err := tenantmembership.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "TenantMembership.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := tmc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TenantMembership.status"`)}
+	}
+	if _, ok := tmc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TenantMembership.created_at"`)}
+	}
+	if _, ok := tmc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TenantMembership.updated_at"`)}
+	}
+	if _, ok := tmc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TenantMembership.user_id"`)}
+	}
+	if v, ok := tmc.mutation.UserID(); ok {
+		if err := tenantmembership.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "TenantMembership.user_id": %w`, err)}
+		}
+	}
+	if _, ok := tmc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "TenantMembership.email"`)}
+	}
+	if v, ok := tmc.mutation.Email(); ok {
+		if err := tenantmembership.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "TenantMembership.email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tmc *TenantMembershipCreate) sqlSave(ctx context.Context) (*TenantMembership, error) {
+	if err := tmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TenantMembership.ID type: %T", _spec.ID.Value)
+		}
+	}
+	tmc.mutation.id = &_node.ID
+	tmc.mutation.done = true
+	return _node, nil
+}
+
+func (tmc *TenantMembershipCreate) createSpec() (*TenantMembership, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantMembership{config: tmc.config}
+		_spec = sqlgraph.NewCreateSpec(tenantmembership.Table, sqlgraph.NewFieldSpec(tenantmembership.FieldID, field.TypeString))
+	)
+	if id, ok := tmc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tmc.mutation.TenantID(); ok {
+		_spec.SetField(tenantmembership.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := tmc.mutation.Status(); ok {
+		_spec.SetField(tenantmembership.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := tmc.mutation.CreatedAt(); ok {
+		_spec.SetField(tenantmembership.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tmc.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantmembership.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := tmc.mutation.CreatedBy(); ok {
+		_spec.SetField(tenantmembership.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := tmc.mutation.UpdatedBy(); ok {
+		_spec.SetField(tenantmembership.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := tmc.mutation.UserID(); ok {
+		_spec.SetField(tenantmembership.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := tmc.mutation.Email(); ok {
+		_spec.SetField(tenantmembership.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := tmc.mutation.Roles(); ok {
+		_spec.SetField(tenantmembership.FieldRoles, field.TypeJSON, value)
+		_node.Roles = value
+	}
+	return _node, _spec
+}
+
+// TenantMembershipCreateBulk is the builder for creating many TenantMembership entities in bulk.
+type TenantMembershipCreateBulk struct {
+	config
+	err      error
+	builders []*TenantMembershipCreate
+}
+
+// Save creates the TenantMembership entities in the database.
+func (tmcb *TenantMembershipCreateBulk) Save(ctx context.Context) ([]*TenantMembership, error) {
+	if tmcb.err != nil {
+		return nil, tmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tmcb.builders))
+	nodes := make([]*TenantMembership, len(tmcb.builders))
+	mutators := make([]Mutator, len(tmcb.builders))
+	for i := range tmcb.builders {
+		func(i int, root context.Context) {
+			builder := tmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantMembershipMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tmcb *TenantMembershipCreateBulk) SaveX(ctx context.Context) []*TenantMembership {
+	v, err := tmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tmcb *TenantMembershipCreateBulk) Exec(ctx context.Context) error {
+	_, err := tmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmcb *TenantMembershipCreateBulk) ExecX(ctx context.Context) {
+	if err := tmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/tenantmembership"
+)
+
+// TenantMembershipDelete is the builder for deleting a TenantMembership entity.
+type TenantMembershipDelete struct {
+	config
+	hooks    []Hook
+	mutation *TenantMembershipMutation
+}
+
+// Where appends a list predicates to the TenantMembershipDelete builder.
+func (tmd *TenantMembershipDelete) Where(ps ...predicate.TenantMembership) *TenantMembershipDelete {
+	tmd.mutation.Where(ps...)
+	return tmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tmd *TenantMembershipDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tmd.sqlExec, tmd.mutation, tmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmd *TenantMembershipDelete) ExecX(ctx context.Context) int {
+	n, err := tmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tmd *TenantMembershipDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tenantmembership.Table, sqlgraph.NewFieldSpec(tenantmembership.FieldID, field.TypeString))
+	if ps := tmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tmd.mutation.done = true
+	return affected, err
+}
+
+// TenantMembershipDeleteOne is the builder for deleting a single TenantMembership entity.
+type TenantMembershipDeleteOne struct {
+	tmd *TenantMembershipDelete
+}
+
+// Where appends a list predicates to the TenantMembershipDelete builder.
+func (tmdo *TenantMembershipDeleteOne) Where(ps ...predicate.TenantMembership) *TenantMembershipDeleteOne {
+	tmdo.tmd.mutation.Where(ps...)
+	return tmdo
+}
+
+// Exec executes the deletion query.
+func (tmdo *TenantMembershipDeleteOne) Exec(ctx context.Context) error {
+	n, err := tmdo.tmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tenantmembership.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmdo *TenantMembershipDeleteOne) ExecX(ctx context.Context) {
+	if err := tmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
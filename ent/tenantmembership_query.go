@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/tenantmembership"
+)
+
+// TenantMembershipQuery is the builder for querying TenantMembership entities.
+type TenantMembershipQuery struct {
+	config
+	ctx        *QueryContext
+	order      []tenantmembership.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TenantMembership
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TenantMembershipQuery builder.
+func (tmq *TenantMembershipQuery) Where(ps ...predicate.TenantMembership) *TenantMembershipQuery {
+	tmq.predicates = append(tmq.predicates, ps...)
+	return tmq
+}
+
+// Limit the number of records to be returned by this query.
+func (tmq *TenantMembershipQuery) Limit(limit int) *TenantMembershipQuery {
+	tmq.ctx.Limit = &limit
+	return tmq
+}
+
+// Offset to start from.
+func (tmq *TenantMembershipQuery) Offset(offset int) *TenantMembershipQuery {
+	tmq.ctx.Offset = &offset
+	return tmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tmq *TenantMembershipQuery) Unique(unique bool) *TenantMembershipQuery {
+	tmq.ctx.Unique = &unique
+	return tmq
+}
+
+// Order specifies how the records should be ordered.
+func (tmq *TenantMembershipQuery) Order(o ...tenantmembership.OrderOption) *TenantMembershipQuery {
+	tmq.order = append(tmq.order, o...)
+	return tmq
+}
+
+// First returns the first TenantMembership entity from the query.
+// Returns a *NotFoundError when no TenantMembership was found.
+func (tmq *TenantMembershipQuery) First(ctx context.Context) (*TenantMembership, error) {
+	nodes, err := tmq.Limit(1).All(setContextOp(ctx, tmq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{tenantmembership.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tmq *TenantMembershipQuery) FirstX(ctx context.Context) *TenantMembership {
+	node, err := tmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TenantMembership ID from the query.
+// Returns a *NotFoundError when no TenantMembership ID was found.
+func (tmq *TenantMembershipQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = tmq.Limit(1).IDs(setContextOp(ctx, tmq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{tenantmembership.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tmq *TenantMembershipQuery) FirstIDX(ctx context.Context) string {
+	id, err := tmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TenantMembership entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TenantMembership entity is found.
+// Returns a *NotFoundError when no TenantMembership entities are found.
+func (tmq *TenantMembershipQuery) Only(ctx context.Context) (*TenantMembership, error) {
+	nodes, err := tmq.Limit(2).All(setContextOp(ctx, tmq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{tenantmembership.Label}
+	default:
+		return nil, &NotSingularError{tenantmembership.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tmq *TenantMembershipQuery) OnlyX(ctx context.Context) *TenantMembership {
+	node, err := tmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TenantMembership ID in the query.
+// Returns a *NotSingularError when more than one TenantMembership ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tmq *TenantMembershipQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = tmq.Limit(2).IDs(setContextOp(ctx, tmq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{tenantmembership.Label}
+	default:
+		err = &NotSingularError{tenantmembership.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tmq *TenantMembershipQuery) OnlyIDX(ctx context.Context) string {
+	id, err := tmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TenantMemberships.
+func (tmq *TenantMembershipQuery) All(ctx context.Context) ([]*TenantMembership, error) {
+	ctx = setContextOp(ctx, tmq.ctx, ent.OpQueryAll)
+	if err := tmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TenantMembership, *TenantMembershipQuery]()
+	return withInterceptors[[]*TenantMembership](ctx, tmq, qr, tmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tmq *TenantMembershipQuery) AllX(ctx context.Context) []*TenantMembership {
+	nodes, err := tmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TenantMembership IDs.
+func (tmq *TenantMembershipQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if tmq.ctx.Unique == nil && tmq.path != nil {
+		tmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tmq.ctx, ent.OpQueryIDs)
+	if err = tmq.Select(tenantmembership.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tmq *TenantMembershipQuery) IDsX(ctx context.Context) []string {
+	ids, err := tmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tmq *TenantMembershipQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tmq.ctx, ent.OpQueryCount)
+	if err := tmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tmq, querierCount[*TenantMembershipQuery](), tmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tmq *TenantMembershipQuery) CountX(ctx context.Context) int {
+	count, err := tmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tmq *TenantMembershipQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tmq.ctx, ent.OpQueryExist)
+	switch _, err := tmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tmq *TenantMembershipQuery) ExistX(ctx context.Context) bool {
+	exist, err := tmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TenantMembershipQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tmq *TenantMembershipQuery) Clone() *TenantMembershipQuery {
+	if tmq == nil {
+		return nil
+	}
+	return &TenantMembershipQuery{
+		config:     tmq.config,
+		ctx:        tmq.ctx.Clone(),
+		order:      append([]tenantmembership.OrderOption{}, tmq.order...),
+		inters:     append([]Interceptor{}, tmq.inters...),
+		predicates: append([]predicate.TenantMembership{}, tmq.predicates...),
+		// clone intermediate query.
+		sql:  tmq.sql.Clone(),
+		path: tmq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TenantMembership.Query().
+//		GroupBy(tenantmembership.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tmq *TenantMembershipQuery) GroupBy(field string, fields ...string) *TenantMembershipGroupBy {
+	tmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TenantMembershipGroupBy{build: tmq}
+	grbuild.flds = &tmq.ctx.Fields
+	grbuild.label = tenantmembership.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.TenantMembership.Query().
+//		Select(tenantmembership.FieldTenantID).
+//		Scan(ctx, &v)
+func (tmq *TenantMembershipQuery) Select(fields ...string) *TenantMembershipSelect {
+	tmq.ctx.Fields = append(tmq.ctx.Fields, fields...)
+	sbuild := &TenantMembershipSelect{TenantMembershipQuery: tmq}
+	sbuild.label = tenantmembership.Label
+	sbuild.flds, sbuild.scan = &tmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TenantMembershipSelect configured with the given aggregations.
+func (tmq *TenantMembershipQuery) Aggregate(fns ...AggregateFunc) *TenantMembershipSelect {
+	return tmq.Select().Aggregate(fns...)
+}
+
+func (tmq *TenantMembershipQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tmq.ctx.Fields {
+		if !tenantmembership.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tmq.path != nil {
+		prev, err := tmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tmq.sql = prev
+	}
+	return nil
+}
+
+func (tmq *TenantMembershipQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TenantMembership, error) {
+	var (
+		nodes = []*TenantMembership{}
+		_spec = tmq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TenantMembership).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TenantMembership{config: tmq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (tmq *TenantMembershipQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tmq.querySpec()
+	_spec.Node.Columns = tmq.ctx.Fields
+	if len(tmq.ctx.Fields) > 0 {
+		_spec.Unique = tmq.ctx.Unique != nil && *tmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tmq.driver, _spec)
+}
+
+func (tmq *TenantMembershipQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(tenantmembership.Table, tenantmembership.Columns, sqlgraph.NewFieldSpec(tenantmembership.FieldID, field.TypeString))
+	_spec.From = tmq.sql
+	if unique := tmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantmembership.FieldID)
+		for i := range fields {
+			if fields[i] != tenantmembership.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tmq *TenantMembershipQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tmq.driver.Dialect())
+	t1 := builder.Table(tenantmembership.Table)
+	columns := tmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = tenantmembership.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tmq.sql != nil {
+		selector = tmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tmq.ctx.Unique != nil && *tmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tmq.predicates {
+		p(selector)
+	}
+	for _, p := range tmq.order {
+		p(selector)
+	}
+	if offset := tmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TenantMembershipGroupBy is the group-by builder for TenantMembership entities.
+type TenantMembershipGroupBy struct {
+	selector
+	build *TenantMembershipQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tmgb *TenantMembershipGroupBy) Aggregate(fns ...AggregateFunc) *TenantMembershipGroupBy {
+	tmgb.fns = append(tmgb.fns, fns...)
+	return tmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tmgb *TenantMembershipGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tmgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TenantMembershipQuery, *TenantMembershipGroupBy](ctx, tmgb.build, tmgb, tmgb.build.inters, v)
+}
+
+func (tmgb *TenantMembershipGroupBy) sqlScan(ctx context.Context, root *TenantMembershipQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tmgb.fns))
+	for _, fn := range tmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tmgb.flds)+len(tmgb.fns))
+		for _, f := range *tmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TenantMembershipSelect is the builder for selecting fields of TenantMembership entities.
+type TenantMembershipSelect struct {
+	*TenantMembershipQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tms *TenantMembershipSelect) Aggregate(fns ...AggregateFunc) *TenantMembershipSelect {
+	tms.fns = append(tms.fns, fns...)
+	return tms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tms *TenantMembershipSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tms.ctx, ent.OpQuerySelect)
+	if err := tms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TenantMembershipQuery, *TenantMembershipSelect](ctx, tms.TenantMembershipQuery, tms, tms.inters, v)
+}
+
+func (tms *TenantMembershipSelect) sqlScan(ctx context.Context, root *TenantMembershipQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tms.fns))
+	for _, fn := range tms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
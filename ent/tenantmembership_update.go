@@ -0,0 +1,363 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/tenantmembership"
+)
+
+// TenantMembershipUpdate is the builder for updating TenantMembership entities.
+type TenantMembershipUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TenantMembershipMutation
+}
+
+// Where appends a list predicates to the TenantMembershipUpdate builder.
+func (tmu *TenantMembershipUpdate) Where(ps ...predicate.TenantMembership) *TenantMembershipUpdate {
+	tmu.mutation.Where(ps...)
+	return tmu
+}
+
+// SetStatus sets the "status" field.
+func (tmu *TenantMembershipUpdate) SetStatus(s string) *TenantMembershipUpdate {
+	tmu.mutation.SetStatus(s)
+	return tmu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tmu *TenantMembershipUpdate) SetNillableStatus(s *string) *TenantMembershipUpdate {
+	if s != nil {
+		tmu.SetStatus(*s)
+	}
+	return tmu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tmu *TenantMembershipUpdate) SetUpdatedAt(t time.Time) *TenantMembershipUpdate {
+	tmu.mutation.SetUpdatedAt(t)
+	return tmu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (tmu *TenantMembershipUpdate) SetUpdatedBy(s string) *TenantMembershipUpdate {
+	tmu.mutation.SetUpdatedBy(s)
+	return tmu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (tmu *TenantMembershipUpdate) SetNillableUpdatedBy(s *string) *TenantMembershipUpdate {
+	if s != nil {
+		tmu.SetUpdatedBy(*s)
+	}
+	return tmu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (tmu *TenantMembershipUpdate) ClearUpdatedBy() *TenantMembershipUpdate {
+	tmu.mutation.ClearUpdatedBy()
+	return tmu
+}
+
+// SetRoles sets the "roles" field.
+func (tmu *TenantMembershipUpdate) SetRoles(s []string) *TenantMembershipUpdate {
+	tmu.mutation.SetRoles(s)
+	return tmu
+}
+
+// AppendRoles appends s to the "roles" field.
+func (tmu *TenantMembershipUpdate) AppendRoles(s []string) *TenantMembershipUpdate {
+	tmu.mutation.AppendRoles(s)
+	return tmu
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (tmu *TenantMembershipUpdate) ClearRoles() *TenantMembershipUpdate {
+	tmu.mutation.ClearRoles()
+	return tmu
+}
+
+// Mutation returns the TenantMembershipMutation object of the builder.
+func (tmu *TenantMembershipUpdate) Mutation() *TenantMembershipMutation {
+	return tmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tmu *TenantMembershipUpdate) Save(ctx context.Context) (int, error) {
+	tmu.defaults()
+	return withHooks(ctx, tmu.sqlSave, tmu.mutation, tmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tmu *TenantMembershipUpdate) SaveX(ctx context.Context) int {
+	affected, err := tmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tmu *TenantMembershipUpdate) Exec(ctx context.Context) error {
+	_, err := tmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmu *TenantMembershipUpdate) ExecX(ctx context.Context) {
+	if err := tmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tmu *TenantMembershipUpdate) defaults() {
+	if _, ok := tmu.mutation.UpdatedAt(); !ok {
+		v := tenantmembership.UpdateDefaultUpdatedAt()
+		tmu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (tmu *TenantMembershipUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tenantmembership.Table, tenantmembership.Columns, sqlgraph.NewFieldSpec(tenantmembership.FieldID, field.TypeString))
+	if ps := tmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tmu.mutation.Status(); ok {
+		_spec.SetField(tenantmembership.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := tmu.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantmembership.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tmu.mutation.CreatedByCleared() {
+		_spec.ClearField(tenantmembership.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := tmu.mutation.UpdatedBy(); ok {
+		_spec.SetField(tenantmembership.FieldUpdatedBy, field.TypeString, value)
+	}
+	if tmu.mutation.UpdatedByCleared() {
+		_spec.ClearField(tenantmembership.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := tmu.mutation.Roles(); ok {
+		_spec.SetField(tenantmembership.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := tmu.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantmembership.FieldRoles, value)
+		})
+	}
+	if tmu.mutation.RolesCleared() {
+		_spec.ClearField(tenantmembership.FieldRoles, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantmembership.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tmu.mutation.done = true
+	return n, nil
+}
+
+// TenantMembershipUpdateOne is the builder for updating a single TenantMembership entity.
+type TenantMembershipUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TenantMembershipMutation
+}
+
+// SetStatus sets the "status" field.
+func (tmuo *TenantMembershipUpdateOne) SetStatus(s string) *TenantMembershipUpdateOne {
+	tmuo.mutation.SetStatus(s)
+	return tmuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tmuo *TenantMembershipUpdateOne) SetNillableStatus(s *string) *TenantMembershipUpdateOne {
+	if s != nil {
+		tmuo.SetStatus(*s)
+	}
+	return tmuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tmuo *TenantMembershipUpdateOne) SetUpdatedAt(t time.Time) *TenantMembershipUpdateOne {
+	tmuo.mutation.SetUpdatedAt(t)
+	return tmuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (tmuo *TenantMembershipUpdateOne) SetUpdatedBy(s string) *TenantMembershipUpdateOne {
+	tmuo.mutation.SetUpdatedBy(s)
+	return tmuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (tmuo *TenantMembershipUpdateOne) SetNillableUpdatedBy(s *string) *TenantMembershipUpdateOne {
+	if s != nil {
+		tmuo.SetUpdatedBy(*s)
+	}
+	return tmuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (tmuo *TenantMembershipUpdateOne) ClearUpdatedBy() *TenantMembershipUpdateOne {
+	tmuo.mutation.ClearUpdatedBy()
+	return tmuo
+}
+
+// SetRoles sets the "roles" field.
+func (tmuo *TenantMembershipUpdateOne) SetRoles(s []string) *TenantMembershipUpdateOne {
+	tmuo.mutation.SetRoles(s)
+	return tmuo
+}
+
+// AppendRoles appends s to the "roles" field.
+func (tmuo *TenantMembershipUpdateOne) AppendRoles(s []string) *TenantMembershipUpdateOne {
+	tmuo.mutation.AppendRoles(s)
+	return tmuo
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (tmuo *TenantMembershipUpdateOne) ClearRoles() *TenantMembershipUpdateOne {
+	tmuo.mutation.ClearRoles()
+	return tmuo
+}
+
+// Mutation returns the TenantMembershipMutation object of the builder.
+func (tmuo *TenantMembershipUpdateOne) Mutation() *TenantMembershipMutation {
+	return tmuo.mutation
+}
+
+// Where appends a list predicates to the TenantMembershipUpdate builder.
+func (tmuo *TenantMembershipUpdateOne) Where(ps ...predicate.TenantMembership) *TenantMembershipUpdateOne {
+	tmuo.mutation.Where(ps...)
+	return tmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tmuo *TenantMembershipUpdateOne) Select(field string, fields ...string) *TenantMembershipUpdateOne {
+	tmuo.fields = append([]string{field}, fields...)
+	return tmuo
+}
+
+// Save executes the query and returns the updated TenantMembership entity.
+func (tmuo *TenantMembershipUpdateOne) Save(ctx context.Context) (*TenantMembership, error) {
+	tmuo.defaults()
+	return withHooks(ctx, tmuo.sqlSave, tmuo.mutation, tmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tmuo *TenantMembershipUpdateOne) SaveX(ctx context.Context) *TenantMembership {
+	node, err := tmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tmuo *TenantMembershipUpdateOne) Exec(ctx context.Context) error {
+	_, err := tmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmuo *TenantMembershipUpdateOne) ExecX(ctx context.Context) {
+	if err := tmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tmuo *TenantMembershipUpdateOne) defaults() {
+	if _, ok := tmuo.mutation.UpdatedAt(); !ok {
+		v := tenantmembership.UpdateDefaultUpdatedAt()
+		tmuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (tmuo *TenantMembershipUpdateOne) sqlSave(ctx context.Context) (_node *TenantMembership, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tenantmembership.Table, tenantmembership.Columns, sqlgraph.NewFieldSpec(tenantmembership.FieldID, field.TypeString))
+	id, ok := tmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TenantMembership.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantmembership.FieldID)
+		for _, f := range fields {
+			if !tenantmembership.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenantmembership.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tmuo.mutation.Status(); ok {
+		_spec.SetField(tenantmembership.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := tmuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantmembership.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tmuo.mutation.CreatedByCleared() {
+		_spec.ClearField(tenantmembership.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := tmuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(tenantmembership.FieldUpdatedBy, field.TypeString, value)
+	}
+	if tmuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(tenantmembership.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := tmuo.mutation.Roles(); ok {
+		_spec.SetField(tenantmembership.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := tmuo.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantmembership.FieldRoles, value)
+		})
+	}
+	if tmuo.mutation.RolesCleared() {
+		_spec.ClearField(tenantmembership.FieldRoles, field.TypeJSON)
+	}
+	_node = &TenantMembership{config: tmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantmembership.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tmuo.mutation.done = true
+	return _node, nil
+}
@@ -68,6 +68,14 @@ type WalletTransaction struct {
 	ConversionRate *decimal.Decimal `json:"conversion_rate,omitempty"`
 	// TopupConversionRate holds the value of the "topup_conversion_rate" field.
 	TopupConversionRate *decimal.Decimal `json:"topup_conversion_rate,omitempty"`
+	// FX rate from the wallet currency to the settlement currency for converted debits
+	FxRate *decimal.Decimal `json:"fx_rate,omitempty"`
+	// FxRateID holds the value of the "fx_rate_id" field.
+	FxRateID *string `json:"fx_rate_id,omitempty"`
+	// Currency of the invoice a converted debit settled
+	SettlementCurrency *string `json:"settlement_currency,omitempty"`
+	// Amount settled in the settlement currency
+	SettlementAmount *decimal.Decimal `json:"settlement_amount,omitempty"`
 	// IdempotencyKey holds the value of the "idempotency_key" field.
 	IdempotencyKey *string `json:"idempotency_key,omitempty"`
 	// TransactionReason holds the value of the "transaction_reason" field.
@@ -82,7 +90,7 @@ func (*WalletTransaction) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case wallettransaction.FieldConversionRate, wallettransaction.FieldTopupConversionRate:
+		case wallettransaction.FieldConversionRate, wallettransaction.FieldTopupConversionRate, wallettransaction.FieldFxRate, wallettransaction.FieldSettlementAmount:
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
 		case wallettransaction.FieldMetadata:
 			values[i] = new([]byte)
@@ -90,7 +98,7 @@ func (*WalletTransaction) scanValues(columns []string) ([]any, error) {
 			values[i] = new(decimal.Decimal)
 		case wallettransaction.FieldPriority:
 			values[i] = new(sql.NullInt64)
-		case wallettransaction.FieldID, wallettransaction.FieldTenantID, wallettransaction.FieldStatus, wallettransaction.FieldCreatedBy, wallettransaction.FieldUpdatedBy, wallettransaction.FieldEnvironmentID, wallettransaction.FieldWalletID, wallettransaction.FieldCustomerID, wallettransaction.FieldType, wallettransaction.FieldReferenceType, wallettransaction.FieldReferenceID, wallettransaction.FieldDescription, wallettransaction.FieldTransactionStatus, wallettransaction.FieldCurrency, wallettransaction.FieldIdempotencyKey, wallettransaction.FieldTransactionReason:
+		case wallettransaction.FieldID, wallettransaction.FieldTenantID, wallettransaction.FieldStatus, wallettransaction.FieldCreatedBy, wallettransaction.FieldUpdatedBy, wallettransaction.FieldEnvironmentID, wallettransaction.FieldWalletID, wallettransaction.FieldCustomerID, wallettransaction.FieldType, wallettransaction.FieldReferenceType, wallettransaction.FieldReferenceID, wallettransaction.FieldDescription, wallettransaction.FieldTransactionStatus, wallettransaction.FieldCurrency, wallettransaction.FieldFxRateID, wallettransaction.FieldSettlementCurrency, wallettransaction.FieldIdempotencyKey, wallettransaction.FieldTransactionReason:
 			values[i] = new(sql.NullString)
 		case wallettransaction.FieldCreatedAt, wallettransaction.FieldUpdatedAt, wallettransaction.FieldExpiryDate:
 			values[i] = new(sql.NullTime)
@@ -264,6 +272,34 @@ func (wt *WalletTransaction) assignValues(columns []string, values []any) error
 				wt.TopupConversionRate = new(decimal.Decimal)
 				*wt.TopupConversionRate = *value.S.(*decimal.Decimal)
 			}
+		case wallettransaction.FieldFxRate:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field fx_rate", values[i])
+			} else if value.Valid {
+				wt.FxRate = new(decimal.Decimal)
+				*wt.FxRate = *value.S.(*decimal.Decimal)
+			}
+		case wallettransaction.FieldFxRateID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fx_rate_id", values[i])
+			} else if value.Valid {
+				wt.FxRateID = new(string)
+				*wt.FxRateID = value.String
+			}
+		case wallettransaction.FieldSettlementCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field settlement_currency", values[i])
+			} else if value.Valid {
+				wt.SettlementCurrency = new(string)
+				*wt.SettlementCurrency = value.String
+			}
+		case wallettransaction.FieldSettlementAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field settlement_amount", values[i])
+			} else if value.Valid {
+				wt.SettlementAmount = new(decimal.Decimal)
+				*wt.SettlementAmount = *value.S.(*decimal.Decimal)
+			}
 		case wallettransaction.FieldIdempotencyKey:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field idempotency_key", values[i])
@@ -398,6 +434,26 @@ func (wt *WalletTransaction) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := wt.FxRate; v != nil {
+		builder.WriteString("fx_rate=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := wt.FxRateID; v != nil {
+		builder.WriteString("fx_rate_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := wt.SettlementCurrency; v != nil {
+		builder.WriteString("settlement_currency=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := wt.SettlementAmount; v != nil {
+		builder.WriteString("settlement_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := wt.IdempotencyKey; v != nil {
 		builder.WriteString("idempotency_key=")
 		builder.WriteString(*v)
@@ -63,6 +63,14 @@ const (
 	FieldConversionRate = "conversion_rate"
 	// FieldTopupConversionRate holds the string denoting the topup_conversion_rate field in the database.
 	FieldTopupConversionRate = "topup_conversion_rate"
+	// FieldFxRate holds the string denoting the fx_rate field in the database.
+	FieldFxRate = "fx_rate"
+	// FieldFxRateID holds the string denoting the fx_rate_id field in the database.
+	FieldFxRateID = "fx_rate_id"
+	// FieldSettlementCurrency holds the string denoting the settlement_currency field in the database.
+	FieldSettlementCurrency = "settlement_currency"
+	// FieldSettlementAmount holds the string denoting the settlement_amount field in the database.
+	FieldSettlementAmount = "settlement_amount"
 	// FieldIdempotencyKey holds the string denoting the idempotency_key field in the database.
 	FieldIdempotencyKey = "idempotency_key"
 	// FieldTransactionReason holds the string denoting the transaction_reason field in the database.
@@ -100,6 +108,10 @@ var Columns = []string{
 	FieldCurrency,
 	FieldConversionRate,
 	FieldTopupConversionRate,
+	FieldFxRate,
+	FieldFxRateID,
+	FieldSettlementCurrency,
+	FieldSettlementAmount,
 	FieldIdempotencyKey,
 	FieldTransactionReason,
 	FieldPriority,
@@ -267,6 +279,26 @@ func ByTopupConversionRate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTopupConversionRate, opts...).ToFunc()
 }
 
+// ByFxRate orders the results by the fx_rate field.
+func ByFxRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFxRate, opts...).ToFunc()
+}
+
+// ByFxRateID orders the results by the fx_rate_id field.
+func ByFxRateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFxRateID, opts...).ToFunc()
+}
+
+// BySettlementCurrency orders the results by the settlement_currency field.
+func BySettlementCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSettlementCurrency, opts...).ToFunc()
+}
+
+// BySettlementAmount orders the results by the settlement_amount field.
+func BySettlementAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSettlementAmount, opts...).ToFunc()
+}
+
 // ByIdempotencyKey orders the results by the idempotency_key field.
 func ByIdempotencyKey(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIdempotencyKey, opts...).ToFunc()
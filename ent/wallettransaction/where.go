@@ -184,6 +184,26 @@ func TopupConversionRate(v decimal.Decimal) predicate.WalletTransaction {
 	return predicate.WalletTransaction(sql.FieldEQ(FieldTopupConversionRate, v))
 }
 
+// FxRate applies equality check predicate on the "fx_rate" field. It's identical to FxRateEQ.
+func FxRate(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldFxRate, v))
+}
+
+// FxRateID applies equality check predicate on the "fx_rate_id" field. It's identical to FxRateIDEQ.
+func FxRateID(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldFxRateID, v))
+}
+
+// SettlementCurrency applies equality check predicate on the "settlement_currency" field. It's identical to SettlementCurrencyEQ.
+func SettlementCurrency(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldSettlementCurrency, v))
+}
+
+// SettlementAmount applies equality check predicate on the "settlement_amount" field. It's identical to SettlementAmountEQ.
+func SettlementAmount(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldSettlementAmount, v))
+}
+
 // IdempotencyKey applies equality check predicate on the "idempotency_key" field. It's identical to IdempotencyKeyEQ.
 func IdempotencyKey(v string) predicate.WalletTransaction {
 	return predicate.WalletTransaction(sql.FieldEQ(FieldIdempotencyKey, v))
@@ -1612,6 +1632,256 @@ func TopupConversionRateNotNil() predicate.WalletTransaction {
 	return predicate.WalletTransaction(sql.FieldNotNull(FieldTopupConversionRate))
 }
 
+// FxRateEQ applies the EQ predicate on the "fx_rate" field.
+func FxRateEQ(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldFxRate, v))
+}
+
+// FxRateNEQ applies the NEQ predicate on the "fx_rate" field.
+func FxRateNEQ(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNEQ(FieldFxRate, v))
+}
+
+// FxRateIn applies the In predicate on the "fx_rate" field.
+func FxRateIn(vs ...decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIn(FieldFxRate, vs...))
+}
+
+// FxRateNotIn applies the NotIn predicate on the "fx_rate" field.
+func FxRateNotIn(vs ...decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotIn(FieldFxRate, vs...))
+}
+
+// FxRateGT applies the GT predicate on the "fx_rate" field.
+func FxRateGT(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGT(FieldFxRate, v))
+}
+
+// FxRateGTE applies the GTE predicate on the "fx_rate" field.
+func FxRateGTE(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGTE(FieldFxRate, v))
+}
+
+// FxRateLT applies the LT predicate on the "fx_rate" field.
+func FxRateLT(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLT(FieldFxRate, v))
+}
+
+// FxRateLTE applies the LTE predicate on the "fx_rate" field.
+func FxRateLTE(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLTE(FieldFxRate, v))
+}
+
+// FxRateIsNil applies the IsNil predicate on the "fx_rate" field.
+func FxRateIsNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIsNull(FieldFxRate))
+}
+
+// FxRateNotNil applies the NotNil predicate on the "fx_rate" field.
+func FxRateNotNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotNull(FieldFxRate))
+}
+
+// FxRateIDEQ applies the EQ predicate on the "fx_rate_id" field.
+func FxRateIDEQ(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldFxRateID, v))
+}
+
+// FxRateIDNEQ applies the NEQ predicate on the "fx_rate_id" field.
+func FxRateIDNEQ(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNEQ(FieldFxRateID, v))
+}
+
+// FxRateIDIn applies the In predicate on the "fx_rate_id" field.
+func FxRateIDIn(vs ...string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIn(FieldFxRateID, vs...))
+}
+
+// FxRateIDNotIn applies the NotIn predicate on the "fx_rate_id" field.
+func FxRateIDNotIn(vs ...string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotIn(FieldFxRateID, vs...))
+}
+
+// FxRateIDGT applies the GT predicate on the "fx_rate_id" field.
+func FxRateIDGT(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGT(FieldFxRateID, v))
+}
+
+// FxRateIDGTE applies the GTE predicate on the "fx_rate_id" field.
+func FxRateIDGTE(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGTE(FieldFxRateID, v))
+}
+
+// FxRateIDLT applies the LT predicate on the "fx_rate_id" field.
+func FxRateIDLT(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLT(FieldFxRateID, v))
+}
+
+// FxRateIDLTE applies the LTE predicate on the "fx_rate_id" field.
+func FxRateIDLTE(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLTE(FieldFxRateID, v))
+}
+
+// FxRateIDContains applies the Contains predicate on the "fx_rate_id" field.
+func FxRateIDContains(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldContains(FieldFxRateID, v))
+}
+
+// FxRateIDHasPrefix applies the HasPrefix predicate on the "fx_rate_id" field.
+func FxRateIDHasPrefix(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldHasPrefix(FieldFxRateID, v))
+}
+
+// FxRateIDHasSuffix applies the HasSuffix predicate on the "fx_rate_id" field.
+func FxRateIDHasSuffix(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldHasSuffix(FieldFxRateID, v))
+}
+
+// FxRateIDIsNil applies the IsNil predicate on the "fx_rate_id" field.
+func FxRateIDIsNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIsNull(FieldFxRateID))
+}
+
+// FxRateIDNotNil applies the NotNil predicate on the "fx_rate_id" field.
+func FxRateIDNotNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotNull(FieldFxRateID))
+}
+
+// FxRateIDEqualFold applies the EqualFold predicate on the "fx_rate_id" field.
+func FxRateIDEqualFold(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEqualFold(FieldFxRateID, v))
+}
+
+// FxRateIDContainsFold applies the ContainsFold predicate on the "fx_rate_id" field.
+func FxRateIDContainsFold(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldContainsFold(FieldFxRateID, v))
+}
+
+// SettlementCurrencyEQ applies the EQ predicate on the "settlement_currency" field.
+func SettlementCurrencyEQ(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldSettlementCurrency, v))
+}
+
+// SettlementCurrencyNEQ applies the NEQ predicate on the "settlement_currency" field.
+func SettlementCurrencyNEQ(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNEQ(FieldSettlementCurrency, v))
+}
+
+// SettlementCurrencyIn applies the In predicate on the "settlement_currency" field.
+func SettlementCurrencyIn(vs ...string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIn(FieldSettlementCurrency, vs...))
+}
+
+// SettlementCurrencyNotIn applies the NotIn predicate on the "settlement_currency" field.
+func SettlementCurrencyNotIn(vs ...string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotIn(FieldSettlementCurrency, vs...))
+}
+
+// SettlementCurrencyGT applies the GT predicate on the "settlement_currency" field.
+func SettlementCurrencyGT(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGT(FieldSettlementCurrency, v))
+}
+
+// SettlementCurrencyGTE applies the GTE predicate on the "settlement_currency" field.
+func SettlementCurrencyGTE(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGTE(FieldSettlementCurrency, v))
+}
+
+// SettlementCurrencyLT applies the LT predicate on the "settlement_currency" field.
+func SettlementCurrencyLT(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLT(FieldSettlementCurrency, v))
+}
+
+// SettlementCurrencyLTE applies the LTE predicate on the "settlement_currency" field.
+func SettlementCurrencyLTE(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLTE(FieldSettlementCurrency, v))
+}
+
+// SettlementCurrencyContains applies the Contains predicate on the "settlement_currency" field.
+func SettlementCurrencyContains(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldContains(FieldSettlementCurrency, v))
+}
+
+// SettlementCurrencyHasPrefix applies the HasPrefix predicate on the "settlement_currency" field.
+func SettlementCurrencyHasPrefix(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldHasPrefix(FieldSettlementCurrency, v))
+}
+
+// SettlementCurrencyHasSuffix applies the HasSuffix predicate on the "settlement_currency" field.
+func SettlementCurrencyHasSuffix(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldHasSuffix(FieldSettlementCurrency, v))
+}
+
+// SettlementCurrencyIsNil applies the IsNil predicate on the "settlement_currency" field.
+func SettlementCurrencyIsNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIsNull(FieldSettlementCurrency))
+}
+
+// SettlementCurrencyNotNil applies the NotNil predicate on the "settlement_currency" field.
+func SettlementCurrencyNotNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotNull(FieldSettlementCurrency))
+}
+
+// SettlementCurrencyEqualFold applies the EqualFold predicate on the "settlement_currency" field.
+func SettlementCurrencyEqualFold(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEqualFold(FieldSettlementCurrency, v))
+}
+
+// SettlementCurrencyContainsFold applies the ContainsFold predicate on the "settlement_currency" field.
+func SettlementCurrencyContainsFold(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldContainsFold(FieldSettlementCurrency, v))
+}
+
+// SettlementAmountEQ applies the EQ predicate on the "settlement_amount" field.
+func SettlementAmountEQ(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldSettlementAmount, v))
+}
+
+// SettlementAmountNEQ applies the NEQ predicate on the "settlement_amount" field.
+func SettlementAmountNEQ(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNEQ(FieldSettlementAmount, v))
+}
+
+// SettlementAmountIn applies the In predicate on the "settlement_amount" field.
+func SettlementAmountIn(vs ...decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIn(FieldSettlementAmount, vs...))
+}
+
+// SettlementAmountNotIn applies the NotIn predicate on the "settlement_amount" field.
+func SettlementAmountNotIn(vs ...decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotIn(FieldSettlementAmount, vs...))
+}
+
+// SettlementAmountGT applies the GT predicate on the "settlement_amount" field.
+func SettlementAmountGT(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGT(FieldSettlementAmount, v))
+}
+
+// SettlementAmountGTE applies the GTE predicate on the "settlement_amount" field.
+func SettlementAmountGTE(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGTE(FieldSettlementAmount, v))
+}
+
+// SettlementAmountLT applies the LT predicate on the "settlement_amount" field.
+func SettlementAmountLT(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLT(FieldSettlementAmount, v))
+}
+
+// SettlementAmountLTE applies the LTE predicate on the "settlement_amount" field.
+func SettlementAmountLTE(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLTE(FieldSettlementAmount, v))
+}
+
+// SettlementAmountIsNil applies the IsNil predicate on the "settlement_amount" field.
+func SettlementAmountIsNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIsNull(FieldSettlementAmount))
+}
+
+// SettlementAmountNotNil applies the NotNil predicate on the "settlement_amount" field.
+func SettlementAmountNotNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotNull(FieldSettlementAmount))
+}
+
 // IdempotencyKeyEQ applies the EQ predicate on the "idempotency_key" field.
 func IdempotencyKeyEQ(v string) predicate.WalletTransaction {
 	return predicate.WalletTransaction(sql.FieldEQ(FieldIdempotencyKey, v))
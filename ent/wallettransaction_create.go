@@ -294,6 +294,62 @@ func (wtc *WalletTransactionCreate) SetNillableTopupConversionRate(d *decimal.De
 	return wtc
 }
 
+// SetFxRate sets the "fx_rate" field.
+func (wtc *WalletTransactionCreate) SetFxRate(d decimal.Decimal) *WalletTransactionCreate {
+	wtc.mutation.SetFxRate(d)
+	return wtc
+}
+
+// SetNillableFxRate sets the "fx_rate" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableFxRate(d *decimal.Decimal) *WalletTransactionCreate {
+	if d != nil {
+		wtc.SetFxRate(*d)
+	}
+	return wtc
+}
+
+// SetFxRateID sets the "fx_rate_id" field.
+func (wtc *WalletTransactionCreate) SetFxRateID(s string) *WalletTransactionCreate {
+	wtc.mutation.SetFxRateID(s)
+	return wtc
+}
+
+// SetNillableFxRateID sets the "fx_rate_id" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableFxRateID(s *string) *WalletTransactionCreate {
+	if s != nil {
+		wtc.SetFxRateID(*s)
+	}
+	return wtc
+}
+
+// SetSettlementCurrency sets the "settlement_currency" field.
+func (wtc *WalletTransactionCreate) SetSettlementCurrency(s string) *WalletTransactionCreate {
+	wtc.mutation.SetSettlementCurrency(s)
+	return wtc
+}
+
+// SetNillableSettlementCurrency sets the "settlement_currency" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableSettlementCurrency(s *string) *WalletTransactionCreate {
+	if s != nil {
+		wtc.SetSettlementCurrency(*s)
+	}
+	return wtc
+}
+
+// SetSettlementAmount sets the "settlement_amount" field.
+func (wtc *WalletTransactionCreate) SetSettlementAmount(d decimal.Decimal) *WalletTransactionCreate {
+	wtc.mutation.SetSettlementAmount(d)
+	return wtc
+}
+
+// SetNillableSettlementAmount sets the "settlement_amount" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableSettlementAmount(d *decimal.Decimal) *WalletTransactionCreate {
+	if d != nil {
+		wtc.SetSettlementAmount(*d)
+	}
+	return wtc
+}
+
 // SetIdempotencyKey sets the "idempotency_key" field.
 func (wtc *WalletTransactionCreate) SetIdempotencyKey(s string) *WalletTransactionCreate {
 	wtc.mutation.SetIdempotencyKey(s)
@@ -621,6 +677,22 @@ func (wtc *WalletTransactionCreate) createSpec() (*WalletTransaction, *sqlgraph.
 		_spec.SetField(wallettransaction.FieldTopupConversionRate, field.TypeOther, value)
 		_node.TopupConversionRate = &value
 	}
+	if value, ok := wtc.mutation.FxRate(); ok {
+		_spec.SetField(wallettransaction.FieldFxRate, field.TypeOther, value)
+		_node.FxRate = &value
+	}
+	if value, ok := wtc.mutation.FxRateID(); ok {
+		_spec.SetField(wallettransaction.FieldFxRateID, field.TypeString, value)
+		_node.FxRateID = &value
+	}
+	if value, ok := wtc.mutation.SettlementCurrency(); ok {
+		_spec.SetField(wallettransaction.FieldSettlementCurrency, field.TypeString, value)
+		_node.SettlementCurrency = &value
+	}
+	if value, ok := wtc.mutation.SettlementAmount(); ok {
+		_spec.SetField(wallettransaction.FieldSettlementAmount, field.TypeOther, value)
+		_node.SettlementAmount = &value
+	}
 	if value, ok := wtc.mutation.IdempotencyKey(); ok {
 		_spec.SetField(wallettransaction.FieldIdempotencyKey, field.TypeString, value)
 		_node.IdempotencyKey = &value
@@ -438,6 +438,18 @@ func (wtu *WalletTransactionUpdate) sqlSave(ctx context.Context) (n int, err err
 	if wtu.mutation.TopupConversionRateCleared() {
 		_spec.ClearField(wallettransaction.FieldTopupConversionRate, field.TypeOther)
 	}
+	if wtu.mutation.FxRateCleared() {
+		_spec.ClearField(wallettransaction.FieldFxRate, field.TypeOther)
+	}
+	if wtu.mutation.FxRateIDCleared() {
+		_spec.ClearField(wallettransaction.FieldFxRateID, field.TypeString)
+	}
+	if wtu.mutation.SettlementCurrencyCleared() {
+		_spec.ClearField(wallettransaction.FieldSettlementCurrency, field.TypeString)
+	}
+	if wtu.mutation.SettlementAmountCleared() {
+		_spec.ClearField(wallettransaction.FieldSettlementAmount, field.TypeOther)
+	}
 	if wtu.mutation.IdempotencyKeyCleared() {
 		_spec.ClearField(wallettransaction.FieldIdempotencyKey, field.TypeString)
 	}
@@ -908,6 +920,18 @@ func (wtuo *WalletTransactionUpdateOne) sqlSave(ctx context.Context) (_node *Wal
 	if wtuo.mutation.TopupConversionRateCleared() {
 		_spec.ClearField(wallettransaction.FieldTopupConversionRate, field.TypeOther)
 	}
+	if wtuo.mutation.FxRateCleared() {
+		_spec.ClearField(wallettransaction.FieldFxRate, field.TypeOther)
+	}
+	if wtuo.mutation.FxRateIDCleared() {
+		_spec.ClearField(wallettransaction.FieldFxRateID, field.TypeString)
+	}
+	if wtuo.mutation.SettlementCurrencyCleared() {
+		_spec.ClearField(wallettransaction.FieldSettlementCurrency, field.TypeString)
+	}
+	if wtuo.mutation.SettlementAmountCleared() {
+		_spec.ClearField(wallettransaction.FieldSettlementAmount, field.TypeOther)
+	}
 	if wtuo.mutation.IdempotencyKeyCleared() {
 		_spec.ClearField(wallettransaction.FieldIdempotencyKey, field.TypeString)
 	}
@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/webhookdeliveryattempt"
+)
+
+// WebhookDeliveryAttempt is the model entity for the WebhookDeliveryAttempt schema.
+type WebhookDeliveryAttempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// SystemEventID holds the value of the "system_event_id" field.
+	SystemEventID string `json:"system_event_id,omitempty"`
+	// WebhookEndpointID holds the value of the "webhook_endpoint_id" field.
+	WebhookEndpointID string `json:"webhook_endpoint_id,omitempty"`
+	// EventName holds the value of the "event_name" field.
+	EventName string `json:"event_name,omitempty"`
+	// 1 for the first delivery of the event to the endpoint, incremented on every retry
+	AttemptNumber int `json:"attempt_number,omitempty"`
+	// RequestURL holds the value of the "request_url" field.
+	RequestURL string `json:"request_url,omitempty"`
+	// Headers sent with the request, custom header values are redacted
+	RequestHeaders map[string]string `json:"request_headers,omitempty"`
+	// RequestBody holds the value of the "request_body" field.
+	RequestBody string `json:"request_body,omitempty"`
+	// Empty when no response was received
+	ResponseStatusCode *int `json:"response_status_code,omitempty"`
+	// Excerpt of the response body
+	ResponseBody string `json:"response_body,omitempty"`
+	// LatencyMs holds the value of the "latency_ms" field.
+	LatencyMs int64 `json:"latency_ms,omitempty"`
+	// Success holds the value of the "success" field.
+	Success bool `json:"success,omitempty"`
+	// ErrorMessage holds the value of the "error_message" field.
+	ErrorMessage *string `json:"error_message,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookDeliveryAttempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookdeliveryattempt.FieldRequestHeaders:
+			values[i] = new([]byte)
+		case webhookdeliveryattempt.FieldSuccess:
+			values[i] = new(sql.NullBool)
+		case webhookdeliveryattempt.FieldAttemptNumber, webhookdeliveryattempt.FieldResponseStatusCode, webhookdeliveryattempt.FieldLatencyMs:
+			values[i] = new(sql.NullInt64)
+		case webhookdeliveryattempt.FieldID, webhookdeliveryattempt.FieldTenantID, webhookdeliveryattempt.FieldStatus, webhookdeliveryattempt.FieldCreatedBy, webhookdeliveryattempt.FieldUpdatedBy, webhookdeliveryattempt.FieldEnvironmentID, webhookdeliveryattempt.FieldSystemEventID, webhookdeliveryattempt.FieldWebhookEndpointID, webhookdeliveryattempt.FieldEventName, webhookdeliveryattempt.FieldRequestURL, webhookdeliveryattempt.FieldRequestBody, webhookdeliveryattempt.FieldResponseBody, webhookdeliveryattempt.FieldErrorMessage:
+			values[i] = new(sql.NullString)
+		case webhookdeliveryattempt.FieldCreatedAt, webhookdeliveryattempt.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookDeliveryAttempt fields.
+func (wda *WebhookDeliveryAttempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookdeliveryattempt.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				wda.ID = value.String
+			}
+		case webhookdeliveryattempt.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				wda.TenantID = value.String
+			}
+		case webhookdeliveryattempt.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				wda.Status = value.String
+			}
+		case webhookdeliveryattempt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wda.CreatedAt = value.Time
+			}
+		case webhookdeliveryattempt.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				wda.UpdatedAt = value.Time
+			}
+		case webhookdeliveryattempt.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				wda.CreatedBy = value.String
+			}
+		case webhookdeliveryattempt.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				wda.UpdatedBy = value.String
+			}
+		case webhookdeliveryattempt.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				wda.EnvironmentID = value.String
+			}
+		case webhookdeliveryattempt.FieldSystemEventID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field system_event_id", values[i])
+			} else if value.Valid {
+				wda.SystemEventID = value.String
+			}
+		case webhookdeliveryattempt.FieldWebhookEndpointID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field webhook_endpoint_id", values[i])
+			} else if value.Valid {
+				wda.WebhookEndpointID = value.String
+			}
+		case webhookdeliveryattempt.FieldEventName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_name", values[i])
+			} else if value.Valid {
+				wda.EventName = value.String
+			}
+		case webhookdeliveryattempt.FieldAttemptNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt_number", values[i])
+			} else if value.Valid {
+				wda.AttemptNumber = int(value.Int64)
+			}
+		case webhookdeliveryattempt.FieldRequestURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_url", values[i])
+			} else if value.Valid {
+				wda.RequestURL = value.String
+			}
+		case webhookdeliveryattempt.FieldRequestHeaders:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field request_headers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &wda.RequestHeaders); err != nil {
+					return fmt.Errorf("unmarshal field request_headers: %w", err)
+				}
+			}
+		case webhookdeliveryattempt.FieldRequestBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_body", values[i])
+			} else if value.Valid {
+				wda.RequestBody = value.String
+			}
+		case webhookdeliveryattempt.FieldResponseStatusCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field response_status_code", values[i])
+			} else if value.Valid {
+				wda.ResponseStatusCode = new(int)
+				*wda.ResponseStatusCode = int(value.Int64)
+			}
+		case webhookdeliveryattempt.FieldResponseBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field response_body", values[i])
+			} else if value.Valid {
+				wda.ResponseBody = value.String
+			}
+		case webhookdeliveryattempt.FieldLatencyMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latency_ms", values[i])
+			} else if value.Valid {
+				wda.LatencyMs = value.Int64
+			}
+		case webhookdeliveryattempt.FieldSuccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field success", values[i])
+			} else if value.Valid {
+				wda.Success = value.Bool
+			}
+		case webhookdeliveryattempt.FieldErrorMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_message", values[i])
+			} else if value.Valid {
+				wda.ErrorMessage = new(string)
+				*wda.ErrorMessage = value.String
+			}
+		default:
+			wda.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebhookDeliveryAttempt.
+// This includes values selected through modifiers, order, etc.
+func (wda *WebhookDeliveryAttempt) Value(name string) (ent.Value, error) {
+	return wda.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WebhookDeliveryAttempt.
+// Note that you need to call WebhookDeliveryAttempt.Unwrap() before calling this method if this WebhookDeliveryAttempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wda *WebhookDeliveryAttempt) Update() *WebhookDeliveryAttemptUpdateOne {
+	return NewWebhookDeliveryAttemptClient(wda.config).UpdateOne(wda)
+}
+
+// Unwrap unwraps the WebhookDeliveryAttempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wda *WebhookDeliveryAttempt) Unwrap() *WebhookDeliveryAttempt {
+	_tx, ok := wda.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebhookDeliveryAttempt is not a transactional entity")
+	}
+	wda.config.driver = _tx.drv
+	return wda
+}
+
+// String implements the fmt.Stringer.
+func (wda *WebhookDeliveryAttempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookDeliveryAttempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wda.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(wda.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(wda.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(wda.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(wda.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(wda.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(wda.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(wda.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("system_event_id=")
+	builder.WriteString(wda.SystemEventID)
+	builder.WriteString(", ")
+	builder.WriteString("webhook_endpoint_id=")
+	builder.WriteString(wda.WebhookEndpointID)
+	builder.WriteString(", ")
+	builder.WriteString("event_name=")
+	builder.WriteString(wda.EventName)
+	builder.WriteString(", ")
+	builder.WriteString("attempt_number=")
+	builder.WriteString(fmt.Sprintf("%v", wda.AttemptNumber))
+	builder.WriteString(", ")
+	builder.WriteString("request_url=")
+	builder.WriteString(wda.RequestURL)
+	builder.WriteString(", ")
+	builder.WriteString("request_headers=")
+	builder.WriteString(fmt.Sprintf("%v", wda.RequestHeaders))
+	builder.WriteString(", ")
+	builder.WriteString("request_body=")
+	builder.WriteString(wda.RequestBody)
+	builder.WriteString(", ")
+	if v := wda.ResponseStatusCode; v != nil {
+		builder.WriteString("response_status_code=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("response_body=")
+	builder.WriteString(wda.ResponseBody)
+	builder.WriteString(", ")
+	builder.WriteString("latency_ms=")
+	builder.WriteString(fmt.Sprintf("%v", wda.LatencyMs))
+	builder.WriteString(", ")
+	builder.WriteString("success=")
+	builder.WriteString(fmt.Sprintf("%v", wda.Success))
+	builder.WriteString(", ")
+	if v := wda.ErrorMessage; v != nil {
+		builder.WriteString("error_message=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookDeliveryAttempts is a parsable slice of WebhookDeliveryAttempt.
+type WebhookDeliveryAttempts []*WebhookDeliveryAttempt
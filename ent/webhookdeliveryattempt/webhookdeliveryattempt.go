@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package webhookdeliveryattempt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the webhookdeliveryattempt type in the database.
+	Label = "webhook_delivery_attempt"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldEnvironmentID holds the string denoting the environment_id field in the database.
+	FieldEnvironmentID = "environment_id"
+	// FieldSystemEventID holds the string denoting the system_event_id field in the database.
+	FieldSystemEventID = "system_event_id"
+	// FieldWebhookEndpointID holds the string denoting the webhook_endpoint_id field in the database.
+	FieldWebhookEndpointID = "webhook_endpoint_id"
+	// FieldEventName holds the string denoting the event_name field in the database.
+	FieldEventName = "event_name"
+	// FieldAttemptNumber holds the string denoting the attempt_number field in the database.
+	FieldAttemptNumber = "attempt_number"
+	// FieldRequestURL holds the string denoting the request_url field in the database.
+	FieldRequestURL = "request_url"
+	// FieldRequestHeaders holds the string denoting the request_headers field in the database.
+	FieldRequestHeaders = "request_headers"
+	// FieldRequestBody holds the string denoting the request_body field in the database.
+	FieldRequestBody = "request_body"
+	// FieldResponseStatusCode holds the string denoting the response_status_code field in the database.
+	FieldResponseStatusCode = "response_status_code"
+	// FieldResponseBody holds the string denoting the response_body field in the database.
+	FieldResponseBody = "response_body"
+	// FieldLatencyMs holds the string denoting the latency_ms field in the database.
+	FieldLatencyMs = "latency_ms"
+	// FieldSuccess holds the string denoting the success field in the database.
+	FieldSuccess = "success"
+	// FieldErrorMessage holds the string denoting the error_message field in the database.
+	FieldErrorMessage = "error_message"
+	// Table holds the table name of the webhookdeliveryattempt in the database.
+	Table = "webhook_delivery_attempts"
+)
+
+// Columns holds all SQL columns for webhookdeliveryattempt fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldEnvironmentID,
+	FieldSystemEventID,
+	FieldWebhookEndpointID,
+	FieldEventName,
+	FieldAttemptNumber,
+	FieldRequestURL,
+	FieldRequestHeaders,
+	FieldRequestBody,
+	FieldResponseStatusCode,
+	FieldResponseBody,
+	FieldLatencyMs,
+	FieldSuccess,
+	FieldErrorMessage,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultEnvironmentID holds the default value on creation for the "environment_id" field.
+	DefaultEnvironmentID string
+	// SystemEventIDValidator is a validator for the "system_event_id" field. It is called by the builders before save.
+	SystemEventIDValidator func(string) error
+	// WebhookEndpointIDValidator is a validator for the "webhook_endpoint_id" field. It is called by the builders before save.
+	WebhookEndpointIDValidator func(string) error
+	// EventNameValidator is a validator for the "event_name" field. It is called by the builders before save.
+	EventNameValidator func(string) error
+	// DefaultAttemptNumber holds the default value on creation for the "attempt_number" field.
+	DefaultAttemptNumber int
+	// DefaultLatencyMs holds the default value on creation for the "latency_ms" field.
+	DefaultLatencyMs int64
+	// DefaultSuccess holds the default value on creation for the "success" field.
+	DefaultSuccess bool
+)
+
+// OrderOption defines the ordering options for the WebhookDeliveryAttempt queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByEnvironmentID orders the results by the environment_id field.
+func ByEnvironmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvironmentID, opts...).ToFunc()
+}
+
+// BySystemEventID orders the results by the system_event_id field.
+func BySystemEventID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSystemEventID, opts...).ToFunc()
+}
+
+// ByWebhookEndpointID orders the results by the webhook_endpoint_id field.
+func ByWebhookEndpointID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWebhookEndpointID, opts...).ToFunc()
+}
+
+// ByEventName orders the results by the event_name field.
+func ByEventName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventName, opts...).ToFunc()
+}
+
+// ByAttemptNumber orders the results by the attempt_number field.
+func ByAttemptNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttemptNumber, opts...).ToFunc()
+}
+
+// ByRequestURL orders the results by the request_url field.
+func ByRequestURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestURL, opts...).ToFunc()
+}
+
+// ByRequestBody orders the results by the request_body field.
+func ByRequestBody(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestBody, opts...).ToFunc()
+}
+
+// ByResponseStatusCode orders the results by the response_status_code field.
+func ByResponseStatusCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponseStatusCode, opts...).ToFunc()
+}
+
+// ByResponseBody orders the results by the response_body field.
+func ByResponseBody(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponseBody, opts...).ToFunc()
+}
+
+// ByLatencyMs orders the results by the latency_ms field.
+func ByLatencyMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatencyMs, opts...).ToFunc()
+}
+
+// BySuccess orders the results by the success field.
+func BySuccess(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuccess, opts...).ToFunc()
+}
+
+// ByErrorMessage orders the results by the error_message field.
+func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
+}
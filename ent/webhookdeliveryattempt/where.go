@@ -0,0 +1,1240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package webhookdeliveryattempt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// SystemEventID applies equality check predicate on the "system_event_id" field. It's identical to SystemEventIDEQ.
+func SystemEventID(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldSystemEventID, v))
+}
+
+// WebhookEndpointID applies equality check predicate on the "webhook_endpoint_id" field. It's identical to WebhookEndpointIDEQ.
+func WebhookEndpointID(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldWebhookEndpointID, v))
+}
+
+// EventName applies equality check predicate on the "event_name" field. It's identical to EventNameEQ.
+func EventName(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldEventName, v))
+}
+
+// AttemptNumber applies equality check predicate on the "attempt_number" field. It's identical to AttemptNumberEQ.
+func AttemptNumber(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldAttemptNumber, v))
+}
+
+// RequestURL applies equality check predicate on the "request_url" field. It's identical to RequestURLEQ.
+func RequestURL(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldRequestURL, v))
+}
+
+// RequestBody applies equality check predicate on the "request_body" field. It's identical to RequestBodyEQ.
+func RequestBody(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldRequestBody, v))
+}
+
+// ResponseStatusCode applies equality check predicate on the "response_status_code" field. It's identical to ResponseStatusCodeEQ.
+func ResponseStatusCode(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldResponseStatusCode, v))
+}
+
+// ResponseBody applies equality check predicate on the "response_body" field. It's identical to ResponseBodyEQ.
+func ResponseBody(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldResponseBody, v))
+}
+
+// LatencyMs applies equality check predicate on the "latency_ms" field. It's identical to LatencyMsEQ.
+func LatencyMs(v int64) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// Success applies equality check predicate on the "success" field. It's identical to SuccessEQ.
+func Success(v bool) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldSuccess, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContains(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasPrefix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasSuffix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIsNil applies the IsNil predicate on the "environment_id" field.
+func EnvironmentIDIsNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIsNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDNotNil applies the NotNil predicate on the "environment_id" field.
+func EnvironmentIDNotNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEqualFold(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContainsFold(FieldEnvironmentID, v))
+}
+
+// SystemEventIDEQ applies the EQ predicate on the "system_event_id" field.
+func SystemEventIDEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldSystemEventID, v))
+}
+
+// SystemEventIDNEQ applies the NEQ predicate on the "system_event_id" field.
+func SystemEventIDNEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldSystemEventID, v))
+}
+
+// SystemEventIDIn applies the In predicate on the "system_event_id" field.
+func SystemEventIDIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldSystemEventID, vs...))
+}
+
+// SystemEventIDNotIn applies the NotIn predicate on the "system_event_id" field.
+func SystemEventIDNotIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldSystemEventID, vs...))
+}
+
+// SystemEventIDGT applies the GT predicate on the "system_event_id" field.
+func SystemEventIDGT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldSystemEventID, v))
+}
+
+// SystemEventIDGTE applies the GTE predicate on the "system_event_id" field.
+func SystemEventIDGTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldSystemEventID, v))
+}
+
+// SystemEventIDLT applies the LT predicate on the "system_event_id" field.
+func SystemEventIDLT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldSystemEventID, v))
+}
+
+// SystemEventIDLTE applies the LTE predicate on the "system_event_id" field.
+func SystemEventIDLTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldSystemEventID, v))
+}
+
+// SystemEventIDContains applies the Contains predicate on the "system_event_id" field.
+func SystemEventIDContains(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContains(FieldSystemEventID, v))
+}
+
+// SystemEventIDHasPrefix applies the HasPrefix predicate on the "system_event_id" field.
+func SystemEventIDHasPrefix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasPrefix(FieldSystemEventID, v))
+}
+
+// SystemEventIDHasSuffix applies the HasSuffix predicate on the "system_event_id" field.
+func SystemEventIDHasSuffix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasSuffix(FieldSystemEventID, v))
+}
+
+// SystemEventIDEqualFold applies the EqualFold predicate on the "system_event_id" field.
+func SystemEventIDEqualFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEqualFold(FieldSystemEventID, v))
+}
+
+// SystemEventIDContainsFold applies the ContainsFold predicate on the "system_event_id" field.
+func SystemEventIDContainsFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContainsFold(FieldSystemEventID, v))
+}
+
+// WebhookEndpointIDEQ applies the EQ predicate on the "webhook_endpoint_id" field.
+func WebhookEndpointIDEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldWebhookEndpointID, v))
+}
+
+// WebhookEndpointIDNEQ applies the NEQ predicate on the "webhook_endpoint_id" field.
+func WebhookEndpointIDNEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldWebhookEndpointID, v))
+}
+
+// WebhookEndpointIDIn applies the In predicate on the "webhook_endpoint_id" field.
+func WebhookEndpointIDIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldWebhookEndpointID, vs...))
+}
+
+// WebhookEndpointIDNotIn applies the NotIn predicate on the "webhook_endpoint_id" field.
+func WebhookEndpointIDNotIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldWebhookEndpointID, vs...))
+}
+
+// WebhookEndpointIDGT applies the GT predicate on the "webhook_endpoint_id" field.
+func WebhookEndpointIDGT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldWebhookEndpointID, v))
+}
+
+// WebhookEndpointIDGTE applies the GTE predicate on the "webhook_endpoint_id" field.
+func WebhookEndpointIDGTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldWebhookEndpointID, v))
+}
+
+// WebhookEndpointIDLT applies the LT predicate on the "webhook_endpoint_id" field.
+func WebhookEndpointIDLT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldWebhookEndpointID, v))
+}
+
+// WebhookEndpointIDLTE applies the LTE predicate on the "webhook_endpoint_id" field.
+func WebhookEndpointIDLTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldWebhookEndpointID, v))
+}
+
+// WebhookEndpointIDContains applies the Contains predicate on the "webhook_endpoint_id" field.
+func WebhookEndpointIDContains(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContains(FieldWebhookEndpointID, v))
+}
+
+// WebhookEndpointIDHasPrefix applies the HasPrefix predicate on the "webhook_endpoint_id" field.
+func WebhookEndpointIDHasPrefix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasPrefix(FieldWebhookEndpointID, v))
+}
+
+// WebhookEndpointIDHasSuffix applies the HasSuffix predicate on the "webhook_endpoint_id" field.
+func WebhookEndpointIDHasSuffix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasSuffix(FieldWebhookEndpointID, v))
+}
+
+// WebhookEndpointIDEqualFold applies the EqualFold predicate on the "webhook_endpoint_id" field.
+func WebhookEndpointIDEqualFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEqualFold(FieldWebhookEndpointID, v))
+}
+
+// WebhookEndpointIDContainsFold applies the ContainsFold predicate on the "webhook_endpoint_id" field.
+func WebhookEndpointIDContainsFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContainsFold(FieldWebhookEndpointID, v))
+}
+
+// EventNameEQ applies the EQ predicate on the "event_name" field.
+func EventNameEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldEventName, v))
+}
+
+// EventNameNEQ applies the NEQ predicate on the "event_name" field.
+func EventNameNEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldEventName, v))
+}
+
+// EventNameIn applies the In predicate on the "event_name" field.
+func EventNameIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldEventName, vs...))
+}
+
+// EventNameNotIn applies the NotIn predicate on the "event_name" field.
+func EventNameNotIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldEventName, vs...))
+}
+
+// EventNameGT applies the GT predicate on the "event_name" field.
+func EventNameGT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldEventName, v))
+}
+
+// EventNameGTE applies the GTE predicate on the "event_name" field.
+func EventNameGTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldEventName, v))
+}
+
+// EventNameLT applies the LT predicate on the "event_name" field.
+func EventNameLT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldEventName, v))
+}
+
+// EventNameLTE applies the LTE predicate on the "event_name" field.
+func EventNameLTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldEventName, v))
+}
+
+// EventNameContains applies the Contains predicate on the "event_name" field.
+func EventNameContains(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContains(FieldEventName, v))
+}
+
+// EventNameHasPrefix applies the HasPrefix predicate on the "event_name" field.
+func EventNameHasPrefix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasPrefix(FieldEventName, v))
+}
+
+// EventNameHasSuffix applies the HasSuffix predicate on the "event_name" field.
+func EventNameHasSuffix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasSuffix(FieldEventName, v))
+}
+
+// EventNameEqualFold applies the EqualFold predicate on the "event_name" field.
+func EventNameEqualFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEqualFold(FieldEventName, v))
+}
+
+// EventNameContainsFold applies the ContainsFold predicate on the "event_name" field.
+func EventNameContainsFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContainsFold(FieldEventName, v))
+}
+
+// AttemptNumberEQ applies the EQ predicate on the "attempt_number" field.
+func AttemptNumberEQ(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldAttemptNumber, v))
+}
+
+// AttemptNumberNEQ applies the NEQ predicate on the "attempt_number" field.
+func AttemptNumberNEQ(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldAttemptNumber, v))
+}
+
+// AttemptNumberIn applies the In predicate on the "attempt_number" field.
+func AttemptNumberIn(vs ...int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldAttemptNumber, vs...))
+}
+
+// AttemptNumberNotIn applies the NotIn predicate on the "attempt_number" field.
+func AttemptNumberNotIn(vs ...int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldAttemptNumber, vs...))
+}
+
+// AttemptNumberGT applies the GT predicate on the "attempt_number" field.
+func AttemptNumberGT(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldAttemptNumber, v))
+}
+
+// AttemptNumberGTE applies the GTE predicate on the "attempt_number" field.
+func AttemptNumberGTE(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldAttemptNumber, v))
+}
+
+// AttemptNumberLT applies the LT predicate on the "attempt_number" field.
+func AttemptNumberLT(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldAttemptNumber, v))
+}
+
+// AttemptNumberLTE applies the LTE predicate on the "attempt_number" field.
+func AttemptNumberLTE(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldAttemptNumber, v))
+}
+
+// RequestURLEQ applies the EQ predicate on the "request_url" field.
+func RequestURLEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldRequestURL, v))
+}
+
+// RequestURLNEQ applies the NEQ predicate on the "request_url" field.
+func RequestURLNEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldRequestURL, v))
+}
+
+// RequestURLIn applies the In predicate on the "request_url" field.
+func RequestURLIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldRequestURL, vs...))
+}
+
+// RequestURLNotIn applies the NotIn predicate on the "request_url" field.
+func RequestURLNotIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldRequestURL, vs...))
+}
+
+// RequestURLGT applies the GT predicate on the "request_url" field.
+func RequestURLGT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldRequestURL, v))
+}
+
+// RequestURLGTE applies the GTE predicate on the "request_url" field.
+func RequestURLGTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldRequestURL, v))
+}
+
+// RequestURLLT applies the LT predicate on the "request_url" field.
+func RequestURLLT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldRequestURL, v))
+}
+
+// RequestURLLTE applies the LTE predicate on the "request_url" field.
+func RequestURLLTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldRequestURL, v))
+}
+
+// RequestURLContains applies the Contains predicate on the "request_url" field.
+func RequestURLContains(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContains(FieldRequestURL, v))
+}
+
+// RequestURLHasPrefix applies the HasPrefix predicate on the "request_url" field.
+func RequestURLHasPrefix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasPrefix(FieldRequestURL, v))
+}
+
+// RequestURLHasSuffix applies the HasSuffix predicate on the "request_url" field.
+func RequestURLHasSuffix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasSuffix(FieldRequestURL, v))
+}
+
+// RequestURLEqualFold applies the EqualFold predicate on the "request_url" field.
+func RequestURLEqualFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEqualFold(FieldRequestURL, v))
+}
+
+// RequestURLContainsFold applies the ContainsFold predicate on the "request_url" field.
+func RequestURLContainsFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContainsFold(FieldRequestURL, v))
+}
+
+// RequestHeadersIsNil applies the IsNil predicate on the "request_headers" field.
+func RequestHeadersIsNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIsNull(FieldRequestHeaders))
+}
+
+// RequestHeadersNotNil applies the NotNil predicate on the "request_headers" field.
+func RequestHeadersNotNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotNull(FieldRequestHeaders))
+}
+
+// RequestBodyEQ applies the EQ predicate on the "request_body" field.
+func RequestBodyEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldRequestBody, v))
+}
+
+// RequestBodyNEQ applies the NEQ predicate on the "request_body" field.
+func RequestBodyNEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldRequestBody, v))
+}
+
+// RequestBodyIn applies the In predicate on the "request_body" field.
+func RequestBodyIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldRequestBody, vs...))
+}
+
+// RequestBodyNotIn applies the NotIn predicate on the "request_body" field.
+func RequestBodyNotIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldRequestBody, vs...))
+}
+
+// RequestBodyGT applies the GT predicate on the "request_body" field.
+func RequestBodyGT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldRequestBody, v))
+}
+
+// RequestBodyGTE applies the GTE predicate on the "request_body" field.
+func RequestBodyGTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldRequestBody, v))
+}
+
+// RequestBodyLT applies the LT predicate on the "request_body" field.
+func RequestBodyLT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldRequestBody, v))
+}
+
+// RequestBodyLTE applies the LTE predicate on the "request_body" field.
+func RequestBodyLTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldRequestBody, v))
+}
+
+// RequestBodyContains applies the Contains predicate on the "request_body" field.
+func RequestBodyContains(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContains(FieldRequestBody, v))
+}
+
+// RequestBodyHasPrefix applies the HasPrefix predicate on the "request_body" field.
+func RequestBodyHasPrefix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasPrefix(FieldRequestBody, v))
+}
+
+// RequestBodyHasSuffix applies the HasSuffix predicate on the "request_body" field.
+func RequestBodyHasSuffix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasSuffix(FieldRequestBody, v))
+}
+
+// RequestBodyIsNil applies the IsNil predicate on the "request_body" field.
+func RequestBodyIsNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIsNull(FieldRequestBody))
+}
+
+// RequestBodyNotNil applies the NotNil predicate on the "request_body" field.
+func RequestBodyNotNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotNull(FieldRequestBody))
+}
+
+// RequestBodyEqualFold applies the EqualFold predicate on the "request_body" field.
+func RequestBodyEqualFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEqualFold(FieldRequestBody, v))
+}
+
+// RequestBodyContainsFold applies the ContainsFold predicate on the "request_body" field.
+func RequestBodyContainsFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContainsFold(FieldRequestBody, v))
+}
+
+// ResponseStatusCodeEQ applies the EQ predicate on the "response_status_code" field.
+func ResponseStatusCodeEQ(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldResponseStatusCode, v))
+}
+
+// ResponseStatusCodeNEQ applies the NEQ predicate on the "response_status_code" field.
+func ResponseStatusCodeNEQ(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldResponseStatusCode, v))
+}
+
+// ResponseStatusCodeIn applies the In predicate on the "response_status_code" field.
+func ResponseStatusCodeIn(vs ...int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldResponseStatusCode, vs...))
+}
+
+// ResponseStatusCodeNotIn applies the NotIn predicate on the "response_status_code" field.
+func ResponseStatusCodeNotIn(vs ...int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldResponseStatusCode, vs...))
+}
+
+// ResponseStatusCodeGT applies the GT predicate on the "response_status_code" field.
+func ResponseStatusCodeGT(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldResponseStatusCode, v))
+}
+
+// ResponseStatusCodeGTE applies the GTE predicate on the "response_status_code" field.
+func ResponseStatusCodeGTE(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldResponseStatusCode, v))
+}
+
+// ResponseStatusCodeLT applies the LT predicate on the "response_status_code" field.
+func ResponseStatusCodeLT(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldResponseStatusCode, v))
+}
+
+// ResponseStatusCodeLTE applies the LTE predicate on the "response_status_code" field.
+func ResponseStatusCodeLTE(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldResponseStatusCode, v))
+}
+
+// ResponseStatusCodeIsNil applies the IsNil predicate on the "response_status_code" field.
+func ResponseStatusCodeIsNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIsNull(FieldResponseStatusCode))
+}
+
+// ResponseStatusCodeNotNil applies the NotNil predicate on the "response_status_code" field.
+func ResponseStatusCodeNotNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotNull(FieldResponseStatusCode))
+}
+
+// ResponseBodyEQ applies the EQ predicate on the "response_body" field.
+func ResponseBodyEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldResponseBody, v))
+}
+
+// ResponseBodyNEQ applies the NEQ predicate on the "response_body" field.
+func ResponseBodyNEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldResponseBody, v))
+}
+
+// ResponseBodyIn applies the In predicate on the "response_body" field.
+func ResponseBodyIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldResponseBody, vs...))
+}
+
+// ResponseBodyNotIn applies the NotIn predicate on the "response_body" field.
+func ResponseBodyNotIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldResponseBody, vs...))
+}
+
+// ResponseBodyGT applies the GT predicate on the "response_body" field.
+func ResponseBodyGT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldResponseBody, v))
+}
+
+// ResponseBodyGTE applies the GTE predicate on the "response_body" field.
+func ResponseBodyGTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldResponseBody, v))
+}
+
+// ResponseBodyLT applies the LT predicate on the "response_body" field.
+func ResponseBodyLT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldResponseBody, v))
+}
+
+// ResponseBodyLTE applies the LTE predicate on the "response_body" field.
+func ResponseBodyLTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldResponseBody, v))
+}
+
+// ResponseBodyContains applies the Contains predicate on the "response_body" field.
+func ResponseBodyContains(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContains(FieldResponseBody, v))
+}
+
+// ResponseBodyHasPrefix applies the HasPrefix predicate on the "response_body" field.
+func ResponseBodyHasPrefix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasPrefix(FieldResponseBody, v))
+}
+
+// ResponseBodyHasSuffix applies the HasSuffix predicate on the "response_body" field.
+func ResponseBodyHasSuffix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasSuffix(FieldResponseBody, v))
+}
+
+// ResponseBodyIsNil applies the IsNil predicate on the "response_body" field.
+func ResponseBodyIsNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIsNull(FieldResponseBody))
+}
+
+// ResponseBodyNotNil applies the NotNil predicate on the "response_body" field.
+func ResponseBodyNotNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotNull(FieldResponseBody))
+}
+
+// ResponseBodyEqualFold applies the EqualFold predicate on the "response_body" field.
+func ResponseBodyEqualFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEqualFold(FieldResponseBody, v))
+}
+
+// ResponseBodyContainsFold applies the ContainsFold predicate on the "response_body" field.
+func ResponseBodyContainsFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContainsFold(FieldResponseBody, v))
+}
+
+// LatencyMsEQ applies the EQ predicate on the "latency_ms" field.
+func LatencyMsEQ(v int64) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsNEQ applies the NEQ predicate on the "latency_ms" field.
+func LatencyMsNEQ(v int64) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsIn applies the In predicate on the "latency_ms" field.
+func LatencyMsIn(vs ...int64) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsNotIn applies the NotIn predicate on the "latency_ms" field.
+func LatencyMsNotIn(vs ...int64) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsGT applies the GT predicate on the "latency_ms" field.
+func LatencyMsGT(v int64) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldLatencyMs, v))
+}
+
+// LatencyMsGTE applies the GTE predicate on the "latency_ms" field.
+func LatencyMsGTE(v int64) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldLatencyMs, v))
+}
+
+// LatencyMsLT applies the LT predicate on the "latency_ms" field.
+func LatencyMsLT(v int64) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldLatencyMs, v))
+}
+
+// LatencyMsLTE applies the LTE predicate on the "latency_ms" field.
+func LatencyMsLTE(v int64) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldLatencyMs, v))
+}
+
+// SuccessEQ applies the EQ predicate on the "success" field.
+func SuccessEQ(v bool) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldSuccess, v))
+}
+
+// SuccessNEQ applies the NEQ predicate on the "success" field.
+func SuccessNEQ(v bool) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldSuccess, v))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageIsNil applies the IsNil predicate on the "error_message" field.
+func ErrorMessageIsNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIsNull(FieldErrorMessage))
+}
+
+// ErrorMessageNotNil applies the NotNil predicate on the "error_message" field.
+func ErrorMessageNotNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotNull(FieldErrorMessage))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WebhookDeliveryAttempt) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WebhookDeliveryAttempt) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WebhookDeliveryAttempt) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.NotPredicates(p))
+}
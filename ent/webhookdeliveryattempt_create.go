@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/webhookdeliveryattempt"
+)
+
+// WebhookDeliveryAttemptCreate is the builder for creating a WebhookDeliveryAttempt entity.
+type WebhookDeliveryAttemptCreate struct {
+	config
+	mutation *WebhookDeliveryAttemptMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetTenantID(s string) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetTenantID(s)
+	return wdac
+}
+
+// SetStatus sets the "status" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetStatus(s string) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetStatus(s)
+	return wdac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wdac *WebhookDeliveryAttemptCreate) SetNillableStatus(s *string) *WebhookDeliveryAttemptCreate {
+	if s != nil {
+		wdac.SetStatus(*s)
+	}
+	return wdac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetCreatedAt(t time.Time) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetCreatedAt(t)
+	return wdac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wdac *WebhookDeliveryAttemptCreate) SetNillableCreatedAt(t *time.Time) *WebhookDeliveryAttemptCreate {
+	if t != nil {
+		wdac.SetCreatedAt(*t)
+	}
+	return wdac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetUpdatedAt(t time.Time) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetUpdatedAt(t)
+	return wdac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wdac *WebhookDeliveryAttemptCreate) SetNillableUpdatedAt(t *time.Time) *WebhookDeliveryAttemptCreate {
+	if t != nil {
+		wdac.SetUpdatedAt(*t)
+	}
+	return wdac
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetCreatedBy(s string) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetCreatedBy(s)
+	return wdac
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (wdac *WebhookDeliveryAttemptCreate) SetNillableCreatedBy(s *string) *WebhookDeliveryAttemptCreate {
+	if s != nil {
+		wdac.SetCreatedBy(*s)
+	}
+	return wdac
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetUpdatedBy(s string) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetUpdatedBy(s)
+	return wdac
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wdac *WebhookDeliveryAttemptCreate) SetNillableUpdatedBy(s *string) *WebhookDeliveryAttemptCreate {
+	if s != nil {
+		wdac.SetUpdatedBy(*s)
+	}
+	return wdac
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetEnvironmentID(s string) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetEnvironmentID(s)
+	return wdac
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (wdac *WebhookDeliveryAttemptCreate) SetNillableEnvironmentID(s *string) *WebhookDeliveryAttemptCreate {
+	if s != nil {
+		wdac.SetEnvironmentID(*s)
+	}
+	return wdac
+}
+
+// SetSystemEventID sets the "system_event_id" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetSystemEventID(s string) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetSystemEventID(s)
+	return wdac
+}
+
+// SetWebhookEndpointID sets the "webhook_endpoint_id" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetWebhookEndpointID(s string) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetWebhookEndpointID(s)
+	return wdac
+}
+
+// SetEventName sets the "event_name" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetEventName(s string) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetEventName(s)
+	return wdac
+}
+
+// SetAttemptNumber sets the "attempt_number" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetAttemptNumber(i int) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetAttemptNumber(i)
+	return wdac
+}
+
+// SetNillableAttemptNumber sets the "attempt_number" field if the given value is not nil.
+func (wdac *WebhookDeliveryAttemptCreate) SetNillableAttemptNumber(i *int) *WebhookDeliveryAttemptCreate {
+	if i != nil {
+		wdac.SetAttemptNumber(*i)
+	}
+	return wdac
+}
+
+// SetRequestURL sets the "request_url" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetRequestURL(s string) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetRequestURL(s)
+	return wdac
+}
+
+// SetRequestHeaders sets the "request_headers" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetRequestHeaders(m map[string]string) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetRequestHeaders(m)
+	return wdac
+}
+
+// SetRequestBody sets the "request_body" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetRequestBody(s string) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetRequestBody(s)
+	return wdac
+}
+
+// SetNillableRequestBody sets the "request_body" field if the given value is not nil.
+func (wdac *WebhookDeliveryAttemptCreate) SetNillableRequestBody(s *string) *WebhookDeliveryAttemptCreate {
+	if s != nil {
+		wdac.SetRequestBody(*s)
+	}
+	return wdac
+}
+
+// SetResponseStatusCode sets the "response_status_code" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetResponseStatusCode(i int) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetResponseStatusCode(i)
+	return wdac
+}
+
+// SetNillableResponseStatusCode sets the "response_status_code" field if the given value is not nil.
+func (wdac *WebhookDeliveryAttemptCreate) SetNillableResponseStatusCode(i *int) *WebhookDeliveryAttemptCreate {
+	if i != nil {
+		wdac.SetResponseStatusCode(*i)
+	}
+	return wdac
+}
+
+// SetResponseBody sets the "response_body" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetResponseBody(s string) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetResponseBody(s)
+	return wdac
+}
+
+// SetNillableResponseBody sets the "response_body" field if the given value is not nil.
+func (wdac *WebhookDeliveryAttemptCreate) SetNillableResponseBody(s *string) *WebhookDeliveryAttemptCreate {
+	if s != nil {
+		wdac.SetResponseBody(*s)
+	}
+	return wdac
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetLatencyMs(i int64) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetLatencyMs(i)
+	return wdac
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (wdac *WebhookDeliveryAttemptCreate) SetNillableLatencyMs(i *int64) *WebhookDeliveryAttemptCreate {
+	if i != nil {
+		wdac.SetLatencyMs(*i)
+	}
+	return wdac
+}
+
+// SetSuccess sets the "success" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetSuccess(b bool) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetSuccess(b)
+	return wdac
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (wdac *WebhookDeliveryAttemptCreate) SetNillableSuccess(b *bool) *WebhookDeliveryAttemptCreate {
+	if b != nil {
+		wdac.SetSuccess(*b)
+	}
+	return wdac
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetErrorMessage(s string) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetErrorMessage(s)
+	return wdac
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (wdac *WebhookDeliveryAttemptCreate) SetNillableErrorMessage(s *string) *WebhookDeliveryAttemptCreate {
+	if s != nil {
+		wdac.SetErrorMessage(*s)
+	}
+	return wdac
+}
+
+// SetID sets the "id" field.
+func (wdac *WebhookDeliveryAttemptCreate) SetID(s string) *WebhookDeliveryAttemptCreate {
+	wdac.mutation.SetID(s)
+	return wdac
+}
+
+// Mutation returns the WebhookDeliveryAttemptMutation object of the builder.
+func (wdac *WebhookDeliveryAttemptCreate) Mutation() *WebhookDeliveryAttemptMutation {
+	return wdac.mutation
+}
+
+// Save creates the WebhookDeliveryAttempt in the database.
+func (wdac *WebhookDeliveryAttemptCreate) Save(ctx context.Context) (*WebhookDeliveryAttempt, error) {
+	wdac.defaults()
+	return withHooks(ctx, wdac.sqlSave, wdac.mutation, wdac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wdac *WebhookDeliveryAttemptCreate) SaveX(ctx context.Context) *WebhookDeliveryAttempt {
+	v, err := wdac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdac *WebhookDeliveryAttemptCreate) Exec(ctx context.Context) error {
+	_, err := wdac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdac *WebhookDeliveryAttemptCreate) ExecX(ctx context.Context) {
+	if err := wdac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdac *WebhookDeliveryAttemptCreate) defaults() {
+	if _, ok := wdac.mutation.Status(); !ok {
+		v := webhookdeliveryattempt.DefaultStatus
+		wdac.mutation.SetStatus(v)
+	}
+	if _, ok := wdac.mutation.CreatedAt(); !ok {
+		v := webhookdeliveryattempt.DefaultCreatedAt()
+		wdac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wdac.mutation.UpdatedAt(); !ok {
+		v := webhookdeliveryattempt.DefaultUpdatedAt()
+		wdac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wdac.mutation.EnvironmentID(); !ok {
+		v := webhookdeliveryattempt.DefaultEnvironmentID
+		wdac.mutation.SetEnvironmentID(v)
+	}
+	if _, ok := wdac.mutation.AttemptNumber(); !ok {
+		v := webhookdeliveryattempt.DefaultAttemptNumber
+		wdac.mutation.SetAttemptNumber(v)
+	}
+	if _, ok := wdac.mutation.LatencyMs(); !ok {
+		v := webhookdeliveryattempt.DefaultLatencyMs
+		wdac.mutation.SetLatencyMs(v)
+	}
+	if _, ok := wdac.mutation.Success(); !ok {
+		v := webhookdeliveryattempt.DefaultSuccess
+		wdac.mutation.SetSuccess(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdac *WebhookDeliveryAttemptCreate) check() error {
+	if _, ok := wdac.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "WebhookDeliveryAttempt.tenant_id"`)}
+	}
+	if v, ok := wdac.mutation.TenantID(); ok {
+		if err := webhookdeliveryattempt.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "WebhookDeliveryAttempt.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := wdac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WebhookDeliveryAttempt.status"`)}
+	}
+	if _, ok := wdac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebhookDeliveryAttempt.created_at"`)}
+	}
+	if _, ok := wdac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WebhookDeliveryAttempt.updated_at"`)}
+	}
+	if _, ok := wdac.mutation.SystemEventID(); !ok {
+		return &ValidationError{Name: "system_event_id", err: errors.New(`ent: missing required field "WebhookDeliveryAttempt.system_event_id"`)}
+	}
+	if v, ok := wdac.mutation.SystemEventID(); ok {
+		if err := webhookdeliveryattempt.SystemEventIDValidator(v); err != nil {
+			return &ValidationError{Name: "system_event_id", err: fmt.Errorf(`ent: validator failed for field "WebhookDeliveryAttempt.system_event_id": %w`, err)}
+		}
+	}
+	if _, ok := wdac.mutation.WebhookEndpointID(); !ok {
+		return &ValidationError{Name: "webhook_endpoint_id", err: errors.New(`ent: missing required field "WebhookDeliveryAttempt.webhook_endpoint_id"`)}
+	}
+	if v, ok := wdac.mutation.WebhookEndpointID(); ok {
+		if err := webhookdeliveryattempt.WebhookEndpointIDValidator(v); err != nil {
+			return &ValidationError{Name: "webhook_endpoint_id", err: fmt.Errorf(`ent: validator failed for field "WebhookDeliveryAttempt.webhook_endpoint_id": %w`, err)}
+		}
+	}
+	if _, ok := wdac.mutation.EventName(); !ok {
+		return &ValidationError{Name: "event_name", err: errors.New(`ent: missing required field "WebhookDeliveryAttempt.event_name"`)}
+	}
+	if v, ok := wdac.mutation.EventName(); ok {
+		if err := webhookdeliveryattempt.EventNameValidator(v); err != nil {
+			return &ValidationError{Name: "event_name", err: fmt.Errorf(`ent: validator failed for field "WebhookDeliveryAttempt.event_name": %w`, err)}
+		}
+	}
+	if _, ok := wdac.mutation.AttemptNumber(); !ok {
+		return &ValidationError{Name: "attempt_number", err: errors.New(`ent: missing required field "WebhookDeliveryAttempt.attempt_number"`)}
+	}
+	if _, ok := wdac.mutation.RequestURL(); !ok {
+		return &ValidationError{Name: "request_url", err: errors.New(`ent: missing required field "WebhookDeliveryAttempt.request_url"`)}
+	}
+	if _, ok := wdac.mutation.LatencyMs(); !ok {
+		return &ValidationError{Name: "latency_ms", err: errors.New(`ent: missing required field "WebhookDeliveryAttempt.latency_ms"`)}
+	}
+	if _, ok := wdac.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`ent: missing required field "WebhookDeliveryAttempt.success"`)}
+	}
+	return nil
+}
+
+func (wdac *WebhookDeliveryAttemptCreate) sqlSave(ctx context.Context) (*WebhookDeliveryAttempt, error) {
+	if err := wdac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wdac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wdac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected WebhookDeliveryAttempt.ID type: %T", _spec.ID.Value)
+		}
+	}
+	wdac.mutation.id = &_node.ID
+	wdac.mutation.done = true
+	return _node, nil
+}
+
+func (wdac *WebhookDeliveryAttemptCreate) createSpec() (*WebhookDeliveryAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookDeliveryAttempt{config: wdac.config}
+		_spec = sqlgraph.NewCreateSpec(webhookdeliveryattempt.Table, sqlgraph.NewFieldSpec(webhookdeliveryattempt.FieldID, field.TypeString))
+	)
+	if id, ok := wdac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wdac.mutation.TenantID(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := wdac.mutation.Status(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := wdac.mutation.CreatedAt(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wdac.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := wdac.mutation.CreatedBy(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := wdac.mutation.UpdatedBy(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := wdac.mutation.EnvironmentID(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := wdac.mutation.SystemEventID(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldSystemEventID, field.TypeString, value)
+		_node.SystemEventID = value
+	}
+	if value, ok := wdac.mutation.WebhookEndpointID(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldWebhookEndpointID, field.TypeString, value)
+		_node.WebhookEndpointID = value
+	}
+	if value, ok := wdac.mutation.EventName(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldEventName, field.TypeString, value)
+		_node.EventName = value
+	}
+	if value, ok := wdac.mutation.AttemptNumber(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldAttemptNumber, field.TypeInt, value)
+		_node.AttemptNumber = value
+	}
+	if value, ok := wdac.mutation.RequestURL(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldRequestURL, field.TypeString, value)
+		_node.RequestURL = value
+	}
+	if value, ok := wdac.mutation.RequestHeaders(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldRequestHeaders, field.TypeJSON, value)
+		_node.RequestHeaders = value
+	}
+	if value, ok := wdac.mutation.RequestBody(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldRequestBody, field.TypeString, value)
+		_node.RequestBody = value
+	}
+	if value, ok := wdac.mutation.ResponseStatusCode(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldResponseStatusCode, field.TypeInt, value)
+		_node.ResponseStatusCode = &value
+	}
+	if value, ok := wdac.mutation.ResponseBody(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldResponseBody, field.TypeString, value)
+		_node.ResponseBody = value
+	}
+	if value, ok := wdac.mutation.LatencyMs(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldLatencyMs, field.TypeInt64, value)
+		_node.LatencyMs = value
+	}
+	if value, ok := wdac.mutation.Success(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	if value, ok := wdac.mutation.ErrorMessage(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = &value
+	}
+	return _node, _spec
+}
+
+// WebhookDeliveryAttemptCreateBulk is the builder for creating many WebhookDeliveryAttempt entities in bulk.
+type WebhookDeliveryAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookDeliveryAttemptCreate
+}
+
+// Save creates the WebhookDeliveryAttempt entities in the database.
+func (wdacb *WebhookDeliveryAttemptCreateBulk) Save(ctx context.Context) ([]*WebhookDeliveryAttempt, error) {
+	if wdacb.err != nil {
+		return nil, wdacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wdacb.builders))
+	nodes := make([]*WebhookDeliveryAttempt, len(wdacb.builders))
+	mutators := make([]Mutator, len(wdacb.builders))
+	for i := range wdacb.builders {
+		func(i int, root context.Context) {
+			builder := wdacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookDeliveryAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wdacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wdacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wdacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdacb *WebhookDeliveryAttemptCreateBulk) SaveX(ctx context.Context) []*WebhookDeliveryAttempt {
+	v, err := wdacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdacb *WebhookDeliveryAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := wdacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdacb *WebhookDeliveryAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := wdacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
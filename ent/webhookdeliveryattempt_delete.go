@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/webhookdeliveryattempt"
+)
+
+// WebhookDeliveryAttemptDelete is the builder for deleting a WebhookDeliveryAttempt entity.
+type WebhookDeliveryAttemptDelete struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeliveryAttemptMutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryAttemptDelete builder.
+func (wdad *WebhookDeliveryAttemptDelete) Where(ps ...predicate.WebhookDeliveryAttempt) *WebhookDeliveryAttemptDelete {
+	wdad.mutation.Where(ps...)
+	return wdad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wdad *WebhookDeliveryAttemptDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wdad.sqlExec, wdad.mutation, wdad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdad *WebhookDeliveryAttemptDelete) ExecX(ctx context.Context) int {
+	n, err := wdad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wdad *WebhookDeliveryAttemptDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(webhookdeliveryattempt.Table, sqlgraph.NewFieldSpec(webhookdeliveryattempt.FieldID, field.TypeString))
+	if ps := wdad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wdad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wdad.mutation.done = true
+	return affected, err
+}
+
+// WebhookDeliveryAttemptDeleteOne is the builder for deleting a single WebhookDeliveryAttempt entity.
+type WebhookDeliveryAttemptDeleteOne struct {
+	wdad *WebhookDeliveryAttemptDelete
+}
+
+// Where appends a list predicates to the WebhookDeliveryAttemptDelete builder.
+func (wdado *WebhookDeliveryAttemptDeleteOne) Where(ps ...predicate.WebhookDeliveryAttempt) *WebhookDeliveryAttemptDeleteOne {
+	wdado.wdad.mutation.Where(ps...)
+	return wdado
+}
+
+// Exec executes the deletion query.
+func (wdado *WebhookDeliveryAttemptDeleteOne) Exec(ctx context.Context) error {
+	n, err := wdado.wdad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{webhookdeliveryattempt.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdado *WebhookDeliveryAttemptDeleteOne) ExecX(ctx context.Context) {
+	if err := wdado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/webhookdeliveryattempt"
+)
+
+// WebhookDeliveryAttemptQuery is the builder for querying WebhookDeliveryAttempt entities.
+type WebhookDeliveryAttemptQuery struct {
+	config
+	ctx        *QueryContext
+	order      []webhookdeliveryattempt.OrderOption
+	inters     []Interceptor
+	predicates []predicate.WebhookDeliveryAttempt
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WebhookDeliveryAttemptQuery builder.
+func (wdaq *WebhookDeliveryAttemptQuery) Where(ps ...predicate.WebhookDeliveryAttempt) *WebhookDeliveryAttemptQuery {
+	wdaq.predicates = append(wdaq.predicates, ps...)
+	return wdaq
+}
+
+// Limit the number of records to be returned by this query.
+func (wdaq *WebhookDeliveryAttemptQuery) Limit(limit int) *WebhookDeliveryAttemptQuery {
+	wdaq.ctx.Limit = &limit
+	return wdaq
+}
+
+// Offset to start from.
+func (wdaq *WebhookDeliveryAttemptQuery) Offset(offset int) *WebhookDeliveryAttemptQuery {
+	wdaq.ctx.Offset = &offset
+	return wdaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wdaq *WebhookDeliveryAttemptQuery) Unique(unique bool) *WebhookDeliveryAttemptQuery {
+	wdaq.ctx.Unique = &unique
+	return wdaq
+}
+
+// Order specifies how the records should be ordered.
+func (wdaq *WebhookDeliveryAttemptQuery) Order(o ...webhookdeliveryattempt.OrderOption) *WebhookDeliveryAttemptQuery {
+	wdaq.order = append(wdaq.order, o...)
+	return wdaq
+}
+
+// First returns the first WebhookDeliveryAttempt entity from the query.
+// Returns a *NotFoundError when no WebhookDeliveryAttempt was found.
+func (wdaq *WebhookDeliveryAttemptQuery) First(ctx context.Context) (*WebhookDeliveryAttempt, error) {
+	nodes, err := wdaq.Limit(1).All(setContextOp(ctx, wdaq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{webhookdeliveryattempt.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wdaq *WebhookDeliveryAttemptQuery) FirstX(ctx context.Context) *WebhookDeliveryAttempt {
+	node, err := wdaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WebhookDeliveryAttempt ID from the query.
+// Returns a *NotFoundError when no WebhookDeliveryAttempt ID was found.
+func (wdaq *WebhookDeliveryAttemptQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = wdaq.Limit(1).IDs(setContextOp(ctx, wdaq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{webhookdeliveryattempt.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wdaq *WebhookDeliveryAttemptQuery) FirstIDX(ctx context.Context) string {
+	id, err := wdaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WebhookDeliveryAttempt entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WebhookDeliveryAttempt entity is found.
+// Returns a *NotFoundError when no WebhookDeliveryAttempt entities are found.
+func (wdaq *WebhookDeliveryAttemptQuery) Only(ctx context.Context) (*WebhookDeliveryAttempt, error) {
+	nodes, err := wdaq.Limit(2).All(setContextOp(ctx, wdaq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{webhookdeliveryattempt.Label}
+	default:
+		return nil, &NotSingularError{webhookdeliveryattempt.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wdaq *WebhookDeliveryAttemptQuery) OnlyX(ctx context.Context) *WebhookDeliveryAttempt {
+	node, err := wdaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WebhookDeliveryAttempt ID in the query.
+// Returns a *NotSingularError when more than one WebhookDeliveryAttempt ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wdaq *WebhookDeliveryAttemptQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = wdaq.Limit(2).IDs(setContextOp(ctx, wdaq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{webhookdeliveryattempt.Label}
+	default:
+		err = &NotSingularError{webhookdeliveryattempt.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wdaq *WebhookDeliveryAttemptQuery) OnlyIDX(ctx context.Context) string {
+	id, err := wdaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WebhookDeliveryAttempts.
+func (wdaq *WebhookDeliveryAttemptQuery) All(ctx context.Context) ([]*WebhookDeliveryAttempt, error) {
+	ctx = setContextOp(ctx, wdaq.ctx, ent.OpQueryAll)
+	if err := wdaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WebhookDeliveryAttempt, *WebhookDeliveryAttemptQuery]()
+	return withInterceptors[[]*WebhookDeliveryAttempt](ctx, wdaq, qr, wdaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wdaq *WebhookDeliveryAttemptQuery) AllX(ctx context.Context) []*WebhookDeliveryAttempt {
+	nodes, err := wdaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WebhookDeliveryAttempt IDs.
+func (wdaq *WebhookDeliveryAttemptQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if wdaq.ctx.Unique == nil && wdaq.path != nil {
+		wdaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wdaq.ctx, ent.OpQueryIDs)
+	if err = wdaq.Select(webhookdeliveryattempt.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wdaq *WebhookDeliveryAttemptQuery) IDsX(ctx context.Context) []string {
+	ids, err := wdaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wdaq *WebhookDeliveryAttemptQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wdaq.ctx, ent.OpQueryCount)
+	if err := wdaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wdaq, querierCount[*WebhookDeliveryAttemptQuery](), wdaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wdaq *WebhookDeliveryAttemptQuery) CountX(ctx context.Context) int {
+	count, err := wdaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wdaq *WebhookDeliveryAttemptQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wdaq.ctx, ent.OpQueryExist)
+	switch _, err := wdaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wdaq *WebhookDeliveryAttemptQuery) ExistX(ctx context.Context) bool {
+	exist, err := wdaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WebhookDeliveryAttemptQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wdaq *WebhookDeliveryAttemptQuery) Clone() *WebhookDeliveryAttemptQuery {
+	if wdaq == nil {
+		return nil
+	}
+	return &WebhookDeliveryAttemptQuery{
+		config:     wdaq.config,
+		ctx:        wdaq.ctx.Clone(),
+		order:      append([]webhookdeliveryattempt.OrderOption{}, wdaq.order...),
+		inters:     append([]Interceptor{}, wdaq.inters...),
+		predicates: append([]predicate.WebhookDeliveryAttempt{}, wdaq.predicates...),
+		// clone intermediate query.
+		sql:  wdaq.sql.Clone(),
+		path: wdaq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WebhookDeliveryAttempt.Query().
+//		GroupBy(webhookdeliveryattempt.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wdaq *WebhookDeliveryAttemptQuery) GroupBy(field string, fields ...string) *WebhookDeliveryAttemptGroupBy {
+	wdaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WebhookDeliveryAttemptGroupBy{build: wdaq}
+	grbuild.flds = &wdaq.ctx.Fields
+	grbuild.label = webhookdeliveryattempt.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.WebhookDeliveryAttempt.Query().
+//		Select(webhookdeliveryattempt.FieldTenantID).
+//		Scan(ctx, &v)
+func (wdaq *WebhookDeliveryAttemptQuery) Select(fields ...string) *WebhookDeliveryAttemptSelect {
+	wdaq.ctx.Fields = append(wdaq.ctx.Fields, fields...)
+	sbuild := &WebhookDeliveryAttemptSelect{WebhookDeliveryAttemptQuery: wdaq}
+	sbuild.label = webhookdeliveryattempt.Label
+	sbuild.flds, sbuild.scan = &wdaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WebhookDeliveryAttemptSelect configured with the given aggregations.
+func (wdaq *WebhookDeliveryAttemptQuery) Aggregate(fns ...AggregateFunc) *WebhookDeliveryAttemptSelect {
+	return wdaq.Select().Aggregate(fns...)
+}
+
+func (wdaq *WebhookDeliveryAttemptQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wdaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wdaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wdaq.ctx.Fields {
+		if !webhookdeliveryattempt.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wdaq.path != nil {
+		prev, err := wdaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wdaq.sql = prev
+	}
+	return nil
+}
+
+func (wdaq *WebhookDeliveryAttemptQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WebhookDeliveryAttempt, error) {
+	var (
+		nodes = []*WebhookDeliveryAttempt{}
+		_spec = wdaq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WebhookDeliveryAttempt).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WebhookDeliveryAttempt{config: wdaq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wdaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (wdaq *WebhookDeliveryAttemptQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wdaq.querySpec()
+	_spec.Node.Columns = wdaq.ctx.Fields
+	if len(wdaq.ctx.Fields) > 0 {
+		_spec.Unique = wdaq.ctx.Unique != nil && *wdaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wdaq.driver, _spec)
+}
+
+func (wdaq *WebhookDeliveryAttemptQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(webhookdeliveryattempt.Table, webhookdeliveryattempt.Columns, sqlgraph.NewFieldSpec(webhookdeliveryattempt.FieldID, field.TypeString))
+	_spec.From = wdaq.sql
+	if unique := wdaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wdaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wdaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdeliveryattempt.FieldID)
+		for i := range fields {
+			if fields[i] != webhookdeliveryattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wdaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wdaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wdaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wdaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wdaq *WebhookDeliveryAttemptQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wdaq.driver.Dialect())
+	t1 := builder.Table(webhookdeliveryattempt.Table)
+	columns := wdaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = webhookdeliveryattempt.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wdaq.sql != nil {
+		selector = wdaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wdaq.ctx.Unique != nil && *wdaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range wdaq.predicates {
+		p(selector)
+	}
+	for _, p := range wdaq.order {
+		p(selector)
+	}
+	if offset := wdaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wdaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WebhookDeliveryAttemptGroupBy is the group-by builder for WebhookDeliveryAttempt entities.
+type WebhookDeliveryAttemptGroupBy struct {
+	selector
+	build *WebhookDeliveryAttemptQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wdagb *WebhookDeliveryAttemptGroupBy) Aggregate(fns ...AggregateFunc) *WebhookDeliveryAttemptGroupBy {
+	wdagb.fns = append(wdagb.fns, fns...)
+	return wdagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wdagb *WebhookDeliveryAttemptGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wdagb.build.ctx, ent.OpQueryGroupBy)
+	if err := wdagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WebhookDeliveryAttemptQuery, *WebhookDeliveryAttemptGroupBy](ctx, wdagb.build, wdagb, wdagb.build.inters, v)
+}
+
+func (wdagb *WebhookDeliveryAttemptGroupBy) sqlScan(ctx context.Context, root *WebhookDeliveryAttemptQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wdagb.fns))
+	for _, fn := range wdagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wdagb.flds)+len(wdagb.fns))
+		for _, f := range *wdagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wdagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wdagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WebhookDeliveryAttemptSelect is the builder for selecting fields of WebhookDeliveryAttempt entities.
+type WebhookDeliveryAttemptSelect struct {
+	*WebhookDeliveryAttemptQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wdas *WebhookDeliveryAttemptSelect) Aggregate(fns ...AggregateFunc) *WebhookDeliveryAttemptSelect {
+	wdas.fns = append(wdas.fns, fns...)
+	return wdas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wdas *WebhookDeliveryAttemptSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wdas.ctx, ent.OpQuerySelect)
+	if err := wdas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WebhookDeliveryAttemptQuery, *WebhookDeliveryAttemptSelect](ctx, wdas.WebhookDeliveryAttemptQuery, wdas, wdas.inters, v)
+}
+
+func (wdas *WebhookDeliveryAttemptSelect) sqlScan(ctx context.Context, root *WebhookDeliveryAttemptQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wdas.fns))
+	for _, fn := range wdas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wdas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wdas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/webhookdeliveryattempt"
+)
+
+// WebhookDeliveryAttemptUpdate is the builder for updating WebhookDeliveryAttempt entities.
+type WebhookDeliveryAttemptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeliveryAttemptMutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryAttemptUpdate builder.
+func (wdau *WebhookDeliveryAttemptUpdate) Where(ps ...predicate.WebhookDeliveryAttempt) *WebhookDeliveryAttemptUpdate {
+	wdau.mutation.Where(ps...)
+	return wdau
+}
+
+// SetStatus sets the "status" field.
+func (wdau *WebhookDeliveryAttemptUpdate) SetStatus(s string) *WebhookDeliveryAttemptUpdate {
+	wdau.mutation.SetStatus(s)
+	return wdau
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wdau *WebhookDeliveryAttemptUpdate) SetNillableStatus(s *string) *WebhookDeliveryAttemptUpdate {
+	if s != nil {
+		wdau.SetStatus(*s)
+	}
+	return wdau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdau *WebhookDeliveryAttemptUpdate) SetUpdatedAt(t time.Time) *WebhookDeliveryAttemptUpdate {
+	wdau.mutation.SetUpdatedAt(t)
+	return wdau
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wdau *WebhookDeliveryAttemptUpdate) SetUpdatedBy(s string) *WebhookDeliveryAttemptUpdate {
+	wdau.mutation.SetUpdatedBy(s)
+	return wdau
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wdau *WebhookDeliveryAttemptUpdate) SetNillableUpdatedBy(s *string) *WebhookDeliveryAttemptUpdate {
+	if s != nil {
+		wdau.SetUpdatedBy(*s)
+	}
+	return wdau
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (wdau *WebhookDeliveryAttemptUpdate) ClearUpdatedBy() *WebhookDeliveryAttemptUpdate {
+	wdau.mutation.ClearUpdatedBy()
+	return wdau
+}
+
+// Mutation returns the WebhookDeliveryAttemptMutation object of the builder.
+func (wdau *WebhookDeliveryAttemptUpdate) Mutation() *WebhookDeliveryAttemptMutation {
+	return wdau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdau *WebhookDeliveryAttemptUpdate) Save(ctx context.Context) (int, error) {
+	wdau.defaults()
+	return withHooks(ctx, wdau.sqlSave, wdau.mutation, wdau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdau *WebhookDeliveryAttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdau *WebhookDeliveryAttemptUpdate) Exec(ctx context.Context) error {
+	_, err := wdau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdau *WebhookDeliveryAttemptUpdate) ExecX(ctx context.Context) {
+	if err := wdau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdau *WebhookDeliveryAttemptUpdate) defaults() {
+	if _, ok := wdau.mutation.UpdatedAt(); !ok {
+		v := webhookdeliveryattempt.UpdateDefaultUpdatedAt()
+		wdau.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (wdau *WebhookDeliveryAttemptUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webhookdeliveryattempt.Table, webhookdeliveryattempt.Columns, sqlgraph.NewFieldSpec(webhookdeliveryattempt.FieldID, field.TypeString))
+	if ps := wdau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdau.mutation.Status(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := wdau.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wdau.mutation.CreatedByCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := wdau.mutation.UpdatedBy(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldUpdatedBy, field.TypeString, value)
+	}
+	if wdau.mutation.UpdatedByCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldUpdatedBy, field.TypeString)
+	}
+	if wdau.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldEnvironmentID, field.TypeString)
+	}
+	if wdau.mutation.RequestHeadersCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldRequestHeaders, field.TypeJSON)
+	}
+	if wdau.mutation.RequestBodyCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldRequestBody, field.TypeString)
+	}
+	if wdau.mutation.ResponseStatusCodeCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldResponseStatusCode, field.TypeInt)
+	}
+	if wdau.mutation.ResponseBodyCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldResponseBody, field.TypeString)
+	}
+	if wdau.mutation.ErrorMessageCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldErrorMessage, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wdau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdeliveryattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wdau.mutation.done = true
+	return n, nil
+}
+
+// WebhookDeliveryAttemptUpdateOne is the builder for updating a single WebhookDeliveryAttempt entity.
+type WebhookDeliveryAttemptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookDeliveryAttemptMutation
+}
+
+// SetStatus sets the "status" field.
+func (wdauo *WebhookDeliveryAttemptUpdateOne) SetStatus(s string) *WebhookDeliveryAttemptUpdateOne {
+	wdauo.mutation.SetStatus(s)
+	return wdauo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wdauo *WebhookDeliveryAttemptUpdateOne) SetNillableStatus(s *string) *WebhookDeliveryAttemptUpdateOne {
+	if s != nil {
+		wdauo.SetStatus(*s)
+	}
+	return wdauo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdauo *WebhookDeliveryAttemptUpdateOne) SetUpdatedAt(t time.Time) *WebhookDeliveryAttemptUpdateOne {
+	wdauo.mutation.SetUpdatedAt(t)
+	return wdauo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wdauo *WebhookDeliveryAttemptUpdateOne) SetUpdatedBy(s string) *WebhookDeliveryAttemptUpdateOne {
+	wdauo.mutation.SetUpdatedBy(s)
+	return wdauo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wdauo *WebhookDeliveryAttemptUpdateOne) SetNillableUpdatedBy(s *string) *WebhookDeliveryAttemptUpdateOne {
+	if s != nil {
+		wdauo.SetUpdatedBy(*s)
+	}
+	return wdauo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (wdauo *WebhookDeliveryAttemptUpdateOne) ClearUpdatedBy() *WebhookDeliveryAttemptUpdateOne {
+	wdauo.mutation.ClearUpdatedBy()
+	return wdauo
+}
+
+// Mutation returns the WebhookDeliveryAttemptMutation object of the builder.
+func (wdauo *WebhookDeliveryAttemptUpdateOne) Mutation() *WebhookDeliveryAttemptMutation {
+	return wdauo.mutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryAttemptUpdate builder.
+func (wdauo *WebhookDeliveryAttemptUpdateOne) Where(ps ...predicate.WebhookDeliveryAttempt) *WebhookDeliveryAttemptUpdateOne {
+	wdauo.mutation.Where(ps...)
+	return wdauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wdauo *WebhookDeliveryAttemptUpdateOne) Select(field string, fields ...string) *WebhookDeliveryAttemptUpdateOne {
+	wdauo.fields = append([]string{field}, fields...)
+	return wdauo
+}
+
+// Save executes the query and returns the updated WebhookDeliveryAttempt entity.
+func (wdauo *WebhookDeliveryAttemptUpdateOne) Save(ctx context.Context) (*WebhookDeliveryAttempt, error) {
+	wdauo.defaults()
+	return withHooks(ctx, wdauo.sqlSave, wdauo.mutation, wdauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdauo *WebhookDeliveryAttemptUpdateOne) SaveX(ctx context.Context) *WebhookDeliveryAttempt {
+	node, err := wdauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wdauo *WebhookDeliveryAttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := wdauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdauo *WebhookDeliveryAttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := wdauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdauo *WebhookDeliveryAttemptUpdateOne) defaults() {
+	if _, ok := wdauo.mutation.UpdatedAt(); !ok {
+		v := webhookdeliveryattempt.UpdateDefaultUpdatedAt()
+		wdauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (wdauo *WebhookDeliveryAttemptUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDeliveryAttempt, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webhookdeliveryattempt.Table, webhookdeliveryattempt.Columns, sqlgraph.NewFieldSpec(webhookdeliveryattempt.FieldID, field.TypeString))
+	id, ok := wdauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookDeliveryAttempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wdauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdeliveryattempt.FieldID)
+		for _, f := range fields {
+			if !webhookdeliveryattempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookdeliveryattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wdauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdauo.mutation.Status(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := wdauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wdauo.mutation.CreatedByCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := wdauo.mutation.UpdatedBy(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldUpdatedBy, field.TypeString, value)
+	}
+	if wdauo.mutation.UpdatedByCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldUpdatedBy, field.TypeString)
+	}
+	if wdauo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldEnvironmentID, field.TypeString)
+	}
+	if wdauo.mutation.RequestHeadersCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldRequestHeaders, field.TypeJSON)
+	}
+	if wdauo.mutation.RequestBodyCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldRequestBody, field.TypeString)
+	}
+	if wdauo.mutation.ResponseStatusCodeCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldResponseStatusCode, field.TypeInt)
+	}
+	if wdauo.mutation.ResponseBodyCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldResponseBody, field.TypeString)
+	}
+	if wdauo.mutation.ErrorMessageCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldErrorMessage, field.TypeString)
+	}
+	_node = &WebhookDeliveryAttempt{config: wdauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wdauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdeliveryattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wdauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,631 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/webhookendpoint"
+)
+
+// WebhookEndpointCreate is the builder for creating a WebhookEndpoint entity.
+type WebhookEndpointCreate struct {
+	config
+	mutation *WebhookEndpointMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (wec *WebhookEndpointCreate) SetTenantID(s string) *WebhookEndpointCreate {
+	wec.mutation.SetTenantID(s)
+	return wec
+}
+
+// SetStatus sets the "status" field.
+func (wec *WebhookEndpointCreate) SetStatus(s string) *WebhookEndpointCreate {
+	wec.mutation.SetStatus(s)
+	return wec
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillableStatus(s *string) *WebhookEndpointCreate {
+	if s != nil {
+		wec.SetStatus(*s)
+	}
+	return wec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wec *WebhookEndpointCreate) SetCreatedAt(t time.Time) *WebhookEndpointCreate {
+	wec.mutation.SetCreatedAt(t)
+	return wec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillableCreatedAt(t *time.Time) *WebhookEndpointCreate {
+	if t != nil {
+		wec.SetCreatedAt(*t)
+	}
+	return wec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wec *WebhookEndpointCreate) SetUpdatedAt(t time.Time) *WebhookEndpointCreate {
+	wec.mutation.SetUpdatedAt(t)
+	return wec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillableUpdatedAt(t *time.Time) *WebhookEndpointCreate {
+	if t != nil {
+		wec.SetUpdatedAt(*t)
+	}
+	return wec
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (wec *WebhookEndpointCreate) SetCreatedBy(s string) *WebhookEndpointCreate {
+	wec.mutation.SetCreatedBy(s)
+	return wec
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillableCreatedBy(s *string) *WebhookEndpointCreate {
+	if s != nil {
+		wec.SetCreatedBy(*s)
+	}
+	return wec
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wec *WebhookEndpointCreate) SetUpdatedBy(s string) *WebhookEndpointCreate {
+	wec.mutation.SetUpdatedBy(s)
+	return wec
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillableUpdatedBy(s *string) *WebhookEndpointCreate {
+	if s != nil {
+		wec.SetUpdatedBy(*s)
+	}
+	return wec
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (wec *WebhookEndpointCreate) SetEnvironmentID(s string) *WebhookEndpointCreate {
+	wec.mutation.SetEnvironmentID(s)
+	return wec
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillableEnvironmentID(s *string) *WebhookEndpointCreate {
+	if s != nil {
+		wec.SetEnvironmentID(*s)
+	}
+	return wec
+}
+
+// SetURL sets the "url" field.
+func (wec *WebhookEndpointCreate) SetURL(s string) *WebhookEndpointCreate {
+	wec.mutation.SetURL(s)
+	return wec
+}
+
+// SetDescription sets the "description" field.
+func (wec *WebhookEndpointCreate) SetDescription(s string) *WebhookEndpointCreate {
+	wec.mutation.SetDescription(s)
+	return wec
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillableDescription(s *string) *WebhookEndpointCreate {
+	if s != nil {
+		wec.SetDescription(*s)
+	}
+	return wec
+}
+
+// SetEventTypes sets the "event_types" field.
+func (wec *WebhookEndpointCreate) SetEventTypes(s []string) *WebhookEndpointCreate {
+	wec.mutation.SetEventTypes(s)
+	return wec
+}
+
+// SetHeaders sets the "headers" field.
+func (wec *WebhookEndpointCreate) SetHeaders(m map[string]string) *WebhookEndpointCreate {
+	wec.mutation.SetHeaders(m)
+	return wec
+}
+
+// SetEnabled sets the "enabled" field.
+func (wec *WebhookEndpointCreate) SetEnabled(b bool) *WebhookEndpointCreate {
+	wec.mutation.SetEnabled(b)
+	return wec
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillableEnabled(b *bool) *WebhookEndpointCreate {
+	if b != nil {
+		wec.SetEnabled(*b)
+	}
+	return wec
+}
+
+// SetSigningSecret sets the "signing_secret" field.
+func (wec *WebhookEndpointCreate) SetSigningSecret(s string) *WebhookEndpointCreate {
+	wec.mutation.SetSigningSecret(s)
+	return wec
+}
+
+// SetNillableSigningSecret sets the "signing_secret" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillableSigningSecret(s *string) *WebhookEndpointCreate {
+	if s != nil {
+		wec.SetSigningSecret(*s)
+	}
+	return wec
+}
+
+// SetPreviousSigningSecret sets the "previous_signing_secret" field.
+func (wec *WebhookEndpointCreate) SetPreviousSigningSecret(s string) *WebhookEndpointCreate {
+	wec.mutation.SetPreviousSigningSecret(s)
+	return wec
+}
+
+// SetNillablePreviousSigningSecret sets the "previous_signing_secret" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillablePreviousSigningSecret(s *string) *WebhookEndpointCreate {
+	if s != nil {
+		wec.SetPreviousSigningSecret(*s)
+	}
+	return wec
+}
+
+// SetPreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field.
+func (wec *WebhookEndpointCreate) SetPreviousSigningSecretExpiresAt(t time.Time) *WebhookEndpointCreate {
+	wec.mutation.SetPreviousSigningSecretExpiresAt(t)
+	return wec
+}
+
+// SetNillablePreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillablePreviousSigningSecretExpiresAt(t *time.Time) *WebhookEndpointCreate {
+	if t != nil {
+		wec.SetPreviousSigningSecretExpiresAt(*t)
+	}
+	return wec
+}
+
+// SetMetadata sets the "metadata" field.
+func (wec *WebhookEndpointCreate) SetMetadata(m map[string]string) *WebhookEndpointCreate {
+	wec.mutation.SetMetadata(m)
+	return wec
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (wec *WebhookEndpointCreate) SetConsecutiveFailures(i int) *WebhookEndpointCreate {
+	wec.mutation.SetConsecutiveFailures(i)
+	return wec
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillableConsecutiveFailures(i *int) *WebhookEndpointCreate {
+	if i != nil {
+		wec.SetConsecutiveFailures(*i)
+	}
+	return wec
+}
+
+// SetFailingSince sets the "failing_since" field.
+func (wec *WebhookEndpointCreate) SetFailingSince(t time.Time) *WebhookEndpointCreate {
+	wec.mutation.SetFailingSince(t)
+	return wec
+}
+
+// SetNillableFailingSince sets the "failing_since" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillableFailingSince(t *time.Time) *WebhookEndpointCreate {
+	if t != nil {
+		wec.SetFailingSince(*t)
+	}
+	return wec
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (wec *WebhookEndpointCreate) SetLastSuccessAt(t time.Time) *WebhookEndpointCreate {
+	wec.mutation.SetLastSuccessAt(t)
+	return wec
+}
+
+// SetNillableLastSuccessAt sets the "last_success_at" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillableLastSuccessAt(t *time.Time) *WebhookEndpointCreate {
+	if t != nil {
+		wec.SetLastSuccessAt(*t)
+	}
+	return wec
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (wec *WebhookEndpointCreate) SetLastFailureAt(t time.Time) *WebhookEndpointCreate {
+	wec.mutation.SetLastFailureAt(t)
+	return wec
+}
+
+// SetNillableLastFailureAt sets the "last_failure_at" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillableLastFailureAt(t *time.Time) *WebhookEndpointCreate {
+	if t != nil {
+		wec.SetLastFailureAt(*t)
+	}
+	return wec
+}
+
+// SetDisabledReason sets the "disabled_reason" field.
+func (wec *WebhookEndpointCreate) SetDisabledReason(s string) *WebhookEndpointCreate {
+	wec.mutation.SetDisabledReason(s)
+	return wec
+}
+
+// SetNillableDisabledReason sets the "disabled_reason" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillableDisabledReason(s *string) *WebhookEndpointCreate {
+	if s != nil {
+		wec.SetDisabledReason(*s)
+	}
+	return wec
+}
+
+// SetPayloadVersion sets the "payload_version" field.
+func (wec *WebhookEndpointCreate) SetPayloadVersion(s string) *WebhookEndpointCreate {
+	wec.mutation.SetPayloadVersion(s)
+	return wec
+}
+
+// SetNillablePayloadVersion sets the "payload_version" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillablePayloadVersion(s *string) *WebhookEndpointCreate {
+	if s != nil {
+		wec.SetPayloadVersion(*s)
+	}
+	return wec
+}
+
+// SetPayloadMode sets the "payload_mode" field.
+func (wec *WebhookEndpointCreate) SetPayloadMode(s string) *WebhookEndpointCreate {
+	wec.mutation.SetPayloadMode(s)
+	return wec
+}
+
+// SetNillablePayloadMode sets the "payload_mode" field if the given value is not nil.
+func (wec *WebhookEndpointCreate) SetNillablePayloadMode(s *string) *WebhookEndpointCreate {
+	if s != nil {
+		wec.SetPayloadMode(*s)
+	}
+	return wec
+}
+
+// SetID sets the "id" field.
+func (wec *WebhookEndpointCreate) SetID(s string) *WebhookEndpointCreate {
+	wec.mutation.SetID(s)
+	return wec
+}
+
+// Mutation returns the WebhookEndpointMutation object of the builder.
+func (wec *WebhookEndpointCreate) Mutation() *WebhookEndpointMutation {
+	return wec.mutation
+}
+
+// Save creates the WebhookEndpoint in the database.
+func (wec *WebhookEndpointCreate) Save(ctx context.Context) (*WebhookEndpoint, error) {
+	wec.defaults()
+	return withHooks(ctx, wec.sqlSave, wec.mutation, wec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wec *WebhookEndpointCreate) SaveX(ctx context.Context) *WebhookEndpoint {
+	v, err := wec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wec *WebhookEndpointCreate) Exec(ctx context.Context) error {
+	_, err := wec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wec *WebhookEndpointCreate) ExecX(ctx context.Context) {
+	if err := wec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wec *WebhookEndpointCreate) defaults() {
+	if _, ok := wec.mutation.Status(); !ok {
+		v := webhookendpoint.DefaultStatus
+		wec.mutation.SetStatus(v)
+	}
+	if _, ok := wec.mutation.CreatedAt(); !ok {
+		v := webhookendpoint.DefaultCreatedAt()
+		wec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wec.mutation.UpdatedAt(); !ok {
+		v := webhookendpoint.DefaultUpdatedAt()
+		wec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wec.mutation.EnvironmentID(); !ok {
+		v := webhookendpoint.DefaultEnvironmentID
+		wec.mutation.SetEnvironmentID(v)
+	}
+	if _, ok := wec.mutation.Enabled(); !ok {
+		v := webhookendpoint.DefaultEnabled
+		wec.mutation.SetEnabled(v)
+	}
+	if _, ok := wec.mutation.ConsecutiveFailures(); !ok {
+		v := webhookendpoint.DefaultConsecutiveFailures
+		wec.mutation.SetConsecutiveFailures(v)
+	}
+	if _, ok := wec.mutation.PayloadVersion(); !ok {
+		v := webhookendpoint.DefaultPayloadVersion
+		wec.mutation.SetPayloadVersion(v)
+	}
+	if _, ok := wec.mutation.PayloadMode(); !ok {
+		v := webhookendpoint.DefaultPayloadMode
+		wec.mutation.SetPayloadMode(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wec *WebhookEndpointCreate) check() error {
+	if _, ok := wec.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "WebhookEndpoint.tenant_id"`)}
+	}
+	if v, ok := wec.mutation.TenantID(); ok {
+		if err := webhookendpoint.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "WebhookEndpoint.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := wec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WebhookEndpoint.status"`)}
+	}
+	if _, ok := wec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebhookEndpoint.created_at"`)}
+	}
+	if _, ok := wec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WebhookEndpoint.updated_at"`)}
+	}
+	if _, ok := wec.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "WebhookEndpoint.url"`)}
+	}
+	if v, ok := wec.mutation.URL(); ok {
+		if err := webhookendpoint.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WebhookEndpoint.url": %w`, err)}
+		}
+	}
+	if _, ok := wec.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "WebhookEndpoint.enabled"`)}
+	}
+	if _, ok := wec.mutation.ConsecutiveFailures(); !ok {
+		return &ValidationError{Name: "consecutive_failures", err: errors.New(`ent: missing required field "WebhookEndpoint.consecutive_failures"`)}
+	}
+	if _, ok := wec.mutation.PayloadVersion(); !ok {
+		return &ValidationError{Name: "payload_version", err: errors.New(`ent: missing required field "WebhookEndpoint.payload_version"`)}
+	}
+	if _, ok := wec.mutation.PayloadMode(); !ok {
+		return &ValidationError{Name: "payload_mode", err: errors.New(`ent: missing required field "WebhookEndpoint.payload_mode"`)}
+	}
+	return nil
+}
+
+func (wec *WebhookEndpointCreate) sqlSave(ctx context.Context) (*WebhookEndpoint, error) {
+	if err := wec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected WebhookEndpoint.ID type: %T", _spec.ID.Value)
+		}
+	}
+	wec.mutation.id = &_node.ID
+	wec.mutation.done = true
+	return _node, nil
+}
+
+func (wec *WebhookEndpointCreate) createSpec() (*WebhookEndpoint, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookEndpoint{config: wec.config}
+		_spec = sqlgraph.NewCreateSpec(webhookendpoint.Table, sqlgraph.NewFieldSpec(webhookendpoint.FieldID, field.TypeString))
+	)
+	if id, ok := wec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wec.mutation.TenantID(); ok {
+		_spec.SetField(webhookendpoint.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := wec.mutation.Status(); ok {
+		_spec.SetField(webhookendpoint.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := wec.mutation.CreatedAt(); ok {
+		_spec.SetField(webhookendpoint.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wec.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookendpoint.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := wec.mutation.CreatedBy(); ok {
+		_spec.SetField(webhookendpoint.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := wec.mutation.UpdatedBy(); ok {
+		_spec.SetField(webhookendpoint.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := wec.mutation.EnvironmentID(); ok {
+		_spec.SetField(webhookendpoint.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := wec.mutation.URL(); ok {
+		_spec.SetField(webhookendpoint.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := wec.mutation.Description(); ok {
+		_spec.SetField(webhookendpoint.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := wec.mutation.EventTypes(); ok {
+		_spec.SetField(webhookendpoint.FieldEventTypes, field.TypeJSON, value)
+		_node.EventTypes = value
+	}
+	if value, ok := wec.mutation.Headers(); ok {
+		_spec.SetField(webhookendpoint.FieldHeaders, field.TypeJSON, value)
+		_node.Headers = value
+	}
+	if value, ok := wec.mutation.Enabled(); ok {
+		_spec.SetField(webhookendpoint.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := wec.mutation.SigningSecret(); ok {
+		_spec.SetField(webhookendpoint.FieldSigningSecret, field.TypeString, value)
+		_node.SigningSecret = value
+	}
+	if value, ok := wec.mutation.PreviousSigningSecret(); ok {
+		_spec.SetField(webhookendpoint.FieldPreviousSigningSecret, field.TypeString, value)
+		_node.PreviousSigningSecret = &value
+	}
+	if value, ok := wec.mutation.PreviousSigningSecretExpiresAt(); ok {
+		_spec.SetField(webhookendpoint.FieldPreviousSigningSecretExpiresAt, field.TypeTime, value)
+		_node.PreviousSigningSecretExpiresAt = &value
+	}
+	if value, ok := wec.mutation.Metadata(); ok {
+		_spec.SetField(webhookendpoint.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := wec.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(webhookendpoint.FieldConsecutiveFailures, field.TypeInt, value)
+		_node.ConsecutiveFailures = value
+	}
+	if value, ok := wec.mutation.FailingSince(); ok {
+		_spec.SetField(webhookendpoint.FieldFailingSince, field.TypeTime, value)
+		_node.FailingSince = &value
+	}
+	if value, ok := wec.mutation.LastSuccessAt(); ok {
+		_spec.SetField(webhookendpoint.FieldLastSuccessAt, field.TypeTime, value)
+		_node.LastSuccessAt = &value
+	}
+	if value, ok := wec.mutation.LastFailureAt(); ok {
+		_spec.SetField(webhookendpoint.FieldLastFailureAt, field.TypeTime, value)
+		_node.LastFailureAt = &value
+	}
+	if value, ok := wec.mutation.DisabledReason(); ok {
+		_spec.SetField(webhookendpoint.FieldDisabledReason, field.TypeString, value)
+		_node.DisabledReason = &value
+	}
+	if value, ok := wec.mutation.PayloadVersion(); ok {
+		_spec.SetField(webhookendpoint.FieldPayloadVersion, field.TypeString, value)
+		_node.PayloadVersion = value
+	}
+	if value, ok := wec.mutation.PayloadMode(); ok {
+		_spec.SetField(webhookendpoint.FieldPayloadMode, field.TypeString, value)
+		_node.PayloadMode = value
+	}
+	return _node, _spec
+}
+
+// WebhookEndpointCreateBulk is the builder for creating many WebhookEndpoint entities in bulk.
+type WebhookEndpointCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookEndpointCreate
+}
+
+// Save creates the WebhookEndpoint entities in the database.
+func (wecb *WebhookEndpointCreateBulk) Save(ctx context.Context) ([]*WebhookEndpoint, error) {
+	if wecb.err != nil {
+		return nil, wecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wecb.builders))
+	nodes := make([]*WebhookEndpoint, len(wecb.builders))
+	mutators := make([]Mutator, len(wecb.builders))
+	for i := range wecb.builders {
+		func(i int, root context.Context) {
+			builder := wecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookEndpointMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wecb *WebhookEndpointCreateBulk) SaveX(ctx context.Context) []*WebhookEndpoint {
+	v, err := wecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wecb *WebhookEndpointCreateBulk) Exec(ctx context.Context) error {
+	_, err := wecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wecb *WebhookEndpointCreateBulk) ExecX(ctx context.Context) {
+	if err := wecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
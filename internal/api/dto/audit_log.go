@@ -0,0 +1,14 @@
+package dto
+
+import (
+	"github.com/flexprice/flexprice/internal/domain/auditlog"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// AuditLogResponse represents an entry of the audit log
+type AuditLogResponse struct {
+	*auditlog.AuditLog `json:",inline"`
+}
+
+// ListAuditLogsResponse represents the response for listing audit logs
+type ListAuditLogsResponse = types.ListResponse[*AuditLogResponse] // @name ListAuditLogsResponse
@@ -21,6 +21,16 @@ type AuthResponse struct {
 	Token    string `json:"token"`
 	UserID   string `json:"user_id"`
 	TenantID string `json:"tenant_id"`
+	// Tenants are the tenants the user can switch to, including the current one
+	Tenants []UserTenant `json:"tenants,omitempty"`
+}
+
+// UserTenant is a tenant the user belongs to
+type UserTenant struct {
+	TenantID string `json:"tenant_id"`
+	Name     string `json:"name"`
+	// HomeTenant is set for the tenant the account of the user was created in
+	HomeTenant bool `json:"home_tenant"`
 }
 
 func (r *SignUpRequest) Validate() error {
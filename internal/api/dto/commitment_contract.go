@@ -0,0 +1,218 @@
+package dto
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/commitmentcontract"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// CreateCommitmentContractRequest represents the request to create a commitment contract
+type CreateCommitmentContractRequest struct {
+	Name       string `json:"name" validate:"required"`
+	CustomerID string `json:"customer_id" validate:"required"`
+	// SubscriptionIDs are the subscriptions of the customer whose usage draws down the contract
+	SubscriptionIDs []string `json:"subscription_ids" validate:"required,min=1"`
+	Currency        string   `json:"currency" validate:"required,len=3"`
+	// CommitmentAmount is the minimum spend over the contract term
+	CommitmentAmount decimal.Decimal `json:"commitment_amount" validate:"required" swaggertype:"string"`
+	// PrepaidAmount is invoiced when the contract is created, defaults to the commitment amount
+	PrepaidAmount *decimal.Decimal `json:"prepaid_amount,omitempty" swaggertype:"string"`
+	// OverageFactor multiplies usage charged after the prepaid amount is exhausted, defaults to 1
+	OverageFactor *decimal.Decimal `json:"overage_factor,omitempty" swaggertype:"string"`
+	StartDate     time.Time        `json:"start_date" validate:"required"`
+	EndDate       time.Time        `json:"end_date" validate:"required"`
+	// EnableTrueUp invoices the spend short of the commitment at the end of the term, defaults to true
+	EnableTrueUp *bool             `json:"enable_true_up,omitempty"`
+	Metadata     map[string]string `json:"metadata,omitempty"`
+}
+
+// Validate validates the CreateCommitmentContractRequest
+func (r *CreateCommitmentContractRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if err := types.ValidateCurrencyCode(r.Currency); err != nil {
+		return err
+	}
+
+	if !r.CommitmentAmount.IsPositive() {
+		return ierr.NewError("commitment_amount must be positive").
+			WithHint("Please provide a commitment amount greater than zero").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.PrepaidAmount != nil {
+		if r.PrepaidAmount.IsNegative() {
+			return ierr.NewError("prepaid_amount cannot be negative").
+				WithHint("Please provide a prepaid amount of zero or more").
+				Mark(ierr.ErrValidation)
+		}
+		if r.PrepaidAmount.GreaterThan(r.CommitmentAmount) {
+			return ierr.NewError("prepaid_amount cannot exceed commitment_amount").
+				WithHint("The prepaid amount must be at most the commitment amount").
+				WithReportableDetails(map[string]any{
+					"prepaid_amount":    r.PrepaidAmount.String(),
+					"commitment_amount": r.CommitmentAmount.String(),
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	if r.OverageFactor != nil && r.OverageFactor.LessThan(decimal.NewFromInt(1)) {
+		return ierr.NewError("overage_factor must be at least 1").
+			WithHint("Overage is charged at the usage rate or above").
+			Mark(ierr.ErrValidation)
+	}
+
+	if !r.EndDate.After(r.StartDate) {
+		return ierr.NewError("end_date must be after start_date").
+			WithHint("Please provide an end date after the start date").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// ToCommitmentContract converts the request to a domain commitment contract
+func (r *CreateCommitmentContractRequest) ToCommitmentContract(ctx context.Context) *commitmentcontract.CommitmentContract {
+	return &commitmentcontract.CommitmentContract{
+		ID:               types.GenerateUUIDWithPrefix(types.UUID_PREFIX_COMMITMENT_CONTRACT),
+		Name:             r.Name,
+		CustomerID:       r.CustomerID,
+		SubscriptionIDs:  lo.Uniq(r.SubscriptionIDs),
+		Currency:         strings.ToLower(r.Currency),
+		CommitmentAmount: r.CommitmentAmount,
+		PrepaidAmount:    lo.FromPtrOr(r.PrepaidAmount, r.CommitmentAmount),
+		OverageFactor:    lo.FromPtrOr(r.OverageFactor, decimal.NewFromInt(1)),
+		DrawnDownAmount:  decimal.Zero,
+		OverageAmount:    decimal.Zero,
+		StartDate:        r.StartDate.UTC(),
+		EndDate:          r.EndDate.UTC(),
+		EnableTrueUp:     lo.FromPtrOr(r.EnableTrueUp, true),
+		ContractStatus:   types.CommitmentContractStatusActive,
+		Metadata:         r.Metadata,
+		EnvironmentID:    types.GetEnvironmentID(ctx),
+		BaseModel:        types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdateCommitmentContractRequest represents the request to update a commitment contract.
+// Amounts and the start date are fixed once the prepayment is invoiced.
+type UpdateCommitmentContractRequest struct {
+	Name            *string           `json:"name,omitempty"`
+	SubscriptionIDs []string          `json:"subscription_ids,omitempty"`
+	EndDate         *time.Time        `json:"end_date,omitempty"`
+	EnableTrueUp    *bool             `json:"enable_true_up,omitempty"`
+	Metadata        map[string]string `json:"metadata,omitempty"`
+}
+
+// Validate validates the UpdateCommitmentContractRequest against the contract
+func (r *UpdateCommitmentContractRequest) Validate(contract *commitmentcontract.CommitmentContract) error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if contract.ContractStatus != types.CommitmentContractStatusActive {
+		return ierr.NewError("commitment contract is not active").
+			WithHint("Only active commitment contracts can be updated").
+			WithReportableDetails(map[string]any{
+				"contract_status": contract.ContractStatus,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.Name != nil && strings.TrimSpace(*r.Name) == "" {
+		return ierr.NewError("name cannot be empty").
+			WithHint("Please provide a name for the commitment contract").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.SubscriptionIDs != nil && len(r.SubscriptionIDs) == 0 {
+		return ierr.NewError("subscription_ids cannot be empty").
+			WithHint("A commitment contract must cover at least one subscription").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.EndDate != nil && !r.EndDate.After(contract.StartDate) {
+		return ierr.NewError("end_date must be after start_date").
+			WithHint("Please provide an end date after the contract start date").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// ApplyTo applies the update to a commitment contract
+func (r *UpdateCommitmentContractRequest) ApplyTo(contract *commitmentcontract.CommitmentContract) {
+	if r.Name != nil {
+		contract.Name = *r.Name
+	}
+	if r.SubscriptionIDs != nil {
+		contract.SubscriptionIDs = lo.Uniq(r.SubscriptionIDs)
+	}
+	if r.EndDate != nil {
+		contract.EndDate = r.EndDate.UTC()
+	}
+	if r.EnableTrueUp != nil {
+		contract.EnableTrueUp = *r.EnableTrueUp
+	}
+	if r.Metadata != nil {
+		contract.Metadata = r.Metadata
+	}
+}
+
+// CommitmentContractResponse represents the response for commitment contract data
+type CommitmentContractResponse struct {
+	*commitmentcontract.CommitmentContract `json:",inline"`
+
+	// RemainingBalance is the prepaid amount not drawn down yet
+	RemainingBalance decimal.Decimal `json:"remaining_balance" swaggertype:"string"`
+}
+
+// NewCommitmentContractResponse creates a response for a commitment contract
+func NewCommitmentContractResponse(contract *commitmentcontract.CommitmentContract) *CommitmentContractResponse {
+	return &CommitmentContractResponse{
+		CommitmentContract: contract,
+		RemainingBalance:   contract.RemainingBalance(),
+	}
+}
+
+// ListCommitmentContractsResponse represents the response for listing commitment contracts
+type ListCommitmentContractsResponse = types.ListResponse[*CommitmentContractResponse] // @name ListCommitmentContractsResponse
+
+// CommitmentContractBurnDownResponse reports how a contract's prepaid amount has been drawn down
+type CommitmentContractBurnDownResponse struct {
+	ContractID       string          `json:"contract_id"`
+	Currency         string          `json:"currency"`
+	CommitmentAmount decimal.Decimal `json:"commitment_amount" swaggertype:"string"`
+	PrepaidAmount    decimal.Decimal `json:"prepaid_amount" swaggertype:"string"`
+	DrawnDownAmount  decimal.Decimal `json:"drawn_down_amount" swaggertype:"string"`
+	RemainingBalance decimal.Decimal `json:"remaining_balance" swaggertype:"string"`
+	OverageAmount    decimal.Decimal `json:"overage_amount" swaggertype:"string"`
+	// UtilizationPercent is the drawn down share of the prepaid amount
+	UtilizationPercent decimal.Decimal `json:"utilization_percent" swaggertype:"string"`
+	// ProjectedTrueUpAmount is the true-up owed if no further usage is drawn down
+	ProjectedTrueUpAmount decimal.Decimal `json:"projected_true_up_amount" swaggertype:"string"`
+	// ProjectedExhaustionDate extrapolates the average drawdown rate so far, empty when there is no usage yet
+	ProjectedExhaustionDate *time.Time `json:"projected_exhaustion_date,omitempty"`
+	StartDate               time.Time  `json:"start_date"`
+	EndDate                 time.Time  `json:"end_date"`
+	// Periods lists the drawdowns of the contract ordered by billing period
+	Periods []*commitmentcontract.CommitmentDrawdown `json:"periods"`
+}
+
+// ProcessCommitmentContractsResult summarizes a run completing the contracts of an environment
+// whose term ended
+type ProcessCommitmentContractsResult struct {
+	Completed      int `json:"completed"`
+	TrueUpInvoices int `json:"true_up_invoices"`
+	Failed         int `json:"failed"`
+}
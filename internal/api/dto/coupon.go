@@ -12,18 +12,18 @@ import (
 
 // CreateCouponRequest represents the request to create a new coupon
 type CreateCouponRequest struct {
-	Name              string                  `json:"name" validate:"required"`
-	RedeemAfter       *time.Time              `json:"redeem_after,omitempty"`
-	RedeemBefore      *time.Time              `json:"redeem_before,omitempty"`
-	MaxRedemptions    *int                    `json:"max_redemptions,omitempty"`
-	Rules             *map[string]interface{} `json:"rules,omitempty"`
-	AmountOff         *decimal.Decimal        `json:"amount_off,omitempty" swaggertype:"string"`
-	PercentageOff     *decimal.Decimal        `json:"percentage_off,omitempty" swaggertype:"string"`
-	Type              types.CouponType        `json:"type" validate:"required,oneof=fixed percentage"`
-	Cadence           types.CouponCadence     `json:"cadence" validate:"required,oneof=once repeated forever"`
-	DurationInPeriods *int                    `json:"duration_in_periods,omitempty"`
-	Metadata          *map[string]string      `json:"metadata,omitempty"`
-	Currency          *string                 `json:"currency,omitempty"`
+	Name              string              `json:"name" validate:"required"`
+	RedeemAfter       *time.Time          `json:"redeem_after,omitempty"`
+	RedeemBefore      *time.Time          `json:"redeem_before,omitempty"`
+	MaxRedemptions    *int                `json:"max_redemptions,omitempty"`
+	Rules             *types.CouponRules  `json:"rules,omitempty"`
+	AmountOff         *decimal.Decimal    `json:"amount_off,omitempty" swaggertype:"string"`
+	PercentageOff     *decimal.Decimal    `json:"percentage_off,omitempty" swaggertype:"string"`
+	Type              types.CouponType    `json:"type" validate:"required,oneof=fixed percentage"`
+	Cadence           types.CouponCadence `json:"cadence" validate:"required,oneof=once repeated forever"`
+	DurationInPeriods *int                `json:"duration_in_periods,omitempty"`
+	Metadata          *map[string]string  `json:"metadata,omitempty"`
+	Currency          *string             `json:"currency,omitempty"`
 }
 
 // UpdateCouponRequest represents the request to update an existing coupon
@@ -86,6 +86,10 @@ func (r *CreateCouponRequest) Validate() error {
 			Mark(ierr.ErrValidation)
 	}
 
+	if err := r.Rules.Validate(); err != nil {
+		return err
+	}
+
 	// Validate duration_in_periods based on cadence
 	if r.Cadence == types.CouponCadenceRepeated {
 		if r.DurationInPeriods == nil {
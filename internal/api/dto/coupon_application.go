@@ -11,6 +11,7 @@ import (
 type CreateCouponApplicationRequest struct {
 	CouponID            string                 `json:"coupon_id" validate:"required"`
 	CouponAssociationID string                 `json:"coupon_association_id,omitempty"`
+	PromotionCodeID     *string                `json:"promotion_code_id,omitempty"`
 	InvoiceID           string                 `json:"invoice_id" validate:"required"`
 	InvoiceLineItemID   *string                `json:"invoice_line_item_id,omitempty"`
 	SubscriptionID      *string                `json:"subscription_id,omitempty"`
@@ -14,6 +14,7 @@ type CreateCouponAssociationRequest struct {
 	SubscriptionID         string            `json:"subscription_id" validate:"required"`
 	SubscriptionLineItemID *string           `json:"subscription_line_item_id,omitempty"`
 	SubscriptionPhaseID    *string           `json:"subscription_phase_id,omitempty"`
+	PromotionCodeID        *string           `json:"promotion_code_id,omitempty"`
 	StartDate              time.Time         `json:"start_date" validate:"required"`
 	EndDate                *time.Time        `json:"end_date,omitempty"`
 	Metadata               map[string]string `json:"metadata,omitempty"`
@@ -44,6 +44,10 @@ type CreateCreditGrantRequest struct {
 	// ex if topup_conversion_rate is 2, then 1 USD = 0.5 credits
 	// ex if topup_conversion_rate is 0.5, then 1 USD = 2 credits
 	TopupConversionRate *decimal.Decimal `json:"topup_conversion_rate,omitempty" swaggertype:"string"`
+
+	// rollover_config carries unused credits into the next period instead of letting them expire.
+	// Only allowed for RECURRING grants whose credits expire.
+	RolloverConfig *types.CreditGrantRolloverConfig `json:"rollover_config,omitempty"`
 }
 
 // UpdateCreditGrantRequest represents the request to update an existing credit grant
@@ -232,6 +236,22 @@ func (r *CreateCreditGrantRequest) Validate() error {
 		}
 	}
 
+	if r.RolloverConfig != nil {
+		if r.Cadence != types.CreditGrantCadenceRecurring || r.ExpirationType == types.CreditGrantExpiryTypeNever {
+			return errors.NewError("rollover_config is only allowed for RECURRING grants with expiring credits").
+				WithHint("Credits that never expire or are granted once have nothing to roll over").
+				WithReportableDetails(map[string]interface{}{
+					"cadence":         r.Cadence,
+					"expiration_type": r.ExpirationType,
+				}).
+				Mark(errors.ErrValidation)
+		}
+
+		if err := r.RolloverConfig.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -306,6 +326,9 @@ func (r *CreateCreditGrantRequest) ToCreditGrant(ctx context.Context) *creditgra
 	if r.ConversionRate != nil {
 		cg.ConversionRate = r.ConversionRate
 	}
+	if r.RolloverConfig != nil {
+		cg.RolloverConfig = r.RolloverConfig
+	}
 	if r.TopupConversionRate != nil {
 		cg.TopupConversionRate = r.TopupConversionRate
 	}
@@ -3,12 +3,14 @@ package dto
 import (
 	"context"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/flexprice/flexprice/internal/domain/customer"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
 )
 
 // IntegrationEntityMapping represents a provider integration mapping
@@ -54,6 +56,17 @@ type CreateCustomerRequest struct {
 	// metadata contains additional key-value pairs for storing extra information
 	Metadata map[string]string `json:"metadata,omitempty"`
 
+	// currency is the customer's billing currency (ISO 4217). Subscriptions created without
+	// a currency use it, localizing plan prices through a price book when needed.
+	Currency string `json:"currency,omitempty" validate:"omitempty,len=3"`
+
+	// parent_customer_id places the customer under a parent in a customer hierarchy
+	ParentCustomerID *string `json:"parent_customer_id,omitempty"`
+
+	// invoice_to_parent routes the customer's invoices to its parent, which then pays for it.
+	// Requires parent_customer_id.
+	InvoiceToParent bool `json:"invoice_to_parent,omitempty"`
+
 	// skip_onboarding_workflow when true, prevents the customer onboarding workflow from being triggered
 	// This is used internally when a customer is created via a workflow to prevent infinite loops
 	// Default: false
@@ -99,6 +112,16 @@ type UpdateCustomerRequest struct {
 	// metadata contains updated key-value pairs that will replace existing metadata
 	Metadata map[string]string `json:"metadata,omitempty"`
 
+	// currency is the updated billing currency. Existing subscriptions keep their currency.
+	Currency *string `json:"currency,omitempty" validate:"omitempty,len=3"`
+
+	// parent_customer_id moves the customer under another parent. Send "" to detach it.
+	ParentCustomerID *string `json:"parent_customer_id,omitempty"`
+
+	// invoice_to_parent routes the customer's invoices to its parent.
+	// Applies to subscriptions created after the change.
+	InvoiceToParent *bool `json:"invoice_to_parent,omitempty"`
+
 	// integration_entity_mapping contains provider integration mappings for this customer
 	IntegrationEntityMapping []*CreateEntityIntegrationMappingRequest `json:"integration_entity_mapping,omitempty"`
 }
@@ -141,6 +164,18 @@ func (r *CreateCustomerRequest) Validate() error {
 		}
 	}
 
+	if r.Currency != "" {
+		if err := types.ValidateCurrencyCode(r.Currency); err != nil {
+			return err
+		}
+	}
+
+	if r.InvoiceToParent && lo.FromPtr(r.ParentCustomerID) == "" {
+		return ierr.NewError("invoice_to_parent requires parent_customer_id").
+			WithHint("Please provide the parent customer that pays the invoices").
+			Mark(ierr.ErrValidation)
+	}
+
 	return nil
 }
 
@@ -156,6 +191,9 @@ func (r *CreateCustomerRequest) ToCustomer(ctx context.Context) *customer.Custom
 		AddressState:      r.AddressState,
 		AddressPostalCode: r.AddressPostalCode,
 		AddressCountry:    r.AddressCountry,
+		Currency:          strings.ToLower(r.Currency),
+		ParentCustomerID:  lo.EmptyableToPtr(lo.FromPtr(r.ParentCustomerID)),
+		InvoiceToParent:   r.InvoiceToParent,
 		Metadata:          r.Metadata,
 		EnvironmentID:     types.GetEnvironmentID(ctx),
 		BaseModel:         types.GetDefaultBaseModel(ctx),
@@ -167,6 +205,12 @@ func (r *UpdateCustomerRequest) Validate() error {
 		return err
 	}
 
+	if lo.FromPtr(r.Currency) != "" {
+		if err := types.ValidateCurrencyCode(*r.Currency); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -176,3 +220,21 @@ type PortalSessionResponse struct {
 	Token     string    `json:"token"`
 	ExpiresAt time.Time `json:"expires_at"`
 }
+
+// CustomerHierarchyNode is a customer in a hierarchy with its distance from the requested customer
+type CustomerHierarchyNode struct {
+	*customer.Customer
+	// depth is 1 for direct children (or the direct parent), 2 for their children, and so on
+	Depth int `json:"depth"`
+}
+
+// CustomerHierarchyResponse describes where a customer sits in its customer hierarchy
+type CustomerHierarchyResponse struct {
+	CustomerID string `json:"customer_id"`
+	// paying_customer_id is the customer invoices are routed to, following invoice_to_parent upwards
+	PayingCustomerID string `json:"paying_customer_id"`
+	// ancestors lists the parents of the customer, nearest first
+	Ancestors []*CustomerHierarchyNode `json:"ancestors"`
+	// descendants lists every customer below the customer, breadth first
+	Descendants []*CustomerHierarchyNode `json:"descendants"`
+}
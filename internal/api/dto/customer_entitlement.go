@@ -102,6 +102,9 @@ type GetCustomerUsageSummaryRequest struct {
 	FeatureIDs        []string `json:"feature_ids,omitempty" form:"feature_ids"`
 	FeatureLookupKeys []string `json:"feature_lookup_keys,omitempty" form:"feature_lookup_keys"`
 	SubscriptionIDs   []string `json:"subscription_ids,omitempty" form:"subscription_ids"`
+	// IncludeDescendants rolls up the usage and spend of every customer below the customer
+	// in its customer hierarchy
+	IncludeDescendants bool `json:"include_descendants,omitempty" form:"include_descendants"`
 }
 
 func (r *GetCustomerUsageSummaryRequest) Validate() error {
@@ -121,6 +124,18 @@ type CustomerUsageSummaryResponse struct {
 	Features   []*FeatureUsageSummary    `json:"features"`
 	Period     *BillingPeriodInfo        `json:"period"`
 	Pagination *types.PaginationResponse `json:"pagination,omitempty"`
+	// CustomerIDs lists the customers rolled up when include_descendants is set
+	CustomerIDs []string `json:"customer_ids,omitempty"`
+	// Spend totals the invoices of the rolled up customers per currency
+	Spend []*CustomerSpendSummary `json:"spend,omitempty"`
+}
+
+// CustomerSpendSummary represents the invoiced spend of customers in a single currency
+type CustomerSpendSummary struct {
+	Currency     string          `json:"currency"`
+	TotalAmount  decimal.Decimal `json:"total_amount" swaggertype:"string"`
+	UnpaidAmount decimal.Decimal `json:"unpaid_amount" swaggertype:"string"`
+	InvoiceCount int             `json:"invoice_count"`
 }
 
 // FeatureUsageSummary represents usage for a single feature
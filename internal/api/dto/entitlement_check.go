@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+)
+
+// CheckEntitlementsRequest asks for a customer's entitlements to a set of features.
+// When no feature is given the whole snapshot is returned, which SDKs can cache locally.
+type CheckEntitlementsRequest struct {
+	// CustomerID or ExternalCustomerID identifies the customer
+	CustomerID         string `json:"customer_id,omitempty"`
+	ExternalCustomerID string `json:"external_customer_id,omitempty"`
+	// FeatureLookupKeys and FeatureIDs select the features to check
+	FeatureLookupKeys []string `json:"feature_lookup_keys,omitempty"`
+	FeatureIDs        []string `json:"feature_ids,omitempty"`
+}
+
+func (r *CheckEntitlementsRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+	if r.CustomerID == "" && r.ExternalCustomerID == "" {
+		return ierr.NewError("customer_id or external_customer_id is required").
+			WithHint("Please provide the customer to check").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// EntitlementCheckResult is the effective entitlement of a customer to one feature
+type EntitlementCheckResult struct {
+	FeatureID        string                            `json:"feature_id"`
+	FeatureLookupKey string                            `json:"feature_lookup_key"`
+	FeatureType      types.FeatureType                 `json:"feature_type,omitempty"`
+	IsEnabled        bool                              `json:"is_enabled"`
+	UsageLimit       *int64                            `json:"usage_limit,omitempty"`
+	IsSoftLimit      bool                              `json:"is_soft_limit"`
+	UsageResetPeriod types.EntitlementUsageResetPeriod `json:"usage_reset_period,omitempty"`
+	StaticValues     []string                          `json:"static_values,omitempty"`
+}
+
+// EntitlementSnapshot is the precomputed set of entitlements of a customer.
+// Version changes whenever any entitlement in the snapshot changes and is served as the ETag.
+type EntitlementSnapshot struct {
+	CustomerID  string                    `json:"customer_id"`
+	Version     string                    `json:"version"`
+	GeneratedAt time.Time                 `json:"generated_at"`
+	Features    []*EntitlementCheckResult `json:"features"`
+}
+
+// CheckEntitlementsResponse returns the requested entitlements with the snapshot version
+type CheckEntitlementsResponse struct {
+	CustomerID   string                    `json:"customer_id"`
+	Version      string                    `json:"version"`
+	GeneratedAt  time.Time                 `json:"generated_at"`
+	Entitlements []*EntitlementCheckResult `json:"entitlements"`
+}
@@ -0,0 +1,119 @@
+package dto
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/fxrate"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/shopspring/decimal"
+)
+
+// CreateFXRateRequest represents the request to publish an FX rate
+type CreateFXRateRequest struct {
+	BaseCurrency  string `json:"base_currency" validate:"required,len=3"`
+	QuoteCurrency string `json:"quote_currency" validate:"required,len=3"`
+	// Rate is how many units of QuoteCurrency one unit of BaseCurrency buys
+	Rate decimal.Decimal `json:"rate" validate:"required" swaggertype:"string"`
+	// EffectiveAt defaults to now
+	EffectiveAt *time.Time        `json:"effective_at,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+// Validate validates the CreateFXRateRequest
+func (r *CreateFXRateRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	return types.ValidateFXRate(r.BaseCurrency, r.QuoteCurrency, r.Rate)
+}
+
+// ToFXRate converts the request to a domain FX rate
+func (r *CreateFXRateRequest) ToFXRate(ctx context.Context) *fxrate.FXRate {
+	effectiveAt := time.Now().UTC()
+	if r.EffectiveAt != nil {
+		effectiveAt = r.EffectiveAt.UTC()
+	}
+
+	return &fxrate.FXRate{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_FX_RATE),
+		BaseCurrency:  strings.ToLower(r.BaseCurrency),
+		QuoteCurrency: strings.ToLower(r.QuoteCurrency),
+		Rate:          r.Rate,
+		EffectiveAt:   effectiveAt,
+		Source:        types.FXRateSourceManual,
+		Metadata:      r.Metadata,
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// FXRateResponse represents the response for FX rate data
+type FXRateResponse struct {
+	*fxrate.FXRate `json:",inline"`
+}
+
+// ListFXRatesResponse represents the response for listing FX rates
+type ListFXRatesResponse = types.ListResponse[*FXRateResponse] // @name ListFXRatesResponse
+
+// SyncFXRatesResponse reports the outcome of syncing rates from the configured source
+type SyncFXRatesResponse struct {
+	Source  types.FXRateSource `json:"source"`
+	Created int                `json:"created"`
+	// Skipped counts rates already present for the same pair and effective time, or invalid
+	Skipped int `json:"skipped"`
+}
+
+// FXGainLossRequest represents the request for the FX gain/loss report
+type FXGainLossRequest struct {
+	StartTime time.Time `json:"start_time" form:"start_time" validate:"required"`
+	EndTime   time.Time `json:"end_time" form:"end_time" validate:"required"`
+	// WalletCurrency limits the report to wallets in this currency
+	WalletCurrency string `json:"wallet_currency,omitempty" form:"wallet_currency"`
+}
+
+// Validate validates the FXGainLossRequest
+func (r *FXGainLossRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if !r.EndTime.After(r.StartTime) {
+		return ierr.NewError("end_time must be after start_time").
+			WithHint("Please provide a valid time range").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// FXGainLossEntry aggregates the converted wallet debits of one currency pair
+type FXGainLossEntry struct {
+	WalletCurrency     string `json:"wallet_currency"`
+	SettlementCurrency string `json:"settlement_currency"`
+	TransactionCount   int    `json:"transaction_count"`
+	// WalletAmount is the total debited from wallets, in WalletCurrency
+	WalletAmount decimal.Decimal `json:"wallet_amount" swaggertype:"string"`
+	// SettledAmount is the total settled on invoices, in SettlementCurrency
+	SettledAmount decimal.Decimal `json:"settled_amount" swaggertype:"string"`
+	// AverageRate is SettledAmount / WalletAmount
+	AverageRate decimal.Decimal `json:"average_rate" swaggertype:"string"`
+	// RevaluationRate is the rate effective at the end of the period, nil when none is published
+	RevaluationRate *decimal.Decimal `json:"revaluation_rate,omitempty" swaggertype:"string"`
+	// RevaluedAmount is WalletAmount at RevaluationRate, in SettlementCurrency
+	RevaluedAmount *decimal.Decimal `json:"revalued_amount,omitempty" swaggertype:"string"`
+	// GainLoss is RevaluedAmount - SettledAmount in SettlementCurrency. A positive value is a
+	// gain: the wallet credits taken are worth more at the period end rate than what they settled.
+	GainLoss *decimal.Decimal `json:"gain_loss,omitempty" swaggertype:"string"`
+}
+
+// FXGainLossResponse is the FX gain/loss report for a period
+type FXGainLossResponse struct {
+	StartTime time.Time          `json:"start_time"`
+	EndTime   time.Time          `json:"end_time"`
+	Items     []*FXGainLossEntry `json:"items"`
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/flexprice/flexprice/internal/domain/coupon_application"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
@@ -18,6 +19,7 @@ import (
 type InvoiceCoupon struct {
 	CouponID            string  `json:"coupon_id" validate:"required"`
 	CouponAssociationID *string `json:"coupon_association_id,omitempty"`
+	PromotionCodeID     *string `json:"promotion_code_id,omitempty"`
 }
 
 // InvoiceLineItemCoupon represents a coupon applied to a specific invoice line item.
@@ -27,6 +29,7 @@ type InvoiceLineItemCoupon struct {
 	LineItemID          string  `json:"line_item_id" validate:"required"` // price_id used to match the line item
 	CouponID            string  `json:"coupon_id" validate:"required"`
 	CouponAssociationID *string `json:"coupon_association_id,omitempty"`
+	PromotionCodeID     *string `json:"promotion_code_id,omitempty"`
 }
 
 // CreateInvoiceRequest represents the request payload for creating a new invoice
@@ -827,6 +830,21 @@ type InvoiceLineItemResponse struct {
 
 	// usage_breakdown contains flexible usage breakdown for this line item (supports any grouping)
 	UsageBreakdown []UsageBreakdownItem `json:"usage_breakdown,omitempty"`
+
+	// discount_breakdown explains the line_item_discount and invoice_level_discount of this line item per coupon
+	DiscountBreakdown []*InvoiceLineItemDiscount `json:"discount_breakdown,omitempty"`
+}
+
+// InvoiceLineItemDiscount is one coupon's contribution to a line item's discount
+type InvoiceLineItemDiscount struct {
+	CouponID            string `json:"coupon_id"`
+	CouponApplicationID string `json:"coupon_application_id"`
+	// applied_to is line_item for coupons discounting the line item directly and invoice for
+	// its share of an invoice-wide coupon
+	AppliedTo string          `json:"applied_to"`
+	Amount    decimal.Decimal `json:"amount" swaggertype:"string"`
+	// reason explains why the coupon applied to the line item, e.g. the targeting rules it matched
+	Reason string `json:"reason,omitempty"`
 }
 
 func NewInvoiceLineItemResponse(item *invoice.InvoiceLineItem) *InvoiceLineItemResponse {
@@ -997,11 +1015,73 @@ func NewInvoiceResponse(inv *invoice.Invoice) *InvoiceResponse {
 			}
 		}
 		resp.WithCouponApplications(couponApplications)
+		resp.withDiscountBreakdown(inv.CouponApplications)
 	}
 
 	return resp
 }
 
+// withDiscountBreakdown explains each line item's discounts from the coupon applications. Line item
+// applications map directly; a line item's invoice_level_discount is split across the invoice-wide
+// applications in proportion to their discounted amounts, the last one taking the rounding remainder.
+func (r *InvoiceResponse) withDiscountBreakdown(applications []*coupon_application.CouponApplication) {
+	invoiceLevel := make([]*coupon_application.CouponApplication, 0)
+	invoiceLevelTotal := decimal.Zero
+	lineItemApplications := make(map[string][]*coupon_application.CouponApplication)
+	for _, ca := range applications {
+		if ca.InvoiceLineItemID != nil {
+			lineItemApplications[*ca.InvoiceLineItemID] = append(lineItemApplications[*ca.InvoiceLineItemID], ca)
+			continue
+		}
+		invoiceLevel = append(invoiceLevel, ca)
+		invoiceLevelTotal = invoiceLevelTotal.Add(ca.DiscountedAmount)
+	}
+
+	for _, lineItem := range r.LineItems {
+		if lineItem == nil {
+			continue
+		}
+		breakdown := make([]*InvoiceLineItemDiscount, 0)
+		for _, ca := range lineItemApplications[lineItem.ID] {
+			breakdown = append(breakdown, &InvoiceLineItemDiscount{
+				CouponID:            ca.CouponID,
+				CouponApplicationID: ca.ID,
+				AppliedTo:           "line_item",
+				Amount:              ca.DiscountedAmount,
+				Reason:              couponApplicationReason(ca),
+			})
+		}
+
+		if lineItem.InvoiceLevelDiscount.IsPositive() && invoiceLevelTotal.IsPositive() {
+			allocated := decimal.Zero
+			for i, ca := range invoiceLevel {
+				share := lineItem.InvoiceLevelDiscount.Sub(allocated)
+				if i < len(invoiceLevel)-1 {
+					share = types.RoundToCurrencyPrecision(lineItem.InvoiceLevelDiscount.Mul(ca.DiscountedAmount).Div(invoiceLevelTotal), lineItem.Currency)
+				}
+				allocated = allocated.Add(share)
+				breakdown = append(breakdown, &InvoiceLineItemDiscount{
+					CouponID:            ca.CouponID,
+					CouponApplicationID: ca.ID,
+					AppliedTo:           "invoice",
+					Amount:              share,
+					Reason:              couponApplicationReason(ca),
+				})
+			}
+		}
+
+		if len(breakdown) > 0 {
+			lineItem.DiscountBreakdown = breakdown
+		}
+	}
+}
+
+// couponApplicationReason reads the explanation recorded in the coupon snapshot at application time
+func couponApplicationReason(ca *coupon_application.CouponApplication) string {
+	reason, _ := ca.CouponSnapshot["reason"].(string)
+	return reason
+}
+
 // WithOverpaidAmount sets the overpaid amount for the invoice response
 func (r *InvoiceResponse) WithOverpaidAmount(amount *decimal.Decimal) *InvoiceResponse {
 	r.OverpaidAmount = amount
@@ -0,0 +1,117 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/invitation"
+	"github.com/flexprice/flexprice/internal/domain/membership"
+	"github.com/flexprice/flexprice/internal/domain/user"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
+)
+
+// InviteUserRequest represents the request to invite a user to the tenant
+type InviteUserRequest struct {
+	Email string `json:"email" validate:"required,email"`
+	// Roles are the RBAC roles granted once the invitation is accepted
+	Roles []string `json:"roles,omitempty"`
+}
+
+// Validate validates the InviteUserRequest
+func (r *InviteUserRequest) Validate() error {
+	r.Email = strings.ToLower(strings.TrimSpace(r.Email))
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	r.Roles = lo.Uniq(r.Roles)
+	return nil
+}
+
+// InvitationResponse represents an invitation in API responses
+type InvitationResponse struct {
+	*invitation.Invitation
+}
+
+// NewInvitationResponse creates a response, reporting pending invitations past their
+// expiry as expired
+func NewInvitationResponse(i *invitation.Invitation) *InvitationResponse {
+	resp := *i
+	resp.InvitationStatus = i.GetStatus()
+	return &InvitationResponse{Invitation: &resp}
+}
+
+// ListInvitationsResponse represents the invitations of the tenant
+type ListInvitationsResponse struct {
+	Items []*InvitationResponse `json:"items"`
+}
+
+// AcceptInvitationRequest accepts an invitation. Invitees without an account choose their
+// password, invitees with an account confirm theirs.
+type AcceptInvitationRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=8"`
+}
+
+// Validate validates the AcceptInvitationRequest
+func (r *AcceptInvitationRequest) Validate() error {
+	return validator.ValidateRequest(r)
+}
+
+// DeclineInvitationRequest declines an invitation
+type DeclineInvitationRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// Validate validates the DeclineInvitationRequest
+func (r *DeclineInvitationRequest) Validate() error {
+	return validator.ValidateRequest(r)
+}
+
+// MemberResponse represents a user with access to the tenant
+type MemberResponse struct {
+	UserID string   `json:"user_id"`
+	Email  string   `json:"email"`
+	Roles  []string `json:"roles"`
+	// HomeTenant is set when the account of the user was created in the tenant, and unset
+	// when the user joined from another tenant
+	HomeTenant bool      `json:"home_tenant"`
+	JoinedAt   time.Time `json:"joined_at"`
+}
+
+// NewMemberResponseFromUser creates a response for a user whose account belongs to the tenant
+func NewMemberResponseFromUser(u *user.User) *MemberResponse {
+	return &MemberResponse{
+		UserID:     u.ID,
+		Email:      u.Email,
+		Roles:      lo.Ternary(u.Roles != nil, u.Roles, []string{}),
+		HomeTenant: true,
+		JoinedAt:   u.CreatedAt,
+	}
+}
+
+// NewMemberResponseFromMembership creates a response for a user that joined from another tenant
+func NewMemberResponseFromMembership(m *membership.Membership) *MemberResponse {
+	return &MemberResponse{
+		UserID:   m.UserID,
+		Email:    m.Email,
+		Roles:    lo.Ternary(m.Roles != nil, m.Roles, []string{}),
+		JoinedAt: m.CreatedAt,
+	}
+}
+
+// ListMembersResponse represents the members of the tenant
+type ListMembersResponse struct {
+	Items []*MemberResponse `json:"items"`
+}
+
+// SwitchTenantRequest asks for a token of another tenant the user belongs to
+type SwitchTenantRequest struct {
+	TenantID string `json:"tenant_id" validate:"required"`
+}
+
+// Validate validates the SwitchTenantRequest
+func (r *SwitchTenantRequest) Validate() error {
+	return validator.ValidateRequest(r)
+}
@@ -0,0 +1,220 @@
+package dto
+
+import (
+	"context"
+	"strings"
+
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/pricebook"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// CreatePriceBookRequest represents the request to create a price book
+type CreatePriceBookRequest struct {
+	Name        string `json:"name" validate:"required"`
+	Description string `json:"description,omitempty"`
+	// BaseCurrency is the currency of the plan and addon prices the book localizes
+	BaseCurrency string `json:"base_currency" validate:"required,len=3"`
+	// PlanIDs limits the book to these plans, empty for all plans
+	PlanIDs []string `json:"plan_ids,omitempty"`
+	// AddonIDs limits the book to these addons, empty for all addons
+	AddonIDs   []string                       `json:"addon_ids,omitempty"`
+	Currencies []types.PriceBookCurrency      `json:"currencies,omitempty"`
+	Overrides  []types.PriceBookPriceOverride `json:"overrides,omitempty"`
+	Metadata   map[string]string              `json:"metadata,omitempty"`
+}
+
+// Validate validates the CreatePriceBookRequest
+func (r *CreatePriceBookRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if err := types.ValidateCurrencyCode(r.BaseCurrency); err != nil {
+		return err
+	}
+
+	return validatePriceBookRules(r.BaseCurrency, r.Currencies, r.Overrides)
+}
+
+// ToPriceBook converts the request to a domain price book
+func (r *CreatePriceBookRequest) ToPriceBook(ctx context.Context) *pricebook.PriceBook {
+	return &pricebook.PriceBook{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PRICE_BOOK),
+		Name:          r.Name,
+		Description:   r.Description,
+		BaseCurrency:  strings.ToLower(r.BaseCurrency),
+		PlanIDs:       lo.Uniq(r.PlanIDs),
+		AddonIDs:      lo.Uniq(r.AddonIDs),
+		Currencies:    normalizePriceBookCurrencies(r.Currencies),
+		Overrides:     normalizePriceBookOverrides(r.Overrides),
+		Metadata:      r.Metadata,
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdatePriceBookRequest represents the request to update a price book.
+// Lists that are provided replace the existing ones. The base currency cannot be changed.
+type UpdatePriceBookRequest struct {
+	Name        *string                        `json:"name,omitempty"`
+	Description *string                        `json:"description,omitempty"`
+	PlanIDs     []string                       `json:"plan_ids,omitempty"`
+	AddonIDs    []string                       `json:"addon_ids,omitempty"`
+	Currencies  []types.PriceBookCurrency      `json:"currencies,omitempty"`
+	Overrides   []types.PriceBookPriceOverride `json:"overrides,omitempty"`
+	Metadata    map[string]string              `json:"metadata,omitempty"`
+}
+
+// Validate validates the UpdatePriceBookRequest against the book's base currency
+func (r *UpdatePriceBookRequest) Validate(baseCurrency string) error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if r.Name != nil && strings.TrimSpace(*r.Name) == "" {
+		return ierr.NewError("name cannot be empty").
+			WithHint("Please provide a name for the price book").
+			Mark(ierr.ErrValidation)
+	}
+
+	return validatePriceBookRules(baseCurrency, r.Currencies, r.Overrides)
+}
+
+func validatePriceBookRules(baseCurrency string, currencies []types.PriceBookCurrency, overrides []types.PriceBookPriceOverride) error {
+	seen := make(map[string]bool, len(currencies))
+	for i := range currencies {
+		if err := currencies[i].Validate(baseCurrency); err != nil {
+			return err
+		}
+		currency := strings.ToLower(currencies[i].Currency)
+		if seen[currency] {
+			return ierr.NewError("duplicate currency in price book").
+				WithHint("Each currency can only have one conversion rule").
+				WithReportableDetails(map[string]any{
+					"currency": currency,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		seen[currency] = true
+	}
+
+	seenOverrides := make(map[string]bool, len(overrides))
+	for i := range overrides {
+		if err := overrides[i].Validate(); err != nil {
+			return err
+		}
+		key := overrides[i].PriceID + ":" + strings.ToLower(overrides[i].Currency)
+		if seenOverrides[key] {
+			return ierr.NewError("duplicate price override in price book").
+				WithHint("Each price can only be overridden once per currency").
+				WithReportableDetails(map[string]any{
+					"price_id": overrides[i].PriceID,
+					"currency": overrides[i].Currency,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		seenOverrides[key] = true
+	}
+
+	return nil
+}
+
+func normalizePriceBookCurrencies(currencies []types.PriceBookCurrency) []types.PriceBookCurrency {
+	return lo.Map(currencies, func(c types.PriceBookCurrency, _ int) types.PriceBookCurrency {
+		c.Currency = strings.ToLower(c.Currency)
+		return c
+	})
+}
+
+func normalizePriceBookOverrides(overrides []types.PriceBookPriceOverride) []types.PriceBookPriceOverride {
+	return lo.Map(overrides, func(o types.PriceBookPriceOverride, _ int) types.PriceBookPriceOverride {
+		o.Currency = strings.ToLower(o.Currency)
+		return o
+	})
+}
+
+// ApplyTo applies the update to a price book
+func (r *UpdatePriceBookRequest) ApplyTo(book *pricebook.PriceBook) {
+	if r.Name != nil {
+		book.Name = *r.Name
+	}
+	if r.Description != nil {
+		book.Description = *r.Description
+	}
+	if r.PlanIDs != nil {
+		book.PlanIDs = lo.Uniq(r.PlanIDs)
+	}
+	if r.AddonIDs != nil {
+		book.AddonIDs = lo.Uniq(r.AddonIDs)
+	}
+	if r.Currencies != nil {
+		book.Currencies = normalizePriceBookCurrencies(r.Currencies)
+	}
+	if r.Overrides != nil {
+		book.Overrides = normalizePriceBookOverrides(r.Overrides)
+	}
+	if r.Metadata != nil {
+		book.Metadata = r.Metadata
+	}
+}
+
+// PriceBookResponse represents the response for price book data
+type PriceBookResponse struct {
+	*pricebook.PriceBook `json:",inline"`
+}
+
+// ListPriceBooksResponse represents the response for listing price books
+type ListPriceBooksResponse = types.ListResponse[*PriceBookResponse] // @name ListPriceBooksResponse
+
+// PreviewPriceBookRequest represents the request to preview localized catalog prices
+type PreviewPriceBookRequest struct {
+	Currency string `json:"currency" form:"currency" validate:"required,len=3"`
+	// PlanID limits the preview to one plan
+	PlanID string `json:"plan_id,omitempty" form:"plan_id"`
+	// AddonID limits the preview to one addon
+	AddonID string `json:"addon_id,omitempty" form:"addon_id"`
+}
+
+// Validate validates the PreviewPriceBookRequest
+func (r *PreviewPriceBookRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	return types.ValidateCurrencyCode(r.Currency)
+}
+
+// LocalizedPrice is a base currency price converted into another currency by a price book
+type LocalizedPrice struct {
+	PriceID      string                `json:"price_id"`
+	EntityType   types.PriceEntityType `json:"entity_type"`
+	EntityID     string                `json:"entity_id"`
+	DisplayName  string                `json:"display_name,omitempty"`
+	Type         types.PriceType       `json:"type"`
+	BillingModel types.BillingModel    `json:"billing_model"`
+	BaseCurrency string                `json:"base_currency"`
+	BaseAmount   decimal.Decimal       `json:"base_amount" swaggertype:"string"`
+	Currency     string                `json:"currency"`
+	Amount       decimal.Decimal       `json:"amount" swaggertype:"string"`
+	BaseTiers    []price.PriceTier     `json:"base_tiers,omitempty"`
+	Tiers        []price.PriceTier     `json:"tiers,omitempty"`
+	// Rate is the conversion rate applied, empty when the amounts come from an override
+	Rate   *decimal.Decimal           `json:"rate,omitempty" swaggertype:"string"`
+	Source types.LocalizedPriceSource `json:"source"`
+}
+
+// PreviewPriceBookResponse lists the catalog prices a price book localizes into a currency
+type PreviewPriceBookResponse struct {
+	PriceBookID  string            `json:"price_book_id"`
+	BaseCurrency string            `json:"base_currency"`
+	Currency     string            `json:"currency"`
+	Prices       []*LocalizedPrice `json:"prices"`
+	// SkippedPriceIDs are base currency prices the book cannot localize, such as prices in
+	// custom price units or without a rule or override for the currency
+	SkippedPriceIDs []string `json:"skipped_price_ids,omitempty"`
+}
@@ -0,0 +1,178 @@
+package dto
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/promotion_code"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// CreatePromotionCodeRequest represents the request to create a promotion code for a coupon
+type CreatePromotionCodeRequest struct {
+	CouponID string `json:"coupon_id" validate:"required"`
+	// Code is the customer-facing code, matched case-insensitively
+	Code string `json:"code" validate:"required,max=100"`
+	// MaxRedemptions limits redemptions across all customers, 1 makes the code one-time
+	MaxRedemptions *int `json:"max_redemptions,omitempty"`
+	// MaxRedemptionsPerCustomer limits how many subscriptions of one customer can redeem the code
+	MaxRedemptionsPerCustomer *int `json:"max_redemptions_per_customer,omitempty"`
+	// FirstTimeCustomerOnly restricts the code to customers without prior subscriptions or invoices
+	FirstTimeCustomerOnly bool       `json:"first_time_customer_only"`
+	ExpiresAt             *time.Time `json:"expires_at,omitempty"`
+	// MinimumAmount is the minimum invoice subtotal the discount applies to
+	MinimumAmount         *decimal.Decimal  `json:"minimum_amount,omitempty" swaggertype:"string"`
+	MinimumAmountCurrency *string           `json:"minimum_amount_currency,omitempty"`
+	Metadata              map[string]string `json:"metadata,omitempty"`
+}
+
+// Validate validates the CreatePromotionCodeRequest
+func (r *CreatePromotionCodeRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if promotion_code.NormalizeCode(r.Code) == "" {
+		return ierr.NewError("code is required").
+			WithHint("Please provide a promotion code").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.MaxRedemptions != nil && *r.MaxRedemptions <= 0 {
+		return ierr.NewError("max_redemptions must be greater than zero").
+			WithHint("Please provide a valid maximum redemption count").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.MaxRedemptionsPerCustomer != nil && *r.MaxRedemptionsPerCustomer <= 0 {
+		return ierr.NewError("max_redemptions_per_customer must be greater than zero").
+			WithHint("Please provide a valid per customer redemption count").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.MinimumAmount != nil {
+		if r.MinimumAmount.LessThan(decimal.Zero) {
+			return ierr.NewError("minimum_amount cannot be negative").
+				WithHint("Please provide a valid minimum amount").
+				Mark(ierr.ErrValidation)
+		}
+		if err := types.ValidateCurrencyCode(lo.FromPtr(r.MinimumAmountCurrency)); err != nil {
+			return ierr.WithError(err).
+				WithHint("Please provide the currency of the minimum amount").
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	if r.ExpiresAt != nil && r.ExpiresAt.Before(time.Now()) {
+		return ierr.NewError("expires_at must be in the future").
+			WithHint("Please provide a future expiry date").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// ToPromotionCode converts the request to a domain promotion code
+func (r *CreatePromotionCodeRequest) ToPromotionCode(ctx context.Context) *promotion_code.PromotionCode {
+	return &promotion_code.PromotionCode{
+		ID:                        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PROMOTION_CODE),
+		CouponID:                  r.CouponID,
+		Code:                      promotion_code.NormalizeCode(r.Code),
+		Active:                    true,
+		MaxRedemptions:            r.MaxRedemptions,
+		MaxRedemptionsPerCustomer: r.MaxRedemptionsPerCustomer,
+		FirstTimeCustomerOnly:     r.FirstTimeCustomerOnly,
+		ExpiresAt:                 r.ExpiresAt,
+		MinimumAmount:             r.MinimumAmount,
+		MinimumAmountCurrency:     strings.ToLower(lo.FromPtr(r.MinimumAmountCurrency)),
+		Metadata:                  r.Metadata,
+		EnvironmentID:             types.GetEnvironmentID(ctx),
+		BaseModel:                 types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdatePromotionCodeRequest represents the request to update a promotion code
+type UpdatePromotionCodeRequest struct {
+	Active                    *bool             `json:"active,omitempty"`
+	MaxRedemptions            *int              `json:"max_redemptions,omitempty"`
+	MaxRedemptionsPerCustomer *int              `json:"max_redemptions_per_customer,omitempty"`
+	ExpiresAt                 *time.Time        `json:"expires_at,omitempty"`
+	Metadata                  map[string]string `json:"metadata,omitempty"`
+}
+
+// Validate validates the UpdatePromotionCodeRequest
+func (r *UpdatePromotionCodeRequest) Validate() error {
+	if r.MaxRedemptions != nil && *r.MaxRedemptions <= 0 {
+		return ierr.NewError("max_redemptions must be greater than zero").
+			WithHint("Please provide a valid maximum redemption count").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.MaxRedemptionsPerCustomer != nil && *r.MaxRedemptionsPerCustomer <= 0 {
+		return ierr.NewError("max_redemptions_per_customer must be greater than zero").
+			WithHint("Please provide a valid per customer redemption count").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// PromotionCodeResponse represents the response for promotion code data
+type PromotionCodeResponse struct {
+	*promotion_code.PromotionCode `json:",inline"`
+	Coupon                        *CouponResponse `json:"coupon,omitempty"`
+}
+
+// ListPromotionCodesResponse represents the response for listing promotion codes
+type ListPromotionCodesResponse = types.ListResponse[*PromotionCodeResponse] // @name ListPromotionCodesResponse
+
+// ValidatePromotionCodeRequest represents the request to check whether a code can be redeemed
+type ValidatePromotionCodeRequest struct {
+	Code string `json:"code" validate:"required"`
+	// CustomerID enables the per-customer and first-time customer checks
+	CustomerID string `json:"customer_id,omitempty"`
+	// Amount enables the minimum amount check and the discount preview
+	Amount   *decimal.Decimal `json:"amount,omitempty" swaggertype:"string"`
+	Currency string           `json:"currency,omitempty"`
+}
+
+// Validate validates the ValidatePromotionCodeRequest
+func (r *ValidatePromotionCodeRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if r.Amount != nil && r.Currency == "" {
+		return ierr.NewError("currency is required with amount").
+			WithHint("Please provide the currency of the amount").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// ValidatePromotionCodeResponse reports whether a code can be redeemed and, when an
+// amount was given, the discount it grants
+type ValidatePromotionCodeResponse struct {
+	Valid         bool                            `json:"valid"`
+	ErrorCode     types.CouponValidationErrorCode `json:"error_code,omitempty"`
+	Message       string                          `json:"message,omitempty"`
+	PromotionCode *PromotionCodeResponse          `json:"promotion_code,omitempty"`
+	Discount      *decimal.Decimal                `json:"discount,omitempty" swaggertype:"string"`
+	FinalAmount   *decimal.Decimal                `json:"final_amount,omitempty" swaggertype:"string"`
+}
+
+// RedeemPromotionCodeRequest represents the request to redeem a promotion code on an existing subscription
+type RedeemPromotionCodeRequest struct {
+	Code string `json:"code" validate:"required"`
+}
+
+// Validate validates the RedeemPromotionCodeRequest
+func (r *RedeemPromotionCodeRequest) Validate() error {
+	return validator.ValidateRequest(r)
+}
@@ -0,0 +1,154 @@
+package dto
+
+import (
+	"context"
+	"strings"
+
+	"github.com/flexprice/flexprice/internal/domain/rbacrole"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
+)
+
+// CreateRBACRoleRequest represents the request to create a custom role
+type CreateRBACRoleRequest struct {
+	Name        string `json:"name" validate:"required"`
+	Description string `json:"description,omitempty"`
+	// Permissions are the actions the role grants per entity, e.g. {"event": ["read", "write"]}.
+	// * grants every entity or every action.
+	Permissions map[string][]string `json:"permissions" validate:"required,min=1"`
+	// EnvironmentIDs limits the role to some environments, empty for all environments
+	EnvironmentIDs []string `json:"environment_ids,omitempty"`
+}
+
+// Validate validates the CreateRBACRoleRequest
+func (r *CreateRBACRoleRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(r.Name) == "" {
+		return ierr.NewError("name is required").
+			WithHint("Role name cannot be empty").
+			Mark(ierr.ErrValidation)
+	}
+
+	return validateRBACPermissions(r.Permissions)
+}
+
+// ToRBACRole converts the request to a domain custom role
+func (r *CreateRBACRoleRequest) ToRBACRole(ctx context.Context) *rbacrole.RBACRole {
+	return &rbacrole.RBACRole{
+		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_RBAC_ROLE),
+		Name:           strings.TrimSpace(r.Name),
+		Description:    r.Description,
+		Permissions:    normalizeRBACPermissions(r.Permissions),
+		EnvironmentIDs: lo.Uniq(r.EnvironmentIDs),
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdateRBACRoleRequest represents the request to update a custom role.
+// Permissions and environment IDs that are provided replace the existing ones.
+type UpdateRBACRoleRequest struct {
+	Name           *string             `json:"name,omitempty"`
+	Description    *string             `json:"description,omitempty"`
+	Permissions    map[string][]string `json:"permissions,omitempty"`
+	EnvironmentIDs []string            `json:"environment_ids,omitempty"`
+}
+
+// Validate validates the UpdateRBACRoleRequest
+func (r *UpdateRBACRoleRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if r.Name != nil && strings.TrimSpace(*r.Name) == "" {
+		return ierr.NewError("name cannot be empty").
+			WithHint("Role name cannot be empty").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.Permissions != nil {
+		return validateRBACPermissions(r.Permissions)
+	}
+	return nil
+}
+
+// ApplyTo applies the provided fields of the request to a custom role
+func (r *UpdateRBACRoleRequest) ApplyTo(role *rbacrole.RBACRole) {
+	if r.Name != nil {
+		role.Name = strings.TrimSpace(*r.Name)
+	}
+	if r.Description != nil {
+		role.Description = *r.Description
+	}
+	if r.Permissions != nil {
+		role.Permissions = normalizeRBACPermissions(r.Permissions)
+	}
+	if r.EnvironmentIDs != nil {
+		role.EnvironmentIDs = lo.Uniq(r.EnvironmentIDs)
+	}
+}
+
+// AssignRolesRequest represents the request to replace the roles of a user or API key.
+// An empty list removes every role.
+type AssignRolesRequest struct {
+	Roles []string `json:"roles" validate:"required"`
+}
+
+// Validate validates the AssignRolesRequest
+func (r *AssignRolesRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	r.Roles = lo.Uniq(r.Roles)
+	return nil
+}
+
+// AssignRolesResponse represents the roles of a user after an assignment
+type AssignRolesResponse struct {
+	ID    string   `json:"id"`
+	Roles []string `json:"roles"`
+}
+
+func validateRBACPermissions(permissions map[string][]string) error {
+	if len(permissions) == 0 {
+		return ierr.NewError("permissions are required").
+			WithHint("A role has to grant at least one permission").
+			Mark(ierr.ErrValidation)
+	}
+
+	for entity, actions := range permissions {
+		if strings.TrimSpace(entity) == "" {
+			return ierr.NewError("permission entity cannot be empty").
+				WithHint("Every permission needs an entity such as event or *").
+				Mark(ierr.ErrValidation)
+		}
+		if len(actions) == 0 || lo.ContainsBy(actions, func(action string) bool {
+			return strings.TrimSpace(action) == ""
+		}) {
+			return ierr.NewErrorf("invalid actions for entity %s", entity).
+				WithHintf("Entity %s needs at least one non-empty action such as read, write or *", entity).
+				WithReportableDetails(map[string]any{
+					"entity":  entity,
+					"actions": actions,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+	return nil
+}
+
+func normalizeRBACPermissions(permissions map[string][]string) map[string][]string {
+	result := make(map[string][]string, len(permissions))
+	for entity, actions := range permissions {
+		entity = strings.TrimSpace(entity)
+		result[entity] = lo.Uniq(append(result[entity], lo.Map(actions, func(action string, _ int) string {
+			return strings.TrimSpace(action)
+		})...))
+	}
+	return result
+}
@@ -16,6 +16,12 @@ type CreateAPIKeyRequest struct {
 	Type             types.SecretType `json:"type" binding:"required" validate:"required"`
 	ExpiresAt        *time.Time       `json:"expires_at,omitempty"`
 	ServiceAccountID string           `json:"service_account_id,omitempty"`
+	// Scopes restricts the key to resource:action pairs such as events:write, empty for no restriction
+	Scopes []string `json:"scopes,omitempty"`
+	// AllowedIPs restricts the key to IP addresses or CIDR ranges, empty for any address
+	AllowedIPs []string `json:"allowed_ips,omitempty"`
+	// RateLimitPerMinute caps the requests per minute made with the key
+	RateLimitPerMinute *int `json:"rate_limit_per_minute,omitempty" validate:"omitempty,gt=0"`
 }
 
 func (r *CreateAPIKeyRequest) Validate() error {
@@ -35,7 +41,84 @@ func (r *CreateAPIKeyRequest) Validate() error {
 			Mark(ierr.ErrValidation)
 	}
 
-	return nil
+	if err := types.ValidateAPIKeyScopes(r.Scopes); err != nil {
+		return err
+	}
+
+	return types.ValidateAllowedIPs(r.AllowedIPs)
+}
+
+// UpdateAPIKeyRequest represents the request to update the restrictions of an API key.
+// Scopes and allowed IPs that are provided replace the existing ones.
+type UpdateAPIKeyRequest struct {
+	Name       *string  `json:"name,omitempty"`
+	Scopes     []string `json:"scopes,omitempty"`
+	AllowedIPs []string `json:"allowed_ips,omitempty"`
+	// RateLimitPerMinute caps the requests per minute made with the key, 0 removes the limit
+	RateLimitPerMinute *int `json:"rate_limit_per_minute,omitempty" validate:"omitempty,gte=0"`
+}
+
+func (r *UpdateAPIKeyRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if r.Name != nil && *r.Name == "" {
+		return ierr.NewError("name cannot be empty").
+			WithHint("API key name cannot be empty").
+			Mark(ierr.ErrValidation)
+	}
+
+	if err := types.ValidateAPIKeyScopes(r.Scopes); err != nil {
+		return err
+	}
+
+	return types.ValidateAllowedIPs(r.AllowedIPs)
+}
+
+// ApplyTo applies the provided fields of the request to an API key
+func (r *UpdateAPIKeyRequest) ApplyTo(s *secret.Secret) {
+	if r.Name != nil {
+		s.Name = *r.Name
+	}
+	if r.Scopes != nil {
+		s.Scopes = lo.Uniq(r.Scopes)
+	}
+	if r.AllowedIPs != nil {
+		s.AllowedIPs = lo.Uniq(r.AllowedIPs)
+	}
+	if r.RateLimitPerMinute != nil {
+		s.RateLimitPerMinute = lo.Ternary(*r.RateLimitPerMinute > 0, r.RateLimitPerMinute, nil)
+	}
+}
+
+// RotateAPIKeyRequest represents the request to rotate an API key
+type RotateAPIKeyRequest struct {
+	// GracePeriodHours is how long the old key keeps working, defaults to 24 hours. 0 revokes it right away.
+	GracePeriodHours *int `json:"grace_period_hours,omitempty" validate:"omitempty,gte=0,lte=720"`
+}
+
+func (r *RotateAPIKeyRequest) Validate() error {
+	return validator.ValidateRequest(r)
+}
+
+// GracePeriod returns how long the old key keeps working
+func (r *RotateAPIKeyRequest) GracePeriod() time.Duration {
+	return time.Duration(lo.FromPtrOr(r.GracePeriodHours, 24)) * time.Hour
+}
+
+// APIKeyUsageResponse represents the requests made with an API key per day
+type APIKeyUsageResponse struct {
+	ID            string             `json:"id"`
+	LastUsedAt    *time.Time         `json:"last_used_at,omitempty"`
+	TotalRequests int64              `json:"total_requests"`
+	Daily         []APIKeyDailyUsage `json:"daily"`
+}
+
+// APIKeyDailyUsage represents the requests made with an API key on a day (UTC)
+type APIKeyDailyUsage struct {
+	Date     string `json:"date"`
+	Requests int64  `json:"requests"`
 }
 
 // CreateIntegrationRequest represents the request to create/update an integration
@@ -74,6 +157,13 @@ type SecretResponse struct {
 	Status     types.Status         `json:"status"`
 	CreatedAt  time.Time            `json:"created_at"`
 	UpdatedAt  time.Time            `json:"updated_at"`
+
+	// Restrictions of the API key
+	Scopes             []string `json:"scopes,omitempty"`
+	AllowedIPs         []string `json:"allowed_ips,omitempty"`
+	RateLimitPerMinute *int     `json:"rate_limit_per_minute,omitempty"`
+	// RotatedFromID is the key this key replaced when it was rotated
+	RotatedFromID *string `json:"rotated_from_id,omitempty"`
 }
 
 // CreateAPIKeyResponse represents the response when creating a new API key
@@ -105,6 +195,11 @@ func ToSecretResponse(s *secret.Secret) *SecretResponse {
 		Status:     s.Status,
 		CreatedAt:  s.CreatedAt,
 		UpdatedAt:  s.UpdatedAt,
+
+		Scopes:             s.Scopes,
+		AllowedIPs:         s.AllowedIPs,
+		RateLimitPerMinute: s.RateLimitPerMinute,
+		RotatedFromID:      s.RotatedFromID,
 	}
 }
 
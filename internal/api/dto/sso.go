@@ -0,0 +1,201 @@
+package dto
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/sso"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
+)
+
+// CreateSSOConnectionRequest represents the request to create a single sign-on connection
+type CreateSSOConnectionRequest struct {
+	Name     string            `json:"name" validate:"required"`
+	Protocol types.SSOProtocol `json:"protocol" validate:"required"`
+	// Domains are the email domains whose users sign in through the connection once verified
+	Domains []string `json:"domains" validate:"required,min=1"`
+	Enabled *bool    `json:"enabled,omitempty"`
+
+	// IssuerURL, ClientID and ClientSecret are required for OIDC connections
+	IssuerURL    string   `json:"issuer_url,omitempty" validate:"omitempty,url"`
+	ClientID     string   `json:"client_id,omitempty"`
+	ClientSecret string   `json:"client_secret,omitempty"`
+	Scopes       []string `json:"scopes,omitempty"`
+
+	// IdPMetadataURL or IdPMetadataXML is required for SAML connections
+	IdPMetadataURL string `json:"idp_metadata_url,omitempty" validate:"omitempty,url"`
+	IdPMetadataXML string `json:"idp_metadata_xml,omitempty"`
+
+	// RoleClaim is the claim or attribute holding the groups of the user, groups by default
+	RoleClaim string `json:"role_claim,omitempty"`
+	// RoleMappings are the RBAC roles granted per identity provider group
+	RoleMappings map[string][]string `json:"role_mappings,omitempty"`
+	// DefaultRoles are granted to every user signing in through the connection
+	DefaultRoles []string `json:"default_roles,omitempty"`
+	// JITProvisioning creates users on their first sign in, enabled by default
+	JITProvisioning *bool `json:"jit_provisioning,omitempty"`
+}
+
+// Validate validates the CreateSSOConnectionRequest
+func (r *CreateSSOConnectionRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+	return r.Protocol.Validate()
+}
+
+// ToSSOConnection converts the request to a domain connection. The client secret is not encrypted yet.
+func (r *CreateSSOConnectionRequest) ToSSOConnection(ctx context.Context) *sso.Connection {
+	return &sso.Connection{
+		ID:                      types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SSO_CONNECTION),
+		Name:                    strings.TrimSpace(r.Name),
+		Protocol:                r.Protocol,
+		Domains:                 normalizeSSODomains(r.Domains),
+		Enabled:                 lo.FromPtrOr(r.Enabled, true),
+		DomainVerificationToken: sso.NewDomainVerificationToken(),
+		IssuerURL:               r.IssuerURL,
+		ClientID:                r.ClientID,
+		ClientSecret:            r.ClientSecret,
+		Scopes:                  lo.Uniq(r.Scopes),
+		IdPMetadataURL:          r.IdPMetadataURL,
+		IdPMetadataXML:          r.IdPMetadataXML,
+		RoleClaim:               r.RoleClaim,
+		RoleMappings:            r.RoleMappings,
+		DefaultRoles:            lo.Uniq(r.DefaultRoles),
+		JITProvisioning:         lo.FromPtrOr(r.JITProvisioning, true),
+		BaseModel:               types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdateSSOConnectionRequest represents the request to update a single sign-on connection.
+// Lists and mappings that are provided replace the existing ones. The protocol cannot change.
+type UpdateSSOConnectionRequest struct {
+	Name            *string             `json:"name,omitempty"`
+	Domains         []string            `json:"domains,omitempty"`
+	Enabled         *bool               `json:"enabled,omitempty"`
+	IssuerURL       *string             `json:"issuer_url,omitempty" validate:"omitempty,url"`
+	ClientID        *string             `json:"client_id,omitempty"`
+	ClientSecret    *string             `json:"client_secret,omitempty"`
+	Scopes          []string            `json:"scopes,omitempty"`
+	IdPMetadataURL  *string             `json:"idp_metadata_url,omitempty"`
+	IdPMetadataXML  *string             `json:"idp_metadata_xml,omitempty"`
+	RoleClaim       *string             `json:"role_claim,omitempty"`
+	RoleMappings    map[string][]string `json:"role_mappings,omitempty"`
+	DefaultRoles    []string            `json:"default_roles,omitempty"`
+	JITProvisioning *bool               `json:"jit_provisioning,omitempty"`
+}
+
+// Validate validates the UpdateSSOConnectionRequest
+func (r *UpdateSSOConnectionRequest) Validate() error {
+	return validator.ValidateRequest(r)
+}
+
+// ApplyTo applies the provided fields of the request to a connection. A provided client
+// secret is not encrypted yet. Removed domains lose their verification.
+func (r *UpdateSSOConnectionRequest) ApplyTo(c *sso.Connection) {
+	if r.Name != nil {
+		c.Name = strings.TrimSpace(*r.Name)
+	}
+	if r.Domains != nil {
+		c.Domains = normalizeSSODomains(r.Domains)
+		c.VerifiedDomains = lo.Filter(c.VerifiedDomains, func(domain string, _ int) bool {
+			return lo.Contains(c.Domains, domain)
+		})
+	}
+	if r.Enabled != nil {
+		c.Enabled = *r.Enabled
+	}
+	if r.IssuerURL != nil {
+		c.IssuerURL = *r.IssuerURL
+	}
+	if r.ClientID != nil {
+		c.ClientID = *r.ClientID
+	}
+	if r.ClientSecret != nil {
+		c.ClientSecret = *r.ClientSecret
+	}
+	if r.Scopes != nil {
+		c.Scopes = lo.Uniq(r.Scopes)
+	}
+	if r.IdPMetadataURL != nil {
+		c.IdPMetadataURL = *r.IdPMetadataURL
+	}
+	if r.IdPMetadataXML != nil {
+		c.IdPMetadataXML = *r.IdPMetadataXML
+	}
+	if r.RoleClaim != nil {
+		c.RoleClaim = *r.RoleClaim
+	}
+	if r.RoleMappings != nil {
+		c.RoleMappings = r.RoleMappings
+	}
+	if r.DefaultRoles != nil {
+		c.DefaultRoles = lo.Uniq(r.DefaultRoles)
+	}
+	if r.JITProvisioning != nil {
+		c.JITProvisioning = *r.JITProvisioning
+	}
+}
+
+// SSOConnectionResponse represents a single sign-on connection. The client secret is never returned.
+type SSOConnectionResponse struct {
+	*sso.Connection
+	// CallbackURL is the OIDC redirect URI or the SAML ACS URL to register with the identity provider
+	CallbackURL string `json:"callback_url"`
+	// SPMetadataURL is the service provider metadata of SAML connections, also their entity ID
+	SPMetadataURL string `json:"sp_metadata_url,omitempty"`
+	// DomainVerifications are the DNS TXT records proving the ownership of the domains.
+	// Users of a domain can only sign in once it is verified.
+	DomainVerifications []SSODomainVerification `json:"domain_verifications"`
+}
+
+// SSODomainVerification is the DNS TXT record to publish to verify a domain
+type SSODomainVerification struct {
+	Domain   string `json:"domain"`
+	Host     string `json:"host"`
+	Value    string `json:"value"`
+	Verified bool   `json:"verified"`
+}
+
+// ListSSOConnectionsResponse represents the response for listing single sign-on connections
+type ListSSOConnectionsResponse = types.ListResponse[*SSOConnectionResponse] // @name ListSSOConnectionsResponse
+
+// SSOLoginRequest starts a single sign-on. The connection is found by the domain of the
+// email, or is the given connection.
+type SSOLoginRequest struct {
+	Email        string `json:"email,omitempty" form:"email" validate:"omitempty,email"`
+	ConnectionID string `json:"connection_id,omitempty" form:"connection_id"`
+}
+
+// Validate validates the SSOLoginRequest
+func (r *SSOLoginRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+	if r.Email == "" && r.ConnectionID == "" {
+		return ierr.NewError("email or connection_id is required").
+			WithHint("Provide your work email or the SSO connection to sign in with").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// SSOLoginResponse holds the identity provider URL to send the user to
+type SSOLoginResponse struct {
+	RedirectURL string `json:"redirect_url"`
+	// Binding ties the sign in to the browser that started it, it is set as a cookie
+	Binding string `json:"-"`
+	// ExpiresAt bounds how long the user may take at the identity provider
+	ExpiresAt time.Time `json:"-"`
+}
+
+func normalizeSSODomains(domains []string) []string {
+	return lo.Uniq(lo.FilterMap(domains, func(domain string, _ int) (string, bool) {
+		domain = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(domain), "@")))
+		return domain, domain != ""
+	}))
+}
@@ -247,6 +247,8 @@ type SubscriptionCouponRequest struct {
 	EndDate             *time.Time `json:"end_date,omitempty"`
 	LineItemID          *string    `json:"line_item_id,omitempty"`
 	SubscriptionPhaseID *string    `json:"subscription_phase_id,omitempty"`
+	// PromotionCodeID is set when the coupon is redeemed through a promotion code
+	PromotionCodeID *string `json:"promotion_code_id,omitempty"`
 }
 
 // Validate validates the SubscriptionCouponRequest
@@ -307,8 +309,11 @@ type CreateSubscriptionRequest struct {
 	// and must be same as what you provided as external_id while creating the customer in flexprice.
 	ExternalCustomerID string `json:"external_customer_id"`
 
-	PlanID    string     `json:"plan_id" validate:"required"`
-	Currency  string     `json:"currency" validate:"required,len=3"`
+	PlanID string `json:"plan_id" validate:"required"`
+
+	// currency of the subscription. Defaults to the customer's currency. Plan prices in
+	// another currency are localized through a price book covering the plan.
+	Currency  string     `json:"currency" validate:"omitempty,len=3"`
 	LookupKey string     `json:"lookup_key"`
 	StartDate *time.Time `json:"start_date,omitempty"`
 	EndDate   *time.Time `json:"end_date,omitempty"`
@@ -354,6 +359,9 @@ type CreateSubscriptionRequest struct {
 
 	LineItemCoupons map[string][]string `json:"line_item_coupons,omitempty"`
 
+	// PromotionCodes are customer-facing codes redeemed on the subscription, each applying its coupon
+	PromotionCodes []string `json:"promotion_codes,omitempty"`
+
 	// LineItemCommitments allows setting commitment configuration per line item (keyed by price_id)
 	LineItemCommitments map[string]*LineItemCommitmentConfig `json:"line_item_commitments,omitempty" validate:"omitempty,dive"`
 
@@ -415,6 +423,10 @@ type CreateSubscriptionRequest struct {
 
 	// SubscriptionType is set internally by the service layer.
 	SubscriptionType types.SubscriptionType `json:"-"`
+
+	// Migration creates the subscription as migrated from another billing system: past start
+	// dates keep their anchor, the current period is carried over and past periods are not invoiced.
+	Migration *SubscriptionMigrationConfig `json:"migration,omitempty"`
 }
 
 // AddAddonRequest is used by body-based endpoint /subscriptions/addon
@@ -627,9 +639,11 @@ func (r *CreateSubscriptionRequest) Validate() error {
 		}
 	}
 
-	// Validate currency
-	if err := types.ValidateCurrencyCode(r.Currency); err != nil {
-		return err
+	// Validate currency, an empty currency falls back to the customer's
+	if r.Currency != "" {
+		if err := types.ValidateCurrencyCode(r.Currency); err != nil {
+			return err
+		}
 	}
 
 	if r.BillingCadence == "" {
@@ -730,6 +744,12 @@ func (r *CreateSubscriptionRequest) Validate() error {
 	} else {
 		r.ProrationBehavior = types.ProrationBehaviorNone
 	}
+
+	if r.Migration != nil {
+		if err := r.validateMigration(); err != nil {
+			return err
+		}
+	}
 	if r.Workflow == nil {
 		r.Workflow = lo.ToPtr(types.TemporalSubscriptionChangeWorkflow)
 	}
@@ -1041,6 +1061,31 @@ func (r *CreateSubscriptionRequest) validatePaymentBehaviorForCollectionMethod(c
 	return nil
 }
 
+// validateMigration rejects options that can't be carried over from another billing system
+func (r *CreateSubscriptionRequest) validateMigration() error {
+	if len(r.Phases) > 0 {
+		return ierr.NewError("phases are not supported for migrated subscriptions").
+			WithHint("Migrate the subscription as it is today and schedule upcoming changes separately").
+			Mark(ierr.ErrValidation)
+	}
+	if lo.FromPtr(r.TrialPeriodDays) > 0 {
+		return ierr.NewError("trial_period_days is not supported for migrated subscriptions").
+			Mark(ierr.ErrValidation)
+	}
+	// Don't inherit the plan's trial, the subscription is already past it
+	r.TrialPeriodDays = lo.ToPtr(0)
+	if r.SubscriptionStatus == types.SubscriptionStatusDraft {
+		return ierr.NewError("migrated subscriptions cannot be created as drafts").
+			Mark(ierr.ErrValidation)
+	}
+	if r.ProrationBehavior == types.ProrationBehaviorCreateProrations {
+		return ierr.NewError("proration is not supported for migrated subscriptions").
+			WithHint("The current period is carried over from the previous billing system; set proration_behavior to none").
+			Mark(ierr.ErrValidation)
+	}
+	return r.Migration.Validate(*r.StartDate)
+}
+
 func (r *CreateSubscriptionRequest) validateShouldAllowProrationOnStartDate(request *CreateSubscriptionRequest) error {
 	// If the start date is before the current date and proration mode is active, return an error
 	// This prevents creating subscriptions with backdated start dates that would trigger proration
@@ -0,0 +1,109 @@
+package dto
+
+import (
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+)
+
+// Metadata keys recording where a migrated subscription came from
+const (
+	SubscriptionMetadataMigrationSource     = "migration_source"
+	SubscriptionMetadataMigrationExternalID = "migration_external_id"
+	SubscriptionMetadataInvoicedThrough     = "migration_invoiced_through"
+)
+
+// SubscriptionMigrationConfig creates a subscription mid-cycle as it exists in a previous billing
+// system (e.g. Stripe or Chargebee). The start date may be in the past; periods before the current
+// one are treated as billed externally and are never invoiced.
+type SubscriptionMigrationConfig struct {
+	// Source is the billing system the subscription is migrated from (e.g. stripe, chargebee)
+	Source string `json:"source,omitempty"`
+
+	// ExternalSubscriptionID is the subscription's ID in the previous billing system
+	ExternalSubscriptionID string `json:"external_subscription_id,omitempty"`
+
+	// CurrentPeriodStart is the start of the billing period in progress.
+	// When omitted it is derived by rolling billing periods forward from start_date.
+	CurrentPeriodStart *time.Time `json:"current_period_start,omitempty"`
+
+	// NextBillingDate is the end of the billing period in progress. It must be in the future.
+	// When billing_anchor is omitted the anchor is taken from this date.
+	NextBillingDate *time.Time `json:"next_billing_date,omitempty"`
+
+	// InvoicedThrough marks the end of the service time already invoiced by the previous system.
+	// Set it to next_billing_date when the current period's in-advance charges were billed externally;
+	// leave it empty (or at current_period_start) to have the current period invoiced on creation.
+	// Mid-period markers are not supported.
+	InvoicedThrough *time.Time `json:"invoiced_through,omitempty"`
+}
+
+// Validate validates the migration config against the subscription start date
+func (c *SubscriptionMigrationConfig) Validate(startDate time.Time) error {
+	if c.CurrentPeriodStart != nil && c.NextBillingDate == nil {
+		return ierr.NewError("next_billing_date is required with current_period_start").
+			WithHint("Provide the end of the current billing period as next_billing_date").
+			Mark(ierr.ErrValidation)
+	}
+
+	if c.NextBillingDate != nil && !c.NextBillingDate.After(time.Now().UTC()) {
+		return ierr.NewError("next_billing_date must be in the future").
+			WithHint("Periods that already ended are billed by the previous system and cannot be imported").
+			WithReportableDetails(map[string]interface{}{
+				"next_billing_date": *c.NextBillingDate,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if c.CurrentPeriodStart != nil {
+		if c.CurrentPeriodStart.Before(startDate) {
+			return ierr.NewError("current_period_start cannot be before start_date").
+				WithReportableDetails(map[string]interface{}{
+					"start_date":           startDate,
+					"current_period_start": *c.CurrentPeriodStart,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if !c.CurrentPeriodStart.Before(*c.NextBillingDate) {
+			return ierr.NewError("current_period_start must be before next_billing_date").
+				WithReportableDetails(map[string]interface{}{
+					"current_period_start": *c.CurrentPeriodStart,
+					"next_billing_date":    *c.NextBillingDate,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	if c.InvoicedThrough != nil && c.InvoicedThrough.Before(startDate) {
+		return ierr.NewError("invoiced_through cannot be before start_date").
+			WithReportableDetails(map[string]interface{}{
+				"start_date":       startDate,
+				"invoiced_through": *c.InvoicedThrough,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// ValidateInvoicedThrough checks the invoiced-through marker against the resolved current period
+func (c *SubscriptionMigrationConfig) ValidateInvoicedThrough(periodStart, periodEnd time.Time) error {
+	if c.InvoicedThrough == nil || !c.InvoicedThrough.After(periodStart) || c.InvoicedThrough.Equal(periodEnd) {
+		return nil
+	}
+
+	return ierr.NewError("invoiced_through must be the start or end of the current billing period").
+		WithHint("Set invoiced_through to next_billing_date if the current period was billed externally, or to current_period_start otherwise").
+		WithReportableDetails(map[string]interface{}{
+			"invoiced_through":     *c.InvoicedThrough,
+			"current_period_start": periodStart,
+			"current_period_end":   periodEnd,
+		}).
+		Mark(ierr.ErrValidation)
+}
+
+// CurrentPeriodInvoiced reports whether the previous system already invoiced the current period,
+// in which case no opening invoice is raised.
+func (c *SubscriptionMigrationConfig) CurrentPeriodInvoiced(periodEnd time.Time) bool {
+	return c.InvoicedThrough != nil && !c.InvoicedThrough.Before(periodEnd)
+}
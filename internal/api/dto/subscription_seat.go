@@ -0,0 +1,85 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+)
+
+// UpdateSubscriptionSeatsRequest changes the seat count of a subscription
+type UpdateSubscriptionSeatsRequest struct {
+	// Seats is the new seat count
+	Seats int64 `json:"seats" validate:"min=0"`
+	// EffectiveDate is when the new seat count takes effect, defaults to now.
+	// It must lie within the current billing period.
+	EffectiveDate *time.Time `json:"effective_date,omitempty"`
+	// ProrationBehavior overrides the behavior configured for this kind of change
+	ProrationBehavior *types.SeatProrationBehavior `json:"proration_behavior,omitempty"`
+}
+
+// Validate validates the UpdateSubscriptionSeatsRequest
+func (r *UpdateSubscriptionSeatsRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+	if r.ProrationBehavior != nil {
+		if err := r.ProrationBehavior.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateSubscriptionSeatConfigRequest enables or updates seat licensing on a subscription
+type UpdateSubscriptionSeatConfigRequest struct {
+	types.SeatConfig
+}
+
+// Validate validates the UpdateSubscriptionSeatConfigRequest
+func (r *UpdateSubscriptionSeatConfigRequest) Validate() error {
+	r.SeatConfig.SetDefaults()
+	return r.SeatConfig.Validate()
+}
+
+// SubscriptionSeatChangeResponse represents a seat change in the seat history
+type SubscriptionSeatChangeResponse struct {
+	*subscription.SubscriptionSeatChange
+}
+
+// NewSubscriptionSeatChangeResponse creates a new seat change response
+func NewSubscriptionSeatChangeResponse(c *subscription.SubscriptionSeatChange) *SubscriptionSeatChangeResponse {
+	if c == nil {
+		return nil
+	}
+	return &SubscriptionSeatChangeResponse{SubscriptionSeatChange: c}
+}
+
+// SubscriptionSeatsResponse represents the seats of a subscription
+type SubscriptionSeatsResponse struct {
+	SubscriptionID string            `json:"subscription_id"`
+	Seats          int64             `json:"seats"`
+	LineItemID     string            `json:"line_item_id"`
+	SeatConfig     *types.SeatConfig `json:"seat_config"`
+	// History lists the seat changes of the subscription, oldest first
+	History []*SubscriptionSeatChangeResponse `json:"history"`
+}
+
+// UpdateSubscriptionSeatsResponse is returned after a seat change
+type UpdateSubscriptionSeatsResponse struct {
+	Seats *SubscriptionSeatsResponse `json:"seats"`
+	// SeatChange is the recorded change, nil when the seat count was unchanged
+	SeatChange *SubscriptionSeatChangeResponse `json:"seat_change,omitempty"`
+	// Invoice is the proration invoice of an immediate seat increase
+	Invoice *InvoiceResponse `json:"invoice,omitempty"`
+	// WalletTransaction is the credit of an immediate seat decrease
+	WalletTransaction *WalletTransactionResponse `json:"wallet_transaction,omitempty"`
+}
+
+// ProcessSubscriptionSeatsResult summarizes a seat sync and true-up pass
+type ProcessSubscriptionSeatsResult struct {
+	SeatsSynced    int `json:"seats_synced"`
+	TrueUpInvoices int `json:"true_up_invoices"`
+	Failed         int `json:"failed"`
+}
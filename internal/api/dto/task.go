@@ -57,6 +57,9 @@ func (r *CreateTaskRequest) ToTask(ctx context.Context) *task.Task {
 // TaskResponse represents a task in responses
 type TaskResponse struct {
 	task.Task
+
+	// HasErrorReport is true when a CSV of the failed rows can be downloaded from /tasks/{id}/download
+	HasErrorReport bool `json:"has_error_report"`
 }
 
 // NewTaskResponse creates a new task response from a domain task
@@ -85,6 +88,7 @@ func NewTaskResponse(t *task.Task) *TaskResponse {
 			FailedAt:          t.FailedAt,
 			BaseModel:         t.BaseModel,
 		},
+		HasErrorReport: t.ErrorReport != nil,
 	}
 }
 
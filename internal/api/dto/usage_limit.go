@@ -0,0 +1,53 @@
+package dto
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/shopspring/decimal"
+)
+
+// CheckUsageLimitRequest asks whether a customer may consume more of a metered feature
+type CheckUsageLimitRequest struct {
+	// CustomerID or ExternalCustomerID identifies the customer
+	CustomerID         string `json:"customer_id,omitempty"`
+	ExternalCustomerID string `json:"external_customer_id,omitempty"`
+	// FeatureID or FeatureLookupKey identifies the feature
+	FeatureID        string `json:"feature_id,omitempty"`
+	FeatureLookupKey string `json:"feature_lookup_key,omitempty"`
+	// Quantity is the amount the caller is about to consume.
+	// When omitted the check reports whether any further usage is allowed.
+	Quantity decimal.Decimal `json:"quantity" swaggertype:"string"`
+}
+
+func (r *CheckUsageLimitRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+	if r.CustomerID == "" && r.ExternalCustomerID == "" {
+		return ierr.NewError("customer_id or external_customer_id is required").
+			WithHint("Please provide the customer to check").
+			Mark(ierr.ErrValidation)
+	}
+	if r.FeatureID == "" && r.FeatureLookupKey == "" {
+		return ierr.NewError("feature_id or feature_lookup_key is required").
+			WithHint("Please provide the feature to check").
+			Mark(ierr.ErrValidation)
+	}
+	if r.Quantity.IsNegative() {
+		return ierr.NewError("quantity cannot be negative").
+			WithHint("Please provide a non-negative quantity").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// CheckUsageLimitResponse is the decision for a usage limit check
+type CheckUsageLimitResponse struct {
+	Decision types.UsageLimitDecision `json:"decision"`
+	Allowed  bool                     `json:"allowed"`
+	// LimitExceeded is true when the requested quantity goes over the limit.
+	// For soft limits the request is still allowed.
+	LimitExceeded bool `json:"limit_exceeded"`
+	types.UsageLimitStatus
+}
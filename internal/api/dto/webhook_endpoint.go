@@ -0,0 +1,311 @@
+package dto
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/webhookdeliveryattempt"
+	"github.com/flexprice/flexprice/internal/domain/webhookendpoint"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// CreateWebhookEndpointRequest represents the request to create a webhook endpoint
+type CreateWebhookEndpointRequest struct {
+	URL         string `json:"url" validate:"required"`
+	Description string `json:"description,omitempty"`
+	// EventTypes are the events delivered to the endpoint, as event names such as
+	// invoice.update.finalized, prefix wildcards such as invoice.* or * for all events
+	EventTypes []string `json:"event_types" validate:"required,min=1"`
+	// Headers are sent with every delivery to the endpoint
+	Headers map[string]string `json:"headers,omitempty"`
+	// Enabled defaults to true
+	Enabled  *bool             `json:"enabled,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// PayloadVersion pins the schema version deliveries are rendered in, defaults to the latest version
+	PayloadVersion types.WebhookPayloadVersion `json:"payload_version,omitempty"`
+	// PayloadMode defaults to full, thin deliveries carry the ids of the event and entity only
+	PayloadMode types.WebhookPayloadMode `json:"payload_mode,omitempty"`
+}
+
+// Validate validates the CreateWebhookEndpointRequest
+func (r *CreateWebhookEndpointRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if err := validateWebhookEndpointURL(r.URL); err != nil {
+		return err
+	}
+
+	if err := validateWebhookEventTypes(r.EventTypes); err != nil {
+		return err
+	}
+
+	if err := r.PayloadVersion.Validate(); err != nil {
+		return err
+	}
+
+	if err := r.PayloadMode.Validate(); err != nil {
+		return err
+	}
+
+	return validateWebhookEndpointHeaders(r.Headers)
+}
+
+// ToWebhookEndpoint converts the request to a domain webhook endpoint
+func (r *CreateWebhookEndpointRequest) ToWebhookEndpoint(ctx context.Context) *webhookendpoint.WebhookEndpoint {
+	return &webhookendpoint.WebhookEndpoint{
+		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WEBHOOK_ENDPOINT),
+		URL:            strings.TrimSpace(r.URL),
+		Description:    r.Description,
+		EventTypes:     lo.Uniq(r.EventTypes),
+		Headers:        r.Headers,
+		Enabled:        lo.FromPtrOr(r.Enabled, true),
+		Metadata:       r.Metadata,
+		PayloadVersion: lo.CoalesceOrEmpty(r.PayloadVersion, types.WebhookPayloadVersionLatest),
+		PayloadMode:    lo.CoalesceOrEmpty(r.PayloadMode, types.WebhookPayloadModeFull),
+		EnvironmentID:  types.GetEnvironmentID(ctx),
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdateWebhookEndpointRequest represents the request to update a webhook endpoint.
+// Event types, headers and metadata that are provided replace the existing ones.
+type UpdateWebhookEndpointRequest struct {
+	URL         *string           `json:"url,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	EventTypes  []string          `json:"event_types,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Enabled     *bool             `json:"enabled,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	// PayloadVersion moves the endpoint to another payload schema version
+	PayloadVersion *types.WebhookPayloadVersion `json:"payload_version,omitempty"`
+	PayloadMode    *types.WebhookPayloadMode    `json:"payload_mode,omitempty"`
+}
+
+// Validate validates the UpdateWebhookEndpointRequest
+func (r *UpdateWebhookEndpointRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if r.URL != nil {
+		if err := validateWebhookEndpointURL(*r.URL); err != nil {
+			return err
+		}
+	}
+
+	if r.EventTypes != nil {
+		if err := validateWebhookEventTypes(r.EventTypes); err != nil {
+			return err
+		}
+	}
+
+	if r.PayloadVersion != nil {
+		if *r.PayloadVersion == "" {
+			return ierr.NewError("payload_version cannot be empty").
+				WithHint("Provide a payload version such as v1 or v2").
+				Mark(ierr.ErrValidation)
+		}
+		if err := r.PayloadVersion.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if r.PayloadMode != nil {
+		if *r.PayloadMode == "" {
+			return ierr.NewError("payload_mode cannot be empty").
+				WithHint("Provide full or thin as payload mode").
+				Mark(ierr.ErrValidation)
+		}
+		if err := r.PayloadMode.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return validateWebhookEndpointHeaders(r.Headers)
+}
+
+// ApplyTo applies the update to a webhook endpoint
+func (r *UpdateWebhookEndpointRequest) ApplyTo(endpoint *webhookendpoint.WebhookEndpoint) {
+	if r.URL != nil {
+		endpoint.URL = strings.TrimSpace(*r.URL)
+	}
+	if r.Description != nil {
+		endpoint.Description = *r.Description
+	}
+	if r.EventTypes != nil {
+		endpoint.EventTypes = lo.Uniq(r.EventTypes)
+	}
+	if r.Headers != nil {
+		endpoint.Headers = r.Headers
+	}
+	if r.Enabled != nil {
+		// Re-enabling starts a new failure streak so the endpoint is not disabled again at once
+		if *r.Enabled && !endpoint.Enabled {
+			endpoint.ResetHealth()
+		}
+		endpoint.Enabled = *r.Enabled
+	}
+	if r.Metadata != nil {
+		endpoint.Metadata = r.Metadata
+	}
+	if r.PayloadVersion != nil {
+		endpoint.PayloadVersion = *r.PayloadVersion
+	}
+	if r.PayloadMode != nil {
+		endpoint.PayloadMode = *r.PayloadMode
+	}
+}
+
+func validateWebhookEndpointURL(rawURL string) error {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return ierr.NewError("invalid webhook endpoint url").
+			WithHint("Webhook endpoint url must be an absolute http or https url").
+			WithReportableDetails(map[string]any{
+				"url": rawURL,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+func validateWebhookEventTypes(eventTypes []string) error {
+	if len(eventTypes) == 0 {
+		return ierr.NewError("event_types are required").
+			WithHint("Subscribe the endpoint to at least one event, or * for all events").
+			Mark(ierr.ErrValidation)
+	}
+	for _, eventType := range eventTypes {
+		if err := types.ValidateWebhookEventPattern(eventType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reservedWebhookHeaders are set on every delivery and cannot be overridden by an endpoint
+var reservedWebhookHeaders = []string{"Content-Type", "Content-Length", "Host"}
+
+func validateWebhookEndpointHeaders(headers map[string]string) error {
+	for name := range headers {
+		canonical := http.CanonicalHeaderKey(strings.TrimSpace(name))
+		if canonical == "" || lo.Contains(reservedWebhookHeaders, canonical) {
+			return ierr.NewError("invalid webhook endpoint header").
+				WithHintf("Header %q cannot be set on a webhook endpoint", name).
+				WithReportableDetails(map[string]any{
+					"header": name,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+	return nil
+}
+
+// WebhookEndpointResponse represents the response for webhook endpoint data
+type WebhookEndpointResponse struct {
+	*webhookendpoint.WebhookEndpoint `json:",inline"`
+
+	// SigningSecret is only returned when the endpoint is created
+	SigningSecret string `json:"signing_secret,omitempty"`
+}
+
+// RotateWebhookEndpointSecretRequest represents the request to rotate the signing secret of a webhook endpoint
+type RotateWebhookEndpointSecretRequest struct {
+	// OverlapMinutes is how long deliveries stay signed with the old secret as well, so
+	// receivers can switch to the new secret without rejecting deliveries. Defaults to 1440
+	// (24 hours), 0 revokes the old secret immediately.
+	OverlapMinutes *int `json:"overlap_minutes,omitempty" validate:"omitempty,min=0,max=10080"`
+}
+
+// Validate validates the RotateWebhookEndpointSecretRequest
+func (r *RotateWebhookEndpointSecretRequest) Validate() error {
+	return validator.ValidateRequest(r)
+}
+
+// GetOverlap returns the overlap window of the old secret
+func (r *RotateWebhookEndpointSecretRequest) GetOverlap() time.Duration {
+	return time.Duration(lo.FromPtrOr(r.OverlapMinutes, DefaultWebhookSecretOverlapMinutes)) * time.Minute
+}
+
+// DefaultWebhookSecretOverlapMinutes is how long a rotated secret keeps signing deliveries by default
+const DefaultWebhookSecretOverlapMinutes = 24 * 60
+
+// WebhookEndpointSecretResponse represents the signing secret of a webhook endpoint
+type WebhookEndpointSecretResponse struct {
+	WebhookEndpointID string `json:"webhook_endpoint_id"`
+	SigningSecret     string `json:"signing_secret"`
+	// PreviousSecretExpiresAt is when deliveries stop being signed with the rotated secret
+	PreviousSecretExpiresAt *time.Time `json:"previous_secret_expires_at,omitempty"`
+}
+
+// ListWebhookEndpointsResponse represents the response for listing webhook endpoints
+type ListWebhookEndpointsResponse = types.ListResponse[*WebhookEndpointResponse] // @name ListWebhookEndpointsResponse
+
+// WebhookEndpointHealthResponse reports how deliveries to a webhook endpoint are doing
+type WebhookEndpointHealthResponse struct {
+	WebhookEndpointID string  `json:"webhook_endpoint_id"`
+	Enabled           bool    `json:"enabled"`
+	DisabledReason    *string `json:"disabled_reason,omitempty"`
+	// ConsecutiveFailures counts the deliveries that failed since the last successful one
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	FailingSince        *time.Time `json:"failing_since,omitempty"`
+	LastSuccessAt       *time.Time `json:"last_success_at,omitempty"`
+	LastFailureAt       *time.Time `json:"last_failure_at,omitempty"`
+
+	// WindowStart is the start of the window the attempt counts cover, up to now
+	WindowStart        time.Time `json:"window_start"`
+	TotalAttempts      int       `json:"total_attempts"`
+	SuccessfulAttempts int       `json:"successful_attempts"`
+	FailedAttempts     int       `json:"failed_attempts"`
+	// SuccessRate is the percentage of successful attempts in the window, empty without attempts
+	SuccessRate *decimal.Decimal `json:"success_rate,omitempty" swaggertype:"string"`
+}
+
+// NewWebhookEndpointHealthResponse builds the health of an endpoint from its attempt counts
+func NewWebhookEndpointHealthResponse(endpoint *webhookendpoint.WebhookEndpoint, windowStart time.Time, total, successful int) *WebhookEndpointHealthResponse {
+	response := &WebhookEndpointHealthResponse{
+		WebhookEndpointID:   endpoint.ID,
+		Enabled:             endpoint.Enabled,
+		DisabledReason:      endpoint.DisabledReason,
+		ConsecutiveFailures: endpoint.ConsecutiveFailures,
+		FailingSince:        endpoint.FailingSince,
+		LastSuccessAt:       endpoint.LastSuccessAt,
+		LastFailureAt:       endpoint.LastFailureAt,
+		WindowStart:         windowStart,
+		TotalAttempts:       total,
+		SuccessfulAttempts:  successful,
+		FailedAttempts:      total - successful,
+	}
+	if total > 0 {
+		rate := decimal.NewFromInt(int64(successful)).
+			Mul(decimal.NewFromInt(100)).
+			Div(decimal.NewFromInt(int64(total))).
+			Round(2)
+		response.SuccessRate = &rate
+	}
+	return response
+}
+
+const (
+	// DefaultWebhookEndpointHealthWindowHours is the window endpoint health is computed over by default
+	DefaultWebhookEndpointHealthWindowHours = 24
+	// MaxWebhookEndpointHealthWindowHours is the widest window endpoint health is computed over
+	MaxWebhookEndpointHealthWindowHours = 30 * 24
+)
+
+// WebhookDeliveryAttemptResponse represents a delivery attempt of a system event to a webhook endpoint
+type WebhookDeliveryAttemptResponse struct {
+	*webhookdeliveryattempt.WebhookDeliveryAttempt `json:",inline"`
+}
+
+// ListWebhookDeliveryAttemptsResponse represents the response for listing webhook delivery attempts
+type ListWebhookDeliveryAttemptsResponse = types.ListResponse[*WebhookDeliveryAttemptResponse] // @name ListWebhookDeliveryAttemptsResponse
@@ -1,5 +1,15 @@
 package dto
 
+import (
+	"encoding/json"
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
+)
+
 // RetryOutboundWebhookRequest is the body for POST /v1/webhooks/retry.
 type RetryOutboundWebhookRequest struct {
 	SystemEventID string `json:"system_event_id" binding:"required" example:"sev_abc123"`
@@ -11,3 +21,84 @@ type RetryOutboundWebhookResponse struct {
 	Message       string `json:"message"`
 	SystemEventID string `json:"system_event_id"`
 }
+
+const (
+	// DefaultRedeliverWebhooksLimit is how many events one bulk redelivery handles by default
+	DefaultRedeliverWebhooksLimit = 100
+	// maxRedeliverWebhooksRange is the widest time range one bulk redelivery accepts
+	maxRedeliverWebhooksRange = 31 * 24 * time.Hour
+)
+
+// RedeliverWebhooksRequest is the body for POST /v1/webhooks/redeliver. Events created in
+// [start_time, end_time) are delivered again, oldest first.
+type RedeliverWebhooksRequest struct {
+	StartTime  time.Time `json:"start_time" validate:"required"`
+	EndTime    time.Time `json:"end_time" validate:"required"`
+	EventNames []string  `json:"event_names,omitempty"`
+	// WebhookEndpointID redelivers to this endpoint only, native webhooks only
+	WebhookEndpointID string `json:"webhook_endpoint_id,omitempty"`
+	// IncludeDelivered redelivers every event of the range to every endpoint. By default only
+	// undelivered events are sent, and only to the endpoints that have not accepted them.
+	IncludeDelivered bool `json:"include_delivered,omitempty"`
+	// Limit is the maximum number of events redelivered, defaults to 100
+	Limit *int `json:"limit,omitempty" validate:"omitempty,min=1,max=1000"`
+}
+
+// Validate validates the RedeliverWebhooksRequest
+func (r *RedeliverWebhooksRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if !r.EndTime.After(r.StartTime) {
+		return ierr.NewError("end_time must be after start_time").
+			WithHint("Please provide a valid time range").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.EndTime.Sub(r.StartTime) > maxRedeliverWebhooksRange {
+		return ierr.NewError("time range is too wide").
+			WithHint("Redeliver at most 31 days of events at once").
+			WithReportableDetails(map[string]any{
+				"start_time": r.StartTime,
+				"end_time":   r.EndTime,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// GetLimit returns the maximum number of events to redeliver
+func (r *RedeliverWebhooksRequest) GetLimit() int {
+	return lo.FromPtrOr(r.Limit, DefaultRedeliverWebhooksLimit)
+}
+
+// RedeliverWebhooksResponse counts the outcome of a bulk redelivery
+type RedeliverWebhooksResponse struct {
+	Total     int `json:"total"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	// Skipped counts events no enabled endpoint subscribes to
+	Skipped int `json:"skipped"`
+	// HasMore is true when the range holds more events than the limit
+	HasMore bool `json:"has_more"`
+	// NextStartTime is the start_time that continues the redelivery when HasMore is true
+	NextStartTime *time.Time `json:"next_start_time,omitempty"`
+}
+
+// SystemEventResponse is a system event with the webhook payload it is delivered with, used by
+// receivers of thin payloads to fetch the snapshot of the event
+type SystemEventResponse struct {
+	ID             string                 `json:"id"`
+	EventName      string                 `json:"event_name"`
+	EntityType     types.SystemEntityType `json:"entity_type,omitempty"`
+	EntityID       string                 `json:"entity_id,omitempty"`
+	SequenceNumber *int64                 `json:"sequence_number,omitempty"`
+	CreatedAt      time.Time              `json:"created_at"`
+	PublishedAt    *time.Time             `json:"published_at,omitempty"`
+	// PayloadVersion is the version Payload is rendered in
+	PayloadVersion types.WebhookPayloadVersion `json:"payload_version"`
+	// Payload is the body a full delivery of the event in PayloadVersion carries
+	Payload json.RawMessage `json:"payload" swaggertype:"object"`
+}
@@ -28,6 +28,7 @@ type Handlers struct {
 	SubscriptionChange       *v1.SubscriptionChangeHandler
 	SubscriptionModification *v1.SubscriptionModificationHandler
 	SubscriptionSchedule     *v1.SubscriptionScheduleHandler
+	SubscriptionSeat         *v1.SubscriptionSeatHandler
 	Wallet                   *v1.WalletHandler
 	Tenant                   *v1.TenantHandler
 	Invoice                  *v1.InvoiceHandler
@@ -42,6 +43,11 @@ type Handlers struct {
 	CreditNote               *v1.CreditNoteHandler
 	Tax                      *v1.TaxHandler
 	Coupon                   *v1.CouponHandler
+	PromotionCode            *v1.PromotionCodeHandler
+	FXRate                   *v1.FXRateHandler
+	PriceBook                *v1.PriceBookHandler
+	WebhookEndpoint          *v1.WebhookEndpointHandler
+	CommitmentContract       *v1.CommitmentContractHandler
 	Webhook                  *v1.WebhookHandler
 	Addon                    *v1.AddonHandler
 	IntegrationMappingLink   *v1.IntegrationMappingLinkHandler
@@ -51,10 +57,16 @@ type Handlers struct {
 	ScheduledTask            *v1.ScheduledTaskHandler
 	AlertLogsHandler         *v1.AlertLogsHandler
 	RBAC                     *v1.RBACHandler
+	AuditLog                 *v1.AuditLogHandler
+	SSO                      *v1.SSOHandler
+	Membership               *v1.MembershipHandler
 	OAuth                    *v1.OAuthHandler
 	Dashboard                *v1.DashboardHandler
 	Workflow                 *v1.WorkflowHandler
 	MeterUsage               *v1.MeterUsageHandler
+	UsageStream              *v1.UsageStreamHandler
+	UsageLimit               *v1.UsageLimitHandler
+	EntitlementCheck         *v1.EntitlementCheckHandler
 
 	// Portal handlers
 	Onboarding     *v1.OnboardingHandler
@@ -68,11 +80,12 @@ type Handlers struct {
 	CronKafkaLagMonitoring *cron.KafkaLagMonitoringHandler
 }
 
-func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logger, secretService service.SecretService, envAccessService service.EnvAccessService, rbacService *rbac.RBACService) *gin.Engine {
+func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logger, secretService service.SecretService, envAccessService service.EnvAccessService, membershipService service.MembershipService, rbacService *rbac.RBACService) *gin.Engine {
 	// gin.SetMode(gin.ReleaseMode)
 
 	// Create a new gin engine without default middleware
 	router := gin.New()
+	middleware.SetTrustedProxies(router, cfg.Server.TrustedProxies, logger)
 
 	// Add recovery middleware (panic recovery)
 	router.Use(gin.RecoveryWithWriter(logger.GetGinLogger()))
@@ -111,20 +124,46 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 		// Auth routes
 		v1Public.POST("/auth/signup", handlers.Auth.SignUp)
 		v1Public.POST("/auth/login", handlers.Auth.Login)
+
+		// SSO routes, identity providers redirect and post back to the callbacks
+		v1Public.POST("/auth/sso/login", handlers.SSO.StartLogin)
+		v1Public.GET("/auth/sso/login", handlers.SSO.RedirectToLogin)
+		v1Public.GET("/auth/sso/oidc/callback", handlers.SSO.OIDCCallback)
+		v1Public.POST("/auth/sso/saml/acs", handlers.SSO.SAMLACS)
+		v1Public.GET("/auth/sso/saml/:id/metadata", handlers.SSO.GetSAMLMetadata)
+
+		// Invitation routes, invitees respond from the link in the invitation email
+		v1Public.POST("/auth/invitations/accept", handlers.Membership.AcceptInvitation)
+		v1Public.POST("/auth/invitations/decline", handlers.Membership.DeclineInvitation)
 	}
 
-	private := router.Group("/", middleware.AuthenticateMiddleware(cfg, secretService, logger))
+	private := router.Group("/", middleware.AuthenticateMiddleware(cfg, secretService, membershipService, logger))
 	private.Use(middleware.EnvAccessMiddleware(envAccessService, logger))
 	private.Use(middleware.SentryTenantContextMiddleware)
 
 	v1Private := private.Group("/v1")
 	v1Private.Use(middleware.ErrorHandler())
+	// Custom roles and deny by default apply to every route, not only the explicitly checked ones
+	v1Private.Use(permissionMW.RequireRoutePermission())
 	{
 		user := v1Private.Group("/users")
 		{
 			user.GET("/me", handlers.User.GetUserInfo)
 			user.POST("", handlers.User.CreateUser)
-			user.POST("/search", handlers.User.QueryUsers)
+			user.POST(middleware.ReadRoute(user, "/search"), handlers.User.QueryUsers)
+
+			// Tenant switcher of users belonging to several tenants
+			user.GET("/me/tenants", handlers.Membership.ListUserTenants)
+			// Switching issues a token for another tenant, it changes nothing in this one
+			user.POST(middleware.ReadRoute(user, "/me/switch-tenant"), handlers.Membership.SwitchTenant)
+
+			// Granting roles is permission checked like role assignment
+			user.GET("/members", handlers.Membership.ListMembers)
+			user.PUT("/members/:id/roles", permissionMW.RequirePermission("rbac", "write"), handlers.Membership.UpdateMemberRoles)
+			user.DELETE("/members/:id", permissionMW.RequirePermission("user", "write"), handlers.Membership.RemoveMember)
+			user.GET("/invitations", handlers.Membership.ListInvitations)
+			user.POST("/invitations", permissionMW.RequirePermission("rbac", "write"), handlers.Membership.InviteUser)
+			user.DELETE("/invitations/:id", permissionMW.RequirePermission("user", "write"), handlers.Membership.RevokeInvitation)
 		}
 
 		environment := v1Private.Group("/environments")
@@ -143,12 +182,14 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			events.POST("/bulk", permissionMW.RequirePermission("event", "write"), handlers.Events.BulkIngestEvent)
 			events.GET("", handlers.Events.GetEvents)
 			events.GET("/:id", handlers.Events.GetEventByID)
-			events.POST("/query", handlers.Events.QueryEvents)
-			events.POST("/usage", handlers.Events.GetUsage)
-			events.POST("/usage/meter", handlers.Events.GetUsageByMeter)
-			events.POST("/analytics", handlers.Events.GetUsageAnalytics)
-			events.POST("/analytics-v2", handlers.Events.GetUsageAnalyticsV2)
-			events.POST("/huggingface-billing", handlers.Events.GetHuggingFaceBillingData)
+			// Webhook payload snapshot of a system event, for receivers of thin payloads
+			events.GET("/system/:id", handlers.Webhook.GetSystemEvent)
+			events.POST(middleware.ReadRoute(events, "/query"), handlers.Events.QueryEvents)
+			events.POST(middleware.ReadRoute(events, "/usage"), handlers.Events.GetUsage)
+			events.POST(middleware.ReadRoute(events, "/usage/meter"), handlers.Events.GetUsageByMeter)
+			events.POST(middleware.ReadRoute(events, "/analytics"), handlers.Events.GetUsageAnalytics)
+			events.POST(middleware.ReadRoute(events, "/analytics-v2"), handlers.Events.GetUsageAnalyticsV2)
+			events.POST(middleware.ReadRoute(events, "/huggingface-billing"), handlers.Events.GetHuggingFaceBillingData)
 			events.GET("/monitoring", handlers.Events.GetMonitoringData)
 			// Reprocess events endpoint
 			events.POST("/reprocess", handlers.Events.ReprocessEvents)
@@ -164,8 +205,8 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 		// Meter usage query endpoints (reads from meter_usage ClickHouse table)
 		meterUsage := v1Private.Group("/meter-usage")
 		{
-			meterUsage.POST("/query", handlers.MeterUsage.QueryUsage)
-			meterUsage.POST("/analytics", handlers.MeterUsage.GetAnalytics)
+			meterUsage.POST(middleware.ReadRoute(meterUsage, "/query"), handlers.MeterUsage.QueryUsage)
+			meterUsage.POST(middleware.ReadRoute(meterUsage, "/analytics"), handlers.MeterUsage.GetAnalytics)
 		}
 
 		meters := v1Private.Group("/meters")
@@ -187,7 +228,7 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			price.PUT("/:id", handlers.Price.UpdatePrice)
 			price.DELETE("/:id", handlers.Price.DeletePrice)
 			price.GET("/lookup/:lookup_key", handlers.Price.GetByLookupKey)
-			price.POST("/search", handlers.Price.QueryPrices)
+			price.POST(middleware.ReadRoute(price, "/search"), handlers.Price.QueryPrices)
 
 			priceUnit := price.Group("/units")
 			{
@@ -197,7 +238,7 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 				priceUnit.GET("/code/:code", handlers.PriceUnit.GetPriceUnitByCode)
 				priceUnit.PUT("/:id", handlers.PriceUnit.UpdatePriceUnit)
 				priceUnit.DELETE("/:id", handlers.PriceUnit.DeletePriceUnit)
-				priceUnit.POST("/search", handlers.PriceUnit.QueryPriceUnits)
+				priceUnit.POST(middleware.ReadRoute(priceUnit, "/search"), handlers.PriceUnit.QueryPriceUnits)
 			}
 		}
 
@@ -205,7 +246,7 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 		{
 
 			// list customers by filter
-			customer.POST("/search", handlers.Customer.QueryCustomers)
+			customer.POST(middleware.ReadRoute(customer, "/search"), handlers.Customer.QueryCustomers)
 
 			customer.POST("", handlers.Customer.CreateCustomer)
 			customer.GET("", handlers.Customer.ListCustomers)
@@ -220,7 +261,10 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			customer.GET("/:id/entitlements", handlers.Customer.GetCustomerEntitlements)
 			customer.GET("/usage", handlers.Customer.GetCustomerUsageSummary)     // New route with query parameters (must come first!)
 			customer.GET("/:id/usage", handlers.Customer.GetCustomerUsageSummary) // Deprecated route with path parameter
+			customer.GET("/:id/usage/stream", handlers.UsageStream.StreamCustomerUsage)
+			customer.POST(middleware.ReadRoute(customer, "/usage-limits/check"), handlers.UsageLimit.CheckUsageLimit)
 			customer.GET("/:id/grants/upcoming", handlers.Customer.GetUpcomingCreditGrantApplications)
+			customer.GET("/:id/hierarchy", handlers.Customer.GetCustomerHierarchy)
 
 			// other routes for customer
 			customer.GET("/:id/wallets", handlers.Wallet.GetWalletsByCustomerID)
@@ -235,7 +279,7 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 		plan := v1Private.Group("/plans")
 		{
 			// list plans by filter
-			plan.POST("/search", handlers.Plan.QueryPlans)
+			plan.POST(middleware.ReadRoute(plan, "/search"), handlers.Plan.QueryPlans)
 
 			plan.POST("", handlers.Plan.CreatePlan)
 			plan.GET("", handlers.Plan.ListPlans)
@@ -254,7 +298,7 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 		addon := v1Private.Group("/addons")
 		{
 			// list addons by filter
-			addon.POST("/search", handlers.Addon.QueryAddons)
+			addon.POST(middleware.ReadRoute(addon, "/search"), handlers.Addon.QueryAddons)
 
 			addon.POST("", handlers.Addon.CreateAddon)
 			addon.GET("", handlers.Addon.ListAddons)
@@ -268,14 +312,14 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 		group := v1Private.Group("/groups")
 		{
 			group.POST("", handlers.Group.CreateGroup)
-			group.POST("/search", handlers.Group.QueryGroups)
+			group.POST(middleware.ReadRoute(group, "/search"), handlers.Group.QueryGroups)
 			group.GET("/:id", handlers.Group.GetGroup)
 			group.DELETE("/:id", handlers.Group.DeleteGroup)
 		}
 
 		subscription := v1Private.Group("/subscriptions")
 		{
-			subscription.POST("/search", handlers.Subscription.QuerySubscriptions)
+			subscription.POST(middleware.ReadRoute(subscription, "/search"), handlers.Subscription.QuerySubscriptions)
 			subscription.POST("", handlers.Subscription.CreateSubscription)
 			subscription.GET("", handlers.Subscription.ListSubscriptions)
 			subscription.GET("/:id", handlers.Subscription.GetSubscription)
@@ -283,7 +327,7 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			subscription.GET("/:id/v2", handlers.Subscription.GetSubscriptionV2)
 			subscription.POST("/:id/activate", handlers.Subscription.ActivateDraftSubscription)
 			subscription.POST("/:id/cancel", handlers.Subscription.CancelSubscription)
-			subscription.POST("/usage", handlers.Subscription.GetUsageBySubscription)
+			subscription.POST(middleware.ReadRoute(subscription, "/usage"), handlers.Subscription.GetUsageBySubscription)
 
 			subscription.GET("/:id/entitlements", handlers.Subscription.GetSubscriptionEntitlements)
 			subscription.GET("/:id/grants/upcoming", handlers.Subscription.GetUpcomingCreditGrantApplications)
@@ -294,10 +338,15 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			subscription.GET("/:id/addons/associations", handlers.Subscription.GetActiveAddonAssociations)
 
 			// Subscription plan changes (upgrade/downgrade)
-			subscription.POST("/:id/change/preview", handlers.SubscriptionChange.PreviewSubscriptionChange)
+			subscription.POST(middleware.ReadRoute(subscription, "/:id/change/preview"), handlers.SubscriptionChange.PreviewSubscriptionChange)
 			subscription.POST("/:id/change/execute", handlers.SubscriptionChange.ExecuteSubscriptionChange)
 			subscription.POST(":id/modify/execute", handlers.SubscriptionModification.Execute)
-			subscription.POST(":id/modify/preview", handlers.SubscriptionModification.Preview)
+			subscription.POST(middleware.ReadRoute(subscription, ":id/modify/preview"), handlers.SubscriptionModification.Preview)
+
+			// Seat-based licensing
+			subscription.GET("/:id/seats", handlers.SubscriptionSeat.GetSeats)
+			subscription.POST("/:id/seats", handlers.SubscriptionSeat.UpdateSeats)
+			subscription.PUT("/:id/seats/config", handlers.SubscriptionSeat.UpdateSeatConfig)
 
 			// Subscription line item management
 			subscription.POST("/:id/lineitems", handlers.Subscription.AddSubscriptionLineItem)
@@ -335,8 +384,8 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			wallet.GET("/:id/balance/real-time-cached", handlers.Wallet.GetWalletBalanceForceCached)
 			wallet.PUT("/:id", handlers.Wallet.UpdateWallet)
 			wallet.POST("/:id/debit", handlers.Wallet.ManualBalanceDebit)
-			wallet.POST("/transactions/search", handlers.Wallet.QueryWalletTransactions)
-			wallet.POST("/search", handlers.Wallet.QueryWallets)
+			wallet.POST(middleware.ReadRoute(wallet, "/transactions/search"), handlers.Wallet.QueryWalletTransactions)
+			wallet.POST(middleware.ReadRoute(wallet, "/search"), handlers.Wallet.QueryWallets)
 		}
 		// Tenant routes
 		tenantRoutes := v1Private.Group("/tenants")
@@ -349,7 +398,7 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 		invoices := v1Private.Group("/invoices")
 		{
 			invoices.POST("/temporal/:invoice_id/finalize", handlers.Invoice.TriggerFinalizeDraftInvoiceWorkflow)
-			invoices.POST("/search", handlers.Invoice.QueryInvoices)
+			invoices.POST(middleware.ReadRoute(invoices, "/search"), handlers.Invoice.QueryInvoices)
 			invoices.POST("", handlers.Invoice.CreateOneOffInvoice)
 			invoices.GET("", handlers.Invoice.ListInvoices)
 			invoices.GET("/:id", handlers.Invoice.GetInvoice)
@@ -357,9 +406,9 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			invoices.POST("/:id/finalize", handlers.Invoice.FinalizeInvoice)
 			invoices.POST("/:id/compute", handlers.Invoice.ComputeInvoice)
 			invoices.POST("/:id/void", handlers.Invoice.VoidInvoice)
-			invoices.POST("/preview", handlers.Invoice.GetPreviewInvoice)
-			invoices.POST("/internal/preview", handlers.Invoice.GetInternalPreviewInvoice)
-			invoices.POST("/meter-usage-preview", handlers.Invoice.GetMeterUsagePreviewInvoice)
+			invoices.POST(middleware.ReadRoute(invoices, "/preview"), handlers.Invoice.GetPreviewInvoice)
+			invoices.POST(middleware.ReadRoute(invoices, "/internal/preview"), handlers.Invoice.GetInternalPreviewInvoice)
+			invoices.POST(middleware.ReadRoute(invoices, "/meter-usage-preview"), handlers.Invoice.GetMeterUsagePreviewInvoice)
 			invoices.PUT("/:id/payment", handlers.Invoice.UpdatePaymentStatus)
 			invoices.POST("/:id/payment/attempt", handlers.Invoice.AttemptPayment)
 			invoices.GET("/:id/pdf", handlers.Invoice.GetInvoicePDF)
@@ -377,15 +426,16 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			feature.GET("/:id", handlers.Feature.GetFeature)
 			feature.PUT("/:id", handlers.Feature.UpdateFeature)
 			feature.DELETE("/:id", handlers.Feature.DeleteFeature)
-			feature.POST("/search", handlers.Feature.QueryFeatures)
+			feature.POST(middleware.ReadRoute(feature, "/search"), handlers.Feature.QueryFeatures)
 			feature.POST("/:id/clone", handlers.Feature.CloneFeature)
 		}
 
 		entitlement := v1Private.Group("/entitlements")
 		{
-			entitlement.POST("/search", handlers.Entitlement.QueryEntitlements)
+			entitlement.POST(middleware.ReadRoute(entitlement, "/search"), handlers.Entitlement.QueryEntitlements)
 			entitlement.POST("", handlers.Entitlement.CreateEntitlement)
 			entitlement.POST("/bulk", handlers.Entitlement.CreateBulkEntitlement)
+			entitlement.POST(middleware.ReadRoute(entitlement, "/check"), handlers.EntitlementCheck.CheckEntitlements)
 			entitlement.GET("", handlers.Entitlement.ListEntitlements)
 			entitlement.GET("/:id", handlers.Entitlement.GetEntitlement)
 			entitlement.PUT("/:id", handlers.Entitlement.UpdateEntitlement)
@@ -466,7 +516,10 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			{
 				apiKeys.GET("", handlers.Secret.ListAPIKeys)
 				apiKeys.POST("", handlers.Secret.CreateAPIKey)
+				apiKeys.PUT("/:id", handlers.Secret.UpdateAPIKey)
 				apiKeys.DELETE("/:id", handlers.Secret.DeleteAPIKey)
+				apiKeys.POST("/:id/rotate", handlers.Secret.RotateAPIKey)
+				apiKeys.GET("/:id/usage", handlers.Secret.GetAPIKeyUsage)
 			}
 		}
 
@@ -478,20 +531,20 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			connections.GET("/:id", handlers.Connection.GetConnection)
 			connections.PUT("/:id", handlers.Connection.UpdateConnection)
 			connections.DELETE("/:id", handlers.Connection.DeleteConnection)
-			connections.POST("/search", handlers.Connection.QueryConnections)
+			connections.POST(middleware.ReadRoute(connections, "/search"), handlers.Connection.QueryConnections)
 		}
 
 		// Costsheet routes
 		costsheets := v1Private.Group("/costs")
 		{
-			costsheets.POST("/search", handlers.Costsheet.QueryCostsheets)
+			costsheets.POST(middleware.ReadRoute(costsheets, "/search"), handlers.Costsheet.QueryCostsheets)
 			costsheets.POST("", handlers.Costsheet.CreateCostsheet)
 			costsheets.GET("/:id", handlers.Costsheet.GetCostsheet)
 			costsheets.PUT("/:id", handlers.Costsheet.UpdateCostsheet)
 			costsheets.DELETE("/:id", handlers.Costsheet.DeleteCostsheet)
 			costsheets.GET("/active", handlers.Costsheet.GetActiveCostsheetForTenant)
-			costsheets.POST("/analytics", handlers.RevenueAnalytics.GetDetailedCostAnalytics)
-			costsheets.POST("/analytics-v2", handlers.RevenueAnalytics.GetDetailedCostAnalyticsV2)
+			costsheets.POST(middleware.ReadRoute(costsheets, "/analytics"), handlers.RevenueAnalytics.GetDetailedCostAnalytics)
+			costsheets.POST(middleware.ReadRoute(costsheets, "/analytics-v2"), handlers.RevenueAnalytics.GetDetailedCostAnalyticsV2)
 		}
 
 		// Credit note routes
@@ -518,7 +571,48 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			coupon.GET("/:id", handlers.Coupon.GetCoupon)
 			coupon.PUT("/:id", handlers.Coupon.UpdateCoupon)
 			coupon.DELETE("/:id", handlers.Coupon.DeleteCoupon)
-			coupon.POST("/search", handlers.Coupon.QueryCoupons)
+			coupon.POST(middleware.ReadRoute(coupon, "/search"), handlers.Coupon.QueryCoupons)
+
+			// Promotion codes of coupons
+			coupon.POST("/codes", handlers.PromotionCode.CreatePromotionCode)
+			coupon.GET("/codes", handlers.PromotionCode.ListPromotionCodes)
+			coupon.POST("/codes/validate", handlers.PromotionCode.ValidatePromotionCode)
+			coupon.GET("/codes/:id", handlers.PromotionCode.GetPromotionCode)
+			coupon.PUT("/codes/:id", handlers.PromotionCode.UpdatePromotionCode)
+			coupon.DELETE("/codes/:id", handlers.PromotionCode.DeletePromotionCode)
+		}
+
+		// FX rate routes
+		fxRates := v1Private.Group("/fx-rates")
+		{
+			fxRates.POST("", handlers.FXRate.CreateFXRate)
+			fxRates.GET("", handlers.FXRate.ListFXRates)
+			fxRates.POST("/sync", handlers.FXRate.SyncFXRates)
+			fxRates.GET("/gain-loss", handlers.FXRate.GetFXGainLoss)
+			fxRates.GET("/:id", handlers.FXRate.GetFXRate)
+			fxRates.DELETE("/:id", handlers.FXRate.DeleteFXRate)
+		}
+
+		// Price book routes
+		priceBooks := v1Private.Group("/price-books")
+		{
+			priceBooks.POST("", handlers.PriceBook.CreatePriceBook)
+			priceBooks.GET("", handlers.PriceBook.ListPriceBooks)
+			priceBooks.GET("/:id", handlers.PriceBook.GetPriceBook)
+			priceBooks.PUT("/:id", handlers.PriceBook.UpdatePriceBook)
+			priceBooks.DELETE("/:id", handlers.PriceBook.DeletePriceBook)
+			priceBooks.GET("/:id/preview", handlers.PriceBook.PreviewPriceBook)
+		}
+
+		// Commitment contract routes
+		commitmentContracts := v1Private.Group("/commitment-contracts")
+		{
+			commitmentContracts.POST("", handlers.CommitmentContract.CreateCommitmentContract)
+			commitmentContracts.GET("", handlers.CommitmentContract.ListCommitmentContracts)
+			commitmentContracts.GET("/:id", handlers.CommitmentContract.GetCommitmentContract)
+			commitmentContracts.PUT("/:id", handlers.CommitmentContract.UpdateCommitmentContract)
+			commitmentContracts.POST("/:id/cancel", handlers.CommitmentContract.CancelCommitmentContract)
+			commitmentContracts.GET("/:id/burn-down", handlers.CommitmentContract.GetCommitmentContractBurnDown)
 		}
 
 		// Admin routes (API Key only)
@@ -552,6 +646,25 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 		{
 			webhookGroup.GET("/dashboard", handlers.Webhook.GetDashboardURL)
 			webhookGroup.POST("/retry", handlers.Webhook.RetryOutboundWebhook)
+			webhookGroup.POST("/redeliver", handlers.Webhook.RedeliverWebhooks)
+
+			webhookEndpoints := webhookGroup.Group("/endpoints")
+			{
+				webhookEndpoints.POST("", handlers.WebhookEndpoint.CreateWebhookEndpoint)
+				webhookEndpoints.GET("", handlers.WebhookEndpoint.ListWebhookEndpoints)
+				webhookEndpoints.GET("/:id", handlers.WebhookEndpoint.GetWebhookEndpoint)
+				webhookEndpoints.PUT("/:id", handlers.WebhookEndpoint.UpdateWebhookEndpoint)
+				webhookEndpoints.DELETE("/:id", handlers.WebhookEndpoint.DeleteWebhookEndpoint)
+				webhookEndpoints.GET("/:id/secret", handlers.WebhookEndpoint.GetSigningSecret)
+				webhookEndpoints.POST("/:id/secret/rotate", handlers.WebhookEndpoint.RotateSigningSecret)
+				webhookEndpoints.GET("/:id/health", handlers.WebhookEndpoint.GetWebhookEndpointHealth)
+			}
+
+			webhookAttempts := webhookGroup.Group("/attempts")
+			{
+				webhookAttempts.GET("", handlers.WebhookEndpoint.ListDeliveryAttempts)
+				webhookAttempts.GET("/:id", handlers.WebhookEndpoint.GetDeliveryAttempt)
+			}
 		}
 	}
 
@@ -564,10 +677,13 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 		customerPortalAPI.GET("/info", handlers.CustomerPortal.GetCustomer)
 		customerPortalAPI.PUT("/info", handlers.CustomerPortal.UpdateCustomer)
 		customerPortalAPI.GET("/usage", handlers.CustomerPortal.GetUsageSummary)
+		customerPortalAPI.GET("/usage/stream", handlers.UsageStream.StreamPortalUsage)
 
 		// Subscriptions
 		customerPortalAPI.POST("/subscriptions", handlers.CustomerPortal.GetSubscriptions)
 		customerPortalAPI.GET("/subscriptions/:id", handlers.CustomerPortal.GetSubscription)
+		customerPortalAPI.POST("/subscriptions/:id/promotion-codes", handlers.CustomerPortal.RedeemPromotionCode)
+		customerPortalAPI.POST("/promotion-codes/validate", handlers.CustomerPortal.ValidatePromotionCode)
 
 		// Invoices
 		customerPortalAPI.POST("/invoices", handlers.CustomerPortal.GetInvoices)
@@ -652,7 +768,7 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 	alert := v1Private.Group("/alerts")
 	{
 		// list alert logs by filter
-		alert.POST("/search", handlers.AlertLogsHandler.QueryAlertLogs)
+		alert.POST(middleware.ReadRoute(alert, "/search"), handlers.AlertLogsHandler.QueryAlertLogs)
 	}
 
 	// RBAC routes
@@ -660,6 +776,32 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 	{
 		rbac.GET("/roles", handlers.RBAC.ListRoles)
 		rbac.GET("/roles/:id", handlers.RBAC.GetRole)
+
+		// Changing roles is permission checked so API keys cannot widen their own access
+		rbac.POST("/roles", permissionMW.RequirePermission("rbac", "write"), handlers.RBAC.CreateRole)
+		rbac.PUT("/roles/:id", permissionMW.RequirePermission("rbac", "write"), handlers.RBAC.UpdateRole)
+		rbac.DELETE("/roles/:id", permissionMW.RequirePermission("rbac", "write"), handlers.RBAC.DeleteRole)
+		rbac.PUT("/users/:id/roles", permissionMW.RequirePermission("rbac", "write"), handlers.RBAC.AssignUserRoles)
+		rbac.PUT("/api-keys/:id/roles", permissionMW.RequirePermission("rbac", "write"), handlers.RBAC.AssignAPIKeyRoles)
+	}
+
+	// Audit log routes, the log is append-only so there are no write routes
+	auditLogs := v1Private.Group("/audit-logs")
+	{
+		auditLogs.GET("", handlers.AuditLog.ListAuditLogs)
+		auditLogs.POST(middleware.ReadRoute(auditLogs, "/search"), handlers.AuditLog.QueryAuditLogs)
+		auditLogs.GET("/:id", handlers.AuditLog.GetAuditLog)
+	}
+
+	// SSO connection routes, connections decide who can sign in to the tenant
+	ssoConnections := v1Private.Group("/sso/connections")
+	{
+		ssoConnections.GET("", handlers.SSO.ListConnections)
+		ssoConnections.GET("/:id", handlers.SSO.GetConnection)
+		ssoConnections.POST("", permissionMW.RequirePermission("sso", "write"), handlers.SSO.CreateConnection)
+		ssoConnections.PUT("/:id", permissionMW.RequirePermission("sso", "write"), handlers.SSO.UpdateConnection)
+		ssoConnections.DELETE("/:id", permissionMW.RequirePermission("sso", "write"), handlers.SSO.DeleteConnection)
+		ssoConnections.POST("/:id/verify-domains", permissionMW.RequirePermission("sso", "write"), handlers.SSO.VerifyDomains)
 	}
 
 	// OAuth routes
@@ -672,15 +814,15 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 	// Dashboard routes
 	dashboardRoutes := v1Private.Group("/dashboard")
 	{
-		dashboardRoutes.POST("/revenues", handlers.Dashboard.GetRevenues)
-		dashboardRoutes.POST("/revenue-dashboard", handlers.Dashboard.GetRevenueDashboard)
+		dashboardRoutes.POST(middleware.ReadRoute(dashboardRoutes, "/revenues"), handlers.Dashboard.GetRevenues)
+		dashboardRoutes.POST(middleware.ReadRoute(dashboardRoutes, "/revenue-dashboard"), handlers.Dashboard.GetRevenueDashboard)
 	}
 
 	// Workflow monitoring routes
 	workflows := v1Private.Group("/workflows")
 	{
-		workflows.POST("/search", handlers.Workflow.QueryWorkflows)
-		workflows.POST("/batch", handlers.Workflow.GetWorkflowsBatch)
+		workflows.POST(middleware.ReadRoute(workflows, "/search"), handlers.Workflow.QueryWorkflows)
+		workflows.POST(middleware.ReadRoute(workflows, "/batch"), handlers.Workflow.GetWorkflowsBatch)
 		workflows.GET("/:workflow_id/:run_id/summary", handlers.Workflow.GetWorkflowSummary)
 		workflows.GET("/:workflow_id/:run_id/timeline", handlers.Workflow.GetWorkflowTimeline)
 		workflows.GET("/:workflow_id/:run_id", handlers.Workflow.GetWorkflowDetails)
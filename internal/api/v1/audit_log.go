@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"net/http"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type AuditLogHandler struct {
+	auditLogService service.AuditLogService
+	log             *logger.Logger
+}
+
+func NewAuditLogHandler(auditLogService service.AuditLogService, log *logger.Logger) *AuditLogHandler {
+	return &AuditLogHandler{
+		auditLogService: auditLogService,
+		log:             log,
+	}
+}
+
+// @Summary List audit logs
+// @ID listAuditLogs
+// @Description Use when reviewing who changed what. Every create, update and delete made through the API or by background workflows is recorded with the actor, the changed fields before and after the action, the request ID and the client IP. Entries cannot be changed or deleted.
+// @Tags Audit Logs
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query types.AuditLogFilter false "Filter"
+// @Success 200 {object} dto.ListAuditLogsResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /audit-logs [get]
+func (h *AuditLogHandler) ListAuditLogs(c *gin.Context) {
+	var filter types.AuditLogFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	response, err := h.auditLogService.ListAuditLogs(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Query audit logs
+// @ID queryAuditLogs
+// @Description Use when investigating changes to an entity or the actions of a user or API key (e.g. compliance review). Returns a paginated list; supports the filters and sort conditions of other search endpoints.
+// @Tags Audit Logs
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter body types.AuditLogFilter true "Filter"
+// @Success 200 {object} dto.ListAuditLogsResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /audit-logs/search [post]
+func (h *AuditLogHandler) QueryAuditLogs(c *gin.Context) {
+	var filter types.AuditLogFilter
+	if err := c.ShouldBindJSON(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid filter parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	response, err := h.auditLogService.ListAuditLogs(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Get audit log
+// @ID getAuditLog
+// @Description Use when inspecting a single audit log entry, including every changed field.
+// @Tags Audit Logs
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Audit log ID"
+// @Success 200 {object} dto.AuditLogResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /audit-logs/{id} [get]
+func (h *AuditLogHandler) GetAuditLog(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("audit log ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.auditLogService.GetAuditLog(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
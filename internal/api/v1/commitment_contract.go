@@ -0,0 +1,219 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type CommitmentContractHandler struct {
+	commitmentContractService service.CommitmentContractService
+	logger                    *logger.Logger
+}
+
+func NewCommitmentContractHandler(commitmentContractService service.CommitmentContractService, logger *logger.Logger) *CommitmentContractHandler {
+	return &CommitmentContractHandler{
+		commitmentContractService: commitmentContractService,
+		logger:                    logger,
+	}
+}
+
+// @Summary Create commitment contract
+// @ID createCommitmentContract
+// @Description Use when a customer commits to a minimum spend across one or more subscriptions. The prepaid amount is invoiced right away and drawn down by usage as subscription invoices are finalized; usage after it runs out is charged at the overage factor. At the end of the term, spend short of the commitment is invoiced as a true-up.
+// @Tags Commitment Contracts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param commitment_contract body dto.CreateCommitmentContractRequest true "Commitment contract request"
+// @Success 201 {object} dto.CommitmentContractResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Customer or subscription not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /commitment-contracts [post]
+func (h *CommitmentContractHandler) CreateCommitmentContract(c *gin.Context) {
+	var req dto.CreateCommitmentContractRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.commitmentContractService.CreateCommitmentContract(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// @Summary Get commitment contract
+// @ID getCommitmentContract
+// @Description Use when you need to load a single commitment contract with its drawn down and remaining balance.
+// @Tags Commitment Contracts
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Commitment contract ID"
+// @Success 200 {object} dto.CommitmentContractResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /commitment-contracts/{id} [get]
+func (h *CommitmentContractHandler) GetCommitmentContract(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("commitment contract ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.commitmentContractService.GetCommitmentContract(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Update commitment contract
+// @ID updateCommitmentContract
+// @Description Use when adding subscriptions to a contract or extending its term. Amounts cannot be changed once the prepayment is invoiced. Only active contracts can be updated.
+// @Tags Commitment Contracts
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Commitment contract ID"
+// @Param commitment_contract body dto.UpdateCommitmentContractRequest true "Commitment contract update request"
+// @Success 200 {object} dto.CommitmentContractResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /commitment-contracts/{id} [put]
+func (h *CommitmentContractHandler) UpdateCommitmentContract(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("commitment contract ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateCommitmentContractRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.commitmentContractService.UpdateCommitmentContract(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Cancel commitment contract
+// @ID cancelCommitmentContract
+// @Description Use when ending a commitment early. Usage stops drawing down the contract and no true-up is invoiced.
+// @Tags Commitment Contracts
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Commitment contract ID"
+// @Success 200 {object} dto.CommitmentContractResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /commitment-contracts/{id}/cancel [post]
+func (h *CommitmentContractHandler) CancelCommitmentContract(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("commitment contract ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.commitmentContractService.CancelCommitmentContract(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary List commitment contracts
+// @ID listCommitmentContracts
+// @Description Use when listing commitment contracts, optionally by customer, subscription or status. Returns a paginated list.
+// @Tags Commitment Contracts
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query types.CommitmentContractFilter false "Filter"
+// @Success 200 {object} dto.ListCommitmentContractsResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /commitment-contracts [get]
+func (h *CommitmentContractHandler) ListCommitmentContracts(c *gin.Context) {
+	var filter types.CommitmentContractFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	response, err := h.commitmentContractService.ListCommitmentContracts(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Get commitment contract burn-down
+// @ID getCommitmentContractBurnDown
+// @Description Use when showing how a commitment is being used up, e.g. a burn-down chart. Returns the drawdown per billing period, utilization, overage so far, the projected true-up and when the prepaid amount is projected to run out.
+// @Tags Commitment Contracts
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Commitment contract ID"
+// @Success 200 {object} dto.CommitmentContractBurnDownResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /commitment-contracts/{id}/burn-down [get]
+func (h *CommitmentContractHandler) GetCommitmentContractBurnDown(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("commitment contract ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.commitmentContractService.GetBurnDown(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
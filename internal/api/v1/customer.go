@@ -306,6 +306,36 @@ func (h *CustomerHandler) GetCustomerEntitlements(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// @Summary Get customer hierarchy
+// @ID getCustomerHierarchy
+// @Description Use when showing how a customer sits in an account hierarchy. Returns its parents (nearest first), all of its descendants, and the customer its invoices are routed to.
+// @Tags Customers
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Customer ID"
+// @Success 200 {object} dto.CustomerHierarchyResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Customer not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /customers/{id}/hierarchy [get]
+func (h *CustomerHandler) GetCustomerHierarchy(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("customer ID is required").
+			WithHint("Please provide a valid customer ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.GetCustomerHierarchy(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // @Summary Get customer usage summary
 // @ID getCustomerUsageSummary
 // @Description Use when showing a customer's usage (e.g. portal or overage alerts). Identify by customer_id or customer_lookup_key; supports filters.
@@ -263,3 +263,45 @@ func (h *CustomerPortalHandler) GetWalletTransactions(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
+
+func (h *CustomerPortalHandler) ValidatePromotionCode(c *gin.Context) {
+	var req dto.ValidatePromotionCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.portalService.ValidatePromotionCode(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *CustomerPortalHandler) RedeemPromotionCode(c *gin.Context) {
+	subscriptionID := c.Param("id")
+	if subscriptionID == "" {
+		c.Error(ierr.NewError("subscription_id is required").Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.RedeemPromotionCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.portalService.RedeemPromotionCode(c.Request.Context(), subscriptionID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/config"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type EntitlementCheckHandler struct {
+	entitlementCheckService service.EntitlementCheckService
+	config                  *config.Configuration
+	log                     *logger.Logger
+}
+
+func NewEntitlementCheckHandler(
+	entitlementCheckService service.EntitlementCheckService,
+	config *config.Configuration,
+	log *logger.Logger,
+) *EntitlementCheckHandler {
+	return &EntitlementCheckHandler{
+		entitlementCheckService: entitlementCheckService,
+		config:                  config,
+		log:                     log,
+	}
+}
+
+// @Summary Check entitlements
+// @ID checkEntitlements
+// @Description Use for per-request feature gating. Returns the customer's effective entitlements for the given features (or all features when none are given) from a cached snapshot. The snapshot version is returned as the ETag; SDKs may cache the response for the Cache-Control max-age and revalidate with If-None-Match, which returns 304 when nothing changed.
+// @Tags Entitlements
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.CheckEntitlementsRequest true "Entitlement check"
+// @Param If-None-Match header string false "Snapshot version held by the client"
+// @Success 200 {object} dto.CheckEntitlementsResponse
+// @Success 304 "Snapshot unchanged"
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Customer not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /entitlements/check [post]
+func (h *EntitlementCheckHandler) CheckEntitlements(c *gin.Context) {
+	var req dto.CheckEntitlementsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.entitlementCheckService.CheckEntitlements(c.Request.Context(), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	etag := fmt.Sprintf("%q", resp.Version)
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", fmt.Sprintf("private, max-age=%d", int(h.config.EntitlementCheck.ClientMaxAge.Seconds())))
+
+	if ifNoneMatch := c.GetHeader("If-None-Match"); ifNoneMatch != "" {
+		for _, candidate := range strings.Split(ifNoneMatch, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == etag || candidate == "*" {
+				c.Status(http.StatusNotModified)
+				return
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
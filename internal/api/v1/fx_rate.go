@@ -0,0 +1,197 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type FXRateHandler struct {
+	fxRateService service.FXRateService
+	logger        *logger.Logger
+}
+
+func NewFXRateHandler(fxRateService service.FXRateService, logger *logger.Logger) *FXRateHandler {
+	return &FXRateHandler{
+		fxRateService: fxRateService,
+		logger:        logger,
+	}
+}
+
+// @Summary Create FX rate
+// @ID createFxRate
+// @Description Use when publishing an exchange rate for wallets with FX conversion enabled. The rate applies from effective_at until a newer rate for the pair takes effect; the inverse pair falls back to 1/rate.
+// @Tags FX Rates
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param fx_rate body dto.CreateFXRateRequest true "FX rate request"
+// @Success 201 {object} dto.FXRateResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 409 {object} ierr.ErrorResponse "Rate already exists"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /fx-rates [post]
+func (h *FXRateHandler) CreateFXRate(c *gin.Context) {
+	var req dto.CreateFXRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.fxRateService.CreateFXRate(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// @Summary Get FX rate
+// @ID getFxRate
+// @Description Use when you need to load a single FX rate.
+// @Tags FX Rates
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "FX rate ID"
+// @Success 200 {object} dto.FXRateResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /fx-rates/{id} [get]
+func (h *FXRateHandler) GetFXRate(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("fx rate ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.fxRateService.GetFXRate(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Delete FX rate
+// @ID deleteFxRate
+// @Description Use when withdrawing a rate entered by mistake. Wallet transactions already converted with it keep the rate they recorded.
+// @Tags FX Rates
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "FX rate ID"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /fx-rates/{id} [delete]
+func (h *FXRateHandler) DeleteFXRate(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("fx rate ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.fxRateService.DeleteFXRate(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "FX rate deleted successfully"})
+}
+
+// @Summary List FX rates
+// @ID listFxRates
+// @Description Use when reviewing the rate table of a currency pair or the rates loaded from a source. Returns a paginated list.
+// @Tags FX Rates
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query types.FXRateFilter false "Filter"
+// @Success 200 {object} dto.ListFXRatesResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /fx-rates [get]
+func (h *FXRateHandler) ListFXRates(c *gin.Context) {
+	var filter types.FXRateFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	response, err := h.fxRateService.ListFXRates(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Sync FX rates
+// @ID syncFxRates
+// @Description Use when loading the latest rates from the configured rate source. Rates already present for the same pair and effective time are skipped.
+// @Tags FX Rates
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} dto.SyncFXRatesResponse
+// @Failure 400 {object} ierr.ErrorResponse "No rate source configured"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /fx-rates/sync [post]
+func (h *FXRateHandler) SyncFXRates(c *gin.Context) {
+	response, err := h.fxRateService.SyncFXRates(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Get FX gain/loss report
+// @ID getFxGainLoss
+// @Description Use when closing a period to see how much wallet credit was converted to settle invoices in other currencies, at what average rate, and the gain or loss against the rate effective at end_time.
+// @Tags FX Rates
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request query dto.FXGainLossRequest true "Report period"
+// @Success 200 {object} dto.FXGainLossResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /fx-rates/gain-loss [get]
+func (h *FXRateHandler) GetFXGainLoss(c *gin.Context) {
+	var req dto.FXGainLossRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.fxRateService.GetFXGainLoss(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,273 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type MembershipHandler struct {
+	membershipService service.MembershipService
+	log               *logger.Logger
+}
+
+func NewMembershipHandler(membershipService service.MembershipService, log *logger.Logger) *MembershipHandler {
+	return &MembershipHandler{
+		membershipService: membershipService,
+		log:               log,
+	}
+}
+
+// @Summary Invite user
+// @ID inviteUser
+// @Description Use when adding a teammate to the organization. An email with a link to accept or decline is sent to the address; the invitation expires after the configured time. Inviting an address again replaces its pending invitation. Users that already have an account join with it and can switch between their tenants.
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.InviteUserRequest true "Invitation"
+// @Success 201 {object} dto.InvitationResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request or user limit reached"
+// @Failure 409 {object} ierr.ErrorResponse "Already a member"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /users/invitations [post]
+func (h *MembershipHandler) InviteUser(c *gin.Context) {
+	var req dto.InviteUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.membershipService.InviteUser(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// @Summary List invitations
+// @ID listInvitations
+// @Description Use when showing who was invited to the organization and whether they responded. Pending invitations past their expiry are reported as expired.
+// @Tags Users
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} dto.ListInvitationsResponse
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /users/invitations [get]
+func (h *MembershipHandler) ListInvitations(c *gin.Context) {
+	response, err := h.membershipService.ListInvitations(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Revoke invitation
+// @ID revokeInvitation
+// @Description Use when an invitation was sent by mistake. The link in the email stops working.
+// @Tags Users
+// @Security ApiKeyAuth
+// @Param id path string true "Invitation ID"
+// @Success 204
+// @Failure 400 {object} ierr.ErrorResponse "Invitation is no longer pending"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /users/invitations/{id} [delete]
+func (h *MembershipHandler) RevokeInvitation(c *gin.Context) {
+	if err := h.membershipService.RevokeInvitation(c.Request.Context(), c.Param("id")); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Accept invitation
+// @ID acceptInvitation
+// @Description Use on the page the invitation email links to. Invitees without an account choose the password of their new account; invitees with an account confirm its password. Returns a token for the tenant of the invitation and the tenants the user can switch to.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body dto.AcceptInvitationRequest true "Invitation token and password"
+// @Success 200 {object} dto.AuthResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request or invitation no longer pending"
+// @Failure 403 {object} ierr.ErrorResponse "Wrong password"
+// @Failure 404 {object} ierr.ErrorResponse "Invalid invitation link"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /auth/invitations/accept [post]
+func (h *MembershipHandler) AcceptInvitation(c *gin.Context) {
+	var req dto.AcceptInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.membershipService.AcceptInvitation(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Decline invitation
+// @ID declineInvitation
+// @Description Use on the page the invitation email links to when the invitee does not want to join.
+// @Tags Auth
+// @Accept json
+// @Param request body dto.DeclineInvitationRequest true "Invitation token"
+// @Success 204
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request or invitation no longer pending"
+// @Failure 404 {object} ierr.ErrorResponse "Invalid invitation link"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /auth/invitations/decline [post]
+func (h *MembershipHandler) DeclineInvitation(c *gin.Context) {
+	var req dto.DeclineInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.membershipService.DeclineInvitation(c.Request.Context(), req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary List members
+// @ID listMembers
+// @Description Use when showing the team of the organization with their roles. Includes users whose account was created in the organization and users that joined from another tenant.
+// @Tags Users
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} dto.ListMembersResponse
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /users/members [get]
+func (h *MembershipHandler) ListMembers(c *gin.Context) {
+	response, err := h.membershipService.ListMembers(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Change member roles
+// @ID updateMemberRoles
+// @Description Use when changing what a teammate can do in the organization. The roles replace the current ones.
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "User ID"
+// @Param request body dto.AssignRolesRequest true "Roles"
+// @Success 200 {object} dto.MemberResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Not a member"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /users/members/{id}/roles [put]
+func (h *MembershipHandler) UpdateMemberRoles(c *gin.Context) {
+	var req dto.AssignRolesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.membershipService.UpdateMemberRoles(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Remove member
+// @ID removeMember
+// @Description Use when a teammate leaves the organization. Users that joined from another tenant keep their account; the account of users created in the organization is deleted, which requires removing them from their other tenants first.
+// @Tags Users
+// @Security ApiKeyAuth
+// @Param id path string true "User ID"
+// @Success 204
+// @Failure 400 {object} ierr.ErrorResponse "Cannot remove the member"
+// @Failure 404 {object} ierr.ErrorResponse "Not a member"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /users/members/{id} [delete]
+func (h *MembershipHandler) RemoveMember(c *gin.Context) {
+	if err := h.membershipService.RemoveMember(c.Request.Context(), c.Param("id")); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary List my tenants
+// @ID listUserTenants
+// @Description Use for the tenant switcher of the dashboard. Lists the tenant the account was created in first, followed by the tenants the user joined.
+// @Tags Users
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {array} dto.UserTenant
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /users/me/tenants [get]
+func (h *MembershipHandler) ListUserTenants(c *gin.Context) {
+	response, err := h.membershipService.ListUserTenants(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Switch tenant
+// @ID switchTenant
+// @Description Use when the user picks another tenant in the tenant switcher. Returns a token for that tenant; API keys cannot switch tenants.
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.SwitchTenantRequest true "Tenant to switch to"
+// @Success 200 {object} dto.AuthResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 403 {object} ierr.ErrorResponse "Not a member of the tenant"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /users/me/switch-tenant [post]
+func (h *MembershipHandler) SwitchTenant(c *gin.Context) {
+	var req dto.SwitchTenantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.membershipService.SwitchTenant(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,227 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type PriceBookHandler struct {
+	priceBookService service.PriceBookService
+	logger           *logger.Logger
+}
+
+func NewPriceBookHandler(priceBookService service.PriceBookService, logger *logger.Logger) *PriceBookHandler {
+	return &PriceBookHandler{
+		priceBookService: priceBookService,
+		logger:           logger,
+	}
+}
+
+// @Summary Create price book
+// @ID createPriceBook
+// @Description Use when selling plans in currencies they are not priced in. A price book converts base currency prices into other currencies with a fixed or FX rate per currency, optional rounding (e.g. charm pricing ending in .99) and explicit amounts for individual prices.
+// @Tags Price Books
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param price_book body dto.CreatePriceBookRequest true "Price book request"
+// @Success 201 {object} dto.PriceBookResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Plan or addon not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /price-books [post]
+func (h *PriceBookHandler) CreatePriceBook(c *gin.Context) {
+	var req dto.CreatePriceBookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.priceBookService.CreatePriceBook(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// @Summary Get price book
+// @ID getPriceBook
+// @Description Use when you need to load a single price book and its currency rules.
+// @Tags Price Books
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Price book ID"
+// @Success 200 {object} dto.PriceBookResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /price-books/{id} [get]
+func (h *PriceBookHandler) GetPriceBook(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("price book ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.priceBookService.GetPriceBook(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Update price book
+// @ID updatePriceBook
+// @Description Use when changing the currencies, rounding or overrides of a price book. Lists that are sent replace the existing ones. Subscriptions already created keep their localized prices.
+// @Tags Price Books
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Price book ID"
+// @Param price_book body dto.UpdatePriceBookRequest true "Price book update request"
+// @Success 200 {object} dto.PriceBookResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /price-books/{id} [put]
+func (h *PriceBookHandler) UpdatePriceBook(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("price book ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdatePriceBookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.priceBookService.UpdatePriceBook(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Delete price book
+// @ID deletePriceBook
+// @Description Use when retiring a price book. New subscriptions stop using it, existing ones keep their prices.
+// @Tags Price Books
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Price book ID"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /price-books/{id} [delete]
+func (h *PriceBookHandler) DeletePriceBook(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("price book ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.priceBookService.DeletePriceBook(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Price book deleted successfully"})
+}
+
+// @Summary List price books
+// @ID listPriceBooks
+// @Description Use when listing price books, optionally by base currency. Returns a paginated list.
+// @Tags Price Books
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query types.PriceBookFilter false "Filter"
+// @Success 200 {object} dto.ListPriceBooksResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /price-books [get]
+func (h *PriceBookHandler) ListPriceBooks(c *gin.Context) {
+	var filter types.PriceBookFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	response, err := h.priceBookService.ListPriceBooks(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Preview localized prices
+// @ID previewPriceBook
+// @Description Use when showing a localized price list before publishing it, e.g. a pricing page in EUR. Returns the catalog prices the book covers converted into the currency, with the rate and whether each amount came from an override. Nothing is saved.
+// @Tags Price Books
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Price book ID"
+// @Param request query dto.PreviewPriceBookRequest true "Preview request"
+// @Success 200 {object} dto.PreviewPriceBookResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /price-books/{id}/preview [get]
+func (h *PriceBookHandler) PreviewPriceBook(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("price book ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.PreviewPriceBookRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.priceBookService.PreviewPriceBook(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,219 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type PromotionCodeHandler struct {
+	promotionCodeService service.PromotionCodeService
+	logger               *logger.Logger
+}
+
+func NewPromotionCodeHandler(promotionCodeService service.PromotionCodeService, logger *logger.Logger) *PromotionCodeHandler {
+	return &PromotionCodeHandler{
+		promotionCodeService: promotionCodeService,
+		logger:               logger,
+	}
+}
+
+// @Summary Create promotion code
+// @ID createPromotionCode
+// @Description Use when handing out a redeemable code for a coupon (e.g. a campaign or partner code). Codes can be one-time or multi-use, limited per customer, restricted to first-time customers, expire, or require a minimum order amount.
+// @Tags Coupons
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param promotion_code body dto.CreatePromotionCodeRequest true "Promotion code request"
+// @Success 201 {object} dto.PromotionCodeResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Coupon not found"
+// @Failure 409 {object} ierr.ErrorResponse "Code already exists"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /coupons/codes [post]
+func (h *PromotionCodeHandler) CreatePromotionCode(c *gin.Context) {
+	var req dto.CreatePromotionCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.promotionCodeService.CreatePromotionCode(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// @Summary Get promotion code
+// @ID getPromotionCode
+// @Description Use when you need to load a single promotion code and its coupon.
+// @Tags Coupons
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Promotion code ID"
+// @Success 200 {object} dto.PromotionCodeResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /coupons/codes/{id} [get]
+func (h *PromotionCodeHandler) GetPromotionCode(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("promotion code ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.promotionCodeService.GetPromotionCode(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Update promotion code
+// @ID updatePromotionCode
+// @Description Use when deactivating a code or changing its limits or expiry. The code and its coupon cannot be changed.
+// @Tags Coupons
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Promotion code ID"
+// @Param promotion_code body dto.UpdatePromotionCodeRequest true "Promotion code update request"
+// @Success 200 {object} dto.PromotionCodeResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /coupons/codes/{id} [put]
+func (h *PromotionCodeHandler) UpdatePromotionCode(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("promotion code ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdatePromotionCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.promotionCodeService.UpdatePromotionCode(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Delete promotion code
+// @ID deletePromotionCode
+// @Description Use when retiring a code. Coupons already redeemed through it stay applied.
+// @Tags Coupons
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Promotion code ID"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /coupons/codes/{id} [delete]
+func (h *PromotionCodeHandler) DeletePromotionCode(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("promotion code ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.promotionCodeService.DeletePromotionCode(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Promotion code deleted successfully"})
+}
+
+// @Summary List promotion codes
+// @ID listPromotionCodes
+// @Description Use when listing the codes of a coupon or looking up codes. Returns a paginated list.
+// @Tags Coupons
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query types.PromotionCodeFilter false "Filter"
+// @Success 200 {object} dto.ListPromotionCodesResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /coupons/codes [get]
+func (h *PromotionCodeHandler) ListPromotionCodes(c *gin.Context) {
+	var filter types.PromotionCodeFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	response, err := h.promotionCodeService.ListPromotionCodes(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Validate promotion code
+// @ID validatePromotionCode
+// @Description Use when checking a code before checkout. Pass customer_id for the per-customer and first-time checks, and amount with currency for the minimum amount check and a discount preview. Codes that cannot be redeemed return valid=false with an error_code.
+// @Tags Coupons
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.ValidatePromotionCodeRequest true "Validate promotion code request"
+// @Success 200 {object} dto.ValidatePromotionCodeResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /coupons/codes/validate [post]
+func (h *PromotionCodeHandler) ValidatePromotionCode(c *gin.Context) {
+	var req dto.ValidatePromotionCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.promotionCodeService.ValidatePromotionCode(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -3,6 +3,8 @@ package v1
 import (
 	"net/http"
 
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/rbac"
 	"github.com/flexprice/flexprice/internal/service"
@@ -10,32 +12,38 @@ import (
 )
 
 type RBACHandler struct {
-	rbacService *rbac.RBACService
-	userService service.UserService
-	logger      *logger.Logger
+	rbacService     *rbac.RBACService
+	rbacRoleService service.RBACRoleService
+	userService     service.UserService
+	logger          *logger.Logger
 }
 
-func NewRBACHandler(rbacService *rbac.RBACService, userService service.UserService, logger *logger.Logger) *RBACHandler {
+func NewRBACHandler(rbacService *rbac.RBACService, rbacRoleService service.RBACRoleService, userService service.UserService, logger *logger.Logger) *RBACHandler {
 	return &RBACHandler{
-		rbacService: rbacService,
-		userService: userService,
-		logger:      logger,
+		rbacService:     rbacService,
+		rbacRoleService: rbacRoleService,
+		userService:     userService,
+		logger:          logger,
 	}
 }
 
 // ListRoles returns all available roles with their metadata
 // @Summary List all RBAC roles
 // @ID listRbacRoles
-// @Description Use when building role pickers or permission UIs. Returns all roles with permissions and descriptions.
+// @Description Use when building role pickers or permission UIs. Returns the built-in roles and the custom roles of the tenant with permissions and descriptions.
 // @Tags RBAC
 // @Accept json
 // @Produce json
 // @Success 200 {object} map[string]interface{} "List of roles"
-// @Failure 500 {object} map[string]string "Internal server error"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
 // @Router /rbac/roles [get]
 // @Security ApiKeyAuth
 func (h *RBACHandler) ListRoles(c *gin.Context) {
-	roles := h.rbacService.ListRoles()
+	roles, err := h.rbacService.ListRoles(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"roles": roles,
@@ -51,17 +59,82 @@ func (h *RBACHandler) ListRoles(c *gin.Context) {
 // @Produce json
 // @Param id path string true "Role ID"
 // @Success 200 {object} map[string]interface{} "Role details"
-// @Failure 404 {object} map[string]string "Role not found"
+// @Failure 404 {object} ierr.ErrorResponse "Role not found"
 // @Router /rbac/roles/{id} [get]
 // @Security ApiKeyAuth
 func (h *RBACHandler) GetRole(c *gin.Context) {
-	roleID := c.Param("id")
+	role, err := h.rbacService.GetRole(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"role": role,
+	})
+}
+
+// CreateRole creates a custom role
+// @Summary Create a custom RBAC role
+// @ID createRbacRole
+// @Description Use when the built-in roles are too broad. Grants actions per entity (* for every entity or action), optionally only in some environments.
+// @Tags RBAC
+// @Accept json
+// @Produce json
+// @Param role body dto.CreateRBACRoleRequest true "Role request"
+// @Success 201 {object} map[string]interface{} "Created role"
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 409 {object} ierr.ErrorResponse "Role name already exists"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /rbac/roles [post]
+// @Security ApiKeyAuth
+func (h *RBACHandler) CreateRole(c *gin.Context) {
+	var req dto.CreateRBACRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
 
-	role, exists := h.rbacService.GetRole(roleID)
-	if !exists {
-		c.JSON(http.StatusNotFound, gin.H{
-			"error": "Role not found",
-		})
+	role, err := h.rbacRoleService.CreateRole(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"role": role,
+	})
+}
+
+// UpdateRole updates a custom role
+// @Summary Update a custom RBAC role
+// @ID updateRbacRole
+// @Description Use when changing what a custom role grants. Takes effect for every user and API key holding the role. Built-in roles cannot be updated.
+// @Tags RBAC
+// @Accept json
+// @Produce json
+// @Param id path string true "Role ID"
+// @Param role body dto.UpdateRBACRoleRequest true "Role request"
+// @Success 200 {object} map[string]interface{} "Updated role"
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Role not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /rbac/roles/{id} [put]
+// @Security ApiKeyAuth
+func (h *RBACHandler) UpdateRole(c *gin.Context) {
+	var req dto.UpdateRBACRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	role, err := h.rbacRoleService.UpdateRole(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -69,3 +142,90 @@ func (h *RBACHandler) GetRole(c *gin.Context) {
 		"role": role,
 	})
 }
+
+// DeleteRole deletes a custom role
+// @Summary Delete a custom RBAC role
+// @ID deleteRbacRole
+// @Description Use when a custom role is no longer needed. Users and API keys holding the role lose its permissions. Built-in roles cannot be deleted.
+// @Tags RBAC
+// @Produce json
+// @Param id path string true "Role ID"
+// @Success 200 {object} map[string]interface{} "Role deleted"
+// @Failure 404 {object} ierr.ErrorResponse "Role not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /rbac/roles/{id} [delete]
+// @Security ApiKeyAuth
+func (h *RBACHandler) DeleteRole(c *gin.Context) {
+	if err := h.rbacRoleService.DeleteRole(c.Request.Context(), c.Param("id")); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Role deleted successfully"})
+}
+
+// AssignUserRoles replaces the roles of a user
+// @Summary Assign roles to a user
+// @ID assignRbacUserRoles
+// @Description Use when changing what a user or service account may do. API keys created for the user afterwards get the roles; existing keys keep theirs.
+// @Tags RBAC
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param roles body dto.AssignRolesRequest true "Roles"
+// @Success 200 {object} dto.AssignRolesResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "User not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /rbac/users/{id}/roles [put]
+// @Security ApiKeyAuth
+func (h *RBACHandler) AssignUserRoles(c *gin.Context) {
+	var req dto.AssignRolesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.rbacRoleService.AssignUserRoles(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// AssignAPIKeyRoles replaces the roles of an API key
+// @Summary Assign roles to an API key
+// @ID assignRbacApiKeyRoles
+// @Description Use when narrowing or widening what an existing API key may do. Takes effect on the next request made with the key.
+// @Tags RBAC
+// @Accept json
+// @Produce json
+// @Param id path string true "API key ID"
+// @Param roles body dto.AssignRolesRequest true "Roles"
+// @Success 200 {object} dto.SecretResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "API key not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /rbac/api-keys/{id}/roles [put]
+// @Security ApiKeyAuth
+func (h *RBACHandler) AssignAPIKeyRoles(c *gin.Context) {
+	var req dto.AssignRolesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.rbacRoleService.AssignAPIKeyRoles(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
 	ierr "github.com/flexprice/flexprice/internal/errors"
@@ -124,3 +125,115 @@ func (h *SecretHandler) DeleteAPIKey(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+// UpdateAPIKey godoc
+// @Summary Update an API key
+// @ID updateApiKey
+// @Description Use when renaming an API key or changing its scopes, IP allowlist or rate limit. Omitted fields are left unchanged.
+// @Tags Secrets
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "API key ID"
+// @Param request body dto.UpdateAPIKeyRequest true "API key update request"
+// @Success 200 {object} dto.SecretResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /secrets/api/keys/{id} [put]
+func (h *SecretHandler) UpdateAPIKey(c *gin.Context) {
+	var req dto.UpdateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Errorw("failed to bind request", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Please check the request payload").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	secret, err := h.service.UpdateAPIKey(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		h.logger.Errorw("failed to update api key", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToSecretResponse(secret))
+}
+
+// RotateAPIKey godoc
+// @Summary Rotate an API key
+// @ID rotateApiKey
+// @Description Use when replacing an API key without downtime. Issues a new key with the same roles and restrictions; the old key keeps working until the grace period (default 24 hours) ends.
+// @Tags Secrets
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "API key ID"
+// @Param request body dto.RotateAPIKeyRequest false "API key rotation request"
+// @Success 201 {object} dto.CreateAPIKeyResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /secrets/api/keys/{id}/rotate [post]
+func (h *SecretHandler) RotateAPIKey(c *gin.Context) {
+	var req dto.RotateAPIKeyRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.logger.Errorw("failed to bind request", "error", err)
+			c.Error(ierr.WithError(err).
+				WithHint("Please check the request payload").
+				Mark(ierr.ErrValidation))
+			return
+		}
+	}
+
+	secret, apiKey, err := h.service.RotateAPIKey(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		h.logger.Errorw("failed to rotate api key", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateAPIKeyResponse{
+		Secret: *dto.ToSecretResponse(secret),
+		APIKey: apiKey,
+	})
+}
+
+// GetAPIKeyUsage godoc
+// @Summary Get API key usage
+// @ID getApiKeyUsage
+// @Description Use when auditing how an API key is used. Returns the number of requests made with the key on each of the last days (UTC). Requires usage tracking to be enabled.
+// @Tags Secrets
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "API key ID"
+// @Param days query int false "Number of days (default 7, max 31)"
+// @Success 200 {object} dto.APIKeyUsageResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /secrets/api/keys/{id}/usage [get]
+func (h *SecretHandler) GetAPIKeyUsage(c *gin.Context) {
+	var days int
+	if value := c.Query("days"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			c.Error(ierr.WithError(err).
+				WithHint("days must be a number").
+				Mark(ierr.ErrValidation))
+			return
+		}
+		days = parsed
+	}
+
+	usage, err := h.service.GetAPIKeyUsage(c.Request.Context(), c.Param("id"), days)
+	if err != nil {
+		h.logger.Errorw("failed to get api key usage", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, usage)
+}
+
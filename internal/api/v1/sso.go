@@ -0,0 +1,283 @@
+package v1
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/config"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// ssoLoginCookie binds a sign in to the browser that started it. It is sent on the SAML
+// ACS, which identity providers post cross-site, so it cannot be SameSite=Lax.
+const (
+	ssoLoginCookie     = "flexprice_sso_login"
+	ssoLoginCookiePath = "/v1/auth/sso"
+)
+
+type SSOHandler struct {
+	ssoService service.SSOService
+	cfg        *config.Configuration
+	log        *logger.Logger
+}
+
+func NewSSOHandler(cfg *config.Configuration, ssoService service.SSOService, log *logger.Logger) *SSOHandler {
+	return &SSOHandler{
+		ssoService: ssoService,
+		cfg:        cfg,
+		log:        log,
+	}
+}
+
+// @Summary Create SSO connection
+// @ID createSsoConnection
+// @Description Use when an organization wants its team to sign in to the dashboard with their identity provider. OIDC connections need the issuer URL, client ID and client secret; SAML connections need the identity provider metadata. Users of the listed email domains are created on their first sign in and get the roles mapped from their groups.
+// @Tags SSO
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.CreateSSOConnectionRequest true "SSO connection"
+// @Success 201 {object} dto.SSOConnectionResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 409 {object} ierr.ErrorResponse "Name or domain already in use"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /sso/connections [post]
+func (h *SSOHandler) CreateConnection(c *gin.Context) {
+	var req dto.CreateSSOConnectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.ssoService.CreateConnection(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// @Summary List SSO connections
+// @ID listSsoConnections
+// @Description Use when showing the single sign-on settings of the organization. Client secrets are never returned.
+// @Tags SSO
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} dto.ListSSOConnectionsResponse
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /sso/connections [get]
+func (h *SSOHandler) ListConnections(c *gin.Context) {
+	response, err := h.ssoService.ListConnections(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Get SSO connection
+// @ID getSsoConnection
+// @Description Use when editing a single sign-on connection. Includes the callback URL to register with the identity provider.
+// @Tags SSO
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "SSO connection ID"
+// @Success 200 {object} dto.SSOConnectionResponse
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /sso/connections/{id} [get]
+func (h *SSOHandler) GetConnection(c *gin.Context) {
+	response, err := h.ssoService.GetConnection(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Update SSO connection
+// @ID updateSsoConnection
+// @Description Use when rotating the client secret, changing domains or changing role mappings of a connection. Provided lists and mappings replace the existing ones.
+// @Tags SSO
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "SSO connection ID"
+// @Param request body dto.UpdateSSOConnectionRequest true "SSO connection changes"
+// @Success 200 {object} dto.SSOConnectionResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /sso/connections/{id} [put]
+func (h *SSOHandler) UpdateConnection(c *gin.Context) {
+	var req dto.UpdateSSOConnectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.ssoService.UpdateConnection(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Delete SSO connection
+// @ID deleteSsoConnection
+// @Description Use when an organization stops using an identity provider. Its users keep their accounts but can no longer sign in through it.
+// @Tags SSO
+// @Security ApiKeyAuth
+// @Param id path string true "SSO connection ID"
+// @Success 204
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /sso/connections/{id} [delete]
+func (h *SSOHandler) DeleteConnection(c *gin.Context) {
+	if err := h.ssoService.DeleteConnection(c.Request.Context(), c.Param("id")); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Verify SSO connection domains
+// @ID verifySsoConnectionDomains
+// @Description Use after publishing the DNS TXT records listed in the domain verifications of a connection. Users of a domain can only sign in once it is verified, and a domain can only be verified by one connection.
+// @Tags SSO
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "SSO connection ID"
+// @Success 200 {object} dto.SSOConnectionResponse
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 409 {object} ierr.ErrorResponse "Domain verified by another connection"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /sso/connections/{id}/verify-domains [post]
+func (h *SSOHandler) VerifyDomains(c *gin.Context) {
+	response, err := h.ssoService.VerifyDomains(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// StartLogin returns the identity provider URL for the email domain or connection. Browsers
+// only keep the login cookie when the dashboard shares the origin of the API, other
+// dashboards navigate to RedirectToLogin instead.
+func (h *SSOHandler) StartLogin(c *gin.Context) {
+	var req dto.SSOLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Please check the request payload").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.ssoService.StartLogin(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	h.setLoginCookie(c, response.Binding, response.ExpiresAt)
+	c.JSON(http.StatusOK, response)
+}
+
+// RedirectToLogin sends the browser to the identity provider for the email domain or
+// connection of the query
+func (h *SSOHandler) RedirectToLogin(c *gin.Context) {
+	var req dto.SSOLoginRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Please check the query parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.ssoService.StartLogin(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	h.setLoginCookie(c, response.Binding, response.ExpiresAt)
+	c.Redirect(http.StatusFound, response.RedirectURL)
+}
+
+// OIDCCallback completes a sign in the OIDC identity provider redirected back
+func (h *SSOHandler) OIDCCallback(c *gin.Context) {
+	h.completeLogin(c, c.Query("state"))
+}
+
+// SAMLACS completes a sign in the SAML identity provider posted back
+func (h *SSOHandler) SAMLACS(c *gin.Context) {
+	h.completeLogin(c, c.PostForm("RelayState"))
+}
+
+// setLoginCookie sets the binding of a sign in in the browser until the sign in expires.
+// An expired or zero time clears it.
+func (h *SSOHandler) setLoginCookie(c *gin.Context, binding string, expiresAt time.Time) {
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     ssoLoginCookie,
+		Value:    binding,
+		Path:     ssoLoginCookiePath,
+		MaxAge:   max(int(time.Until(expiresAt).Seconds()), -1),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteNoneMode,
+	})
+}
+
+// GetSAMLMetadata returns the service provider metadata tenants register with their SAML identity provider
+func (h *SSOHandler) GetSAMLMetadata(c *gin.Context) {
+	metadata, err := h.ssoService.GetSAMLMetadata(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, "application/samlmetadata+xml", metadata)
+}
+
+// completeLogin sends the user to the dashboard with the token in the URL fragment, which
+// is not sent to servers or logged. Without a dashboard URL the token is returned as JSON.
+func (h *SSOHandler) completeLogin(c *gin.Context, state string) {
+	binding, _ := c.Cookie(ssoLoginCookie)
+	h.setLoginCookie(c, "", time.Time{})
+
+	authResponse, err := h.ssoService.CompleteLogin(c.Request.Context(), state, binding, c.Request)
+	if err != nil {
+		h.log.Errorw("failed to complete sso login", "error", err)
+		c.Error(err)
+		return
+	}
+
+	if h.cfg.Auth.SSO.RedirectURL == "" {
+		c.JSON(http.StatusOK, authResponse)
+		return
+	}
+
+	fragment := url.Values{
+		"token":     {authResponse.Token},
+		"user_id":   {authResponse.UserID},
+		"tenant_id": {authResponse.TenantID},
+	}
+	c.Redirect(http.StatusFound, h.cfg.Auth.SSO.RedirectURL+"#"+fragment.Encode())
+}
@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// SubscriptionSeatHandler handles API requests for subscription seats
+type SubscriptionSeatHandler struct {
+	subscriptionSeatService service.SubscriptionSeatService
+	logger                  *logger.Logger
+}
+
+// NewSubscriptionSeatHandler creates a new subscription seat handler
+func NewSubscriptionSeatHandler(subscriptionSeatService service.SubscriptionSeatService, logger *logger.Logger) *SubscriptionSeatHandler {
+	return &SubscriptionSeatHandler{
+		subscriptionSeatService: subscriptionSeatService,
+		logger:                  logger,
+	}
+}
+
+// @Summary Get subscription seats
+// @ID getSubscriptionSeats
+// @Description Use when showing how many seats a subscription is licensed for, its seat config and the history of seat changes.
+// @Tags Subscriptions
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Subscription ID"
+// @Success 200 {object} dto.SubscriptionSeatsResponse
+// @Failure 400 {object} ierr.ErrorResponse "Subscription is not seat based"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /subscriptions/{id}/seats [get]
+func (h *SubscriptionSeatHandler) GetSeats(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("subscription ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.subscriptionSeatService.GetSeats(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Update subscription seats
+// @ID updateSubscriptionSeats
+// @Description Use when a customer adds or removes seats. The seat change is billed according to the subscription's seat config: increases are invoiced immediately or accrued for a true-up at period end, decreases are credited to the wallet or not at all. Metered seat subscriptions follow their meter and cannot be updated here.
+// @Tags Subscriptions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Subscription ID"
+// @Param request body dto.UpdateSubscriptionSeatsRequest true "Seat update request"
+// @Success 200 {object} dto.UpdateSubscriptionSeatsResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /subscriptions/{id}/seats [post]
+func (h *SubscriptionSeatHandler) UpdateSeats(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("subscription ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateSubscriptionSeatsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.subscriptionSeatService.UpdateSeats(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Update subscription seat config
+// @ID updateSubscriptionSeatConfig
+// @Description Use when enabling seat licensing on a subscription or changing how seats are counted and billed. The seat price must be an active fixed-price line item of the subscription; metered seats need a COUNT_UNIQUE meter.
+// @Tags Subscriptions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Subscription ID"
+// @Param request body dto.UpdateSubscriptionSeatConfigRequest true "Seat config"
+// @Success 200 {object} dto.SubscriptionSeatsResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /subscriptions/{id}/seats/config [put]
+func (h *SubscriptionSeatHandler) UpdateSeatConfig(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("subscription ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateSubscriptionSeatConfigRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.subscriptionSeatService.UpdateSeatConfig(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
@@ -215,12 +216,13 @@ func (h *TaskHandler) GetTaskProcessingResult(c *gin.Context) {
 	})
 }
 
-// @Summary Download task export file
+// @Summary Download task file
 // @ID downloadTaskExport
-// @Description Use when letting a user download an exported file (e.g. report or data export). Returns a presigned URL; supports FlexPrice or customer-owned S3.
+// @Description Use when letting a user download a task's file. For export tasks returns a presigned URL to the exported file; supports FlexPrice or customer-owned S3. For import tasks returns a CSV of the rows that failed, with the error for each row, so they can be fixed and imported again.
 // @Tags Tasks
 // @Accept json
 // @Produce json
+// @Produce text/csv
 // @Security ApiKeyAuth
 // @Param id path string true "Task ID"
 // @Success 200 {object} map[string]string
@@ -237,6 +239,25 @@ func (h *TaskHandler) DownloadTaskFile(c *gin.Context) {
 		return
 	}
 
+	t, err := h.service.GetTask(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	// Import tasks serve the report of failed rows instead of a file URL
+	if t.TaskType == types.TaskTypeImport {
+		report, err := h.service.GetErrorReport(c.Request.Context(), id)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s_errors.csv", id))
+		c.Data(http.StatusOK, "text/csv", []byte(report))
+		return
+	}
+
 	downloadURL, err := h.service.GenerateDownloadURL(c.Request.Context(), id)
 	if err != nil {
 		h.log.Errorw("failed to generate download URL",
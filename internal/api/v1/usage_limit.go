@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type UsageLimitHandler struct {
+	usageLimitService service.UsageLimitService
+	log               *logger.Logger
+}
+
+func NewUsageLimitHandler(usageLimitService service.UsageLimitService, log *logger.Logger) *UsageLimitHandler {
+	return &UsageLimitHandler{
+		usageLimitService: usageLimitService,
+		log:               log,
+	}
+}
+
+// @Summary Check usage limit
+// @ID checkUsageLimit
+// @Description Use before serving metered usage to enforce entitlement limits. Returns allowed or denied with the remaining quantity for the current usage reset period; soft limits are always allowed but report limit_exceeded.
+// @Tags Customers
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.CheckUsageLimitRequest true "Usage limit check"
+// @Success 200 {object} dto.CheckUsageLimitResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Customer or feature not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /customers/usage-limits/check [post]
+func (h *UsageLimitHandler) CheckUsageLimit(c *gin.Context) {
+	var req dto.CheckUsageLimitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.usageLimitService.CheckLimit(c.Request.Context(), &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,145 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/config"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+)
+
+// UsageStreamHandler serves real-time usage and wallet balance deltas over server-sent events
+type UsageStreamHandler struct {
+	usageStreamService service.UsageStreamService
+	config             *config.Configuration
+	log                *logger.Logger
+}
+
+func NewUsageStreamHandler(
+	usageStreamService service.UsageStreamService,
+	config *config.Configuration,
+	log *logger.Logger,
+) *UsageStreamHandler {
+	return &UsageStreamHandler{
+		usageStreamService: usageStreamService,
+		config:             config,
+		log:                log,
+	}
+}
+
+// @Summary Stream customer usage
+// @ID streamCustomerUsage
+// @Description Use when building live usage meters or balance widgets. Opens a server-sent events stream of usage deltas (usage.delta) and wallet balance updates (wallet.balance) for the customer. Each event id is a resume cursor; reconnect with the Last-Event-ID header or cursor query param to replay buffered events.
+// @Tags Customers
+// @Produce text/event-stream
+// @Security ApiKeyAuth
+// @Param id path string true "Customer ID"
+// @Param filter query types.UsageStreamFilter false "Filter"
+// @Param Last-Event-ID header string false "Resume after this event id"
+// @Success 200 {object} types.UsageStreamEvent
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Customer not found or streaming disabled"
+// @Router /customers/{id}/usage/stream [get]
+func (h *UsageStreamHandler) StreamCustomerUsage(c *gin.Context) {
+	var filter types.UsageStreamFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid stream parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+	filter.CustomerID = c.Param("id")
+
+	h.stream(c, &filter)
+}
+
+// StreamPortalUsage streams usage for the customer of the current portal session
+func (h *UsageStreamHandler) StreamPortalUsage(c *gin.Context) {
+	var filter types.UsageStreamFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).Mark(ierr.ErrValidation))
+		return
+	}
+	filter.CustomerID = types.GetCustomerID(c.Request.Context())
+
+	h.stream(c, &filter)
+}
+
+func (h *UsageStreamHandler) stream(c *gin.Context, filter *types.UsageStreamFilter) {
+	if lastEventID := c.GetHeader("Last-Event-ID"); lastEventID != "" {
+		filter.Cursor = lastEventID
+	}
+
+	ctx := c.Request.Context()
+	sub, err := h.usageStreamService.Subscribe(ctx, filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	defer h.usageStreamService.Unsubscribe(sub)
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	// Tell the client to re-fetch a snapshot when its cursor is no longer buffered
+	if sub.CursorExpired {
+		fmt.Fprint(c.Writer, "event: reset\ndata: {}\n\n")
+	}
+	for _, event := range sub.Backlog {
+		if err := writeUsageStreamEvent(c.Writer, event); err != nil {
+			return
+		}
+	}
+	c.Writer.Flush()
+
+	interval := h.config.UsageStream.HeartbeatInterval
+	if interval <= 0 {
+		interval = 15 * time.Second
+	}
+	heartbeat := time.NewTicker(interval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-sub.Events():
+			if !ok {
+				// Subscriber fell behind and was dropped; the client reconnects with its cursor
+				h.log.InfowCtx(ctx, "usage stream subscriber disconnected",
+					"subscription_id", sub.ID,
+					"customer_id", filter.CustomerID,
+				)
+				return
+			}
+			if err := writeUsageStreamEvent(c.Writer, event); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		case <-heartbeat.C:
+			if _, err := fmt.Fprintf(c.Writer, "event: %s\ndata: {}\n\n", types.UsageStreamEventTypeHeartbeat); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
+
+func writeUsageStreamEvent(w io.Writer, event *types.UsageStreamEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.Cursor, event.Type, data)
+	return err
+}
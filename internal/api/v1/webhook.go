@@ -143,6 +143,68 @@ func (h *WebhookHandler) RetryOutboundWebhook(c *gin.Context) {
 	})
 }
 
+// @Summary Redeliver webhooks
+// @ID redeliverWebhooks
+// @Description Use after an outage of a receiver to deliver the webhooks of a time range again, oldest first. By default only undelivered events are sent, and only to the endpoints that have not accepted them. Returns has_more and next_start_time when the range holds more events than the limit.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.RedeliverWebhooksRequest true "Redelivery request"
+// @Success 200 {object} dto.RedeliverWebhooksResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /webhooks/redeliver [post]
+func (h *WebhookHandler) RedeliverWebhooks(c *gin.Context) {
+	var req dto.RedeliverWebhooksRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Provide a JSON body with start_time and end_time.").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.webhookService.RedeliverWebhooks(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Get system event
+// @ID getSystemEvent
+// @Description Get a system event with the webhook payload it is delivered with. Receivers of thin payloads fetch the snapshot of an event here. The payload is rendered in the requested version, the latest version by default.
+// @Tags Webhooks
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "System event ID"
+// @Param version query string false "Payload version" Enums(v1, v2)
+// @Success 200 {object} dto.SystemEventResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "System event not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /events/system/{id} [get]
+func (h *WebhookHandler) GetSystemEvent(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("system event id is required").
+			WithHint("Please provide a valid system event ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	version := types.WebhookPayloadVersion(c.Query("version"))
+	response, err := h.webhookService.GetSystemEvent(c.Request.Context(), id, version)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
 func (h *WebhookHandler) HandleStripeWebhook(c *gin.Context) {
 	tenantID := c.Param("tenant_id")
 	environmentID := c.Param("environment_id")
@@ -0,0 +1,366 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type WebhookEndpointHandler struct {
+	webhookEndpointService service.WebhookEndpointService
+	logger                 *logger.Logger
+}
+
+func NewWebhookEndpointHandler(webhookEndpointService service.WebhookEndpointService, logger *logger.Logger) *WebhookEndpointHandler {
+	return &WebhookEndpointHandler{
+		webhookEndpointService: webhookEndpointService,
+		logger:                 logger,
+	}
+}
+
+// @Summary Create webhook endpoint
+// @ID createWebhookEndpoint
+// @Description Use when registering a URL to receive webhooks for this environment. Subscribe to event names (e.g. invoice.update.finalized), prefix wildcards (e.g. invoice.*) or * for all events. Custom headers are sent with every delivery.
+// @Tags Webhook Endpoints
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param webhook_endpoint body dto.CreateWebhookEndpointRequest true "Webhook endpoint request"
+// @Success 201 {object} dto.WebhookEndpointResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /webhooks/endpoints [post]
+func (h *WebhookEndpointHandler) CreateWebhookEndpoint(c *gin.Context) {
+	var req dto.CreateWebhookEndpointRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.webhookEndpointService.CreateWebhookEndpoint(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// @Summary Get webhook endpoint
+// @ID getWebhookEndpoint
+// @Description Use when you need to load a single webhook endpoint and its event subscriptions.
+// @Tags Webhook Endpoints
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Webhook endpoint ID"
+// @Success 200 {object} dto.WebhookEndpointResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /webhooks/endpoints/{id} [get]
+func (h *WebhookEndpointHandler) GetWebhookEndpoint(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("webhook endpoint ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.webhookEndpointService.GetWebhookEndpoint(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Update webhook endpoint
+// @ID updateWebhookEndpoint
+// @Description Use when changing the URL, event subscriptions or headers of a webhook endpoint, or to enable or disable it. Event types, headers and metadata that are sent replace the existing ones.
+// @Tags Webhook Endpoints
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Webhook endpoint ID"
+// @Param webhook_endpoint body dto.UpdateWebhookEndpointRequest true "Webhook endpoint update request"
+// @Success 200 {object} dto.WebhookEndpointResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /webhooks/endpoints/{id} [put]
+func (h *WebhookEndpointHandler) UpdateWebhookEndpoint(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("webhook endpoint ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateWebhookEndpointRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.webhookEndpointService.UpdateWebhookEndpoint(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Delete webhook endpoint
+// @ID deleteWebhookEndpoint
+// @Description Use when a URL should no longer receive webhooks. To pause deliveries temporarily, disable the endpoint instead.
+// @Tags Webhook Endpoints
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Webhook endpoint ID"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /webhooks/endpoints/{id} [delete]
+func (h *WebhookEndpointHandler) DeleteWebhookEndpoint(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("webhook endpoint ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.webhookEndpointService.DeleteWebhookEndpoint(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Webhook endpoint deleted successfully"})
+}
+
+// @Summary List webhook endpoints
+// @ID listWebhookEndpoints
+// @Description Use when listing the webhook endpoints of the environment, optionally only enabled or disabled ones. Returns a paginated list.
+// @Tags Webhook Endpoints
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query types.WebhookEndpointFilter false "Filter"
+// @Success 200 {object} dto.ListWebhookEndpointsResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /webhooks/endpoints [get]
+func (h *WebhookEndpointHandler) ListWebhookEndpoints(c *gin.Context) {
+	var filter types.WebhookEndpointFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	response, err := h.webhookEndpointService.ListWebhookEndpoints(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Get webhook endpoint signing secret
+// @ID getWebhookEndpointSecret
+// @Description Use when configuring a receiver to verify deliveries. Every delivery carries a FlexPrice-Signature header with a timestamp and an HMAC-SHA256 signature of the body made with this secret.
+// @Tags Webhook Endpoints
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Webhook endpoint ID"
+// @Success 200 {object} dto.WebhookEndpointSecretResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /webhooks/endpoints/{id}/secret [get]
+func (h *WebhookEndpointHandler) GetSigningSecret(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("webhook endpoint ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.webhookEndpointService.GetSigningSecret(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Rotate webhook endpoint signing secret
+// @ID rotateWebhookEndpointSecret
+// @Description Use when a signing secret may have leaked or on a regular rotation schedule. Returns the new secret. Deliveries carry signatures made with both secrets until the overlap window ends (24 hours by default), so receivers can switch without rejecting deliveries.
+// @Tags Webhook Endpoints
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Webhook endpoint ID"
+// @Param request body dto.RotateWebhookEndpointSecretRequest false "Rotation request"
+// @Success 200 {object} dto.WebhookEndpointSecretResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /webhooks/endpoints/{id}/secret/rotate [post]
+func (h *WebhookEndpointHandler) RotateSigningSecret(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("webhook endpoint ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.RotateWebhookEndpointSecretRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(ierr.WithError(err).
+				WithHint("Invalid request format").
+				Mark(ierr.ErrValidation))
+			return
+		}
+	}
+
+	response, err := h.webhookEndpointService.RotateSigningSecret(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Get webhook endpoint health
+// @ID getWebhookEndpointHealth
+// @Description Use when checking whether an endpoint is receiving webhooks. Returns the current failure streak, why the endpoint was disabled if it was disabled automatically, and the delivery success rate over the window (24 hours by default).
+// @Tags Webhook Endpoints
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Webhook endpoint ID"
+// @Param window_hours query int false "Hours the success rate is computed over (1-720)"
+// @Success 200 {object} dto.WebhookEndpointHealthResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /webhooks/endpoints/{id}/health [get]
+func (h *WebhookEndpointHandler) GetWebhookEndpointHealth(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("webhook endpoint ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var windowHours int
+	if raw := c.Query("window_hours"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			c.Error(ierr.WithError(err).
+				WithHint("window_hours must be a number of hours").
+				Mark(ierr.ErrValidation))
+			return
+		}
+		windowHours = parsed
+	}
+
+	response, err := h.webhookEndpointService.GetWebhookEndpointHealth(c.Request.Context(), id, windowHours)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary List webhook delivery attempts
+// @ID listWebhookDeliveryAttempts
+// @Description Use when debugging webhook deliveries. Every native delivery of a system event to an endpoint is recorded with the request, the response status and body excerpt, the latency and the attempt number. Filter by system_event_ids for the attempts of an event or webhook_endpoint_ids for the attempts of an endpoint.
+// @Tags Webhook Endpoints
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query types.WebhookDeliveryAttemptFilter false "Filter"
+// @Success 200 {object} dto.ListWebhookDeliveryAttemptsResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /webhooks/attempts [get]
+func (h *WebhookEndpointHandler) ListDeliveryAttempts(c *gin.Context) {
+	var filter types.WebhookDeliveryAttemptFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	response, err := h.webhookEndpointService.ListDeliveryAttempts(c.Request.Context(), &filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Get webhook delivery attempt
+// @ID getWebhookDeliveryAttempt
+// @Description Use when inspecting a single delivery attempt, including the request body sent and the response received.
+// @Tags Webhook Endpoints
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Webhook delivery attempt ID"
+// @Success 200 {object} dto.WebhookDeliveryAttemptResponse
+// @Failure 400 {object} ierr.ErrorResponse "Invalid request"
+// @Failure 404 {object} ierr.ErrorResponse "Resource not found"
+// @Failure 500 {object} ierr.ErrorResponse "Server error"
+// @Router /webhooks/attempts/{id} [get]
+func (h *WebhookEndpointHandler) GetDeliveryAttempt(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("webhook delivery attempt ID is required").
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.webhookEndpointService.GetDeliveryAttempt(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
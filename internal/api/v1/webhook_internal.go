@@ -16,11 +16,13 @@ var _ = []any{
 	(*webhookDto.CommunicationWebhookPayload)(nil),
 	(*webhookDto.SubscriptionWebhookPayload)(nil),
 	(*webhookDto.SubscriptionPhaseWebhookPayload)(nil),
+	(*webhookDto.SubscriptionSeatsWebhookPayload)(nil),
 	(*webhookDto.CustomerWebhookPayload)(nil),
 	(*webhookDto.PaymentWebhookPayload)(nil),
 	(*webhookDto.FeatureWebhookPayload)(nil),
 	(*webhookDto.AlertWebhookPayload)(nil),
 	(*webhookDto.EntitlementWebhookPayload)(nil),
+	(*webhookDto.EntitlementLimitReachedWebhookPayload)(nil),
 	(*webhookDto.WalletWebhookPayload)(nil),
 	(*webhookDto.TransactionWebhookPayload)(nil),
 	(*webhookDto.CreditNoteWebhookPayload)(nil),
@@ -166,6 +168,16 @@ func WebhookEventSubscriptionResumed() {}
 // @Router /webhook-events/subscription.renewal.due [post]
 func WebhookEventSubscriptionRenewalDue() {}
 
+// WebhookEventSubscriptionSeatsUpdated godoc
+// @Summary subscription.seats.updated
+// @Description Fired when the seat count of a subscription changes, through the seats API or a seat meter. Doc-only for parsing.
+// @Tags Webhook Events
+// @Accept json
+// @Produce json
+// @Success 200 {object} webhookDto.SubscriptionSeatsWebhookPayload "Webhook payload"
+// @Router /webhook-events/subscription.seats.updated [post]
+func WebhookEventSubscriptionSeatsUpdated() {}
+
 // WebhookEventSubscriptionPhaseCreated godoc
 // @Summary subscription.phase.created
 // @Description Fired when a new subscription phase is created. Doc-only for parsing.
@@ -316,6 +328,26 @@ func WebhookEventFeatureDeleted() {}
 // @Router /webhook-events/feature.wallet_balance.alert [post]
 func WebhookEventFeatureWalletBalanceAlert() {}
 
+// WebhookEventUsageAnomalyDetected godoc
+// @Summary usage.anomaly.detected
+// @Description Fired when a customer's usage of a meter spikes or drops beyond the configured baseline thresholds. Doc-only for parsing.
+// @Tags Webhook Events
+// @Accept json
+// @Produce json
+// @Success 200 {object} webhookDto.UsageAnomalyWebhookPayload "Webhook payload"
+// @Router /webhook-events/usage.anomaly.detected [post]
+func WebhookEventUsageAnomalyDetected() {}
+
+// WebhookEventUsageAnomalyResolved godoc
+// @Summary usage.anomaly.resolved
+// @Description Fired when a customer's usage of a meter returns within baseline thresholds after an anomaly. Doc-only for parsing.
+// @Tags Webhook Events
+// @Accept json
+// @Produce json
+// @Success 200 {object} webhookDto.UsageAnomalyWebhookPayload "Webhook payload"
+// @Router /webhook-events/usage.anomaly.resolved [post]
+func WebhookEventUsageAnomalyResolved() {}
+
 // WebhookEventEntitlementCreated godoc
 // @Summary entitlement.created
 // @Description Fired when a new entitlement is created. Doc-only for parsing.
@@ -346,6 +378,16 @@ func WebhookEventEntitlementUpdated() {}
 // @Router /webhook-events/entitlement.deleted [post]
 func WebhookEventEntitlementDeleted() {}
 
+// WebhookEventEntitlementLimitReached godoc
+// @Summary entitlement.limit.reached
+// @Description Fired once per usage reset period when a customer's usage of a metered feature reaches its entitlement limit. Doc-only for parsing.
+// @Tags Webhook Events
+// @Accept json
+// @Produce json
+// @Success 200 {object} webhookDto.EntitlementLimitReachedWebhookPayload "Webhook payload"
+// @Router /webhook-events/entitlement.limit.reached [post]
+func WebhookEventEntitlementLimitReached() {}
+
 // WebhookEventWalletCreated godoc
 // @Summary wallet.created
 // @Description Fired when a new wallet is created. Doc-only for parsing.
@@ -142,6 +142,17 @@ func (f *flexpriceAuth) generateToken(userID, tenantID string) (string, error) {
 	return token.SignedString([]byte(f.AuthConfig.Secret))
 }
 
+// IssueToken issues the same token as a password login
+func (f *flexpriceAuth) IssueToken(ctx context.Context, userID, tenantID, email string) (string, error) {
+	token, err := f.generateToken(userID, tenantID)
+	if err != nil {
+		return "", ierr.WithError(err).
+			WithHint("Failed to generate token").
+			Mark(ierr.ErrSystem)
+	}
+	return token, nil
+}
+
 func (f *flexpriceAuth) AssignUserToTenant(ctx context.Context, userID string, tenantID string) error {
 	// No action required for Flexprice as we do not support
 	// reassigning users to a different tenant for now
@@ -56,6 +56,9 @@ type Provider interface {
 	Login(ctx context.Context, req AuthRequest, userAuthInfo *auth.Auth) (*AuthResponse, error)
 	ValidateToken(ctx context.Context, token string) (*auth.Claims, error)
 	AssignUserToTenant(ctx context.Context, userID string, tenantID string) error
+	// IssueToken issues an auth token for a user that authenticated outside the provider,
+	// e.g. through the single sign-on connection of their tenant
+	IssueToken(ctx context.Context, userID, tenantID, email string) (string, error)
 
 	// Customer Dashboard Token Management
 	GenerateSessionToken(customerID, externalCustomerID, tenantID, environmentID string, timeoutHours int) (string, time.Time, error)
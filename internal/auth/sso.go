@@ -0,0 +1,160 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/sso"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"golang.org/x/oauth2"
+)
+
+const (
+	ssoOIDCCallbackPath = "/v1/auth/sso/oidc/callback"
+	ssoSAMLACSPath      = "/v1/auth/sso/saml/acs"
+)
+
+// SSOIdentity is the identity an identity provider asserted for a user
+type SSOIdentity struct {
+	Subject string
+	Email   string
+	Name    string
+	// Groups are read from the role claim of the connection
+	Groups []string
+}
+
+// SSOLoginState is the state of a sign in while the user is at the identity provider. It
+// is kept server side, only its ID is carried through the identity provider as the OAuth
+// state or the SAML RelayState.
+type SSOLoginState struct {
+	// ID ties the callback to the sign in it answers
+	ID           string `json:"id"`
+	ConnectionID string `json:"connection_id"`
+	TenantID     string `json:"tenant_id"`
+	// Nonce is bound to the OIDC ID token
+	Nonce string `json:"nonce,omitempty"`
+	// CodeVerifier is the OIDC PKCE verifier
+	CodeVerifier string `json:"code_verifier,omitempty"`
+	// RequestID is the ID of the SAML authentication request
+	RequestID string `json:"request_id,omitempty"`
+	// BindingHash is the hash of the secret set in the browser that started the sign in
+	BindingHash string    `json:"binding_hash"`
+	ExpiresAt   time.Time `json:"expires_at"`
+
+	// Binding is the secret itself, it is only known to the browser
+	Binding string `json:"-"`
+}
+
+// NewSSOLoginState creates the state of a sign in through the connection
+func NewSSOLoginState(connection *sso.Connection, ttl time.Duration) *SSOLoginState {
+	binding := randomHex(32)
+	return &SSOLoginState{
+		ID:           randomHex(32),
+		ConnectionID: connection.ID,
+		TenantID:     connection.TenantID,
+		Nonce:        randomHex(16),
+		CodeVerifier: oauth2.GenerateVerifier(),
+		RequestID:    "id-" + randomHex(20),
+		BindingHash:  hashBinding(binding),
+		ExpiresAt:    time.Now().UTC().Add(ttl),
+		Binding:      binding,
+	}
+}
+
+// BoundTo reports whether the callback came from the browser that started the sign in
+func (s *SSOLoginState) BoundTo(binding string) bool {
+	return binding != "" && subtle.ConstantTimeCompare([]byte(hashBinding(binding)), []byte(s.BindingHash)) == 1
+}
+
+// Expired reports whether the user took too long at the identity provider
+func (s *SSOLoginState) Expired() bool {
+	return time.Now().UTC().After(s.ExpiresAt)
+}
+
+// SSOProvider signs users in through the identity provider of an SSO connection
+type SSOProvider interface {
+	GetProtocol() types.SSOProtocol
+	// LoginURL returns the identity provider URL the user is sent to. The ID of the state
+	// is echoed back to the callback.
+	LoginURL(ctx context.Context, state *SSOLoginState) (string, error)
+	// Authenticate verifies the callback of the identity provider for the sign in of state
+	Authenticate(ctx context.Context, state *SSOLoginState, r *http.Request) (*SSOIdentity, error)
+}
+
+// NewSSOProvider creates the provider of a connection. The client secret of the
+// connection must already be decrypted.
+func NewSSOProvider(ctx context.Context, cfg config.SSOConfig, connection *sso.Connection) (SSOProvider, error) {
+	switch connection.Protocol {
+	case types.SSOProtocolOIDC:
+		return newOIDCProvider(ctx, cfg, connection)
+	case types.SSOProtocolSAML:
+		return newSAMLProvider(ctx, cfg, connection)
+	default:
+		return nil, ierr.NewErrorf("unsupported sso protocol %s", connection.Protocol).
+			WithHint("SSO protocol must be oidc or saml").
+			Mark(ierr.ErrValidation)
+	}
+}
+
+// SSOOIDCCallbackURL is the redirect URI to register with OIDC identity providers
+func SSOOIDCCallbackURL(cfg config.SSOConfig) string {
+	return strings.TrimSuffix(cfg.BaseURL, "/") + ssoOIDCCallbackPath
+}
+
+// SSOSAMLACSURL is the assertion consumer service URL to register with SAML identity providers
+func SSOSAMLACSURL(cfg config.SSOConfig) string {
+	return strings.TrimSuffix(cfg.BaseURL, "/") + ssoSAMLACSPath
+}
+
+// SSOSAMLMetadataURL is the service provider metadata URL of a SAML connection. It is
+// also the entity ID of the service provider.
+func SSOSAMLMetadataURL(cfg config.SSOConfig, connectionID string) string {
+	return strings.TrimSuffix(cfg.BaseURL, "/") + "/v1/auth/sso/saml/" + connectionID + "/metadata"
+}
+
+// claimStrings reads a claim or attribute holding one or many strings
+func claimStrings(v interface{}) []string {
+	switch value := v.(type) {
+	case string:
+		return []string{value}
+	case []string:
+		return value
+	case []interface{}:
+		values := make([]string, 0, len(value))
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+func hashBinding(binding string) string {
+	sum := sha256.Sum256([]byte(binding))
+	return hex.EncodeToString(sum[:])
+}
+
+func ssoAuthenticationFailed(err error, hint string) error {
+	return ierr.WithError(err).
+		WithHint(hint).
+		Mark(ierr.ErrPermissionDenied)
+}
@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/sso"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"golang.org/x/oauth2"
+)
+
+// oidcProvider signs users in with the authorization code flow and PKCE
+type oidcProvider struct {
+	connection *sso.Connection
+	oauth      *oauth2.Config
+	verifier   *oidc.IDTokenVerifier
+}
+
+func newOIDCProvider(ctx context.Context, cfg config.SSOConfig, connection *sso.Connection) (*oidcProvider, error) {
+	// Discovery
+	provider, err := oidc.NewProvider(ctx, connection.IssuerURL)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHintf("Failed to discover the OIDC provider at %s", connection.IssuerURL).
+			WithReportableDetails(map[string]interface{}{
+				"connection_id": connection.ID,
+				"issuer_url":    connection.IssuerURL,
+			}).
+			Mark(ierr.ErrHTTPClient)
+	}
+
+	scopes := connection.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"profile", "email"}
+	}
+	if !lo.Contains(scopes, oidc.ScopeOpenID) {
+		scopes = append([]string{oidc.ScopeOpenID}, scopes...)
+	}
+
+	return &oidcProvider{
+		connection: connection,
+		oauth: &oauth2.Config{
+			ClientID:     connection.ClientID,
+			ClientSecret: connection.ClientSecret,
+			Endpoint:     provider.Endpoint(),
+			RedirectURL:  SSOOIDCCallbackURL(cfg),
+			Scopes:       scopes,
+		},
+		verifier: provider.Verifier(&oidc.Config{ClientID: connection.ClientID}),
+	}, nil
+}
+
+func (p *oidcProvider) GetProtocol() types.SSOProtocol {
+	return types.SSOProtocolOIDC
+}
+
+func (p *oidcProvider) LoginURL(_ context.Context, state *SSOLoginState) (string, error) {
+	return p.oauth.AuthCodeURL(state.ID,
+		oidc.Nonce(state.Nonce),
+		oauth2.S256ChallengeOption(state.CodeVerifier),
+	), nil
+}
+
+func (p *oidcProvider) Authenticate(ctx context.Context, state *SSOLoginState, r *http.Request) (*SSOIdentity, error) {
+	query := r.URL.Query()
+	if errCode := query.Get("error"); errCode != "" {
+		return nil, ierr.NewErrorf("identity provider returned %s", errCode).
+			WithHintf("Sign in was rejected by the identity provider: %s", query.Get("error_description")).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	code := query.Get("code")
+	if code == "" {
+		return nil, ierr.NewError("authorization code missing").
+			WithHint("The identity provider did not return an authorization code").
+			Mark(ierr.ErrValidation)
+	}
+
+	token, err := p.oauth.Exchange(ctx, code, oauth2.VerifierOption(state.CodeVerifier))
+	if err != nil {
+		return nil, ssoAuthenticationFailed(err, "Failed to exchange the authorization code")
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, ierr.NewError("id token missing").
+			WithHint("The identity provider did not return an ID token").
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	idToken, err := p.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, ssoAuthenticationFailed(err, "Invalid ID token")
+	}
+	if idToken.Nonce != state.Nonce {
+		return nil, ierr.NewError("id token nonce mismatch").
+			WithHint("Invalid ID token").
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	var claims map[string]interface{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, ssoAuthenticationFailed(err, "Invalid ID token claims")
+	}
+
+	email, _ := claims["email"].(string)
+	if email == "" {
+		return nil, ierr.NewError("id token missing email").
+			WithHint("The identity provider did not share the email of the user").
+			Mark(ierr.ErrPermissionDenied)
+	}
+	if verified, ok := claims["email_verified"].(bool); ok && !verified {
+		return nil, ierr.NewError("email not verified").
+			WithHint("The email of the user is not verified by the identity provider").
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	name, _ := claims["name"].(string)
+	return &SSOIdentity{
+		Subject: idToken.Subject,
+		Email:   strings.ToLower(email),
+		Name:    name,
+		Groups:  claimStrings(claims[p.connection.GetRoleClaim()]),
+	}, nil
+}
@@ -0,0 +1,260 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/sso"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// samlEmailAttributes are the attribute names identity providers commonly use for the email
+var samlEmailAttributes = []string{
+	"email",
+	"mail",
+	"emailaddress",
+	"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress",
+	"urn:oid:0.9.2342.19200300.100.1.3",
+}
+
+// samlNameAttributes are the attribute names identity providers commonly use for the display name
+var samlNameAttributes = []string{
+	"name",
+	"displayname",
+	"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name",
+	"urn:oid:2.16.840.1.113730.3.1.241",
+}
+
+// samlProvider signs users in with SP initiated SAML 2.0, sending the authentication
+// request with the HTTP-Redirect binding and receiving the response with HTTP-POST
+type samlProvider struct {
+	connection *sso.Connection
+	sp         *saml.ServiceProvider
+}
+
+func newSAMLProvider(ctx context.Context, cfg config.SSOConfig, connection *sso.Connection) (*samlProvider, error) {
+	idpMetadata, err := fetchIdPMetadata(ctx, cfg, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	sp := NewSAMLServiceProvider(cfg, connection)
+	sp.IDPMetadata = idpMetadata
+	return &samlProvider{
+		connection: connection,
+		sp:         sp,
+	}, nil
+}
+
+// NewSAMLServiceProvider returns the service provider of a SAML connection without the
+// identity provider metadata. Its metadata is what tenants register with their identity provider.
+func NewSAMLServiceProvider(cfg config.SSOConfig, connection *sso.Connection) *saml.ServiceProvider {
+	metadataURL, _ := url.Parse(SSOSAMLMetadataURL(cfg, connection.ID))
+	acsURL, _ := url.Parse(SSOSAMLACSURL(cfg))
+
+	return &saml.ServiceProvider{
+		EntityID:          metadataURL.String(),
+		MetadataURL:       *metadataURL,
+		AcsURL:            *acsURL,
+		AuthnNameIDFormat: saml.EmailAddressNameIDFormat,
+	}
+}
+
+// samlMetadataTimeout bounds the fetch of identity provider metadata
+const samlMetadataTimeout = 10 * time.Second
+
+func fetchIdPMetadata(ctx context.Context, cfg config.SSOConfig, connection *sso.Connection) (*saml.EntityDescriptor, error) {
+	if connection.IdPMetadataURL == "" {
+		metadata, err := samlsp.ParseMetadata([]byte(connection.IdPMetadataXML))
+		if err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Invalid identity provider metadata").
+				WithReportableDetails(map[string]interface{}{
+					"connection_id": connection.ID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		return metadata, nil
+	}
+
+	metadataURL, err := url.Parse(connection.IdPMetadataURL)
+	if err == nil && !cfg.AllowPrivateMetadataURLs && metadataURL.Scheme != "https" {
+		err = fmt.Errorf("metadata url scheme %q is not https", metadataURL.Scheme)
+	}
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Invalid identity provider metadata URL, it must be an https URL").
+			WithReportableDetails(map[string]interface{}{
+				"connection_id": connection.ID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	metadata, err := samlsp.FetchMetadata(ctx, newSAMLMetadataClient(cfg), *metadataURL)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to fetch the identity provider metadata").
+			WithReportableDetails(map[string]interface{}{
+				"connection_id": connection.ID,
+			}).
+			Mark(ierr.ErrHTTPClient)
+	}
+	return metadata, nil
+}
+
+// newSAMLMetadataClient returns the client fetching identity provider metadata. Tenants choose
+// the metadata URL, so unless private networks are allowed it only connects to public
+// addresses over https. Addresses are checked once resolved, which also covers redirects.
+func newSAMLMetadataClient(cfg config.SSOConfig) *http.Client {
+	dialer := &net.Dialer{Timeout: samlMetadataTimeout}
+	if !cfg.AllowPrivateMetadataURLs {
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+				return fmt.Errorf("metadata address %s is not public", host)
+			}
+			return nil
+		}
+	}
+
+	return &http.Client{
+		Timeout: samlMetadataTimeout,
+		Transport: &http.Transport{
+			// No proxy, the dialer has to see the address of the identity provider
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: samlMetadataTimeout,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return fmt.Errorf("too many redirects")
+			}
+			if !cfg.AllowPrivateMetadataURLs && req.URL.Scheme != "https" {
+				return fmt.Errorf("metadata redirect scheme %q is not https", req.URL.Scheme)
+			}
+			return nil
+		},
+	}
+}
+
+// isPublicIP reports whether the address is routable on the internet
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified()
+}
+
+func (p *samlProvider) GetProtocol() types.SSOProtocol {
+	return types.SSOProtocolSAML
+}
+
+func (p *samlProvider) LoginURL(_ context.Context, state *SSOLoginState) (string, error) {
+	ssoURL := p.sp.GetSSOBindingLocation(saml.HTTPRedirectBinding)
+	if ssoURL == "" {
+		return "", ierr.NewError("identity provider has no redirect binding").
+			WithHint("The identity provider metadata has no HTTP-Redirect single sign-on service").
+			Mark(ierr.ErrValidation)
+	}
+
+	req, err := p.sp.MakeAuthenticationRequest(ssoURL, saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+	if err != nil {
+		return "", ierr.WithError(err).
+			WithHint("Failed to create the SAML authentication request").
+			Mark(ierr.ErrInternal)
+	}
+	// The request ID is part of the state so the response can be matched to it
+	req.ID = state.RequestID
+
+	// Redirect appends the relay state to the query as is
+	redirectURL, err := req.Redirect(url.QueryEscape(state.ID), p.sp)
+	if err != nil {
+		return "", ierr.WithError(err).
+			WithHint("Failed to create the SAML authentication request").
+			Mark(ierr.ErrInternal)
+	}
+	return redirectURL.String(), nil
+}
+
+func (p *samlProvider) Authenticate(_ context.Context, state *SSOLoginState, r *http.Request) (*SSOIdentity, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Invalid SAML response").
+			Mark(ierr.ErrValidation)
+	}
+
+	assertion, err := p.sp.ParseResponse(r, []string{state.RequestID})
+	if err != nil {
+		// InvalidResponseError hides the reason from Error, it is logged by the error handler
+		if invalid, ok := err.(*saml.InvalidResponseError); ok && invalid.PrivateErr != nil {
+			err = invalid.PrivateErr
+		}
+		return nil, ssoAuthenticationFailed(err, "Invalid SAML response")
+	}
+
+	identity := &SSOIdentity{}
+	if assertion.Subject != nil && assertion.Subject.NameID != nil {
+		identity.Subject = assertion.Subject.NameID.Value
+	}
+
+	roleClaim := p.connection.GetRoleClaim()
+	for _, statement := range assertion.AttributeStatements {
+		for _, attribute := range statement.Attributes {
+			values := make([]string, 0, len(attribute.Values))
+			for _, v := range attribute.Values {
+				values = append(values, v.Value)
+			}
+			if len(values) == 0 {
+				continue
+			}
+
+			switch {
+			case samlAttributeIs(attribute, roleClaim):
+				identity.Groups = append(identity.Groups, values...)
+			case identity.Email == "" && samlAttributeIn(attribute, samlEmailAttributes):
+				identity.Email = values[0]
+			case identity.Name == "" && samlAttributeIn(attribute, samlNameAttributes):
+				identity.Name = values[0]
+			}
+		}
+	}
+
+	if identity.Email == "" && strings.Contains(identity.Subject, "@") {
+		identity.Email = identity.Subject
+	}
+	if identity.Email == "" {
+		return nil, ierr.NewError("saml assertion missing email").
+			WithHint("The identity provider did not share the email of the user").
+			Mark(ierr.ErrPermissionDenied)
+	}
+	identity.Email = strings.ToLower(identity.Email)
+	return identity, nil
+}
+
+func samlAttributeIs(attribute saml.Attribute, name string) bool {
+	return strings.EqualFold(attribute.Name, name) || strings.EqualFold(attribute.FriendlyName, name)
+}
+
+func samlAttributeIn(attribute saml.Attribute, names []string) bool {
+	for _, name := range names {
+		if samlAttributeIs(attribute, name) {
+			return true
+		}
+	}
+	return false
+}
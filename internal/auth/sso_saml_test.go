@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/sso"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchIdPMetadataRejectsPrivateURLs(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := config.SSOConfig{}
+	tests := []struct {
+		name string
+		url  string
+	}{
+		{name: "plain http", url: "http://idp.example.com/metadata"},
+		{name: "loopback", url: server.URL + "/metadata"},
+		{name: "link local", url: "https://169.254.169.254/latest/meta-data"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := fetchIdPMetadata(context.Background(), cfg, &sso.Connection{ID: "conn_1", IdPMetadataURL: tt.url})
+			assert.Error(t, err)
+			// The hint reaches the API response, it does not reveal what was reached
+			for _, hint := range errors.GetAllHints(err) {
+				assert.NotContains(t, hint, tt.url)
+			}
+		})
+	}
+
+	assert.True(t, isPublicIP([]byte{93, 184, 216, 34}))
+	assert.False(t, isPublicIP([]byte{10, 0, 0, 1}))
+}
@@ -149,6 +149,29 @@ func (s *supabaseAuth) ValidateToken(ctx context.Context, token string) (*auth.C
 	}, nil
 }
 
+// IssueToken signs a token with the claims of Supabase tokens, which ValidateToken accepts.
+// Users signing in through SSO have no Supabase account.
+func (s *supabaseAuth) IssueToken(ctx context.Context, userID, tenantID, email string) (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"sub":   userID,
+		"email": email,
+		"app_metadata": map[string]interface{}{
+			"tenant_id": tenantID,
+		},
+		"exp": now.Add(24 * time.Hour).Unix(),
+		"iat": now.Unix(),
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.AuthConfig.Secret))
+	if err != nil {
+		return "", ierr.WithError(err).
+			WithHint("Failed to generate token").
+			Mark(ierr.ErrSystem)
+	}
+	return token, nil
+}
+
 func (s *supabaseAuth) AssignUserToTenant(ctx context.Context, userID string, tenantID string) error {
 	// Use Supabase Admin API to update user's app_metadata
 	params := supabase.AdminUserParams{
@@ -31,6 +31,9 @@ type Cache interface {
 
 	// ForceCacheSet adds a value to the cache without checking if the cache is enabled
 	ForceCacheSet(ctx context.Context, key string, value interface{}, expiration time.Duration)
+
+	// ForceCacheDelete removes a key from the cache without checking if the cache is enabled
+	ForceCacheDelete(ctx context.Context, key string)
 }
 
 // Predefined cache key prefixes for different entity types
@@ -57,6 +60,7 @@ const (
 	PrefixCoupon                   = "coupon:v1:"
 	PrefixCouponAssociation        = "couponassociation:v1:"
 	PrefixCouponApplication        = "couponapplication:v1:"
+	PrefixPromotionCode            = "promotioncode:v1:"
 	PrefixAddon                    = "addon:v1:"
 	PrefixAddonAssociation         = "addonassociation:v1:"
 	PrefixEntityIntegrationMapping = "entity_integration_mapping:v1:"
@@ -69,6 +73,19 @@ const (
 	PrefixPriceUnit                = "price_unit:v1:"
 	PrefixWalletRealTimeBalance    = "wallet_realtime_balance:v1:"
 	PrefixWorkflowExecution        = "workflow_execution:v1:"
+	PrefixUsageLimitState          = "usage_limit_state:v1:"
+	PrefixUsageLimitCounter        = "usage_limit_counter:v1:"
+	PrefixUsageLimitNotified       = "usage_limit_notified:v1:"
+	PrefixUsageLimitBlocked        = "usage_limit_blocked:v1:"
+	PrefixUsageLimitGen            = "usage_limit_gen:v1:"
+	PrefixEntitlementSnapshot      = "entitlement_snapshot:v1:"
+	PrefixEntitlementSnapshotGen   = "entitlement_snapshot_gen:v1:"
+	PrefixRBACRoles                = "rbac_roles:v1:"
+	PrefixMemberRoles              = "member_roles:v1:"
+	PrefixAPIKeyRateLimit          = "api_key_rate_limit:v1:"
+	PrefixAPIKeyUsage              = "api_key_usage:v1:"
+	PrefixAPIKeyLastUsed           = "api_key_last_used:v1:"
+	PrefixSSOLoginState            = "sso_login_state:v1:"
 	// PrefixPriceSyncLock is the Redis key prefix for plan-level price sync lock (used with planID).
 	// Used by both API (acquire) and Temporal activity (release); do not change without updating both.
 	PrefixPriceSyncLock = "price_sync:plan:"
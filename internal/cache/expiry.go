@@ -3,9 +3,15 @@ package cache
 import "time"
 
 const (
-	ExpiryDefaultInMemory   = 30 * time.Minute
-	ExpiryDefaultRedis      = 5 * time.Minute
-	ExpiryWalletBalance     = 30 * time.Minute
-	ExpiryWalletAlertCheck  = 1 * time.Minute
-	ExpiryPriceSyncLock     = 2 * time.Hour
+	ExpiryDefaultInMemory  = 30 * time.Minute
+	ExpiryDefaultRedis     = 5 * time.Minute
+	ExpiryWalletBalance    = 30 * time.Minute
+	ExpiryWalletAlertCheck = 1 * time.Minute
+	ExpiryPriceSyncLock    = 2 * time.Hour
+	// ExpiryUsageLimitCounter caps usage limit counters of long or never resetting periods;
+	// expired counters are re-seeded from recorded usage
+	ExpiryUsageLimitCounter = 7 * 24 * time.Hour
+	// ExpirySecretInMemory keeps API keys cached per node briefly, other nodes only see a
+	// revoked key once their entry expires
+	ExpirySecretInMemory = 30 * time.Second
 )
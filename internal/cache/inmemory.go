@@ -88,6 +88,11 @@ func (c *InMemoryCache) Delete(_ context.Context, key string) {
 	c.cache.Delete(key)
 }
 
+// ForceCacheDelete removes a key from the cache bypassing configuration checks
+func (c *InMemoryCache) ForceCacheDelete(_ context.Context, key string) {
+	c.cache.Delete(key)
+}
+
 // DeleteByPrefix removes all keys with the given prefix
 func (c *InMemoryCache) DeleteByPrefix(_ context.Context, prefix string) {
 	if !c.cfg.Cache.Enabled {
@@ -181,6 +181,34 @@ func (c *RedisCache) TrySetNX(ctx context.Context, key string, value interface{}
 	return ok, nil
 }
 
+// GetDel atomically returns the value at key and deletes it, so only one caller gets it.
+// Returns false when the key does not exist. Returns error on Redis failure.
+func (c *RedisCache) GetDel(ctx context.Context, key string) (string, bool, error) {
+	value, err := c.client.GetDel(ctx, c.GetRedisKey(key)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	return value, true, nil
+}
+
+// IncrByFloat atomically adds delta to the numeric value at key and refreshes its expiration.
+// A missing key is treated as zero. Returns the value after the increment.
+func (c *RedisCache) IncrByFloat(ctx context.Context, key string, delta float64, expiration time.Duration) (float64, error) {
+	redisKey := c.GetRedisKey(key)
+	pipe := c.client.TxPipeline()
+	incr := pipe.IncrByFloat(ctx, redisKey, delta)
+	if expiration > 0 {
+		pipe.Expire(ctx, redisKey, expiration)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
 // DeleteByPrefix removes all keys with the given prefix
 func (c *RedisCache) DeleteByPrefix(ctx context.Context, prefix string) {
 
@@ -288,3 +316,9 @@ func (c *RedisCache) ForceCacheSet(ctx context.Context, key string, value interf
 		fmt.Println("Redis SET error", "key", redisKey, "error", err)
 	}
 }
+
+// ForceCacheDelete removes a key from the cache bypassing configuration checks.
+// Redis deletes are never gated by configuration, so this is the same as Delete.
+func (c *RedisCache) ForceCacheDelete(ctx context.Context, key string) {
+	c.Delete(ctx, key)
+}
@@ -53,6 +53,11 @@ type Configuration struct {
 	RBAC                       RBACConfig                       `mapstructure:"rbac" validate:"omitempty"`
 	OAuth                      OAuthConfig                      `mapstructure:"oauth" validate:"required"`
 	WalletBalanceAlert         WalletBalanceAlertConfig         `mapstructure:"wallet_balance_alert" validate:"required"`
+	UsageStream                UsageStreamConfig                `mapstructure:"usage_stream" validate:"omitempty"`
+	UsageLimit                 UsageLimitConfig                 `mapstructure:"usage_limit" validate:"omitempty"`
+	EntitlementCheck           EntitlementCheckConfig           `mapstructure:"entitlement_check" validate:"omitempty"`
+	UsageRollup                UsageRollupConfig                `mapstructure:"usage_rollup" validate:"omitempty"`
+	FX                         FXConfig                         `mapstructure:"fx" validate:"omitempty"`
 	CustomerPortal             CustomerPortalConfig             `mapstructure:"customer_portal" validate:"required"`
 	Redis                      RedisConfig                      `mapstructure:"redis" validate:"required"`
 	RawEventsReprocessing      RawEventsReprocessingConfig      `mapstructure:"raw_events_reprocessing" validate:"required"`
@@ -100,13 +105,43 @@ type DeploymentConfig struct {
 
 type ServerConfig struct {
 	Address string `mapstructure:"address" validate:"required"`
+	// TrustedProxies are the addresses or CIDRs of the load balancers in front of the API.
+	// Only their X-Forwarded-For headers are used for the client IP; without any the client
+	// IP is the address of the connection.
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
 }
 
 type AuthConfig struct {
-	Provider types.AuthProvider `mapstructure:"provider" validate:"required"`
-	Secret   string             `mapstructure:"secret" validate:"required"`
-	Supabase SupabaseConfig     `mapstructure:"supabase"`
-	APIKey   APIKeyConfig       `mapstructure:"api_key"`
+	Provider   types.AuthProvider `mapstructure:"provider" validate:"required"`
+	Secret     string             `mapstructure:"secret" validate:"required"`
+	Supabase   SupabaseConfig     `mapstructure:"supabase"`
+	APIKey     APIKeyConfig       `mapstructure:"api_key"`
+	SSO        SSOConfig          `mapstructure:"sso"`
+	Invitation InvitationConfig   `mapstructure:"invitation"`
+}
+
+// InvitationConfig configures the emails inviting users to join a tenant
+type InvitationConfig struct {
+	// AcceptURL is the dashboard page invitees open to accept or decline. The invitation
+	// token is appended as the token query parameter.
+	AcceptURL string `mapstructure:"accept_url"`
+	// TTL bounds how long an invitation can be accepted
+	TTL time.Duration `mapstructure:"ttl" default:"168h"`
+}
+
+// SSOConfig configures dashboard sign in through the OIDC and SAML connections of tenants
+type SSOConfig struct {
+	// BaseURL is the public URL of the API. The OIDC callback, SAML ACS and SAML metadata
+	// URLs registered with identity providers are derived from it.
+	BaseURL string `mapstructure:"base_url"`
+	// RedirectURL is the dashboard page users land on after signing in. The token is
+	// passed in the URL fragment.
+	RedirectURL string `mapstructure:"redirect_url"`
+	// LoginTimeout bounds how long a user may take at the identity provider
+	LoginTimeout time.Duration `mapstructure:"login_timeout" default:"10m"`
+	// AllowPrivateMetadataURLs lets SAML metadata be fetched over plain HTTP and from private,
+	// loopback and link-local addresses, for identity providers inside a self-hosted network
+	AllowPrivateMetadataURLs bool `mapstructure:"allow_private_metadata_urls" default:"false"`
 }
 
 type SupabaseConfig struct {
@@ -182,6 +217,9 @@ type PostgresConfig struct {
 type APIKeyConfig struct {
 	Header string                   `mapstructure:"header" validate:"required" default:"x-api-key"`
 	Keys   map[string]APIKeyDetails `mapstructure:"keys"` // map of hashed API key to its details
+	// TrackUsage counts the requests made with each API key. Counters are kept in Redis.
+	// Per-key rate limits are enforced either way.
+	TrackUsage bool `mapstructure:"track_usage" default:"false"`
 }
 
 type APIKeyDetails struct {
@@ -337,6 +375,63 @@ type WalletBalanceAlertConfig struct {
 	ConsumerGroup string `mapstructure:"consumer_group" default:"v1_wallet_alert_service"`
 }
 
+// UsageStreamConfig configures the real-time usage stream (SSE) fan-out.
+// Every API node consumes the topic with its own consumer group so that all nodes
+// see every delta and can serve subscribers connected to them.
+type UsageStreamConfig struct {
+	Enabled             bool          `mapstructure:"enabled" default:"false"`
+	Topic               string        `mapstructure:"topic" default:"usage_stream"`
+	ConsumerGroupPrefix string        `mapstructure:"consumer_group_prefix" default:"v1_usage_stream"`
+	HeartbeatInterval   time.Duration `mapstructure:"heartbeat_interval" default:"15s"`
+	// BufferSize is the number of recent events kept per customer for cursor resumption
+	BufferSize int `mapstructure:"buffer_size" default:"500"`
+	// ReplayWindow is how long idle per-customer buffers are retained for resumption
+	ReplayWindow time.Duration `mapstructure:"replay_window" default:"15m"`
+	// SubscriberBufferSize is the per-connection queue; slow subscribers are disconnected when it fills
+	SubscriberBufferSize int `mapstructure:"subscriber_buffer_size" default:"256"`
+}
+
+// UsageLimitConfig configures near real-time enforcement of entitlement usage limits.
+// Consumption is tracked in Redis counters fed from feature usage processing.
+type UsageLimitConfig struct {
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// IngestionMode controls what happens to events of customers that reached a hard limit:
+	// "off" accepts them, "tag" marks them with a property, "drop" discards them
+	IngestionMode string `mapstructure:"ingestion_mode" default:"off" validate:"omitempty,oneof=off tag drop"`
+	// LimitRefreshInterval is how long a resolved limit is cached before it is re-read
+	// from entitlements and re-seeded from recorded usage
+	LimitRefreshInterval time.Duration `mapstructure:"limit_refresh_interval" default:"5m"`
+}
+
+// EntitlementCheckConfig configures the cached per-customer entitlement snapshots
+// served by the entitlement check API
+type EntitlementCheckConfig struct {
+	// SnapshotTTL bounds how long a snapshot is kept when no change event invalidates it
+	SnapshotTTL time.Duration `mapstructure:"snapshot_ttl" default:"15m"`
+	// ClientMaxAge is advertised in Cache-Control so SDKs know when to revalidate their copy
+	ClientMaxAge time.Duration `mapstructure:"client_max_age" default:"60s"`
+}
+
+// UsageRollupConfig configures the hourly and daily meter usage rollups in ClickHouse
+// that analytics queries read instead of scanning raw meter_usage
+type UsageRollupConfig struct {
+	Enabled bool `mapstructure:"enabled" default:"true"`
+	// LateArrivalWindow is how far back each run rebuilds already rolled up buckets,
+	// so events arriving late are still reflected in the rollups
+	LateArrivalWindow time.Duration `mapstructure:"late_arrival_window" default:"6h"`
+	// InitialBackfill is how much history the first run of an environment rolls up
+	InitialBackfill time.Duration `mapstructure:"initial_backfill" default:"168h"`
+}
+
+// FXConfig configures where FX rates used for cross-currency wallet debits come from
+type FXConfig struct {
+	// Source is "manual" for rates entered through the API only, or "file" to also sync
+	// rates from RatesFile
+	Source string `mapstructure:"source" default:"manual"`
+	// RatesFile is a JSON file of rates, {"rates": [{"base", "quote", "rate", "effective_at"}]}
+	RatesFile string `mapstructure:"rates_file"`
+}
+
 type RawEventsReprocessingConfig struct {
 	Enabled     bool   `mapstructure:"enabled" default:"true"`
 	OutputTopic string `mapstructure:"output_topic" default:"prod_events_v4"`
@@ -497,7 +592,7 @@ func NewConfig() (*Configuration, error) {
 		cfg.Auth.APIKey.Keys = apiKeys
 	}
 
-	// tenant webhook config
+	// retired tenant webhook config, kept to import it into webhook endpoints
 	tenantWebhookConfig := make(map[string]TenantWebhookConfig)
 	if err := v.UnmarshalKey("webhook.tenants", &tenantWebhookConfig); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal webhook tenants config: %v", err)
@@ -592,6 +687,9 @@ func (c PostgresConfig) HasSeparateReader() bool {
 
 type RBACConfig struct {
 	RolesConfigPath string `mapstructure:"roles_config_path" json:"roles_config_path"`
+	// DenyByDefault denies permission checked routes to API keys without any role instead
+	// of granting them full access
+	DenyByDefault bool `mapstructure:"deny_by_default" json:"deny_by_default" default:"false"`
 }
 
 // OAuthConfig holds generic OAuth configuration for multiple providers
@@ -1,25 +1,34 @@
 package config
 
 import (
+	"fmt"
 	"time"
 )
 
 // Webhook represents the configuration for the webhook system
 type Webhook struct {
-	Enabled         bool                           `mapstructure:"enabled"`
-	Topic           string                         `mapstructure:"topic" default:"system_events"`
-	ConsumerGroup   string                         `mapstructure:"consumer_group" default:"system-events-consumer"`
-	RateLimit       int64                          `mapstructure:"rate_limit" default:"5"`
-	MaxRetries      int                            `mapstructure:"max_retries" default:"3"`
-	InitialInterval time.Duration                  `mapstructure:"initial_interval" default:"1s"`
-	MaxInterval     time.Duration                  `mapstructure:"max_interval" default:"10s"`
-	Multiplier      float64                        `mapstructure:"multiplier" default:"2.0"`
-	MaxElapsedTime  time.Duration                  `mapstructure:"max_elapsed_time" default:"2m"`
-	Tenants         map[string]TenantWebhookConfig `mapstructure:"tenants"`
-	Svix            Svix                           `mapstructure:"svix_config"`
+	Enabled         bool          `mapstructure:"enabled"`
+	Topic           string        `mapstructure:"topic" default:"system_events"`
+	ConsumerGroup   string        `mapstructure:"consumer_group" default:"system-events-consumer"`
+	RateLimit       int64         `mapstructure:"rate_limit" default:"5"`
+	MaxRetries      int           `mapstructure:"max_retries" default:"3"`
+	InitialInterval time.Duration `mapstructure:"initial_interval" default:"1s"`
+	MaxInterval     time.Duration `mapstructure:"max_interval" default:"10s"`
+	Multiplier      float64       `mapstructure:"multiplier" default:"2.0"`
+	MaxElapsedTime  time.Duration `mapstructure:"max_elapsed_time" default:"2m"`
+	Svix            Svix          `mapstructure:"svix_config"`
+	// EndpointAutoDisableAfter disables a native webhook endpoint once every delivery to it
+	// has failed for this long. Zero keeps failing endpoints enabled.
+	EndpointAutoDisableAfter time.Duration `mapstructure:"endpoint_auto_disable_after" default:"72h"`
+	// OrderedDelivery holds back the events of an entity until every earlier event of the same
+	// entity was delivered or exhausted its retries (webhook_retry_job.max_attempts)
+	OrderedDelivery bool `mapstructure:"ordered_delivery" default:"false"`
+	// Tenants is the retired per-tenant webhook configuration, replaced by webhook endpoints.
+	// It is only read by the import-webhook-tenants script, which moves it into webhook endpoints.
+	Tenants map[string]TenantWebhookConfig `mapstructure:"tenants"`
 }
 
-// TenantWebhookConfig represents webhook configuration for a specific tenant
+// TenantWebhookConfig is the retired webhook configuration of a tenant
 type TenantWebhookConfig struct {
 	Endpoint       string            `mapstructure:"endpoint"`
 	Headers        map[string]string `mapstructure:"headers"`
@@ -27,6 +36,15 @@ type TenantWebhookConfig struct {
 	ExcludedEvents []string          `mapstructure:"excluded_events"`
 }
 
+// ValidateRetiredTenants fails while the retired webhook.tenants configuration is set, so
+// the endpoints it configured are not silently dropped
+func (w Webhook) ValidateRetiredTenants() error {
+	if len(w.Tenants) == 0 {
+		return nil
+	}
+	return fmt.Errorf("webhook.tenants is no longer supported (%d tenants configured): import it into webhook endpoints with `go run scripts/main.go -cmd import-webhook-tenants` and remove it from the configuration", len(w.Tenants))
+}
+
 type Svix struct {
 	Enabled   bool   `mapstructure:"enabled"`
 	AuthToken string `mapstructure:"auth_token"`
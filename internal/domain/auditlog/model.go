@@ -0,0 +1,157 @@
+package auditlog
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/flexprice/flexprice/ent"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// AuditLog is an immutable record of an action performed on an entity
+type AuditLog struct {
+	ID         string                   `json:"id" db:"id"`
+	EntityType types.AuditLogEntityType `json:"entity_type" db:"entity_type"`
+	EntityID   string                   `json:"entity_id" db:"entity_id"`
+	Action     types.AuditLogAction     `json:"action" db:"action"`
+	ActorType  types.AuditLogActorType  `json:"actor_type" db:"actor_type"`
+	// ActorID is the user ID, API key ID or workflow name depending on the actor type
+	ActorID string `json:"actor_id,omitempty" db:"actor_id"`
+	// Changes holds the changed fields with their values before and after the action
+	Changes       map[string]types.AuditLogChange `json:"changes,omitempty" db:"changes"`
+	RequestID     string                          `json:"request_id,omitempty" db:"request_id"`
+	IPAddress     string                          `json:"ip_address,omitempty" db:"ip_address"`
+	EnvironmentID string                          `json:"environment_id" db:"environment_id"`
+	types.BaseModel
+}
+
+// redactedValue replaces the values of sensitive fields in diffs
+const redactedValue = "[REDACTED]"
+
+// ignoredFields change with every write and are left out of diffs
+var ignoredFields = map[string]bool{
+	"tenant_id":      true,
+	"environment_id": true,
+	"created_at":     true,
+	"created_by":     true,
+	"updated_at":     true,
+	"updated_by":     true,
+}
+
+// sensitiveFieldMarkers mark fields whose values are never written to the audit log
+var sensitiveFieldMarkers = []string{"secret", "password", "token", "credentials"}
+
+// Diff returns the fields that differ between two snapshots of an entity. Snapshots are
+// compared by their JSON representation so that field names match the API. A nil before
+// yields the fields of a created entity and a nil after those of a deleted one.
+func Diff(before, after interface{}) (map[string]types.AuditLogChange, error) {
+	beforeFields, err := toFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := toFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]types.AuditLogChange)
+	for key, value := range afterFields {
+		if ignoredFields[key] {
+			continue
+		}
+		previous, existed := beforeFields[key]
+		if (!existed && value == nil) || (existed && reflect.DeepEqual(previous, value)) {
+			continue
+		}
+		changes[key] = redact(key, types.AuditLogChange{Before: previous, After: value})
+	}
+	for key, value := range beforeFields {
+		if _, exists := afterFields[key]; exists || ignoredFields[key] || value == nil {
+			continue
+		}
+		changes[key] = redact(key, types.AuditLogChange{Before: value})
+	}
+
+	return changes, nil
+}
+
+func toFields(snapshot interface{}) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	if snapshot == nil {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to serialize audit log snapshot").
+			Mark(ierr.ErrInternal)
+	}
+	if string(data) == "null" {
+		return fields, nil
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Audit log snapshots must serialize to a JSON object").
+			Mark(ierr.ErrInternal)
+	}
+	return fields, nil
+}
+
+func redact(key string, change types.AuditLogChange) types.AuditLogChange {
+	for _, marker := range sensitiveFieldMarkers {
+		if !strings.Contains(key, marker) {
+			continue
+		}
+		if change.Before != nil {
+			change.Before = redactedValue
+		}
+		if change.After != nil {
+			change.After = redactedValue
+		}
+		break
+	}
+	return change
+}
+
+// FromEnt converts an ent.AuditLog to a domain AuditLog
+func FromEnt(e *ent.AuditLog) *AuditLog {
+	if e == nil {
+		return nil
+	}
+
+	return &AuditLog{
+		ID:            e.ID,
+		EntityType:    types.AuditLogEntityType(e.EntityType),
+		EntityID:      e.EntityID,
+		Action:        types.AuditLogAction(e.Action),
+		ActorType:     types.AuditLogActorType(e.ActorType),
+		ActorID:       e.ActorID,
+		Changes:       e.Changes,
+		RequestID:     e.RequestID,
+		IPAddress:     e.IPAddress,
+		EnvironmentID: e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+		},
+	}
+}
+
+// FromEntList converts a list of ent.AuditLog to domain audit logs
+func FromEntList(list []*ent.AuditLog) []*AuditLog {
+	if list == nil {
+		return nil
+	}
+	logs := make([]*AuditLog, len(list))
+	for i, item := range list {
+		logs[i] = FromEnt(item)
+	}
+	return logs
+}
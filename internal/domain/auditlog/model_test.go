@@ -0,0 +1,37 @@
+package auditlog
+
+import (
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+func TestDiff(t *testing.T) {
+	type entity struct {
+		Name     string `json:"name"`
+		Amount   int    `json:"amount"`
+		Password string `json:"password"`
+		types.BaseModel
+	}
+
+	changes, err := Diff(
+		&entity{Name: "a", Amount: 1, Password: "x", BaseModel: types.BaseModel{TenantID: "t1"}},
+		&entity{Name: "b", Amount: 1, Password: "y", BaseModel: types.BaseModel{TenantID: "t2"}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]types.AuditLogChange{
+		"name":     {Before: "a", After: "b"},
+		"password": {Before: "[REDACTED]", After: "[REDACTED]"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("expected %d changes, got %v", len(want), changes)
+	}
+	for key, change := range want {
+		if changes[key] != change {
+			t.Errorf("change of %s: expected %v, got %v", key, change, changes[key])
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package auditlog
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Repository defines the interface for audit log data access. The audit log is
+// append-only, so entries can be created but never updated or deleted.
+type Repository interface {
+	Create(ctx context.Context, log *AuditLog) error
+	Get(ctx context.Context, id string) (*AuditLog, error)
+	List(ctx context.Context, filter *types.AuditLogFilter) ([]*AuditLog, error)
+	Count(ctx context.Context, filter *types.AuditLogFilter) (int, error)
+}
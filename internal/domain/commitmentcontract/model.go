@@ -0,0 +1,171 @@
+package commitmentcontract
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// CommitmentContract is a prepaid spend commitment of a customer spanning one or more subscriptions.
+// Usage charges of the subscriptions draw down the prepaid amount; once it is exhausted usage is
+// charged at the overage factor. At the end of the term, spend short of the commitment is trued up.
+type CommitmentContract struct {
+	ID              string   `json:"id" db:"id"`
+	Name            string   `json:"name" db:"name"`
+	CustomerID      string   `json:"customer_id" db:"customer_id"`
+	SubscriptionIDs []string `json:"subscription_ids" db:"subscription_ids"`
+	Currency        string   `json:"currency" db:"currency"`
+	// CommitmentAmount is the minimum spend over the contract term
+	CommitmentAmount decimal.Decimal `json:"commitment_amount" db:"commitment_amount" swaggertype:"string"`
+	// PrepaidAmount is invoiced upfront and drawn down by usage
+	PrepaidAmount decimal.Decimal `json:"prepaid_amount" db:"prepaid_amount" swaggertype:"string"`
+	// OverageFactor multiplies usage charged after the prepaid amount is exhausted
+	OverageFactor   decimal.Decimal                `json:"overage_factor" db:"overage_factor" swaggertype:"string"`
+	DrawnDownAmount decimal.Decimal                `json:"drawn_down_amount" db:"drawn_down_amount" swaggertype:"string"`
+	OverageAmount   decimal.Decimal                `json:"overage_amount" db:"overage_amount" swaggertype:"string"`
+	StartDate       time.Time                      `json:"start_date" db:"start_date"`
+	EndDate         time.Time                      `json:"end_date" db:"end_date"`
+	EnableTrueUp    bool                           `json:"enable_true_up" db:"enable_true_up"`
+	ContractStatus  types.CommitmentContractStatus `json:"contract_status" db:"contract_status"`
+	// PrepaymentInvoiceID and TrueUpInvoiceID are the invoices raised at the start and end of the term
+	PrepaymentInvoiceID *string           `json:"prepayment_invoice_id,omitempty" db:"prepayment_invoice_id"`
+	TrueUpInvoiceID     *string           `json:"true_up_invoice_id,omitempty" db:"true_up_invoice_id"`
+	Metadata            map[string]string `json:"metadata,omitempty" db:"metadata"`
+	EnvironmentID       string            `json:"environment_id" db:"environment_id"`
+	types.BaseModel
+}
+
+// RemainingBalance returns the prepaid amount not drawn down yet
+func (c *CommitmentContract) RemainingBalance() decimal.Decimal {
+	return decimal.Max(c.PrepaidAmount.Sub(c.DrawnDownAmount), decimal.Zero)
+}
+
+// TotalSpend returns the spend counted towards the commitment: the prepaid amount and the overage
+func (c *CommitmentContract) TotalSpend() decimal.Decimal {
+	return c.PrepaidAmount.Add(c.OverageAmount)
+}
+
+// TrueUpAmount returns the spend short of the commitment at the end of the term
+func (c *CommitmentContract) TrueUpAmount() decimal.Decimal {
+	if !c.EnableTrueUp {
+		return decimal.Zero
+	}
+	return decimal.Max(c.CommitmentAmount.Sub(c.TotalSpend()), decimal.Zero)
+}
+
+// Covers reports whether the usage of a subscription in a period starting at periodStart
+// draws down the contract
+func (c *CommitmentContract) Covers(subscriptionID string, periodStart time.Time) bool {
+	return c.ContractStatus == types.CommitmentContractStatusActive &&
+		lo.Contains(c.SubscriptionIDs, subscriptionID) &&
+		!periodStart.Before(c.StartDate) &&
+		periodStart.Before(c.EndDate)
+}
+
+// CommitmentDrawdown records what one invoice drew down from a contract
+type CommitmentDrawdown struct {
+	ID             string          `json:"id" db:"id"`
+	ContractID     string          `json:"contract_id" db:"contract_id"`
+	SubscriptionID string          `json:"subscription_id" db:"subscription_id"`
+	InvoiceID      string          `json:"invoice_id" db:"invoice_id"`
+	PeriodStart    time.Time       `json:"period_start" db:"period_start"`
+	PeriodEnd      time.Time       `json:"period_end" db:"period_end"`
+	UsageAmount    decimal.Decimal `json:"usage_amount" db:"usage_amount" swaggertype:"string"`
+	DrawdownAmount decimal.Decimal `json:"drawdown_amount" db:"drawdown_amount" swaggertype:"string"`
+	OverageAmount  decimal.Decimal `json:"overage_amount" db:"overage_amount" swaggertype:"string"`
+	BalanceAfter   decimal.Decimal `json:"balance_after" db:"balance_after" swaggertype:"string"`
+	EnvironmentID  string          `json:"environment_id" db:"environment_id"`
+	types.BaseModel
+}
+
+// FromEnt converts an ent.CommitmentContract to a domain CommitmentContract
+func FromEnt(e *ent.CommitmentContract) *CommitmentContract {
+	if e == nil {
+		return nil
+	}
+
+	return &CommitmentContract{
+		ID:                  e.ID,
+		Name:                e.Name,
+		CustomerID:          e.CustomerID,
+		SubscriptionIDs:     e.SubscriptionIds,
+		Currency:            e.Currency,
+		CommitmentAmount:    e.CommitmentAmount,
+		PrepaidAmount:       e.PrepaidAmount,
+		OverageFactor:       e.OverageFactor,
+		DrawnDownAmount:     e.DrawnDownAmount,
+		OverageAmount:       e.OverageAmount,
+		StartDate:           e.StartDate,
+		EndDate:             e.EndDate,
+		EnableTrueUp:        e.EnableTrueUp,
+		ContractStatus:      types.CommitmentContractStatus(e.ContractStatus),
+		PrepaymentInvoiceID: e.PrepaymentInvoiceID,
+		TrueUpInvoiceID:     e.TrueUpInvoiceID,
+		Metadata:            e.Metadata,
+		EnvironmentID:       e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+		},
+	}
+}
+
+// FromEntList converts a list of ent.CommitmentContract to domain commitment contracts
+func FromEntList(list []*ent.CommitmentContract) []*CommitmentContract {
+	if list == nil {
+		return nil
+	}
+	contracts := make([]*CommitmentContract, len(list))
+	for i, item := range list {
+		contracts[i] = FromEnt(item)
+	}
+	return contracts
+}
+
+// DrawdownFromEnt converts an ent.CommitmentDrawdown to a domain CommitmentDrawdown
+func DrawdownFromEnt(e *ent.CommitmentDrawdown) *CommitmentDrawdown {
+	if e == nil {
+		return nil
+	}
+
+	return &CommitmentDrawdown{
+		ID:             e.ID,
+		ContractID:     e.ContractID,
+		SubscriptionID: e.SubscriptionID,
+		InvoiceID:      e.InvoiceID,
+		PeriodStart:    e.PeriodStart,
+		PeriodEnd:      e.PeriodEnd,
+		UsageAmount:    e.UsageAmount,
+		DrawdownAmount: e.DrawdownAmount,
+		OverageAmount:  e.OverageAmount,
+		BalanceAfter:   e.BalanceAfter,
+		EnvironmentID:  e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+		},
+	}
+}
+
+// DrawdownFromEntList converts a list of ent.CommitmentDrawdown to domain drawdowns
+func DrawdownFromEntList(list []*ent.CommitmentDrawdown) []*CommitmentDrawdown {
+	if list == nil {
+		return nil
+	}
+	drawdowns := make([]*CommitmentDrawdown, len(list))
+	for i, item := range list {
+		drawdowns[i] = DrawdownFromEnt(item)
+	}
+	return drawdowns
+}
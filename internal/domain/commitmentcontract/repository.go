@@ -0,0 +1,26 @@
+package commitmentcontract
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Repository defines the interface for commitment contract data access
+type Repository interface {
+	Create(ctx context.Context, contract *CommitmentContract) error
+	Get(ctx context.Context, id string) (*CommitmentContract, error)
+	Update(ctx context.Context, contract *CommitmentContract) error
+	List(ctx context.Context, filter *types.CommitmentContractFilter) ([]*CommitmentContract, error)
+	Count(ctx context.Context, filter *types.CommitmentContractFilter) (int, error)
+
+	// CreateDrawdown records the drawdown of an invoice
+	CreateDrawdown(ctx context.Context, drawdown *CommitmentDrawdown) error
+	// GetDrawdownByInvoice returns the drawdown of an invoice against a contract, if any
+	GetDrawdownByInvoice(ctx context.Context, contractID, invoiceID string) (*CommitmentDrawdown, error)
+	// GetForUpdate returns a contract locked for the rest of the transaction
+	GetForUpdate(ctx context.Context, id string) (*CommitmentContract, error)
+
+	// ListDrawdowns returns the drawdowns of a contract ordered by period start
+	ListDrawdowns(ctx context.Context, contractID string) ([]*CommitmentDrawdown, error)
+}
@@ -11,21 +11,21 @@ import (
 
 // Coupon represents a discount coupon entity
 type Coupon struct {
-	ID                string                  `json:"id" db:"id"`
-	Name              string                  `json:"name" db:"name"`
-	RedeemAfter       *time.Time              `json:"redeem_after" db:"redeem_after"`
-	RedeemBefore      *time.Time              `json:"redeem_before" db:"redeem_before"`
-	MaxRedemptions    *int                    `json:"max_redemptions" db:"max_redemptions"`
-	TotalRedemptions  int                     `json:"total_redemptions" db:"total_redemptions"`
-	Rules             *map[string]interface{} `json:"rules" db:"rules"`
-	AmountOff         *decimal.Decimal        `json:"amount_off" db:"amount_off" swaggertype:"string"`
-	PercentageOff     *decimal.Decimal        `json:"percentage_off" db:"percentage_off" swaggertype:"string"`
-	Type              types.CouponType        `json:"type" db:"type"`
-	Cadence           types.CouponCadence     `json:"cadence" db:"cadence"`
-	DurationInPeriods *int                    `json:"duration_in_periods" db:"duration_in_periods"`
-	Currency          string                  `json:"currency" db:"currency"`
-	Metadata          *map[string]string      `json:"metadata" db:"metadata"`
-	EnvironmentID     string                  `json:"environment_id" db:"environment_id"`
+	ID                string              `json:"id" db:"id"`
+	Name              string              `json:"name" db:"name"`
+	RedeemAfter       *time.Time          `json:"redeem_after" db:"redeem_after"`
+	RedeemBefore      *time.Time          `json:"redeem_before" db:"redeem_before"`
+	MaxRedemptions    *int                `json:"max_redemptions" db:"max_redemptions"`
+	TotalRedemptions  int                 `json:"total_redemptions" db:"total_redemptions"`
+	Rules             *types.CouponRules  `json:"rules" db:"rules"`
+	AmountOff         *decimal.Decimal    `json:"amount_off" db:"amount_off" swaggertype:"string"`
+	PercentageOff     *decimal.Decimal    `json:"percentage_off" db:"percentage_off" swaggertype:"string"`
+	Type              types.CouponType    `json:"type" db:"type"`
+	Cadence           types.CouponCadence `json:"cadence" db:"cadence"`
+	DurationInPeriods *int                `json:"duration_in_periods" db:"duration_in_periods"`
+	Currency          string              `json:"currency" db:"currency"`
+	Metadata          *map[string]string  `json:"metadata" db:"metadata"`
+	EnvironmentID     string              `json:"environment_id" db:"environment_id"`
 	types.BaseModel
 }
 
@@ -107,7 +107,7 @@ func FromEnt(e *ent.Coupon) *Coupon {
 		RedeemBefore:      e.RedeemBefore,
 		MaxRedemptions:    e.MaxRedemptions,
 		TotalRedemptions:  e.TotalRedemptions,
-		Rules:             &e.Rules,
+		Rules:             e.Rules,
 		AmountOff:         &e.AmountOff,
 		PercentageOff:     &e.PercentageOff,
 		Type:              types.CouponType(e.Type),
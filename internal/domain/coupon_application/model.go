@@ -16,6 +16,7 @@ type CouponApplication struct {
 	InvoiceID           string                 `json:"invoice_id" db:"invoice_id"`
 	InvoiceLineItemID   *string                `json:"invoice_line_item_id,omitempty" db:"invoice_line_item_id"`
 	SubscriptionID      *string                `json:"subscription_id,omitempty" db:"subscription_id"`
+	PromotionCodeID     *string                `json:"promotion_code_id,omitempty" db:"promotion_code_id"`
 	AppliedAt           time.Time              `json:"applied_at" db:"applied_at"`
 	OriginalPrice       decimal.Decimal        `json:"original_price" db:"original_price" swaggertype:"string"`
 	FinalPrice          decimal.Decimal        `json:"final_price" db:"final_price" swaggertype:"string"`
@@ -46,6 +47,7 @@ func FromEnt(e *ent.CouponApplication) *CouponApplication {
 		InvoiceID:          e.InvoiceID,
 		InvoiceLineItemID:  e.InvoiceLineItemID,
 		SubscriptionID:     e.SubscriptionID,
+		PromotionCodeID:    e.PromotionCodeID,
 		AppliedAt:          e.AppliedAt,
 		OriginalPrice:      e.OriginalPrice,
 		FinalPrice:         e.FinalPrice,
@@ -18,6 +18,7 @@ type CouponAssociation struct {
 	SubscriptionPhaseID    *string           `json:"subscription_phase_id,omitempty" db:"subscription_phase_id"`         // Optional
 	StartDate              time.Time         `json:"start_date" db:"start_date"`
 	EndDate                *time.Time        `json:"end_date,omitempty" db:"end_date"` // Optional
+	PromotionCodeID        *string           `json:"promotion_code_id,omitempty" db:"promotion_code_id"`
 	Metadata               map[string]string `json:"metadata,omitempty" db:"metadata"`
 	EnvironmentID          string            `json:"environment_id" db:"environment_id"`
 	Coupon                 *coupon.Coupon    `json:"coupon,omitempty" db:"coupon"`
@@ -43,6 +44,7 @@ func FromEnt(e *ent.CouponAssociation) *CouponAssociation {
 		SubscriptionPhaseID:    e.SubscriptionPhaseID,
 		StartDate:              e.StartDate,
 		EndDate:                e.EndDate,
+		PromotionCodeID:        e.PromotionCodeID,
 		Metadata:               e.Metadata,
 		EnvironmentID:          e.EnvironmentID,
 		Coupon:                 coupon,
@@ -31,6 +31,9 @@ type CreditGrant struct {
 	EndDate                *time.Time                           `json:"end_date,omitempty"`
 	CreditGrantAnchor      *time.Time                           `json:"credit_grant_anchor,omitempty"`
 
+	// RolloverConfig carries unused credits of a recurring grant into the next period
+	RolloverConfig *types.CreditGrantRolloverConfig `json:"rollover_config,omitempty"`
+
 	// amount in the currency =  number of credits * conversion_rate
 	// ex if conversion_rate is 1, then 1 USD = 1 credit
 	// ex if conversion_rate is 2, then 1 USD = 0.5 credits
@@ -212,6 +215,7 @@ func FromEnt(c *ent.CreditGrant) *CreditGrant {
 		StartDate:              c.StartDate,
 		EndDate:                c.EndDate,
 		CreditGrantAnchor:      c.CreditGrantAnchor,
+		RolloverConfig:         c.RolloverConfig,
 		ConversionRate:         c.ConversionRate,
 		TopupConversionRate:    c.TopupConversionRate,
 		EnvironmentID:          c.EnvironmentID,
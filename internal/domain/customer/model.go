@@ -38,6 +38,15 @@ type Customer struct {
 	// AddressCountry is the country of the customer's address (ISO 3166-1 alpha-2)
 	AddressCountry string `db:"address_country" json:"address_country"`
 
+	// Currency is the customer's billing currency, used when a subscription does not set one
+	Currency string `db:"currency" json:"currency,omitempty"`
+
+	// ParentCustomerID is the parent of the customer in a customer hierarchy
+	ParentCustomerID *string `db:"parent_customer_id" json:"parent_customer_id,omitempty"`
+
+	// InvoiceToParent routes the customer's invoices to its parent, which pays for it
+	InvoiceToParent bool `db:"invoice_to_parent" json:"invoice_to_parent"`
+
 	// Metadata
 	Metadata map[string]string `db:"metadata" json:"metadata"`
 
@@ -63,6 +72,9 @@ func FromEnt(c *ent.Customer) *Customer {
 		AddressState:      c.AddressState,
 		AddressPostalCode: c.AddressPostalCode,
 		AddressCountry:    c.AddressCountry,
+		Currency:          c.Currency,
+		ParentCustomerID:  c.ParentCustomerID,
+		InvoiceToParent:   c.InvoiceToParent,
 		Metadata:          c.Metadata,
 		EnvironmentID:     c.EnvironmentID,
 		BaseModel: types.BaseModel{
@@ -60,6 +60,15 @@ type MeterUsageAggregationResult struct {
 	Points          []MeterUsageResult    `json:"points,omitempty"`
 }
 
+// MeterUsageBucket is the summed usage of one customer × meter within one time bucket
+type MeterUsageBucket struct {
+	ExternalCustomerID string          `json:"external_customer_id"`
+	MeterID            string          `json:"meter_id"`
+	WindowStart        time.Time       `json:"window_start"`
+	Value              decimal.Decimal `json:"value"`
+	EventCount         uint64          `json:"event_count"`
+}
+
 // MeterUsageRepository defines read/write operations on the meter_usage ClickHouse table
 type MeterUsageRepository interface {
 	// BulkInsertMeterUsage inserts multiple meter usage records in batches
@@ -81,4 +90,9 @@ type MeterUsageRepository interface {
 	// GetDistinctMeterIDs returns the set of meter_ids that have data in the meter_usage table
 	// for the given customer(s) and time range. Used to skip meters with zero usage.
 	GetDistinctMeterIDs(ctx context.Context, params *MeterUsageQueryParams) ([]string, error)
+
+	// GetUsageBuckets returns usage per customer, meter and fixed-size time bucket across the
+	// environment, aggregated with the aggregation type of the params. Used to build rolling
+	// usage baselines (e.g. anomaly detection).
+	GetUsageBuckets(ctx context.Context, params *MeterUsageQueryParams, bucketSize time.Duration) ([]*MeterUsageBucket, error)
 }
@@ -0,0 +1,54 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// UsageRollupState is the time range an environment's rollup table is complete for.
+// Both bounds are aligned to the rollup granularity.
+type UsageRollupState struct {
+	TenantID      string
+	EnvironmentID string
+	Source        types.UsageRollupSource
+	Granularity   types.UsageRollupGranularity
+	RolledUpFrom  time.Time
+	RolledUpTo    time.Time
+}
+
+// UsageRetentionPolicy is the retention of an environment's usage data, synced from the
+// usage_retention_config setting to ClickHouse where TTLs enforce it. Zero keeps data forever.
+type UsageRetentionPolicy struct {
+	TenantID       string
+	EnvironmentID  string
+	RawEventDays   int
+	MeterUsageDays int
+	RollupDays     int
+}
+
+// UsageRollupRepository maintains and queries the hourly and daily meter and feature usage rollups
+type UsageRollupRepository interface {
+	// Rollup rebuilds the buckets of the source and granularity in [start, end) for an environment.
+	// Hourly buckets are built from the source table, daily buckets from the hourly rollup.
+	Rollup(ctx context.Context, source types.UsageRollupSource, tenantID, environmentID string, granularity types.UsageRollupGranularity, start, end time.Time) error
+
+	// GetRollupStates returns the rolled up ranges of an environment for a source, one per granularity that has run
+	GetRollupStates(ctx context.Context, source types.UsageRollupSource, tenantID, environmentID string) ([]*UsageRollupState, error)
+
+	// SaveRollupState records the rolled up range of an environment for a source and granularity
+	SaveRollupState(ctx context.Context, state *UsageRollupState) error
+
+	// GetUsageMultiMeter queries a meter usage rollup like MeterUsageRepository.GetUsageMultiMeter.
+	// Only SUM, COUNT and MAX can be answered from rollups.
+	GetUsageMultiMeter(ctx context.Context, params *MeterUsageQueryParams, granularity types.UsageRollupGranularity) ([]*MeterUsageAggregationResult, error)
+
+	// GetDetailedUsageAnalytics queries a feature usage rollup like the standard analytics of
+	// FeatureUsageRepository.GetDetailedUsageAnalytics. Only SUM, COUNT and MAX can be answered,
+	// and rollups hold no event properties to filter or group by.
+	GetDetailedUsageAnalytics(ctx context.Context, params *UsageAnalyticsParams, granularity types.UsageRollupGranularity) ([]*DetailedUsageAnalytic, error)
+
+	// SaveRetentionPolicy upserts the retention policy of an environment
+	SaveRetentionPolicy(ctx context.Context, policy *UsageRetentionPolicy) error
+}
@@ -0,0 +1,81 @@
+package fxrate
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// FXRate is the rate converting one unit of BaseCurrency into QuoteCurrency from EffectiveAt on
+type FXRate struct {
+	ID            string             `json:"id" db:"id"`
+	BaseCurrency  string             `json:"base_currency" db:"base_currency"`
+	QuoteCurrency string             `json:"quote_currency" db:"quote_currency"`
+	Rate          decimal.Decimal    `json:"rate" db:"rate" swaggertype:"string"`
+	EffectiveAt   time.Time          `json:"effective_at" db:"effective_at"`
+	Source        types.FXRateSource `json:"source" db:"source"`
+	Metadata      map[string]string  `json:"metadata,omitempty" db:"metadata"`
+	EnvironmentID string             `json:"environment_id" db:"environment_id"`
+	types.BaseModel
+}
+
+// ToConversion returns the conversion from BaseCurrency to QuoteCurrency
+func (r *FXRate) ToConversion() *types.FXConversion {
+	return &types.FXConversion{
+		RateID:       r.ID,
+		FromCurrency: r.BaseCurrency,
+		ToCurrency:   r.QuoteCurrency,
+		Rate:         r.Rate,
+		EffectiveAt:  r.EffectiveAt,
+	}
+}
+
+// ToInverseConversion returns the conversion from QuoteCurrency to BaseCurrency
+func (r *FXRate) ToInverseConversion() *types.FXConversion {
+	return &types.FXConversion{
+		RateID:       r.ID,
+		FromCurrency: r.QuoteCurrency,
+		ToCurrency:   r.BaseCurrency,
+		Rate:         decimal.NewFromInt(1).DivRound(r.Rate, 12),
+		EffectiveAt:  r.EffectiveAt,
+	}
+}
+
+func FromEnt(e *ent.FXRate) *FXRate {
+	if e == nil {
+		return nil
+	}
+
+	return &FXRate{
+		ID:            e.ID,
+		BaseCurrency:  e.BaseCurrency,
+		QuoteCurrency: e.QuoteCurrency,
+		Rate:          e.Rate,
+		EffectiveAt:   e.EffectiveAt,
+		Source:        types.FXRateSource(e.Source),
+		Metadata:      e.Metadata,
+		EnvironmentID: e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+		},
+	}
+}
+
+// FromEntList converts a list of ent.FXRate to domain FX rates
+func FromEntList(list []*ent.FXRate) []*FXRate {
+	if list == nil {
+		return nil
+	}
+	rates := make([]*FXRate, len(list))
+	for i, item := range list {
+		rates[i] = FromEnt(item)
+	}
+	return rates
+}
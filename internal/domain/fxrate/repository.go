@@ -0,0 +1,19 @@
+package fxrate
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Repository defines the interface for FX rate data access
+type Repository interface {
+	Create(ctx context.Context, rate *FXRate) error
+	Get(ctx context.Context, id string) (*FXRate, error)
+	// GetLatest returns the most recent published rate for the pair effective at or before the given time
+	GetLatest(ctx context.Context, baseCurrency, quoteCurrency string, at time.Time) (*FXRate, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, filter *types.FXRateFilter) ([]*FXRate, error)
+	Count(ctx context.Context, filter *types.FXRateFilter) (int, error)
+}
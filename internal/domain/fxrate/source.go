@@ -0,0 +1,67 @@
+package fxrate
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// SourceRate is a rate as published by a RateSource
+type SourceRate struct {
+	Base        string          `json:"base"`
+	Quote       string          `json:"quote"`
+	Rate        decimal.Decimal `json:"rate"`
+	EffectiveAt time.Time       `json:"effective_at"`
+}
+
+// RateSource fetches FX rates from an external provider
+type RateSource interface {
+	Source() types.FXRateSource
+	FetchRates(ctx context.Context) ([]SourceRate, error)
+}
+
+// FileRateSource reads rates from a local JSON file. It stands in for a market data
+// provider until one is integrated.
+type FileRateSource struct {
+	path string
+}
+
+// NewFileRateSource creates a rate source reading from the JSON file at path
+func NewFileRateSource(path string) *FileRateSource {
+	return &FileRateSource{path: path}
+}
+
+func (s *FileRateSource) Source() types.FXRateSource {
+	return types.FXRateSourceFile
+}
+
+func (s *FileRateSource) FetchRates(_ context.Context) ([]SourceRate, error) {
+	if s.path == "" {
+		return nil, ierr.NewError("fx rates file is not configured").
+			WithHint("Set fx.rates_file to sync rates from a file").
+			Mark(ierr.ErrValidation)
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHintf("Failed to read FX rates file %s", s.path).
+			Mark(ierr.ErrInternal)
+	}
+
+	var payload struct {
+		Rates []SourceRate `json:"rates"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, ierr.WithError(err).
+			WithHintf("FX rates file %s is not valid JSON", s.path).
+			Mark(ierr.ErrValidation)
+	}
+
+	return payload.Rates, nil
+}
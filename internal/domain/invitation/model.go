@@ -0,0 +1,76 @@
+package invitation
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Invitation asks the owner of an email address to join a tenant. The token sent to the
+// invitee is only stored hashed.
+type Invitation struct {
+	ID    string   `json:"id" db:"id"`
+	Email string   `json:"email" db:"email"`
+	Roles []string `json:"roles,omitempty" db:"roles"`
+	// TokenHash is the SHA-256 of the token sent to the invitee
+	TokenHash        string                 `json:"-" db:"token_hash"`
+	InvitationStatus types.InvitationStatus `json:"invitation_status" db:"invitation_status"`
+	ExpiresAt        time.Time              `json:"expires_at" db:"expires_at"`
+	RespondedAt      *time.Time             `json:"responded_at,omitempty" db:"responded_at"`
+	// AcceptedBy is the user that joined the tenant by accepting the invitation
+	AcceptedBy string `json:"accepted_by,omitempty" db:"accepted_by"`
+	types.BaseModel
+}
+
+// IsExpired reports whether a pending invitation can no longer be accepted
+func (i *Invitation) IsExpired() bool {
+	return i.InvitationStatus == types.InvitationStatusPending && time.Now().After(i.ExpiresAt)
+}
+
+// GetStatus returns the status of the invitation, reading pending invitations past their
+// expiry as expired
+func (i *Invitation) GetStatus() types.InvitationStatus {
+	if i.IsExpired() {
+		return types.InvitationStatusExpired
+	}
+	return i.InvitationStatus
+}
+
+// FromEnt converts an ent.Invitation to a domain Invitation
+func FromEnt(e *ent.Invitation) *Invitation {
+	if e == nil {
+		return nil
+	}
+
+	return &Invitation{
+		ID:               e.ID,
+		Email:            e.Email,
+		Roles:            e.Roles,
+		TokenHash:        e.TokenHash,
+		InvitationStatus: types.InvitationStatus(e.InvitationStatus),
+		ExpiresAt:        e.ExpiresAt,
+		RespondedAt:      e.RespondedAt,
+		AcceptedBy:       e.AcceptedBy,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+		},
+	}
+}
+
+// FromEntList converts a list of ent.Invitation to domain invitations
+func FromEntList(list []*ent.Invitation) []*Invitation {
+	if list == nil {
+		return nil
+	}
+	invitations := make([]*Invitation, len(list))
+	for i, item := range list {
+		invitations[i] = FromEnt(item)
+	}
+	return invitations
+}
@@ -0,0 +1,21 @@
+package invitation
+
+import (
+	"context"
+)
+
+// Repository defines the interface for invitation data access
+type Repository interface {
+	Create(ctx context.Context, invitation *Invitation) error
+	Get(ctx context.Context, id string) (*Invitation, error)
+	// Update stores the response to the invitation
+	Update(ctx context.Context, invitation *Invitation) error
+
+	// ListByTenant returns the invitations of the tenant in the context, newest first
+	ListByTenant(ctx context.Context) ([]*Invitation, error)
+	// GetPendingByEmail returns the pending invitation of the email to the tenant in the context
+	GetPendingByEmail(ctx context.Context, email string) (*Invitation, error)
+	// GetByTokenHash returns the invitation of any tenant the token was issued for.
+	// Invitees respond before they are signed in to the tenant.
+	GetByTokenHash(ctx context.Context, tokenHash string) (*Invitation, error)
+}
@@ -0,0 +1,50 @@
+package membership
+
+import (
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Membership gives a user access to a tenant other than the one their account was
+// created in. The tenant of the membership is its TenantID.
+type Membership struct {
+	ID     string   `json:"id" db:"id"`
+	UserID string   `json:"user_id" db:"user_id"`
+	Email  string   `json:"email" db:"email"`
+	Roles  []string `json:"roles,omitempty" db:"roles"`
+	types.BaseModel
+}
+
+// FromEnt converts an ent.TenantMembership to a domain Membership
+func FromEnt(e *ent.TenantMembership) *Membership {
+	if e == nil {
+		return nil
+	}
+
+	return &Membership{
+		ID:     e.ID,
+		UserID: e.UserID,
+		Email:  e.Email,
+		Roles:  e.Roles,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+		},
+	}
+}
+
+// FromEntList converts a list of ent.TenantMembership to domain memberships
+func FromEntList(list []*ent.TenantMembership) []*Membership {
+	if list == nil {
+		return nil
+	}
+	memberships := make([]*Membership, len(list))
+	for i, item := range list {
+		memberships[i] = FromEnt(item)
+	}
+	return memberships
+}
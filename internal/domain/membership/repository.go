@@ -0,0 +1,19 @@
+package membership
+
+import (
+	"context"
+)
+
+// Repository defines the interface for tenant membership data access
+type Repository interface {
+	Create(ctx context.Context, membership *Membership) error
+	// GetByUserID returns the membership of the user in the tenant in the context
+	GetByUserID(ctx context.Context, userID string) (*Membership, error)
+	UpdateRoles(ctx context.Context, id string, roles []string) error
+	Delete(ctx context.Context, id string) error
+
+	// ListByTenant returns the memberships of the tenant in the context, oldest first
+	ListByTenant(ctx context.Context) ([]*Membership, error)
+	// ListByUserID returns the memberships of the user in every tenant
+	ListByUserID(ctx context.Context, userID string) ([]*Membership, error)
+}
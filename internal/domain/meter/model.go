@@ -1,12 +1,14 @@
 package meter
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/flexprice/flexprice/ent"
 	"github.com/flexprice/flexprice/ent/schema"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 )
 
@@ -261,6 +263,23 @@ func (m *Meter) Validate() error {
 }
 
 // IsBucketedMaxMeter returns true if this is a max aggregation meter with bucket size
+// MatchesFilters reports whether event properties pass the filters of a meter. Every filter
+// must match, no filters match every event.
+func MatchesFilters(filters []Filter, properties map[string]interface{}) bool {
+	for _, filter := range filters {
+		propertyValue, exists := properties[filter.Key]
+		if !exists {
+			return false
+		}
+
+		// Convert property value to string for comparison
+		if !lo.Contains(filter.Values, fmt.Sprintf("%v", propertyValue)) {
+			return false
+		}
+	}
+	return true
+}
+
 func (m *Meter) IsBucketedMaxMeter() bool {
 	return m.Aggregation.Type == types.AggregationMax && m.Aggregation.BucketSize != ""
 }
@@ -0,0 +1,111 @@
+package pricebook
+
+import (
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// PriceBook localizes the base currency prices of plans and addons into other currencies,
+// through per-currency conversion rules and per-price overrides
+type PriceBook struct {
+	ID           string `json:"id" db:"id"`
+	Name         string `json:"name" db:"name"`
+	Description  string `json:"description,omitempty" db:"description"`
+	BaseCurrency string `json:"base_currency" db:"base_currency"`
+	// PlanIDs and AddonIDs restrict the book to these entities, empty applies to all
+	PlanIDs       []string                       `json:"plan_ids,omitempty" db:"plan_ids"`
+	AddonIDs      []string                       `json:"addon_ids,omitempty" db:"addon_ids"`
+	Currencies    []types.PriceBookCurrency      `json:"currencies,omitempty" db:"currencies"`
+	Overrides     []types.PriceBookPriceOverride `json:"overrides,omitempty" db:"overrides"`
+	Metadata      map[string]string              `json:"metadata,omitempty" db:"metadata"`
+	EnvironmentID string                         `json:"environment_id" db:"environment_id"`
+	types.BaseModel
+}
+
+// GetCurrency returns the conversion rule for a currency
+func (b *PriceBook) GetCurrency(currency string) (*types.PriceBookCurrency, bool) {
+	for i := range b.Currencies {
+		if types.IsMatchingCurrency(b.Currencies[i].Currency, currency) {
+			return &b.Currencies[i], true
+		}
+	}
+	return nil, false
+}
+
+// GetOverride returns the override of a price in a currency
+func (b *PriceBook) GetOverride(priceID, currency string) (*types.PriceBookPriceOverride, bool) {
+	for i := range b.Overrides {
+		if b.Overrides[i].PriceID == priceID && types.IsMatchingCurrency(b.Overrides[i].Currency, currency) {
+			return &b.Overrides[i], true
+		}
+	}
+	return nil, false
+}
+
+// SupportsCurrency reports whether the book has a rule or at least one override for the currency
+func (b *PriceBook) SupportsCurrency(currency string) bool {
+	if _, ok := b.GetCurrency(currency); ok {
+		return true
+	}
+	return lo.ContainsBy(b.Overrides, func(o types.PriceBookPriceOverride) bool {
+		return types.IsMatchingCurrency(o.Currency, currency)
+	})
+}
+
+// AppliesTo reports whether the book covers a plan or addon. The second return value is
+// true when the entity is listed explicitly rather than covered by an empty list.
+func (b *PriceBook) AppliesTo(entityType types.PriceEntityType, entityID string) (applies bool, explicit bool) {
+	var ids []string
+	switch entityType {
+	case types.PRICE_ENTITY_TYPE_PLAN:
+		ids = b.PlanIDs
+	case types.PRICE_ENTITY_TYPE_ADDON:
+		ids = b.AddonIDs
+	default:
+		return false, false
+	}
+	if len(ids) == 0 {
+		return true, false
+	}
+	return lo.Contains(ids, entityID), true
+}
+
+func FromEnt(e *ent.PriceBook) *PriceBook {
+	if e == nil {
+		return nil
+	}
+
+	return &PriceBook{
+		ID:            e.ID,
+		Name:          e.Name,
+		Description:   e.Description,
+		BaseCurrency:  e.BaseCurrency,
+		PlanIDs:       e.PlanIds,
+		AddonIDs:      e.AddonIds,
+		Currencies:    e.Currencies,
+		Overrides:     e.Overrides,
+		Metadata:      e.Metadata,
+		EnvironmentID: e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+		},
+	}
+}
+
+// FromEntList converts a list of ent.PriceBook to domain price books
+func FromEntList(list []*ent.PriceBook) []*PriceBook {
+	if list == nil {
+		return nil
+	}
+	books := make([]*PriceBook, len(list))
+	for i, item := range list {
+		books[i] = FromEnt(item)
+	}
+	return books
+}
@@ -0,0 +1,17 @@
+package pricebook
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Repository defines the interface for price book data access
+type Repository interface {
+	Create(ctx context.Context, book *PriceBook) error
+	Get(ctx context.Context, id string) (*PriceBook, error)
+	Update(ctx context.Context, book *PriceBook) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, filter *types.PriceBookFilter) ([]*PriceBook, error)
+	Count(ctx context.Context, filter *types.PriceBookFilter) (int, error)
+}
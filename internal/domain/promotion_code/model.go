@@ -0,0 +1,98 @@
+package promotion_code
+
+import (
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// PromotionCode is a customer-facing code that redeems a coupon
+type PromotionCode struct {
+	ID                        string            `json:"id" db:"id"`
+	CouponID                  string            `json:"coupon_id" db:"coupon_id"`
+	Code                      string            `json:"code" db:"code"`
+	Active                    bool              `json:"active" db:"active"`
+	MaxRedemptions            *int              `json:"max_redemptions,omitempty" db:"max_redemptions"`
+	MaxRedemptionsPerCustomer *int              `json:"max_redemptions_per_customer,omitempty" db:"max_redemptions_per_customer"`
+	TimesRedeemed             int               `json:"times_redeemed" db:"times_redeemed"`
+	FirstTimeCustomerOnly     bool              `json:"first_time_customer_only" db:"first_time_customer_only"`
+	ExpiresAt                 *time.Time        `json:"expires_at,omitempty" db:"expires_at"`
+	MinimumAmount             *decimal.Decimal  `json:"minimum_amount,omitempty" db:"minimum_amount" swaggertype:"string"`
+	MinimumAmountCurrency     string            `json:"minimum_amount_currency,omitempty" db:"minimum_amount_currency"`
+	Metadata                  map[string]string `json:"metadata,omitempty" db:"metadata"`
+	EnvironmentID             string            `json:"environment_id" db:"environment_id"`
+	types.BaseModel
+}
+
+// NormalizeCode returns the stored form of a code. Codes are matched case-insensitively.
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// IsExpired returns true if the code can no longer be redeemed because of its expiry
+func (p *PromotionCode) IsExpired(now time.Time) bool {
+	return p.ExpiresAt != nil && now.After(*p.ExpiresAt)
+}
+
+// IsExhausted returns true if the code has been redeemed as many times as allowed
+func (p *PromotionCode) IsExhausted() bool {
+	return p.MaxRedemptions != nil && p.TimesRedeemed >= *p.MaxRedemptions
+}
+
+// MeetsMinimumAmount returns true if an order amount in currency qualifies for the code.
+// Amounts in other currencies than the minimum's never qualify.
+func (p *PromotionCode) MeetsMinimumAmount(amount decimal.Decimal, currency string) bool {
+	if p.MinimumAmount == nil {
+		return true
+	}
+	if p.MinimumAmountCurrency != "" && !types.IsMatchingCurrency(p.MinimumAmountCurrency, currency) {
+		return false
+	}
+	return amount.GreaterThanOrEqual(*p.MinimumAmount)
+}
+
+func FromEnt(e *ent.PromotionCode) *PromotionCode {
+	if e == nil {
+		return nil
+	}
+
+	return &PromotionCode{
+		ID:                        e.ID,
+		CouponID:                  e.CouponID,
+		Code:                      e.Code,
+		Active:                    e.Active,
+		MaxRedemptions:            e.MaxRedemptions,
+		MaxRedemptionsPerCustomer: e.MaxRedemptionsPerCustomer,
+		TimesRedeemed:             e.TimesRedeemed,
+		FirstTimeCustomerOnly:     e.FirstTimeCustomerOnly,
+		ExpiresAt:                 e.ExpiresAt,
+		MinimumAmount:             e.MinimumAmount,
+		MinimumAmountCurrency:     lo.FromPtr(e.MinimumAmountCurrency),
+		Metadata:                  e.Metadata,
+		EnvironmentID:             e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+		},
+	}
+}
+
+// FromEntList converts a list of ent.PromotionCode to domain PromotionCodes
+func FromEntList(list []*ent.PromotionCode) []*PromotionCode {
+	if list == nil {
+		return nil
+	}
+	codes := make([]*PromotionCode, len(list))
+	for i, item := range list {
+		codes[i] = FromEnt(item)
+	}
+	return codes
+}
@@ -0,0 +1,20 @@
+package promotion_code
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Repository defines the interface for promotion code data access
+type Repository interface {
+	Create(ctx context.Context, code *PromotionCode) error
+	Get(ctx context.Context, id string) (*PromotionCode, error)
+	// GetByCode returns the published promotion code with the given code
+	GetByCode(ctx context.Context, code string) (*PromotionCode, error)
+	Update(ctx context.Context, code *PromotionCode) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, filter *types.PromotionCodeFilter) ([]*PromotionCode, error)
+	Count(ctx context.Context, filter *types.PromotionCodeFilter) (int, error)
+	IncrementRedemptions(ctx context.Context, id string) error
+}
@@ -0,0 +1,71 @@
+package rbacrole
+
+import (
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// RBACRole is a role a tenant defines on top of the built-in roles of roles.json
+type RBACRole struct {
+	ID          string `json:"id" db:"id"`
+	Name        string `json:"name" db:"name"`
+	Description string `json:"description,omitempty" db:"description"`
+	// Permissions are the actions the role grants per entity, * matches every entity or action
+	Permissions map[string][]string `json:"permissions" db:"permissions"`
+	// EnvironmentIDs are the environments the role grants permissions in, empty for all environments
+	EnvironmentIDs []string `json:"environment_ids,omitempty" db:"environment_ids"`
+	types.BaseModel
+}
+
+// Grants reports whether the role allows action on entity in the environment
+func (r *RBACRole) Grants(entity, action, environmentID string) bool {
+	if len(r.EnvironmentIDs) > 0 && !lo.Contains(r.EnvironmentIDs, environmentID) {
+		return false
+	}
+
+	for _, e := range []string{entity, types.RBACWildcard} {
+		actions, ok := r.Permissions[e]
+		if !ok {
+			continue
+		}
+		if lo.Contains(actions, action) || lo.Contains(actions, types.RBACWildcard) {
+			return true
+		}
+	}
+	return false
+}
+
+func FromEnt(e *ent.RBACRole) *RBACRole {
+	if e == nil {
+		return nil
+	}
+
+	return &RBACRole{
+		ID:             e.ID,
+		Name:           e.Name,
+		Description:    e.Description,
+		Permissions:    e.Permissions,
+		EnvironmentIDs: e.EnvironmentIds,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+		},
+	}
+}
+
+// FromEntList converts a list of ent.RBACRole to domain roles
+func FromEntList(list []*ent.RBACRole) []*RBACRole {
+	if list == nil {
+		return nil
+	}
+	roles := make([]*RBACRole, len(list))
+	for i, item := range list {
+		roles[i] = FromEnt(item)
+	}
+	return roles
+}
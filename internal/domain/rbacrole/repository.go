@@ -0,0 +1,17 @@
+package rbacrole
+
+import (
+	"context"
+)
+
+// Repository defines the interface for custom RBAC role data access
+type Repository interface {
+	Create(ctx context.Context, role *RBACRole) error
+	Get(ctx context.Context, id string) (*RBACRole, error)
+	Update(ctx context.Context, role *RBACRole) error
+	Delete(ctx context.Context, id string) error
+
+	// ListByTenant returns every role of the tenant in the context. It backs permission
+	// checks and is cached until a role of the tenant changes.
+	ListByTenant(ctx context.Context) ([]*RBACRole, error)
+}
@@ -1,6 +1,7 @@
 package secret
 
 import (
+	"net"
 	"time"
 
 	"github.com/flexprice/flexprice/ent"
@@ -21,6 +22,13 @@ type Secret struct {
 	ProviderData  map[string]string
 	Roles         []string // RBAC roles
 	UserType      string   // "user" or "service_account"
+	// Scopes restricts an API key to resource:action pairs such as events:write, empty for no restriction
+	Scopes []string
+	// AllowedIPs restricts an API key to IP addresses or CIDR ranges, empty for any address
+	AllowedIPs         []string
+	RateLimitPerMinute *int
+	// RotatedFromID is the key this key replaced when it was rotated
+	RotatedFromID *string
 	types.BaseModel
 }
 
@@ -43,6 +51,11 @@ func FromEnt(e *ent.Secret) *Secret {
 		ProviderData:  e.ProviderData,
 		Roles:         e.Roles,
 		UserType:      e.UserType,
+
+		Scopes:             e.Scopes,
+		AllowedIPs:         e.AllowedIps,
+		RateLimitPerMinute: e.RateLimitPerMinute,
+		RotatedFromID:      e.RotatedFromID,
 		BaseModel: types.BaseModel{
 			TenantID:  e.TenantID,
 			Status:    types.Status(e.Status),
@@ -93,3 +106,46 @@ func (s *Secret) IsExpired() bool {
 	}
 	return s.ExpiresAt.Before(time.Now())
 }
+
+// AllowsIP reports whether the API key may be used from an IP address
+func (s *Secret) AllowsIP(clientIP string) bool {
+	if len(s.AllowedIPs) == 0 {
+		return true
+	}
+
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return false
+	}
+	for _, allowed := range s.AllowedIPs {
+		if allowedIP := net.ParseIP(allowed); allowedIP != nil {
+			if allowedIP.Equal(ip) {
+				return true
+			}
+			continue
+		}
+		if _, network, err := net.ParseCIDR(allowed); err == nil && network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsScope reports whether the scopes of the API key allow an action on a resource
+func (s *Secret) AllowsScope(resource, action string) bool {
+	if len(s.Scopes) == 0 {
+		return true
+	}
+
+	for _, scope := range s.Scopes {
+		scopeResource, scopeAction, err := types.ParseAPIKeyScope(scope)
+		if err != nil {
+			continue
+		}
+		if (scopeResource == resource || scopeResource == types.RBACWildcard) &&
+			(scopeAction == action || scopeAction == types.RBACWildcard) {
+			return true
+		}
+	}
+	return false
+}
@@ -31,4 +31,10 @@ type Repository interface {
 
 	// UpdateLastUsed updates the last used timestamp of a secret
 	UpdateLastUsed(ctx context.Context, id string) error
+
+	// Update updates the name, expiry and restrictions of a secret
+	Update(ctx context.Context, secret *Secret) error
+
+	// UpdateRoles replaces the RBAC roles of a secret
+	UpdateRoles(ctx context.Context, id string, roles []string) error
 }
@@ -0,0 +1,188 @@
+package sso
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+
+	"github.com/flexprice/flexprice/ent"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+const (
+	// DomainVerificationHostPrefix is prepended to a domain to get the host of its
+	// verification TXT record
+	DomainVerificationHostPrefix = "_flexprice-sso."
+	// DomainVerificationValuePrefix is prepended to the verification token of a connection
+	// to get the value of its verification TXT records
+	DomainVerificationValuePrefix = "flexprice-sso-verification="
+)
+
+// Connection is a single sign-on identity provider configured for a tenant. Users whose
+// email domain matches one of its verified domains sign in to the dashboard through it.
+type Connection struct {
+	ID       string            `json:"id" db:"id"`
+	Name     string            `json:"name" db:"name"`
+	Protocol types.SSOProtocol `json:"protocol" db:"protocol"`
+	Domains  []string          `json:"domains,omitempty" db:"domains"`
+	Enabled  bool              `json:"enabled" db:"enabled"`
+	// VerifiedDomains are the domains the tenant proved it owns through a DNS TXT record
+	VerifiedDomains []string `json:"verified_domains,omitempty" db:"verified_domains"`
+	// DomainVerificationToken is published in the TXT records proving domain ownership
+	DomainVerificationToken string `json:"domain_verification_token,omitempty" db:"domain_verification_token"`
+
+	// OIDC settings
+	IssuerURL string `json:"issuer_url,omitempty" db:"issuer_url"`
+	ClientID  string `json:"client_id,omitempty" db:"client_id"`
+	// ClientSecret is stored encrypted
+	ClientSecret string   `json:"-" db:"client_secret"`
+	Scopes       []string `json:"scopes,omitempty" db:"scopes"`
+
+	// SAML settings
+	IdPMetadataURL string `json:"idp_metadata_url,omitempty" db:"idp_metadata_url"`
+	IdPMetadataXML string `json:"idp_metadata_xml,omitempty" db:"idp_metadata_xml"`
+
+	// RoleClaim is the claim or attribute holding the groups of the user
+	RoleClaim string `json:"role_claim,omitempty" db:"role_claim"`
+	// RoleMappings are the RBAC roles granted per identity provider group
+	RoleMappings map[string][]string `json:"role_mappings,omitempty" db:"role_mappings"`
+	// DefaultRoles are granted to every user signing in through the connection
+	DefaultRoles []string `json:"default_roles,omitempty" db:"default_roles"`
+	// JITProvisioning creates users on their first sign in
+	JITProvisioning bool `json:"jit_provisioning" db:"jit_provisioning"`
+
+	types.BaseModel
+}
+
+// Validate checks that the settings of the protocol of the connection are complete
+func (c *Connection) Validate() error {
+	if err := c.Protocol.Validate(); err != nil {
+		return err
+	}
+
+	if len(c.Domains) == 0 {
+		return ierr.NewError("domains are required").
+			WithHint("An SSO connection needs at least one email domain").
+			Mark(ierr.ErrValidation)
+	}
+
+	switch c.Protocol {
+	case types.SSOProtocolOIDC:
+		if c.IssuerURL == "" || c.ClientID == "" || c.ClientSecret == "" {
+			return ierr.NewError("oidc settings are incomplete").
+				WithHint("OIDC connections need an issuer URL, a client ID and a client secret").
+				Mark(ierr.ErrValidation)
+		}
+	case types.SSOProtocolSAML:
+		if c.IdPMetadataURL == "" && c.IdPMetadataXML == "" {
+			return ierr.NewError("saml settings are incomplete").
+				WithHint("SAML connections need the metadata URL or the metadata XML of the identity provider").
+				Mark(ierr.ErrValidation)
+		}
+	}
+	return nil
+}
+
+// GetRoleClaim returns the claim holding the groups of the user
+func (c *Connection) GetRoleClaim() string {
+	if c.RoleClaim == "" {
+		return types.DefaultSSORoleClaim
+	}
+	return c.RoleClaim
+}
+
+// RolesFor returns the RBAC roles of a user in the given identity provider groups
+func (c *Connection) RolesFor(groups []string) []string {
+	roles := append([]string{}, c.DefaultRoles...)
+	for _, group := range groups {
+		roles = append(roles, c.RoleMappings[group]...)
+	}
+	return lo.Uniq(roles)
+}
+
+// MatchesDomain reports whether the email belongs to one of the verified domains of the connection
+func (c *Connection) MatchesDomain(email string) bool {
+	domain := EmailDomain(email)
+	return domain != "" && lo.Contains(c.VerifiedDomains, domain)
+}
+
+// PendingDomains returns the domains of the connection that are not verified yet
+func (c *Connection) PendingDomains() []string {
+	return lo.Without(c.Domains, c.VerifiedDomains...)
+}
+
+// DomainVerificationHost returns the host of the TXT record verifying the domain
+func (c *Connection) DomainVerificationHost(domain string) string {
+	return DomainVerificationHostPrefix + domain
+}
+
+// DomainVerificationValue returns the value of the TXT records verifying the domains of the connection
+func (c *Connection) DomainVerificationValue() string {
+	return DomainVerificationValuePrefix + c.DomainVerificationToken
+}
+
+// NewDomainVerificationToken returns a random token for the verification TXT records of a connection
+func NewDomainVerificationToken() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// EmailDomain returns the lower-cased domain of an email address
+func EmailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return strings.ToLower(email[at+1:])
+}
+
+func FromEnt(e *ent.SSOConnection) *Connection {
+	if e == nil {
+		return nil
+	}
+
+	return &Connection{
+		ID:                      e.ID,
+		Name:                    e.Name,
+		Protocol:                types.SSOProtocol(e.Protocol),
+		Domains:                 e.Domains,
+		Enabled:                 e.Enabled,
+		VerifiedDomains:         e.VerifiedDomains,
+		DomainVerificationToken: e.DomainVerificationToken,
+		IssuerURL:               e.IssuerURL,
+		ClientID:                e.ClientID,
+		ClientSecret:            e.ClientSecret,
+		Scopes:                  e.Scopes,
+		IdPMetadataURL:          e.IdpMetadataURL,
+		IdPMetadataXML:          e.IdpMetadataXML,
+		RoleClaim:               e.RoleClaim,
+		RoleMappings:            e.RoleMappings,
+		DefaultRoles:            e.DefaultRoles,
+		JITProvisioning:         e.JitProvisioning,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+		},
+	}
+}
+
+// FromEntList converts a list of ent.SSOConnection to domain connections
+func FromEntList(list []*ent.SSOConnection) []*Connection {
+	if list == nil {
+		return nil
+	}
+	connections := make([]*Connection, len(list))
+	for i, item := range list {
+		connections[i] = FromEnt(item)
+	}
+	return connections
+}
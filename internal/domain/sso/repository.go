@@ -0,0 +1,20 @@
+package sso
+
+import (
+	"context"
+)
+
+// Repository defines the interface for single sign-on connection data access
+type Repository interface {
+	Create(ctx context.Context, connection *Connection) error
+	Get(ctx context.Context, id string) (*Connection, error)
+	Update(ctx context.Context, connection *Connection) error
+	Delete(ctx context.Context, id string) error
+
+	// ListByTenant returns every connection of the tenant in the context
+	ListByTenant(ctx context.Context) ([]*Connection, error)
+	// GetByID returns a connection of any tenant. Sign in flows start before the tenant is known.
+	GetByID(ctx context.Context, id string) (*Connection, error)
+	// GetByDomain returns the connection of any tenant that verified the email domain
+	GetByDomain(ctx context.Context, domain string) (*Connection, error)
+}
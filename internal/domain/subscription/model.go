@@ -136,6 +136,9 @@ type Subscription struct {
 	// SubscriptionType categorises the subscription within a customer hierarchy (standalone, parent, inherited).
 	SubscriptionType types.SubscriptionType `db:"subscription_type" json:"subscription_type"`
 
+	// SeatConfig configures seat licensing; nil when the subscription is not seat based
+	SeatConfig *types.SeatConfig `db:"seat_config" json:"seat_config,omitempty"`
+
 	types.BaseModel
 }
 
@@ -251,6 +254,7 @@ func GetSubscriptionFromEnt(sub *ent.Subscription) *Subscription {
 		ParentSubscriptionID: sub.ParentSubscriptionID,
 		PaymentTerms:         sub.PaymentTerms,
 		SubscriptionType:     types.SubscriptionType(sub.SubscriptionType),
+		SeatConfig:           sub.SeatConfig,
 		BaseModel: types.BaseModel{
 			TenantID:  sub.TenantID,
 			Status:    types.Status(sub.Status),
@@ -0,0 +1,100 @@
+package subscription
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionSeatChange records a change of the seat count of a subscription
+type SubscriptionSeatChange struct {
+	// ID is the unique identifier for the seat change
+	ID string `db:"id" json:"id"`
+
+	// SubscriptionID is the identifier for the subscription
+	SubscriptionID string `db:"subscription_id" json:"subscription_id"`
+
+	// LineItemID is the line item carrying the new seat count
+	LineItemID string `db:"line_item_id" json:"line_item_id"`
+
+	// PreviousSeats is the seat count before the change
+	PreviousSeats int64 `db:"previous_seats" json:"previous_seats"`
+
+	// NewSeats is the seat count after the change
+	NewSeats int64 `db:"new_seats" json:"new_seats"`
+
+	// Source is what triggered the change (api or meter)
+	Source types.SeatSource `db:"source" json:"source"`
+
+	// ProrationBehavior is how the change was billed
+	ProrationBehavior types.SeatProrationBehavior `db:"proration_behavior" json:"proration_behavior"`
+
+	// EffectiveDate is when the new seat count took effect
+	EffectiveDate time.Time `db:"effective_date" json:"effective_date"`
+
+	// ProrationAmount is the prorated charge (positive) or credit (negative) for the rest of the period
+	ProrationAmount decimal.Decimal `db:"proration_amount" json:"proration_amount" swaggertype:"string"`
+
+	// InvoiceID is the invoice that billed the proration, immediately or at true-up
+	InvoiceID *string `db:"invoice_id" json:"invoice_id,omitempty"`
+
+	// WalletTransactionID is the wallet credit issued for an immediate seat decrease
+	WalletTransactionID *string `db:"wallet_transaction_id" json:"wallet_transaction_id,omitempty"`
+
+	// EnvironmentID is the environment identifier for the seat change
+	EnvironmentID string `db:"environment_id" json:"environment_id"`
+
+	types.BaseModel
+}
+
+// IsPendingTrueUp returns true if the change accrued a charge that has not been invoiced yet
+func (c *SubscriptionSeatChange) IsPendingTrueUp() bool {
+	return c.ProrationBehavior == types.SeatProrationBehaviorTrueUp &&
+		c.InvoiceID == nil &&
+		c.ProrationAmount.GreaterThan(decimal.Zero)
+}
+
+// SubscriptionSeatChangeFromEnt converts an ent.SubscriptionSeatChange to domain SubscriptionSeatChange
+func SubscriptionSeatChangeFromEnt(e *ent.SubscriptionSeatChange) *SubscriptionSeatChange {
+	if e == nil {
+		return nil
+	}
+
+	return &SubscriptionSeatChange{
+		ID:                  e.ID,
+		SubscriptionID:      e.SubscriptionID,
+		LineItemID:          e.LineItemID,
+		PreviousSeats:       e.PreviousSeats,
+		NewSeats:            e.NewSeats,
+		Source:              e.Source,
+		ProrationBehavior:   e.ProrationBehavior,
+		EffectiveDate:       e.EffectiveDate,
+		ProrationAmount:     e.ProrationAmount,
+		InvoiceID:           e.InvoiceID,
+		WalletTransactionID: e.WalletTransactionID,
+		EnvironmentID:       e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+		},
+	}
+}
+
+// SubscriptionSeatChangeListFromEnt converts a list of ent.SubscriptionSeatChange to domain seat changes
+func SubscriptionSeatChangeListFromEnt(changes []*ent.SubscriptionSeatChange) []*SubscriptionSeatChange {
+	if changes == nil {
+		return nil
+	}
+
+	result := make([]*SubscriptionSeatChange, len(changes))
+	for i, c := range changes {
+		result[i] = SubscriptionSeatChangeFromEnt(c)
+	}
+	return result
+}
@@ -0,0 +1,28 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// SubscriptionSeatChangeRepository defines the interface for subscription seat change operations
+type SubscriptionSeatChangeRepository interface {
+	// Create records a seat change
+	Create(ctx context.Context, change *SubscriptionSeatChange) error
+
+	// Get retrieves a seat change by ID
+	Get(ctx context.Context, id string) (*SubscriptionSeatChange, error)
+
+	// SetInvoiceID links a seat change to the invoice that billed it
+	SetInvoiceID(ctx context.Context, id string, invoiceID string) error
+
+	// SetWalletTransactionID links a seat change to the wallet credit issued for it
+	SetWalletTransactionID(ctx context.Context, id string, walletTransactionID string) error
+
+	// List retrieves seat changes based on filter
+	List(ctx context.Context, filter *types.SubscriptionSeatChangeFilter) ([]*SubscriptionSeatChange, error)
+
+	// Count returns the count of seat changes matching the filter
+	Count(ctx context.Context, filter *types.SubscriptionSeatChangeFilter) (int, error)
+}
@@ -2,6 +2,7 @@ package systemevent
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	flexent "github.com/flexprice/flexprice/ent"
@@ -17,11 +18,27 @@ type ListStaleUndeliveredWebhooksParams struct {
 	AllowedEventTypes []string
 }
 
+// ListWebhooksInRangeParams configures the system events selected for bulk redelivery.
+type ListWebhooksInRangeParams struct {
+	TenantID      string
+	EnvironmentID string
+	// From is inclusive and To is exclusive
+	From       time.Time
+	To         time.Time
+	EventNames []string
+	// UndeliveredOnly skips events that were delivered to every endpoint
+	UndeliveredOnly bool
+	Limit           int
+}
+
 // Repository defines the data-access contract for system events.
 type Repository interface {
 	GetByID(ctx context.Context, tenantID, environmentID, id string) (*flexent.SystemEvent, error)
 	ListStaleUndeliveredWebhooks(ctx context.Context, params ListStaleUndeliveredWebhooksParams) ([]*flexent.SystemEvent, error)
+	ListWebhooksInRange(ctx context.Context, params ListWebhooksInRangeParams) ([]*flexent.SystemEvent, error)
+	GetUndeliveredPredecessor(ctx context.Context, event *types.WebhookEvent, maxAttempts int) (*flexent.SystemEvent, error)
 	OnConsumed(ctx context.Context, event *types.WebhookEvent) error
 	OnDelivered(ctx context.Context, eventID string, webhookMessageID *string) error
 	OnFailed(ctx context.Context, eventID, reason string) error
+	OnSnapshot(ctx context.Context, eventID string, snapshot json.RawMessage) error
 }
@@ -22,6 +22,7 @@ type Task struct {
 	SuccessfulRecords int                    `json:"successful_records"`
 	FailedRecords     int                    `json:"failed_records"`
 	ErrorSummary      *string                `json:"error_summary"`
+	ErrorReport       *string                `json:"-"`
 	Metadata          map[string]interface{} `json:"metadata"`
 	StartedAt         *time.Time             `json:"started_at"`
 	CompletedAt       *time.Time             `json:"completed_at"`
@@ -51,6 +52,7 @@ func FromEnt(e *ent.Task) *Task {
 		SuccessfulRecords: e.SuccessfulRecords,
 		FailedRecords:     e.FailedRecords,
 		ErrorSummary:      e.ErrorSummary,
+		ErrorReport:       e.ErrorReport,
 		Metadata:          e.Metadata,
 		StartedAt:         e.StartedAt,
 		CompletedAt:       e.CompletedAt,
@@ -11,4 +11,8 @@ type Repository interface {
 	GetByID(ctx context.Context, id string) (*User, error)
 	GetByEmail(ctx context.Context, email string) (*User, error)
 	ListByFilter(ctx context.Context, filter *types.UserFilter) ([]*User, int64, error)
+	// UpdateRoles replaces the RBAC roles of a user
+	UpdateRoles(ctx context.Context, id string, roles []string) error
+	// Delete archives a user of the tenant in the context
+	Delete(ctx context.Context, id string) error
 }
@@ -40,6 +40,10 @@ type WalletOperation struct {
 	// For Expiry Credits, this is the ID of the parent credit transaction
 	// so that we can use the same credits for the expiry debit transaction
 	ParentCreditTxID string `json:"-"`
+	// FX is set when the debit settles an amount in another currency; Amount stays in the wallet
+	// currency and SettlementAmount is the amount settled in FX.ToCurrency
+	FX               *types.FXConversion `json:"-"`
+	SettlementAmount decimal.Decimal     `json:"-"`
 }
 
 func (w *WalletOperation) Validate() error {
@@ -36,6 +36,15 @@ type Transaction struct {
 	// topup_conversion_rate is the conversion rate for the topup to the currency
 	TopupConversionRate *decimal.Decimal `db:"topup_conversion_rate" json:"topup_conversion_rate,omitempty" swaggertype:"string"`
 
+	// fx_rate is the rate from the wallet currency to the settlement currency when a debit
+	// settled an invoice in another currency
+	FXRate   *decimal.Decimal `db:"fx_rate" json:"fx_rate,omitempty" swaggertype:"string"`
+	FXRateID *string          `db:"fx_rate_id" json:"fx_rate_id,omitempty"`
+
+	// settlement_currency and settlement_amount are the invoice currency and the amount in it a converted debit settled
+	SettlementCurrency *string          `db:"settlement_currency" json:"settlement_currency,omitempty"`
+	SettlementAmount   *decimal.Decimal `db:"settlement_amount" json:"settlement_amount,omitempty" swaggertype:"string"`
+
 	IdempotencyKey string `db:"idempotency_key" json:"idempotency_key"`
 	EnvironmentID  string `db:"environment_id" json:"environment_id"`
 	types.BaseModel
@@ -100,6 +109,10 @@ func (t *Transaction) ToEnt() *ent.WalletTransaction {
 		Currency:            t.Currency,
 		ConversionRate:      t.ConversionRate,
 		TopupConversionRate: t.TopupConversionRate,
+		FxRate:              t.FXRate,
+		FxRateID:            t.FXRateID,
+		SettlementCurrency:  t.SettlementCurrency,
+		SettlementAmount:    t.SettlementAmount,
 		EnvironmentID:       t.EnvironmentID,
 		TenantID:            t.TenantID,
 		Status:              string(t.Status),
@@ -137,6 +150,10 @@ func TransactionFromEnt(e *ent.WalletTransaction) *Transaction {
 		Priority:            e.Priority,
 		ConversionRate:      e.ConversionRate,
 		TopupConversionRate: e.TopupConversionRate,
+		FXRate:              e.FxRate,
+		FXRateID:            e.FxRateID,
+		SettlementCurrency:  e.SettlementCurrency,
+		SettlementAmount:    e.SettlementAmount,
 		EnvironmentID:       e.EnvironmentID,
 		BaseModel: types.BaseModel{
 			TenantID:  e.TenantID,
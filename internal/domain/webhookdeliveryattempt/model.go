@@ -0,0 +1,71 @@
+package webhookdeliveryattempt
+
+import (
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// WebhookDeliveryAttempt is a single native delivery of a system event to a webhook endpoint
+type WebhookDeliveryAttempt struct {
+	ID                string                 `json:"id" db:"id"`
+	SystemEventID     string                 `json:"system_event_id" db:"system_event_id"`
+	WebhookEndpointID string                 `json:"webhook_endpoint_id" db:"webhook_endpoint_id"`
+	EventName         types.WebhookEventName `json:"event_name" db:"event_name"`
+	// AttemptNumber is 1 for the first delivery of the event to the endpoint
+	AttemptNumber  int               `json:"attempt_number" db:"attempt_number"`
+	RequestURL     string            `json:"request_url" db:"request_url"`
+	RequestHeaders map[string]string `json:"request_headers,omitempty" db:"request_headers"`
+	RequestBody    string            `json:"request_body,omitempty" db:"request_body"`
+	// ResponseStatusCode is nil when the endpoint could not be reached
+	ResponseStatusCode *int    `json:"response_status_code,omitempty" db:"response_status_code"`
+	ResponseBody       string  `json:"response_body,omitempty" db:"response_body"`
+	LatencyMs          int64   `json:"latency_ms" db:"latency_ms"`
+	Success            bool    `json:"success" db:"success"`
+	ErrorMessage       *string `json:"error_message,omitempty" db:"error_message"`
+	EnvironmentID      string  `json:"environment_id" db:"environment_id"`
+	types.BaseModel
+}
+
+// FromEnt converts an ent.WebhookDeliveryAttempt to a domain WebhookDeliveryAttempt
+func FromEnt(e *ent.WebhookDeliveryAttempt) *WebhookDeliveryAttempt {
+	if e == nil {
+		return nil
+	}
+
+	return &WebhookDeliveryAttempt{
+		ID:                 e.ID,
+		SystemEventID:      e.SystemEventID,
+		WebhookEndpointID:  e.WebhookEndpointID,
+		EventName:          e.EventName,
+		AttemptNumber:      e.AttemptNumber,
+		RequestURL:         e.RequestURL,
+		RequestHeaders:     e.RequestHeaders,
+		RequestBody:        e.RequestBody,
+		ResponseStatusCode: e.ResponseStatusCode,
+		ResponseBody:       e.ResponseBody,
+		LatencyMs:          e.LatencyMs,
+		Success:            e.Success,
+		ErrorMessage:       e.ErrorMessage,
+		EnvironmentID:      e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+		},
+	}
+}
+
+// FromEntList converts a list of ent.WebhookDeliveryAttempt to domain webhook delivery attempts
+func FromEntList(list []*ent.WebhookDeliveryAttempt) []*WebhookDeliveryAttempt {
+	if list == nil {
+		return nil
+	}
+	attempts := make([]*WebhookDeliveryAttempt, len(list))
+	for i, item := range list {
+		attempts[i] = FromEnt(item)
+	}
+	return attempts
+}
@@ -0,0 +1,15 @@
+package webhookdeliveryattempt
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Repository defines the interface for webhook delivery attempt data access
+type Repository interface {
+	Create(ctx context.Context, attempt *WebhookDeliveryAttempt) error
+	Get(ctx context.Context, id string) (*WebhookDeliveryAttempt, error)
+	List(ctx context.Context, filter *types.WebhookDeliveryAttemptFilter) ([]*WebhookDeliveryAttempt, error)
+	Count(ctx context.Context, filter *types.WebhookDeliveryAttemptFilter) (int, error)
+}
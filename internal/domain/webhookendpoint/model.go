@@ -0,0 +1,119 @@
+package webhookendpoint
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// WebhookEndpoint is a URL of an environment that receives native webhook deliveries
+// for the events it subscribes to
+type WebhookEndpoint struct {
+	ID          string `json:"id" db:"id"`
+	URL         string `json:"url" db:"url"`
+	Description string `json:"description,omitempty" db:"description"`
+	// EventTypes are event names or wildcard patterns such as invoice.* or *
+	EventTypes    []string          `json:"event_types" db:"event_types"`
+	Headers       map[string]string `json:"headers,omitempty" db:"headers"`
+	Enabled       bool              `json:"enabled" db:"enabled"`
+	Metadata      map[string]string `json:"metadata,omitempty" db:"metadata"`
+	EnvironmentID string            `json:"environment_id" db:"environment_id"`
+	// SigningSecret is encrypted and never serialized
+	SigningSecret string `json:"-" db:"signing_secret"`
+	// PreviousSigningSecret is the encrypted secret replaced by the last rotation. Deliveries
+	// are signed with it as well until PreviousSigningSecretExpiresAt.
+	PreviousSigningSecret          *string    `json:"-" db:"previous_signing_secret"`
+	PreviousSigningSecretExpiresAt *time.Time `json:"previous_signing_secret_expires_at,omitempty" db:"previous_signing_secret_expires_at"`
+	// ConsecutiveFailures counts the deliveries that failed since the last successful one
+	ConsecutiveFailures int `json:"consecutive_failures" db:"consecutive_failures"`
+	// FailingSince is the first failed delivery since the last successful one
+	FailingSince  *time.Time `json:"failing_since,omitempty" db:"failing_since"`
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty" db:"last_success_at"`
+	LastFailureAt *time.Time `json:"last_failure_at,omitempty" db:"last_failure_at"`
+	// DisabledReason is set when the endpoint was disabled automatically
+	DisabledReason *string `json:"disabled_reason,omitempty" db:"disabled_reason"`
+	// PayloadVersion is the schema version deliveries to the endpoint are rendered in
+	PayloadVersion types.WebhookPayloadVersion `json:"payload_version" db:"payload_version"`
+	// PayloadMode is thin when deliveries carry the ids of the event and entity only
+	PayloadMode types.WebhookPayloadMode `json:"payload_mode" db:"payload_mode"`
+	types.BaseModel
+}
+
+// ActivePreviousSigningSecret returns the encrypted previous secret while it is still valid
+func (e *WebhookEndpoint) ActivePreviousSigningSecret(at time.Time) (string, bool) {
+	if e.PreviousSigningSecret == nil || e.PreviousSigningSecretExpiresAt == nil || !at.Before(*e.PreviousSigningSecretExpiresAt) {
+		return "", false
+	}
+	return *e.PreviousSigningSecret, true
+}
+
+// FailingLongerThan reports whether every delivery since at least window before at has failed
+func (e *WebhookEndpoint) FailingLongerThan(window time.Duration, at time.Time) bool {
+	return e.FailingSince != nil && !e.FailingSince.After(at.Add(-window))
+}
+
+// ResetHealth clears the delivery failure streak and the reason the endpoint was disabled
+func (e *WebhookEndpoint) ResetHealth() {
+	e.ConsecutiveFailures = 0
+	e.FailingSince = nil
+	e.DisabledReason = nil
+}
+
+// Subscribes reports whether the endpoint receives an event
+func (e *WebhookEndpoint) Subscribes(eventName types.WebhookEventName) bool {
+	return lo.ContainsBy(e.EventTypes, func(pattern string) bool {
+		return types.MatchWebhookEventPattern(pattern, eventName)
+	})
+}
+
+func FromEnt(e *ent.WebhookEndpoint) *WebhookEndpoint {
+	if e == nil {
+		return nil
+	}
+
+	return &WebhookEndpoint{
+		ID:            e.ID,
+		URL:           e.URL,
+		Description:   e.Description,
+		EventTypes:    e.EventTypes,
+		Headers:       e.Headers,
+		Enabled:       e.Enabled,
+		Metadata:      e.Metadata,
+		EnvironmentID: e.EnvironmentID,
+
+		SigningSecret:                  e.SigningSecret,
+		PreviousSigningSecret:          e.PreviousSigningSecret,
+		PreviousSigningSecretExpiresAt: e.PreviousSigningSecretExpiresAt,
+
+		ConsecutiveFailures: e.ConsecutiveFailures,
+		FailingSince:        e.FailingSince,
+		LastSuccessAt:       e.LastSuccessAt,
+		LastFailureAt:       e.LastFailureAt,
+		DisabledReason:      e.DisabledReason,
+
+		PayloadVersion: types.WebhookPayloadVersion(e.PayloadVersion),
+		PayloadMode:    types.WebhookPayloadMode(e.PayloadMode),
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+		},
+	}
+}
+
+// FromEntList converts a list of ent.WebhookEndpoint to domain webhook endpoints
+func FromEntList(list []*ent.WebhookEndpoint) []*WebhookEndpoint {
+	if list == nil {
+		return nil
+	}
+	endpoints := make([]*WebhookEndpoint, len(list))
+	for i, item := range list {
+		endpoints[i] = FromEnt(item)
+	}
+	return endpoints
+}
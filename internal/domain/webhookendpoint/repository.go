@@ -0,0 +1,23 @@
+package webhookendpoint
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Repository defines the interface for webhook endpoint data access
+type Repository interface {
+	Create(ctx context.Context, endpoint *WebhookEndpoint) error
+	Get(ctx context.Context, id string) (*WebhookEndpoint, error)
+	Update(ctx context.Context, endpoint *WebhookEndpoint) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, filter *types.WebhookEndpointFilter) ([]*WebhookEndpoint, error)
+	Count(ctx context.Context, filter *types.WebhookEndpointFilter) (int, error)
+
+	// RecordDeliverySuccess ends the failure streak of the endpoint
+	RecordDeliverySuccess(ctx context.Context, id string, at time.Time) error
+	// RecordDeliveryFailure extends the failure streak of the endpoint and returns the updated endpoint
+	RecordDeliveryFailure(ctx context.Context, id string, at time.Time) (*WebhookEndpoint, error)
+}
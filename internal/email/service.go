@@ -35,6 +35,44 @@ var emailTemplates = map[string]string{
     Co-founder & CEO, Flexprice
     </p>
 </body>
+</html>`,
+	"invitation-email.html": `<!DOCTYPE html>
+<html>
+<head>
+    <meta http-equiv="Content-Type" content="text/html; charset=UTF-8" />
+    <title>You're invited to {{.tenant_name}} on Flexprice</title>
+</head>
+<body style="font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, Helvetica, Arial, sans-serif; font-size: 14px; line-height: 1.6; color: #333;">
+    <p>Hi,</p>
+    <p>{{.inviter}} invited you to join <strong>{{.tenant_name}}</strong> on <a href="https://flexprice.io">Flexprice</a>.</p>
+
+    <p><a href="{{.accept_url}}">Accept or decline the invitation</a></p>
+
+    <p>The invitation expires on {{.expires_at}}. If you were not expecting it, you can ignore this email.</p>
+
+    <br/>
+
+    <p>The Flexprice team</p>
+</body>
+</html>`,
+	"webhook-endpoint-disabled-email.html": `<!DOCTYPE html>
+<html>
+<head>
+    <meta http-equiv="Content-Type" content="text/html; charset=UTF-8" />
+    <title>Your Flexprice webhook endpoint was disabled</title>
+</head>
+<body style="font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, Helvetica, Arial, sans-serif; font-size: 14px; line-height: 1.6; color: #333;">
+    <p>Hi,</p>
+    <p>Flexprice stopped delivering webhooks to <strong>{{.endpoint_url}}</strong> (endpoint {{.webhook_endpoint_id}}, environment {{.environment_id}}).</p>
+
+    <p>{{.reason}}.</p>
+
+    <p>Once the endpoint accepts deliveries again, enable it from the dashboard or the API. Events created while it was disabled can be redelivered.</p>
+
+    <br/>
+
+    <p>The Flexprice team</p>
+</body>
 </html>`,
 }
 
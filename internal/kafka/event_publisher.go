@@ -57,7 +57,7 @@ func (p *EventPublisher) Publish(ctx context.Context, event *events.Event) error
 	}
 	msg.Metadata.Set("tenant_id", event.TenantID)
 	msg.Metadata.Set("environment_id", event.EnvironmentID)
-	msg.Metadata.Set("partition_key", partitionKey)
+	msg.Metadata.Set(PartitionKeyMetadataKey, partitionKey)
 	/*
 		TODO: Once we support multiple event import integrations (e.g., S3, Postgres, etc.),
 		route those imported events to the lazy topic.
@@ -0,0 +1,30 @@
+package kafka
+
+import (
+	"github.com/Shopify/sarama"
+	"github.com/ThreeDotsLabs/watermill-kafka/v2/pkg/kafka"
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+// PartitionKeyMetadataKey is the message metadata the kafka message key is taken from
+const PartitionKeyMetadataKey = "partition_key"
+
+// partitionKeyMarshaler marshals like kafka.DefaultMarshaler and uses the partition_key metadata
+// as the message key, so messages with the same key land on the same partition in order.
+// Messages without a partition key keep an empty key and are spread across partitions.
+type partitionKeyMarshaler struct {
+	kafka.DefaultMarshaler
+}
+
+func (m partitionKeyMarshaler) Marshal(topic string, msg *message.Message) (*sarama.ProducerMessage, error) {
+	kafkaMsg, err := m.DefaultMarshaler.Marshal(topic, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if key := msg.Metadata.Get(PartitionKeyMetadataKey); key != "" {
+		kafkaMsg.Key = sarama.StringEncoder(key)
+	}
+
+	return kafkaMsg, nil
+}
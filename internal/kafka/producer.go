@@ -12,6 +12,17 @@ type Producer struct {
 }
 
 func NewProducer(cfg *config.Configuration) (*Producer, error) {
+	return newProducer(cfg, kafka.DefaultMarshaler{})
+}
+
+// NewPartitionKeyProducer creates a producer that keys messages by their partition_key metadata,
+// so messages with the same key are consumed in order. It is meant for publishers that set the
+// key, messages of the shared producer keep being spread across partitions.
+func NewPartitionKeyProducer(cfg *config.Configuration) (*Producer, error) {
+	return newProducer(cfg, partitionKeyMarshaler{})
+}
+
+func newProducer(cfg *config.Configuration, marshaler kafka.Marshaler) (*Producer, error) {
 	// enableDebugLogs allows watermill DEBUG messages in debug mode.
 	// TRACE is never enabled — it logs every individual message sent/received, which is too noisy.
 	enableDebugLogs := cfg.Logging.Level == types.LogLevelDebug
@@ -26,7 +37,7 @@ func NewProducer(cfg *config.Configuration) (*Producer, error) {
 	publisher, err := kafka.NewPublisher(
 		kafka.PublisherConfig{
 			Brokers:               cfg.Kafka.Brokers,
-			Marshaler:             kafka.DefaultMarshaler{},
+			Marshaler:             marshaler,
 			OverwriteSaramaConfig: saramaConfig,
 		},
 		watermill.NewStdLogger(enableDebugLogs, false),
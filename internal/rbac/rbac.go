@@ -1,13 +1,28 @@
 package rbac
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
+	"time"
 
+	"github.com/flexprice/flexprice/internal/cache"
 	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/rbacrole"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/types"
 )
 
+// RoleAdmin is the built-in role with access to every entity and action. Dashboard users
+// and API keys from the config are authenticated with it.
+const RoleAdmin = "admin"
+
+// customRolesTTL bounds how long a node trusts the cached custom roles of a tenant
+const customRolesTTL = time.Minute
+
 // Service handles permission checks with set-based lookups
 type RBACService struct {
 	// Fast lookup for permission checks (hot path - O(1))
@@ -15,6 +30,17 @@ type RBACService struct {
 
 	// Full role definitions with metadata (for API responses)
 	roles map[string]*Role
+
+	// Custom roles tenants define on top of roles.json, nil when only built-in roles are used
+	roleRepo rbacrole.Repository
+
+	// Deny principals without roles instead of granting them full access
+	denyByDefault bool
+
+	// cacheType selects where custom roles are cached, Redis shares them across nodes
+	cacheType cache.CacheType
+
+	logger *logger.Logger
 }
 
 // Role represents a role with metadata
@@ -23,10 +49,14 @@ type Role struct {
 	Name        string              `json:"name"`
 	Description string              `json:"description"`
 	Permissions map[string][]string `json:"permissions"`
+	// EnvironmentIDs limits a custom role to some environments, empty for all environments
+	EnvironmentIDs []string `json:"environment_ids,omitempty"`
+	// Custom is true for roles a tenant defined, false for the built-in roles of roles.json
+	Custom bool `json:"custom"`
 }
 
 // NewRBACService loads roles.json from config and optimizes for fast lookups
-func NewRBACService(cfg *config.Configuration) (*RBACService, error) {
+func NewRBACService(cfg *config.Configuration, roleRepo rbacrole.Repository, logger *logger.Logger) (*RBACService, error) {
 	// Get roles path from config or use default
 	configPath := cfg.RBAC.RolesConfigPath
 	if configPath == "" {
@@ -63,25 +93,54 @@ func NewRBACService(cfg *config.Configuration) (*RBACService, error) {
 	}
 
 	return &RBACService{
-		permissions: permissions,
-		roles:       rawConfig,
+		permissions:   permissions,
+		roles:         rawConfig,
+		roleRepo:      roleRepo,
+		denyByDefault: cfg.RBAC.DenyByDefault,
+		cacheType:     cache.CacheType(cfg.Cache.Type),
+		logger:        logger,
 	}, nil
 }
 
-// HasPermission checks if any of the user's roles grant permission
-// Complexity: O(roles) with O(1) lookups = ~3 operations for typical use
-// NOTE: Never touches role.Name or role.Description - zero overhead
-func (s *RBACService) HasPermission(roles []string, entity string, action string) bool {
-	// Empty roles = full access (backward compatibility)
+// HasPermission checks if any of the principal's roles grant permission in the environment
+// of the context. Built-in roles are checked first with O(1) lookups, the custom roles of the
+// tenant are only loaded (from cache) when a role is not built-in.
+func (s *RBACService) HasPermission(ctx context.Context, roles []string, entity string, action string) bool {
+	// Empty roles = full access (backward compatibility) unless deny by default is enabled
 	if len(roles) == 0 {
-		return true
+		return !s.denyByDefault
 	}
 
 	// Check each role - if ANY role grants permission, allow
+	customRoleIDs := make([]string, 0)
 	for _, role := range roles {
-		if s.permissions[role] != nil &&
-			s.permissions[role][entity] != nil &&
-			s.permissions[role][entity][action] {
+		if _, builtIn := s.permissions[role]; !builtIn {
+			customRoleIDs = append(customRoleIDs, role)
+			continue
+		}
+		if s.builtInRoleGrants(role, entity, action) {
+			return true
+		}
+	}
+
+	if len(customRoleIDs) == 0 || s.roleRepo == nil {
+		return false
+	}
+
+	customRoles, err := s.customRoles(ctx)
+	if err != nil {
+		s.logger.Errorw("failed to load custom roles, denying permission",
+			"error", err,
+			"tenant_id", types.GetTenantID(ctx),
+			"entity", entity,
+			"action", action,
+		)
+		return false
+	}
+
+	environmentID := types.GetEnvironmentID(ctx)
+	for _, id := range customRoleIDs {
+		if role, ok := customRoles[id]; ok && role.Grants(entity, action, environmentID) {
 			return true
 		}
 	}
@@ -89,24 +148,160 @@ func (s *RBACService) HasPermission(roles []string, entity string, action string
 	return false
 }
 
-// ValidateRole checks if role exists in definitions
+// builtInRoleGrants checks a built-in role, honouring the * wildcard for entities and actions
+func (s *RBACService) builtInRoleGrants(role, entity, action string) bool {
+	for _, e := range []string{entity, types.RBACWildcard} {
+		actions := s.permissions[role][e]
+		if actions[action] || actions[types.RBACWildcard] {
+			return true
+		}
+	}
+	return false
+}
+
+// customRoles returns the custom roles of the tenant in the context by ID
+func (s *RBACService) customRoles(ctx context.Context) (map[string]*rbacrole.RBACRole, error) {
+	result := make(map[string]*rbacrole.RBACRole)
+	if s.roleRepo == nil {
+		return result, nil
+	}
+
+	roles, err := s.listCustomRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles {
+		result[role.ID] = role
+	}
+	return result, nil
+}
+
+// listCustomRoles returns the custom roles of the tenant in the context from the cache,
+// loading them when they are not cached
+func (s *RBACService) listCustomRoles(ctx context.Context) ([]*rbacrole.RBACRole, error) {
+	rolesCache := s.rolesCache()
+	key := customRolesKey(ctx)
+	if value, found := rolesCache.ForceCacheGet(ctx, key); found {
+		var roles []*rbacrole.RBACRole
+		if err := json.Unmarshal([]byte(fmt.Sprint(value)), &roles); err == nil {
+			return roles, nil
+		}
+	}
+
+	roles, err := s.roleRepo.ListByTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if data, err := json.Marshal(roles); err == nil {
+		rolesCache.ForceCacheSet(ctx, key, string(data), customRolesTTL)
+	}
+	return roles, nil
+}
+
+// InvalidateCustomRoles drops the cached custom roles of the tenant in the context, so
+// created, changed and deleted roles apply on the next request
+func (s *RBACService) InvalidateCustomRoles(ctx context.Context) {
+	s.rolesCache().ForceCacheDelete(ctx, customRolesKey(ctx))
+}
+
+// rolesCache prefers the shared Redis cache so role changes reach every node
+func (s *RBACService) rolesCache() cache.Cache {
+	if s.cacheType == cache.CacheTypeRedis {
+		if redisCache := cache.GetRedisCache(); redisCache != nil {
+			return redisCache
+		}
+	}
+	return cache.GetInMemoryCache()
+}
+
+func customRolesKey(ctx context.Context) string {
+	return cache.GenerateKey(cache.PrefixRBACRoles, types.GetTenantID(ctx))
+}
+
+// ValidateRole checks if role exists in the built-in definitions
 func (s *RBACService) ValidateRole(roleName string) bool {
 	_, exists := s.permissions[roleName]
 	return exists
 }
 
-// GetAllRoles returns all roles with metadata (for API endpoint)
+// ValidateRoles checks that every role is a built-in role or a custom role of the tenant
+func (s *RBACService) ValidateRoles(ctx context.Context, roles []string) error {
+	var customRoles map[string]*rbacrole.RBACRole
+	for _, role := range roles {
+		if s.ValidateRole(role) {
+			continue
+		}
+
+		if customRoles == nil {
+			var err error
+			if customRoles, err = s.customRoles(ctx); err != nil {
+				return err
+			}
+		}
+		if _, ok := customRoles[role]; !ok {
+			return ierr.NewError("invalid role").
+				WithHint("Role '" + role + "' does not exist").
+				WithReportableDetails(map[string]interface{}{"role": role}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+	return nil
+}
+
+// ListRoles returns the built-in roles and the custom roles of the tenant with metadata
 // This is called rarely (only when fetching available roles for UI)
-func (s *RBACService) ListRoles() []*Role {
+func (s *RBACService) ListRoles(ctx context.Context) ([]*Role, error) {
 	result := make([]*Role, 0, len(s.roles))
 	for _, role := range s.roles {
 		result = append(result, role)
 	}
-	return result
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	customRoles, err := s.customRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	custom := make([]*Role, 0, len(customRoles))
+	for _, role := range customRoles {
+		custom = append(custom, FromCustomRole(role))
+	}
+	sort.Slice(custom, func(i, j int) bool {
+		return custom[i].Name < custom[j].Name
+	})
+
+	return append(result, custom...), nil
 }
 
-// GetRole returns a specific role with metadata
-func (s *RBACService) GetRole(roleID string) (*Role, bool) {
-	role, exists := s.roles[roleID]
-	return role, exists
+// GetRole returns a built-in or custom role with metadata
+func (s *RBACService) GetRole(ctx context.Context, roleID string) (*Role, error) {
+	if role, exists := s.roles[roleID]; exists {
+		return role, nil
+	}
+
+	customRoles, err := s.customRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if role, exists := customRoles[roleID]; exists {
+		return FromCustomRole(role), nil
+	}
+
+	return nil, ierr.NewError("role not found").
+		WithHintf("Role with ID %s was not found", roleID).
+		WithReportableDetails(map[string]interface{}{"role_id": roleID}).
+		Mark(ierr.ErrNotFound)
+}
+
+// FromCustomRole converts a custom role of a tenant to a Role
+func FromCustomRole(role *rbacrole.RBACRole) *Role {
+	return &Role{
+		ID:             role.ID,
+		Name:           role.Name,
+		Description:    role.Description,
+		Permissions:    role.Permissions,
+		EnvironmentIDs: role.EnvironmentIDs,
+		Custom:         true,
+	}
 }
@@ -384,6 +384,54 @@ func (r *MeterUsageRepository) GetDistinctMeterIDs(ctx context.Context, params *
 	return meterIDs, nil
 }
 
+// GetUsageBuckets returns usage per customer × meter × bucket, aggregated with the
+// aggregation type of the params. Buckets are aligned to the epoch, so hour-multiple bucket
+// sizes line up with UTC hour boundaries.
+func (r *MeterUsageRepository) GetUsageBuckets(ctx context.Context, params *events.MeterUsageQueryParams, bucketSize time.Duration) ([]*events.MeterUsageBucket, error) {
+	if params == nil || bucketSize < time.Second {
+		return nil, ierr.NewError("params and a bucket size of at least one second are required").Mark(ierr.ErrValidation)
+	}
+
+	where, args := r.qb.BuildWhereClause(params)
+	finalClause, settings := r.qb.BuildFinalClause(params.UseFinal)
+	aggExpr, countExpr := getMeterUsageAggExprs(GetMeterUsageAggregator(params.AggregationType))
+
+	query := fmt.Sprintf(`
+		SELECT
+			external_customer_id,
+			meter_id,
+			toStartOfInterval(timestamp, INTERVAL %d SECOND) AS window_start,
+			%s AS value,
+			%s AS event_count
+		FROM meter_usage %s
+		WHERE %s
+		GROUP BY external_customer_id, meter_id, window_start
+		ORDER BY external_customer_id, meter_id, window_start
+		%s
+	`, int64(bucketSize.Seconds()), aggExpr, countExpr, finalClause, where, settings)
+
+	rows, err := r.store.GetConn().Query(ctx, query, args...)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to query usage buckets from meter_usage").
+			Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	var buckets []*events.MeterUsageBucket
+	for rows.Next() {
+		var bucket events.MeterUsageBucket
+		if err := rows.Scan(&bucket.ExternalCustomerID, &bucket.MeterID, &bucket.WindowStart, &bucket.Value, &bucket.EventCount); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to scan usage bucket").
+				Mark(ierr.ErrDatabase)
+		}
+		buckets = append(buckets, &bucket)
+	}
+
+	return buckets, nil
+}
+
 // getMeterUsageAggExprs returns the SQL expression pair for a given aggregator.
 // This bridges the aggregator strategy with multi-meter queries that need raw expressions.
 func getMeterUsageAggExprs(agg MeterUsageAggregator) (aggExpr string, countExpr string) {
@@ -0,0 +1,465 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/clickhouse"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// UsageRollupRepository implements events.UsageRollupRepository on the meter_usage_hourly,
+// meter_usage_daily, feature_usage_hourly and feature_usage_daily tables. Rollup tables share
+// the dimension columns of their source table and name their bucket column timestamp, so
+// the source WHERE clauses and window expressions are reused as is.
+type UsageRollupRepository struct {
+	meterUsage *MeterUsageRepository
+}
+
+func NewUsageRollupRepository(store *clickhouse.ClickHouseStore, logger *logger.Logger) events.UsageRollupRepository {
+	return &UsageRollupRepository{
+		meterUsage: &MeterUsageRepository{
+			store:  store,
+			logger: logger,
+			qb:     NewMeterUsageQueryBuilder(),
+		},
+	}
+}
+
+// usageRollupTable returns the rollup table of a source and granularity
+func usageRollupTable(source types.UsageRollupSource, granularity types.UsageRollupGranularity) (string, error) {
+	switch source {
+	case types.UsageRollupSourceMeterUsage, types.UsageRollupSourceFeatureUsage:
+	default:
+		return "", ierr.NewErrorf("unsupported rollup source: %s", source).
+			Mark(ierr.ErrValidation)
+	}
+
+	switch granularity {
+	case types.UsageRollupGranularityHour:
+		return string(source) + "_hourly", nil
+	case types.UsageRollupGranularityDay:
+		return string(source) + "_daily", nil
+	default:
+		return "", ierr.NewErrorf("unsupported rollup granularity: %s", granularity).
+			Mark(ierr.ErrValidation)
+	}
+}
+
+// usageRollupStateTable returns the table holding the rolled up ranges of a source
+func usageRollupStateTable(source types.UsageRollupSource) (string, error) {
+	switch source {
+	case types.UsageRollupSourceMeterUsage, types.UsageRollupSourceFeatureUsage:
+		return string(source) + "_rollup_state", nil
+	default:
+		return "", ierr.NewErrorf("unsupported rollup source: %s", source).
+			Mark(ierr.ErrValidation)
+	}
+}
+
+func (r *UsageRollupRepository) Rollup(ctx context.Context, source types.UsageRollupSource, tenantID, environmentID string, granularity types.UsageRollupGranularity, start, end time.Time) error {
+	if _, err := usageRollupTable(source, granularity); err != nil {
+		return err
+	}
+
+	var query string
+	if source == types.UsageRollupSourceFeatureUsage {
+		query = featureUsageRollupQuery(granularity)
+	} else {
+		query = meterUsageRollupQuery(granularity)
+	}
+
+	version := uint64(time.Now().UnixMilli())
+	if err := r.meterUsage.store.GetConn().Exec(ctx, query, version, tenantID, environmentID, start.UTC(), end.UTC()); err != nil {
+		return ierr.WithError(err).
+			WithHintf("Failed to roll up %s %s", granularity, source).
+			WithReportableDetails(map[string]interface{}{
+				"environment_id": environmentID,
+				"start":          start,
+				"end":            end,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+// meterUsageRollupQuery returns the INSERT rebuilding meter usage buckets of a granularity
+func meterUsageRollupQuery(granularity types.UsageRollupGranularity) string {
+	if granularity == types.UsageRollupGranularityHour {
+		return `
+			INSERT INTO meter_usage_hourly
+				(tenant_id, environment_id, meter_id, external_customer_id, timestamp, qty_sum, qty_max, event_count, version)
+			SELECT
+				tenant_id,
+				environment_id,
+				meter_id,
+				external_customer_id,
+				toStartOfHour(timestamp) AS bucket_start,
+				SUM(qty_total),
+				MAX(qty_total),
+				COUNT(DISTINCT id),
+				?
+			FROM meter_usage FINAL
+			WHERE tenant_id = ? AND environment_id = ? AND timestamp >= ? AND timestamp < ?
+			GROUP BY tenant_id, environment_id, meter_id, external_customer_id, bucket_start
+			SETTINGS do_not_merge_across_partitions_select_final = 1
+		`
+	}
+	return `
+			INSERT INTO meter_usage_daily
+				(tenant_id, environment_id, meter_id, external_customer_id, timestamp, qty_sum, qty_max, event_count, version)
+			SELECT
+				tenant_id,
+				environment_id,
+				meter_id,
+				external_customer_id,
+				toStartOfDay(timestamp) AS bucket_start,
+				SUM(qty_sum),
+				MAX(qty_max),
+				SUM(event_count),
+				?
+			FROM meter_usage_hourly FINAL
+			WHERE tenant_id = ? AND environment_id = ? AND timestamp >= ? AND timestamp < ?
+			GROUP BY tenant_id, environment_id, meter_id, external_customer_id, bucket_start
+		`
+}
+
+// featureUsageRollupQuery returns the INSERT rebuilding feature usage buckets of a granularity.
+// Rows are filtered like the standard analytics query so both read the same usage.
+func featureUsageRollupQuery(granularity types.UsageRollupGranularity) string {
+	if granularity == types.UsageRollupGranularityHour {
+		return `
+			INSERT INTO feature_usage_hourly
+				(tenant_id, environment_id, customer_id, feature_id, price_id, meter_id, sub_line_item_id, source, timestamp, qty_sum, qty_max, event_count, version)
+			SELECT
+				tenant_id,
+				environment_id,
+				customer_id,
+				feature_id,
+				price_id,
+				ifNull(meter_id, '') AS meter,
+				sub_line_item_id,
+				ifNull(source, '') AS event_source,
+				toStartOfHour(timestamp) AS bucket_start,
+				SUM(qty_total),
+				MAX(qty_total),
+				COUNT(DISTINCT id),
+				?
+			FROM feature_usage FINAL
+			WHERE tenant_id = ? AND environment_id = ? AND timestamp >= ? AND timestamp < ? AND sign != 0
+			GROUP BY tenant_id, environment_id, customer_id, feature_id, price_id, meter, sub_line_item_id, event_source, bucket_start
+			SETTINGS do_not_merge_across_partitions_select_final = 1
+		`
+	}
+	return `
+			INSERT INTO feature_usage_daily
+				(tenant_id, environment_id, customer_id, feature_id, price_id, meter_id, sub_line_item_id, source, timestamp, qty_sum, qty_max, event_count, version)
+			SELECT
+				tenant_id,
+				environment_id,
+				customer_id,
+				feature_id,
+				price_id,
+				meter_id,
+				sub_line_item_id,
+				source,
+				toStartOfDay(timestamp) AS bucket_start,
+				SUM(qty_sum),
+				MAX(qty_max),
+				SUM(event_count),
+				?
+			FROM feature_usage_hourly FINAL
+			WHERE tenant_id = ? AND environment_id = ? AND timestamp >= ? AND timestamp < ?
+			GROUP BY tenant_id, environment_id, customer_id, feature_id, price_id, meter_id, sub_line_item_id, source, bucket_start
+		`
+}
+
+func (r *UsageRollupRepository) GetRollupStates(ctx context.Context, source types.UsageRollupSource, tenantID, environmentID string) ([]*events.UsageRollupState, error) {
+	table, err := usageRollupStateTable(source)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.meterUsage.store.GetConn().Query(ctx, fmt.Sprintf(`
+		SELECT granularity, rolled_up_from, rolled_up_to
+		FROM %s FINAL
+		WHERE tenant_id = ? AND environment_id = ?
+	`, table), tenantID, environmentID)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to query usage rollup state").
+			Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	var states []*events.UsageRollupState
+	for rows.Next() {
+		var granularity string
+		state := &events.UsageRollupState{
+			TenantID:      tenantID,
+			EnvironmentID: environmentID,
+			Source:        source,
+		}
+		if err := rows.Scan(&granularity, &state.RolledUpFrom, &state.RolledUpTo); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to scan usage rollup state").
+				Mark(ierr.ErrDatabase)
+		}
+		state.Granularity = types.UsageRollupGranularity(granularity)
+		states = append(states, state)
+	}
+
+	return states, nil
+}
+
+func (r *UsageRollupRepository) SaveRollupState(ctx context.Context, state *events.UsageRollupState) error {
+	table, err := usageRollupStateTable(state.Source)
+	if err != nil {
+		return err
+	}
+
+	err = r.meterUsage.store.GetConn().Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (tenant_id, environment_id, granularity, rolled_up_from, rolled_up_to)
+		VALUES (?, ?, ?, ?, ?)
+	`, table), state.TenantID, state.EnvironmentID, string(state.Granularity), state.RolledUpFrom.UTC(), state.RolledUpTo.UTC())
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to save usage rollup state").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (r *UsageRollupRepository) GetUsageMultiMeter(ctx context.Context, params *events.MeterUsageQueryParams, granularity types.UsageRollupGranularity) ([]*events.MeterUsageAggregationResult, error) {
+	if params == nil || len(params.MeterIDs) == 0 {
+		return nil, ierr.NewError("params with meter_ids are required").Mark(ierr.ErrValidation)
+	}
+
+	table, err := usageRollupTable(types.UsageRollupSourceMeterUsage, granularity)
+	if err != nil {
+		return nil, err
+	}
+
+	var aggExpr string
+	switch params.AggregationType {
+	case types.AggregationSum:
+		aggExpr = "SUM(qty_sum)"
+	case types.AggregationCount:
+		aggExpr = "SUM(event_count)"
+	case types.AggregationMax:
+		aggExpr = "MAX(qty_max)"
+	default:
+		return nil, ierr.NewErrorf("aggregation %s cannot be served from rollups", params.AggregationType).
+			Mark(ierr.ErrValidation)
+	}
+
+	query := buildRollupMultiMeterQuery(table, aggExpr, "SUM(event_count)", params, r.meterUsage.qb)
+	_, args := r.meterUsage.qb.BuildWhereClause(params)
+
+	if formatWindowSizeWithBillingAnchor(params.WindowSize, params.BillingAnchor) != "" {
+		return r.meterUsage.executeMultiMeterWindowedQuery(ctx, query, args, params)
+	}
+	return r.meterUsage.executeMultiMeterScalarQuery(ctx, query, args, params)
+}
+
+// buildRollupMultiMeterQuery mirrors BuildMultiMeterQuery on a rollup table. Rollups are
+// always read with FINAL since recent buckets are rebuilt by every rollup run.
+func buildRollupMultiMeterQuery(table, aggExpr, countExpr string, params *events.MeterUsageQueryParams, qb *MeterUsageQueryBuilder) string {
+	windowExpr := formatWindowSizeWithBillingAnchor(params.WindowSize, params.BillingAnchor)
+	where, _ := qb.BuildWhereClause(params)
+
+	if windowExpr != "" {
+		return fmt.Sprintf(`
+			SELECT
+				meter_id,
+				%s AS window_start,
+				%s AS value,
+				%s AS event_count
+			FROM %s FINAL
+			WHERE %s
+			GROUP BY meter_id, window_start
+			ORDER BY meter_id, window_start ASC
+		`, windowExpr, aggExpr, countExpr, table, where)
+	}
+
+	return fmt.Sprintf(`
+		SELECT
+			meter_id,
+			%s AS value,
+			%s AS event_count
+		FROM %s FINAL
+		WHERE %s
+		GROUP BY meter_id
+	`, aggExpr, countExpr, table, where)
+}
+
+func (r *UsageRollupRepository) SaveRetentionPolicy(ctx context.Context, policy *events.UsageRetentionPolicy) error {
+	err := r.meterUsage.store.GetConn().Exec(ctx, `
+		INSERT INTO usage_retention_policies (tenant_id, environment_id, raw_event_days, meter_usage_days, rollup_days)
+		VALUES (?, ?, ?, ?, ?)
+	`, policy.TenantID, policy.EnvironmentID, uint16(policy.RawEventDays), uint16(policy.MeterUsageDays), uint16(policy.RollupDays))
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to save usage retention policy").
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (r *UsageRollupRepository) GetDetailedUsageAnalytics(ctx context.Context, params *events.UsageAnalyticsParams, granularity types.UsageRollupGranularity) ([]*events.DetailedUsageAnalytic, error) {
+	table, err := usageRollupTable(types.UsageRollupSourceFeatureUsage, granularity)
+	if err != nil {
+		return nil, err
+	}
+	if len(params.PropertyFilters) > 0 {
+		return nil, ierr.NewError("property filters cannot be served from rollups").
+			Mark(ierr.ErrValidation)
+	}
+
+	// The same dimensions as the standard analytics query, which always groups by them
+	dimensions := []string{"feature_id", "price_id", "meter_id", "sub_line_item_id"}
+	sourceInGroupBy := false
+	for _, groupBy := range params.GroupBy {
+		switch groupBy {
+		case "feature_id":
+		case "source":
+			sourceInGroupBy = true
+			dimensions = append(dimensions, "source")
+		default:
+			return nil, ierr.NewErrorf("group_by %s cannot be served from rollups", groupBy).
+				Mark(ierr.ErrValidation)
+		}
+	}
+	for _, aggType := range params.AggregationTypes {
+		switch aggType {
+		case types.AggregationSum, types.AggregationCount, types.AggregationMax:
+		default:
+			return nil, ierr.NewErrorf("aggregation %s cannot be served from rollups", aggType).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	where := "tenant_id = ? AND environment_id = ? AND customer_id = ? AND timestamp >= ? AND timestamp < ?"
+	args := []interface{}{params.TenantID, params.EnvironmentID, params.CustomerID, params.StartTime.UTC(), params.EndTime.UTC()}
+	if len(params.FeatureIDs) > 0 {
+		where += " AND feature_id IN ?"
+		args = append(args, params.FeatureIDs)
+	}
+	if len(params.Sources) > 0 {
+		where += " AND source IN ?"
+		args = append(args, params.Sources)
+	}
+
+	// Rollups answer every supported aggregation at once; callers pick the one of the meter
+	aggregates := "SUM(qty_sum) AS total_usage, MAX(qty_max) AS max_usage, SUM(event_count) AS event_count"
+	totalsQuery := fmt.Sprintf(`
+		SELECT %s, %s%s
+		FROM %s FINAL
+		WHERE %s
+		GROUP BY %s
+	`, strings.Join(dimensions, ", "), aggregates,
+		lo.Ternary(sourceInGroupBy, "", ", groupUniqArrayIf(source, source != '') AS sources"),
+		table, where, strings.Join(dimensions, ", "))
+
+	rows, err := r.meterUsage.store.GetConn().Query(ctx, totalsQuery, args...)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to execute usage analytics rollup query").
+			WithReportableDetails(map[string]interface{}{
+				"customer_id": params.CustomerID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	results := make([]*events.DetailedUsageAnalytic, 0)
+	byKey := make(map[string]*events.DetailedUsageAnalytic)
+	for rows.Next() {
+		analytic := &events.DetailedUsageAnalytic{
+			Points:     []events.UsageAnalyticPoint{},
+			Properties: make(map[string]string),
+		}
+		dest := []interface{}{&analytic.FeatureID, &analytic.PriceID, &analytic.MeterID, &analytic.SubLineItemID}
+		if sourceInGroupBy {
+			dest = append(dest, &analytic.Source)
+		}
+		dest = append(dest, &analytic.TotalUsage, &analytic.MaxUsage, &analytic.EventCount)
+		if !sourceInGroupBy {
+			analytic.Sources = []string{}
+			dest = append(dest, &analytic.Sources)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to scan usage analytics rollup row").
+				Mark(ierr.ErrDatabase)
+		}
+		results = append(results, analytic)
+		byKey[usageAnalyticKey(analytic)] = analytic
+	}
+	if err := rows.Err(); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Error iterating usage analytics rollup rows").
+			Mark(ierr.ErrDatabase)
+	}
+
+	windowExpr := formatWindowSizeWithBillingAnchor(params.WindowSize, params.BillingAnchor)
+	if params.WindowSize == "" || windowExpr == "" || len(results) == 0 {
+		return results, nil
+	}
+
+	// All points of all groups in one query instead of one query per group
+	pointsQuery := fmt.Sprintf(`
+		SELECT %s, %s AS window_time, %s
+		FROM %s FINAL
+		WHERE %s
+		GROUP BY %s, window_time
+		ORDER BY window_time
+	`, strings.Join(dimensions, ", "), windowExpr, aggregates, table, where, strings.Join(dimensions, ", "))
+
+	pointRows, err := r.meterUsage.store.GetConn().Query(ctx, pointsQuery, args...)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to execute usage analytics rollup time-series query").
+			WithReportableDetails(map[string]interface{}{
+				"customer_id": params.CustomerID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+	defer pointRows.Close()
+
+	for pointRows.Next() {
+		var group events.DetailedUsageAnalytic
+		var point events.UsageAnalyticPoint
+		dest := []interface{}{&group.FeatureID, &group.PriceID, &group.MeterID, &group.SubLineItemID}
+		if sourceInGroupBy {
+			dest = append(dest, &group.Source)
+		}
+		dest = append(dest, &point.Timestamp, &point.Usage, &point.MaxUsage, &point.EventCount)
+		if err := pointRows.Scan(dest...); err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to scan usage analytics rollup point").
+				Mark(ierr.ErrDatabase)
+		}
+		if analytic, ok := byKey[usageAnalyticKey(&group)]; ok {
+			analytic.Points = append(analytic.Points, point)
+		}
+	}
+	if err := pointRows.Err(); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Error iterating usage analytics rollup points").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return results, nil
+}
+
+// usageAnalyticKey identifies the group of an analytics row
+func usageAnalyticKey(a *events.DetailedUsageAnalytic) string {
+	return strings.Join([]string{a.FeatureID, a.PriceID, a.MeterID, a.SubLineItemID, a.Source}, ":")
+}
@@ -0,0 +1,346 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/auditlog"
+	"github.com/flexprice/flexprice/ent/predicate"
+	domainAuditLog "github.com/flexprice/flexprice/internal/domain/auditlog"
+	"github.com/flexprice/flexprice/internal/dsl"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type auditLogRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts AuditLogQueryOptions
+}
+
+func NewAuditLogRepository(client postgres.IClient, log *logger.Logger) domainAuditLog.Repository {
+	return &auditLogRepository{
+		client:    client,
+		log:       log,
+		queryOpts: AuditLogQueryOptions{},
+	}
+}
+
+func (r *auditLogRepository) Create(ctx context.Context, l *domainAuditLog.AuditLog) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("creating audit log",
+		"audit_log_id", l.ID,
+		"entity_type", l.EntityType,
+		"entity_id", l.EntityID,
+		"action", l.Action,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "audit_log", "create", map[string]interface{}{
+		"audit_log_id": l.ID,
+		"entity_type":  l.EntityType,
+		"entity_id":    l.EntityID,
+	})
+	defer FinishSpan(span)
+
+	// Set environment ID from context if not already set
+	if l.EnvironmentID == "" {
+		l.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	createQuery := client.AuditLog.Create().
+		SetID(l.ID).
+		SetTenantID(l.TenantID).
+		SetEntityType(string(l.EntityType)).
+		SetEntityID(l.EntityID).
+		SetAction(string(l.Action)).
+		SetActorType(string(l.ActorType)).
+		SetActorID(l.ActorID).
+		SetRequestID(l.RequestID).
+		SetIPAddress(l.IPAddress).
+		SetStatus(string(l.Status)).
+		SetCreatedAt(l.CreatedAt).
+		SetUpdatedAt(l.UpdatedAt).
+		SetCreatedBy(l.CreatedBy).
+		SetUpdatedBy(l.UpdatedBy).
+		SetEnvironmentID(l.EnvironmentID)
+
+	if l.Changes != nil {
+		createQuery = createQuery.SetChanges(l.Changes)
+	}
+
+	if _, err := r.saveInSavepoint(ctx, createQuery); err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("An audit log with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"audit_log_id": l.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create audit log").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+// saveInSavepoint saves an entry. Inside a transaction the insert runs under a savepoint, so a
+// failed insert is rolled back on its own instead of aborting the transaction of the audited action.
+func (r *auditLogRepository) saveInSavepoint(ctx context.Context, createQuery *ent.AuditLogCreate) (*ent.AuditLog, error) {
+	tx := r.client.TxFromContext(ctx)
+	if tx == nil {
+		return createQuery.Save(ctx)
+	}
+
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT audit_log"); err != nil {
+		return nil, err
+	}
+
+	l, err := createQuery.Save(ctx)
+	if err != nil {
+		if _, rerr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT audit_log"); rerr != nil {
+			return nil, fmt.Errorf("rolling back to savepoint: %v (original error: %w)", rerr, err)
+		}
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT audit_log"); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (r *auditLogRepository) Get(ctx context.Context, id string) (*domainAuditLog.AuditLog, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "audit_log", "get", map[string]interface{}{
+		"audit_log_id": id,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Reader(ctx)
+	r.log.Debugw("getting audit log", "audit_log_id", id)
+
+	l, err := client.AuditLog.Query().
+		Where(
+			auditlog.ID(id),
+			auditlog.TenantID(types.GetTenantID(ctx)),
+			auditlog.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Audit log with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"audit_log_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get audit log").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainAuditLog.FromEnt(l), nil
+}
+
+func (r *auditLogRepository) List(ctx context.Context, filter *types.AuditLogFilter) ([]*domainAuditLog.AuditLog, error) {
+	client := r.client.Reader(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "audit_log", "list", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := client.AuditLog.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query, err := r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list audit logs").
+			Mark(ierr.ErrDatabase)
+	}
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	logs, err := query.All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list audit logs").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainAuditLog.FromEntList(logs), nil
+}
+
+func (r *auditLogRepository) Count(ctx context.Context, filter *types.AuditLogFilter) (int, error) {
+	client := r.client.Reader(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "audit_log", "count", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := client.AuditLog.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+
+	var err error
+	query, err = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to apply query options").
+			Mark(ierr.ErrDatabase)
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count audit logs").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return count, nil
+}
+
+// AuditLogQuery type alias for better readability
+type AuditLogQuery = *ent.AuditLogQuery
+
+// AuditLogQueryOptions implements BaseQueryOptions for audit log queries
+type AuditLogQueryOptions struct{}
+
+func (o AuditLogQueryOptions) ApplyTenantFilter(ctx context.Context, query AuditLogQuery) AuditLogQuery {
+	return query.Where(auditlog.TenantIDEQ(types.GetTenantID(ctx)))
+}
+
+func (o AuditLogQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query AuditLogQuery) AuditLogQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(auditlog.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o AuditLogQueryOptions) ApplyStatusFilter(query AuditLogQuery, status string) AuditLogQuery {
+	if status == "" {
+		return query.Where(auditlog.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(auditlog.Status(status))
+}
+
+func (o AuditLogQueryOptions) ApplySortFilter(query AuditLogQuery, field string, order string) AuditLogQuery {
+	if field != "" {
+		if order == types.OrderDesc {
+			query = query.Order(ent.Desc(o.GetFieldName(field)))
+		} else {
+			query = query.Order(ent.Asc(o.GetFieldName(field)))
+		}
+	}
+	return query
+}
+
+func (o AuditLogQueryOptions) ApplyPaginationFilter(query AuditLogQuery, limit int, offset int) AuditLogQuery {
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o AuditLogQueryOptions) GetFieldName(field string) string {
+	if auditlog.ValidColumn(field) {
+		return field
+	}
+	return ""
+}
+
+func (o AuditLogQueryOptions) GetFieldResolver(field string) (string, error) {
+	fieldName := o.GetFieldName(field)
+	if fieldName == "" {
+		return "", ierr.NewErrorf("unknown field name '%s' in audit log query", field).
+			Mark(ierr.ErrValidation)
+	}
+	return fieldName, nil
+}
+
+func (o AuditLogQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.AuditLogFilter, query AuditLogQuery) (AuditLogQuery, error) {
+	var err error
+	if f == nil {
+		return query, nil
+	}
+
+	if len(f.EntityTypes) > 0 {
+		query = query.Where(auditlog.EntityTypeIn(f.EntityTypes...))
+	}
+	if len(f.EntityIDs) > 0 {
+		query = query.Where(auditlog.EntityIDIn(f.EntityIDs...))
+	}
+	if len(f.Actions) > 0 {
+		query = query.Where(auditlog.ActionIn(f.Actions...))
+	}
+	if len(f.ActorTypes) > 0 {
+		query = query.Where(auditlog.ActorTypeIn(f.ActorTypes...))
+	}
+	if len(f.ActorIDs) > 0 {
+		query = query.Where(auditlog.ActorIDIn(f.ActorIDs...))
+	}
+	if f.RequestID != "" {
+		query = query.Where(auditlog.RequestID(f.RequestID))
+	}
+
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(auditlog.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(auditlog.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	if f.Filters != nil {
+		query, err = dsl.ApplyFilters[AuditLogQuery, predicate.AuditLog](
+			query,
+			f.Filters,
+			o.GetFieldResolver,
+			func(p dsl.Predicate) predicate.AuditLog { return predicate.AuditLog(p) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if f.Sort != nil {
+		query, err = dsl.ApplySorts[AuditLogQuery, auditlog.OrderOption](
+			query,
+			f.Sort,
+			o.GetFieldResolver,
+			func(o dsl.OrderFunc) auditlog.OrderOption { return auditlog.OrderOption(o) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return query, nil
+}
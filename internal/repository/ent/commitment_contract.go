@@ -0,0 +1,565 @@
+package ent
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/commitmentcontract"
+	"github.com/flexprice/flexprice/ent/commitmentdrawdown"
+	"github.com/flexprice/flexprice/ent/predicate"
+	domainCommitmentContract "github.com/flexprice/flexprice/internal/domain/commitmentcontract"
+	"github.com/flexprice/flexprice/internal/dsl"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+type commitmentContractRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts CommitmentContractQueryOptions
+}
+
+func NewCommitmentContractRepository(client postgres.IClient, log *logger.Logger) domainCommitmentContract.Repository {
+	return &commitmentContractRepository{
+		client:    client,
+		log:       log,
+		queryOpts: CommitmentContractQueryOptions{},
+	}
+}
+
+func (r *commitmentContractRepository) Create(ctx context.Context, contract *domainCommitmentContract.CommitmentContract) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("creating commitment contract",
+		"contract_id", contract.ID,
+		"tenant_id", contract.TenantID,
+		"customer_id", contract.CustomerID,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "commitment_contract", "create", map[string]interface{}{
+		"contract_id": contract.ID,
+		"customer_id": contract.CustomerID,
+	})
+	defer FinishSpan(span)
+
+	// Set environment ID from context if not already set
+	if contract.EnvironmentID == "" {
+		contract.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	createQuery := client.CommitmentContract.Create().
+		SetID(contract.ID).
+		SetTenantID(contract.TenantID).
+		SetName(contract.Name).
+		SetCustomerID(contract.CustomerID).
+		SetSubscriptionIds(contract.SubscriptionIDs).
+		SetCurrency(strings.ToLower(contract.Currency)).
+		SetCommitmentAmount(contract.CommitmentAmount).
+		SetPrepaidAmount(contract.PrepaidAmount).
+		SetOverageFactor(contract.OverageFactor).
+		SetDrawnDownAmount(contract.DrawnDownAmount).
+		SetOverageAmount(contract.OverageAmount).
+		SetStartDate(contract.StartDate).
+		SetEndDate(contract.EndDate).
+		SetEnableTrueUp(contract.EnableTrueUp).
+		SetContractStatus(string(contract.ContractStatus)).
+		SetNillablePrepaymentInvoiceID(contract.PrepaymentInvoiceID).
+		SetNillableTrueUpInvoiceID(contract.TrueUpInvoiceID).
+		SetStatus(string(contract.Status)).
+		SetCreatedAt(contract.CreatedAt).
+		SetUpdatedAt(contract.UpdatedAt).
+		SetCreatedBy(contract.CreatedBy).
+		SetUpdatedBy(contract.UpdatedBy).
+		SetEnvironmentID(contract.EnvironmentID)
+
+	if contract.Metadata != nil {
+		createQuery = createQuery.SetMetadata(contract.Metadata)
+	}
+
+	created, err := createQuery.Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("A commitment contract with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"contract_id": contract.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create commitment contract").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	*contract = *domainCommitmentContract.FromEnt(created)
+	return nil
+}
+
+func (r *commitmentContractRepository) Get(ctx context.Context, id string) (*domainCommitmentContract.CommitmentContract, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "commitment_contract", "get", map[string]interface{}{
+		"contract_id": id,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Reader(ctx)
+	r.log.Debugw("getting commitment contract", "contract_id", id)
+
+	contract, err := client.CommitmentContract.Query().
+		Where(
+			commitmentcontract.ID(id),
+			commitmentcontract.TenantID(types.GetTenantID(ctx)),
+			commitmentcontract.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Commitment contract with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"contract_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get commitment contract").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainCommitmentContract.FromEnt(contract), nil
+}
+
+// GetForUpdate retrieves a contract with a row-level lock (SELECT FOR UPDATE), so concurrent
+// invoice finalizations draw it down one after the other.
+// Must be called within a transaction so the lock is held until commit/rollback.
+func (r *commitmentContractRepository) GetForUpdate(ctx context.Context, id string) (*domainCommitmentContract.CommitmentContract, error) {
+	span := StartRepositorySpan(ctx, "commitment_contract", "get_for_update", map[string]interface{}{
+		"contract_id": id,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Writer(ctx)
+	tenantID := types.GetTenantID(ctx)
+	environmentID := types.GetEnvironmentID(ctx)
+
+	lockQuery := `SELECT id FROM commitment_contracts WHERE id = $1 AND tenant_id = $2 AND environment_id = $3 FOR UPDATE`
+	rows, err := client.QueryContext(ctx, lockQuery, id, tenantID, environmentID)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).WithHint("commitment contract lock failed").Mark(ierr.ErrDatabase)
+	}
+	// Must check and close rows BEFORE running another query on the same connection
+	hasRow := rows.Next()
+	rowErr := rows.Err()
+	rows.Close()
+	if rowErr != nil {
+		SetSpanError(span, rowErr)
+		return nil, ierr.WithError(rowErr).WithHint("commitment contract lock failed").Mark(ierr.ErrDatabase)
+	}
+	if !hasRow {
+		return nil, ierr.NewError("commitment contract not found").
+			WithHintf("Commitment contract with ID %s was not found", id).
+			WithReportableDetails(map[string]any{"contract_id": id}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	contract, err := client.CommitmentContract.Query().
+		Where(
+			commitmentcontract.ID(id),
+			commitmentcontract.TenantID(tenantID),
+			commitmentcontract.EnvironmentID(environmentID),
+		).
+		Only(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get commitment contract").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainCommitmentContract.FromEnt(contract), nil
+}
+
+func (r *commitmentContractRepository) Update(ctx context.Context, contract *domainCommitmentContract.CommitmentContract) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("updating commitment contract",
+		"contract_id", contract.ID,
+		"tenant_id", contract.TenantID,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "commitment_contract", "update", map[string]interface{}{
+		"contract_id": contract.ID,
+	})
+	defer FinishSpan(span)
+
+	updateQuery := client.CommitmentContract.Update().
+		Where(
+			commitmentcontract.ID(contract.ID),
+			commitmentcontract.TenantID(contract.TenantID),
+			commitmentcontract.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetName(contract.Name).
+		SetSubscriptionIds(contract.SubscriptionIDs).
+		SetOverageFactor(contract.OverageFactor).
+		SetDrawnDownAmount(contract.DrawnDownAmount).
+		SetOverageAmount(contract.OverageAmount).
+		SetEndDate(contract.EndDate).
+		SetEnableTrueUp(contract.EnableTrueUp).
+		SetContractStatus(string(contract.ContractStatus)).
+		SetNillablePrepaymentInvoiceID(contract.PrepaymentInvoiceID).
+		SetNillableTrueUpInvoiceID(contract.TrueUpInvoiceID).
+		SetStatus(string(contract.Status)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	if contract.Metadata != nil {
+		updateQuery = updateQuery.SetMetadata(contract.Metadata)
+	}
+
+	_, err := updateQuery.Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Commitment contract with ID %s was not found", contract.ID).
+				WithReportableDetails(map[string]any{
+					"contract_id": contract.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update commitment contract").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *commitmentContractRepository) List(ctx context.Context, filter *types.CommitmentContractFilter) ([]*domainCommitmentContract.CommitmentContract, error) {
+	client := r.client.Reader(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "commitment_contract", "list", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := client.CommitmentContract.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query, err := r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list commitment contracts").
+			Mark(ierr.ErrDatabase)
+	}
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	contracts, err := query.All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list commitment contracts").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainCommitmentContract.FromEntList(contracts), nil
+}
+
+func (r *commitmentContractRepository) Count(ctx context.Context, filter *types.CommitmentContractFilter) (int, error) {
+	client := r.client.Reader(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "commitment_contract", "count", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := client.CommitmentContract.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+
+	var err error
+	query, err = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to apply query options").
+			Mark(ierr.ErrDatabase)
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count commitment contracts").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return count, nil
+}
+
+func (r *commitmentContractRepository) CreateDrawdown(ctx context.Context, drawdown *domainCommitmentContract.CommitmentDrawdown) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("creating commitment drawdown",
+		"drawdown_id", drawdown.ID,
+		"contract_id", drawdown.ContractID,
+		"invoice_id", drawdown.InvoiceID,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "commitment_drawdown", "create", map[string]interface{}{
+		"drawdown_id": drawdown.ID,
+		"contract_id": drawdown.ContractID,
+	})
+	defer FinishSpan(span)
+
+	if drawdown.EnvironmentID == "" {
+		drawdown.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	created, err := client.CommitmentDrawdown.Create().
+		SetID(drawdown.ID).
+		SetTenantID(drawdown.TenantID).
+		SetContractID(drawdown.ContractID).
+		SetSubscriptionID(drawdown.SubscriptionID).
+		SetInvoiceID(drawdown.InvoiceID).
+		SetPeriodStart(drawdown.PeriodStart).
+		SetPeriodEnd(drawdown.PeriodEnd).
+		SetUsageAmount(drawdown.UsageAmount).
+		SetDrawdownAmount(drawdown.DrawdownAmount).
+		SetOverageAmount(drawdown.OverageAmount).
+		SetBalanceAfter(drawdown.BalanceAfter).
+		SetStatus(string(drawdown.Status)).
+		SetCreatedAt(drawdown.CreatedAt).
+		SetUpdatedAt(drawdown.UpdatedAt).
+		SetCreatedBy(drawdown.CreatedBy).
+		SetUpdatedBy(drawdown.UpdatedBy).
+		SetEnvironmentID(drawdown.EnvironmentID).
+		Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("The invoice already drew down this commitment contract").
+				WithReportableDetails(map[string]any{
+					"contract_id": drawdown.ContractID,
+					"invoice_id":  drawdown.InvoiceID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create commitment drawdown").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	*drawdown = *domainCommitmentContract.DrawdownFromEnt(created)
+	return nil
+}
+
+func (r *commitmentContractRepository) GetDrawdownByInvoice(ctx context.Context, contractID, invoiceID string) (*domainCommitmentContract.CommitmentDrawdown, error) {
+	span := StartRepositorySpan(ctx, "commitment_drawdown", "get_by_invoice", map[string]interface{}{
+		"contract_id": contractID,
+		"invoice_id":  invoiceID,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Reader(ctx)
+
+	drawdown, err := client.CommitmentDrawdown.Query().
+		Where(
+			commitmentdrawdown.ContractID(contractID),
+			commitmentdrawdown.InvoiceID(invoiceID),
+			commitmentdrawdown.TenantID(types.GetTenantID(ctx)),
+			commitmentdrawdown.EnvironmentID(types.GetEnvironmentID(ctx)),
+			commitmentdrawdown.Status(string(types.StatusPublished)),
+		).
+		Only(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHint("The invoice has not drawn down this commitment contract").
+				WithReportableDetails(map[string]any{
+					"contract_id": contractID,
+					"invoice_id":  invoiceID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get commitment drawdown").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainCommitmentContract.DrawdownFromEnt(drawdown), nil
+}
+
+func (r *commitmentContractRepository) ListDrawdowns(ctx context.Context, contractID string) ([]*domainCommitmentContract.CommitmentDrawdown, error) {
+	span := StartRepositorySpan(ctx, "commitment_drawdown", "list", map[string]interface{}{
+		"contract_id": contractID,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Reader(ctx)
+
+	drawdowns, err := client.CommitmentDrawdown.Query().
+		Where(
+			commitmentdrawdown.ContractID(contractID),
+			commitmentdrawdown.TenantID(types.GetTenantID(ctx)),
+			commitmentdrawdown.EnvironmentID(types.GetEnvironmentID(ctx)),
+			commitmentdrawdown.Status(string(types.StatusPublished)),
+		).
+		Order(ent.Asc(commitmentdrawdown.FieldPeriodStart), ent.Asc(commitmentdrawdown.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list commitment drawdowns").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainCommitmentContract.DrawdownFromEntList(drawdowns), nil
+}
+
+// CommitmentContractQuery type alias for better readability
+type CommitmentContractQuery = *ent.CommitmentContractQuery
+
+// CommitmentContractQueryOptions implements BaseQueryOptions for commitment contract queries
+type CommitmentContractQueryOptions struct{}
+
+func (o CommitmentContractQueryOptions) ApplyTenantFilter(ctx context.Context, query CommitmentContractQuery) CommitmentContractQuery {
+	return query.Where(commitmentcontract.TenantIDEQ(types.GetTenantID(ctx)))
+}
+
+func (o CommitmentContractQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query CommitmentContractQuery) CommitmentContractQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(commitmentcontract.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o CommitmentContractQueryOptions) ApplyStatusFilter(query CommitmentContractQuery, status string) CommitmentContractQuery {
+	if status == "" {
+		return query.Where(commitmentcontract.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(commitmentcontract.Status(status))
+}
+
+func (o CommitmentContractQueryOptions) ApplySortFilter(query CommitmentContractQuery, field string, order string) CommitmentContractQuery {
+	if field != "" {
+		if order == types.OrderDesc {
+			query = query.Order(ent.Desc(o.GetFieldName(field)))
+		} else {
+			query = query.Order(ent.Asc(o.GetFieldName(field)))
+		}
+	}
+	return query
+}
+
+func (o CommitmentContractQueryOptions) ApplyPaginationFilter(query CommitmentContractQuery, limit int, offset int) CommitmentContractQuery {
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o CommitmentContractQueryOptions) GetFieldName(field string) string {
+	if commitmentcontract.ValidColumn(field) {
+		return field
+	}
+	return ""
+}
+
+func (o CommitmentContractQueryOptions) GetFieldResolver(field string) (string, error) {
+	fieldName := o.GetFieldName(field)
+	if fieldName == "" {
+		return "", ierr.NewErrorf("unknown field name '%s' in commitment contract query", field).
+			Mark(ierr.ErrValidation)
+	}
+	return fieldName, nil
+}
+
+func (o CommitmentContractQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.CommitmentContractFilter, query CommitmentContractQuery) (CommitmentContractQuery, error) {
+	var err error
+	if f == nil {
+		return query, nil
+	}
+
+	if len(f.ContractIDs) > 0 {
+		query = query.Where(commitmentcontract.IDIn(f.ContractIDs...))
+	}
+	if f.CustomerID != "" {
+		query = query.Where(commitmentcontract.CustomerID(f.CustomerID))
+	}
+	if f.SubscriptionID != "" {
+		subscriptionID := f.SubscriptionID
+		query = query.Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(s.C(commitmentcontract.FieldSubscriptionIds), subscriptionID))
+		})
+	}
+	if len(f.ContractStatuses) > 0 {
+		query = query.Where(commitmentcontract.ContractStatusIn(lo.Map(f.ContractStatuses, func(s types.CommitmentContractStatus, _ int) string {
+			return string(s)
+		})...))
+	}
+	if f.EndDateLTE != nil {
+		query = query.Where(commitmentcontract.EndDateLTE(*f.EndDateLTE))
+	}
+
+	if f.Filters != nil {
+		query, err = dsl.ApplyFilters[CommitmentContractQuery, predicate.CommitmentContract](
+			query,
+			f.Filters,
+			o.GetFieldResolver,
+			func(p dsl.Predicate) predicate.CommitmentContract { return predicate.CommitmentContract(p) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if f.Sort != nil {
+		query, err = dsl.ApplySorts[CommitmentContractQuery, commitmentcontract.OrderOption](
+			query,
+			f.Sort,
+			o.GetFieldResolver,
+			func(o dsl.OrderFunc) commitmentcontract.OrderOption { return commitmentcontract.OrderOption(o) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return query, nil
+}
@@ -77,7 +77,7 @@ func (r *couponRepository) Create(ctx context.Context, c *domainCoupon.Coupon) e
 
 	// Handle optional fields
 	if c.Rules != nil {
-		createQuery = createQuery.SetRules(*c.Rules)
+		createQuery = createQuery.SetRules(c.Rules)
 	}
 	if c.Metadata != nil {
 		createQuery = createQuery.SetMetadata(*c.Metadata)
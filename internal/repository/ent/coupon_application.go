@@ -75,6 +75,7 @@ func (r *couponApplicationRepository) Create(ctx context.Context, ca *domainCoup
 		SetEnvironmentID(ca.EnvironmentID).
 		SetNillableInvoiceLineItemID(ca.InvoiceLineItemID).
 		SetNillableSubscriptionID(ca.SubscriptionID).
+		SetNillablePromotionCodeID(ca.PromotionCodeID).
 		SetNillableDiscountPercentage(ca.DiscountPercentage)
 
 	if ca.CouponSnapshot != nil {
@@ -477,6 +478,10 @@ func (o CouponApplicationQueryOptions) applyEntityQueryOptions(_ context.Context
 		query = query.Where(couponapplication.CouponAssociationIDIn(f.CouponAssociationIDs...))
 	}
 
+	if len(f.PromotionCodeIDs) > 0 {
+		query = query.Where(couponapplication.PromotionCodeIDIn(f.PromotionCodeIDs...))
+	}
+
 	// Apply filters using the generic function
 	if f.Filters != nil {
 		query, err = dsl.ApplyFilters[CouponApplicationQuery, predicate.CouponApplication](
@@ -144,6 +144,10 @@ func (o CouponAssociationQueryOptions) applyEntityQueryOptions(_ context.Context
 		query = query.Where(couponassociation.SubscriptionPhaseIDIn(f.SubscriptionPhaseIDs...))
 	}
 
+	if len(f.PromotionCodeIDs) > 0 {
+		query = query.Where(couponassociation.PromotionCodeIDIn(f.PromotionCodeIDs...))
+	}
+
 	// Apply active filter based on start_date and end_date
 	if f.ActiveOnly {
 		query = applyActiveOnlyFilter(query, f.PeriodStart, f.PeriodEnd)
@@ -235,6 +239,11 @@ func (r *couponAssociationRepository) Create(ctx context.Context, ca *domainCoup
 		create = create.SetSubscriptionPhaseID(*ca.SubscriptionPhaseID)
 	}
 
+	// Handle optional promotion code the association was redeemed through
+	if ca.PromotionCodeID != nil {
+		create = create.SetPromotionCodeID(*ca.PromotionCodeID)
+	}
+
 	// Handle optional start date (nullable in schema)
 	if !ca.StartDate.IsZero() {
 		create = create.SetStartDate(ca.StartDate)
@@ -79,6 +79,10 @@ func (r *creditGrantRepository) Create(ctx context.Context, cg *domainCreditGran
 		SetEnvironmentID(cg.EnvironmentID).
 		SetMetadata(cg.Metadata)
 
+	if cg.RolloverConfig != nil {
+		create = create.SetRolloverConfig(cg.RolloverConfig)
+	}
+
 	result, err := create.Save(ctx)
 
 	if err != nil {
@@ -165,6 +169,10 @@ func (r *creditGrantRepository) CreateBulk(ctx context.Context, creditGrants []*
 			SetUpdatedBy(cg.UpdatedBy).
 			SetEnvironmentID(cg.EnvironmentID).
 			SetMetadata(cg.Metadata)
+
+		if cg.RolloverConfig != nil {
+			builders[i] = builders[i].SetRolloverConfig(cg.RolloverConfig)
+		}
 	}
 
 	results, err := client.CreditGrant.CreateBulk(builders...).Save(ctx)
@@ -68,6 +68,9 @@ func (r *customerRepository) Create(ctx context.Context, c *domainCustomer.Custo
 		SetAddressState(c.AddressState).
 		SetAddressPostalCode(c.AddressPostalCode).
 		SetAddressCountry(c.AddressCountry).
+		SetCurrency(c.Currency).
+		SetNillableParentCustomerID(c.ParentCustomerID).
+		SetInvoiceToParent(c.InvoiceToParent).
 		SetMetadata(c.Metadata).
 		SetStatus(string(c.Status)).
 		SetCreatedAt(c.CreatedAt).
@@ -323,12 +326,19 @@ func (r *customerRepository) Update(ctx context.Context, c *domainCustomer.Custo
 	})
 	defer FinishSpan(span)
 
-	_, err := client.Customer.Update().
+	update := client.Customer.Update().
 		Where(
 			customer.ID(c.ID),
 			customer.TenantID(c.TenantID),
 			customer.EnvironmentID(types.GetEnvironmentID(ctx)),
-		).
+		)
+	if c.ParentCustomerID != nil {
+		update = update.SetParentCustomerID(*c.ParentCustomerID)
+	} else {
+		update = update.ClearParentCustomerID()
+	}
+
+	_, err := update.
 		SetExternalID(c.ExternalID).
 		SetName(c.Name).
 		SetEmail(c.Email).
@@ -338,6 +348,8 @@ func (r *customerRepository) Update(ctx context.Context, c *domainCustomer.Custo
 		SetAddressState(c.AddressState).
 		SetAddressPostalCode(c.AddressPostalCode).
 		SetAddressCountry(c.AddressCountry).
+		SetCurrency(c.Currency).
+		SetInvoiceToParent(c.InvoiceToParent).
 		SetMetadata(c.Metadata).
 		SetUpdatedAt(time.Now().UTC()).
 		SetUpdatedBy(types.GetUserID(ctx)).
@@ -505,6 +517,10 @@ func (o CustomerQueryOptions) applyEntityQueryOptions(_ context.Context, f *type
 		query = query.Where(customer.ExternalIDIn(f.ExternalIDs...))
 	}
 
+	if len(f.ParentCustomerIDs) > 0 {
+		query = query.Where(customer.ParentCustomerIDIn(f.ParentCustomerIDs...))
+	}
+
 	if f.Filters != nil {
 		query, err = dsl.ApplyFilters[CustomerQuery, predicate.Customer](
 			query,
@@ -0,0 +1,402 @@
+package ent
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/fxrate"
+	"github.com/flexprice/flexprice/ent/predicate"
+	domainFXRate "github.com/flexprice/flexprice/internal/domain/fxrate"
+	"github.com/flexprice/flexprice/internal/dsl"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type fxRateRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts FXRateQueryOptions
+}
+
+func NewFXRateRepository(client postgres.IClient, log *logger.Logger) domainFXRate.Repository {
+	return &fxRateRepository{
+		client:    client,
+		log:       log,
+		queryOpts: FXRateQueryOptions{},
+	}
+}
+
+func (r *fxRateRepository) Create(ctx context.Context, rate *domainFXRate.FXRate) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("creating fx rate",
+		"fx_rate_id", rate.ID,
+		"tenant_id", rate.TenantID,
+		"base_currency", rate.BaseCurrency,
+		"quote_currency", rate.QuoteCurrency,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "fx_rate", "create", map[string]interface{}{
+		"fx_rate_id":     rate.ID,
+		"base_currency":  rate.BaseCurrency,
+		"quote_currency": rate.QuoteCurrency,
+	})
+	defer FinishSpan(span)
+
+	// Set environment ID from context if not already set
+	if rate.EnvironmentID == "" {
+		rate.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	createQuery := client.FXRate.Create().
+		SetID(rate.ID).
+		SetTenantID(rate.TenantID).
+		SetBaseCurrency(strings.ToLower(rate.BaseCurrency)).
+		SetQuoteCurrency(strings.ToLower(rate.QuoteCurrency)).
+		SetRate(rate.Rate).
+		SetEffectiveAt(rate.EffectiveAt).
+		SetSource(string(rate.Source)).
+		SetStatus(string(rate.Status)).
+		SetCreatedAt(rate.CreatedAt).
+		SetUpdatedAt(rate.UpdatedAt).
+		SetCreatedBy(rate.CreatedBy).
+		SetUpdatedBy(rate.UpdatedBy).
+		SetEnvironmentID(rate.EnvironmentID)
+
+	if rate.Metadata != nil {
+		createQuery = createQuery.SetMetadata(rate.Metadata)
+	}
+
+	created, err := createQuery.Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("An FX rate for this currency pair and effective time already exists").
+				WithReportableDetails(map[string]any{
+					"base_currency":  rate.BaseCurrency,
+					"quote_currency": rate.QuoteCurrency,
+					"effective_at":   rate.EffectiveAt,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create FX rate").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	*rate = *domainFXRate.FromEnt(created)
+	return nil
+}
+
+func (r *fxRateRepository) Get(ctx context.Context, id string) (*domainFXRate.FXRate, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "fx_rate", "get", map[string]interface{}{
+		"fx_rate_id": id,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Reader(ctx)
+	r.log.Debugw("getting fx rate", "fx_rate_id", id)
+
+	rate, err := client.FXRate.Query().
+		Where(
+			fxrate.ID(id),
+			fxrate.TenantID(types.GetTenantID(ctx)),
+			fxrate.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("FX rate with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"fx_rate_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get FX rate").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainFXRate.FromEnt(rate), nil
+}
+
+func (r *fxRateRepository) GetLatest(ctx context.Context, baseCurrency, quoteCurrency string, at time.Time) (*domainFXRate.FXRate, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "fx_rate", "get_latest", map[string]interface{}{
+		"base_currency":  baseCurrency,
+		"quote_currency": quoteCurrency,
+		"at":             at,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Reader(ctx)
+
+	rate, err := client.FXRate.Query().
+		Where(
+			fxrate.TenantID(types.GetTenantID(ctx)),
+			fxrate.EnvironmentID(types.GetEnvironmentID(ctx)),
+			fxrate.Status(string(types.StatusPublished)),
+			fxrate.BaseCurrency(strings.ToLower(baseCurrency)),
+			fxrate.QuoteCurrency(strings.ToLower(quoteCurrency)),
+			fxrate.EffectiveAtLTE(at),
+		).
+		Order(ent.Desc(fxrate.FieldEffectiveAt), ent.Desc(fxrate.FieldCreatedAt)).
+		First(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("No FX rate from %s to %s is effective at %s", baseCurrency, quoteCurrency, at.Format(time.RFC3339)).
+				WithReportableDetails(map[string]any{
+					"base_currency":  baseCurrency,
+					"quote_currency": quoteCurrency,
+					"at":             at,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get FX rate").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainFXRate.FromEnt(rate), nil
+}
+
+func (r *fxRateRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("deleting fx rate",
+		"fx_rate_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+		"environment_id", types.GetEnvironmentID(ctx),
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "fx_rate", "delete", map[string]interface{}{
+		"fx_rate_id": id,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.FXRate.Update().
+		Where(
+			fxrate.ID(id),
+			fxrate.TenantID(types.GetTenantID(ctx)),
+			fxrate.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetStatus(string(types.StatusArchived)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("FX rate with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"fx_rate_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to delete FX rate").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *fxRateRepository) List(ctx context.Context, filter *types.FXRateFilter) ([]*domainFXRate.FXRate, error) {
+	client := r.client.Reader(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "fx_rate", "list", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := client.FXRate.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query, err := r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list FX rates").
+			Mark(ierr.ErrDatabase)
+	}
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	rates, err := query.All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list FX rates").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainFXRate.FromEntList(rates), nil
+}
+
+func (r *fxRateRepository) Count(ctx context.Context, filter *types.FXRateFilter) (int, error) {
+	client := r.client.Reader(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "fx_rate", "count", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := client.FXRate.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+
+	var err error
+	query, err = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to apply query options").
+			Mark(ierr.ErrDatabase)
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count FX rates").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return count, nil
+}
+
+// FXRateQuery type alias for better readability
+type FXRateQuery = *ent.FXRateQuery
+
+// FXRateQueryOptions implements BaseQueryOptions for FX rate queries
+type FXRateQueryOptions struct{}
+
+func (o FXRateQueryOptions) ApplyTenantFilter(ctx context.Context, query FXRateQuery) FXRateQuery {
+	return query.Where(fxrate.TenantIDEQ(types.GetTenantID(ctx)))
+}
+
+func (o FXRateQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query FXRateQuery) FXRateQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(fxrate.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o FXRateQueryOptions) ApplyStatusFilter(query FXRateQuery, status string) FXRateQuery {
+	if status == "" {
+		return query.Where(fxrate.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(fxrate.Status(status))
+}
+
+func (o FXRateQueryOptions) ApplySortFilter(query FXRateQuery, field string, order string) FXRateQuery {
+	if field != "" {
+		if order == types.OrderDesc {
+			query = query.Order(ent.Desc(o.GetFieldName(field)))
+		} else {
+			query = query.Order(ent.Asc(o.GetFieldName(field)))
+		}
+	}
+	return query
+}
+
+func (o FXRateQueryOptions) ApplyPaginationFilter(query FXRateQuery, limit int, offset int) FXRateQuery {
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o FXRateQueryOptions) GetFieldName(field string) string {
+	if fxrate.ValidColumn(field) {
+		return field
+	}
+	return ""
+}
+
+func (o FXRateQueryOptions) GetFieldResolver(field string) (string, error) {
+	fieldName := o.GetFieldName(field)
+	if fieldName == "" {
+		return "", ierr.NewErrorf("unknown field name '%s' in fx rate query", field).
+			Mark(ierr.ErrValidation)
+	}
+	return fieldName, nil
+}
+
+func (o FXRateQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.FXRateFilter, query FXRateQuery) (FXRateQuery, error) {
+	var err error
+	if f == nil {
+		return query, nil
+	}
+
+	if len(f.FXRateIDs) > 0 {
+		query = query.Where(fxrate.IDIn(f.FXRateIDs...))
+	}
+	if f.BaseCurrency != "" {
+		query = query.Where(fxrate.BaseCurrency(strings.ToLower(f.BaseCurrency)))
+	}
+	if f.QuoteCurrency != "" {
+		query = query.Where(fxrate.QuoteCurrency(strings.ToLower(f.QuoteCurrency)))
+	}
+	if f.Source != "" {
+		query = query.Where(fxrate.Source(string(f.Source)))
+	}
+	if f.EffectiveAtOrBefore != nil {
+		query = query.Where(fxrate.EffectiveAtLTE(*f.EffectiveAtOrBefore))
+	}
+
+	if f.Filters != nil {
+		query, err = dsl.ApplyFilters[FXRateQuery, predicate.FXRate](
+			query,
+			f.Filters,
+			o.GetFieldResolver,
+			func(p dsl.Predicate) predicate.FXRate { return predicate.FXRate(p) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if f.Sort != nil {
+		query, err = dsl.ApplySorts[FXRateQuery, fxrate.OrderOption](
+			query,
+			f.Sort,
+			o.GetFieldResolver,
+			func(o dsl.OrderFunc) fxrate.OrderOption { return fxrate.OrderOption(o) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return query, nil
+}
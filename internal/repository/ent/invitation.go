@@ -0,0 +1,191 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/invitation"
+	"github.com/flexprice/flexprice/ent/predicate"
+	domainInvitation "github.com/flexprice/flexprice/internal/domain/invitation"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type invitationRepository struct {
+	client postgres.IClient
+	log    *logger.Logger
+}
+
+func NewInvitationRepository(client postgres.IClient, log *logger.Logger) domainInvitation.Repository {
+	return &invitationRepository{
+		client: client,
+		log:    log,
+	}
+}
+
+func (r *invitationRepository) Create(ctx context.Context, i *domainInvitation.Invitation) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("creating invitation",
+		"invitation_id", i.ID,
+		"tenant_id", i.TenantID,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "invitation", "create", map[string]interface{}{
+		"invitation_id": i.ID,
+	})
+	defer FinishSpan(span)
+
+	created, err := client.Invitation.Create().
+		SetID(i.ID).
+		SetTenantID(i.TenantID).
+		SetEmail(i.Email).
+		SetRoles(i.Roles).
+		SetTokenHash(i.TokenHash).
+		SetInvitationStatus(string(i.InvitationStatus)).
+		SetExpiresAt(i.ExpiresAt).
+		SetStatus(string(i.Status)).
+		SetCreatedAt(i.CreatedAt).
+		SetUpdatedAt(i.UpdatedAt).
+		SetCreatedBy(i.CreatedBy).
+		SetUpdatedBy(i.UpdatedBy).
+		Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHintf("%s already has a pending invitation", i.Email).
+				WithReportableDetails(map[string]any{
+					"email": i.Email,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create invitation").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	*i = *domainInvitation.FromEnt(created)
+	return nil
+}
+
+func (r *invitationRepository) Get(ctx context.Context, id string) (*domainInvitation.Invitation, error) {
+	return r.get(ctx, "get", map[string]interface{}{"invitation_id": id},
+		invitation.ID(id),
+		invitation.TenantID(types.GetTenantID(ctx)),
+		invitation.Status(string(types.StatusPublished)),
+	)
+}
+
+func (r *invitationRepository) GetPendingByEmail(ctx context.Context, email string) (*domainInvitation.Invitation, error) {
+	return r.get(ctx, "get_pending_by_email", map[string]interface{}{"email": email},
+		invitation.Email(email),
+		invitation.TenantID(types.GetTenantID(ctx)),
+		invitation.InvitationStatus(string(types.InvitationStatusPending)),
+		invitation.Status(string(types.StatusPublished)),
+	)
+}
+
+func (r *invitationRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domainInvitation.Invitation, error) {
+	return r.get(ctx, "get_by_token_hash", nil,
+		invitation.TokenHash(tokenHash),
+		invitation.Status(string(types.StatusPublished)),
+	)
+}
+
+func (r *invitationRepository) get(ctx context.Context, operation string, details map[string]interface{}, preds ...predicate.Invitation) (*domainInvitation.Invitation, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "invitation", operation, details)
+	defer FinishSpan(span)
+
+	i, err := r.client.Reader(ctx).Invitation.Query().
+		Where(preds...).
+		Only(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHint("Invitation was not found").
+				WithReportableDetails(details).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get invitation").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainInvitation.FromEnt(i), nil
+}
+
+func (r *invitationRepository) Update(ctx context.Context, i *domainInvitation.Invitation) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("updating invitation",
+		"invitation_id", i.ID,
+		"tenant_id", i.TenantID,
+		"invitation_status", i.InvitationStatus,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "invitation", "update", map[string]interface{}{
+		"invitation_id": i.ID,
+	})
+	defer FinishSpan(span)
+
+	update := client.Invitation.Update().
+		Where(
+			invitation.ID(i.ID),
+			invitation.TenantID(i.TenantID),
+		).
+		SetInvitationStatus(string(i.InvitationStatus)).
+		SetAcceptedBy(i.AcceptedBy).
+		SetNillableRespondedAt(i.RespondedAt).
+		SetUpdatedAt(time.Now().UTC())
+	// Invitees respond without being signed in to the tenant
+	if userID := types.GetUserID(ctx); userID != "" {
+		update = update.SetUpdatedBy(userID)
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to update invitation").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *invitationRepository) ListByTenant(ctx context.Context) ([]*domainInvitation.Invitation, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "invitation", "list_by_tenant", map[string]interface{}{
+		"tenant_id": types.GetTenantID(ctx),
+	})
+	defer FinishSpan(span)
+
+	invitations, err := r.client.Reader(ctx).Invitation.Query().
+		Where(
+			invitation.TenantID(types.GetTenantID(ctx)),
+			invitation.Status(string(types.StatusPublished)),
+		).
+		Order(ent.Desc(invitation.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list invitations").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainInvitation.FromEntList(invitations), nil
+}
@@ -0,0 +1,400 @@
+package ent
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/pricebook"
+	domainPriceBook "github.com/flexprice/flexprice/internal/domain/pricebook"
+	"github.com/flexprice/flexprice/internal/dsl"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type priceBookRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts PriceBookQueryOptions
+}
+
+func NewPriceBookRepository(client postgres.IClient, log *logger.Logger) domainPriceBook.Repository {
+	return &priceBookRepository{
+		client:    client,
+		log:       log,
+		queryOpts: PriceBookQueryOptions{},
+	}
+}
+
+func (r *priceBookRepository) Create(ctx context.Context, book *domainPriceBook.PriceBook) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("creating price book",
+		"price_book_id", book.ID,
+		"tenant_id", book.TenantID,
+		"base_currency", book.BaseCurrency,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "price_book", "create", map[string]interface{}{
+		"price_book_id": book.ID,
+		"base_currency": book.BaseCurrency,
+	})
+	defer FinishSpan(span)
+
+	// Set environment ID from context if not already set
+	if book.EnvironmentID == "" {
+		book.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	createQuery := client.PriceBook.Create().
+		SetID(book.ID).
+		SetTenantID(book.TenantID).
+		SetName(book.Name).
+		SetDescription(book.Description).
+		SetBaseCurrency(strings.ToLower(book.BaseCurrency)).
+		SetPlanIds(book.PlanIDs).
+		SetAddonIds(book.AddonIDs).
+		SetCurrencies(book.Currencies).
+		SetOverrides(book.Overrides).
+		SetStatus(string(book.Status)).
+		SetCreatedAt(book.CreatedAt).
+		SetUpdatedAt(book.UpdatedAt).
+		SetCreatedBy(book.CreatedBy).
+		SetUpdatedBy(book.UpdatedBy).
+		SetEnvironmentID(book.EnvironmentID)
+
+	if book.Metadata != nil {
+		createQuery = createQuery.SetMetadata(book.Metadata)
+	}
+
+	created, err := createQuery.Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("A price book with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"price_book_id": book.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create price book").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	*book = *domainPriceBook.FromEnt(created)
+	return nil
+}
+
+func (r *priceBookRepository) Get(ctx context.Context, id string) (*domainPriceBook.PriceBook, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "price_book", "get", map[string]interface{}{
+		"price_book_id": id,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Reader(ctx)
+	r.log.Debugw("getting price book", "price_book_id", id)
+
+	book, err := client.PriceBook.Query().
+		Where(
+			pricebook.ID(id),
+			pricebook.TenantID(types.GetTenantID(ctx)),
+			pricebook.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Price book with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"price_book_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get price book").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainPriceBook.FromEnt(book), nil
+}
+
+func (r *priceBookRepository) Update(ctx context.Context, book *domainPriceBook.PriceBook) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("updating price book",
+		"price_book_id", book.ID,
+		"tenant_id", book.TenantID,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "price_book", "update", map[string]interface{}{
+		"price_book_id": book.ID,
+	})
+	defer FinishSpan(span)
+
+	updateQuery := client.PriceBook.Update().
+		Where(
+			pricebook.ID(book.ID),
+			pricebook.TenantID(book.TenantID),
+			pricebook.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetName(book.Name).
+		SetDescription(book.Description).
+		SetPlanIds(book.PlanIDs).
+		SetAddonIds(book.AddonIDs).
+		SetCurrencies(book.Currencies).
+		SetOverrides(book.Overrides).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	if book.Metadata != nil {
+		updateQuery = updateQuery.SetMetadata(book.Metadata)
+	}
+
+	_, err := updateQuery.Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Price book with ID %s was not found", book.ID).
+				WithReportableDetails(map[string]any{
+					"price_book_id": book.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update price book").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *priceBookRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("deleting price book",
+		"price_book_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+		"environment_id", types.GetEnvironmentID(ctx),
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "price_book", "delete", map[string]interface{}{
+		"price_book_id": id,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.PriceBook.Update().
+		Where(
+			pricebook.ID(id),
+			pricebook.TenantID(types.GetTenantID(ctx)),
+			pricebook.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetStatus(string(types.StatusArchived)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Price book with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"price_book_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to delete price book").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *priceBookRepository) List(ctx context.Context, filter *types.PriceBookFilter) ([]*domainPriceBook.PriceBook, error) {
+	client := r.client.Reader(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "price_book", "list", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := client.PriceBook.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query, err := r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list price books").
+			Mark(ierr.ErrDatabase)
+	}
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	books, err := query.All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list price books").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainPriceBook.FromEntList(books), nil
+}
+
+func (r *priceBookRepository) Count(ctx context.Context, filter *types.PriceBookFilter) (int, error) {
+	client := r.client.Reader(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "price_book", "count", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := client.PriceBook.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+
+	var err error
+	query, err = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to apply query options").
+			Mark(ierr.ErrDatabase)
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count price books").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return count, nil
+}
+
+// PriceBookQuery type alias for better readability
+type PriceBookQuery = *ent.PriceBookQuery
+
+// PriceBookQueryOptions implements BaseQueryOptions for price book queries
+type PriceBookQueryOptions struct{}
+
+func (o PriceBookQueryOptions) ApplyTenantFilter(ctx context.Context, query PriceBookQuery) PriceBookQuery {
+	return query.Where(pricebook.TenantIDEQ(types.GetTenantID(ctx)))
+}
+
+func (o PriceBookQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query PriceBookQuery) PriceBookQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(pricebook.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o PriceBookQueryOptions) ApplyStatusFilter(query PriceBookQuery, status string) PriceBookQuery {
+	if status == "" {
+		return query.Where(pricebook.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(pricebook.Status(status))
+}
+
+func (o PriceBookQueryOptions) ApplySortFilter(query PriceBookQuery, field string, order string) PriceBookQuery {
+	if field != "" {
+		if order == types.OrderDesc {
+			query = query.Order(ent.Desc(o.GetFieldName(field)))
+		} else {
+			query = query.Order(ent.Asc(o.GetFieldName(field)))
+		}
+	}
+	return query
+}
+
+func (o PriceBookQueryOptions) ApplyPaginationFilter(query PriceBookQuery, limit int, offset int) PriceBookQuery {
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o PriceBookQueryOptions) GetFieldName(field string) string {
+	if pricebook.ValidColumn(field) {
+		return field
+	}
+	return ""
+}
+
+func (o PriceBookQueryOptions) GetFieldResolver(field string) (string, error) {
+	fieldName := o.GetFieldName(field)
+	if fieldName == "" {
+		return "", ierr.NewErrorf("unknown field name '%s' in price book query", field).
+			Mark(ierr.ErrValidation)
+	}
+	return fieldName, nil
+}
+
+func (o PriceBookQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.PriceBookFilter, query PriceBookQuery) (PriceBookQuery, error) {
+	var err error
+	if f == nil {
+		return query, nil
+	}
+
+	if len(f.PriceBookIDs) > 0 {
+		query = query.Where(pricebook.IDIn(f.PriceBookIDs...))
+	}
+	if f.BaseCurrency != "" {
+		query = query.Where(pricebook.BaseCurrency(strings.ToLower(f.BaseCurrency)))
+	}
+
+	if f.Filters != nil {
+		query, err = dsl.ApplyFilters[PriceBookQuery, predicate.PriceBook](
+			query,
+			f.Filters,
+			o.GetFieldResolver,
+			func(p dsl.Predicate) predicate.PriceBook { return predicate.PriceBook(p) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if f.Sort != nil {
+		query, err = dsl.ApplySorts[PriceBookQuery, pricebook.OrderOption](
+			query,
+			f.Sort,
+			o.GetFieldResolver,
+			func(o dsl.OrderFunc) pricebook.OrderOption { return pricebook.OrderOption(o) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return query, nil
+}
@@ -0,0 +1,569 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/promotioncode"
+	"github.com/flexprice/flexprice/internal/cache"
+	domainPromotionCode "github.com/flexprice/flexprice/internal/domain/promotion_code"
+	"github.com/flexprice/flexprice/internal/dsl"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+type promotionCodeRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts PromotionCodeQueryOptions
+	cache     cache.Cache
+}
+
+func NewPromotionCodeRepository(client postgres.IClient, log *logger.Logger, cache cache.Cache) domainPromotionCode.Repository {
+	return &promotionCodeRepository{
+		client:    client,
+		log:       log,
+		queryOpts: PromotionCodeQueryOptions{},
+		cache:     cache,
+	}
+}
+
+func (r *promotionCodeRepository) Create(ctx context.Context, p *domainPromotionCode.PromotionCode) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("creating promotion code",
+		"promotion_code_id", p.ID,
+		"tenant_id", p.TenantID,
+		"coupon_id", p.CouponID,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "promotion_code", "create", map[string]interface{}{
+		"promotion_code_id": p.ID,
+		"coupon_id":         p.CouponID,
+	})
+	defer FinishSpan(span)
+
+	// Set environment ID from context if not already set
+	if p.EnvironmentID == "" {
+		p.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	createQuery := client.PromotionCode.Create().
+		SetID(p.ID).
+		SetTenantID(p.TenantID).
+		SetCouponID(p.CouponID).
+		SetCode(domainPromotionCode.NormalizeCode(p.Code)).
+		SetActive(p.Active).
+		SetNillableMaxRedemptions(p.MaxRedemptions).
+		SetNillableMaxRedemptionsPerCustomer(p.MaxRedemptionsPerCustomer).
+		SetTimesRedeemed(p.TimesRedeemed).
+		SetFirstTimeCustomerOnly(p.FirstTimeCustomerOnly).
+		SetNillableExpiresAt(p.ExpiresAt).
+		SetNillableMinimumAmount(p.MinimumAmount).
+		SetNillableMinimumAmountCurrency(lo.EmptyableToPtr(p.MinimumAmountCurrency)).
+		SetStatus(string(p.Status)).
+		SetCreatedAt(p.CreatedAt).
+		SetUpdatedAt(p.UpdatedAt).
+		SetCreatedBy(p.CreatedBy).
+		SetUpdatedBy(p.UpdatedBy).
+		SetEnvironmentID(p.EnvironmentID)
+
+	if p.Metadata != nil {
+		createQuery = createQuery.SetMetadata(p.Metadata)
+	}
+
+	code, err := createQuery.Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("A promotion code with this code already exists").
+				WithReportableDetails(map[string]any{
+					"code": p.Code,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create promotion code").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	*p = *domainPromotionCode.FromEnt(code)
+	return nil
+}
+
+func (r *promotionCodeRepository) Get(ctx context.Context, id string) (*domainPromotionCode.PromotionCode, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "promotion_code", "get", map[string]interface{}{
+		"promotion_code_id": id,
+	})
+	defer FinishSpan(span)
+
+	// Try to get from cache first
+	if cachedCode := r.GetCache(ctx, id); cachedCode != nil {
+		return cachedCode, nil
+	}
+
+	client := r.client.Reader(ctx)
+	r.log.Debugw("getting promotion code", "promotion_code_id", id)
+
+	p, err := client.PromotionCode.Query().
+		Where(
+			promotioncode.ID(id),
+			promotioncode.TenantID(types.GetTenantID(ctx)),
+			promotioncode.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Promotion code with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"promotion_code_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get promotion code").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	code := domainPromotionCode.FromEnt(p)
+	r.SetCache(ctx, code)
+	return code, nil
+}
+
+func (r *promotionCodeRepository) GetByCode(ctx context.Context, code string) (*domainPromotionCode.PromotionCode, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "promotion_code", "get_by_code", map[string]interface{}{
+		"code": code,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Reader(ctx)
+	r.log.Debugw("getting promotion code by code", "code", code)
+
+	p, err := client.PromotionCode.Query().
+		Where(
+			promotioncode.Code(domainPromotionCode.NormalizeCode(code)),
+			promotioncode.TenantID(types.GetTenantID(ctx)),
+			promotioncode.EnvironmentID(types.GetEnvironmentID(ctx)),
+			promotioncode.Status(string(types.StatusPublished)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Promotion code %s was not found", code).
+				WithReportableDetails(map[string]any{
+					"code": code,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get promotion code").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainPromotionCode.FromEnt(p), nil
+}
+
+func (r *promotionCodeRepository) Update(ctx context.Context, p *domainPromotionCode.PromotionCode) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("updating promotion code",
+		"promotion_code_id", p.ID,
+		"tenant_id", p.TenantID,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "promotion_code", "update", map[string]interface{}{
+		"promotion_code_id": p.ID,
+	})
+	defer FinishSpan(span)
+
+	updateQuery := client.PromotionCode.Update().
+		Where(
+			promotioncode.ID(p.ID),
+			promotioncode.TenantID(p.TenantID),
+			promotioncode.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetActive(p.Active).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	if p.MaxRedemptions != nil {
+		updateQuery = updateQuery.SetMaxRedemptions(*p.MaxRedemptions)
+	} else {
+		updateQuery = updateQuery.ClearMaxRedemptions()
+	}
+	if p.MaxRedemptionsPerCustomer != nil {
+		updateQuery = updateQuery.SetMaxRedemptionsPerCustomer(*p.MaxRedemptionsPerCustomer)
+	} else {
+		updateQuery = updateQuery.ClearMaxRedemptionsPerCustomer()
+	}
+	if p.ExpiresAt != nil {
+		updateQuery = updateQuery.SetExpiresAt(*p.ExpiresAt)
+	} else {
+		updateQuery = updateQuery.ClearExpiresAt()
+	}
+	if p.Metadata != nil {
+		updateQuery = updateQuery.SetMetadata(p.Metadata)
+	}
+
+	_, err := updateQuery.Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Promotion code with ID %s was not found", p.ID).
+				WithReportableDetails(map[string]any{
+					"promotion_code_id": p.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update promotion code").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	r.DeleteCache(ctx, p)
+	return nil
+}
+
+func (r *promotionCodeRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("deleting promotion code",
+		"promotion_code_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+		"environment_id", types.GetEnvironmentID(ctx),
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "promotion_code", "delete", map[string]interface{}{
+		"promotion_code_id": id,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.PromotionCode.Update().
+		Where(
+			promotioncode.ID(id),
+			promotioncode.TenantID(types.GetTenantID(ctx)),
+			promotioncode.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetStatus(string(types.StatusArchived)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Promotion code with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"promotion_code_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to delete promotion code").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	r.DeleteCache(ctx, &domainPromotionCode.PromotionCode{ID: id})
+	return nil
+}
+
+func (r *promotionCodeRepository) IncrementRedemptions(ctx context.Context, id string) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("incrementing promotion code redemptions",
+		"promotion_code_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+		"environment_id", types.GetEnvironmentID(ctx),
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "promotion_code", "increment_redemptions", map[string]interface{}{
+		"promotion_code_id": id,
+	})
+	defer FinishSpan(span)
+
+	// Only count the redemption while the code has redemptions left, so concurrent
+	// redemptions cannot push a code past max_redemptions
+	n, err := client.PromotionCode.Update().
+		Where(
+			promotioncode.ID(id),
+			promotioncode.TenantID(types.GetTenantID(ctx)),
+			promotioncode.EnvironmentID(types.GetEnvironmentID(ctx)),
+			promotioncode.Or(
+				promotioncode.MaxRedemptionsIsNil(),
+				func(s *sql.Selector) {
+					s.Where(sql.ColumnsLT(s.C(promotioncode.FieldTimesRedeemed), s.C(promotioncode.FieldMaxRedemptions)))
+				},
+			),
+		).
+		AddTimesRedeemed(1).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to increment promotion code redemptions").
+			Mark(ierr.ErrDatabase)
+	}
+
+	if n == 0 {
+		err := ierr.NewError("promotion code has reached maximum redemptions").
+			WithHint("Promotion code has reached maximum redemptions").
+			WithReportableDetails(map[string]any{
+				"promotion_code_id": id,
+			}).
+			Mark(ierr.ErrValidation)
+		SetSpanError(span, err)
+		return err
+	}
+
+	SetSpanSuccess(span)
+	r.DeleteCache(ctx, &domainPromotionCode.PromotionCode{ID: id})
+	return nil
+}
+
+func (r *promotionCodeRepository) List(ctx context.Context, filter *types.PromotionCodeFilter) ([]*domainPromotionCode.PromotionCode, error) {
+	client := r.client.Reader(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "promotion_code", "list", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := client.PromotionCode.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query, err := r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list promotion codes").
+			Mark(ierr.ErrDatabase)
+	}
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	codes, err := query.All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list promotion codes").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainPromotionCode.FromEntList(codes), nil
+}
+
+func (r *promotionCodeRepository) Count(ctx context.Context, filter *types.PromotionCodeFilter) (int, error) {
+	client := r.client.Reader(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "promotion_code", "count", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := client.PromotionCode.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+
+	var err error
+	query, err = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to apply query options").
+			Mark(ierr.ErrDatabase)
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count promotion codes").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return count, nil
+}
+
+// PromotionCodeQuery type alias for better readability
+type PromotionCodeQuery = *ent.PromotionCodeQuery
+
+// PromotionCodeQueryOptions implements BaseQueryOptions for promotion code queries
+type PromotionCodeQueryOptions struct{}
+
+func (o PromotionCodeQueryOptions) ApplyTenantFilter(ctx context.Context, query PromotionCodeQuery) PromotionCodeQuery {
+	return query.Where(promotioncode.TenantIDEQ(types.GetTenantID(ctx)))
+}
+
+func (o PromotionCodeQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query PromotionCodeQuery) PromotionCodeQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(promotioncode.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o PromotionCodeQueryOptions) ApplyStatusFilter(query PromotionCodeQuery, status string) PromotionCodeQuery {
+	if status == "" {
+		return query.Where(promotioncode.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(promotioncode.Status(status))
+}
+
+func (o PromotionCodeQueryOptions) ApplySortFilter(query PromotionCodeQuery, field string, order string) PromotionCodeQuery {
+	if field != "" {
+		if order == types.OrderDesc {
+			query = query.Order(ent.Desc(o.GetFieldName(field)))
+		} else {
+			query = query.Order(ent.Asc(o.GetFieldName(field)))
+		}
+	}
+	return query
+}
+
+func (o PromotionCodeQueryOptions) ApplyPaginationFilter(query PromotionCodeQuery, limit int, offset int) PromotionCodeQuery {
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o PromotionCodeQueryOptions) GetFieldName(field string) string {
+	if promotioncode.ValidColumn(field) {
+		return field
+	}
+	return ""
+}
+
+func (o PromotionCodeQueryOptions) GetFieldResolver(field string) (string, error) {
+	fieldName := o.GetFieldName(field)
+	if fieldName == "" {
+		return "", ierr.NewErrorf("unknown field name '%s' in promotion code query", field).
+			Mark(ierr.ErrValidation)
+	}
+	return fieldName, nil
+}
+
+func (o PromotionCodeQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.PromotionCodeFilter, query PromotionCodeQuery) (PromotionCodeQuery, error) {
+	var err error
+	if f == nil {
+		return query, nil
+	}
+
+	if len(f.PromotionCodeIDs) > 0 {
+		query = query.Where(promotioncode.IDIn(f.PromotionCodeIDs...))
+	}
+	if len(f.CouponIDs) > 0 {
+		query = query.Where(promotioncode.CouponIDIn(f.CouponIDs...))
+	}
+	if len(f.Codes) > 0 {
+		query = query.Where(promotioncode.CodeIn(lo.Map(f.Codes, func(code string, _ int) string {
+			return domainPromotionCode.NormalizeCode(code)
+		})...))
+	}
+	if f.Active != nil {
+		query = query.Where(promotioncode.Active(*f.Active))
+	}
+
+	if f.Filters != nil {
+		query, err = dsl.ApplyFilters[PromotionCodeQuery, predicate.PromotionCode](
+			query,
+			f.Filters,
+			o.GetFieldResolver,
+			func(p dsl.Predicate) predicate.PromotionCode { return predicate.PromotionCode(p) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if f.Sort != nil {
+		query, err = dsl.ApplySorts[PromotionCodeQuery, promotioncode.OrderOption](
+			query,
+			f.Sort,
+			o.GetFieldResolver,
+			func(o dsl.OrderFunc) promotioncode.OrderOption { return promotioncode.OrderOption(o) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return query, nil
+}
+
+func (r *promotionCodeRepository) SetCache(ctx context.Context, code *domainPromotionCode.PromotionCode) {
+	span := cache.StartCacheSpan(ctx, "promotion_code", "set", map[string]interface{}{
+		"promotion_code_id": code.ID,
+	})
+	defer cache.FinishSpan(span)
+
+	cacheKey := cache.GenerateKey(cache.PrefixPromotionCode, types.GetTenantID(ctx), types.GetEnvironmentID(ctx), code.ID)
+	r.cache.Set(ctx, cacheKey, code, cache.ExpiryDefaultInMemory)
+
+	r.log.Debugw("cache set", "key", cacheKey)
+}
+
+func (r *promotionCodeRepository) GetCache(ctx context.Context, key string) *domainPromotionCode.PromotionCode {
+	span := cache.StartCacheSpan(ctx, "promotion_code", "get", map[string]interface{}{
+		"promotion_code_id": key,
+	})
+	defer cache.FinishSpan(span)
+
+	cacheKey := cache.GenerateKey(cache.PrefixPromotionCode, types.GetTenantID(ctx), types.GetEnvironmentID(ctx), key)
+	if value, found := r.cache.Get(ctx, cacheKey); found {
+		if code, ok := value.(*domainPromotionCode.PromotionCode); ok {
+			r.log.Debugw("cache hit", "key", cacheKey)
+			return code
+		}
+	}
+	return nil
+}
+
+func (r *promotionCodeRepository) DeleteCache(ctx context.Context, code *domainPromotionCode.PromotionCode) {
+	span := cache.StartCacheSpan(ctx, "promotion_code", "delete", map[string]interface{}{
+		"promotion_code_id": code.ID,
+	})
+	defer cache.FinishSpan(span)
+
+	cacheKey := cache.GenerateKey(cache.PrefixPromotionCode, types.GetTenantID(ctx), types.GetEnvironmentID(ctx), code.ID)
+	r.cache.Delete(ctx, cacheKey)
+	r.log.Debugw("cache deleted", "key", cacheKey)
+}
@@ -0,0 +1,219 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/rbacrole"
+	domainRBACRole "github.com/flexprice/flexprice/internal/domain/rbacrole"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type rbacRoleRepository struct {
+	client postgres.IClient
+	log    *logger.Logger
+}
+
+func NewRBACRoleRepository(client postgres.IClient, log *logger.Logger) domainRBACRole.Repository {
+	return &rbacRoleRepository{
+		client: client,
+		log:    log,
+	}
+}
+
+func (r *rbacRoleRepository) Create(ctx context.Context, role *domainRBACRole.RBACRole) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("creating rbac role",
+		"role_id", role.ID,
+		"tenant_id", role.TenantID,
+		"name", role.Name,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "rbac_role", "create", map[string]interface{}{
+		"role_id": role.ID,
+		"name":    role.Name,
+	})
+	defer FinishSpan(span)
+
+	created, err := client.RBACRole.Create().
+		SetID(role.ID).
+		SetTenantID(role.TenantID).
+		SetName(role.Name).
+		SetDescription(role.Description).
+		SetPermissions(role.Permissions).
+		SetEnvironmentIds(role.EnvironmentIDs).
+		SetStatus(string(role.Status)).
+		SetCreatedAt(role.CreatedAt).
+		SetUpdatedAt(role.UpdatedAt).
+		SetCreatedBy(role.CreatedBy).
+		SetUpdatedBy(role.UpdatedBy).
+		Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHintf("A role named %s already exists", role.Name).
+				WithReportableDetails(map[string]any{
+					"name": role.Name,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create role").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	*role = *domainRBACRole.FromEnt(created)
+	return nil
+}
+
+func (r *rbacRoleRepository) Get(ctx context.Context, id string) (*domainRBACRole.RBACRole, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "rbac_role", "get", map[string]interface{}{
+		"role_id": id,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Reader(ctx)
+	r.log.Debugw("getting rbac role", "role_id", id)
+
+	role, err := client.RBACRole.Query().
+		Where(
+			rbacrole.ID(id),
+			rbacrole.TenantID(types.GetTenantID(ctx)),
+			rbacrole.Status(string(types.StatusPublished)),
+		).
+		Only(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Role with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"role_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get role").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainRBACRole.FromEnt(role), nil
+}
+
+func (r *rbacRoleRepository) Update(ctx context.Context, role *domainRBACRole.RBACRole) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("updating rbac role",
+		"role_id", role.ID,
+		"tenant_id", role.TenantID,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "rbac_role", "update", map[string]interface{}{
+		"role_id": role.ID,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.RBACRole.Update().
+		Where(
+			rbacrole.ID(role.ID),
+			rbacrole.TenantID(role.TenantID),
+		).
+		SetName(role.Name).
+		SetDescription(role.Description).
+		SetPermissions(role.Permissions).
+		SetEnvironmentIds(role.EnvironmentIDs).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHintf("A role named %s already exists", role.Name).
+				WithReportableDetails(map[string]any{
+					"name": role.Name,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update role").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *rbacRoleRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("deleting rbac role",
+		"role_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "rbac_role", "delete", map[string]interface{}{
+		"role_id": id,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.RBACRole.Update().
+		Where(
+			rbacrole.ID(id),
+			rbacrole.TenantID(types.GetTenantID(ctx)),
+		).
+		SetStatus(string(types.StatusArchived)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to delete role").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *rbacRoleRepository) ListByTenant(ctx context.Context) ([]*domainRBACRole.RBACRole, error) {
+	tenantID := types.GetTenantID(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "rbac_role", "list_by_tenant", map[string]interface{}{
+		"tenant_id": tenantID,
+	})
+	defer FinishSpan(span)
+
+	roles, err := r.client.Reader(ctx).RBACRole.Query().
+		Where(
+			rbacrole.TenantID(tenantID),
+			rbacrole.Status(string(types.StatusPublished)),
+		).
+		All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list roles").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainRBACRole.FromEntList(roles), nil
+}
@@ -2,6 +2,8 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/flexprice/flexprice/ent"
@@ -12,6 +14,7 @@ import (
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/postgres"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 )
 
 type secretRepository struct {
@@ -63,12 +66,22 @@ func (r *secretRepository) Create(ctx context.Context, s *domainSecret.Secret) e
 		SetEnvironmentID(s.EnvironmentID).
 		SetRoles(s.Roles).
 		SetUserType(s.UserType).
+		SetNillableRateLimitPerMinute(s.RateLimitPerMinute).
+		SetNillableRotatedFromID(s.RotatedFromID).
 		SetStatus(string(s.Status)).
 		SetCreatedAt(s.CreatedAt).
 		SetUpdatedAt(s.UpdatedAt).
 		SetCreatedBy(s.CreatedBy).
 		SetUpdatedBy(s.UpdatedBy)
 
+	if s.Scopes != nil {
+		create.SetScopes(s.Scopes)
+	}
+
+	if s.AllowedIPs != nil {
+		create.SetAllowedIps(s.AllowedIPs)
+	}
+
 	if s.ProviderData != nil {
 		create.SetProviderData(s.ProviderData)
 	}
@@ -306,6 +319,80 @@ func (r *secretRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// Update updates the name, expiry and restrictions of a secret
+func (r *secretRepository) Update(ctx context.Context, s *domainSecret.Secret) error {
+	client := r.client.Writer(ctx)
+	r.log.Debugw("updating secret", "secret_id", s.ID)
+
+	update := client.Secret.UpdateOneID(s.ID).
+		Where(secret.TenantID(types.GetTenantID(ctx))).
+		SetName(s.Name).
+		SetScopes(lo.Ternary(s.Scopes != nil, s.Scopes, []string{})).
+		SetAllowedIps(lo.Ternary(s.AllowedIPs != nil, s.AllowedIPs, []string{})).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		SetUpdatedAt(time.Now().UTC())
+
+	if s.RateLimitPerMinute != nil {
+		update.SetRateLimitPerMinute(*s.RateLimitPerMinute)
+	} else {
+		update.ClearRateLimitPerMinute()
+	}
+
+	if s.ExpiresAt != nil {
+		update.SetExpiresAt(*s.ExpiresAt)
+	} else {
+		update.ClearExpiresAt()
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHint("Secret not found").
+				WithReportableDetails(map[string]interface{}{
+					"secret_id": s.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update secret").
+			WithReportableDetails(map[string]interface{}{
+				"secret_id": s.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	r.DeleteCache(ctx, s.Value)
+	return nil
+}
+
+func (r *secretRepository) UpdateRoles(ctx context.Context, id string, roles []string) error {
+	// Get the secret first to invalidate cache
+	secret, err := r.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	client := r.client.Writer(ctx)
+	r.log.Debugw("updating secret roles", "secret_id", id, "roles", roles)
+
+	err = client.Secret.UpdateOneID(id).
+		SetRoles(roles).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		SetUpdatedAt(time.Now().UTC()).
+		Exec(ctx)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to update secret roles").
+			WithReportableDetails(map[string]interface{}{
+				"secret_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	r.DeleteCache(ctx, secret.Value)
+	return nil
+}
+
 type SecretQuery = *ent.SecretQuery
 
 type SecretQueryOptions struct{}
@@ -393,13 +480,24 @@ func (o SecretQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.
 	return query
 }
 
+// SetCache caches the secret in the configured cache. Secrets are stored as JSON so they can
+// be shared through Redis, where revocations reach every node at once.
 func (r *secretRepository) SetCache(ctx context.Context, secret *domainSecret.Secret) {
 	span := cache.StartCacheSpan(ctx, "secret", "set", map[string]interface{}{
 		"secret_id": secret.ID,
 	})
 	defer cache.FinishSpan(span)
+
+	data, err := json.Marshal(secret)
+	if err != nil {
+		return
+	}
+	expiry := cache.ExpirySecretInMemory
+	if _, ok := r.cache.(*cache.RedisCache); ok {
+		expiry = cache.ExpiryDefaultRedis
+	}
 	cacheKey := cache.GenerateKey(cache.PrefixSecret, secret.Value)
-	r.cache.Set(ctx, cacheKey, secret, cache.ExpiryDefaultInMemory)
+	r.cache.Set(ctx, cacheKey, string(data), expiry)
 }
 
 func (r *secretRepository) GetCache(ctx context.Context, key string) *domainSecret.Secret {
@@ -409,7 +507,10 @@ func (r *secretRepository) GetCache(ctx context.Context, key string) *domainSecr
 	defer cache.FinishSpan(span)
 	cacheKey := cache.GenerateKey(cache.PrefixSecret, key)
 	if value, found := r.cache.Get(ctx, cacheKey); found {
-		return value.(*domainSecret.Secret)
+		var cached domainSecret.Secret
+		if err := json.Unmarshal([]byte(fmt.Sprint(value)), &cached); err == nil {
+			return &cached
+		}
 	}
 	return nil
 }
@@ -0,0 +1,291 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/ssoconnection"
+	domainSSO "github.com/flexprice/flexprice/internal/domain/sso"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type ssoConnectionRepository struct {
+	client postgres.IClient
+	log    *logger.Logger
+}
+
+func NewSSOConnectionRepository(client postgres.IClient, log *logger.Logger) domainSSO.Repository {
+	return &ssoConnectionRepository{
+		client: client,
+		log:    log,
+	}
+}
+
+func (r *ssoConnectionRepository) Create(ctx context.Context, c *domainSSO.Connection) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("creating sso connection",
+		"connection_id", c.ID,
+		"tenant_id", c.TenantID,
+		"protocol", c.Protocol,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "sso_connection", "create", map[string]interface{}{
+		"connection_id": c.ID,
+		"protocol":      c.Protocol,
+	})
+	defer FinishSpan(span)
+
+	created, err := client.SSOConnection.Create().
+		SetID(c.ID).
+		SetTenantID(c.TenantID).
+		SetName(c.Name).
+		SetProtocol(string(c.Protocol)).
+		SetDomains(c.Domains).
+		SetEnabled(c.Enabled).
+		SetVerifiedDomains(c.VerifiedDomains).
+		SetDomainVerificationToken(c.DomainVerificationToken).
+		SetIssuerURL(c.IssuerURL).
+		SetClientID(c.ClientID).
+		SetClientSecret(c.ClientSecret).
+		SetScopes(c.Scopes).
+		SetIdpMetadataURL(c.IdPMetadataURL).
+		SetIdpMetadataXML(c.IdPMetadataXML).
+		SetRoleClaim(c.RoleClaim).
+		SetRoleMappings(c.RoleMappings).
+		SetDefaultRoles(c.DefaultRoles).
+		SetJitProvisioning(c.JITProvisioning).
+		SetStatus(string(c.Status)).
+		SetCreatedAt(c.CreatedAt).
+		SetUpdatedAt(c.UpdatedAt).
+		SetCreatedBy(c.CreatedBy).
+		SetUpdatedBy(c.UpdatedBy).
+		Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHintf("An SSO connection named %s already exists", c.Name).
+				WithReportableDetails(map[string]any{
+					"name": c.Name,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create SSO connection").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	*c = *domainSSO.FromEnt(created)
+	return nil
+}
+
+func (r *ssoConnectionRepository) Get(ctx context.Context, id string) (*domainSSO.Connection, error) {
+	return r.get(ctx, "get", id,
+		ssoconnection.ID(id),
+		ssoconnection.TenantID(types.GetTenantID(ctx)),
+		ssoconnection.Status(string(types.StatusPublished)),
+	)
+}
+
+func (r *ssoConnectionRepository) GetByID(ctx context.Context, id string) (*domainSSO.Connection, error) {
+	return r.get(ctx, "get_by_id", id,
+		ssoconnection.ID(id),
+		ssoconnection.Status(string(types.StatusPublished)),
+	)
+}
+
+func (r *ssoConnectionRepository) get(ctx context.Context, operation, id string, preds ...predicate.SSOConnection) (*domainSSO.Connection, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "sso_connection", operation, map[string]interface{}{
+		"connection_id": id,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Reader(ctx)
+	r.log.Debugw("getting sso connection", "connection_id", id)
+
+	c, err := client.SSOConnection.Query().
+		Where(preds...).
+		Only(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("SSO connection with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"connection_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get SSO connection").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainSSO.FromEnt(c), nil
+}
+
+func (r *ssoConnectionRepository) GetByDomain(ctx context.Context, domain string) (*domainSSO.Connection, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "sso_connection", "get_by_domain", map[string]interface{}{
+		"domain": domain,
+	})
+	defer FinishSpan(span)
+
+	c, err := r.client.Reader(ctx).SSOConnection.Query().
+		Where(
+			ssoconnection.Status(string(types.StatusPublished)),
+			func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(s.C(ssoconnection.FieldVerifiedDomains), domain))
+			},
+		).
+		Order(ent.Asc(ssoconnection.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("No SSO connection is configured for %s", domain).
+				WithReportableDetails(map[string]any{
+					"domain": domain,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get SSO connection").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainSSO.FromEnt(c), nil
+}
+
+func (r *ssoConnectionRepository) Update(ctx context.Context, c *domainSSO.Connection) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("updating sso connection",
+		"connection_id", c.ID,
+		"tenant_id", c.TenantID,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "sso_connection", "update", map[string]interface{}{
+		"connection_id": c.ID,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.SSOConnection.Update().
+		Where(
+			ssoconnection.ID(c.ID),
+			ssoconnection.TenantID(c.TenantID),
+		).
+		SetName(c.Name).
+		SetDomains(c.Domains).
+		SetEnabled(c.Enabled).
+		SetVerifiedDomains(c.VerifiedDomains).
+		SetDomainVerificationToken(c.DomainVerificationToken).
+		SetIssuerURL(c.IssuerURL).
+		SetClientID(c.ClientID).
+		SetClientSecret(c.ClientSecret).
+		SetScopes(c.Scopes).
+		SetIdpMetadataURL(c.IdPMetadataURL).
+		SetIdpMetadataXML(c.IdPMetadataXML).
+		SetRoleClaim(c.RoleClaim).
+		SetRoleMappings(c.RoleMappings).
+		SetDefaultRoles(c.DefaultRoles).
+		SetJitProvisioning(c.JITProvisioning).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHintf("An SSO connection named %s already exists", c.Name).
+				WithReportableDetails(map[string]any{
+					"name": c.Name,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update SSO connection").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *ssoConnectionRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("deleting sso connection",
+		"connection_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "sso_connection", "delete", map[string]interface{}{
+		"connection_id": id,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.SSOConnection.Update().
+		Where(
+			ssoconnection.ID(id),
+			ssoconnection.TenantID(types.GetTenantID(ctx)),
+		).
+		SetStatus(string(types.StatusArchived)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to delete SSO connection").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *ssoConnectionRepository) ListByTenant(ctx context.Context) ([]*domainSSO.Connection, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "sso_connection", "list_by_tenant", map[string]interface{}{
+		"tenant_id": types.GetTenantID(ctx),
+	})
+	defer FinishSpan(span)
+
+	connections, err := r.client.Reader(ctx).SSOConnection.Query().
+		Where(
+			ssoconnection.TenantID(types.GetTenantID(ctx)),
+			ssoconnection.Status(string(types.StatusPublished)),
+		).
+		Order(ent.Asc(ssoconnection.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list SSO connections").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainSSO.FromEntList(connections), nil
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/flexprice/flexprice/ent"
 	"github.com/flexprice/flexprice/ent/coupon"
 	"github.com/flexprice/flexprice/ent/couponassociation"
@@ -94,6 +96,7 @@ func (r *subscriptionRepository) Create(ctx context.Context, sub *domainSub.Subs
 		SetNillableInvoicingCustomerID(sub.InvoicingCustomerID).
 		SetNillableParentSubscriptionID(sub.ParentSubscriptionID).
 		SetNillablePaymentTerms(sub.PaymentTerms).
+		SetSeatConfig(sub.SeatConfig).
 		Save(ctx)
 
 	if err != nil {
@@ -195,6 +198,12 @@ func (r *subscriptionRepository) Update(ctx context.Context, sub *domainSub.Subs
 		query.ClearPaymentTerms()
 	}
 
+	if sub.SeatConfig != nil {
+		query.SetSeatConfig(sub.SeatConfig)
+	} else {
+		query.ClearSeatConfig()
+	}
+
 	// Handle nullable date fields - explicitly clear if nil
 	if sub.CancelledAt != nil {
 		query.SetCancelledAt(*sub.CancelledAt)
@@ -578,6 +587,22 @@ func (o *SubscriptionQueryOptions) applyEntityQueryOptions(_ context.Context, f
 		query = query.Where(subscription.SubscriptionTypeIn(f.SubscriptionTypes...))
 	}
 
+	// Apply seat source filter
+	if len(f.SeatSources) > 0 {
+		query = query.Where(func(s *sql.Selector) {
+			preds := make([]*sql.Predicate, 0, len(f.SeatSources))
+			for _, source := range f.SeatSources {
+				preds = append(preds, sqljson.ValueEQ(s.C(subscription.FieldSeatConfig), string(source), sqljson.Path("source")))
+			}
+			s.Where(sql.Or(preds...))
+		})
+	}
+
+	// Apply lookup key filter
+	if len(f.LookupKeys) > 0 {
+		query = query.Where(subscription.LookupKeyIn(f.LookupKeys...))
+	}
+
 	// Apply customer filter
 	if f.CustomerID != "" {
 		query = query.Where(subscription.CustomerID(f.CustomerID))
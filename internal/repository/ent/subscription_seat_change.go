@@ -0,0 +1,336 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/subscriptionseatchange"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+type subscriptionSeatChangeRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts SubscriptionSeatChangeQueryOptions
+}
+
+// NewSubscriptionSeatChangeRepository creates a new subscription seat change repository
+func NewSubscriptionSeatChangeRepository(client postgres.IClient, log *logger.Logger) subscription.SubscriptionSeatChangeRepository {
+	return &subscriptionSeatChangeRepository{
+		client:    client,
+		log:       log,
+		queryOpts: SubscriptionSeatChangeQueryOptions{},
+	}
+}
+
+// Create records a seat change
+func (r *subscriptionSeatChangeRepository) Create(ctx context.Context, change *subscription.SubscriptionSeatChange) error {
+	client := r.client.Writer(ctx)
+
+	span := StartRepositorySpan(ctx, "subscription_seat_change", "create", map[string]interface{}{
+		"seat_change_id":  change.ID,
+		"subscription_id": change.SubscriptionID,
+	})
+	defer FinishSpan(span)
+
+	// Set environment ID from context if not already set
+	if change.EnvironmentID == "" {
+		change.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	entChange, err := client.SubscriptionSeatChange.Create().
+		SetID(change.ID).
+		SetTenantID(change.TenantID).
+		SetSubscriptionID(change.SubscriptionID).
+		SetLineItemID(change.LineItemID).
+		SetPreviousSeats(change.PreviousSeats).
+		SetNewSeats(change.NewSeats).
+		SetSource(change.Source).
+		SetProrationBehavior(change.ProrationBehavior).
+		SetEffectiveDate(change.EffectiveDate).
+		SetProrationAmount(change.ProrationAmount).
+		SetNillableInvoiceID(change.InvoiceID).
+		SetNillableWalletTransactionID(change.WalletTransactionID).
+		SetStatus(string(change.Status)).
+		SetCreatedAt(change.CreatedAt).
+		SetUpdatedAt(change.UpdatedAt).
+		SetCreatedBy(change.CreatedBy).
+		SetUpdatedBy(change.UpdatedBy).
+		SetEnvironmentID(change.EnvironmentID).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to create subscription seat change").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": change.SubscriptionID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	*change = *subscription.SubscriptionSeatChangeFromEnt(entChange)
+	SetSpanSuccess(span)
+	return nil
+}
+
+// Get retrieves a seat change by ID
+func (r *subscriptionSeatChangeRepository) Get(ctx context.Context, id string) (*subscription.SubscriptionSeatChange, error) {
+	client := r.client.Reader(ctx)
+
+	span := StartRepositorySpan(ctx, "subscription_seat_change", "get", map[string]interface{}{
+		"seat_change_id": id,
+	})
+	defer FinishSpan(span)
+
+	entChange, err := client.SubscriptionSeatChange.Query().
+		Where(
+			subscriptionseatchange.ID(id),
+			subscriptionseatchange.TenantID(types.GetTenantID(ctx)),
+			subscriptionseatchange.EnvironmentID(types.GetEnvironmentID(ctx)),
+			subscriptionseatchange.StatusNotIn(string(types.StatusDeleted)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		if ent.IsNotFound(err) {
+			return nil, ierr.NewError("subscription seat change not found").
+				WithHint("The seat change with the given ID does not exist").
+				WithReportableDetails(map[string]interface{}{
+					"seat_change_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get subscription seat change").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return subscription.SubscriptionSeatChangeFromEnt(entChange), nil
+}
+
+// SetInvoiceID links a seat change to the invoice that billed it
+func (r *subscriptionSeatChangeRepository) SetInvoiceID(ctx context.Context, id string, invoiceID string) error {
+	client := r.client.Writer(ctx)
+
+	span := StartRepositorySpan(ctx, "subscription_seat_change", "set_invoice_id", map[string]interface{}{
+		"seat_change_id": id,
+		"invoice_id":     invoiceID,
+	})
+	defer FinishSpan(span)
+
+	err := client.SubscriptionSeatChange.UpdateOneID(id).
+		Where(
+			subscriptionseatchange.TenantID(types.GetTenantID(ctx)),
+			subscriptionseatchange.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetInvoiceID(invoiceID).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Exec(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return r.updateError(err, id)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+// SetWalletTransactionID links a seat change to the wallet credit issued for it
+func (r *subscriptionSeatChangeRepository) SetWalletTransactionID(ctx context.Context, id string, walletTransactionID string) error {
+	client := r.client.Writer(ctx)
+
+	span := StartRepositorySpan(ctx, "subscription_seat_change", "set_wallet_transaction_id", map[string]interface{}{
+		"seat_change_id":        id,
+		"wallet_transaction_id": walletTransactionID,
+	})
+	defer FinishSpan(span)
+
+	err := client.SubscriptionSeatChange.UpdateOneID(id).
+		Where(
+			subscriptionseatchange.TenantID(types.GetTenantID(ctx)),
+			subscriptionseatchange.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetWalletTransactionID(walletTransactionID).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Exec(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return r.updateError(err, id)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *subscriptionSeatChangeRepository) updateError(err error, id string) error {
+	if ent.IsNotFound(err) {
+		return ierr.NewError("subscription seat change not found").
+			WithHint("The seat change with the given ID does not exist").
+			WithReportableDetails(map[string]interface{}{
+				"seat_change_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return ierr.WithError(err).
+		WithHint("Failed to update subscription seat change").
+		WithReportableDetails(map[string]interface{}{
+			"seat_change_id": id,
+		}).
+		Mark(ierr.ErrDatabase)
+}
+
+// List retrieves seat changes based on filter
+func (r *subscriptionSeatChangeRepository) List(ctx context.Context, filter *types.SubscriptionSeatChangeFilter) ([]*subscription.SubscriptionSeatChange, error) {
+	if filter == nil {
+		filter = types.NewSubscriptionSeatChangeFilter()
+	}
+
+	span := StartRepositorySpan(ctx, "subscription_seat_change", "list", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	if err := filter.Validate(); err != nil {
+		SetSpanError(span, err)
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	client := r.client.Reader(ctx)
+	query := client.SubscriptionSeatChange.Query()
+	query = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	entChanges, err := query.All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list subscription seat changes").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return subscription.SubscriptionSeatChangeListFromEnt(entChanges), nil
+}
+
+// Count returns the count of seat changes matching the filter
+func (r *subscriptionSeatChangeRepository) Count(ctx context.Context, filter *types.SubscriptionSeatChangeFilter) (int, error) {
+	if filter == nil {
+		filter = types.NewSubscriptionSeatChangeFilter()
+	}
+
+	span := StartRepositorySpan(ctx, "subscription_seat_change", "count", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	if err := filter.Validate(); err != nil {
+		SetSpanError(span, err)
+		return 0, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	client := r.client.Reader(ctx)
+	query := client.SubscriptionSeatChange.Query()
+	query = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count subscription seat changes").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return count, nil
+}
+
+// SubscriptionSeatChangeQueryOptions implements BaseQueryOptions for seat change queries
+type SubscriptionSeatChangeQueryOptions struct{}
+
+// SubscriptionSeatChangeQuery type alias for better readability
+type SubscriptionSeatChangeQuery = *ent.SubscriptionSeatChangeQuery
+
+func (o SubscriptionSeatChangeQueryOptions) ApplyTenantFilter(ctx context.Context, query SubscriptionSeatChangeQuery) SubscriptionSeatChangeQuery {
+	return query.Where(subscriptionseatchange.TenantID(types.GetTenantID(ctx)))
+}
+
+func (o SubscriptionSeatChangeQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query SubscriptionSeatChangeQuery) SubscriptionSeatChangeQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(subscriptionseatchange.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o SubscriptionSeatChangeQueryOptions) ApplyStatusFilter(query SubscriptionSeatChangeQuery, status string) SubscriptionSeatChangeQuery {
+	if status == "" {
+		return query.Where(subscriptionseatchange.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(subscriptionseatchange.Status(status))
+}
+
+func (o SubscriptionSeatChangeQueryOptions) ApplySortFilter(query SubscriptionSeatChangeQuery, field string, order string) SubscriptionSeatChangeQuery {
+	orderFunc := ent.Desc
+	if order == "asc" {
+		orderFunc = ent.Asc
+	}
+	return query.Order(orderFunc(o.GetFieldName(field)))
+}
+
+func (o SubscriptionSeatChangeQueryOptions) ApplyPaginationFilter(query SubscriptionSeatChangeQuery, limit int, offset int) SubscriptionSeatChangeQuery {
+	query = query.Limit(limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o SubscriptionSeatChangeQueryOptions) GetFieldName(field string) string {
+	if subscriptionseatchange.ValidColumn(field) {
+		return field
+	}
+	return ""
+}
+
+func (o SubscriptionSeatChangeQueryOptions) GetFieldResolver(field string) (string, error) {
+	fieldName := o.GetFieldName(field)
+	if fieldName == "" {
+		return "", ierr.NewErrorf("unknown field name '%s' in subscription seat change query", field).
+			Mark(ierr.ErrValidation)
+	}
+	return fieldName, nil
+}
+
+// applyEntityQueryOptions applies seat change specific filters to the query
+func (o *SubscriptionSeatChangeQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.SubscriptionSeatChangeFilter, query SubscriptionSeatChangeQuery) SubscriptionSeatChangeQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.SubscriptionIDs) > 0 {
+		query = query.Where(subscriptionseatchange.SubscriptionIDIn(f.SubscriptionIDs...))
+	}
+
+	if f.PendingTrueUp {
+		query = query.Where(
+			subscriptionseatchange.ProrationBehaviorEQ(types.SeatProrationBehaviorTrueUp),
+			subscriptionseatchange.InvoiceIDIsNil(),
+			subscriptionseatchange.ProrationAmountGT(decimal.Zero),
+		)
+	}
+
+	return query
+}
@@ -64,9 +64,69 @@ func (r *SystemEventRepository) ListStaleUndeliveredWebhooks(ctx context.Context
 		All(ctx)
 }
 
+// ListWebhooksInRange returns the system_events rows of a tenant and environment created in
+// [From, To), ordered by created_at ascending. Pass limit > 0 (caller caps page size).
+func (r *SystemEventRepository) ListWebhooksInRange(ctx context.Context, params domainsystemevent.ListWebhooksInRangeParams) ([]*flexent.SystemEvent, error) {
+	if params.Limit <= 0 {
+		return nil, nil
+	}
+	preds := []predicate.SystemEvent{
+		systemevent.TenantIDEQ(params.TenantID),
+		systemevent.EnvironmentIDEQ(params.EnvironmentID),
+		systemevent.CreatedAtGTE(params.From),
+		systemevent.CreatedAtLT(params.To),
+		systemevent.EventNameNotNil(),
+		systemevent.EventNameNEQ(""),
+	}
+	if params.UndeliveredOnly {
+		preds = append(preds, systemevent.PublishedAtIsNil())
+	}
+	if len(params.EventNames) > 0 {
+		preds = append(preds, systemevent.EventNameIn(params.EventNames...))
+	}
+	return r.client.Reader(ctx).SystemEvent.Query().
+		Where(preds...).
+		Order(flexent.Asc(systemevent.FieldCreatedAt), flexent.Asc(systemevent.FieldID)).
+		Limit(params.Limit).
+		All(ctx)
+}
+
+// GetUndeliveredPredecessor returns the earliest event of the same entity as event with a lower
+// sequence number that was neither delivered nor exhausted maxAttempts delivery attempts, or nil
+// when there is none.
+func (r *SystemEventRepository) GetUndeliveredPredecessor(ctx context.Context, event *types.WebhookEvent, maxAttempts int) (*flexent.SystemEvent, error) {
+	if event == nil || event.EntityID == "" || event.SequenceNumber == nil {
+		return nil, nil
+	}
+	preds := []predicate.SystemEvent{
+		systemevent.TenantIDEQ(event.TenantID),
+		systemevent.EnvironmentIDEQ(event.EnvironmentID),
+		systemevent.EntityTypeEQ(string(event.EntityType)),
+		systemevent.EntityIDEQ(event.EntityID),
+		systemevent.SequenceNumberLT(*event.SequenceNumber),
+		systemevent.PublishedAtIsNil(),
+	}
+	if maxAttempts > 0 {
+		preds = append(preds, systemevent.FailureCountLT(maxAttempts))
+	}
+	se, err := r.client.Reader(ctx).SystemEvent.Query().
+		Where(preds...).
+		Order(flexent.Asc(systemevent.FieldSequenceNumber)).
+		First(ctx)
+	if flexent.IsNotFound(err) {
+		return nil, nil
+	}
+	return se, err
+}
+
+// maxSequenceAttempts bounds how often OnConsumed picks a new sequence number after losing a
+// race with a concurrent event of the same entity
+const maxSequenceAttempts = 5
+
 // OnConsumed creates a full system_events row when the consumer reads the Kafka message.
 // All fields are populated from the event — only webhook_message_id and published_at are left
 // empty until the webhook is actually delivered (see OnDelivered).
+// Events of an entity get the next sequence number of the entity, which is set on event.
 func (r *SystemEventRepository) OnConsumed(ctx context.Context, event *types.WebhookEvent) error {
 	if event == nil || event.ID == "" {
 		return nil
@@ -80,24 +140,51 @@ func (r *SystemEventRepository) OnConsumed(ctx context.Context, event *types.Web
 	client := r.client.Writer(ctx)
 	now := time.Now().UTC()
 
-	err = client.SystemEvent.Create().
-		SetID(event.ID).
-		SetTenantID(event.TenantID).
-		SetEnvironmentID(event.EnvironmentID).
-		SetEventName(string(event.EventName)).
-		SetEntityType(string(event.EntityType)).
-		SetEntityID(event.EntityID).
-		SetPayload(payloadMap).
-		SetCreatedAt(now).
-		SetUpdatedAt(now).
-		SetCreatedBy(event.UserID).
-		SetUpdatedBy(event.UserID).
-		Exec(ctx)
-	if err == nil {
-		return nil
-	}
-	if !flexent.IsConstraintError(err) {
-		return err
+	for attempt := 1; ; attempt++ {
+		var sequenceNumber *int64
+		if event.EntityID != "" {
+			next, err := r.nextSequenceNumber(ctx, event)
+			if err != nil {
+				return err
+			}
+			sequenceNumber = &next
+		}
+
+		err = client.SystemEvent.Create().
+			SetID(event.ID).
+			SetTenantID(event.TenantID).
+			SetEnvironmentID(event.EnvironmentID).
+			SetEventName(string(event.EventName)).
+			SetEntityType(string(event.EntityType)).
+			SetEntityID(event.EntityID).
+			SetNillableSequenceNumber(sequenceNumber).
+			SetPayload(payloadMap).
+			SetCreatedAt(now).
+			SetUpdatedAt(now).
+			SetCreatedBy(event.UserID).
+			SetUpdatedBy(event.UserID).
+			Exec(ctx)
+		if err == nil {
+			event.SequenceNumber = sequenceNumber
+			return nil
+		}
+		if !flexent.IsConstraintError(err) {
+			return err
+		}
+
+		existing, getErr := client.SystemEvent.Get(ctx, event.ID)
+		if getErr == nil {
+			event.SequenceNumber = existing.SequenceNumber
+			break
+		}
+		if !flexent.IsNotFound(getErr) {
+			return getErr
+		}
+
+		// A concurrent event of the same entity took the sequence number, pick the next one
+		if sequenceNumber == nil || attempt >= maxSequenceAttempts {
+			return err
+		}
 	}
 
 	// Row already exists (created by another consumer process with stale code).
@@ -148,6 +235,76 @@ func (r *SystemEventRepository) OnFailed(ctx context.Context, eventID, reason st
 		Exec(ctx)
 }
 
+// OnDeadLettered records the reason of a delivery failure that retries cannot fix and raises
+// failure_count to maxAttempts, so the event is neither retried nor holds back later events of
+// its entity under ordered delivery.
+func (r *SystemEventRepository) OnDeadLettered(ctx context.Context, eventID, reason string, maxAttempts int) error {
+	if eventID == "" {
+		return nil
+	}
+
+	n, err := r.client.Writer(ctx).SystemEvent.Update().
+		Where(
+			systemevent.IDEQ(eventID),
+			systemevent.FailureCountLT(maxAttempts),
+		).
+		SetUpdatedAt(time.Now().UTC()).
+		SetFailureReason(reason).
+		SetFailureCount(maxAttempts).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		// Already past maxAttempts
+		return r.OnFailed(ctx, eventID, reason)
+	}
+	return nil
+}
+
+// nextSequenceNumber returns the sequence number following the last event of the entity of event
+func (r *SystemEventRepository) nextSequenceNumber(ctx context.Context, event *types.WebhookEvent) (int64, error) {
+	last, err := r.client.Writer(ctx).SystemEvent.Query().
+		Where(
+			systemevent.TenantIDEQ(event.TenantID),
+			systemevent.EnvironmentIDEQ(event.EnvironmentID),
+			systemevent.EntityTypeEQ(string(event.EntityType)),
+			systemevent.EntityIDEQ(event.EntityID),
+			systemevent.SequenceNumberNotNil(),
+		).
+		Order(flexent.Desc(systemevent.FieldSequenceNumber)).
+		First(ctx)
+	if flexent.IsNotFound(err) {
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return *last.SequenceNumber + 1, nil
+}
+
+// OnSnapshot stores the payload built for an event unless one is stored already, so every
+// delivery and thin payload receiver sees the event as it was first delivered.
+func (r *SystemEventRepository) OnSnapshot(ctx context.Context, eventID string, snapshot json.RawMessage) error {
+	if eventID == "" {
+		return nil
+	}
+
+	snapshotMap, err := toPayloadMap(snapshot)
+	if err != nil || snapshotMap == nil {
+		return err
+	}
+
+	return r.client.Writer(ctx).SystemEvent.Update().
+		Where(
+			systemevent.IDEQ(eventID),
+			systemevent.SnapshotIsNil(),
+		).
+		SetSnapshot(snapshotMap).
+		SetUpdatedAt(time.Now().UTC()).
+		Exec(ctx)
+}
+
 func toPayloadMap(raw json.RawMessage) (map[string]interface{}, error) {
 	if len(raw) == 0 {
 		return nil, nil
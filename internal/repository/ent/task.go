@@ -65,6 +65,7 @@ func (r *taskRepository) Create(ctx context.Context, t *domainTask.Task) error {
 		SetSuccessfulRecords(t.SuccessfulRecords).
 		SetFailedRecords(t.FailedRecords).
 		SetNillableErrorSummary(t.ErrorSummary).
+		SetNillableErrorReport(t.ErrorReport).
 		SetMetadata(t.Metadata).
 		SetNillableStartedAt(t.StartedAt).
 		SetNillableCompletedAt(t.CompletedAt).
@@ -221,6 +222,7 @@ func (r *taskRepository) Update(ctx context.Context, t *domainTask.Task) error {
 		SetSuccessfulRecords(t.SuccessfulRecords).
 		SetFailedRecords(t.FailedRecords).
 		SetNillableErrorSummary(t.ErrorSummary).
+		SetNillableErrorReport(t.ErrorReport).
 		SetMetadata(t.Metadata).
 		SetNillableStartedAt(t.StartedAt).
 		SetNillableCompletedAt(t.CompletedAt).
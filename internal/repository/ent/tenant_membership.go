@@ -0,0 +1,218 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/tenantmembership"
+	domainMembership "github.com/flexprice/flexprice/internal/domain/membership"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type tenantMembershipRepository struct {
+	client postgres.IClient
+	log    *logger.Logger
+}
+
+func NewTenantMembershipRepository(client postgres.IClient, log *logger.Logger) domainMembership.Repository {
+	return &tenantMembershipRepository{
+		client: client,
+		log:    log,
+	}
+}
+
+func (r *tenantMembershipRepository) Create(ctx context.Context, m *domainMembership.Membership) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("creating tenant membership",
+		"membership_id", m.ID,
+		"tenant_id", m.TenantID,
+		"user_id", m.UserID,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "tenant_membership", "create", map[string]interface{}{
+		"membership_id": m.ID,
+		"user_id":       m.UserID,
+	})
+	defer FinishSpan(span)
+
+	created, err := client.TenantMembership.Create().
+		SetID(m.ID).
+		SetTenantID(m.TenantID).
+		SetUserID(m.UserID).
+		SetEmail(m.Email).
+		SetRoles(m.Roles).
+		SetStatus(string(m.Status)).
+		SetCreatedAt(m.CreatedAt).
+		SetUpdatedAt(m.UpdatedAt).
+		SetCreatedBy(m.CreatedBy).
+		SetUpdatedBy(m.UpdatedBy).
+		Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHintf("%s is already a member of this tenant", m.Email).
+				WithReportableDetails(map[string]any{
+					"user_id": m.UserID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create tenant membership").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	*m = *domainMembership.FromEnt(created)
+	return nil
+}
+
+func (r *tenantMembershipRepository) GetByUserID(ctx context.Context, userID string) (*domainMembership.Membership, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "tenant_membership", "get_by_user_id", map[string]interface{}{
+		"user_id": userID,
+	})
+	defer FinishSpan(span)
+
+	m, err := r.client.Reader(ctx).TenantMembership.Query().
+		Where(
+			tenantmembership.UserID(userID),
+			tenantmembership.TenantID(types.GetTenantID(ctx)),
+			tenantmembership.Status(string(types.StatusPublished)),
+		).
+		Only(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHint("The user is not a member of this tenant").
+				WithReportableDetails(map[string]any{
+					"user_id": userID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get tenant membership").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainMembership.FromEnt(m), nil
+}
+
+func (r *tenantMembershipRepository) UpdateRoles(ctx context.Context, id string, roles []string) error {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "tenant_membership", "update_roles", map[string]interface{}{
+		"membership_id": id,
+	})
+	defer FinishSpan(span)
+
+	_, err := r.client.Writer(ctx).TenantMembership.Update().
+		Where(
+			tenantmembership.ID(id),
+			tenantmembership.TenantID(types.GetTenantID(ctx)),
+		).
+		SetRoles(roles).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to update tenant membership roles").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *tenantMembershipRepository) Delete(ctx context.Context, id string) error {
+	r.log.Debugw("deleting tenant membership",
+		"membership_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "tenant_membership", "delete", map[string]interface{}{
+		"membership_id": id,
+	})
+	defer FinishSpan(span)
+
+	_, err := r.client.Writer(ctx).TenantMembership.Update().
+		Where(
+			tenantmembership.ID(id),
+			tenantmembership.TenantID(types.GetTenantID(ctx)),
+		).
+		SetStatus(string(types.StatusArchived)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to delete tenant membership").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *tenantMembershipRepository) ListByTenant(ctx context.Context) ([]*domainMembership.Membership, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "tenant_membership", "list_by_tenant", map[string]interface{}{
+		"tenant_id": types.GetTenantID(ctx),
+	})
+	defer FinishSpan(span)
+
+	memberships, err := r.client.Reader(ctx).TenantMembership.Query().
+		Where(
+			tenantmembership.TenantID(types.GetTenantID(ctx)),
+			tenantmembership.Status(string(types.StatusPublished)),
+		).
+		Order(ent.Asc(tenantmembership.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list tenant memberships").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainMembership.FromEntList(memberships), nil
+}
+
+func (r *tenantMembershipRepository) ListByUserID(ctx context.Context, userID string) ([]*domainMembership.Membership, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "tenant_membership", "list_by_user_id", map[string]interface{}{
+		"user_id": userID,
+	})
+	defer FinishSpan(span)
+
+	memberships, err := r.client.Reader(ctx).TenantMembership.Query().
+		Where(
+			tenantmembership.UserID(userID),
+			tenantmembership.Status(string(types.StatusPublished)),
+		).
+		Order(ent.Asc(tenantmembership.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list tenant memberships").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainMembership.FromEntList(memberships), nil
+}
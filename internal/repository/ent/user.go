@@ -2,6 +2,7 @@ package ent
 
 import (
 	"context"
+	"time"
 
 	"github.com/flexprice/flexprice/ent"
 	entUser "github.com/flexprice/flexprice/ent/user"
@@ -122,6 +123,97 @@ func (r *userRepository) GetByID(ctx context.Context, id string) (*domainUser.Us
 	return domainUser.FromEnt(user), nil
 }
 
+// UpdateRoles replaces the RBAC roles of a user
+func (r *userRepository) UpdateRoles(ctx context.Context, id string, roles []string) error {
+	tenantID := types.GetTenantID(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "user", "update_roles", map[string]interface{}{
+		"user_id":   id,
+		"tenant_id": tenantID,
+	})
+	defer FinishSpan(span)
+
+	count, err := r.client.Writer(ctx).User.
+		Update().
+		Where(
+			entUser.ID(id),
+			entUser.TenantID(tenantID),
+		).
+		SetRoles(roles).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		SetUpdatedAt(time.Now().UTC()).
+		Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to update user roles").
+			WithReportableDetails(map[string]interface{}{
+				"user_id":   id,
+				"tenant_id": tenantID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+	if count == 0 {
+		return ierr.NewError("user not found").
+			WithHint("User not found").
+			WithReportableDetails(map[string]interface{}{
+				"user_id":   id,
+				"tenant_id": tenantID,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+// Delete archives a user of the tenant in the context
+func (r *userRepository) Delete(ctx context.Context, id string) error {
+	tenantID := types.GetTenantID(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "user", "delete", map[string]interface{}{
+		"user_id":   id,
+		"tenant_id": tenantID,
+	})
+	defer FinishSpan(span)
+
+	count, err := r.client.Writer(ctx).User.
+		Update().
+		Where(
+			entUser.ID(id),
+			entUser.TenantID(tenantID),
+			entUser.Status(string(types.StatusPublished)),
+		).
+		SetStatus(string(types.StatusArchived)).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		SetUpdatedAt(time.Now().UTC()).
+		Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to delete user").
+			WithReportableDetails(map[string]interface{}{
+				"user_id":   id,
+				"tenant_id": tenantID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+	if count == 0 {
+		return ierr.NewError("user not found").
+			WithHint("User not found").
+			WithReportableDetails(map[string]interface{}{
+				"user_id":   id,
+				"tenant_id": tenantID,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
 // GetByEmail retrieves a user by email
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*domainUser.User, error) {
 	// Start a span for this repository operation
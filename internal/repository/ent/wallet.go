@@ -396,6 +396,10 @@ func (r *walletRepository) CreateTransaction(ctx context.Context, tx *walletdoma
 		SetCurrency(tx.Currency).
 		SetNillableConversionRate(tx.ConversionRate).
 		SetNillableTopupConversionRate(tx.TopupConversionRate).
+		SetNillableFxRate(tx.FXRate).
+		SetNillableFxRateID(tx.FXRateID).
+		SetNillableSettlementCurrency(tx.SettlementCurrency).
+		SetNillableSettlementAmount(tx.SettlementAmount).
 		SetCreatedAt(tx.CreatedAt).
 		SetCreatedBy(tx.CreatedBy).
 		SetUpdatedAt(tx.UpdatedAt).
@@ -825,6 +829,14 @@ func (o WalletTransactionQueryOptions) applyEntityQueryOptions(_ context.Context
 		query = query.Where(wallettransaction.Priority(*f.Priority))
 	}
 
+	if f.FXConverted != nil {
+		if *f.FXConverted {
+			query = query.Where(wallettransaction.FxRateNotNil())
+		} else {
+			query = query.Where(wallettransaction.FxRateIsNil())
+		}
+	}
+
 	// Apply filters using the generic function
 	if f.Filters != nil {
 		query, err = dsl.ApplyFilters[WalletTransactionQuery, predicate.WalletTransaction](
@@ -1112,7 +1124,7 @@ func (r *walletRepository) GetCreditsAvailableBreakdown(ctx context.Context, wal
 		SELECT 
 			CASE 
 				WHEN transaction_reason IN ('PURCHASED_CREDIT_INVOICED', 'PURCHASED_CREDIT_DIRECT') THEN 'PURCHASED'
-				WHEN transaction_reason IN ('FREE_CREDIT_GRANT', 'SUBSCRIPTION_CREDIT_GRANT') THEN 'FREE'
+				WHEN transaction_reason IN ('FREE_CREDIT_GRANT', 'SUBSCRIPTION_CREDIT_GRANT', 'CREDIT_ROLLOVER') THEN 'FREE'
 			END AS credit_type,
 			SUM(credits_available) AS total_credits_available
 		FROM wallet_transactions
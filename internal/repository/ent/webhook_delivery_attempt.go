@@ -0,0 +1,317 @@
+package ent
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/webhookdeliveryattempt"
+	domainWebhookDeliveryAttempt "github.com/flexprice/flexprice/internal/domain/webhookdeliveryattempt"
+	"github.com/flexprice/flexprice/internal/dsl"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type webhookDeliveryAttemptRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts WebhookDeliveryAttemptQueryOptions
+}
+
+func NewWebhookDeliveryAttemptRepository(client postgres.IClient, log *logger.Logger) domainWebhookDeliveryAttempt.Repository {
+	return &webhookDeliveryAttemptRepository{
+		client:    client,
+		log:       log,
+		queryOpts: WebhookDeliveryAttemptQueryOptions{},
+	}
+}
+
+func (r *webhookDeliveryAttemptRepository) Create(ctx context.Context, attempt *domainWebhookDeliveryAttempt.WebhookDeliveryAttempt) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("creating webhook delivery attempt",
+		"webhook_delivery_attempt_id", attempt.ID,
+		"system_event_id", attempt.SystemEventID,
+		"webhook_endpoint_id", attempt.WebhookEndpointID,
+		"attempt_number", attempt.AttemptNumber,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "webhook_delivery_attempt", "create", map[string]interface{}{
+		"webhook_delivery_attempt_id": attempt.ID,
+		"system_event_id":             attempt.SystemEventID,
+		"webhook_endpoint_id":         attempt.WebhookEndpointID,
+	})
+	defer FinishSpan(span)
+
+	// Set environment ID from context if not already set
+	if attempt.EnvironmentID == "" {
+		attempt.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	createQuery := client.WebhookDeliveryAttempt.Create().
+		SetID(attempt.ID).
+		SetTenantID(attempt.TenantID).
+		SetSystemEventID(attempt.SystemEventID).
+		SetWebhookEndpointID(attempt.WebhookEndpointID).
+		SetEventName(attempt.EventName).
+		SetAttemptNumber(attempt.AttemptNumber).
+		SetRequestURL(attempt.RequestURL).
+		SetRequestBody(attempt.RequestBody).
+		SetNillableResponseStatusCode(attempt.ResponseStatusCode).
+		SetResponseBody(attempt.ResponseBody).
+		SetLatencyMs(attempt.LatencyMs).
+		SetSuccess(attempt.Success).
+		SetNillableErrorMessage(attempt.ErrorMessage).
+		SetStatus(string(attempt.Status)).
+		SetCreatedAt(attempt.CreatedAt).
+		SetUpdatedAt(attempt.UpdatedAt).
+		SetCreatedBy(attempt.CreatedBy).
+		SetUpdatedBy(attempt.UpdatedBy).
+		SetEnvironmentID(attempt.EnvironmentID)
+
+	if attempt.RequestHeaders != nil {
+		createQuery = createQuery.SetRequestHeaders(attempt.RequestHeaders)
+	}
+
+	if _, err := createQuery.Save(ctx); err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("A webhook delivery attempt with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"webhook_delivery_attempt_id": attempt.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create webhook delivery attempt").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *webhookDeliveryAttemptRepository) Get(ctx context.Context, id string) (*domainWebhookDeliveryAttempt.WebhookDeliveryAttempt, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "webhook_delivery_attempt", "get", map[string]interface{}{
+		"webhook_delivery_attempt_id": id,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Reader(ctx)
+	r.log.Debugw("getting webhook delivery attempt", "webhook_delivery_attempt_id", id)
+
+	attempt, err := client.WebhookDeliveryAttempt.Query().
+		Where(
+			webhookdeliveryattempt.ID(id),
+			webhookdeliveryattempt.TenantID(types.GetTenantID(ctx)),
+			webhookdeliveryattempt.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Webhook delivery attempt with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"webhook_delivery_attempt_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get webhook delivery attempt").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainWebhookDeliveryAttempt.FromEnt(attempt), nil
+}
+
+func (r *webhookDeliveryAttemptRepository) List(ctx context.Context, filter *types.WebhookDeliveryAttemptFilter) ([]*domainWebhookDeliveryAttempt.WebhookDeliveryAttempt, error) {
+	client := r.client.Reader(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "webhook_delivery_attempt", "list", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := client.WebhookDeliveryAttempt.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query, err := r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list webhook delivery attempts").
+			Mark(ierr.ErrDatabase)
+	}
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	attempts, err := query.All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list webhook delivery attempts").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainWebhookDeliveryAttempt.FromEntList(attempts), nil
+}
+
+func (r *webhookDeliveryAttemptRepository) Count(ctx context.Context, filter *types.WebhookDeliveryAttemptFilter) (int, error) {
+	client := r.client.Reader(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "webhook_delivery_attempt", "count", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := client.WebhookDeliveryAttempt.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+
+	var err error
+	query, err = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to apply query options").
+			Mark(ierr.ErrDatabase)
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count webhook delivery attempts").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return count, nil
+}
+
+// WebhookDeliveryAttemptQuery type alias for better readability
+type WebhookDeliveryAttemptQuery = *ent.WebhookDeliveryAttemptQuery
+
+// WebhookDeliveryAttemptQueryOptions implements BaseQueryOptions for webhook delivery attempt queries
+type WebhookDeliveryAttemptQueryOptions struct{}
+
+func (o WebhookDeliveryAttemptQueryOptions) ApplyTenantFilter(ctx context.Context, query WebhookDeliveryAttemptQuery) WebhookDeliveryAttemptQuery {
+	return query.Where(webhookdeliveryattempt.TenantIDEQ(types.GetTenantID(ctx)))
+}
+
+func (o WebhookDeliveryAttemptQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query WebhookDeliveryAttemptQuery) WebhookDeliveryAttemptQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(webhookdeliveryattempt.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o WebhookDeliveryAttemptQueryOptions) ApplyStatusFilter(query WebhookDeliveryAttemptQuery, status string) WebhookDeliveryAttemptQuery {
+	if status == "" {
+		return query.Where(webhookdeliveryattempt.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(webhookdeliveryattempt.Status(status))
+}
+
+func (o WebhookDeliveryAttemptQueryOptions) ApplySortFilter(query WebhookDeliveryAttemptQuery, field string, order string) WebhookDeliveryAttemptQuery {
+	if field != "" {
+		if order == types.OrderDesc {
+			query = query.Order(ent.Desc(o.GetFieldName(field)))
+		} else {
+			query = query.Order(ent.Asc(o.GetFieldName(field)))
+		}
+	}
+	return query
+}
+
+func (o WebhookDeliveryAttemptQueryOptions) ApplyPaginationFilter(query WebhookDeliveryAttemptQuery, limit int, offset int) WebhookDeliveryAttemptQuery {
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o WebhookDeliveryAttemptQueryOptions) GetFieldName(field string) string {
+	if webhookdeliveryattempt.ValidColumn(field) {
+		return field
+	}
+	return ""
+}
+
+func (o WebhookDeliveryAttemptQueryOptions) GetFieldResolver(field string) (string, error) {
+	fieldName := o.GetFieldName(field)
+	if fieldName == "" {
+		return "", ierr.NewErrorf("unknown field name '%s' in webhook delivery attempt query", field).
+			Mark(ierr.ErrValidation)
+	}
+	return fieldName, nil
+}
+
+func (o WebhookDeliveryAttemptQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.WebhookDeliveryAttemptFilter, query WebhookDeliveryAttemptQuery) (WebhookDeliveryAttemptQuery, error) {
+	var err error
+	if f == nil {
+		return query, nil
+	}
+
+	if len(f.SystemEventIDs) > 0 {
+		query = query.Where(webhookdeliveryattempt.SystemEventIDIn(f.SystemEventIDs...))
+	}
+	if len(f.WebhookEndpointIDs) > 0 {
+		query = query.Where(webhookdeliveryattempt.WebhookEndpointIDIn(f.WebhookEndpointIDs...))
+	}
+	if len(f.EventNames) > 0 {
+		query = query.Where(webhookdeliveryattempt.EventNameIn(f.EventNames...))
+	}
+	if f.Success != nil {
+		query = query.Where(webhookdeliveryattempt.Success(*f.Success))
+	}
+
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(webhookdeliveryattempt.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(webhookdeliveryattempt.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	if f.Filters != nil {
+		query, err = dsl.ApplyFilters[WebhookDeliveryAttemptQuery, predicate.WebhookDeliveryAttempt](
+			query,
+			f.Filters,
+			o.GetFieldResolver,
+			func(p dsl.Predicate) predicate.WebhookDeliveryAttempt { return predicate.WebhookDeliveryAttempt(p) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if f.Sort != nil {
+		query, err = dsl.ApplySorts[WebhookDeliveryAttemptQuery, webhookdeliveryattempt.OrderOption](
+			query,
+			f.Sort,
+			o.GetFieldResolver,
+			func(o dsl.OrderFunc) webhookdeliveryattempt.OrderOption { return webhookdeliveryattempt.OrderOption(o) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return query, nil
+}
@@ -0,0 +1,520 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/webhookendpoint"
+	domainWebhookEndpoint "github.com/flexprice/flexprice/internal/domain/webhookendpoint"
+	"github.com/flexprice/flexprice/internal/dsl"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+type webhookEndpointRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts WebhookEndpointQueryOptions
+}
+
+func NewWebhookEndpointRepository(client postgres.IClient, log *logger.Logger) domainWebhookEndpoint.Repository {
+	return &webhookEndpointRepository{
+		client:    client,
+		log:       log,
+		queryOpts: WebhookEndpointQueryOptions{},
+	}
+}
+
+func (r *webhookEndpointRepository) Create(ctx context.Context, endpoint *domainWebhookEndpoint.WebhookEndpoint) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("creating webhook endpoint",
+		"webhook_endpoint_id", endpoint.ID,
+		"tenant_id", endpoint.TenantID,
+		"url", endpoint.URL,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "webhook_endpoint", "create", map[string]interface{}{
+		"webhook_endpoint_id": endpoint.ID,
+		"url":                 endpoint.URL,
+	})
+	defer FinishSpan(span)
+
+	// Set environment ID from context if not already set
+	if endpoint.EnvironmentID == "" {
+		endpoint.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	createQuery := client.WebhookEndpoint.Create().
+		SetID(endpoint.ID).
+		SetTenantID(endpoint.TenantID).
+		SetURL(endpoint.URL).
+		SetDescription(endpoint.Description).
+		SetEventTypes(endpoint.EventTypes).
+		SetHeaders(endpoint.Headers).
+		SetEnabled(endpoint.Enabled).
+		SetSigningSecret(endpoint.SigningSecret).
+		SetNillablePreviousSigningSecret(endpoint.PreviousSigningSecret).
+		SetNillablePreviousSigningSecretExpiresAt(endpoint.PreviousSigningSecretExpiresAt).
+		SetPayloadVersion(string(endpoint.PayloadVersion)).
+		SetPayloadMode(string(endpoint.PayloadMode)).
+		SetStatus(string(endpoint.Status)).
+		SetCreatedAt(endpoint.CreatedAt).
+		SetUpdatedAt(endpoint.UpdatedAt).
+		SetCreatedBy(endpoint.CreatedBy).
+		SetUpdatedBy(endpoint.UpdatedBy).
+		SetEnvironmentID(endpoint.EnvironmentID)
+
+	if endpoint.Metadata != nil {
+		createQuery = createQuery.SetMetadata(endpoint.Metadata)
+	}
+
+	created, err := createQuery.Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsConstraintError(err) {
+			return ierr.WithError(err).
+				WithHint("A webhook endpoint with this ID already exists").
+				WithReportableDetails(map[string]any{
+					"webhook_endpoint_id": endpoint.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create webhook endpoint").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	*endpoint = *domainWebhookEndpoint.FromEnt(created)
+	return nil
+}
+
+func (r *webhookEndpointRepository) Get(ctx context.Context, id string) (*domainWebhookEndpoint.WebhookEndpoint, error) {
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "webhook_endpoint", "get", map[string]interface{}{
+		"webhook_endpoint_id": id,
+	})
+	defer FinishSpan(span)
+
+	client := r.client.Reader(ctx)
+	r.log.Debugw("getting webhook endpoint", "webhook_endpoint_id", id)
+
+	endpoint, err := client.WebhookEndpoint.Query().
+		Where(
+			webhookendpoint.ID(id),
+			webhookendpoint.TenantID(types.GetTenantID(ctx)),
+			webhookendpoint.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Webhook endpoint with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"webhook_endpoint_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get webhook endpoint").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainWebhookEndpoint.FromEnt(endpoint), nil
+}
+
+func (r *webhookEndpointRepository) Update(ctx context.Context, endpoint *domainWebhookEndpoint.WebhookEndpoint) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("updating webhook endpoint",
+		"webhook_endpoint_id", endpoint.ID,
+		"tenant_id", endpoint.TenantID,
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "webhook_endpoint", "update", map[string]interface{}{
+		"webhook_endpoint_id": endpoint.ID,
+	})
+	defer FinishSpan(span)
+
+	updateQuery := client.WebhookEndpoint.Update().
+		Where(
+			webhookendpoint.ID(endpoint.ID),
+			webhookendpoint.TenantID(endpoint.TenantID),
+			webhookendpoint.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetURL(endpoint.URL).
+		SetDescription(endpoint.Description).
+		SetEventTypes(endpoint.EventTypes).
+		SetHeaders(endpoint.Headers).
+		SetEnabled(endpoint.Enabled).
+		SetSigningSecret(endpoint.SigningSecret).
+		SetConsecutiveFailures(endpoint.ConsecutiveFailures).
+		SetPayloadVersion(string(endpoint.PayloadVersion)).
+		SetPayloadMode(string(endpoint.PayloadMode)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	if endpoint.Metadata != nil {
+		updateQuery = updateQuery.SetMetadata(endpoint.Metadata)
+	}
+
+	if endpoint.PreviousSigningSecret != nil {
+		updateQuery = updateQuery.
+			SetPreviousSigningSecret(*endpoint.PreviousSigningSecret).
+			SetNillablePreviousSigningSecretExpiresAt(endpoint.PreviousSigningSecretExpiresAt)
+	} else {
+		updateQuery = updateQuery.
+			ClearPreviousSigningSecret().
+			ClearPreviousSigningSecretExpiresAt()
+	}
+
+	if endpoint.FailingSince != nil {
+		updateQuery = updateQuery.SetFailingSince(*endpoint.FailingSince)
+	} else {
+		updateQuery = updateQuery.ClearFailingSince()
+	}
+
+	if endpoint.DisabledReason != nil {
+		updateQuery = updateQuery.SetDisabledReason(*endpoint.DisabledReason)
+	} else {
+		updateQuery = updateQuery.ClearDisabledReason()
+	}
+
+	_, err := updateQuery.Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Webhook endpoint with ID %s was not found", endpoint.ID).
+				WithReportableDetails(map[string]any{
+					"webhook_endpoint_id": endpoint.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update webhook endpoint").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *webhookEndpointRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("deleting webhook endpoint",
+		"webhook_endpoint_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+		"environment_id", types.GetEnvironmentID(ctx),
+	)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "webhook_endpoint", "delete", map[string]interface{}{
+		"webhook_endpoint_id": id,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.WebhookEndpoint.Update().
+		Where(
+			webhookendpoint.ID(id),
+			webhookendpoint.TenantID(types.GetTenantID(ctx)),
+			webhookendpoint.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetStatus(string(types.StatusArchived)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHintf("Webhook endpoint with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"webhook_endpoint_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to delete webhook endpoint").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *webhookEndpointRepository) List(ctx context.Context, filter *types.WebhookEndpointFilter) ([]*domainWebhookEndpoint.WebhookEndpoint, error) {
+	client := r.client.Reader(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "webhook_endpoint", "list", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := client.WebhookEndpoint.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query, err := r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list webhook endpoints").
+			Mark(ierr.ErrDatabase)
+	}
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	endpoints, err := query.All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list webhook endpoints").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainWebhookEndpoint.FromEntList(endpoints), nil
+}
+
+func (r *webhookEndpointRepository) Count(ctx context.Context, filter *types.WebhookEndpointFilter) (int, error) {
+	client := r.client.Reader(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "webhook_endpoint", "count", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := client.WebhookEndpoint.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+
+	var err error
+	query, err = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to apply query options").
+			Mark(ierr.ErrDatabase)
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count webhook endpoints").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return count, nil
+}
+
+func (r *webhookEndpointRepository) RecordDeliverySuccess(ctx context.Context, id string, at time.Time) error {
+	client := r.client.Writer(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "webhook_endpoint", "record_delivery_success", map[string]interface{}{
+		"webhook_endpoint_id": id,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.WebhookEndpoint.Update().
+		Where(
+			webhookendpoint.ID(id),
+			webhookendpoint.TenantID(types.GetTenantID(ctx)),
+			webhookendpoint.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetConsecutiveFailures(0).
+		ClearFailingSince().
+		SetLastSuccessAt(at).
+		Save(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to record webhook endpoint delivery").
+			WithReportableDetails(map[string]any{
+				"webhook_endpoint_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *webhookEndpointRepository) RecordDeliveryFailure(ctx context.Context, id string, at time.Time) (*domainWebhookEndpoint.WebhookEndpoint, error) {
+	client := r.client.Writer(ctx)
+
+	// Start a span for this repository operation
+	span := StartRepositorySpan(ctx, "webhook_endpoint", "record_delivery_failure", map[string]interface{}{
+		"webhook_endpoint_id": id,
+	})
+	defer FinishSpan(span)
+
+	scope := []predicate.WebhookEndpoint{
+		webhookendpoint.ID(id),
+		webhookendpoint.TenantID(types.GetTenantID(ctx)),
+		webhookendpoint.EnvironmentID(types.GetEnvironmentID(ctx)),
+	}
+
+	// Increment atomically so concurrent deliveries to the endpoint are all counted, and
+	// only start the failure streak when there is none yet
+	_, err := client.WebhookEndpoint.Update().
+		Where(scope...).
+		AddConsecutiveFailures(1).
+		SetLastFailureAt(at).
+		Save(ctx)
+	if err == nil {
+		_, err = client.WebhookEndpoint.Update().
+			Where(append(scope, webhookendpoint.FailingSinceIsNil())...).
+			SetFailingSince(at).
+			Save(ctx)
+	}
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to record webhook endpoint delivery failure").
+			WithReportableDetails(map[string]any{
+				"webhook_endpoint_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	endpoint, err := client.WebhookEndpoint.Query().
+		Where(scope...).
+		Only(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Webhook endpoint with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"webhook_endpoint_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get webhook endpoint").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainWebhookEndpoint.FromEnt(endpoint), nil
+}
+
+// WebhookEndpointQuery type alias for better readability
+type WebhookEndpointQuery = *ent.WebhookEndpointQuery
+
+// WebhookEndpointQueryOptions implements BaseQueryOptions for webhook endpoint queries
+type WebhookEndpointQueryOptions struct{}
+
+func (o WebhookEndpointQueryOptions) ApplyTenantFilter(ctx context.Context, query WebhookEndpointQuery) WebhookEndpointQuery {
+	return query.Where(webhookendpoint.TenantIDEQ(types.GetTenantID(ctx)))
+}
+
+func (o WebhookEndpointQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query WebhookEndpointQuery) WebhookEndpointQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(webhookendpoint.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o WebhookEndpointQueryOptions) ApplyStatusFilter(query WebhookEndpointQuery, status string) WebhookEndpointQuery {
+	if status == "" {
+		return query.Where(webhookendpoint.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(webhookendpoint.Status(status))
+}
+
+func (o WebhookEndpointQueryOptions) ApplySortFilter(query WebhookEndpointQuery, field string, order string) WebhookEndpointQuery {
+	if field != "" {
+		if order == types.OrderDesc {
+			query = query.Order(ent.Desc(o.GetFieldName(field)))
+		} else {
+			query = query.Order(ent.Asc(o.GetFieldName(field)))
+		}
+	}
+	return query
+}
+
+func (o WebhookEndpointQueryOptions) ApplyPaginationFilter(query WebhookEndpointQuery, limit int, offset int) WebhookEndpointQuery {
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o WebhookEndpointQueryOptions) GetFieldName(field string) string {
+	if webhookendpoint.ValidColumn(field) {
+		return field
+	}
+	return ""
+}
+
+func (o WebhookEndpointQueryOptions) GetFieldResolver(field string) (string, error) {
+	fieldName := o.GetFieldName(field)
+	if fieldName == "" {
+		return "", ierr.NewErrorf("unknown field name '%s' in webhook endpoint query", field).
+			Mark(ierr.ErrValidation)
+	}
+	return fieldName, nil
+}
+
+func (o WebhookEndpointQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.WebhookEndpointFilter, query WebhookEndpointQuery) (WebhookEndpointQuery, error) {
+	var err error
+	if f == nil {
+		return query, nil
+	}
+
+	if len(f.WebhookEndpointIDs) > 0 {
+		query = query.Where(webhookendpoint.IDIn(f.WebhookEndpointIDs...))
+	}
+	if f.Enabled != nil {
+		query = query.Where(webhookendpoint.Enabled(*f.Enabled))
+	}
+
+	if f.Filters != nil {
+		query, err = dsl.ApplyFilters[WebhookEndpointQuery, predicate.WebhookEndpoint](
+			query,
+			f.Filters,
+			o.GetFieldResolver,
+			func(p dsl.Predicate) predicate.WebhookEndpoint { return predicate.WebhookEndpoint(p) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if f.Sort != nil {
+		query, err = dsl.ApplySorts[WebhookEndpointQuery, webhookendpoint.OrderOption](
+			query,
+			f.Sort,
+			o.GetFieldResolver,
+			func(o dsl.OrderFunc) webhookendpoint.OrderOption { return webhookendpoint.OrderOption(o) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return query, nil
+}
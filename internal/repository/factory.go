@@ -6,7 +6,9 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/addon"
 	"github.com/flexprice/flexprice/internal/domain/addonassociation"
 	"github.com/flexprice/flexprice/internal/domain/alertlogs"
+	"github.com/flexprice/flexprice/internal/domain/auditlog"
 	"github.com/flexprice/flexprice/internal/domain/auth"
+	"github.com/flexprice/flexprice/internal/domain/commitmentcontract"
 	"github.com/flexprice/flexprice/internal/domain/connection"
 	"github.com/flexprice/flexprice/internal/domain/costsheet"
 	"github.com/flexprice/flexprice/internal/domain/coupon"
@@ -21,17 +23,24 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/environment"
 	"github.com/flexprice/flexprice/internal/domain/events"
 	"github.com/flexprice/flexprice/internal/domain/feature"
+	"github.com/flexprice/flexprice/internal/domain/fxrate"
 	"github.com/flexprice/flexprice/internal/domain/group"
+	"github.com/flexprice/flexprice/internal/domain/invitation"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/membership"
 	"github.com/flexprice/flexprice/internal/domain/meter"
 	"github.com/flexprice/flexprice/internal/domain/payment"
 	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/domain/planpricesync"
 	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/pricebook"
 	"github.com/flexprice/flexprice/internal/domain/priceunit"
+	"github.com/flexprice/flexprice/internal/domain/promotion_code"
+	"github.com/flexprice/flexprice/internal/domain/rbacrole"
 	"github.com/flexprice/flexprice/internal/domain/scheduledtask"
 	"github.com/flexprice/flexprice/internal/domain/secret"
 	"github.com/flexprice/flexprice/internal/domain/settings"
+	"github.com/flexprice/flexprice/internal/domain/sso"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
 	domainsystemevent "github.com/flexprice/flexprice/internal/domain/systemevent"
 	"github.com/flexprice/flexprice/internal/domain/task"
@@ -41,6 +50,8 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/tenant"
 	"github.com/flexprice/flexprice/internal/domain/user"
 	"github.com/flexprice/flexprice/internal/domain/wallet"
+	"github.com/flexprice/flexprice/internal/domain/webhookdeliveryattempt"
+	"github.com/flexprice/flexprice/internal/domain/webhookendpoint"
 	"github.com/flexprice/flexprice/internal/domain/workflowexecution"
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/postgres"
@@ -119,6 +130,10 @@ func NewSubscriptionScheduleRepository(p RepositoryParams) subscription.Subscrip
 	return entRepo.NewSubscriptionScheduleRepository(p.EntClient, p.Logger)
 }
 
+func NewSubscriptionSeatChangeRepository(p RepositoryParams) subscription.SubscriptionSeatChangeRepository {
+	return entRepo.NewSubscriptionSeatChangeRepository(p.EntClient, p.Logger)
+}
+
 func NewWalletRepository(p RepositoryParams) wallet.Repository {
 	return entRepo.NewWalletRepository(p.EntClient, p.Logger, p.Cache)
 }
@@ -183,6 +198,50 @@ func NewCouponApplicationRepository(p RepositoryParams) coupon_application.Repos
 	return entRepo.NewCouponApplicationRepository(p.EntClient, p.Logger, p.Cache)
 }
 
+func NewPromotionCodeRepository(p RepositoryParams) promotion_code.Repository {
+	return entRepo.NewPromotionCodeRepository(p.EntClient, p.Logger, p.Cache)
+}
+
+func NewFXRateRepository(p RepositoryParams) fxrate.Repository {
+	return entRepo.NewFXRateRepository(p.EntClient, p.Logger)
+}
+
+func NewPriceBookRepository(p RepositoryParams) pricebook.Repository {
+	return entRepo.NewPriceBookRepository(p.EntClient, p.Logger)
+}
+
+func NewCommitmentContractRepository(p RepositoryParams) commitmentcontract.Repository {
+	return entRepo.NewCommitmentContractRepository(p.EntClient, p.Logger)
+}
+
+func NewWebhookEndpointRepository(p RepositoryParams) webhookendpoint.Repository {
+	return entRepo.NewWebhookEndpointRepository(p.EntClient, p.Logger)
+}
+
+func NewWebhookDeliveryAttemptRepository(p RepositoryParams) webhookdeliveryattempt.Repository {
+	return entRepo.NewWebhookDeliveryAttemptRepository(p.EntClient, p.Logger)
+}
+
+func NewRBACRoleRepository(p RepositoryParams) rbacrole.Repository {
+	return entRepo.NewRBACRoleRepository(p.EntClient, p.Logger)
+}
+
+func NewAuditLogRepository(p RepositoryParams) auditlog.Repository {
+	return entRepo.NewAuditLogRepository(p.EntClient, p.Logger)
+}
+
+func NewSSOConnectionRepository(p RepositoryParams) sso.Repository {
+	return entRepo.NewSSOConnectionRepository(p.EntClient, p.Logger)
+}
+
+func NewInvitationRepository(p RepositoryParams) invitation.Repository {
+	return entRepo.NewInvitationRepository(p.EntClient, p.Logger)
+}
+
+func NewTenantMembershipRepository(p RepositoryParams) membership.Repository {
+	return entRepo.NewTenantMembershipRepository(p.EntClient, p.Logger)
+}
+
 func NewCreditNoteRepository(p RepositoryParams) creditnote.Repository {
 	return entRepo.NewCreditNoteRepository(p.EntClient, p.Logger, p.Cache)
 }
@@ -255,6 +314,10 @@ func NewMeterUsageRepository(p RepositoryParams) events.MeterUsageRepository {
 	return clickhouseRepo.NewMeterUsageRepository(p.ClickHouseDB, p.Logger)
 }
 
+func NewUsageRollupRepository(p RepositoryParams) events.UsageRollupRepository {
+	return clickhouseRepo.NewUsageRollupRepository(p.ClickHouseDB, p.Logger)
+}
+
 func NewUsageBenchmarkRepository(p RepositoryParams) events.UsageBenchmarkRepository {
 	return clickhouseRepo.NewUsageBenchmarkRepository(p.ClickHouseDB, p.Logger)
 }
@@ -3,27 +3,35 @@ package middleware
 import (
 	"context"
 	"net/http"
+	"path"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/flexprice/flexprice/internal/auth"
 	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/secret"
+	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/rbac"
 	"github.com/flexprice/flexprice/internal/service"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
 )
 
 // validateAPIKey validates the API key and returns roles array if valid
-// First checks the config, then the database
-func validateAPIKey(ctx context.Context, cfg *config.Configuration, secretService service.SecretService, apiKey string) (tenantID, userID, environmentID string, roles []string, valid bool) {
+// First checks the config, then the database. For database keys the stored secret is
+// returned as well so that its restrictions can be enforced.
+func validateAPIKey(ctx context.Context, cfg *config.Configuration, secretService service.SecretService, apiKey string) (tenantID, userID, environmentID string, roles []string, key *secret.Secret, valid bool) {
 	if apiKey == "" {
-		return "", "", "", nil, false
+		return "", "", "", nil, nil, false
 	}
 
 	// First check in config
 	tenantID, userID, valid = auth.ValidateAPIKey(cfg, apiKey)
 	if valid {
-		return tenantID, userID, "", []string{}, true // Empty roles = full access for config keys
+		return tenantID, userID, "", []string{rbac.RoleAdmin}, nil, true // Config keys have full access
 	}
 
 	// If not found in config, check in database
@@ -31,11 +39,80 @@ func validateAPIKey(ctx context.Context, cfg *config.Configuration, secretServic
 		secret, err := secretService.VerifyAPIKey(ctx, apiKey)
 		if err == nil && secret != nil {
 			// Return roles from the secret for RBAC permission checks
-			return secret.TenantID, secret.CreatedBy, secret.EnvironmentID, secret.Roles, true
+			return secret.TenantID, secret.CreatedBy, secret.EnvironmentID, secret.Roles, secret, true
 		}
 	}
 
-	return "", "", "", nil, false
+	return "", "", "", nil, nil, false
+}
+
+// checkAPIKeyRestrictions enforces the IP allowlist, scopes and rate limit of a database API key.
+// It aborts the request and returns false when the request is not allowed.
+func checkAPIKeyRestrictions(c *gin.Context, secretService service.SecretService, key *secret.Secret, logger *logger.Logger) bool {
+	if key == nil {
+		return true
+	}
+
+	err := secretService.CheckAPIKeyRequest(c.Request.Context(), key, types.APIKeyRequest{
+		ClientIP: c.ClientIP(),
+		Resource: requestResource(c),
+		Action:   requestAction(c),
+	})
+	if err == nil {
+		return true
+	}
+
+	logger.Debugw("api key request rejected", "secret_id", key.ID, "path", c.Request.URL.Path, "error", err)
+
+	status := http.StatusForbidden
+	if ierr.IsTooManyRequests(err) {
+		status = http.StatusTooManyRequests
+		if retryAfter, ok := getSafeDetails(err)["retry_after"].(float64); ok {
+			c.Header("Retry-After", strconv.Itoa(int(retryAfter)))
+		}
+	} else if !ierr.IsPermissionDenied(err) {
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, gin.H{"error": getDisplayMessage(err)})
+	c.Abort()
+	return false
+}
+
+// requestResource returns the resource a request targets, i.e. the first path segment after the API version
+func requestResource(c *gin.Context) string {
+	path := c.FullPath()
+	if path == "" {
+		path = c.Request.URL.Path
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 2 {
+		return ""
+	}
+	return segments[1]
+}
+
+// readRoutes are the full paths of the POST routes that only read data, see ReadRoute
+var readRoutes sync.Map
+
+// ReadRoute marks the POST route at relativePath of group as only reading data, e.g. a search or
+// an analytics query, so API key scopes treat requests to it as reads. It returns relativePath
+// to register the route with.
+func ReadRoute(group *gin.RouterGroup, relativePath string) string {
+	readRoutes.Store(path.Join(group.BasePath(), relativePath), true)
+	return relativePath
+}
+
+// requestAction returns the API key scope action of a request
+func requestAction(c *gin.Context) string {
+	if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+		return types.APIKeyScopeActionRead
+	}
+	if _, ok := readRoutes.Load(c.FullPath()); ok && c.FullPath() != "" {
+		return types.APIKeyScopeActionRead
+	}
+	return types.APIKeyScopeActionWrite
 }
 
 // setContextValues sets the tenant ID, user ID, environment ID, and roles in the context
@@ -60,6 +137,14 @@ func setContextValues(c *gin.Context, tenantID, userID, environmentID string, ro
 	c.Request = c.Request.WithContext(ctx)
 }
 
+// setAPIKeyID sets the ID of the database API key that authenticated the request in the context
+func setAPIKeyID(c *gin.Context, key *secret.Secret) {
+	if key == nil {
+		return
+	}
+	c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), types.CtxAPIKeyID, key.ID))
+}
+
 // GuestAuthenticateMiddleware is a middleware that allows requests without authentication
 // For now it sets a default tenant ID and user ID in the request context
 func GuestAuthenticateMiddleware(c *gin.Context) {
@@ -70,7 +155,7 @@ func GuestAuthenticateMiddleware(c *gin.Context) {
 func APIKeyAuthMiddleware(cfg *config.Configuration, secretService service.SecretService, logger *logger.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		apiKey := c.GetHeader(cfg.Auth.APIKey.Header)
-		tenantID, userID, environmentID, roles, valid := validateAPIKey(c.Request.Context(), cfg, secretService, apiKey)
+		tenantID, userID, environmentID, roles, key, valid := validateAPIKey(c.Request.Context(), cfg, secretService, apiKey)
 		if !valid {
 			logger.Debugw("invalid api key")
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid API key"})
@@ -79,6 +164,10 @@ func APIKeyAuthMiddleware(cfg *config.Configuration, secretService service.Secre
 		}
 
 		setContextValues(c, tenantID, userID, environmentID, roles)
+		setAPIKeyID(c, key)
+		if !checkAPIKeyRestrictions(c, secretService, key, logger) {
+			return
+		}
 		c.Next()
 	}
 }
@@ -86,15 +175,21 @@ func APIKeyAuthMiddleware(cfg *config.Configuration, secretService service.Secre
 // AuthenticateMiddleware is a middleware that authenticates requests based on either:
 // 1. JWT token in the Authorization header as a Bearer token
 // 2. API key in the x-api-key header (or configured header name)
-func AuthenticateMiddleware(cfg *config.Configuration, secretService service.SecretService, logger *logger.Logger) gin.HandlerFunc {
+// JWT users get the roles of their account, or of their membership in the token's tenant.
+// Membership is checked on every request, so tokens of removed members are rejected.
+func AuthenticateMiddleware(cfg *config.Configuration, secretService service.SecretService, membershipService service.MembershipService, logger *logger.Logger) gin.HandlerFunc {
 	authProvider := auth.NewProvider(cfg)
 
 	return func(c *gin.Context) {
 		// First check for API key
 		apiKey := c.GetHeader(cfg.Auth.APIKey.Header)
-		tenantID, userID, environmentID, roles, valid := validateAPIKey(c.Request.Context(), cfg, secretService, apiKey)
+		tenantID, userID, environmentID, roles, key, valid := validateAPIKey(c.Request.Context(), cfg, secretService, apiKey)
 		if valid {
 			setContextValues(c, tenantID, userID, environmentID, roles)
+			setAPIKeyID(c, key)
+			if !checkAPIKeyRestrictions(c, secretService, key, logger) {
+				return
+			}
 			c.Next()
 			return
 		}
@@ -129,8 +224,17 @@ func AuthenticateMiddleware(cfg *config.Configuration, secretService service.Sec
 			return
 		}
 
-		// JWT users have empty roles = full access
-		setContextValues(c, claims.TenantID, claims.UserID, environmentID, []string{})
+		tenantCtx := context.WithValue(c.Request.Context(), types.CtxTenantID, claims.TenantID)
+		roles, err = membershipService.GetMemberRoles(tenantCtx, claims.UserID)
+		if err != nil {
+			logger.Debugw("token user is not a member of the tenant", "user_id", claims.UserID, "tenant_id", claims.TenantID, "error", err)
+			status := lo.Ternary(ierr.IsPermissionDenied(err), http.StatusForbidden, http.StatusInternalServerError)
+			c.JSON(status, gin.H{"error": getDisplayMessage(err)})
+			c.Abort()
+			return
+		}
+
+		setContextValues(c, claims.TenantID, claims.UserID, environmentID, roles)
 		c.Next()
 	}
 }
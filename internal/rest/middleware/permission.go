@@ -3,6 +3,7 @@ package middleware
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/rbac"
@@ -25,26 +26,10 @@ func NewPermissionMiddleware(rbacService *rbac.RBACService, logger *logger.Logge
 }
 
 // RequirePermission returns a middleware that checks for specific entity.action
-// This is called explicitly in route definitions
+// This is called explicitly in route definitions, on top of RequireRoutePermission
 func (pm *PermissionMiddleware) RequirePermission(entity string, action string) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Get roles from context (set by auth middleware)
-		roles := types.GetRoles(c.Request.Context())
-
-		// Check permission using set-based lookup
-		if !pm.rbacService.HasPermission(roles, entity, action) {
-			pm.logger.Info("Permission denied",
-				"user_id", types.GetUserID(c.Request.Context()),
-				"roles", roles,
-				"entity", entity,
-				"action", action,
-				"path", c.Request.URL.Path,
-			)
-
-			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
-				"error":   "Forbidden",
-				"message": fmt.Sprintf("Insufficient permissions to %s %s", action, entity),
-			})
+		if !pm.checkPermission(c, entity, action) {
 			return
 		}
 
@@ -52,3 +37,51 @@ func (pm *PermissionMiddleware) RequirePermission(entity string, action string)
 		c.Next()
 	}
 }
+
+// RequireRoutePermission returns a group middleware checking every route of the group. The
+// entity is derived from the resource of the path, e.g. customer for /v1/customers, and the
+// action the way API key scopes derive it, see ReadRoute.
+func (pm *PermissionMiddleware) RequireRoutePermission() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		entity := requestEntity(c)
+		if entity != "" && !pm.checkPermission(c, entity, requestAction(c)) {
+			return
+		}
+		c.Next()
+	}
+}
+
+// checkPermission checks the roles in the context against built-in and custom roles. It
+// aborts the request and returns false when the permission is not granted.
+func (pm *PermissionMiddleware) checkPermission(c *gin.Context, entity, action string) bool {
+	// Get roles from context (set by auth middleware)
+	roles := types.GetRoles(c.Request.Context())
+
+	if pm.rbacService.HasPermission(c.Request.Context(), roles, entity, action) {
+		return true
+	}
+
+	pm.logger.Info("Permission denied",
+		"user_id", types.GetUserID(c.Request.Context()),
+		"roles", roles,
+		"entity", entity,
+		"action", action,
+		"path", c.Request.URL.Path,
+	)
+
+	c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+		"error":   "Forbidden",
+		"message": fmt.Sprintf("Insufficient permissions to %s %s", action, entity),
+	})
+	return false
+}
+
+// requestEntity returns the RBAC entity of a request, the singular of its resource with
+// underscores, e.g. event for /v1/events and audit_log for /v1/audit-logs
+func requestEntity(c *gin.Context) string {
+	resource := strings.ReplaceAll(requestResource(c), "-", "_")
+	if resource == "taxes" {
+		return "tax"
+	}
+	return strings.TrimSuffix(resource, "s")
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/rbacrole"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/rbac"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequireRoutePermission(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctx := context.WithValue(context.Background(), types.CtxTenantID, "tenant_route_permission")
+	roleRepo := testutil.NewInMemoryRBACRoleStore()
+	readOnly := &rbacrole.RBACRole{
+		ID:          "role_read_only",
+		Name:        "Read Only",
+		Permissions: map[string][]string{"*": {"read"}},
+		BaseModel:   types.GetDefaultBaseModel(ctx),
+	}
+	require.NoError(t, roleRepo.Create(ctx, readOnly))
+
+	rbacService, err := rbac.NewRBACService(&config.Configuration{
+		RBAC: config.RBACConfig{RolesConfigPath: "../../config/rbac/roles.json"},
+	}, roleRepo, logger.NewNoopLogger())
+	require.NoError(t, err)
+
+	router := gin.New()
+	v1 := router.Group("/v1", func(c *gin.Context) {
+		c.Request = c.Request.WithContext(context.WithValue(ctx, types.CtxRoles, []string{readOnly.ID}))
+	})
+	v1.Use(NewPermissionMiddleware(rbacService, logger.NewNoopLogger()).RequireRoutePermission())
+	customers := v1.Group("/customers")
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	customers.GET("/:id", ok)
+	customers.POST("", ok)
+	customers.POST(ReadRoute(customers, "/search"), ok)
+
+	tests := []struct {
+		method string
+		path   string
+		want   int
+	}{
+		{method: http.MethodGet, path: "/v1/customers/cust_1", want: http.StatusOK},
+		{method: http.MethodPost, path: "/v1/customers/search", want: http.StatusOK},
+		{method: http.MethodPost, path: "/v1/customers", want: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, nil))
+			assert.Equal(t, tt.want, w.Code)
+		})
+	}
+
+	assert.Equal(t, "audit_log", requestEntity(&gin.Context{Request: httptest.NewRequest(http.MethodGet, "/v1/audit-logs", nil)}))
+	assert.Equal(t, "tax", requestEntity(&gin.Context{Request: httptest.NewRequest(http.MethodGet, "/v1/taxes/rates", nil)}))
+}
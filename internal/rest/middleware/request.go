@@ -3,10 +3,21 @@ package middleware
 import (
 	"context"
 
+	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/gin-gonic/gin"
 )
 
+// SetTrustedProxies limits the proxies whose forwarding headers set the client IP. Gin trusts
+// every proxy by default, which lets callers spoof their IP past API key IP allowlists.
+// Invalid proxies are logged and no proxy is trusted.
+func SetTrustedProxies(router *gin.Engine, proxies []string, logger *logger.Logger) {
+	if err := router.SetTrustedProxies(proxies); err != nil {
+		logger.Errorw("invalid trusted proxies, client IPs are taken from the connection", "error", err)
+		_ = router.SetTrustedProxies(nil)
+	}
+}
+
 func RequestIDMiddleware(c *gin.Context) {
 	// Create a new context from the request context
 	ctx := c.Request.Context()
@@ -19,6 +30,7 @@ func RequestIDMiddleware(c *gin.Context) {
 
 	// Create new context with values
 	ctx = context.WithValue(ctx, types.CtxRequestID, requestID)
+	ctx = context.WithValue(ctx, types.CtxClientIP, c.ClientIP())
 
 	// Replace request context
 	c.Request = c.Request.WithContext(ctx)
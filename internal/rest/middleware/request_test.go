@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/domain/secret"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetTrustedProxies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	key := &secret.Secret{AllowedIPs: []string{"10.0.0.0/8"}}
+
+	clientIP := func(proxies []string) string {
+		router := gin.New()
+		SetTrustedProxies(router, proxies, logger.NewNoopLogger())
+		router.Use(RequestIDMiddleware)
+
+		var ip string
+		router.GET("/", func(c *gin.Context) {
+			ip = types.GetClientIP(c.Request.Context())
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = "203.0.113.7:4321"
+		req.Header.Set("X-Forwarded-For", "10.0.0.1")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+		return ip
+	}
+
+	// A caller cannot claim an allowlisted address when no proxy is trusted
+	ip := clientIP(nil)
+	assert.Equal(t, "203.0.113.7", ip)
+	assert.False(t, key.AllowsIP(ip))
+
+	// Behind a trusted load balancer the forwarded address is the client
+	ip = clientIP([]string{"203.0.113.0/24"})
+	assert.Equal(t, "10.0.0.1", ip)
+	assert.True(t, key.AllowsIP(ip))
+
+	// Invalid proxies trust no proxy
+	assert.Equal(t, "203.0.113.7", clientIP([]string{"not-a-cidr"}))
+}
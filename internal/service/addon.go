@@ -49,6 +49,8 @@ func (s *addonService) CreateAddon(ctx context.Context, req dto.CreateAddonReque
 		return nil, err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeAddon, domainAddon.ID, types.AuditLogActionCreate, nil, domainAddon)
+
 	// Return response
 	return &dto.CreateAddonResponse{
 		AddonResponse: &dto.AddonResponse{
@@ -263,6 +265,7 @@ func (s *addonService) UpdateAddon(ctx context.Context, id string, req dto.Updat
 	if err != nil {
 		return nil, err
 	}
+	before := *domainAddon
 
 	// Apply basic updates
 	if req.Name != nil {
@@ -280,6 +283,8 @@ func (s *addonService) UpdateAddon(ctx context.Context, id string, req dto.Updat
 		return nil, err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeAddon, id, types.AuditLogActionUpdate, &before, domainAddon)
+
 	return &dto.AddonResponse{
 		Addon: domainAddon,
 	}, nil
@@ -294,7 +299,7 @@ func (s *addonService) DeleteAddon(ctx context.Context, id string) error {
 	}
 
 	// Check if addon exists
-	_, err := s.AddonRepo.GetByID(ctx, id)
+	existing, err := s.AddonRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -347,6 +352,8 @@ func (s *addonService) DeleteAddon(ctx context.Context, id string) error {
 			Mark(ierr.ErrSystem)
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeAddon, id, types.AuditLogActionDelete, existing, nil)
+
 	s.Logger.InfowCtx(ctx, "addon deleted successfully",
 		"addon_id", id)
 
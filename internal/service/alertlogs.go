@@ -254,7 +254,7 @@ func (s *alertLogsService) LogAlert(ctx context.Context, req *LogAlertRequest) e
 				"webhook_event", webhookEventName,
 			)
 		}
-	case types.AlertTypeFeatureWalletBalance:
+	case types.AlertTypeFeatureWalletBalance, types.AlertTypeUsageAnomaly:
 		// Publish webhook event using the publishSystemEvent helper
 		// This will pass the alert log with parent entity fields (feature_id, wallet_id) to AlertPayloadBuilder
 		if webhookEventName != "" {
@@ -328,6 +328,17 @@ var alertWebhookMapping = map[types.AlertType]map[types.AlertState]WebhookEventM
 			WebhookEvent: types.WebhookEventWalletCreditBalanceRecovered, // "wallet.credit_balance.recovered"
 		},
 	},
+	types.AlertTypeUsageAnomaly: {
+		types.AlertStateInAlarm: {
+			WebhookEvent: types.WebhookEventUsageAnomalyDetected, // "usage.anomaly.detected"
+		},
+		types.AlertStateWarning: {
+			WebhookEvent: types.WebhookEventUsageAnomalyDetected, // "usage.anomaly.detected"
+		},
+		types.AlertStateOk: {
+			WebhookEvent: types.WebhookEventUsageAnomalyResolved, // "usage.anomaly.resolved"
+		},
+	},
 	types.AlertTypeFeatureWalletBalance: {
 		types.AlertStateInAlarm: {
 			WebhookEvent: types.WebhookEventFeatureWalletBalanceAlert, // "feature.balance.threshold.alert"
@@ -387,6 +398,19 @@ func (s *alertLogsService) publishSystemEvent(ctx context.Context, eventName typ
 			s.Logger.ErrorwCtx(ctx, "failed to marshal webhook payload", "error", err)
 			return err
 		}
+	case types.AlertTypeUsageAnomaly:
+		// For usage anomalies the entity is the meter and the customer is the parent
+		webhookPayload, err = json.Marshal(webhookDto.InternalAlertEvent{
+			MeterID:      alertLog.EntityID,
+			CustomerID:   lo.FromPtr(alertLog.CustomerID),
+			AlertType:    string(alertLog.AlertType),
+			AlertStatus:  string(alertLog.AlertStatus),
+			UsageAnomaly: alertLog.AlertInfo.UsageAnomaly,
+		})
+		if err != nil {
+			s.Logger.ErrorwCtx(ctx, "failed to marshal webhook payload", "error", err)
+			return err
+		}
 	default:
 		return ierr.NewError("invalid alert type").
 			WithHint("Invalid alert type").
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/auditlog"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"go.temporal.io/sdk/activity"
+)
+
+// AuditLogService reads the audit log. Entries are written by the services that perform
+// the audited actions, see ServiceParams.recordAuditLog.
+type AuditLogService interface {
+	GetAuditLog(ctx context.Context, id string) (*dto.AuditLogResponse, error)
+	ListAuditLogs(ctx context.Context, filter *types.AuditLogFilter) (*dto.ListAuditLogsResponse, error)
+}
+
+type auditLogService struct {
+	ServiceParams
+}
+
+// NewAuditLogService creates a new audit log service
+func NewAuditLogService(params ServiceParams) AuditLogService {
+	return &auditLogService{
+		ServiceParams: params,
+	}
+}
+
+func (s *auditLogService) GetAuditLog(ctx context.Context, id string) (*dto.AuditLogResponse, error) {
+	log, err := s.AuditLogRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.AuditLogResponse{AuditLog: log}, nil
+}
+
+func (s *auditLogService) ListAuditLogs(ctx context.Context, filter *types.AuditLogFilter) (*dto.ListAuditLogsResponse, error) {
+	if filter == nil {
+		filter = types.NewAuditLogFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewDefaultQueryFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	logs, err := s.AuditLogRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.AuditLogRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := lo.Map(logs, func(l *auditlog.AuditLog, _ int) *dto.AuditLogResponse {
+		return &dto.AuditLogResponse{AuditLog: l}
+	})
+
+	listResponse := types.NewListResponse(responses, total, filter.GetLimit(), filter.GetOffset())
+	return &listResponse, nil
+}
+
+// recordAuditLog appends an entry for an action on an entity to the audit log. before and
+// after are snapshots of the entity around the action; before is nil for creates and after
+// is nil for deletes. The entry is written with ctx, so inside a transaction it commits or
+// rolls back together with the action. Failures are logged and never fail the action, the
+// repository writes entries under a savepoint so a failed insert leaves the transaction usable.
+func (p *ServiceParams) recordAuditLog(
+	ctx context.Context,
+	entityType types.AuditLogEntityType,
+	entityID string,
+	action types.AuditLogAction,
+	before, after interface{},
+) {
+	recordAuditLog(ctx, p.AuditLogRepo, p.Logger, entityType, entityID, action, before, after)
+}
+
+// recordAuditLog is ServiceParams.recordAuditLog for services that are not built from ServiceParams
+func recordAuditLog(
+	ctx context.Context,
+	repo auditlog.Repository,
+	log *logger.Logger,
+	entityType types.AuditLogEntityType,
+	entityID string,
+	action types.AuditLogAction,
+	before, after interface{},
+) {
+	if repo == nil || entityID == "" {
+		return
+	}
+
+	changes, err := auditlog.Diff(before, after)
+	if err != nil {
+		log.Errorw("failed to diff audit log snapshots",
+			"entity_type", entityType,
+			"entity_id", entityID,
+			"action", action,
+			"error", err)
+		return
+	}
+
+	// Saving an entity without changing it is not worth an entry
+	if action == types.AuditLogActionUpdate && len(changes) == 0 {
+		return
+	}
+
+	actorType, actorID := auditLogActor(ctx)
+	now := time.Now().UTC()
+	entry := &auditlog.AuditLog{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_AUDIT_LOG),
+		EntityType:    entityType,
+		EntityID:      entityID,
+		Action:        action,
+		ActorType:     actorType,
+		ActorID:       actorID,
+		Changes:       changes,
+		RequestID:     types.GetRequestID(ctx),
+		IPAddress:     types.GetClientIP(ctx),
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		BaseModel: types.BaseModel{
+			TenantID:  types.GetTenantID(ctx),
+			Status:    types.StatusPublished,
+			CreatedAt: now,
+			UpdatedAt: now,
+			CreatedBy: types.GetUserID(ctx),
+			UpdatedBy: types.GetUserID(ctx),
+		},
+	}
+
+	if err := repo.Create(ctx, entry); err != nil {
+		log.Errorw("failed to record audit log",
+			"entity_type", entityType,
+			"entity_id", entityID,
+			"action", action,
+			"error", err)
+	}
+}
+
+// auditLogActor returns who performed an action. Requests authenticated with a database API
+// key are attributed to the key, other requests to the user, and Temporal activities and
+// background jobs without a user to the system.
+func auditLogActor(ctx context.Context) (types.AuditLogActorType, string) {
+	if apiKeyID := types.GetAPIKeyID(ctx); apiKeyID != "" {
+		return types.AuditLogActorTypeAPIKey, apiKeyID
+	}
+
+	if activity.IsActivity(ctx) {
+		return types.AuditLogActorTypeSystem, activity.GetInfo(ctx).WorkflowType.Name
+	}
+
+	if userID := types.GetUserID(ctx); userID != "" {
+		return types.AuditLogActorTypeUser, userID
+	}
+
+	return types.AuditLogActorTypeSystem, ""
+}
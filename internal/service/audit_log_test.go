@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/security"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuditLogServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service         AuditLogService
+	endpointService WebhookEndpointService
+}
+
+func TestAuditLogService(t *testing.T) {
+	suite.Run(t, new(AuditLogServiceSuite))
+}
+
+func (s *AuditLogServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+
+	encryption, err := security.NewEncryptionService(s.GetConfig(), s.GetLogger())
+	s.Require().NoError(err)
+
+	params := ServiceParams{
+		Logger:              s.GetLogger(),
+		Config:              s.GetConfig(),
+		DB:                  s.GetDB(),
+		WebhookEndpointRepo: s.GetStores().WebhookEndpointRepo,
+		AuditLogRepo:        s.GetStores().AuditLogRepo,
+	}
+	s.service = NewAuditLogService(params)
+	s.endpointService = NewWebhookEndpointService(params, encryption)
+}
+
+func (s *AuditLogServiceSuite) listEntries(entityID string) []*dto.AuditLogResponse {
+	filter := types.NewAuditLogFilter()
+	filter.EntityIDs = []string{entityID}
+	resp, err := s.service.ListAuditLogs(s.GetContext(), filter)
+	s.Require().NoError(err)
+	return resp.Items
+}
+
+func (s *AuditLogServiceSuite) TestRecordsCreateUpdateDelete() {
+	ctx := context.WithValue(s.GetContext(), types.CtxRequestID, "req_1")
+	ctx = context.WithValue(ctx, types.CtxClientIP, "10.0.0.1")
+
+	endpoint, err := s.endpointService.CreateWebhookEndpoint(ctx, dto.CreateWebhookEndpointRequest{
+		URL:        "https://example.com/hooks",
+		EventTypes: []string{types.WebhookEventCustomerCreated},
+	})
+	s.Require().NoError(err)
+
+	entries := s.listEntries(endpoint.ID)
+	s.Require().Len(entries, 1)
+	created := entries[0]
+	s.Equal(types.AuditLogEntityTypeWebhookEndpoint, created.EntityType)
+	s.Equal(types.AuditLogActionCreate, created.Action)
+	s.Equal(types.AuditLogActorTypeUser, created.ActorType)
+	s.Equal(types.GetUserID(ctx), created.ActorID)
+	s.Equal("req_1", created.RequestID)
+	s.Equal("10.0.0.1", created.IPAddress)
+	s.Equal("https://example.com/hooks", created.Changes["url"].After)
+	s.Nil(created.Changes["url"].Before)
+	s.NotContains(created.Changes, "signing_secret", "secrets never reach the audit log")
+
+	// Saving without changes is not recorded
+	_, err = s.endpointService.UpdateWebhookEndpoint(ctx, endpoint.ID, dto.UpdateWebhookEndpointRequest{})
+	s.Require().NoError(err)
+	s.Len(s.listEntries(endpoint.ID), 1)
+
+	apiKeyCtx := context.WithValue(ctx, types.CtxAPIKeyID, "secret_1")
+	_, err = s.endpointService.UpdateWebhookEndpoint(apiKeyCtx, endpoint.ID, dto.UpdateWebhookEndpointRequest{
+		URL: lo.ToPtr("https://example.com/v2/hooks"),
+	})
+	s.Require().NoError(err)
+
+	filter := types.NewAuditLogFilter()
+	filter.EntityIDs = []string{endpoint.ID}
+	filter.Actions = []string{string(types.AuditLogActionUpdate)}
+	updates, err := s.service.ListAuditLogs(ctx, filter)
+	s.Require().NoError(err)
+	s.Require().Len(updates.Items, 1)
+	updated := updates.Items[0]
+	s.Equal(types.AuditLogActorTypeAPIKey, updated.ActorType)
+	s.Equal("secret_1", updated.ActorID)
+	s.Equal(types.AuditLogChange{Before: "https://example.com/hooks", After: "https://example.com/v2/hooks"}, updated.Changes["url"])
+	s.NotContains(updated.Changes, "event_types", "unchanged fields are left out")
+
+	s.Require().NoError(s.endpointService.DeleteWebhookEndpoint(ctx, endpoint.ID))
+	filter.Actions = []string{string(types.AuditLogActionDelete)}
+	deletes, err := s.service.ListAuditLogs(ctx, filter)
+	s.Require().NoError(err)
+	s.Require().Len(deletes.Items, 1)
+	s.Equal("https://example.com/v2/hooks", deletes.Items[0].Changes["url"].Before)
+	s.Nil(deletes.Items[0].Changes["url"].After)
+
+	got, err := s.service.GetAuditLog(ctx, created.ID)
+	s.Require().NoError(err)
+	s.Equal(created.ID, got.ID)
+}
+
+func (s *AuditLogServiceSuite) TestRecordWithoutRepository() {
+	params := ServiceParams{Logger: s.GetLogger()}
+	params.recordAuditLog(s.GetContext(), types.AuditLogEntityTypePlan, "plan_1", types.AuditLogActionCreate, nil, map[string]string{"name": "Pro"})
+
+	resp, err := s.service.ListAuditLogs(s.GetContext(), nil)
+	s.Require().NoError(err)
+	s.Empty(resp.Items)
+}
+
+func (s *AuditLogServiceSuite) TestRecordsMeterChanges() {
+	ctx := s.GetContext()
+	meterService := NewMeterService(s.GetStores().MeterRepo, s.GetStores().AuditLogRepo, s.GetLogger())
+
+	m, err := meterService.CreateMeter(ctx, &dto.CreateMeterRequest{
+		Name:        "API Calls",
+		EventName:   "api_call",
+		Aggregation: meter.Aggregation{Type: types.AggregationCount},
+		ResetUsage:  types.ResetUsageBillingPeriod,
+	})
+	s.Require().NoError(err)
+
+	_, err = meterService.UpdateMeter(ctx, m.ID, []meter.Filter{{Key: "region", Values: []string{"eu"}}})
+	s.Require().NoError(err)
+	s.Require().NoError(meterService.DisableMeter(ctx, m.ID))
+
+	entries := s.listEntries(m.ID)
+	s.Require().Len(entries, 3)
+	actions := lo.Map(entries, func(e *dto.AuditLogResponse, _ int) types.AuditLogAction { return e.Action })
+	s.ElementsMatch([]types.AuditLogAction{types.AuditLogActionCreate, types.AuditLogActionUpdate, types.AuditLogActionDelete}, actions)
+	for _, e := range entries {
+		s.Equal(types.AuditLogEntityTypeMeter, e.EntityType)
+	}
+}
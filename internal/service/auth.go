@@ -154,10 +154,16 @@ func (s *authService) Login(ctx context.Context, req *dto.LoginRequest) (*dto.Au
 			Mark(ierr.ErrPermissionDenied)
 	}
 
+	tenants, err := s.userTenants(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
 	response := &dto.AuthResponse{
 		Token:    authResponse.AuthToken,
 		UserID:   authResponse.ID,
 		TenantID: user.TenantID,
+		Tenants:  tenants,
 	}
 
 	return response, nil
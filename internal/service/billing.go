@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
@@ -2648,12 +2649,15 @@ func (s *billingService) CreateInvoiceRequestForCharges(
 		}
 	}
 
-	// Build set of price IDs that appear in invoice line items
+	// Build set of price IDs that appear in invoice line items, and the subtotal promotion
+	// code minimum amounts are checked against
 	invoiceLineItemPriceIDs := make(map[string]bool)
+	invoiceSubtotal := decimal.Zero
 	for _, lineItem := range append(result.FixedCharges, result.UsageCharges...) {
 		if lineItem.PriceID != nil {
 			invoiceLineItemPriceIDs[*lineItem.PriceID] = true
 		}
+		invoiceSubtotal = invoiceSubtotal.Add(lineItem.Amount)
 	}
 
 	// Process all coupon associations in a single loop
@@ -2674,11 +2678,28 @@ func (s *billingService) CreateInvoiceRequestForCharges(
 			continue
 		}
 
+		// Coupons redeemed through a promotion code only apply while the invoice meets the code's minimum amount
+		if couponAssociation.PromotionCodeID != nil {
+			promotionCode, err := s.PromotionCodeRepo.Get(ctx, *couponAssociation.PromotionCodeID)
+			if err != nil {
+				s.Logger.Errorw("failed to get promotion code", "error", err, "promotion_code_id", *couponAssociation.PromotionCodeID)
+				continue
+			}
+			if err := checkPromotionCodeMinimumAmount(promotionCode, invoiceSubtotal, sub.Currency); err != nil {
+				s.Logger.Infow("skipping coupon, invoice does not meet promotion code minimum amount",
+					"coupon_id", couponAssociation.CouponID,
+					"promotion_code_id", promotionCode.ID,
+					"invoice_subtotal", invoiceSubtotal)
+				continue
+			}
+		}
+
 		if couponAssociation.SubscriptionLineItemID == nil {
 			// Subscription-level coupon
 			validCoupons = append(validCoupons, dto.InvoiceCoupon{
 				CouponID:            couponAssociation.CouponID,
 				CouponAssociationID: &couponAssociation.ID,
+				PromotionCodeID:     couponAssociation.PromotionCodeID,
 			})
 		} else {
 			// Line item-level coupon - only include if the line item is in the invoice
@@ -2694,6 +2715,7 @@ func (s *billingService) CreateInvoiceRequestForCharges(
 				LineItemID:          priceID,
 				CouponID:            couponAssociation.CouponID,
 				CouponAssociationID: &couponAssociation.ID,
+				PromotionCodeID:     couponAssociation.PromotionCodeID,
 			})
 		}
 	}
@@ -3019,6 +3041,13 @@ func (s *billingService) AggregateEntitlements(entitlements []*dto.EntitlementRe
 }
 
 func (s *billingService) GetCustomerEntitlements(ctx context.Context, customerID string, req *dto.GetCustomerEntitlementsRequest) (*dto.CustomerEntitlementsResponse, error) {
+	return s.getCustomerEntitlements(ctx, customerID, req, true)
+}
+
+// getCustomerEntitlements aggregates the entitlements of a customer's subscriptions. With
+// includeAncestors, subscriptions of the customer's parents in its customer hierarchy are
+// included too, so children can use what their parent accounts pay for.
+func (s *billingService) getCustomerEntitlements(ctx context.Context, customerID string, req *dto.GetCustomerEntitlementsRequest, includeAncestors bool) (*dto.CustomerEntitlementsResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, err
 	}
@@ -3035,6 +3064,24 @@ func (s *billingService) GetCustomerEntitlements(ctx context.Context, customerID
 		return nil, err
 	}
 
+	if includeAncestors {
+		cust, err := s.CustomerRepo.Get(ctx, customerID)
+		if err != nil {
+			return nil, err
+		}
+		ancestors, err := newCustomerHierarchy(s.CustomerRepo).GetAncestors(ctx, cust)
+		if err != nil {
+			return nil, err
+		}
+		for _, ancestor := range ancestors {
+			ancestorSubscriptions, err := subscriptionService.ListByCustomerID(ctx, ancestor.ID)
+			if err != nil {
+				return nil, err
+			}
+			subscriptions = append(subscriptions, ancestorSubscriptions...)
+		}
+	}
+
 	// Filter subscriptions if IDs are specified
 	if len(req.SubscriptionIDs) > 0 {
 		filteredSubscriptions := make([]*subscription.Subscription, 0)
@@ -3134,6 +3181,125 @@ func (s *billingService) getChildExternalCustomerIDsForSubscription(ctx context.
 }
 
 func (s *billingService) GetCustomerUsageSummary(ctx context.Context, customerID string, req *dto.GetCustomerUsageSummaryRequest) (*dto.CustomerUsageSummaryResponse, error) {
+	resp, err := s.getCustomerUsageSummary(ctx, customerID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.IncludeDescendants {
+		if err := s.rollupDescendantUsage(ctx, resp, req); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// rollupDescendantUsage adds the usage of every customer below resp's customer in its
+// customer hierarchy to resp, along with the invoiced spend of the whole hierarchy
+func (s *billingService) rollupDescendantUsage(ctx context.Context, resp *dto.CustomerUsageSummaryResponse, req *dto.GetCustomerUsageSummaryRequest) error {
+	descendants, err := newCustomerHierarchy(s.CustomerRepo).GetDescendants(ctx, resp.CustomerID)
+	if err != nil {
+		return err
+	}
+
+	customerIDs := []string{resp.CustomerID}
+	featuresByID := make(map[string]*dto.FeatureUsageSummary, len(resp.Features))
+	for _, feature := range resp.Features {
+		featuresByID[feature.Feature.ID] = feature
+	}
+
+	for _, descendant := range descendants {
+		customerIDs = append(customerIDs, descendant.ID)
+
+		// Subscription filters belong to the requested customer, so descendants are
+		// summarized across all of their own subscriptions
+		descendantSummary, err := s.getCustomerUsageSummary(ctx, descendant.ID, &dto.GetCustomerUsageSummaryRequest{
+			FeatureIDs:        req.FeatureIDs,
+			FeatureLookupKeys: req.FeatureLookupKeys,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, feature := range descendantSummary.Features {
+			existing, ok := featuresByID[feature.Feature.ID]
+			if !ok {
+				featuresByID[feature.Feature.ID] = feature
+				resp.Features = append(resp.Features, feature)
+				continue
+			}
+			existing.CurrentUsage = existing.CurrentUsage.Add(feature.CurrentUsage)
+			existing.UsagePercent = s.getUsagePercent(existing.CurrentUsage, existing.TotalLimit)
+			existing.Sources = append(existing.Sources, feature.Sources...)
+		}
+	}
+
+	spend, err := s.getCustomerHierarchySpend(ctx, customerIDs)
+	if err != nil {
+		return err
+	}
+
+	resp.CustomerIDs = customerIDs
+	resp.Spend = spend
+	return nil
+}
+
+// getCustomerHierarchySpend totals, per currency, the invoices billed to the customers or
+// raised for their subscriptions. Invoices matching several customers count once.
+func (s *billingService) getCustomerHierarchySpend(ctx context.Context, customerIDs []string) ([]*dto.CustomerSpendSummary, error) {
+	filter := types.NewNoLimitInvoiceFilter()
+	filter.QueryFilter.Status = lo.ToPtr(types.StatusPublished)
+	filter.InvoiceStatus = []types.InvoiceStatus{types.InvoiceStatusDraft, types.InvoiceStatusFinalized}
+	filter.SubscriptionCustomerIDs = customerIDs
+	invoices, err := s.InvoiceRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, customerID := range customerIDs {
+		customerFilter := types.NewNoLimitInvoiceFilter()
+		customerFilter.QueryFilter.Status = lo.ToPtr(types.StatusPublished)
+		customerFilter.InvoiceStatus = filter.InvoiceStatus
+		customerFilter.CustomerID = customerID
+		customerInvoices, err := s.InvoiceRepo.List(ctx, customerFilter)
+		if err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, customerInvoices...)
+	}
+	invoices = lo.UniqBy(invoices, func(inv *invoice.Invoice) string { return inv.ID })
+
+	spendByCurrency := make(map[string]*dto.CustomerSpendSummary)
+	currencies := make([]string, 0)
+	for _, inv := range invoices {
+		currency := strings.ToLower(inv.Currency)
+		spend, ok := spendByCurrency[currency]
+		if !ok {
+			spend = &dto.CustomerSpendSummary{
+				Currency:     currency,
+				TotalAmount:  decimal.Zero,
+				UnpaidAmount: decimal.Zero,
+			}
+			spendByCurrency[currency] = spend
+			currencies = append(currencies, currency)
+		}
+		spend.TotalAmount = spend.TotalAmount.Add(inv.AmountDue)
+		spend.InvoiceCount++
+		if inv.PaymentStatus != types.PaymentStatusSucceeded {
+			spend.UnpaidAmount = spend.UnpaidAmount.Add(inv.AmountRemaining)
+		}
+	}
+
+	sort.Strings(currencies)
+	out := make([]*dto.CustomerSpendSummary, 0, len(currencies))
+	for _, currency := range currencies {
+		out = append(out, spendByCurrency[currency])
+	}
+	return out, nil
+}
+
+func (s *billingService) getCustomerUsageSummary(ctx context.Context, customerID string, req *dto.GetCustomerUsageSummaryRequest) (*dto.CustomerUsageSummaryResponse, error) {
 	subscriptionService := NewSubscriptionService(s.ServiceParams)
 	eventService := NewEventService(s.EventRepo, s.MeterRepo, s.EventPublisher, s.Logger, s.Config)
 
@@ -3164,7 +3330,8 @@ func (s *billingService) GetCustomerUsageSummary(ctx context.Context, customerID
 		FeatureIDs:      featureIDs,
 	}
 
-	entitlements, err := s.GetCustomerEntitlements(ctx, customerID, entitlementsReq)
+	// Parent subscriptions are summarized on the parent, so only the customer's own count here
+	entitlements, err := s.getCustomerEntitlements(ctx, customerID, entitlementsReq, false)
 	if err != nil {
 		return nil, err
 	}
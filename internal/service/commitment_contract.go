@@ -0,0 +1,528 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/commitmentcontract"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// CommitmentContractService manages prepaid commitment contracts, draws them down as
+// subscription invoices are finalized and trues them up at the end of their term
+type CommitmentContractService interface {
+	// CreateCommitmentContract creates a contract and invoices its prepaid amount
+	CreateCommitmentContract(ctx context.Context, req dto.CreateCommitmentContractRequest) (*dto.CommitmentContractResponse, error)
+	GetCommitmentContract(ctx context.Context, id string) (*dto.CommitmentContractResponse, error)
+	UpdateCommitmentContract(ctx context.Context, id string, req dto.UpdateCommitmentContractRequest) (*dto.CommitmentContractResponse, error)
+	ListCommitmentContracts(ctx context.Context, filter *types.CommitmentContractFilter) (*dto.ListCommitmentContractsResponse, error)
+
+	// CancelCommitmentContract ends an active contract early without a true-up
+	CancelCommitmentContract(ctx context.Context, id string) (*dto.CommitmentContractResponse, error)
+
+	// GetBurnDown reports the drawdown of a contract per billing period
+	GetBurnDown(ctx context.Context, id string) (*dto.CommitmentContractBurnDownResponse, error)
+
+	// ApplyContractToInvoice draws down the contract covering a subscription invoice, if any.
+	// The drawn down amount is recorded as prepaid credits on the usage line items, and usage
+	// beyond the remaining balance is surcharged at the contract's overage factor.
+	// Must be called within the finalization transaction. Returns the amount drawn down.
+	ApplyContractToInvoice(ctx context.Context, inv *invoice.Invoice) (decimal.Decimal, error)
+
+	// ProcessEndedContracts completes the active contracts of the environment whose term
+	// ended, raising a true-up invoice for spend short of the commitment
+	ProcessEndedContracts(ctx context.Context) (*dto.ProcessCommitmentContractsResult, error)
+}
+
+type commitmentContractService struct {
+	ServiceParams
+}
+
+// NewCommitmentContractService creates a new commitment contract service
+func NewCommitmentContractService(params ServiceParams) CommitmentContractService {
+	return &commitmentContractService{
+		ServiceParams: params,
+	}
+}
+
+func (s *commitmentContractService) CreateCommitmentContract(ctx context.Context, req dto.CreateCommitmentContractRequest) (*dto.CommitmentContractResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	contract := req.ToCommitmentContract(ctx)
+	if _, err := s.CustomerRepo.Get(ctx, contract.CustomerID); err != nil {
+		return nil, err
+	}
+	if err := s.validateSubscriptions(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	err := s.DB.WithTx(ctx, func(txCtx context.Context) error {
+		if err := s.CommitmentContractRepo.Create(txCtx, contract); err != nil {
+			return err
+		}
+
+		if contract.PrepaidAmount.IsPositive() {
+			inv, err := s.createContractInvoice(txCtx, contract, contract.PrepaidAmount, "prepayment",
+				fmt.Sprintf("Prepaid commitment - %s", contract.Name))
+			if err != nil {
+				return err
+			}
+
+			contract.PrepaymentInvoiceID = lo.ToPtr(inv.ID)
+			if err := s.CommitmentContractRepo.Update(txCtx, contract); err != nil {
+				return err
+			}
+		}
+
+		s.recordAuditLog(txCtx, types.AuditLogEntityTypeCommitmentContract, contract.ID, types.AuditLogActionCreate, nil, contract)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetCommitmentContract(ctx, contract.ID)
+}
+
+func (s *commitmentContractService) GetCommitmentContract(ctx context.Context, id string) (*dto.CommitmentContractResponse, error) {
+	contract, err := s.CommitmentContractRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewCommitmentContractResponse(contract), nil
+}
+
+func (s *commitmentContractService) UpdateCommitmentContract(ctx context.Context, id string, req dto.UpdateCommitmentContractRequest) (*dto.CommitmentContractResponse, error) {
+	contract, err := s.CommitmentContractRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(contract); err != nil {
+		return nil, err
+	}
+
+	before := *contract
+	req.ApplyTo(contract)
+	if err := s.validateSubscriptions(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	if err := s.CommitmentContractRepo.Update(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeCommitmentContract, id, types.AuditLogActionUpdate, &before, contract)
+	return s.GetCommitmentContract(ctx, id)
+}
+
+func (s *commitmentContractService) ListCommitmentContracts(ctx context.Context, filter *types.CommitmentContractFilter) (*dto.ListCommitmentContractsResponse, error) {
+	if filter == nil {
+		filter = types.NewCommitmentContractFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewDefaultQueryFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	contracts, err := s.CommitmentContractRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.CommitmentContractRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := lo.Map(contracts, func(c *commitmentcontract.CommitmentContract, _ int) *dto.CommitmentContractResponse {
+		return dto.NewCommitmentContractResponse(c)
+	})
+
+	listResponse := types.NewListResponse(responses, total, filter.GetLimit(), filter.GetOffset())
+	return &listResponse, nil
+}
+
+func (s *commitmentContractService) CancelCommitmentContract(ctx context.Context, id string) (*dto.CommitmentContractResponse, error) {
+	contract, err := s.CommitmentContractRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if contract.ContractStatus != types.CommitmentContractStatusActive {
+		return nil, ierr.NewError("commitment contract is not active").
+			WithHint("Only active commitment contracts can be cancelled").
+			WithReportableDetails(map[string]any{
+				"contract_id":     id,
+				"contract_status": contract.ContractStatus,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	before := *contract
+	contract.ContractStatus = types.CommitmentContractStatusCancelled
+	if err := s.CommitmentContractRepo.Update(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeCommitmentContract, id, types.AuditLogActionCancel, &before, contract)
+
+	return s.GetCommitmentContract(ctx, id)
+}
+
+func (s *commitmentContractService) GetBurnDown(ctx context.Context, id string) (*dto.CommitmentContractBurnDownResponse, error) {
+	contract, err := s.CommitmentContractRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	drawdowns, err := s.CommitmentContractRepo.ListDrawdowns(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := contract.RemainingBalance()
+	utilization := decimal.Zero
+	if contract.PrepaidAmount.IsPositive() {
+		utilization = contract.DrawnDownAmount.Div(contract.PrepaidAmount).Mul(decimal.NewFromInt(100)).Round(2)
+	}
+
+	return &dto.CommitmentContractBurnDownResponse{
+		ContractID:              contract.ID,
+		Currency:                contract.Currency,
+		CommitmentAmount:        contract.CommitmentAmount,
+		PrepaidAmount:           contract.PrepaidAmount,
+		DrawnDownAmount:         contract.DrawnDownAmount,
+		RemainingBalance:        remaining,
+		OverageAmount:           contract.OverageAmount,
+		UtilizationPercent:      utilization,
+		ProjectedTrueUpAmount:   contract.TrueUpAmount(),
+		ProjectedExhaustionDate: projectExhaustionDate(contract, drawdowns, time.Now().UTC()),
+		StartDate:               contract.StartDate,
+		EndDate:                 contract.EndDate,
+		Periods:                 drawdowns,
+	}, nil
+}
+
+// projectExhaustionDate returns when the prepaid amount ran out, or extrapolates the average
+// drawdown rate since the contract started to when it will. Returns nil without drawdowns.
+func projectExhaustionDate(contract *commitmentcontract.CommitmentContract, drawdowns []*commitmentcontract.CommitmentDrawdown, now time.Time) *time.Time {
+	if len(drawdowns) == 0 || !contract.DrawnDownAmount.IsPositive() {
+		return nil
+	}
+
+	if !contract.RemainingBalance().IsPositive() {
+		for _, d := range drawdowns {
+			if !d.BalanceAfter.IsPositive() {
+				return lo.ToPtr(d.PeriodEnd)
+			}
+		}
+		return lo.ToPtr(drawdowns[len(drawdowns)-1].PeriodEnd)
+	}
+
+	// Measure the rate over the billed periods rather than wall clock time, so a period
+	// that is billed in arrears does not dilute it
+	observedUntil := drawdowns[len(drawdowns)-1].PeriodEnd
+	elapsed := observedUntil.Sub(contract.StartDate)
+	if elapsed <= 0 {
+		return nil
+	}
+
+	perSecond := contract.DrawnDownAmount.Div(decimal.NewFromFloat(elapsed.Seconds()))
+	secondsLeft := contract.RemainingBalance().Div(perSecond).IntPart()
+	exhaustion := observedUntil.Add(time.Duration(secondsLeft) * time.Second)
+	if exhaustion.Before(now) {
+		exhaustion = now
+	}
+	return &exhaustion
+}
+
+func (s *commitmentContractService) ApplyContractToInvoice(ctx context.Context, inv *invoice.Invoice) (decimal.Decimal, error) {
+	if s.CommitmentContractRepo == nil || inv.SubscriptionID == nil || inv.PeriodStart == nil {
+		return decimal.Zero, nil
+	}
+
+	contract, err := s.findContractForInvoice(ctx, inv)
+	if err != nil || contract == nil {
+		return decimal.Zero, err
+	}
+
+	// Lock the contract so concurrent finalizations draw down its balance one after the other
+	contract, err = s.CommitmentContractRepo.GetForUpdate(ctx, contract.ID)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	existing, err := s.CommitmentContractRepo.GetDrawdownByInvoice(ctx, contract.ID, inv.ID)
+	if err == nil {
+		return existing.DrawdownAmount, nil
+	}
+	if !ierr.IsNotFound(err) {
+		return decimal.Zero, err
+	}
+
+	remaining := contract.RemainingBalance()
+	surchargeFactor := contract.OverageFactor.Sub(decimal.NewFromInt(1))
+	usageAmount, drawnDown, overage := decimal.Zero, decimal.Zero, decimal.Zero
+
+	for _, lineItem := range inv.LineItems {
+		if lo.FromPtr(lineItem.PriceType) != string(types.PRICE_TYPE_USAGE) {
+			continue
+		}
+
+		netAmount := lineItem.Amount.Sub(lineItem.LineItemDiscount).Sub(lineItem.InvoiceLevelDiscount)
+		if !netAmount.IsPositive() {
+			continue
+		}
+		usageAmount = usageAmount.Add(netAmount)
+
+		covered := decimal.Min(remaining, netAmount)
+		covered = decimal.Min(types.RoundToCurrencyPrecision(covered, inv.Currency), covered)
+		uncovered := netAmount.Sub(covered)
+
+		if covered.IsPositive() {
+			lineItem.PrepaidCreditsApplied = lineItem.PrepaidCreditsApplied.Add(covered)
+			remaining = remaining.Sub(covered)
+			drawnDown = drawnDown.Add(covered)
+		}
+
+		if uncovered.IsPositive() {
+			// Usage beyond the prepaid balance is charged at the overage factor
+			surcharge := types.RoundToCurrencyPrecision(uncovered.Mul(surchargeFactor), inv.Currency)
+			if surcharge.IsPositive() {
+				lineItem.Amount = lineItem.Amount.Add(surcharge)
+				if lineItem.Metadata == nil {
+					lineItem.Metadata = types.Metadata{}
+				}
+				lineItem.Metadata["commitment_contract_id"] = contract.ID
+				lineItem.Metadata["commitment_overage_surcharge"] = surcharge.String()
+				inv.Subtotal = inv.Subtotal.Add(surcharge)
+			}
+			overage = overage.Add(uncovered).Add(surcharge)
+		}
+
+		if covered.IsPositive() || uncovered.IsPositive() {
+			if err := s.InvoiceLineItemRepo.Update(ctx, lineItem); err != nil {
+				return decimal.Zero, err
+			}
+		}
+	}
+
+	if !usageAmount.IsPositive() {
+		return decimal.Zero, nil
+	}
+
+	drawdown := &commitmentcontract.CommitmentDrawdown{
+		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_COMMITMENT_DRAWDOWN),
+		ContractID:     contract.ID,
+		SubscriptionID: lo.FromPtr(inv.SubscriptionID),
+		InvoiceID:      inv.ID,
+		PeriodStart:    lo.FromPtr(inv.PeriodStart),
+		PeriodEnd:      lo.FromPtr(inv.PeriodEnd),
+		UsageAmount:    usageAmount,
+		DrawdownAmount: drawnDown,
+		OverageAmount:  overage,
+		BalanceAfter:   remaining,
+		EnvironmentID:  contract.EnvironmentID,
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}
+	if err := s.CommitmentContractRepo.CreateDrawdown(ctx, drawdown); err != nil {
+		return decimal.Zero, err
+	}
+
+	contract.DrawnDownAmount = contract.DrawnDownAmount.Add(drawnDown)
+	contract.OverageAmount = contract.OverageAmount.Add(overage)
+	if err := s.CommitmentContractRepo.Update(ctx, contract); err != nil {
+		return decimal.Zero, err
+	}
+
+	s.Logger.InfowCtx(ctx, "applied commitment contract to invoice",
+		"contract_id", contract.ID,
+		"invoice_id", inv.ID,
+		"usage_amount", usageAmount.String(),
+		"drawdown_amount", drawnDown.String(),
+		"overage_amount", overage.String(),
+		"balance_after", remaining.String(),
+	)
+
+	return drawnDown, nil
+}
+
+func (s *commitmentContractService) ProcessEndedContracts(ctx context.Context) (*dto.ProcessCommitmentContractsResult, error) {
+	filter := types.NewNoLimitCommitmentContractFilter()
+	filter.ContractStatuses = []types.CommitmentContractStatus{types.CommitmentContractStatusActive}
+	filter.EndDateLTE = lo.ToPtr(time.Now().UTC())
+
+	contracts, err := s.CommitmentContractRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.ProcessCommitmentContractsResult{}
+	for _, contract := range contracts {
+		trueUp, err := s.completeContract(ctx, contract)
+		if err != nil {
+			// One failing contract should not block the rest
+			s.Logger.ErrorwCtx(ctx, "failed to complete commitment contract",
+				"contract_id", contract.ID,
+				"error", err,
+			)
+			result.Failed++
+			continue
+		}
+
+		result.Completed++
+		if trueUp {
+			result.TrueUpInvoices++
+		}
+	}
+
+	return result, nil
+}
+
+// completeContract raises the true-up invoice of an ended contract and marks it completed.
+// Reports whether a true-up invoice was raised.
+func (s *commitmentContractService) completeContract(ctx context.Context, contract *commitmentcontract.CommitmentContract) (bool, error) {
+	trueUpRaised := false
+	err := s.DB.WithTx(ctx, func(txCtx context.Context) error {
+		locked, err := s.CommitmentContractRepo.GetForUpdate(txCtx, contract.ID)
+		if err != nil {
+			return err
+		}
+		if locked.ContractStatus != types.CommitmentContractStatusActive {
+			return nil
+		}
+
+		if trueUp := locked.TrueUpAmount(); trueUp.IsPositive() && locked.TrueUpInvoiceID == nil {
+			inv, err := s.createContractInvoice(txCtx, locked, trueUp, "true_up",
+				fmt.Sprintf("Commitment true-up - %s", locked.Name))
+			if err != nil {
+				return err
+			}
+			locked.TrueUpInvoiceID = lo.ToPtr(inv.ID)
+			trueUpRaised = true
+		}
+
+		locked.ContractStatus = types.CommitmentContractStatusCompleted
+		return s.CommitmentContractRepo.Update(txCtx, locked)
+	})
+	return trueUpRaised, err
+}
+
+// createContractInvoice raises a one-off invoice for the contract's customer covering the
+// contract term. kind identifies the invoice of the contract and keeps its creation idempotent.
+func (s *commitmentContractService) createContractInvoice(ctx context.Context, contract *commitmentcontract.CommitmentContract, amount decimal.Decimal, kind, displayName string) (*dto.InvoiceResponse, error) {
+	invoiceService := NewInvoiceService(s.ServiceParams)
+
+	inv, err := invoiceService.CreateInvoice(ctx, dto.CreateInvoiceRequest{
+		CustomerID:     contract.CustomerID,
+		IdempotencyKey: lo.ToPtr(fmt.Sprintf("%s_%s", contract.ID, kind)),
+		InvoiceType:    types.InvoiceTypeOneOff,
+		BillingReason:  types.InvoiceBillingReasonManual,
+		Currency:       contract.Currency,
+		AmountDue:      amount,
+		Subtotal:       amount,
+		Total:          amount,
+		Description:    displayName,
+		DueDate:        lo.ToPtr(time.Now().UTC()),
+		PeriodStart:    lo.ToPtr(contract.StartDate),
+		PeriodEnd:      lo.ToPtr(contract.EndDate),
+		LineItems: []dto.CreateInvoiceLineItemRequest{
+			{
+				Amount:      amount,
+				Quantity:    decimal.NewFromInt(1),
+				DisplayName: lo.ToPtr(displayName),
+			},
+		},
+		Metadata: types.Metadata{
+			"commitment_contract_id": contract.ID,
+			"commitment_invoice":     kind,
+		},
+	})
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHintf("Failed to create %s invoice for commitment contract", strings.ReplaceAll(kind, "_", "-")).
+			WithReportableDetails(map[string]any{
+				"contract_id": contract.ID,
+			}).
+			Mark(ierr.ErrInternal)
+	}
+
+	return inv, nil
+}
+
+// findContractForInvoice returns the active contract covering the subscription and period of an
+// invoice in its currency. When contracts overlap, the one ending first is drawn down first.
+func (s *commitmentContractService) findContractForInvoice(ctx context.Context, inv *invoice.Invoice) (*commitmentcontract.CommitmentContract, error) {
+	filter := types.NewNoLimitCommitmentContractFilter()
+	filter.CustomerID = inv.CustomerID
+	filter.SubscriptionID = lo.FromPtr(inv.SubscriptionID)
+	filter.ContractStatuses = []types.CommitmentContractStatus{types.CommitmentContractStatusActive}
+
+	contracts, err := s.CommitmentContractRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	contracts = lo.Filter(contracts, func(c *commitmentcontract.CommitmentContract, _ int) bool {
+		return strings.EqualFold(c.Currency, inv.Currency) &&
+			c.Covers(lo.FromPtr(inv.SubscriptionID), lo.FromPtr(inv.PeriodStart))
+	})
+	if len(contracts) == 0 {
+		return nil, nil
+	}
+
+	sort.SliceStable(contracts, func(i, j int) bool {
+		return contracts[i].EndDate.Before(contracts[j].EndDate)
+	})
+	return contracts[0], nil
+}
+
+// validateSubscriptions checks that the subscriptions of a contract belong to its customer and
+// are billed in its currency
+func (s *commitmentContractService) validateSubscriptions(ctx context.Context, contract *commitmentcontract.CommitmentContract) error {
+	for _, subscriptionID := range contract.SubscriptionIDs {
+		sub, err := s.SubRepo.Get(ctx, subscriptionID)
+		if err != nil {
+			return err
+		}
+
+		if sub.CustomerID != contract.CustomerID {
+			return ierr.NewError("subscription belongs to another customer").
+				WithHint("A commitment contract can only cover subscriptions of its customer").
+				WithReportableDetails(map[string]any{
+					"subscription_id": subscriptionID,
+					"customer_id":     contract.CustomerID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if !strings.EqualFold(sub.Currency, contract.Currency) {
+			return ierr.NewError("subscription currency does not match contract currency").
+				WithHint("A commitment contract can only cover subscriptions billed in its currency").
+				WithReportableDetails(map[string]any{
+					"subscription_id":       subscriptionID,
+					"subscription_currency": sub.Currency,
+					"contract_currency":     contract.Currency,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	return nil
+}
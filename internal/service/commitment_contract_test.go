@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type CommitmentContractServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service CommitmentContractService
+	ctx     context.Context
+	start   time.Time
+}
+
+func TestCommitmentContractService(t *testing.T) {
+	suite.Run(t, new(CommitmentContractServiceSuite))
+}
+
+func (s *CommitmentContractServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.ctx = s.GetContext()
+	s.start = time.Now().UTC().AddDate(0, -3, 0).Truncate(time.Hour)
+
+	stores := s.GetStores()
+	s.service = NewCommitmentContractService(ServiceParams{
+		Logger:                     s.GetLogger(),
+		Config:                     s.GetConfig(),
+		DB:                         s.GetDB(),
+		CustomerRepo:               stores.CustomerRepo,
+		SubRepo:                    stores.SubscriptionRepo,
+		SubscriptionLineItemRepo:   stores.SubscriptionLineItemRepo,
+		PlanRepo:                   stores.PlanRepo,
+		PriceRepo:                  stores.PriceRepo,
+		MeterRepo:                  stores.MeterRepo,
+		InvoiceRepo:                stores.InvoiceRepo,
+		InvoiceLineItemRepo:        stores.InvoiceLineItemRepo,
+		WalletRepo:                 stores.WalletRepo,
+		TaxRateRepo:                stores.TaxRateRepo,
+		TaxAssociationRepo:         stores.TaxAssociationRepo,
+		TaxAppliedRepo:             stores.TaxAppliedRepo,
+		CouponRepo:                 stores.CouponRepo,
+		CouponAssociationRepo:      stores.CouponAssociationRepo,
+		CouponApplicationRepo:      stores.CouponApplicationRepo,
+		SettingsRepo:               stores.SettingsRepo,
+		CreditGrantRepo:            stores.CreditGrantRepo,
+		CreditGrantApplicationRepo: stores.CreditGrantApplicationRepo,
+		CommitmentContractRepo:     stores.CommitmentContractRepo,
+		EventPublisher:             s.GetPublisher(),
+		WebhookPublisher:           s.GetWebhookPublisher(),
+	})
+
+	s.NoError(stores.CustomerRepo.Create(s.ctx, &customer.Customer{
+		ID:         "cust_cc",
+		ExternalID: "ext_cust_cc",
+		Name:       "Acme",
+		BaseModel:  types.GetDefaultBaseModel(s.ctx),
+	}))
+	for _, id := range []string{"sub_cc_1", "sub_cc_2"} {
+		s.NoError(stores.SubscriptionRepo.Create(s.ctx, &subscription.Subscription{
+			ID:                 id,
+			CustomerID:         "cust_cc",
+			PlanID:             "plan_cc",
+			SubscriptionStatus: types.SubscriptionStatusActive,
+			Currency:           "usd",
+			StartDate:          s.start,
+			CurrentPeriodStart: s.start,
+			CurrentPeriodEnd:   s.start.AddDate(0, 1, 0),
+			BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+			BillingPeriodCount: 1,
+			BaseModel:          types.GetDefaultBaseModel(s.ctx),
+		}))
+	}
+}
+
+func (s *CommitmentContractServiceSuite) createContract(prepaid, factor decimal.Decimal) *dto.CommitmentContractResponse {
+	contract, err := s.service.CreateCommitmentContract(s.ctx, dto.CreateCommitmentContractRequest{
+		Name:             "Annual commit",
+		CustomerID:       "cust_cc",
+		SubscriptionIDs:  []string{"sub_cc_1", "sub_cc_2"},
+		Currency:         "USD",
+		CommitmentAmount: decimal.NewFromInt(1000),
+		PrepaidAmount:    lo.ToPtr(prepaid),
+		OverageFactor:    lo.ToPtr(factor),
+		StartDate:        s.start,
+		EndDate:          s.start.AddDate(1, 0, 0),
+	})
+	s.Require().NoError(err)
+	return contract
+}
+
+// createUsageInvoice creates a draft subscription invoice with a single usage line item
+func (s *CommitmentContractServiceSuite) createUsageInvoice(subscriptionID string, month int, amount decimal.Decimal) *invoice.Invoice {
+	periodStart := s.start.AddDate(0, month, 0)
+	periodEnd := periodStart.AddDate(0, 1, 0)
+	inv := &invoice.Invoice{
+		ID:             s.GetUUID(),
+		CustomerID:     "cust_cc",
+		SubscriptionID: lo.ToPtr(subscriptionID),
+		InvoiceType:    types.InvoiceTypeSubscription,
+		InvoiceStatus:  types.InvoiceStatusDraft,
+		PaymentStatus:  types.PaymentStatusPending,
+		Currency:       "usd",
+		Subtotal:       amount,
+		Total:          amount,
+		AmountDue:      amount,
+		PeriodStart:    lo.ToPtr(periodStart),
+		PeriodEnd:      lo.ToPtr(periodEnd),
+		BaseModel:      types.GetDefaultBaseModel(s.ctx),
+	}
+	inv.LineItems = []*invoice.InvoiceLineItem{{
+		ID:             s.GetUUID(),
+		InvoiceID:      inv.ID,
+		CustomerID:     "cust_cc",
+		SubscriptionID: lo.ToPtr(subscriptionID),
+		PriceType:      lo.ToPtr(string(types.PRICE_TYPE_USAGE)),
+		Amount:         amount,
+		Quantity:       decimal.NewFromInt(1),
+		Currency:       "usd",
+		PeriodStart:    lo.ToPtr(periodStart),
+		PeriodEnd:      lo.ToPtr(periodEnd),
+		BaseModel:      types.GetDefaultBaseModel(s.ctx),
+	}}
+	s.Require().NoError(s.GetStores().InvoiceRepo.CreateWithLineItems(s.ctx, inv))
+	return inv
+}
+
+func (s *CommitmentContractServiceSuite) TestCreateInvoicesPrepayment() {
+	contract := s.createContract(decimal.NewFromInt(600), decimal.NewFromInt(1))
+
+	s.Require().NotNil(contract.PrepaymentInvoiceID)
+	prepayment, err := s.GetStores().InvoiceRepo.Get(s.ctx, *contract.PrepaymentInvoiceID)
+	s.Require().NoError(err)
+	s.True(prepayment.Total.Equal(decimal.NewFromInt(600)), "got %s", prepayment.Total)
+	s.Equal(types.InvoiceTypeOneOff, prepayment.InvoiceType)
+	s.True(contract.RemainingBalance.Equal(decimal.NewFromInt(600)))
+
+	_, err = s.service.CreateCommitmentContract(s.ctx, dto.CreateCommitmentContractRequest{
+		Name:             "Too much prepaid",
+		CustomerID:       "cust_cc",
+		SubscriptionIDs:  []string{"sub_cc_1"},
+		Currency:         "usd",
+		CommitmentAmount: decimal.NewFromInt(100),
+		PrepaidAmount:    lo.ToPtr(decimal.NewFromInt(200)),
+		StartDate:        s.start,
+		EndDate:          s.start.AddDate(1, 0, 0),
+	})
+	s.Error(err)
+}
+
+func (s *CommitmentContractServiceSuite) TestDrawdownAndOverage() {
+	contract := s.createContract(decimal.NewFromInt(500), decimal.NewFromFloat(1.5))
+
+	// First period is fully covered by the prepaid balance
+	first := s.createUsageInvoice("sub_cc_1", 0, decimal.NewFromInt(300))
+	drawn, err := s.service.ApplyContractToInvoice(s.ctx, first)
+	s.Require().NoError(err)
+	s.True(drawn.Equal(decimal.NewFromInt(300)), "got %s", drawn)
+	s.True(first.LineItems[0].PrepaidCreditsApplied.Equal(decimal.NewFromInt(300)))
+
+	// Applying the same invoice again does not draw down twice
+	drawn, err = s.service.ApplyContractToInvoice(s.ctx, first)
+	s.Require().NoError(err)
+	s.True(drawn.Equal(decimal.NewFromInt(300)))
+
+	// Second period on the other subscription exhausts the balance: 200 covered, 100 charged at 1.5x
+	second := s.createUsageInvoice("sub_cc_2", 1, decimal.NewFromInt(300))
+	drawn, err = s.service.ApplyContractToInvoice(s.ctx, second)
+	s.Require().NoError(err)
+	s.True(drawn.Equal(decimal.NewFromInt(200)), "got %s", drawn)
+	s.True(second.LineItems[0].Amount.Equal(decimal.NewFromInt(350)), "got %s", second.LineItems[0].Amount)
+	s.True(second.Subtotal.Equal(decimal.NewFromInt(350)), "got %s", second.Subtotal)
+
+	burnDown, err := s.service.GetBurnDown(s.ctx, contract.ID)
+	s.Require().NoError(err)
+	s.Len(burnDown.Periods, 2)
+	s.True(burnDown.DrawnDownAmount.Equal(decimal.NewFromInt(500)))
+	s.True(burnDown.RemainingBalance.IsZero())
+	s.True(burnDown.OverageAmount.Equal(decimal.NewFromInt(150)), "got %s", burnDown.OverageAmount)
+	s.True(burnDown.UtilizationPercent.Equal(decimal.NewFromInt(100)))
+	s.True(burnDown.ProjectedTrueUpAmount.Equal(decimal.NewFromInt(350)), "got %s", burnDown.ProjectedTrueUpAmount)
+	s.Require().NotNil(burnDown.ProjectedExhaustionDate)
+	s.Equal(lo.FromPtr(second.PeriodEnd), *burnDown.ProjectedExhaustionDate)
+
+	// Usage outside the contract term is not drawn down
+	later := s.createUsageInvoice("sub_cc_1", 13, decimal.NewFromInt(100))
+	drawn, err = s.service.ApplyContractToInvoice(s.ctx, later)
+	s.Require().NoError(err)
+	s.True(drawn.IsZero())
+}
+
+func (s *CommitmentContractServiceSuite) TestProcessEndedContractsTruesUp() {
+	contract := s.createContract(decimal.NewFromInt(400), decimal.NewFromInt(1))
+
+	inv := s.createUsageInvoice("sub_cc_1", 0, decimal.NewFromInt(500))
+	_, err := s.service.ApplyContractToInvoice(s.ctx, inv)
+	s.Require().NoError(err)
+
+	// End the term: spend is 400 prepaid + 100 overage, short of the 1000 commitment by 500
+	stored, err := s.GetStores().CommitmentContractRepo.Get(s.ctx, contract.ID)
+	s.Require().NoError(err)
+	stored.EndDate = time.Now().UTC().Add(-time.Minute)
+	s.Require().NoError(s.GetStores().CommitmentContractRepo.Update(s.ctx, stored))
+
+	result, err := s.service.ProcessEndedContracts(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(1, result.Completed)
+	s.Equal(1, result.TrueUpInvoices)
+
+	completed, err := s.service.GetCommitmentContract(s.ctx, contract.ID)
+	s.Require().NoError(err)
+	s.Equal(types.CommitmentContractStatusCompleted, completed.ContractStatus)
+	s.Require().NotNil(completed.TrueUpInvoiceID)
+
+	trueUp, err := s.GetStores().InvoiceRepo.Get(s.ctx, *completed.TrueUpInvoiceID)
+	s.Require().NoError(err)
+	s.True(trueUp.Total.Equal(decimal.NewFromInt(500)), "got %s", trueUp.Total)
+
+	// Completed contracts are not processed again
+	result, err = s.service.ProcessEndedContracts(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(0, result.Completed)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/flexprice/flexprice/internal/security"
 	temporalService "github.com/flexprice/flexprice/internal/temporal/service"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/utils"
 )
 
 // ConnectionService defines the interface for connection operations
@@ -533,6 +534,7 @@ func (s *connectionService) CreateConnection(ctx context.Context, req dto.Create
 		return nil, err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeConnection, conn.ID, types.AuditLogActionCreate, nil, conn)
 	s.Logger.InfowCtx(ctx, "connection created successfully", "connection_id", conn.ID)
 
 	// For QuickBooks connections with auth_code, exchange it immediately for tokens
@@ -612,6 +614,12 @@ func (s *connectionService) UpdateConnection(ctx context.Context, id string, req
 		return nil, err
 	}
 
+	// Secret data is merged in place, so the snapshot is a copy of the fields rather than of the struct
+	before, err := utils.ToMap(conn)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update simple fields if provided
 	if req.Name != "" {
 		conn.Name = req.Name
@@ -678,6 +686,7 @@ func (s *connectionService) UpdateConnection(ctx context.Context, id string, req
 		return nil, err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeConnection, conn.ID, types.AuditLogActionUpdate, before, conn)
 	s.Logger.InfowCtx(ctx, "connection updated successfully", "connection_id", conn.ID)
 	return dto.ToConnectionResponse(conn), nil
 }
@@ -717,6 +726,7 @@ func (s *connectionService) DeleteConnection(ctx context.Context, id string) err
 		}
 	}
 
+	before := *conn
 	conn.UpdatedAt = time.Now()
 	conn.UpdatedBy = types.GetUserID(ctx)
 
@@ -726,6 +736,7 @@ func (s *connectionService) DeleteConnection(ctx context.Context, id string) err
 		return err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeConnection, conn.ID, types.AuditLogActionDelete, &before, nil)
 	s.Logger.InfowCtx(ctx, "connection deleted successfully", "connection_id", conn.ID)
 	return nil
 }
@@ -45,6 +45,8 @@ func (s *couponService) CreateCoupon(ctx context.Context, req dto.CreateCouponRe
 		return nil, err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeCoupon, c.ID, types.AuditLogActionCreate, nil, c)
+
 	return dto.NewCouponResponse(c), nil
 }
 
@@ -64,6 +66,7 @@ func (s *couponService) UpdateCoupon(ctx context.Context, id string, req dto.Upd
 	if err != nil {
 		return nil, err
 	}
+	before := *c
 
 	// Update fields if provided
 	if req.Name != nil {
@@ -78,12 +81,24 @@ func (s *couponService) UpdateCoupon(ctx context.Context, id string, req dto.Upd
 		return nil, err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeCoupon, c.ID, types.AuditLogActionUpdate, &before, c)
+
 	return dto.NewCouponResponse(c), nil
 }
 
 // DeleteCoupon deletes a coupon
 func (s *couponService) DeleteCoupon(ctx context.Context, id string) error {
-	return s.CouponRepo.Delete(ctx, id)
+	c, err := s.CouponRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.CouponRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeCoupon, id, types.AuditLogActionDelete, c, nil)
+	return nil
 }
 
 // ListCoupons lists coupons with filtering
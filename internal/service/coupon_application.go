@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
@@ -53,6 +54,7 @@ func (s *couponApplicationService) CreateCouponApplication(ctx context.Context,
 			ID:                  types.GenerateUUIDWithPrefix(types.UUID_PREFIX_COUPON_APPLICATION),
 			CouponID:            req.CouponID,
 			CouponAssociationID: req.CouponAssociationID,
+			PromotionCodeID:     req.PromotionCodeID,
 			InvoiceID:           req.InvoiceID,
 			InvoiceLineItemID:   req.InvoiceLineItemID,
 			SubscriptionID:      req.SubscriptionID,
@@ -163,13 +165,12 @@ func (s *couponApplicationService) ApplyCouponsToInvoice(ctx context.Context, re
 	}
 
 	// Step 2: Prepare all data outside transaction (calculations, validations, entity building)
-	// This is a pure function - we do NOT mutate inv or inv.LineItems
+	// Line item discounts are accumulated on the line items; invoice-level discounts are only
+	// computed here and distributed in the transaction
 	couponService := NewCouponService(s.ServiceParams)
 	totalLineItemDiscount := decimal.Zero
 	totalInvoiceLevelDiscount := decimal.Zero
 	appliedCoupons := make([]*dto.CouponApplicationResponse, 0)
-	lineItemCouponApplications := make([]*coupon_application.CouponApplication, 0)
-	invoiceLevelCouponApplications := make([]*coupon_application.CouponApplication, 0)
 
 	// Build a map of line items by PriceID for O(1) lookup
 	lineItemsByPriceID := make(map[string]*invoice.InvoiceLineItem)
@@ -179,95 +180,171 @@ func (s *couponApplicationService) ApplyCouponsToInvoice(ctx context.Context, re
 		}
 	}
 
-	// Process line item coupons (mutate line items directly since we'll persist them in DB)
+	ruleTargets := s.buildCouponRuleTargets(ctx, inv.LineItems, coupons)
+
+	// Line items discounted so far, and those locked by an exclusive coupon
+	discountedLineItems := make(map[string]bool)
+	exclusiveLineItems := make(map[string]bool)
+
+	// Phase 1: line-scoped coupons. These are the coupons associated with a line item and the
+	// invoice coupons whose rules target a subset of line items, applied in application order.
+	lineScopedCoupons := make([]lineScopedCoupon, 0, len(lineItemCoupons))
 	for _, lineItemCoupon := range lineItemCoupons {
-		// Find the line item this coupon applies to by matching price_id
-		targetLineItem, exists := lineItemsByPriceID[lineItemCoupon.LineItemID]
-		if !exists {
-			s.Logger.WarnwCtx(ctx, "line item not found for coupon, skipping",
-				"price_id_used_as_line_item_id", lineItemCoupon.LineItemID,
-				"coupon_id", lineItemCoupon.CouponID)
-			continue
+		lineScopedCoupons = append(lineScopedCoupons, lineScopedCoupon{
+			CouponID:            lineItemCoupon.CouponID,
+			CouponAssociationID: lineItemCoupon.CouponAssociationID,
+			PromotionCodeID:     lineItemCoupon.PromotionCodeID,
+			PriceID:             lineItemCoupon.LineItemID,
+		})
+	}
+	for _, invoiceCoupon := range invoiceCoupons {
+		if couponsMap[invoiceCoupon.CouponID].Rules.IsTargeted() {
+			lineScopedCoupons = append(lineScopedCoupons, lineScopedCoupon{
+				CouponID:            invoiceCoupon.CouponID,
+				CouponAssociationID: invoiceCoupon.CouponAssociationID,
+				PromotionCodeID:     invoiceCoupon.PromotionCodeID,
+			})
 		}
+	}
+	sort.SliceStable(lineScopedCoupons, func(i, j int) bool {
+		return couponsMap[lineScopedCoupons[i].CouponID].Rules.GetApplicationOrder() <
+			couponsMap[lineScopedCoupons[j].CouponID].Rules.GetApplicationOrder()
+	})
 
+	for _, scoped := range lineScopedCoupons {
 		// Coupon already validated to exist in map
-		coupon := couponsMap[lineItemCoupon.CouponID]
+		coupon := couponsMap[scoped.CouponID]
+
+		candidates := inv.LineItems
+		if scoped.PriceID != "" {
+			// Find the line item this coupon applies to by matching price_id
+			targetLineItem, exists := lineItemsByPriceID[scoped.PriceID]
+			if !exists {
+				s.Logger.WarnwCtx(ctx, "line item not found for coupon, skipping",
+					"price_id_used_as_line_item_id", scoped.PriceID,
+					"coupon_id", scoped.CouponID)
+				continue
+			}
+			candidates = []*invoice.InvoiceLineItem{targetLineItem}
+		}
+
+		// Collect the line items the coupon's rules and stacking allow it to discount
+		matched := make([]*invoice.InvoiceLineItem, 0, len(candidates))
+		reasons := make(map[string]string, len(candidates))
+		eligibleAmount := decimal.Zero
+		for _, lineItem := range candidates {
+			ok, reason := coupon.Rules.Match(ruleTargets[lineItem.ID])
+			if !ok {
+				continue
+			}
+			if exclusiveLineItems[lineItem.ID] || (coupon.Rules.IsExclusive() && discountedLineItems[lineItem.ID]) {
+				continue
+			}
+			remaining := lineItem.Amount.Sub(lineItem.LineItemDiscount)
+			if !remaining.IsPositive() {
+				continue
+			}
+			matched = append(matched, lineItem)
+			reasons[lineItem.ID] = reason
+			eligibleAmount = eligibleAmount.Add(remaining)
+		}
+		if len(matched) == 0 {
+			s.Logger.InfowCtx(ctx, "coupon rules matched no eligible line items, skipping",
+				"coupon_id", scoped.CouponID,
+				"price_id", scoped.PriceID)
+			continue
+		}
 
-		// Use correct ApplyDiscount signature
 		discountResult, err := couponService.ApplyDiscount(ctx, dto.ApplyDiscountRequest{
-			CouponID:      lineItemCoupon.CouponID,
-			OriginalPrice: targetLineItem.Amount,
+			CouponID:      scoped.CouponID,
+			OriginalPrice: eligibleAmount,
 			Currency:      inv.Currency,
 		})
 		if err != nil {
 			s.Logger.WarnwCtx(ctx, "failed to apply line item coupon, skipping",
-				"coupon_id", lineItemCoupon.CouponID,
+				"coupon_id", scoped.CouponID,
 				"error", err)
 			continue
 		}
 
-		// Mutate line item directly since we'll persist it in DB anyway
-		targetLineItem.LineItemDiscount = targetLineItem.LineItemDiscount.Add(discountResult.Discount)
-		totalLineItemDiscount = totalLineItemDiscount.Add(discountResult.Discount)
-
-		// Build coupon application entity (for persistence by caller)
-		couponAssociationID := ""
-		if lineItemCoupon.CouponAssociationID != nil {
-			couponAssociationID = *lineItemCoupon.CouponAssociationID
-		}
-		ca := &coupon_application.CouponApplication{
-			ID:                  types.GenerateUUIDWithPrefix(types.UUID_PREFIX_COUPON_APPLICATION),
-			CouponID:            lineItemCoupon.CouponID,
-			CouponAssociationID: couponAssociationID,
-			InvoiceID:           inv.ID,
-			InvoiceLineItemID:   &targetLineItem.ID,
-			SubscriptionID:      inv.SubscriptionID,
-			AppliedAt:           time.Now(),
-			OriginalPrice:       targetLineItem.Amount,
-			FinalPrice:          discountResult.FinalPrice,
-			DiscountedAmount:    discountResult.Discount,
-			DiscountType:        coupon.Type,
-			DiscountPercentage:  coupon.PercentageOff,
-			Currency:            inv.Currency,
-			CouponSnapshot: map[string]interface{}{
-				"type":           coupon.Type,
-				"amount_off":     coupon.AmountOff,
-				"percentage_off": coupon.PercentageOff,
-				"applied_to":     "line_item",
-				"line_item_id":   targetLineItem.ID,
-				"price_id":       lineItemCoupon.LineItemID,
-			},
-			BaseModel:     types.GetDefaultBaseModel(ctx),
-			EnvironmentID: types.GetEnvironmentID(ctx),
-		}
+		// Split the discount across the matched line items in proportion to their remaining amounts
+		allocated := decimal.Zero
+		for i, lineItem := range matched {
+			remaining := lineItem.Amount.Sub(lineItem.LineItemDiscount)
+			share := discountResult.Discount.Sub(allocated)
+			if i < len(matched)-1 {
+				share = types.RoundToCurrencyPrecision(discountResult.Discount.Mul(remaining).Div(eligibleAmount), inv.Currency)
+			}
+			allocated = allocated.Add(share)
+
+			// Mutate line item directly since we'll persist it in DB anyway
+			lineItem.LineItemDiscount = lineItem.LineItemDiscount.Add(share)
+			totalLineItemDiscount = totalLineItemDiscount.Add(share)
+			discountedLineItems[lineItem.ID] = true
+			if coupon.Rules.IsExclusive() {
+				exclusiveLineItems[lineItem.ID] = true
+			}
 
-		lineItemCouponApplications = append(lineItemCouponApplications, ca)
-		appliedCoupons = append(appliedCoupons, &dto.CouponApplicationResponse{
-			CouponApplication: ca,
-		})
+			snapshot := buildCouponSnapshot(coupon, "line_item", reasons[lineItem.ID])
+			snapshot["line_item_id"] = lineItem.ID
+			snapshot["price_id"] = lo.FromPtr(lineItem.PriceID)
+
+			// Build coupon application entity (for persistence by caller)
+			ca := &coupon_application.CouponApplication{
+				ID:                  types.GenerateUUIDWithPrefix(types.UUID_PREFIX_COUPON_APPLICATION),
+				CouponID:            scoped.CouponID,
+				CouponAssociationID: lo.FromPtr(scoped.CouponAssociationID),
+				PromotionCodeID:     scoped.PromotionCodeID,
+				InvoiceID:           inv.ID,
+				InvoiceLineItemID:   &lineItem.ID,
+				SubscriptionID:      inv.SubscriptionID,
+				AppliedAt:           time.Now(),
+				OriginalPrice:       remaining,
+				FinalPrice:          remaining.Sub(share),
+				DiscountedAmount:    share,
+				DiscountType:        coupon.Type,
+				DiscountPercentage:  coupon.PercentageOff,
+				Currency:            inv.Currency,
+				CouponSnapshot:      snapshot,
+				BaseModel:           types.GetDefaultBaseModel(ctx),
+				EnvironmentID:       types.GetEnvironmentID(ctx),
+			}
 
-		s.Logger.DebugwCtx(ctx, "prepared line item coupon application",
-			"line_item_id", targetLineItem.ID,
-			"price_id", lineItemCoupon.LineItemID,
-			"coupon_id", lineItemCoupon.CouponID,
-			"original_amount", targetLineItem.Amount,
-			"discount", discountResult.Discount,
-			"accumulated_discount", targetLineItem.LineItemDiscount,
-			"final_price", discountResult.FinalPrice)
+			appliedCoupons = append(appliedCoupons, &dto.CouponApplicationResponse{
+				CouponApplication: ca,
+			})
+
+			s.Logger.DebugwCtx(ctx, "prepared line item coupon application",
+				"line_item_id", lineItem.ID,
+				"coupon_id", scoped.CouponID,
+				"original_amount", remaining,
+				"discount", share,
+				"accumulated_discount", lineItem.LineItemDiscount,
+				"reason", reasons[lineItem.ID])
+		}
 	}
 
-	// Calculate subtotal after line item discounts
-	// This is the base for invoice-level discounts
+	// Phase 2: invoice-wide coupons, applied sequentially to the subtotal after line item
+	// discounts of the line items not locked by an exclusive coupon
+	invoiceWideCoupons := lo.Filter(invoiceCoupons, func(ic dto.InvoiceCoupon, _ int) bool {
+		return !couponsMap[ic.CouponID].Rules.IsTargeted()
+	})
+	sort.SliceStable(invoiceWideCoupons, func(i, j int) bool {
+		return couponsMap[invoiceWideCoupons[i].CouponID].Rules.GetApplicationOrder() <
+			couponsMap[invoiceWideCoupons[j].CouponID].Rules.GetApplicationOrder()
+	})
+
+	invoiceDiscountLineItems := lo.Filter(inv.LineItems, func(lineItem *invoice.InvoiceLineItem, _ int) bool {
+		return !exclusiveLineItems[lineItem.ID]
+	})
 	subtotalAfterLineItemDiscounts := decimal.Zero
-	for _, lineItem := range inv.LineItems {
+	for _, lineItem := range invoiceDiscountLineItems {
 		amountAfterLineItemDiscount := lineItem.Amount.Sub(lineItem.LineItemDiscount)
 		subtotalAfterLineItemDiscounts = subtotalAfterLineItemDiscounts.Add(amountAfterLineItemDiscount)
 	}
 
-	// Process invoice-level coupons (pure computation - no mutations)
-	// Apply sequentially to subtotal after line item discounts
 	runningSubTotal := subtotalAfterLineItemDiscounts
-	for _, invoiceCoupon := range invoiceCoupons {
+	for _, invoiceCoupon := range invoiceWideCoupons {
 		// Skip if running subtotal is zero or negative (nothing to discount)
 		if runningSubTotal.LessThanOrEqual(decimal.Zero) {
 			s.Logger.WarnwCtx(ctx, "running subtotal is zero or negative, skipping remaining invoice coupons",
@@ -279,6 +356,13 @@ func (s *couponApplicationService) ApplyCouponsToInvoice(ctx context.Context, re
 		// Coupon already validated to exist in map
 		coupon := couponsMap[invoiceCoupon.CouponID]
 
+		// An exclusive invoice coupon cannot combine with any discount already applied
+		if coupon.Rules.IsExclusive() && len(appliedCoupons) > 0 {
+			s.Logger.InfowCtx(ctx, "exclusive invoice coupon skipped as other discounts already apply",
+				"coupon_id", invoiceCoupon.CouponID)
+			continue
+		}
+
 		// Use correct ApplyDiscount signature
 		discountResult, err := couponService.ApplyDiscount(ctx, dto.ApplyDiscountRequest{
 			CouponID:      invoiceCoupon.CouponID,
@@ -296,14 +380,11 @@ func (s *couponApplicationService) ApplyCouponsToInvoice(ctx context.Context, re
 		runningSubTotal = discountResult.FinalPrice
 
 		// Build coupon application entity (for persistence by caller)
-		couponAssociationID := ""
-		if invoiceCoupon.CouponAssociationID != nil {
-			couponAssociationID = *invoiceCoupon.CouponAssociationID
-		}
 		ca := &coupon_application.CouponApplication{
 			ID:                  types.GenerateUUIDWithPrefix(types.UUID_PREFIX_COUPON_APPLICATION),
 			CouponID:            invoiceCoupon.CouponID,
-			CouponAssociationID: couponAssociationID,
+			CouponAssociationID: lo.FromPtr(invoiceCoupon.CouponAssociationID),
+			PromotionCodeID:     invoiceCoupon.PromotionCodeID,
 			InvoiceID:           inv.ID,
 			SubscriptionID:      inv.SubscriptionID,
 			AppliedAt:           time.Now(),
@@ -313,17 +394,11 @@ func (s *couponApplicationService) ApplyCouponsToInvoice(ctx context.Context, re
 			DiscountType:        coupon.Type,
 			DiscountPercentage:  coupon.PercentageOff,
 			Currency:            inv.Currency,
-			CouponSnapshot: map[string]interface{}{
-				"type":           coupon.Type,
-				"amount_off":     coupon.AmountOff,
-				"percentage_off": coupon.PercentageOff,
-				"applied_to":     "invoice",
-			},
-			BaseModel:     types.GetDefaultBaseModel(ctx),
-			EnvironmentID: types.GetEnvironmentID(ctx),
+			CouponSnapshot:      buildCouponSnapshot(coupon, "invoice", "applies to all line items"),
+			BaseModel:           types.GetDefaultBaseModel(ctx),
+			EnvironmentID:       types.GetEnvironmentID(ctx),
 		}
 
-		invoiceLevelCouponApplications = append(invoiceLevelCouponApplications, ca)
 		appliedCoupons = append(appliedCoupons, &dto.CouponApplicationResponse{
 			CouponApplication: ca,
 		})
@@ -333,6 +408,11 @@ func (s *couponApplicationService) ApplyCouponsToInvoice(ctx context.Context, re
 			"original_subtotal", runningSubTotal.Add(discountResult.Discount),
 			"discount", discountResult.Discount,
 			"final_subtotal", discountResult.FinalPrice)
+
+		// Nothing combines with an exclusive invoice coupon once it has applied
+		if coupon.Rules.IsExclusive() {
+			break
+		}
 	}
 
 	// Step 3: Apply mutations in transaction (mutations at boundaries)
@@ -351,9 +431,9 @@ func (s *couponApplicationService) ApplyCouponsToInvoice(ctx context.Context, re
 		}
 
 		// Explicitly distribute invoice-level discount (mutating method - name makes it clear)
-		if !totalInvoiceLevelDiscount.IsZero() && len(inv.LineItems) > 0 {
+		if !totalInvoiceLevelDiscount.IsZero() && len(invoiceDiscountLineItems) > 0 {
 			invoiceService := NewInvoiceService(s.ServiceParams)
-			if err := invoiceService.DistributeInvoiceLevelDiscount(txCtx, inv.LineItems, totalInvoiceLevelDiscount); err != nil {
+			if err := invoiceService.DistributeInvoiceLevelDiscount(txCtx, invoiceDiscountLineItems, totalInvoiceLevelDiscount); err != nil {
 				s.Logger.ErrorwCtx(ctx, "failed to distribute invoice-level discount",
 					"invoice_id", inv.ID,
 					"total_invoice_level_discount", totalInvoiceLevelDiscount,
@@ -397,3 +477,71 @@ func (s *couponApplicationService) ApplyCouponsToInvoice(ctx context.Context, re
 
 	return result, nil
 }
+
+// lineScopedCoupon is a coupon discounting individual line items, either through a line item
+// association (PriceID set) or through targeting rules of an invoice coupon
+type lineScopedCoupon struct {
+	CouponID            string
+	CouponAssociationID *string
+	PromotionCodeID     *string
+	PriceID             string
+}
+
+// buildCouponRuleTargets describes each line item for coupon rule matching. Features are only
+// looked up when a coupon targets features.
+func (s *couponApplicationService) buildCouponRuleTargets(ctx context.Context, lineItems []*invoice.InvoiceLineItem, coupons []*coupon.Coupon) map[string]types.CouponRuleTarget {
+	featureIDsByMeterID := make(map[string]string)
+	targetsFeatures := lo.SomeBy(coupons, func(c *coupon.Coupon) bool {
+		return c.Rules != nil && len(c.Rules.FeatureIDs) > 0
+	})
+	if targetsFeatures && s.FeatureRepo != nil {
+		meterIDs := lo.Uniq(lo.FilterMap(lineItems, func(lineItem *invoice.InvoiceLineItem, _ int) (string, bool) {
+			return lo.FromPtr(lineItem.MeterID), lo.FromPtr(lineItem.MeterID) != ""
+		}))
+		if len(meterIDs) > 0 {
+			featureFilter := types.NewNoLimitFeatureFilter()
+			featureFilter.MeterIDs = meterIDs
+			features, err := s.FeatureRepo.List(ctx, featureFilter)
+			if err != nil {
+				// Feature rules then match nothing rather than failing the invoice
+				s.Logger.WarnwCtx(ctx, "failed to fetch features for coupon rules",
+					"error", err)
+			}
+			for _, f := range features {
+				featureIDsByMeterID[f.MeterID] = f.ID
+			}
+		}
+	}
+
+	targets := make(map[string]types.CouponRuleTarget, len(lineItems))
+	for _, lineItem := range lineItems {
+		target := types.CouponRuleTarget{
+			PriceID:   lo.FromPtr(lineItem.PriceID),
+			PriceType: types.PriceType(lo.FromPtr(lineItem.PriceType)),
+			FeatureID: featureIDsByMeterID[lo.FromPtr(lineItem.MeterID)],
+		}
+		switch types.SubscriptionLineItemEntityType(lo.FromPtr(lineItem.EntityType)) {
+		case types.SubscriptionLineItemEntityTypePlan:
+			target.PlanID = lo.FromPtr(lineItem.EntityID)
+		case types.SubscriptionLineItemEntityTypeAddon:
+			target.AddonID = lo.FromPtr(lineItem.EntityID)
+		}
+		targets[lineItem.ID] = target
+	}
+	return targets
+}
+
+// buildCouponSnapshot captures the coupon terms and why it applied, for the invoice discount breakdown
+func buildCouponSnapshot(c *coupon.Coupon, appliedTo string, reason string) map[string]interface{} {
+	snapshot := map[string]interface{}{
+		"type":           c.Type,
+		"amount_off":     c.AmountOff,
+		"percentage_off": c.PercentageOff,
+		"applied_to":     appliedTo,
+		"reason":         reason,
+	}
+	if c.Rules != nil {
+		snapshot["rules"] = c.Rules
+	}
+	return snapshot
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/coupon"
+	"github.com/flexprice/flexprice/internal/domain/coupon_application"
+	"github.com/flexprice/flexprice/internal/domain/feature"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type CouponApplicationServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service CouponApplicationService
+	ctx     context.Context
+}
+
+func TestCouponApplicationService(t *testing.T) {
+	suite.Run(t, new(CouponApplicationServiceSuite))
+}
+
+func (s *CouponApplicationServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.ctx = s.GetContext()
+	s.service = NewCouponApplicationService(ServiceParams{
+		Logger:                s.GetLogger(),
+		Config:                s.GetConfig(),
+		DB:                    s.GetDB(),
+		InvoiceRepo:           s.GetStores().InvoiceRepo,
+		InvoiceLineItemRepo:   s.GetStores().InvoiceLineItemRepo,
+		FeatureRepo:           s.GetStores().FeatureRepo,
+		CouponRepo:            s.GetStores().CouponRepo,
+		CouponApplicationRepo: s.GetStores().CouponApplicationRepo,
+	})
+}
+
+func (s *CouponApplicationServiceSuite) createCoupon(id string, couponType types.CouponType, value int64, rules *types.CouponRules) {
+	c := &coupon.Coupon{
+		ID:            id,
+		Name:          id,
+		Type:          couponType,
+		Cadence:       types.CouponCadenceForever,
+		Rules:         rules,
+		Currency:      "usd",
+		EnvironmentID: types.GetEnvironmentID(s.ctx),
+		BaseModel:     types.GetDefaultBaseModel(s.ctx),
+	}
+	if couponType == types.CouponTypeFixed {
+		c.AmountOff = lo.ToPtr(decimal.NewFromInt(value))
+	} else {
+		c.PercentageOff = lo.ToPtr(decimal.NewFromInt(value))
+	}
+	s.NoError(s.GetStores().CouponRepo.Create(s.ctx, c))
+}
+
+func (s *CouponApplicationServiceSuite) createLineItem(id, priceID string, priceType types.PriceType, entityType types.SubscriptionLineItemEntityType, entityID string, meterID *string, amount int64) *invoice.InvoiceLineItem {
+	item := &invoice.InvoiceLineItem{
+		ID:                   id,
+		InvoiceID:            "inv-1",
+		PriceID:              lo.ToPtr(priceID),
+		PriceType:            lo.ToPtr(string(priceType)),
+		EntityType:           lo.ToPtr(string(entityType)),
+		EntityID:             lo.ToPtr(entityID),
+		MeterID:              meterID,
+		Amount:               decimal.NewFromInt(amount),
+		Currency:             "usd",
+		LineItemDiscount:     decimal.Zero,
+		InvoiceLevelDiscount: decimal.Zero,
+		EnvironmentID:        types.GetEnvironmentID(s.ctx),
+		BaseModel:            types.GetDefaultBaseModel(s.ctx),
+	}
+	s.NoError(s.GetStores().InvoiceLineItemRepo.Create(s.ctx, item))
+	return item
+}
+
+func (s *CouponApplicationServiceSuite) TestApplyCouponsToInvoiceWithRules() {
+	s.NoError(s.GetStores().FeatureRepo.Create(s.ctx, &feature.Feature{
+		ID:            "feat-api-calls",
+		Name:          "API calls",
+		Type:          types.FeatureTypeMetered,
+		MeterID:       "meter-api-calls",
+		EnvironmentID: types.GetEnvironmentID(s.ctx),
+		BaseModel:     types.GetDefaultBaseModel(s.ctx),
+	}))
+
+	planFixed := s.createLineItem("li-plan-fixed", "price-fixed", types.PRICE_TYPE_FIXED, types.SubscriptionLineItemEntityTypePlan, "plan-pro", nil, 100)
+	planUsage := s.createLineItem("li-plan-usage", "price-usage", types.PRICE_TYPE_USAGE, types.SubscriptionLineItemEntityTypePlan, "plan-pro", lo.ToPtr("meter-api-calls"), 200)
+	addonFixed := s.createLineItem("li-addon", "price-addon", types.PRICE_TYPE_FIXED, types.SubscriptionLineItemEntityTypeAddon, "addon-support", nil, 50)
+
+	// Applied first and locks the addon line item
+	s.createCoupon("coupon-addon", types.CouponTypeFixed, 10, &types.CouponRules{
+		AddonIDs: []string{"addon-support"},
+		Stacking: types.CouponStackingModeExclusive,
+	})
+	// Discounts only usage line items
+	s.createCoupon("coupon-usage", types.CouponTypePercentage, 10, &types.CouponRules{
+		PriceTypes:       []types.PriceType{types.PRICE_TYPE_USAGE},
+		ApplicationOrder: 1,
+	})
+	// Exclusive, but the usage line item is already discounted by then
+	s.createCoupon("coupon-feature", types.CouponTypeFixed, 30, &types.CouponRules{
+		FeatureIDs:       []string{"feat-api-calls"},
+		Stacking:         types.CouponStackingModeExclusive,
+		ApplicationOrder: 2,
+	})
+	// Invoice-wide, skips the line item locked by the exclusive addon coupon
+	s.createCoupon("coupon-all", types.CouponTypePercentage, 10, nil)
+
+	inv := &invoice.Invoice{
+		ID:            "inv-1",
+		Currency:      "usd",
+		LineItems:     []*invoice.InvoiceLineItem{planFixed, planUsage, addonFixed},
+		EnvironmentID: types.GetEnvironmentID(s.ctx),
+		BaseModel:     types.GetDefaultBaseModel(s.ctx),
+	}
+
+	result, err := s.service.ApplyCouponsToInvoice(s.ctx, dto.ApplyCouponsToInvoiceRequest{
+		Invoice: inv,
+		InvoiceCoupons: []dto.InvoiceCoupon{
+			{CouponID: "coupon-all"},
+			{CouponID: "coupon-feature"},
+			{CouponID: "coupon-usage"},
+			{CouponID: "coupon-addon"},
+		},
+	})
+	s.NoError(err)
+
+	s.True(planFixed.LineItemDiscount.IsZero())
+	s.True(planUsage.LineItemDiscount.Equal(decimal.NewFromInt(20)))
+	s.True(addonFixed.LineItemDiscount.Equal(decimal.NewFromInt(10)))
+	s.True(result.TotalInvoiceLineItemDiscount.Equal(decimal.NewFromInt(30)))
+
+	// 10% of the plan line items after line item discounts: (100 + 180) * 10%
+	s.True(result.TotalInvoiceLevelDiscount.Equal(decimal.NewFromInt(28)))
+	s.True(addonFixed.InvoiceLevelDiscount.IsZero())
+	s.True(planFixed.InvoiceLevelDiscount.Add(planUsage.InvoiceLevelDiscount).Equal(decimal.NewFromInt(28)))
+
+	filter := types.NewNoLimitCouponApplicationFilter()
+	filter.InvoiceIDs = []string{inv.ID}
+	applications, err := s.GetStores().CouponApplicationRepo.List(s.ctx, filter)
+	s.NoError(err)
+	s.Len(applications, 3)
+	s.False(lo.ContainsBy(applications, func(ca *coupon_application.CouponApplication) bool {
+		return ca.CouponID == "coupon-feature"
+	}))
+
+	inv.CouponApplications = applications
+	resp := dto.NewInvoiceResponse(inv)
+	usageBreakdown := resp.LineItems[1].DiscountBreakdown
+	s.Len(usageBreakdown, 2)
+	s.Equal("coupon-usage", usageBreakdown[0].CouponID)
+	s.Equal("matched price type USAGE", usageBreakdown[0].Reason)
+	s.Equal("invoice", usageBreakdown[1].AppliedTo)
+	s.True(usageBreakdown[1].Amount.Equal(planUsage.InvoiceLevelDiscount))
+	s.Len(resp.LineItems[2].DiscountBreakdown, 1)
+}
@@ -46,6 +46,7 @@ func (s *couponAssociationService) CreateCouponAssociation(ctx context.Context,
 			SubscriptionID:         req.SubscriptionID,
 			SubscriptionLineItemID: req.SubscriptionLineItemID,
 			SubscriptionPhaseID:    req.SubscriptionPhaseID,
+			PromotionCodeID:        req.PromotionCodeID,
 			StartDate:              req.StartDate.UTC(),
 			EndDate:                req.EndDate,
 			Metadata:               req.Metadata,
@@ -68,6 +69,14 @@ func (s *couponAssociationService) CreateCouponAssociation(ctx context.Context,
 				Mark(ierr.ErrInternal)
 		}
 
+		if req.PromotionCodeID != nil {
+			if err := s.PromotionCodeRepo.IncrementRedemptions(txCtx, *req.PromotionCodeID); err != nil {
+				return ierr.WithError(err).
+					WithHint("Failed to increment promotion code redemptions").
+					Mark(ierr.ErrInternal)
+			}
+		}
+
 		response = s.toCouponAssociationResponse(ca)
 		return nil
 	})
@@ -189,6 +198,7 @@ func (s *couponAssociationService) ApplyCouponsToSubscription(ctx context.Contex
 			StartDate:              couponReq.StartDate.UTC(),
 			EndDate:                couponReq.EndDate,
 			SubscriptionPhaseID:    couponReq.SubscriptionPhaseID,
+			PromotionCodeID:        couponReq.PromotionCodeID,
 			Metadata:               map[string]string{},
 		}
 
@@ -7,6 +7,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/coupon"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 )
 
 // CouponValidationError represents validation errors with structured details
@@ -113,6 +114,31 @@ func (s *couponValidationService) validateCouponBusinessRules(coupon coupon.Coup
 		}
 	}
 
+	// Targeting validation (if subscription exists and has a plan)
+	if subscription != nil && subscription.PlanID != "" {
+		if err := s.validateCouponTargeting(coupon, subscription.PlanID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Targeting validation
+func (s *couponValidationService) validateCouponTargeting(coupon coupon.Coupon, planID string) error {
+	// A coupon restricted to other plans can never discount this subscription's line items
+	if coupon.Rules != nil && len(coupon.Rules.PlanIDs) > 0 && !lo.Contains(coupon.Rules.PlanIDs, planID) {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodePlanNotEligible,
+			Message: "Coupon does not apply to the subscription's plan",
+			Details: map[string]interface{}{
+				"coupon_id":         coupon.ID,
+				"plan_id":           planID,
+				"eligible_plan_ids": coupon.Rules.PlanIDs,
+			},
+		}
+	}
+
 	return nil
 }
 
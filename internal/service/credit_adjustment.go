@@ -108,6 +108,13 @@ func (s *creditAdjustmentService) CalculateCreditAdjustments(inv *invoice.Invoic
 			continue
 		}
 
+		// Amounts already applied to the line item (e.g. a commitment contract drawdown) are kept
+		alreadyApplied := lineItem.PrepaidCreditsApplied
+		lineItemAmountAfterDiscounts = lineItemAmountAfterDiscounts.Sub(alreadyApplied)
+		if lineItemAmountAfterDiscounts.LessThanOrEqual(decimal.Zero) {
+			continue
+		}
+
 		// How much can we apply to this line item? Can't exceed what's available or what's needed
 		maxAmountToApply := decimal.Min(remainingAmountAvailable, lineItemAmountAfterDiscounts)
 		amountAppliedToLineItem := decimal.Zero
@@ -152,7 +159,7 @@ func (s *creditAdjustmentService) CalculateCreditAdjustments(inv *invoice.Invoic
 			}
 		}
 
-		lineItem.PrepaidCreditsApplied = amountAppliedToLineItem
+		lineItem.PrepaidCreditsApplied = alreadyApplied.Add(amountAppliedToLineItem)
 
 		// Subtract what we used from the pool (use unrounded value to keep precision)
 		remainingAmountAvailable = remainingAmountAvailable.Sub(amountAppliedToLineItem)
@@ -228,6 +235,29 @@ func (s *creditAdjustmentService) ApplyCreditsToInvoice(ctx context.Context, inv
 		}, nil
 	}
 
+	// Wallets in other currencies take part with their balance converted into the invoice
+	// currency, and are debited the converted back amount at the same rate
+	conversions := make(map[string]*types.FXConversion)
+	walletLookupMap := make(map[string]*wallet.Wallet)
+	adjustmentWallets := make([]*wallet.Wallet, 0, len(wallets))
+	for _, w := range wallets {
+		converted, conversion, err := walletPaymentService.ConvertWalletBalance(ctx, w, inv.Currency)
+		if err != nil {
+			s.Logger.WarnwCtx(ctx, "skipping wallet without fx rate to invoice currency",
+				"error", err,
+				"invoice_id", inv.ID,
+				"wallet_id", w.ID,
+				"wallet_currency", w.Currency,
+				"invoice_currency", inv.Currency)
+			continue
+		}
+		if conversion != nil {
+			conversions[w.ID] = conversion
+		}
+		walletLookupMap[w.ID] = w
+		adjustmentWallets = append(adjustmentWallets, converted)
+	}
+
 	// Step 2: Calculate all credit adjustments (OUTSIDE TRANSACTION)
 	// This method:
 	// - Filters usage-based line items only
@@ -235,7 +265,7 @@ func (s *creditAdjustmentService) ApplyCreditsToInvoice(ctx context.Context, inv
 	// - Determines how much credit to apply from each wallet
 	// - Directly modifies lineItem.PrepaidCreditsApplied in memory (NOT persisted yet)
 	// - Returns a map of wallet debits (walletID -> total amount to debit)
-	amountsToDebitFromWallets, err := s.CalculateCreditAdjustments(inv, wallets)
+	amountsToDebitFromWallets, err := s.CalculateCreditAdjustments(inv, adjustmentWallets)
 	if err != nil {
 		return nil, err
 	}
@@ -250,12 +280,6 @@ func (s *creditAdjustmentService) ApplyCreditsToInvoice(ctx context.Context, inv
 
 	walletService := NewWalletService(s.ServiceParams)
 
-	// Build a quick lookup map so we can find wallet details fast during the transaction
-	walletLookupMap := make(map[string]*wallet.Wallet)
-	for _, w := range wallets {
-		walletLookupMap[w.ID] = w
-	}
-
 	var result *dto.CreditAdjustmentResult
 	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
 		// Take money from each wallet that contributed amounts
@@ -293,6 +317,15 @@ func (s *creditAdjustmentService) ApplyCreditsToInvoice(ctx context.Context, inv
 				},
 			}
 
+			if conversion, ok := conversions[walletID]; ok {
+				// amountToDebit is in the invoice currency, the wallet is debited in its own
+				walletDebitOperation.Amount = decimal.Min(conversion.ConvertBack(amountToDebit), walletToDebit.Balance)
+				walletDebitOperation.FX = conversion
+				walletDebitOperation.SettlementAmount = amountToDebit
+				walletDebitOperation.Metadata["fx_rate"] = conversion.Rate.String()
+				walletDebitOperation.Metadata["settlement_currency"] = conversion.ToCurrency
+			}
+
 			if err := walletService.DebitWallet(ctx, walletDebitOperation); err != nil {
 				return err
 			}
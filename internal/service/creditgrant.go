@@ -3,17 +3,22 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/domain/creditgrant"
 	domainCreditGrantApplication "github.com/flexprice/flexprice/internal/domain/creditgrantapplication"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/domain/wallet"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/idempotency"
 	"github.com/flexprice/flexprice/internal/sentry"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 )
 
 // CreditGrantService defines the interface for credit grant service
@@ -210,6 +215,7 @@ func (s *creditGrantService) CreateCreditGrant(ctx context.Context, req dto.Crea
 	if err != nil {
 		return nil, err
 	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeCreditGrant, cg.ID, types.AuditLogActionCreate, nil, cg)
 
 	// Initialize workflow
 	if cg.Scope == types.CreditGrantScopeSubscription {
@@ -342,6 +348,7 @@ func (s *creditGrantService) UpdateCreditGrant(ctx context.Context, id string, r
 	if err != nil {
 		return nil, err
 	}
+	before := *existing
 
 	// Update fields if provided
 	if req.Name != nil {
@@ -360,6 +367,7 @@ func (s *creditGrantService) UpdateCreditGrant(ctx context.Context, id string, r
 	if err != nil {
 		return nil, err
 	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeCreditGrant, id, types.AuditLogActionUpdate, &before, updated)
 
 	response := &dto.CreditGrantResponse{CreditGrant: updated}
 	return response, nil
@@ -385,6 +393,7 @@ func (s *creditGrantService) DeleteCreditGrant(ctx context.Context, req dto.Dele
 			}).
 			Mark(ierr.ErrValidation)
 	}
+	before := *grant
 
 	// Handle based on scope
 	if grant.Scope == types.CreditGrantScopePlan {
@@ -396,6 +405,7 @@ func (s *creditGrantService) DeleteCreditGrant(ctx context.Context, req dto.Dele
 		}); err != nil {
 			return err
 		}
+		s.recordAuditLog(ctx, types.AuditLogEntityTypeCreditGrant, grant.ID, types.AuditLogActionDelete, &before, grant)
 		return nil
 	}
 
@@ -422,6 +432,7 @@ func (s *creditGrantService) DeleteCreditGrant(ctx context.Context, req dto.Dele
 		return err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeCreditGrant, grant.ID, types.AuditLogActionDelete, &before, grant)
 	return nil
 }
 
@@ -677,13 +688,18 @@ func (s *creditGrantService) applyCreditGrantToWallet(ctx context.Context, grant
 			}
 		}
 
-		// Task 1: Apply credit to wallet
+		// Task 1: Carry unused credits of earlier periods into this one
+		if err := s.rolloverUnusedCredits(txCtx, grant, subscription, cga, selectedWallet.ID, expiryDate); err != nil {
+			return err
+		}
+
+		// Task 2: Apply credit to wallet
 		_, err := walletService.TopUpWallet(txCtx, selectedWallet.ID, topupReq)
 		if err != nil {
 			return err
 		}
 
-		// Task 2: Update CGA status to applied
+		// Task 3: Update CGA status to applied
 		cga.ApplicationStatus = types.ApplicationStatusApplied
 		cga.AppliedAt = lo.ToPtr(time.Now().UTC())
 		// Note: We do NOT clear failure reason even on success - preserve it for audit/history purposes
@@ -692,7 +708,7 @@ func (s *creditGrantService) applyCreditGrantToWallet(ctx context.Context, grant
 			return err
 		}
 
-		// Task 3: Create next period application if recurring
+		// Task 4: Create next period application if recurring
 		if grant.Cadence == types.CreditGrantCadenceRecurring {
 			created, err := s.createNextPeriodApplication(txCtx, grant, subscription, lo.FromPtr(cga.PeriodEnd))
 			if err != nil {
@@ -722,6 +738,202 @@ func (s *creditGrantService) applyCreditGrantToWallet(ctx context.Context, grant
 	return nextCGA, nil
 }
 
+// rolloverUnusedCredits moves the unused credits of earlier periods of a grant into the period of cga,
+// within the grant's rollover policy. Each source transaction is debited with a CREDIT_ROLLOVER debit
+// and the total is credited back as a single CREDIT_ROLLOVER transaction with its own expiry.
+// Credits beyond the policy's caps, or that already rolled over max_periods times, are left to expire.
+func (s *creditGrantService) rolloverUnusedCredits(
+	ctx context.Context,
+	grant *creditgrant.CreditGrant,
+	subscription *subscription.Subscription,
+	cga *domainCreditGrantApplication.CreditGrantApplication,
+	walletID string,
+	periodExpiryDate *time.Time,
+) error {
+	if grant.RolloverConfig == nil || grant.Cadence != types.CreditGrantCadenceRecurring {
+		return nil
+	}
+
+	sources, err := s.findRolloverSourceTransactions(ctx, grant, subscription, cga, walletID)
+	if err != nil {
+		return err
+	}
+
+	unused := decimal.Zero
+	for _, tx := range sources {
+		unused = unused.Add(tx.CreditsAvailable)
+	}
+
+	remaining := grant.RolloverConfig.Cap(unused, grant.Credits)
+	if !remaining.IsPositive() {
+		return nil
+	}
+
+	walletService := NewWalletService(s.ServiceParams)
+	rolledOver := decimal.Zero
+	generation := 0
+	sourceIDs := make([]string, 0, len(sources))
+
+	// Sources are ordered oldest expiry first, so the credits that have waited longest move first
+	for _, tx := range sources {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		amount := decimal.Min(remaining, tx.CreditsAvailable)
+		if err := walletService.DebitWallet(ctx, &wallet.WalletOperation{
+			WalletID:          walletID,
+			ParentCreditTxID:  tx.ID,
+			Type:              types.TransactionTypeDebit,
+			CreditAmount:      amount,
+			Description:       fmt.Sprintf("Credit rollover from transaction %s", tx.ID),
+			TransactionReason: types.TransactionReasonCreditRollover,
+			ReferenceType:     types.WalletTxReferenceTypeRequest,
+			ReferenceID:       cga.ID,
+			IdempotencyKey:    fmt.Sprintf("%s_rollover_%s", cga.ID, tx.ID),
+			Metadata: types.Metadata{
+				"grant_id":        grant.ID,
+				"subscription_id": subscription.ID,
+				"cga_id":          cga.ID,
+			},
+		}); err != nil {
+			return err
+		}
+
+		remaining = remaining.Sub(amount)
+		rolledOver = rolledOver.Add(amount)
+		generation = max(generation, rolloverGeneration(tx)+1)
+		sourceIDs = append(sourceIDs, tx.ID)
+	}
+
+	expiryDate, err := s.rolloverExpiryDate(grant, cga, periodExpiryDate)
+	if err != nil {
+		return err
+	}
+
+	var expiry *int
+	if expiryDate != nil {
+		parsed, err := strconv.Atoi(expiryDate.UTC().Format("20060102"))
+		if err != nil {
+			return ierr.WithError(err).
+				WithHint("Invalid rollover expiry date").
+				Mark(ierr.ErrValidation)
+		}
+		expiry = &parsed
+	}
+
+	if err := walletService.CreditWallet(ctx, &wallet.WalletOperation{
+		WalletID:          walletID,
+		Type:              types.TransactionTypeCredit,
+		CreditAmount:      rolledOver,
+		Description:       fmt.Sprintf("Credit rollover for grant %s", grant.Name),
+		TransactionReason: types.TransactionReasonCreditRollover,
+		ReferenceType:     types.WalletTxReferenceTypeRequest,
+		ReferenceID:       cga.ID,
+		IdempotencyKey:    fmt.Sprintf("%s_rollover", cga.ID),
+		ExpiryDate:        expiry,
+		Priority:          grant.Priority,
+		Metadata: types.Metadata{
+			"grant_id":            grant.ID,
+			"subscription_id":     subscription.ID,
+			"cga_id":              cga.ID,
+			"rollover_generation": strconv.Itoa(generation),
+			"rolled_over_from":    strings.Join(sourceIDs, ","),
+		},
+	}); err != nil {
+		return err
+	}
+
+	if cga.Metadata == nil {
+		cga.Metadata = types.Metadata{}
+	}
+	cga.Metadata["rollover_credits"] = rolledOver.String()
+
+	s.Logger.InfowCtx(ctx, "rolled over unused credits",
+		"grant_id", grant.ID,
+		"subscription_id", subscription.ID,
+		"cga_id", cga.ID,
+		"unused_credits", unused,
+		"rollover_credits", rolledOver,
+		"rollover_generation", generation,
+	)
+
+	return nil
+}
+
+// findRolloverSourceTransactions returns the credits of this grant and subscription that are still
+// available in the wallet, expire by the start of the period of cga, and may still roll over
+func (s *creditGrantService) findRolloverSourceTransactions(
+	ctx context.Context,
+	grant *creditgrant.CreditGrant,
+	subscription *subscription.Subscription,
+	cga *domainCreditGrantApplication.CreditGrantApplication,
+	walletID string,
+) ([]*wallet.Transaction, error) {
+	filter := types.NewNoLimitWalletTransactionFilter()
+	filter.WalletID = lo.ToPtr(walletID)
+	filter.Type = lo.ToPtr(types.TransactionTypeCredit)
+	filter.CreditsAvailableGT = lo.ToPtr(decimal.Zero)
+	filter.ExpiryDateBefore = lo.ToPtr(cga.PeriodStart)
+
+	transactions, err := s.WalletRepo.ListWalletTransactions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	maxPeriods := grant.RolloverConfig.GetMaxPeriods()
+	sources := lo.Filter(transactions, func(tx *wallet.Transaction, _ int) bool {
+		if tx.ExpiryDate == nil || tx.ExpiryDate.After(cga.PeriodStart) || !tx.CreditsAvailable.IsPositive() {
+			return false
+		}
+		if tx.TransactionReason != types.TransactionReasonSubscriptionCredit &&
+			tx.TransactionReason != types.TransactionReasonCreditRollover {
+			return false
+		}
+		if tx.Metadata["grant_id"] != grant.ID || tx.Metadata["subscription_id"] != subscription.ID {
+			return false
+		}
+		return rolloverGeneration(tx) < maxPeriods
+	})
+
+	sort.SliceStable(sources, func(i, j int) bool {
+		return sources[i].ExpiryDate.Before(*sources[j].ExpiryDate)
+	})
+
+	return sources, nil
+}
+
+// rolloverExpiryDate returns when credits rolled into the period of cga expire: the policy's own
+// expiration counted from the period start, else the expiry of the period's credits, else the period end
+func (s *creditGrantService) rolloverExpiryDate(
+	grant *creditgrant.CreditGrant,
+	cga *domainCreditGrantApplication.CreditGrantApplication,
+	periodExpiryDate *time.Time,
+) (*time.Time, error) {
+	config := grant.RolloverConfig
+	if config.ExpirationDuration != nil && config.ExpirationDurationUnit != nil {
+		expiry, err := config.ExpirationDurationUnit.AddTo(cga.PeriodStart, *config.ExpirationDuration)
+		if err != nil {
+			return nil, err
+		}
+		return &expiry, nil
+	}
+
+	if periodExpiryDate != nil {
+		return periodExpiryDate, nil
+	}
+	return cga.PeriodEnd, nil
+}
+
+// rolloverGeneration returns how many times the credits of tx have rolled over
+func rolloverGeneration(tx *wallet.Transaction) int {
+	generation, err := strconv.Atoi(tx.Metadata["rollover_generation"])
+	if err != nil {
+		return 0
+	}
+	return generation
+}
+
 // handleCreditGrantFailure handles failure by updating CGA status and logging
 func (s *creditGrantService) handleCreditGrantFailure(
 	ctx context.Context,
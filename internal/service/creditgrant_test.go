@@ -2369,3 +2369,95 @@ func (s *CreditGrantServiceTestSuite) TestCatchUp_FutureStartDate() {
 	s.Equal(0, applied, "expected no applied CGAs for future grant")
 	s.Equal(1, pending, "expected 1 pending CGA waiting for future schedule")
 }
+
+// TestRecurringGrantRollover verifies unused credits of a period roll into the next one within the policy's cap
+func (s *CreditGrantServiceTestSuite) TestRecurringGrantRollover() {
+	ctx := s.GetContext()
+	startDate := s.testData.now.AddDate(0, 0, -1)
+	sub := s.createBackdatedSubscription("sub_rollover", startDate)
+
+	resp, err := s.creditGrantService.CreateCreditGrant(ctx, dto.CreateCreditGrantRequest{
+		Name:                   "Rollover Grant",
+		Scope:                  types.CreditGrantScopeSubscription,
+		Credits:                decimal.NewFromInt(100),
+		Cadence:                types.CreditGrantCadenceRecurring,
+		Period:                 lo.ToPtr(types.CREDIT_GRANT_PERIOD_MONTHLY),
+		PeriodCount:            lo.ToPtr(1),
+		ExpirationType:         types.CreditGrantExpiryTypeDuration,
+		ExpirationDuration:     lo.ToPtr(1),
+		ExpirationDurationUnit: lo.ToPtr(types.CreditGrantExpiryDurationUnitMonths),
+		Priority:               lo.ToPtr(1),
+		StartDate:              &startDate,
+		SubscriptionID:         &sub.ID,
+		RolloverConfig: &types.CreditGrantRolloverConfig{
+			MaxPercent: lo.ToPtr(decimal.NewFromInt(50)),
+		},
+	})
+	s.NoError(err)
+	grant := resp.CreditGrant
+
+	apps, err := s.GetStores().CreditGrantApplicationRepo.List(ctx, &types.CreditGrantApplicationFilter{
+		CreditGrantIDs:      []string{grant.ID},
+		ApplicationStatuses: []types.ApplicationStatus{types.ApplicationStatusPending},
+		QueryFilter:         types.NewNoLimitQueryFilter(),
+	})
+	s.NoError(err)
+	s.Require().Len(apps, 1)
+	nextCGA := apps[0]
+
+	// Apply the next period as the scheduler would once it starts
+	_, err = s.creditGrantService.(*creditGrantService).applyCreditGrantToWallet(ctx, grant, sub, nextCGA)
+	s.NoError(err)
+	s.Equal("50", nextCGA.Metadata["rollover_credits"])
+
+	wallets, err := s.walletService.GetWalletsByCustomerID(ctx, sub.CustomerID)
+	s.NoError(err)
+	s.Require().NotEmpty(wallets)
+
+	var txs []*wallet.Transaction
+	for _, w := range wallets {
+		walletTxs, err := s.GetStores().WalletRepo.ListWalletTransactions(ctx, &types.WalletTransactionFilter{
+			WalletID:    &w.ID,
+			QueryFilter: types.NewNoLimitQueryFilter(),
+		})
+		s.NoError(err)
+		txs = append(txs, walletTxs...)
+	}
+
+	var rolloverCredit, firstPeriod *wallet.Transaction
+	for _, tx := range txs {
+		if tx.Metadata["grant_id"] != grant.ID {
+			continue
+		}
+		switch {
+		case tx.Type == types.TransactionTypeCredit && tx.TransactionReason == types.TransactionReasonCreditRollover:
+			rolloverCredit = tx
+		case tx.Type == types.TransactionTypeCredit && tx.Metadata["cga_id"] != nextCGA.ID:
+			firstPeriod = tx
+		}
+	}
+
+	s.Require().NotNil(rolloverCredit)
+	s.Require().NotNil(firstPeriod)
+	s.True(rolloverCredit.CreditAmount.Equal(decimal.NewFromInt(50)))
+	s.Equal("1", rolloverCredit.Metadata["rollover_generation"])
+	s.Equal(firstPeriod.ID, rolloverCredit.Metadata["rolled_over_from"])
+	s.True(firstPeriod.CreditsAvailable.Equal(decimal.NewFromInt(50)), "the rest of the first period's credits are left to expire")
+}
+
+// TestRolloverRequiresRecurringExpiringGrant verifies rollover is rejected where nothing can roll over
+func (s *CreditGrantServiceTestSuite) TestRolloverRequiresRecurringExpiringGrant() {
+	_, err := s.creditGrantService.CreateCreditGrant(s.GetContext(), dto.CreateCreditGrantRequest{
+		Name:           "Onetime Rollover Grant",
+		Scope:          types.CreditGrantScopePlan,
+		PlanID:         &s.testData.plan.ID,
+		Credits:        decimal.NewFromInt(100),
+		Cadence:        types.CreditGrantCadenceOneTime,
+		ExpirationType: types.CreditGrantExpiryTypeNever,
+		RolloverConfig: &types.CreditGrantRolloverConfig{
+			MaxCredits: lo.ToPtr(decimal.NewFromInt(10)),
+		},
+	})
+	s.Error(err)
+	s.True(ierr.IsValidation(err))
+}
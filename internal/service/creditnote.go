@@ -121,6 +121,7 @@ func (s *creditNoteService) CreateCreditNote(ctx context.Context, req *dto.Creat
 		if err := s.CreditNoteRepo.CreateWithLineItems(tx, cn); err != nil {
 			return err
 		}
+		s.recordAuditLog(tx, types.AuditLogEntityTypeCreditNote, cn.ID, types.AuditLogActionCreate, nil, cn)
 
 		s.Logger.InfowCtx(ctx,
 			"credit note created successfully",
@@ -408,6 +409,7 @@ func (s *creditNoteService) VoidCreditNote(ctx context.Context, id string) error
 
 	// Store original status for logging
 	originalStatus := cn.CreditNoteStatus
+	before := *cn
 
 	// Update credit note status to voided
 	cn.CreditNoteStatus = types.CreditNoteStatusVoided
@@ -415,6 +417,7 @@ func (s *creditNoteService) VoidCreditNote(ctx context.Context, id string) error
 	if err := s.CreditNoteRepo.Update(ctx, cn); err != nil {
 		return err
 	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeCreditNote, cn.ID, types.AuditLogActionVoid, &before, cn)
 
 	// Recalculate invoice amounts after credit note void
 	// This is needed to update the adjustment and refunded amounts
@@ -475,10 +478,12 @@ func (s *creditNoteService) FinalizeCreditNote(ctx context.Context, id string) e
 	// Process the credit note in transaction
 	err = s.DB.WithTx(ctx, func(tx context.Context) error {
 		// Update credit note status first
+		before := *cn
 		cn.CreditNoteStatus = types.CreditNoteStatusFinalized
 		if err := s.CreditNoteRepo.Update(tx, cn); err != nil {
 			return err
 		}
+		s.recordAuditLog(tx, types.AuditLogEntityTypeCreditNote, cn.ID, types.AuditLogActionFinalize, &before, cn)
 
 		// Handle refund credit notes (wallet top-up logic)
 		if cn.CreditNoteType == types.CreditNoteTypeRefund {
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
@@ -42,11 +43,18 @@ func (s *customerService) CreateCustomer(ctx context.Context, req dto.CreateCust
 			Mark(ierr.ErrValidation)
 	}
 
+	if cust.ParentCustomerID != nil {
+		if err := newCustomerHierarchy(s.CustomerRepo).ValidateParent(ctx, "", *cust.ParentCustomerID); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := s.DB.WithTx(ctx, func(txCtx context.Context) error {
 		if err := s.CustomerRepo.Create(txCtx, cust); err != nil {
 			// No need to wrap the error as the repository already returns properly formatted errors
 			return err
 		}
+		s.recordAuditLog(txCtx, types.AuditLogEntityTypeCustomer, cust.ID, types.AuditLogActionCreate, nil, cust)
 
 		taxService := NewTaxService(s.ServiceParams)
 
@@ -215,6 +223,7 @@ func (s *customerService) UpdateCustomer(ctx context.Context, id string, req dto
 	if err != nil {
 		return nil, err
 	}
+	before := *cust
 
 	// Update basic fields
 	if req.ExternalID != nil && *req.ExternalID != cust.ExternalID {
@@ -256,12 +265,35 @@ func (s *customerService) UpdateCustomer(ctx context.Context, id string, req dto
 	if req.AddressCountry != nil {
 		cust.AddressCountry = *req.AddressCountry
 	}
+	if req.Currency != nil {
+		cust.Currency = strings.ToLower(*req.Currency)
+	}
 
 	// Update metadata if provided
 	if req.Metadata != nil {
 		cust.Metadata = req.Metadata
 	}
 
+	// Update the position of the customer in its hierarchy
+	if req.ParentCustomerID != nil {
+		if *req.ParentCustomerID == "" {
+			cust.ParentCustomerID = nil
+		} else if *req.ParentCustomerID != lo.FromPtr(cust.ParentCustomerID) {
+			if err := newCustomerHierarchy(s.CustomerRepo).ValidateParent(ctx, cust.ID, *req.ParentCustomerID); err != nil {
+				return nil, err
+			}
+			cust.ParentCustomerID = req.ParentCustomerID
+		}
+	}
+	if req.InvoiceToParent != nil {
+		cust.InvoiceToParent = *req.InvoiceToParent
+	}
+	if cust.InvoiceToParent && cust.ParentCustomerID == nil {
+		return nil, ierr.NewError("invoice_to_parent requires parent_customer_id").
+			WithHint("Please set a parent customer before routing invoices to it").
+			Mark(ierr.ErrValidation)
+	}
+
 	// Validate address fields after update
 	if err := customer.ValidateAddress(cust); err != nil {
 		return nil, ierr.WithError(err).
@@ -274,6 +306,12 @@ func (s *customerService) UpdateCustomer(ctx context.Context, id string, req dto
 		return nil, err
 	}
 
+	// Moving a customer changes the entitlements it and its descendants inherit
+	if lo.FromPtr(before.ParentCustomerID) != lo.FromPtr(cust.ParentCustomerID) {
+		NewEntitlementCheckService(s.ServiceParams).InvalidateCustomer(ctx, cust.ID)
+	}
+
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeCustomer, cust.ID, types.AuditLogActionUpdate, &before, cust)
 	s.publishSystemEvent(ctx, types.WebhookEventCustomerUpdated, cust.ID)
 
 	return &dto.CustomerResponse{Customer: cust}, nil
@@ -323,14 +361,44 @@ func (s *customerService) DeleteCustomer(ctx context.Context, id string) error {
 			Mark(ierr.ErrInvalidOperation)
 	}
 
+	childFilter := types.NewCustomerFilter()
+	childFilter.ParentCustomerIDs = []string{id}
+	childFilter.Limit = lo.ToPtr(1)
+	children, err := s.CustomerRepo.List(ctx, childFilter)
+	if err != nil {
+		return err
+	}
+
+	if len(children) > 0 {
+		return ierr.NewError("customer cannot be deleted due to child customers").
+			WithHint("Please move or delete the child customers before deleting the customer").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
 	if err := s.CustomerRepo.Delete(ctx, customer); err != nil {
 		return err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeCustomer, id, types.AuditLogActionDelete, customer, nil)
 	s.publishSystemEvent(ctx, types.WebhookEventCustomerDeleted, id)
 	return nil
 }
 
+func (s *customerService) GetCustomerHierarchy(ctx context.Context, id string) (*dto.CustomerHierarchyResponse, error) {
+	if id == "" {
+		return nil, ierr.NewError("customer ID is required").
+			WithHint("Customer ID is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	cust, err := s.CustomerRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCustomerHierarchy(s.CustomerRepo).GetHierarchy(ctx, cust)
+}
+
 func (s *customerService) GetCustomerByLookupKey(ctx context.Context, lookupKey string) (*dto.CustomerResponse, error) {
 	if lookupKey == "" {
 		return nil, ierr.NewError("lookup key is required").
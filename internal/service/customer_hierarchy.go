@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// maxCustomerHierarchyDepth is the most ancestors a customer can have. Parents are
+// validated against it on write, and walks over the hierarchy are bounded by it so a
+// corrupted hierarchy cannot loop forever.
+const maxCustomerHierarchyDepth = 50
+
+// customerHierarchy resolves parents and children of customers. Customers point at their
+// parent through parent_customer_id, so ancestors are walked one lookup at a time and
+// descendants one level at a time.
+type customerHierarchy struct {
+	customerRepo customer.Repository
+}
+
+func newCustomerHierarchy(customerRepo customer.Repository) *customerHierarchy {
+	return &customerHierarchy{customerRepo: customerRepo}
+}
+
+// ValidateParent checks that parentID can become the parent of customerID, without a
+// cycle and without the customer or its descendants ending up deeper than
+// maxCustomerHierarchyDepth. customerID is empty for customers that are being created.
+func (h *customerHierarchy) ValidateParent(ctx context.Context, customerID, parentID string) error {
+	if parentID == customerID {
+		return ierr.NewError("customer cannot be its own parent").
+			WithHint("Please provide a different parent customer").
+			Mark(ierr.ErrValidation)
+	}
+
+	parent, err := h.customerRepo.Get(ctx, parentID)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Parent customer not found").
+			WithReportableDetails(map[string]interface{}{
+				"parent_customer_id": parentID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	if parent.Status != types.StatusPublished {
+		return ierr.NewError("parent customer is not published").
+			WithHint("Parent customer not found").
+			Mark(ierr.ErrValidation)
+	}
+
+	ancestors, err := h.GetAncestors(ctx, parent)
+	if err != nil {
+		return err
+	}
+
+	// The height of the subtree moved under the parent, zero for a new customer
+	height := 0
+	if customerID != "" {
+		for _, ancestor := range append([]*customer.Customer{parent}, ancestors...) {
+			if ancestor.ID == customerID {
+				return ierr.NewError("parent customer is a descendant of the customer").
+					WithHint("A customer cannot be moved under one of its own descendants").
+					WithReportableDetails(map[string]interface{}{
+						"customer_id":        customerID,
+						"parent_customer_id": parentID,
+					}).
+					Mark(ierr.ErrValidation)
+			}
+		}
+
+		descendants, err := h.GetDescendants(ctx, customerID)
+		if err != nil {
+			return err
+		}
+		height = lo.Max(lo.Map(descendants, func(n *dto.CustomerHierarchyNode, _ int) int { return n.Depth }))
+	}
+
+	if depth := len(ancestors) + 1 + height; depth > maxCustomerHierarchyDepth {
+		return ierr.NewErrorf("customer hierarchy would be %d levels deep", depth).
+			WithHintf("Customer hierarchies can be at most %d levels deep", maxCustomerHierarchyDepth).
+			WithReportableDetails(map[string]interface{}{
+				"customer_id":        customerID,
+				"parent_customer_id": parentID,
+				"depth":              depth,
+				"max_depth":          maxCustomerHierarchyDepth,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// GetAncestors returns the parents of a customer, nearest first
+func (h *customerHierarchy) GetAncestors(ctx context.Context, c *customer.Customer) ([]*customer.Customer, error) {
+	ancestors := make([]*customer.Customer, 0)
+	seen := map[string]bool{c.ID: true}
+	for current := c; current.ParentCustomerID != nil; {
+		parentID := lo.FromPtr(current.ParentCustomerID)
+		if seen[parentID] || len(ancestors) >= maxCustomerHierarchyDepth {
+			return nil, ierr.NewError("customer hierarchy is too deep or cyclic").
+				WithHint("Please check the parent customers of the customer").
+				WithReportableDetails(map[string]interface{}{
+					"customer_id": c.ID,
+				}).
+				Mark(ierr.ErrInvalidOperation)
+		}
+		seen[parentID] = true
+
+		parent, err := h.customerRepo.Get(ctx, parentID)
+		if err != nil {
+			return nil, err
+		}
+		ancestors = append(ancestors, parent)
+		current = parent
+	}
+	return ancestors, nil
+}
+
+// GetDescendants returns every customer below a customer, breadth first, with their depth
+func (h *customerHierarchy) GetDescendants(ctx context.Context, customerID string) ([]*dto.CustomerHierarchyNode, error) {
+	descendants := make([]*dto.CustomerHierarchyNode, 0)
+	seen := map[string]bool{customerID: true}
+	level := []string{customerID}
+	for depth := 1; len(level) > 0; depth++ {
+		if depth > maxCustomerHierarchyDepth {
+			return nil, ierr.NewError("customer hierarchy is too deep").
+				WithHint("Please check the child customers of the customer").
+				WithReportableDetails(map[string]interface{}{
+					"customer_id": customerID,
+				}).
+				Mark(ierr.ErrInvalidOperation)
+		}
+
+		filter := types.NewNoLimitCustomerFilter()
+		filter.ParentCustomerIDs = level
+		children, err := h.customerRepo.List(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		level = make([]string, 0, len(children))
+		for _, child := range children {
+			if seen[child.ID] {
+				continue
+			}
+			seen[child.ID] = true
+			descendants = append(descendants, &dto.CustomerHierarchyNode{Customer: child, Depth: depth})
+			level = append(level, child.ID)
+		}
+	}
+	return descendants, nil
+}
+
+// GetPayingCustomerID returns the customer invoices of c are routed to. Invoices move up
+// the hierarchy for as long as customers have invoice_to_parent set.
+func (h *customerHierarchy) GetPayingCustomerID(ctx context.Context, c *customer.Customer) (string, error) {
+	if !c.InvoiceToParent || c.ParentCustomerID == nil {
+		return c.ID, nil
+	}
+
+	ancestors, err := h.GetAncestors(ctx, c)
+	if err != nil {
+		return "", err
+	}
+	return payingCustomerID(c, ancestors), nil
+}
+
+// payingCustomerID walks ancestors, nearest first, while customers invoice to their parent
+func payingCustomerID(c *customer.Customer, ancestors []*customer.Customer) string {
+	paying := c
+	for _, ancestor := range ancestors {
+		if !paying.InvoiceToParent {
+			break
+		}
+		paying = ancestor
+	}
+	return paying.ID
+}
+
+// GetHierarchy returns the ancestors, descendants and paying customer of a customer
+func (h *customerHierarchy) GetHierarchy(ctx context.Context, c *customer.Customer) (*dto.CustomerHierarchyResponse, error) {
+	ancestors, err := h.GetAncestors(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	descendants, err := h.GetDescendants(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ancestorNodes := make([]*dto.CustomerHierarchyNode, 0, len(ancestors))
+	for i, ancestor := range ancestors {
+		ancestorNodes = append(ancestorNodes, &dto.CustomerHierarchyNode{Customer: ancestor, Depth: i + 1})
+	}
+
+	return &dto.CustomerHierarchyResponse{
+		CustomerID:       c.ID,
+		PayingCustomerID: payingCustomerID(c, ancestors),
+		Ancestors:        ancestorNodes,
+		Descendants:      descendants,
+	}, nil
+}
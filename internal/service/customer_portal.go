@@ -3,11 +3,13 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/auth"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 )
 
 // CustomerPortalService provides customer portal functionality
@@ -42,6 +44,10 @@ type CustomerPortalService interface {
 	GetUsageSummary(ctx context.Context, req dto.GetCustomerUsageSummaryRequest) (*dto.CustomerUsageSummaryResponse, error)
 	// GetPortalConfig returns the customer portal configuration for the current tenant/environment
 	GetPortalConfig(ctx context.Context) (*dto.SettingResponse, error)
+	// ValidatePromotionCode checks whether the portal customer can redeem a promotion code
+	ValidatePromotionCode(ctx context.Context, req dto.ValidatePromotionCodeRequest) (*dto.ValidatePromotionCodeResponse, error)
+	// RedeemPromotionCode applies a promotion code to a subscription of the portal customer
+	RedeemPromotionCode(ctx context.Context, subscriptionID string, req dto.RedeemPromotionCodeRequest) (*dto.SubscriptionResponse, error)
 }
 
 type customerPortalService struct {
@@ -403,3 +409,65 @@ func (s *customerPortalService) GetWalletTransactions(ctx context.Context, walle
 	// Get the transactions
 	return walletService.GetWalletTransactions(ctx, walletID, filter)
 }
+
+// ValidatePromotionCode checks a promotion code against the portal customer
+func (s *customerPortalService) ValidatePromotionCode(ctx context.Context, req dto.ValidatePromotionCodeRequest) (*dto.ValidatePromotionCodeResponse, error) {
+	customerID := types.GetCustomerID(ctx)
+	if customerID == "" {
+		return nil, ierr.NewError("customer not found in context").Mark(ierr.ErrPermissionDenied)
+	}
+
+	req.CustomerID = customerID
+	return NewPromotionCodeService(s.ServiceParams).ValidatePromotionCode(ctx, req)
+}
+
+// RedeemPromotionCode applies the coupon of a promotion code to a subscription of the portal customer
+func (s *customerPortalService) RedeemPromotionCode(ctx context.Context, subscriptionID string, req dto.RedeemPromotionCodeRequest) (*dto.SubscriptionResponse, error) {
+	customerID := types.GetCustomerID(ctx)
+	if customerID == "" {
+		return nil, ierr.NewError("customer not found in context").Mark(ierr.ErrPermissionDenied)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	sub, err := s.SubRepo.Get(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if sub.CustomerID != customerID {
+		return nil, ierr.NewError("subscription not found").
+			WithHint("Subscription does not belong to this customer").
+			Mark(ierr.ErrNotFound)
+	}
+	if sub.SubscriptionStatus != types.SubscriptionStatusActive && sub.SubscriptionStatus != types.SubscriptionStatusTrialing {
+		return nil, ierr.NewError("subscription is not active").
+			WithHint("Promotion codes can only be redeemed on active subscriptions").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": sub.ID,
+				"status":          sub.SubscriptionStatus,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	err = s.DB.WithTx(ctx, func(txCtx context.Context) error {
+		p, c, err := NewPromotionCodeService(s.ServiceParams).ResolvePromotionCode(txCtx, req.Code, customerID, sub.Currency, sub.ID)
+		if err != nil {
+			return err
+		}
+
+		return NewCouponAssociationService(s.ServiceParams).ApplyCouponsToSubscription(txCtx, sub, []dto.SubscriptionCouponRequest{
+			{
+				CouponID:        c.ID,
+				StartDate:       time.Now().UTC(),
+				PromotionCodeID: lo.ToPtr(p.ID),
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSubscriptionService(s.ServiceParams).GetSubscription(ctx, sub.ID)
+}
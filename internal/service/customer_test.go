@@ -3,6 +3,7 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
@@ -538,3 +539,93 @@ func (s *CustomerServiceSuite) TestGetCustomerByLookupKey() {
 		})
 	}
 }
+
+func (s *CustomerServiceSuite) TestCustomerHierarchy() {
+	ctx := s.GetContext()
+	create := func(id string, parentID *string, invoiceToParent bool) {
+		s.NoError(s.GetStores().CustomerRepo.Create(ctx, &domainCustomer.Customer{
+			ID:               id,
+			ExternalID:       "ext-" + id,
+			Name:             id,
+			ParentCustomerID: parentID,
+			InvoiceToParent:  invoiceToParent,
+			EnvironmentID:    types.GetEnvironmentID(ctx),
+			BaseModel:        types.GetDefaultBaseModel(ctx),
+		}))
+	}
+	create("cust-root", nil, false)
+	create("cust-division", lo.ToPtr("cust-root"), true)
+	create("cust-team", lo.ToPtr("cust-division"), true)
+	create("cust-other-team", lo.ToPtr("cust-division"), false)
+
+	s.Run("team invoices roll up to the root", func() {
+		resp, err := s.service.GetCustomerHierarchy(ctx, "cust-team")
+		s.NoError(err)
+		s.Equal("cust-root", resp.PayingCustomerID)
+		s.Equal([]string{"cust-division", "cust-root"}, lo.Map(resp.Ancestors, func(n *dto.CustomerHierarchyNode, _ int) string { return n.ID }))
+	})
+
+	s.Run("invoices stop at customers paying for themselves", func() {
+		resp, err := s.service.GetCustomerHierarchy(ctx, "cust-other-team")
+		s.NoError(err)
+		s.Equal("cust-other-team", resp.PayingCustomerID)
+	})
+
+	s.Run("descendants are listed breadth first", func() {
+		resp, err := s.service.GetCustomerHierarchy(ctx, "cust-root")
+		s.NoError(err)
+		s.Len(resp.Descendants, 3)
+		s.Equal("cust-division", resp.Descendants[0].ID)
+		s.Equal(1, resp.Descendants[0].Depth)
+		s.Equal(2, resp.Descendants[1].Depth)
+	})
+
+	s.Run("team draws from the wallets of the paying customer", func() {
+		for _, w := range []*wallet.Wallet{
+			{ID: "wallet-root", CustomerID: "cust-root", EnvironmentID: types.GetEnvironmentID(ctx), BaseModel: types.GetDefaultBaseModel(ctx)},
+			{ID: "wallet-other-team", CustomerID: "cust-other-team", EnvironmentID: types.GetEnvironmentID(ctx), BaseModel: types.GetDefaultBaseModel(ctx)},
+		} {
+			s.NoError(s.GetStores().WalletRepo.CreateWallet(ctx, w))
+		}
+		walletSvc := &walletService{ServiceParams: ServiceParams{
+			Logger:       s.GetLogger(),
+			CustomerRepo: s.GetStores().CustomerRepo,
+			WalletRepo:   s.GetStores().WalletRepo,
+		}}
+
+		wallets, err := walletSvc.getCustomerDrawableWallets(ctx, "cust-team")
+		s.NoError(err)
+		s.Equal([]string{"wallet-root"}, lo.Map(wallets, func(w *wallet.Wallet, _ int) string { return w.ID }))
+
+		wallets, err = walletSvc.getCustomerDrawableWallets(ctx, "cust-other-team")
+		s.NoError(err)
+		s.Equal([]string{"wallet-other-team"}, lo.Map(wallets, func(w *wallet.Wallet, _ int) string { return w.ID }))
+	})
+
+	s.Run("customer cannot move under its own descendant", func() {
+		_, err := s.service.UpdateCustomer(ctx, "cust-root", dto.UpdateCustomerRequest{ParentCustomerID: lo.ToPtr("cust-team")})
+		s.Error(err)
+		s.True(ierr.IsValidation(err))
+	})
+
+	s.Run("hierarchy cannot grow deeper than the limit", func() {
+		parentID := "cust-root"
+		for i := 0; i < maxCustomerHierarchyDepth-1; i++ {
+			id := fmt.Sprintf("cust-level-%d", i)
+			create(id, lo.ToPtr(parentID), false)
+			parentID = id
+		}
+
+		// A leaf fits at the bottom of the deepest chain, cust-division and its children do not
+		_, err := s.service.UpdateCustomer(ctx, "cust-division", dto.UpdateCustomerRequest{ParentCustomerID: lo.ToPtr(parentID)})
+		s.True(ierr.IsValidation(err))
+		_, err = s.service.UpdateCustomer(ctx, "cust-other-team", dto.UpdateCustomerRequest{ParentCustomerID: lo.ToPtr(parentID)})
+		s.NoError(err)
+	})
+
+	s.Run("customer with children cannot be deleted", func() {
+		err := s.service.DeleteCustomer(ctx, "cust-division")
+		s.Error(err)
+		s.True(ierr.IsInvalidOperation(err))
+	})
+}
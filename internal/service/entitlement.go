@@ -160,6 +160,7 @@ func (s *entitlementService) CreateEntitlement(ctx context.Context, req dto.Crea
 	if err != nil {
 		return nil, err
 	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeEntitlement, result.ID, types.AuditLogActionCreate, nil, result)
 
 	response := &dto.EntitlementResponse{Entitlement: result}
 
@@ -382,6 +383,7 @@ func (s *entitlementService) CreateBulkEntitlement(ctx context.Context, req dto.
 			}
 
 			response.Items = append(response.Items, entResp)
+			s.recordAuditLog(txCtx, types.AuditLogEntityTypeEntitlement, ent.ID, types.AuditLogActionCreate, nil, ent)
 
 			// Publish webhook event for each created entitlement
 			s.publishSystemEvent(txCtx, types.WebhookEventEntitlementCreated, ent.ID)
@@ -619,6 +621,7 @@ func (s *entitlementService) UpdateEntitlement(ctx context.Context, id string, r
 	if err != nil {
 		return nil, err
 	}
+	before := *existing
 
 	// Update fields if provided
 	if req.IsEnabled != nil {
@@ -652,6 +655,7 @@ func (s *entitlementService) UpdateEntitlement(ctx context.Context, id string, r
 	if err != nil {
 		return nil, err
 	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeEntitlement, id, types.AuditLogActionUpdate, &before, result)
 
 	response := &dto.EntitlementResponse{Entitlement: result}
 
@@ -667,11 +671,16 @@ func (s *entitlementService) UpdateEntitlement(ctx context.Context, id string, r
 }
 
 func (s *entitlementService) DeleteEntitlement(ctx context.Context, id string) error {
+	existing, err := s.EntitlementRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
 
-	err := s.EntitlementRepo.Delete(ctx, id)
+	err = s.EntitlementRepo.Delete(ctx, id)
 	if err != nil {
 		return err
 	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeEntitlement, id, types.AuditLogActionDelete, existing, nil)
 
 	// Publish webhook event after successful deletion
 	s.publishSystemEvent(ctx, types.WebhookEventEntitlementDeleted, id)
@@ -717,6 +726,10 @@ func (s *entitlementService) GetAddonEntitlements(ctx context.Context, addonID s
 }
 
 func (s *entitlementService) publishSystemEvent(ctx context.Context, eventName types.WebhookEventName, entitlementID string) {
+	// Plan and addon entitlements reach every customer subscribed to them
+	NewEntitlementCheckService(s.ServiceParams).InvalidateEnvironment(ctx)
+	NewUsageLimitService(s.ServiceParams).InvalidateEnvironment(ctx)
+
 	webhookPayload, err := json.Marshal(webhookDto.InternalEntitlementEvent{
 		EntitlementID: entitlementID,
 		TenantID:      types.GetTenantID(ctx),
@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/cache"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// EntitlementCheckService serves entitlement checks from a precomputed snapshot per customer.
+// Snapshots are built from the aggregated customer entitlements on first use and dropped when
+// subscriptions, addons, features or entitlements change, so checks skip the aggregation.
+type EntitlementCheckService interface {
+	// CheckEntitlements returns the requested entitlements of a customer and the snapshot version
+	CheckEntitlements(ctx context.Context, req *dto.CheckEntitlementsRequest) (*dto.CheckEntitlementsResponse, error)
+
+	// InvalidateCustomer drops the snapshot of a customer
+	InvalidateCustomer(ctx context.Context, customerID string)
+
+	// InvalidateSubscription drops the snapshot of the customer owning the subscription
+	InvalidateSubscription(ctx context.Context, subscriptionID string)
+
+	// InvalidateEnvironment drops every snapshot of the environment in context. Used for
+	// plan, addon and feature level changes that may affect any customer.
+	InvalidateEnvironment(ctx context.Context)
+}
+
+type entitlementCheckService struct {
+	ServiceParams
+}
+
+func NewEntitlementCheckService(params ServiceParams) EntitlementCheckService {
+	return &entitlementCheckService{
+		ServiceParams: params,
+	}
+}
+
+func (s *entitlementCheckService) CheckEntitlements(ctx context.Context, req *dto.CheckEntitlementsRequest) (*dto.CheckEntitlementsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	customerID := req.CustomerID
+	if customerID == "" {
+		c, err := s.CustomerRepo.GetByLookupKey(ctx, req.ExternalCustomerID)
+		if err != nil {
+			return nil, err
+		}
+		customerID = c.ID
+	}
+
+	snapshot, err := s.getSnapshot(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterEntitlementSnapshot(snapshot, req.FeatureIDs, req.FeatureLookupKeys), nil
+}
+
+func (s *entitlementCheckService) InvalidateCustomer(ctx context.Context, customerID string) {
+	if customerID == "" {
+		return
+	}
+	snapshotCache := s.snapshotCache()
+	snapshotCache.ForceCacheDelete(ctx, s.snapshotKey(ctx, snapshotCache, customerID))
+
+	// Descendants inherit the customer's entitlements, so their snapshots go stale with it
+	descendants, err := newCustomerHierarchy(s.CustomerRepo).GetDescendants(ctx, customerID)
+	if err != nil {
+		s.Logger.WarnwCtx(ctx, "failed to invalidate entitlement snapshots of child customers",
+			"customer_id", customerID,
+			"error", err,
+		)
+		return
+	}
+	for _, descendant := range descendants {
+		snapshotCache.ForceCacheDelete(ctx, s.snapshotKey(ctx, snapshotCache, descendant.ID))
+	}
+}
+
+func (s *entitlementCheckService) InvalidateSubscription(ctx context.Context, subscriptionID string) {
+	sub, err := s.SubRepo.Get(ctx, subscriptionID)
+	if err != nil {
+		s.Logger.WarnwCtx(ctx, "failed to invalidate entitlement snapshot for subscription",
+			"subscription_id", subscriptionID,
+			"error", err,
+		)
+		return
+	}
+	s.InvalidateCustomer(ctx, sub.CustomerID)
+}
+
+func (s *entitlementCheckService) InvalidateEnvironment(ctx context.Context) {
+	// Snapshot keys embed the environment generation, so a new generation orphans them all.
+	// The generation outlives every snapshot built under the previous one before it may lapse.
+	s.snapshotCache().ForceCacheSet(ctx, s.generationKey(ctx), strconv.FormatInt(time.Now().UnixNano(), 10), 2*s.snapshotTTL())
+}
+
+func (s *entitlementCheckService) getSnapshot(ctx context.Context, customerID string) (*dto.EntitlementSnapshot, error) {
+	snapshotCache := s.snapshotCache()
+	key := s.snapshotKey(ctx, snapshotCache, customerID)
+
+	if value, found := snapshotCache.ForceCacheGet(ctx, key); found {
+		var snapshot dto.EntitlementSnapshot
+		if err := json.Unmarshal([]byte(fmt.Sprint(value)), &snapshot); err == nil {
+			return &snapshot, nil
+		}
+	}
+
+	snapshot, err := s.buildSnapshot(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to encode entitlement snapshot").
+			Mark(ierr.ErrInternal)
+	}
+	snapshotCache.ForceCacheSet(ctx, key, string(data), s.snapshotTTL())
+
+	return snapshot, nil
+}
+
+func (s *entitlementCheckService) buildSnapshot(ctx context.Context, customerID string) (*dto.EntitlementSnapshot, error) {
+	billingService := NewBillingService(s.ServiceParams)
+	entitlements, err := billingService.GetCustomerEntitlements(ctx, customerID, &dto.GetCustomerEntitlementsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	features := make([]*dto.EntitlementCheckResult, 0, len(entitlements.Features))
+	for _, f := range entitlements.Features {
+		if f.Feature == nil || f.Feature.Feature == nil || f.Entitlement == nil {
+			continue
+		}
+		features = append(features, &dto.EntitlementCheckResult{
+			FeatureID:        f.Feature.ID,
+			FeatureLookupKey: f.Feature.LookupKey,
+			FeatureType:      f.Feature.Type,
+			IsEnabled:        f.Entitlement.IsEnabled,
+			UsageLimit:       f.Entitlement.UsageLimit,
+			IsSoftLimit:      f.Entitlement.IsSoftLimit,
+			UsageResetPeriod: f.Entitlement.UsageResetPeriod,
+			StaticValues:     f.Entitlement.StaticValues,
+		})
+	}
+
+	version, err := entitlementSnapshotVersion(features)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.EntitlementSnapshot{
+		CustomerID:  customerID,
+		Version:     version,
+		GeneratedAt: time.Now().UTC(),
+		Features:    features,
+	}, nil
+}
+
+// snapshotCache prefers the shared Redis cache so invalidations reach every node
+func (s *entitlementCheckService) snapshotCache() cache.Cache {
+	if s.Config != nil && cache.CacheType(s.Config.Cache.Type) == cache.CacheTypeRedis {
+		if redisCache := cache.GetRedisCache(); redisCache != nil {
+			return redisCache
+		}
+	}
+	return cache.GetInMemoryCache()
+}
+
+func (s *entitlementCheckService) snapshotTTL() time.Duration {
+	if s.Config != nil && s.Config.EntitlementCheck.SnapshotTTL > 0 {
+		return s.Config.EntitlementCheck.SnapshotTTL
+	}
+	return 15 * time.Minute
+}
+
+func (s *entitlementCheckService) generationKey(ctx context.Context) string {
+	return cache.GenerateKey(cache.PrefixEntitlementSnapshotGen, types.GetTenantID(ctx), types.GetEnvironmentID(ctx))
+}
+
+func (s *entitlementCheckService) snapshotKey(ctx context.Context, snapshotCache cache.Cache, customerID string) string {
+	generation := "0"
+	if value, found := snapshotCache.ForceCacheGet(ctx, s.generationKey(ctx)); found {
+		generation = fmt.Sprint(value)
+	}
+	return cache.GenerateKey(cache.PrefixEntitlementSnapshot, types.GetTenantID(ctx), types.GetEnvironmentID(ctx), generation, customerID)
+}
+
+// entitlementSnapshotVersion hashes the snapshot content so that rebuilding an unchanged
+// snapshot keeps its version and clients holding it are not forced to refetch
+func entitlementSnapshotVersion(features []*dto.EntitlementCheckResult) (string, error) {
+	sort.SliceStable(features, func(i, j int) bool {
+		return features[i].FeatureID < features[j].FeatureID
+	})
+	data, err := json.Marshal(features)
+	if err != nil {
+		return "", ierr.WithError(err).
+			WithHint("Failed to compute entitlement snapshot version").
+			Mark(ierr.ErrInternal)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:16]), nil
+}
+
+// filterEntitlementSnapshot selects the requested features from the snapshot. Requested
+// features the customer has no entitlement to are returned as disabled.
+func filterEntitlementSnapshot(snapshot *dto.EntitlementSnapshot, featureIDs, featureLookupKeys []string) *dto.CheckEntitlementsResponse {
+	resp := &dto.CheckEntitlementsResponse{
+		CustomerID:   snapshot.CustomerID,
+		Version:      snapshot.Version,
+		GeneratedAt:  snapshot.GeneratedAt,
+		Entitlements: snapshot.Features,
+	}
+	if len(featureIDs) == 0 && len(featureLookupKeys) == 0 {
+		return resp
+	}
+
+	byID := lo.KeyBy(snapshot.Features, func(f *dto.EntitlementCheckResult) string { return f.FeatureID })
+	byLookupKey := lo.KeyBy(snapshot.Features, func(f *dto.EntitlementCheckResult) string { return f.FeatureLookupKey })
+
+	resp.Entitlements = make([]*dto.EntitlementCheckResult, 0, len(featureIDs)+len(featureLookupKeys))
+	for _, id := range lo.Uniq(featureIDs) {
+		if f, ok := byID[id]; ok {
+			resp.Entitlements = append(resp.Entitlements, f)
+			continue
+		}
+		resp.Entitlements = append(resp.Entitlements, &dto.EntitlementCheckResult{FeatureID: id})
+	}
+	for _, key := range lo.Uniq(featureLookupKeys) {
+		if f, ok := byLookupKey[key]; ok {
+			resp.Entitlements = append(resp.Entitlements, f)
+			continue
+		}
+		resp.Entitlements = append(resp.Entitlements, &dto.EntitlementCheckResult{FeatureLookupKey: key})
+	}
+	return resp
+}
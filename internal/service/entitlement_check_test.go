@@ -0,0 +1,59 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntitlementSnapshotVersion(t *testing.T) {
+	features := func() []*dto.EntitlementCheckResult {
+		return []*dto.EntitlementCheckResult{
+			{FeatureID: "feat_b", FeatureLookupKey: "seats", IsEnabled: true, UsageLimit: lo.ToPtr(int64(5))},
+			{FeatureID: "feat_a", FeatureLookupKey: "sso", IsEnabled: true},
+		}
+	}
+
+	v1, err := entitlementSnapshotVersion(features())
+	require.NoError(t, err)
+
+	reordered := features()
+	reordered[0], reordered[1] = reordered[1], reordered[0]
+	v2, err := entitlementSnapshotVersion(reordered)
+	require.NoError(t, err)
+	assert.Equal(t, v1, v2, "version must not depend on feature order")
+
+	changed := features()
+	changed[0].UsageLimit = lo.ToPtr(int64(10))
+	v3, err := entitlementSnapshotVersion(changed)
+	require.NoError(t, err)
+	assert.NotEqual(t, v1, v3, "version must change with entitlement values")
+}
+
+func TestFilterEntitlementSnapshot(t *testing.T) {
+	snapshot := &dto.EntitlementSnapshot{
+		CustomerID: "cust_1",
+		Version:    "v1",
+		Features: []*dto.EntitlementCheckResult{
+			{FeatureID: "feat_a", FeatureLookupKey: "sso", IsEnabled: true},
+			{FeatureID: "feat_b", FeatureLookupKey: "seats", IsEnabled: true},
+		},
+	}
+
+	t.Run("no filter returns the whole snapshot", func(t *testing.T) {
+		resp := filterEntitlementSnapshot(snapshot, nil, nil)
+		assert.Len(t, resp.Entitlements, 2)
+		assert.Equal(t, "v1", resp.Version)
+	})
+
+	t.Run("unknown features are returned disabled", func(t *testing.T) {
+		resp := filterEntitlementSnapshot(snapshot, []string{"feat_a"}, []string{"audit_logs"})
+		require.Len(t, resp.Entitlements, 2)
+		assert.True(t, resp.Entitlements[0].IsEnabled)
+		assert.Equal(t, "audit_logs", resp.Entitlements[1].FeatureLookupKey)
+		assert.False(t, resp.Entitlements[1].IsEnabled)
+	})
+}
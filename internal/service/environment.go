@@ -81,6 +81,10 @@ func (s *environmentService) CreateEnvironment(ctx context.Context, req dto.Crea
 		return nil, err
 	}
 
+	// Changes to an environment are recorded in the environment itself
+	s.recordAuditLog(context.WithValue(ctx, types.CtxEnvironmentID, env.ID),
+		types.AuditLogEntityTypeEnvironment, env.ID, types.AuditLogActionCreate, nil, env)
+
 	return dto.NewEnvironmentResponse(env), nil
 }
 
@@ -169,6 +173,7 @@ func (s *environmentService) UpdateEnvironment(ctx context.Context, id string, r
 	if err != nil {
 		return nil, err
 	}
+	before := *env
 
 	if req.Name != "" {
 		env.Name = req.Name
@@ -182,5 +187,8 @@ func (s *environmentService) UpdateEnvironment(ctx context.Context, id string, r
 		return nil, err
 	}
 
+	s.recordAuditLog(context.WithValue(ctx, types.CtxEnvironmentID, env.ID),
+		types.AuditLogEntityTypeEnvironment, env.ID, types.AuditLogActionUpdate, &before, env)
+
 	return dto.NewEnvironmentResponse(env), nil
 }
@@ -68,6 +68,27 @@ func (s *eventService) CreateEvent(ctx context.Context, createEventRequest *dto.
 	}
 
 	event := createEventRequest.ToEvent(ctx)
+	createEventRequest.EventID = event.ID
+
+	// Over-limit customers are flagged by usage limit enforcement; drop or tag their events
+	ingestionMode := types.UsageLimitIngestionMode(s.config.UsageLimit.IngestionMode)
+	if s.config.UsageLimit.Enabled && (ingestionMode == types.UsageLimitIngestionModeDrop || ingestionMode == types.UsageLimitIngestionModeTag) &&
+		usageLimitExceededForEvent(ctx, event, s.meterRepo) {
+		switch ingestionMode {
+		case types.UsageLimitIngestionModeDrop:
+			s.logger.WarnwCtx(ctx, "dropping event of customer over usage limit",
+				"event_id", event.ID,
+				"event_name", event.EventName,
+				"external_customer_id", event.ExternalCustomerID,
+			)
+			return nil
+		case types.UsageLimitIngestionModeTag:
+			if event.Properties == nil {
+				event.Properties = make(map[string]interface{})
+			}
+			event.Properties[types.UsageLimitExceededProperty] = true
+		}
+	}
 
 	if err := s.publisher.Publish(ctx, event); err != nil {
 		// Log the error but don't fail the request
@@ -77,7 +98,6 @@ func (s *eventService) CreateEvent(ctx context.Context, createEventRequest *dto.
 		).Error("failed to publish event")
 	}
 
-	createEventRequest.EventID = event.ID
 	return nil
 }
 
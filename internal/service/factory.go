@@ -5,7 +5,9 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/addon"
 	"github.com/flexprice/flexprice/internal/domain/addonassociation"
 	"github.com/flexprice/flexprice/internal/domain/alertlogs"
+	"github.com/flexprice/flexprice/internal/domain/auditlog"
 	"github.com/flexprice/flexprice/internal/domain/auth"
+	"github.com/flexprice/flexprice/internal/domain/commitmentcontract"
 	"github.com/flexprice/flexprice/internal/domain/connection"
 	costsheet "github.com/flexprice/flexprice/internal/domain/costsheet"
 	"github.com/flexprice/flexprice/internal/domain/coupon"
@@ -20,18 +22,25 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/environment"
 	"github.com/flexprice/flexprice/internal/domain/events"
 	"github.com/flexprice/flexprice/internal/domain/feature"
+	"github.com/flexprice/flexprice/internal/domain/fxrate"
 	"github.com/flexprice/flexprice/internal/domain/group"
+	"github.com/flexprice/flexprice/internal/domain/invitation"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/membership"
 	"github.com/flexprice/flexprice/internal/domain/meter"
 	"github.com/flexprice/flexprice/internal/domain/payment"
 	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/domain/planpricesync"
 	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/pricebook"
 	"github.com/flexprice/flexprice/internal/domain/priceunit"
+	"github.com/flexprice/flexprice/internal/domain/promotion_code"
 	"github.com/flexprice/flexprice/internal/domain/proration"
+	"github.com/flexprice/flexprice/internal/domain/rbacrole"
 	"github.com/flexprice/flexprice/internal/domain/scheduledtask"
 	"github.com/flexprice/flexprice/internal/domain/secret"
 	"github.com/flexprice/flexprice/internal/domain/settings"
+	"github.com/flexprice/flexprice/internal/domain/sso"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
 	"github.com/flexprice/flexprice/internal/domain/task"
 	taxrate "github.com/flexprice/flexprice/internal/domain/tax"
@@ -40,6 +49,8 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/tenant"
 	"github.com/flexprice/flexprice/internal/domain/user"
 	"github.com/flexprice/flexprice/internal/domain/wallet"
+	"github.com/flexprice/flexprice/internal/domain/webhookdeliveryattempt"
+	"github.com/flexprice/flexprice/internal/domain/webhookendpoint"
 	"github.com/flexprice/flexprice/internal/domain/workflowexecution"
 	"github.com/flexprice/flexprice/internal/httpclient"
 	"github.com/flexprice/flexprice/internal/integration"
@@ -71,6 +82,7 @@ type ServiceParams struct {
 	FeatureUsageRepo             events.FeatureUsageRepository
 	RawEventRepo                 events.RawEventRepository
 	MeterUsageRepo               events.MeterUsageRepository
+	UsageRollupRepo              events.UsageRollupRepository
 	MeterRepo                    meter.Repository
 	PriceRepo                    price.Repository
 	PriceUnitRepo                priceunit.Repository
@@ -101,6 +113,18 @@ type ServiceParams struct {
 	CouponRepo                   coupon.Repository
 	CouponAssociationRepo        coupon_association.Repository
 	CouponApplicationRepo        coupon_application.Repository
+	PromotionCodeRepo            promotion_code.Repository
+	FXRateRepo                   fxrate.Repository
+	PriceBookRepo                pricebook.Repository
+	CommitmentContractRepo       commitmentcontract.Repository
+	SubscriptionSeatChangeRepo   subscription.SubscriptionSeatChangeRepository
+	WebhookEndpointRepo          webhookendpoint.Repository
+	WebhookDeliveryAttemptRepo   webhookdeliveryattempt.Repository
+	RBACRoleRepo                 rbacrole.Repository
+	AuditLogRepo                 auditlog.Repository
+	SSOConnectionRepo            sso.Repository
+	InvitationRepo               invitation.Repository
+	MembershipRepo               membership.Repository
 	AddonRepo                    addon.Repository
 	AddonAssociationRepo         addonassociation.Repository
 	ConnectionRepo               connection.Repository
@@ -129,6 +153,7 @@ type ServiceParams struct {
 	WalletBalanceAlertPubSub types.WalletBalanceAlertPubSub
 	UsageBenchmarkPubSub     types.UsageBenchmarkPubSub
 	WebhookPubSub            pubsub.PubSub
+	UsageStreamPubSub        types.UsageStreamPubSub
 }
 
 // Common service params
@@ -145,6 +170,7 @@ func NewServiceParams(
 	featureUsageRepo events.FeatureUsageRepository,
 	rawEventRepo events.RawEventRepository,
 	meterUsageRepo events.MeterUsageRepository,
+	usageRollupRepo events.UsageRollupRepository,
 	meterRepo meter.Repository,
 	priceRepo price.Repository,
 	priceUnitRepo priceunit.Repository,
@@ -175,6 +201,7 @@ func NewServiceParams(
 	couponRepo coupon.Repository,
 	couponAssociationRepo coupon_association.Repository,
 	couponApplicationRepo coupon_application.Repository,
+	promotionCodeRepo promotion_code.Repository,
 	eventPublisher publisher.EventPublisher,
 	webhookPublisher webhookPublisher.WebhookPublisher,
 	s3Service s3.Service,
@@ -194,6 +221,18 @@ func NewServiceParams(
 	webhookPubSub pubsub.PubSub,
 	planPriceSyncRepo planpricesync.Repository,
 	workflowExecutionRepo workflowexecution.Repository,
+	usageStreamPubSub types.UsageStreamPubSub,
+	fxRateRepo fxrate.Repository,
+	priceBookRepo pricebook.Repository,
+	commitmentContractRepo commitmentcontract.Repository,
+	subscriptionSeatChangeRepo subscription.SubscriptionSeatChangeRepository,
+	webhookEndpointRepo webhookendpoint.Repository,
+	webhookDeliveryAttemptRepo webhookdeliveryattempt.Repository,
+	rbacRoleRepo rbacrole.Repository,
+	auditLogRepo auditlog.Repository,
+	ssoConnectionRepo sso.Repository,
+	invitationRepo invitation.Repository,
+	membershipRepo membership.Repository,
 ) ServiceParams {
 	return ServiceParams{
 		Logger:                       logger,
@@ -208,6 +247,7 @@ func NewServiceParams(
 		FeatureUsageRepo:             featureUsageRepo,
 		RawEventRepo:                 rawEventRepo,
 		MeterUsageRepo:               meterUsageRepo,
+		UsageRollupRepo:              usageRollupRepo,
 		MeterRepo:                    meterRepo,
 		PriceRepo:                    priceRepo,
 		PriceUnitRepo:                priceUnitRepo,
@@ -242,6 +282,7 @@ func NewServiceParams(
 		CouponRepo:                   couponRepo,
 		CouponAssociationRepo:        couponAssociationRepo,
 		CouponApplicationRepo:        couponApplicationRepo,
+		PromotionCodeRepo:            promotionCodeRepo,
 		AddonRepo:                    addonRepo,
 		AddonAssociationRepo:         addonAssociationRepo,
 		ConnectionRepo:               connectionRepo,
@@ -257,5 +298,17 @@ func NewServiceParams(
 		WebhookPubSub:                webhookPubSub,
 		PlanPriceSyncRepo:            planPriceSyncRepo,
 		WorkflowExecutionRepo:        workflowExecutionRepo,
+		UsageStreamPubSub:            usageStreamPubSub,
+		FXRateRepo:                   fxRateRepo,
+		PriceBookRepo:                priceBookRepo,
+		CommitmentContractRepo:       commitmentContractRepo,
+		SubscriptionSeatChangeRepo:   subscriptionSeatChangeRepo,
+		WebhookEndpointRepo:          webhookEndpointRepo,
+		WebhookDeliveryAttemptRepo:   webhookDeliveryAttemptRepo,
+		RBACRoleRepo:                 rbacRoleRepo,
+		AuditLogRepo:                 auditLogRepo,
+		SSOConnectionRepo:            ssoConnectionRepo,
+		InvitationRepo:               invitationRepo,
+		MembershipRepo:               membershipRepo,
 	}
 }
@@ -35,7 +35,7 @@ func NewFeatureService(params ServiceParams) FeatureService {
 }
 
 func (s *featureService) CreateFeature(ctx context.Context, req dto.CreateFeatureRequest) (*dto.FeatureResponse, error) {
-	meterService := NewMeterService(s.MeterRepo)
+	meterService := NewMeterService(s.MeterRepo, s.AuditLogRepo, s.Logger)
 	err := req.Validate()
 	if err != nil {
 		return nil, err // Validation errors are already properly formatted in the DTO
@@ -92,6 +92,7 @@ func (s *featureService) CreateFeature(ctx context.Context, req dto.CreateFeatur
 			return err
 		}
 
+		s.recordAuditLog(ctx, types.AuditLogEntityTypeFeature, featureModel.ID, types.AuditLogActionCreate, nil, featureModel)
 		return nil
 	})
 
@@ -272,6 +273,8 @@ func (s *featureService) UpdateFeature(ctx context.Context, id string, req dto.U
 	if err != nil {
 		return nil, err
 	}
+	before := *feature
+
 	if req.Description != nil {
 		feature.Description = *req.Description
 	}
@@ -346,7 +349,7 @@ func (s *featureService) UpdateFeature(ctx context.Context, id string, req dto.U
 
 	if feature.Type == types.FeatureTypeMetered && feature.MeterID != "" {
 		// update meter filters if provided
-		meterService := NewMeterService(s.MeterRepo)
+		meterService := NewMeterService(s.MeterRepo, s.AuditLogRepo, s.Logger)
 		if req.Filters != nil {
 			if _, err := meterService.UpdateMeter(ctx, feature.MeterID, *req.Filters); err != nil {
 				return nil, err
@@ -365,6 +368,8 @@ func (s *featureService) UpdateFeature(ctx context.Context, id string, req dto.U
 		return nil, err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeFeature, feature.ID, types.AuditLogActionUpdate, &before, feature)
+
 	// Publish webhook event
 	s.publishSystemEvent(ctx, types.WebhookEventFeatureUpdated, feature.ID)
 
@@ -419,6 +424,8 @@ func (s *featureService) DeleteFeature(ctx context.Context, id string) error {
 		return err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeFeature, id, types.AuditLogActionDelete, feature, nil)
+
 	// Publish webhook event
 	s.publishSystemEvent(ctx, types.WebhookEventFeatureDeleted, id)
 
@@ -426,6 +433,8 @@ func (s *featureService) DeleteFeature(ctx context.Context, id string) error {
 }
 
 func (s *featureService) publishSystemEvent(ctx context.Context, eventName types.WebhookEventName, featureID string) {
+	NewEntitlementCheckService(s.ServiceParams).InvalidateEnvironment(ctx)
+
 	webhookPayload, err := json.Marshal(webhookDto.InternalFeatureEvent{
 		FeatureID: featureID,
 		TenantID:  types.GetTenantID(ctx),
@@ -426,6 +426,22 @@ func (s *featureUsageTrackingService) processEvent(ctx context.Context, event *e
 			return err
 		}
 
+		// Push usage deltas to real-time stream subscribers, best effort
+		if err := newUsageStreamPublisher(s.ServiceParams).PublishFeatureUsage(ctx, featureUsage); err != nil {
+			s.Logger.ErrorwCtx(ctx, "failed to publish usage stream events",
+				"error", err,
+				"event_id", event.ID,
+			)
+		}
+
+		// Update usage limit counters; failures are logged per customer and feature
+		if err := NewUsageLimitService(s.ServiceParams).RecordUsage(ctx, featureUsage); err != nil {
+			s.Logger.ErrorwCtx(ctx, "failed to record usage limits",
+				"error", err,
+				"event_id", event.ID,
+			)
+		}
+
 		// Only publish wallet balance alerts if enabled in configuration
 		if s.Config.FeatureUsageTracking.WalletAlertPushEnabled {
 			walletBalanceAlertService := NewWalletBalanceAlertService(s.ServiceParams)
@@ -1003,26 +1019,7 @@ func (s *featureUsageTrackingService) handleMissingCustomer(
 
 // Check if an event matches the meter filters
 func (s *featureUsageTrackingService) checkMeterFilters(event *events.Event, filters []meter.Filter) bool {
-	if len(filters) == 0 {
-		return true // No filters means everything matches
-	}
-
-	for _, filter := range filters {
-		propertyValue, exists := event.Properties[filter.Key]
-		if !exists {
-			return false
-		}
-
-		// Convert property value to string for comparison
-		propStr := fmt.Sprintf("%v", propertyValue)
-
-		// Check if the value is in the filter values
-		if !lo.Contains(filter.Values, propStr) {
-			return false
-		}
-	}
-
-	return true
+	return meter.MatchesFilters(filters, event.Properties)
 }
 
 // Extract quantity from event based on meter aggregation
@@ -1809,6 +1806,14 @@ func (s *featureUsageTrackingService) fetchAnalytics(ctx context.Context, params
 		return nil, err
 	}
 
+	// Bucketed features are aggregated per meter bucket, which rollups cannot reproduce
+	if len(maxBucketFeatures) == 0 && len(sumBucketFeatures) == 0 && usageAnalyticsRollupCompatible(params) {
+		coverage := s.usageRollupCoverage(ctx, types.UsageRollupSourceFeatureUsage, params.TenantID, params.EnvironmentID)
+		if segments := planUsageAnalyticsQuery(params, coverage); len(segments) > 1 || segments[0].Granularity != "" {
+			return s.fetchAnalyticsSegments(ctx, params, segments)
+		}
+	}
+
 	// Fetch analytics with bucket features
 	analytics, err := s.featureUsageRepo.GetDetailedUsageAnalytics(ctx, params, maxBucketFeatures, sumBucketFeatures)
 	if err != nil {
@@ -1821,6 +1826,35 @@ func (s *featureUsageTrackingService) fetchAnalytics(ctx context.Context, params
 	return analytics, nil
 }
 
+// fetchAnalyticsSegments serves analytics from the feature usage rollups where they cover the
+// range and from feature_usage for the rest
+func (s *featureUsageTrackingService) fetchAnalyticsSegments(ctx context.Context, params *events.UsageAnalyticsParams, segments []usageQuerySegment) ([]*events.DetailedUsageAnalytic, error) {
+	var results []*events.DetailedUsageAnalytic
+	for _, segment := range segments {
+		segmentParams := *params
+		segmentParams.StartTime = segment.StartTime
+		segmentParams.EndTime = segment.EndTime
+
+		var segmentResults []*events.DetailedUsageAnalytic
+		var err error
+		if segment.Granularity == "" {
+			segmentResults, err = s.featureUsageRepo.GetDetailedUsageAnalytics(ctx, &segmentParams, nil, nil)
+		} else {
+			segmentResults, err = s.UsageRollupRepo.GetDetailedUsageAnalytics(ctx, &segmentParams, segment.Granularity)
+		}
+		if err != nil {
+			s.Logger.ErrorwCtx(ctx, "failed to get detailed usage analytics",
+				"error", err,
+				"external_customer_id", params.ExternalCustomerID,
+				"granularity", segment.Granularity,
+			)
+			return nil, err
+		}
+		results = append(results, segmentResults...)
+	}
+	return mergeUsageAnalytics(results), nil
+}
+
 // createAnalyticsParams creates analytics parameters from request
 func (s *featureUsageTrackingService) createAnalyticsParams(ctx context.Context, req *dto.GetUsageAnalyticsRequest) *events.UsageAnalyticsParams {
 	return &events.UsageAnalyticsParams{
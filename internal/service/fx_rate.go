@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/fxrate"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// FXRateService manages FX rate tables and converts amounts between currencies
+type FXRateService interface {
+	CreateFXRate(ctx context.Context, req dto.CreateFXRateRequest) (*dto.FXRateResponse, error)
+	GetFXRate(ctx context.Context, id string) (*dto.FXRateResponse, error)
+	DeleteFXRate(ctx context.Context, id string) error
+	ListFXRates(ctx context.Context, filter *types.FXRateFilter) (*dto.ListFXRatesResponse, error)
+
+	// SyncFXRates loads rates from the configured rate source
+	SyncFXRates(ctx context.Context) (*dto.SyncFXRatesResponse, error)
+
+	// GetConversion returns the conversion from one currency to another effective at the given
+	// time. A rate for the inverse pair is used when the direct pair has none.
+	GetConversion(ctx context.Context, fromCurrency, toCurrency string, at time.Time) (*types.FXConversion, error)
+
+	// GetFXGainLoss reports converted wallet debits in a period and their gain or loss
+	// against the rate effective at the end of it
+	GetFXGainLoss(ctx context.Context, req dto.FXGainLossRequest) (*dto.FXGainLossResponse, error)
+}
+
+type fxRateService struct {
+	ServiceParams
+}
+
+// NewFXRateService creates a new FX rate service
+func NewFXRateService(params ServiceParams) FXRateService {
+	return &fxRateService{
+		ServiceParams: params,
+	}
+}
+
+func (s *fxRateService) CreateFXRate(ctx context.Context, req dto.CreateFXRateRequest) (*dto.FXRateResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	rate := req.ToFXRate(ctx)
+	if err := s.FXRateRepo.Create(ctx, rate); err != nil {
+		return nil, err
+	}
+
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeFXRate, rate.ID, types.AuditLogActionCreate, nil, rate)
+
+	return &dto.FXRateResponse{FXRate: rate}, nil
+}
+
+func (s *fxRateService) GetFXRate(ctx context.Context, id string) (*dto.FXRateResponse, error) {
+	rate, err := s.FXRateRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.FXRateResponse{FXRate: rate}, nil
+}
+
+func (s *fxRateService) DeleteFXRate(ctx context.Context, id string) error {
+	rate, err := s.FXRateRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.FXRateRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeFXRate, id, types.AuditLogActionDelete, rate, nil)
+	return nil
+}
+
+func (s *fxRateService) ListFXRates(ctx context.Context, filter *types.FXRateFilter) (*dto.ListFXRatesResponse, error) {
+	if filter == nil {
+		filter = types.NewFXRateFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewDefaultQueryFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	rates, err := s.FXRateRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.FXRateRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := lo.Map(rates, func(r *fxrate.FXRate, _ int) *dto.FXRateResponse {
+		return &dto.FXRateResponse{FXRate: r}
+	})
+
+	listResponse := types.NewListResponse(responses, total, filter.GetLimit(), filter.GetOffset())
+	return &listResponse, nil
+}
+
+func (s *fxRateService) SyncFXRates(ctx context.Context) (*dto.SyncFXRatesResponse, error) {
+	source, err := s.rateSource()
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := source.FetchRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.SyncFXRatesResponse{Source: source.Source()}
+	for _, r := range rates {
+		if err := types.ValidateFXRate(r.Base, r.Quote, r.Rate); err != nil {
+			s.Logger.Warnw("skipping invalid fx rate from source",
+				"source", source.Source(),
+				"base_currency", r.Base,
+				"quote_currency", r.Quote,
+				"error", err)
+			resp.Skipped++
+			continue
+		}
+
+		effectiveAt := r.EffectiveAt
+		if effectiveAt.IsZero() {
+			effectiveAt = time.Now().UTC()
+		}
+
+		rate := &fxrate.FXRate{
+			ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_FX_RATE),
+			BaseCurrency:  strings.ToLower(r.Base),
+			QuoteCurrency: strings.ToLower(r.Quote),
+			Rate:          r.Rate,
+			EffectiveAt:   effectiveAt.UTC(),
+			Source:        source.Source(),
+			EnvironmentID: types.GetEnvironmentID(ctx),
+			BaseModel:     types.GetDefaultBaseModel(ctx),
+		}
+		if err := s.FXRateRepo.Create(ctx, rate); err != nil {
+			if ierr.IsAlreadyExists(err) {
+				resp.Skipped++
+				continue
+			}
+			return nil, err
+		}
+		resp.Created++
+	}
+
+	return resp, nil
+}
+
+// rateSource returns the configured external rate source
+func (s *fxRateService) rateSource() (fxrate.RateSource, error) {
+	switch types.FXRateSource(s.Config.FX.Source) {
+	case types.FXRateSourceFile:
+		return fxrate.NewFileRateSource(s.Config.FX.RatesFile), nil
+	default:
+		return nil, ierr.NewError("no fx rate source is configured").
+			WithHint("FX rates are managed manually. Set fx.source to sync rates from a source").
+			WithReportableDetails(map[string]interface{}{
+				"source": s.Config.FX.Source,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+}
+
+func (s *fxRateService) GetConversion(ctx context.Context, fromCurrency, toCurrency string, at time.Time) (*types.FXConversion, error) {
+	if types.IsMatchingCurrency(fromCurrency, toCurrency) {
+		return &types.FXConversion{
+			FromCurrency: strings.ToLower(fromCurrency),
+			ToCurrency:   strings.ToLower(toCurrency),
+			Rate:         decimal.NewFromInt(1),
+			EffectiveAt:  at,
+		}, nil
+	}
+
+	rate, err := s.FXRateRepo.GetLatest(ctx, fromCurrency, toCurrency, at)
+	if err == nil {
+		return rate.ToConversion(), nil
+	}
+	if !ierr.IsNotFound(err) {
+		return nil, err
+	}
+
+	inverse, err := s.FXRateRepo.GetLatest(ctx, toCurrency, fromCurrency, at)
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("No FX rate between %s and %s is available", fromCurrency, toCurrency).
+				WithReportableDetails(map[string]interface{}{
+					"from_currency": fromCurrency,
+					"to_currency":   toCurrency,
+					"at":            at,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return inverse.ToInverseConversion(), nil
+}
+
+func (s *fxRateService) GetFXGainLoss(ctx context.Context, req dto.FXGainLossRequest) (*dto.FXGainLossResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	filter := types.NewNoLimitWalletTransactionFilter()
+	filter.Type = lo.ToPtr(types.TransactionTypeDebit)
+	filter.FXConverted = lo.ToPtr(true)
+	filter.TimeRangeFilter = &types.TimeRangeFilter{
+		StartTime: &req.StartTime,
+		EndTime:   &req.EndTime,
+	}
+
+	txs, err := s.WalletRepo.ListAllWalletTransactions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	type pair struct{ wallet, settlement string }
+	entries := make(map[pair]*dto.FXGainLossEntry)
+	pairs := make([]pair, 0)
+	for _, tx := range txs {
+		if tx.SettlementCurrency == nil || tx.SettlementAmount == nil {
+			continue
+		}
+		if req.WalletCurrency != "" && !types.IsMatchingCurrency(tx.Currency, req.WalletCurrency) {
+			continue
+		}
+
+		key := pair{wallet: strings.ToLower(tx.Currency), settlement: strings.ToLower(*tx.SettlementCurrency)}
+		entry, ok := entries[key]
+		if !ok {
+			entry = &dto.FXGainLossEntry{
+				WalletCurrency:     key.wallet,
+				SettlementCurrency: key.settlement,
+				WalletAmount:       decimal.Zero,
+				SettledAmount:      decimal.Zero,
+			}
+			entries[key] = entry
+			pairs = append(pairs, key)
+		}
+		entry.TransactionCount++
+		entry.WalletAmount = entry.WalletAmount.Add(tx.Amount)
+		entry.SettledAmount = entry.SettledAmount.Add(*tx.SettlementAmount)
+	}
+
+	resp := &dto.FXGainLossResponse{
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		Items:     make([]*dto.FXGainLossEntry, 0, len(pairs)),
+	}
+	for _, key := range pairs {
+		entry := entries[key]
+		if !entry.WalletAmount.IsZero() {
+			entry.AverageRate = entry.SettledAmount.DivRound(entry.WalletAmount, 12)
+		}
+
+		conversion, err := s.GetConversion(ctx, key.wallet, key.settlement, req.EndTime)
+		if err != nil {
+			if !ierr.IsNotFound(err) {
+				return nil, err
+			}
+			s.Logger.Warnw("no fx rate to revalue converted wallet debits",
+				"wallet_currency", key.wallet,
+				"settlement_currency", key.settlement,
+				"at", req.EndTime)
+		} else {
+			revalued := conversion.Convert(entry.WalletAmount)
+			entry.RevaluationRate = lo.ToPtr(conversion.Rate)
+			entry.RevaluedAmount = lo.ToPtr(revalued)
+			entry.GainLoss = lo.ToPtr(revalued.Sub(entry.SettledAmount))
+		}
+
+		resp.Items = append(resp.Items, entry)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/fxrate"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/wallet"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type FXRateServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	params  ServiceParams
+	service FXRateService
+	ctx     context.Context
+}
+
+func TestFXRateService(t *testing.T) {
+	suite.Run(t, new(FXRateServiceSuite))
+}
+
+func (s *FXRateServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.ctx = types.SetEnvironmentID(s.GetContext(), "env_test")
+
+	stores := s.GetStores()
+	s.params = ServiceParams{
+		Logger:                   s.GetLogger(),
+		Config:                   s.GetConfig(),
+		DB:                       s.GetDB(),
+		CustomerRepo:             stores.CustomerRepo,
+		WalletRepo:               stores.WalletRepo,
+		InvoiceRepo:              stores.InvoiceRepo,
+		InvoiceLineItemRepo:      stores.InvoiceLineItemRepo,
+		SettingsRepo:             stores.SettingsRepo,
+		AlertLogsRepo:            stores.AlertLogsRepo,
+		SubRepo:                  stores.SubscriptionRepo,
+		SubscriptionLineItemRepo: stores.SubscriptionLineItemRepo,
+		MeterRepo:                stores.MeterRepo,
+		PriceRepo:                stores.PriceRepo,
+		FeatureRepo:              stores.FeatureRepo,
+		FeatureUsageRepo:         stores.FeatureUsageRepo,
+		FXRateRepo:               stores.FXRateRepo,
+		EventPublisher:           s.GetPublisher(),
+		WebhookPublisher:         s.GetWebhookPublisher(),
+		WalletBalanceAlertPubSub: types.WalletBalanceAlertPubSub{PubSub: testutil.NewInMemoryPubSub()},
+	}
+	s.service = NewFXRateService(s.params)
+
+	s.NoError(stores.CustomerRepo.Create(s.ctx, &customer.Customer{
+		ID:         "cust_fx",
+		ExternalID: "ext_cust_fx",
+		Name:       "FX Customer",
+		BaseModel:  types.GetDefaultBaseModel(s.ctx),
+	}))
+}
+
+func (s *FXRateServiceSuite) createRate(base, quote string, rate float64, effectiveAt time.Time) {
+	s.NoError(s.GetStores().FXRateRepo.Create(s.ctx, &fxrate.FXRate{
+		ID:            s.GetUUID(),
+		BaseCurrency:  base,
+		QuoteCurrency: quote,
+		Rate:          decimal.NewFromFloat(rate),
+		EffectiveAt:   effectiveAt,
+		Source:        types.FXRateSourceManual,
+		BaseModel:     types.GetDefaultBaseModel(s.ctx),
+	}))
+}
+
+func (s *FXRateServiceSuite) TestGetConversion() {
+	now := time.Now().UTC()
+	s.createRate("usd", "eur", 0.90, now.Add(-48*time.Hour))
+	s.createRate("usd", "eur", 0.80, now.Add(-time.Hour))
+	s.createRate("usd", "eur", 0.70, now.Add(time.Hour))
+
+	conversion, err := s.service.GetConversion(s.ctx, "USD", "EUR", now)
+	s.NoError(err)
+	s.True(conversion.Rate.Equal(decimal.NewFromFloat(0.80)))
+	s.True(conversion.Convert(decimal.NewFromInt(10)).Equal(decimal.NewFromInt(8)))
+
+	// The inverse pair falls back to 1/rate
+	inverse, err := s.service.GetConversion(s.ctx, "eur", "usd", now)
+	s.NoError(err)
+	s.True(inverse.Convert(decimal.NewFromInt(8)).Equal(decimal.NewFromInt(10)))
+
+	_, err = s.service.GetConversion(s.ctx, "usd", "jpy", now)
+	s.Error(err)
+}
+
+func (s *FXRateServiceSuite) TestCreditAdjustmentWithFXWallet() {
+	start := time.Now().UTC().Add(-time.Minute)
+	s.createRate("eur", "usd", 1.10, start.Add(-time.Hour))
+
+	w := &wallet.Wallet{
+		ID:             "wallet_eur",
+		CustomerID:     "cust_fx",
+		Currency:       "eur",
+		WalletStatus:   types.WalletStatusActive,
+		Name:           "EUR wallet",
+		ConversionRate: decimal.NewFromInt(1),
+		WalletType:     types.WalletTypePrePaid,
+		Config:         types.WalletConfig{FXConversionEnabled: true},
+		EnvironmentID:  "env_test",
+		BaseModel:      types.GetDefaultBaseModel(s.ctx),
+	}
+	s.NoError(s.GetStores().WalletRepo.CreateWallet(s.ctx, w))
+	s.NoError(NewWalletService(s.params).CreditWallet(s.ctx, &wallet.WalletOperation{
+		WalletID:          w.ID,
+		Type:              types.TransactionTypeCredit,
+		CreditAmount:      decimal.NewFromInt(100),
+		TransactionReason: types.TransactionReasonFreeCredit,
+	}))
+
+	inv := &invoice.Invoice{
+		ID:            "inv_fx",
+		CustomerID:    "cust_fx",
+		Currency:      "usd",
+		InvoiceType:   types.InvoiceTypeOneOff,
+		InvoiceStatus: types.InvoiceStatusDraft,
+		LineItems: []*invoice.InvoiceLineItem{{
+			ID:         "li_fx",
+			InvoiceID:  "inv_fx",
+			CustomerID: "cust_fx",
+			Amount:     decimal.NewFromInt(55),
+			Currency:   "usd",
+			Quantity:   decimal.NewFromInt(1),
+			PriceType:  lo.ToPtr(string(types.PRICE_TYPE_USAGE)),
+			BaseModel:  types.GetDefaultBaseModel(s.ctx),
+		}},
+		BaseModel: types.GetDefaultBaseModel(s.ctx),
+	}
+	s.NoError(s.GetStores().InvoiceRepo.CreateWithLineItems(s.ctx, inv))
+
+	result, err := NewCreditAdjustmentService(s.params).ApplyCreditsToInvoice(s.ctx, inv)
+	s.NoError(err)
+	s.True(result.TotalPrepaidCreditsApplied.Equal(decimal.NewFromInt(55)))
+
+	// 55 usd at 1.10 is 50 eur
+	updated, err := s.GetStores().WalletRepo.GetWalletByID(s.ctx, w.ID)
+	s.NoError(err)
+	s.True(updated.Balance.Equal(decimal.NewFromInt(50)), "balance %s", updated.Balance)
+
+	filter := types.NewNoLimitWalletTransactionFilter()
+	filter.WalletID = lo.ToPtr(w.ID)
+	filter.FXConverted = lo.ToPtr(true)
+	txs, err := s.GetStores().WalletRepo.ListWalletTransactions(s.ctx, filter)
+	s.NoError(err)
+	s.Len(txs, 1)
+	s.True(txs[0].Amount.Equal(decimal.NewFromInt(50)))
+	s.True(txs[0].FXRate.Equal(decimal.NewFromFloat(1.10)))
+	s.Equal("usd", lo.FromPtr(txs[0].SettlementCurrency))
+	s.True(txs[0].SettlementAmount.Equal(decimal.NewFromInt(55)))
+
+	// The euro strengthens after the debit, so the 50 eur taken are now worth 60 usd
+	end := time.Now().UTC().Add(time.Minute)
+	s.createRate("eur", "usd", 1.20, end.Add(-time.Second))
+
+	report, err := s.service.GetFXGainLoss(s.ctx, dto.FXGainLossRequest{StartTime: start, EndTime: end})
+	s.NoError(err)
+	s.Len(report.Items, 1)
+	entry := report.Items[0]
+	s.Equal("eur", entry.WalletCurrency)
+	s.Equal("usd", entry.SettlementCurrency)
+	s.Equal(1, entry.TransactionCount)
+	s.True(entry.AverageRate.Equal(decimal.NewFromFloat(1.10)))
+	s.True(entry.RevaluedAmount.Equal(decimal.NewFromInt(60)))
+	s.True(entry.GainLoss.Equal(decimal.NewFromInt(5)))
+}
@@ -258,6 +258,7 @@ func (s *invoiceService) CreateEmptyDraftInvoice(ctx context.Context, req dto.Cr
 		if err := s.InvoiceRepo.Create(txCtx, inv); err != nil {
 			return err
 		}
+		s.recordAuditLog(txCtx, types.AuditLogEntityTypeInvoice, inv.ID, types.AuditLogActionCreate, nil, inv)
 
 		resp = dto.NewInvoiceResponse(inv)
 		return nil
@@ -877,6 +878,7 @@ func (s *invoiceService) performFinalizeInvoiceActions(ctx context.Context, inv
 		if lockedInv.InvoiceStatus != types.InvoiceStatusDraft {
 			return ierr.NewError("invoice is not in draft status").WithHint("invoice was finalized concurrently").Mark(ierr.ErrValidation)
 		}
+		before := *lockedInv
 
 		// ====================================================================
 		// Apply prepaid credits and taxes for subscription invoices.
@@ -895,13 +897,25 @@ func (s *invoiceService) performFinalizeInvoiceActions(ctx context.Context, inv
 			lockedInv.LineItems = lineItems
 
 			if len(lockedInv.LineItems) > 0 {
+				// Draw down the commitment contract covering the subscription before wallets,
+				// so prepaid commitments are used up first
+				commitmentContractService := NewCommitmentContractService(s.ServiceParams)
+				if _, err := commitmentContractService.ApplyContractToInvoice(txCtx, lockedInv); err != nil {
+					return err
+				}
+
 				// Apply credits — this debits wallets and updates line items
 				creditAdjustmentService := NewCreditAdjustmentService(s.ServiceParams)
-				creditResult, err := creditAdjustmentService.ApplyCreditsToInvoice(txCtx, lockedInv)
-				if err != nil {
+				if _, err := creditAdjustmentService.ApplyCreditsToInvoice(txCtx, lockedInv); err != nil {
 					return err
 				}
-				lockedInv.TotalPrepaidCreditsApplied = creditResult.TotalPrepaidCreditsApplied
+
+				// Line items carry both the contract drawdown and the wallet credits
+				totalPrepaidApplied := decimal.Zero
+				for _, lineItem := range lockedInv.LineItems {
+					totalPrepaidApplied = totalPrepaidApplied.Add(lineItem.PrepaidCreditsApplied)
+				}
+				lockedInv.TotalPrepaidCreditsApplied = totalPrepaidApplied
 
 				// Recalculate total with credits applied
 				newTotal := lockedInv.Subtotal.Sub(lockedInv.TotalDiscount).Sub(lockedInv.TotalPrepaidCreditsApplied)
@@ -961,6 +975,7 @@ func (s *invoiceService) performFinalizeInvoiceActions(ctx context.Context, inv
 		if err := s.InvoiceRepo.Update(txCtx, lockedInv); err != nil {
 			return err
 		}
+		s.recordAuditLog(txCtx, types.AuditLogEntityTypeInvoice, lockedInv.ID, types.AuditLogActionFinalize, &before, lockedInv)
 
 		// Update the caller's reference so downstream code sees the finalized state
 		*inv = *lockedInv
@@ -1091,6 +1106,7 @@ func (s *invoiceService) VoidInvoice(ctx context.Context, id string, req dto.Inv
 			Mark(ierr.ErrValidation)
 	}
 
+	before := *inv
 	err = s.DB.WithTx(ctx, func(tx context.Context) error {
 		now := time.Now().UTC()
 		inv.InvoiceStatus = types.InvoiceStatusVoided
@@ -1148,7 +1164,11 @@ func (s *invoiceService) VoidInvoice(ctx context.Context, id string, req dto.Inv
 			inv.PaymentStatus = types.PaymentStatusRefunded
 		}
 
-		return s.InvoiceRepo.Update(tx, inv)
+		if err := s.InvoiceRepo.Update(tx, inv); err != nil {
+			return err
+		}
+		s.recordAuditLog(tx, types.AuditLogEntityTypeInvoice, inv.ID, types.AuditLogActionVoid, &before, inv)
+		return nil
 	})
 	if err != nil {
 		return err
@@ -1589,6 +1609,7 @@ func (s *invoiceService) UpdatePaymentStatus(ctx context.Context, id string, sta
 			Mark(ierr.ErrValidation)
 	}
 
+	before := *inv
 	now := time.Now().UTC()
 	inv.PaymentStatus = status
 
@@ -1616,6 +1637,7 @@ func (s *invoiceService) UpdatePaymentStatus(ctx context.Context, id string, sta
 	if err := s.InvoiceRepo.Update(ctx, inv); err != nil {
 		return err
 	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeInvoice, inv.ID, types.AuditLogActionUpdate, &before, inv)
 
 	// If invoice is for a purchased credit (has wallet_transaction_id in metadata) and the payment status transitioned to succeeded,
 	// complete the wallet transaction to credit the wallet
@@ -3357,6 +3379,8 @@ func (s *invoiceService) UpdateInvoice(ctx context.Context, id string, req dto.U
 		}
 	}
 
+	before := *inv
+
 	// Update invoice PDF URL if provided
 	if req.InvoicePDFURL != nil {
 		inv.InvoicePDFURL = req.InvoicePDFURL
@@ -3376,6 +3400,7 @@ func (s *invoiceService) UpdateInvoice(ctx context.Context, id string, req dto.U
 	if err := s.InvoiceRepo.Update(ctx, inv); err != nil {
 		return nil, err
 	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeInvoice, inv.ID, types.AuditLogActionUpdate, &before, inv)
 
 	// Publish webhook event for invoice update
 	s.publishSystemEvent(ctx, types.WebhookEventInvoiceUpdate, id)
@@ -0,0 +1,763 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	authProvider "github.com/flexprice/flexprice/internal/auth"
+	"github.com/flexprice/flexprice/internal/cache"
+	"github.com/flexprice/flexprice/internal/domain/auth"
+	"github.com/flexprice/flexprice/internal/domain/invitation"
+	"github.com/flexprice/flexprice/internal/domain/membership"
+	"github.com/flexprice/flexprice/internal/domain/user"
+	"github.com/flexprice/flexprice/internal/email"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/rbac"
+	"github.com/flexprice/flexprice/internal/security"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// defaultInvitationTTL bounds how long an invitation can be accepted
+const defaultInvitationTTL = 7 * 24 * time.Hour
+
+// memberRolesTTL bounds how long a node without Redis may miss the removal or the role
+// change of a member made on another node
+const memberRolesTTL = time.Minute
+
+// MembershipService manages who belongs to a tenant. Users are invited by email and join
+// with their existing account or a new one. A user belongs to the tenant their account was
+// created in and to every tenant they joined since, and can switch between them.
+type MembershipService interface {
+	InviteUser(ctx context.Context, req dto.InviteUserRequest) (*dto.InvitationResponse, error)
+	ListInvitations(ctx context.Context) (*dto.ListInvitationsResponse, error)
+	RevokeInvitation(ctx context.Context, id string) error
+	// AcceptInvitation joins the invitee to the tenant of the invitation and signs them in to it
+	AcceptInvitation(ctx context.Context, req dto.AcceptInvitationRequest) (*dto.AuthResponse, error)
+	DeclineInvitation(ctx context.Context, req dto.DeclineInvitationRequest) error
+
+	ListMembers(ctx context.Context) (*dto.ListMembersResponse, error)
+	UpdateMemberRoles(ctx context.Context, userID string, req dto.AssignRolesRequest) (*dto.MemberResponse, error)
+	RemoveMember(ctx context.Context, userID string) error
+	// GetMemberRoles returns the roles of a member of the tenant in the context. Members without
+	// roles are admins, as every dashboard user was before roles could be assigned. Roles are
+	// cached until the member is removed or their roles change.
+	GetMemberRoles(ctx context.Context, userID string) ([]string, error)
+
+	// ListUserTenants returns the tenants the current user belongs to
+	ListUserTenants(ctx context.Context) ([]dto.UserTenant, error)
+	// SwitchTenant issues a token of another tenant the current user belongs to
+	SwitchTenant(ctx context.Context, req dto.SwitchTenantRequest) (*dto.AuthResponse, error)
+}
+
+// invitationSender sends the invitation emails, it is satisfied by *email.Email
+type invitationSender interface {
+	SendEmailWithTemplate(ctx context.Context, req email.SendEmailWithTemplateRequest) (*email.SendEmailWithTemplateResponse, error)
+}
+
+type membershipService struct {
+	ServiceParams
+	encryptionService security.EncryptionService
+	rbacService       *rbac.RBACService
+	emailService      invitationSender
+	authProvider      authProvider.Provider
+}
+
+// NewMembershipService creates a new membership service
+func NewMembershipService(
+	params ServiceParams,
+	encryptionService security.EncryptionService,
+	rbacService *rbac.RBACService,
+	emailService *email.Email,
+) MembershipService {
+	return &membershipService{
+		ServiceParams:     params,
+		encryptionService: encryptionService,
+		rbacService:       rbacService,
+		emailService:      emailService,
+		authProvider:      authProvider.NewProvider(params.Config),
+	}
+}
+
+func (s *membershipService) InviteUser(ctx context.Context, req dto.InviteUserRequest) (*dto.InvitationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.rbacService.ValidateRoles(ctx, req.Roles); err != nil {
+		return nil, err
+	}
+
+	// Emails are unique across tenants, so the invitee is looked up outside the tenant
+	existing, err := s.UserRepo.GetByEmail(context.WithValue(ctx, types.CtxTenantID, ""), req.Email)
+	if err != nil && !ierr.IsNotFound(err) {
+		return nil, err
+	}
+	if existing != nil {
+		if _, _, err := s.getMember(ctx, existing.ID); err == nil {
+			return nil, ierr.NewError("user is already a member").
+				WithHintf("%s is already a member of this tenant", req.Email).
+				WithReportableDetails(map[string]any{
+					"email": req.Email,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		} else if !ierr.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	if err := s.checkMemberLimit(ctx, req.Email); err != nil {
+		return nil, err
+	}
+
+	tenant, err := s.TenantRepo.GetByID(ctx, types.GetTenantID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	inviter := "A teammate"
+	if u, err := s.UserRepo.GetByID(ctx, types.GetUserID(ctx)); err == nil && u.Email != "" {
+		inviter = u.Email
+	}
+
+	token := newInvitationToken()
+	inv := &invitation.Invitation{
+		ID:               types.GenerateUUIDWithPrefix(types.UUID_PREFIX_INVITATION),
+		Email:            req.Email,
+		Roles:            lo.Ternary(req.Roles != nil, req.Roles, []string{}),
+		TokenHash:        s.encryptionService.Hash(token),
+		InvitationStatus: types.InvitationStatusPending,
+		ExpiresAt:        time.Now().UTC().Add(s.invitationTTL()),
+		BaseModel:        types.GetDefaultBaseModel(ctx),
+	}
+
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		// Inviting again replaces the pending invitation, the earlier email stops working
+		pending, err := s.InvitationRepo.GetPendingByEmail(ctx, req.Email)
+		if err != nil && !ierr.IsNotFound(err) {
+			return err
+		}
+		if pending != nil {
+			if err := s.respond(ctx, pending, types.InvitationStatusRevoked, "", types.AuditLogActionRevoke); err != nil {
+				return err
+			}
+		}
+
+		if err := s.InvitationRepo.Create(ctx, inv); err != nil {
+			return err
+		}
+		s.recordAuditLog(ctx, types.AuditLogEntityTypeInvitation, inv.ID, types.AuditLogActionCreate, nil, inv)
+
+		// Sent last so a failed send rolls the invitation back
+		return s.sendInvitation(ctx, inv, token, tenant.Name, inviter)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("invited user to tenant",
+		"invitation_id", inv.ID,
+		"tenant_id", inv.TenantID,
+	)
+	return dto.NewInvitationResponse(inv), nil
+}
+
+// checkMemberLimit enforces the user limit of the tenant, counting the members and the
+// pending invitations. The pending invitation of the email is replaced, so it does not count.
+func (s *membershipService) checkMemberLimit(ctx context.Context, inviteeEmail string) error {
+	tenantConfig, err := GetSetting[types.TenantConfig](&settingsService{ServiceParams: s.ServiceParams}, ctx, types.SettingKeyTenantConfig)
+	if err != nil {
+		return err
+	}
+
+	members, err := s.ListMembers(ctx)
+	if err != nil {
+		return err
+	}
+	invitations, err := s.InvitationRepo.ListByTenant(ctx)
+	if err != nil {
+		return err
+	}
+	pending := lo.CountBy(invitations, func(i *invitation.Invitation) bool {
+		return i.GetStatus() == types.InvitationStatusPending && i.Email != inviteeEmail
+	})
+
+	if len(members.Items)+pending >= tenantConfig.MaxUsers {
+		return ierr.NewError("user limit reached: you cannot invite any more users").
+			WithHintf("Maximum %d user(s) allowed for this tenant, including pending invitations. Limit reached.", tenantConfig.MaxUsers).
+			WithReportableDetails(map[string]interface{}{
+				"max_users":           tenantConfig.MaxUsers,
+				"members":             len(members.Items),
+				"pending_invitations": pending,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+func (s *membershipService) sendInvitation(ctx context.Context, inv *invitation.Invitation, token, tenantName, inviter string) error {
+	acceptURL, err := url.Parse(s.Config.Auth.Invitation.AcceptURL)
+	if err != nil || s.Config.Auth.Invitation.AcceptURL == "" {
+		return ierr.NewError("invitation accept url is not configured").
+			WithHint("Invitations require auth.invitation.accept_url to be configured").
+			Mark(ierr.ErrValidation)
+	}
+	query := acceptURL.Query()
+	query.Set("token", token)
+	acceptURL.RawQuery = query.Encode()
+
+	resp, err := s.emailService.SendEmailWithTemplate(ctx, email.SendEmailWithTemplateRequest{
+		ToAddress:    inv.Email,
+		Subject:      "You're invited to join " + tenantName + " on Flexprice",
+		TemplatePath: "invitation-email.html",
+		Data: map[string]interface{}{
+			"tenant_name": tenantName,
+			"inviter":     inviter,
+			"accept_url":  acceptURL.String(),
+			"expires_at":  inv.ExpiresAt.Format("January 2, 2006 15:04 MST"),
+		},
+	})
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to send the invitation email").
+			Mark(ierr.ErrSystem)
+	}
+	if !resp.Success {
+		s.Logger.Warnw("invitation email was not sent",
+			"invitation_id", inv.ID,
+			"error", resp.Error,
+		)
+	}
+	return nil
+}
+
+func (s *membershipService) ListInvitations(ctx context.Context) (*dto.ListInvitationsResponse, error) {
+	invitations, err := s.InvitationRepo.ListByTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ListInvitationsResponse{
+		Items: lo.Map(invitations, func(i *invitation.Invitation, _ int) *dto.InvitationResponse {
+			return dto.NewInvitationResponse(i)
+		}),
+	}, nil
+}
+
+func (s *membershipService) RevokeInvitation(ctx context.Context, id string) error {
+	inv, err := s.InvitationRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkInvitationPending(inv); err != nil {
+		return err
+	}
+
+	return s.respond(ctx, inv, types.InvitationStatusRevoked, "", types.AuditLogActionRevoke)
+}
+
+func (s *membershipService) AcceptInvitation(ctx context.Context, req dto.AcceptInvitationRequest) (*dto.AuthResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	inv, err := s.getInvitationByToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emails are unique across tenants, so the invitee is looked up outside the tenant
+	existing, err := s.UserRepo.GetByEmail(context.WithValue(ctx, types.CtxTenantID, ""), inv.Email)
+	if err != nil && !ierr.IsNotFound(err) {
+		return nil, err
+	}
+
+	var (
+		u     *user.User
+		token string
+	)
+	if existing == nil {
+		u, token, err = s.signUpInvitee(ctx, inv, req.Password)
+	} else {
+		u, token, err = s.joinInvitee(ctx, inv, existing, req.Password)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tenants, err := s.userTenants(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("user accepted invitation",
+		"invitation_id", inv.ID,
+		"tenant_id", inv.TenantID,
+		"user_id", u.ID,
+	)
+
+	return &dto.AuthResponse{
+		Token:    token,
+		UserID:   u.ID,
+		TenantID: inv.TenantID,
+		Tenants:  tenants,
+	}, nil
+}
+
+// signUpInvitee creates the account of an invitee without one in the tenant of the invitation
+func (s *membershipService) signUpInvitee(ctx context.Context, inv *invitation.Invitation, password string) (*user.User, string, error) {
+	authResponse, err := s.authProvider.SignUp(ctx, authProvider.AuthRequest{
+		Email:    inv.Email,
+		Password: password,
+		TenantID: inv.TenantID,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	u := user.NewUser(inv.Email, inv.TenantID)
+	u.ID = authResponse.ID
+	u.Roles = inv.Roles
+	u.CreatedBy = inv.CreatedBy
+	u.UpdatedBy = inv.CreatedBy
+
+	// The invitee acts in the tenant they are joining
+	ctx = context.WithValue(ctx, types.CtxTenantID, inv.TenantID)
+	ctx = context.WithValue(ctx, types.CtxUserID, u.ID)
+
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if s.authProvider.GetProvider() == types.AuthProviderFlexprice {
+			if err := s.AuthRepo.CreateAuth(ctx, auth.NewAuth(u.ID, s.authProvider.GetProvider(), authResponse.ProviderToken)); err != nil {
+				return err
+			}
+		}
+		if err := s.UserRepo.Create(ctx, u); err != nil {
+			return err
+		}
+		s.recordAuditLog(ctx, types.AuditLogEntityTypeUser, u.ID, types.AuditLogActionCreate, nil,
+			map[string]interface{}{"email": u.Email, "roles": u.Roles, "invitation_id": inv.ID})
+
+		if err := s.respond(ctx, inv, types.InvitationStatusAccepted, u.ID, types.AuditLogActionAccept); err != nil {
+			return err
+		}
+
+		return s.authProvider.AssignUserToTenant(ctx, u.ID, inv.TenantID)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return u, authResponse.AuthToken, nil
+}
+
+// joinInvitee adds an invitee with an account in another tenant to the tenant of the
+// invitation. The invitee confirms the password of their account.
+func (s *membershipService) joinInvitee(ctx context.Context, inv *invitation.Invitation, u *user.User, password string) (*user.User, string, error) {
+	var userAuth *auth.Auth
+	if s.authProvider.GetProvider() == types.AuthProviderFlexprice {
+		var err error
+		if userAuth, err = s.AuthRepo.GetAuthByUserID(ctx, u.ID); err != nil {
+			return nil, "", ierr.WithError(err).
+				WithHint("Sign in to your account to accept the invitation").
+				Mark(ierr.ErrPermissionDenied)
+		}
+	}
+
+	authResponse, err := s.authProvider.Login(ctx, authProvider.AuthRequest{
+		UserID:   u.ID,
+		TenantID: inv.TenantID,
+		Email:    u.Email,
+		Password: password,
+	}, userAuth)
+	if err != nil {
+		return nil, "", ierr.WithError(err).
+			WithHint("The password does not match your existing account").
+			Mark(ierr.ErrPermissionDenied)
+	}
+	if authResponse.ID != u.ID {
+		return nil, "", ierr.NewError("user mismatch").
+			WithHint("The password does not match your existing account").
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	// The invitee acts in the tenant they are joining
+	ctx = context.WithValue(ctx, types.CtxTenantID, inv.TenantID)
+	ctx = context.WithValue(ctx, types.CtxUserID, u.ID)
+
+	if _, _, err := s.getMember(ctx, u.ID); err == nil {
+		return nil, "", ierr.NewError("user is already a member").
+			WithHint("You are already a member of this tenant").
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	m := &membership.Membership{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_TENANT_MEMBERSHIP),
+		UserID:    u.ID,
+		Email:     u.Email,
+		Roles:     inv.Roles,
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	m.CreatedBy = inv.CreatedBy
+
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.MembershipRepo.Create(ctx, m); err != nil {
+			return err
+		}
+		s.recordAuditLog(ctx, types.AuditLogEntityTypeMembership, m.ID, types.AuditLogActionCreate, nil, m)
+
+		return s.respond(ctx, inv, types.InvitationStatusAccepted, u.ID, types.AuditLogActionAccept)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return u, authResponse.AuthToken, nil
+}
+
+func (s *membershipService) DeclineInvitation(ctx context.Context, req dto.DeclineInvitationRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	inv, err := s.getInvitationByToken(ctx, req.Token)
+	if err != nil {
+		return err
+	}
+
+	return s.respond(context.WithValue(ctx, types.CtxTenantID, inv.TenantID), inv,
+		types.InvitationStatusDeclined, "", types.AuditLogActionDecline)
+}
+
+// getInvitationByToken returns the pending invitation the token was issued for
+func (s *membershipService) getInvitationByToken(ctx context.Context, token string) (*invitation.Invitation, error) {
+	inv, err := s.InvitationRepo.GetByTokenHash(ctx, s.encryptionService.Hash(token))
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHint("The invitation link is invalid").
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, err
+	}
+	if err := checkInvitationPending(inv); err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+func checkInvitationPending(inv *invitation.Invitation) error {
+	if status := inv.GetStatus(); status != types.InvitationStatusPending {
+		return ierr.NewErrorf("invitation is %s", status).
+			WithHintf("The invitation is %s, ask for a new one", status).
+			WithReportableDetails(map[string]any{
+				"invitation_id":     inv.ID,
+				"invitation_status": status,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+	return nil
+}
+
+// respond stores the response to a pending invitation
+func (s *membershipService) respond(ctx context.Context, inv *invitation.Invitation, status types.InvitationStatus, acceptedBy string, action types.AuditLogAction) error {
+	before := *inv
+	inv.InvitationStatus = status
+	inv.AcceptedBy = acceptedBy
+	inv.RespondedAt = lo.ToPtr(time.Now().UTC())
+
+	if err := s.InvitationRepo.Update(ctx, inv); err != nil {
+		return err
+	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeInvitation, inv.ID, action, &before, inv)
+	return nil
+}
+
+func (s *membershipService) ListMembers(ctx context.Context) (*dto.ListMembersResponse, error) {
+	users, _, err := s.UserRepo.ListByFilter(ctx, &types.UserFilter{
+		QueryFilter: types.NewNoLimitQueryFilter(),
+		Type:        lo.ToPtr(types.UserTypeUser),
+	})
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := s.MembershipRepo.ListByTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*dto.MemberResponse, 0, len(users)+len(memberships))
+	for _, u := range users {
+		items = append(items, dto.NewMemberResponseFromUser(u))
+	}
+	for _, m := range memberships {
+		items = append(items, dto.NewMemberResponseFromMembership(m))
+	}
+	return &dto.ListMembersResponse{Items: items}, nil
+}
+
+func (s *membershipService) UpdateMemberRoles(ctx context.Context, userID string, req dto.AssignRolesRequest) (*dto.MemberResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.rbacService.ValidateRoles(ctx, req.Roles); err != nil {
+		return nil, err
+	}
+
+	u, m, err := s.getMember(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if u != nil {
+		if err := s.UserRepo.UpdateRoles(ctx, u.ID, req.Roles); err != nil {
+			return nil, err
+		}
+		s.invalidateMemberRoles(ctx, u.ID)
+		s.recordAuditLog(ctx, types.AuditLogEntityTypeUser, u.ID, types.AuditLogActionUpdate,
+			map[string]interface{}{"roles": u.Roles},
+			map[string]interface{}{"roles": req.Roles})
+		u.Roles = req.Roles
+		return dto.NewMemberResponseFromUser(u), nil
+	}
+
+	if err := s.MembershipRepo.UpdateRoles(ctx, m.ID, req.Roles); err != nil {
+		return nil, err
+	}
+	s.invalidateMemberRoles(ctx, userID)
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeMembership, m.ID, types.AuditLogActionUpdate,
+		map[string]interface{}{"roles": m.Roles},
+		map[string]interface{}{"roles": req.Roles})
+	m.Roles = req.Roles
+	return dto.NewMemberResponseFromMembership(m), nil
+}
+
+func (s *membershipService) RemoveMember(ctx context.Context, userID string) error {
+	if userID == types.GetUserID(ctx) {
+		return ierr.NewError("cannot remove yourself").
+			WithHint("You cannot remove yourself from the tenant").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	u, m, err := s.getMember(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if m != nil {
+		if err := s.MembershipRepo.Delete(ctx, m.ID); err != nil {
+			return err
+		}
+		s.invalidateMemberRoles(ctx, userID)
+		s.recordAuditLog(ctx, types.AuditLogEntityTypeMembership, m.ID, types.AuditLogActionDelete, m, nil)
+		return nil
+	}
+
+	// The account of the user belongs to this tenant, removing the user deletes the account.
+	// Accounts that joined other tenants would lose access to them.
+	memberships, err := s.MembershipRepo.ListByUserID(ctx, u.ID)
+	if err != nil {
+		return err
+	}
+	if len(memberships) > 0 {
+		return ierr.NewError("user belongs to other tenants").
+			WithHint("The account of this user was created in this tenant and also belongs to other tenants. Remove the user from the other tenants first.").
+			WithReportableDetails(map[string]any{
+				"user_id": u.ID,
+				"tenants": len(memberships),
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	if err := s.UserRepo.Delete(ctx, u.ID); err != nil {
+		return err
+	}
+	s.invalidateMemberRoles(ctx, u.ID)
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeUser, u.ID, types.AuditLogActionDelete,
+		map[string]interface{}{"email": u.Email, "roles": u.Roles}, nil)
+	return nil
+}
+
+// getMember returns the user when their account belongs to the tenant in the context, or
+// their membership when they joined from another tenant
+func (s *membershipService) getMember(ctx context.Context, userID string) (*user.User, *membership.Membership, error) {
+	u, err := s.UserRepo.GetByID(ctx, userID)
+	if err != nil && !ierr.IsNotFound(err) {
+		return nil, nil, err
+	}
+	if u != nil && u.TenantID == types.GetTenantID(ctx) && u.Status == types.StatusPublished && u.Type == types.UserTypeUser {
+		return u, nil, nil
+	}
+
+	m, err := s.MembershipRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nil, m, nil
+}
+
+func (s *membershipService) GetMemberRoles(ctx context.Context, userID string) ([]string, error) {
+	rolesCache := s.memberRolesCache()
+	key := memberRolesKey(ctx, userID)
+	if value, found := rolesCache.ForceCacheGet(ctx, key); found {
+		var roles []string
+		if err := json.Unmarshal([]byte(fmt.Sprint(value)), &roles); err == nil {
+			return roles, nil
+		}
+	}
+
+	roles, err := s.loadMemberRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if data, err := json.Marshal(roles); err == nil {
+		rolesCache.ForceCacheSet(ctx, key, string(data), memberRolesTTL)
+	}
+	return roles, nil
+}
+
+func (s *membershipService) loadMemberRoles(ctx context.Context, userID string) ([]string, error) {
+	u, m, err := s.getMember(ctx, userID)
+	if ierr.IsNotFound(err) {
+		return nil, ierr.WithError(err).
+			WithHint("You are not a member of this tenant").
+			WithReportableDetails(map[string]any{
+				"tenant_id": types.GetTenantID(ctx),
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	roles := lo.TernaryF(u != nil, func() []string { return u.Roles }, func() []string { return m.Roles })
+	if len(roles) == 0 {
+		return []string{rbac.RoleAdmin}, nil
+	}
+	return roles, nil
+}
+
+func (s *membershipService) ListUserTenants(ctx context.Context) ([]dto.UserTenant, error) {
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.userTenants(ctx, u)
+}
+
+func (s *membershipService) SwitchTenant(ctx context.Context, req dto.SwitchTenantRequest) (*dto.AuthResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	// API keys are bound to their tenant and roles, only dashboard sessions switch
+	if types.GetAPIKeyID(ctx) != "" {
+		return nil, ierr.NewError("api keys cannot switch tenants").
+			WithHint("Switching tenants requires signing in to the dashboard").
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tenants, err := s.userTenants(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	if !lo.ContainsBy(tenants, func(t dto.UserTenant) bool { return t.TenantID == req.TenantID }) {
+		return nil, ierr.NewError("user does not belong to tenant").
+			WithHint("You are not a member of this tenant").
+			WithReportableDetails(map[string]any{
+				"tenant_id": req.TenantID,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	token, err := s.authProvider.IssueToken(ctx, u.ID, req.TenantID, u.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AuthResponse{
+		Token:    token,
+		UserID:   u.ID,
+		TenantID: req.TenantID,
+		Tenants:  tenants,
+	}, nil
+}
+
+// memberRolesCache prefers the shared Redis cache so removals and role changes reach every node
+func (p *ServiceParams) memberRolesCache() cache.Cache {
+	if p.Config != nil && cache.CacheType(p.Config.Cache.Type) == cache.CacheTypeRedis {
+		if redisCache := cache.GetRedisCache(); redisCache != nil {
+			return redisCache
+		}
+	}
+	return cache.GetInMemoryCache()
+}
+
+// invalidateMemberRoles makes the next request of the user in the tenant of the context
+// load their roles, or be rejected when they are no longer a member
+func (p *ServiceParams) invalidateMemberRoles(ctx context.Context, userID string) {
+	p.memberRolesCache().ForceCacheDelete(ctx, memberRolesKey(ctx, userID))
+}
+
+func memberRolesKey(ctx context.Context, userID string) string {
+	return cache.GenerateKey(cache.PrefixMemberRoles, types.GetTenantID(ctx), userID)
+}
+
+// currentUser returns the account of the user in the context, which may belong to another
+// tenant than the one in the context
+func (s *membershipService) currentUser(ctx context.Context) (*user.User, error) {
+	u, m, err := s.getMember(ctx, types.GetUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if u != nil {
+		return u, nil
+	}
+	return s.UserRepo.GetByEmail(context.WithValue(ctx, types.CtxTenantID, ""), m.Email)
+}
+
+// userTenants returns the tenants of the user, starting with the one their account was created in
+func (p *ServiceParams) userTenants(ctx context.Context, u *user.User) ([]dto.UserTenant, error) {
+	memberships, err := p.MembershipRepo.ListByUserID(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantIDs := append([]string{u.TenantID}, lo.Map(memberships, func(m *membership.Membership, _ int) string {
+		return m.TenantID
+	})...)
+
+	tenants := make([]dto.UserTenant, 0, len(tenantIDs))
+	for i, tenantID := range tenantIDs {
+		t, err := p.TenantRepo.GetByID(ctx, tenantID)
+		if err != nil {
+			return nil, err
+		}
+		tenants = append(tenants, dto.UserTenant{
+			TenantID:   t.ID,
+			Name:       t.Name,
+			HomeTenant: i == 0,
+		})
+	}
+	return tenants, nil
+}
+
+func (s *membershipService) invitationTTL() time.Duration {
+	if s.Config.Auth.Invitation.TTL > 0 {
+		return s.Config.Auth.Invitation.TTL
+	}
+	return defaultInvitationTTL
+}
+
+// newInvitationToken returns the random token sent to the invitee
+func newInvitationToken() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	authProvider "github.com/flexprice/flexprice/internal/auth"
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/tenant"
+	"github.com/flexprice/flexprice/internal/email"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/rbac"
+	"github.com/flexprice/flexprice/internal/security"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+// recordingInvitationSender keeps the invitation emails instead of sending them
+type recordingInvitationSender struct {
+	sent []email.SendEmailWithTemplateRequest
+}
+
+func (r *recordingInvitationSender) SendEmailWithTemplate(_ context.Context, req email.SendEmailWithTemplateRequest) (*email.SendEmailWithTemplateResponse, error) {
+	r.sent = append(r.sent, req)
+	return &email.SendEmailWithTemplateResponse{Success: true}, nil
+}
+
+type MembershipServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service  MembershipService
+	sender   *recordingInvitationSender
+	provider authProvider.Provider
+}
+
+func TestMembershipService(t *testing.T) {
+	suite.Run(t, new(MembershipServiceSuite))
+}
+
+func (s *MembershipServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+
+	cfg := *s.GetConfig()
+	cfg.Auth = config.AuthConfig{
+		Provider:   types.AuthProviderFlexprice,
+		Secret:     "test-auth-secret",
+		Invitation: config.InvitationConfig{AcceptURL: "http://localhost:3000/auth/invitation"},
+	}
+	cfg.RBAC.RolesConfigPath = "../config/rbac/roles.json"
+
+	encryption, err := security.NewEncryptionService(&cfg, s.GetLogger())
+	s.Require().NoError(err)
+	rbacService, err := rbac.NewRBACService(&cfg, s.GetStores().RBACRoleRepo, s.GetLogger())
+	s.Require().NoError(err)
+
+	params := ServiceParams{
+		Logger:         s.GetLogger(),
+		Config:         &cfg,
+		DB:             s.GetDB(),
+		UserRepo:       s.GetStores().UserRepo,
+		AuthRepo:       s.GetStores().AuthRepo,
+		TenantRepo:     s.GetStores().TenantRepo,
+		SettingsRepo:   s.GetStores().SettingsRepo,
+		AuditLogRepo:   s.GetStores().AuditLogRepo,
+		InvitationRepo: s.GetStores().InvitationRepo,
+		MembershipRepo: s.GetStores().MembershipRepo,
+	}
+	service := NewMembershipService(params, encryption, rbacService, email.NewEmail(email.NewEmailClient(email.Config{}), zap.NewNop()))
+	s.sender = &recordingInvitationSender{}
+	service.(*membershipService).emailService = s.sender
+	s.service = service
+	s.provider = authProvider.NewProvider(&cfg)
+
+	for id, name := range map[string]string{types.DefaultTenantID: "Acme", "tenant_globex": "Globex", "tenant_initech": "Initech"} {
+		s.Require().NoError(s.GetStores().TenantRepo.Create(s.GetContext(), &tenant.Tenant{ID: id, Name: name}))
+	}
+}
+
+func (s *MembershipServiceSuite) tenantContext(tenantID string) context.Context {
+	return context.WithValue(s.GetContext(), types.CtxTenantID, tenantID)
+}
+
+// invite invites the email and returns the token of the invitation email
+func (s *MembershipServiceSuite) invite(ctx context.Context, emailAddress string, roles ...string) string {
+	_, err := s.service.InviteUser(ctx, dto.InviteUserRequest{Email: emailAddress, Roles: roles})
+	s.Require().NoError(err)
+
+	sent := s.sender.sent[len(s.sender.sent)-1]
+	s.Equal(strings.ToLower(emailAddress), sent.ToAddress)
+	acceptURL, err := url.Parse(sent.Data["accept_url"].(string))
+	s.Require().NoError(err)
+	return acceptURL.Query().Get("token")
+}
+
+func (s *MembershipServiceSuite) TestInviteNewUser() {
+	token := s.invite(s.GetContext(), "Jane@Acme.com", "event_reader")
+
+	invitations, err := s.service.ListInvitations(s.GetContext())
+	s.Require().NoError(err)
+	s.Require().Len(invitations.Items, 1)
+	s.Equal("jane@acme.com", invitations.Items[0].Email)
+	s.Equal(types.InvitationStatusPending, invitations.Items[0].InvitationStatus)
+	s.NotEqual(token, invitations.Items[0].TokenHash, "tokens are stored hashed")
+
+	resp, err := s.service.AcceptInvitation(context.Background(), dto.AcceptInvitationRequest{Token: token, Password: "s3cret-password"})
+	s.Require().NoError(err)
+	s.Equal(types.DefaultTenantID, resp.TenantID)
+	s.Equal([]dto.UserTenant{{TenantID: types.DefaultTenantID, Name: "Acme", HomeTenant: true}}, resp.Tenants)
+
+	claims, err := s.provider.ValidateToken(s.GetContext(), resp.Token)
+	s.Require().NoError(err)
+	s.Equal(resp.UserID, claims.UserID)
+	s.Equal(types.DefaultTenantID, claims.TenantID)
+
+	members, err := s.service.ListMembers(s.GetContext())
+	s.Require().NoError(err)
+	s.Require().Len(members.Items, 1)
+	s.Equal(resp.UserID, members.Items[0].UserID)
+	s.Equal([]string{"event_reader"}, members.Items[0].Roles)
+	s.True(members.Items[0].HomeTenant)
+
+	// The link works once
+	_, err = s.service.AcceptInvitation(context.Background(), dto.AcceptInvitationRequest{Token: token, Password: "s3cret-password"})
+	s.True(ierr.IsInvalidOperation(err))
+
+	// Members cannot be invited again
+	_, err = s.service.InviteUser(s.GetContext(), dto.InviteUserRequest{Email: "jane@acme.com"})
+	s.True(ierr.IsAlreadyExists(err))
+}
+
+func (s *MembershipServiceSuite) TestUserInSeveralTenants() {
+	// Sam signs up through an invitation to Globex
+	globex := s.tenantContext("tenant_globex")
+	signedUp, err := s.service.AcceptInvitation(context.Background(), dto.AcceptInvitationRequest{
+		Token:    s.invite(globex, "sam@globex.com"),
+		Password: "s3cret-password",
+	})
+	s.Require().NoError(err)
+
+	// and joins Acme with the same account
+	token := s.invite(s.GetContext(), "sam@globex.com")
+	_, err = s.service.AcceptInvitation(context.Background(), dto.AcceptInvitationRequest{Token: token, Password: "wrong-password"})
+	s.True(ierr.IsPermissionDenied(err), "joining with an existing account needs its password")
+
+	joined, err := s.service.AcceptInvitation(context.Background(), dto.AcceptInvitationRequest{Token: token, Password: "s3cret-password"})
+	s.Require().NoError(err)
+	s.Equal(signedUp.UserID, joined.UserID)
+	s.Equal(types.DefaultTenantID, joined.TenantID)
+	s.Equal([]dto.UserTenant{
+		{TenantID: "tenant_globex", Name: "Globex", HomeTenant: true},
+		{TenantID: types.DefaultTenantID, Name: "Acme"},
+	}, joined.Tenants)
+
+	roles, err := s.service.GetMemberRoles(globex, signedUp.UserID)
+	s.Require().NoError(err)
+	s.Equal([]string{rbac.RoleAdmin}, roles, "members without roles are admins")
+	roles, err = s.service.GetMemberRoles(s.GetContext(), joined.UserID)
+	s.Require().NoError(err)
+	s.Equal([]string{rbac.RoleAdmin}, roles)
+
+	// Role changes apply to the next request, the cached roles are dropped
+	member, err := s.service.UpdateMemberRoles(s.GetContext(), joined.UserID, dto.AssignRolesRequest{Roles: []string{"event_reader"}})
+	s.Require().NoError(err)
+	s.False(member.HomeTenant)
+	s.Equal([]string{"event_reader"}, member.Roles)
+
+	roles, err = s.service.GetMemberRoles(s.GetContext(), joined.UserID)
+	s.Require().NoError(err)
+	s.Equal([]string{"event_reader"}, roles, "roles come from the membership in the tenant")
+	_, err = s.service.GetMemberRoles(s.tenantContext("tenant_initech"), joined.UserID)
+	s.True(ierr.IsPermissionDenied(err))
+
+	// Switching from Globex to Acme issues a token of Acme
+	samGlobex := context.WithValue(globex, types.CtxUserID, signedUp.UserID)
+	switched, err := s.service.SwitchTenant(samGlobex, dto.SwitchTenantRequest{TenantID: types.DefaultTenantID})
+	s.Require().NoError(err)
+	claims, err := s.provider.ValidateToken(s.GetContext(), switched.Token)
+	s.Require().NoError(err)
+	s.Equal(types.DefaultTenantID, claims.TenantID)
+
+	samAcme := context.WithValue(s.GetContext(), types.CtxUserID, signedUp.UserID)
+	tenants, err := s.service.ListUserTenants(samAcme)
+	s.Require().NoError(err)
+	s.Len(tenants, 2)
+
+	_, err = s.service.SwitchTenant(samAcme, dto.SwitchTenantRequest{TenantID: "tenant_initech"})
+	s.True(ierr.IsPermissionDenied(err))
+	_, err = s.service.SwitchTenant(context.WithValue(samAcme, types.CtxAPIKeyID, "secret_1"), dto.SwitchTenantRequest{TenantID: "tenant_globex"})
+	s.True(ierr.IsPermissionDenied(err), "api keys cannot switch tenants")
+
+	// The account cannot be deleted from Globex while it belongs to Acme
+	err = s.service.RemoveMember(globex, signedUp.UserID)
+	s.True(ierr.IsInvalidOperation(err))
+
+	s.Require().NoError(s.service.RemoveMember(s.GetContext(), joined.UserID))
+	_, err = s.service.SwitchTenant(samGlobex, dto.SwitchTenantRequest{TenantID: types.DefaultTenantID})
+	s.True(ierr.IsPermissionDenied(err))
+	_, err = s.service.GetMemberRoles(s.GetContext(), joined.UserID)
+	s.True(ierr.IsPermissionDenied(err), "tokens issued before the removal are rejected")
+
+	s.Require().NoError(s.service.RemoveMember(globex, signedUp.UserID))
+	_, err = s.GetStores().UserRepo.GetByEmail(globex, "sam@globex.com")
+	s.True(ierr.IsNotFound(err))
+}
+
+func (s *MembershipServiceSuite) TestRespondingToInvitations() {
+	declined := s.invite(s.GetContext(), "lee@acme.com")
+	s.Require().NoError(s.service.DeclineInvitation(context.Background(), dto.DeclineInvitationRequest{Token: declined}))
+	_, err := s.service.AcceptInvitation(context.Background(), dto.AcceptInvitationRequest{Token: declined, Password: "s3cret-password"})
+	s.True(ierr.IsInvalidOperation(err))
+
+	// Inviting again replaces the pending invitation
+	first := s.invite(s.GetContext(), "kim@acme.com")
+	second := s.invite(s.GetContext(), "kim@acme.com")
+	_, err = s.service.AcceptInvitation(context.Background(), dto.AcceptInvitationRequest{Token: first, Password: "s3cret-password"})
+	s.True(ierr.IsInvalidOperation(err))
+
+	pending, err := s.GetStores().InvitationRepo.GetPendingByEmail(s.GetContext(), "kim@acme.com")
+	s.Require().NoError(err)
+	s.Require().NoError(s.service.RevokeInvitation(s.GetContext(), pending.ID))
+	_, err = s.service.AcceptInvitation(context.Background(), dto.AcceptInvitationRequest{Token: second, Password: "s3cret-password"})
+	s.True(ierr.IsInvalidOperation(err))
+
+	expiring := s.invite(s.GetContext(), "max@acme.com")
+	pending, err = s.GetStores().InvitationRepo.GetPendingByEmail(s.GetContext(), "max@acme.com")
+	s.Require().NoError(err)
+	pending.ExpiresAt = time.Now().Add(-time.Minute)
+	s.Require().NoError(s.GetStores().InvitationRepo.Update(s.GetContext(), pending))
+	_, err = s.service.AcceptInvitation(context.Background(), dto.AcceptInvitationRequest{Token: expiring, Password: "s3cret-password"})
+	s.True(ierr.IsInvalidOperation(err))
+
+	invitations, err := s.service.ListInvitations(s.GetContext())
+	s.Require().NoError(err)
+	statuses := make(map[string][]types.InvitationStatus)
+	for _, i := range invitations.Items {
+		statuses[i.Email] = append(statuses[i.Email], i.InvitationStatus)
+	}
+	s.Equal([]types.InvitationStatus{types.InvitationStatusDeclined}, statuses["lee@acme.com"])
+	s.ElementsMatch([]types.InvitationStatus{types.InvitationStatusRevoked, types.InvitationStatusRevoked}, statuses["kim@acme.com"])
+	s.Equal([]types.InvitationStatus{types.InvitationStatusExpired}, statuses["max@acme.com"])
+
+	_, err = s.service.AcceptInvitation(context.Background(), dto.AcceptInvitationRequest{Token: "unknown", Password: "s3cret-password"})
+	s.True(ierr.IsNotFound(err))
+}
@@ -5,8 +5,10 @@ import (
 	"strings"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/auditlog"
 	"github.com/flexprice/flexprice/internal/domain/meter"
 	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/types"
 )
 
@@ -20,11 +22,13 @@ type MeterService interface {
 }
 
 type meterService struct {
-	meterRepo meter.Repository
+	meterRepo    meter.Repository
+	auditLogRepo auditlog.Repository
+	logger       *logger.Logger
 }
 
-func NewMeterService(meterRepo meter.Repository) MeterService {
-	return &meterService{meterRepo: meterRepo}
+func NewMeterService(meterRepo meter.Repository, auditLogRepo auditlog.Repository, logger *logger.Logger) MeterService {
+	return &meterService{meterRepo: meterRepo, auditLogRepo: auditLogRepo, logger: logger}
 }
 
 func (s *meterService) CreateMeter(ctx context.Context, req *dto.CreateMeterRequest) (*meter.Meter, error) {
@@ -52,6 +56,7 @@ func (s *meterService) CreateMeter(ctx context.Context, req *dto.CreateMeterRequ
 		return nil, err
 	}
 
+	recordAuditLog(ctx, s.auditLogRepo, s.logger, types.AuditLogEntityTypeMeter, meter.ID, types.AuditLogActionCreate, nil, meter)
 	return meter, nil
 }
 
@@ -124,7 +129,18 @@ func (s *meterService) DisableMeter(ctx context.Context, id string) error {
 			WithHint("Id is required").
 			Mark(ierr.ErrValidation)
 	}
-	return s.meterRepo.DisableMeter(ctx, id)
+
+	m, err := s.meterRepo.GetMeter(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.meterRepo.DisableMeter(ctx, id); err != nil {
+		return err
+	}
+
+	recordAuditLog(ctx, s.auditLogRepo, s.logger, types.AuditLogEntityTypeMeter, id, types.AuditLogActionDelete, m, nil)
+	return nil
 }
 
 // contains checks if a slice contains a specific value
@@ -156,6 +172,7 @@ func (s *meterService) UpdateMeter(ctx context.Context, id string, filters []met
 	if err != nil {
 		return nil, err
 	}
+	before := *existingMeter
 
 	// Merge filters
 	mergedFilters := mergeFilters(existingMeter.Filters, filters)
@@ -167,6 +184,7 @@ func (s *meterService) UpdateMeter(ctx context.Context, id string, filters []met
 
 	// Return the updated meter object
 	existingMeter.Filters = mergedFilters
+	recordAuditLog(ctx, s.auditLogRepo, s.logger, types.AuditLogEntityTypeMeter, id, types.AuditLogActionUpdate, &before, existingMeter)
 	return existingMeter, nil
 }
 
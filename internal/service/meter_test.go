@@ -7,6 +7,7 @@ import (
 
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/testutil"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/stretchr/testify/suite"
@@ -26,7 +27,7 @@ func TestMeterService(t *testing.T) {
 func (s *MeterServiceSuite) SetupTest() {
 	s.ctx = testutil.SetupContext()
 	s.store = testutil.NewInMemoryMeterStore()
-	s.service = NewMeterService(s.store)
+	s.service = NewMeterService(s.store, testutil.NewInMemoryAuditLogStore(), logger.GetLogger())
 }
 
 func (s *MeterServiceSuite) TestCreateMeter() {
@@ -2,10 +2,13 @@ package service
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"github.com/flexprice/flexprice/internal/domain/events"
 	ierr "github.com/flexprice/flexprice/internal/errors"
-	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
 )
 
 // MeterUsageService handles read-side meter usage queries.
@@ -13,18 +16,18 @@ import (
 // keeping the handler HTTP/DTO-only.
 type MeterUsageService interface {
 	GetUsage(ctx context.Context, params *events.MeterUsageQueryParams) (*events.MeterUsageAggregationResult, error)
+	// GetUsageMultiMeter serves analytics queries, reading the hourly and daily rollups
+	// where they can answer the query and meter_usage for the rest of the range
 	GetUsageMultiMeter(ctx context.Context, params *events.MeterUsageQueryParams) ([]*events.MeterUsageAggregationResult, error)
 }
 
 type meterUsageService struct {
-	repo   events.MeterUsageRepository
-	logger *logger.Logger
+	ServiceParams
 }
 
-func NewMeterUsageService(repo events.MeterUsageRepository, logger *logger.Logger) MeterUsageService {
+func NewMeterUsageService(params ServiceParams) MeterUsageService {
 	return &meterUsageService{
-		repo:   repo,
-		logger: logger,
+		ServiceParams: params,
 	}
 }
 
@@ -32,12 +35,99 @@ func (s *meterUsageService) GetUsage(ctx context.Context, params *events.MeterUs
 	if params == nil {
 		return nil, ierr.NewError("params are required").Mark(ierr.ErrValidation)
 	}
-	return s.repo.GetUsage(ctx, params)
+	return s.MeterUsageRepo.GetUsage(ctx, params)
 }
 
 func (s *meterUsageService) GetUsageMultiMeter(ctx context.Context, params *events.MeterUsageQueryParams) ([]*events.MeterUsageAggregationResult, error) {
 	if params == nil || len(params.MeterIDs) == 0 {
 		return nil, ierr.NewError("params with meter_ids are required").Mark(ierr.ErrValidation)
 	}
-	return s.repo.GetUsageMultiMeter(ctx, params)
+
+	segments := planUsageQuery(params, s.usageRollupCoverage(ctx, types.UsageRollupSourceMeterUsage, params.TenantID, params.EnvironmentID))
+	if len(segments) == 1 && segments[0].Granularity == "" {
+		return s.MeterUsageRepo.GetUsageMultiMeter(ctx, params)
+	}
+
+	var results []*events.MeterUsageAggregationResult
+	for _, segment := range segments {
+		segmentParams := *params
+		segmentParams.StartTime = segment.StartTime
+		segmentParams.EndTime = segment.EndTime
+
+		var segmentResults []*events.MeterUsageAggregationResult
+		var err error
+		if segment.Granularity == "" {
+			segmentResults, err = s.MeterUsageRepo.GetUsageMultiMeter(ctx, &segmentParams)
+		} else {
+			segmentResults, err = s.UsageRollupRepo.GetUsageMultiMeter(ctx, &segmentParams, segment.Granularity)
+		}
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, segmentResults...)
+	}
+
+	return mergeMeterUsageResults(params, results), nil
+}
+
+// mergeMeterUsageResults combines the per-segment results of a planned query into one
+// result per meter. Points sharing a window are combined the way the aggregation combines
+// buckets, and window totals are summed as the single query does.
+func mergeMeterUsageResults(params *events.MeterUsageQueryParams, results []*events.MeterUsageAggregationResult) []*events.MeterUsageAggregationResult {
+	combine := func(a, b decimal.Decimal) decimal.Decimal {
+		if params.AggregationType == types.AggregationMax {
+			return decimal.Max(a, b)
+		}
+		return a.Add(b)
+	}
+
+	merged := make(map[string]*events.MeterUsageAggregationResult)
+	points := make(map[string]map[time.Time]*events.MeterUsageResult)
+	order := make([]string, 0)
+	for _, res := range results {
+		m, ok := merged[res.MeterID]
+		if !ok {
+			m = &events.MeterUsageAggregationResult{
+				MeterID:         res.MeterID,
+				AggregationType: params.AggregationType,
+				TotalValue:      res.TotalValue,
+				EventCount:      res.EventCount,
+			}
+			merged[res.MeterID] = m
+			points[res.MeterID] = make(map[time.Time]*events.MeterUsageResult)
+			order = append(order, res.MeterID)
+		} else {
+			m.TotalValue = combine(m.TotalValue, res.TotalValue)
+			m.EventCount += res.EventCount
+		}
+
+		for _, point := range res.Points {
+			key := point.WindowStart.UTC()
+			if existing, ok := points[res.MeterID][key]; ok {
+				existing.Value = combine(existing.Value, point.Value)
+				existing.EventCount += point.EventCount
+				continue
+			}
+			p := point
+			points[res.MeterID][key] = &p
+		}
+	}
+
+	out := make([]*events.MeterUsageAggregationResult, 0, len(order))
+	for _, meterID := range order {
+		m := merged[meterID]
+		if len(points[meterID]) > 0 {
+			m.Points = make([]events.MeterUsageResult, 0, len(points[meterID]))
+			m.TotalValue = decimal.Zero
+			for _, p := range points[meterID] {
+				m.Points = append(m.Points, *p)
+				m.TotalValue = m.TotalValue.Add(p.Value)
+			}
+			sort.Slice(m.Points, func(i, j int) bool {
+				return m.Points[i].WindowStart.Before(m.Points[j].WindowStart)
+			})
+		}
+		out = append(out, m)
+	}
+	return out
 }
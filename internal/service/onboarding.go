@@ -563,7 +563,7 @@ func (s *onboardingService) createDefaultMeters(ctx context.Context) ([]*meter.M
 	s.Logger.InfowCtx(ctx, "creating AI-focused meters for hackathon environment")
 
 	// Create a meter service instance
-	meterService := NewMeterService(s.MeterRepo)
+	meterService := NewMeterService(s.MeterRepo, s.AuditLogRepo, s.Logger)
 
 	// Define simple LLM usage meter
 	modelFilters := []meter.Filter{
@@ -157,6 +157,7 @@ func (s *paymentService) CreatePayment(ctx context.Context, req *dto.CreatePayme
 		return nil, err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypePayment, p.ID, types.AuditLogActionCreate, nil, p)
 	s.publishSystemEvent(ctx, types.WebhookEventPaymentCreated, p.ID)
 
 	if req.ProcessPayment {
@@ -353,6 +354,7 @@ func (s *paymentService) UpdatePayment(ctx context.Context, id string, req dto.U
 	if err != nil {
 		return nil, err // Repository already using ierr
 	}
+	before := *p
 
 	if req.PaymentStatus != nil {
 		p.PaymentStatus = types.PaymentStatus(*req.PaymentStatus)
@@ -383,6 +385,7 @@ func (s *paymentService) UpdatePayment(ctx context.Context, id string, req dto.U
 		return nil, err // Repository already using ierr
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypePayment, p.ID, types.AuditLogActionUpdate, &before, p)
 	s.publishSystemEvent(ctx, types.WebhookEventPaymentUpdated, p.ID)
 
 	return dto.NewPaymentResponse(p), nil
@@ -461,7 +464,17 @@ func (s *paymentService) DeletePayment(ctx context.Context, id string) error {
 			Mark(ierr.ErrValidation)
 	}
 
-	return s.PaymentRepo.Delete(ctx, id) // Repository already using ierr
+	p, err := s.PaymentRepo.Get(ctx, id)
+	if err != nil {
+		return err // Repository already using ierr
+	}
+
+	if err := s.PaymentRepo.Delete(ctx, id); err != nil {
+		return err // Repository already using ierr
+	}
+
+	s.recordAuditLog(ctx, types.AuditLogEntityTypePayment, id, types.AuditLogActionDelete, p, nil)
+	return nil
 }
 
 func (s *paymentService) publishSystemEvent(ctx context.Context, eventName types.WebhookEventName, paymentID string) {
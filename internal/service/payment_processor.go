@@ -562,19 +562,34 @@ func (p *paymentProcessor) handleCreditsPayment(ctx context.Context, paymentObj
 			Mark(ierr.ErrInvalidOperation)
 	}
 
-	// Validate currency match
+	// Validate currency match, wallets with FX conversion enabled are debited at the current rate
+	debitAmount := paymentObj.Amount
+	var conversion *types.FXConversion
 	if !types.IsMatchingCurrency(selectedWallet.Currency, paymentObj.Currency) {
-		return ierr.NewError("wallet currency does not match payment currency").
-			WithHint("Wallet currency does not match payment currency").
-			WithReportableDetails(map[string]interface{}{
-				"wallet_id": selectedWallet.ID,
-				"currency":  paymentObj.Currency,
-			}).
-			Mark(ierr.ErrInvalidOperation)
+		if !selectedWallet.Config.FXConversionEnabled || p.FXRateRepo == nil {
+			return ierr.NewError("wallet currency does not match payment currency").
+				WithHint("Wallet currency does not match payment currency").
+				WithReportableDetails(map[string]interface{}{
+					"wallet_id": selectedWallet.ID,
+					"currency":  paymentObj.Currency,
+				}).
+				Mark(ierr.ErrInvalidOperation)
+		}
+
+		conversion, err = NewFXRateService(p.ServiceParams).GetConversion(ctx, selectedWallet.Currency, paymentObj.Currency, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+		debitAmount = conversion.ConvertBack(paymentObj.Amount)
+		// Converting back can round past a balance that still covers the payment
+		if debitAmount.GreaterThan(selectedWallet.Balance) &&
+			conversion.Convert(selectedWallet.Balance).GreaterThanOrEqual(paymentObj.Amount) {
+			debitAmount = selectedWallet.Balance
+		}
 	}
 
 	// Validate sufficient balance
-	if selectedWallet.Balance.LessThan(paymentObj.Amount) {
+	if selectedWallet.Balance.LessThan(debitAmount) {
 		return ierr.NewError("wallet balance is less than payment amount").
 			WithHint("Wallet balance is less than payment amount").
 			WithReportableDetails(map[string]interface{}{
@@ -588,7 +603,7 @@ func (p *paymentProcessor) handleCreditsPayment(ctx context.Context, paymentObj
 	operation := &wallet.WalletOperation{
 		WalletID:          selectedWallet.ID,
 		Type:              types.TransactionTypeDebit,
-		Amount:            paymentObj.Amount,
+		Amount:            debitAmount,
 		ReferenceType:     types.WalletTxReferenceTypePayment,
 		ReferenceID:       paymentObj.ID,
 		Description:       fmt.Sprintf("Payment for invoice %s", paymentObj.DestinationID),
@@ -605,6 +620,13 @@ func (p *paymentProcessor) handleCreditsPayment(ctx context.Context, paymentObj
 		operation.InvoiceID = &paymentObj.DestinationID
 	}
 
+	if conversion != nil {
+		operation.FX = conversion
+		operation.SettlementAmount = paymentObj.Amount
+		operation.Metadata["fx_rate"] = conversion.Rate.String()
+		operation.Metadata["settlement_currency"] = conversion.ToCurrency
+	}
+
 	// Create wallet service
 	walletService := NewWalletService(p.ServiceParams)
 
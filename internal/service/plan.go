@@ -48,6 +48,8 @@ func (s *planService) CreatePlan(ctx context.Context, req dto.CreatePlanRequest)
 		return nil, err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypePlan, plan.ID, types.AuditLogActionCreate, nil, plan)
+
 	return &dto.CreatePlanResponse{Plan: plan}, nil
 }
 
@@ -265,6 +267,7 @@ func (s *planService) UpdatePlan(ctx context.Context, id string, req dto.UpdateP
 	}
 
 	plan := data
+	before := *plan
 
 	// Update plan fields if provided
 	if req.Name != nil {
@@ -290,6 +293,7 @@ func (s *planService) UpdatePlan(ctx context.Context, id string, req dto.UpdateP
 			return err
 		}
 
+		s.recordAuditLog(ctx, types.AuditLogEntityTypePlan, plan.ID, types.AuditLogActionUpdate, &before, plan)
 		return nil
 	})
 
@@ -344,6 +348,8 @@ func (s *planService) DeletePlan(ctx context.Context, id string) error {
 	if err != nil {
 		return err
 	}
+
+	s.recordAuditLog(ctx, types.AuditLogEntityTypePlan, plan.ID, types.AuditLogActionDelete, plan, nil)
 	return nil
 }
 
@@ -626,7 +632,7 @@ func (s *planService) ReprocessEventsForMissingPairs(ctx context.Context, missin
 		}
 		return p.MeterID, true
 	}))
-	meterService := NewMeterService(s.MeterRepo)
+	meterService := NewMeterService(s.MeterRepo, s.AuditLogRepo, s.Logger)
 	var meterIDToEventName map[string]string = make(map[string]string)
 
 	meterFilter := types.NewNoLimitMeterFilter()
@@ -104,6 +104,8 @@ func (s *priceService) CreatePrice(ctx context.Context, req dto.CreatePriceReque
 		return nil, err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypePrice, p.ID, types.AuditLogActionCreate, nil, p)
+
 	response := &dto.PriceResponse{Price: p}
 
 	// Sync new price to Chargebee if it belongs to a plan
@@ -203,7 +205,7 @@ func (s *priceService) setDisplayName(ctx context.Context, req *dto.CreatePriceR
 	case types.PRICE_TYPE_USAGE:
 		// For USAGE prices, extract meter name
 		if req.MeterID != "" {
-			meterService := NewMeterService(s.MeterRepo)
+			meterService := NewMeterService(s.MeterRepo, s.AuditLogRepo, s.Logger)
 			meter, err := meterService.GetMeter(ctx, req.MeterID)
 			if err == nil && meter != nil {
 				req.DisplayName = meter.Name
@@ -365,6 +367,7 @@ func (s *priceService) CreateBulkPrice(ctx context.Context, req dto.CreateBulkPr
 
 		// Add successful prices to response
 		for _, p := range prices {
+			s.recordAuditLog(txCtx, types.AuditLogEntityTypePrice, p.ID, types.AuditLogActionCreate, nil, p)
 			priceResp := &dto.PriceResponse{Price: p}
 			response.Items = append(response.Items, priceResp)
 		}
@@ -430,7 +433,7 @@ func (s *priceService) GetPrice(ctx context.Context, id string) (*dto.PriceRespo
 	response.EntityID = price.EntityID
 
 	if price.MeterID != "" {
-		meterService := NewMeterService(s.MeterRepo)
+		meterService := NewMeterService(s.MeterRepo, s.AuditLogRepo, s.Logger)
 		meter, err := meterService.GetMeter(ctx, price.MeterID)
 		if err != nil {
 			s.Logger.WarnwCtx(ctx, "failed to fetch meter", "meter_id", price.MeterID, "error", err)
@@ -550,7 +553,7 @@ func (s *priceService) GetPricesByCostsheetID(ctx context.Context, costsheetID s
 }
 
 func (s *priceService) GetPrices(ctx context.Context, filter *types.PriceFilter) (*dto.ListPricesResponse, error) {
-	meterService := NewMeterService(s.MeterRepo)
+	meterService := NewMeterService(s.MeterRepo, s.AuditLogRepo, s.Logger)
 
 	// Validate expand fields
 	if err := filter.GetExpand().Validate(types.PriceExpandConfig); err != nil {
@@ -771,6 +774,7 @@ func (s *priceService) UpdatePrice(ctx context.Context, id string, req dto.Updat
 	if err != nil {
 		return nil, err
 	}
+	before := *existingPrice
 
 	// Validate price unit type cannot be changed
 	hasFIATFields := req.Amount != nil || len(req.Tiers) > 0
@@ -850,6 +854,7 @@ func (s *priceService) UpdatePrice(ctx context.Context, id string, req dto.Updat
 			if err := s.PriceRepo.Update(ctx, existingPrice); err != nil {
 				return err
 			}
+			s.recordAuditLog(ctx, types.AuditLogEntityTypePrice, existingPrice.ID, types.AuditLogActionUpdate, &before, existingPrice)
 
 			// Convert update request to create request - this handles all the field mapping
 			createReq := req.ToCreatePriceRequest(existingPrice)
@@ -920,6 +925,7 @@ func (s *priceService) UpdatePrice(ctx context.Context, id string, req dto.Updat
 		if err := s.PriceRepo.Update(ctx, existingPrice); err != nil {
 			return nil, err
 		}
+		s.recordAuditLog(ctx, types.AuditLogEntityTypePrice, existingPrice.ID, types.AuditLogActionUpdate, &before, existingPrice)
 
 		response := &dto.PriceResponse{Price: existingPrice}
 
@@ -968,12 +974,15 @@ func (s *priceService) DeletePrice(ctx context.Context, id string, req dto.Delet
 			Mark(ierr.ErrValidation)
 	}
 
+	before := *price
 	price.EndDate = &endDate
 
 	if err := s.PriceRepo.Update(ctx, price); err != nil {
 		return err
 	}
 
+	// Prices are deleted by terminating them, so the entry carries the new end date
+	s.recordAuditLog(ctx, types.AuditLogEntityTypePrice, price.ID, types.AuditLogActionDelete, &before, price)
 	return nil
 }
 
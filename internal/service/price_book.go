@@ -0,0 +1,559 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/pricebook"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// usageUnitAmountPrecision is the precision converted per-unit usage amounts are kept at,
+// matching the precision prices are stored with
+const usageUnitAmountPrecision = 8
+
+// PriceBookService manages price books and localizes catalog prices into other currencies
+type PriceBookService interface {
+	CreatePriceBook(ctx context.Context, req dto.CreatePriceBookRequest) (*dto.PriceBookResponse, error)
+	GetPriceBook(ctx context.Context, id string) (*dto.PriceBookResponse, error)
+	UpdatePriceBook(ctx context.Context, id string, req dto.UpdatePriceBookRequest) (*dto.PriceBookResponse, error)
+	DeletePriceBook(ctx context.Context, id string) error
+	ListPriceBooks(ctx context.Context, filter *types.PriceBookFilter) (*dto.ListPriceBooksResponse, error)
+
+	// PreviewPriceBook localizes the catalog prices covered by a price book into a currency
+	// without persisting anything
+	PreviewPriceBook(ctx context.Context, id string, req dto.PreviewPriceBookRequest) (*dto.PreviewPriceBookResponse, error)
+
+	// FindPriceBook returns the published price book that localizes a plan or addon from
+	// baseCurrency into currency, or nil when there is none. Books listing the entity
+	// explicitly win over books covering all entities, then the newest book wins.
+	FindPriceBook(ctx context.Context, entityType types.PriceEntityType, entityID, baseCurrency, currency string) (*pricebook.PriceBook, error)
+
+	// LocalizePrice converts a base currency price into currency using the book's override
+	// for the price or its conversion rule for the currency
+	LocalizePrice(ctx context.Context, book *pricebook.PriceBook, p *price.Price, currency string, at time.Time) (*dto.LocalizedPrice, error)
+
+	// LocalizeSubscriptionPrices builds subscription-scoped prices in the subscription currency
+	// for the prices of a plan or addon, using the matching price book. Returns nil when no
+	// price book localizes the entity into the subscription currency. The prices are not
+	// saved; they are created with the subscription line items that use them.
+	LocalizeSubscriptionPrices(ctx context.Context, sub *subscription.Subscription, entityType types.PriceEntityType, entityID string, prices []*dto.PriceResponse) ([]*dto.PriceResponse, error)
+}
+
+type priceBookService struct {
+	ServiceParams
+}
+
+// NewPriceBookService creates a new price book service
+func NewPriceBookService(params ServiceParams) PriceBookService {
+	return &priceBookService{
+		ServiceParams: params,
+	}
+}
+
+func (s *priceBookService) CreatePriceBook(ctx context.Context, req dto.CreatePriceBookRequest) (*dto.PriceBookResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	book := req.ToPriceBook(ctx)
+	if err := s.validateEntities(ctx, book); err != nil {
+		return nil, err
+	}
+
+	if err := s.PriceBookRepo.Create(ctx, book); err != nil {
+		return nil, err
+	}
+
+	s.recordAuditLog(ctx, types.AuditLogEntityTypePriceBook, book.ID, types.AuditLogActionCreate, nil, book)
+
+	return &dto.PriceBookResponse{PriceBook: book}, nil
+}
+
+func (s *priceBookService) GetPriceBook(ctx context.Context, id string) (*dto.PriceBookResponse, error) {
+	book, err := s.PriceBookRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PriceBookResponse{PriceBook: book}, nil
+}
+
+func (s *priceBookService) UpdatePriceBook(ctx context.Context, id string, req dto.UpdatePriceBookRequest) (*dto.PriceBookResponse, error) {
+	book, err := s.PriceBookRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(book.BaseCurrency); err != nil {
+		return nil, err
+	}
+
+	before := *book
+	req.ApplyTo(book)
+	if err := s.validateEntities(ctx, book); err != nil {
+		return nil, err
+	}
+
+	if err := s.PriceBookRepo.Update(ctx, book); err != nil {
+		return nil, err
+	}
+
+	s.recordAuditLog(ctx, types.AuditLogEntityTypePriceBook, id, types.AuditLogActionUpdate, &before, book)
+
+	return s.GetPriceBook(ctx, id)
+}
+
+func (s *priceBookService) DeletePriceBook(ctx context.Context, id string) error {
+	book, err := s.PriceBookRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.PriceBookRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.recordAuditLog(ctx, types.AuditLogEntityTypePriceBook, id, types.AuditLogActionDelete, book, nil)
+	return nil
+}
+
+func (s *priceBookService) ListPriceBooks(ctx context.Context, filter *types.PriceBookFilter) (*dto.ListPriceBooksResponse, error) {
+	if filter == nil {
+		filter = types.NewPriceBookFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewDefaultQueryFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	books, err := s.PriceBookRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.PriceBookRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := lo.Map(books, func(b *pricebook.PriceBook, _ int) *dto.PriceBookResponse {
+		return &dto.PriceBookResponse{PriceBook: b}
+	})
+
+	listResponse := types.NewListResponse(responses, total, filter.GetLimit(), filter.GetOffset())
+	return &listResponse, nil
+}
+
+// validateEntities checks that the plans and addons a book is restricted to exist
+func (s *priceBookService) validateEntities(ctx context.Context, book *pricebook.PriceBook) error {
+	for _, planID := range book.PlanIDs {
+		if _, err := s.PlanRepo.Get(ctx, planID); err != nil {
+			return err
+		}
+	}
+	for _, addonID := range book.AddonIDs {
+		if _, err := s.AddonRepo.GetByID(ctx, addonID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *priceBookService) PreviewPriceBook(ctx context.Context, id string, req dto.PreviewPriceBookRequest) (*dto.PreviewPriceBookResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	req.Currency = strings.ToLower(req.Currency)
+
+	book, err := s.PriceBookRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := s.getCatalogPrices(ctx, book, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.PreviewPriceBookResponse{
+		PriceBookID:  book.ID,
+		BaseCurrency: book.BaseCurrency,
+		Currency:     req.Currency,
+		Prices:       make([]*dto.LocalizedPrice, 0, len(prices)),
+	}
+
+	now := time.Now().UTC()
+	for _, p := range prices {
+		localized, err := s.LocalizePrice(ctx, book, p, req.Currency, now)
+		if err != nil {
+			if ierr.IsValidation(err) {
+				resp.SkippedPriceIDs = append(resp.SkippedPriceIDs, p.ID)
+				continue
+			}
+			return nil, err
+		}
+		resp.Prices = append(resp.Prices, localized)
+	}
+
+	return resp, nil
+}
+
+// getCatalogPrices returns the published base currency prices of the plans and addons a
+// book covers, narrowed to the plan or addon of the preview request
+func (s *priceBookService) getCatalogPrices(ctx context.Context, book *pricebook.PriceBook, req dto.PreviewPriceBookRequest) ([]*price.Price, error) {
+	type entityScope struct {
+		entityType types.PriceEntityType
+		entityIDs  []string
+	}
+
+	var scopes []entityScope
+	switch {
+	case req.PlanID != "":
+		if applies, _ := book.AppliesTo(types.PRICE_ENTITY_TYPE_PLAN, req.PlanID); !applies {
+			return nil, ierr.NewError("price book does not apply to plan").
+				WithHint("The plan is not listed in the price book").
+				WithReportableDetails(map[string]any{
+					"price_book_id": book.ID,
+					"plan_id":       req.PlanID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		scopes = append(scopes, entityScope{types.PRICE_ENTITY_TYPE_PLAN, []string{req.PlanID}})
+	case req.AddonID != "":
+		if applies, _ := book.AppliesTo(types.PRICE_ENTITY_TYPE_ADDON, req.AddonID); !applies {
+			return nil, ierr.NewError("price book does not apply to addon").
+				WithHint("The addon is not listed in the price book").
+				WithReportableDetails(map[string]any{
+					"price_book_id": book.ID,
+					"addon_id":      req.AddonID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		scopes = append(scopes, entityScope{types.PRICE_ENTITY_TYPE_ADDON, []string{req.AddonID}})
+	default:
+		scopes = append(scopes,
+			entityScope{types.PRICE_ENTITY_TYPE_PLAN, book.PlanIDs},
+			entityScope{types.PRICE_ENTITY_TYPE_ADDON, book.AddonIDs},
+		)
+	}
+
+	var result []*price.Price
+	for _, scope := range scopes {
+		filter := types.NewNoLimitPriceFilter().
+			WithEntityType(scope.entityType).
+			WithStatus(types.StatusPublished)
+		if len(scope.entityIDs) > 0 {
+			filter = filter.WithEntityIDs(scope.entityIDs)
+		}
+
+		prices, err := s.PriceRepo.List(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, lo.Filter(prices, func(p *price.Price, _ int) bool {
+			return types.IsMatchingCurrency(p.Currency, book.BaseCurrency)
+		})...)
+	}
+
+	return result, nil
+}
+
+func (s *priceBookService) FindPriceBook(ctx context.Context, entityType types.PriceEntityType, entityID, baseCurrency, currency string) (*pricebook.PriceBook, error) {
+	filter := types.NewNoLimitPriceBookFilter()
+	filter.BaseCurrency = baseCurrency
+	filter.QueryFilter.Status = lo.ToPtr(types.StatusPublished)
+
+	books, err := s.PriceBookRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*pricebook.PriceBook
+	explicit := make(map[string]bool)
+	for _, b := range books {
+		applies, isExplicit := b.AppliesTo(entityType, entityID)
+		if !applies || !b.SupportsCurrency(currency) {
+			continue
+		}
+		candidates = append(candidates, b)
+		explicit[b.ID] = isExplicit
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if explicit[candidates[i].ID] != explicit[candidates[j].ID] {
+			return explicit[candidates[i].ID]
+		}
+		return candidates[i].CreatedAt.After(candidates[j].CreatedAt)
+	})
+
+	return candidates[0], nil
+}
+
+func (s *priceBookService) LocalizePrice(ctx context.Context, book *pricebook.PriceBook, p *price.Price, currency string, at time.Time) (*dto.LocalizedPrice, error) {
+	if !types.IsMatchingCurrency(p.Currency, book.BaseCurrency) {
+		return nil, ierr.NewError("price is not in the price book base currency").
+			WithHint("Only base currency prices can be localized").
+			WithReportableDetails(map[string]any{
+				"price_id":      p.ID,
+				"currency":      p.Currency,
+				"base_currency": book.BaseCurrency,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	if p.PriceUnitType == types.PRICE_UNIT_TYPE_CUSTOM {
+		return nil, ierr.NewError("prices in custom price units cannot be localized").
+			WithHint("Create the price in the target currency instead").
+			WithReportableDetails(map[string]any{
+				"price_id": p.ID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	localized := &dto.LocalizedPrice{
+		PriceID:      p.ID,
+		EntityType:   p.EntityType,
+		EntityID:     p.EntityID,
+		DisplayName:  p.DisplayName,
+		Type:         p.Type,
+		BillingModel: p.BillingModel,
+		BaseCurrency: p.Currency,
+		BaseAmount:   p.Amount,
+		Currency:     currency,
+		BaseTiers:    p.Tiers,
+	}
+
+	// The conversion rule is optional when an override sets every amount of the price
+	var conversion *types.FXConversion
+	var rounding *types.PriceRoundingPolicy
+	rule, hasRule := book.GetCurrency(currency)
+	if hasRule {
+		var err error
+		conversion, err = s.getConversion(ctx, book.BaseCurrency, rule, at)
+		if err != nil {
+			return nil, err
+		}
+		rounding = rule.Rounding
+		localized.Rate = lo.ToPtr(conversion.Rate)
+		localized.Source = types.LocalizedPriceSourceFixedRate
+		if rule.RateType == types.PriceBookRateTypeFX {
+			localized.Source = types.LocalizedPriceSourceFXRate
+		}
+	}
+
+	override, hasOverride := book.GetOverride(p.ID, currency)
+	missingRate := func() error {
+		return ierr.NewError("price book cannot localize price").
+			WithHintf("Add a conversion rule for %s or an override for the price", currency).
+			WithReportableDetails(map[string]any{
+				"price_book_id": book.ID,
+				"price_id":      p.ID,
+				"currency":      currency,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	// Amount
+	switch {
+	case hasOverride && override.Amount != nil:
+		localized.Amount = *override.Amount
+		localized.Source = types.LocalizedPriceSourceOverride
+	case conversion != nil:
+		localized.Amount = s.convertAmount(p.Amount, p.Type, conversion, rounding)
+	case p.Amount.IsZero():
+		localized.Amount = decimal.Zero
+	default:
+		return nil, missingRate()
+	}
+
+	// Tiers
+	if len(p.Tiers) > 0 {
+		switch {
+		case hasOverride && len(override.Tiers) > 0:
+			if len(override.Tiers) != len(p.Tiers) {
+				return nil, ierr.NewError("override tiers do not match price tiers").
+					WithHint("Override tiers must match the tiers of the price in number and order").
+					WithReportableDetails(map[string]any{
+						"price_id":       p.ID,
+						"currency":       currency,
+						"price_tiers":    len(p.Tiers),
+						"override_tiers": len(override.Tiers),
+					}).
+					Mark(ierr.ErrValidation)
+			}
+			localized.Tiers = make([]price.PriceTier, len(p.Tiers))
+			for i, tier := range p.Tiers {
+				localized.Tiers[i] = price.PriceTier{
+					UpTo:       tier.UpTo,
+					UnitAmount: override.Tiers[i].UnitAmount,
+					FlatAmount: override.Tiers[i].FlatAmount,
+				}
+			}
+			localized.Source = types.LocalizedPriceSourceOverride
+		case conversion != nil:
+			localized.Tiers = make([]price.PriceTier, len(p.Tiers))
+			for i, tier := range p.Tiers {
+				localized.Tiers[i] = price.PriceTier{
+					UpTo:       tier.UpTo,
+					UnitAmount: tier.UnitAmount.Mul(conversion.Rate).Round(usageUnitAmountPrecision),
+				}
+				if tier.FlatAmount != nil {
+					localized.Tiers[i].FlatAmount = lo.ToPtr(rounding.Apply(tier.FlatAmount.Mul(conversion.Rate), currency))
+				}
+			}
+		default:
+			return nil, missingRate()
+		}
+	}
+
+	if localized.Source == types.LocalizedPriceSourceOverride {
+		localized.Rate = nil
+	}
+
+	return localized, nil
+}
+
+// getConversion returns the conversion from the base currency for a currency rule
+func (s *priceBookService) getConversion(ctx context.Context, baseCurrency string, rule *types.PriceBookCurrency, at time.Time) (*types.FXConversion, error) {
+	if rule.RateType == types.PriceBookRateTypeFixed {
+		return &types.FXConversion{
+			FromCurrency: baseCurrency,
+			ToCurrency:   rule.Currency,
+			Rate:         lo.FromPtr(rule.Rate),
+			EffectiveAt:  at,
+		}, nil
+	}
+
+	if s.FXRateRepo == nil {
+		return nil, ierr.NewError("fx rates are not available").
+			WithHint("Use a fixed rate for this currency").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	return NewFXRateService(s.ServiceParams).GetConversion(ctx, baseCurrency, rule.Currency, at)
+}
+
+// convertAmount converts a price amount. Fixed price amounts follow the rounding policy,
+// usage amounts are per unit and only keep the stored precision.
+func (s *priceBookService) convertAmount(amount decimal.Decimal, priceType types.PriceType, conversion *types.FXConversion, rounding *types.PriceRoundingPolicy) decimal.Decimal {
+	converted := amount.Mul(conversion.Rate)
+	if priceType == types.PRICE_TYPE_FIXED {
+		return rounding.Apply(converted, conversion.ToCurrency)
+	}
+	return converted.Round(usageUnitAmountPrecision)
+}
+
+func (s *priceBookService) LocalizeSubscriptionPrices(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	entityType types.PriceEntityType,
+	entityID string,
+	prices []*dto.PriceResponse,
+) ([]*dto.PriceResponse, error) {
+	if len(prices) == 0 {
+		return nil, nil
+	}
+
+	// Localize from the currency the entity is priced in. Entities priced in several
+	// currencies are covered by books for any of them, in price order.
+	var book *pricebook.PriceBook
+	for _, baseCurrency := range lo.Uniq(lo.Map(prices, func(p *dto.PriceResponse, _ int) string { return p.Currency })) {
+		found, err := s.FindPriceBook(ctx, entityType, entityID, baseCurrency, sub.Currency)
+		if err != nil {
+			return nil, err
+		}
+		if found != nil {
+			book = found
+			break
+		}
+	}
+	if book == nil {
+		return nil, nil
+	}
+
+	priceService := &priceService{ServiceParams: s.ServiceParams}
+	now := time.Now().UTC()
+	result := make([]*dto.PriceResponse, 0, len(prices))
+
+	for _, p := range prices {
+		if !types.IsMatchingCurrency(p.Currency, book.BaseCurrency) {
+			continue
+		}
+
+		localized, err := s.LocalizePrice(ctx, book, p.Price, sub.Currency, now)
+		if err != nil {
+			return nil, err
+		}
+
+		createPriceReq := dto.CreatePriceRequest{
+			Amount:               lo.ToPtr(localized.Amount),
+			Currency:             sub.Currency,
+			EntityType:           types.PRICE_ENTITY_TYPE_SUBSCRIPTION,
+			EntityID:             sub.ID,
+			Type:                 p.Type,
+			PriceUnitType:        p.PriceUnitType,
+			BillingPeriod:        p.BillingPeriod,
+			BillingPeriodCount:   p.BillingPeriodCount,
+			BillingModel:         p.BillingModel,
+			MeterID:              p.MeterID,
+			InvoiceCadence:       p.InvoiceCadence,
+			TrialPeriodDays:      p.TrialPeriodDays,
+			Description:          p.Description,
+			Metadata:             lo.Assign(p.Metadata, map[string]string{"price_book_id": book.ID}),
+			TierMode:             p.TierMode,
+			DisplayName:          p.DisplayName,
+			ParentPriceID:        p.GetRootPriceID(),
+			SkipEntityValidation: true,
+		}
+		if p.BillingModel == types.BILLING_MODEL_PACKAGE && p.TransformQuantity != (price.JSONBTransformQuantity{}) {
+			transformQuantity := price.TransformQuantity(p.TransformQuantity)
+			createPriceReq.TransformQuantity = &transformQuantity
+		}
+		if len(localized.Tiers) > 0 {
+			createPriceReq.Tiers = make([]dto.CreatePriceTier, len(localized.Tiers))
+			for i, tier := range localized.Tiers {
+				createPriceReq.Tiers[i] = dto.CreatePriceTier{
+					UpTo:       tier.UpTo,
+					UnitAmount: tier.UnitAmount,
+					FlatAmount: tier.FlatAmount,
+				}
+			}
+		}
+
+		if err := createPriceReq.Validate(); err != nil {
+			return nil, err
+		}
+		localizedPrice, err := priceService.preparePriceForCreation(ctx, &createPriceReq)
+		if err != nil {
+			return nil, err
+		}
+
+		// Keep the expansions line item creation relies on
+		result = append(result, &dto.PriceResponse{Price: localizedPrice, Meter: p.Meter})
+	}
+
+	s.Logger.Infow("localized prices for subscription",
+		"subscription_id", sub.ID,
+		"price_book_id", book.ID,
+		"entity_id", entityID,
+		"currency", sub.Currency,
+		"price_count", len(result))
+
+	return result, nil
+}
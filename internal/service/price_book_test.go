@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/fxrate"
+	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/domain/plan"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type PriceBookServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	params  ServiceParams
+	service PriceBookService
+	ctx     context.Context
+}
+
+func TestPriceBookService(t *testing.T) {
+	suite.Run(t, new(PriceBookServiceSuite))
+}
+
+func (s *PriceBookServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.ctx = types.SetEnvironmentID(s.GetContext(), "env_test")
+
+	stores := s.GetStores()
+	s.params = ServiceParams{
+		Logger:         s.GetLogger(),
+		Config:         s.GetConfig(),
+		DB:             s.GetDB(),
+		PlanRepo:       stores.PlanRepo,
+		PriceRepo:      stores.PriceRepo,
+		MeterRepo:      stores.MeterRepo,
+		PriceUnitRepo:  stores.PriceUnitRepo,
+		FXRateRepo:     stores.FXRateRepo,
+		PriceBookRepo:  stores.PriceBookRepo,
+		EventPublisher: s.GetPublisher(),
+	}
+	s.service = NewPriceBookService(s.params)
+
+	s.NoError(stores.PlanRepo.Create(s.ctx, &plan.Plan{
+		ID:            "plan_pb",
+		Name:          "Pro",
+		EnvironmentID: "env_test",
+		BaseModel:     types.GetDefaultBaseModel(s.ctx),
+	}))
+	s.NoError(stores.MeterRepo.CreateMeter(s.ctx, &meter.Meter{
+		ID:            "meter_pb",
+		Name:          "API Calls",
+		EventName:     "api_call",
+		Aggregation:   meter.Aggregation{Type: types.AggregationCount},
+		EnvironmentID: "env_test",
+		BaseModel:     types.GetDefaultBaseModel(s.ctx),
+	}))
+	s.createPrice("price_pb_fixed", decimal.NewFromFloat(23.40), nil)
+	s.createPrice("price_pb_tiered", decimal.Zero, []price.PriceTier{
+		{UpTo: lo.ToPtr(uint64(100)), UnitAmount: decimal.NewFromFloat(0.015), FlatAmount: lo.ToPtr(decimal.NewFromInt(5))},
+		{UnitAmount: decimal.NewFromFloat(0.01)},
+	})
+}
+
+func (s *PriceBookServiceSuite) createPrice(id string, amount decimal.Decimal, tiers []price.PriceTier) {
+	p := &price.Price{
+		ID:                 id,
+		Amount:             amount,
+		Currency:           "usd",
+		EntityType:         types.PRICE_ENTITY_TYPE_PLAN,
+		EntityID:           "plan_pb",
+		Type:               types.PRICE_TYPE_FIXED,
+		PriceUnitType:      types.PRICE_UNIT_TYPE_FIAT,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceAdvance,
+		EnvironmentID:      "env_test",
+		BaseModel:          types.GetDefaultBaseModel(s.ctx),
+	}
+	if len(tiers) > 0 {
+		p.Type = types.PRICE_TYPE_USAGE
+		p.MeterID = "meter_pb"
+		p.BillingModel = types.BILLING_MODEL_TIERED
+		p.TierMode = types.BILLING_TIER_SLAB
+		p.InvoiceCadence = types.InvoiceCadenceArrear
+		p.Tiers = tiers
+	}
+	s.NoError(s.GetStores().PriceRepo.Create(s.ctx, p))
+}
+
+func (s *PriceBookServiceSuite) TestRoundingPolicy() {
+	tests := []struct {
+		name     string
+		policy   *types.PriceRoundingPolicy
+		amount   float64
+		expected float64
+	}{
+		{"no policy rounds to precision", nil, 21.52799, 21.53},
+		{"charm", &types.PriceRoundingPolicy{Mode: types.PriceRoundingModeCharm}, 21.528, 21.99},
+		{"charm on exact ending", &types.PriceRoundingPolicy{Mode: types.PriceRoundingModeCharm}, 21.99, 21.99},
+		{"charm above ending", &types.PriceRoundingPolicy{Mode: types.PriceRoundingModeCharm, Ending: lo.ToPtr(decimal.NewFromFloat(0.49))}, 21.5, 22.49},
+		{"nearest increment", &types.PriceRoundingPolicy{Mode: types.PriceRoundingModeNearest, Increment: lo.ToPtr(decimal.NewFromFloat(0.05))}, 21.528, 21.55},
+		{"up", &types.PriceRoundingPolicy{Mode: types.PriceRoundingModeUp}, 21.01, 22},
+		{"down", &types.PriceRoundingPolicy{Mode: types.PriceRoundingModeDown, Increment: lo.ToPtr(decimal.NewFromInt(10))}, 21.99, 20},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			got := tt.policy.Apply(decimal.NewFromFloat(tt.amount), "eur")
+			s.True(got.Equal(decimal.NewFromFloat(tt.expected)), "got %s", got)
+		})
+	}
+}
+
+func (s *PriceBookServiceSuite) TestPreviewPriceBook() {
+	now := time.Now().UTC()
+	s.NoError(s.GetStores().FXRateRepo.Create(s.ctx, &fxrate.FXRate{
+		ID:            s.GetUUID(),
+		BaseCurrency:  "usd",
+		QuoteCurrency: "gbp",
+		Rate:          decimal.NewFromFloat(0.80),
+		EffectiveAt:   now.Add(-time.Hour),
+		Source:        types.FXRateSourceManual,
+		BaseModel:     types.GetDefaultBaseModel(s.ctx),
+	}))
+
+	book, err := s.service.CreatePriceBook(s.ctx, dto.CreatePriceBookRequest{
+		Name:         "Europe",
+		BaseCurrency: "USD",
+		PlanIDs:      []string{"plan_pb"},
+		Currencies: []types.PriceBookCurrency{
+			{
+				Currency: "EUR",
+				RateType: types.PriceBookRateTypeFixed,
+				Rate:     lo.ToPtr(decimal.NewFromFloat(0.92)),
+				Rounding: &types.PriceRoundingPolicy{Mode: types.PriceRoundingModeCharm},
+			},
+			{Currency: "gbp", RateType: types.PriceBookRateTypeFX},
+		},
+		Overrides: []types.PriceBookPriceOverride{
+			{PriceID: "price_pb_fixed", Currency: "gbp", Amount: lo.ToPtr(decimal.NewFromInt(19))},
+		},
+	})
+	s.NoError(err)
+
+	// EUR: 23.40 * 0.92 = 21.528, charm rounded to 21.99. Usage unit amounts keep their precision.
+	preview, err := s.service.PreviewPriceBook(s.ctx, book.ID, dto.PreviewPriceBookRequest{Currency: "EUR"})
+	s.NoError(err)
+	s.Len(preview.Prices, 2)
+	prices := lo.KeyBy(preview.Prices, func(p *dto.LocalizedPrice) string { return p.PriceID })
+
+	fixed := prices["price_pb_fixed"]
+	s.True(fixed.Amount.Equal(decimal.NewFromFloat(21.99)), "amount %s", fixed.Amount)
+	s.Equal(types.LocalizedPriceSourceFixedRate, fixed.Source)
+
+	tiered := prices["price_pb_tiered"]
+	s.Len(tiered.Tiers, 2)
+	s.True(tiered.Tiers[0].UnitAmount.Equal(decimal.NewFromFloat(0.0138)), "unit %s", tiered.Tiers[0].UnitAmount)
+	s.True(tiered.Tiers[0].FlatAmount.Equal(decimal.NewFromFloat(4.99)), "flat %s", tiered.Tiers[0].FlatAmount)
+	s.Nil(tiered.Tiers[1].FlatAmount)
+
+	// GBP: the override wins for the fixed price, the FX rate converts the rest
+	preview, err = s.service.PreviewPriceBook(s.ctx, book.ID, dto.PreviewPriceBookRequest{Currency: "gbp", PlanID: "plan_pb"})
+	s.NoError(err)
+	prices = lo.KeyBy(preview.Prices, func(p *dto.LocalizedPrice) string { return p.PriceID })
+	s.True(prices["price_pb_fixed"].Amount.Equal(decimal.NewFromInt(19)))
+	s.Equal(types.LocalizedPriceSourceOverride, prices["price_pb_fixed"].Source)
+	s.Nil(prices["price_pb_fixed"].Rate)
+	s.Equal(types.LocalizedPriceSourceFXRate, prices["price_pb_tiered"].Source)
+	s.True(prices["price_pb_tiered"].Tiers[1].UnitAmount.Equal(decimal.NewFromFloat(0.008)))
+
+	// A currency without a rule or overrides cannot be localized
+	preview, err = s.service.PreviewPriceBook(s.ctx, book.ID, dto.PreviewPriceBookRequest{Currency: "jpy"})
+	s.NoError(err)
+	s.Empty(preview.Prices)
+	s.ElementsMatch([]string{"price_pb_fixed", "price_pb_tiered"}, preview.SkippedPriceIDs)
+}
+
+func (s *PriceBookServiceSuite) TestLocalizeSubscriptionPrices() {
+	generic, err := s.service.CreatePriceBook(s.ctx, dto.CreatePriceBookRequest{
+		Name:         "All plans",
+		BaseCurrency: "usd",
+		Currencies: []types.PriceBookCurrency{
+			{Currency: "eur", RateType: types.PriceBookRateTypeFixed, Rate: lo.ToPtr(decimal.NewFromInt(2))},
+		},
+	})
+	s.NoError(err)
+	explicit, err := s.service.CreatePriceBook(s.ctx, dto.CreatePriceBookRequest{
+		Name:         "Pro plan",
+		BaseCurrency: "usd",
+		PlanIDs:      []string{"plan_pb"},
+		Currencies: []types.PriceBookCurrency{
+			{Currency: "eur", RateType: types.PriceBookRateTypeFixed, Rate: lo.ToPtr(decimal.NewFromFloat(0.5))},
+		},
+	})
+	s.NoError(err)
+
+	found, err := s.service.FindPriceBook(s.ctx, types.PRICE_ENTITY_TYPE_PLAN, "plan_pb", "usd", "eur")
+	s.NoError(err)
+	s.Equal(explicit.ID, found.ID)
+	found, err = s.service.FindPriceBook(s.ctx, types.PRICE_ENTITY_TYPE_PLAN, "plan_other", "usd", "eur")
+	s.NoError(err)
+	s.Equal(generic.ID, found.ID)
+
+	planPrices, err := NewPriceService(s.params).GetPricesByPlanID(s.ctx, dto.GetPricesByPlanRequest{PlanID: "plan_pb"})
+	s.NoError(err)
+
+	sub := &subscription.Subscription{ID: "subs_pb", PlanID: "plan_pb", Currency: "eur"}
+	localized, err := s.service.LocalizeSubscriptionPrices(s.ctx, sub, types.PRICE_ENTITY_TYPE_PLAN, "plan_pb", planPrices.Items)
+	s.NoError(err)
+	s.Len(localized, 2)
+	for _, p := range localized {
+		s.Equal("eur", p.Currency)
+		s.Equal(types.PRICE_ENTITY_TYPE_SUBSCRIPTION, p.EntityType)
+		s.Equal(sub.ID, p.EntityID)
+		s.NotEmpty(p.ParentPriceID)
+		if p.ParentPriceID == "price_pb_fixed" {
+			s.True(p.Amount.Equal(decimal.NewFromFloat(11.70)), "amount %s", p.Amount)
+		}
+	}
+
+	// Localized prices are saved with the subscription, not when they are built
+	_, err = s.GetStores().PriceRepo.Get(s.ctx, localized[0].ID)
+	s.True(ierr.IsNotFound(err))
+
+	// No book localizes into jpy
+	sub.Currency = "jpy"
+	localized, err = s.service.LocalizeSubscriptionPrices(s.ctx, sub, types.PRICE_ENTITY_TYPE_PLAN, "plan_pb", planPrices.Items)
+	s.NoError(err)
+	s.Nil(localized)
+}
+
+func TestPricesWithoutCurrencyCounterpart(t *testing.T) {
+	newPrice := func(id, currency string, priceType types.PriceType, meterID string) *dto.PriceResponse {
+		return &dto.PriceResponse{Price: &price.Price{
+			ID:                 id,
+			Currency:           currency,
+			Type:               priceType,
+			MeterID:            meterID,
+			BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+			BillingPeriodCount: 1,
+		}}
+	}
+
+	prices := []*dto.PriceResponse{
+		newPrice("price_fixed_usd", "usd", types.PRICE_TYPE_FIXED, ""),
+		newPrice("price_fixed_eur", "eur", types.PRICE_TYPE_FIXED, ""),
+		newPrice("price_api_usd", "usd", types.PRICE_TYPE_USAGE, "meter_api"),
+	}
+
+	unlocalized := pricesWithoutCurrencyCounterpart(prices, "eur")
+	assert.Equal(t, []string{"price_api_usd"}, lo.Map(unlocalized, func(p *dto.PriceResponse, _ int) string { return p.ID }))
+	assert.Empty(t, pricesWithoutCurrencyCounterpart(prices, "usd"))
+}
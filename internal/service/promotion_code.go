@@ -0,0 +1,419 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/coupon"
+	"github.com/flexprice/flexprice/internal/domain/promotion_code"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// PromotionCodeService manages the customer-facing codes that redeem coupons
+type PromotionCodeService interface {
+	CreatePromotionCode(ctx context.Context, req dto.CreatePromotionCodeRequest) (*dto.PromotionCodeResponse, error)
+	GetPromotionCode(ctx context.Context, id string) (*dto.PromotionCodeResponse, error)
+	UpdatePromotionCode(ctx context.Context, id string, req dto.UpdatePromotionCodeRequest) (*dto.PromotionCodeResponse, error)
+	DeletePromotionCode(ctx context.Context, id string) error
+	ListPromotionCodes(ctx context.Context, filter *types.PromotionCodeFilter) (*dto.ListPromotionCodesResponse, error)
+
+	// ValidatePromotionCode checks whether a code can be redeemed without redeeming it.
+	// Rule violations are reported in the response rather than as errors.
+	ValidatePromotionCode(ctx context.Context, req dto.ValidatePromotionCodeRequest) (*dto.ValidatePromotionCodeResponse, error)
+
+	// ResolvePromotionCode returns a code and its coupon if customerID can redeem it for a
+	// subscription in currency. excludeSubscriptionID is the subscription the code is being
+	// redeemed on, which does not make the customer a returning one.
+	ResolvePromotionCode(ctx context.Context, code, customerID, currency, excludeSubscriptionID string) (*promotion_code.PromotionCode, *coupon.Coupon, error)
+}
+
+type promotionCodeService struct {
+	ServiceParams
+}
+
+// NewPromotionCodeService creates a new promotion code service
+func NewPromotionCodeService(params ServiceParams) PromotionCodeService {
+	return &promotionCodeService{
+		ServiceParams: params,
+	}
+}
+
+func (s *promotionCodeService) CreatePromotionCode(ctx context.Context, req dto.CreatePromotionCodeRequest) (*dto.PromotionCodeResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	c, err := s.CouponRepo.Get(ctx, req.CouponID)
+	if err != nil {
+		return nil, err
+	}
+	if c.Status != types.StatusPublished {
+		return nil, ierr.NewError("coupon is not published").
+			WithHint("Promotion codes can only be created for published coupons").
+			WithReportableDetails(map[string]interface{}{
+				"coupon_id": c.ID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	p := req.ToPromotionCode(ctx)
+	if err := s.PromotionCodeRepo.Create(ctx, p); err != nil {
+		return nil, err
+	}
+
+	s.recordAuditLog(ctx, types.AuditLogEntityTypePromotionCode, p.ID, types.AuditLogActionCreate, nil, p)
+
+	return s.toPromotionCodeResponse(p, c), nil
+}
+
+func (s *promotionCodeService) GetPromotionCode(ctx context.Context, id string) (*dto.PromotionCodeResponse, error) {
+	p, err := s.PromotionCodeRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.CouponRepo.Get(ctx, p.CouponID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toPromotionCodeResponse(p, c), nil
+}
+
+func (s *promotionCodeService) UpdatePromotionCode(ctx context.Context, id string, req dto.UpdatePromotionCodeRequest) (*dto.PromotionCodeResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	p, err := s.PromotionCodeRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	before := *p
+
+	if req.Active != nil {
+		p.Active = *req.Active
+	}
+	if req.MaxRedemptions != nil {
+		p.MaxRedemptions = req.MaxRedemptions
+	}
+	if req.MaxRedemptionsPerCustomer != nil {
+		p.MaxRedemptionsPerCustomer = req.MaxRedemptionsPerCustomer
+	}
+	if req.ExpiresAt != nil {
+		p.ExpiresAt = req.ExpiresAt
+	}
+	if req.Metadata != nil {
+		p.Metadata = req.Metadata
+	}
+
+	if err := s.PromotionCodeRepo.Update(ctx, p); err != nil {
+		return nil, err
+	}
+
+	s.recordAuditLog(ctx, types.AuditLogEntityTypePromotionCode, id, types.AuditLogActionUpdate, &before, p)
+
+	return s.GetPromotionCode(ctx, id)
+}
+
+func (s *promotionCodeService) DeletePromotionCode(ctx context.Context, id string) error {
+	p, err := s.PromotionCodeRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.PromotionCodeRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.recordAuditLog(ctx, types.AuditLogEntityTypePromotionCode, id, types.AuditLogActionDelete, p, nil)
+	return nil
+}
+
+func (s *promotionCodeService) ListPromotionCodes(ctx context.Context, filter *types.PromotionCodeFilter) (*dto.ListPromotionCodesResponse, error) {
+	if filter == nil {
+		filter = types.NewPromotionCodeFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewDefaultQueryFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	codes, err := s.PromotionCodeRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.PromotionCodeRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	couponFilter := types.NewNoLimitCouponFilter()
+	couponFilter.CouponIDs = lo.Uniq(lo.Map(codes, func(p *promotion_code.PromotionCode, _ int) string {
+		return p.CouponID
+	}))
+	couponsByID := make(map[string]*coupon.Coupon)
+	if len(couponFilter.CouponIDs) > 0 {
+		coupons, err := s.CouponRepo.List(ctx, couponFilter)
+		if err != nil {
+			return nil, err
+		}
+		couponsByID = lo.KeyBy(coupons, func(c *coupon.Coupon) string { return c.ID })
+	}
+
+	responses := make([]*dto.PromotionCodeResponse, len(codes))
+	for i, p := range codes {
+		responses[i] = s.toPromotionCodeResponse(p, couponsByID[p.CouponID])
+	}
+
+	listResponse := types.NewListResponse(responses, total, filter.GetLimit(), filter.GetOffset())
+	return &listResponse, nil
+}
+
+func (s *promotionCodeService) ValidatePromotionCode(ctx context.Context, req dto.ValidatePromotionCodeRequest) (*dto.ValidatePromotionCodeResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	p, c, err := s.resolve(ctx, req.Code, req.CustomerID, req.Currency, "")
+	if err == nil && req.Amount != nil {
+		err = checkPromotionCodeMinimumAmount(p, *req.Amount, req.Currency)
+	}
+	if err != nil {
+		var validationErr *CouponValidationError
+		if !errors.As(err, &validationErr) {
+			return nil, err
+		}
+		response := &dto.ValidatePromotionCodeResponse{
+			Valid:     false,
+			ErrorCode: validationErr.Code,
+			Message:   validationErr.Message,
+		}
+		if p != nil {
+			response.PromotionCode = s.toPromotionCodeResponse(p, c)
+		}
+		return response, nil
+	}
+
+	response := &dto.ValidatePromotionCodeResponse{
+		Valid:         true,
+		PromotionCode: s.toPromotionCodeResponse(p, c),
+	}
+	if req.Amount != nil {
+		result := c.ApplyDiscount(*req.Amount, req.Currency)
+		response.Discount = lo.ToPtr(result.Discount)
+		response.FinalAmount = lo.ToPtr(result.FinalPrice)
+	}
+	return response, nil
+}
+
+func (s *promotionCodeService) ResolvePromotionCode(ctx context.Context, code, customerID, currency, excludeSubscriptionID string) (*promotion_code.PromotionCode, *coupon.Coupon, error) {
+	p, c, err := s.resolve(ctx, code, customerID, currency, excludeSubscriptionID)
+	if err != nil {
+		var validationErr *CouponValidationError
+		if errors.As(err, &validationErr) {
+			return nil, nil, ierr.WithError(err).
+				WithHint(validationErr.Message).
+				WithReportableDetails(map[string]interface{}{
+					"code":       code,
+					"error_code": validationErr.Code,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		return nil, nil, err
+	}
+	return p, c, nil
+}
+
+// resolve looks up a code and runs every redemption check that does not need an order
+// amount. Rule violations are returned as *CouponValidationError, along with the code and
+// coupon when they were found.
+func (s *promotionCodeService) resolve(ctx context.Context, code, customerID, currency, excludeSubscriptionID string) (*promotion_code.PromotionCode, *coupon.Coupon, error) {
+	p, err := s.PromotionCodeRepo.GetByCode(ctx, code)
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			return nil, nil, &CouponValidationError{
+				Code:    types.CouponValidationErrorCodePromotionCodeNotFound,
+				Message: "Promotion code not found",
+				Details: map[string]interface{}{
+					"code": code,
+				},
+			}
+		}
+		return nil, nil, err
+	}
+
+	c, err := s.CouponRepo.Get(ctx, p.CouponID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := checkPromotionCode(p, time.Now().UTC()); err != nil {
+		return p, c, err
+	}
+
+	// Coupon level rules: published, redeem window and total redemptions
+	if err := NewCouponValidationService(s.ServiceParams).ValidateCoupon(ctx, *c, nil); err != nil {
+		return p, c, err
+	}
+	if currency != "" && c.Currency != "" && !types.IsMatchingCurrency(c.Currency, currency) {
+		return p, c, &CouponValidationError{
+			Code:    types.CouponValidationErrorCodeCurrencyMismatch,
+			Message: "Coupon currency does not match target currency",
+			Details: map[string]interface{}{
+				"coupon_id":       c.ID,
+				"coupon_currency": c.Currency,
+				"target_currency": currency,
+			},
+		}
+	}
+
+	if customerID != "" {
+		if err := s.checkCustomerEligibility(ctx, p, customerID, excludeSubscriptionID); err != nil {
+			return p, c, err
+		}
+	}
+
+	return p, c, nil
+}
+
+// checkCustomerEligibility enforces the per-customer redemption limit and the first-time
+// customer restriction of a code
+func (s *promotionCodeService) checkCustomerEligibility(ctx context.Context, p *promotion_code.PromotionCode, customerID, excludeSubscriptionID string) error {
+	if p.MaxRedemptionsPerCustomer == nil && !p.FirstTimeCustomerOnly {
+		return nil
+	}
+
+	subFilter := types.NewNoLimitSubscriptionFilter()
+	subFilter.CustomerID = customerID
+	subs, err := s.SubRepo.List(ctx, subFilter)
+	if err != nil {
+		return err
+	}
+	subscriptionIDs := make([]string, 0, len(subs))
+	for _, sub := range subs {
+		if sub.ID != excludeSubscriptionID {
+			subscriptionIDs = append(subscriptionIDs, sub.ID)
+		}
+	}
+
+	if p.MaxRedemptionsPerCustomer != nil && len(subscriptionIDs) > 0 {
+		associationFilter := types.NewNoLimitCouponAssociationFilter()
+		associationFilter.SubscriptionIDs = subscriptionIDs
+		associationFilter.PromotionCodeIDs = []string{p.ID}
+		redeemed, err := s.CouponAssociationRepo.Count(ctx, associationFilter)
+		if err != nil {
+			return err
+		}
+		if redeemed >= *p.MaxRedemptionsPerCustomer {
+			return &CouponValidationError{
+				Code:    types.CouponValidationErrorCodeCustomerRedemptionLimitReached,
+				Message: "Customer has reached the maximum redemptions of this promotion code",
+				Details: map[string]interface{}{
+					"promotion_code_id":            p.ID,
+					"customer_id":                  customerID,
+					"max_redemptions_per_customer": *p.MaxRedemptionsPerCustomer,
+				},
+			}
+		}
+	}
+
+	if p.FirstTimeCustomerOnly {
+		returning := len(subscriptionIDs) > 0
+		if !returning {
+			invoiceFilter := types.NewNoLimitInvoiceFilter()
+			invoiceFilter.CustomerID = customerID
+			invoices, err := s.InvoiceRepo.Count(ctx, invoiceFilter)
+			if err != nil {
+				return err
+			}
+			returning = invoices > 0
+		}
+		if returning {
+			return &CouponValidationError{
+				Code:    types.CouponValidationErrorCodeFirstTimeCustomerOnly,
+				Message: "Promotion code is only available to first-time customers",
+				Details: map[string]interface{}{
+					"promotion_code_id": p.ID,
+					"customer_id":       customerID,
+				},
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkPromotionCode runs the checks that only depend on the code itself
+func checkPromotionCode(p *promotion_code.PromotionCode, now time.Time) error {
+	if !p.Active {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodePromotionCodeInactive,
+			Message: "Promotion code is not active",
+			Details: map[string]interface{}{
+				"promotion_code_id": p.ID,
+			},
+		}
+	}
+
+	if p.IsExpired(now) {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodePromotionCodeExpired,
+			Message: "Promotion code has expired",
+			Details: map[string]interface{}{
+				"promotion_code_id": p.ID,
+				"expires_at":        p.ExpiresAt,
+			},
+		}
+	}
+
+	if p.IsExhausted() {
+		return &CouponValidationError{
+			Code:    types.CouponValidationErrorCodePromotionCodeLimitReached,
+			Message: "Promotion code has reached maximum redemptions",
+			Details: map[string]interface{}{
+				"promotion_code_id": p.ID,
+				"max_redemptions":   *p.MaxRedemptions,
+				"times_redeemed":    p.TimesRedeemed,
+			},
+		}
+	}
+
+	return nil
+}
+
+// checkPromotionCodeMinimumAmount checks an order amount against the minimum of a code
+func checkPromotionCodeMinimumAmount(p *promotion_code.PromotionCode, amount decimal.Decimal, currency string) error {
+	if p.MeetsMinimumAmount(amount, currency) {
+		return nil
+	}
+
+	return &CouponValidationError{
+		Code:    types.CouponValidationErrorCodeMinimumAmountNotMet,
+		Message: "Order amount does not meet the minimum amount of the promotion code",
+		Details: map[string]interface{}{
+			"promotion_code_id":       p.ID,
+			"minimum_amount":          p.MinimumAmount,
+			"minimum_amount_currency": p.MinimumAmountCurrency,
+			"amount":                  amount,
+			"currency":                currency,
+		},
+	}
+}
+
+func (s *promotionCodeService) toPromotionCodeResponse(p *promotion_code.PromotionCode, c *coupon.Coupon) *dto.PromotionCodeResponse {
+	return &dto.PromotionCodeResponse{
+		PromotionCode: p,
+		Coupon:        dto.NewCouponResponse(c),
+	}
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/coupon"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type PromotionCodeServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service    PromotionCodeService
+	params     ServiceParams
+	ctx        context.Context
+	couponID   string
+	customerID string
+}
+
+func TestPromotionCodeService(t *testing.T) {
+	suite.Run(t, new(PromotionCodeServiceSuite))
+}
+
+func (s *PromotionCodeServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.ctx = s.GetContext()
+	s.params = ServiceParams{
+		Logger:                s.GetLogger(),
+		Config:                s.GetConfig(),
+		DB:                    s.GetDB(),
+		SubRepo:               s.GetStores().SubscriptionRepo,
+		InvoiceRepo:           s.GetStores().InvoiceRepo,
+		CustomerRepo:          s.GetStores().CustomerRepo,
+		CouponRepo:            s.GetStores().CouponRepo,
+		CouponAssociationRepo: s.GetStores().CouponAssociationRepo,
+		CouponApplicationRepo: s.GetStores().CouponApplicationRepo,
+		PromotionCodeRepo:     s.GetStores().PromotionCodeRepo,
+	}
+	s.service = NewPromotionCodeService(s.params)
+
+	s.couponID = "coupon-10-off"
+	s.customerID = "cust-1"
+	s.NoError(s.GetStores().CouponRepo.Create(s.ctx, &coupon.Coupon{
+		ID:            s.couponID,
+		Name:          "10% off",
+		Type:          types.CouponTypePercentage,
+		Cadence:       types.CouponCadenceForever,
+		PercentageOff: lo.ToPtr(decimal.NewFromInt(10)),
+		Currency:      "usd",
+		EnvironmentID: types.GetEnvironmentID(s.ctx),
+		BaseModel:     types.GetDefaultBaseModel(s.ctx),
+	}))
+}
+
+func (s *PromotionCodeServiceSuite) createCode(req dto.CreatePromotionCodeRequest) *dto.PromotionCodeResponse {
+	req.CouponID = s.couponID
+	resp, err := s.service.CreatePromotionCode(s.ctx, req)
+	s.NoError(err)
+	return resp
+}
+
+// redeem creates a subscription for the customer and redeems the code on it
+func (s *PromotionCodeServiceSuite) redeem(code string) error {
+	sub := &subscription.Subscription{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION),
+		CustomerID:    s.customerID,
+		Currency:      "usd",
+		StartDate:     time.Now().UTC(),
+		EnvironmentID: types.GetEnvironmentID(s.ctx),
+		BaseModel:     types.GetDefaultBaseModel(s.ctx),
+	}
+	s.NoError(s.GetStores().SubscriptionRepo.Create(s.ctx, sub))
+
+	p, c, err := s.service.ResolvePromotionCode(s.ctx, code, s.customerID, sub.Currency, sub.ID)
+	if err != nil {
+		return err
+	}
+	_, err = NewCouponAssociationService(s.params).CreateCouponAssociation(s.ctx, dto.CreateCouponAssociationRequest{
+		CouponID:        c.ID,
+		SubscriptionID:  sub.ID,
+		PromotionCodeID: lo.ToPtr(p.ID),
+		StartDate:       sub.StartDate,
+	})
+	return err
+}
+
+func (s *PromotionCodeServiceSuite) validate(code string, amount *decimal.Decimal) *dto.ValidatePromotionCodeResponse {
+	resp, err := s.service.ValidatePromotionCode(s.ctx, dto.ValidatePromotionCodeRequest{
+		Code:       code,
+		CustomerID: s.customerID,
+		Amount:     amount,
+		Currency:   lo.Ternary(amount != nil, "usd", ""),
+	})
+	s.NoError(err)
+	return resp
+}
+
+func (s *PromotionCodeServiceSuite) TestValidateAndRedeem() {
+	s.Run("codes match case-insensitively and preview the discount", func() {
+		s.createCode(dto.CreatePromotionCodeRequest{Code: "welcome10"})
+
+		resp := s.validate(" Welcome10 ", lo.ToPtr(decimal.NewFromInt(200)))
+		s.True(resp.Valid)
+		s.Equal("WELCOME10", resp.PromotionCode.Code)
+		s.True(resp.Discount.Equal(decimal.NewFromInt(20)))
+		s.True(resp.FinalAmount.Equal(decimal.NewFromInt(180)))
+	})
+
+	s.Run("unknown codes are reported, not returned as errors", func() {
+		resp := s.validate("NOPE", nil)
+		s.False(resp.Valid)
+		s.Equal(types.CouponValidationErrorCodePromotionCodeNotFound, resp.ErrorCode)
+	})
+
+	s.Run("one-time codes are exhausted after a redemption", func() {
+		s.createCode(dto.CreatePromotionCodeRequest{Code: "ONCE", MaxRedemptions: lo.ToPtr(1)})
+
+		s.NoError(s.redeem("ONCE"))
+		resp := s.validate("ONCE", nil)
+		s.False(resp.Valid)
+		s.Equal(types.CouponValidationErrorCodePromotionCodeLimitReached, resp.ErrorCode)
+		s.Equal(1, resp.PromotionCode.TimesRedeemed)
+	})
+
+	s.Run("redemptions past the limit are rejected when counted", func() {
+		code := s.createCode(dto.CreatePromotionCodeRequest{Code: "RACE", MaxRedemptions: lo.ToPtr(1)})
+
+		s.NoError(s.GetStores().PromotionCodeRepo.IncrementRedemptions(s.ctx, code.ID))
+		err := s.GetStores().PromotionCodeRepo.IncrementRedemptions(s.ctx, code.ID)
+		s.Error(err)
+		s.True(ierr.IsValidation(err))
+	})
+
+	s.Run("per customer limit counts the customer's redemptions", func() {
+		s.createCode(dto.CreatePromotionCodeRequest{Code: "TWICE", MaxRedemptionsPerCustomer: lo.ToPtr(2)})
+
+		s.NoError(s.redeem("TWICE"))
+		s.NoError(s.redeem("TWICE"))
+		err := s.redeem("TWICE")
+		s.Error(err)
+
+		resp := s.validate("TWICE", nil)
+		s.Equal(types.CouponValidationErrorCodeCustomerRedemptionLimitReached, resp.ErrorCode)
+	})
+
+	s.Run("first-time codes reject returning customers", func() {
+		s.createCode(dto.CreatePromotionCodeRequest{Code: "NEWBIE", FirstTimeCustomerOnly: true})
+
+		resp := s.validate("NEWBIE", nil)
+		s.False(resp.Valid)
+		s.Equal(types.CouponValidationErrorCodeFirstTimeCustomerOnly, resp.ErrorCode)
+	})
+
+	s.Run("minimum amount and deactivation", func() {
+		code := s.createCode(dto.CreatePromotionCodeRequest{
+			Code:                  "BIGSPENDER",
+			MinimumAmount:         lo.ToPtr(decimal.NewFromInt(100)),
+			MinimumAmountCurrency: lo.ToPtr("USD"),
+		})
+
+		resp := s.validate("BIGSPENDER", lo.ToPtr(decimal.NewFromInt(50)))
+		s.Equal(types.CouponValidationErrorCodeMinimumAmountNotMet, resp.ErrorCode)
+		s.True(s.validate("BIGSPENDER", lo.ToPtr(decimal.NewFromInt(100))).Valid)
+
+		_, err := s.service.UpdatePromotionCode(s.ctx, code.ID, dto.UpdatePromotionCodeRequest{Active: lo.ToPtr(false)})
+		s.NoError(err)
+		s.Equal(types.CouponValidationErrorCodePromotionCodeInactive, s.validate("BIGSPENDER", nil).ErrorCode)
+	})
+}
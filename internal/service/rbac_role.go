@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/rbac"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// RBACRoleService manages the custom roles of a tenant and the roles assigned to users and API keys
+type RBACRoleService interface {
+	CreateRole(ctx context.Context, req dto.CreateRBACRoleRequest) (*rbac.Role, error)
+	UpdateRole(ctx context.Context, id string, req dto.UpdateRBACRoleRequest) (*rbac.Role, error)
+	// DeleteRole archives a custom role. Users and API keys holding it lose its permissions.
+	DeleteRole(ctx context.Context, id string) error
+
+	// AssignUserRoles replaces the roles of a user. API keys created for the user afterwards get them.
+	AssignUserRoles(ctx context.Context, userID string, req dto.AssignRolesRequest) (*dto.AssignRolesResponse, error)
+	// AssignAPIKeyRoles replaces the roles of an API key
+	AssignAPIKeyRoles(ctx context.Context, secretID string, req dto.AssignRolesRequest) (*dto.SecretResponse, error)
+}
+
+type rbacRoleService struct {
+	ServiceParams
+	rbacService *rbac.RBACService
+}
+
+// NewRBACRoleService creates a new RBAC role service
+func NewRBACRoleService(params ServiceParams, rbacService *rbac.RBACService) RBACRoleService {
+	return &rbacRoleService{
+		ServiceParams: params,
+		rbacService:   rbacService,
+	}
+}
+
+func (s *rbacRoleService) CreateRole(ctx context.Context, req dto.CreateRBACRoleRequest) (*rbac.Role, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	role := req.ToRBACRole(ctx)
+	if err := s.validateEnvironments(ctx, role.EnvironmentIDs); err != nil {
+		return nil, err
+	}
+
+	if err := s.RBACRoleRepo.Create(ctx, role); err != nil {
+		return nil, err
+	}
+	s.rbacService.InvalidateCustomRoles(ctx)
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeRBACRole, role.ID, types.AuditLogActionCreate, nil, role)
+
+	s.Logger.Infow("created rbac role", "role_id", role.ID, "name", role.Name)
+	return rbac.FromCustomRole(role), nil
+}
+
+func (s *rbacRoleService) UpdateRole(ctx context.Context, id string, req dto.UpdateRBACRoleRequest) (*rbac.Role, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	role, err := s.RBACRoleRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *role
+	req.ApplyTo(role)
+	if err := s.validateEnvironments(ctx, role.EnvironmentIDs); err != nil {
+		return nil, err
+	}
+
+	if err := s.RBACRoleRepo.Update(ctx, role); err != nil {
+		return nil, err
+	}
+	s.rbacService.InvalidateCustomRoles(ctx)
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeRBACRole, role.ID, types.AuditLogActionUpdate, &before, role)
+
+	s.Logger.Infow("updated rbac role", "role_id", role.ID)
+	return rbac.FromCustomRole(role), nil
+}
+
+func (s *rbacRoleService) DeleteRole(ctx context.Context, id string) error {
+	role, err := s.RBACRoleRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.RBACRoleRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.rbacService.InvalidateCustomRoles(ctx)
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeRBACRole, id, types.AuditLogActionDelete, role, nil)
+
+	s.Logger.Infow("deleted rbac role", "role_id", id)
+	return nil
+}
+
+func (s *rbacRoleService) AssignUserRoles(ctx context.Context, userID string, req dto.AssignRolesRequest) (*dto.AssignRolesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.rbacService.ValidateRoles(ctx, req.Roles); err != nil {
+		return nil, err
+	}
+
+	user, err := s.UserRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.UserRepo.UpdateRoles(ctx, userID, req.Roles); err != nil {
+		return nil, err
+	}
+	s.invalidateMemberRoles(ctx, userID)
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeUser, userID, types.AuditLogActionUpdate,
+		map[string]interface{}{"roles": user.Roles},
+		map[string]interface{}{"roles": req.Roles})
+
+	return &dto.AssignRolesResponse{
+		ID:    userID,
+		Roles: req.Roles,
+	}, nil
+}
+
+func (s *rbacRoleService) AssignAPIKeyRoles(ctx context.Context, secretID string, req dto.AssignRolesRequest) (*dto.SecretResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.rbacService.ValidateRoles(ctx, req.Roles); err != nil {
+		return nil, err
+	}
+
+	secret, err := s.SecretRepo.Get(ctx, secretID)
+	if err != nil {
+		return nil, err
+	}
+	if secret.Provider != types.SecretProviderFlexPrice || secret.Status != types.StatusPublished {
+		return nil, ierr.NewError("secret is not an active API key").
+			WithHint("Roles can only be assigned to active API keys").
+			WithReportableDetails(map[string]interface{}{
+				"secret_id": secretID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if err := s.SecretRepo.UpdateRoles(ctx, secretID, req.Roles); err != nil {
+		return nil, err
+	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeAPIKey, secretID, types.AuditLogActionUpdate,
+		map[string]interface{}{"roles": secret.Roles},
+		map[string]interface{}{"roles": req.Roles})
+
+	secret.Roles = req.Roles
+	return dto.ToSecretResponse(secret), nil
+}
+
+// validateEnvironments checks that a role is only limited to environments of the tenant
+func (s *rbacRoleService) validateEnvironments(ctx context.Context, environmentIDs []string) error {
+	for _, id := range environmentIDs {
+		env, err := s.EnvironmentRepo.Get(ctx, id)
+		if err != nil && !ierr.IsNotFound(err) {
+			return err
+		}
+		if err != nil || env.TenantID != types.GetTenantID(ctx) {
+			return ierr.NewErrorf("environment %s not found", id).
+				WithHintf("Environment %s does not exist", id).
+				WithReportableDetails(map[string]interface{}{
+					"environment_id": id,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+	return nil
+}
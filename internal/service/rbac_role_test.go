@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/environment"
+	"github.com/flexprice/flexprice/internal/domain/secret"
+	"github.com/flexprice/flexprice/internal/domain/user"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/rbac"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+)
+
+type RBACRoleServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service     RBACRoleService
+	rbacService *rbac.RBACService
+}
+
+func TestRBACRoleService(t *testing.T) {
+	suite.Run(t, new(RBACRoleServiceSuite))
+}
+
+func (s *RBACRoleServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.rbacService = s.newRBACService(false)
+
+	s.service = NewRBACRoleService(ServiceParams{
+		Logger:          s.GetLogger(),
+		Config:          s.GetConfig(),
+		DB:              s.GetDB(),
+		UserRepo:        s.GetStores().UserRepo,
+		SecretRepo:      s.GetStores().SecretRepo,
+		EnvironmentRepo: s.GetStores().EnvironmentRepo,
+		RBACRoleRepo:    s.GetStores().RBACRoleRepo,
+	}, s.rbacService)
+
+	for _, id := range []string{"env_sandbox", "env_production"} {
+		s.NoError(s.GetStores().EnvironmentRepo.Create(s.GetContext(), &environment.Environment{
+			ID:        id,
+			Name:      id,
+			Type:      types.EnvironmentDevelopment,
+			BaseModel: types.GetDefaultBaseModel(s.GetContext()),
+		}))
+	}
+}
+
+func (s *RBACRoleServiceSuite) newRBACService(denyByDefault bool) *rbac.RBACService {
+	svc, err := rbac.NewRBACService(&config.Configuration{
+		RBAC: config.RBACConfig{
+			RolesConfigPath: "../config/rbac/roles.json",
+			DenyByDefault:   denyByDefault,
+		},
+	}, s.GetStores().RBACRoleRepo, s.GetLogger())
+	s.Require().NoError(err)
+	return svc
+}
+
+func (s *RBACRoleServiceSuite) TestCustomRolePermissions() {
+	ctx := s.GetContext()
+
+	role, err := s.service.CreateRole(ctx, dto.CreateRBACRoleRequest{
+		Name: "Billing Reader",
+		Permissions: map[string][]string{
+			" invoice ": {"read", "read"},
+			"customer":  {"*"},
+		},
+		EnvironmentIDs: []string{"env_production"},
+	})
+	s.Require().NoError(err)
+	s.True(role.Custom)
+	s.Equal([]string{"read"}, role.Permissions["invoice"])
+
+	prodCtx := context.WithValue(ctx, types.CtxEnvironmentID, "env_production")
+	roles := []string{role.ID}
+	s.True(s.rbacService.HasPermission(prodCtx, roles, "invoice", "read"))
+	s.True(s.rbacService.HasPermission(prodCtx, roles, "customer", "write"), "wildcard action")
+	s.False(s.rbacService.HasPermission(prodCtx, roles, "invoice", "write"))
+	s.False(s.rbacService.HasPermission(ctx, roles, "invoice", "read"), "role is limited to production")
+
+	// Updates take effect on the next check
+	_, err = s.service.UpdateRole(ctx, role.ID, dto.UpdateRBACRoleRequest{
+		Permissions:    map[string][]string{"invoice": {"read", "write"}},
+		EnvironmentIDs: []string{},
+	})
+	s.Require().NoError(err)
+	s.True(s.rbacService.HasPermission(ctx, roles, "invoice", "write"))
+	s.False(s.rbacService.HasPermission(ctx, roles, "customer", "write"))
+
+	listed, err := s.rbacService.ListRoles(ctx)
+	s.NoError(err)
+	s.True(lo.ContainsBy(listed, func(r *rbac.Role) bool { return r.ID == role.ID && r.Custom }))
+	s.True(lo.ContainsBy(listed, func(r *rbac.Role) bool { return r.ID == rbac.RoleAdmin && !r.Custom }))
+
+	_, err = s.service.CreateRole(ctx, dto.CreateRBACRoleRequest{
+		Name:        "Billing Reader",
+		Permissions: map[string][]string{"invoice": {"read"}},
+	})
+	s.True(ierr.IsAlreadyExists(err))
+
+	// Deleted roles grant nothing
+	s.NoError(s.service.DeleteRole(ctx, role.ID))
+	s.False(s.rbacService.HasPermission(ctx, roles, "invoice", "read"))
+	_, err = s.rbacService.GetRole(ctx, role.ID)
+	s.True(ierr.IsNotFound(err))
+}
+
+func (s *RBACRoleServiceSuite) TestBuiltInRolesAndDenyByDefault() {
+	ctx := s.GetContext()
+
+	s.True(s.rbacService.HasPermission(ctx, []string{rbac.RoleAdmin}, "invoice", "write"))
+	s.True(s.rbacService.HasPermission(ctx, []string{"event_ingestor"}, "event", "write"))
+	s.False(s.rbacService.HasPermission(ctx, []string{"event_ingestor"}, "event", "read"))
+	s.False(s.rbacService.HasPermission(ctx, []string{"unknown"}, "event", "write"))
+	s.True(s.rbacService.HasPermission(ctx, nil, "event", "write"), "no roles means full access by default")
+
+	deny := s.newRBACService(true)
+	s.False(deny.HasPermission(ctx, nil, "event", "write"))
+	s.True(deny.HasPermission(ctx, []string{rbac.RoleAdmin}, "event", "write"))
+}
+
+func (s *RBACRoleServiceSuite) TestAssignRoles() {
+	ctx := s.GetContext()
+
+	role, err := s.service.CreateRole(ctx, dto.CreateRBACRoleRequest{
+		Name:        "Event Writer",
+		Permissions: map[string][]string{"event": {"write"}},
+	})
+	s.Require().NoError(err)
+
+	s.NoError(s.GetStores().UserRepo.Create(ctx, &user.User{
+		ID:        "user_sa",
+		Type:      types.UserTypeServiceAccount,
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}))
+	userResp, err := s.service.AssignUserRoles(ctx, "user_sa", dto.AssignRolesRequest{
+		Roles: []string{role.ID, "event_reader", role.ID},
+	})
+	s.NoError(err)
+	s.Equal([]string{role.ID, "event_reader"}, userResp.Roles)
+
+	sa, err := s.GetStores().UserRepo.GetByID(ctx, "user_sa")
+	s.NoError(err)
+	s.Equal([]string{role.ID, "event_reader"}, sa.Roles)
+
+	_, err = s.service.AssignUserRoles(ctx, "user_sa", dto.AssignRolesRequest{Roles: []string{"missing"}})
+	s.True(ierr.IsValidation(err))
+
+	s.NoError(s.GetStores().SecretRepo.Create(ctx, &secret.Secret{
+		ID:        "secret_key",
+		Type:      types.SecretTypePrivateKey,
+		Provider:  types.SecretProviderFlexPrice,
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}))
+	keyResp, err := s.service.AssignAPIKeyRoles(ctx, "secret_key", dto.AssignRolesRequest{Roles: []string{role.ID}})
+	s.NoError(err)
+	s.Equal([]string{role.ID}, keyResp.Roles)
+
+	key, err := s.GetStores().SecretRepo.Get(ctx, "secret_key")
+	s.NoError(err)
+	s.Equal([]string{role.ID}, key.Roles)
+}
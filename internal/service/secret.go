@@ -3,10 +3,13 @@ package service
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/cache"
 	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/auditlog"
 	"github.com/flexprice/flexprice/internal/domain/secret"
 	"github.com/flexprice/flexprice/internal/domain/user"
 	ierr "github.com/flexprice/flexprice/internal/errors"
@@ -22,6 +25,13 @@ type SecretService interface {
 	CreateAPIKey(ctx context.Context, req *dto.CreateAPIKeyRequest) (*secret.Secret, string, error)
 	ListAPIKeys(ctx context.Context, filter *types.SecretFilter) (*dto.ListSecretsResponse, error)
 	Delete(ctx context.Context, id string) error
+	// UpdateAPIKey updates the name and restrictions of an API key
+	UpdateAPIKey(ctx context.Context, id string, req *dto.UpdateAPIKeyRequest) (*secret.Secret, error)
+	// RotateAPIKey issues a key with the same roles and restrictions that replaces an API key.
+	// The old key keeps working until the grace period ends.
+	RotateAPIKey(ctx context.Context, id string, req *dto.RotateAPIKeyRequest) (*secret.Secret, string, error)
+	// GetAPIKeyUsage returns the requests made with an API key on each of the last days
+	GetAPIKeyUsage(ctx context.Context, id string, days int) (*dto.APIKeyUsageResponse, error)
 
 	// Integration operations
 	CreateIntegration(ctx context.Context, req *dto.CreateIntegrationRequest) (*secret.Secret, error)
@@ -29,6 +39,9 @@ type SecretService interface {
 
 	// Verification operations
 	VerifyAPIKey(ctx context.Context, apiKey string) (*secret.Secret, error)
+	// CheckAPIKeyRequest enforces the IP allowlist, scopes and rate limit of a verified API key
+	// and counts the request towards its usage
+	CheckAPIKeyRequest(ctx context.Context, key *secret.Secret, req types.APIKeyRequest) error
 	getIntegrationCredentials(ctx context.Context, provider string) ([]map[string]string, error)
 
 	ListLinkedIntegrations(ctx context.Context) ([]string, error)
@@ -37,6 +50,7 @@ type SecretService interface {
 type secretService struct {
 	repo              secret.Repository
 	userRepo          user.Repository
+	auditLogRepo      auditlog.Repository
 	encryptionService security.EncryptionService
 	config            *config.Configuration
 	logger            *logger.Logger
@@ -46,6 +60,7 @@ type secretService struct {
 func NewSecretService(
 	repo secret.Repository,
 	userRepo user.Repository,
+	auditLogRepo auditlog.Repository,
 	config *config.Configuration,
 	logger *logger.Logger,
 ) SecretService {
@@ -57,6 +72,7 @@ func NewSecretService(
 	return &secretService{
 		repo:              repo,
 		userRepo:          userRepo,
+		auditLogRepo:      auditLogRepo,
 		encryptionService: encryptionService,
 		config:            config,
 		logger:            logger,
@@ -65,6 +81,24 @@ func NewSecretService(
 
 // Helper functions
 
+// recordAuditLog appends an entry for an action on an API key or integration to the audit log.
+// Snapshots are taken from the secret response so hashed keys and credentials never reach it.
+func (s *secretService) recordAuditLog(ctx context.Context, secretEntity *secret.Secret, action types.AuditLogAction, before, after *secret.Secret) {
+	entityType := types.AuditLogEntityTypeAPIKey
+	if !secretEntity.IsAPIKey() {
+		entityType = types.AuditLogEntityTypeIntegration
+	}
+
+	var beforeSnapshot, afterSnapshot interface{}
+	if before != nil {
+		beforeSnapshot = dto.ToSecretResponse(before)
+	}
+	if after != nil {
+		afterSnapshot = dto.ToSecretResponse(after)
+	}
+	recordAuditLog(ctx, s.auditLogRepo, s.logger, entityType, secretEntity.ID, action, beforeSnapshot, afterSnapshot)
+}
+
 // generatePrefix generates a prefix for an API key based on its type
 func generatePrefix(keyType types.SecretType) string {
 	switch keyType {
@@ -147,13 +181,18 @@ func (s *secretService) CreateAPIKey(ctx context.Context, req *dto.CreateAPIKeyR
 		ExpiresAt:     req.ExpiresAt,
 		Roles:         roles,
 		UserType:      userType,
+		Scopes:        lo.Uniq(req.Scopes),
+		AllowedIPs:    lo.Uniq(req.AllowedIPs),
 		BaseModel:     types.GetDefaultBaseModel(ctx),
+
+		RateLimitPerMinute: req.RateLimitPerMinute,
 	}
 
 	// Save to repository
 	if err := s.repo.Create(ctx, secretEntity); err != nil {
 		return nil, "", err
 	}
+	s.recordAuditLog(ctx, secretEntity, types.AuditLogActionCreate, nil, secretEntity)
 
 	// DEBUG: Log the final secret entity with roles
 	s.logger.Debugw("API Key created successfully",
@@ -241,6 +280,7 @@ func (s *secretService) CreateIntegration(ctx context.Context, req *dto.CreateIn
 	if err := s.repo.Create(ctx, secretEntity); err != nil {
 		return nil, err
 	}
+	s.recordAuditLog(ctx, secretEntity, types.AuditLogActionCreate, nil, secretEntity)
 
 	return secretEntity, nil
 }
@@ -273,12 +313,250 @@ func (s *secretService) ListIntegrations(ctx context.Context, filter *types.Secr
 }
 
 func (s *secretService) Delete(ctx context.Context, id string) error {
+	secretEntity, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
 	if err := s.repo.Delete(ctx, id); err != nil {
 		return err
 	}
+
+	s.recordAuditLog(ctx, secretEntity, types.AuditLogActionDelete, secretEntity, nil)
 	return nil
 }
 
+// maxAPIKeyUsageDays is how many days of per-key request counts are retained
+const maxAPIKeyUsageDays = 31
+
+// getAPIKey returns a published FlexPrice API key of the current environment
+func (s *secretService) getAPIKey(ctx context.Context, id string) (*secret.Secret, error) {
+	secretEntity, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !secretEntity.IsAPIKey() || secretEntity.Provider != types.SecretProviderFlexPrice ||
+		secretEntity.Status != types.StatusPublished {
+		return nil, ierr.NewError("API key not found").
+			WithHintf("API key %s not found", id).
+			Mark(ierr.ErrNotFound)
+	}
+
+	return secretEntity, nil
+}
+
+func (s *secretService) UpdateAPIKey(ctx context.Context, id string, req *dto.UpdateAPIKeyRequest) (*secret.Secret, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	secretEntity, err := s.getAPIKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *secretEntity
+	req.ApplyTo(secretEntity)
+	secretEntity.UpdatedAt = time.Now().UTC()
+	secretEntity.UpdatedBy = types.GetUserID(ctx)
+
+	if err := s.repo.Update(ctx, secretEntity); err != nil {
+		return nil, err
+	}
+	s.recordAuditLog(ctx, secretEntity, types.AuditLogActionUpdate, &before, secretEntity)
+
+	return secretEntity, nil
+}
+
+func (s *secretService) RotateAPIKey(ctx context.Context, id string, req *dto.RotateAPIKeyRequest) (*secret.Secret, string, error) {
+	if err := req.Validate(); err != nil {
+		return nil, "", err
+	}
+
+	oldKey, err := s.getAPIKey(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if oldKey.IsExpired() {
+		return nil, "", ierr.NewError("API key has expired").
+			WithHint("An expired API key cannot be rotated").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	apiKey := generateAPIKey(generatePrefix(oldKey.Type))
+	newKey := &secret.Secret{
+		ID:                 types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SECRET),
+		Name:               oldKey.Name,
+		Type:               oldKey.Type,
+		EnvironmentID:      oldKey.EnvironmentID,
+		Provider:           types.SecretProviderFlexPrice,
+		Value:              s.encryptionService.Hash(apiKey),
+		DisplayID:          generateDisplayID(apiKey),
+		ExpiresAt:          oldKey.ExpiresAt,
+		Roles:              oldKey.Roles,
+		UserType:           oldKey.UserType,
+		Scopes:             oldKey.Scopes,
+		AllowedIPs:         oldKey.AllowedIPs,
+		RateLimitPerMinute: oldKey.RateLimitPerMinute,
+		RotatedFromID:      lo.ToPtr(oldKey.ID),
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+
+	// The old key stops working at the end of the grace period unless it expires earlier
+	before := *oldKey
+	now := time.Now().UTC()
+	graceEnd := now.Add(req.GracePeriod())
+	if oldKey.ExpiresAt == nil || oldKey.ExpiresAt.After(graceEnd) {
+		oldKey.ExpiresAt = lo.ToPtr(graceEnd)
+	}
+	oldKey.UpdatedAt = now
+	oldKey.UpdatedBy = types.GetUserID(ctx)
+
+	if err := s.repo.Create(ctx, newKey); err != nil {
+		return nil, "", err
+	}
+	s.recordAuditLog(ctx, newKey, types.AuditLogActionCreate, nil, newKey)
+
+	if err := s.repo.Update(ctx, oldKey); err != nil {
+		return nil, "", err
+	}
+	s.recordAuditLog(ctx, oldKey, types.AuditLogActionUpdate, &before, oldKey)
+
+	s.logger.Infow("rotated API key",
+		"old_secret_id", oldKey.ID,
+		"new_secret_id", newKey.ID,
+		"old_key_expires_at", oldKey.ExpiresAt,
+	)
+
+	return newKey, apiKey, nil
+}
+
+func (s *secretService) GetAPIKeyUsage(ctx context.Context, id string, days int) (*dto.APIKeyUsageResponse, error) {
+	if days <= 0 {
+		days = 7
+	}
+	if days > maxAPIKeyUsageDays {
+		return nil, ierr.NewErrorf("days must be at most %d", maxAPIKeyUsageDays).
+			WithHintf("Usage is retained for the last %d days", maxAPIKeyUsageDays).
+			Mark(ierr.ErrValidation)
+	}
+
+	secretEntity, err := s.getAPIKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	redisCache := s.usageCache()
+	if redisCache == nil {
+		return nil, ierr.NewError("API key usage tracking is disabled").
+			WithHint("Enable auth.api_key.track_usage to record API key usage").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	resp := &dto.APIKeyUsageResponse{
+		ID:         secretEntity.ID,
+		LastUsedAt: secretEntity.LastUsedAt,
+		Daily:      make([]dto.APIKeyDailyUsage, 0, days),
+	}
+
+	today := time.Now().UTC()
+	for i := days - 1; i >= 0; i-- {
+		date := today.AddDate(0, 0, -i).Format(time.DateOnly)
+		var requests int64
+		if value, found := redisCache.ForceCacheGet(ctx, cache.GenerateKey(cache.PrefixAPIKeyUsage, secretEntity.ID, date)); found {
+			if count, err := strconv.ParseFloat(fmt.Sprint(value), 64); err == nil {
+				requests = int64(count)
+			}
+		}
+		resp.TotalRequests += requests
+		resp.Daily = append(resp.Daily, dto.APIKeyDailyUsage{Date: date, Requests: requests})
+	}
+
+	return resp, nil
+}
+
+func (s *secretService) CheckAPIKeyRequest(ctx context.Context, key *secret.Secret, req types.APIKeyRequest) error {
+	if !key.AllowsIP(req.ClientIP) {
+		return ierr.NewError("request IP is not allowed for this API key").
+			WithHintf("API key cannot be used from %s", req.ClientIP).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	if req.Resource != "" && !key.AllowsScope(req.Resource, req.Action) {
+		return ierr.NewError("API key scope does not allow this request").
+			WithHintf("API key is not allowed to %s %s", req.Action, req.Resource).
+			WithReportableDetails(map[string]interface{}{
+				"required_scope": req.Resource + ":" + req.Action,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	now := time.Now().UTC()
+	if err := s.checkAPIKeyRateLimit(ctx, key, now); err != nil {
+		return err
+	}
+
+	if redisCache := s.usageCache(); redisCache != nil {
+		s.recordAPIKeyUsage(ctx, redisCache, key, now)
+	}
+	return nil
+}
+
+// checkAPIKeyRateLimit counts a request towards the rate limit of an API key. Limits are enforced
+// whether or not usage is tracked, a request is only let through unchecked when Redis is unavailable.
+func (s *secretService) checkAPIKeyRateLimit(ctx context.Context, key *secret.Secret, now time.Time) error {
+	if key.RateLimitPerMinute == nil {
+		return nil
+	}
+
+	redisCache := cache.GetRedisCache()
+	if redisCache == nil {
+		s.logger.Warnw("API key rate limit is not enforced, redis is unavailable", "secret_id", key.ID)
+		return nil
+	}
+
+	window := now.Truncate(time.Minute)
+	count, err := redisCache.IncrByFloat(ctx, cache.GenerateKey(cache.PrefixAPIKeyRateLimit, key.ID, window.Unix()), 1, 2*time.Minute)
+	if err != nil {
+		s.logger.Warnw("failed to check API key rate limit", "secret_id", key.ID, "error", err)
+	} else if int(count) > *key.RateLimitPerMinute {
+		return ierr.NewError("API key rate limit exceeded").
+			WithHintf("API key is limited to %d requests per minute", *key.RateLimitPerMinute).
+			WithReportableDetails(map[string]interface{}{
+				"retry_after": int(window.Add(time.Minute).Sub(now).Seconds()) + 1,
+			}).
+			Mark(ierr.ErrTooManyRequests)
+	}
+	return nil
+}
+
+// recordAPIKeyUsage counts a request towards the daily usage of an API key and refreshes
+// its last used timestamp at most once a minute
+func (s *secretService) recordAPIKeyUsage(ctx context.Context, redisCache *cache.RedisCache, key *secret.Secret, now time.Time) {
+	usageKey := cache.GenerateKey(cache.PrefixAPIKeyUsage, key.ID, now.Format(time.DateOnly))
+	if _, err := redisCache.IncrByFloat(ctx, usageKey, 1, (maxAPIKeyUsageDays+1)*24*time.Hour); err != nil {
+		s.logger.Warnw("failed to record API key usage", "secret_id", key.ID, "error", err)
+	}
+
+	ok, err := redisCache.TrySetNX(ctx, cache.GenerateKey(cache.PrefixAPIKeyLastUsed, key.ID), now.Unix(), time.Minute)
+	if err != nil || !ok {
+		return
+	}
+	if err := s.repo.UpdateLastUsed(ctx, key.ID); err != nil {
+		s.logger.Warnw("failed to update last used timestamp", "secret_id", key.ID, "error", err)
+	}
+}
+
+// usageCache returns the cache used for API key usage tracking, or nil when tracking is disabled
+func (s *secretService) usageCache() *cache.RedisCache {
+	if !s.config.Auth.APIKey.TrackUsage {
+		return nil
+	}
+	return cache.GetRedisCache()
+}
+
 func (s *secretService) VerifyAPIKey(ctx context.Context, apiKey string) (*secret.Secret, error) {
 	if apiKey == "" {
 		return nil, ierr.NewError("validation failed: API key is required").
@@ -325,12 +603,6 @@ func (s *secretService) VerifyAPIKey(ctx context.Context, apiKey string) (*secre
 			Mark(ierr.ErrValidation)
 	}
 
-	// Update last used timestamp
-	// TODO: Uncomment this when we have a way to efficiently update the last used timestamp
-	// if err := s.repo.UpdateLastUsed(ctx, secretEntity.ID); err != nil {
-	// 	s.logger.Warnw("failed to update last used timestamp", "error", err)
-	// }
-
 	return secretEntity, nil
 }
 
@@ -8,6 +8,7 @@ import (
 	"github.com/flexprice/flexprice/internal/config"
 	"github.com/flexprice/flexprice/internal/domain/secret"
 	domainUser "github.com/flexprice/flexprice/internal/domain/user"
+	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/security"
 	"github.com/flexprice/flexprice/internal/testutil"
 	"github.com/flexprice/flexprice/internal/types"
@@ -56,7 +57,7 @@ func (s *SecretServiceSuite) setupService() {
 
 	s.secretRepo = s.GetStores().SecretRepo
 	userRepo := s.GetStores().UserRepo
-	s.service = NewSecretService(s.secretRepo, userRepo, cfg, s.GetLogger())
+	s.service = NewSecretService(s.secretRepo, userRepo, s.GetStores().AuditLogRepo, cfg, s.GetLogger())
 }
 
 func (s *SecretServiceSuite) setupTestData() {
@@ -170,6 +171,26 @@ func (s *SecretServiceSuite) TestCreateAPIKey() {
 			wantErr:   true,
 			errString: "invalid secret type",
 		},
+		{
+			name: "error - invalid scope",
+			req: dto.CreateAPIKeyRequest{
+				Name:   "Test Key",
+				Type:   types.SecretTypePrivateKey,
+				Scopes: []string{"events:delete"},
+			},
+			wantErr:   true,
+			errString: "invalid scope",
+		},
+		{
+			name: "error - invalid allowed ip",
+			req: dto.CreateAPIKeyRequest{
+				Name:       "Test Key",
+				Type:       types.SecretTypePrivateKey,
+				AllowedIPs: []string{"10.0.0.0/33"},
+			},
+			wantErr:   true,
+			errString: "invalid allowed ip",
+		},
 	}
 
 	for _, tt := range tests {
@@ -489,3 +510,123 @@ func (s *SecretServiceSuite) TestDelete() {
 		})
 	}
 }
+
+func (s *SecretServiceSuite) TestCheckAPIKeyRequest() {
+	key, _, err := s.service.CreateAPIKey(s.GetContext(), &dto.CreateAPIKeyRequest{
+		Name:       "Ingest Key",
+		Type:       types.SecretTypePrivateKey,
+		Scopes:     []string{"events:write", "customers:read"},
+		AllowedIPs: []string{"10.0.0.0/8", "192.168.1.10"},
+	})
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name    string
+		req     types.APIKeyRequest
+		wantErr bool
+	}{
+		{
+			name: "allowed scope from allowed network",
+			req:  types.APIKeyRequest{ClientIP: "10.1.2.3", Resource: "events", Action: types.APIKeyScopeActionWrite},
+		},
+		{
+			name: "allowed scope from allowed ip",
+			req:  types.APIKeyRequest{ClientIP: "192.168.1.10", Resource: "customers", Action: types.APIKeyScopeActionRead},
+		},
+		{
+			name:    "action outside scope",
+			req:     types.APIKeyRequest{ClientIP: "10.1.2.3", Resource: "customers", Action: types.APIKeyScopeActionWrite},
+			wantErr: true,
+		},
+		{
+			name:    "resource outside scope",
+			req:     types.APIKeyRequest{ClientIP: "10.1.2.3", Resource: "invoices", Action: types.APIKeyScopeActionRead},
+			wantErr: true,
+		},
+		{
+			name:    "ip outside allowlist",
+			req:     types.APIKeyRequest{ClientIP: "192.168.1.11", Resource: "events", Action: types.APIKeyScopeActionWrite},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			err := s.service.CheckAPIKeyRequest(s.GetContext(), key, tt.req)
+			if tt.wantErr {
+				s.Error(err)
+				s.True(ierr.IsPermissionDenied(err))
+				return
+			}
+			s.NoError(err)
+		})
+	}
+}
+
+func (s *SecretServiceSuite) TestUpdateAPIKey() {
+	key, _, err := s.service.CreateAPIKey(s.GetContext(), &dto.CreateAPIKeyRequest{
+		Name:               "Test Key",
+		Type:               types.SecretTypePrivateKey,
+		RateLimitPerMinute: lo.ToPtr(100),
+	})
+	s.Require().NoError(err)
+
+	updated, err := s.service.UpdateAPIKey(s.GetContext(), key.ID, &dto.UpdateAPIKeyRequest{
+		Scopes:             []string{"events:*"},
+		RateLimitPerMinute: lo.ToPtr(0),
+	})
+	s.Require().NoError(err)
+	s.Equal("Test Key", updated.Name)
+	s.Equal([]string{"events:*"}, updated.Scopes)
+	s.Nil(updated.RateLimitPerMinute)
+
+	_, err = s.service.UpdateAPIKey(s.GetContext(), s.testData.secrets.integration.ID, &dto.UpdateAPIKeyRequest{
+		Name: lo.ToPtr("Renamed"),
+	})
+	s.Error(err)
+	s.True(ierr.IsNotFound(err))
+}
+
+func (s *SecretServiceSuite) TestRotateAPIKey() {
+	key, oldAPIKey, err := s.service.CreateAPIKey(s.GetContext(), &dto.CreateAPIKeyRequest{
+		Name:       "Test Key",
+		Type:       types.SecretTypePrivateKey,
+		Scopes:     []string{"events:write"},
+		AllowedIPs: []string{"10.0.0.1"},
+	})
+	s.Require().NoError(err)
+
+	s.Run("old key keeps working during the grace period", func() {
+		newKey, newAPIKey, err := s.service.RotateAPIKey(s.GetContext(), key.ID, &dto.RotateAPIKeyRequest{})
+		s.Require().NoError(err)
+		s.NotEqual(oldAPIKey, newAPIKey)
+		s.Equal(key.ID, lo.FromPtr(newKey.RotatedFromID))
+		s.Equal(key.Scopes, newKey.Scopes)
+		s.Equal(key.AllowedIPs, newKey.AllowedIPs)
+
+		verified, err := s.service.VerifyAPIKey(s.GetContext(), newAPIKey)
+		s.Require().NoError(err)
+		s.Equal(newKey.ID, verified.ID)
+
+		old, err := s.service.VerifyAPIKey(s.GetContext(), oldAPIKey)
+		s.Require().NoError(err)
+		s.Require().NotNil(old.ExpiresAt)
+		s.WithinDuration(time.Now().Add(24*time.Hour), *old.ExpiresAt, time.Minute)
+	})
+
+	s.Run("zero grace period expires the old key", func() {
+		rotated, rotatedAPIKey, err := s.service.CreateAPIKey(s.GetContext(), &dto.CreateAPIKeyRequest{
+			Name: "Short Lived Key",
+			Type: types.SecretTypePrivateKey,
+		})
+		s.Require().NoError(err)
+
+		_, _, err = s.service.RotateAPIKey(s.GetContext(), rotated.ID, &dto.RotateAPIKeyRequest{
+			GracePeriodHours: lo.ToPtr(0),
+		})
+		s.Require().NoError(err)
+
+		_, err = s.service.VerifyAPIKey(s.GetContext(), rotatedAPIKey)
+		s.Error(err)
+	})
+}
@@ -233,6 +233,10 @@ func (s *settingsService) GetSettingByKey(ctx context.Context, key types.Setting
 		return getSettingByKey[types.CustomerPortalConfig](s, ctx, key)
 	case types.SettingKeyEventIngestionFilter:
 		return getSettingByKey[types.EventIngestionFilterConfig](s, ctx, key)
+	case types.SettingKeyUsageAnomalyConfig:
+		return getSettingByKey[types.UsageAnomalyConfig](s, ctx, key)
+	case types.SettingKeyUsageRetentionConfig:
+		return getSettingByKey[types.UsageRetentionConfig](s, ctx, key)
 	default:
 		return nil, ierr.NewErrorf("unknown setting key: %s", key).
 			WithHintf("Unknown setting key: %s", key).
@@ -277,6 +281,18 @@ func (s *settingsService) UpdateSettingByKey(ctx context.Context, key types.Sett
 		return updateSettingByKey[types.CustomerPortalConfig](s, ctx, key, req)
 	case types.SettingKeyEventIngestionFilter:
 		return updateSettingByKey[types.EventIngestionFilterConfig](s, ctx, key, req)
+	case types.SettingKeyUsageAnomalyConfig:
+		return updateSettingByKey[types.UsageAnomalyConfig](s, ctx, key, req)
+	case types.SettingKeyUsageRetentionConfig:
+		resp, err := updateSettingByKey[types.UsageRetentionConfig](s, ctx, key, req)
+		if err != nil {
+			return nil, err
+		}
+		// The rollup job re-syncs every environment hourly, so a failure here only delays the change
+		if err := NewUsageRollupService(s.ServiceParams).SyncRetentionPolicy(ctx); err != nil {
+			s.Logger.WarnwCtx(ctx, "failed to sync usage retention policy", "error", err)
+		}
+		return resp, nil
 	default:
 		return nil, ierr.NewErrorf("unknown setting key: %s", key).
 			WithHintf("Unknown setting key: %s", key).
@@ -294,7 +310,7 @@ func (s *settingsService) UpdateSettingByKey(ctx context.Context, key types.Sett
 //   - Don't call repository methods directly - always use service methods
 func (s *settingsService) DeleteSettingByKey(ctx context.Context, key types.SettingKey) error {
 	// Check if setting exists
-	_, err := s.fetchSetting(ctx, key)
+	setting, err := s.fetchSetting(ctx, key)
 	if ent.IsNotFound(err) {
 		return ierr.NewErrorf("setting with key '%s' not found", key).
 			WithHintf("Setting with key %s not found", key).
@@ -306,9 +322,16 @@ func (s *settingsService) DeleteSettingByKey(ctx context.Context, key types.Sett
 
 	// Delete based on setting type (tenant-level vs environment-level)
 	if isTenantLevelSetting(key) {
-		return s.SettingsRepo.DeleteTenantLevelSettingByKey(ctx, key)
+		err = s.SettingsRepo.DeleteTenantLevelSettingByKey(ctx, key)
+	} else {
+		err = s.SettingsRepo.DeleteByKey(ctx, key)
+	}
+	if err != nil {
+		return err
 	}
-	return s.SettingsRepo.DeleteByKey(ctx, key)
+
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeSetting, setting.ID, types.AuditLogActionDelete, setting, nil)
+	return nil
 }
 
 // getSettingByKey fetches a setting and returns it as a DTO response.
@@ -377,5 +400,13 @@ func updateSettingByKey[T types.SettingConfig](s *settingsService, ctx context.C
 	}
 
 	// Return updated setting
-	return s.GetSettingByKey(ctx, key)
+	resp, err := s.GetSettingByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Setting != nil {
+		s.recordAuditLog(ctx, types.AuditLogEntityTypeSetting, resp.ID, types.AuditLogActionUpdate, current, merged)
+	}
+	return resp, nil
 }
@@ -0,0 +1,575 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	authProvider "github.com/flexprice/flexprice/internal/auth"
+	"github.com/flexprice/flexprice/internal/cache"
+	"github.com/flexprice/flexprice/internal/domain/sso"
+	"github.com/flexprice/flexprice/internal/domain/user"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/rbac"
+	"github.com/flexprice/flexprice/internal/security"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// defaultSSOLoginTimeout bounds how long a user may take at the identity provider
+const defaultSSOLoginTimeout = 10 * time.Minute
+
+// lookupTXT resolves the TXT records verifying the domains of connections
+var lookupTXT = net.DefaultResolver.LookupTXT
+
+// SSOService manages the single sign-on connections of tenants and signs dashboard users
+// in through them
+type SSOService interface {
+	CreateConnection(ctx context.Context, req dto.CreateSSOConnectionRequest) (*dto.SSOConnectionResponse, error)
+	GetConnection(ctx context.Context, id string) (*dto.SSOConnectionResponse, error)
+	ListConnections(ctx context.Context) (*dto.ListSSOConnectionsResponse, error)
+	UpdateConnection(ctx context.Context, id string, req dto.UpdateSSOConnectionRequest) (*dto.SSOConnectionResponse, error)
+	DeleteConnection(ctx context.Context, id string) error
+	// VerifyDomains verifies the pending domains of a connection whose TXT record is published
+	VerifyDomains(ctx context.Context, id string) (*dto.SSOConnectionResponse, error)
+
+	// StartLogin returns the identity provider URL the user is sent to and the binding the
+	// browser must present on the callback
+	StartLogin(ctx context.Context, req dto.SSOLoginRequest) (*dto.SSOLoginResponse, error)
+	// CompleteLogin verifies the callback of the identity provider, provisions the user in
+	// the tenant of the connection and issues an auth token. A sign in completes only once.
+	CompleteLogin(ctx context.Context, stateID, binding string, r *http.Request) (*dto.AuthResponse, error)
+	// GetSAMLMetadata returns the service provider metadata of a SAML connection
+	GetSAMLMetadata(ctx context.Context, connectionID string) ([]byte, error)
+}
+
+type ssoService struct {
+	ServiceParams
+	encryptionService security.EncryptionService
+	rbacService       *rbac.RBACService
+	authProvider      authProvider.Provider
+	loginStates       ssoLoginStateStore
+}
+
+// NewSSOService creates a new SSO service
+func NewSSOService(
+	params ServiceParams,
+	encryptionService security.EncryptionService,
+	rbacService *rbac.RBACService,
+) SSOService {
+	return &ssoService{
+		ServiceParams:     params,
+		encryptionService: encryptionService,
+		rbacService:       rbacService,
+		authProvider:      authProvider.NewProvider(params.Config),
+		loginStates:       redisSSOLoginStateStore{},
+	}
+}
+
+// ssoLoginStateStore keeps the state of sign ins until the identity provider calls back
+type ssoLoginStateStore interface {
+	Save(ctx context.Context, state *authProvider.SSOLoginState) error
+	// Take returns and deletes a state so it is used once, nil when there is none
+	Take(ctx context.Context, id string) (*authProvider.SSOLoginState, error)
+}
+
+// redisSSOLoginStateStore keeps login states in Redis so any instance can take the callback
+type redisSSOLoginStateStore struct{}
+
+func (redisSSOLoginStateStore) Save(ctx context.Context, state *authProvider.SSOLoginState) error {
+	redisCache := cache.GetRedisCache()
+	if redisCache == nil {
+		return ierr.NewError("redis is unavailable").
+			WithHint("Single sign-on is temporarily unavailable, please try again later").
+			Mark(ierr.ErrServiceUnavailable)
+	}
+
+	ok, err := redisCache.TrySetNX(ctx, cache.GenerateKey(cache.PrefixSSOLoginState, state.ID), state, time.Until(state.ExpiresAt))
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to start the sign in").
+			Mark(ierr.ErrInternal)
+	}
+	if !ok {
+		return ierr.NewError("sso state already exists").
+			WithHint("Failed to start the sign in").
+			Mark(ierr.ErrInternal)
+	}
+	return nil
+}
+
+func (redisSSOLoginStateStore) Take(ctx context.Context, id string) (*authProvider.SSOLoginState, error) {
+	redisCache := cache.GetRedisCache()
+	if redisCache == nil {
+		return nil, ierr.NewError("redis is unavailable").
+			WithHint("Single sign-on is temporarily unavailable, please try again later").
+			Mark(ierr.ErrServiceUnavailable)
+	}
+
+	data, ok, err := redisCache.GetDel(ctx, cache.GenerateKey(cache.PrefixSSOLoginState, id))
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to complete the sign in").
+			Mark(ierr.ErrInternal)
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	var state authProvider.SSOLoginState
+	if err := json.Unmarshal([]byte(data), &state); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to complete the sign in").
+			Mark(ierr.ErrInternal)
+	}
+	return &state, nil
+}
+
+func (s *ssoService) CreateConnection(ctx context.Context, req dto.CreateSSOConnectionRequest) (*dto.SSOConnectionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	connection := req.ToSSOConnection(ctx)
+	if err := s.validateConnection(ctx, connection); err != nil {
+		return nil, err
+	}
+
+	secret, err := s.encryptionService.Encrypt(connection.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	connection.ClientSecret = secret
+
+	if err := s.SSOConnectionRepo.Create(ctx, connection); err != nil {
+		return nil, err
+	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeSSOConnection, connection.ID, types.AuditLogActionCreate, nil, connection)
+
+	return s.toResponse(connection), nil
+}
+
+func (s *ssoService) GetConnection(ctx context.Context, id string) (*dto.SSOConnectionResponse, error) {
+	connection, err := s.SSOConnectionRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.toResponse(connection), nil
+}
+
+func (s *ssoService) ListConnections(ctx context.Context) (*dto.ListSSOConnectionsResponse, error) {
+	connections, err := s.SSOConnectionRepo.ListByTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := lo.Map(connections, func(c *sso.Connection, _ int) *dto.SSOConnectionResponse {
+		return s.toResponse(c)
+	})
+	response := types.NewListResponse(items, len(items), len(items), 0)
+	return &response, nil
+}
+
+func (s *ssoService) UpdateConnection(ctx context.Context, id string, req dto.UpdateSSOConnectionRequest) (*dto.SSOConnectionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	connection, err := s.SSOConnectionRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	before := *connection
+
+	req.ApplyTo(connection)
+	if req.ClientSecret != nil {
+		if connection.ClientSecret, err = s.encryptionService.Encrypt(*req.ClientSecret); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.validateConnection(ctx, connection); err != nil {
+		return nil, err
+	}
+
+	if err := s.SSOConnectionRepo.Update(ctx, connection); err != nil {
+		return nil, err
+	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeSSOConnection, connection.ID, types.AuditLogActionUpdate, &before, connection)
+
+	return s.toResponse(connection), nil
+}
+
+func (s *ssoService) DeleteConnection(ctx context.Context, id string) error {
+	connection, err := s.SSOConnectionRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.SSOConnectionRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeSSOConnection, id, types.AuditLogActionDelete, connection, nil)
+	return nil
+}
+
+// validateConnection checks the settings of the connection, that its roles exist and that
+// no other connection verified its verified domains
+func (s *ssoService) validateConnection(ctx context.Context, connection *sso.Connection) error {
+	if err := connection.Validate(); err != nil {
+		return err
+	}
+
+	roles := append([]string{}, connection.DefaultRoles...)
+	for _, mapped := range connection.RoleMappings {
+		roles = append(roles, mapped...)
+	}
+	if err := s.rbacService.ValidateRoles(ctx, lo.Uniq(roles)); err != nil {
+		return err
+	}
+
+	for _, domain := range connection.VerifiedDomains {
+		if err := s.checkDomainAvailable(ctx, connection, domain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkDomainAvailable checks that no other connection verified the domain
+func (s *ssoService) checkDomainAvailable(ctx context.Context, connection *sso.Connection, domain string) error {
+	existing, err := s.SSOConnectionRepo.GetByDomain(ctx, domain)
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if existing.ID != connection.ID {
+		return ierr.NewErrorf("domain %s is already claimed", domain).
+			WithHintf("Another SSO connection already signs in users of %s", domain).
+			WithReportableDetails(map[string]any{
+				"domain": domain,
+			}).
+			Mark(ierr.ErrAlreadyExists)
+	}
+	return nil
+}
+
+func (s *ssoService) VerifyDomains(ctx context.Context, id string) (*dto.SSOConnectionResponse, error) {
+	connection, err := s.SSOConnectionRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	before := *connection
+
+	// Connections created before domains were verified get their token on the first attempt
+	if connection.DomainVerificationToken == "" {
+		connection.DomainVerificationToken = sso.NewDomainVerificationToken()
+	}
+
+	verified := make([]string, 0)
+	for _, domain := range connection.PendingDomains() {
+		records, err := lookupTXT(ctx, connection.DomainVerificationHost(domain))
+		if err != nil || !lo.Contains(records, connection.DomainVerificationValue()) {
+			s.Logger.Debugw("sso domain not verified", "connection_id", connection.ID, "domain", domain, "error", err)
+			continue
+		}
+		if err := s.checkDomainAvailable(ctx, connection, domain); err != nil {
+			return nil, err
+		}
+		verified = append(verified, domain)
+	}
+
+	if len(verified) == 0 && connection.DomainVerificationToken == before.DomainVerificationToken {
+		return s.toResponse(connection), nil
+	}
+
+	connection.VerifiedDomains = append(append([]string{}, connection.VerifiedDomains...), verified...)
+	if err := s.SSOConnectionRepo.Update(ctx, connection); err != nil {
+		return nil, err
+	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeSSOConnection, connection.ID, types.AuditLogActionUpdate, &before, connection)
+
+	return s.toResponse(connection), nil
+}
+
+func (s *ssoService) StartLogin(ctx context.Context, req dto.SSOLoginRequest) (*dto.SSOLoginResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var connection *sso.Connection
+	var err error
+	if req.ConnectionID != "" {
+		connection, err = s.SSOConnectionRepo.GetByID(ctx, req.ConnectionID)
+	} else {
+		connection, err = s.SSOConnectionRepo.GetByDomain(ctx, sso.EmailDomain(req.Email))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !connection.Enabled {
+		return nil, ierr.NewError("sso connection is disabled").
+			WithHint("Single sign-on is disabled for your organization").
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	provider, err := s.newProvider(ctx, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	state := authProvider.NewSSOLoginState(connection, s.loginTimeout())
+	redirectURL, err := provider.LoginURL(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.loginStates.Save(ctx, state); err != nil {
+		return nil, err
+	}
+
+	return &dto.SSOLoginResponse{
+		RedirectURL: redirectURL,
+		Binding:     state.Binding,
+		ExpiresAt:   state.ExpiresAt,
+	}, nil
+}
+
+func (s *ssoService) CompleteLogin(ctx context.Context, stateID, binding string, r *http.Request) (*dto.AuthResponse, error) {
+	state, err := s.takeState(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
+	// A callback from another browser would sign the user in to the account of whoever started the sign in
+	if !state.BoundTo(binding) {
+		return nil, ierr.NewError("sso state not bound to browser").
+			WithHint("The sign in was started in another browser, please start again").
+			Mark(ierr.ErrPermissionDenied)
+	}
+	if state.Expired() {
+		return nil, ierr.NewError("sso login expired").
+			WithHint("The sign in took too long, please start again").
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	connection, err := s.SSOConnectionRepo.GetByID(ctx, state.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if !connection.Enabled || connection.TenantID != state.TenantID {
+		return nil, ierr.NewError("sso connection is disabled").
+			WithHint("Single sign-on is disabled for your organization").
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	provider, err := s.newProvider(ctx, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	identity, err := provider.Authenticate(ctx, state, r)
+	if err != nil {
+		return nil, err
+	}
+
+	// The identity provider may only sign in users of the domains the tenant verified
+	if !connection.MatchesDomain(identity.Email) {
+		return nil, ierr.NewError("email domain not allowed").
+			WithHintf("%s cannot sign in through this SSO connection", identity.Email).
+			WithReportableDetails(map[string]any{
+				"connection_id": connection.ID,
+				"email":         identity.Email,
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	u, err := s.provisionUser(ctx, connection, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	// Members of the tenant sign in to it even though their account lives in another tenant
+	token, err := s.authProvider.IssueToken(ctx, u.ID, connection.TenantID, u.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	tenants, err := s.userTenants(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("user signed in through sso",
+		"user_id", u.ID,
+		"tenant_id", connection.TenantID,
+		"connection_id", connection.ID,
+		"protocol", connection.Protocol,
+	)
+
+	return &dto.AuthResponse{
+		Token:    token,
+		UserID:   u.ID,
+		TenantID: connection.TenantID,
+		Tenants:  tenants,
+	}, nil
+}
+
+// provisionUser returns the user of the identity in the tenant of the connection, either
+// through their account or a membership of the tenant. Users are created on their first sign
+// in when the connection allows it. The roles of connections that map roles are synced on
+// every sign in.
+func (s *ssoService) provisionUser(ctx context.Context, connection *sso.Connection, identity *authProvider.SSOIdentity) (*user.User, error) {
+	// Emails are unique across tenants, so the user is looked up outside the tenant
+	existing, err := s.UserRepo.GetByEmail(context.WithValue(ctx, types.CtxTenantID, ""), identity.Email)
+	if err != nil && !ierr.IsNotFound(err) {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, types.CtxTenantID, connection.TenantID)
+	roles := connection.RolesFor(identity.Groups)
+	managesRoles := len(connection.DefaultRoles) > 0 || len(connection.RoleMappings) > 0
+
+	if existing != nil && existing.TenantID != connection.TenantID {
+		m, err := s.MembershipRepo.GetByUserID(ctx, existing.ID)
+		if err != nil && !ierr.IsNotFound(err) {
+			return nil, err
+		}
+		if m == nil {
+			return nil, ierr.NewError("user belongs to another tenant").
+				WithHint("This account belongs to another organization").
+				WithReportableDetails(map[string]any{
+					"email": identity.Email,
+				}).
+				Mark(ierr.ErrPermissionDenied)
+		}
+
+		added, removed := lo.Difference(roles, m.Roles)
+		if managesRoles && len(added)+len(removed) > 0 {
+			if err := s.MembershipRepo.UpdateRoles(ctx, m.ID, roles); err != nil {
+				return nil, err
+			}
+			s.invalidateMemberRoles(ctx, existing.ID)
+			s.recordAuditLog(ctx, types.AuditLogEntityTypeMembership, m.ID, types.AuditLogActionUpdate,
+				map[string]interface{}{"roles": m.Roles},
+				map[string]interface{}{"roles": roles})
+		}
+		return existing, nil
+	}
+
+	if existing == nil {
+		if !connection.JITProvisioning {
+			return nil, ierr.NewError("user not provisioned").
+				WithHint("Your account has not been created yet, ask an administrator to invite you").
+				WithReportableDetails(map[string]any{
+					"email": identity.Email,
+				}).
+				Mark(ierr.ErrPermissionDenied)
+		}
+
+		u := user.NewUser(identity.Email, connection.TenantID)
+		u.Roles = roles
+		if err := s.UserRepo.Create(ctx, u); err != nil {
+			return nil, err
+		}
+		s.recordAuditLog(ctx, types.AuditLogEntityTypeUser, u.ID, types.AuditLogActionCreate, nil,
+			map[string]interface{}{"email": u.Email, "roles": u.Roles, "sso_connection_id": connection.ID})
+		return u, nil
+	}
+
+	added, removed := lo.Difference(roles, existing.Roles)
+	if managesRoles && len(added)+len(removed) > 0 {
+		if err := s.UserRepo.UpdateRoles(ctx, existing.ID, roles); err != nil {
+			return nil, err
+		}
+		s.invalidateMemberRoles(ctx, existing.ID)
+		s.recordAuditLog(ctx, types.AuditLogEntityTypeUser, existing.ID, types.AuditLogActionUpdate,
+			map[string]interface{}{"roles": existing.Roles},
+			map[string]interface{}{"roles": roles})
+		existing.Roles = roles
+	}
+	return existing, nil
+}
+
+func (s *ssoService) GetSAMLMetadata(ctx context.Context, connectionID string) ([]byte, error) {
+	connection, err := s.SSOConnectionRepo.GetByID(ctx, connectionID)
+	if err != nil {
+		return nil, err
+	}
+	if connection.Protocol != types.SSOProtocolSAML {
+		return nil, ierr.NewError("not a saml connection").
+			WithHint("Service provider metadata is only available for SAML connections").
+			Mark(ierr.ErrNotFound)
+	}
+
+	metadata, err := xml.MarshalIndent(authProvider.NewSAMLServiceProvider(s.Config.Auth.SSO, connection).Metadata(), "", "  ")
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to generate the service provider metadata").
+			Mark(ierr.ErrInternal)
+	}
+	return metadata, nil
+}
+
+// newProvider creates the SSO provider of a connection with its client secret decrypted
+func (s *ssoService) newProvider(ctx context.Context, connection *sso.Connection) (authProvider.SSOProvider, error) {
+	decrypted := *connection
+	secret, err := s.encryptionService.Decrypt(connection.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	decrypted.ClientSecret = secret
+
+	return authProvider.NewSSOProvider(ctx, s.Config.Auth.SSO, &decrypted)
+}
+
+func (s *ssoService) loginTimeout() time.Duration {
+	if s.Config.Auth.SSO.LoginTimeout > 0 {
+		return s.Config.Auth.SSO.LoginTimeout
+	}
+	return defaultSSOLoginTimeout
+}
+
+// takeState returns the state of a sign in and forgets it, so a callback cannot be replayed
+func (s *ssoService) takeState(ctx context.Context, id string) (*authProvider.SSOLoginState, error) {
+	invalid := ierr.NewError("invalid sso state").
+		WithHint("The sign in could not be verified, please start again").
+		Mark(ierr.ErrPermissionDenied)
+	if id == "" {
+		return nil, invalid
+	}
+
+	state, err := s.loginStates.Take(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, invalid
+	}
+	return state, nil
+}
+
+func (s *ssoService) toResponse(connection *sso.Connection) *dto.SSOConnectionResponse {
+	response := &dto.SSOConnectionResponse{
+		Connection: connection,
+		DomainVerifications: lo.Map(connection.Domains, func(domain string, _ int) dto.SSODomainVerification {
+			return dto.SSODomainVerification{
+				Domain:   domain,
+				Host:     connection.DomainVerificationHost(domain),
+				Value:    connection.DomainVerificationValue(),
+				Verified: lo.Contains(connection.VerifiedDomains, domain),
+			}
+		}),
+	}
+	switch connection.Protocol {
+	case types.SSOProtocolOIDC:
+		response.CallbackURL = authProvider.SSOOIDCCallbackURL(s.Config.Auth.SSO)
+	case types.SSOProtocolSAML:
+		response.CallbackURL = authProvider.SSOSAMLACSURL(s.Config.Auth.SSO)
+		response.SPMetadataURL = authProvider.SSOSAMLMetadataURL(s.Config.Auth.SSO, connection.ID)
+	}
+	return response
+}
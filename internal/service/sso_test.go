@@ -0,0 +1,404 @@
+package service
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/crewjam/saml"
+	"github.com/flexprice/flexprice/internal/api/dto"
+	authProvider "github.com/flexprice/flexprice/internal/auth"
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/membership"
+	"github.com/flexprice/flexprice/internal/domain/tenant"
+	"github.com/flexprice/flexprice/internal/domain/user"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/rbac"
+	"github.com/flexprice/flexprice/internal/security"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+)
+
+type SSOServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service  SSOService
+	provider authProvider.Provider
+	// txtRecords are the DNS TXT records published per host
+	txtRecords map[string][]string
+}
+
+func TestSSOService(t *testing.T) {
+	suite.Run(t, new(SSOServiceSuite))
+}
+
+func (s *SSOServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+
+	cfg := *s.GetConfig()
+	cfg.Auth = config.AuthConfig{
+		Provider: types.AuthProviderFlexprice,
+		Secret:   "test-auth-secret",
+		// The mock identity providers listen on loopback
+		SSO: config.SSOConfig{BaseURL: "http://localhost:8080", AllowPrivateMetadataURLs: true},
+	}
+	cfg.RBAC.RolesConfigPath = "../config/rbac/roles.json"
+
+	encryption, err := security.NewEncryptionService(&cfg, s.GetLogger())
+	s.Require().NoError(err)
+	rbacService, err := rbac.NewRBACService(&cfg, s.GetStores().RBACRoleRepo, s.GetLogger())
+	s.Require().NoError(err)
+
+	params := ServiceParams{
+		Logger:            s.GetLogger(),
+		Config:            &cfg,
+		DB:                s.GetDB(),
+		UserRepo:          s.GetStores().UserRepo,
+		RBACRoleRepo:      s.GetStores().RBACRoleRepo,
+		AuditLogRepo:      s.GetStores().AuditLogRepo,
+		SSOConnectionRepo: s.GetStores().SSOConnectionRepo,
+		TenantRepo:        s.GetStores().TenantRepo,
+		MembershipRepo:    s.GetStores().MembershipRepo,
+	}
+	service := NewSSOService(params, encryption, rbacService).(*ssoService)
+	service.loginStates = testutil.NewInMemorySSOLoginStateStore()
+	s.service = service
+	s.provider = authProvider.NewProvider(&cfg)
+
+	s.txtRecords = make(map[string][]string)
+	original := lookupTXT
+	lookupTXT = func(_ context.Context, host string) ([]string, error) {
+		return s.txtRecords[host], nil
+	}
+	s.T().Cleanup(func() { lookupTXT = original })
+
+	s.Require().NoError(s.GetStores().TenantRepo.Create(s.GetContext(), &tenant.Tenant{ID: types.DefaultTenantID, Name: "Acme"}))
+}
+
+// guestContext is the context of the public sign in routes, which have no tenant
+func (s *SSOServiceSuite) guestContext() context.Context {
+	return context.Background()
+}
+
+// publishVerification publishes the TXT records verifying the domains of the connection
+func (s *SSOServiceSuite) publishVerification(connection *dto.SSOConnectionResponse) {
+	for _, verification := range connection.DomainVerifications {
+		s.txtRecords[verification.Host] = append(s.txtRecords[verification.Host], "v=spf1 -all", verification.Value)
+	}
+}
+
+// verifyDomains publishes the TXT records of the connection and verifies its domains
+func (s *SSOServiceSuite) verifyDomains(ctx context.Context, connection *dto.SSOConnectionResponse) *dto.SSOConnectionResponse {
+	s.publishVerification(connection)
+	verified, err := s.service.VerifyDomains(ctx, connection.ID)
+	s.Require().NoError(err)
+	return verified
+}
+
+func (s *SSOServiceSuite) TestOIDCLogin() {
+	idp := testutil.NewMockOIDCIdP("flexprice", "client-secret")
+	defer idp.Close()
+
+	connection, err := s.service.CreateConnection(s.GetContext(), dto.CreateSSOConnectionRequest{
+		Name:         "Okta",
+		Protocol:     types.SSOProtocolOIDC,
+		Domains:      []string{"@Acme.com"},
+		IssuerURL:    idp.Issuer(),
+		ClientID:     idp.ClientID,
+		ClientSecret: idp.ClientSecret,
+		RoleMappings: map[string][]string{"billing-admins": {"admin"}},
+	})
+	s.Require().NoError(err)
+	s.Equal([]string{"acme.com"}, connection.Domains)
+	s.Equal("http://localhost:8080/v1/auth/sso/oidc/callback", connection.CallbackURL)
+	s.NotEqual("client-secret", connection.ClientSecret, "client secrets are stored encrypted")
+
+	// Users of a domain cannot sign in until the tenant proves it owns the domain
+	_, err = s.service.StartLogin(s.guestContext(), dto.SSOLoginRequest{Email: "jane@acme.com"})
+	s.True(ierr.IsNotFound(err))
+	s.Require().Len(connection.DomainVerifications, 1)
+	s.Equal("_flexprice-sso.acme.com", connection.DomainVerifications[0].Host)
+	s.False(connection.DomainVerifications[0].Verified)
+	connection = s.verifyDomains(s.GetContext(), connection)
+	s.Equal([]string{"acme.com"}, connection.VerifiedDomains)
+	s.True(connection.DomainVerifications[0].Verified)
+
+	login, err := s.service.StartLogin(s.guestContext(), dto.SSOLoginRequest{Email: "jane@acme.com"})
+	s.Require().NoError(err)
+	s.True(strings.HasPrefix(login.RedirectURL, idp.Issuer()+"/auth?"))
+	s.NotEmpty(login.Binding)
+
+	callbackURL, err := idp.Authorize(login.RedirectURL, map[string]interface{}{
+		"sub":            "00u1",
+		"email":          "Jane@acme.com",
+		"email_verified": true,
+		"groups":         []string{"billing-admins", "everyone"},
+	})
+	s.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodGet, callbackURL, nil)
+
+	// A callback opened in another browser does not sign that browser in
+	other, err := s.service.StartLogin(s.guestContext(), dto.SSOLoginRequest{Email: "jane@acme.com"})
+	s.Require().NoError(err)
+	_, err = s.service.CompleteLogin(s.guestContext(), req.URL.Query().Get("state"), other.Binding, req)
+	s.True(ierr.IsPermissionDenied(err))
+
+	login, err = s.service.StartLogin(s.guestContext(), dto.SSOLoginRequest{Email: "jane@acme.com"})
+	s.Require().NoError(err)
+	callbackURL, err = idp.Authorize(login.RedirectURL, map[string]interface{}{
+		"sub":            "00u1",
+		"email":          "Jane@acme.com",
+		"email_verified": true,
+		"groups":         []string{"billing-admins", "everyone"},
+	})
+	s.Require().NoError(err)
+	req = httptest.NewRequest(http.MethodGet, callbackURL, nil)
+	resp, err := s.service.CompleteLogin(s.guestContext(), req.URL.Query().Get("state"), login.Binding, req)
+	s.Require().NoError(err)
+	s.Equal(types.GetTenantID(s.GetContext()), resp.TenantID)
+	s.Equal([]dto.UserTenant{{TenantID: resp.TenantID, Name: "Acme", HomeTenant: true}}, resp.Tenants)
+
+	claims, err := s.provider.ValidateToken(s.GetContext(), resp.Token)
+	s.Require().NoError(err)
+	s.Equal(resp.UserID, claims.UserID)
+	s.Equal(resp.TenantID, claims.TenantID)
+
+	// The user was provisioned just in time with the mapped roles
+	u, err := s.GetStores().UserRepo.GetByEmail(s.GetContext(), "jane@acme.com")
+	s.Require().NoError(err)
+	s.Equal(resp.UserID, u.ID)
+	s.Equal([]string{"admin"}, u.Roles)
+
+	// A sign in cannot be completed twice
+	_, err = s.service.CompleteLogin(s.guestContext(), req.URL.Query().Get("state"), login.Binding, req)
+	s.True(ierr.IsPermissionDenied(err))
+}
+
+func (s *SSOServiceSuite) TestOIDCLoginSyncsRolesAndRejectsForeignDomains() {
+	idp := testutil.NewMockOIDCIdP("flexprice", "client-secret")
+	defer idp.Close()
+
+	connection, err := s.service.CreateConnection(s.GetContext(), dto.CreateSSOConnectionRequest{
+		Name:         "Okta",
+		Protocol:     types.SSOProtocolOIDC,
+		Domains:      []string{"acme.com"},
+		IssuerURL:    idp.Issuer(),
+		ClientID:     idp.ClientID,
+		ClientSecret: idp.ClientSecret,
+		RoleMappings: map[string][]string{"billing-admins": {"admin"}},
+		DefaultRoles: []string{"event_reader"},
+	})
+	s.Require().NoError(err)
+	s.verifyDomains(s.GetContext(), connection)
+
+	login := func(email string, groups ...string) (*dto.AuthResponse, error) {
+		start, err := s.service.StartLogin(s.guestContext(), dto.SSOLoginRequest{ConnectionID: connection.ID})
+		s.Require().NoError(err)
+		callbackURL, err := idp.Authorize(start.RedirectURL, map[string]interface{}{
+			"sub":    email,
+			"email":  email,
+			"groups": groups,
+		})
+		s.Require().NoError(err)
+		req := httptest.NewRequest(http.MethodGet, callbackURL, nil)
+		return s.service.CompleteLogin(s.guestContext(), req.URL.Query().Get("state"), start.Binding, req)
+	}
+
+	_, err = login("sam@acme.com", "billing-admins")
+	s.Require().NoError(err)
+	u, err := s.GetStores().UserRepo.GetByEmail(s.GetContext(), "sam@acme.com")
+	s.Require().NoError(err)
+	s.ElementsMatch([]string{"admin", "event_reader"}, u.Roles)
+
+	// Leaving the group at the identity provider removes the mapped role on the next sign in
+	_, err = login("sam@acme.com")
+	s.Require().NoError(err)
+	u, err = s.GetStores().UserRepo.GetByEmail(s.GetContext(), "sam@acme.com")
+	s.Require().NoError(err)
+	s.Equal([]string{"event_reader"}, u.Roles)
+
+	// The identity provider cannot sign in users of domains the tenant did not verify
+	_, err = login("mallory@evil.com")
+	s.True(ierr.IsPermissionDenied(err))
+
+	// Without just in time provisioning only existing users can sign in
+	_, err = s.service.UpdateConnection(s.GetContext(), connection.ID, dto.UpdateSSOConnectionRequest{
+		JITProvisioning: lo.ToPtr(false),
+	})
+	s.Require().NoError(err)
+	_, err = login("new@acme.com")
+	s.True(ierr.IsPermissionDenied(err))
+	_, err = login("sam@acme.com")
+	s.NoError(err)
+}
+
+func (s *SSOServiceSuite) TestOIDCLoginOfMember() {
+	idp := testutil.NewMockOIDCIdP("flexprice", "client-secret")
+	defer idp.Close()
+
+	connection, err := s.service.CreateConnection(s.GetContext(), dto.CreateSSOConnectionRequest{
+		Name:         "Okta",
+		Protocol:     types.SSOProtocolOIDC,
+		Domains:      []string{"acme.com"},
+		IssuerURL:    idp.Issuer(),
+		ClientID:     idp.ClientID,
+		ClientSecret: idp.ClientSecret,
+		RoleMappings: map[string][]string{"billing-admins": {"admin"}},
+		DefaultRoles: []string{"event_reader"},
+	})
+	s.Require().NoError(err)
+	s.verifyDomains(s.GetContext(), connection)
+
+	// The account of the user was created in another tenant before they were invited to this one
+	otherTenant := context.WithValue(s.GetContext(), types.CtxTenantID, "tenant_other")
+	s.Require().NoError(s.GetStores().TenantRepo.Create(otherTenant, &tenant.Tenant{ID: "tenant_other", Name: "Other"}))
+	u := user.NewUser("kim@acme.com", "tenant_other")
+	s.Require().NoError(s.GetStores().UserRepo.Create(otherTenant, u))
+
+	login := func() (*dto.AuthResponse, error) {
+		start, err := s.service.StartLogin(s.guestContext(), dto.SSOLoginRequest{ConnectionID: connection.ID})
+		s.Require().NoError(err)
+		callbackURL, err := idp.Authorize(start.RedirectURL, map[string]interface{}{
+			"sub":    "kim",
+			"email":  "kim@acme.com",
+			"groups": []string{"billing-admins"},
+		})
+		s.Require().NoError(err)
+		req := httptest.NewRequest(http.MethodGet, callbackURL, nil)
+		return s.service.CompleteLogin(s.guestContext(), req.URL.Query().Get("state"), start.Binding, req)
+	}
+
+	_, err = login()
+	s.True(ierr.IsPermissionDenied(err), "users of other tenants sign in once they are members")
+
+	m := &membership.Membership{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_TENANT_MEMBERSHIP),
+		UserID:    u.ID,
+		Email:     u.Email,
+		Roles:     []string{"event_reader"},
+		BaseModel: types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.Require().NoError(s.GetStores().MembershipRepo.Create(s.GetContext(), m))
+
+	resp, err := login()
+	s.Require().NoError(err)
+	s.Equal(u.ID, resp.UserID)
+	s.Equal(types.GetTenantID(s.GetContext()), resp.TenantID)
+	claims, err := s.provider.ValidateToken(s.GetContext(), resp.Token)
+	s.Require().NoError(err)
+	s.Equal(resp.TenantID, claims.TenantID)
+
+	// The mapped roles are synced onto the membership, the account keeps its own roles
+	m, err = s.GetStores().MembershipRepo.GetByUserID(s.GetContext(), u.ID)
+	s.Require().NoError(err)
+	s.ElementsMatch([]string{"admin", "event_reader"}, m.Roles)
+	u, err = s.GetStores().UserRepo.GetByID(otherTenant, u.ID)
+	s.Require().NoError(err)
+	s.Empty(u.Roles)
+}
+
+func (s *SSOServiceSuite) TestSAMLLogin() {
+	idp := testutil.NewMockSAMLIdP()
+	defer idp.Close()
+
+	connection, err := s.service.CreateConnection(s.GetContext(), dto.CreateSSOConnectionRequest{
+		Name:           "ADFS",
+		Protocol:       types.SSOProtocolSAML,
+		Domains:        []string{"acme.com"},
+		IdPMetadataURL: idp.MetadataURL(),
+		RoleMappings:   map[string][]string{"Finance": {"admin"}},
+	})
+	s.Require().NoError(err)
+	s.Equal("http://localhost:8080/v1/auth/sso/saml/acs", connection.CallbackURL)
+	s.verifyDomains(s.GetContext(), connection)
+
+	// The tenant registers the service provider metadata with its identity provider
+	metadataXML, err := s.service.GetSAMLMetadata(s.guestContext(), connection.ID)
+	s.Require().NoError(err)
+	var metadata saml.EntityDescriptor
+	s.Require().NoError(xml.Unmarshal(metadataXML, &metadata))
+	s.Equal(connection.SPMetadataURL, metadata.EntityID)
+	idp.RegisterServiceProvider(&metadata)
+
+	login, err := s.service.StartLogin(s.guestContext(), dto.SSOLoginRequest{Email: "lee@acme.com"})
+	s.Require().NoError(err)
+
+	form, err := idp.Authorize(login.RedirectURL, "Lee@acme.com", map[string][]string{
+		"groups": {"Finance"},
+	})
+	s.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodPost, connection.CallbackURL, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := s.service.CompleteLogin(s.guestContext(), form.Get("RelayState"), login.Binding, req)
+	s.Require().NoError(err)
+
+	u, err := s.GetStores().UserRepo.GetByEmail(s.GetContext(), "lee@acme.com")
+	s.Require().NoError(err)
+	s.Equal(resp.UserID, u.ID)
+	s.Equal([]string{"admin"}, u.Roles)
+
+	// A tampered relay state is rejected
+	_, err = s.service.CompleteLogin(s.guestContext(), "tampered", login.Binding, req)
+	s.True(ierr.IsPermissionDenied(err))
+}
+
+func (s *SSOServiceSuite) TestConnectionValidation() {
+	_, err := s.service.CreateConnection(s.GetContext(), dto.CreateSSOConnectionRequest{
+		Name:     "Incomplete",
+		Protocol: types.SSOProtocolOIDC,
+		Domains:  []string{"acme.com"},
+	})
+	s.True(ierr.IsValidation(err), "oidc connections need client credentials")
+
+	_, err = s.service.CreateConnection(s.GetContext(), dto.CreateSSOConnectionRequest{
+		Name:           "Unknown role",
+		Protocol:       types.SSOProtocolSAML,
+		Domains:        []string{"acme.com"},
+		IdPMetadataURL: "https://idp.example.com/metadata",
+		DefaultRoles:   []string{"no_such_role"},
+	})
+	s.True(ierr.IsValidation(err))
+
+	first, err := s.service.CreateConnection(s.GetContext(), dto.CreateSSOConnectionRequest{
+		Name:           "First",
+		Protocol:       types.SSOProtocolSAML,
+		Domains:        []string{"acme.com"},
+		IdPMetadataURL: "https://idp.example.com/metadata",
+	})
+	s.Require().NoError(err)
+
+	// A domain is only verified by the TXT record of the connection itself
+	otherTenant := context.WithValue(s.GetContext(), types.CtxTenantID, "tenant_other")
+	second, err := s.service.CreateConnection(otherTenant, dto.CreateSSOConnectionRequest{
+		Name:           "Second",
+		Protocol:       types.SSOProtocolSAML,
+		Domains:        []string{"acme.com"},
+		IdPMetadataURL: "https://idp.example.com/metadata",
+	})
+	s.Require().NoError(err)
+	s.publishVerification(first)
+	second, err = s.service.VerifyDomains(otherTenant, second.ID)
+	s.Require().NoError(err)
+	s.Empty(second.VerifiedDomains)
+
+	s.verifyDomains(s.GetContext(), first)
+	s.publishVerification(second)
+	_, err = s.service.VerifyDomains(otherTenant, second.ID)
+	s.True(ierr.IsAlreadyExists(err), "a domain signs in to a single tenant")
+
+	// Removing a domain from the connection drops its verification
+	updated, err := s.service.UpdateConnection(s.GetContext(), first.ID, dto.UpdateSSOConnectionRequest{
+		Domains: []string{"acme.io"},
+	})
+	s.Require().NoError(err)
+	s.Empty(updated.VerifiedDomains)
+	second, err = s.service.VerifyDomains(otherTenant, second.ID)
+	s.Require().NoError(err)
+	s.Equal([]string{"acme.com"}, second.VerifiedDomains)
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -56,10 +57,18 @@ type ChunkProcessor interface {
 
 // ChunkResult represents the result of processing a chunk
 type ChunkResult struct {
-	ProcessedRecords  int     `json:"processed_records"`
-	SuccessfulRecords int     `json:"successful_records"`
-	FailedRecords     int     `json:"failed_records"`
-	ErrorSummary      *string `json:"error_summary,omitempty"`
+	ProcessedRecords  int        `json:"processed_records"`
+	SuccessfulRecords int        `json:"successful_records"`
+	FailedRecords     int        `json:"failed_records"`
+	ErrorSummary      *string    `json:"error_summary,omitempty"`
+	RowErrors         []RowError `json:"row_errors,omitempty"`
+}
+
+// RowError records why a single record of a chunk failed, so the record can be
+// written to the task's error report
+type RowError struct {
+	Index int    `json:"index"` // index of the record within the chunk
+	Error string `json:"error"`
 }
 
 // StreamingConfig holds configuration for streaming processing
@@ -181,10 +190,12 @@ func (sp *StreamingProcessor) processCSVStream(
 	// Process file in chunks
 	var chunk [][]string
 	chunkIndex := 0
+	totalRecords := 0
 	totalProcessed := 0
 	totalSuccessful := 0
 	totalFailed := 0
 	var allErrors []string
+	report := newErrorReportWriter(headers, config.MaxErrors)
 	lastProgressUpdate := time.Now()
 
 	for {
@@ -211,6 +222,7 @@ func (sp *StreamingProcessor) processCSVStream(
 			"chunk_index", chunkIndex)
 
 		chunk = append(chunk, record)
+		totalRecords++
 
 		// Process chunk when it reaches the configured size
 		if len(chunk) >= config.ChunkSize {
@@ -220,6 +232,7 @@ func (sp *StreamingProcessor) processCSVStream(
 				"records", chunk)
 
 			result, err := sp.processChunkWithRetry(ctx, processor, chunk, headers, chunkIndex, config)
+			report.add(chunk, totalRecords-len(chunk), result, err)
 			if err != nil {
 				sp.Logger.Error("failed to process chunk", "chunk_index", chunkIndex, "error", err)
 				allErrors = append(allErrors, fmt.Sprintf("Chunk %d: %v", chunkIndex, err))
@@ -252,6 +265,7 @@ func (sp *StreamingProcessor) processCSVStream(
 	// Process remaining records in the last chunk
 	if len(chunk) > 0 {
 		result, err := sp.processChunkWithRetry(ctx, processor, chunk, headers, chunkIndex, config)
+		report.add(chunk, totalRecords-len(chunk), result, err)
 		if err != nil {
 			sp.Logger.Error("failed to process final chunk", "chunk_index", chunkIndex, "error", err)
 			allErrors = append(allErrors, fmt.Sprintf("Final chunk %d: %v", chunkIndex, err))
@@ -265,7 +279,7 @@ func (sp *StreamingProcessor) processCSVStream(
 		}
 	}
 
-	return sp.finalizeProcessing(t, totalProcessed, totalSuccessful, totalFailed, allErrors, chunkIndex)
+	return sp.finalizeProcessing(t, totalProcessed, totalSuccessful, totalFailed, allErrors, report, chunkIndex)
 }
 
 // processJSONStream processes a JSON file in streaming fashion
@@ -295,77 +309,99 @@ func (sp *StreamingProcessor) processJSONStream(
 			Mark(ierr.ErrValidation)
 	}
 
-	// Extract headers from first object
-	headers, err := sp.JSONProcessor.ExtractHeaders(decoder)
-	if err != nil {
-		return err
-	}
-
-	sp.Logger.Debugw("parsed JSON headers", "headers", headers)
+	// Numbers are kept as written so large IDs and amounts are not reformatted
+	decoder.UseNumber()
 
-	// Process objects in chunks
-	var chunk [][]string
+	// Process objects in chunks. Headers are the keys of every object read so far, in the
+	// order they first appear, so objects may omit optional fields.
+	var headers []string
+	seenHeaders := make(map[string]bool)
+	report := newErrorReportWriter(nil, config.MaxErrors)
+	var objects []map[string]interface{}
 	chunkIndex := 0
+	totalRecords := 0
 	totalProcessed := 0
 	totalSuccessful := 0
 	totalFailed := 0
 	var allErrors []string
 
+	processObjects := func(final bool) {
+		// Convert objects to string arrays matching CSV format
+		chunk := make([][]string, len(objects))
+		for i, obj := range objects {
+			record := make([]string, len(headers))
+			for j, header := range headers {
+				record[j] = jsonValueToString(obj[header])
+			}
+			chunk[i] = record
+		}
+		report.setHeaders(headers)
+
+		result, err := sp.processChunkWithRetry(ctx, processor, chunk, headers, chunkIndex, config)
+		report.add(chunk, totalRecords-len(chunk), result, err)
+		if err != nil {
+			sp.Logger.Error("failed to process chunk", "chunk_index", chunkIndex, "final", final, "error", err)
+			if final {
+				allErrors = append(allErrors, fmt.Sprintf("Final chunk %d: %v", chunkIndex, err))
+			} else {
+				allErrors = append(allErrors, fmt.Sprintf("Chunk %d: %v", chunkIndex, err))
+			}
+		} else {
+			totalProcessed += result.ProcessedRecords
+			totalSuccessful += result.SuccessfulRecords
+			totalFailed += result.FailedRecords
+			if result.ErrorSummary != nil {
+				allErrors = append(allErrors, *result.ErrorSummary)
+			}
+		}
+	}
+
 	for decoder.More() {
-		var obj map[string]interface{}
-		if err := decoder.Decode(&obj); err != nil {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			// The decoder cannot recover from a syntax error, so stop reading
 			sp.Logger.Error("failed to decode JSON object", "error", err)
 			allErrors = append(allErrors, fmt.Sprintf("JSON decode error: %v", err))
-			continue
+			break
 		}
 
-		// Convert object to string array matching CSV format
-		record := make([]string, len(headers))
-		for i, header := range headers {
-			if val, ok := obj[header]; ok {
-				record[i] = fmt.Sprintf("%v", val)
+		keys, err := jsonObjectKeys(raw)
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if !seenHeaders[key] {
+				seenHeaders[key] = true
+				headers = append(headers, key)
 			}
 		}
 
-		chunk = append(chunk, record)
+		var obj map[string]interface{}
+		objDecoder := json.NewDecoder(bytes.NewReader(raw))
+		objDecoder.UseNumber()
+		if err := objDecoder.Decode(&obj); err != nil {
+			sp.Logger.Error("failed to decode JSON object", "error", err)
+			allErrors = append(allErrors, fmt.Sprintf("JSON decode error: %v", err))
+			continue
+		}
 
-		// Process chunk when it reaches the configured size
-		if len(chunk) >= config.ChunkSize {
-			result, err := sp.processChunkWithRetry(ctx, processor, chunk, headers, chunkIndex, config)
-			if err != nil {
-				sp.Logger.Error("failed to process chunk", "chunk_index", chunkIndex, "error", err)
-				allErrors = append(allErrors, fmt.Sprintf("Chunk %d: %v", chunkIndex, err))
-			} else {
-				totalProcessed += result.ProcessedRecords
-				totalSuccessful += result.SuccessfulRecords
-				totalFailed += result.FailedRecords
-				if result.ErrorSummary != nil {
-					allErrors = append(allErrors, *result.ErrorSummary)
-				}
-			}
+		objects = append(objects, obj)
+		totalRecords++
 
-			chunk = nil // Reset chunk
+		// Process chunk when it reaches the configured size
+		if len(objects) >= config.ChunkSize {
+			processObjects(false)
+			objects = nil // Reset chunk
 			chunkIndex++
 		}
 	}
 
 	// Process remaining records in the last chunk
-	if len(chunk) > 0 {
-		result, err := sp.processChunkWithRetry(ctx, processor, chunk, headers, chunkIndex, config)
-		if err != nil {
-			sp.Logger.Error("failed to process final chunk", "chunk_index", chunkIndex, "error", err)
-			allErrors = append(allErrors, fmt.Sprintf("Final chunk %d: %v", chunkIndex, err))
-		} else {
-			totalProcessed += result.ProcessedRecords
-			totalSuccessful += result.SuccessfulRecords
-			totalFailed += result.FailedRecords
-			if result.ErrorSummary != nil {
-				allErrors = append(allErrors, *result.ErrorSummary)
-			}
-		}
+	if len(objects) > 0 {
+		processObjects(true)
 	}
 
-	return sp.finalizeProcessing(t, totalProcessed, totalSuccessful, totalFailed, allErrors, chunkIndex)
+	return sp.finalizeProcessing(t, totalProcessed, totalSuccessful, totalFailed, allErrors, report, chunkIndex)
 }
 
 // finalizeProcessing updates the task with final processing results
@@ -375,6 +411,7 @@ func (sp *StreamingProcessor) finalizeProcessing(
 	totalSuccessful int,
 	totalFailed int,
 	allErrors []string,
+	report *errorReportWriter,
 	chunkIndex int,
 ) error {
 	// Update final task status
@@ -387,6 +424,12 @@ func (sp *StreamingProcessor) finalizeProcessing(
 		t.ErrorSummary = &errorSummary
 	}
 
+	if errorReport, err := report.finish(); err != nil {
+		sp.Logger.Error("failed to write error report", "task_id", t.ID, "error", err)
+	} else {
+		t.ErrorReport = errorReport
+	}
+
 	sp.Logger.Infow("completed streaming processing",
 		"task_id", t.ID,
 		"total_processed", totalProcessed,
@@ -504,3 +547,147 @@ func (sp *StreamingProcessor) updateTaskProgress(ctx context.Context, t *task.Ta
 func (sp *StreamingProcessor) Close() {
 	// No resources to clean up
 }
+
+// errorReportWriter collects the failed records of an import into a CSV that keeps the
+// original columns, prefixed with the record's position in the file and the error, so
+// the rows can be fixed and uploaded again as a new import
+type errorReportWriter struct {
+	headers []string
+	rows    [][]string
+	maxRows int
+}
+
+// newErrorReportWriter creates a report for a file with the given headers
+func newErrorReportWriter(headers []string, maxRows int) *errorReportWriter {
+	return &errorReportWriter{
+		headers: headers,
+		maxRows: maxRows,
+	}
+}
+
+// setHeaders replaces the file headers. JSON files can add columns as later objects are read.
+func (w *errorReportWriter) setHeaders(headers []string) {
+	if w == nil {
+		return
+	}
+	w.headers = headers
+}
+
+// add records the failed records of a processed chunk. firstRow is the number of records
+// read before the chunk. If the chunk failed as a whole every record is written with chunkErr.
+func (w *errorReportWriter) add(chunk [][]string, firstRow int, result *ChunkResult, chunkErr error) {
+	if w == nil {
+		return
+	}
+
+	var rowErrors []RowError
+	if chunkErr != nil {
+		rowErrors = make([]RowError, len(chunk))
+		for i := range chunk {
+			rowErrors[i] = RowError{Index: i, Error: chunkErr.Error()}
+		}
+	} else if result != nil {
+		rowErrors = result.RowErrors
+	}
+
+	for _, rowErr := range rowErrors {
+		if len(w.rows) >= w.maxRows {
+			return
+		}
+		if rowErr.Index < 0 || rowErr.Index >= len(chunk) {
+			continue
+		}
+
+		row := append([]string{strconv.Itoa(firstRow + rowErr.Index + 1), rowErr.Error}, chunk[rowErr.Index]...)
+		w.rows = append(w.rows, row)
+	}
+}
+
+// finish returns the report as CSV, or nil if no record failed
+func (w *errorReportWriter) finish() (*string, error) {
+	if w == nil || len(w.rows) == 0 {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	columns := len(w.headers) + 2
+	if err := writer.Write(append([]string{"row", "error"}, w.headers...)); err != nil {
+		return nil, err
+	}
+	for _, row := range w.rows {
+		// Pad short records so every row has the same number of columns
+		record := make([]string, columns)
+		copy(record, row)
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	report := buf.String()
+	return &report, nil
+}
+
+// jsonObjectKeys returns the keys of a JSON object in the order they appear
+func jsonObjectKeys(raw json.RawMessage) ([]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, ierr.NewErrorf("failed to read object start: %v", err).
+			WithHint("Invalid JSON format").
+			Mark(ierr.ErrValidation)
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return nil, ierr.NewError("JSON array must contain objects").
+			WithHint("Invalid JSON format").
+			Mark(ierr.ErrValidation)
+	}
+
+	var keys []string
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return nil, ierr.NewErrorf("failed to read field name: %v", err).
+				WithHint("Invalid JSON format").
+				Mark(ierr.ErrValidation)
+		}
+		if str, ok := key.(string); ok {
+			keys = append(keys, str)
+		}
+
+		// Skip the value
+		var v json.RawMessage
+		if err := decoder.Decode(&v); err != nil {
+			return nil, ierr.NewErrorf("failed to skip value: %v", err).
+				WithHint("Invalid JSON format").
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	return keys, nil
+}
+
+// jsonValueToString converts a decoded JSON value to the string a CSV cell would hold.
+// Objects and arrays are kept as JSON so columns like line_items parse the same way.
+func jsonValueToString(val interface{}) string {
+	switch v := val.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case map[string]interface{}, []interface{}:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(encoded)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
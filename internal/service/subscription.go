@@ -90,6 +90,17 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 			Mark(ierr.ErrValidation)
 	}
 
+	// Default to the customer's billing currency
+	if req.Currency == "" {
+		if customer.Currency == "" {
+			return nil, ierr.NewError("currency is required").
+				WithHint("Please provide a currency or set a billing currency on the customer").
+				WithReportableDetails(map[string]interface{}{"customer_id": customer.ID}).
+				Mark(ierr.ErrValidation)
+		}
+		req.Currency = customer.Currency
+	}
+
 	// Get and validate plan
 	plan, err := s.PlanRepo.Get(ctx, req.PlanID)
 	if err != nil {
@@ -153,6 +164,13 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 	sub.CurrentPeriodStart = sub.StartDate
 	sub.CurrentPeriodEnd = nextBillingDate
 
+	// Migrated subscriptions continue from the period in progress in the previous billing system
+	if req.Migration != nil {
+		if err := applySubscriptionMigration(sub, req.Migration, req.BillingAnchor != nil); err != nil {
+			return nil, err
+		}
+	}
+
 	err = setCreateSubscriptionTrialWindow(&req, sub, validPrices)
 	if err != nil {
 		return nil, err
@@ -299,9 +317,13 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 			}
 		}
 
+		if err := s.createLocalizedPrices(ctx, sub, validPrices); err != nil {
+			return err
+		}
 		if err := s.SubRepo.CreateWithLineItems(ctx, sub, sub.LineItems); err != nil {
 			return err
 		}
+		s.recordAuditLog(ctx, types.AuditLogEntityTypeSubscription, sub.ID, types.AuditLogActionCreate, nil, sub)
 
 		if len(req.Addons) > 0 {
 			if err = s.handleSubscriptionAddons(ctx, sub, req.Addons); err != nil {
@@ -352,11 +374,17 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 						PeriodCount:            cg.PeriodCount,
 						ConversionRate:         cg.ConversionRate,
 						TopupConversionRate:    cg.TopupConversionRate,
+						RolloverConfig:         cg.RolloverConfig,
 					})
 				}
 			}
 		}
-		if err = s.handleCreditGrants(ctx, sub, creditGrantRequests); err != nil {
+		// Migrated subscriptions only receive grants from the current period on
+		creditGrantStart := sub.StartDate
+		if req.Migration != nil {
+			creditGrantStart = sub.CurrentPeriodStart
+		}
+		if err = s.handleCreditGrants(ctx, sub, creditGrantRequests, creditGrantStart); err != nil {
 			return err
 		}
 		if err = s.handleTaxRateLinking(ctx, sub, req); err != nil {
@@ -413,8 +441,17 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 			}
 		}
 
+		// Migrated subscriptions whose current period was invoiced by the previous system start active without an opening invoice
+		currentPeriodInvoiced := req.Migration != nil && req.Migration.CurrentPeriodInvoiced(sub.CurrentPeriodEnd)
+		if currentPeriodInvoiced && sub.SubscriptionStatus == types.SubscriptionStatusIncomplete {
+			sub.SubscriptionStatus = types.SubscriptionStatusActive
+			if err = s.SubRepo.Update(ctx, sub); err != nil {
+				return err
+			}
+		}
+
 		// Create invoice for non-draft, non-trialing subscriptions (trial conversion invoice is created at trial end).
-		if sub.SubscriptionStatus != types.SubscriptionStatusDraft && sub.SubscriptionStatus != types.SubscriptionStatusTrialing {
+		if !currentPeriodInvoiced && sub.SubscriptionStatus != types.SubscriptionStatusDraft && sub.SubscriptionStatus != types.SubscriptionStatusTrialing {
 			paymentParams := dto.NewPaymentParametersFromSubscription(sub.CollectionMethod, sub.PaymentBehavior, sub.GatewayPaymentMethodID).NormalizePaymentParameters()
 			invoice, updatedSub, err = invoiceService.CreateSubscriptionInvoice(ctx, &dto.CreateSubscriptionInvoiceRequest{
 				SubscriptionID: sub.ID,
@@ -1353,6 +1390,7 @@ func (s *subscriptionService) handleCreditGrants(
 	ctx context.Context,
 	subscription *subscription.Subscription,
 	creditGrantRequests []dto.CreateCreditGrantRequest,
+	startDate time.Time,
 ) error {
 	if len(creditGrantRequests) == 0 {
 		return nil
@@ -1400,7 +1438,6 @@ func (s *subscriptionService) handleCreditGrants(
 	}
 
 	// Create and apply credit grants
-	startDate := subscription.StartDate
 	if subscription.TrialEnd != nil {
 		startDate = lo.FromPtr(subscription.TrialEnd)
 	}
@@ -1412,7 +1449,7 @@ func (s *subscriptionService) handleCreditGrants(
 		grantReq.StartDate = lo.ToPtr(startDate)
 		grantReq.EndDate = subscription.EndDate
 
-		// Use subscription start date as the anchor for the credit grant chain
+		// Use the grant start date as the anchor for the credit grant chain
 		grantReq.CreditGrantAnchor = lo.ToPtr(startDate)
 
 		// Create credit grant: this now triggers initializeCreditGrantWorkflow
@@ -1700,6 +1737,8 @@ func (s *subscriptionService) UpdateSubscription(ctx context.Context, subscripti
 		}
 	}
 
+	before := *subscription
+
 	// Update fields from request
 	if req.Status != "" {
 		subscription.SubscriptionStatus = req.Status
@@ -1719,6 +1758,7 @@ func (s *subscriptionService) UpdateSubscription(ctx context.Context, subscripti
 			WithHint("Failed to update subscription").
 			Mark(ierr.ErrDatabase)
 	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeSubscription, subscription.ID, types.AuditLogActionUpdate, &before, subscription)
 
 	logger.Info("successfully updated subscription")
 
@@ -1876,10 +1916,12 @@ func (s *subscriptionService) CancelSubscription(
 		}
 
 		// Step 7: Update subscription status
+		before := *subscription
 		err = s.updateSubscriptionForCancellation(ctx, subscription, req.CancellationType, effectiveDate, req.Reason)
 		if err != nil {
 			return err
 		}
+		s.recordAuditLog(ctx, types.AuditLogEntityTypeSubscription, subscription.ID, types.AuditLogActionCancel, &before, subscription)
 
 		if err := s.CascadeCancelToInheritedSubscriptions(ctx, subscription); err != nil {
 			return err
@@ -3277,6 +3319,41 @@ func filterValidPricesForSubscription(prices []*dto.PriceResponse, subscription
 	return validPrices
 }
 
+// pricesWithoutCurrencyCounterpart returns the prices in other currencies than currency that
+// have no price in currency charging for the same thing: same type, meter and cadence
+func pricesWithoutCurrencyCounterpart(prices []*dto.PriceResponse, currency string) []*dto.PriceResponse {
+	counterpartKey := func(p *dto.PriceResponse) string {
+		return fmt.Sprintf("%s:%s:%s:%d:%s", p.Type, p.MeterID, p.BillingPeriod, p.BillingPeriodCount, p.InvoiceCadence)
+	}
+
+	priced := make(map[string]bool)
+	for _, p := range prices {
+		if types.IsMatchingCurrency(p.Currency, currency) {
+			priced[counterpartKey(p)] = true
+		}
+	}
+
+	return lo.Filter(prices, func(p *dto.PriceResponse, _ int) bool {
+		return !types.IsMatchingCurrency(p.Currency, currency) && !priced[counterpartKey(p)]
+	})
+}
+
+// createLocalizedPrices saves the prices localized for a subscription through a price book.
+// They are built before the subscription and saved in its transaction, so a failed
+// subscription leaves none behind.
+func (s *subscriptionService) createLocalizedPrices(ctx context.Context, sub *subscription.Subscription, prices []*dto.PriceResponse) error {
+	for _, p := range prices {
+		if p.EntityType != types.PRICE_ENTITY_TYPE_SUBSCRIPTION || p.EntityID != sub.ID || p.Metadata["price_book_id"] == "" {
+			continue
+		}
+		if err := s.PriceRepo.Create(ctx, p.Price); err != nil {
+			return err
+		}
+		s.recordAuditLog(ctx, types.AuditLogEntityTypePrice, p.ID, types.AuditLogActionCreate, nil, p.Price)
+	}
+	return nil
+}
+
 // ValidateAndFilterPricesForSubscription validates and filters prices for a subscription
 // This method follows the same validation pattern as plans and can be reused for addons
 func (s *subscriptionService) ValidateAndFilterPricesForSubscription(
@@ -3305,6 +3382,19 @@ func (s *subscriptionService) ValidateAndFilterPricesForSubscription(
 		return nil, err
 	}
 
+	// Localize the entity's prices that have no counterpart in the subscription currency
+	// through a price book
+	if s.PriceBookRepo != nil {
+		if unlocalized := pricesWithoutCurrencyCounterpart(pricesResponse.Items, subscription.Currency); len(unlocalized) > 0 {
+			localizedPrices, err := NewPriceBookService(s.ServiceParams).
+				LocalizeSubscriptionPrices(ctx, subscription, entityType, entityID, unlocalized)
+			if err != nil {
+				return nil, err
+			}
+			pricesResponse.Items = append(pricesResponse.Items, localizedPrices...)
+		}
+	}
+
 	// Check if empty prices are allowed for this workflow type
 	if !s.allowsEmptyPrices(workflowType) {
 		if len(pricesResponse.Items) == 0 {
@@ -3930,6 +4020,8 @@ func (s *subscriptionService) calculateBillingImpact(
 }
 
 func (s *subscriptionService) publishSystemEvent(ctx context.Context, eventName types.WebhookEventName, subscriptionID string) {
+	// Subscription changes alter the customer's effective entitlements
+	NewEntitlementCheckService(s.ServiceParams).InvalidateSubscription(ctx, subscriptionID)
 
 	eventPayload := webhookDto.InternalSubscriptionEvent{
 		SubscriptionID: subscriptionID,
@@ -4024,6 +4116,25 @@ func (s *subscriptionService) handleSubCoupons(
 		}
 	}
 
+	// Promotion codes are checked against the customer and redeemed as subscription-level coupons
+	if len(req.PromotionCodes) > 0 {
+		promotionCodeService := NewPromotionCodeService(s.ServiceParams)
+		for _, code := range lo.Uniq(req.PromotionCodes) {
+			if code == "" {
+				continue
+			}
+			p, c, err := promotionCodeService.ResolvePromotionCode(ctx, code, sub.CustomerID, sub.Currency, sub.ID)
+			if err != nil {
+				return err
+			}
+			subscriptionCoupons = append(subscriptionCoupons, dto.SubscriptionCouponRequest{
+				CouponID:        c.ID,
+				StartDate:       sub.StartDate,
+				PromotionCodeID: lo.ToPtr(p.ID),
+			})
+		}
+	}
+
 	if len(subscriptionCoupons) == 0 {
 		return nil
 	}
@@ -4197,6 +4308,10 @@ func (s *subscriptionService) addAddonToSubscription(
 	}
 
 	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.createLocalizedPrices(ctx, sub, validPrices); err != nil {
+			return err
+		}
+
 		// Create subscription addon association
 		err = s.AddonAssociationRepo.Create(ctx, addonAssociation)
 		if err != nil {
@@ -4218,6 +4333,8 @@ func (s *subscriptionService) addAddonToSubscription(
 		return nil, err
 	}
 
+	NewEntitlementCheckService(s.ServiceParams).InvalidateCustomer(ctx, sub.CustomerID)
+
 	effectiveDate := addonRequestedStart
 	for _, li := range lineItems {
 		if li.StartDate.After(effectiveDate) {
@@ -4546,6 +4663,10 @@ func (s *subscriptionService) RemoveAddonFromSubscription(ctx context.Context, r
 		return err
 	}
 
+	if sub != nil {
+		NewEntitlementCheckService(s.ServiceParams).InvalidateCustomer(ctx, sub.CustomerID)
+	}
+
 	// Issue wallet credit for unused prepaid time if proration is requested.
 	// Onetime addons (EndDate set) are skipped automatically inside LineItemProrationService.
 	if sub != nil && effectiveEndDate != nil {
@@ -6291,6 +6412,7 @@ func (s *subscriptionService) queryBucketedMeterUsage(
 // 1. The subscription's plan
 // 2. Active addon associations (one-time addons counted once, multiple addons counted per occurrence)
 // 3. Subscription-scoped entitlement overrides
+// Plan entitlements of per-seat features are scaled by the subscription's seat count.
 // Note: If a plan/addon entitlement has been overridden at the subscription level,
 // only the subscription-scoped override is returned, not the original.
 func (s *subscriptionService) GetSubscriptionEntitlements(ctx context.Context, subscriptionID string) ([]*dto.EntitlementResponse, error) {
@@ -6395,9 +6517,24 @@ func (s *subscriptionService) GetSubscriptionEntitlements(ctx context.Context, s
 	}
 	subscriptionEntitlements := subscriptionEntResp.Items
 
-	// Step 6: Filter out overridden entitlements and combine results
+	// Step 6: Scale plan entitlements of per-seat features by the current seat count.
+	// Subscription-scoped overrides are explicit limits and are not scaled.
+	planItems := planEntitlements.Items
+	if sub.SeatConfig != nil && len(sub.SeatConfig.PerSeatFeatureIDs) > 0 {
+		lineItems, err := s.SubscriptionLineItemRepo.ListBySubscription(ctx, sub)
+		if err != nil {
+			return nil, err
+		}
+		seats := int64(0)
+		if item := seatLineItemAt(lineItems, sub.SeatConfig.PriceID, time.Now().UTC()); item != nil {
+			seats = item.Quantity.IntPart()
+		}
+		planItems = applySeatsToEntitlements(planItems, sub.SeatConfig, seats)
+	}
+
+	// Step 7: Filter out overridden entitlements and combine results
 	finalEntitlements := s.filterOverriddenEntitlements(
-		planEntitlements.Items,
+		planItems,
 		addonEntitlements,
 		subscriptionEntitlements,
 		subscriptionID,
@@ -7337,6 +7474,21 @@ func (s *subscriptionService) prepareSubscriptionInheritanceForCreate(ctx contex
 		}
 	}
 
+	// route invoices to the paying ancestor of customers that invoice to their parent
+	if sub.InvoicingCustomerID == nil && sub.CustomerID != "" {
+		subscriber, err := s.CustomerRepo.Get(ctx, sub.CustomerID)
+		if err != nil {
+			return nil, err
+		}
+		payingCustomerID, err := newCustomerHierarchy(s.CustomerRepo).GetPayingCustomerID(ctx, subscriber)
+		if err != nil {
+			return nil, err
+		}
+		if payingCustomerID != sub.CustomerID {
+			sub.InvoicingCustomerID = lo.ToPtr(payingCustomerID)
+		}
+	}
+
 	// validate that the subscriber does not have an inherited subscription
 	if sub.SubscriptionType == types.SubscriptionTypeStandalone || sub.SubscriptionType == types.SubscriptionTypeParent {
 		subscriberFilter := types.NewSubscriptionFilter()
@@ -82,7 +82,12 @@ func (s *subscriptionService) AddSubscriptionLineItem(ctx context.Context, subsc
 		if err := s.validateSubscriptionLevelCommitment(sub); err != nil {
 			return err
 		}
-		return s.SubscriptionLineItemRepo.Create(txCtx, lineItem)
+		if err := s.SubscriptionLineItemRepo.Create(txCtx, lineItem); err != nil {
+			return err
+		}
+
+		s.recordAuditLog(txCtx, types.AuditLogEntityTypeSubscriptionLineItem, lineItem.ID, types.AuditLogActionCreate, nil, lineItem)
+		return nil
 	})
 	if err != nil {
 		return nil, err
@@ -305,6 +310,8 @@ func (s *subscriptionService) DeleteSubscriptionLineItem(ctx context.Context, li
 		return nil, err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeSubscriptionLineItem, lineItem.ID, types.AuditLogActionDelete, &lineItemForProration, lineItem)
+
 	// Apply proration for the removal if requested. Skip usage prices.
 	// Use lineItemForProration (EndDate still zero) so Compute doesn't treat this as onetime.
 	if req.ProrationBehavior == types.ProrationBehaviorCreateProrations &&
@@ -494,6 +501,8 @@ func (s *subscriptionService) UpdateSubscriptionLineItem(ctx context.Context, li
 			if err := s.SubscriptionLineItemRepo.Create(ctx, newLineItem); err != nil {
 				return err
 			}
+
+			s.recordAuditLog(ctx, types.AuditLogEntityTypeSubscriptionLineItem, newLineItem.ID, types.AuditLogActionCreate, nil, newLineItem)
 			return nil
 		})
 
@@ -510,6 +519,8 @@ func (s *subscriptionService) UpdateSubscriptionLineItem(ctx context.Context, li
 
 		return &dto.SubscriptionLineItemResponse{SubscriptionLineItem: newLineItem}, nil
 	} else {
+		before := *existingLineItem
+
 		// Update metadata and commitment fields if provided
 		if req.Metadata != nil {
 			existingLineItem.Metadata = req.Metadata
@@ -551,6 +562,8 @@ func (s *subscriptionService) UpdateSubscriptionLineItem(ctx context.Context, li
 			return nil, err
 		}
 
+		s.recordAuditLog(ctx, types.AuditLogEntityTypeSubscriptionLineItem, existingLineItem.ID, types.AuditLogActionUpdate, &before, existingLineItem)
+
 		s.Logger.InfowCtx(ctx, "updated subscription line item",
 			"subscription_id", sub.ID,
 			"line_item_id", existingLineItem.ID)
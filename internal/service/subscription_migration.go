@@ -0,0 +1,92 @@
+package service
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// applySubscriptionMigration carries the billing position of a subscription migrated from another
+// billing system over to the new subscription. The anchor follows the next billing date (unless
+// billing_anchor was passed) and the current period is the one in progress rather than the first
+// period after start_date, so the renewal job never catches up on (and invoices) past periods.
+func applySubscriptionMigration(sub *subscription.Subscription, migration *dto.SubscriptionMigrationConfig, anchorProvided bool) error {
+	if migration.NextBillingDate != nil && !anchorProvided && sub.BillingCycle == types.BillingCycleAnniversary {
+		sub.BillingAnchor = migration.NextBillingDate.UTC().Truncate(time.Millisecond)
+	}
+
+	if migration.CurrentPeriodStart != nil {
+		sub.CurrentPeriodStart = migration.CurrentPeriodStart.UTC().Truncate(time.Millisecond)
+		sub.CurrentPeriodEnd = migration.NextBillingDate.UTC().Truncate(time.Millisecond)
+	} else {
+		firstPeriodEnd, err := types.NextBillingDate(sub.StartDate, sub.BillingAnchor, sub.BillingPeriodCount, sub.BillingPeriod, nil)
+		if err != nil {
+			return err
+		}
+
+		// Find the period in progress, or the one ending on the next billing date
+		target := time.Now().UTC()
+		if migration.NextBillingDate != nil {
+			target = migration.NextBillingDate.UTC().Add(-time.Millisecond)
+		}
+		if target.Before(sub.StartDate) {
+			target = sub.StartDate
+		}
+
+		period, err := types.FindPeriodForDate(target, sub.StartDate, firstPeriodEnd, sub.BillingAnchor, sub.BillingPeriodCount, sub.BillingPeriod)
+		if err != nil {
+			return err
+		}
+
+		if migration.NextBillingDate != nil && !period.End.Equal(migration.NextBillingDate.UTC().Truncate(time.Millisecond)) {
+			return ierr.NewError("next_billing_date is not a billing date of the subscription").
+				WithHint("Pass current_period_start as well, or set a billing_anchor that lands on next_billing_date").
+				WithReportableDetails(map[string]interface{}{
+					"next_billing_date":  *migration.NextBillingDate,
+					"derived_period_end": period.End,
+					"billing_anchor":     sub.BillingAnchor,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		sub.CurrentPeriodStart = period.Start
+		sub.CurrentPeriodEnd = period.End
+	}
+
+	if sub.EndDate != nil {
+		if !sub.EndDate.After(sub.CurrentPeriodStart) {
+			return ierr.NewError("end_date must be after the current period start").
+				WithHint("Subscriptions that already ended cannot be migrated").
+				WithReportableDetails(map[string]interface{}{
+					"end_date":             *sub.EndDate,
+					"current_period_start": sub.CurrentPeriodStart,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if sub.EndDate.Before(sub.CurrentPeriodEnd) {
+			sub.CurrentPeriodEnd = *sub.EndDate
+		}
+	}
+
+	if err := migration.ValidateInvoicedThrough(sub.CurrentPeriodStart, sub.CurrentPeriodEnd); err != nil {
+		return err
+	}
+
+	if sub.Metadata == nil {
+		sub.Metadata = types.Metadata{}
+	}
+	if migration.Source != "" {
+		sub.Metadata[dto.SubscriptionMetadataMigrationSource] = migration.Source
+	}
+	if migration.ExternalSubscriptionID != "" {
+		sub.Metadata[dto.SubscriptionMetadataMigrationExternalID] = migration.ExternalSubscriptionID
+	}
+	if migration.InvoicedThrough != nil {
+		sub.Metadata[dto.SubscriptionMetadataInvoicedThrough] = migration.InvoicedThrough.UTC().Format(time.RFC3339)
+	}
+
+	return nil
+}
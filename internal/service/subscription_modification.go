@@ -308,6 +308,46 @@ func validateQuantityChangeEffectiveDateWithinLineItemWindow(
 	return nil
 }
 
+// newQuantityChangeLineItem copies a line item with a new quantity, starting at effectiveDate.
+// The caller ends the original line item at the same instant.
+func newQuantityChangeLineItem(
+	ctx context.Context,
+	lineItem *subscription.SubscriptionLineItem,
+	quantity decimal.Decimal,
+	effectiveDate time.Time,
+) *subscription.SubscriptionLineItem {
+	return &subscription.SubscriptionLineItem{
+		ID:                      types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_LINE_ITEM),
+		SubscriptionID:          lineItem.SubscriptionID,
+		CustomerID:              lineItem.CustomerID,
+		EntityID:                lineItem.EntityID,
+		EntityType:              lineItem.EntityType,
+		PlanDisplayName:         lineItem.PlanDisplayName,
+		PriceID:                 lineItem.PriceID,
+		PriceType:               lineItem.PriceType,
+		MeterID:                 lineItem.MeterID,
+		MeterDisplayName:        lineItem.MeterDisplayName,
+		PriceUnitID:             lineItem.PriceUnitID,
+		PriceUnit:               lineItem.PriceUnit,
+		DisplayName:             lineItem.DisplayName,
+		Quantity:                quantity,
+		Currency:                lineItem.Currency,
+		BillingPeriod:           lineItem.BillingPeriod,
+		BillingPeriodCount:      lineItem.BillingPeriodCount,
+		InvoiceCadence:          lineItem.InvoiceCadence,
+		StartDate:               effectiveDate,
+		CommitmentAmount:        lineItem.CommitmentAmount,
+		CommitmentQuantity:      lineItem.CommitmentQuantity,
+		CommitmentType:          lineItem.CommitmentType,
+		CommitmentOverageFactor: lineItem.CommitmentOverageFactor,
+		CommitmentTrueUpEnabled: lineItem.CommitmentTrueUpEnabled,
+		CommitmentWindowed:      lineItem.CommitmentWindowed,
+		CommitmentDuration:      lineItem.CommitmentDuration,
+		EnvironmentID:           lineItem.EnvironmentID,
+		BaseModel:               types.GetDefaultBaseModel(ctx),
+	}
+}
+
 func (s *subscriptionModificationService) executeQuantityChange(
 	ctx context.Context,
 	subscriptionID string,
@@ -429,36 +469,7 @@ func (s *subscriptionModificationService) executeQuantityChange(
 			}
 
 			// Create new line item (copy with new quantity)
-			newItem := &subscription.SubscriptionLineItem{
-				ID:                      types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_LINE_ITEM),
-				SubscriptionID:          lineItem.SubscriptionID,
-				CustomerID:              lineItem.CustomerID,
-				EntityID:                lineItem.EntityID,
-				EntityType:              lineItem.EntityType,
-				PlanDisplayName:         lineItem.PlanDisplayName,
-				PriceID:                 lineItem.PriceID,
-				PriceType:               lineItem.PriceType,
-				MeterID:                 lineItem.MeterID,
-				MeterDisplayName:        lineItem.MeterDisplayName,
-				PriceUnitID:             lineItem.PriceUnitID,
-				PriceUnit:               lineItem.PriceUnit,
-				DisplayName:             lineItem.DisplayName,
-				Quantity:                change.Quantity,
-				Currency:                lineItem.Currency,
-				BillingPeriod:           lineItem.BillingPeriod,
-				BillingPeriodCount:      lineItem.BillingPeriodCount,
-				InvoiceCadence:          lineItem.InvoiceCadence,
-				StartDate:               effectiveDate,
-				CommitmentAmount:        lineItem.CommitmentAmount,
-				CommitmentQuantity:      lineItem.CommitmentQuantity,
-				CommitmentType:          lineItem.CommitmentType,
-				CommitmentOverageFactor: lineItem.CommitmentOverageFactor,
-				CommitmentTrueUpEnabled: lineItem.CommitmentTrueUpEnabled,
-				CommitmentWindowed:      lineItem.CommitmentWindowed,
-				CommitmentDuration:      lineItem.CommitmentDuration,
-				EnvironmentID:           lineItem.EnvironmentID,
-				BaseModel:               types.GetDefaultBaseModel(txCtx),
-			}
+			newItem := newQuantityChangeLineItem(txCtx, lineItem, change.Quantity, effectiveDate)
 			if err := sp.SubscriptionLineItemRepo.Create(txCtx, newItem); err != nil {
 				return err
 			}
@@ -678,21 +689,22 @@ func (s *subscriptionModificationService) previewQuantityChange(
 	}, nil
 }
 
-// handleQuantityChangeProration handles the proration logic for quantity changes on in-advance line items.
-func (s *subscriptionModificationService) handleQuantityChangeProration(
+// calculateQuantityChangeProration prorates a quantity change of an in-advance line item over the
+// rest of the current period. A positive net amount is a charge, a negative one a credit.
+func (s *subscriptionModificationService) calculateQuantityChangeProration(
 	ctx context.Context,
 	sub *subscription.Subscription,
 	oldItem *subscription.SubscriptionLineItem,
 	newItem *subscription.SubscriptionLineItem,
 	effectiveDate time.Time,
-) (*dto.ChangedInvoice, error) {
+) (*dto.PriceResponse, *proration.ProrationResult, error) {
 	sp := s.serviceParams
 	prorationSvc := NewProrationService(sp)
 	priceSvc := NewPriceService(sp)
 
 	price, err := priceSvc.GetPrice(ctx, oldItem.PriceID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	customerTimezone := sub.CustomerTimezone
@@ -721,6 +733,21 @@ func (s *subscriptionModificationService) handleQuantityChangeProration(
 	}
 
 	result, err := prorationSvc.CalculateProration(ctx, prorationParams)
+	if err != nil {
+		return nil, nil, err
+	}
+	return price, result, nil
+}
+
+// handleQuantityChangeProration handles the proration logic for quantity changes on in-advance line items.
+func (s *subscriptionModificationService) handleQuantityChangeProration(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	oldItem *subscription.SubscriptionLineItem,
+	newItem *subscription.SubscriptionLineItem,
+	effectiveDate time.Time,
+) (*dto.ChangedInvoice, error) {
+	price, result, err := s.calculateQuantityChangeProration(ctx, sub, oldItem, newItem, effectiveDate)
 	if err != nil {
 		return nil, err
 	}
@@ -729,7 +756,23 @@ func (s *subscriptionModificationService) handleQuantityChangeProration(
 		return nil, nil
 	}
 
-	if result.NetAmount.GreaterThan(decimal.Zero) {
+	return s.applyQuantityChangeProration(ctx, sub, oldItem, newItem, effectiveDate, price, result.NetAmount)
+}
+
+// applyQuantityChangeProration invoices a positive prorated amount as a delta-only invoice and
+// credits a negative one to the customer's wallet.
+func (s *subscriptionModificationService) applyQuantityChangeProration(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	oldItem *subscription.SubscriptionLineItem,
+	newItem *subscription.SubscriptionLineItem,
+	effectiveDate time.Time,
+	price *dto.PriceResponse,
+	netAmount decimal.Decimal,
+) (*dto.ChangedInvoice, error) {
+	sp := s.serviceParams
+
+	if netAmount.GreaterThan(decimal.Zero) {
 		// Upgrade: create a delta-only invoice for exactly the prorated amount (Stripe-style).
 		// We do NOT re-bill the full remaining period — only the incremental charge.
 		invoiceSvc := NewInvoiceService(sp)
@@ -756,7 +799,7 @@ func (s *subscriptionModificationService) handleQuantityChangeProration(
 				PriceType:       &priceType,
 				PlanDisplayName: &planDisplayName,
 				DisplayName:     &displayName,
-				Amount:          result.NetAmount,
+				Amount:          netAmount,
 				Quantity:        qtyDelta,
 				PeriodStart:     &effectiveDate,
 				PeriodEnd:       &periodEnd,
@@ -773,9 +816,9 @@ func (s *subscriptionModificationService) handleQuantityChangeProration(
 			InvoiceType:    types.InvoiceTypeOneOff,
 			Currency:       sub.Currency,
 			BillingReason:  types.InvoiceBillingReasonSubscriptionUpdate,
-			AmountDue:      result.NetAmount,
-			Total:          result.NetAmount,
-			Subtotal:       result.NetAmount,
+			AmountDue:      netAmount,
+			Total:          netAmount,
+			Subtotal:       netAmount,
 			PeriodStart:    &effectiveDate,
 			PeriodEnd:      &periodEnd,
 			BillingPeriod:  &billingPeriod,
@@ -805,7 +848,7 @@ func (s *subscriptionModificationService) handleQuantityChangeProration(
 
 	// Downgrade: wallet credit
 	walletSvc := NewWalletService(sp)
-	creditAmount := result.NetAmount.Abs()
+	creditAmount := netAmount.Abs()
 	billingCustomer := sub.GetInvoicingCustomerID()
 	// Stable idempotency key: prevents duplicate credits if this call is retried.
 	idempotencyKey := fmt.Sprintf("proration_credit_%s_%s_%s", sub.ID, oldItem.ID, effectiveDate.Format(time.RFC3339))
@@ -971,41 +1014,7 @@ func (s *subscriptionModificationService) previewQuantityChangeProration(
 	newItem *subscription.SubscriptionLineItem,
 	effectiveDate time.Time,
 ) (*dto.ChangedInvoice, error) {
-	sp := s.serviceParams
-	prorationSvc := NewProrationService(sp)
-	priceSvc := NewPriceService(sp)
-
-	price, err := priceSvc.GetPrice(ctx, oldItem.PriceID)
-	if err != nil {
-		return nil, err
-	}
-
-	customerTimezone := sub.CustomerTimezone
-	if customerTimezone == "" {
-		customerTimezone = "UTC"
-	}
-
-	prorationParams := proration.ProrationParams{
-		SubscriptionID:     sub.ID,
-		LineItemID:         oldItem.ID,
-		PlanPayInAdvance:   price.Price.InvoiceCadence == types.InvoiceCadenceAdvance,
-		CurrentPeriodStart: sub.CurrentPeriodStart,
-		CurrentPeriodEnd:   sub.CurrentPeriodEnd.Add(-time.Second),
-		Action:             types.ProrationActionQuantityChange,
-		NewPriceID:         newItem.PriceID,
-		OldQuantity:        oldItem.Quantity,
-		NewQuantity:        newItem.Quantity,
-		NewPricePerUnit:    price.Price.Amount,
-		OldPricePerUnit:    price.Price.Amount,
-		ProrationDate:      effectiveDate,
-		ProrationBehavior:  types.ProrationBehaviorCreateProrations,
-		ProrationStrategy:  types.StrategySecondBased,
-		Currency:           sub.Currency,
-		PlanDisplayName:    oldItem.PlanDisplayName,
-		CustomerTimezone:   customerTimezone,
-	}
-
-	result, err := prorationSvc.CalculateProration(ctx, prorationParams)
+	price, result, err := s.calculateQuantityChangeProration(ctx, sub, oldItem, newItem, effectiveDate)
 	if err != nil {
 		return nil, err
 	}
@@ -1142,6 +1151,8 @@ func (s *subscriptionModificationService) createInheritedSubscription(ctx contex
 
 // publishSystemEvent publishes a webhook event for a subscription change.
 func (s *subscriptionModificationService) publishSystemEvent(ctx context.Context, eventName types.WebhookEventName, subscriptionID string) {
+	NewEntitlementCheckService(s.serviceParams).InvalidateSubscription(ctx, subscriptionID)
+
 	eventPayload := webhookDto.InternalSubscriptionEvent{
 		SubscriptionID: subscriptionID,
 		TenantID:       types.GetTenantID(ctx),
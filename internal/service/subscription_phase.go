@@ -57,6 +57,8 @@ func (s *subscriptionPhaseService) CreateSubscriptionPhase(ctx context.Context,
 		return nil, err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeSubscriptionPhase, phaseModel.ID, types.AuditLogActionCreate, nil, phaseModel)
+
 	// Publish webhook event
 	s.publishSystemEvent(ctx, types.WebhookEventSubscriptionPhaseCreated, phaseModel.ID)
 
@@ -140,6 +142,7 @@ func (s *subscriptionPhaseService) UpdateSubscriptionPhase(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	before := *phase
 
 	// Only metadata can be updated - start_date and end_date are immutable
 	if req.Metadata != nil {
@@ -153,6 +156,8 @@ func (s *subscriptionPhaseService) UpdateSubscriptionPhase(ctx context.Context,
 		return nil, err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeSubscriptionPhase, id, types.AuditLogActionUpdate, &before, phase)
+
 	// Publish webhook event
 	s.publishSystemEvent(ctx, types.WebhookEventSubscriptionPhaseUpdated, phase.ID)
 
@@ -183,6 +188,8 @@ func (s *subscriptionPhaseService) DeleteSubscriptionPhase(ctx context.Context,
 		return err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeSubscriptionPhase, id, types.AuditLogActionDelete, phase, nil)
+
 	// Publish webhook event
 	s.publishSystemEvent(ctx, types.WebhookEventSubscriptionPhaseDeleted, id)
 
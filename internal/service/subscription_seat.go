@@ -0,0 +1,665 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	webhookDto "github.com/flexprice/flexprice/internal/webhook/dto"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionSeatService manages seat-based licensing of subscriptions. The seat count is the
+// quantity of the subscription's fixed line item for the configured seat price, set either
+// through the API or from the unique active users reported by a meter.
+type SubscriptionSeatService interface {
+	// GetSeats returns the current seat count, seat config and seat history of a subscription
+	GetSeats(ctx context.Context, subscriptionID string) (*dto.SubscriptionSeatsResponse, error)
+
+	// UpdateSeats changes the seat count of an API-driven seat subscription, billing the change
+	// according to the configured (or requested) proration behavior
+	UpdateSeats(ctx context.Context, subscriptionID string, req dto.UpdateSubscriptionSeatsRequest) (*dto.UpdateSubscriptionSeatsResponse, error)
+
+	// UpdateSeatConfig enables or updates seat licensing on a subscription
+	UpdateSeatConfig(ctx context.Context, subscriptionID string, req dto.UpdateSubscriptionSeatConfigRequest) (*dto.SubscriptionSeatsResponse, error)
+
+	// ProcessSeats syncs the seat counts of metered seat subscriptions and invoices the seat
+	// increases accrued for true-up in periods that have ended, for the environment in context
+	ProcessSeats(ctx context.Context) (*dto.ProcessSubscriptionSeatsResult, error)
+}
+
+type subscriptionSeatService struct {
+	ServiceParams
+}
+
+func NewSubscriptionSeatService(params ServiceParams) SubscriptionSeatService {
+	return &subscriptionSeatService{
+		ServiceParams: params,
+	}
+}
+
+func (s *subscriptionSeatService) GetSeats(ctx context.Context, subscriptionID string) (*dto.SubscriptionSeatsResponse, error) {
+	sub, lineItems, err := s.SubRepo.GetWithLineItems(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateSeatSubscription(sub); err != nil {
+		return nil, err
+	}
+
+	resp := &dto.SubscriptionSeatsResponse{
+		SubscriptionID: sub.ID,
+		SeatConfig:     sub.SeatConfig,
+		History:        make([]*dto.SubscriptionSeatChangeResponse, 0),
+	}
+
+	if item := seatLineItemAt(lineItems, sub.SeatConfig.PriceID, time.Now().UTC()); item != nil {
+		resp.Seats = item.Quantity.IntPart()
+		resp.LineItemID = item.ID
+	}
+
+	filter := types.NewNoLimitSubscriptionSeatChangeFilter()
+	filter.SubscriptionIDs = []string{sub.ID}
+	changes, err := s.SubscriptionSeatChangeRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].EffectiveDate.Equal(changes[j].EffectiveDate) {
+			return changes[i].CreatedAt.Before(changes[j].CreatedAt)
+		}
+		return changes[i].EffectiveDate.Before(changes[j].EffectiveDate)
+	})
+	for _, change := range changes {
+		resp.History = append(resp.History, dto.NewSubscriptionSeatChangeResponse(change))
+	}
+
+	return resp, nil
+}
+
+func (s *subscriptionSeatService) UpdateSeats(ctx context.Context, subscriptionID string, req dto.UpdateSubscriptionSeatsRequest) (*dto.UpdateSubscriptionSeatsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	sub, lineItems, err := s.SubRepo.GetWithLineItems(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateSeatSubscription(sub); err != nil {
+		return nil, err
+	}
+
+	if sub.SeatConfig.Source != types.SeatSourceAPI {
+		return nil, ierr.NewError("seats of this subscription follow a meter").
+			WithHint("Seat counts of metered seat subscriptions are synced from the meter and cannot be set through the API").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": subscriptionID,
+				"meter_id":        sub.SeatConfig.MeterID,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	if err := sub.SeatConfig.ValidateSeats(req.Seats); err != nil {
+		return nil, err
+	}
+
+	effectiveDate := time.Now().UTC()
+	if req.EffectiveDate != nil {
+		effectiveDate = req.EffectiveDate.UTC()
+	}
+
+	return s.changeSeats(ctx, sub, lineItems, req.Seats, effectiveDate, types.SeatSourceAPI, req.ProrationBehavior)
+}
+
+func (s *subscriptionSeatService) UpdateSeatConfig(ctx context.Context, subscriptionID string, req dto.UpdateSubscriptionSeatConfigRequest) (*dto.SubscriptionSeatsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	sub, lineItems, err := s.SubRepo.GetWithLineItems(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	config := req.SeatConfig
+	item := seatLineItemAt(lineItems, config.PriceID, time.Now().UTC())
+	if item == nil {
+		return nil, ierr.NewError("seat price is not active on the subscription").
+			WithHint("The seat price must be an active line item of the subscription").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": subscriptionID,
+				"price_id":        config.PriceID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if item.PriceType != types.PRICE_TYPE_FIXED {
+		return nil, ierr.NewError("seat price is not a fixed price").
+			WithHint("Seats are counted by the quantity of a fixed-price line item").
+			WithReportableDetails(map[string]interface{}{
+				"price_id":   config.PriceID,
+				"price_type": item.PriceType,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if !item.Quantity.IsInteger() {
+		return nil, ierr.NewError("seat line item quantity must be a whole number").
+			WithHint("Set a whole-number quantity on the seat line item before enabling seats").
+			WithReportableDetails(map[string]interface{}{
+				"line_item_id": item.ID,
+				"quantity":     item.Quantity.String(),
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if config.Source == types.SeatSourceMeter {
+		m, err := s.MeterRepo.GetMeter(ctx, config.MeterID)
+		if err != nil {
+			return nil, err
+		}
+		if m.Aggregation.Type != types.AggregationCountUnique {
+			return nil, ierr.NewError("seat meter must count unique values").
+				WithHint("Use a COUNT_UNIQUE meter on the user identifier to count active users").
+				WithReportableDetails(map[string]interface{}{
+					"meter_id":         m.ID,
+					"aggregation_type": m.Aggregation.Type,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	before := map[string]interface{}{"seat_config": sub.SeatConfig}
+	sub.SeatConfig = &config
+	if err := s.SubRepo.Update(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeSubscriptionSeats, sub.ID, types.AuditLogActionUpdate,
+		before, map[string]interface{}{"seat_config": sub.SeatConfig})
+
+	// Per-seat features change the customer's effective entitlements
+	NewEntitlementCheckService(s.ServiceParams).InvalidateSubscription(ctx, sub.ID)
+
+	return s.GetSeats(ctx, sub.ID)
+}
+
+func (s *subscriptionSeatService) ProcessSeats(ctx context.Context) (*dto.ProcessSubscriptionSeatsResult, error) {
+	result := &dto.ProcessSubscriptionSeatsResult{}
+
+	filter := types.NewNoLimitSubscriptionFilter()
+	filter.SeatSources = []types.SeatSource{types.SeatSourceMeter}
+	filter.SubscriptionStatus = []types.SubscriptionStatus{types.SubscriptionStatusActive}
+
+	subs, err := s.SubRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sub := range subs {
+		synced, err := s.syncMeteredSeats(ctx, sub)
+		if err != nil {
+			s.Logger.Errorw("failed to sync metered seats",
+				"subscription_id", sub.ID,
+				"error", err,
+			)
+			result.Failed++
+			continue
+		}
+		if synced {
+			result.SeatsSynced++
+		}
+	}
+
+	invoices, failed, err := s.processSeatTrueUps(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.TrueUpInvoices = invoices
+	result.Failed += failed
+
+	return result, nil
+}
+
+// changeSeats moves the seat line item to the new seat count at effectiveDate and records the
+// change. Increases and decreases of in-advance seat prices are billed according to the proration
+// behavior: immediately (invoice or wallet credit), accrued for true-up at period end, or not at all.
+// Arrear seat prices need no proration since they are billed per line item window at period end.
+func (s *subscriptionSeatService) changeSeats(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	lineItems []*subscription.SubscriptionLineItem,
+	seats int64,
+	effectiveDate time.Time,
+	source types.SeatSource,
+	behaviorOverride *types.SeatProrationBehavior,
+) (*dto.UpdateSubscriptionSeatsResponse, error) {
+	if sub.SubscriptionStatus != types.SubscriptionStatusActive {
+		return nil, ierr.NewError("subscription is not active").
+			WithHint("Only active subscriptions can have their seats changed").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": sub.ID,
+				"status":          sub.SubscriptionStatus,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if effectiveDate.Before(sub.CurrentPeriodStart) || !effectiveDate.Before(sub.CurrentPeriodEnd) {
+		return nil, ierr.NewError("effective_date must be within the current billing period").
+			WithHint("Set effective_date to a time within the current billing period").
+			WithReportableDetails(map[string]interface{}{
+				"effective_date":       effectiveDate,
+				"current_period_start": sub.CurrentPeriodStart,
+				"current_period_end":   sub.CurrentPeriodEnd,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	lineItem := seatLineItemAt(lineItems, sub.SeatConfig.PriceID, effectiveDate)
+	if lineItem == nil {
+		return nil, ierr.NewError("seat line item not found").
+			WithHint("The seat price has no active line item at the effective date").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": sub.ID,
+				"price_id":        sub.SeatConfig.PriceID,
+				"effective_date":  effectiveDate,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	if err := validateQuantityChangeEffectiveDateWithinLineItemWindow(effectiveDate, sub, lineItem, lineItem.ID); err != nil {
+		return nil, err
+	}
+
+	previousSeats := lineItem.Quantity.IntPart()
+	if previousSeats == seats {
+		current, err := s.GetSeats(ctx, sub.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &dto.UpdateSubscriptionSeatsResponse{Seats: current}, nil
+	}
+
+	behavior := sub.SeatConfig.BehaviorFor(previousSeats, seats)
+	if behaviorOverride != nil {
+		behavior = *behaviorOverride
+	}
+	if seats < previousSeats && behavior == types.SeatProrationBehaviorTrueUp {
+		return nil, ierr.NewError("seat decreases cannot be trued up").
+			WithHint("Use immediate to credit the seat decrease or none to skip the credit").
+			Mark(ierr.ErrValidation)
+	}
+
+	modSvc := &subscriptionModificationService{serviceParams: s.ServiceParams}
+
+	// Only in-advance seats are prorated; the amount is what gets billed (now or at true-up)
+	var price *dto.PriceResponse
+	prorationAmount := decimal.Zero
+	if lineItem.InvoiceCadence == types.InvoiceCadenceAdvance && behavior != types.SeatProrationBehaviorNone {
+		prorationItem := &subscription.SubscriptionLineItem{
+			PriceID:  lineItem.PriceID,
+			Quantity: decimal.NewFromInt(seats),
+		}
+		p, result, err := modSvc.calculateQuantityChangeProration(ctx, sub, lineItem, prorationItem, effectiveDate)
+		if err != nil {
+			return nil, err
+		}
+		price = p
+		prorationAmount = result.NetAmount
+	}
+
+	resp := &dto.UpdateSubscriptionSeatsResponse{}
+	var change *subscription.SubscriptionSeatChange
+
+	// Proration runs inside the transaction (with the outer context, as for quantity changes)
+	// so a failed charge or credit rolls back the seat change.
+	err := s.DB.WithTx(ctx, func(txCtx context.Context) error {
+		oldItem := *lineItem
+		lineItem.EndDate = effectiveDate
+		if err := s.SubscriptionLineItemRepo.Update(txCtx, lineItem); err != nil {
+			return err
+		}
+
+		newItem := newQuantityChangeLineItem(txCtx, lineItem, decimal.NewFromInt(seats), effectiveDate)
+		if err := s.SubscriptionLineItemRepo.Create(txCtx, newItem); err != nil {
+			return err
+		}
+
+		change = &subscription.SubscriptionSeatChange{
+			ID:                types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_SEAT_CHANGE),
+			SubscriptionID:    sub.ID,
+			LineItemID:        newItem.ID,
+			PreviousSeats:     previousSeats,
+			NewSeats:          seats,
+			Source:            source,
+			ProrationBehavior: behavior,
+			EffectiveDate:     effectiveDate,
+			ProrationAmount:   prorationAmount,
+			EnvironmentID:     types.GetEnvironmentID(txCtx),
+			BaseModel:         types.GetDefaultBaseModel(txCtx),
+		}
+
+		if behavior == types.SeatProrationBehaviorImmediate && !prorationAmount.IsZero() {
+			changed, err := modSvc.applyQuantityChangeProration(ctx, sub, &oldItem, newItem, effectiveDate, price, prorationAmount)
+			if err != nil {
+				return err
+			}
+			if changed != nil && changed.Invoice != nil {
+				change.InvoiceID = lo.ToPtr(changed.Invoice.ID)
+				resp.Invoice = changed.Invoice
+			}
+			if changed != nil && changed.WalletTransaction != nil && changed.WalletTransaction.Transaction != nil {
+				change.WalletTransactionID = lo.ToPtr(changed.WalletTransaction.ID)
+				resp.WalletTransaction = changed.WalletTransaction
+			}
+		}
+
+		if err := s.SubscriptionSeatChangeRepo.Create(txCtx, change); err != nil {
+			return err
+		}
+
+		s.recordAuditLog(txCtx, types.AuditLogEntityTypeSubscriptionSeats, sub.ID, types.AuditLogActionUpdate,
+			map[string]interface{}{"seats": previousSeats},
+			map[string]interface{}{"seats": seats, "seat_change_id": change.ID, "proration_behavior": behavior})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("subscription seats changed",
+		"subscription_id", sub.ID,
+		"previous_seats", previousSeats,
+		"new_seats", seats,
+		"source", source,
+		"proration_behavior", behavior,
+		"proration_amount", prorationAmount.String(),
+	)
+
+	s.publishSeatsUpdatedEvent(ctx, sub.ID, change)
+
+	current, err := s.GetSeats(ctx, sub.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp.Seats = current
+	resp.SeatChange = dto.NewSubscriptionSeatChangeResponse(change)
+	return resp, nil
+}
+
+// syncMeteredSeats sets the seat count of a metered seat subscription to the unique active users
+// the meter reports for the current period, bounded by the configured minimum and maximum.
+// Returns true if the seat count changed.
+func (s *subscriptionSeatService) syncMeteredSeats(ctx context.Context, sub *subscription.Subscription) (bool, error) {
+	now := time.Now().UTC()
+	if !now.Before(sub.CurrentPeriodEnd) {
+		// The period is pending renewal; sync again once the billing period has moved on
+		return false, nil
+	}
+
+	m, err := s.MeterRepo.GetMeter(ctx, sub.SeatConfig.MeterID)
+	if err != nil {
+		return false, err
+	}
+
+	cust, err := s.CustomerRepo.Get(ctx, sub.CustomerID)
+	if err != nil {
+		return false, err
+	}
+
+	eventService := NewEventService(s.EventRepo, s.MeterRepo, s.EventPublisher, s.Logger, s.Config)
+	usage, err := eventService.GetUsageByMeter(ctx, &dto.GetUsageByMeterRequest{
+		MeterID:            m.ID,
+		Meter:              m,
+		ExternalCustomerID: cust.ExternalID,
+		StartTime:          sub.CurrentPeriodStart,
+		EndTime:            now,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	seats := sub.SeatConfig.ClampSeats(usage.Value.IntPart())
+
+	_, lineItems, err := s.SubRepo.GetWithLineItems(ctx, sub.ID)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := s.changeSeats(ctx, sub, lineItems, seats, now, types.SeatSourceMeter, nil)
+	if err != nil {
+		return false, err
+	}
+	return resp.SeatChange != nil, nil
+}
+
+// processSeatTrueUps raises one invoice per subscription for the seat increases accrued for
+// true-up in billing periods that have since ended. Returns the number of invoices and failures.
+func (s *subscriptionSeatService) processSeatTrueUps(ctx context.Context) (int, int, error) {
+	filter := types.NewNoLimitSubscriptionSeatChangeFilter()
+	filter.PendingTrueUp = true
+
+	changes, err := s.SubscriptionSeatChangeRepo.List(ctx, filter)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	changesBySub := lo.GroupBy(changes, func(c *subscription.SubscriptionSeatChange) string {
+		return c.SubscriptionID
+	})
+	subscriptionIDs := lo.Keys(changesBySub)
+	sort.Strings(subscriptionIDs)
+
+	invoices, failed := 0, 0
+	for _, subscriptionID := range subscriptionIDs {
+		sub, err := s.SubRepo.Get(ctx, subscriptionID)
+		if err != nil {
+			s.Logger.Errorw("failed to get subscription for seat true-up",
+				"subscription_id", subscriptionID,
+				"error", err,
+			)
+			failed++
+			continue
+		}
+
+		due := lo.Filter(changesBySub[subscriptionID], func(c *subscription.SubscriptionSeatChange, _ int) bool {
+			return c.EffectiveDate.Before(sub.CurrentPeriodStart)
+		})
+		if len(due) == 0 {
+			continue
+		}
+
+		if err := s.trueUpSeatChanges(ctx, sub, due); err != nil {
+			s.Logger.Errorw("failed to true up seat changes",
+				"subscription_id", subscriptionID,
+				"error", err,
+			)
+			failed++
+			continue
+		}
+		invoices++
+	}
+
+	return invoices, failed, nil
+}
+
+// trueUpSeatChanges invoices accrued seat increases and links them to the invoice
+func (s *subscriptionSeatService) trueUpSeatChanges(ctx context.Context, sub *subscription.Subscription, changes []*subscription.SubscriptionSeatChange) error {
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].EffectiveDate.Before(changes[j].EffectiveDate)
+	})
+
+	periodStart := changes[0].EffectiveDate
+	periodEnd := sub.CurrentPeriodStart
+	priceID := sub.SeatConfig.PriceID
+	priceType := string(types.PRICE_TYPE_FIXED)
+
+	total := decimal.Zero
+	lineItems := make([]dto.CreateInvoiceLineItemRequest, 0, len(changes))
+	for _, change := range changes {
+		total = total.Add(change.ProrationAmount)
+		displayName := fmt.Sprintf("Seat True-Up: %d → %d seats (%s – %s)",
+			change.PreviousSeats, change.NewSeats,
+			change.EffectiveDate.Format("2 Jan 2006"), periodEnd.Format("2 Jan 2006"))
+		lineItems = append(lineItems, dto.CreateInvoiceLineItemRequest{
+			PriceID:     lo.ToPtr(priceID),
+			PriceType:   lo.ToPtr(priceType),
+			DisplayName: lo.ToPtr(displayName),
+			Amount:      change.ProrationAmount,
+			Quantity:    decimal.NewFromInt(change.NewSeats - change.PreviousSeats),
+			PeriodStart: lo.ToPtr(change.EffectiveDate),
+			PeriodEnd:   lo.ToPtr(periodEnd),
+			Metadata:    types.Metadata{"seat_change_id": change.ID},
+		})
+	}
+
+	invoiceService := NewInvoiceService(s.ServiceParams)
+	idempotencyKey := fmt.Sprintf("seat_true_up_%s_%s", sub.ID, periodEnd.Format(time.RFC3339))
+	billingPeriod := string(sub.BillingPeriod)
+
+	var invoiceID string
+	inv, err := invoiceService.CreateInvoice(ctx, dto.CreateInvoiceRequest{
+		CustomerID:     sub.GetInvoicingCustomerID(),
+		SubscriptionID: lo.ToPtr(sub.ID),
+		IdempotencyKey: lo.ToPtr(idempotencyKey),
+		InvoiceType:    types.InvoiceTypeOneOff,
+		Currency:       sub.Currency,
+		BillingReason:  types.InvoiceBillingReasonSubscriptionUpdate,
+		AmountDue:      total,
+		Subtotal:       total,
+		Total:          total,
+		PeriodStart:    lo.ToPtr(periodStart),
+		PeriodEnd:      lo.ToPtr(periodEnd),
+		BillingPeriod:  lo.ToPtr(billingPeriod),
+		LineItems:      lineItems,
+		Metadata: types.Metadata{
+			"seat_true_up": "true",
+			"seat_changes": strings.Join(lo.Map(changes, func(c *subscription.SubscriptionSeatChange, _ int) string { return c.ID }), ","),
+		},
+	})
+	switch {
+	case err == nil:
+		invoiceID = inv.ID
+		if err := invoiceService.AttemptPayment(ctx, inv.ID); err != nil {
+			s.Logger.Warnw("failed to attempt payment for seat true-up invoice", "error", err, "invoice_id", inv.ID)
+		}
+	case ierr.IsAlreadyExists(err):
+		// A previous run raised the invoice but did not link all changes to it
+		existing, getErr := s.InvoiceRepo.GetByIdempotencyKey(ctx, idempotencyKey)
+		if getErr != nil {
+			return getErr
+		}
+		invoiceID = existing.ID
+	default:
+		return err
+	}
+
+	for _, change := range changes {
+		if err := s.SubscriptionSeatChangeRepo.SetInvoiceID(ctx, change.ID, invoiceID); err != nil {
+			return err
+		}
+	}
+
+	s.Logger.Infow("invoiced seat true-up",
+		"subscription_id", sub.ID,
+		"invoice_id", invoiceID,
+		"seat_changes", len(changes),
+		"amount", total.String(),
+	)
+	return nil
+}
+
+func (s *subscriptionSeatService) publishSeatsUpdatedEvent(ctx context.Context, subscriptionID string, change *subscription.SubscriptionSeatChange) {
+	// Seat counts scale per-seat entitlements
+	NewEntitlementCheckService(s.ServiceParams).InvalidateSubscription(ctx, subscriptionID)
+
+	eventPayload := webhookDto.InternalSubscriptionSeatsEvent{
+		EventType:      types.WebhookEventSubscriptionSeatsUpdated,
+		SubscriptionID: subscriptionID,
+		SeatChange:     dto.NewSubscriptionSeatChangeResponse(change),
+		TenantID:       types.GetTenantID(ctx),
+		EnvironmentID:  types.GetEnvironmentID(ctx),
+	}
+
+	webhookPayload, err := json.Marshal(eventPayload)
+	if err != nil {
+		s.Logger.ErrorwCtx(ctx, "failed to marshal webhook payload", "error", err)
+		return
+	}
+
+	webhookEvent := &types.WebhookEvent{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SYSTEM_EVENT),
+		EventName:     types.WebhookEventSubscriptionSeatsUpdated,
+		TenantID:      types.GetTenantID(ctx),
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		UserID:        types.GetUserID(ctx),
+		Timestamp:     time.Now().UTC(),
+		Payload:       json.RawMessage(webhookPayload),
+		EntityType:    types.SystemEntityTypeSubscription,
+		EntityID:      subscriptionID,
+	}
+	if err := s.WebhookPublisher.PublishWebhook(ctx, webhookEvent); err != nil {
+		s.Logger.ErrorfCtx(ctx, "failed to publish %s event: %v", webhookEvent.EventName, err)
+	}
+}
+
+// validateSeatSubscription ensures seat licensing is enabled on the subscription
+func validateSeatSubscription(sub *subscription.Subscription) error {
+	if sub.SeatConfig == nil {
+		return ierr.NewError("subscription is not seat based").
+			WithHint("Enable seats on the subscription with a seat config first").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": sub.ID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// seatLineItemAt returns the published line item of the seat price in effect at t.
+// A line item ending at t has already been replaced by the one starting at t.
+func seatLineItemAt(lineItems []*subscription.SubscriptionLineItem, priceID string, t time.Time) *subscription.SubscriptionLineItem {
+	var current *subscription.SubscriptionLineItem
+	for _, li := range lineItems {
+		if li.PriceID != priceID || li.Status != types.StatusPublished {
+			continue
+		}
+		if li.StartDate.After(t) || (!li.EndDate.IsZero() && !t.Before(li.EndDate)) {
+			continue
+		}
+		if current == nil || li.StartDate.After(current.StartDate) {
+			current = li
+		}
+	}
+	return current
+}
+
+// applySeatsToEntitlements scales the usage limits of per-seat features by the seat count.
+// Entitlements are copied so cached plan entitlements are left untouched.
+func applySeatsToEntitlements(entitlements []*dto.EntitlementResponse, config *types.SeatConfig, seats int64) []*dto.EntitlementResponse {
+	result := make([]*dto.EntitlementResponse, 0, len(entitlements))
+	for _, ent := range entitlements {
+		if ent == nil || ent.Entitlement == nil || ent.UsageLimit == nil || !config.IsPerSeatFeature(ent.FeatureID) {
+			result = append(result, ent)
+			continue
+		}
+		scaledEnt := *ent.Entitlement
+		scaledEnt.UsageLimit = lo.ToPtr(*ent.UsageLimit * seats)
+		scaled := *ent
+		scaled.Entitlement = &scaledEnt
+		result = append(result, &scaled)
+	}
+	return result
+}
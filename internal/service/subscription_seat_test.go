@@ -0,0 +1,285 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/entitlement"
+	"github.com/flexprice/flexprice/internal/domain/plan"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type SubscriptionSeatServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service SubscriptionSeatService
+
+	periodStart time.Time
+	periodEnd   time.Time
+}
+
+func TestSubscriptionSeatServiceSuite(t *testing.T) {
+	suite.Run(t, new(SubscriptionSeatServiceSuite))
+}
+
+func (s *SubscriptionSeatServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.service = NewSubscriptionSeatService(s.buildServiceParams())
+	s.periodStart = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.periodEnd = time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *SubscriptionSeatServiceSuite) buildServiceParams() ServiceParams {
+	return ServiceParams{
+		Logger:                     s.GetLogger(),
+		Config:                     s.GetConfig(),
+		DB:                         s.GetDB(),
+		SubRepo:                    s.GetStores().SubscriptionRepo,
+		SubscriptionLineItemRepo:   s.GetStores().SubscriptionLineItemRepo,
+		SubscriptionPhaseRepo:      s.GetStores().SubscriptionPhaseRepo,
+		SubScheduleRepo:            s.GetStores().SubscriptionScheduleRepo,
+		SubscriptionSeatChangeRepo: s.GetStores().SubscriptionSeatChangeRepo,
+		PlanRepo:                   s.GetStores().PlanRepo,
+		PriceRepo:                  s.GetStores().PriceRepo,
+		PriceUnitRepo:              s.GetStores().PriceUnitRepo,
+		EventRepo:                  s.GetStores().EventRepo,
+		MeterRepo:                  s.GetStores().MeterRepo,
+		CustomerRepo:               s.GetStores().CustomerRepo,
+		InvoiceRepo:                s.GetStores().InvoiceRepo,
+		InvoiceLineItemRepo:        s.GetStores().InvoiceLineItemRepo,
+		EntitlementRepo:            s.GetStores().EntitlementRepo,
+		EnvironmentRepo:            s.GetStores().EnvironmentRepo,
+		FeatureRepo:                s.GetStores().FeatureRepo,
+		TenantRepo:                 s.GetStores().TenantRepo,
+		UserRepo:                   s.GetStores().UserRepo,
+		AuthRepo:                   s.GetStores().AuthRepo,
+		WalletRepo:                 s.GetStores().WalletRepo,
+		PaymentRepo:                s.GetStores().PaymentRepo,
+		CreditGrantRepo:            s.GetStores().CreditGrantRepo,
+		CreditGrantApplicationRepo: s.GetStores().CreditGrantApplicationRepo,
+		CouponRepo:                 s.GetStores().CouponRepo,
+		CouponAssociationRepo:      s.GetStores().CouponAssociationRepo,
+		CouponApplicationRepo:      s.GetStores().CouponApplicationRepo,
+		AddonRepo:                  testutil.NewInMemoryAddonStore(),
+		AddonAssociationRepo:       s.GetStores().AddonAssociationRepo,
+		ConnectionRepo:             s.GetStores().ConnectionRepo,
+		SettingsRepo:               s.GetStores().SettingsRepo,
+		TaxAssociationRepo:         s.GetStores().TaxAssociationRepo,
+		TaxRateRepo:                s.GetStores().TaxRateRepo,
+		TaxAppliedRepo:             s.GetStores().TaxAppliedRepo,
+		AlertLogsRepo:              s.GetStores().AlertLogsRepo,
+		EventPublisher:             s.GetPublisher(),
+		WebhookPublisher:           s.GetWebhookPublisher(),
+		ProrationCalculator:        s.GetCalculator(),
+		FeatureUsageRepo:           s.GetStores().FeatureUsageRepo,
+		IntegrationFactory:         s.GetIntegrationFactory(),
+	}
+}
+
+// setupSeatSubscription creates an active monthly subscription with an in-advance seat line item
+// of `seats` at $50 per seat, and enables API-driven seats with the given increase behavior.
+func (s *SubscriptionSeatServiceSuite) setupSeatSubscription(externalID string, seats int64, increase types.SeatProrationBehavior) *subscription.Subscription {
+	ctx := s.GetContext()
+
+	cust := &customer.Customer{
+		ID:         types.GenerateUUIDWithPrefix(types.UUID_PREFIX_CUSTOMER),
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+		ExternalID: externalID,
+		Name:       "Test " + externalID,
+	}
+	s.Require().NoError(s.GetStores().CustomerRepo.Create(ctx, cust))
+
+	p := &plan.Plan{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PLAN),
+		Name:      "Team",
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.Require().NoError(s.GetStores().PlanRepo.Create(ctx, p))
+
+	seatPrice := &price.Price{
+		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PRICE),
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+		Amount:         decimal.NewFromInt(50),
+		Currency:       "USD",
+		Type:           types.PRICE_TYPE_FIXED,
+		BillingModel:   types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence: types.BILLING_CADENCE_RECURRING,
+		BillingPeriod:  types.BILLING_PERIOD_MONTHLY,
+		InvoiceCadence: types.InvoiceCadenceAdvance,
+	}
+	s.Require().NoError(s.GetStores().PriceRepo.Create(ctx, seatPrice))
+
+	sub := &subscription.Subscription{
+		ID:                 types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION),
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+		CustomerID:         cust.ID,
+		PlanID:             p.ID,
+		Currency:           "USD",
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingCycle:       types.BillingCycleAnniversary,
+		BillingAnchor:      s.periodStart,
+		SubscriptionStatus: types.SubscriptionStatusActive,
+		SubscriptionType:   types.SubscriptionTypeStandalone,
+		CurrentPeriodStart: s.periodStart,
+		CurrentPeriodEnd:   s.periodEnd,
+		StartDate:          s.periodStart,
+	}
+	s.Require().NoError(s.GetStores().SubscriptionRepo.Create(ctx, sub))
+
+	li := &subscription.SubscriptionLineItem{
+		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_LINE_ITEM),
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+		SubscriptionID: sub.ID,
+		CustomerID:     cust.ID,
+		PriceID:        seatPrice.ID,
+		PriceType:      types.PRICE_TYPE_FIXED,
+		Quantity:       decimal.NewFromInt(seats),
+		Currency:       "USD",
+		BillingPeriod:  types.BILLING_PERIOD_MONTHLY,
+		InvoiceCadence: types.InvoiceCadenceAdvance,
+		StartDate:      s.periodStart,
+		EntityType:     types.SubscriptionLineItemEntityTypePlan,
+	}
+	s.Require().NoError(s.GetStores().SubscriptionLineItemRepo.Create(ctx, li))
+
+	_, err := s.service.UpdateSeatConfig(ctx, sub.ID, dto.UpdateSubscriptionSeatConfigRequest{
+		SeatConfig: types.SeatConfig{
+			PriceID:          seatPrice.ID,
+			IncreaseBehavior: increase,
+		},
+	})
+	s.Require().NoError(err)
+
+	sub, err = s.GetStores().SubscriptionRepo.Get(ctx, sub.ID)
+	s.Require().NoError(err)
+	return sub
+}
+
+// proratedSeatAmount mirrors the proration calculator: the remaining share of the period
+// (measured to one second before period end) of the seat delta at $50 per seat.
+func (s *SubscriptionSeatServiceSuite) proratedSeatAmount(delta int64, effectiveDate time.Time) decimal.Decimal {
+	effectivePeriodEnd := s.periodEnd.Add(-time.Second)
+	total := decimal.NewFromFloat(effectivePeriodEnd.Sub(s.periodStart).Seconds())
+	remaining := decimal.NewFromFloat(effectivePeriodEnd.Sub(effectiveDate).Seconds())
+	return decimal.NewFromInt(delta * 50).Mul(remaining.Div(total))
+}
+
+func (s *SubscriptionSeatServiceSuite) TestUpdateSeats_ImmediateIncreaseInvoicesProration() {
+	ctx := s.GetContext()
+	sub := s.setupSeatSubscription("seats-immediate", 1, types.SeatProrationBehaviorImmediate)
+	effectiveDate := time.Date(2026, 1, 16, 0, 0, 0, 0, time.UTC)
+
+	resp, err := s.service.UpdateSeats(ctx, sub.ID, dto.UpdateSubscriptionSeatsRequest{
+		Seats:         3,
+		EffectiveDate: &effectiveDate,
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(resp.Invoice)
+	s.Require().NotNil(resp.SeatChange)
+
+	s.Equal(int64(3), resp.Seats.Seats)
+	s.Equal(int64(1), resp.SeatChange.PreviousSeats)
+	s.Equal(types.SeatProrationBehaviorImmediate, resp.SeatChange.ProrationBehavior)
+	s.Require().NotNil(resp.SeatChange.InvoiceID)
+	s.Equal(resp.Invoice.ID, *resp.SeatChange.InvoiceID)
+
+	expected := s.proratedSeatAmount(2, effectiveDate)
+	s.True(resp.Invoice.AmountDue.Sub(expected).Abs().LessThanOrEqual(decimal.NewFromFloat(0.01)),
+		"invoice amount %s should be ≈ %s", resp.Invoice.AmountDue, expected)
+
+	s.Require().Len(resp.Seats.History, 1)
+	s.Equal(resp.SeatChange.ID, resp.Seats.History[0].ID)
+}
+
+func (s *SubscriptionSeatServiceSuite) TestUpdateSeats_DecreaseDefaultsToNoCredit() {
+	ctx := s.GetContext()
+	sub := s.setupSeatSubscription("seats-decrease", 5, types.SeatProrationBehaviorImmediate)
+	effectiveDate := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	resp, err := s.service.UpdateSeats(ctx, sub.ID, dto.UpdateSubscriptionSeatsRequest{
+		Seats:         2,
+		EffectiveDate: &effectiveDate,
+	})
+	s.Require().NoError(err)
+	s.Nil(resp.Invoice)
+	s.Nil(resp.WalletTransaction)
+	s.Equal(int64(2), resp.Seats.Seats)
+	s.Equal(types.SeatProrationBehaviorNone, resp.SeatChange.ProrationBehavior)
+	s.True(resp.SeatChange.ProrationAmount.IsZero())
+
+	// Decreases can't be trued up, even when requested explicitly
+	_, err = s.service.UpdateSeats(ctx, sub.ID, dto.UpdateSubscriptionSeatsRequest{
+		Seats:             1,
+		EffectiveDate:     &effectiveDate,
+		ProrationBehavior: lo.ToPtr(types.SeatProrationBehaviorTrueUp),
+	})
+	s.Error(err)
+}
+
+func (s *SubscriptionSeatServiceSuite) TestProcessSeats_InvoicesTrueUpAfterPeriodEnds() {
+	ctx := s.GetContext()
+	sub := s.setupSeatSubscription("seats-true-up", 1, types.SeatProrationBehaviorTrueUp)
+	effectiveDate := time.Date(2026, 1, 16, 0, 0, 0, 0, time.UTC)
+
+	resp, err := s.service.UpdateSeats(ctx, sub.ID, dto.UpdateSubscriptionSeatsRequest{
+		Seats:         2,
+		EffectiveDate: &effectiveDate,
+	})
+	s.Require().NoError(err)
+	s.Nil(resp.Invoice)
+	s.Require().NotNil(resp.SeatChange)
+	s.Nil(resp.SeatChange.InvoiceID)
+	s.True(resp.SeatChange.IsPendingTrueUp())
+
+	// Nothing is due while the period of the change is still current
+	result, err := s.service.ProcessSeats(ctx)
+	s.Require().NoError(err)
+	s.Equal(0, result.TrueUpInvoices)
+
+	sub, err = s.GetStores().SubscriptionRepo.Get(ctx, sub.ID)
+	s.Require().NoError(err)
+	sub.CurrentPeriodStart = s.periodEnd
+	sub.CurrentPeriodEnd = s.periodEnd.AddDate(0, 1, 0)
+	s.Require().NoError(s.GetStores().SubscriptionRepo.Update(ctx, sub))
+
+	result, err = s.service.ProcessSeats(ctx)
+	s.Require().NoError(err)
+	s.Equal(1, result.TrueUpInvoices)
+	s.Equal(0, result.Failed)
+
+	change, err := s.GetStores().SubscriptionSeatChangeRepo.Get(ctx, resp.SeatChange.ID)
+	s.Require().NoError(err)
+	s.Require().NotNil(change.InvoiceID)
+
+	inv, err := s.GetStores().InvoiceRepo.Get(ctx, *change.InvoiceID)
+	s.Require().NoError(err)
+	s.True(inv.AmountDue.Equal(change.ProrationAmount))
+	s.Equal(types.InvoiceBillingReasonSubscriptionUpdate, types.InvoiceBillingReason(inv.BillingReason))
+
+	// Invoiced changes are not trued up again
+	result, err = s.service.ProcessSeats(ctx)
+	s.Require().NoError(err)
+	s.Equal(0, result.TrueUpInvoices)
+}
+
+func (s *SubscriptionSeatServiceSuite) TestApplySeatsToEntitlements_ScalesPerSeatFeatures() {
+	config := &types.SeatConfig{PerSeatFeatureIDs: []string{"feat_seat"}}
+	perSeat := &dto.EntitlementResponse{Entitlement: &entitlement.Entitlement{FeatureID: "feat_seat", UsageLimit: lo.ToPtr(int64(10))}}
+	shared := &dto.EntitlementResponse{Entitlement: &entitlement.Entitlement{FeatureID: "feat_shared", UsageLimit: lo.ToPtr(int64(10))}}
+
+	result := applySeatsToEntitlements([]*dto.EntitlementResponse{perSeat, shared}, config, 4)
+	s.Require().Len(result, 2)
+	s.Equal(int64(40), *result[0].UsageLimit)
+	s.Equal(int64(10), *result[1].UsageLimit)
+
+	// The source entitlement is left untouched
+	s.Equal(int64(10), *perSeat.UsageLimit)
+}
@@ -6131,3 +6131,88 @@ func (s *SubscriptionServiceSuite) TestMultiCadence_ProrationMutualExclusion_Can
 	_, errCancelNone := s.service.CancelSubscription(ctx, resp.ID, cancelReqNone)
 	s.NoError(errCancelNone, "E.3.2: cancel with mixed + none should succeed")
 }
+
+func (s *SubscriptionServiceSuite) TestCreateSubscription_Migration() {
+	ctx := s.GetContext()
+	now := time.Now().UTC()
+	startDate := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -7, 0)
+
+	listInvoices := func(subscriptionID string) []*invoice.Invoice {
+		filter := types.NewNoLimitInvoiceFilter()
+		filter.SubscriptionID = subscriptionID
+		invoices, err := s.GetStores().InvoiceRepo.List(ctx, filter)
+		s.Require().NoError(err)
+		return invoices
+	}
+
+	newRequest := func(migration *dto.SubscriptionMigrationConfig) dto.CreateSubscriptionRequest {
+		return dto.CreateSubscriptionRequest{
+			CustomerID:         s.testData.customer.ID,
+			PlanID:             s.testData.plan.ID,
+			StartDate:          lo.ToPtr(startDate),
+			Currency:           "usd",
+			BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+			BillingPeriodCount: 1,
+			BillingCycle:       types.BillingCycleAnniversary,
+			Migration:          migration,
+		}
+	}
+
+	s.Run("current period derived from past start date", func() {
+		resp, err := s.service.CreateSubscription(ctx, newRequest(&dto.SubscriptionMigrationConfig{
+			Source:                 "stripe",
+			ExternalSubscriptionID: "sub_stripe_derived",
+		}))
+		s.Require().NoError(err)
+
+		s.True(startDate.Equal(resp.StartDate))
+		s.False(resp.CurrentPeriodStart.After(now), "current period should have started")
+		s.True(resp.CurrentPeriodEnd.After(now), "current period should not have ended")
+		s.Equal("stripe", resp.Metadata[dto.SubscriptionMetadataMigrationSource])
+		s.Equal("sub_stripe_derived", resp.Metadata[dto.SubscriptionMetadataMigrationExternalID])
+
+		// Only the current period is invoiced, never the months before it
+		for _, inv := range listInvoices(resp.ID) {
+			s.Require().NotNil(inv.PeriodStart)
+			s.False(inv.PeriodStart.Before(resp.CurrentPeriodStart), "historical period %s was invoiced", inv.PeriodStart)
+		}
+	})
+
+	s.Run("current period invoiced externally", func() {
+		periodStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -5)
+		nextBillingDate := periodStart.AddDate(0, 1, 0)
+
+		resp, err := s.service.CreateSubscription(ctx, newRequest(&dto.SubscriptionMigrationConfig{
+			Source:             "chargebee",
+			CurrentPeriodStart: lo.ToPtr(periodStart),
+			NextBillingDate:    lo.ToPtr(nextBillingDate),
+			InvoicedThrough:    lo.ToPtr(nextBillingDate),
+		}))
+		s.Require().NoError(err)
+
+		s.True(periodStart.Equal(resp.CurrentPeriodStart))
+		s.True(nextBillingDate.Equal(resp.CurrentPeriodEnd))
+		s.True(nextBillingDate.Equal(resp.BillingAnchor), "anchor should follow the next billing date")
+		s.Equal(types.SubscriptionStatusActive, resp.SubscriptionStatus)
+		s.Empty(listInvoices(resp.ID))
+	})
+
+	s.Run("mid-period invoiced_through rejected", func() {
+		periodStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -5)
+		_, err := s.service.CreateSubscription(ctx, newRequest(&dto.SubscriptionMigrationConfig{
+			CurrentPeriodStart: lo.ToPtr(periodStart),
+			NextBillingDate:    lo.ToPtr(periodStart.AddDate(0, 1, 0)),
+			InvoicedThrough:    lo.ToPtr(periodStart.AddDate(0, 0, 3)),
+		}))
+		s.Error(err)
+		s.True(ierr.IsValidation(err))
+	})
+
+	s.Run("past next_billing_date rejected", func() {
+		_, err := s.service.CreateSubscription(ctx, newRequest(&dto.SubscriptionMigrationConfig{
+			NextBillingDate: lo.ToPtr(now.AddDate(0, 0, -1)),
+		}))
+		s.Error(err)
+		s.True(ierr.IsValidation(err))
+	})
+}
@@ -0,0 +1,133 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/auditlog"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/integration"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gocarina/gocsv"
+	"github.com/samber/lo"
+)
+
+// AuditLogExporter handles audit log export operations. Entries recorded within the export
+// window are exported oldest first, so consecutive exports form a continuous trail.
+type AuditLogExporter struct {
+	auditLogRepo       auditlog.Repository
+	integrationFactory *integration.Factory
+	logger             *logger.Logger
+}
+
+// AuditLogCSV represents the CSV structure for audit log export
+type AuditLogCSV struct {
+	ID         string `csv:"id"`
+	EntityType string `csv:"entity_type"`
+	EntityID   string `csv:"entity_id"`
+	Action     string `csv:"action"`
+	ActorType  string `csv:"actor_type"`
+	ActorID    string `csv:"actor_id"`
+	Changes    string `csv:"changes"` // JSON string
+	RequestID  string `csv:"request_id"`
+	IPAddress  string `csv:"ip_address"`
+	CreatedAt  string `csv:"created_at"` // RFC3339 format
+}
+
+// NewAuditLogExporter creates a new audit log exporter
+func NewAuditLogExporter(
+	auditLogRepo auditlog.Repository,
+	integrationFactory *integration.Factory,
+	logger *logger.Logger,
+) *AuditLogExporter {
+	return &AuditLogExporter{
+		auditLogRepo:       auditLogRepo,
+		integrationFactory: integrationFactory,
+		logger:             logger,
+	}
+}
+
+// PrepareData fetches the audit log entries of the export window in batches and converts them to CSV format
+func (e *AuditLogExporter) PrepareData(ctx context.Context, request *dto.ExportRequest) ([]byte, int, error) {
+	const batchSize = 500
+
+	e.logger.Infow("starting batched audit log data fetch",
+		"tenant_id", request.TenantID,
+		"env_id", request.EnvID,
+		"start_time", request.StartTime,
+		"end_time", request.EndTime,
+		"batch_size", batchSize)
+
+	var csvRecords []*AuditLogCSV
+	totalRecords := 0
+	offset := 0
+
+	for {
+		filter := types.NewAuditLogFilter()
+		filter.Limit = lo.ToPtr(batchSize)
+		filter.Offset = lo.ToPtr(offset)
+		filter.QueryFilter.Sort = lo.ToPtr("created_at")
+		filter.QueryFilter.Order = lo.ToPtr(types.OrderAsc)
+		filter.TimeRangeFilter = &types.TimeRangeFilter{
+			StartTime: lo.ToPtr(request.StartTime),
+			EndTime:   lo.ToPtr(request.EndTime),
+		}
+
+		logs, err := e.auditLogRepo.List(ctx, filter)
+		if err != nil {
+			return nil, 0, ierr.WithError(err).
+				WithHint("Failed to fetch audit log data batch").
+				WithReportableDetails(map[string]interface{}{
+					"offset":     offset,
+					"batch_size": batchSize,
+				}).
+				Mark(ierr.ErrDatabase)
+		}
+
+		for _, l := range logs {
+			record := &AuditLogCSV{
+				ID:         l.ID,
+				EntityType: string(l.EntityType),
+				EntityID:   l.EntityID,
+				Action:     string(l.Action),
+				ActorType:  string(l.ActorType),
+				ActorID:    l.ActorID,
+				RequestID:  l.RequestID,
+				IPAddress:  l.IPAddress,
+				CreatedAt:  l.CreatedAt.Format(time.RFC3339),
+			}
+			if len(l.Changes) > 0 {
+				record.Changes = jsonOrEmpty(l.Changes)
+			}
+			csvRecords = append(csvRecords, record)
+		}
+
+		totalRecords += len(logs)
+		offset += batchSize
+
+		if len(logs) < batchSize {
+			break
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := gocsv.Marshal(csvRecords, &buf); err != nil {
+		return nil, 0, ierr.WithError(err).
+			WithHint("Failed to marshal data to CSV").
+			Mark(ierr.ErrInternal)
+	}
+
+	e.logger.Infow("completed audit log export data preparation",
+		"total_records", totalRecords,
+		"csv_size_bytes", buf.Len())
+
+	return buf.Bytes(), totalRecords, nil
+}
+
+// GetFilenamePrefix returns the prefix for the exported file
+func (e *AuditLogExporter) GetFilenamePrefix() string {
+	return string(types.ScheduledTaskEntityTypeAuditLogs)
+}
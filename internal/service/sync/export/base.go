@@ -5,11 +5,15 @@ import (
 	"fmt"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/auditlog"
 	"github.com/flexprice/flexprice/internal/domain/connection"
+	"github.com/flexprice/flexprice/internal/domain/coupon"
 	"github.com/flexprice/flexprice/internal/domain/customer"
 	"github.com/flexprice/flexprice/internal/domain/events"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
 	"github.com/flexprice/flexprice/internal/domain/wallet"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/integration"
@@ -34,6 +38,10 @@ type ExportService struct {
 	walletRepo          wallet.Repository
 	walletBalanceGetter WalletBalanceGetter
 	customerRepo        customer.Repository
+	planRepo            plan.Repository
+	subscriptionRepo    subscription.Repository
+	couponRepo          coupon.Repository
+	auditLogRepo        auditlog.Repository
 	connectionRepo      connection.Repository
 	integrationFactory  *integration.Factory
 	logger              *logger.Logger
@@ -84,6 +92,24 @@ func NewExportServiceWithWallet(
 	}
 }
 
+// WithCatalogRepos sets the repositories used by the plan, subscription and coupon exports
+func (s *ExportService) WithCatalogRepos(
+	planRepo plan.Repository,
+	subscriptionRepo subscription.Repository,
+	couponRepo coupon.Repository,
+) *ExportService {
+	s.planRepo = planRepo
+	s.subscriptionRepo = subscriptionRepo
+	s.couponRepo = couponRepo
+	return s
+}
+
+// WithAuditLogRepo sets the repository used by the audit log export
+func (s *ExportService) WithAuditLogRepo(auditLogRepo auditlog.Repository) *ExportService {
+	s.auditLogRepo = auditLogRepo
+	return s
+}
+
 // Export routes the export request to the appropriate entity exporter
 func (s *ExportService) Export(ctx context.Context, request *dto.ExportRequest) (*dto.ExportResponse, error) {
 	s.logger.Infow("starting export",
@@ -218,6 +244,36 @@ func (s *ExportService) getExporter(entityType types.ScheduledTaskEntityType) Ex
 			return nil
 		}
 		return NewCreditUsageExporter(s.walletRepo, s.customerRepo, s.walletBalanceGetter, s.integrationFactory, s.logger)
+	case types.ScheduledTaskEntityTypePlans:
+		if s.planRepo == nil {
+			s.logger.Errorw("plan repository not configured for plan export")
+			return nil
+		}
+		return NewPlanExporter(s.planRepo, s.integrationFactory, s.logger)
+	case types.ScheduledTaskEntityTypeSubscriptions:
+		if s.subscriptionRepo == nil {
+			s.logger.Errorw("subscription repository not configured for subscription export")
+			return nil
+		}
+		return NewSubscriptionExporter(s.subscriptionRepo, s.integrationFactory, s.logger)
+	case types.ScheduledTaskEntityTypeWallets:
+		if s.walletRepo == nil {
+			s.logger.Errorw("wallet repository not configured for wallet export")
+			return nil
+		}
+		return NewWalletExporter(s.walletRepo, s.integrationFactory, s.logger)
+	case types.ScheduledTaskEntityTypeCoupons:
+		if s.couponRepo == nil {
+			s.logger.Errorw("coupon repository not configured for coupon export")
+			return nil
+		}
+		return NewCouponExporter(s.couponRepo, s.integrationFactory, s.logger)
+	case types.ScheduledTaskEntityTypeAuditLogs:
+		if s.auditLogRepo == nil {
+			s.logger.Errorw("audit log repository not configured for audit log export")
+			return nil
+		}
+		return NewAuditLogExporter(s.auditLogRepo, s.integrationFactory, s.logger)
 	default:
 		return nil
 	}
@@ -0,0 +1,146 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/coupon"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/integration"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gocarina/gocsv"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// CouponExporter handles coupon export operations. Coupons are exported as a snapshot of the
+// environment, with the same columns the COUPONS import task accepts.
+type CouponExporter struct {
+	couponRepo         coupon.Repository
+	integrationFactory *integration.Factory
+	logger             *logger.Logger
+}
+
+// CouponCSV represents the CSV structure for coupon export
+type CouponCSV struct {
+	ID                string `csv:"id"`
+	Name              string `csv:"name"`
+	Type              string `csv:"type"`
+	Cadence           string `csv:"cadence"`
+	AmountOff         string `csv:"amount_off"`     // Decimal as string
+	PercentageOff     string `csv:"percentage_off"` // Decimal as string
+	Currency          string `csv:"currency"`
+	RedeemAfter       string `csv:"redeem_after"`  // RFC3339 format
+	RedeemBefore      string `csv:"redeem_before"` // RFC3339 format
+	MaxRedemptions    string `csv:"max_redemptions"`
+	TotalRedemptions  string `csv:"total_redemptions"`
+	DurationInPeriods string `csv:"duration_in_periods"`
+	Rules             string `csv:"rules"`      // JSON string
+	Metadata          string `csv:"metadata"`   // JSON string
+	CreatedAt         string `csv:"created_at"` // RFC3339 format
+}
+
+// NewCouponExporter creates a new coupon exporter
+func NewCouponExporter(
+	couponRepo coupon.Repository,
+	integrationFactory *integration.Factory,
+	logger *logger.Logger,
+) *CouponExporter {
+	return &CouponExporter{
+		couponRepo:         couponRepo,
+		integrationFactory: integrationFactory,
+		logger:             logger,
+	}
+}
+
+// PrepareData fetches all coupons in batches and converts them to CSV format
+func (e *CouponExporter) PrepareData(ctx context.Context, request *dto.ExportRequest) ([]byte, int, error) {
+	const batchSize = 500
+
+	e.logger.Infow("starting batched coupon data fetch",
+		"tenant_id", request.TenantID,
+		"env_id", request.EnvID,
+		"batch_size", batchSize)
+
+	var csvRecords []*CouponCSV
+	totalRecords := 0
+	offset := 0
+
+	for {
+		filter := types.NewCouponFilter()
+		filter.Limit = lo.ToPtr(batchSize)
+		filter.Offset = lo.ToPtr(offset)
+
+		coupons, err := e.couponRepo.List(ctx, filter)
+		if err != nil {
+			return nil, 0, ierr.WithError(err).
+				WithHint("Failed to fetch coupon data batch").
+				WithReportableDetails(map[string]interface{}{
+					"offset":     offset,
+					"batch_size": batchSize,
+				}).
+				Mark(ierr.ErrDatabase)
+		}
+
+		for _, c := range coupons {
+			record := &CouponCSV{
+				ID:                c.ID,
+				Name:              c.Name,
+				Type:              string(c.Type),
+				Cadence:           string(c.Cadence),
+				AmountOff:         decimalOrEmpty(c.AmountOff),
+				PercentageOff:     decimalOrEmpty(c.PercentageOff),
+				Currency:          c.Currency,
+				RedeemAfter:       timeOrEmpty(c.RedeemAfter),
+				RedeemBefore:      timeOrEmpty(c.RedeemBefore),
+				MaxRedemptions:    intOrEmpty(c.MaxRedemptions),
+				TotalRedemptions:  fmt.Sprintf("%d", c.TotalRedemptions),
+				DurationInPeriods: intOrEmpty(c.DurationInPeriods),
+				CreatedAt:         c.CreatedAt.Format(time.RFC3339),
+			}
+			if c.Rules != nil {
+				record.Rules = jsonOrEmpty(c.Rules)
+			}
+			if c.Metadata != nil {
+				record.Metadata = jsonOrEmpty(*c.Metadata)
+			}
+			csvRecords = append(csvRecords, record)
+		}
+
+		totalRecords += len(coupons)
+		offset += batchSize
+
+		if len(coupons) < batchSize {
+			break
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := gocsv.Marshal(csvRecords, &buf); err != nil {
+		return nil, 0, ierr.WithError(err).
+			WithHint("Failed to marshal data to CSV").
+			Mark(ierr.ErrInternal)
+	}
+
+	e.logger.Infow("completed coupon export data preparation",
+		"total_records", totalRecords,
+		"csv_size_bytes", buf.Len())
+
+	return buf.Bytes(), totalRecords, nil
+}
+
+// GetFilenamePrefix returns the prefix for the exported file
+func (e *CouponExporter) GetFilenamePrefix() string {
+	return string(types.ScheduledTaskEntityTypeCoupons)
+}
+
+func decimalOrEmpty(d *decimal.Decimal) string {
+	if d == nil {
+		return ""
+	}
+	return d.String()
+}
@@ -256,3 +256,15 @@ func timeOrEmpty(t *time.Time) string {
 	}
 	return t.Format(time.RFC3339)
 }
+
+// jsonOrEmpty marshals v to a JSON string, returning an empty string for nil or empty values
+func jsonOrEmpty(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	data, err := json.Marshal(v)
+	if err != nil || string(data) == "null" || string(data) == "{}" || string(data) == "[]" {
+		return ""
+	}
+	return string(data)
+}
@@ -0,0 +1,118 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/plan"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/integration"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gocarina/gocsv"
+	"github.com/samber/lo"
+)
+
+// PlanExporter handles plan export operations. Plans are exported as a snapshot of the
+// environment, with the same columns the PLANS import task accepts.
+type PlanExporter struct {
+	planRepo           plan.Repository
+	integrationFactory *integration.Factory
+	logger             *logger.Logger
+}
+
+// PlanCSV represents the CSV structure for plan export
+type PlanCSV struct {
+	ID           string `csv:"id"`
+	Name         string `csv:"name"`
+	LookupKey    string `csv:"lookup_key"`
+	Description  string `csv:"description"`
+	DisplayOrder string `csv:"display_order"` // Integer as string
+	Metadata     string `csv:"metadata"`      // JSON string
+	CreatedAt    string `csv:"created_at"`    // RFC3339 format
+	UpdatedAt    string `csv:"updated_at"`    // RFC3339 format
+}
+
+// NewPlanExporter creates a new plan exporter
+func NewPlanExporter(
+	planRepo plan.Repository,
+	integrationFactory *integration.Factory,
+	logger *logger.Logger,
+) *PlanExporter {
+	return &PlanExporter{
+		planRepo:           planRepo,
+		integrationFactory: integrationFactory,
+		logger:             logger,
+	}
+}
+
+// PrepareData fetches all plans in batches and converts them to CSV format
+func (e *PlanExporter) PrepareData(ctx context.Context, request *dto.ExportRequest) ([]byte, int, error) {
+	const batchSize = 500
+
+	e.logger.Infow("starting batched plan data fetch",
+		"tenant_id", request.TenantID,
+		"env_id", request.EnvID,
+		"batch_size", batchSize)
+
+	var csvRecords []*PlanCSV
+	totalRecords := 0
+	offset := 0
+
+	for {
+		filter := types.NewPlanFilter()
+		filter.Limit = lo.ToPtr(batchSize)
+		filter.Offset = lo.ToPtr(offset)
+
+		plans, err := e.planRepo.List(ctx, filter)
+		if err != nil {
+			return nil, 0, ierr.WithError(err).
+				WithHint("Failed to fetch plan data batch").
+				WithReportableDetails(map[string]interface{}{
+					"offset":     offset,
+					"batch_size": batchSize,
+				}).
+				Mark(ierr.ErrDatabase)
+		}
+
+		for _, p := range plans {
+			csvRecords = append(csvRecords, &PlanCSV{
+				ID:           p.ID,
+				Name:         p.Name,
+				LookupKey:    p.LookupKey,
+				Description:  p.Description,
+				DisplayOrder: intOrEmpty(p.DisplayOrder),
+				Metadata:     jsonOrEmpty(p.Metadata),
+				CreatedAt:    p.CreatedAt.Format(time.RFC3339),
+				UpdatedAt:    p.UpdatedAt.Format(time.RFC3339),
+			})
+		}
+
+		totalRecords += len(plans)
+		offset += batchSize
+
+		if len(plans) < batchSize {
+			break
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := gocsv.Marshal(csvRecords, &buf); err != nil {
+		return nil, 0, ierr.WithError(err).
+			WithHint("Failed to marshal data to CSV").
+			Mark(ierr.ErrInternal)
+	}
+
+	e.logger.Infow("completed plan export data preparation",
+		"total_records", totalRecords,
+		"csv_size_bytes", buf.Len())
+
+	return buf.Bytes(), totalRecords, nil
+}
+
+// GetFilenamePrefix returns the prefix for the exported file
+func (e *PlanExporter) GetFilenamePrefix() string {
+	return string(types.ScheduledTaskEntityTypePlans)
+}
@@ -0,0 +1,178 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/integration"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gocarina/gocsv"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// subscriptionExportMigrationSource is written as migration_source so subscriptions
+// re-imported from an export can be told apart from ones migrated from other systems
+const subscriptionExportMigrationSource = "flexprice"
+
+// SubscriptionExporter handles subscription export operations. Subscriptions that are not
+// cancelled are exported with their current billing period, in the columns the SUBSCRIPTIONS
+// import task accepts.
+type SubscriptionExporter struct {
+	subscriptionRepo   subscription.Repository
+	integrationFactory *integration.Factory
+	logger             *logger.Logger
+}
+
+// SubscriptionCSV represents the CSV structure for subscription export
+type SubscriptionCSV struct {
+	ID                     string `csv:"id"`
+	CustomerID             string `csv:"customer_id"`
+	PlanID                 string `csv:"plan_id"`
+	Currency               string `csv:"currency"`
+	LookupKey              string `csv:"lookup_key"`
+	SubscriptionStatus     string `csv:"subscription_status"`
+	StartDate              string `csv:"start_date"` // RFC3339 format
+	EndDate                string `csv:"end_date"`   // RFC3339 format
+	BillingPeriod          string `csv:"billing_period"`
+	BillingPeriodCount     string `csv:"billing_period_count"`
+	BillingCycle           string `csv:"billing_cycle"`
+	BillingAnchor          string `csv:"billing_anchor"` // RFC3339 format
+	CollectionMethod       string `csv:"collection_method"`
+	PaymentBehavior        string `csv:"payment_behavior"`
+	MigrationSource        string `csv:"migration_source"`
+	ExternalSubscriptionID string `csv:"external_subscription_id"`
+	CurrentPeriodStart     string `csv:"current_period_start"` // RFC3339 format
+	NextBillingDate        string `csv:"next_billing_date"`    // RFC3339 format
+	OverrideLineItems      string `csv:"override_line_items"`  // JSON array of plan price quantities
+	Metadata               string `csv:"metadata"`             // JSON string
+}
+
+// subscriptionExportLineItem is the override_line_items entry written for each plan line item
+type subscriptionExportLineItem struct {
+	PriceID  string          `json:"price_id"`
+	Quantity decimal.Decimal `json:"quantity"`
+}
+
+// NewSubscriptionExporter creates a new subscription exporter
+func NewSubscriptionExporter(
+	subscriptionRepo subscription.Repository,
+	integrationFactory *integration.Factory,
+	logger *logger.Logger,
+) *SubscriptionExporter {
+	return &SubscriptionExporter{
+		subscriptionRepo:   subscriptionRepo,
+		integrationFactory: integrationFactory,
+		logger:             logger,
+	}
+}
+
+// PrepareData fetches subscriptions with their line items in batches and converts them to CSV format
+func (e *SubscriptionExporter) PrepareData(ctx context.Context, request *dto.ExportRequest) ([]byte, int, error) {
+	const batchSize = 200
+
+	e.logger.Infow("starting batched subscription data fetch",
+		"tenant_id", request.TenantID,
+		"env_id", request.EnvID,
+		"batch_size", batchSize)
+
+	var csvRecords []*SubscriptionCSV
+	totalRecords := 0
+	offset := 0
+	now := time.Now().UTC()
+
+	for {
+		filter := types.NewSubscriptionFilter()
+		filter.Limit = lo.ToPtr(batchSize)
+		filter.Offset = lo.ToPtr(offset)
+		filter.WithLineItems = true
+		filter.SubscriptionStatusNotIn = []types.SubscriptionStatus{types.SubscriptionStatusCancelled}
+
+		subs, err := e.subscriptionRepo.List(ctx, filter)
+		if err != nil {
+			return nil, 0, ierr.WithError(err).
+				WithHint("Failed to fetch subscription data batch").
+				WithReportableDetails(map[string]interface{}{
+					"offset":     offset,
+					"batch_size": batchSize,
+				}).
+				Mark(ierr.ErrDatabase)
+		}
+
+		for _, sub := range subs {
+			csvRecords = append(csvRecords, e.convertToCSVRecord(sub, now))
+		}
+
+		totalRecords += len(subs)
+		offset += batchSize
+
+		if len(subs) < batchSize {
+			break
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := gocsv.Marshal(csvRecords, &buf); err != nil {
+		return nil, 0, ierr.WithError(err).
+			WithHint("Failed to marshal data to CSV").
+			Mark(ierr.ErrInternal)
+	}
+
+	e.logger.Infow("completed subscription export data preparation",
+		"total_records", totalRecords,
+		"csv_size_bytes", buf.Len())
+
+	return buf.Bytes(), totalRecords, nil
+}
+
+// convertToCSVRecord converts a subscription and its active plan line items to a CSV record
+func (e *SubscriptionExporter) convertToCSVRecord(sub *subscription.Subscription, now time.Time) *SubscriptionCSV {
+	var overrides []subscriptionExportLineItem
+	for _, item := range sub.LineItems {
+		if item.EntityType != types.SubscriptionLineItemEntityTypePlan || !item.IsActive(now) {
+			continue
+		}
+		overrides = append(overrides, subscriptionExportLineItem{
+			PriceID:  item.PriceID,
+			Quantity: item.Quantity,
+		})
+	}
+
+	lookupKey := sub.LookupKey
+	if lookupKey == "" {
+		lookupKey = sub.ID
+	}
+
+	return &SubscriptionCSV{
+		ID:                     sub.ID,
+		CustomerID:             sub.CustomerID,
+		PlanID:                 sub.PlanID,
+		Currency:               sub.Currency,
+		LookupKey:              lookupKey,
+		SubscriptionStatus:     string(sub.SubscriptionStatus),
+		StartDate:              sub.StartDate.Format(time.RFC3339),
+		EndDate:                timeOrEmpty(sub.EndDate),
+		BillingPeriod:          string(sub.BillingPeriod),
+		BillingPeriodCount:     intOrEmpty(&sub.BillingPeriodCount),
+		BillingCycle:           string(sub.BillingCycle),
+		BillingAnchor:          sub.BillingAnchor.Format(time.RFC3339),
+		CollectionMethod:       sub.CollectionMethod,
+		PaymentBehavior:        sub.PaymentBehavior,
+		MigrationSource:        subscriptionExportMigrationSource,
+		ExternalSubscriptionID: sub.ID,
+		CurrentPeriodStart:     sub.CurrentPeriodStart.Format(time.RFC3339),
+		NextBillingDate:        sub.CurrentPeriodEnd.Format(time.RFC3339),
+		OverrideLineItems:      jsonOrEmpty(overrides),
+		Metadata:               jsonOrEmpty(sub.Metadata),
+	}
+}
+
+// GetFilenamePrefix returns the prefix for the exported file
+func (e *SubscriptionExporter) GetFilenamePrefix() string {
+	return string(types.ScheduledTaskEntityTypeSubscriptions)
+}
@@ -0,0 +1,108 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/wallet"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/integration"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gocarina/gocsv"
+	"github.com/samber/lo"
+)
+
+// WalletExporter handles wallet export operations. Active wallets are exported with their
+// current credit balance as opening_balance, so the file can be imported with a WALLETS task.
+type WalletExporter struct {
+	walletRepo         wallet.Repository
+	integrationFactory *integration.Factory
+	logger             *logger.Logger
+}
+
+// WalletCSV represents the CSV structure for wallet export
+type WalletCSV struct {
+	ID                  string `csv:"id"`
+	CustomerID          string `csv:"customer_id"`
+	Name                string `csv:"name"`
+	Description         string `csv:"description"`
+	Currency            string `csv:"currency"`
+	WalletType          string `csv:"wallet_type"`
+	WalletStatus        string `csv:"wallet_status"`
+	ConversionRate      string `csv:"conversion_rate"`       // Decimal as string
+	TopupConversionRate string `csv:"topup_conversion_rate"` // Decimal as string
+	OpeningBalance      string `csv:"opening_balance"`       // Credit balance as string
+	Balance             string `csv:"balance"`               // Balance in currency as string
+	Metadata            string `csv:"metadata"`              // JSON string
+	CreatedAt           string `csv:"created_at"`            // RFC3339 format
+}
+
+// NewWalletExporter creates a new wallet exporter
+func NewWalletExporter(
+	walletRepo wallet.Repository,
+	integrationFactory *integration.Factory,
+	logger *logger.Logger,
+) *WalletExporter {
+	return &WalletExporter{
+		walletRepo:         walletRepo,
+		integrationFactory: integrationFactory,
+		logger:             logger,
+	}
+}
+
+// PrepareData fetches all active wallets and converts them to CSV format
+func (e *WalletExporter) PrepareData(ctx context.Context, request *dto.ExportRequest) ([]byte, int, error) {
+	e.logger.Infow("starting wallet data fetch",
+		"tenant_id", request.TenantID,
+		"env_id", request.EnvID)
+
+	filter := types.NewWalletFilter()
+	filter.Status = lo.ToPtr(types.WalletStatusActive)
+
+	wallets, err := e.walletRepo.GetWalletsByFilter(ctx, filter)
+	if err != nil {
+		return nil, 0, ierr.WithError(err).
+			WithHint("Failed to fetch wallet data").
+			Mark(ierr.ErrDatabase)
+	}
+
+	csvRecords := make([]*WalletCSV, 0, len(wallets))
+	for _, w := range wallets {
+		csvRecords = append(csvRecords, &WalletCSV{
+			ID:                  w.ID,
+			CustomerID:          w.CustomerID,
+			Name:                w.Name,
+			Description:         w.Description,
+			Currency:            w.Currency,
+			WalletType:          string(w.WalletType),
+			WalletStatus:        string(w.WalletStatus),
+			ConversionRate:      w.ConversionRate.String(),
+			TopupConversionRate: w.TopupConversionRate.String(),
+			OpeningBalance:      w.CreditBalance.String(),
+			Balance:             w.Balance.String(),
+			Metadata:            jsonOrEmpty(w.Metadata),
+			CreatedAt:           w.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := gocsv.Marshal(csvRecords, &buf); err != nil {
+		return nil, 0, ierr.WithError(err).
+			WithHint("Failed to marshal data to CSV").
+			Mark(ierr.ErrInternal)
+	}
+
+	e.logger.Infow("completed wallet export data preparation",
+		"total_records", len(csvRecords),
+		"csv_size_bytes", buf.Len())
+
+	return buf.Bytes(), len(csvRecords), nil
+}
+
+// GetFilenamePrefix returns the prefix for the exported file
+func (e *WalletExporter) GetFilenamePrefix() string {
+	return string(types.ScheduledTaskEntityTypeWallets)
+}
@@ -28,6 +28,7 @@ type TaskService interface {
 	UpdateTaskStatus(ctx context.Context, id string, status types.TaskStatus) error
 	ProcessTaskWithStreaming(ctx context.Context, id string) error
 	GenerateDownloadURL(ctx context.Context, id string) (string, error)
+	GetErrorReport(ctx context.Context, id string) (string, error)
 }
 
 type taskService struct {
@@ -238,6 +239,34 @@ func (s *taskService) ProcessTaskWithStreaming(ctx context.Context, id string) e
 			featureService: featureSvc,
 			logger:         s.Logger,
 		}
+	case types.EntityTypeSubscriptions:
+		// Create subscription service for chunk processor
+		subscriptionSvc := NewSubscriptionService(s.ServiceParams)
+		processor = &SubscriptionsChunkProcessor{
+			subscriptionService: subscriptionSvc,
+			logger:              s.Logger,
+		}
+	case types.EntityTypePlans:
+		// Create plan service for chunk processor
+		planSvc := NewPlanService(s.ServiceParams)
+		processor = &PlansChunkProcessor{
+			planService: planSvc,
+			logger:      s.Logger,
+		}
+	case types.EntityTypeWallets:
+		// Create wallet service for chunk processor
+		walletSvc := NewWalletService(s.ServiceParams)
+		processor = &WalletsChunkProcessor{
+			walletService: walletSvc,
+			logger:        s.Logger,
+		}
+	case types.EntityTypeCoupons:
+		// Create coupon service for chunk processor
+		couponSvc := NewCouponService(s.ServiceParams)
+		processor = &CouponsChunkProcessor{
+			couponService: couponSvc,
+			logger:        s.Logger,
+		}
 	default:
 		return ierr.NewError("unsupported entity type").
 			WithHint("Unsupported entity type").
@@ -288,6 +317,7 @@ func (s *taskService) updateTaskWithResults(ctx context.Context, id string, t *t
 	currentTask.SuccessfulRecords = t.SuccessfulRecords
 	currentTask.FailedRecords = t.FailedRecords
 	currentTask.ErrorSummary = t.ErrorSummary
+	currentTask.ErrorReport = t.ErrorReport
 
 	// Update the task in the database
 	if err := s.TaskRepo.Update(ctx, currentTask); err != nil {
@@ -317,6 +347,7 @@ func (p *EventsChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]strin
 	successfulRecords := 0
 	failedRecords := 0
 	var errors []string
+	var rowErrors []RowError
 
 	// Batch process events for better performance
 	var eventRequests []*dto.IngestEventRequest
@@ -376,6 +407,7 @@ func (p *EventsChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]strin
 				var properties map[string]interface{}
 				if err := json.Unmarshal([]byte(record[j]), &properties); err != nil {
 					errors = append(errors, fmt.Sprintf("Record %d: invalid JSON in properties column: %v", i, err))
+					rowErrors = append(rowErrors, RowError{Index: i, Error: fmt.Sprintf("invalid JSON in properties column: %v", err)})
 					failedRecords++
 					skipRecord = true
 					break // Break out of the inner loop to skip this record
@@ -395,6 +427,7 @@ func (p *EventsChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]strin
 		// Validate the event request
 		if err := eventReq.Validate(); err != nil {
 			errors = append(errors, fmt.Sprintf("Record %d: %v", i, err))
+			rowErrors = append(rowErrors, RowError{Index: i, Error: err.Error()})
 			failedRecords++
 			continue
 		}
@@ -402,6 +435,7 @@ func (p *EventsChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]strin
 		// Parse timestamp
 		if err := p.parseTimestamp(eventReq); err != nil {
 			errors = append(errors, fmt.Sprintf("Record %d: timestamp error: %v", i, err))
+			rowErrors = append(rowErrors, RowError{Index: i, Error: fmt.Sprintf("timestamp error: %v", err)})
 			failedRecords++
 			continue
 		}
@@ -435,6 +469,7 @@ func (p *EventsChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]strin
 		SuccessfulRecords: successfulRecords,
 		FailedRecords:     failedRecords,
 		ErrorSummary:      errorSummary,
+		RowErrors:         rowErrors,
 	}, nil
 }
 
@@ -526,6 +561,7 @@ func (p *CustomersChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]st
 	successfulRecords := 0
 	failedRecords := 0
 	var errors []string
+	var rowErrors []RowError
 
 	p.logger.Debugw("processing customer chunk",
 		"chunk_index", chunkIndex,
@@ -588,6 +624,7 @@ func (p *CustomersChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]st
 		// Validate the customer request
 		if err := customerReq.Validate(); err != nil {
 			errors = append(errors, fmt.Sprintf("Record %d: %v", i, err))
+			rowErrors = append(rowErrors, RowError{Index: i, Error: err.Error()})
 			failedRecords++
 			continue
 		}
@@ -595,6 +632,7 @@ func (p *CustomersChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]st
 		// Process the customer (create or update)
 		if err := p.processCustomer(ctx, customerReq); err != nil {
 			errors = append(errors, fmt.Sprintf("Record %d: %v", i, err))
+			rowErrors = append(rowErrors, RowError{Index: i, Error: err.Error()})
 			failedRecords++
 			continue
 		}
@@ -614,6 +652,7 @@ func (p *CustomersChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]st
 		SuccessfulRecords: successfulRecords,
 		FailedRecords:     failedRecords,
 		ErrorSummary:      errorSummary,
+		RowErrors:         rowErrors,
 	}, nil
 }
 
@@ -735,6 +774,7 @@ func (p *PricesChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]strin
 	successfulRecords := 0
 	failedRecords := 0
 	var errors []string
+	var rowErrors []RowError
 
 	p.logger.Debugw("processing price chunk",
 		"chunk_index", chunkIndex,
@@ -840,6 +880,7 @@ func (p *PricesChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]strin
 				var tiers []dto.CreatePriceTier
 				if err := json.Unmarshal([]byte(value), &tiers); err != nil {
 					errors = append(errors, fmt.Sprintf("Record %d: failed to parse tiers JSON: %v", i, err))
+					rowErrors = append(rowErrors, RowError{Index: i, Error: fmt.Sprintf("failed to parse tiers JSON: %v", err)})
 					failedRecords++
 					hasParsingError = true
 					break
@@ -853,6 +894,7 @@ func (p *PricesChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]strin
 				var tiers []dto.CreatePriceTier
 				if err := json.Unmarshal([]byte(value), &tiers); err != nil {
 					errors = append(errors, fmt.Sprintf("Record %d: failed to parse price_unit_config_tiers JSON: %v", i, err))
+					rowErrors = append(rowErrors, RowError{Index: i, Error: fmt.Sprintf("failed to parse price_unit_config_tiers JSON: %v", err)})
 					failedRecords++
 					hasParsingError = true
 					break
@@ -883,6 +925,7 @@ func (p *PricesChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]strin
 		// Validate the price request
 		if err := priceReq.Validate(); err != nil {
 			errors = append(errors, fmt.Sprintf("Record %d: %v", i, err))
+			rowErrors = append(rowErrors, RowError{Index: i, Error: err.Error()})
 			failedRecords++
 			continue
 		}
@@ -890,6 +933,7 @@ func (p *PricesChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]strin
 		// Process the price (create)
 		if err := p.processPrice(ctx, priceReq); err != nil {
 			errors = append(errors, fmt.Sprintf("Record %d: %v", i, err))
+			rowErrors = append(rowErrors, RowError{Index: i, Error: err.Error()})
 			failedRecords++
 			continue
 		}
@@ -909,6 +953,7 @@ func (p *PricesChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]strin
 		SuccessfulRecords: successfulRecords,
 		FailedRecords:     failedRecords,
 		ErrorSummary:      errorSummary,
+		RowErrors:         rowErrors,
 	}, nil
 }
 
@@ -937,6 +982,7 @@ func (f *FeaturesChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]str
 	successfulRecords := 0
 	failedRecords := 0
 	var errors []string
+	var rowErrors []RowError
 
 	f.logger.Debugw("processing feature chunk",
 		"chunk_index", chunkIndex,
@@ -1039,6 +1085,7 @@ func (f *FeaturesChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]str
 				"error", err,
 				"feature_req", featureReq)
 			errors = append(errors, fmt.Sprintf("Record %d: %v", i, err))
+			rowErrors = append(rowErrors, RowError{Index: i, Error: err.Error()})
 			failedRecords++
 			continue
 		}
@@ -1046,6 +1093,7 @@ func (f *FeaturesChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]str
 		// Process the feature (create or update)
 		if err := f.processFeature(ctx, featureReq); err != nil {
 			errors = append(errors, fmt.Sprintf("Record %d: %v", i, err))
+			rowErrors = append(rowErrors, RowError{Index: i, Error: err.Error()})
 			failedRecords++
 			continue
 		}
@@ -1065,6 +1113,7 @@ func (f *FeaturesChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]str
 		SuccessfulRecords: successfulRecords,
 		FailedRecords:     failedRecords,
 		ErrorSummary:      errorSummary,
+		RowErrors:         rowErrors,
 	}, nil
 }
 
@@ -1152,6 +1201,682 @@ func (f *FeaturesChunkProcessor) processFeature(ctx context.Context, featureReq
 	return nil
 }
 
+// SubscriptionsChunkProcessor processes chunks of subscription data migrated from another billing system.
+// Every record is created in migration mode, so past periods are never invoiced. Line items are passed
+// as JSON arrays in the line_items and override_line_items columns.
+type SubscriptionsChunkProcessor struct {
+	subscriptionService SubscriptionService
+	logger              *logger.Logger
+}
+
+// ProcessChunk processes a chunk of subscription records
+func (p *SubscriptionsChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]string, headers []string, chunkIndex int) (*ChunkResult, error) {
+	processedRecords := 0
+	successfulRecords := 0
+	failedRecords := 0
+	var errors []string
+	var rowErrors []RowError
+
+	p.logger.Debugw("processing subscription chunk",
+		"chunk_index", chunkIndex,
+		"chunk_size", len(chunk),
+		"headers", headers)
+
+	// Process each record in the chunk
+	for i, record := range chunk {
+		processedRecords++
+
+		p.logger.Debugw("processing subscription record",
+			"record_index", i,
+			"record", record,
+			"chunk_index", chunkIndex)
+
+		// Create subscription request
+		subReq := &dto.CreateSubscriptionRequest{
+			Metadata:  make(map[string]string),
+			Migration: &dto.SubscriptionMigrationConfig{},
+		}
+
+		var parseErr error
+		parseTime := func(header, value string) *time.Time {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil && parseErr == nil {
+				parseErr = fmt.Errorf("failed to parse %s: %w", header, err)
+			}
+			return &t
+		}
+
+		// Map standard fields
+		for j, header := range headers {
+			if j >= len(record) {
+				continue
+			}
+			value := record[j]
+			if value == "" {
+				continue
+			}
+			switch header {
+			case "customer_id":
+				subReq.CustomerID = value
+			case "external_customer_id":
+				subReq.ExternalCustomerID = value
+			case "plan_id":
+				subReq.PlanID = value
+			case "currency":
+				subReq.Currency = value
+			case "lookup_key":
+				subReq.LookupKey = value
+			case "start_date":
+				subReq.StartDate = parseTime(header, value)
+			case "end_date":
+				subReq.EndDate = parseTime(header, value)
+			case "billing_period":
+				subReq.BillingPeriod = types.BillingPeriod(value)
+			case "billing_period_count":
+				count, _ := strconv.Atoi(value)
+				subReq.BillingPeriodCount = count
+			case "billing_cycle":
+				subReq.BillingCycle = types.BillingCycle(value)
+			case "billing_anchor":
+				subReq.BillingAnchor = parseTime(header, value)
+			case "collection_method":
+				subReq.CollectionMethod = lo.ToPtr(types.CollectionMethod(value))
+			case "payment_behavior":
+				subReq.PaymentBehavior = lo.ToPtr(types.PaymentBehavior(value))
+			case "customer_timezone":
+				subReq.CustomerTimezone = value
+			case "migration_source":
+				subReq.Migration.Source = value
+			case "external_subscription_id":
+				subReq.Migration.ExternalSubscriptionID = value
+			case "current_period_start":
+				subReq.Migration.CurrentPeriodStart = parseTime(header, value)
+			case "next_billing_date":
+				subReq.Migration.NextBillingDate = parseTime(header, value)
+			case "invoiced_through":
+				subReq.Migration.InvoicedThrough = parseTime(header, value)
+			case "line_items":
+				// Parse JSON array of extra line items
+				var lineItems []dto.CreateSubscriptionLineItemRequest
+				if err := json.Unmarshal([]byte(value), &lineItems); err != nil && parseErr == nil {
+					parseErr = fmt.Errorf("failed to parse line_items JSON: %w", err)
+				}
+				subReq.LineItems = lineItems
+			case "override_line_items":
+				// Parse JSON array of plan price overrides (e.g. quantities)
+				var overrides []dto.OverrideLineItemRequest
+				if err := json.Unmarshal([]byte(value), &overrides); err != nil && parseErr == nil {
+					parseErr = fmt.Errorf("failed to parse override_line_items JSON: %w", err)
+				}
+				subReq.OverrideLineItems = overrides
+			}
+		}
+
+		// Skip this record if a field could not be parsed
+		if parseErr != nil {
+			errors = append(errors, fmt.Sprintf("Record %d: %v", i, parseErr))
+			rowErrors = append(rowErrors, RowError{Index: i, Error: parseErr.Error()})
+			failedRecords++
+			continue
+		}
+
+		// Parse metadata fields (a metadata JSON column or headers starting with "metadata.")
+		metadata, err := parseImportMetadata(headers, record)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("Record %d: %v", i, err))
+			rowErrors = append(rowErrors, RowError{Index: i, Error: err.Error()})
+			failedRecords++
+			continue
+		}
+		subReq.Metadata = metadata
+
+		// The external subscription ID identifies the record across re-runs of the import
+		if subReq.LookupKey == "" {
+			subReq.LookupKey = subReq.Migration.ExternalSubscriptionID
+		}
+
+		// Process the subscription (create unless already imported)
+		if err := p.processSubscription(ctx, subReq); err != nil {
+			errors = append(errors, fmt.Sprintf("Record %d: %v", i, err))
+			rowErrors = append(rowErrors, RowError{Index: i, Error: err.Error()})
+			failedRecords++
+			continue
+		}
+
+		successfulRecords++
+	}
+
+	// Create error summary if there are errors
+	var errorSummary *string
+	if len(errors) > 0 {
+		summary := strings.Join(errors, "; ")
+		errorSummary = &summary
+	}
+
+	return &ChunkResult{
+		ProcessedRecords:  processedRecords,
+		SuccessfulRecords: successfulRecords,
+		FailedRecords:     failedRecords,
+		ErrorSummary:      errorSummary,
+		RowErrors:         rowErrors,
+	}, nil
+}
+
+// processSubscription creates a single migrated subscription, skipping it if a subscription
+// with the same lookup key was already imported
+func (p *SubscriptionsChunkProcessor) processSubscription(ctx context.Context, subReq *dto.CreateSubscriptionRequest) error {
+	if subReq.LookupKey != "" {
+		filter := types.NewSubscriptionFilter()
+		filter.LookupKeys = []string{subReq.LookupKey}
+		filter.SubscriptionStatusNotIn = []types.SubscriptionStatus{types.SubscriptionStatusCancelled}
+		filter.Limit = lo.ToPtr(1)
+
+		existing, err := p.subscriptionService.ListSubscriptions(ctx, filter)
+		if err != nil {
+			p.logger.Error("failed to search for existing subscription", "lookup_key", subReq.LookupKey, "error", err)
+			return fmt.Errorf("failed to search for existing subscription: %w", err)
+		}
+		if len(existing.Items) > 0 {
+			p.logger.Info("subscription already imported", "lookup_key", subReq.LookupKey, "subscription_id", existing.Items[0].ID)
+			return nil
+		}
+	}
+
+	sub, err := p.subscriptionService.CreateSubscription(ctx, *subReq)
+	if err != nil {
+		p.logger.Error("failed to create subscription", "error", err)
+		return fmt.Errorf("failed to create subscription: %w", err)
+	}
+
+	p.logger.Info("created migrated subscription",
+		"subscription_id", sub.ID,
+		"lookup_key", subReq.LookupKey,
+		"current_period_start", sub.CurrentPeriodStart,
+		"current_period_end", sub.CurrentPeriodEnd)
+	return nil
+}
+
+// PlansChunkProcessor processes chunks of plan data. Prices are imported separately
+// with a PRICES task that references the plans by ID.
+type PlansChunkProcessor struct {
+	planService PlanService
+	logger      *logger.Logger
+}
+
+// ProcessChunk processes a chunk of plan records
+func (p *PlansChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]string, headers []string, chunkIndex int) (*ChunkResult, error) {
+	processedRecords := 0
+	successfulRecords := 0
+	failedRecords := 0
+	var errors []string
+	var rowErrors []RowError
+
+	p.logger.Debugw("processing plan chunk",
+		"chunk_index", chunkIndex,
+		"chunk_size", len(chunk),
+		"headers", headers)
+
+	for i, record := range chunk {
+		processedRecords++
+
+		planReq := &dto.CreatePlanRequest{}
+
+		var parseErr error
+		for j, header := range headers {
+			if j >= len(record) {
+				continue
+			}
+			value := record[j]
+			if value == "" {
+				continue
+			}
+			switch header {
+			case "name":
+				planReq.Name = value
+			case "lookup_key":
+				planReq.LookupKey = value
+			case "description":
+				planReq.Description = value
+			case "display_order":
+				displayOrder, err := strconv.Atoi(value)
+				if err != nil && parseErr == nil {
+					parseErr = fmt.Errorf("failed to parse display_order: %w", err)
+				}
+				planReq.DisplayOrder = lo.ToPtr(displayOrder)
+			}
+		}
+
+		metadata, err := parseImportMetadata(headers, record)
+		if err != nil && parseErr == nil {
+			parseErr = err
+		}
+		planReq.Metadata = metadata
+
+		if parseErr != nil {
+			errors = append(errors, fmt.Sprintf("Record %d: %v", i, parseErr))
+			rowErrors = append(rowErrors, RowError{Index: i, Error: parseErr.Error()})
+			failedRecords++
+			continue
+		}
+
+		if err := planReq.Validate(); err != nil {
+			errors = append(errors, fmt.Sprintf("Record %d: %v", i, err))
+			rowErrors = append(rowErrors, RowError{Index: i, Error: err.Error()})
+			failedRecords++
+			continue
+		}
+
+		if err := p.processPlan(ctx, planReq); err != nil {
+			errors = append(errors, fmt.Sprintf("Record %d: %v", i, err))
+			rowErrors = append(rowErrors, RowError{Index: i, Error: err.Error()})
+			failedRecords++
+			continue
+		}
+
+		successfulRecords++
+	}
+
+	// Create error summary if there are errors
+	var errorSummary *string
+	if len(errors) > 0 {
+		summary := strings.Join(errors, "; ")
+		errorSummary = &summary
+	}
+
+	return &ChunkResult{
+		ProcessedRecords:  processedRecords,
+		SuccessfulRecords: successfulRecords,
+		FailedRecords:     failedRecords,
+		ErrorSummary:      errorSummary,
+		RowErrors:         rowErrors,
+	}, nil
+}
+
+// processPlan creates a single plan, skipping it if a plan with the same lookup key exists
+func (p *PlansChunkProcessor) processPlan(ctx context.Context, planReq *dto.CreatePlanRequest) error {
+	if planReq.LookupKey != "" {
+		filter := types.NewPlanFilter()
+		filter.LookupKey = lo.ToPtr(planReq.LookupKey)
+		filter.Limit = lo.ToPtr(1)
+
+		existing, err := p.planService.GetPlans(ctx, filter)
+		if err != nil {
+			p.logger.Error("failed to search for existing plan", "lookup_key", planReq.LookupKey, "error", err)
+			return fmt.Errorf("failed to search for existing plan: %w", err)
+		}
+		if len(existing.Items) > 0 {
+			p.logger.Info("plan already exists", "lookup_key", planReq.LookupKey, "plan_id", existing.Items[0].ID)
+			return nil
+		}
+	}
+
+	plan, err := p.planService.CreatePlan(ctx, *planReq)
+	if err != nil {
+		p.logger.Error("failed to create plan", "error", err)
+		return fmt.Errorf("failed to create plan: %w", err)
+	}
+
+	p.logger.Info("created plan", "plan_id", plan.ID, "lookup_key", planReq.LookupKey)
+	return nil
+}
+
+// WalletsChunkProcessor processes chunks of wallet data. The opening_balance column is
+// loaded into the new wallet as credits in the same transaction that creates it.
+type WalletsChunkProcessor struct {
+	walletService WalletService
+	logger        *logger.Logger
+}
+
+// ProcessChunk processes a chunk of wallet records
+func (p *WalletsChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]string, headers []string, chunkIndex int) (*ChunkResult, error) {
+	processedRecords := 0
+	successfulRecords := 0
+	failedRecords := 0
+	var errors []string
+	var rowErrors []RowError
+
+	p.logger.Debugw("processing wallet chunk",
+		"chunk_index", chunkIndex,
+		"chunk_size", len(chunk),
+		"headers", headers)
+
+	for i, record := range chunk {
+		processedRecords++
+
+		walletReq := &dto.CreateWalletRequest{}
+
+		var parseErr error
+		parseDecimal := func(header, value string) decimal.Decimal {
+			d, err := decimal.NewFromString(value)
+			if err != nil && parseErr == nil {
+				parseErr = fmt.Errorf("failed to parse %s: %w", header, err)
+			}
+			return d
+		}
+
+		for j, header := range headers {
+			if j >= len(record) {
+				continue
+			}
+			value := record[j]
+			if value == "" {
+				continue
+			}
+			switch header {
+			case "customer_id":
+				walletReq.CustomerID = value
+			case "external_customer_id":
+				walletReq.ExternalCustomerID = value
+			case "name":
+				walletReq.Name = value
+			case "description":
+				walletReq.Description = value
+			case "currency":
+				walletReq.Currency = value
+			case "wallet_type":
+				walletReq.WalletType = types.WalletType(value)
+			case "conversion_rate":
+				walletReq.ConversionRate = parseDecimal(header, value)
+			case "topup_conversion_rate":
+				walletReq.TopupConversionRate = lo.ToPtr(parseDecimal(header, value))
+			case "opening_balance":
+				walletReq.InitialCreditsToLoad = parseDecimal(header, value)
+			case "opening_balance_expiry_date":
+				expiry, err := time.Parse(time.RFC3339, value)
+				if err != nil && parseErr == nil {
+					parseErr = fmt.Errorf("failed to parse %s: %w", header, err)
+				}
+				walletReq.InitialCreditsExpiryDateUTC = &expiry
+			case "price_unit":
+				walletReq.PriceUnit = lo.ToPtr(value)
+			}
+		}
+
+		metadata, err := parseImportMetadata(headers, record)
+		if err != nil && parseErr == nil {
+			parseErr = err
+		}
+		walletReq.Metadata = metadata
+
+		if parseErr == nil && walletReq.CustomerID == "" && walletReq.ExternalCustomerID == "" {
+			parseErr = fmt.Errorf("either customer_id or external_customer_id is required")
+		}
+		if parseErr == nil && walletReq.InitialCreditsToLoad.IsNegative() {
+			parseErr = fmt.Errorf("opening_balance cannot be negative")
+		}
+
+		if parseErr != nil {
+			errors = append(errors, fmt.Sprintf("Record %d: %v", i, parseErr))
+			rowErrors = append(rowErrors, RowError{Index: i, Error: parseErr.Error()})
+			failedRecords++
+			continue
+		}
+
+		if walletReq.WalletType == "" {
+			walletReq.WalletType = types.WalletTypePrePaid
+		}
+
+		if err := p.processWallet(ctx, walletReq); err != nil {
+			errors = append(errors, fmt.Sprintf("Record %d: %v", i, err))
+			rowErrors = append(rowErrors, RowError{Index: i, Error: err.Error()})
+			failedRecords++
+			continue
+		}
+
+		successfulRecords++
+	}
+
+	// Create error summary if there are errors
+	var errorSummary *string
+	if len(errors) > 0 {
+		summary := strings.Join(errors, "; ")
+		errorSummary = &summary
+	}
+
+	return &ChunkResult{
+		ProcessedRecords:  processedRecords,
+		SuccessfulRecords: successfulRecords,
+		FailedRecords:     failedRecords,
+		ErrorSummary:      errorSummary,
+		RowErrors:         rowErrors,
+	}, nil
+}
+
+// processWallet creates a single wallet with its opening balance. A customer can only have one
+// active wallet per currency and type, so a wallet that already exists is treated as imported
+// unless the row has an opening balance, which is then reported instead of silently dropped.
+func (p *WalletsChunkProcessor) processWallet(ctx context.Context, walletReq *dto.CreateWalletRequest) error {
+	w, err := p.walletService.CreateWallet(ctx, walletReq)
+	if err != nil {
+		if ierr.IsAlreadyExists(err) {
+			p.logger.Info("wallet already exists",
+				"customer_id", walletReq.CustomerID,
+				"external_customer_id", walletReq.ExternalCustomerID,
+				"currency", walletReq.Currency)
+			if walletReq.InitialCreditsToLoad.IsPositive() {
+				return fmt.Errorf("wallet already exists, opening_balance %s was not loaded; top up the existing wallet instead", walletReq.InitialCreditsToLoad)
+			}
+			return nil
+		}
+		p.logger.Error("failed to create wallet", "error", err)
+		return fmt.Errorf("failed to create wallet: %w", err)
+	}
+
+	p.logger.Info("created wallet",
+		"wallet_id", w.ID,
+		"customer_id", w.CustomerID,
+		"opening_balance", walletReq.InitialCreditsToLoad)
+	return nil
+}
+
+// couponImportIDMetadataKey is the coupon metadata key holding the import_id a coupon was
+// imported with, so re-running an import skips the coupons it already created
+const couponImportIDMetadataKey = "import_id"
+
+// CouponsChunkProcessor processes chunks of coupon data. Rules are passed as a JSON object
+// in the rules column. Coupons are deduplicated on the import_id column, or the id column
+// written by coupon exports, falling back to the coupon name.
+type CouponsChunkProcessor struct {
+	couponService CouponService
+	logger        *logger.Logger
+
+	// importedCoupons maps import IDs to the coupons created for them, loaded on first use
+	importedCoupons map[string]string
+}
+
+// ProcessChunk processes a chunk of coupon records
+func (p *CouponsChunkProcessor) ProcessChunk(ctx context.Context, chunk [][]string, headers []string, chunkIndex int) (*ChunkResult, error) {
+	processedRecords := 0
+	successfulRecords := 0
+	failedRecords := 0
+	var errors []string
+	var rowErrors []RowError
+
+	p.logger.Debugw("processing coupon chunk",
+		"chunk_index", chunkIndex,
+		"chunk_size", len(chunk),
+		"headers", headers)
+
+	for i, record := range chunk {
+		processedRecords++
+
+		couponReq := &dto.CreateCouponRequest{}
+		var importID string
+
+		var parseErr error
+		parseDecimal := func(header, value string) *decimal.Decimal {
+			d, err := decimal.NewFromString(value)
+			if err != nil && parseErr == nil {
+				parseErr = fmt.Errorf("failed to parse %s: %w", header, err)
+			}
+			return &d
+		}
+		parseInt := func(header, value string) *int {
+			n, err := strconv.Atoi(value)
+			if err != nil && parseErr == nil {
+				parseErr = fmt.Errorf("failed to parse %s: %w", header, err)
+			}
+			return &n
+		}
+		parseTime := func(header, value string) *time.Time {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil && parseErr == nil {
+				parseErr = fmt.Errorf("failed to parse %s: %w", header, err)
+			}
+			return &t
+		}
+
+		for j, header := range headers {
+			if j >= len(record) {
+				continue
+			}
+			value := record[j]
+			if value == "" {
+				continue
+			}
+			switch header {
+			case "import_id":
+				importID = value
+			case "id":
+				if importID == "" {
+					importID = value
+				}
+			case "name":
+				couponReq.Name = value
+			case "type":
+				couponReq.Type = types.CouponType(value)
+			case "cadence":
+				couponReq.Cadence = types.CouponCadence(value)
+			case "amount_off":
+				couponReq.AmountOff = parseDecimal(header, value)
+			case "percentage_off":
+				couponReq.PercentageOff = parseDecimal(header, value)
+			case "currency":
+				couponReq.Currency = lo.ToPtr(value)
+			case "redeem_after":
+				couponReq.RedeemAfter = parseTime(header, value)
+			case "redeem_before":
+				couponReq.RedeemBefore = parseTime(header, value)
+			case "max_redemptions":
+				couponReq.MaxRedemptions = parseInt(header, value)
+			case "duration_in_periods":
+				couponReq.DurationInPeriods = parseInt(header, value)
+			case "rules":
+				var rules types.CouponRules
+				if err := json.Unmarshal([]byte(value), &rules); err != nil && parseErr == nil {
+					parseErr = fmt.Errorf("failed to parse rules JSON: %w", err)
+				}
+				couponReq.Rules = &rules
+			}
+		}
+
+		metadata, err := parseImportMetadata(headers, record)
+		if err != nil && parseErr == nil {
+			parseErr = err
+		}
+		if importID == "" {
+			importID = couponReq.Name
+		}
+		couponMetadata := map[string]string(metadata)
+		if couponMetadata == nil {
+			couponMetadata = make(map[string]string)
+		}
+		couponMetadata[couponImportIDMetadataKey] = importID
+		couponReq.Metadata = &couponMetadata
+
+		if parseErr == nil && importID == "" {
+			parseErr = fmt.Errorf("either import_id, id or name is required")
+		}
+
+		if parseErr != nil {
+			errors = append(errors, fmt.Sprintf("Record %d: %v", i, parseErr))
+			rowErrors = append(rowErrors, RowError{Index: i, Error: parseErr.Error()})
+			failedRecords++
+			continue
+		}
+
+		if err := p.processCoupon(ctx, importID, couponReq); err != nil {
+			errors = append(errors, fmt.Sprintf("Record %d: %v", i, err))
+			rowErrors = append(rowErrors, RowError{Index: i, Error: err.Error()})
+			failedRecords++
+			continue
+		}
+
+		successfulRecords++
+	}
+
+	// Create error summary if there are errors
+	var errorSummary *string
+	if len(errors) > 0 {
+		summary := strings.Join(errors, "; ")
+		errorSummary = &summary
+	}
+
+	return &ChunkResult{
+		ProcessedRecords:  processedRecords,
+		SuccessfulRecords: successfulRecords,
+		FailedRecords:     failedRecords,
+		ErrorSummary:      errorSummary,
+		RowErrors:         rowErrors,
+	}, nil
+}
+
+// processCoupon creates a single coupon, skipping it if a coupon was already imported with the
+// same import ID
+func (p *CouponsChunkProcessor) processCoupon(ctx context.Context, importID string, couponReq *dto.CreateCouponRequest) error {
+	if p.importedCoupons == nil {
+		coupons, err := p.couponService.ListCoupons(ctx, types.NewNoLimitCouponFilter())
+		if err != nil {
+			p.logger.Error("failed to list existing coupons", "error", err)
+			return fmt.Errorf("failed to search for existing coupons: %w", err)
+		}
+		p.importedCoupons = make(map[string]string, len(coupons.Items))
+		for _, c := range coupons.Items {
+			if c.Metadata != nil && (*c.Metadata)[couponImportIDMetadataKey] != "" {
+				p.importedCoupons[(*c.Metadata)[couponImportIDMetadataKey]] = c.ID
+			}
+		}
+	}
+
+	if couponID, ok := p.importedCoupons[importID]; ok {
+		p.logger.Info("coupon already imported", "import_id", importID, "coupon_id", couponID)
+		return nil
+	}
+
+	coupon, err := p.couponService.CreateCoupon(ctx, *couponReq)
+	if err != nil {
+		p.logger.Error("failed to create coupon", "error", err)
+		return err
+	}
+	p.importedCoupons[importID] = coupon.ID
+
+	p.logger.Info("created coupon", "coupon_id", coupon.ID, "import_id", importID, "name", couponReq.Name)
+	return nil
+}
+
+// parseImportMetadata reads metadata from a record, either from a metadata column holding a
+// JSON object (as written by exports) or from one column per key named metadata.<key>
+func parseImportMetadata(headers []string, record []string) (types.Metadata, error) {
+	metadata := types.Metadata{}
+	for j, header := range headers {
+		if j >= len(record) || record[j] == "" {
+			continue
+		}
+		value := record[j]
+		switch {
+		case header == "metadata":
+			var parsed map[string]string
+			if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+				return nil, fmt.Errorf("failed to parse metadata JSON: %w", err)
+			}
+			maps.Copy(metadata, parsed)
+		case strings.HasPrefix(header, "metadata."):
+			metadata[strings.TrimPrefix(header, "metadata.")] = value
+		}
+	}
+	return metadata, nil
+}
+
 // GenerateDownloadURL generates a presigned URL for downloading an exported file
 func (s *taskService) GenerateDownloadURL(ctx context.Context, id string) (string, error) {
 	// Get the task
@@ -1325,3 +2050,36 @@ func (s *taskService) GenerateDownloadURL(ctx context.Context, id string) (strin
 
 	return result.URL, nil
 }
+
+// GetErrorReport returns the CSV report of the rows an import task failed to process
+func (s *taskService) GetErrorReport(ctx context.Context, id string) (string, error) {
+	t, err := s.TaskRepo.Get(ctx, id)
+	if err != nil {
+		return "", ierr.WithError(err).
+			WithHint("Failed to get task").
+			Mark(ierr.ErrNotFound)
+	}
+
+	if t.TaskType != types.TaskTypeImport {
+		return "", ierr.NewError("error reports are only available for import tasks").
+			WithHint("Use the download URL of the export instead").
+			WithReportableDetails(map[string]interface{}{
+				"task_id":   id,
+				"task_type": t.TaskType,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if t.ErrorReport == nil {
+		return "", ierr.NewError("task has no error report").
+			WithHint("The import has not completed yet or no rows failed").
+			WithReportableDetails(map[string]interface{}{
+				"task_id":        id,
+				"task_status":    t.TaskStatus,
+				"failed_records": t.FailedRecords,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	return *t.ErrorReport, nil
+}
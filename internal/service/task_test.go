@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
 	"github.com/flexprice/flexprice/internal/domain/events"
 	"github.com/flexprice/flexprice/internal/domain/feature"
 	"github.com/flexprice/flexprice/internal/domain/meter"
@@ -18,6 +19,7 @@ import (
 	"github.com/flexprice/flexprice/internal/testutil"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -82,6 +84,9 @@ func (s *TaskServiceSuite) setupService() {
 			TaxRateRepo:        s.GetStores().TaxRateRepo,
 			TaxAppliedRepo:     s.GetStores().TaxAppliedRepo,
 			TaxAssociationRepo: s.GetStores().TaxAssociationRepo,
+			CouponRepo:         s.GetStores().CouponRepo,
+			SettingsRepo:       s.GetStores().SettingsRepo,
+			AlertLogsRepo:      s.GetStores().AlertLogsRepo,
 		},
 	)
 }
@@ -1183,3 +1188,188 @@ func (s *TaskServiceSuite) TestFeatureImportErrorHandling() {
 	s.Contains(*updatedTask.ErrorSummary, "Record 1")
 	s.Contains(*updatedTask.ErrorSummary, "Record 2")
 }
+
+func (s *TaskServiceSuite) TestPlanImportErrorReport() {
+	existingPlan := &plan.Plan{
+		ID:        s.GetUUID(),
+		Name:      "Existing Plan",
+		LookupKey: "existing-plan",
+		BaseModel: types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().PlanRepo.Create(s.GetContext(), existingPlan))
+
+	planTask := &task.Task{
+		ID:         "task_plan_import",
+		TaskType:   types.TaskTypeImport,
+		EntityType: types.EntityTypePlans,
+		FileURL:    "https://example.com/plans.csv",
+		FileType:   types.FileTypeCSV,
+		TaskStatus: types.TaskStatusPending,
+		BaseModel:  types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().TaskRepo.Create(s.GetContext(), planTask))
+
+	data := [][]string{
+		{"name", "lookup_key", "description", "display_order", "metadata.tier"},
+		{"Starter", "starter", "Starter plan", "1", "basic"},
+		{"", "no-name", "Missing name", "2", ""},       // Missing name
+		{"Pro", "pro", "Pro plan", "three", ""},        // Invalid display_order
+		{"Existing Plan", "existing-plan", "", "", ""}, // Already exists, skipped
+	}
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	s.NoError(writer.WriteAll(data))
+
+	s.client.RegisterResponse("plans.csv", testutil.MockResponse{
+		StatusCode: http.StatusOK,
+		Body:       buf.Bytes(),
+		Headers: map[string]string{
+			"Content-Type": "text/csv",
+		},
+	})
+
+	s.NoError(s.service.ProcessTaskWithStreaming(s.GetContext(), planTask.ID))
+
+	updatedTask, err := s.service.GetTask(s.GetContext(), planTask.ID)
+	s.NoError(err)
+	s.Equal(types.TaskStatusCompleted, updatedTask.TaskStatus)
+	s.Equal(4, updatedTask.ProcessedRecords)
+	s.Equal(2, updatedTask.SuccessfulRecords)
+	s.Equal(2, updatedTask.FailedRecords)
+	s.True(updatedTask.HasErrorReport)
+
+	starter, err := s.GetStores().PlanRepo.GetByLookupKey(s.GetContext(), "starter")
+	s.NoError(err)
+	s.Equal("basic", starter.Metadata["tier"])
+	s.Equal(1, lo.FromPtr(starter.DisplayOrder))
+
+	// The report keeps the original columns of the failed rows, with their row number and error
+	report, err := s.service.GetErrorReport(s.GetContext(), planTask.ID)
+	s.NoError(err)
+	rows, err := csv.NewReader(bytes.NewReader([]byte(report))).ReadAll()
+	s.NoError(err)
+	s.Len(rows, 3)
+	s.Equal([]string{"row", "error", "name", "lookup_key", "description", "display_order", "metadata.tier"}, rows[0])
+	s.Equal("2", rows[1][0])
+	s.Equal("no-name", rows[1][3])
+	s.Equal("3", rows[2][0])
+	s.Contains(rows[2][1], "display_order")
+	s.Equal("three", rows[2][5])
+}
+
+func (s *TaskServiceSuite) TestWalletImportOpeningBalance() {
+	cust := &customer.Customer{
+		ID:         s.GetUUID(),
+		ExternalID: "cust_wallet_import",
+		Name:       "Wallet Import Customer",
+		BaseModel:  types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(s.GetContext(), cust))
+
+	walletTask := &task.Task{
+		ID:         "task_wallet_import",
+		TaskType:   types.TaskTypeImport,
+		EntityType: types.EntityTypeWallets,
+		FileURL:    "https://example.com/wallets.csv",
+		FileType:   types.FileTypeCSV,
+		TaskStatus: types.TaskStatusPending,
+		BaseModel:  types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().TaskRepo.Create(s.GetContext(), walletTask))
+
+	data := [][]string{
+		{"external_customer_id", "currency", "name", "conversion_rate", "opening_balance"},
+		{"cust_wallet_import", "usd", "Migrated wallet", "1", "150.50"},
+		{"cust_wallet_import", "eur", "Bad balance", "1", "-10"},     // Negative opening balance
+		{"cust_wallet_import", "usd", "Duplicate wallet", "1", "20"}, // Opening balance for an existing wallet
+	}
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	s.NoError(writer.WriteAll(data))
+
+	s.client.RegisterResponse("wallets.csv", testutil.MockResponse{
+		StatusCode: http.StatusOK,
+		Body:       buf.Bytes(),
+		Headers: map[string]string{
+			"Content-Type": "text/csv",
+		},
+	})
+
+	s.NoError(s.service.ProcessTaskWithStreaming(s.GetContext(), walletTask.ID))
+
+	updatedTask, err := s.GetStores().TaskRepo.Get(s.GetContext(), walletTask.ID)
+	s.NoError(err)
+	s.Equal(1, updatedTask.SuccessfulRecords)
+	s.Equal(2, updatedTask.FailedRecords)
+	s.NotNil(updatedTask.ErrorReport)
+	s.Contains(*updatedTask.ErrorReport, "opening_balance cannot be negative")
+	s.Contains(*updatedTask.ErrorReport, "opening_balance 20 was not loaded")
+
+	wallets, err := s.GetStores().WalletRepo.GetWalletsByCustomerID(s.GetContext(), cust.ID)
+	s.NoError(err)
+	s.Len(wallets, 1)
+	s.Equal("usd", wallets[0].Currency)
+	s.True(wallets[0].CreditBalance.Equal(decimal.RequireFromString("150.50")))
+}
+
+func (s *TaskServiceSuite) TestCouponImportJSON() {
+	couponTask := &task.Task{
+		ID:         "task_coupon_import",
+		TaskType:   types.TaskTypeImport,
+		EntityType: types.EntityTypeCoupons,
+		FileURL:    "https://example.com/coupons.json",
+		FileType:   types.FileTypeJSON,
+		TaskStatus: types.TaskStatusPending,
+		BaseModel:  types.GetDefaultBaseModel(s.GetContext()),
+	}
+	s.NoError(s.GetStores().TaskRepo.Create(s.GetContext(), couponTask))
+
+	body := `[
+		{"name": "Launch 20", "type": "percentage", "cadence": "once", "percentage_off": 20, "max_redemptions": 100, "metadata": {"campaign": "launch"}},
+		{"name": "Ten off", "type": "fixed", "cadence": "forever", "amount_off": "10", "currency": "usd"},
+		{"name": "Broken", "type": "percentage", "cadence": "once", "percentage_off": "twenty"}
+	]`
+
+	s.client.RegisterResponse("coupons.json", testutil.MockResponse{
+		StatusCode: http.StatusOK,
+		Body:       []byte(body),
+		Headers: map[string]string{
+			"Content-Type": "application/json",
+		},
+	})
+
+	s.NoError(s.service.ProcessTaskWithStreaming(s.GetContext(), couponTask.ID))
+
+	updatedTask, err := s.GetStores().TaskRepo.Get(s.GetContext(), couponTask.ID)
+	s.NoError(err)
+	s.Equal(3, updatedTask.ProcessedRecords)
+	s.Equal(2, updatedTask.SuccessfulRecords)
+	s.Equal(1, updatedTask.FailedRecords)
+	s.NotNil(updatedTask.ErrorReport)
+	s.Contains(*updatedTask.ErrorReport, "percentage_off")
+
+	coupons, err := s.GetStores().CouponRepo.List(s.GetContext(), types.NewCouponFilter())
+	s.NoError(err)
+	s.Len(coupons, 2)
+	for _, c := range coupons {
+		if c.Name == "Launch 20" {
+			s.True(c.PercentageOff.Equal(decimal.NewFromInt(20)))
+			s.Equal(100, lo.FromPtr(c.MaxRedemptions))
+			s.Equal("launch", (*c.Metadata)["campaign"])
+		}
+	}
+
+	// Importing the same file again skips the coupons it already created
+	retryTask := *couponTask
+	retryTask.ID = "task_coupon_import_retry"
+	s.NoError(s.GetStores().TaskRepo.Create(s.GetContext(), &retryTask))
+	s.NoError(s.service.ProcessTaskWithStreaming(s.GetContext(), retryTask.ID))
+
+	updatedTask, err = s.GetStores().TaskRepo.Get(s.GetContext(), retryTask.ID)
+	s.NoError(err)
+	s.Equal(2, updatedTask.SuccessfulRecords)
+
+	coupons, err = s.GetStores().CouponRepo.List(s.GetContext(), types.NewCouponFilter())
+	s.NoError(err)
+	s.Len(coupons, 2)
+}
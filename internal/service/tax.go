@@ -85,6 +85,8 @@ func (s *taxService) CreateTaxRate(ctx context.Context, req dto.CreateTaxRateReq
 		return nil, err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeTaxRate, taxRate.ID, types.AuditLogActionCreate, nil, taxRate)
+
 	// Return the created tax rate
 	return &dto.TaxRateResponse{TaxRate: taxRate}, nil
 }
@@ -223,6 +225,7 @@ func (s *taxService) UpdateTaxRate(ctx context.Context, id string, req dto.Updat
 	if err != nil {
 		return nil, err
 	}
+	before := *taxRate
 
 	// Apply updates only for non-empty fields
 	if req.Name != "" {
@@ -254,6 +257,8 @@ func (s *taxService) UpdateTaxRate(ctx context.Context, id string, req dto.Updat
 		return nil, err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeTaxRate, id, types.AuditLogActionUpdate, &before, taxRate)
+
 	s.Logger.InfowCtx(ctx, "tax rate updated successfully",
 		"tax_rate_id", id,
 		"name", taxRate.Name,
@@ -292,6 +297,8 @@ func (s *taxService) DeleteTaxRate(ctx context.Context, id string) error {
 		return err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeTaxRate, id, types.AuditLogActionDelete, taxRate, nil)
+
 	s.Logger.InfowCtx(ctx, "tax rate deleted successfully",
 		"tax_rate_id", id,
 		"name", taxRate.Name,
@@ -580,6 +587,8 @@ func (s *taxService) CreateTaxAssociation(ctx context.Context, req *dto.CreateTa
 		return nil, err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeTaxAssociation, tc.ID, types.AuditLogActionCreate, nil, tc)
+
 	s.Logger.InfowCtx(ctx, "tax association created successfully",
 		"tax_config_id", tc.ID,
 		"tax_rate_id", tc.TaxRateID,
@@ -635,6 +644,7 @@ func (s *taxService) UpdateTaxAssociation(ctx context.Context, id string, req *d
 	if err != nil {
 		return nil, err
 	}
+	before := *existing
 
 	// Update fields if provided
 	if req.Priority != nil {
@@ -664,6 +674,8 @@ func (s *taxService) UpdateTaxAssociation(ctx context.Context, id string, req *d
 		return nil, err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeTaxAssociation, id, types.AuditLogActionUpdate, &before, existing)
+
 	s.Logger.InfowCtx(ctx, "tax association updated successfully",
 		"tax_association_id", id,
 		"tax_rate_id", existing.TaxRateID,
@@ -686,6 +698,7 @@ func (s *taxService) DeleteTaxAssociation(ctx context.Context, id string) error
 	if err != nil {
 		return err
 	}
+	before := *existing
 
 	// Delete tax association
 	err = s.TaxAssociationRepo.Delete(ctx, existing)
@@ -696,6 +709,7 @@ func (s *taxService) DeleteTaxAssociation(ctx context.Context, id string) error
 		return err
 	}
 
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeTaxAssociation, id, types.AuditLogActionDelete, &before, nil)
 	return nil
 }
 
@@ -46,6 +46,10 @@ func (s *tenantService) CreateTenant(ctx context.Context, req dto.CreateTenantRe
 		return nil, err
 	}
 
+	// Changes to a tenant are recorded in the tenant itself
+	s.recordAuditLog(context.WithValue(ctx, types.CtxTenantID, newTenant.ID),
+		types.AuditLogEntityTypeTenant, newTenant.ID, types.AuditLogActionCreate, nil, newTenant)
+
 	// Create a customer in the billing tenant for this new tenant
 	if err := s.CreateTenantAsBillingCustomer(ctx, newTenant); err != nil {
 		// Log error but don't fail tenant creation
@@ -214,6 +218,7 @@ func (s *tenantService) UpdateTenant(ctx context.Context, id string, req dto.Upd
 	if err != nil {
 		return nil, err
 	}
+	before := *existingTenant
 
 	var billingDetails tenant.TenantBillingDetails
 	if req.BillingDetails != nil {
@@ -250,6 +255,9 @@ func (s *tenantService) UpdateTenant(ctx context.Context, id string, req dto.Upd
 		return nil, err
 	}
 
+	s.recordAuditLog(context.WithValue(ctx, types.CtxTenantID, id),
+		types.AuditLogEntityTypeTenant, id, types.AuditLogActionUpdate, &before, existingTenant)
+
 	return dto.NewTenantResponse(existingTenant), nil
 }
 
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// UsageAnomalyService detects usage spikes and drops per customer × meter by comparing the
+// last complete window of meter_usage against a rolling baseline of the preceding windows.
+type UsageAnomalyService interface {
+	// DetectAnomalies evaluates the environment in context and logs usage_anomaly alerts.
+	// Alert state transitions emit usage.anomaly.detected / usage.anomaly.resolved webhooks.
+	DetectAnomalies(ctx context.Context) (*types.UsageAnomalyDetectionResult, error)
+}
+
+type usageAnomalyService struct {
+	ServiceParams
+}
+
+func NewUsageAnomalyService(params ServiceParams) UsageAnomalyService {
+	return &usageAnomalyService{
+		ServiceParams: params,
+	}
+}
+
+// getUsageBuckets returns the usage buckets of every meter of the environment, each
+// aggregated the way its meter is, so gauges reported more often do not look like spikes
+func (s *usageAnomalyService) getUsageBuckets(ctx context.Context, start, end time.Time, window time.Duration) ([]*events.MeterUsageBucket, error) {
+	meters, err := s.MeterRepo.ListAll(ctx, types.NewNoLimitMeterFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	meterIDsByAggregation := make(map[types.AggregationType][]string)
+	for _, m := range meters {
+		meterIDsByAggregation[m.Aggregation.Type] = append(meterIDsByAggregation[m.Aggregation.Type], m.ID)
+	}
+
+	buckets := make([]*events.MeterUsageBucket, 0)
+	for aggregationType, meterIDs := range meterIDsByAggregation {
+		aggregated, err := s.MeterUsageRepo.GetUsageBuckets(ctx, &events.MeterUsageQueryParams{
+			TenantID:        types.GetTenantID(ctx),
+			EnvironmentID:   types.GetEnvironmentID(ctx),
+			MeterIDs:        meterIDs,
+			AggregationType: aggregationType,
+			StartTime:       start,
+			EndTime:         end,
+			UseFinal:        true,
+		}, window)
+		if err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, aggregated...)
+	}
+	return buckets, nil
+}
+
+type usageAnomalySeriesKey struct {
+	externalCustomerID string
+	meterID            string
+}
+
+func (s *usageAnomalyService) DetectAnomalies(ctx context.Context) (*types.UsageAnomalyDetectionResult, error) {
+	result := &types.UsageAnomalyDetectionResult{}
+
+	settingsSvc := &settingsService{ServiceParams: s.ServiceParams}
+	cfg, err := GetSetting[types.UsageAnomalyConfig](settingsSvc, ctx, types.SettingKeyUsageAnomalyConfig)
+	if err != nil {
+		return nil, err
+	}
+	if !cfg.Enabled || cfg.BaselineBuckets() == 0 {
+		return result, nil
+	}
+
+	window := cfg.Window()
+	windowEnd := time.Now().UTC().Truncate(window)
+	windowStart := windowEnd.Add(-window)
+	baselineStart := windowStart.Add(-time.Duration(cfg.BaselineBuckets()) * window)
+
+	buckets, err := s.getUsageBuckets(ctx, baselineStart, windowEnd, window)
+	if err != nil {
+		return nil, err
+	}
+
+	series := make(map[usageAnomalySeriesKey]map[time.Time]decimal.Decimal)
+	for _, b := range buckets {
+		key := usageAnomalySeriesKey{externalCustomerID: b.ExternalCustomerID, meterID: b.MeterID}
+		if _, ok := series[key]; !ok {
+			series[key] = make(map[time.Time]decimal.Decimal)
+		}
+		series[key][b.WindowStart.UTC()] = b.Value
+	}
+
+	alertLogsService := NewAlertLogsService(s.ServiceParams)
+	customerIDs := make(map[string]string)
+
+	for key, points := range series {
+		result.Evaluated++
+
+		// Windows without usage count as zero so quiet periods pull the baseline down
+		baseline := make([]decimal.Decimal, 0, cfg.BaselineBuckets())
+		for t := baselineStart; t.Before(windowStart); t = t.Add(window) {
+			baseline = append(baseline, points[t])
+		}
+		observed := points[windowStart]
+
+		state, info := evaluateUsageAnomaly(cfg, baseline, observed)
+		info.MeterID = key.meterID
+		info.WindowStart = windowStart
+		info.WindowEnd = windowEnd
+
+		customerID, ok := customerIDs[key.externalCustomerID]
+		if !ok {
+			c, err := s.CustomerRepo.GetByLookupKey(ctx, key.externalCustomerID)
+			if err != nil {
+				s.Logger.WarnwCtx(ctx, "skipping usage anomaly check for unknown customer",
+					"external_customer_id", key.externalCustomerID,
+					"meter_id", key.meterID,
+					"error", err,
+				)
+				result.Failed++
+				continue
+			}
+			customerID = c.ID
+			customerIDs[key.externalCustomerID] = customerID
+		}
+
+		if state != types.AlertStateOk {
+			result.Anomalies++
+		}
+
+		if err := alertLogsService.LogAlert(ctx, &LogAlertRequest{
+			EntityType:       types.AlertEntityTypeMeter,
+			EntityID:         key.meterID,
+			ParentEntityType: lo.ToPtr("customer"),
+			ParentEntityID:   lo.ToPtr(customerID),
+			CustomerID:       lo.ToPtr(customerID),
+			AlertType:        types.AlertTypeUsageAnomaly,
+			AlertStatus:      state,
+			AlertInfo: types.AlertInfo{
+				ValueAtTime:  observed,
+				Timestamp:    windowEnd,
+				UsageAnomaly: info,
+			},
+		}); err != nil {
+			s.Logger.ErrorwCtx(ctx, "failed to log usage anomaly alert",
+				"error", err,
+				"customer_id", customerID,
+				"meter_id", key.meterID,
+			)
+			result.Failed++
+		}
+	}
+
+	s.Logger.InfowCtx(ctx, "completed usage anomaly detection",
+		"window_start", windowStart,
+		"window_end", windowEnd,
+		"evaluated", result.Evaluated,
+		"anomalies", result.Anomalies,
+		"failed", result.Failed,
+	)
+
+	return result, nil
+}
+
+// evaluateUsageAnomaly compares the observed window with the baseline windows.
+// Spikes raise in_alarm, drops (when enabled) raise warning, anything else is ok.
+// Baselines whose mean is below MinBaselineValue are considered too thin to judge.
+func evaluateUsageAnomaly(cfg types.UsageAnomalyConfig, baseline []decimal.Decimal, observed decimal.Decimal) (types.AlertState, *types.UsageAnomalyInfo) {
+	info := &types.UsageAnomalyInfo{
+		ObservedValue:   observed,
+		BaselineBuckets: len(baseline),
+	}
+	if len(baseline) == 0 {
+		return types.AlertStateOk, info
+	}
+
+	n := decimal.NewFromInt(int64(len(baseline)))
+	sum := decimal.Zero
+	for _, v := range baseline {
+		sum = sum.Add(v)
+	}
+	mean := sum.Div(n)
+
+	variance := decimal.Zero
+	for _, v := range baseline {
+		diff := v.Sub(mean)
+		variance = variance.Add(diff.Mul(diff))
+	}
+	variance = variance.Div(n)
+	stdDev := decimal.NewFromFloat(math.Sqrt(variance.InexactFloat64()))
+
+	info.BaselineMean = mean.Round(8)
+	info.BaselineStdDev = stdDev.Round(8)
+
+	if mean.IsZero() || mean.LessThan(cfg.MinBaselineValue) {
+		return types.AlertStateOk, info
+	}
+
+	delta := observed.Sub(mean)
+	info.PercentageChange = delta.Div(mean).Mul(decimal.NewFromInt(100)).Round(2)
+	if stdDev.IsPositive() {
+		info.Sigma = delta.Div(stdDev).Round(2)
+	}
+
+	breaches := func(sigma, pct decimal.Decimal) bool {
+		if cfg.SigmaThreshold.IsPositive() && stdDev.IsPositive() && sigma.GreaterThanOrEqual(cfg.SigmaThreshold) {
+			return true
+		}
+		return cfg.PercentageThreshold.IsPositive() && pct.GreaterThanOrEqual(cfg.PercentageThreshold)
+	}
+
+	switch {
+	case delta.IsPositive() && breaches(info.Sigma, info.PercentageChange):
+		info.Direction = types.UsageAnomalyDirectionSpike
+		return types.AlertStateInAlarm, info
+	case cfg.DetectDrops && delta.IsNegative() && breaches(info.Sigma.Neg(), info.PercentageChange.Neg()):
+		info.Direction = types.UsageAnomalyDirectionDrop
+		return types.AlertStateWarning, info
+	}
+
+	return types.AlertStateOk, info
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluateUsageAnomaly(t *testing.T) {
+	cfg := types.UsageAnomalyConfig{
+		Enabled:             true,
+		WindowHours:         1,
+		BaselineDays:        1,
+		SigmaThreshold:      decimal.NewFromInt(3),
+		PercentageThreshold: decimal.NewFromInt(200),
+		MinBaselineValue:    decimal.NewFromInt(1),
+	}
+
+	series := func(values ...int64) []decimal.Decimal {
+		out := make([]decimal.Decimal, len(values))
+		for i, v := range values {
+			out[i] = decimal.NewFromInt(v)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name      string
+		cfg       func(c types.UsageAnomalyConfig) types.UsageAnomalyConfig
+		baseline  []decimal.Decimal
+		observed  int64
+		state     types.AlertState
+		direction types.UsageAnomalyDirection
+	}{
+		{
+			name:     "within baseline",
+			baseline: series(100, 110, 90, 100),
+			observed: 105,
+			state:    types.AlertStateOk,
+		},
+		{
+			name:      "sigma spike",
+			baseline:  series(100, 110, 90, 100),
+			observed:  150,
+			state:     types.AlertStateInAlarm,
+			direction: types.UsageAnomalyDirectionSpike,
+		},
+		{
+			name:      "percentage spike on flat baseline",
+			baseline:  series(100, 100, 100, 100),
+			observed:  300,
+			state:     types.AlertStateInAlarm,
+			direction: types.UsageAnomalyDirectionSpike,
+		},
+		{
+			name:     "drop ignored unless enabled",
+			baseline: series(100, 110, 90, 100),
+			observed: 0,
+			state:    types.AlertStateOk,
+		},
+		{
+			name: "drop detected when enabled",
+			cfg: func(c types.UsageAnomalyConfig) types.UsageAnomalyConfig {
+				c.DetectDrops = true
+				return c
+			},
+			baseline:  series(100, 110, 90, 100),
+			observed:  0,
+			state:     types.AlertStateWarning,
+			direction: types.UsageAnomalyDirectionDrop,
+		},
+		{
+			name:     "thin baseline is not judged",
+			baseline: series(0, 0, 1, 0),
+			observed: 1000,
+			state:    types.AlertStateOk,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := cfg
+			if tt.cfg != nil {
+				c = tt.cfg(c)
+			}
+			state, info := evaluateUsageAnomaly(c, tt.baseline, decimal.NewFromInt(tt.observed))
+			assert.Equal(t, tt.state, state)
+			assert.Equal(t, tt.direction, info.Direction)
+			assert.Equal(t, len(tt.baseline), info.BaselineBuckets)
+		})
+	}
+}
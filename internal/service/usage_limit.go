@@ -0,0 +1,501 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/cache"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/meter"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	webhookDto "github.com/flexprice/flexprice/internal/webhook/dto"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// UsageLimitService enforces entitlement usage limits in near real time.
+// Consumption is kept in Redis counters per customer, feature and usage reset period.
+// Counters are seeded from the usage summary whenever the cached limit is refreshed and
+// incremented as feature usage is processed, so checks stay off ClickHouse on the hot path.
+type UsageLimitService interface {
+	// CheckLimit reports whether the customer may consume the requested quantity of a feature
+	CheckLimit(ctx context.Context, req *dto.CheckUsageLimitRequest) (*dto.CheckUsageLimitResponse, error)
+
+	// RecordUsage adds processed feature usage to the counters. The first time a limit is
+	// reached in a period it emits entitlement.limit.reached, and for hard limits it marks
+	// the customer's events matching the feature's meter as over limit for ingestion.
+	RecordUsage(ctx context.Context, featureUsage []*events.FeatureUsage) error
+
+	// InvalidateEnvironment drops the cached limits and ingestion blocks of the environment in
+	// context, so raised or deleted limits apply without waiting for the cache to expire
+	InvalidateEnvironment(ctx context.Context)
+}
+
+type usageLimitService struct {
+	ServiceParams
+}
+
+func NewUsageLimitService(params ServiceParams) UsageLimitService {
+	return &usageLimitService{
+		ServiceParams: params,
+	}
+}
+
+// usageLimitState is the cached part of a usage limit status; usage lives in the counter
+type usageLimitState struct {
+	IsEnabled        bool       `json:"is_enabled"`
+	IsUnlimited      bool       `json:"is_unlimited"`
+	UsageLimit       *int64     `json:"usage_limit,omitempty"`
+	IsSoftLimit      bool       `json:"is_soft_limit"`
+	NextUsageResetAt *time.Time `json:"next_usage_reset_at,omitempty"`
+}
+
+// usageLimitGroup accumulates processed usage for one customer and feature
+type usageLimitGroup struct {
+	customerID         string
+	featureID          string
+	meterID            string
+	externalCustomerID string
+	quantity           decimal.Decimal
+	// incremental is set when the meter aggregation adds up, so the counter can be incremented
+	incremental bool
+}
+
+func (s *usageLimitService) CheckLimit(ctx context.Context, req *dto.CheckUsageLimitRequest) (*dto.CheckUsageLimitResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	customerID := req.CustomerID
+	if customerID == "" {
+		c, err := s.CustomerRepo.GetByLookupKey(ctx, req.ExternalCustomerID)
+		if err != nil {
+			return nil, err
+		}
+		customerID = c.ID
+	}
+
+	featureID := req.FeatureID
+	if featureID == "" {
+		filter := types.NewNoLimitFeatureFilter()
+		filter.LookupKeys = []string{req.FeatureLookupKey}
+		features, err := s.FeatureRepo.List(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		if len(features) == 0 {
+			return nil, ierr.NewError("feature not found").
+				WithHint("No feature exists with the given lookup key").
+				WithReportableDetails(map[string]any{
+					"feature_lookup_key": req.FeatureLookupKey,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		featureID = features[0].ID
+	}
+
+	status, err := s.getStatus(ctx, customerID, featureID)
+	if err != nil {
+		return nil, err
+	}
+
+	return evaluateUsageLimit(status, req.Quantity), nil
+}
+
+func (s *usageLimitService) RecordUsage(ctx context.Context, featureUsage []*events.FeatureUsage) error {
+	redisCache := s.counterCache()
+	if redisCache == nil {
+		return nil
+	}
+
+	groups := make(map[string]*usageLimitGroup)
+	order := make([]string, 0)
+	incrementalByMeter := make(map[string]bool)
+	for _, fu := range featureUsage {
+		if fu.CustomerID == "" || fu.FeatureID == "" || fu.QtyTotal.IsZero() {
+			continue
+		}
+		key := fu.CustomerID + ":" + fu.FeatureID
+		group, ok := groups[key]
+		if !ok {
+			incremental, seen := incrementalByMeter[fu.MeterID]
+			if !seen {
+				incremental = s.meterCountsIncrementally(ctx, fu.MeterID)
+				incrementalByMeter[fu.MeterID] = incremental
+			}
+			group = &usageLimitGroup{
+				customerID:         fu.CustomerID,
+				featureID:          fu.FeatureID,
+				meterID:            fu.MeterID,
+				externalCustomerID: fu.ExternalCustomerID,
+				incremental:        incremental,
+			}
+			groups[key] = group
+			order = append(order, key)
+		}
+		group.quantity = group.quantity.Add(fu.QtyTotal)
+	}
+
+	for _, key := range order {
+		group := groups[key]
+		if err := s.recordGroup(ctx, redisCache, group); err != nil {
+			s.Logger.ErrorwCtx(ctx, "failed to record usage against limit",
+				"error", err,
+				"customer_id", group.customerID,
+				"feature_id", group.featureID,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (s *usageLimitService) recordGroup(ctx context.Context, redisCache *cache.RedisCache, group *usageLimitGroup) error {
+	state, ok := s.getCachedState(ctx, redisCache, group.customerID, group.featureID)
+
+	var status *types.UsageLimitStatus
+	if ok && state.IsEnabled && !state.IsUnlimited && group.incremental {
+		counterKey := usageLimitPeriodKey(ctx, cache.PrefixUsageLimitCounter, group.customerID, group.featureID, state.NextUsageResetAt)
+		if _, found := redisCache.ForceCacheGet(ctx, counterKey); found {
+			value, err := redisCache.IncrByFloat(ctx, counterKey, group.quantity.InexactFloat64(), usageLimitCounterTTL(state.NextUsageResetAt))
+			if err != nil {
+				return err
+			}
+			status = state.toStatus(group.customerID, group.featureID, decimal.NewFromFloat(value))
+		}
+	}
+
+	// No cached state or counter, or usage that does not add up (max, unique count, latest):
+	// the summary already includes the usage just inserted
+	if status == nil {
+		var err error
+		status, err = s.refreshStatus(ctx, redisCache, group.customerID, group.featureID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !status.IsEnabled || !status.IsLimitReached() {
+		return nil
+	}
+
+	if !status.IsSoftLimit && group.externalCustomerID != "" && group.meterID != "" {
+		generation := usageLimitGeneration(ctx, redisCache)
+		redisCache.ForceCacheSet(ctx, usageLimitBlockedKey(ctx, group.externalCustomerID, group.meterID), generation, s.refreshInterval(status.NextUsageResetAt))
+		// The customer marker outlives every block so ingestion only resolves meters for blocked customers
+		redisCache.ForceCacheSet(ctx, usageLimitBlockedCustomerKey(ctx, group.externalCustomerID), generation, s.refreshInterval(nil))
+	}
+
+	notifiedKey := usageLimitPeriodKey(ctx, cache.PrefixUsageLimitNotified, group.customerID, group.featureID, status.NextUsageResetAt)
+	first, err := redisCache.TrySetNX(ctx, notifiedKey, "1", usageLimitCounterTTL(status.NextUsageResetAt))
+	if err != nil {
+		return err
+	}
+	if first {
+		s.publishLimitReached(ctx, status)
+	}
+
+	return nil
+}
+
+// meterCountsIncrementally reports whether usage of the meter adds up, so processed quantities
+// can be added to the counter. Other aggregations are reloaded from the usage summary.
+func (s *usageLimitService) meterCountsIncrementally(ctx context.Context, meterID string) bool {
+	if meterID == "" {
+		return false
+	}
+	m, err := s.MeterRepo.GetMeter(ctx, meterID)
+	if err != nil {
+		s.Logger.WarnwCtx(ctx, "failed to get meter for usage limit", "error", err, "meter_id", meterID)
+		return false
+	}
+	return usageLimitCountsIncrementally(m.Aggregation.Type)
+}
+
+// getStatus returns the usage limit status from the counters when enforcement is enabled,
+// otherwise it is computed from the usage summary
+func (s *usageLimitService) getStatus(ctx context.Context, customerID, featureID string) (*types.UsageLimitStatus, error) {
+	redisCache := s.counterCache()
+	if redisCache == nil {
+		state, usage, err := s.loadState(ctx, customerID, featureID)
+		if err != nil {
+			return nil, err
+		}
+		return state.toStatus(customerID, featureID, usage), nil
+	}
+
+	if state, ok := s.getCachedState(ctx, redisCache, customerID, featureID); ok {
+		if !state.IsEnabled || state.IsUnlimited {
+			return state.toStatus(customerID, featureID, decimal.Zero), nil
+		}
+		counterKey := usageLimitPeriodKey(ctx, cache.PrefixUsageLimitCounter, customerID, featureID, state.NextUsageResetAt)
+		if value, found := redisCache.ForceCacheGet(ctx, counterKey); found {
+			if usage, err := decimal.NewFromString(fmt.Sprint(value)); err == nil {
+				return state.toStatus(customerID, featureID, usage), nil
+			}
+		}
+	}
+
+	return s.refreshStatus(ctx, redisCache, customerID, featureID)
+}
+
+// refreshStatus reloads the limit and usage from the source of truth and re-seeds the cache
+func (s *usageLimitService) refreshStatus(ctx context.Context, redisCache *cache.RedisCache, customerID, featureID string) (*types.UsageLimitStatus, error) {
+	state, usage, err := s.loadState(ctx, customerID, featureID)
+	if err != nil {
+		return nil, err
+	}
+
+	redisCache.ForceCacheSet(ctx, usageLimitStateKey(ctx, redisCache, customerID, featureID), state, s.refreshInterval(state.NextUsageResetAt))
+	if state.IsEnabled && !state.IsUnlimited {
+		counterKey := usageLimitPeriodKey(ctx, cache.PrefixUsageLimitCounter, customerID, featureID, state.NextUsageResetAt)
+		redisCache.ForceCacheSet(ctx, counterKey, usage.String(), usageLimitCounterTTL(state.NextUsageResetAt))
+	}
+
+	return state.toStatus(customerID, featureID, usage), nil
+}
+
+func (s *usageLimitService) loadState(ctx context.Context, customerID, featureID string) (*usageLimitState, decimal.Decimal, error) {
+	billingService := NewBillingService(s.ServiceParams)
+	summary, err := billingService.GetCustomerUsageSummary(ctx, customerID, &dto.GetCustomerUsageSummaryRequest{
+		FeatureIDs: []string{featureID},
+	})
+	if err != nil {
+		return nil, decimal.Zero, err
+	}
+
+	for _, feature := range summary.Features {
+		if feature.Feature == nil || feature.Feature.ID != featureID {
+			continue
+		}
+		return &usageLimitState{
+			IsEnabled:        feature.IsEnabled,
+			IsUnlimited:      feature.IsUnlimited,
+			UsageLimit:       feature.TotalLimit,
+			IsSoftLimit:      feature.IsSoftLimit,
+			NextUsageResetAt: feature.NextUsageResetAt,
+		}, feature.CurrentUsage, nil
+	}
+
+	// The customer has no entitlement to the feature
+	return &usageLimitState{}, decimal.Zero, nil
+}
+
+func (s *usageLimitService) getCachedState(ctx context.Context, redisCache *cache.RedisCache, customerID, featureID string) (*usageLimitState, bool) {
+	value, found := redisCache.ForceCacheGet(ctx, usageLimitStateKey(ctx, redisCache, customerID, featureID))
+	if !found {
+		return nil, false
+	}
+	var state usageLimitState
+	if err := json.Unmarshal([]byte(fmt.Sprint(value)), &state); err != nil {
+		return nil, false
+	}
+	// A state past its reset boundary belongs to the previous period
+	if state.NextUsageResetAt != nil && !state.NextUsageResetAt.After(time.Now()) {
+		return nil, false
+	}
+	return &state, true
+}
+
+func (s *usageLimitService) publishLimitReached(ctx context.Context, status *types.UsageLimitStatus) {
+	webhookPayload, err := json.Marshal(webhookDto.InternalEntitlementLimitEvent{
+		TenantID: types.GetTenantID(ctx),
+		Status:   status,
+	})
+	if err != nil {
+		s.Logger.ErrorwCtx(ctx, "failed to marshal webhook payload", "error", err)
+		return
+	}
+
+	webhookEvent := &types.WebhookEvent{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SYSTEM_EVENT),
+		EventName:     types.WebhookEventEntitlementLimitReached,
+		TenantID:      types.GetTenantID(ctx),
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		UserID:        types.GetUserID(ctx),
+		Timestamp:     time.Now().UTC(),
+		Payload:       json.RawMessage(webhookPayload),
+		EntityType:    types.SystemEntityTypeCustomer,
+		EntityID:      status.CustomerID,
+	}
+	if err := s.WebhookPublisher.PublishWebhook(ctx, webhookEvent); err != nil {
+		s.Logger.ErrorfCtx(ctx, "failed to publish %s event: %v", webhookEvent.EventName, err)
+	}
+}
+
+func (s *usageLimitService) InvalidateEnvironment(ctx context.Context) {
+	redisCache := s.counterCache()
+	if redisCache == nil {
+		return
+	}
+	// State keys embed the generation and blocks record it, so a new generation orphans both.
+	// The generation outlives every state and block written under the previous one.
+	redisCache.ForceCacheSet(ctx, usageLimitGenerationKey(ctx), strconv.FormatInt(time.Now().UnixNano(), 10), 2*s.refreshInterval(nil))
+}
+
+// counterCache returns the Redis cache backing the counters, or nil when enforcement is off
+func (s *usageLimitService) counterCache() *cache.RedisCache {
+	if !s.Config.UsageLimit.Enabled {
+		return nil
+	}
+	return cache.GetRedisCache()
+}
+
+// refreshInterval bounds how long a resolved limit is trusted before it is reloaded
+func (s *usageLimitService) refreshInterval(resetAt *time.Time) time.Duration {
+	interval := s.Config.UsageLimit.LimitRefreshInterval
+	if interval <= 0 {
+		interval = cache.ExpiryDefaultRedis
+	}
+	if resetAt != nil {
+		if untilReset := time.Until(*resetAt); untilReset > 0 && untilReset < interval {
+			return untilReset
+		}
+	}
+	return interval
+}
+
+func (st *usageLimitState) toStatus(customerID, featureID string, usage decimal.Decimal) *types.UsageLimitStatus {
+	status := &types.UsageLimitStatus{
+		CustomerID:       customerID,
+		FeatureID:        featureID,
+		IsEnabled:        st.IsEnabled,
+		IsUnlimited:      st.IsUnlimited,
+		UsageLimit:       st.UsageLimit,
+		IsSoftLimit:      st.IsSoftLimit,
+		CurrentUsage:     usage,
+		NextUsageResetAt: st.NextUsageResetAt,
+	}
+	if st.IsEnabled && !st.IsUnlimited && st.UsageLimit != nil {
+		remaining := decimal.Max(decimal.NewFromInt(*st.UsageLimit).Sub(usage), decimal.Zero)
+		status.Remaining = &remaining
+	}
+	return status
+}
+
+// evaluateUsageLimit decides whether quantity more usage fits in the limit.
+// A zero quantity asks whether any further usage is allowed. Soft limits never deny.
+func evaluateUsageLimit(status *types.UsageLimitStatus, quantity decimal.Decimal) *dto.CheckUsageLimitResponse {
+	resp := &dto.CheckUsageLimitResponse{UsageLimitStatus: *status}
+
+	switch {
+	case !status.IsEnabled:
+		resp.LimitExceeded = true
+	case status.IsUnlimited || status.UsageLimit == nil:
+		resp.LimitExceeded = false
+	case quantity.IsZero():
+		resp.LimitExceeded = status.IsLimitReached()
+	default:
+		resp.LimitExceeded = status.CurrentUsage.Add(quantity).GreaterThan(decimal.NewFromInt(*status.UsageLimit))
+	}
+
+	resp.Allowed = status.IsEnabled && (!resp.LimitExceeded || status.IsSoftLimit)
+	resp.Decision = types.UsageLimitDecisionDenied
+	if resp.Allowed {
+		resp.Decision = types.UsageLimitDecisionAllowed
+	}
+	return resp
+}
+
+// usageLimitCountsIncrementally reports whether usage of an aggregation is the sum of the
+// usage of its events
+func usageLimitCountsIncrementally(aggregationType types.AggregationType) bool {
+	return lo.Contains([]types.AggregationType{
+		types.AggregationSum,
+		types.AggregationCount,
+		types.AggregationSumWithMultiplier,
+	}, aggregationType)
+}
+
+// usageLimitExceededForEvent reports whether the event's customer has reached a hard limit
+// on a feature metered by a meter the event matches. Used by ingestion when usage limits are
+// enforced. Lookup failures let the event through.
+func usageLimitExceededForEvent(ctx context.Context, event *events.Event, meterRepo meter.Repository) bool {
+	redisCache := cache.GetRedisCache()
+	if redisCache == nil || event.ExternalCustomerID == "" {
+		return false
+	}
+	generation := usageLimitGeneration(ctx, redisCache)
+	if !usageLimitBlockCurrent(ctx, redisCache, usageLimitBlockedCustomerKey(ctx, event.ExternalCustomerID), generation) {
+		return false
+	}
+
+	filter := types.NewNoLimitMeterFilter()
+	filter.EventName = event.EventName
+	meters, err := meterRepo.ListAll(ctx, filter)
+	if err != nil {
+		return false
+	}
+	for _, m := range meters {
+		if !meter.MatchesFilters(m.Filters, event.Properties) {
+			continue
+		}
+		if usageLimitBlockCurrent(ctx, redisCache, usageLimitBlockedKey(ctx, event.ExternalCustomerID, m.ID), generation) {
+			return true
+		}
+	}
+	return false
+}
+
+// usageLimitBlockCurrent reports whether a block is set under the current generation
+func usageLimitBlockCurrent(ctx context.Context, redisCache *cache.RedisCache, key, generation string) bool {
+	value, found := redisCache.ForceCacheGet(ctx, key)
+	if !found {
+		return false
+	}
+	// The limits changed since the block was set; usage is re-checked against the new limit
+	if fmt.Sprint(value) != generation {
+		redisCache.ForceCacheDelete(ctx, key)
+		return false
+	}
+	return true
+}
+
+func usageLimitGenerationKey(ctx context.Context) string {
+	return cache.GenerateKey(cache.PrefixUsageLimitGen, types.GetTenantID(ctx), types.GetEnvironmentID(ctx))
+}
+
+// usageLimitGeneration returns the generation of the limits of the environment in context
+func usageLimitGeneration(ctx context.Context, redisCache *cache.RedisCache) string {
+	if value, found := redisCache.ForceCacheGet(ctx, usageLimitGenerationKey(ctx)); found {
+		return fmt.Sprint(value)
+	}
+	return "0"
+}
+
+func usageLimitStateKey(ctx context.Context, redisCache *cache.RedisCache, customerID, featureID string) string {
+	return cache.GenerateKey(cache.PrefixUsageLimitState, types.GetTenantID(ctx), types.GetEnvironmentID(ctx), usageLimitGeneration(ctx, redisCache), customerID, featureID)
+}
+
+// usageLimitPeriodKey embeds the period boundary so counters and notifications reset with the period
+func usageLimitPeriodKey(ctx context.Context, prefix, customerID, featureID string, resetAt *time.Time) string {
+	period := int64(0)
+	if resetAt != nil {
+		period = resetAt.Unix()
+	}
+	return cache.GenerateKey(prefix, types.GetTenantID(ctx), types.GetEnvironmentID(ctx), customerID, featureID, period)
+}
+
+// usageLimitBlockedKey marks the events of a customer matching a meter as over limit
+func usageLimitBlockedKey(ctx context.Context, externalCustomerID, meterID string) string {
+	return cache.GenerateKey(cache.PrefixUsageLimitBlocked, types.GetTenantID(ctx), types.GetEnvironmentID(ctx), externalCustomerID, meterID)
+}
+
+// usageLimitBlockedCustomerKey marks a customer with at least one blocked meter
+func usageLimitBlockedCustomerKey(ctx context.Context, externalCustomerID string) string {
+	return cache.GenerateKey(cache.PrefixUsageLimitBlocked, types.GetTenantID(ctx), types.GetEnvironmentID(ctx), externalCustomerID)
+}
+
+func usageLimitCounterTTL(resetAt *time.Time) time.Duration {
+	if resetAt != nil {
+		if untilReset := time.Until(*resetAt); untilReset > 0 && untilReset < cache.ExpiryUsageLimitCounter {
+			return untilReset
+		}
+	}
+	return cache.ExpiryUsageLimitCounter
+}
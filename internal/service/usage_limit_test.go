@@ -0,0 +1,93 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluateUsageLimit(t *testing.T) {
+	limited := func(usage int64, soft bool) *types.UsageLimitStatus {
+		state := &usageLimitState{IsEnabled: true, UsageLimit: lo.ToPtr(int64(100)), IsSoftLimit: soft}
+		return state.toStatus("cust_1", "feat_1", decimal.NewFromInt(usage))
+	}
+
+	tests := []struct {
+		name          string
+		status        *types.UsageLimitStatus
+		quantity      decimal.Decimal
+		wantDecision  types.UsageLimitDecision
+		wantExceeded  bool
+		wantRemaining *decimal.Decimal
+	}{
+		{
+			name:          "within limit",
+			status:        limited(40, false),
+			quantity:      decimal.NewFromInt(60),
+			wantDecision:  types.UsageLimitDecisionAllowed,
+			wantRemaining: lo.ToPtr(decimal.NewFromInt(60)),
+		},
+		{
+			name:          "quantity over hard limit is denied",
+			status:        limited(40, false),
+			quantity:      decimal.NewFromInt(61),
+			wantDecision:  types.UsageLimitDecisionDenied,
+			wantExceeded:  true,
+			wantRemaining: lo.ToPtr(decimal.NewFromInt(60)),
+		},
+		{
+			name:          "zero quantity at hard limit is denied",
+			status:        limited(100, false),
+			wantDecision:  types.UsageLimitDecisionDenied,
+			wantExceeded:  true,
+			wantRemaining: lo.ToPtr(decimal.Zero),
+		},
+		{
+			name:          "soft limit allows overage",
+			status:        limited(120, true),
+			quantity:      decimal.NewFromInt(1),
+			wantDecision:  types.UsageLimitDecisionAllowed,
+			wantExceeded:  true,
+			wantRemaining: lo.ToPtr(decimal.Zero),
+		},
+		{
+			name:         "unlimited",
+			status:       (&usageLimitState{IsEnabled: true, IsUnlimited: true}).toStatus("cust_1", "feat_1", decimal.NewFromInt(1000)),
+			quantity:     decimal.NewFromInt(1000),
+			wantDecision: types.UsageLimitDecisionAllowed,
+		},
+		{
+			name:         "no entitlement is denied",
+			status:       (&usageLimitState{}).toStatus("cust_1", "feat_1", decimal.Zero),
+			quantity:     decimal.NewFromInt(1),
+			wantDecision: types.UsageLimitDecisionDenied,
+			wantExceeded: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := evaluateUsageLimit(tt.status, tt.quantity)
+			assert.Equal(t, tt.wantDecision, resp.Decision)
+			assert.Equal(t, tt.wantDecision == types.UsageLimitDecisionAllowed, resp.Allowed)
+			assert.Equal(t, tt.wantExceeded, resp.LimitExceeded)
+			if tt.wantRemaining == nil {
+				assert.Nil(t, resp.Remaining)
+			} else if assert.NotNil(t, resp.Remaining) {
+				assert.True(t, tt.wantRemaining.Equal(*resp.Remaining))
+			}
+		})
+	}
+}
+
+func TestUsageLimitCountsIncrementally(t *testing.T) {
+	assert.True(t, usageLimitCountsIncrementally(types.AggregationSum))
+	assert.True(t, usageLimitCountsIncrementally(types.AggregationCount))
+	assert.True(t, usageLimitCountsIncrementally(types.AggregationSumWithMultiplier))
+	assert.False(t, usageLimitCountsIncrementally(types.AggregationMax))
+	assert.False(t, usageLimitCountsIncrementally(types.AggregationCountUnique))
+	assert.False(t, usageLimitCountsIncrementally(types.AggregationLatest))
+}
@@ -0,0 +1,384 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// UsageRollupService maintains the hourly and daily meter and feature usage rollups of an
+// environment and syncs its usage retention setting to ClickHouse
+type UsageRollupService interface {
+	// RollupEnvironment brings the rollups of the environment in context up to the last
+	// complete hour and day, rebuilding the late arrival window of already rolled up buckets
+	RollupEnvironment(ctx context.Context) (*types.UsageRollupRunResult, error)
+
+	// SyncRetentionPolicy writes the usage_retention_config setting of the environment in
+	// context to ClickHouse, where table TTLs pick it up
+	SyncRetentionPolicy(ctx context.Context) error
+}
+
+type usageRollupService struct {
+	ServiceParams
+}
+
+func NewUsageRollupService(params ServiceParams) UsageRollupService {
+	return &usageRollupService{
+		ServiceParams: params,
+	}
+}
+
+// usageRollupChunk bounds the range rolled up by a single query
+const usageRollupChunk = 24 * time.Hour
+
+func (s *usageRollupService) RollupEnvironment(ctx context.Context) (*types.UsageRollupRunResult, error) {
+	result := &types.UsageRollupRunResult{}
+	if s.Config == nil || !s.Config.UsageRollup.Enabled {
+		return result, nil
+	}
+
+	for _, source := range []types.UsageRollupSource{types.UsageRollupSourceMeterUsage, types.UsageRollupSourceFeatureUsage} {
+		if err := s.rollupSource(ctx, source, result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// rollupSource brings the rollups of one source table up to date
+func (s *usageRollupService) rollupSource(ctx context.Context, source types.UsageRollupSource, result *types.UsageRollupRunResult) error {
+	tenantID := types.GetTenantID(ctx)
+	environmentID := types.GetEnvironmentID(ctx)
+
+	states, err := s.UsageRollupRepo.GetRollupStates(ctx, source, tenantID, environmentID)
+	if err != nil {
+		return err
+	}
+	var hourly, daily *events.UsageRollupState
+	for _, state := range states {
+		switch state.Granularity {
+		case types.UsageRollupGranularityHour:
+			hourly = state
+		case types.UsageRollupGranularityDay:
+			daily = state
+		}
+	}
+
+	now := time.Now().UTC()
+	lateArrival := s.Config.UsageRollup.LateArrivalWindow
+
+	// Hourly buckets are rebuilt from the source table up to the last complete hour
+	hourEnd := now.Truncate(time.Hour)
+	if hourly == nil {
+		hourly = &events.UsageRollupState{
+			TenantID:      tenantID,
+			EnvironmentID: environmentID,
+			Source:        source,
+			Granularity:   types.UsageRollupGranularityHour,
+			RolledUpFrom:  hourEnd.Add(-s.Config.UsageRollup.InitialBackfill).Truncate(time.Hour),
+		}
+		hourly.RolledUpTo = hourly.RolledUpFrom
+	}
+	hourStart := latestTime(hourly.RolledUpFrom, hourly.RolledUpTo.Add(-lateArrival).Truncate(time.Hour))
+	if hourStart.Before(hourEnd) {
+		if err := s.rollupRange(ctx, source, types.UsageRollupGranularityHour, hourStart, hourEnd); err != nil {
+			return err
+		}
+		result.HourlyBuckets += int(hourEnd.Sub(hourStart) / time.Hour)
+		hourly.RolledUpTo = hourEnd
+		if err := s.UsageRollupRepo.SaveRollupState(ctx, hourly); err != nil {
+			return err
+		}
+	}
+
+	// Daily buckets are rebuilt from complete days of the hourly rollup
+	dayEnd := hourly.RolledUpTo.Truncate(24 * time.Hour)
+	if daily == nil {
+		daily = &events.UsageRollupState{
+			TenantID:      tenantID,
+			EnvironmentID: environmentID,
+			Source:        source,
+			Granularity:   types.UsageRollupGranularityDay,
+			RolledUpFrom:  ceilTime(hourly.RolledUpFrom, 24*time.Hour),
+		}
+		daily.RolledUpTo = daily.RolledUpFrom
+	}
+	dayStart := latestTime(daily.RolledUpFrom, daily.RolledUpTo.Add(-lateArrival).Truncate(24*time.Hour))
+	if dayStart.Before(dayEnd) {
+		if err := s.rollupRange(ctx, source, types.UsageRollupGranularityDay, dayStart, dayEnd); err != nil {
+			return err
+		}
+		result.DailyBuckets += int(dayEnd.Sub(dayStart) / (24 * time.Hour))
+		daily.RolledUpTo = dayEnd
+		if err := s.UsageRollupRepo.SaveRollupState(ctx, daily); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rollupRange rolls up [start, end) in chunks so backfills do not run as one large query
+func (s *usageRollupService) rollupRange(ctx context.Context, source types.UsageRollupSource, granularity types.UsageRollupGranularity, start, end time.Time) error {
+	for chunkStart := start; chunkStart.Before(end); chunkStart = chunkStart.Add(usageRollupChunk) {
+		chunkEnd := chunkStart.Add(usageRollupChunk)
+		if chunkEnd.After(end) {
+			chunkEnd = end
+		}
+		if err := s.UsageRollupRepo.Rollup(ctx, source, types.GetTenantID(ctx), types.GetEnvironmentID(ctx), granularity, chunkStart, chunkEnd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *usageRollupService) SyncRetentionPolicy(ctx context.Context) error {
+	config, err := GetSetting[types.UsageRetentionConfig](&settingsService{ServiceParams: s.ServiceParams}, ctx, types.SettingKeyUsageRetentionConfig)
+	if err != nil {
+		return err
+	}
+
+	return s.UsageRollupRepo.SaveRetentionPolicy(ctx, &events.UsageRetentionPolicy{
+		TenantID:       types.GetTenantID(ctx),
+		EnvironmentID:  types.GetEnvironmentID(ctx),
+		RawEventDays:   config.RawEventRetentionDays,
+		MeterUsageDays: config.MeterUsageRetentionDays,
+		RollupDays:     config.RollupRetentionDays,
+	})
+}
+
+// usageRollupCoverage returns the rolled up ranges of a source usable by queries. Rollups are
+// skipped when disabled or when their state cannot be read, so analytics keep working off
+// the source table.
+func (p *ServiceParams) usageRollupCoverage(ctx context.Context, source types.UsageRollupSource, tenantID, environmentID string) map[types.UsageRollupGranularity]*events.UsageRollupState {
+	if p.Config == nil || !p.Config.UsageRollup.Enabled || p.UsageRollupRepo == nil {
+		return nil
+	}
+
+	states, err := p.UsageRollupRepo.GetRollupStates(ctx, source, tenantID, environmentID)
+	if err != nil {
+		p.Logger.WarnwCtx(ctx, "failed to read usage rollup state, querying the source table",
+			"source", source,
+			"environment_id", environmentID,
+			"error", err,
+		)
+		return nil
+	}
+
+	// Buckets older than the rollup retention may already have been expired by TTL
+	var expiredBefore time.Time
+	retention, err := GetSetting[types.UsageRetentionConfig](&settingsService{ServiceParams: *p}, ctx, types.SettingKeyUsageRetentionConfig)
+	if err != nil {
+		p.Logger.WarnwCtx(ctx, "failed to read usage retention setting, querying the source table",
+			"source", source,
+			"environment_id", environmentID,
+			"error", err,
+		)
+		return nil
+	}
+	if retention.RollupRetentionDays > 0 {
+		expiredBefore = time.Now().UTC().Add(-retention.RollupRetention())
+	}
+
+	coverage := make(map[types.UsageRollupGranularity]*events.UsageRollupState, len(states))
+	for _, state := range states {
+		covered := *state
+		if !expiredBefore.IsZero() {
+			covered.RolledUpFrom = latestTime(covered.RolledUpFrom, ceilTime(expiredBefore, 24*time.Hour))
+		}
+		coverage[state.Granularity] = &covered
+	}
+	return coverage
+}
+
+// usageQuerySegment is a slice of a usage query's time range served by one table.
+// An empty granularity means the source table itself.
+type usageQuerySegment struct {
+	Granularity types.UsageRollupGranularity
+	StartTime   time.Time
+	EndTime     time.Time
+}
+
+// planUsageQuery splits the time range of a usage query into segments served by the
+// coarsest rollup that can answer them. Rollups only cover whole buckets inside their
+// rolled up range, so partial buckets at the edges fall back to finer rollups and finally
+// to meter_usage. Queries rollups cannot answer return a single raw segment.
+func planUsageQuery(params *events.MeterUsageQueryParams, coverage map[types.UsageRollupGranularity]*events.UsageRollupState) []usageQuerySegment {
+	raw := []usageQuerySegment{{StartTime: params.StartTime, EndTime: params.EndTime}}
+	if params.StartTime.IsZero() || params.EndTime.IsZero() || !params.EndTime.After(params.StartTime) {
+		return raw
+	}
+	if params.GroupByProperty != "" || len(coverage) == 0 {
+		return raw
+	}
+	switch params.AggregationType {
+	case types.AggregationSum, types.AggregationCount, types.AggregationMax:
+	default:
+		return raw
+	}
+
+	granularities := usageRollupGranularitiesForWindow(params.WindowSize)
+	if len(granularities) == 0 {
+		return raw
+	}
+	return planUsageSegments(params.StartTime.UTC(), params.EndTime.UTC(), granularities, coverage)
+}
+
+// usageAnalyticsRollupCompatible reports whether feature usage rollups hold what the usage
+// analytics query reads. They keep no event properties and only SUM, COUNT and MAX combine
+// across buckets.
+func usageAnalyticsRollupCompatible(params *events.UsageAnalyticsParams) bool {
+	if len(params.PropertyFilters) > 0 {
+		return false
+	}
+	for _, groupBy := range params.GroupBy {
+		if groupBy != "feature_id" && groupBy != "source" {
+			return false
+		}
+	}
+	for _, aggType := range params.AggregationTypes {
+		switch aggType {
+		case types.AggregationSum, types.AggregationCount, types.AggregationMax:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// planUsageAnalyticsQuery is planUsageQuery for usage analytics over feature usage
+func planUsageAnalyticsQuery(params *events.UsageAnalyticsParams, coverage map[types.UsageRollupGranularity]*events.UsageRollupState) []usageQuerySegment {
+	raw := []usageQuerySegment{{StartTime: params.StartTime, EndTime: params.EndTime}}
+	if params.StartTime.IsZero() || params.EndTime.IsZero() || !params.EndTime.After(params.StartTime) {
+		return raw
+	}
+	if len(coverage) == 0 || !usageAnalyticsRollupCompatible(params) {
+		return raw
+	}
+
+	granularities := usageRollupGranularitiesForWindow(params.WindowSize)
+	if len(granularities) == 0 {
+		return raw
+	}
+	return planUsageSegments(params.StartTime.UTC(), params.EndTime.UTC(), granularities, coverage)
+}
+
+// mergeUsageAnalytics combines the per-segment analytics of a planned query into one row per
+// group. Only SUM, COUNT and MAX reach here, so usage and event counts add up and maxima combine.
+func mergeUsageAnalytics(results []*events.DetailedUsageAnalytic) []*events.DetailedUsageAnalytic {
+	key := func(a *events.DetailedUsageAnalytic) string {
+		return strings.Join([]string{a.FeatureID, a.PriceID, a.MeterID, a.SubLineItemID, a.Source}, ":")
+	}
+
+	merged := make(map[string]*events.DetailedUsageAnalytic)
+	points := make(map[string]map[time.Time]*events.UsageAnalyticPoint)
+	order := make([]string, 0)
+	for _, res := range results {
+		k := key(res)
+		m, ok := merged[k]
+		if !ok {
+			m = res
+			merged[k] = m
+			points[k] = make(map[time.Time]*events.UsageAnalyticPoint)
+			order = append(order, k)
+		} else {
+			m.TotalUsage = m.TotalUsage.Add(res.TotalUsage)
+			m.MaxUsage = decimal.Max(m.MaxUsage, res.MaxUsage)
+			m.EventCount += res.EventCount
+			m.Sources = lo.Uniq(append(m.Sources, res.Sources...))
+		}
+
+		for _, point := range res.Points {
+			ts := point.Timestamp.UTC()
+			if existing, ok := points[k][ts]; ok {
+				existing.Usage = existing.Usage.Add(point.Usage)
+				existing.MaxUsage = decimal.Max(existing.MaxUsage, point.MaxUsage)
+				existing.EventCount += point.EventCount
+				continue
+			}
+			p := point
+			points[k][ts] = &p
+		}
+	}
+
+	out := make([]*events.DetailedUsageAnalytic, 0, len(order))
+	for _, k := range order {
+		m := merged[k]
+		m.Points = make([]events.UsageAnalyticPoint, 0, len(points[k]))
+		for _, p := range points[k] {
+			m.Points = append(m.Points, *p)
+		}
+		sort.Slice(m.Points, func(i, j int) bool {
+			return m.Points[i].Timestamp.Before(m.Points[j].Timestamp)
+		})
+		out = append(out, m)
+	}
+	return out
+}
+
+func planUsageSegments(start, end time.Time, granularities []types.UsageRollupGranularity, coverage map[types.UsageRollupGranularity]*events.UsageRollupState) []usageQuerySegment {
+	if !start.Before(end) {
+		return nil
+	}
+	if len(granularities) == 0 {
+		return []usageQuerySegment{{StartTime: start, EndTime: end}}
+	}
+
+	granularity, finer := granularities[0], granularities[1:]
+	state, ok := coverage[granularity]
+	if !ok || state == nil {
+		return planUsageSegments(start, end, finer, coverage)
+	}
+
+	bucket := granularity.Duration()
+	from := latestTime(ceilTime(start, bucket), state.RolledUpFrom)
+	to := earliestTime(end.Truncate(bucket), state.RolledUpTo)
+	if !from.Before(to) {
+		return planUsageSegments(start, end, finer, coverage)
+	}
+
+	segments := planUsageSegments(start, from, finer, coverage)
+	segments = append(segments, usageQuerySegment{Granularity: granularity, StartTime: from, EndTime: to})
+	return append(segments, planUsageSegments(to, end, finer, coverage)...)
+}
+
+// usageRollupGranularitiesForWindow returns the rollups, coarsest first, whose buckets nest
+// inside the query windows. Sub-hour windows can only be served from meter_usage.
+func usageRollupGranularitiesForWindow(windowSize types.WindowSize) []types.UsageRollupGranularity {
+	switch windowSize {
+	case "", types.WindowSizeDay, types.WindowSizeWeek, types.WindowSizeMonth:
+		return []types.UsageRollupGranularity{types.UsageRollupGranularityDay, types.UsageRollupGranularityHour}
+	case types.WindowSizeHour, types.WindowSize3Hour, types.WindowSize6Hour, types.WindowSize12Hour:
+		return []types.UsageRollupGranularity{types.UsageRollupGranularityHour}
+	default:
+		return nil
+	}
+}
+
+func ceilTime(t time.Time, d time.Duration) time.Time {
+	truncated := t.Truncate(d)
+	if truncated.Equal(t) {
+		return t
+	}
+	return truncated.Add(d)
+}
+
+func latestTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earliestTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
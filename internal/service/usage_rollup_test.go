@@ -0,0 +1,185 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanUsageQuery(t *testing.T) {
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, time.March, day, hour, minute, 0, 0, time.UTC)
+	}
+	coverage := map[types.UsageRollupGranularity]*events.UsageRollupState{
+		types.UsageRollupGranularityHour: {RolledUpFrom: at(1, 0, 0), RolledUpTo: at(20, 14, 0)},
+		types.UsageRollupGranularityDay:  {RolledUpFrom: at(1, 0, 0), RolledUpTo: at(20, 0, 0)},
+	}
+	raw := func(start, end time.Time) usageQuerySegment {
+		return usageQuerySegment{StartTime: start, EndTime: end}
+	}
+	hourly := func(start, end time.Time) usageQuerySegment {
+		return usageQuerySegment{Granularity: types.UsageRollupGranularityHour, StartTime: start, EndTime: end}
+	}
+	daily := func(start, end time.Time) usageQuerySegment {
+		return usageQuerySegment{Granularity: types.UsageRollupGranularityDay, StartTime: start, EndTime: end}
+	}
+
+	tests := []struct {
+		name     string
+		params   events.MeterUsageQueryParams
+		coverage map[types.UsageRollupGranularity]*events.UsageRollupState
+		want     []usageQuerySegment
+	}{
+		{
+			name:     "unaligned range uses daily, hourly and raw edges",
+			params:   events.MeterUsageQueryParams{AggregationType: types.AggregationSum, StartTime: at(2, 10, 30), EndTime: at(20, 15, 10)},
+			coverage: coverage,
+			want: []usageQuerySegment{
+				raw(at(2, 10, 30), at(2, 11, 0)),
+				hourly(at(2, 11, 0), at(3, 0, 0)),
+				daily(at(3, 0, 0), at(20, 0, 0)),
+				hourly(at(20, 0, 0), at(20, 14, 0)),
+				raw(at(20, 14, 0), at(20, 15, 10)),
+			},
+		},
+		{
+			name:     "hourly windows skip the daily rollup",
+			params:   events.MeterUsageQueryParams{AggregationType: types.AggregationCount, WindowSize: types.WindowSizeHour, StartTime: at(5, 0, 0), EndTime: at(6, 0, 0)},
+			coverage: coverage,
+			want:     []usageQuerySegment{hourly(at(5, 0, 0), at(6, 0, 0))},
+		},
+		{
+			name:     "sub-hour windows read meter_usage",
+			params:   events.MeterUsageQueryParams{AggregationType: types.AggregationSum, WindowSize: types.WindowSize15Min, StartTime: at(5, 0, 0), EndTime: at(6, 0, 0)},
+			coverage: coverage,
+			want:     []usageQuerySegment{raw(at(5, 0, 0), at(6, 0, 0))},
+		},
+		{
+			name:     "aggregations rollups cannot answer read meter_usage",
+			params:   events.MeterUsageQueryParams{AggregationType: types.AggregationCountUnique, StartTime: at(2, 0, 0), EndTime: at(10, 0, 0)},
+			coverage: coverage,
+			want:     []usageQuerySegment{raw(at(2, 0, 0), at(10, 0, 0))},
+		},
+		{
+			name:     "range before the rollups read meter_usage",
+			params:   events.MeterUsageQueryParams{AggregationType: types.AggregationMax, StartTime: at(1, 0, 0).AddDate(0, -1, 0), EndTime: at(1, 0, 0)},
+			coverage: coverage,
+			want:     []usageQuerySegment{raw(at(1, 0, 0).AddDate(0, -1, 0), at(1, 0, 0))},
+		},
+		{
+			name:   "no rollup state reads meter_usage",
+			params: events.MeterUsageQueryParams{AggregationType: types.AggregationSum, StartTime: at(2, 0, 0), EndTime: at(10, 0, 0)},
+			want:   []usageQuerySegment{raw(at(2, 0, 0), at(10, 0, 0))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, planUsageQuery(&tt.params, tt.coverage))
+		})
+	}
+}
+
+func TestMergeMeterUsageResults(t *testing.T) {
+	day := time.Date(2025, time.March, 2, 0, 0, 0, 0, time.UTC)
+	point := func(start time.Time, value int64, count uint64) events.MeterUsageResult {
+		return events.MeterUsageResult{WindowStart: start, Value: decimal.NewFromInt(value), EventCount: count}
+	}
+
+	t.Run("windowed segments combine points of the same window", func(t *testing.T) {
+		params := &events.MeterUsageQueryParams{AggregationType: types.AggregationMax, WindowSize: types.WindowSizeDay}
+		merged := mergeMeterUsageResults(params, []*events.MeterUsageAggregationResult{
+			{MeterID: "m1", Points: []events.MeterUsageResult{point(day, 5, 2)}},
+			{MeterID: "m1", Points: []events.MeterUsageResult{point(day.AddDate(0, 0, 1), 3, 1), point(day, 9, 4)}},
+		})
+
+		assert.Len(t, merged, 1)
+		assert.Equal(t, []events.MeterUsageResult{point(day, 9, 6), point(day.AddDate(0, 0, 1), 3, 1)}, merged[0].Points)
+		assert.True(t, merged[0].TotalValue.Equal(decimal.NewFromInt(12)))
+	})
+
+	t.Run("scalar segments combine totals per meter", func(t *testing.T) {
+		params := &events.MeterUsageQueryParams{AggregationType: types.AggregationSum}
+		merged := mergeMeterUsageResults(params, []*events.MeterUsageAggregationResult{
+			{MeterID: "m1", TotalValue: decimal.NewFromInt(10), EventCount: 3},
+			{MeterID: "m2", TotalValue: decimal.NewFromInt(1), EventCount: 1},
+			{MeterID: "m1", TotalValue: decimal.NewFromInt(5), EventCount: 2},
+		})
+
+		assert.Len(t, merged, 2)
+		assert.Equal(t, "m1", merged[0].MeterID)
+		assert.True(t, merged[0].TotalValue.Equal(decimal.NewFromInt(15)))
+		assert.Equal(t, uint64(5), merged[0].EventCount)
+	})
+}
+
+func TestPlanUsageAnalyticsQuery(t *testing.T) {
+	at := func(day, hour int) time.Time {
+		return time.Date(2025, time.March, day, hour, 0, 0, 0, time.UTC)
+	}
+	coverage := map[types.UsageRollupGranularity]*events.UsageRollupState{
+		types.UsageRollupGranularityHour: {RolledUpFrom: at(1, 0), RolledUpTo: at(20, 14)},
+		types.UsageRollupGranularityDay:  {RolledUpFrom: at(1, 0), RolledUpTo: at(20, 0)},
+	}
+
+	t.Run("daily windows read the daily rollup", func(t *testing.T) {
+		params := &events.UsageAnalyticsParams{
+			AggregationTypes: []types.AggregationType{types.AggregationSum, types.AggregationMax},
+			GroupBy:          []string{"feature_id", "source"},
+			WindowSize:       types.WindowSizeDay,
+			StartTime:        at(2, 0),
+			EndTime:          at(5, 0),
+		}
+		assert.Equal(t, []usageQuerySegment{
+			{Granularity: types.UsageRollupGranularityDay, StartTime: at(2, 0), EndTime: at(5, 0)},
+		}, planUsageAnalyticsQuery(params, coverage))
+	})
+
+	t.Run("property grouping reads feature_usage", func(t *testing.T) {
+		params := &events.UsageAnalyticsParams{
+			AggregationTypes: []types.AggregationType{types.AggregationSum},
+			GroupBy:          []string{"feature_id", "properties.org_id"},
+			WindowSize:       types.WindowSizeDay,
+			StartTime:        at(2, 0),
+			EndTime:          at(5, 0),
+		}
+		assert.Equal(t, []usageQuerySegment{{StartTime: at(2, 0), EndTime: at(5, 0)}}, planUsageAnalyticsQuery(params, coverage))
+	})
+
+	t.Run("latest aggregation reads feature_usage", func(t *testing.T) {
+		params := &events.UsageAnalyticsParams{
+			AggregationTypes: []types.AggregationType{types.AggregationLatest},
+			WindowSize:       types.WindowSizeDay,
+			StartTime:        at(2, 0),
+			EndTime:          at(5, 0),
+		}
+		assert.Equal(t, []usageQuerySegment{{StartTime: at(2, 0), EndTime: at(5, 0)}}, planUsageAnalyticsQuery(params, coverage))
+	})
+}
+
+func TestMergeUsageAnalytics(t *testing.T) {
+	day := time.Date(2025, time.March, 2, 0, 0, 0, 0, time.UTC)
+	point := func(ts time.Time, usage, max int64, count uint64) events.UsageAnalyticPoint {
+		return events.UsageAnalyticPoint{Timestamp: ts, Usage: decimal.NewFromInt(usage), MaxUsage: decimal.NewFromInt(max), EventCount: count}
+	}
+
+	merged := mergeUsageAnalytics([]*events.DetailedUsageAnalytic{
+		{FeatureID: "f1", PriceID: "p1", TotalUsage: decimal.NewFromInt(10), MaxUsage: decimal.NewFromInt(4), EventCount: 3, Sources: []string{"api"},
+			Points: []events.UsageAnalyticPoint{point(day.AddDate(0, 0, 1), 10, 4, 3)}},
+		{FeatureID: "f2", PriceID: "p2", TotalUsage: decimal.NewFromInt(1), EventCount: 1},
+		{FeatureID: "f1", PriceID: "p1", TotalUsage: decimal.NewFromInt(5), MaxUsage: decimal.NewFromInt(6), EventCount: 2, Sources: []string{"api", "sdk"},
+			Points: []events.UsageAnalyticPoint{point(day, 2, 1, 1), point(day.AddDate(0, 0, 1), 3, 6, 1)}},
+	})
+
+	assert.Len(t, merged, 2)
+	assert.Equal(t, "f1", merged[0].FeatureID)
+	assert.True(t, merged[0].TotalUsage.Equal(decimal.NewFromInt(15)))
+	assert.True(t, merged[0].MaxUsage.Equal(decimal.NewFromInt(6)))
+	assert.Equal(t, uint64(5), merged[0].EventCount)
+	assert.ElementsMatch(t, []string{"api", "sdk"}, merged[0].Sources)
+	assert.Equal(t, []events.UsageAnalyticPoint{point(day, 2, 1, 1), point(day.AddDate(0, 0, 1), 13, 6, 4)}, merged[0].Points)
+}
@@ -0,0 +1,460 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/wallet"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	pubsubRouter "github.com/flexprice/flexprice/internal/pubsub/router"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// UsageStreamService pushes real-time usage and wallet balance deltas to connected
+// stream subscribers (SSE). Deltas are published to Kafka by the processing pipeline
+// and consumed by every API node, which fans them out to its local subscribers.
+type UsageStreamService interface {
+	// PublishFeatureUsage publishes usage deltas for freshly written feature_usage records
+	PublishFeatureUsage(ctx context.Context, records []*events.FeatureUsage) error
+
+	// PublishWalletBalance publishes a wallet real-time balance snapshot
+	PublishWalletBalance(ctx context.Context, w *wallet.Wallet, balance *dto.WalletBalanceResponse) error
+
+	// RegisterHandler registers the per-node consumer that feeds local subscribers
+	RegisterHandler(router *pubsubRouter.Router, cfg *config.Configuration)
+
+	// Subscribe opens a subscription for a customer. Events after filter.Cursor that are
+	// still buffered on this node are returned in Backlog and must be sent first.
+	Subscribe(ctx context.Context, filter *types.UsageStreamFilter) (*UsageStreamSubscription, error)
+
+	// Unsubscribe closes the subscription and releases its resources
+	Unsubscribe(sub *UsageStreamSubscription)
+}
+
+// UsageStreamSubscription is a single connected stream consumer
+type UsageStreamSubscription struct {
+	ID      string
+	Key     string
+	Filter  *types.UsageStreamFilter
+	Backlog []*types.UsageStreamEvent
+	// CursorExpired is set when the requested cursor is older than anything buffered,
+	// in which case the client should re-fetch a snapshot before relying on deltas
+	CursorExpired bool
+
+	events chan *types.UsageStreamEvent
+	once   sync.Once
+}
+
+// Events returns the channel of live events; it is closed when the subscription ends,
+// including when the subscriber falls too far behind and is disconnected.
+func (s *UsageStreamSubscription) Events() <-chan *types.UsageStreamEvent {
+	return s.events
+}
+
+func (s *UsageStreamSubscription) close() {
+	s.once.Do(func() { close(s.events) })
+}
+
+type usageStreamService struct {
+	ServiceParams
+	hub *usageStreamHub
+}
+
+// NewUsageStreamService creates the usage stream service. It must be provided as a
+// singleton since it owns the in-memory subscriber registry of this node.
+func NewUsageStreamService(params ServiceParams) UsageStreamService {
+	return &usageStreamService{
+		ServiceParams: params,
+		hub: newUsageStreamHub(
+			params.Config.UsageStream.BufferSize,
+			params.Config.UsageStream.SubscriberBufferSize,
+		),
+	}
+}
+
+func (s *usageStreamService) PublishFeatureUsage(ctx context.Context, records []*events.FeatureUsage) error {
+	return newUsageStreamPublisher(s.ServiceParams).PublishFeatureUsage(ctx, records)
+}
+
+func (s *usageStreamService) PublishWalletBalance(ctx context.Context, w *wallet.Wallet, balance *dto.WalletBalanceResponse) error {
+	return newUsageStreamPublisher(s.ServiceParams).PublishWalletBalance(ctx, w, balance)
+}
+
+// RegisterHandler registers the usage stream consumer. Unlike processing handlers this
+// runs on API nodes, each with its own consumer group, so every node sees every delta.
+func (s *usageStreamService) RegisterHandler(router *pubsubRouter.Router, cfg *config.Configuration) {
+	if !cfg.UsageStream.Enabled {
+		s.Logger.Infow("usage stream handler disabled by configuration")
+		return
+	}
+
+	if s.UsageStreamPubSub.PubSub == nil {
+		s.Logger.Warnw("usage stream pubsub not initialized, skipping usage stream handler")
+		return
+	}
+
+	router.AddNoPublishHandler(
+		"usage_stream_handler",
+		cfg.UsageStream.Topic,
+		s.UsageStreamPubSub.PubSub,
+		s.processMessage,
+	)
+
+	go s.hub.runJanitor(cfg.UsageStream.ReplayWindow)
+
+	s.Logger.Infow("registered usage stream handler",
+		"topic", cfg.UsageStream.Topic,
+		"buffer_size", cfg.UsageStream.BufferSize,
+		"replay_window", cfg.UsageStream.ReplayWindow,
+	)
+}
+
+func (s *usageStreamService) processMessage(msg *message.Message) error {
+	var batch []*types.UsageStreamEvent
+	if err := json.Unmarshal(msg.Payload, &batch); err != nil {
+		s.Logger.Errorw("failed to unmarshal usage stream events",
+			"error", err,
+			"message_uuid", msg.UUID,
+		)
+		return nil // Don't retry on unmarshal errors
+	}
+
+	// Deltas are only useful while fresh; drop anything older than the replay window
+	// (e.g. a new consumer group catching up from the start of the topic)
+	cutoff := time.Now().UTC().Add(-s.Config.UsageStream.ReplayWindow)
+	for _, event := range batch {
+		if event == nil || event.Timestamp.Before(cutoff) {
+			continue
+		}
+		s.hub.dispatch(event)
+	}
+	return nil
+}
+
+func (s *usageStreamService) Subscribe(ctx context.Context, filter *types.UsageStreamFilter) (*UsageStreamSubscription, error) {
+	if !s.Config.UsageStream.Enabled {
+		return nil, ierr.NewError("usage streaming is not enabled").
+			WithHint("Real-time usage streaming is not enabled for this deployment").
+			Mark(ierr.ErrNotFound)
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Make sure the customer exists in the caller's tenant and environment
+	if _, err := s.CustomerRepo.Get(ctx, filter.CustomerID); err != nil {
+		return nil, err
+	}
+
+	key := types.UsageStreamKey(types.GetTenantID(ctx), types.GetEnvironmentID(ctx), filter.CustomerID)
+	sub := s.hub.subscribe(key, filter)
+
+	s.Logger.InfowCtx(ctx, "usage stream subscription opened",
+		"subscription_id", sub.ID,
+		"customer_id", filter.CustomerID,
+		"cursor", filter.Cursor,
+		"backlog", len(sub.Backlog),
+		"cursor_expired", sub.CursorExpired,
+	)
+
+	return sub, nil
+}
+
+func (s *usageStreamService) Unsubscribe(sub *UsageStreamSubscription) {
+	if sub == nil {
+		return
+	}
+	s.hub.unsubscribe(sub)
+}
+
+// usageStreamPublisher publishes usage stream deltas to Kafka. It holds no state and can
+// be created ad-hoc by any service that writes usage or recomputes balances.
+type usageStreamPublisher struct {
+	ServiceParams
+}
+
+func newUsageStreamPublisher(params ServiceParams) *usageStreamPublisher {
+	return &usageStreamPublisher{ServiceParams: params}
+}
+
+// PublishFeatureUsage converts feature usage records into usage deltas grouped per customer
+func (p *usageStreamPublisher) PublishFeatureUsage(ctx context.Context, records []*events.FeatureUsage) error {
+	if !p.Config.UsageStream.Enabled || len(records) == 0 {
+		return nil
+	}
+
+	byCustomer := make(map[string][]*types.UsageStreamEvent)
+	for _, fu := range records {
+		if fu == nil || fu.CustomerID == "" {
+			continue
+		}
+		event := &types.UsageStreamEvent{
+			ID:                 types.GenerateUUID(),
+			Type:               types.UsageStreamEventTypeUsage,
+			TenantID:           fu.TenantID,
+			EnvironmentID:      fu.EnvironmentID,
+			CustomerID:         fu.CustomerID,
+			ExternalCustomerID: fu.ExternalCustomerID,
+			Timestamp:          time.Now().UTC(),
+			EventID:            fu.ID,
+			EventName:          fu.EventName,
+			FeatureID:          fu.FeatureID,
+			MeterID:            fu.MeterID,
+			SubscriptionID:     fu.SubscriptionID,
+			PriceID:            fu.PriceID,
+			Quantity:           lo.ToPtr(fu.QtyTotal),
+		}
+		byCustomer[event.StreamKey()] = append(byCustomer[event.StreamKey()], event)
+	}
+
+	for key, batch := range byCustomer {
+		if err := p.publish(ctx, key, batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PublishWalletBalance publishes the latest real-time balance of a wallet
+func (p *usageStreamPublisher) PublishWalletBalance(ctx context.Context, w *wallet.Wallet, balance *dto.WalletBalanceResponse) error {
+	if !p.Config.UsageStream.Enabled || w == nil || balance == nil {
+		return nil
+	}
+
+	event := &types.UsageStreamEvent{
+		ID:                    types.GenerateUUID(),
+		Type:                  types.UsageStreamEventTypeWalletBalance,
+		TenantID:              w.TenantID,
+		EnvironmentID:         w.EnvironmentID,
+		CustomerID:            w.CustomerID,
+		Timestamp:             time.Now().UTC(),
+		WalletID:              w.ID,
+		Currency:              w.Currency,
+		RealTimeBalance:       balance.RealTimeBalance,
+		RealTimeCreditBalance: balance.RealTimeCreditBalance,
+	}
+	return p.publish(ctx, event.StreamKey(), []*types.UsageStreamEvent{event})
+}
+
+func (p *usageStreamPublisher) publish(ctx context.Context, partitionKey string, batch []*types.UsageStreamEvent) error {
+	if p.UsageStreamPubSub.PubSub == nil {
+		return ierr.NewError("pubsub not initialized for usage stream").
+			WithHint("Usage stream PubSub failed to initialize during service creation").
+			Mark(ierr.ErrSystem)
+	}
+
+	payload, err := json.Marshal(batch)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to marshal usage stream events").
+			Mark(ierr.ErrValidation)
+	}
+
+	msg := message.NewMessage(batch[0].ID, payload)
+	msg.Metadata.Set("tenant_id", batch[0].TenantID)
+	msg.Metadata.Set("environment_id", batch[0].EnvironmentID)
+	msg.Metadata.Set("partition_key", partitionKey)
+
+	if err := p.UsageStreamPubSub.Publish(ctx, p.Config.UsageStream.Topic, msg); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to publish usage stream events").
+			Mark(ierr.ErrSystem)
+	}
+	return nil
+}
+
+// usageStreamHub is the in-memory subscriber registry of a single node. It keeps a
+// bounded buffer of recent events per customer so reconnecting clients can resume.
+type usageStreamHub struct {
+	mu                   sync.RWMutex
+	subscribers          map[string]map[string]*UsageStreamSubscription
+	buffers              map[string]*usageStreamBuffer
+	bufferSize           int
+	subscriberBufferSize int
+}
+
+// usageStreamBuffer holds the recent events of a customer. Events are numbered in arrival
+// order; the epoch tells cursors of this buffer apart from those of other nodes or of an
+// evicted buffer, whose numbering means nothing here.
+type usageStreamBuffer struct {
+	epoch      string
+	seq        uint64
+	events     []*types.UsageStreamEvent
+	lastActive time.Time
+	// lastBalances are the last real-time balances of the wallets of the customer, the
+	// balance delta of the next balance event is computed against them
+	lastBalances map[string]decimal.Decimal
+}
+
+func (b *usageStreamBuffer) cursor(seq uint64) string {
+	return fmt.Sprintf("%s-%d", b.epoch, seq)
+}
+
+// parseCursor returns the sequence of a cursor issued by this buffer
+func (b *usageStreamBuffer) parseCursor(cursor string) (uint64, bool) {
+	epoch, seq, found := strings.Cut(cursor, "-")
+	if !found || epoch != b.epoch {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(seq, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// firstSeq returns the sequence of the oldest buffered event
+func (b *usageStreamBuffer) firstSeq() uint64 {
+	return b.seq - uint64(len(b.events)) + 1
+}
+
+func newUsageStreamHub(bufferSize, subscriberBufferSize int) *usageStreamHub {
+	if bufferSize <= 0 {
+		bufferSize = 500
+	}
+	if subscriberBufferSize <= 0 {
+		subscriberBufferSize = 256
+	}
+	return &usageStreamHub{
+		subscribers:          make(map[string]map[string]*UsageStreamSubscription),
+		buffers:              make(map[string]*usageStreamBuffer),
+		bufferSize:           bufferSize,
+		subscriberBufferSize: subscriberBufferSize,
+	}
+}
+
+// dispatch buffers the event and delivers it to every matching local subscriber.
+// Subscribers whose queue is full are disconnected rather than blocking the consumer;
+// they can reconnect with their last cursor and resume from the buffer.
+func (h *usageStreamHub) dispatch(event *types.UsageStreamEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	key := event.StreamKey()
+	buf, ok := h.buffers[key]
+	if !ok {
+		buf = &usageStreamBuffer{epoch: types.GenerateUUID(), lastBalances: make(map[string]decimal.Decimal)}
+		h.buffers[key] = buf
+	}
+
+	if event.Type == types.UsageStreamEventTypeWalletBalance && event.RealTimeBalance != nil {
+		if previous, ok := buf.lastBalances[event.WalletID]; ok {
+			event.BalanceDelta = lo.ToPtr(event.RealTimeBalance.Sub(previous))
+		}
+		buf.lastBalances[event.WalletID] = *event.RealTimeBalance
+	}
+	buf.seq++
+	event.Cursor = buf.cursor(buf.seq)
+	buf.events = append(buf.events, event)
+	if len(buf.events) > h.bufferSize {
+		buf.events = buf.events[len(buf.events)-h.bufferSize:]
+	}
+	buf.lastActive = time.Now()
+
+	for id, sub := range h.subscribers[key] {
+		if !sub.Filter.Matches(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			sub.close()
+			delete(h.subscribers[key], id)
+		}
+	}
+}
+
+func (h *usageStreamHub) subscribe(key string, filter *types.UsageStreamFilter) *UsageStreamSubscription {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sub := &UsageStreamSubscription{
+		ID:     types.GenerateUUID(),
+		Key:    key,
+		Filter: filter,
+		events: make(chan *types.UsageStreamEvent, h.subscriberBufferSize),
+	}
+
+	if filter.Cursor != "" {
+		sub.Backlog, sub.CursorExpired = h.replay(key, filter)
+	}
+
+	if _, ok := h.subscribers[key]; !ok {
+		h.subscribers[key] = make(map[string]*UsageStreamSubscription)
+	}
+	h.subscribers[key][sub.ID] = sub
+	return sub
+}
+
+// replay returns buffered events after the cursor. The cursor is expired when it was not
+// issued by this buffer or when events after it were already dropped from the buffer;
+// the whole buffer is replayed then.
+func (h *usageStreamHub) replay(key string, filter *types.UsageStreamFilter) ([]*types.UsageStreamEvent, bool) {
+	buf, ok := h.buffers[key]
+	if !ok || len(buf.events) == 0 {
+		return nil, false
+	}
+
+	start, expired := 0, true
+	if seq, ok := buf.parseCursor(filter.Cursor); ok && seq+1 >= buf.firstSeq() {
+		start, expired = int(min(seq+1-buf.firstSeq(), uint64(len(buf.events)))), false
+	}
+
+	backlog := make([]*types.UsageStreamEvent, 0, len(buf.events)-start)
+	for _, event := range buf.events[start:] {
+		if filter.Matches(event) {
+			backlog = append(backlog, event)
+		}
+	}
+	return backlog, expired
+}
+
+func (h *usageStreamHub) unsubscribe(sub *UsageStreamSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if subs, ok := h.subscribers[sub.Key]; ok {
+		delete(subs, sub.ID)
+		if len(subs) == 0 {
+			delete(h.subscribers, sub.Key)
+		}
+	}
+	sub.close()
+}
+
+// evictIdle drops the buffers, and with them the last wallet balances, of customers with no
+// subscribers and no activity within the window
+func (h *usageStreamHub) evictIdle(window time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	cutoff := time.Now().Add(-window)
+	for key, buf := range h.buffers {
+		if len(h.subscribers[key]) == 0 && buf.lastActive.Before(cutoff) {
+			delete(h.buffers, key)
+		}
+	}
+}
+
+func (h *usageStreamHub) runJanitor(window time.Duration) {
+	if window <= 0 {
+		window = 15 * time.Minute
+	}
+	ticker := time.NewTicker(window / 2)
+	defer ticker.Stop()
+	for range ticker.C {
+		h.evictIdle(window)
+	}
+}
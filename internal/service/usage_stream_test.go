@@ -0,0 +1,138 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestUsageStreamEvent(eventType types.UsageStreamEventType, featureID string) *types.UsageStreamEvent {
+	return &types.UsageStreamEvent{
+		ID:            types.GenerateUUID(),
+		Type:          eventType,
+		TenantID:      "tenant_1",
+		EnvironmentID: "env_1",
+		CustomerID:    "cust_1",
+		FeatureID:     featureID,
+		WalletID:      "wallet_1",
+	}
+}
+
+func TestUsageStreamHub_DispatchAndReplay(t *testing.T) {
+	hub := newUsageStreamHub(3, 10)
+	key := types.UsageStreamKey("tenant_1", "env_1", "cust_1")
+
+	var dispatched []*types.UsageStreamEvent
+	for i := 0; i < 4; i++ {
+		event := newTestUsageStreamEvent(types.UsageStreamEventTypeUsage, "feat_1")
+		hub.dispatch(event)
+		dispatched = append(dispatched, event)
+	}
+
+	t.Run("resume from cursor returns events after it", func(t *testing.T) {
+		sub := hub.subscribe(key, &types.UsageStreamFilter{CustomerID: "cust_1", Cursor: dispatched[2].Cursor})
+		defer hub.unsubscribe(sub)
+
+		require.Len(t, sub.Backlog, 1)
+		assert.Equal(t, dispatched[3].ID, sub.Backlog[0].ID)
+		assert.False(t, sub.CursorExpired)
+	})
+
+	t.Run("cursor older than buffer is expired", func(t *testing.T) {
+		hub.dispatch(newTestUsageStreamEvent(types.UsageStreamEventTypeUsage, "feat_1"))
+		sub := hub.subscribe(key, &types.UsageStreamFilter{CustomerID: "cust_1", Cursor: dispatched[0].Cursor})
+		defer hub.unsubscribe(sub)
+
+		assert.Len(t, sub.Backlog, 3)
+		assert.True(t, sub.CursorExpired)
+	})
+
+	t.Run("cursor of another node is expired", func(t *testing.T) {
+		other := newUsageStreamHub(3, 10)
+		event := newTestUsageStreamEvent(types.UsageStreamEventTypeUsage, "feat_1")
+		other.dispatch(event)
+
+		sub := hub.subscribe(key, &types.UsageStreamFilter{CustomerID: "cust_1", Cursor: event.Cursor})
+		defer hub.unsubscribe(sub)
+
+		assert.Len(t, sub.Backlog, 3)
+		assert.True(t, sub.CursorExpired)
+	})
+
+	t.Run("live events respect filter", func(t *testing.T) {
+		sub := hub.subscribe(key, &types.UsageStreamFilter{CustomerID: "cust_1", FeatureIDs: []string{"feat_2"}})
+		defer hub.unsubscribe(sub)
+
+		hub.dispatch(newTestUsageStreamEvent(types.UsageStreamEventTypeUsage, "feat_1"))
+		matching := newTestUsageStreamEvent(types.UsageStreamEventTypeUsage, "feat_2")
+		hub.dispatch(matching)
+
+		require.Len(t, sub.events, 1)
+		assert.Equal(t, matching.ID, (<-sub.Events()).ID)
+	})
+}
+
+func TestUsageStreamHub_ReplayFollowsArrivalOrder(t *testing.T) {
+	hub := newUsageStreamHub(10, 10)
+	key := types.UsageStreamKey("tenant_1", "env_1", "cust_1")
+
+	// Events of several producers arrive out of ID order
+	late := newTestUsageStreamEvent(types.UsageStreamEventTypeUsage, "feat_1")
+	early := newTestUsageStreamEvent(types.UsageStreamEventTypeUsage, "feat_1")
+	hub.dispatch(early)
+	hub.dispatch(late)
+	next := newTestUsageStreamEvent(types.UsageStreamEventTypeUsage, "feat_1")
+	next.ID = late.ID[:len(late.ID)-1] + "0"
+	hub.dispatch(next)
+
+	sub := hub.subscribe(key, &types.UsageStreamFilter{CustomerID: "cust_1", Cursor: late.Cursor})
+	defer hub.unsubscribe(sub)
+
+	require.Len(t, sub.Backlog, 1)
+	assert.Equal(t, next.ID, sub.Backlog[0].ID)
+	assert.False(t, sub.CursorExpired)
+}
+
+func TestUsageStreamHub_BalanceDelta(t *testing.T) {
+	hub := newUsageStreamHub(10, 10)
+
+	first := newTestUsageStreamEvent(types.UsageStreamEventTypeWalletBalance, "")
+	first.RealTimeBalance = lo.ToPtr(decimal.NewFromInt(100))
+	hub.dispatch(first)
+	assert.Nil(t, first.BalanceDelta)
+
+	second := newTestUsageStreamEvent(types.UsageStreamEventTypeWalletBalance, "")
+	second.RealTimeBalance = lo.ToPtr(decimal.NewFromInt(75))
+	hub.dispatch(second)
+	require.NotNil(t, second.BalanceDelta)
+	assert.True(t, second.BalanceDelta.Equal(decimal.NewFromInt(-25)))
+
+	// Last balances are evicted with the buffer of the customer
+	hub.evictIdle(0)
+	assert.Empty(t, hub.buffers)
+	third := newTestUsageStreamEvent(types.UsageStreamEventTypeWalletBalance, "")
+	third.RealTimeBalance = lo.ToPtr(decimal.NewFromInt(50))
+	hub.dispatch(third)
+	assert.Nil(t, third.BalanceDelta)
+}
+
+func TestUsageStreamHub_SlowSubscriberDisconnected(t *testing.T) {
+	hub := newUsageStreamHub(10, 1)
+	key := types.UsageStreamKey("tenant_1", "env_1", "cust_1")
+	sub := hub.subscribe(key, &types.UsageStreamFilter{CustomerID: "cust_1"})
+
+	hub.dispatch(newTestUsageStreamEvent(types.UsageStreamEventTypeUsage, "feat_1"))
+	hub.dispatch(newTestUsageStreamEvent(types.UsageStreamEventTypeUsage, "feat_1"))
+
+	<-sub.Events()
+	_, ok := <-sub.Events()
+	assert.False(t, ok, "subscriber channel should be closed once it falls behind")
+	assert.Empty(t, hub.subscribers[key])
+
+	// unsubscribing an already dropped subscriber must not panic
+	hub.unsubscribe(sub)
+}
@@ -125,13 +125,8 @@ func (s *userService) CreateUser(ctx context.Context, req *dto.CreateUserRequest
 				WithHint("Service accounts require RBAC for role validation; provide a non-nil RBAC service.").
 				Mark(ierr.ErrValidation)
 		}
-		for _, role := range req.Roles {
-			if !s.rbacService.ValidateRole(role) {
-				return nil, ierr.NewError("invalid role").
-					WithHint("Role '" + role + "' does not exist").
-					WithReportableDetails(map[string]interface{}{"role": role}).
-					Mark(ierr.ErrValidation)
-			}
+		if err := s.rbacService.ValidateRoles(ctx, req.Roles); err != nil {
+			return nil, err
 		}
 		newUser = &user.User{
 			ID:    types.GenerateUUIDWithPrefix(types.UUID_PREFIX_USER),
@@ -8,6 +8,7 @@ import (
 	"github.com/flexprice/flexprice/internal/config"
 	"github.com/flexprice/flexprice/internal/domain/tenant"
 	"github.com/flexprice/flexprice/internal/domain/user"
+	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/rbac"
 	"github.com/flexprice/flexprice/internal/testutil"
 	"github.com/flexprice/flexprice/internal/types"
@@ -118,11 +119,11 @@ func (s *UserServiceSuite) TestCreateUser_TableDriven() {
 	// Path from module root; fallback when CWD is internal/service
 	rbacSvc, _ := rbac.NewRBACService(&config.Configuration{
 		RBAC: config.RBACConfig{RolesConfigPath: "internal/config/rbac/roles.json"},
-	})
+	}, testutil.NewInMemoryRBACRoleStore(), logger.GetLogger())
 	if rbacSvc == nil {
 		rbacSvc, _ = rbac.NewRBACService(&config.Configuration{
 			RBAC: config.RBACConfig{RolesConfigPath: "../internal/config/rbac/roles.json"},
-		})
+		}, testutil.NewInMemoryRBACRoleStore(), logger.GetLogger())
 	}
 
 	tests := []struct {
@@ -194,6 +194,7 @@ func (s *walletService) CreateWallet(ctx context.Context, req *dto.CreateWalletR
 		if err := s.WalletRepo.CreateWallet(ctx, w); err != nil {
 			return err // Repository already using ierr
 		}
+		s.recordAuditLog(ctx, types.AuditLogEntityTypeWallet, w.ID, types.AuditLogActionCreate, nil, w)
 		response = dto.FromWallet(w)
 
 		s.Logger.DebugwCtx(ctx, "created wallet",
@@ -1176,7 +1177,7 @@ func (s *walletService) GetWalletBalance(ctx context.Context, walletID string) (
 	if shouldIncludeUsage {
 		// Get all active subscriptions to calculate current usage
 		subscriptionService := NewSubscriptionService(s.ServiceParams)
-		subscriptions, err := subscriptionService.ListByCustomerID(ctx, w.CustomerID)
+		subscriptions, err := s.getWalletSubscriptions(ctx, w)
 		if err != nil {
 			return nil, err
 		}
@@ -1303,6 +1304,9 @@ func (s *walletService) TerminateWallet(ctx context.Context, walletID string) er
 		if err := s.WalletRepo.UpdateWalletStatus(ctx, walletID, types.WalletStatusClosed); err != nil {
 			return err
 		}
+		s.recordAuditLog(ctx, types.AuditLogEntityTypeWallet, walletID, types.AuditLogActionDelete,
+			map[string]interface{}{"wallet_status": w.WalletStatus},
+			map[string]interface{}{"wallet_status": types.WalletStatusClosed})
 
 		return nil
 	})
@@ -1334,6 +1338,8 @@ func (s *walletService) UpdateWallet(ctx context.Context, id string, req *dto.Up
 			Mark(ierr.ErrDatabase)
 	}
 
+	before := *existing
+
 	// Update fields if provided
 	if req.Name != nil {
 		existing.Name = *req.Name
@@ -1388,6 +1394,7 @@ func (s *walletService) UpdateWallet(ctx context.Context, id string, req *dto.Up
 			}).
 			Mark(ierr.ErrDatabase)
 	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeWallet, id, types.AuditLogActionUpdate, &before, existing)
 
 	// Publish webhook event
 	s.publishInternalWalletWebhookEvent(ctx, types.WebhookEventWalletUpdated, id)
@@ -1616,6 +1623,12 @@ func (s *walletService) processWalletOperation(ctx context.Context, req *wallet.
 			}
 		} else if req.Type == types.TransactionTypeDebit {
 			tx.ConversionRate = lo.ToPtr(w.ConversionRate)
+			if req.FX != nil {
+				tx.FXRate = lo.ToPtr(req.FX.Rate)
+				tx.FXRateID = lo.EmptyableToPtr(req.FX.RateID)
+				tx.SettlementCurrency = lo.ToPtr(req.FX.ToCurrency)
+				tx.SettlementAmount = lo.ToPtr(req.SettlementAmount)
+			}
 		}
 
 		// Step 6: Create transaction record
@@ -1628,6 +1641,14 @@ func (s *walletService) processWalletOperation(ctx context.Context, req *wallet.
 			return err
 		}
 
+		action := types.AuditLogActionCredit
+		if req.Type == types.TransactionTypeDebit {
+			action = types.AuditLogActionDebit
+		}
+		s.recordAuditLog(ctx, types.AuditLogEntityTypeWallet, req.WalletID, action,
+			map[string]interface{}{"balance": w.Balance, "credit_balance": w.CreditBalance},
+			map[string]interface{}{"balance": finalBalance, "credit_balance": newCreditBalance, "transaction_id": tx.ID})
+
 		s.Logger.DebugwCtx(ctx, "Wallet operation completed")
 		return nil
 	})
@@ -1722,7 +1743,15 @@ func (s *walletService) ExpireCredits(ctx context.Context, transactionID string)
 			Mark(ierr.ErrInvalidOperation)
 	}
 
-	skipReason, err := s.shouldSkipCreditExpiryDueToActiveSubscriptionOrInvoice(ctx, tx)
+	skipReason, err := s.shouldSkipCreditExpiryDueToPendingRollover(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	if skipReason != types.CreditExpirySkipReasonNone {
+		return &types.ExpireCreditsResult{Expired: false, SkipReason: skipReason}, nil
+	}
+
+	skipReason, err = s.shouldSkipCreditExpiryDueToActiveSubscriptionOrInvoice(ctx, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -1763,6 +1792,61 @@ func (s *walletService) ExpireCredits(ctx context.Context, transactionID string)
 	return &types.ExpireCreditsResult{Expired: true}, nil
 }
 
+// shouldSkipCreditExpiryDueToPendingRollover keeps grant credits that may roll over from expiring
+// before the due grant period they roll into is applied. Once that application is applied, skipped
+// or cancelled, the credits that did not roll over expire on the next run.
+func (s *walletService) shouldSkipCreditExpiryDueToPendingRollover(ctx context.Context, tx *wallet.Transaction) (types.CreditExpirySkipReason, error) {
+	if tx.TransactionReason != types.TransactionReasonSubscriptionCredit &&
+		tx.TransactionReason != types.TransactionReasonCreditRollover {
+		return types.CreditExpirySkipReasonNone, nil
+	}
+
+	grantID, subscriptionID := tx.Metadata["grant_id"], tx.Metadata["subscription_id"]
+	if grantID == "" || subscriptionID == "" {
+		return types.CreditExpirySkipReasonNone, nil
+	}
+
+	grant, err := s.CreditGrantRepo.Get(ctx, grantID)
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			return types.CreditExpirySkipReasonNone, nil
+		}
+		return types.CreditExpirySkipReasonNone, err
+	}
+	if grant.RolloverConfig == nil || rolloverGeneration(tx) >= grant.RolloverConfig.GetMaxPeriods() {
+		return types.CreditExpirySkipReasonNone, nil
+	}
+
+	filter := types.NewNoLimitCreditGrantApplicationFilter()
+	filter.CreditGrantIDs = []string{grantID}
+	filter.SubscriptionIDs = []string{subscriptionID}
+	filter.ApplicationStatuses = []types.ApplicationStatus{
+		types.ApplicationStatusPending,
+		types.ApplicationStatusFailed,
+	}
+
+	applications, err := s.CreditGrantApplicationRepo.List(ctx, filter)
+	if err != nil {
+		return types.CreditExpirySkipReasonNone, err
+	}
+
+	// Only a period that starts once the credits expire and is already due can take them over.
+	// Credits that expire mid-period expire as usual.
+	now := time.Now().UTC()
+	for _, cga := range applications {
+		if !cga.PeriodStart.Before(*tx.ExpiryDate) && !cga.PeriodStart.After(now) {
+			s.Logger.InfowCtx(ctx, "skipping credit expiry until the next grant period is applied",
+				"transaction_id", tx.ID,
+				"grant_id", grantID,
+				"cga_id", cga.ID,
+			)
+			return types.CreditExpirySkipReasonRolloverPending, nil
+		}
+	}
+
+	return types.CreditExpirySkipReasonNone, nil
+}
+
 // shouldSkipCreditExpiryDueToActiveSubscriptionOrInvoice checks if there is any subscription or invoice
 // for the customer with current_period_end/end time before now. If so, credit expiry should be skipped.
 // It returns the skip reason when expiry should be skipped, CreditExpirySkipReasonNone when expiry can proceed, and err on error.
@@ -1888,6 +1972,68 @@ func (s *walletService) GetCreditsFromCurrencyAmount(amount decimal.Decimal, con
 	return amount.Div(conversionRate)
 }
 
+// getWalletSubscriptions returns the active subscriptions whose invoices the wallet's customer
+// pays: its own unless they are invoiced to another customer, plus those of other customers,
+// such as children in its customer hierarchy, that are invoiced to it
+func (s *walletService) getWalletSubscriptions(ctx context.Context, w *wallet.Wallet) ([]*subscription.Subscription, error) {
+	subscriptionService := NewSubscriptionService(s.ServiceParams)
+	ownSubscriptions, err := subscriptionService.ListByCustomerID(ctx, w.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := types.NewNoLimitSubscriptionFilter()
+	filter.InvoicingCustomerIDs = []string{w.CustomerID}
+	filter.SubscriptionStatus = []types.SubscriptionStatus{
+		types.SubscriptionStatusActive,
+		types.SubscriptionStatusTrialing,
+	}
+	filter.WithLineItems = true
+	invoicedSubscriptions, err := s.SubRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := lo.Filter(ownSubscriptions, func(sub *subscription.Subscription, _ int) bool {
+		return sub.GetInvoicingCustomerID() == w.CustomerID
+	})
+	for _, sub := range invoicedSubscriptions {
+		// Inherited subscriptions are skeletons billed through their parent subscription
+		if sub.CustomerID == w.CustomerID || sub.SubscriptionType == types.SubscriptionTypeInherited {
+			continue
+		}
+		subscriptions = append(subscriptions, sub)
+	}
+	return subscriptions, nil
+}
+
+// getCustomerDrawableWallets returns the wallets a customer draws from: its own and, when its
+// invoices are routed up its customer hierarchy, those of the paying customer
+func (s *walletService) getCustomerDrawableWallets(ctx context.Context, customerID string) ([]*wallet.Wallet, error) {
+	wallets, err := s.WalletRepo.GetWalletsByCustomerID(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	cust, err := s.CustomerRepo.Get(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	payingCustomerID, err := newCustomerHierarchy(s.CustomerRepo).GetPayingCustomerID(ctx, cust)
+	if err != nil {
+		return nil, err
+	}
+	if payingCustomerID == customerID {
+		return wallets, nil
+	}
+
+	payingWallets, err := s.WalletRepo.GetWalletsByCustomerID(ctx, payingCustomerID)
+	if err != nil {
+		return nil, err
+	}
+	return append(wallets, payingWallets...), nil
+}
+
 func (s *walletService) GetCustomerWallets(ctx context.Context, req *dto.GetCustomerWalletsRequest) ([]*dto.WalletBalanceResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, err
@@ -1908,9 +2054,9 @@ func (s *walletService) GetCustomerWallets(ctx context.Context, req *dto.GetCust
 		customerID = customer.ID
 	}
 
-	wallets, err := s.WalletRepo.GetWalletsByCustomerID(ctx, customerID)
+	wallets, err := s.getCustomerDrawableWallets(ctx, customerID)
 	if err != nil {
-		return nil, err // Repository already using ierr
+		return nil, err
 	}
 
 	// if no wallets found, return empty slice
@@ -2390,7 +2536,7 @@ func (s *walletService) GetWalletBalanceV2(ctx context.Context, walletID string)
 	if shouldIncludeUsage {
 		// Get all active subscriptions to calculate current usage
 		subscriptionService := NewSubscriptionService(s.ServiceParams)
-		subscriptions, err := subscriptionService.ListByCustomerID(ctx, w.CustomerID)
+		subscriptions, err := s.getWalletSubscriptions(ctx, w)
 		if err != nil {
 			return nil, err
 		}
@@ -2548,7 +2694,7 @@ func (s *walletService) GetWalletBalanceFromCache(ctx context.Context, walletID
 
 		// STEP 1: Get all active subscriptions to calculate current usage
 		subscriptionService := NewSubscriptionService(s.ServiceParams)
-		subscriptions, err := subscriptionService.ListByCustomerID(ctx, w.CustomerID)
+		subscriptions, err := s.getWalletSubscriptions(ctx, w)
 		if err != nil {
 			return nil, err
 		}
@@ -2730,8 +2876,8 @@ func (s *walletService) CheckWalletBalanceAlert(ctx context.Context, req *wallet
 		"get_from_cache", req.GetFromCache,
 	)
 
-	// Get active wallets for this customer
-	wallets, err := s.WalletRepo.GetWalletsByCustomerID(ctx, req.CustomerID)
+	// Get active wallets this customer draws from
+	wallets, err := s.getCustomerDrawableWallets(ctx, req.CustomerID)
 	if err != nil {
 		s.Logger.ErrorwCtx(ctx, "failed to get wallets for customer",
 			"error", err,
@@ -2787,6 +2933,15 @@ func (s *walletService) CheckWalletBalanceAlert(ctx context.Context, req *wallet
 			continue
 		}
 
+		// Push the recomputed balance to real-time stream subscribers, best effort
+		if err := newUsageStreamPublisher(s.ServiceParams).PublishWalletBalance(ctx, w, balance); err != nil {
+			s.Logger.ErrorwCtx(ctx, "failed to publish wallet balance to usage stream",
+				"error", err,
+				"wallet_id", w.ID,
+				"event_id", req.ID,
+			)
+		}
+
 		// Determine alert settings: wallet-level settings take precedence over tenant-level settings
 		var alertSettings *types.AlertSettings
 		if w.AlertSettings != nil {
@@ -303,6 +303,11 @@ func (s *walletBalanceAlertService) processEvent(ctx context.Context, event wall
 			"tenant_id", event.TenantID,
 			"environment_id", event.EnvironmentID,
 		)
+		// Usage stream subscribers still need balance updates without alerting
+		if s.Config.UsageStream.Enabled && !s.shouldThrottle(ctx, event) {
+			s.publishStreamBalances(ctx, event)
+			s.markProcessed(ctx, event)
+		}
 		return nil
 	}
 
@@ -332,6 +337,38 @@ func (s *walletBalanceAlertService) processEvent(ctx context.Context, event wall
 	return nil
 }
 
+// publishStreamBalances recomputes the customer's wallet balances and pushes them to the
+// usage stream only, without evaluating alert thresholds
+func (s *walletBalanceAlertService) publishStreamBalances(ctx context.Context, event wallet.WalletBalanceAlertEvent) {
+	wallets, err := s.WalletRepo.GetWalletsByCustomerID(ctx, event.CustomerID)
+	if err != nil {
+		s.Logger.ErrorwCtx(ctx, "failed to get wallets for usage stream balance update",
+			"error", err,
+			"customer_id", event.CustomerID,
+		)
+		return
+	}
+
+	walletService := NewWalletService(s.ServiceParams)
+	publisher := newUsageStreamPublisher(s.ServiceParams)
+	for _, w := range wallets {
+		balance, err := walletService.GetWalletBalanceV2(ctx, w.ID)
+		if err != nil {
+			s.Logger.ErrorwCtx(ctx, "failed to get wallet balance for usage stream, skipping wallet",
+				"error", err,
+				"wallet_id", w.ID,
+			)
+			continue
+		}
+		if err := publisher.PublishWalletBalance(ctx, w, balance); err != nil {
+			s.Logger.ErrorwCtx(ctx, "failed to publish wallet balance to usage stream",
+				"error", err,
+				"wallet_id", w.ID,
+			)
+		}
+	}
+}
+
 // createPartitionKey creates a deterministic partition key for Kafka
 // This ensures all events for the same customer go to the same partition
 func (s *walletBalanceAlertService) createPartitionKey(event *wallet.WalletBalanceAlertEvent) string {
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"sort"
+	"time"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
@@ -51,6 +52,10 @@ type WalletPaymentService interface {
 	// GetWalletsForCreditAdjustment retrieves prepaid wallets for credit adjustment
 	// Returns all prepaid wallets regardless of price type restrictions, sorted by balance (highest first)
 	GetWalletsForCreditAdjustment(ctx context.Context, customerID string, currency string) ([]*wallet.Wallet, error)
+
+	// ConvertWalletBalance returns a copy of the wallet with its balance converted into currency at
+	// the current FX rate, and the conversion used. Wallets in currency are returned as is.
+	ConvertWalletBalance(ctx context.Context, w *wallet.Wallet, currency string) (*wallet.Wallet, *types.FXConversion, error)
 }
 
 type walletPaymentService struct {
@@ -147,7 +152,7 @@ func (s *walletPaymentService) GetWalletsForPayment(
 	}
 
 	if len(activeWallets) == 0 {
-		return activeWallets, nil
+		return s.getFXWallets(wallets, currency, types.WalletTypePostPaid), nil
 	}
 
 	// Categorize wallets by their price type restrictions
@@ -205,6 +210,8 @@ func (s *walletPaymentService) GetWalletsForPayment(
 	result = append(result, fixedWallets...)
 	// Add ALL wallets last (can pay anything)
 	result = append(result, allWallets...)
+	// Wallets in other currencies are only used once same currency wallets are exhausted
+	result = append(result, s.getFXWallets(wallets, currency, types.WalletTypePostPaid)...)
 
 	s.Logger.Infow("categorized wallets for payment",
 		"customer_id", customerID,
@@ -243,11 +250,12 @@ func (s *walletPaymentService) GetWalletsForCreditAdjustment(
 	}
 
 	if len(activeWallets) == 0 {
-		return activeWallets, nil
+		return s.getFXWallets(wallets, currency, types.WalletTypePrePaid), nil
 	}
 
 	// Sort by balance (highest first) to minimize wallet usage
 	s.sortWalletsByBalanceDesc(activeWallets)
+	activeWallets = append(activeWallets, s.getFXWallets(wallets, currency, types.WalletTypePrePaid)...)
 
 	s.Logger.Infow("retrieved prepaid wallets for credit adjustment",
 		"customer_id", customerID,
@@ -275,8 +283,20 @@ func (s *walletPaymentService) processWalletPayments(
 			break
 		}
 
+		// Wallets in other currencies pay in the invoice currency at the current FX rate
+		payer, _, err := s.ConvertWalletBalance(ctx, w, inv.Currency)
+		if err != nil {
+			s.Logger.Warnw("skipping wallet without fx rate to invoice currency",
+				"error", err,
+				"invoice_id", inv.ID,
+				"wallet_id", w.ID,
+				"wallet_currency", w.Currency,
+				"invoice_currency", inv.Currency)
+			continue
+		}
+
 		// Calculate how much this wallet can pay based on its price type restrictions
-		allowedAmount := s.calculateAllowedPaymentAmount(w, priceTypeAmounts, remainingAmount)
+		allowedAmount := s.calculateAllowedPaymentAmount(payer, priceTypeAmounts, remainingAmount)
 		if allowedAmount.IsZero() {
 			s.Logger.Infow("wallet cannot pay any amount due to price type restrictions",
 				"wallet_id", w.ID,
@@ -285,7 +305,7 @@ func (s *walletPaymentService) processWalletPayments(
 			continue
 		}
 
-		paymentAmount := decimal.Min(allowedAmount, w.Balance)
+		paymentAmount := decimal.Min(allowedAmount, payer.Balance)
 		if paymentAmount.IsZero() {
 			continue
 		}
@@ -345,6 +365,46 @@ func (s *walletPaymentService) createWalletPayment(
 	return err
 }
 
+// getFXWallets returns the active wallets of walletType in currencies other than currency that
+// have FX conversion enabled, sorted by balance (highest first)
+func (s *walletPaymentService) getFXWallets(wallets []*wallet.Wallet, currency string, walletType types.WalletType) []*wallet.Wallet {
+	if s.FXRateRepo == nil {
+		return nil
+	}
+
+	fxWallets := make([]*wallet.Wallet, 0)
+	for _, w := range wallets {
+		if w.WalletStatus == types.WalletStatusActive &&
+			!types.IsMatchingCurrency(w.Currency, currency) &&
+			w.Config.FXConversionEnabled &&
+			w.Balance.GreaterThan(decimal.Zero) &&
+			w.WalletType == walletType {
+			fxWallets = append(fxWallets, w)
+		}
+	}
+
+	s.sortWalletsByBalanceDesc(fxWallets)
+	return fxWallets
+}
+
+// ConvertWalletBalance returns a copy of w with its balance converted into currency, and the
+// conversion used. Wallets already in currency are returned as is with a nil conversion.
+func (s *walletPaymentService) ConvertWalletBalance(ctx context.Context, w *wallet.Wallet, currency string) (*wallet.Wallet, *types.FXConversion, error) {
+	if types.IsMatchingCurrency(w.Currency, currency) {
+		return w, nil, nil
+	}
+
+	conversion, err := NewFXRateService(s.ServiceParams).GetConversion(ctx, w.Currency, currency, time.Now().UTC())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	converted := *w
+	converted.Currency = conversion.ToCurrency
+	converted.Balance = conversion.Convert(w.Balance)
+	return &converted, conversion, nil
+}
+
 // sortWalletsByBalanceDesc sorts wallets by balance in descending order (highest first)
 func (s *walletPaymentService) sortWalletsByBalanceDesc(wallets []*wallet.Wallet) {
 	sort.Slice(wallets, func(i, j int) bool {
@@ -0,0 +1,324 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/webhookdeliveryattempt"
+	"github.com/flexprice/flexprice/internal/domain/webhookendpoint"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/security"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/webhook/signature"
+	"github.com/samber/lo"
+)
+
+// WebhookEndpointService manages the endpoints native webhooks are delivered to
+type WebhookEndpointService interface {
+	CreateWebhookEndpoint(ctx context.Context, req dto.CreateWebhookEndpointRequest) (*dto.WebhookEndpointResponse, error)
+	GetWebhookEndpoint(ctx context.Context, id string) (*dto.WebhookEndpointResponse, error)
+	UpdateWebhookEndpoint(ctx context.Context, id string, req dto.UpdateWebhookEndpointRequest) (*dto.WebhookEndpointResponse, error)
+	DeleteWebhookEndpoint(ctx context.Context, id string) error
+	ListWebhookEndpoints(ctx context.Context, filter *types.WebhookEndpointFilter) (*dto.ListWebhookEndpointsResponse, error)
+
+	// GetSigningSecret returns the secret deliveries to the endpoint are signed with
+	GetSigningSecret(ctx context.Context, id string) (*dto.WebhookEndpointSecretResponse, error)
+
+	// RotateSigningSecret replaces the signing secret. Deliveries are signed with the old
+	// secret as well until the overlap window ends.
+	RotateSigningSecret(ctx context.Context, id string, req dto.RotateWebhookEndpointSecretRequest) (*dto.WebhookEndpointSecretResponse, error)
+
+	// GetWebhookEndpointHealth returns the failure streak of the endpoint and its delivery
+	// success rate over the last windowHours hours
+	GetWebhookEndpointHealth(ctx context.Context, id string, windowHours int) (*dto.WebhookEndpointHealthResponse, error)
+
+	// GetDeliveryAttempt returns a single delivery attempt
+	GetDeliveryAttempt(ctx context.Context, id string) (*dto.WebhookDeliveryAttemptResponse, error)
+	// ListDeliveryAttempts lists delivery attempts, e.g. of one system event or one endpoint
+	ListDeliveryAttempts(ctx context.Context, filter *types.WebhookDeliveryAttemptFilter) (*dto.ListWebhookDeliveryAttemptsResponse, error)
+}
+
+type webhookEndpointService struct {
+	ServiceParams
+	encryptionService security.EncryptionService
+}
+
+// NewWebhookEndpointService creates a new webhook endpoint service
+func NewWebhookEndpointService(params ServiceParams, encryptionService security.EncryptionService) WebhookEndpointService {
+	return &webhookEndpointService{
+		ServiceParams:     params,
+		encryptionService: encryptionService,
+	}
+}
+
+func (s *webhookEndpointService) CreateWebhookEndpoint(ctx context.Context, req dto.CreateWebhookEndpointRequest) (*dto.WebhookEndpointResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint := req.ToWebhookEndpoint(ctx)
+
+	secret, encryptedSecret, err := s.generateSigningSecret()
+	if err != nil {
+		return nil, err
+	}
+	endpoint.SigningSecret = encryptedSecret
+
+	if err := s.WebhookEndpointRepo.Create(ctx, endpoint); err != nil {
+		return nil, err
+	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeWebhookEndpoint, endpoint.ID, types.AuditLogActionCreate, nil, endpoint)
+
+	s.Logger.Infow("created webhook endpoint",
+		"webhook_endpoint_id", endpoint.ID,
+		"event_types", endpoint.EventTypes,
+		"enabled", endpoint.Enabled,
+	)
+
+	return &dto.WebhookEndpointResponse{
+		WebhookEndpoint: endpoint,
+		SigningSecret:   secret,
+	}, nil
+}
+
+func (s *webhookEndpointService) GetWebhookEndpoint(ctx context.Context, id string) (*dto.WebhookEndpointResponse, error) {
+	endpoint, err := s.WebhookEndpointRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.WebhookEndpointResponse{WebhookEndpoint: endpoint}, nil
+}
+
+func (s *webhookEndpointService) UpdateWebhookEndpoint(ctx context.Context, id string, req dto.UpdateWebhookEndpointRequest) (*dto.WebhookEndpointResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := s.WebhookEndpointRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *endpoint
+	req.ApplyTo(endpoint)
+	if err := s.WebhookEndpointRepo.Update(ctx, endpoint); err != nil {
+		return nil, err
+	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeWebhookEndpoint, endpoint.ID, types.AuditLogActionUpdate, &before, endpoint)
+
+	return s.GetWebhookEndpoint(ctx, id)
+}
+
+func (s *webhookEndpointService) DeleteWebhookEndpoint(ctx context.Context, id string) error {
+	endpoint, err := s.WebhookEndpointRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.WebhookEndpointRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeWebhookEndpoint, id, types.AuditLogActionDelete, endpoint, nil)
+	return nil
+}
+
+func (s *webhookEndpointService) ListWebhookEndpoints(ctx context.Context, filter *types.WebhookEndpointFilter) (*dto.ListWebhookEndpointsResponse, error) {
+	if filter == nil {
+		filter = types.NewWebhookEndpointFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewDefaultQueryFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	endpoints, err := s.WebhookEndpointRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.WebhookEndpointRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := lo.Map(endpoints, func(e *webhookendpoint.WebhookEndpoint, _ int) *dto.WebhookEndpointResponse {
+		return &dto.WebhookEndpointResponse{WebhookEndpoint: e}
+	})
+
+	listResponse := types.NewListResponse(responses, total, filter.GetLimit(), filter.GetOffset())
+	return &listResponse, nil
+}
+
+func (s *webhookEndpointService) GetSigningSecret(ctx context.Context, id string) (*dto.WebhookEndpointSecretResponse, error) {
+	endpoint, err := s.WebhookEndpointRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoint.SigningSecret == "" {
+		return nil, ierr.NewError("webhook endpoint has no signing secret").
+			WithHint("Rotate the signing secret to generate one").
+			WithReportableDetails(map[string]any{
+				"webhook_endpoint_id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	secret, err := s.encryptionService.Decrypt(endpoint.SigningSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.WebhookEndpointSecretResponse{
+		WebhookEndpointID: endpoint.ID,
+		SigningSecret:     secret,
+	}
+	if _, ok := endpoint.ActivePreviousSigningSecret(time.Now().UTC()); ok {
+		response.PreviousSecretExpiresAt = endpoint.PreviousSigningSecretExpiresAt
+	}
+	return response, nil
+}
+
+func (s *webhookEndpointService) RotateSigningSecret(ctx context.Context, id string, req dto.RotateWebhookEndpointSecretRequest) (*dto.WebhookEndpointSecretResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := s.WebhookEndpointRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, encryptedSecret, err := s.generateSigningSecret()
+	if err != nil {
+		return nil, err
+	}
+	before := *endpoint
+
+	// Keep signing with the current secret during the overlap window. A secret that was
+	// already rotated out is dropped, only the latest previous secret is kept.
+	overlap := req.GetOverlap()
+	if endpoint.SigningSecret != "" && overlap > 0 {
+		endpoint.PreviousSigningSecret = lo.ToPtr(endpoint.SigningSecret)
+		endpoint.PreviousSigningSecretExpiresAt = lo.ToPtr(time.Now().UTC().Add(overlap))
+	} else {
+		endpoint.PreviousSigningSecret = nil
+		endpoint.PreviousSigningSecretExpiresAt = nil
+	}
+	endpoint.SigningSecret = encryptedSecret
+
+	if err := s.WebhookEndpointRepo.Update(ctx, endpoint); err != nil {
+		return nil, err
+	}
+	s.recordAuditLog(ctx, types.AuditLogEntityTypeWebhookEndpoint, endpoint.ID, types.AuditLogActionUpdate, &before, endpoint)
+
+	s.Logger.Infow("rotated webhook endpoint signing secret",
+		"webhook_endpoint_id", endpoint.ID,
+		"previous_secret_expires_at", endpoint.PreviousSigningSecretExpiresAt,
+	)
+
+	return &dto.WebhookEndpointSecretResponse{
+		WebhookEndpointID:       endpoint.ID,
+		SigningSecret:           secret,
+		PreviousSecretExpiresAt: endpoint.PreviousSigningSecretExpiresAt,
+	}, nil
+}
+
+func (s *webhookEndpointService) GetWebhookEndpointHealth(ctx context.Context, id string, windowHours int) (*dto.WebhookEndpointHealthResponse, error) {
+	if windowHours == 0 {
+		windowHours = dto.DefaultWebhookEndpointHealthWindowHours
+	}
+	if windowHours < 1 || windowHours > dto.MaxWebhookEndpointHealthWindowHours {
+		return nil, ierr.NewError("invalid health window").
+			WithHintf("window_hours must be between 1 and %d", dto.MaxWebhookEndpointHealthWindowHours).
+			WithReportableDetails(map[string]any{
+				"window_hours": windowHours,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	endpoint, err := s.WebhookEndpointRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	windowStart := time.Now().UTC().Add(-time.Duration(windowHours) * time.Hour)
+	filter := types.NewNoLimitWebhookDeliveryAttemptFilter()
+	filter.WebhookEndpointIDs = []string{endpoint.ID}
+	filter.TimeRangeFilter = &types.TimeRangeFilter{StartTime: &windowStart}
+
+	total, err := s.WebhookDeliveryAttemptRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.Success = lo.ToPtr(true)
+	successful, err := s.WebhookDeliveryAttemptRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewWebhookEndpointHealthResponse(endpoint, windowStart, total, successful), nil
+}
+
+func (s *webhookEndpointService) GetDeliveryAttempt(ctx context.Context, id string) (*dto.WebhookDeliveryAttemptResponse, error) {
+	attempt, err := s.WebhookDeliveryAttemptRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.WebhookDeliveryAttemptResponse{WebhookDeliveryAttempt: attempt}, nil
+}
+
+func (s *webhookEndpointService) ListDeliveryAttempts(ctx context.Context, filter *types.WebhookDeliveryAttemptFilter) (*dto.ListWebhookDeliveryAttemptsResponse, error) {
+	if filter == nil {
+		filter = types.NewWebhookDeliveryAttemptFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewDefaultQueryFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	attempts, err := s.WebhookDeliveryAttemptRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.WebhookDeliveryAttemptRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := lo.Map(attempts, func(a *webhookdeliveryattempt.WebhookDeliveryAttempt, _ int) *dto.WebhookDeliveryAttemptResponse {
+		return &dto.WebhookDeliveryAttemptResponse{WebhookDeliveryAttempt: a}
+	})
+
+	listResponse := types.NewListResponse(responses, total, filter.GetLimit(), filter.GetOffset())
+	return &listResponse, nil
+}
+
+// generateSigningSecret returns a new signing secret and its encrypted form
+func (s *webhookEndpointService) generateSigningSecret() (string, string, error) {
+	secret, err := signature.GenerateSecret()
+	if err != nil {
+		return "", "", err
+	}
+
+	encrypted, err := s.encryptionService.Encrypt(secret)
+	if err != nil {
+		return "", "", ierr.WithError(err).
+			WithHint("Failed to encrypt webhook signing secret").
+			Mark(ierr.ErrInternal)
+	}
+	return secret, encrypted, nil
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/webhookdeliveryattempt"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/security"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/webhook/signature"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+)
+
+type WebhookEndpointServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service    WebhookEndpointService
+	encryption security.EncryptionService
+}
+
+func TestWebhookEndpointService(t *testing.T) {
+	suite.Run(t, new(WebhookEndpointServiceSuite))
+}
+
+func (s *WebhookEndpointServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+
+	var err error
+	s.encryption, err = security.NewEncryptionService(s.GetConfig(), s.GetLogger())
+	s.Require().NoError(err)
+
+	s.service = NewWebhookEndpointService(ServiceParams{
+		Logger:                     s.GetLogger(),
+		Config:                     s.GetConfig(),
+		DB:                         s.GetDB(),
+		WebhookEndpointRepo:        s.GetStores().WebhookEndpointRepo,
+		WebhookDeliveryAttemptRepo: s.GetStores().WebhookDeliveryAttemptRepo,
+	}, s.encryption)
+}
+
+func (s *WebhookEndpointServiceSuite) TestCreateWebhookEndpoint() {
+	resp, err := s.service.CreateWebhookEndpoint(s.GetContext(), dto.CreateWebhookEndpointRequest{
+		URL:        "https://example.com/hooks",
+		EventTypes: []string{"invoice.*", types.WebhookEventCustomerCreated, "invoice.*"},
+		Headers:    map[string]string{"X-Api-Key": "secret"},
+	})
+	s.NoError(err)
+	s.True(resp.Enabled)
+	s.True(strings.HasPrefix(resp.SigningSecret, signature.SecretPrefix))
+	s.NotEqual(resp.SigningSecret, resp.WebhookEndpoint.SigningSecret, "stored secret is encrypted")
+	s.Equal([]string{"invoice.*", types.WebhookEventCustomerCreated}, resp.EventTypes)
+	s.True(resp.Subscribes(types.WebhookEventInvoiceUpdateFinalized))
+	s.False(resp.Subscribes(types.WebhookEventWalletCreated))
+	s.Equal(types.WebhookPayloadVersionLatest, resp.PayloadVersion)
+	s.Equal(types.WebhookPayloadModeFull, resp.PayloadMode)
+
+	pinned, err := s.service.CreateWebhookEndpoint(s.GetContext(), dto.CreateWebhookEndpointRequest{
+		URL:            "https://example.com/thin",
+		EventTypes:     []string{"*"},
+		PayloadVersion: types.WebhookPayloadVersionV1,
+		PayloadMode:    types.WebhookPayloadModeThin,
+	})
+	s.NoError(err)
+	s.Equal(types.WebhookPayloadVersionV1, pinned.PayloadVersion)
+	s.Equal(types.WebhookPayloadModeThin, pinned.PayloadMode)
+
+	invalid := []dto.CreateWebhookEndpointRequest{
+		{URL: "ftp://example.com", EventTypes: []string{"*"}},
+		{URL: "https://example.com", EventTypes: []string{}},
+		{URL: "https://example.com", EventTypes: []string{"invoice*"}},
+		{URL: "https://example.com", EventTypes: []string{"invoice..created"}},
+		{URL: "https://example.com", EventTypes: []string{"*"}, Headers: map[string]string{"content-type": "text/plain"}},
+		{URL: "https://example.com", EventTypes: []string{"*"}, PayloadVersion: "v9"},
+		{URL: "https://example.com", EventTypes: []string{"*"}, PayloadMode: "partial"},
+	}
+	for _, req := range invalid {
+		_, err := s.service.CreateWebhookEndpoint(s.GetContext(), req)
+		s.Error(err, req)
+		s.True(ierr.IsValidation(err), req)
+	}
+}
+
+func (s *WebhookEndpointServiceSuite) TestUpdateAndListWebhookEndpoints() {
+	created, err := s.service.CreateWebhookEndpoint(s.GetContext(), dto.CreateWebhookEndpointRequest{
+		URL:        "https://example.com/hooks",
+		EventTypes: []string{"*"},
+	})
+	s.NoError(err)
+
+	_, err = s.service.CreateWebhookEndpoint(s.GetContext(), dto.CreateWebhookEndpointRequest{
+		URL:        "https://example.com/other",
+		EventTypes: []string{"wallet.*"},
+	})
+	s.NoError(err)
+
+	updated, err := s.service.UpdateWebhookEndpoint(s.GetContext(), created.ID, dto.UpdateWebhookEndpointRequest{
+		EventTypes: []string{"subscription.*"},
+		Enabled:    lo.ToPtr(false),
+	})
+	s.NoError(err)
+	s.False(updated.Enabled)
+	s.Equal([]string{"subscription.*"}, updated.EventTypes)
+	s.Equal("https://example.com/hooks", updated.URL)
+
+	filter := types.NewWebhookEndpointFilter()
+	filter.Enabled = lo.ToPtr(true)
+	list, err := s.service.ListWebhookEndpoints(s.GetContext(), filter)
+	s.NoError(err)
+	s.Len(list.Items, 1)
+	s.Equal("https://example.com/other", list.Items[0].URL)
+
+	s.NoError(s.service.DeleteWebhookEndpoint(s.GetContext(), created.ID))
+	got, err := s.service.GetWebhookEndpoint(s.GetContext(), created.ID)
+	s.NoError(err)
+	s.Equal(types.StatusArchived, got.Status)
+}
+
+func (s *WebhookEndpointServiceSuite) TestRotateSigningSecret() {
+	created, err := s.service.CreateWebhookEndpoint(s.GetContext(), dto.CreateWebhookEndpointRequest{
+		URL:        "https://example.com/hooks",
+		EventTypes: []string{"*"},
+	})
+	s.NoError(err)
+
+	secret, err := s.service.GetSigningSecret(s.GetContext(), created.ID)
+	s.NoError(err)
+	s.Equal(created.SigningSecret, secret.SigningSecret)
+	s.Nil(secret.PreviousSecretExpiresAt)
+
+	// Default overlap keeps the old secret for a day
+	rotated, err := s.service.RotateSigningSecret(s.GetContext(), created.ID, dto.RotateWebhookEndpointSecretRequest{})
+	s.NoError(err)
+	s.NotEqual(created.SigningSecret, rotated.SigningSecret)
+	s.NotNil(rotated.PreviousSecretExpiresAt)
+	s.WithinDuration(time.Now().Add(24*time.Hour), *rotated.PreviousSecretExpiresAt, time.Minute)
+
+	endpoint, err := s.GetStores().WebhookEndpointRepo.Get(s.GetContext(), created.ID)
+	s.NoError(err)
+	previous, ok := endpoint.ActivePreviousSigningSecret(time.Now())
+	s.True(ok)
+	decrypted, err := s.encryption.Decrypt(previous)
+	s.NoError(err)
+	s.Equal(created.SigningSecret, decrypted)
+
+	// Rotating without overlap revokes the old secret immediately
+	revoked, err := s.service.RotateSigningSecret(s.GetContext(), created.ID, dto.RotateWebhookEndpointSecretRequest{
+		OverlapMinutes: lo.ToPtr(0),
+	})
+	s.NoError(err)
+	s.Nil(revoked.PreviousSecretExpiresAt)
+
+	endpoint, err = s.GetStores().WebhookEndpointRepo.Get(s.GetContext(), created.ID)
+	s.NoError(err)
+	_, ok = endpoint.ActivePreviousSigningSecret(time.Now())
+	s.False(ok)
+
+	_, err = s.service.RotateSigningSecret(s.GetContext(), created.ID, dto.RotateWebhookEndpointSecretRequest{
+		OverlapMinutes: lo.ToPtr(-1),
+	})
+	s.True(ierr.IsValidation(err))
+}
+
+func (s *WebhookEndpointServiceSuite) TestWebhookEndpointHealth() {
+	created, err := s.service.CreateWebhookEndpoint(s.GetContext(), dto.CreateWebhookEndpointRequest{
+		URL:        "https://example.com/hooks",
+		EventTypes: []string{"*"},
+	})
+	s.NoError(err)
+
+	health, err := s.service.GetWebhookEndpointHealth(s.GetContext(), created.ID, 0)
+	s.NoError(err)
+	s.Zero(health.TotalAttempts)
+	s.Nil(health.SuccessRate)
+
+	// Three attempts in the window, one of them successful, and one older attempt
+	now := time.Now().UTC()
+	for i, at := range []time.Time{now.Add(-time.Hour), now.Add(-2 * time.Hour), now.Add(-3 * time.Hour), now.Add(-48 * time.Hour)} {
+		attempt := &webhookdeliveryattempt.WebhookDeliveryAttempt{
+			ID:                types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WEBHOOK_DELIVERY_ATTEMPT),
+			SystemEventID:     "sev_1",
+			WebhookEndpointID: created.ID,
+			EventName:         types.WebhookEventCustomerCreated,
+			AttemptNumber:     i + 1,
+			Success:           i == 0,
+			BaseModel:         types.GetDefaultBaseModel(s.GetContext()),
+		}
+		attempt.CreatedAt = at
+		s.NoError(s.GetStores().WebhookDeliveryAttemptRepo.Create(s.GetContext(), attempt))
+	}
+
+	failingSince := now.Add(-4 * time.Hour)
+	_, err = s.GetStores().WebhookEndpointRepo.RecordDeliveryFailure(s.GetContext(), created.ID, failingSince)
+	s.NoError(err)
+	_, err = s.GetStores().WebhookEndpointRepo.RecordDeliveryFailure(s.GetContext(), created.ID, now)
+	s.NoError(err)
+
+	health, err = s.service.GetWebhookEndpointHealth(s.GetContext(), created.ID, 24)
+	s.NoError(err)
+	s.Equal(3, health.TotalAttempts)
+	s.Equal(1, health.SuccessfulAttempts)
+	s.Equal(2, health.FailedAttempts)
+	s.Equal("33.33", health.SuccessRate.String())
+	s.Equal(2, health.ConsecutiveFailures)
+	s.WithinDuration(failingSince, *health.FailingSince, time.Second)
+
+	list, err := s.service.ListDeliveryAttempts(s.GetContext(), &types.WebhookDeliveryAttemptFilter{
+		QueryFilter:        types.NewDefaultQueryFilter(),
+		WebhookEndpointIDs: []string{created.ID},
+		Success:            lo.ToPtr(false),
+	})
+	s.NoError(err)
+	s.Len(list.Items, 3)
+
+	_, err = s.service.GetWebhookEndpointHealth(s.GetContext(), created.ID, dto.MaxWebhookEndpointHealthWindowHours+1)
+	s.True(ierr.IsValidation(err))
+
+	// Re-enabling a disabled endpoint starts a new failure streak
+	endpoint, err := s.GetStores().WebhookEndpointRepo.Get(s.GetContext(), created.ID)
+	s.NoError(err)
+	endpoint.Enabled = false
+	endpoint.DisabledReason = lo.ToPtr("failing")
+	s.NoError(s.GetStores().WebhookEndpointRepo.Update(s.GetContext(), endpoint))
+
+	enabled, err := s.service.UpdateWebhookEndpoint(s.GetContext(), created.ID, dto.UpdateWebhookEndpointRequest{
+		Enabled: lo.ToPtr(true),
+	})
+	s.NoError(err)
+	s.True(enabled.Enabled)
+	s.Zero(enabled.ConsecutiveFailures)
+	s.Nil(enabled.FailingSince)
+	s.Nil(enabled.DisabledReason)
+}
@@ -0,0 +1,87 @@
+package cron
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	cronModels "github.com/flexprice/flexprice/internal/temporal/models"
+	"github.com/flexprice/flexprice/internal/types"
+	"go.temporal.io/sdk/activity"
+)
+
+// CommitmentContractActivities trues up ended commitment contracts across all tenants and environments.
+type CommitmentContractActivities struct {
+	commitmentContractService service.CommitmentContractService
+	tenantService             service.TenantService
+	environmentService        service.EnvironmentService
+	logger                    *logger.Logger
+}
+
+func NewCommitmentContractActivities(
+	commitmentContractService service.CommitmentContractService,
+	tenantService service.TenantService,
+	environmentService service.EnvironmentService,
+	log *logger.Logger,
+) *CommitmentContractActivities {
+	return &CommitmentContractActivities{
+		commitmentContractService: commitmentContractService,
+		tenantService:             tenantService,
+		environmentService:        environmentService,
+		logger:                    log,
+	}
+}
+
+// TrueUpCommitmentContractsActivity completes the ended contracts of every environment.
+func (a *CommitmentContractActivities) TrueUpCommitmentContractsActivity(ctx context.Context) (*cronModels.CommitmentContractTrueUpWorkflowResult, error) {
+	a.logger.Infow("starting commitment contract true-up cron job")
+
+	tenants, err := a.tenantService.GetAllTenants(ctx)
+	if err != nil {
+		a.logger.Errorw("failed to get all tenants", "error", err)
+		return nil, err
+	}
+
+	result := &cronModels.CommitmentContractTrueUpWorkflowResult{}
+
+	for _, tenant := range tenants {
+		tenantCtx := context.WithValue(ctx, types.CtxTenantID, tenant.ID)
+		envFilter := types.GetDefaultFilter()
+		envFilter.Limit = 1000
+		environments, err := a.environmentService.GetEnvironments(tenantCtx, envFilter)
+		if err != nil {
+			a.logger.Errorw("failed to get environments", "tenant_id", tenant.ID, "error", err)
+			return nil, err
+		}
+
+		for _, environment := range environments.Environments {
+			activity.RecordHeartbeat(ctx, "processing tenant "+tenant.ID+" env "+environment.ID)
+			envCtx := context.WithValue(tenantCtx, types.CtxEnvironmentID, environment.ID)
+
+			envResult, err := a.commitmentContractService.ProcessEndedContracts(envCtx)
+			if err != nil {
+				// One failing environment should not block the rest
+				a.logger.Errorw("commitment contract true-up failed for environment",
+					"tenant_id", tenant.ID,
+					"environment_id", environment.ID,
+					"error", err,
+				)
+				result.Failed++
+				continue
+			}
+
+			result.Environments++
+			result.Completed += envResult.Completed
+			result.TrueUpInvoices += envResult.TrueUpInvoices
+			result.Failed += envResult.Failed
+		}
+	}
+
+	a.logger.Infow("completed commitment contract true-up cron job",
+		"environments", result.Environments,
+		"completed", result.Completed,
+		"true_up_invoices", result.TrueUpInvoices,
+		"failed", result.Failed,
+	)
+	return result, nil
+}
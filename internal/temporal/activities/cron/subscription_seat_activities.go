@@ -0,0 +1,87 @@
+package cron
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	cronModels "github.com/flexprice/flexprice/internal/temporal/models"
+	"github.com/flexprice/flexprice/internal/types"
+	"go.temporal.io/sdk/activity"
+)
+
+// SubscriptionSeatActivities processes seat-based subscriptions across all tenants and environments.
+type SubscriptionSeatActivities struct {
+	subscriptionSeatService service.SubscriptionSeatService
+	tenantService           service.TenantService
+	environmentService      service.EnvironmentService
+	logger                  *logger.Logger
+}
+
+func NewSubscriptionSeatActivities(
+	subscriptionSeatService service.SubscriptionSeatService,
+	tenantService service.TenantService,
+	environmentService service.EnvironmentService,
+	log *logger.Logger,
+) *SubscriptionSeatActivities {
+	return &SubscriptionSeatActivities{
+		subscriptionSeatService: subscriptionSeatService,
+		tenantService:           tenantService,
+		environmentService:      environmentService,
+		logger:                  log,
+	}
+}
+
+// ProcessSubscriptionSeatsActivity syncs metered seats and invoices due seat true-ups of every environment.
+func (a *SubscriptionSeatActivities) ProcessSubscriptionSeatsActivity(ctx context.Context) (*cronModels.SubscriptionSeatsWorkflowResult, error) {
+	a.logger.Infow("starting subscription seats cron job")
+
+	tenants, err := a.tenantService.GetAllTenants(ctx)
+	if err != nil {
+		a.logger.Errorw("failed to get all tenants", "error", err)
+		return nil, err
+	}
+
+	result := &cronModels.SubscriptionSeatsWorkflowResult{}
+
+	for _, tenant := range tenants {
+		tenantCtx := context.WithValue(ctx, types.CtxTenantID, tenant.ID)
+		envFilter := types.GetDefaultFilter()
+		envFilter.Limit = 1000
+		environments, err := a.environmentService.GetEnvironments(tenantCtx, envFilter)
+		if err != nil {
+			a.logger.Errorw("failed to get environments", "tenant_id", tenant.ID, "error", err)
+			return nil, err
+		}
+
+		for _, environment := range environments.Environments {
+			activity.RecordHeartbeat(ctx, "processing tenant "+tenant.ID+" env "+environment.ID)
+			envCtx := context.WithValue(tenantCtx, types.CtxEnvironmentID, environment.ID)
+
+			envResult, err := a.subscriptionSeatService.ProcessSeats(envCtx)
+			if err != nil {
+				// One failing environment should not block the rest
+				a.logger.Errorw("subscription seats processing failed for environment",
+					"tenant_id", tenant.ID,
+					"environment_id", environment.ID,
+					"error", err,
+				)
+				result.Failed++
+				continue
+			}
+
+			result.Environments++
+			result.SeatsSynced += envResult.SeatsSynced
+			result.TrueUpInvoices += envResult.TrueUpInvoices
+			result.Failed += envResult.Failed
+		}
+	}
+
+	a.logger.Infow("completed subscription seats cron job",
+		"environments", result.Environments,
+		"seats_synced", result.SeatsSynced,
+		"true_up_invoices", result.TrueUpInvoices,
+		"failed", result.Failed,
+	)
+	return result, nil
+}
@@ -0,0 +1,88 @@
+package cron
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	cronModels "github.com/flexprice/flexprice/internal/temporal/models"
+	"github.com/flexprice/flexprice/internal/types"
+	"go.temporal.io/sdk/activity"
+)
+
+// UsageAnomalyActivities runs usage anomaly detection across all tenants and environments.
+type UsageAnomalyActivities struct {
+	usageAnomalyService service.UsageAnomalyService
+	tenantService       service.TenantService
+	environmentService  service.EnvironmentService
+	logger              *logger.Logger
+}
+
+func NewUsageAnomalyActivities(
+	usageAnomalyService service.UsageAnomalyService,
+	tenantService service.TenantService,
+	environmentService service.EnvironmentService,
+	log *logger.Logger,
+) *UsageAnomalyActivities {
+	return &UsageAnomalyActivities{
+		usageAnomalyService: usageAnomalyService,
+		tenantService:       tenantService,
+		environmentService:  environmentService,
+		logger:              log,
+	}
+}
+
+// DetectUsageAnomaliesActivity evaluates every environment; environments without the
+// usage_anomaly_config setting enabled are skipped by the service.
+func (a *UsageAnomalyActivities) DetectUsageAnomaliesActivity(ctx context.Context) (*cronModels.UsageAnomalyDetectionWorkflowResult, error) {
+	a.logger.Infow("starting usage anomaly detection cron job")
+
+	tenants, err := a.tenantService.GetAllTenants(ctx)
+	if err != nil {
+		a.logger.Errorw("failed to get all tenants", "error", err)
+		return nil, err
+	}
+
+	result := &cronModels.UsageAnomalyDetectionWorkflowResult{}
+
+	for _, tenant := range tenants {
+		tenantCtx := context.WithValue(ctx, types.CtxTenantID, tenant.ID)
+		envFilter := types.GetDefaultFilter()
+		envFilter.Limit = 1000
+		environments, err := a.environmentService.GetEnvironments(tenantCtx, envFilter)
+		if err != nil {
+			a.logger.Errorw("failed to get environments", "tenant_id", tenant.ID, "error", err)
+			return nil, err
+		}
+
+		for _, environment := range environments.Environments {
+			activity.RecordHeartbeat(ctx, "processing tenant "+tenant.ID+" env "+environment.ID)
+			envCtx := context.WithValue(tenantCtx, types.CtxEnvironmentID, environment.ID)
+
+			envResult, err := a.usageAnomalyService.DetectAnomalies(envCtx)
+			if err != nil {
+				// One failing environment should not block detection for the rest
+				a.logger.Errorw("usage anomaly detection failed for environment",
+					"tenant_id", tenant.ID,
+					"environment_id", environment.ID,
+					"error", err,
+				)
+				result.Failed++
+				continue
+			}
+
+			result.Environments++
+			result.Evaluated += envResult.Evaluated
+			result.Anomalies += envResult.Anomalies
+			result.Failed += envResult.Failed
+		}
+	}
+
+	a.logger.Infow("completed usage anomaly detection cron job",
+		"environments", result.Environments,
+		"evaluated", result.Evaluated,
+		"anomalies", result.Anomalies,
+		"failed", result.Failed,
+	)
+	return result, nil
+}
@@ -0,0 +1,95 @@
+package cron
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	cronModels "github.com/flexprice/flexprice/internal/temporal/models"
+	"github.com/flexprice/flexprice/internal/types"
+	"go.temporal.io/sdk/activity"
+)
+
+// UsageRollupActivities maintains usage rollups and retention across all tenants and environments.
+type UsageRollupActivities struct {
+	usageRollupService service.UsageRollupService
+	tenantService      service.TenantService
+	environmentService service.EnvironmentService
+	logger             *logger.Logger
+}
+
+func NewUsageRollupActivities(
+	usageRollupService service.UsageRollupService,
+	tenantService service.TenantService,
+	environmentService service.EnvironmentService,
+	log *logger.Logger,
+) *UsageRollupActivities {
+	return &UsageRollupActivities{
+		usageRollupService: usageRollupService,
+		tenantService:      tenantService,
+		environmentService: environmentService,
+		logger:             log,
+	}
+}
+
+// RollupUsageActivity syncs the retention policy and rolls up usage of every environment.
+// Syncing on every run also applies retention settings that failed to sync when saved.
+func (a *UsageRollupActivities) RollupUsageActivity(ctx context.Context) (*cronModels.UsageRollupWorkflowResult, error) {
+	a.logger.Infow("starting usage rollup cron job")
+
+	tenants, err := a.tenantService.GetAllTenants(ctx)
+	if err != nil {
+		a.logger.Errorw("failed to get all tenants", "error", err)
+		return nil, err
+	}
+
+	result := &cronModels.UsageRollupWorkflowResult{}
+
+	for _, tenant := range tenants {
+		tenantCtx := context.WithValue(ctx, types.CtxTenantID, tenant.ID)
+		envFilter := types.GetDefaultFilter()
+		envFilter.Limit = 1000
+		environments, err := a.environmentService.GetEnvironments(tenantCtx, envFilter)
+		if err != nil {
+			a.logger.Errorw("failed to get environments", "tenant_id", tenant.ID, "error", err)
+			return nil, err
+		}
+
+		for _, environment := range environments.Environments {
+			activity.RecordHeartbeat(ctx, "processing tenant "+tenant.ID+" env "+environment.ID)
+			envCtx := context.WithValue(tenantCtx, types.CtxEnvironmentID, environment.ID)
+
+			if err := a.usageRollupService.SyncRetentionPolicy(envCtx); err != nil {
+				a.logger.Errorw("usage retention sync failed for environment",
+					"tenant_id", tenant.ID,
+					"environment_id", environment.ID,
+					"error", err,
+				)
+			}
+
+			envResult, err := a.usageRollupService.RollupEnvironment(envCtx)
+			if err != nil {
+				// One failing environment should not block rollups for the rest
+				a.logger.Errorw("usage rollup failed for environment",
+					"tenant_id", tenant.ID,
+					"environment_id", environment.ID,
+					"error", err,
+				)
+				result.Failed++
+				continue
+			}
+
+			result.Environments++
+			result.HourlyBuckets += envResult.HourlyBuckets
+			result.DailyBuckets += envResult.DailyBuckets
+		}
+	}
+
+	a.logger.Infow("completed usage rollup cron job",
+		"environments", result.Environments,
+		"hourly_buckets", result.HourlyBuckets,
+		"daily_buckets", result.DailyBuckets,
+		"failed", result.Failed,
+	)
+	return result, nil
+}
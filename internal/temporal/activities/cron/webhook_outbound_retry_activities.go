@@ -36,12 +36,14 @@ func (a *WebhookOutboundRetryActivities) RetryStaleOutboundWebhooksActivity(ctx
 		Total:     res.Total,
 		Succeeded: res.Succeeded,
 		Failed:    res.Failed,
+		Blocked:   res.Blocked,
 	}
 
 	a.logger.Infow("completed stale outbound webhook retry cron job",
 		"total", out.Total,
 		"succeeded", out.Succeeded,
 		"failed", out.Failed,
+		"blocked", out.Blocked,
 	)
 	return out, nil
 }
@@ -5,11 +5,15 @@ import (
 	"time"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/auditlog"
 	"github.com/flexprice/flexprice/internal/domain/connection"
+	"github.com/flexprice/flexprice/internal/domain/coupon"
 	"github.com/flexprice/flexprice/internal/domain/customer"
 	"github.com/flexprice/flexprice/internal/domain/events"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
 	"github.com/flexprice/flexprice/internal/domain/wallet"
 	"github.com/flexprice/flexprice/internal/integration"
 	"github.com/flexprice/flexprice/internal/logger"
@@ -25,6 +29,10 @@ type ExportActivity struct {
 	walletRepo          wallet.Repository
 	walletBalanceGetter syncExport.WalletBalanceGetter
 	customerRepo        customer.Repository
+	planRepo            plan.Repository
+	subscriptionRepo    subscription.Repository
+	couponRepo          coupon.Repository
+	auditLogRepo        auditlog.Repository
 	connectionRepo      connection.Repository
 	integrationFactory  *integration.Factory
 	logger              *logger.Logger
@@ -38,6 +46,10 @@ func NewExportActivity(
 	walletRepo wallet.Repository,
 	walletBalanceGetter syncExport.WalletBalanceGetter,
 	customerRepo customer.Repository,
+	planRepo plan.Repository,
+	subscriptionRepo subscription.Repository,
+	couponRepo coupon.Repository,
+	auditLogRepo auditlog.Repository,
 	connectionRepo connection.Repository,
 	integrationFactory *integration.Factory,
 	logger *logger.Logger,
@@ -49,6 +61,10 @@ func NewExportActivity(
 		walletRepo:          walletRepo,
 		walletBalanceGetter: walletBalanceGetter,
 		customerRepo:        customerRepo,
+		planRepo:            planRepo,
+		subscriptionRepo:    subscriptionRepo,
+		couponRepo:          couponRepo,
+		auditLogRepo:        auditLogRepo,
 		connectionRepo:      connectionRepo,
 		integrationFactory:  integrationFactory,
 		logger:              logger,
@@ -98,7 +114,9 @@ func (a *ExportActivity) ExportData(ctx context.Context, input ExportDataInput)
 	}
 
 	// Use the ExportService which handles routing to the correct exporter
-	exportService := syncExport.NewExportServiceWithWallet(a.featureUsageRepo, a.priceRepo, a.invoiceRepo, a.walletRepo, a.walletBalanceGetter, a.customerRepo, a.connectionRepo, a.integrationFactory, a.logger)
+	exportService := syncExport.NewExportServiceWithWallet(a.featureUsageRepo, a.priceRepo, a.invoiceRepo, a.walletRepo, a.walletBalanceGetter, a.customerRepo, a.connectionRepo, a.integrationFactory, a.logger).
+		WithCatalogRepos(a.planRepo, a.subscriptionRepo, a.couponRepo).
+		WithAuditLogRepo(a.auditLogRepo)
 	response, err := exportService.Export(ctx, request)
 	if err != nil {
 		a.logger.Errorw("export failed", "error", err, "entity_type", input.EntityType)
@@ -70,4 +70,57 @@ type OutboundWebhookStaleRetryWorkflowResult struct {
 	Total     int `json:"total"`
 	Succeeded int `json:"succeeded"`
 	Failed    int `json:"failed"`
+	Blocked   int `json:"blocked"`
+}
+
+// ===================== Usage anomaly detection =====================
+
+// UsageAnomalyDetectionWorkflowInput is the input for UsageAnomalyDetectionWorkflow.
+type UsageAnomalyDetectionWorkflowInput struct{}
+
+// UsageAnomalyDetectionWorkflowResult aggregates detection metrics across environments.
+type UsageAnomalyDetectionWorkflowResult struct {
+	Environments int `json:"environments"`
+	Evaluated    int `json:"evaluated"`
+	Anomalies    int `json:"anomalies"`
+	Failed       int `json:"failed"`
+}
+
+// ===================== Usage rollup =====================
+
+// UsageRollupWorkflowInput is the input for UsageRollupWorkflow.
+type UsageRollupWorkflowInput struct{}
+
+// UsageRollupWorkflowResult aggregates rollup metrics across environments.
+type UsageRollupWorkflowResult struct {
+	Environments  int `json:"environments"`
+	HourlyBuckets int `json:"hourly_buckets"`
+	DailyBuckets  int `json:"daily_buckets"`
+	Failed        int `json:"failed"`
+}
+
+// ===================== Commitment contract true-up =====================
+
+// CommitmentContractTrueUpWorkflowInput is the input for CommitmentContractTrueUpWorkflow.
+type CommitmentContractTrueUpWorkflowInput struct{}
+
+// CommitmentContractTrueUpWorkflowResult aggregates completed contracts across environments.
+type CommitmentContractTrueUpWorkflowResult struct {
+	Environments   int `json:"environments"`
+	Completed      int `json:"completed"`
+	TrueUpInvoices int `json:"true_up_invoices"`
+	Failed         int `json:"failed"`
+}
+
+// ===================== Subscription seats =====================
+
+// SubscriptionSeatsWorkflowInput is the input for SubscriptionSeatsWorkflow.
+type SubscriptionSeatsWorkflowInput struct{}
+
+// SubscriptionSeatsWorkflowResult aggregates seat syncs and true-ups across environments.
+type SubscriptionSeatsWorkflowResult struct {
+	Environments   int `json:"environments"`
+	SeatsSynced    int `json:"seats_synced"`
+	TrueUpInvoices int `json:"true_up_invoices"`
+	Failed         int `json:"failed"`
 }
@@ -48,6 +48,10 @@ type cronActivityBundle struct {
 	subscription         *cronActivities.SubscriptionCronActivities
 	walletCreditExpiry   *cronActivities.WalletCreditExpiryActivities
 	webhookOutboundRetry *cronActivities.WebhookOutboundRetryActivities
+	usageAnomaly         *cronActivities.UsageAnomalyActivities
+	usageRollup          *cronActivities.UsageRollupActivities
+	commitmentContract   *cronActivities.CommitmentContractActivities
+	subscriptionSeat     *cronActivities.SubscriptionSeatActivities
 }
 
 // RegisterWorkflowsAndActivities registers all workflows and activities with the temporal service
@@ -97,7 +101,7 @@ func RegisterWorkflowsAndActivities(temporalService temporalService.TemporalServ
 	)
 	// Create wallet service for credit usage export
 	walletService := service.NewWalletService(params)
-	exportActivity := exportActivities.NewExportActivity(params.FeatureUsageRepo, params.PriceRepo, params.InvoiceRepo, params.WalletRepo, walletService, params.CustomerRepo, params.ConnectionRepo, params.IntegrationFactory, params.Logger)
+	exportActivity := exportActivities.NewExportActivity(params.FeatureUsageRepo, params.PriceRepo, params.InvoiceRepo, params.WalletRepo, walletService, params.CustomerRepo, params.PlanRepo, params.SubRepo, params.CouponRepo, params.AuditLogRepo, params.ConnectionRepo, params.IntegrationFactory, params.Logger)
 
 	// HubSpot activities - clean and simple, delegates to existing services
 	hubspotDealSyncActivities := hubspotActivities.NewDealSyncActivities(
@@ -235,6 +239,10 @@ func RegisterWorkflowsAndActivities(temporalService temporalService.TemporalServ
 		subscription:         cronActivities.NewSubscriptionCronActivities(subscriptionService, params.Logger),
 		walletCreditExpiry:   cronActivities.NewWalletCreditExpiryActivities(walletService, tenantService, environmentService, params.Logger),
 		webhookOutboundRetry: cronActivities.NewWebhookOutboundRetryActivities(webhookService, params.Logger),
+		usageAnomaly:         cronActivities.NewUsageAnomalyActivities(service.NewUsageAnomalyService(params), tenantService, environmentService, params.Logger),
+		usageRollup:          cronActivities.NewUsageRollupActivities(service.NewUsageRollupService(params), tenantService, environmentService, params.Logger),
+		commitmentContract:   cronActivities.NewCommitmentContractActivities(service.NewCommitmentContractService(params), tenantService, environmentService, params.Logger),
+		subscriptionSeat:     cronActivities.NewSubscriptionSeatActivities(service.NewSubscriptionSeatService(params), tenantService, environmentService, params.Logger),
 	}
 
 	// Get all task queues and register workflows/activities for each
@@ -446,6 +454,10 @@ func buildWorkerConfig(
 			cronWorkflows.SubscriptionRenewalDueAlertsWorkflow,
 			cronWorkflows.SubscriptionTrialEndDueWorkflow,
 			cronWorkflows.OutboundWebhookStaleRetryWorkflow,
+			cronWorkflows.UsageAnomalyDetectionWorkflow,
+			cronWorkflows.UsageRollupWorkflow,
+			cronWorkflows.CommitmentContractTrueUpWorkflow,
+			cronWorkflows.SubscriptionSeatsWorkflow,
 		)
 		activitiesList = append(activitiesList,
 			cron.creditGrant.ProcessScheduledCreditGrantApplicationsActivity,
@@ -455,6 +467,10 @@ func buildWorkerConfig(
 			cron.subscription.ProcessRenewalDueAlertsActivity,
 			cron.subscription.ProcessTrialEndDueActivity,
 			cron.webhookOutboundRetry.RetryStaleOutboundWebhooksActivity,
+			cron.usageAnomaly.DetectUsageAnomaliesActivity,
+			cron.usageRollup.RollupUsageActivity,
+			cron.commitmentContract.TrueUpCommitmentContractsActivity,
+			cron.subscriptionSeat.ProcessSubscriptionSeatsActivity,
 		)
 	}
 	return WorkerConfig{
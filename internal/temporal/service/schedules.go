@@ -69,6 +69,34 @@ func AllTemporalScheduleConfigs() []types.ScheduleConfig {
 			Input:     models.OutboundWebhookStaleRetryWorkflowInput{},
 			TaskQueue: types.TemporalTaskQueueCron,
 		},
+		{
+			ID:        types.ScheduleIDUsageAnomalyDetection,
+			Interval:  time.Hour,
+			Workflow:  cronWorkflows.UsageAnomalyDetectionWorkflow,
+			Input:     models.UsageAnomalyDetectionWorkflowInput{},
+			TaskQueue: types.TemporalTaskQueueCron,
+		},
+		{
+			ID:        types.ScheduleIDUsageRollup,
+			Interval:  time.Hour,
+			Workflow:  cronWorkflows.UsageRollupWorkflow,
+			Input:     models.UsageRollupWorkflowInput{},
+			TaskQueue: types.TemporalTaskQueueCron,
+		},
+		{
+			ID:        types.ScheduleIDCommitmentContractTrueUp,
+			Interval:  time.Hour,
+			Workflow:  cronWorkflows.CommitmentContractTrueUpWorkflow,
+			Input:     models.CommitmentContractTrueUpWorkflowInput{},
+			TaskQueue: types.TemporalTaskQueueCron,
+		},
+		{
+			ID:        types.ScheduleIDSubscriptionSeats,
+			Interval:  time.Hour,
+			Workflow:  cronWorkflows.SubscriptionSeatsWorkflow,
+			Input:     models.SubscriptionSeatsWorkflowInput{},
+			TaskQueue: types.TemporalTaskQueueCron,
+		},
 	}
 }
 
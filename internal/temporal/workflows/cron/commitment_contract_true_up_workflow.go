@@ -0,0 +1,46 @@
+package cron
+
+import (
+	"time"
+
+	cronModels "github.com/flexprice/flexprice/internal/temporal/models"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	ActivityTrueUpCommitmentContracts = "TrueUpCommitmentContractsActivity"
+)
+
+// CommitmentContractTrueUpWorkflow completes commitment contracts whose term ended and invoices
+// the spend short of their commitment. Triggered by a Temporal schedule every hour.
+func CommitmentContractTrueUpWorkflow(ctx workflow.Context, _ cronModels.CommitmentContractTrueUpWorkflowInput) (*cronModels.CommitmentContractTrueUpWorkflowResult, error) {
+	log := workflow.GetLogger(ctx)
+	log.Info("Starting CommitmentContractTrueUpWorkflow")
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: 50 * time.Minute,
+		HeartbeatTimeout:    10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    10 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    5 * time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	var result cronModels.CommitmentContractTrueUpWorkflowResult
+	if err := workflow.ExecuteActivity(ctx, ActivityTrueUpCommitmentContracts).Get(ctx, &result); err != nil {
+		log.Error("CommitmentContractTrueUpWorkflow activity failed", "error", err)
+		return nil, err
+	}
+
+	log.Info("CommitmentContractTrueUpWorkflow completed",
+		"environments", result.Environments,
+		"completed", result.Completed,
+		"true_up_invoices", result.TrueUpInvoices,
+		"failed", result.Failed,
+	)
+	return &result, nil
+}
@@ -39,6 +39,7 @@ func OutboundWebhookStaleRetryWorkflow(ctx workflow.Context, _ cronModels.Outbou
 		"total", result.Total,
 		"succeeded", result.Succeeded,
 		"failed", result.Failed,
+		"blocked", result.Blocked,
 	)
 	return &result, nil
 }
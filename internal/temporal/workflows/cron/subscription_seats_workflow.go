@@ -0,0 +1,46 @@
+package cron
+
+import (
+	"time"
+
+	cronModels "github.com/flexprice/flexprice/internal/temporal/models"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	ActivityProcessSubscriptionSeats = "ProcessSubscriptionSeatsActivity"
+)
+
+// SubscriptionSeatsWorkflow syncs the seat counts of metered seat subscriptions and invoices
+// seat increases accrued for true-up in ended periods. Triggered by a Temporal schedule every hour.
+func SubscriptionSeatsWorkflow(ctx workflow.Context, _ cronModels.SubscriptionSeatsWorkflowInput) (*cronModels.SubscriptionSeatsWorkflowResult, error) {
+	log := workflow.GetLogger(ctx)
+	log.Info("Starting SubscriptionSeatsWorkflow")
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: 50 * time.Minute,
+		HeartbeatTimeout:    10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    10 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    5 * time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	var result cronModels.SubscriptionSeatsWorkflowResult
+	if err := workflow.ExecuteActivity(ctx, ActivityProcessSubscriptionSeats).Get(ctx, &result); err != nil {
+		log.Error("SubscriptionSeatsWorkflow activity failed", "error", err)
+		return nil, err
+	}
+
+	log.Info("SubscriptionSeatsWorkflow completed",
+		"environments", result.Environments,
+		"seats_synced", result.SeatsSynced,
+		"true_up_invoices", result.TrueUpInvoices,
+		"failed", result.Failed,
+	)
+	return &result, nil
+}
@@ -0,0 +1,46 @@
+package cron
+
+import (
+	"time"
+
+	cronModels "github.com/flexprice/flexprice/internal/temporal/models"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	ActivityDetectUsageAnomalies = "DetectUsageAnomaliesActivity"
+)
+
+// UsageAnomalyDetectionWorkflow flags usage spikes and drops per customer and meter.
+// Triggered by a Temporal schedule every hour.
+func UsageAnomalyDetectionWorkflow(ctx workflow.Context, _ cronModels.UsageAnomalyDetectionWorkflowInput) (*cronModels.UsageAnomalyDetectionWorkflowResult, error) {
+	log := workflow.GetLogger(ctx)
+	log.Info("Starting UsageAnomalyDetectionWorkflow")
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    10 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    5 * time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	var result cronModels.UsageAnomalyDetectionWorkflowResult
+	if err := workflow.ExecuteActivity(ctx, ActivityDetectUsageAnomalies).Get(ctx, &result); err != nil {
+		log.Error("UsageAnomalyDetectionWorkflow activity failed", "error", err)
+		return nil, err
+	}
+
+	log.Info("UsageAnomalyDetectionWorkflow completed",
+		"environments", result.Environments,
+		"evaluated", result.Evaluated,
+		"anomalies", result.Anomalies,
+		"failed", result.Failed,
+	)
+	return &result, nil
+}
@@ -0,0 +1,46 @@
+package cron
+
+import (
+	"time"
+
+	cronModels "github.com/flexprice/flexprice/internal/temporal/models"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	ActivityRollupUsage = "RollupUsageActivity"
+)
+
+// UsageRollupWorkflow maintains the hourly and daily meter usage rollups and syncs usage
+// retention policies to ClickHouse. Triggered by a Temporal schedule every hour.
+func UsageRollupWorkflow(ctx workflow.Context, _ cronModels.UsageRollupWorkflowInput) (*cronModels.UsageRollupWorkflowResult, error) {
+	log := workflow.GetLogger(ctx)
+	log.Info("Starting UsageRollupWorkflow")
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: 50 * time.Minute,
+		HeartbeatTimeout:    10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    10 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    5 * time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	var result cronModels.UsageRollupWorkflowResult
+	if err := workflow.ExecuteActivity(ctx, ActivityRollupUsage).Get(ctx, &result); err != nil {
+		log.Error("UsageRollupWorkflow activity failed", "error", err)
+		return nil, err
+	}
+
+	log.Info("UsageRollupWorkflow completed",
+		"environments", result.Environments,
+		"hourly_buckets", result.HourlyBuckets,
+		"daily_buckets", result.DailyBuckets,
+		"failed", result.Failed,
+	)
+	return &result, nil
+}
@@ -8,7 +8,9 @@ import (
 	"github.com/flexprice/flexprice/internal/config"
 	"github.com/flexprice/flexprice/internal/domain/addonassociation"
 	"github.com/flexprice/flexprice/internal/domain/alertlogs"
+	"github.com/flexprice/flexprice/internal/domain/auditlog"
 	"github.com/flexprice/flexprice/internal/domain/auth"
+	"github.com/flexprice/flexprice/internal/domain/commitmentcontract"
 	"github.com/flexprice/flexprice/internal/domain/connection"
 	"github.com/flexprice/flexprice/internal/domain/coupon"
 	"github.com/flexprice/flexprice/internal/domain/coupon_application"
@@ -22,15 +24,22 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/environment"
 	"github.com/flexprice/flexprice/internal/domain/events"
 	"github.com/flexprice/flexprice/internal/domain/feature"
+	"github.com/flexprice/flexprice/internal/domain/fxrate"
+	"github.com/flexprice/flexprice/internal/domain/invitation"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/membership"
 	"github.com/flexprice/flexprice/internal/domain/meter"
 	"github.com/flexprice/flexprice/internal/domain/payment"
 	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/pricebook"
 	"github.com/flexprice/flexprice/internal/domain/priceunit"
+	"github.com/flexprice/flexprice/internal/domain/promotion_code"
 	"github.com/flexprice/flexprice/internal/domain/proration"
+	"github.com/flexprice/flexprice/internal/domain/rbacrole"
 	"github.com/flexprice/flexprice/internal/domain/secret"
 	"github.com/flexprice/flexprice/internal/domain/settings"
+	"github.com/flexprice/flexprice/internal/domain/sso"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
 	"github.com/flexprice/flexprice/internal/domain/task"
 	taxrate "github.com/flexprice/flexprice/internal/domain/tax"
@@ -39,6 +48,8 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/tenant"
 	"github.com/flexprice/flexprice/internal/domain/user"
 	"github.com/flexprice/flexprice/internal/domain/wallet"
+	"github.com/flexprice/flexprice/internal/domain/webhookdeliveryattempt"
+	"github.com/flexprice/flexprice/internal/domain/webhookendpoint"
 	"github.com/flexprice/flexprice/internal/integration"
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/pdf"
@@ -85,6 +96,18 @@ type Stores struct {
 	CouponRepo                   coupon.Repository
 	CouponAssociationRepo        coupon_association.Repository
 	CouponApplicationRepo        coupon_application.Repository
+	PromotionCodeRepo            promotion_code.Repository
+	FXRateRepo                   fxrate.Repository
+	PriceBookRepo                pricebook.Repository
+	CommitmentContractRepo       commitmentcontract.Repository
+	SubscriptionSeatChangeRepo   subscription.SubscriptionSeatChangeRepository
+	WebhookEndpointRepo          webhookendpoint.Repository
+	WebhookDeliveryAttemptRepo   webhookdeliveryattempt.Repository
+	RBACRoleRepo                 rbacrole.Repository
+	AuditLogRepo                 auditlog.Repository
+	SSOConnectionRepo            sso.Repository
+	InvitationRepo               invitation.Repository
+	MembershipRepo               membership.Repository
 	AddonAssociationRepo         addonassociation.Repository
 	ConnectionRepo               connection.Repository
 	EntityIntegrationMappingRepo entityintegrationmapping.Repository
@@ -229,6 +252,18 @@ func (s *BaseServiceTestSuite) setupStores() {
 		CouponRepo:                   NewInMemoryCouponStore(),
 		CouponAssociationRepo:        NewInMemoryCouponAssociationStore(),
 		CouponApplicationRepo:        NewInMemoryCouponApplicationStore(),
+		PromotionCodeRepo:            NewInMemoryPromotionCodeStore(),
+		FXRateRepo:                   NewInMemoryFXRateStore(),
+		PriceBookRepo:                NewInMemoryPriceBookStore(),
+		CommitmentContractRepo:       NewInMemoryCommitmentContractStore(),
+		SubscriptionSeatChangeRepo:   NewInMemorySubscriptionSeatChangeStore(),
+		WebhookEndpointRepo:          NewInMemoryWebhookEndpointStore(),
+		WebhookDeliveryAttemptRepo:   NewInMemoryWebhookDeliveryAttemptStore(),
+		RBACRoleRepo:                 NewInMemoryRBACRoleStore(),
+		AuditLogRepo:                 NewInMemoryAuditLogStore(),
+		SSOConnectionRepo:            NewInMemorySSOConnectionStore(),
+		InvitationRepo:               NewInMemoryInvitationStore(),
+		MembershipRepo:               NewInMemoryMembershipStore(),
 		AddonAssociationRepo:         NewInMemoryAddonAssociationStore(),
 		ConnectionRepo:               NewInMemoryConnectionStore(),
 		EntityIntegrationMappingRepo: NewInMemoryEntityIntegrationMappingStore(),
@@ -281,6 +316,18 @@ func (s *BaseServiceTestSuite) clearStores() {
 	s.stores.CouponRepo.(*InMemoryCouponStore).Clear()
 	s.stores.CouponAssociationRepo.(*InMemoryCouponAssociationStore).Clear()
 	s.stores.CouponApplicationRepo.(*InMemoryCouponApplicationStore).Clear()
+	s.stores.PromotionCodeRepo.(*InMemoryPromotionCodeStore).Clear()
+	s.stores.FXRateRepo.(*InMemoryFXRateStore).Clear()
+	s.stores.PriceBookRepo.(*InMemoryPriceBookStore).Clear()
+	s.stores.CommitmentContractRepo.(*InMemoryCommitmentContractStore).Clear()
+	s.stores.SubscriptionSeatChangeRepo.(*InMemorySubscriptionSeatChangeStore).Clear()
+	s.stores.WebhookEndpointRepo.(*InMemoryWebhookEndpointStore).Clear()
+	s.stores.WebhookDeliveryAttemptRepo.(*InMemoryWebhookDeliveryAttemptStore).Clear()
+	s.stores.RBACRoleRepo.(*InMemoryRBACRoleStore).Clear()
+	s.stores.AuditLogRepo.(*InMemoryAuditLogStore).Clear()
+	s.stores.SSOConnectionRepo.(*InMemorySSOConnectionStore).Clear()
+	s.stores.InvitationRepo.(*InMemoryInvitationStore).Clear()
+	s.stores.MembershipRepo.(*InMemoryMembershipStore).Clear()
 	s.stores.AddonAssociationRepo.(*InMemoryAddonAssociationStore).Clear()
 	s.stores.SettingsRepo.(*InMemorySettingsStore).Clear()
 	s.stores.SubscriptionLineItemRepo.(*InMemorySubscriptionLineItemStore).Clear()
@@ -0,0 +1,137 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/domain/auditlog"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryAuditLogStore implements auditlog.Repository
+type InMemoryAuditLogStore struct {
+	*InMemoryStore[*auditlog.AuditLog]
+}
+
+// NewInMemoryAuditLogStore creates a new in-memory audit log store
+func NewInMemoryAuditLogStore() *InMemoryAuditLogStore {
+	return &InMemoryAuditLogStore{
+		InMemoryStore: NewInMemoryStore[*auditlog.AuditLog](),
+	}
+}
+
+// Helper to copy audit log
+func copyAuditLog(l *auditlog.AuditLog) *auditlog.AuditLog {
+	if l == nil {
+		return nil
+	}
+
+	copied := *l
+	copied.Changes = lo.Assign(map[string]types.AuditLogChange{}, l.Changes)
+	return &copied
+}
+
+func (s *InMemoryAuditLogStore) Create(ctx context.Context, l *auditlog.AuditLog) error {
+	if l == nil {
+		return ierr.NewError("audit log cannot be nil").
+			WithHint("Audit log cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set environment ID from context if not already set
+	if l.EnvironmentID == "" {
+		l.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	return s.InMemoryStore.Create(ctx, l.ID, copyAuditLog(l))
+}
+
+func (s *InMemoryAuditLogStore) Get(ctx context.Context, id string) (*auditlog.AuditLog, error) {
+	l, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.NewError("audit log not found").
+			WithHint("Audit log not found").
+			WithReportableDetails(map[string]interface{}{
+				"id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return copyAuditLog(l), nil
+}
+
+func (s *InMemoryAuditLogStore) List(ctx context.Context, filter *types.AuditLogFilter) ([]*auditlog.AuditLog, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, auditLogFilterFn, auditLogSortFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(items, func(l *auditlog.AuditLog, _ int) *auditlog.AuditLog {
+		return copyAuditLog(l)
+	}), nil
+}
+
+func (s *InMemoryAuditLogStore) Count(ctx context.Context, filter *types.AuditLogFilter) (int, error) {
+	return s.InMemoryStore.Count(ctx, filter, auditLogFilterFn)
+}
+
+// auditLogFilterFn implements filtering logic for audit logs
+func auditLogFilterFn(ctx context.Context, l *auditlog.AuditLog, filter interface{}) bool {
+	f, ok := filter.(*types.AuditLogFilter)
+	if !ok {
+		return false
+	}
+
+	// Apply tenant filter
+	tenantID := types.GetTenantID(ctx)
+	if tenantID != "" && l.TenantID != tenantID {
+		return false
+	}
+
+	// Apply environment filter
+	if !CheckEnvironmentFilter(ctx, l.EnvironmentID) {
+		return false
+	}
+
+	if len(f.EntityTypes) > 0 && !lo.Contains(f.EntityTypes, string(l.EntityType)) {
+		return false
+	}
+
+	if len(f.EntityIDs) > 0 && !lo.Contains(f.EntityIDs, l.EntityID) {
+		return false
+	}
+
+	if len(f.Actions) > 0 && !lo.Contains(f.Actions, string(l.Action)) {
+		return false
+	}
+
+	if len(f.ActorTypes) > 0 && !lo.Contains(f.ActorTypes, string(l.ActorType)) {
+		return false
+	}
+
+	if len(f.ActorIDs) > 0 && !lo.Contains(f.ActorIDs, l.ActorID) {
+		return false
+	}
+
+	if f.RequestID != "" && l.RequestID != f.RequestID {
+		return false
+	}
+
+	// Filter by time range
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil && l.CreatedAt.Before(*f.StartTime) {
+			return false
+		}
+		if f.EndTime != nil && l.CreatedAt.After(*f.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// auditLogSortFn implements sorting logic for audit logs
+func auditLogSortFn(i, j *auditlog.AuditLog) bool {
+	// Default sort by created_at desc
+	return i.CreatedAt.After(j.CreatedAt)
+}
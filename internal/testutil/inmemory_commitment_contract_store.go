@@ -0,0 +1,216 @@
+package testutil
+
+import (
+	"context"
+	"strings"
+
+	"github.com/flexprice/flexprice/internal/domain/commitmentcontract"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryCommitmentContractStore implements commitmentcontract.Repository
+type InMemoryCommitmentContractStore struct {
+	*InMemoryStore[*commitmentcontract.CommitmentContract]
+	drawdowns *InMemoryStore[*commitmentcontract.CommitmentDrawdown]
+}
+
+// NewInMemoryCommitmentContractStore creates a new in-memory commitment contract store
+func NewInMemoryCommitmentContractStore() *InMemoryCommitmentContractStore {
+	return &InMemoryCommitmentContractStore{
+		InMemoryStore: NewInMemoryStore[*commitmentcontract.CommitmentContract](),
+		drawdowns:     NewInMemoryStore[*commitmentcontract.CommitmentDrawdown](),
+	}
+}
+
+// Helper to copy commitment contract
+func copyCommitmentContract(c *commitmentcontract.CommitmentContract) *commitmentcontract.CommitmentContract {
+	if c == nil {
+		return nil
+	}
+
+	copied := *c
+	copied.SubscriptionIDs = append([]string(nil), c.SubscriptionIDs...)
+	copied.Metadata = lo.Assign(map[string]string{}, c.Metadata)
+	return &copied
+}
+
+func (s *InMemoryCommitmentContractStore) Create(ctx context.Context, c *commitmentcontract.CommitmentContract) error {
+	if c == nil {
+		return ierr.NewError("commitment contract cannot be nil").
+			WithHint("Commitment contract cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set environment ID from context if not already set
+	if c.EnvironmentID == "" {
+		c.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+	c.Currency = strings.ToLower(c.Currency)
+
+	return s.InMemoryStore.Create(ctx, c.ID, copyCommitmentContract(c))
+}
+
+func (s *InMemoryCommitmentContractStore) Get(ctx context.Context, id string) (*commitmentcontract.CommitmentContract, error) {
+	c, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.NewError("commitment contract not found").
+			WithHint("Commitment contract not found").
+			WithReportableDetails(map[string]interface{}{
+				"id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return copyCommitmentContract(c), nil
+}
+
+func (s *InMemoryCommitmentContractStore) GetForUpdate(ctx context.Context, id string) (*commitmentcontract.CommitmentContract, error) {
+	return s.Get(ctx, id)
+}
+
+func (s *InMemoryCommitmentContractStore) Update(ctx context.Context, c *commitmentcontract.CommitmentContract) error {
+	if c == nil {
+		return ierr.NewError("commitment contract cannot be nil").
+			WithHint("Commitment contract cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+	return s.InMemoryStore.Update(ctx, c.ID, copyCommitmentContract(c))
+}
+
+func (s *InMemoryCommitmentContractStore) List(ctx context.Context, filter *types.CommitmentContractFilter) ([]*commitmentcontract.CommitmentContract, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, commitmentContractFilterFn, commitmentContractSortFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(items, func(c *commitmentcontract.CommitmentContract, _ int) *commitmentcontract.CommitmentContract {
+		return copyCommitmentContract(c)
+	}), nil
+}
+
+func (s *InMemoryCommitmentContractStore) Count(ctx context.Context, filter *types.CommitmentContractFilter) (int, error) {
+	return s.InMemoryStore.Count(ctx, filter, commitmentContractFilterFn)
+}
+
+func (s *InMemoryCommitmentContractStore) CreateDrawdown(ctx context.Context, d *commitmentcontract.CommitmentDrawdown) error {
+	if d == nil {
+		return ierr.NewError("commitment drawdown cannot be nil").
+			WithHint("Commitment drawdown cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+
+	if _, err := s.GetDrawdownByInvoice(ctx, d.ContractID, d.InvoiceID); err == nil {
+		return ierr.NewError("commitment drawdown already exists").
+			WithHint("The invoice already drew down this commitment contract").
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	if d.EnvironmentID == "" {
+		d.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	copied := *d
+	return s.drawdowns.Create(ctx, d.ID, &copied)
+}
+
+func (s *InMemoryCommitmentContractStore) GetDrawdownByInvoice(ctx context.Context, contractID, invoiceID string) (*commitmentcontract.CommitmentDrawdown, error) {
+	drawdowns, err := s.ListDrawdowns(ctx, contractID)
+	if err != nil {
+		return nil, err
+	}
+
+	d, ok := lo.Find(drawdowns, func(d *commitmentcontract.CommitmentDrawdown) bool {
+		return d.InvoiceID == invoiceID
+	})
+	if !ok {
+		return nil, ierr.NewError("commitment drawdown not found").
+			WithHint("The invoice has not drawn down this commitment contract").
+			Mark(ierr.ErrNotFound)
+	}
+	return d, nil
+}
+
+func (s *InMemoryCommitmentContractStore) ListDrawdowns(ctx context.Context, contractID string) ([]*commitmentcontract.CommitmentDrawdown, error) {
+	items, err := s.drawdowns.List(ctx, nil,
+		func(ctx context.Context, d *commitmentcontract.CommitmentDrawdown, _ interface{}) bool {
+			return d.ContractID == contractID &&
+				CheckTenantFilter(ctx, d.TenantID) &&
+				CheckEnvironmentFilter(ctx, d.EnvironmentID) &&
+				d.Status == types.StatusPublished
+		},
+		func(i, j *commitmentcontract.CommitmentDrawdown) bool {
+			return i.PeriodStart.Before(j.PeriodStart)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(items, func(d *commitmentcontract.CommitmentDrawdown, _ int) *commitmentcontract.CommitmentDrawdown {
+		copied := *d
+		return &copied
+	}), nil
+}
+
+// Clear removes all contracts and drawdowns from the store
+func (s *InMemoryCommitmentContractStore) Clear() {
+	s.InMemoryStore.Clear()
+	s.drawdowns.Clear()
+}
+
+// commitmentContractFilterFn implements filtering logic for commitment contracts
+func commitmentContractFilterFn(ctx context.Context, c *commitmentcontract.CommitmentContract, filter interface{}) bool {
+	f, ok := filter.(*types.CommitmentContractFilter)
+	if !ok {
+		return false
+	}
+
+	// Apply tenant filter
+	tenantID := types.GetTenantID(ctx)
+	if tenantID != "" && c.TenantID != tenantID {
+		return false
+	}
+
+	// Apply environment filter
+	if !CheckEnvironmentFilter(ctx, c.EnvironmentID) {
+		return false
+	}
+
+	// Apply status filter
+	if f.QueryFilter != nil && f.QueryFilter.Status != nil {
+		if c.Status != *f.QueryFilter.Status {
+			return false
+		}
+	} else if c.Status == types.StatusDeleted {
+		return false
+	}
+
+	if len(f.ContractIDs) > 0 && !lo.Contains(f.ContractIDs, c.ID) {
+		return false
+	}
+
+	if f.CustomerID != "" && c.CustomerID != f.CustomerID {
+		return false
+	}
+
+	if f.SubscriptionID != "" && !lo.Contains(c.SubscriptionIDs, f.SubscriptionID) {
+		return false
+	}
+
+	if len(f.ContractStatuses) > 0 && !lo.Contains(f.ContractStatuses, c.ContractStatus) {
+		return false
+	}
+
+	if f.EndDateLTE != nil && c.EndDate.After(*f.EndDateLTE) {
+		return false
+	}
+
+	return true
+}
+
+// commitmentContractSortFn implements sorting logic for commitment contracts
+func commitmentContractSortFn(i, j *commitmentcontract.CommitmentContract) bool {
+	// Default sort by created_at desc
+	return i.CreatedAt.After(j.CreatedAt)
+}
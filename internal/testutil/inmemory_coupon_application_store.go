@@ -43,6 +43,7 @@ func copyCouponApplication(ca *coupon_application.CouponApplication) *coupon_app
 		DiscountPercentage:  ca.DiscountPercentage,
 		Currency:            ca.Currency,
 		CouponSnapshot:      ca.CouponSnapshot,
+		PromotionCodeID:     ca.PromotionCodeID,
 		Metadata:            lo.Assign(map[string]string{}, ca.Metadata),
 		EnvironmentID:       ca.EnvironmentID,
 		BaseModel: types.BaseModel{
@@ -181,6 +182,13 @@ func couponApplicationFilterFn(ctx context.Context, ca *coupon_application.Coupo
 		}
 	}
 
+	// Check promotion code IDs filter
+	if len(f.PromotionCodeIDs) > 0 {
+		if ca.PromotionCodeID == nil || !lo.Contains(f.PromotionCodeIDs, *ca.PromotionCodeID) {
+			return false
+		}
+	}
+
 	// Check coupon association IDs filter
 	if len(f.CouponAssociationIDs) > 0 && !lo.Contains(f.CouponAssociationIDs, ca.CouponAssociationID) {
 		return false
@@ -47,6 +47,7 @@ func copyCouponAssociation(ca *coupon_association.CouponAssociation) *coupon_ass
 		SubscriptionID:         ca.SubscriptionID,
 		SubscriptionLineItemID: ca.SubscriptionLineItemID,
 		SubscriptionPhaseID:    subscriptionPhaseID,
+		PromotionCodeID:        ca.PromotionCodeID,
 		StartDate:              ca.StartDate,
 		EndDate:                endDate,
 		Metadata:               lo.Assign(map[string]string{}, ca.Metadata),
@@ -192,6 +193,13 @@ func couponAssociationFilterFn(ctx context.Context, ca *coupon_association.Coupo
 		}
 	}
 
+	// Apply promotion code ID filters
+	if len(f.PromotionCodeIDs) > 0 {
+		if ca.PromotionCodeID == nil || !lo.Contains(f.PromotionCodeIDs, *ca.PromotionCodeID) {
+			return false
+		}
+	}
+
 	// Apply active filter based on start_date and end_date
 	if f.ActiveOnly {
 		var periodStart, periodEnd time.Time
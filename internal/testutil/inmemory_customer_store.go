@@ -40,6 +40,9 @@ func copyCustomer(c *customer.Customer) *customer.Customer {
 		AddressState:      c.AddressState,
 		AddressPostalCode: c.AddressPostalCode,
 		AddressCountry:    c.AddressCountry,
+		Currency:          c.Currency,
+		ParentCustomerID:  c.ParentCustomerID,
+		InvoiceToParent:   c.InvoiceToParent,
 		Metadata:          lo.Assign(map[string]string{}, c.Metadata),
 		EnvironmentID:     c.EnvironmentID,
 		BaseModel: types.BaseModel{
@@ -161,6 +164,10 @@ func customerFilterFn(ctx context.Context, c *customer.Customer, filter interfac
 		return false
 	}
 
+	if len(f.ParentCustomerIDs) > 0 && (c.ParentCustomerID == nil || !lo.Contains(f.ParentCustomerIDs, *c.ParentCustomerID)) {
+		return false
+	}
+
 	// Apply time range filter if present
 	if f.TimeRangeFilter != nil {
 		if f.StartTime != nil && c.CreatedAt.Before(*f.StartTime) {
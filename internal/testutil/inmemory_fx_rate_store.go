@@ -0,0 +1,168 @@
+package testutil
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/fxrate"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryFXRateStore implements fxrate.Repository
+type InMemoryFXRateStore struct {
+	*InMemoryStore[*fxrate.FXRate]
+}
+
+// NewInMemoryFXRateStore creates a new in-memory FX rate store
+func NewInMemoryFXRateStore() *InMemoryFXRateStore {
+	return &InMemoryFXRateStore{
+		InMemoryStore: NewInMemoryStore[*fxrate.FXRate](),
+	}
+}
+
+// Helper to copy FX rate
+func copyFXRate(r *fxrate.FXRate) *fxrate.FXRate {
+	if r == nil {
+		return nil
+	}
+
+	copied := *r
+	copied.Metadata = lo.Assign(map[string]string{}, r.Metadata)
+	return &copied
+}
+
+func (s *InMemoryFXRateStore) Create(ctx context.Context, r *fxrate.FXRate) error {
+	if r == nil {
+		return ierr.NewError("fx rate cannot be nil").
+			WithHint("FX rate cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set environment ID from context if not already set
+	if r.EnvironmentID == "" {
+		r.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+	r.BaseCurrency = strings.ToLower(r.BaseCurrency)
+	r.QuoteCurrency = strings.ToLower(r.QuoteCurrency)
+
+	return s.InMemoryStore.Create(ctx, r.ID, copyFXRate(r))
+}
+
+func (s *InMemoryFXRateStore) Get(ctx context.Context, id string) (*fxrate.FXRate, error) {
+	r, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.NewError("fx rate not found").
+			WithHint("FX rate not found").
+			WithReportableDetails(map[string]interface{}{
+				"id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return copyFXRate(r), nil
+}
+
+func (s *InMemoryFXRateStore) GetLatest(ctx context.Context, baseCurrency, quoteCurrency string, at time.Time) (*fxrate.FXRate, error) {
+	filter := types.NewNoLimitFXRateFilter()
+	filter.BaseCurrency = baseCurrency
+	filter.QuoteCurrency = quoteCurrency
+	filter.EffectiveAtOrBefore = &at
+	filter.QueryFilter.Status = lo.ToPtr(types.StatusPublished)
+
+	items, err := s.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, ierr.NewError("fx rate not found").
+			WithHintf("No FX rate from %s to %s is effective at %s", baseCurrency, quoteCurrency, at.Format(time.RFC3339)).
+			Mark(ierr.ErrNotFound)
+	}
+
+	return lo.MaxBy(items, func(a, b *fxrate.FXRate) bool {
+		return a.EffectiveAt.After(b.EffectiveAt)
+	}), nil
+}
+
+func (s *InMemoryFXRateStore) Delete(ctx context.Context, id string) error {
+	r, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	r.Status = types.StatusArchived
+	return s.InMemoryStore.Update(ctx, r.ID, r)
+}
+
+func (s *InMemoryFXRateStore) List(ctx context.Context, filter *types.FXRateFilter) ([]*fxrate.FXRate, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, fxRateFilterFn, fxRateSortFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(items, func(r *fxrate.FXRate, _ int) *fxrate.FXRate {
+		return copyFXRate(r)
+	}), nil
+}
+
+func (s *InMemoryFXRateStore) Count(ctx context.Context, filter *types.FXRateFilter) (int, error) {
+	return s.InMemoryStore.Count(ctx, filter, fxRateFilterFn)
+}
+
+// fxRateFilterFn implements filtering logic for FX rates
+func fxRateFilterFn(ctx context.Context, r *fxrate.FXRate, filter interface{}) bool {
+	f, ok := filter.(*types.FXRateFilter)
+	if !ok {
+		return false
+	}
+
+	// Apply tenant filter
+	tenantID := types.GetTenantID(ctx)
+	if tenantID != "" && r.TenantID != tenantID {
+		return false
+	}
+
+	// Apply environment filter
+	if !CheckEnvironmentFilter(ctx, r.EnvironmentID) {
+		return false
+	}
+
+	// Apply status filter
+	if f.QueryFilter != nil && f.QueryFilter.Status != nil {
+		if r.Status != *f.QueryFilter.Status {
+			return false
+		}
+	} else if r.Status == types.StatusDeleted {
+		return false
+	}
+
+	if len(f.FXRateIDs) > 0 && !lo.Contains(f.FXRateIDs, r.ID) {
+		return false
+	}
+
+	if f.BaseCurrency != "" && !strings.EqualFold(r.BaseCurrency, f.BaseCurrency) {
+		return false
+	}
+
+	if f.QuoteCurrency != "" && !strings.EqualFold(r.QuoteCurrency, f.QuoteCurrency) {
+		return false
+	}
+
+	if f.Source != "" && r.Source != f.Source {
+		return false
+	}
+
+	if f.EffectiveAtOrBefore != nil && r.EffectiveAt.After(*f.EffectiveAtOrBefore) {
+		return false
+	}
+
+	return true
+}
+
+// fxRateSortFn implements sorting logic for FX rates
+func fxRateSortFn(i, j *fxrate.FXRate) bool {
+	// Default sort by effective_at desc
+	return i.EffectiveAt.After(j.EffectiveAt)
+}
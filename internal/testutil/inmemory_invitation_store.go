@@ -0,0 +1,120 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/invitation"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryInvitationStore implements invitation.Repository
+type InMemoryInvitationStore struct {
+	*InMemoryStore[*invitation.Invitation]
+}
+
+// NewInMemoryInvitationStore creates a new in-memory invitation store
+func NewInMemoryInvitationStore() *InMemoryInvitationStore {
+	return &InMemoryInvitationStore{
+		InMemoryStore: NewInMemoryStore[*invitation.Invitation](),
+	}
+}
+
+// Helper to copy invitation
+func copyInvitation(i *invitation.Invitation) *invitation.Invitation {
+	if i == nil {
+		return nil
+	}
+
+	copied := *i
+	copied.Roles = append([]string(nil), i.Roles...)
+	if i.RespondedAt != nil {
+		copied.RespondedAt = lo.ToPtr(*i.RespondedAt)
+	}
+	return &copied
+}
+
+func (s *InMemoryInvitationStore) Create(ctx context.Context, i *invitation.Invitation) error {
+	if i == nil {
+		return ierr.NewError("invitation cannot be nil").
+			WithHint("Invitation cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+	// Mirrors the unique index on the pending invitations of an email
+	if _, err := s.GetPendingByEmail(ctx, i.Email); err == nil {
+		return ierr.NewErrorf("invitation for %s already exists", i.Email).
+			WithHintf("%s already has a pending invitation", i.Email).
+			Mark(ierr.ErrAlreadyExists)
+	}
+	return s.InMemoryStore.Create(ctx, i.ID, copyInvitation(i))
+}
+
+func (s *InMemoryInvitationStore) Get(ctx context.Context, id string) (*invitation.Invitation, error) {
+	i, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || !invitationFilterFn(ctx, i, nil) {
+		return nil, invitationNotFound()
+	}
+	return copyInvitation(i), nil
+}
+
+func (s *InMemoryInvitationStore) GetPendingByEmail(ctx context.Context, email string) (*invitation.Invitation, error) {
+	return s.first(ctx, func(ctx context.Context, i *invitation.Invitation, _ interface{}) bool {
+		return invitationFilterFn(ctx, i, nil) &&
+			i.Email == email &&
+			i.InvitationStatus == types.InvitationStatusPending
+	})
+}
+
+func (s *InMemoryInvitationStore) GetByTokenHash(ctx context.Context, tokenHash string) (*invitation.Invitation, error) {
+	return s.first(ctx, func(_ context.Context, i *invitation.Invitation, _ interface{}) bool {
+		return i.Status == types.StatusPublished && i.TokenHash == tokenHash
+	})
+}
+
+func (s *InMemoryInvitationStore) first(ctx context.Context, filterFn FilterFunc[*invitation.Invitation]) (*invitation.Invitation, error) {
+	items, err := s.InMemoryStore.List(ctx, nil, filterFn, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, invitationNotFound()
+	}
+	return copyInvitation(items[0]), nil
+}
+
+func (s *InMemoryInvitationStore) Update(ctx context.Context, i *invitation.Invitation) error {
+	if i == nil {
+		return ierr.NewError("invitation cannot be nil").
+			WithHint("Invitation cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+	updated := copyInvitation(i)
+	updated.UpdatedAt = time.Now().UTC()
+	return s.InMemoryStore.Update(ctx, i.ID, updated)
+}
+
+func (s *InMemoryInvitationStore) ListByTenant(ctx context.Context) ([]*invitation.Invitation, error) {
+	items, err := s.InMemoryStore.List(ctx, nil, invitationFilterFn, func(i, j *invitation.Invitation) bool {
+		return i.CreatedAt.After(j.CreatedAt)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(items, func(i *invitation.Invitation, _ int) *invitation.Invitation {
+		return copyInvitation(i)
+	}), nil
+}
+
+func invitationNotFound() error {
+	return ierr.NewError("invitation not found").
+		WithHint("Invitation was not found").
+		Mark(ierr.ErrNotFound)
+}
+
+// invitationFilterFn keeps the published invitations of the tenant in the context
+func invitationFilterFn(ctx context.Context, i *invitation.Invitation, _ interface{}) bool {
+	return i.TenantID == types.GetTenantID(ctx) && i.Status == types.StatusPublished
+}
@@ -0,0 +1,114 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/domain/membership"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryMembershipStore implements membership.Repository
+type InMemoryMembershipStore struct {
+	*InMemoryStore[*membership.Membership]
+}
+
+// NewInMemoryMembershipStore creates a new in-memory tenant membership store
+func NewInMemoryMembershipStore() *InMemoryMembershipStore {
+	return &InMemoryMembershipStore{
+		InMemoryStore: NewInMemoryStore[*membership.Membership](),
+	}
+}
+
+// Helper to copy membership
+func copyMembership(m *membership.Membership) *membership.Membership {
+	if m == nil {
+		return nil
+	}
+
+	copied := *m
+	copied.Roles = append([]string(nil), m.Roles...)
+	return &copied
+}
+
+func (s *InMemoryMembershipStore) Create(ctx context.Context, m *membership.Membership) error {
+	if m == nil {
+		return ierr.NewError("membership cannot be nil").
+			WithHint("Membership cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+	// Mirrors the unique index on the published memberships of a user per tenant
+	tenantCtx := context.WithValue(ctx, types.CtxTenantID, m.TenantID)
+	if _, err := s.GetByUserID(tenantCtx, m.UserID); err == nil {
+		return ierr.NewErrorf("membership of %s already exists", m.UserID).
+			WithHintf("%s is already a member of this tenant", m.Email).
+			Mark(ierr.ErrAlreadyExists)
+	}
+	return s.InMemoryStore.Create(ctx, m.ID, copyMembership(m))
+}
+
+func (s *InMemoryMembershipStore) GetByUserID(ctx context.Context, userID string) (*membership.Membership, error) {
+	items, err := s.InMemoryStore.List(ctx, nil, func(ctx context.Context, m *membership.Membership, _ interface{}) bool {
+		return membershipFilterFn(ctx, m, nil) && m.UserID == userID
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, ierr.NewError("membership not found").
+			WithHint("The user is not a member of this tenant").
+			Mark(ierr.ErrNotFound)
+	}
+	return copyMembership(items[0]), nil
+}
+
+func (s *InMemoryMembershipStore) UpdateRoles(ctx context.Context, id string, roles []string) error {
+	m, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	updated := copyMembership(m)
+	updated.Roles = append([]string(nil), roles...)
+	return s.InMemoryStore.Update(ctx, id, updated)
+}
+
+func (s *InMemoryMembershipStore) Delete(ctx context.Context, id string) error {
+	m, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	updated := copyMembership(m)
+	updated.Status = types.StatusArchived
+	return s.InMemoryStore.Update(ctx, id, updated)
+}
+
+func (s *InMemoryMembershipStore) ListByTenant(ctx context.Context) ([]*membership.Membership, error) {
+	return s.list(ctx, membershipFilterFn)
+}
+
+func (s *InMemoryMembershipStore) ListByUserID(ctx context.Context, userID string) ([]*membership.Membership, error) {
+	return s.list(ctx, func(_ context.Context, m *membership.Membership, _ interface{}) bool {
+		return m.Status == types.StatusPublished && m.UserID == userID
+	})
+}
+
+func (s *InMemoryMembershipStore) list(ctx context.Context, filterFn FilterFunc[*membership.Membership]) ([]*membership.Membership, error) {
+	items, err := s.InMemoryStore.List(ctx, nil, filterFn, func(i, j *membership.Membership) bool {
+		return i.CreatedAt.Before(j.CreatedAt)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(items, func(m *membership.Membership, _ int) *membership.Membership {
+		return copyMembership(m)
+	}), nil
+}
+
+// membershipFilterFn keeps the published memberships of the tenant in the context
+func membershipFilterFn(ctx context.Context, m *membership.Membership, _ interface{}) bool {
+	return m.TenantID == types.GetTenantID(ctx) && m.Status == types.StatusPublished
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/plan"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 )
 
 // InMemoryPlanStore implements plan.Repository
@@ -48,6 +49,16 @@ func planFilterFn(ctx context.Context, p *plan.Plan, filter interface{}) bool {
 		return false
 	}
 
+	// Filter by plan IDs
+	if len(f.PlanIDs) > 0 && !lo.Contains(f.PlanIDs, p.ID) {
+		return false
+	}
+
+	// Filter by lookup key
+	if f.LookupKey != nil && p.LookupKey != *f.LookupKey {
+		return false
+	}
+
 	// Filter by time range
 	if f.TimeRangeFilter != nil {
 		if f.StartTime != nil && p.CreatedAt.Before(*f.StartTime) {
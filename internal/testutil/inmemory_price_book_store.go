@@ -0,0 +1,145 @@
+package testutil
+
+import (
+	"context"
+	"strings"
+
+	"github.com/flexprice/flexprice/internal/domain/pricebook"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryPriceBookStore implements pricebook.Repository
+type InMemoryPriceBookStore struct {
+	*InMemoryStore[*pricebook.PriceBook]
+}
+
+// NewInMemoryPriceBookStore creates a new in-memory price book store
+func NewInMemoryPriceBookStore() *InMemoryPriceBookStore {
+	return &InMemoryPriceBookStore{
+		InMemoryStore: NewInMemoryStore[*pricebook.PriceBook](),
+	}
+}
+
+// Helper to copy price book
+func copyPriceBook(b *pricebook.PriceBook) *pricebook.PriceBook {
+	if b == nil {
+		return nil
+	}
+
+	copied := *b
+	copied.PlanIDs = append([]string(nil), b.PlanIDs...)
+	copied.AddonIDs = append([]string(nil), b.AddonIDs...)
+	copied.Currencies = append([]types.PriceBookCurrency(nil), b.Currencies...)
+	copied.Overrides = append([]types.PriceBookPriceOverride(nil), b.Overrides...)
+	copied.Metadata = lo.Assign(map[string]string{}, b.Metadata)
+	return &copied
+}
+
+func (s *InMemoryPriceBookStore) Create(ctx context.Context, b *pricebook.PriceBook) error {
+	if b == nil {
+		return ierr.NewError("price book cannot be nil").
+			WithHint("Price book cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set environment ID from context if not already set
+	if b.EnvironmentID == "" {
+		b.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+	b.BaseCurrency = strings.ToLower(b.BaseCurrency)
+
+	return s.InMemoryStore.Create(ctx, b.ID, copyPriceBook(b))
+}
+
+func (s *InMemoryPriceBookStore) Get(ctx context.Context, id string) (*pricebook.PriceBook, error) {
+	b, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.NewError("price book not found").
+			WithHint("Price book not found").
+			WithReportableDetails(map[string]interface{}{
+				"id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return copyPriceBook(b), nil
+}
+
+func (s *InMemoryPriceBookStore) Update(ctx context.Context, b *pricebook.PriceBook) error {
+	if b == nil {
+		return ierr.NewError("price book cannot be nil").
+			WithHint("Price book cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+	return s.InMemoryStore.Update(ctx, b.ID, copyPriceBook(b))
+}
+
+func (s *InMemoryPriceBookStore) Delete(ctx context.Context, id string) error {
+	b, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	b.Status = types.StatusArchived
+	return s.InMemoryStore.Update(ctx, b.ID, b)
+}
+
+func (s *InMemoryPriceBookStore) List(ctx context.Context, filter *types.PriceBookFilter) ([]*pricebook.PriceBook, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, priceBookFilterFn, priceBookSortFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(items, func(b *pricebook.PriceBook, _ int) *pricebook.PriceBook {
+		return copyPriceBook(b)
+	}), nil
+}
+
+func (s *InMemoryPriceBookStore) Count(ctx context.Context, filter *types.PriceBookFilter) (int, error) {
+	return s.InMemoryStore.Count(ctx, filter, priceBookFilterFn)
+}
+
+// priceBookFilterFn implements filtering logic for price books
+func priceBookFilterFn(ctx context.Context, b *pricebook.PriceBook, filter interface{}) bool {
+	f, ok := filter.(*types.PriceBookFilter)
+	if !ok {
+		return false
+	}
+
+	// Apply tenant filter
+	tenantID := types.GetTenantID(ctx)
+	if tenantID != "" && b.TenantID != tenantID {
+		return false
+	}
+
+	// Apply environment filter
+	if !CheckEnvironmentFilter(ctx, b.EnvironmentID) {
+		return false
+	}
+
+	// Apply status filter
+	if f.QueryFilter != nil && f.QueryFilter.Status != nil {
+		if b.Status != *f.QueryFilter.Status {
+			return false
+		}
+	} else if b.Status == types.StatusDeleted {
+		return false
+	}
+
+	if len(f.PriceBookIDs) > 0 && !lo.Contains(f.PriceBookIDs, b.ID) {
+		return false
+	}
+
+	if f.BaseCurrency != "" && !strings.EqualFold(b.BaseCurrency, f.BaseCurrency) {
+		return false
+	}
+
+	return true
+}
+
+// priceBookSortFn implements sorting logic for price books
+func priceBookSortFn(i, j *pricebook.PriceBook) bool {
+	// Default sort by created_at desc
+	return i.CreatedAt.After(j.CreatedAt)
+}
@@ -0,0 +1,190 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/domain/promotion_code"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryPromotionCodeStore implements promotion_code.Repository
+type InMemoryPromotionCodeStore struct {
+	*InMemoryStore[*promotion_code.PromotionCode]
+}
+
+// NewInMemoryPromotionCodeStore creates a new in-memory promotion code store
+func NewInMemoryPromotionCodeStore() *InMemoryPromotionCodeStore {
+	return &InMemoryPromotionCodeStore{
+		InMemoryStore: NewInMemoryStore[*promotion_code.PromotionCode](),
+	}
+}
+
+// Helper to copy promotion code
+func copyPromotionCode(p *promotion_code.PromotionCode) *promotion_code.PromotionCode {
+	if p == nil {
+		return nil
+	}
+
+	copied := *p
+	copied.Metadata = lo.Assign(map[string]string{}, p.Metadata)
+	return &copied
+}
+
+func (s *InMemoryPromotionCodeStore) Create(ctx context.Context, p *promotion_code.PromotionCode) error {
+	if p == nil {
+		return ierr.NewError("promotion code cannot be nil").
+			WithHint("Promotion code cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set environment ID from context if not already set
+	if p.EnvironmentID == "" {
+		p.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+	p.Code = promotion_code.NormalizeCode(p.Code)
+
+	if existing, err := s.GetByCode(ctx, p.Code); err == nil && existing != nil {
+		return ierr.NewError("promotion code already exists").
+			WithHint("A promotion code with this code already exists").
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	return s.InMemoryStore.Create(ctx, p.ID, copyPromotionCode(p))
+}
+
+func (s *InMemoryPromotionCodeStore) Get(ctx context.Context, id string) (*promotion_code.PromotionCode, error) {
+	p, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.NewError("promotion code not found").
+			WithHint("Promotion code not found").
+			WithReportableDetails(map[string]interface{}{
+				"id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return copyPromotionCode(p), nil
+}
+
+func (s *InMemoryPromotionCodeStore) GetByCode(ctx context.Context, code string) (*promotion_code.PromotionCode, error) {
+	filter := types.NewNoLimitPromotionCodeFilter()
+	filter.Codes = []string{code}
+	filter.QueryFilter.Status = lo.ToPtr(types.StatusPublished)
+
+	items, err := s.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, ierr.NewError("promotion code not found").
+			WithHintf("Promotion code %s was not found", code).
+			Mark(ierr.ErrNotFound)
+	}
+	return items[0], nil
+}
+
+func (s *InMemoryPromotionCodeStore) Update(ctx context.Context, p *promotion_code.PromotionCode) error {
+	if p == nil {
+		return ierr.NewError("promotion code cannot be nil").
+			WithHint("Promotion code cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+
+	return s.InMemoryStore.Update(ctx, p.ID, copyPromotionCode(p))
+}
+
+func (s *InMemoryPromotionCodeStore) Delete(ctx context.Context, id string) error {
+	p, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	p.Status = types.StatusArchived
+	return s.Update(ctx, p)
+}
+
+func (s *InMemoryPromotionCodeStore) List(ctx context.Context, filter *types.PromotionCodeFilter) ([]*promotion_code.PromotionCode, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, promotionCodeFilterFn, promotionCodeSortFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(items, func(p *promotion_code.PromotionCode, _ int) *promotion_code.PromotionCode {
+		return copyPromotionCode(p)
+	}), nil
+}
+
+func (s *InMemoryPromotionCodeStore) Count(ctx context.Context, filter *types.PromotionCodeFilter) (int, error) {
+	return s.InMemoryStore.Count(ctx, filter, promotionCodeFilterFn)
+}
+
+func (s *InMemoryPromotionCodeStore) IncrementRedemptions(ctx context.Context, id string) error {
+	p, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if p.IsExhausted() {
+		return ierr.NewError("promotion code has reached maximum redemptions").
+			WithHint("Promotion code has reached maximum redemptions").
+			Mark(ierr.ErrValidation)
+	}
+
+	p.TimesRedeemed++
+	return s.Update(ctx, p)
+}
+
+// promotionCodeFilterFn implements filtering logic for promotion codes
+func promotionCodeFilterFn(ctx context.Context, p *promotion_code.PromotionCode, filter interface{}) bool {
+	f, ok := filter.(*types.PromotionCodeFilter)
+	if !ok {
+		return false
+	}
+
+	// Apply tenant filter
+	tenantID := types.GetTenantID(ctx)
+	if tenantID != "" && p.TenantID != tenantID {
+		return false
+	}
+
+	// Apply environment filter
+	if !CheckEnvironmentFilter(ctx, p.EnvironmentID) {
+		return false
+	}
+
+	// Apply status filter
+	if f.QueryFilter != nil && f.QueryFilter.Status != nil {
+		if p.Status != *f.QueryFilter.Status {
+			return false
+		}
+	} else if p.Status == types.StatusDeleted {
+		return false
+	}
+
+	if len(f.PromotionCodeIDs) > 0 && !lo.Contains(f.PromotionCodeIDs, p.ID) {
+		return false
+	}
+
+	if len(f.CouponIDs) > 0 && !lo.Contains(f.CouponIDs, p.CouponID) {
+		return false
+	}
+
+	if len(f.Codes) > 0 && !lo.ContainsBy(f.Codes, func(code string) bool {
+		return promotion_code.NormalizeCode(code) == p.Code
+	}) {
+		return false
+	}
+
+	if f.Active != nil && p.Active != *f.Active {
+		return false
+	}
+
+	return true
+}
+
+// promotionCodeSortFn implements sorting logic for promotion codes
+func promotionCodeSortFn(i, j *promotion_code.PromotionCode) bool {
+	// Default sort by created_at desc
+	return i.CreatedAt.After(j.CreatedAt)
+}
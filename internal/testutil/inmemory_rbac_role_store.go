@@ -0,0 +1,123 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/domain/rbacrole"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryRBACRoleStore implements rbacrole.Repository
+type InMemoryRBACRoleStore struct {
+	*InMemoryStore[*rbacrole.RBACRole]
+}
+
+// NewInMemoryRBACRoleStore creates a new in-memory RBAC role store
+func NewInMemoryRBACRoleStore() *InMemoryRBACRoleStore {
+	return &InMemoryRBACRoleStore{
+		InMemoryStore: NewInMemoryStore[*rbacrole.RBACRole](),
+	}
+}
+
+// Helper to copy RBAC role
+func copyRBACRole(r *rbacrole.RBACRole) *rbacrole.RBACRole {
+	if r == nil {
+		return nil
+	}
+
+	copied := *r
+	copied.Permissions = make(map[string][]string, len(r.Permissions))
+	for entity, actions := range r.Permissions {
+		copied.Permissions[entity] = append([]string(nil), actions...)
+	}
+	copied.EnvironmentIDs = append([]string(nil), r.EnvironmentIDs...)
+	return &copied
+}
+
+// checkNameAvailable mirrors the unique index on the names of published roles
+func (s *InMemoryRBACRoleStore) checkNameAvailable(ctx context.Context, r *rbacrole.RBACRole) error {
+	roles, err := s.ListByTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	if lo.ContainsBy(roles, func(existing *rbacrole.RBACRole) bool {
+		return existing.ID != r.ID && existing.Name == r.Name
+	}) {
+		return ierr.NewErrorf("role %s already exists", r.Name).
+			WithHintf("A role named %s already exists", r.Name).
+			Mark(ierr.ErrAlreadyExists)
+	}
+	return nil
+}
+
+func (s *InMemoryRBACRoleStore) Create(ctx context.Context, r *rbacrole.RBACRole) error {
+	if r == nil {
+		return ierr.NewError("role cannot be nil").
+			WithHint("Role cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+	if err := s.checkNameAvailable(ctx, r); err != nil {
+		return err
+	}
+	return s.InMemoryStore.Create(ctx, r.ID, copyRBACRole(r))
+}
+
+func (s *InMemoryRBACRoleStore) Get(ctx context.Context, id string) (*rbacrole.RBACRole, error) {
+	r, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || r.TenantID != types.GetTenantID(ctx) || r.Status != types.StatusPublished {
+		return nil, ierr.NewError("role not found").
+			WithHint("Role not found").
+			WithReportableDetails(map[string]interface{}{
+				"id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return copyRBACRole(r), nil
+}
+
+func (s *InMemoryRBACRoleStore) Update(ctx context.Context, r *rbacrole.RBACRole) error {
+	if r == nil {
+		return ierr.NewError("role cannot be nil").
+			WithHint("Role cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+	if err := s.checkNameAvailable(ctx, r); err != nil {
+		return err
+	}
+	return s.InMemoryStore.Update(ctx, r.ID, copyRBACRole(r))
+}
+
+func (s *InMemoryRBACRoleStore) Delete(ctx context.Context, id string) error {
+	r, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	r.Status = types.StatusArchived
+	return s.InMemoryStore.Update(ctx, r.ID, r)
+}
+
+func (s *InMemoryRBACRoleStore) ListByTenant(ctx context.Context) ([]*rbacrole.RBACRole, error) {
+	items, err := s.InMemoryStore.List(ctx, nil, rbacRoleFilterFn, rbacRoleSortFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(items, func(r *rbacrole.RBACRole, _ int) *rbacrole.RBACRole {
+		return copyRBACRole(r)
+	}), nil
+}
+
+// rbacRoleFilterFn keeps the published roles of the tenant in the context
+func rbacRoleFilterFn(ctx context.Context, r *rbacrole.RBACRole, _ interface{}) bool {
+	return r.TenantID == types.GetTenantID(ctx) && r.Status == types.StatusPublished
+}
+
+// rbacRoleSortFn implements sorting logic for RBAC roles
+func rbacRoleSortFn(i, j *rbacrole.RBACRole) bool {
+	// Default sort by created_at desc
+	return i.CreatedAt.After(j.CreatedAt)
+}
@@ -156,6 +156,30 @@ func (s *InMemorySecretStore) UpdateLastUsed(ctx context.Context, id string) err
 	return s.InMemoryStore.Update(ctx, id, secret)
 }
 
+func (s *InMemorySecretStore) Update(ctx context.Context, secret *secret.Secret) error {
+	existing, err := s.Get(ctx, secret.ID)
+	if err != nil {
+		return err
+	}
+
+	existing.Name = secret.Name
+	existing.Scopes = secret.Scopes
+	existing.AllowedIPs = secret.AllowedIPs
+	existing.RateLimitPerMinute = secret.RateLimitPerMinute
+	existing.ExpiresAt = secret.ExpiresAt
+	return s.InMemoryStore.Update(ctx, secret.ID, existing)
+}
+
+func (s *InMemorySecretStore) UpdateRoles(ctx context.Context, id string, roles []string) error {
+	secret, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	secret.Roles = roles
+	return s.InMemoryStore.Update(ctx, id, secret)
+}
+
 // Clear clears the secret store
 func (s *InMemorySecretStore) Clear() {
 	s.InMemoryStore.Clear()
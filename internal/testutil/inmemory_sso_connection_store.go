@@ -0,0 +1,157 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/domain/sso"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemorySSOConnectionStore implements sso.Repository
+type InMemorySSOConnectionStore struct {
+	*InMemoryStore[*sso.Connection]
+}
+
+// NewInMemorySSOConnectionStore creates a new in-memory SSO connection store
+func NewInMemorySSOConnectionStore() *InMemorySSOConnectionStore {
+	return &InMemorySSOConnectionStore{
+		InMemoryStore: NewInMemoryStore[*sso.Connection](),
+	}
+}
+
+// Helper to copy SSO connection
+func copySSOConnection(c *sso.Connection) *sso.Connection {
+	if c == nil {
+		return nil
+	}
+
+	copied := *c
+	copied.Domains = append([]string(nil), c.Domains...)
+	copied.VerifiedDomains = append([]string(nil), c.VerifiedDomains...)
+	copied.Scopes = append([]string(nil), c.Scopes...)
+	copied.DefaultRoles = append([]string(nil), c.DefaultRoles...)
+	copied.RoleMappings = make(map[string][]string, len(c.RoleMappings))
+	for group, roles := range c.RoleMappings {
+		copied.RoleMappings[group] = append([]string(nil), roles...)
+	}
+	return &copied
+}
+
+// checkNameAvailable mirrors the unique index on the names of published connections
+func (s *InMemorySSOConnectionStore) checkNameAvailable(ctx context.Context, c *sso.Connection) error {
+	connections, err := s.ListByTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	if lo.ContainsBy(connections, func(existing *sso.Connection) bool {
+		return existing.ID != c.ID && existing.Name == c.Name
+	}) {
+		return ierr.NewErrorf("sso connection %s already exists", c.Name).
+			WithHintf("An SSO connection named %s already exists", c.Name).
+			Mark(ierr.ErrAlreadyExists)
+	}
+	return nil
+}
+
+func (s *InMemorySSOConnectionStore) Create(ctx context.Context, c *sso.Connection) error {
+	if c == nil {
+		return ierr.NewError("sso connection cannot be nil").
+			WithHint("SSO connection cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+	if err := s.checkNameAvailable(ctx, c); err != nil {
+		return err
+	}
+	return s.InMemoryStore.Create(ctx, c.ID, copySSOConnection(c))
+}
+
+func (s *InMemorySSOConnectionStore) Get(ctx context.Context, id string) (*sso.Connection, error) {
+	c, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c.TenantID != types.GetTenantID(ctx) {
+		return nil, ssoConnectionNotFound(id)
+	}
+	return c, nil
+}
+
+func (s *InMemorySSOConnectionStore) GetByID(ctx context.Context, id string) (*sso.Connection, error) {
+	c, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil || c.Status != types.StatusPublished {
+		return nil, ssoConnectionNotFound(id)
+	}
+	return copySSOConnection(c), nil
+}
+
+func (s *InMemorySSOConnectionStore) GetByDomain(ctx context.Context, domain string) (*sso.Connection, error) {
+	items, err := s.InMemoryStore.List(ctx, nil, func(_ context.Context, c *sso.Connection, _ interface{}) bool {
+		return c.Status == types.StatusPublished && lo.Contains(c.VerifiedDomains, domain)
+	}, func(i, j *sso.Connection) bool {
+		return i.CreatedAt.Before(j.CreatedAt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, ierr.NewError("sso connection not found").
+			WithHintf("No SSO connection is configured for %s", domain).
+			Mark(ierr.ErrNotFound)
+	}
+	return copySSOConnection(items[0]), nil
+}
+
+func (s *InMemorySSOConnectionStore) Update(ctx context.Context, c *sso.Connection) error {
+	if c == nil {
+		return ierr.NewError("sso connection cannot be nil").
+			WithHint("SSO connection cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+	if err := s.checkNameAvailable(ctx, c); err != nil {
+		return err
+	}
+	return s.InMemoryStore.Update(ctx, c.ID, copySSOConnection(c))
+}
+
+func (s *InMemorySSOConnectionStore) Delete(ctx context.Context, id string) error {
+	c, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	c.Status = types.StatusArchived
+	return s.InMemoryStore.Update(ctx, c.ID, c)
+}
+
+func (s *InMemorySSOConnectionStore) ListByTenant(ctx context.Context) ([]*sso.Connection, error) {
+	items, err := s.InMemoryStore.List(ctx, nil, ssoConnectionFilterFn, ssoConnectionSortFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(items, func(c *sso.Connection, _ int) *sso.Connection {
+		return copySSOConnection(c)
+	}), nil
+}
+
+func ssoConnectionNotFound(id string) error {
+	return ierr.NewError("sso connection not found").
+		WithHint("SSO connection not found").
+		WithReportableDetails(map[string]interface{}{
+			"id": id,
+		}).
+		Mark(ierr.ErrNotFound)
+}
+
+// ssoConnectionFilterFn keeps the published connections of the tenant in the context
+func ssoConnectionFilterFn(ctx context.Context, c *sso.Connection, _ interface{}) bool {
+	return c.TenantID == types.GetTenantID(ctx) && c.Status == types.StatusPublished
+}
+
+// ssoConnectionSortFn sorts connections oldest first
+func ssoConnectionSortFn(i, j *sso.Connection) bool {
+	return i.CreatedAt.Before(j.CreatedAt)
+}
@@ -0,0 +1,44 @@
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/flexprice/flexprice/internal/auth"
+)
+
+// InMemorySSOLoginStateStore keeps the state of SSO sign ins in memory. A state is taken once.
+type InMemorySSOLoginStateStore struct {
+	mu     sync.Mutex
+	states map[string]auth.SSOLoginState
+}
+
+// NewInMemorySSOLoginStateStore creates a new in-memory SSO login state store
+func NewInMemorySSOLoginStateStore() *InMemorySSOLoginStateStore {
+	return &InMemorySSOLoginStateStore{
+		states: make(map[string]auth.SSOLoginState),
+	}
+}
+
+func (s *InMemorySSOLoginStateStore) Save(_ context.Context, state *auth.SSOLoginState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := *state
+	// The binding only lives in the browser
+	stored.Binding = ""
+	s.states[state.ID] = stored
+	return nil
+}
+
+func (s *InMemorySSOLoginStateStore) Take(_ context.Context, id string) (*auth.SSOLoginState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.states[id]
+	if !ok {
+		return nil, nil
+	}
+	delete(s.states, id)
+	return &state, nil
+}
@@ -0,0 +1,134 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemorySubscriptionSeatChangeStore implements subscription.SubscriptionSeatChangeRepository
+type InMemorySubscriptionSeatChangeStore struct {
+	*InMemoryStore[*subscription.SubscriptionSeatChange]
+}
+
+// NewInMemorySubscriptionSeatChangeStore creates a new in-memory seat change store
+func NewInMemorySubscriptionSeatChangeStore() *InMemorySubscriptionSeatChangeStore {
+	return &InMemorySubscriptionSeatChangeStore{
+		InMemoryStore: NewInMemoryStore[*subscription.SubscriptionSeatChange](),
+	}
+}
+
+// Helper to copy seat change
+func copySubscriptionSeatChange(c *subscription.SubscriptionSeatChange) *subscription.SubscriptionSeatChange {
+	if c == nil {
+		return nil
+	}
+
+	copied := *c
+	if c.InvoiceID != nil {
+		copied.InvoiceID = lo.ToPtr(*c.InvoiceID)
+	}
+	if c.WalletTransactionID != nil {
+		copied.WalletTransactionID = lo.ToPtr(*c.WalletTransactionID)
+	}
+	return &copied
+}
+
+func (s *InMemorySubscriptionSeatChangeStore) Create(ctx context.Context, c *subscription.SubscriptionSeatChange) error {
+	if c == nil {
+		return ierr.NewError("subscription seat change cannot be nil").
+			WithHint("Subscription seat change cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set environment ID from context if not already set
+	if c.EnvironmentID == "" {
+		c.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	return s.InMemoryStore.Create(ctx, c.ID, copySubscriptionSeatChange(c))
+}
+
+func (s *InMemorySubscriptionSeatChangeStore) Get(ctx context.Context, id string) (*subscription.SubscriptionSeatChange, error) {
+	c, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.NewError("subscription seat change not found").
+			WithHint("Subscription seat change not found").
+			WithReportableDetails(map[string]interface{}{
+				"id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return copySubscriptionSeatChange(c), nil
+}
+
+func (s *InMemorySubscriptionSeatChangeStore) SetInvoiceID(ctx context.Context, id string, invoiceID string) error {
+	c, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	c.InvoiceID = lo.ToPtr(invoiceID)
+	c.UpdatedAt = time.Now().UTC()
+	return s.InMemoryStore.Update(ctx, c.ID, c)
+}
+
+func (s *InMemorySubscriptionSeatChangeStore) SetWalletTransactionID(ctx context.Context, id string, walletTransactionID string) error {
+	c, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	c.WalletTransactionID = lo.ToPtr(walletTransactionID)
+	c.UpdatedAt = time.Now().UTC()
+	return s.InMemoryStore.Update(ctx, c.ID, c)
+}
+
+func (s *InMemorySubscriptionSeatChangeStore) List(ctx context.Context, filter *types.SubscriptionSeatChangeFilter) ([]*subscription.SubscriptionSeatChange, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, subscriptionSeatChangeFilterFn, subscriptionSeatChangeSortFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(items, func(c *subscription.SubscriptionSeatChange, _ int) *subscription.SubscriptionSeatChange {
+		return copySubscriptionSeatChange(c)
+	}), nil
+}
+
+func (s *InMemorySubscriptionSeatChangeStore) Count(ctx context.Context, filter *types.SubscriptionSeatChangeFilter) (int, error) {
+	return s.InMemoryStore.Count(ctx, filter, subscriptionSeatChangeFilterFn)
+}
+
+// subscriptionSeatChangeFilterFn implements filtering logic for seat changes
+func subscriptionSeatChangeFilterFn(ctx context.Context, c *subscription.SubscriptionSeatChange, filter interface{}) bool {
+	f, ok := filter.(*types.SubscriptionSeatChangeFilter)
+	if !ok {
+		return false
+	}
+
+	if !CheckTenantFilter(ctx, c.TenantID) || !CheckEnvironmentFilter(ctx, c.EnvironmentID) {
+		return false
+	}
+
+	if c.Status == types.StatusDeleted {
+		return false
+	}
+
+	if len(f.SubscriptionIDs) > 0 && !lo.Contains(f.SubscriptionIDs, c.SubscriptionID) {
+		return false
+	}
+
+	if f.PendingTrueUp && !c.IsPendingTrueUp() {
+		return false
+	}
+
+	return true
+}
+
+// subscriptionSeatChangeSortFn implements sorting logic for seat changes
+func subscriptionSeatChangeSortFn(i, j *subscription.SubscriptionSeatChange) bool {
+	// Default sort by created_at desc
+	return i.CreatedAt.After(j.CreatedAt)
+}
@@ -56,6 +56,11 @@ func subscriptionFilterFn(ctx context.Context, sub *subscription.Subscription, f
 		return false
 	}
 
+	// Filter by invoicing customer IDs
+	if len(f.InvoicingCustomerIDs) > 0 && !lo.Contains(f.InvoicingCustomerIDs, sub.GetInvoicingCustomerID()) {
+		return false
+	}
+
 	// Filter by plan ID
 	if f.PlanID != "" && sub.PlanID != f.PlanID {
 		return false
@@ -73,6 +78,16 @@ func subscriptionFilterFn(ctx context.Context, sub *subscription.Subscription, f
 		return false
 	}
 
+	// Filter by seat source
+	if len(f.SeatSources) > 0 && (sub.SeatConfig == nil || !lo.Contains(f.SeatSources, sub.SeatConfig.Source)) {
+		return false
+	}
+
+	// Filter by lookup key
+	if len(f.LookupKeys) > 0 && !lo.Contains(f.LookupKeys, sub.LookupKey) {
+		return false
+	}
+
 	// Filter by subscription status
 	if len(f.SubscriptionStatus) > 0 && !lo.Contains(f.SubscriptionStatus, sub.SubscriptionStatus) {
 		return false
@@ -309,6 +324,7 @@ func (s *InMemorySubscriptionStore) ListAll(ctx context.Context, filter *types.S
 		PlanID:                  filter.PlanID,
 		ParentSubscriptionIDs:   filter.ParentSubscriptionIDs,
 		SubscriptionTypes:       filter.SubscriptionTypes,
+		LookupKeys:              filter.LookupKeys,
 		SubscriptionStatus:      filter.SubscriptionStatus,
 		BillingCadence:          filter.BillingCadence,
 		BillingPeriod:           filter.BillingPeriod,
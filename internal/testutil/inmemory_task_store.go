@@ -39,6 +39,7 @@ func copyTask(t *task.Task) *task.Task {
 		SuccessfulRecords: t.SuccessfulRecords,
 		FailedRecords:     t.FailedRecords,
 		ErrorSummary:      t.ErrorSummary,
+		ErrorReport:       t.ErrorReport,
 		Metadata:          t.Metadata,
 		StartedAt:         t.StartedAt,
 		CompletedAt:       t.CompletedAt,
@@ -89,6 +89,34 @@ func (r *InMemoryUserStore) ListByFilter(ctx context.Context, filter *types.User
 	return result, int64(len(result)), nil
 }
 
+// UpdateRoles replaces the roles of a user in the in-memory store
+func (r *InMemoryUserStore) UpdateRoles(ctx context.Context, id string, roles []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, u := range r.users {
+		if u.ID == id && u.TenantID == types.GetTenantID(ctx) {
+			u.Roles = roles
+			return nil
+		}
+	}
+	return ierr.NewError("user not found").Mark(ierr.ErrNotFound)
+}
+
+// Delete removes a user of the tenant in the context from the in-memory store
+func (r *InMemoryUserStore) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for email, u := range r.users {
+		if u.ID == id && u.TenantID == types.GetTenantID(ctx) {
+			delete(r.users, email)
+			return nil
+		}
+	}
+	return ierr.NewError("user not found").Mark(ierr.ErrNotFound)
+}
+
 func (s *InMemoryUserStore) Clear() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -144,6 +144,10 @@ func transactionFilterFn(ctx context.Context, t *wallet.Transaction, filter inte
 		return false
 	}
 
+	if f.FXConverted != nil && (t.FXRate != nil) != *f.FXConverted {
+		return false
+	}
+
 	return true
 }
 
@@ -740,7 +744,7 @@ func (s *InMemoryWalletStore) GetCreditsAvailableBreakdown(ctx context.Context,
 		switch tx.TransactionReason {
 		case types.TransactionReasonPurchasedCreditInvoiced, types.TransactionReasonPurchasedCreditDirect:
 			breakdown.Purchased = breakdown.Purchased.Add(tx.CreditsAvailable)
-		case types.TransactionReasonFreeCredit, types.TransactionReasonSubscriptionCredit:
+		case types.TransactionReasonFreeCredit, types.TransactionReasonSubscriptionCredit, types.TransactionReasonCreditRollover:
 			breakdown.Free = breakdown.Free.Add(tx.CreditsAvailable)
 		}
 	}
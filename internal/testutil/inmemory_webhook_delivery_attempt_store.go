@@ -0,0 +1,129 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/domain/webhookdeliveryattempt"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryWebhookDeliveryAttemptStore implements webhookdeliveryattempt.Repository
+type InMemoryWebhookDeliveryAttemptStore struct {
+	*InMemoryStore[*webhookdeliveryattempt.WebhookDeliveryAttempt]
+}
+
+// NewInMemoryWebhookDeliveryAttemptStore creates a new in-memory webhook delivery attempt store
+func NewInMemoryWebhookDeliveryAttemptStore() *InMemoryWebhookDeliveryAttemptStore {
+	return &InMemoryWebhookDeliveryAttemptStore{
+		InMemoryStore: NewInMemoryStore[*webhookdeliveryattempt.WebhookDeliveryAttempt](),
+	}
+}
+
+// Helper to copy webhook delivery attempt
+func copyWebhookDeliveryAttempt(a *webhookdeliveryattempt.WebhookDeliveryAttempt) *webhookdeliveryattempt.WebhookDeliveryAttempt {
+	if a == nil {
+		return nil
+	}
+
+	copied := *a
+	copied.RequestHeaders = lo.Assign(map[string]string{}, a.RequestHeaders)
+	return &copied
+}
+
+func (s *InMemoryWebhookDeliveryAttemptStore) Create(ctx context.Context, a *webhookdeliveryattempt.WebhookDeliveryAttempt) error {
+	if a == nil {
+		return ierr.NewError("webhook delivery attempt cannot be nil").
+			WithHint("Webhook delivery attempt cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set environment ID from context if not already set
+	if a.EnvironmentID == "" {
+		a.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	return s.InMemoryStore.Create(ctx, a.ID, copyWebhookDeliveryAttempt(a))
+}
+
+func (s *InMemoryWebhookDeliveryAttemptStore) Get(ctx context.Context, id string) (*webhookdeliveryattempt.WebhookDeliveryAttempt, error) {
+	a, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.NewError("webhook delivery attempt not found").
+			WithHint("Webhook delivery attempt not found").
+			WithReportableDetails(map[string]interface{}{
+				"id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return copyWebhookDeliveryAttempt(a), nil
+}
+
+func (s *InMemoryWebhookDeliveryAttemptStore) List(ctx context.Context, filter *types.WebhookDeliveryAttemptFilter) ([]*webhookdeliveryattempt.WebhookDeliveryAttempt, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, webhookDeliveryAttemptFilterFn, webhookDeliveryAttemptSortFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(items, func(a *webhookdeliveryattempt.WebhookDeliveryAttempt, _ int) *webhookdeliveryattempt.WebhookDeliveryAttempt {
+		return copyWebhookDeliveryAttempt(a)
+	}), nil
+}
+
+func (s *InMemoryWebhookDeliveryAttemptStore) Count(ctx context.Context, filter *types.WebhookDeliveryAttemptFilter) (int, error) {
+	return s.InMemoryStore.Count(ctx, filter, webhookDeliveryAttemptFilterFn)
+}
+
+// webhookDeliveryAttemptFilterFn implements filtering logic for webhook delivery attempts
+func webhookDeliveryAttemptFilterFn(ctx context.Context, a *webhookdeliveryattempt.WebhookDeliveryAttempt, filter interface{}) bool {
+	f, ok := filter.(*types.WebhookDeliveryAttemptFilter)
+	if !ok {
+		return false
+	}
+
+	// Apply tenant filter
+	tenantID := types.GetTenantID(ctx)
+	if tenantID != "" && a.TenantID != tenantID {
+		return false
+	}
+
+	// Apply environment filter
+	if !CheckEnvironmentFilter(ctx, a.EnvironmentID) {
+		return false
+	}
+
+	if len(f.SystemEventIDs) > 0 && !lo.Contains(f.SystemEventIDs, a.SystemEventID) {
+		return false
+	}
+
+	if len(f.WebhookEndpointIDs) > 0 && !lo.Contains(f.WebhookEndpointIDs, a.WebhookEndpointID) {
+		return false
+	}
+
+	if len(f.EventNames) > 0 && !lo.Contains(f.EventNames, a.EventName) {
+		return false
+	}
+
+	if f.Success != nil && a.Success != *f.Success {
+		return false
+	}
+
+	// Filter by time range
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil && a.CreatedAt.Before(*f.StartTime) {
+			return false
+		}
+		if f.EndTime != nil && a.CreatedAt.After(*f.EndTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// webhookDeliveryAttemptSortFn implements sorting logic for webhook delivery attempts
+func webhookDeliveryAttemptSortFn(i, j *webhookdeliveryattempt.WebhookDeliveryAttempt) bool {
+	// Default sort by created_at desc
+	return i.CreatedAt.After(j.CreatedAt)
+}
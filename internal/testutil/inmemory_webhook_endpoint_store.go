@@ -0,0 +1,171 @@
+package testutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/webhookendpoint"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryWebhookEndpointStore implements webhookendpoint.Repository
+type InMemoryWebhookEndpointStore struct {
+	*InMemoryStore[*webhookendpoint.WebhookEndpoint]
+}
+
+// NewInMemoryWebhookEndpointStore creates a new in-memory webhook endpoint store
+func NewInMemoryWebhookEndpointStore() *InMemoryWebhookEndpointStore {
+	return &InMemoryWebhookEndpointStore{
+		InMemoryStore: NewInMemoryStore[*webhookendpoint.WebhookEndpoint](),
+	}
+}
+
+// Helper to copy webhook endpoint
+func copyWebhookEndpoint(e *webhookendpoint.WebhookEndpoint) *webhookendpoint.WebhookEndpoint {
+	if e == nil {
+		return nil
+	}
+
+	copied := *e
+	copied.EventTypes = append([]string(nil), e.EventTypes...)
+	copied.Headers = lo.Assign(map[string]string{}, e.Headers)
+	copied.Metadata = lo.Assign(map[string]string{}, e.Metadata)
+	return &copied
+}
+
+func (s *InMemoryWebhookEndpointStore) Create(ctx context.Context, e *webhookendpoint.WebhookEndpoint) error {
+	if e == nil {
+		return ierr.NewError("webhook endpoint cannot be nil").
+			WithHint("Webhook endpoint cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+
+	// Set environment ID from context if not already set
+	if e.EnvironmentID == "" {
+		e.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	return s.InMemoryStore.Create(ctx, e.ID, copyWebhookEndpoint(e))
+}
+
+func (s *InMemoryWebhookEndpointStore) Get(ctx context.Context, id string) (*webhookendpoint.WebhookEndpoint, error) {
+	e, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.NewError("webhook endpoint not found").
+			WithHint("Webhook endpoint not found").
+			WithReportableDetails(map[string]interface{}{
+				"id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return copyWebhookEndpoint(e), nil
+}
+
+func (s *InMemoryWebhookEndpointStore) Update(ctx context.Context, e *webhookendpoint.WebhookEndpoint) error {
+	if e == nil {
+		return ierr.NewError("webhook endpoint cannot be nil").
+			WithHint("Webhook endpoint cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+	return s.InMemoryStore.Update(ctx, e.ID, copyWebhookEndpoint(e))
+}
+
+func (s *InMemoryWebhookEndpointStore) Delete(ctx context.Context, id string) error {
+	e, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	e.Status = types.StatusArchived
+	return s.InMemoryStore.Update(ctx, e.ID, e)
+}
+
+func (s *InMemoryWebhookEndpointStore) List(ctx context.Context, filter *types.WebhookEndpointFilter) ([]*webhookendpoint.WebhookEndpoint, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, webhookEndpointFilterFn, webhookEndpointSortFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(items, func(e *webhookendpoint.WebhookEndpoint, _ int) *webhookendpoint.WebhookEndpoint {
+		return copyWebhookEndpoint(e)
+	}), nil
+}
+
+func (s *InMemoryWebhookEndpointStore) Count(ctx context.Context, filter *types.WebhookEndpointFilter) (int, error) {
+	return s.InMemoryStore.Count(ctx, filter, webhookEndpointFilterFn)
+}
+
+func (s *InMemoryWebhookEndpointStore) RecordDeliverySuccess(ctx context.Context, id string, at time.Time) error {
+	e, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	e.ConsecutiveFailures = 0
+	e.FailingSince = nil
+	e.LastSuccessAt = &at
+	return s.InMemoryStore.Update(ctx, e.ID, e)
+}
+
+func (s *InMemoryWebhookEndpointStore) RecordDeliveryFailure(ctx context.Context, id string, at time.Time) (*webhookendpoint.WebhookEndpoint, error) {
+	e, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	e.ConsecutiveFailures++
+	e.LastFailureAt = &at
+	if e.FailingSince == nil {
+		e.FailingSince = &at
+	}
+	if err := s.InMemoryStore.Update(ctx, e.ID, e); err != nil {
+		return nil, err
+	}
+	return copyWebhookEndpoint(e), nil
+}
+
+// webhookEndpointFilterFn implements filtering logic for webhook endpoints
+func webhookEndpointFilterFn(ctx context.Context, e *webhookendpoint.WebhookEndpoint, filter interface{}) bool {
+	f, ok := filter.(*types.WebhookEndpointFilter)
+	if !ok {
+		return false
+	}
+
+	// Apply tenant filter
+	tenantID := types.GetTenantID(ctx)
+	if tenantID != "" && e.TenantID != tenantID {
+		return false
+	}
+
+	// Apply environment filter
+	if !CheckEnvironmentFilter(ctx, e.EnvironmentID) {
+		return false
+	}
+
+	// Apply status filter
+	if f.QueryFilter != nil && f.QueryFilter.Status != nil {
+		if e.Status != *f.QueryFilter.Status {
+			return false
+		}
+	} else if e.Status == types.StatusDeleted {
+		return false
+	}
+
+	if len(f.WebhookEndpointIDs) > 0 && !lo.Contains(f.WebhookEndpointIDs, e.ID) {
+		return false
+	}
+
+	if f.Enabled != nil && e.Enabled != *f.Enabled {
+		return false
+	}
+
+	return true
+}
+
+// webhookEndpointSortFn implements sorting logic for webhook endpoints
+func webhookEndpointSortFn(i, j *webhookendpoint.WebhookEndpoint) bool {
+	// Default sort by created_at desc
+	return i.CreatedAt.After(j.CreatedAt)
+}
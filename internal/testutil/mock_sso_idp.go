@@ -0,0 +1,293 @@
+package testutil
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/coreos/go-oidc/v3/oidc/oidctest"
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/logger"
+)
+
+const mockOIDCKeyID = "mock-idp-key"
+
+// MockOIDCIdP is a local OpenID Connect provider supporting discovery, the authorization
+// code flow with PKCE and RS256 signed ID tokens
+type MockOIDCIdP struct {
+	Server       *httptest.Server
+	ClientID     string
+	ClientSecret string
+
+	key   *rsa.PrivateKey
+	mu    sync.Mutex
+	codes map[string]mockOIDCCode
+}
+
+type mockOIDCCode struct {
+	clientID      string
+	redirectURI   string
+	codeChallenge string
+	claims        map[string]interface{}
+}
+
+// NewMockOIDCIdP starts a mock OIDC provider. Close it when done.
+func NewMockOIDCIdP(clientID, clientSecret string) *MockOIDCIdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+
+	m := &MockOIDCIdP{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		key:          key,
+		codes:        make(map[string]mockOIDCCode),
+	}
+
+	discovery := &oidctest.Server{
+		PublicKeys: []oidctest.PublicKey{
+			{PublicKey: key.Public(), KeyID: mockOIDCKeyID, Algorithm: oidc.RS256},
+		},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", m.serveToken)
+	mux.Handle("/", discovery)
+
+	m.Server = httptest.NewServer(mux)
+	discovery.SetIssuer(m.Server.URL)
+	return m
+}
+
+// Issuer returns the issuer URL used for discovery
+func (m *MockOIDCIdP) Issuer() string {
+	return m.Server.URL
+}
+
+// Close stops the provider
+func (m *MockOIDCIdP) Close() {
+	m.Server.Close()
+}
+
+// Authorize stands in for a user signing in at the provider. It answers the authorization
+// URL with the callback URL carrying a code for an ID token with the claims.
+func (m *MockOIDCIdP) Authorize(authURL string, claims map[string]interface{}) (string, error) {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		return "", err
+	}
+	query := u.Query()
+	if query.Get("response_type") != "code" || query.Get("code_challenge_method") != "S256" {
+		return "", fmt.Errorf("expected the code flow with S256 PKCE, got %s", u.RawQuery)
+	}
+
+	idClaims := map[string]interface{}{"nonce": query.Get("nonce")}
+	for k, v := range claims {
+		idClaims[k] = v
+	}
+
+	code := rand.Text()
+	m.mu.Lock()
+	m.codes[code] = mockOIDCCode{
+		clientID:      query.Get("client_id"),
+		redirectURI:   query.Get("redirect_uri"),
+		codeChallenge: query.Get("code_challenge"),
+		claims:        idClaims,
+	}
+	m.mu.Unlock()
+
+	callback, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil {
+		return "", err
+	}
+	callbackQuery := callback.Query()
+	callbackQuery.Set("code", code)
+	callbackQuery.Set("state", query.Get("state"))
+	callback.RawQuery = callbackQuery.Encode()
+	return callback.String(), nil
+}
+
+func (m *MockOIDCIdP) serveToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != m.ClientID || clientSecret != m.ClientSecret {
+		writeOAuthError(w, http.StatusUnauthorized, "invalid_client")
+		return
+	}
+
+	m.mu.Lock()
+	code, found := m.codes[r.PostForm.Get("code")]
+	delete(m.codes, r.PostForm.Get("code"))
+	m.mu.Unlock()
+	if !found || code.clientID != clientID || code.redirectURI != r.PostForm.Get("redirect_uri") {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+
+	challenge := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(challenge[:]) != code.codeChallenge {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+
+	now := time.Now()
+	claims := map[string]interface{}{
+		"iss": m.Server.URL,
+		"aud": m.ClientID,
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+	}
+	for k, v := range code.claims {
+		claims[k] = v
+	}
+	rawClaims, _ := json.Marshal(claims)
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": rand.Text(),
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     oidctest.SignIDToken(m.key, mockOIDCKeyID, oidc.RS256, string(rawClaims)),
+	})
+}
+
+func writeOAuthError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": code})
+}
+
+// MockSAMLIdP is a local SAML 2.0 identity provider serving its metadata and answering
+// authentication requests with signed assertions
+type MockSAMLIdP struct {
+	Server *httptest.Server
+
+	idp              *saml.IdentityProvider
+	mu               sync.Mutex
+	serviceProviders map[string]*saml.EntityDescriptor
+}
+
+// NewMockSAMLIdP starts a mock SAML identity provider. Close it when done.
+func NewMockSAMLIdP() *MockSAMLIdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "mock-idp"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		panic(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		panic(err)
+	}
+
+	m := &MockSAMLIdP{serviceProviders: make(map[string]*saml.EntityDescriptor)}
+	m.idp = &saml.IdentityProvider{
+		Key:                     key,
+		Certificate:             cert,
+		Logger:                  logger.DefaultLogger,
+		ServiceProviderProvider: m,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metadata", m.idp.ServeMetadata)
+	m.Server = httptest.NewServer(mux)
+
+	base, _ := url.Parse(m.Server.URL)
+	m.idp.MetadataURL = *base.JoinPath("/metadata")
+	m.idp.SSOURL = *base.JoinPath("/sso")
+	return m
+}
+
+// MetadataURL returns the URL of the identity provider metadata
+func (m *MockSAMLIdP) MetadataURL() string {
+	return m.idp.MetadataURL.String()
+}
+
+// Close stops the identity provider
+func (m *MockSAMLIdP) Close() {
+	m.Server.Close()
+}
+
+// RegisterServiceProvider trusts the service provider of the metadata
+func (m *MockSAMLIdP) RegisterServiceProvider(metadata *saml.EntityDescriptor) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.serviceProviders[metadata.EntityID] = metadata
+}
+
+// GetServiceProvider implements saml.ServiceProviderProvider
+func (m *MockSAMLIdP) GetServiceProvider(_ *http.Request, serviceProviderID string) (*saml.EntityDescriptor, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if sp, ok := m.serviceProviders[serviceProviderID]; ok {
+		return sp, nil
+	}
+	return nil, fmt.Errorf("unknown service provider %s", serviceProviderID)
+}
+
+// Authorize stands in for a user signing in at the identity provider. It answers the
+// redirect binding login URL with the form the browser would post to the ACS URL.
+func (m *MockSAMLIdP) Authorize(loginURL string, email string, attributes map[string][]string) (url.Values, error) {
+	req, err := saml.NewIdpAuthnRequest(m.idp, httptest.NewRequest(http.MethodGet, loginURL, nil))
+	if err != nil {
+		return nil, err
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	session := &saml.Session{
+		ID:           rand.Text(),
+		CreateTime:   time.Now(),
+		ExpireTime:   time.Now().Add(time.Hour),
+		Index:        rand.Text(),
+		NameID:       email,
+		NameIDFormat: string(saml.EmailAddressNameIDFormat),
+	}
+	for name, values := range attributes {
+		attribute := saml.Attribute{Name: name, NameFormat: "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"}
+		for _, v := range values {
+			attribute.Values = append(attribute.Values, saml.AttributeValue{Type: "xs:string", Value: v})
+		}
+		session.CustomAttributes = append(session.CustomAttributes, attribute)
+	}
+
+	if err := (saml.DefaultAssertionMaker{}).MakeAssertion(req, session); err != nil {
+		return nil, err
+	}
+	form, err := req.PostBinding()
+	if err != nil {
+		return nil, err
+	}
+	return url.Values{
+		"SAMLResponse": {form.SAMLResponse},
+		"RelayState":   {form.RelayState},
+	}, nil
+}
@@ -27,6 +27,7 @@ const (
 	AlertTypeLowOngoingBalance    AlertType = "low_ongoing_balance"
 	AlertTypeLowCreditBalance     AlertType = "low_credit_balance"
 	AlertTypeFeatureWalletBalance AlertType = "feature_wallet_balance"
+	AlertTypeUsageAnomaly         AlertType = "usage_anomaly"
 )
 
 // AlertEntityType represents the type of entity for alerts
@@ -35,12 +36,14 @@ type AlertEntityType string
 const (
 	AlertEntityTypeWallet  AlertEntityType = "wallet"
 	AlertEntityTypeFeature AlertEntityType = "feature"
+	AlertEntityTypeMeter   AlertEntityType = "meter"
 )
 
 func (aet AlertEntityType) Validate() error {
 	allowedTypes := []AlertEntityType{
 		AlertEntityTypeWallet,
 		AlertEntityTypeFeature,
+		AlertEntityTypeMeter,
 	}
 	if !lo.Contains(allowedTypes, aet) {
 		return ierr.NewError("invalid alert entity type").
@@ -55,6 +58,7 @@ func (at AlertType) Validate() error {
 		AlertTypeLowOngoingBalance,
 		AlertTypeLowCreditBalance,
 		AlertTypeFeatureWalletBalance,
+		AlertTypeUsageAnomaly,
 	}
 	if !lo.Contains(allowedTypes, at) {
 		return ierr.NewError("invalid alert type").
@@ -68,6 +72,8 @@ type AlertInfo struct {
 	AlertSettings *AlertSettings  `json:"alert_settings,omitempty"`
 	ValueAtTime   decimal.Decimal `json:"value_at_time"`
 	Timestamp     time.Time       `json:"timestamp"`
+	// UsageAnomaly holds the baseline comparison for usage_anomaly alerts
+	UsageAnomaly *UsageAnomalyInfo `json:"usage_anomaly,omitempty"`
 }
 
 // AlertLogFilter represents filters for alert log queries
@@ -0,0 +1,195 @@
+package types
+
+// AuditLogEntityType is the type of entity an audit log entry refers to
+type AuditLogEntityType string
+
+const (
+	AuditLogEntityTypePrice                AuditLogEntityType = "price"
+	AuditLogEntityTypePlan                 AuditLogEntityType = "plan"
+	AuditLogEntityTypeFeature              AuditLogEntityType = "feature"
+	AuditLogEntityTypeCustomer             AuditLogEntityType = "customer"
+	AuditLogEntityTypeSubscription         AuditLogEntityType = "subscription"
+	AuditLogEntityTypeInvoice              AuditLogEntityType = "invoice"
+	AuditLogEntityTypeCreditNote           AuditLogEntityType = "credit_note"
+	AuditLogEntityTypeWallet               AuditLogEntityType = "wallet"
+	AuditLogEntityTypeCoupon               AuditLogEntityType = "coupon"
+	AuditLogEntityTypeWebhookEndpoint      AuditLogEntityType = "webhook_endpoint"
+	AuditLogEntityTypeRBACRole             AuditLogEntityType = "rbac_role"
+	AuditLogEntityTypeUser                 AuditLogEntityType = "user"
+	AuditLogEntityTypeAPIKey               AuditLogEntityType = "api_key"
+	AuditLogEntityTypeIntegration          AuditLogEntityType = "integration"
+	AuditLogEntityTypeSSOConnection        AuditLogEntityType = "sso_connection"
+	AuditLogEntityTypeInvitation           AuditLogEntityType = "invitation"
+	AuditLogEntityTypeMembership           AuditLogEntityType = "tenant_membership"
+	AuditLogEntityTypePayment              AuditLogEntityType = "payment"
+	AuditLogEntityTypeCreditGrant          AuditLogEntityType = "credit_grant"
+	AuditLogEntityTypeTaxRate              AuditLogEntityType = "tax_rate"
+	AuditLogEntityTypeTaxAssociation       AuditLogEntityType = "tax_association"
+	AuditLogEntityTypeMeter                AuditLogEntityType = "meter"
+	AuditLogEntityTypeEntitlement          AuditLogEntityType = "entitlement"
+	AuditLogEntityTypeAddon                AuditLogEntityType = "addon"
+	AuditLogEntityTypeSetting              AuditLogEntityType = "setting"
+	AuditLogEntityTypePriceBook            AuditLogEntityType = "price_book"
+	AuditLogEntityTypePromotionCode        AuditLogEntityType = "promotion_code"
+	AuditLogEntityTypeFXRate               AuditLogEntityType = "fx_rate"
+	AuditLogEntityTypeCommitmentContract   AuditLogEntityType = "commitment_contract"
+	AuditLogEntityTypeSubscriptionSeats    AuditLogEntityType = "subscription_seats"
+	AuditLogEntityTypeSubscriptionPhase    AuditLogEntityType = "subscription_phase"
+	AuditLogEntityTypeSubscriptionLineItem AuditLogEntityType = "subscription_line_item"
+	AuditLogEntityTypeEnvironment          AuditLogEntityType = "environment"
+	AuditLogEntityTypeTenant               AuditLogEntityType = "tenant"
+	AuditLogEntityTypeConnection           AuditLogEntityType = "connection"
+)
+
+// AuditLogAction is the action recorded by an audit log entry. Besides create, update and
+// delete, actions with their own business meaning are recorded under their own name.
+type AuditLogAction string
+
+const (
+	AuditLogActionCreate   AuditLogAction = "create"
+	AuditLogActionUpdate   AuditLogAction = "update"
+	AuditLogActionDelete   AuditLogAction = "delete"
+	AuditLogActionVoid     AuditLogAction = "void"
+	AuditLogActionFinalize AuditLogAction = "finalize"
+	AuditLogActionCancel   AuditLogAction = "cancel"
+	AuditLogActionCredit   AuditLogAction = "credit"
+	AuditLogActionDebit    AuditLogAction = "debit"
+	AuditLogActionAccept   AuditLogAction = "accept"
+	AuditLogActionDecline  AuditLogAction = "decline"
+	AuditLogActionRevoke   AuditLogAction = "revoke"
+)
+
+// AuditLogActorType is the kind of principal that performed an audited action
+type AuditLogActorType string
+
+const (
+	AuditLogActorTypeUser   AuditLogActorType = "user"
+	AuditLogActorTypeAPIKey AuditLogActorType = "api_key"
+	AuditLogActorTypeSystem AuditLogActorType = "system"
+)
+
+// AuditLogChange holds the value of a field before and after an audited action.
+// Before is empty for created entities and After is empty for deleted ones.
+type AuditLogChange struct {
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+// AuditLogFilter represents filters for audit log queries
+type AuditLogFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	Filters []*FilterCondition `json:"filters,omitempty" form:"filters" validate:"omitempty"`
+	Sort    []*SortCondition   `json:"sort,omitempty" form:"sort" validate:"omitempty"`
+
+	EntityTypes []string `json:"entity_types,omitempty" form:"entity_types"`
+	EntityIDs   []string `json:"entity_ids,omitempty" form:"entity_ids"`
+	Actions     []string `json:"actions,omitempty" form:"actions"`
+	ActorTypes  []string `json:"actor_types,omitempty" form:"actor_types"`
+	ActorIDs    []string `json:"actor_ids,omitempty" form:"actor_ids"`
+	RequestID   string   `json:"request_id,omitempty" form:"request_id"`
+}
+
+// NewAuditLogFilter creates a new AuditLogFilter with default values
+func NewAuditLogFilter() *AuditLogFilter {
+	return &AuditLogFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+// NewNoLimitAuditLogFilter creates a new AuditLogFilter with no pagination limits
+func NewNoLimitAuditLogFilter() *AuditLogFilter {
+	return &AuditLogFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+// Validate validates the audit log filter
+func (f *AuditLogFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, filter := range f.Filters {
+		if filter != nil {
+			if err := filter.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, sort := range f.Sort {
+		if sort != nil {
+			if err := sort.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetLimit implements BaseFilter interface
+func (f *AuditLogFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *AuditLogFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetSort implements BaseFilter interface
+func (f *AuditLogFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *AuditLogFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *AuditLogFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *AuditLogFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+// IsUnlimited returns true if this is an unlimited query
+func (f *AuditLogFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
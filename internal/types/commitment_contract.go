@@ -0,0 +1,160 @@
+package types
+
+import (
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// CommitmentContractStatus is the lifecycle state of a commitment contract
+type CommitmentContractStatus string
+
+const (
+	// CommitmentContractStatusActive contracts draw down usage of their subscriptions
+	CommitmentContractStatusActive CommitmentContractStatus = "active"
+	// CommitmentContractStatusCompleted contracts reached their end date and were trued up
+	CommitmentContractStatusCompleted CommitmentContractStatus = "completed"
+	// CommitmentContractStatusCancelled contracts were ended early without a true-up
+	CommitmentContractStatusCancelled CommitmentContractStatus = "cancelled"
+)
+
+func (s CommitmentContractStatus) String() string {
+	return string(s)
+}
+
+func (s CommitmentContractStatus) Validate() error {
+	allowed := []CommitmentContractStatus{
+		CommitmentContractStatusActive,
+		CommitmentContractStatusCompleted,
+		CommitmentContractStatusCancelled,
+	}
+	if !lo.Contains(allowed, s) {
+		return ierr.NewError("invalid commitment contract status").
+			WithHint("Commitment contract status must be one of active, completed or cancelled").
+			WithReportableDetails(map[string]any{
+				"contract_status": s,
+				"allowed":         allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// CommitmentContractFilter defines filters for querying commitment contracts
+type CommitmentContractFilter struct {
+	*QueryFilter
+
+	Filters []*FilterCondition `json:"filters,omitempty" form:"filters" validate:"omitempty"`
+	Sort    []*SortCondition   `json:"sort,omitempty" form:"sort" validate:"omitempty"`
+
+	ContractIDs      []string                   `json:"contract_ids,omitempty" form:"contract_ids"`
+	CustomerID       string                     `json:"customer_id,omitempty" form:"customer_id"`
+	SubscriptionID   string                     `json:"subscription_id,omitempty" form:"subscription_id"`
+	ContractStatuses []CommitmentContractStatus `json:"contract_statuses,omitempty" form:"contract_statuses"`
+	// EndDateLTE returns contracts ending at or before this time
+	EndDateLTE *time.Time `json:"end_date_lte,omitempty" form:"end_date_lte"`
+}
+
+// NewCommitmentContractFilter creates a new CommitmentContractFilter with default values
+func NewCommitmentContractFilter() *CommitmentContractFilter {
+	return &CommitmentContractFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+// NewNoLimitCommitmentContractFilter creates a new CommitmentContractFilter with no pagination limits
+func NewNoLimitCommitmentContractFilter() *CommitmentContractFilter {
+	return &CommitmentContractFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+// Validate validates the commitment contract filter
+func (f *CommitmentContractFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, filter := range f.Filters {
+		if filter != nil {
+			if err := filter.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, sort := range f.Sort {
+		if sort != nil {
+			if err := sort.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, status := range f.ContractStatuses {
+		if err := status.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetLimit implements BaseFilter interface
+func (f *CommitmentContractFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *CommitmentContractFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetSort implements BaseFilter interface
+func (f *CommitmentContractFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *CommitmentContractFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *CommitmentContractFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *CommitmentContractFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+// IsUnlimited returns true if this is an unlimited query
+func (f *CommitmentContractFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
@@ -17,6 +17,8 @@ const (
 	CtxDBTransaction ContextKey = "ctx_db_transaction"
 	CtxForceWriter   ContextKey = "ctx_force_writer" // Force DB operations to use writer connection
 	CtxRoles         ContextKey = "ctx_roles"        // RBAC roles array for permission checks
+	CtxAPIKeyID      ContextKey = "ctx_api_key_id"   // ID of the API key that authenticated the request
+	CtxClientIP      ContextKey = "ctx_client_ip"
 
 	// Default values
 	DefaultTenantID = "00000000-0000-0000-0000-000000000000"
@@ -48,6 +50,22 @@ func GetRequestID(ctx context.Context) string {
 	return ""
 }
 
+// GetAPIKeyID returns the ID of the API key that authenticated the request, if any
+func GetAPIKeyID(ctx context.Context) string {
+	if apiKeyID, ok := ctx.Value(CtxAPIKeyID).(string); ok {
+		return apiKeyID
+	}
+	return ""
+}
+
+// GetClientIP returns the IP address the request was made from
+func GetClientIP(ctx context.Context) string {
+	if clientIP, ok := ctx.Value(CtxClientIP).(string); ok {
+		return clientIP
+	}
+	return ""
+}
+
 func GetJWT(ctx context.Context) string {
 	if jwt, ok := ctx.Value(CtxJWT).(string); ok {
 		return jwt
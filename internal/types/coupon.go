@@ -1,7 +1,11 @@
 package types
 
 import (
+	"strings"
 	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
 )
 
 // CouponType represents the type of coupon discount (fixed or percentage)
@@ -26,6 +30,145 @@ const (
 	CouponCadenceForever CouponCadence = "forever"
 )
 
+// CouponStackingMode controls whether a coupon can be combined with other coupons
+type CouponStackingMode string
+
+const (
+	// CouponStackingModeStackable allows the coupon to combine with other coupons on the same line items
+	CouponStackingModeStackable CouponStackingMode = "stackable"
+	// CouponStackingModeExclusive applies the coupon only to line items without any other discount
+	// and blocks any coupon applied after it on the same line items
+	CouponStackingModeExclusive CouponStackingMode = "exclusive"
+)
+
+// CouponRules restricts where a coupon's discount applies and how it combines with other coupons.
+// Targeting fields are ANDed together; within a field any listed value matches. A coupon
+// without targeting applies to the whole invoice (or the line item it is associated with).
+type CouponRules struct {
+	// PlanIDs restricts the discount to line items billed from these plans
+	PlanIDs []string `json:"plan_ids,omitempty"`
+	// AddonIDs restricts the discount to line items billed from these addons
+	AddonIDs []string `json:"addon_ids,omitempty"`
+	// PriceIDs restricts the discount to line items of these prices
+	PriceIDs []string `json:"price_ids,omitempty"`
+	// FeatureIDs restricts the discount to usage line items of these features
+	FeatureIDs []string `json:"feature_ids,omitempty"`
+	// PriceTypes restricts the discount to usage or fixed line items
+	PriceTypes []PriceType `json:"price_types,omitempty"`
+	// Stacking is stackable by default
+	Stacking CouponStackingMode `json:"stacking,omitempty"`
+	// ApplicationOrder orders coupons on an invoice, lower values apply first
+	ApplicationOrder int `json:"application_order,omitempty"`
+}
+
+// CouponRuleTarget describes the line item a coupon rule is evaluated against
+type CouponRuleTarget struct {
+	PlanID    string
+	AddonID   string
+	PriceID   string
+	FeatureID string
+	PriceType PriceType
+}
+
+// Validate validates the coupon rules
+func (r *CouponRules) Validate() error {
+	if r == nil {
+		return nil
+	}
+
+	if r.Stacking != "" && r.Stacking != CouponStackingModeStackable && r.Stacking != CouponStackingModeExclusive {
+		return ierr.NewError("invalid stacking mode").
+			WithHint("Stacking must be either stackable or exclusive").
+			WithReportableDetails(map[string]interface{}{
+				"stacking": r.Stacking,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	for _, priceType := range r.PriceTypes {
+		if priceType != PRICE_TYPE_USAGE && priceType != PRICE_TYPE_FIXED {
+			return ierr.NewError("invalid price type in coupon rules").
+				WithHint("Price types must be USAGE or FIXED").
+				WithReportableDetails(map[string]interface{}{
+					"price_type": priceType,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	if r.ApplicationOrder < 0 {
+		return ierr.NewError("application_order cannot be negative").
+			WithHint("Please provide a valid application order").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// IsTargeted returns true if the rules restrict the discount to a subset of line items
+func (r *CouponRules) IsTargeted() bool {
+	if r == nil {
+		return false
+	}
+	return len(r.PlanIDs) > 0 || len(r.AddonIDs) > 0 || len(r.PriceIDs) > 0 ||
+		len(r.FeatureIDs) > 0 || len(r.PriceTypes) > 0
+}
+
+// IsExclusive returns true if the coupon cannot be combined with other coupons
+func (r *CouponRules) IsExclusive() bool {
+	return r != nil && r.Stacking == CouponStackingModeExclusive
+}
+
+// GetApplicationOrder returns the application order, zero when no rules are set
+func (r *CouponRules) GetApplicationOrder() int {
+	if r == nil {
+		return 0
+	}
+	return r.ApplicationOrder
+}
+
+// Match reports whether the target satisfies every targeting rule and, if so, a
+// human readable explanation of the rules it matched
+func (r *CouponRules) Match(target CouponRuleTarget) (bool, string) {
+	if !r.IsTargeted() {
+		return true, "applies to all line items"
+	}
+
+	reasons := make([]string, 0, 5)
+	if len(r.PlanIDs) > 0 {
+		if !lo.Contains(r.PlanIDs, target.PlanID) {
+			return false, ""
+		}
+		reasons = append(reasons, "plan "+target.PlanID)
+	}
+	if len(r.AddonIDs) > 0 {
+		if !lo.Contains(r.AddonIDs, target.AddonID) {
+			return false, ""
+		}
+		reasons = append(reasons, "addon "+target.AddonID)
+	}
+	if len(r.PriceIDs) > 0 {
+		if !lo.Contains(r.PriceIDs, target.PriceID) {
+			return false, ""
+		}
+		reasons = append(reasons, "price "+target.PriceID)
+	}
+	if len(r.FeatureIDs) > 0 {
+		if !lo.Contains(r.FeatureIDs, target.FeatureID) {
+			return false, ""
+		}
+		reasons = append(reasons, "feature "+target.FeatureID)
+	}
+	if len(r.PriceTypes) > 0 {
+		if !lo.Contains(r.PriceTypes, target.PriceType) {
+			return false, ""
+		}
+		reasons = append(reasons, "price type "+string(target.PriceType))
+	}
+
+	return true, "matched " + strings.Join(reasons, ", ")
+}
+
 type CouponFilter struct {
 	*QueryFilter
 
@@ -66,6 +209,9 @@ type CouponAssociationFilter struct {
 	// SubscriptionPhaseIDs filters by subscription phase IDs (can be a single ID in array)
 	SubscriptionPhaseIDs []string `json:"subscription_phase_ids,omitempty" form:"subscription_phase_ids"`
 
+	// PromotionCodeIDs filters by the promotion codes the associations were redeemed through
+	PromotionCodeIDs []string `json:"promotion_code_ids,omitempty" form:"promotion_code_ids"`
+
 	// ActiveOnly filters to only return active associations based on start_date and end_date
 	// When ActiveOnly is true, the association must overlap with the period specified by PeriodStart and PeriodEnd
 	// If PeriodStart/PeriodEnd are not provided, uses current time (now())
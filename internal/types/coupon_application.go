@@ -21,6 +21,8 @@ type CouponApplicationFilter struct {
 	InvoiceLineItemIDs []string `json:"invoice_line_item_ids,omitempty" form:"invoice_line_item_ids"`
 	// CouponAssociationIDs filters by coupon association IDs (can be a single ID in array)
 	CouponAssociationIDs []string `json:"coupon_association_ids,omitempty" form:"coupon_association_ids"`
+	// PromotionCodeIDs filters by the promotion codes the coupons were redeemed through
+	PromotionCodeIDs []string `json:"promotion_code_ids,omitempty" form:"promotion_code_ids"`
 }
 
 // NewCouponApplicationFilter creates a new CouponApplicationFilter with default values
@@ -33,6 +33,18 @@ const (
 	CouponValidationErrorCodeRepeatedCadenceLimitReached CouponValidationErrorCode = "REPEATED_CADENCE_LIMIT_REACHED"
 	CouponValidationErrorCodeInvalidRepeatedCadence      CouponValidationErrorCode = "INVALID_REPEATED_CADENCE"
 
+	// Targeting rule validation errors
+	CouponValidationErrorCodePlanNotEligible CouponValidationErrorCode = "PLAN_NOT_ELIGIBLE"
+
+	// Promotion code validation errors
+	CouponValidationErrorCodePromotionCodeNotFound          CouponValidationErrorCode = "PROMOTION_CODE_NOT_FOUND"
+	CouponValidationErrorCodePromotionCodeInactive          CouponValidationErrorCode = "PROMOTION_CODE_INACTIVE"
+	CouponValidationErrorCodePromotionCodeExpired           CouponValidationErrorCode = "PROMOTION_CODE_EXPIRED"
+	CouponValidationErrorCodePromotionCodeLimitReached      CouponValidationErrorCode = "PROMOTION_CODE_REDEMPTION_LIMIT_REACHED"
+	CouponValidationErrorCodeCustomerRedemptionLimitReached CouponValidationErrorCode = "CUSTOMER_REDEMPTION_LIMIT_REACHED"
+	CouponValidationErrorCodeFirstTimeCustomerOnly          CouponValidationErrorCode = "FIRST_TIME_CUSTOMER_ONLY"
+	CouponValidationErrorCodeMinimumAmountNotMet            CouponValidationErrorCode = "MINIMUM_AMOUNT_NOT_MET"
+
 	// Database and system errors
 	CouponValidationErrorCodeDatabaseError CouponValidationErrorCode = "DATABASE_ERROR"
 )
@@ -55,6 +67,14 @@ func (c CouponValidationErrorCode) Validate() error {
 		CouponValidationErrorCodeOnceCadenceViolation,
 		CouponValidationErrorCodeRepeatedCadenceLimitReached,
 		CouponValidationErrorCodeInvalidRepeatedCadence,
+		CouponValidationErrorCodePlanNotEligible,
+		CouponValidationErrorCodePromotionCodeNotFound,
+		CouponValidationErrorCodePromotionCodeInactive,
+		CouponValidationErrorCodePromotionCodeExpired,
+		CouponValidationErrorCodePromotionCodeLimitReached,
+		CouponValidationErrorCodeCustomerRedemptionLimitReached,
+		CouponValidationErrorCodeFirstTimeCustomerOnly,
+		CouponValidationErrorCodeMinimumAmountNotMet,
 		CouponValidationErrorCodeDatabaseError,
 	}
 
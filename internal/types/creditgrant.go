@@ -2,10 +2,12 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/flexprice/flexprice/internal/errors"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 )
 
 // CreditGrantScope defines the scope of a credit grant
@@ -126,6 +128,110 @@ func (u CreditGrantExpiryDurationUnit) Validate() error {
 	return nil
 }
 
+// AddTo returns t moved forward by duration units
+func (u CreditGrantExpiryDurationUnit) AddTo(t time.Time, duration int) (time.Time, error) {
+	switch u {
+	case CreditGrantExpiryDurationUnitDays:
+		return t.AddDate(0, 0, duration), nil
+	case CreditGrantExpiryDurationUnitWeeks:
+		return t.AddDate(0, 0, 7*duration), nil
+	case CreditGrantExpiryDurationUnitMonths:
+		return t.AddDate(0, duration, 0), nil
+	case CreditGrantExpiryDurationUnitYears:
+		return t.AddDate(duration, 0, 0), nil
+	}
+	return time.Time{}, u.Validate()
+}
+
+// CreditGrantRolloverConfig carries unused credits of a recurring grant into the next period
+// instead of letting them expire. Credits roll over when the next period is applied, so only
+// credits that expire by the start of that period are carried.
+type CreditGrantRolloverConfig struct {
+	// MaxCredits caps the credits carried into a period
+	MaxCredits *decimal.Decimal `json:"max_credits,omitempty" swaggertype:"string"`
+
+	// MaxPercent caps the credits carried into a period at a percentage (0-100) of the
+	// credits granted per period
+	MaxPercent *decimal.Decimal `json:"max_percent,omitempty" swaggertype:"string"`
+
+	// MaxPeriods is how many periods credits can roll over for before they expire. Defaults to 1,
+	// so credits roll over once and expire at the end of the next period.
+	MaxPeriods *int `json:"max_periods,omitempty"`
+
+	// ExpirationDuration and ExpirationDurationUnit set when rolled over credits expire, counted
+	// from the start of the period they roll into. Defaults to the expiry of that period's credits.
+	ExpirationDuration     *int                           `json:"expiration_duration,omitempty"`
+	ExpirationDurationUnit *CreditGrantExpiryDurationUnit `json:"expiration_duration_unit,omitempty"`
+}
+
+// Validate validates the rollover config
+func (c *CreditGrantRolloverConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if c.MaxCredits != nil && c.MaxCredits.IsNegative() {
+		return errors.NewError("rollover max_credits cannot be negative").
+			WithHint("Please provide a valid maximum number of rollover credits").
+			Mark(errors.ErrValidation)
+	}
+
+	if c.MaxPercent != nil && (c.MaxPercent.IsNegative() || c.MaxPercent.GreaterThan(decimal.NewFromInt(100))) {
+		return errors.NewError("rollover max_percent must be between 0 and 100").
+			WithHint("Please provide the share of the period's credits that can roll over").
+			Mark(errors.ErrValidation)
+	}
+
+	if c.MaxPeriods != nil && *c.MaxPeriods <= 0 {
+		return errors.NewError("rollover max_periods must be greater than zero").
+			WithHint("Please provide how many periods credits can roll over for").
+			Mark(errors.ErrValidation)
+	}
+
+	if (c.ExpirationDuration == nil) != (c.ExpirationDurationUnit == nil) {
+		return errors.NewError("rollover expiration_duration and expiration_duration_unit must be set together").
+			WithHint("Please provide both the duration and its unit, or neither").
+			Mark(errors.ErrValidation)
+	}
+
+	if c.ExpirationDuration != nil {
+		if *c.ExpirationDuration <= 0 {
+			return errors.NewError("rollover expiration_duration must be greater than zero").
+				WithHint("Please provide a valid expiration duration").
+				Mark(errors.ErrValidation)
+		}
+		if err := c.ExpirationDurationUnit.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetMaxPeriods returns how many periods credits can roll over for
+func (c *CreditGrantRolloverConfig) GetMaxPeriods() int {
+	if c == nil || c.MaxPeriods == nil {
+		return 1
+	}
+	return *c.MaxPeriods
+}
+
+// Cap returns the credits that roll over out of unused, given the credits granted per period
+func (c *CreditGrantRolloverConfig) Cap(unused, periodCredits decimal.Decimal) decimal.Decimal {
+	if c == nil || !unused.IsPositive() {
+		return decimal.Zero
+	}
+
+	rollover := unused
+	if c.MaxCredits != nil {
+		rollover = decimal.Min(rollover, *c.MaxCredits)
+	}
+	if c.MaxPercent != nil {
+		rollover = decimal.Min(rollover, periodCredits.Mul(*c.MaxPercent).Div(decimal.NewFromInt(100)))
+	}
+	return rollover
+}
+
 // Validate validates the credit grant period
 func (p CreditGrantPeriod) Validate() error {
 	allowedValues := []CreditGrantPeriod{
@@ -18,6 +18,7 @@ type CustomerFilter struct {
 	CustomerIDs       []string           `json:"customer_ids,omitempty" form:"customer_ids" validate:"omitempty"`
 	ExternalIDs       []string           `json:"external_ids,omitempty" form:"external_ids" validate:"omitempty"`
 	ExternalID        string             `json:"external_id,omitempty" form:"external_id" validate:"omitempty"`
+	ParentCustomerIDs []string           `json:"parent_customer_ids,omitempty" form:"parent_customer_ids" validate:"omitempty"`
 	Email             string             `json:"email,omitempty" form:"email" validate:"omitempty,email"`
 }
 
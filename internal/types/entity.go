@@ -4,13 +4,14 @@ package types
 type SystemEntityType string
 
 const (
-	SystemEntityTypeFeature      SystemEntityType = "feature"
-	SystemEntityTypeCustomer     SystemEntityType = "customer"
-	SystemEntityTypePlan         SystemEntityType = "plan"
-	SystemEntityTypeSubscription SystemEntityType = "subscription"
-	SystemEntityTypeInvoice      SystemEntityType = "invoice"
-	SystemEntityTypePayment      SystemEntityType = "payment"
-	SystemEntityTypeCreditNote   SystemEntityType = "credit_note"
-	SystemEntityTypeWallet       SystemEntityType = "wallet"
-	SystemEntityTypeEntitlement  SystemEntityType = "entitlement"
+	SystemEntityTypeFeature         SystemEntityType = "feature"
+	SystemEntityTypeCustomer        SystemEntityType = "customer"
+	SystemEntityTypePlan            SystemEntityType = "plan"
+	SystemEntityTypeSubscription    SystemEntityType = "subscription"
+	SystemEntityTypeInvoice         SystemEntityType = "invoice"
+	SystemEntityTypePayment         SystemEntityType = "payment"
+	SystemEntityTypeCreditNote      SystemEntityType = "credit_note"
+	SystemEntityTypeWallet          SystemEntityType = "wallet"
+	SystemEntityTypeEntitlement     SystemEntityType = "entitlement"
+	SystemEntityTypeWebhookEndpoint SystemEntityType = "webhook_endpoint"
 )
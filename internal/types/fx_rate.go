@@ -0,0 +1,168 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/shopspring/decimal"
+)
+
+// FXRateSource identifies where an FX rate came from
+type FXRateSource string
+
+const (
+	// FXRateSourceManual is a rate entered through the API
+	FXRateSourceManual FXRateSource = "manual"
+	// FXRateSourceFile is a rate loaded from the configured rates file
+	FXRateSourceFile FXRateSource = "file"
+)
+
+func (s FXRateSource) String() string {
+	return string(s)
+}
+
+// FXRateFilter represents filters for FX rate queries
+type FXRateFilter struct {
+	*QueryFilter
+
+	Filters []*FilterCondition `json:"filters,omitempty" form:"filters" validate:"omitempty"`
+	Sort    []*SortCondition   `json:"sort,omitempty" form:"sort" validate:"omitempty"`
+
+	FXRateIDs     []string     `json:"fx_rate_ids,omitempty" form:"fx_rate_ids"`
+	BaseCurrency  string       `json:"base_currency,omitempty" form:"base_currency"`
+	QuoteCurrency string       `json:"quote_currency,omitempty" form:"quote_currency"`
+	Source        FXRateSource `json:"source,omitempty" form:"source"`
+	// EffectiveAtOrBefore returns rates that were already effective at this time
+	EffectiveAtOrBefore *time.Time `json:"effective_at_or_before,omitempty" form:"effective_at_or_before"`
+}
+
+// NewFXRateFilter creates a new FXRateFilter with default values
+func NewFXRateFilter() *FXRateFilter {
+	return &FXRateFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+// NewNoLimitFXRateFilter creates a new FXRateFilter with no pagination limits
+func NewNoLimitFXRateFilter() *FXRateFilter {
+	return &FXRateFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+// Validate validates the filter
+func (f *FXRateFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetLimit implements BaseFilter interface
+func (f *FXRateFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *FXRateFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetSort implements BaseFilter interface
+func (f *FXRateFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *FXRateFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *FXRateFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *FXRateFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+// IsUnlimited returns true if this is an unlimited query
+func (f *FXRateFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// FXConversion is an FX rate applied to convert an amount from one currency to another.
+// A rate of 0.92 from usd to eur means 1 usd = 0.92 eur.
+type FXConversion struct {
+	// RateID is the FX rate the conversion used, empty for same currency conversions
+	RateID       string          `json:"rate_id,omitempty"`
+	FromCurrency string          `json:"from_currency"`
+	ToCurrency   string          `json:"to_currency"`
+	Rate         decimal.Decimal `json:"rate" swaggertype:"string"`
+	EffectiveAt  time.Time       `json:"effective_at"`
+}
+
+// Convert converts an amount in FromCurrency to ToCurrency, rounded to ToCurrency precision
+func (c *FXConversion) Convert(amount decimal.Decimal) decimal.Decimal {
+	return RoundToCurrencyPrecision(amount.Mul(c.Rate), c.ToCurrency)
+}
+
+// ConvertBack converts an amount in ToCurrency to FromCurrency, rounded to FromCurrency precision
+func (c *FXConversion) ConvertBack(amount decimal.Decimal) decimal.Decimal {
+	if c.Rate.IsZero() {
+		return decimal.Zero
+	}
+	return RoundToCurrencyPrecision(amount.Div(c.Rate), c.FromCurrency)
+}
+
+// ValidateFXRate validates an FX rate's currency pair and rate
+func ValidateFXRate(baseCurrency, quoteCurrency string, rate decimal.Decimal) error {
+	if err := ValidateCurrencyCode(baseCurrency); err != nil {
+		return ierr.WithError(err).
+			WithHint("Please provide a valid base currency").
+			Mark(ierr.ErrValidation)
+	}
+	if err := ValidateCurrencyCode(quoteCurrency); err != nil {
+		return ierr.WithError(err).
+			WithHint("Please provide a valid quote currency").
+			Mark(ierr.ErrValidation)
+	}
+	if strings.EqualFold(baseCurrency, quoteCurrency) {
+		return ierr.NewError("base and quote currency must differ").
+			WithHint("An FX rate converts between two different currencies").
+			Mark(ierr.ErrValidation)
+	}
+	if !rate.IsPositive() {
+		return ierr.NewError("rate must be greater than zero").
+			WithHint("Please provide a positive FX rate").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package types
+
+// InvitationStatus is the state of an invitation to join a tenant
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusDeclined InvitationStatus = "declined"
+	InvitationStatusRevoked  InvitationStatus = "revoked"
+	// InvitationStatusExpired is never stored, pending invitations past their expiry read as expired
+	InvitationStatusExpired InvitationStatus = "expired"
+)
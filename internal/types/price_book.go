@@ -0,0 +1,327 @@
+package types
+
+import (
+	"strings"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// PriceBookRateType is how a price book converts base currency prices into a target currency
+type PriceBookRateType string
+
+const (
+	// PriceBookRateTypeFX converts at the FX rate effective when the price is localized
+	PriceBookRateTypeFX PriceBookRateType = "fx"
+	// PriceBookRateTypeFixed converts at a rate set on the price book
+	PriceBookRateTypeFixed PriceBookRateType = "fixed"
+)
+
+func (t PriceBookRateType) Validate() error {
+	allowed := []PriceBookRateType{PriceBookRateTypeFX, PriceBookRateTypeFixed}
+	if !lo.Contains(allowed, t) {
+		return ierr.NewError("invalid price book rate type").
+			WithHint("Rate type must be fx or fixed").
+			WithReportableDetails(map[string]any{
+				"allowed": allowed,
+				"type":    t,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// LocalizedPriceSource is where a localized price amount came from
+type LocalizedPriceSource string
+
+const (
+	LocalizedPriceSourceOverride  LocalizedPriceSource = "override"
+	LocalizedPriceSourceFixedRate LocalizedPriceSource = "fixed_rate"
+	LocalizedPriceSourceFXRate    LocalizedPriceSource = "fx_rate"
+)
+
+// PriceRoundingMode is how a converted amount is rounded
+type PriceRoundingMode string
+
+const (
+	// PriceRoundingModeNone keeps the converted amount, rounded to the currency precision
+	PriceRoundingModeNone PriceRoundingMode = "none"
+	// PriceRoundingModeNearest rounds to the nearest multiple of Increment
+	PriceRoundingModeNearest PriceRoundingMode = "nearest"
+	// PriceRoundingModeUp rounds up to a multiple of Increment
+	PriceRoundingModeUp PriceRoundingMode = "up"
+	// PriceRoundingModeDown rounds down to a multiple of Increment
+	PriceRoundingModeDown PriceRoundingMode = "down"
+	// PriceRoundingModeCharm rounds up to the next amount ending in Ending, e.g. 23.40 -> 23.99
+	PriceRoundingModeCharm PriceRoundingMode = "charm"
+)
+
+// PriceRoundingPolicy rounds localized prices to amounts customers expect in a currency
+type PriceRoundingPolicy struct {
+	Mode PriceRoundingMode `json:"mode"`
+	// Increment is the step for nearest, up and down, e.g. 0.05, 1 or 100. Defaults to 1.
+	Increment *decimal.Decimal `json:"increment,omitempty" swaggertype:"string"`
+	// Ending is the fractional ending of charm prices. Defaults to 0.99.
+	Ending *decimal.Decimal `json:"ending,omitempty" swaggertype:"string"`
+}
+
+// Validate validates the rounding policy
+func (p *PriceRoundingPolicy) Validate() error {
+	if p == nil {
+		return nil
+	}
+
+	allowed := []PriceRoundingMode{
+		PriceRoundingModeNone,
+		PriceRoundingModeNearest,
+		PriceRoundingModeUp,
+		PriceRoundingModeDown,
+		PriceRoundingModeCharm,
+	}
+	if !lo.Contains(allowed, p.Mode) {
+		return ierr.NewError("invalid rounding mode").
+			WithHint("Rounding mode must be none, nearest, up, down or charm").
+			WithReportableDetails(map[string]any{
+				"allowed": allowed,
+				"mode":    p.Mode,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	if p.Increment != nil && !p.Increment.IsPositive() {
+		return ierr.NewError("rounding increment must be greater than zero").
+			WithHint("Please provide a positive rounding increment").
+			Mark(ierr.ErrValidation)
+	}
+	if p.Ending != nil && (p.Ending.IsNegative() || p.Ending.GreaterThanOrEqual(decimal.NewFromInt(1))) {
+		return ierr.NewError("charm ending must be between 0 and 1").
+			WithHint("Please provide the fractional ending of charm prices, e.g. 0.99").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// Apply rounds a converted amount in currency. A nil policy only rounds to the currency precision.
+func (p *PriceRoundingPolicy) Apply(amount decimal.Decimal, currency string) decimal.Decimal {
+	if p == nil || p.Mode == PriceRoundingModeNone || !amount.IsPositive() {
+		return RoundToCurrencyPrecision(amount, currency)
+	}
+
+	increment := decimal.NewFromInt(1)
+	if p.Increment != nil {
+		increment = *p.Increment
+	}
+
+	switch p.Mode {
+	case PriceRoundingModeNearest:
+		amount = amount.Div(increment).Round(0).Mul(increment)
+	case PriceRoundingModeUp:
+		amount = amount.Div(increment).Ceil().Mul(increment)
+	case PriceRoundingModeDown:
+		amount = amount.Div(increment).Floor().Mul(increment)
+	case PriceRoundingModeCharm:
+		ending := decimal.NewFromFloat(0.99)
+		if p.Ending != nil {
+			ending = *p.Ending
+		}
+		charm := amount.Floor().Add(ending)
+		if charm.LessThan(amount) {
+			charm = charm.Add(decimal.NewFromInt(1))
+		}
+		amount = charm
+	}
+
+	return RoundToCurrencyPrecision(amount, currency)
+}
+
+// PriceBookCurrency is how a price book localizes its base currency prices into one currency
+type PriceBookCurrency struct {
+	Currency string            `json:"currency"`
+	RateType PriceBookRateType `json:"rate_type"`
+	// Rate is the conversion rate from the base currency, required for fixed rates
+	Rate *decimal.Decimal `json:"rate,omitempty" swaggertype:"string"`
+	// Rounding applies to flat amounts of fixed prices. Usage unit amounts are converted
+	// without rounding so fractional per-unit prices are kept.
+	Rounding *PriceRoundingPolicy `json:"rounding,omitempty"`
+}
+
+// Validate validates the currency rule against the price book base currency
+func (c *PriceBookCurrency) Validate(baseCurrency string) error {
+	if err := ValidateCurrencyCode(c.Currency); err != nil {
+		return err
+	}
+	if IsMatchingCurrency(c.Currency, baseCurrency) {
+		return ierr.NewError("currency rule must differ from the base currency").
+			WithHint("Base currency prices are used as is").
+			WithReportableDetails(map[string]any{
+				"currency": c.Currency,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	if err := c.RateType.Validate(); err != nil {
+		return err
+	}
+	if c.RateType == PriceBookRateTypeFixed && (c.Rate == nil || !c.Rate.IsPositive()) {
+		return ierr.NewError("fixed rate must be greater than zero").
+			WithHint("Please provide the conversion rate for fixed rate currencies").
+			WithReportableDetails(map[string]any{
+				"currency": c.Currency,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return c.Rounding.Validate()
+}
+
+// PriceBookPriceOverride sets the localized amount of one base currency price in a currency
+// instead of converting it
+type PriceBookPriceOverride struct {
+	PriceID  string           `json:"price_id"`
+	Currency string           `json:"currency"`
+	Amount   *decimal.Decimal `json:"amount,omitempty" swaggertype:"string"`
+	// Tiers replaces the tiers of tiered prices, in the same order and with the same up_to
+	Tiers []PriceBookTierOverride `json:"tiers,omitempty"`
+}
+
+// PriceBookTierOverride is the localized amounts of one tier
+type PriceBookTierOverride struct {
+	UnitAmount decimal.Decimal  `json:"unit_amount" swaggertype:"string"`
+	FlatAmount *decimal.Decimal `json:"flat_amount,omitempty" swaggertype:"string"`
+}
+
+// Validate validates the override
+func (o *PriceBookPriceOverride) Validate() error {
+	if o.PriceID == "" {
+		return ierr.NewError("price_id is required").
+			WithHint("Please provide the price the override applies to").
+			Mark(ierr.ErrValidation)
+	}
+	if err := ValidateCurrencyCode(o.Currency); err != nil {
+		return err
+	}
+	if o.Amount == nil && len(o.Tiers) == 0 {
+		return ierr.NewError("override must set an amount or tiers").
+			WithHint("Please provide the localized amount or tiers").
+			WithReportableDetails(map[string]any{
+				"price_id": o.PriceID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	if o.Amount != nil && o.Amount.IsNegative() {
+		return ierr.NewError("override amount cannot be negative").
+			WithHint("Please provide a valid amount").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// PriceBookFilter represents filters for price book queries
+type PriceBookFilter struct {
+	*QueryFilter
+
+	Filters []*FilterCondition `json:"filters,omitempty" form:"filters" validate:"omitempty"`
+	Sort    []*SortCondition   `json:"sort,omitempty" form:"sort" validate:"omitempty"`
+
+	PriceBookIDs []string `json:"price_book_ids,omitempty" form:"price_book_ids"`
+	BaseCurrency string   `json:"base_currency,omitempty" form:"base_currency"`
+}
+
+// NewPriceBookFilter creates a new PriceBookFilter with default values
+func NewPriceBookFilter() *PriceBookFilter {
+	return &PriceBookFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+// NewNoLimitPriceBookFilter creates a new PriceBookFilter with no pagination limits
+func NewNoLimitPriceBookFilter() *PriceBookFilter {
+	return &PriceBookFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+// Validate validates the price book filter
+func (f *PriceBookFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, filter := range f.Filters {
+		if filter != nil {
+			if err := filter.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, sort := range f.Sort {
+		if sort != nil {
+			if err := sort.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if f.BaseCurrency != "" {
+		f.BaseCurrency = strings.ToLower(f.BaseCurrency)
+	}
+
+	return nil
+}
+
+// GetLimit implements BaseFilter interface
+func (f *PriceBookFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *PriceBookFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetSort implements BaseFilter interface
+func (f *PriceBookFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *PriceBookFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *PriceBookFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *PriceBookFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+// IsUnlimited returns true if this is an unlimited query
+func (f *PriceBookFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
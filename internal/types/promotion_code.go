@@ -0,0 +1,114 @@
+package types
+
+// PromotionCodeFilter represents filters for promotion code queries
+type PromotionCodeFilter struct {
+	*QueryFilter
+
+	Filters []*FilterCondition `json:"filters,omitempty" form:"filters" validate:"omitempty"`
+	Sort    []*SortCondition   `json:"sort,omitempty" form:"sort" validate:"omitempty"`
+
+	// PromotionCodeIDs filters by promotion code IDs
+	PromotionCodeIDs []string `json:"promotion_code_ids,omitempty" form:"promotion_code_ids"`
+	// CouponIDs filters by the coupons the codes redeem
+	CouponIDs []string `json:"coupon_ids,omitempty" form:"coupon_ids"`
+	// Codes filters by code, matched case-insensitively
+	Codes []string `json:"codes,omitempty" form:"codes"`
+	// Active filters by whether codes are active
+	Active *bool `json:"active,omitempty" form:"active"`
+}
+
+// NewPromotionCodeFilter creates a new PromotionCodeFilter with default values
+func NewPromotionCodeFilter() *PromotionCodeFilter {
+	return &PromotionCodeFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+// NewNoLimitPromotionCodeFilter creates a new PromotionCodeFilter with no pagination limits
+func NewNoLimitPromotionCodeFilter() *PromotionCodeFilter {
+	return &PromotionCodeFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+// Validate validates the promotion code filter
+func (f *PromotionCodeFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, filter := range f.Filters {
+		if filter != nil {
+			if err := filter.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, sort := range f.Sort {
+		if sort != nil {
+			if err := sort.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetLimit implements BaseFilter interface
+func (f *PromotionCodeFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *PromotionCodeFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetSort implements BaseFilter interface
+func (f *PromotionCodeFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *PromotionCodeFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *PromotionCodeFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *PromotionCodeFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *PromotionCodeFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
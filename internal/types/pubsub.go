@@ -16,3 +16,9 @@ type IntegrationEventsPubSub struct {
 type UsageBenchmarkPubSub struct {
 	pubsub.PubSub
 }
+
+// UsageStreamPubSub is a named wrapper so FX can inject the usage stream
+// producer and per-node consumer independently.
+type UsageStreamPubSub struct {
+	pubsub.PubSub
+}
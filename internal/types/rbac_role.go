@@ -0,0 +1,4 @@
+package types
+
+// RBACWildcard matches every entity or every action in a role's permissions
+const RBACWildcard = "*"
@@ -20,6 +20,10 @@ const (
 	ScheduleIDSubscriptionRenewalAlerts    ScheduleID = "subscription-renewal-due-alerts"
 	ScheduleIDSubscriptionTrialEndDue      ScheduleID = "subscription-trial-end-due"
 	ScheduleIDOutboundWebhookStaleRetry    ScheduleID = "webhook-stale-retry"
+	ScheduleIDUsageAnomalyDetection        ScheduleID = "usage-anomaly-detection"
+	ScheduleIDUsageRollup                  ScheduleID = "usage-rollup"
+	ScheduleIDCommitmentContractTrueUp     ScheduleID = "commitment-contract-true-up"
+	ScheduleIDSubscriptionSeats            ScheduleID = "subscription-seats"
 )
 
 // String returns the raw schedule id.
@@ -36,6 +40,10 @@ func AllTemporalServerScheduleIDs() []ScheduleID {
 		ScheduleIDSubscriptionRenewalAlerts,
 		ScheduleIDSubscriptionTrialEndDue,
 		ScheduleIDOutboundWebhookStaleRetry,
+		ScheduleIDUsageAnomalyDetection,
+		ScheduleIDUsageRollup,
+		ScheduleIDCommitmentContractTrueUp,
+		ScheduleIDSubscriptionSeats,
 	}
 }
 
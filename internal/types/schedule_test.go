@@ -46,9 +46,13 @@ func TestAllTemporalServerScheduleIDs_covers_all_consts(t *testing.T) {
 		ScheduleIDSubscriptionRenewalAlerts,
 		ScheduleIDSubscriptionTrialEndDue,
 		ScheduleIDOutboundWebhookStaleRetry,
+		ScheduleIDUsageAnomalyDetection,
+		ScheduleIDUsageRollup,
+		ScheduleIDCommitmentContractTrueUp,
+		ScheduleIDSubscriptionSeats,
 	} {
 		_, ok := seen[c]
 		require.True(t, ok, "const %q must appear in AllTemporalServerScheduleIDs", c)
 	}
-	require.Equal(t, 7, len(ids), "expected seven managed server schedule ids")
+	require.Equal(t, 11, len(ids), "expected eleven managed server schedule ids")
 }
@@ -47,6 +47,15 @@ const (
 	ScheduledTaskEntityTypeInvoice      ScheduledTaskEntityType = "invoice"
 	ScheduledTaskEntityTypeCreditTopups ScheduledTaskEntityType = "credit_topups"
 	ScheduledTaskEntityTypeCreditUsage  ScheduledTaskEntityType = "credit_usage"
+
+	// Snapshot exports, written in the columns the matching import task accepts
+	ScheduledTaskEntityTypePlans         ScheduledTaskEntityType = "plans"
+	ScheduledTaskEntityTypeSubscriptions ScheduledTaskEntityType = "subscriptions"
+	ScheduledTaskEntityTypeWallets       ScheduledTaskEntityType = "wallets"
+	ScheduledTaskEntityTypeCoupons       ScheduledTaskEntityType = "coupons"
+
+	// Audit log entries recorded within the export window
+	ScheduledTaskEntityTypeAuditLogs ScheduledTaskEntityType = "audit_logs"
 )
 
 // Validate validates the entity type
@@ -56,6 +65,11 @@ func (e ScheduledTaskEntityType) Validate() error {
 		ScheduledTaskEntityTypeInvoice,
 		ScheduledTaskEntityTypeCreditTopups,
 		ScheduledTaskEntityTypeCreditUsage,
+		ScheduledTaskEntityTypePlans,
+		ScheduledTaskEntityTypeSubscriptions,
+		ScheduledTaskEntityTypeWallets,
+		ScheduledTaskEntityTypeCoupons,
+		ScheduledTaskEntityTypeAuditLogs,
 	}
 	if e == "" {
 		return ierr.NewError("entity type is required").
@@ -68,7 +82,7 @@ func (e ScheduledTaskEntityType) Validate() error {
 		}
 	}
 	return ierr.NewError("invalid entity type").
-		WithHint("Entity type must be one of: events, invoices, credit_topups").
+		WithHint("Entity type must be one of: events, invoice, credit_topups, credit_usage, plans, subscriptions, wallets, coupons, audit_logs").
 		Mark(ierr.ErrValidation)
 }
 
@@ -0,0 +1,288 @@
+package types
+
+import (
+	"fmt"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// SeatSource determines what drives the seat count of a subscription
+type SeatSource string
+
+const (
+	// SeatSourceAPI seat counts are set explicitly through the seats API
+	SeatSourceAPI SeatSource = "api"
+	// SeatSourceMeter seat counts follow the unique active users reported by a meter
+	SeatSourceMeter SeatSource = "meter"
+)
+
+func (s SeatSource) String() string {
+	return string(s)
+}
+
+func (s SeatSource) Validate() error {
+	allowed := []SeatSource{
+		SeatSourceAPI,
+		SeatSourceMeter,
+	}
+	if !lo.Contains(allowed, s) {
+		return ierr.NewError("invalid seat source").
+			WithHint("Seat source must be one of api or meter").
+			WithReportableDetails(map[string]any{
+				"source":  s,
+				"allowed": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// SeatProrationBehavior determines how a mid-period seat change is billed
+type SeatProrationBehavior string
+
+const (
+	// SeatProrationBehaviorImmediate invoices seat increases and credits seat decreases right away
+	SeatProrationBehaviorImmediate SeatProrationBehavior = "immediate"
+	// SeatProrationBehaviorTrueUp accrues the prorated charge of seat increases and invoices it at period end
+	SeatProrationBehaviorTrueUp SeatProrationBehavior = "true_up"
+	// SeatProrationBehaviorNone applies the new seat count without any prorated charge or credit
+	SeatProrationBehaviorNone SeatProrationBehavior = "none"
+)
+
+func (b SeatProrationBehavior) String() string {
+	return string(b)
+}
+
+func (b SeatProrationBehavior) Validate() error {
+	allowed := []SeatProrationBehavior{
+		SeatProrationBehaviorImmediate,
+		SeatProrationBehaviorTrueUp,
+		SeatProrationBehaviorNone,
+	}
+	if !lo.Contains(allowed, b) {
+		return ierr.NewError("invalid seat proration behavior").
+			WithHint("Seat proration behavior must be one of immediate, true_up or none").
+			WithReportableDetails(map[string]any{
+				"proration_behavior": b,
+				"allowed":            allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// SeatConfig configures seat licensing on a subscription. The seat count is the
+// quantity of the subscription's fixed line item for PriceID.
+type SeatConfig struct {
+	// PriceID is the fixed price whose line item quantity is the seat count
+	PriceID string `json:"price_id"`
+	// Source determines whether seats are set through the API or follow a meter
+	Source SeatSource `json:"source"`
+	// MeterID is the COUNT_UNIQUE meter of active users, required for the meter source
+	MeterID string `json:"meter_id,omitempty"`
+	// IncreaseBehavior determines how seat increases are billed (defaults to immediate)
+	IncreaseBehavior SeatProrationBehavior `json:"increase_behavior"`
+	// DecreaseBehavior determines how seat decreases are billed (defaults to none).
+	// Decreases are never trued up, so only immediate and none are allowed.
+	DecreaseBehavior SeatProrationBehavior `json:"decrease_behavior"`
+	// MinSeats is the lowest seat count the subscription can go down to
+	MinSeats *int64 `json:"min_seats,omitempty"`
+	// MaxSeats is the highest seat count the subscription can go up to
+	MaxSeats *int64 `json:"max_seats,omitempty"`
+	// PerSeatFeatureIDs lists features whose entitlement limits are granted per seat
+	PerSeatFeatureIDs []string `json:"per_seat_feature_ids,omitempty"`
+}
+
+// SetDefaults fills in the default source and proration behaviors
+func (c *SeatConfig) SetDefaults() {
+	if c.Source == "" {
+		c.Source = SeatSourceAPI
+	}
+	if c.IncreaseBehavior == "" {
+		c.IncreaseBehavior = SeatProrationBehaviorImmediate
+	}
+	if c.DecreaseBehavior == "" {
+		c.DecreaseBehavior = SeatProrationBehaviorNone
+	}
+}
+
+func (c *SeatConfig) Validate() error {
+	if c.PriceID == "" {
+		return ierr.NewError("price_id is required").
+			WithHint("Seat config must reference the fixed price that carries the seat count").
+			Mark(ierr.ErrValidation)
+	}
+	if err := c.Source.Validate(); err != nil {
+		return err
+	}
+	if c.Source == SeatSourceMeter && c.MeterID == "" {
+		return ierr.NewError("meter_id is required for metered seats").
+			WithHint("Provide the meter counting unique active users").
+			Mark(ierr.ErrValidation)
+	}
+	if err := c.IncreaseBehavior.Validate(); err != nil {
+		return err
+	}
+	if err := c.DecreaseBehavior.Validate(); err != nil {
+		return err
+	}
+	if c.DecreaseBehavior == SeatProrationBehaviorTrueUp {
+		return ierr.NewError("seat decreases cannot be trued up").
+			WithHint("Use immediate to credit seat decreases or none to skip the credit").
+			Mark(ierr.ErrValidation)
+	}
+	if c.MinSeats != nil && *c.MinSeats < 0 {
+		return ierr.NewError("min_seats cannot be negative").
+			Mark(ierr.ErrValidation)
+	}
+	if c.MinSeats != nil && c.MaxSeats != nil && *c.MaxSeats < *c.MinSeats {
+		return ierr.NewError("max_seats cannot be less than min_seats").
+			WithReportableDetails(map[string]any{
+				"min_seats": *c.MinSeats,
+				"max_seats": *c.MaxSeats,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ValidateSeats checks a seat count against the configured bounds
+func (c *SeatConfig) ValidateSeats(seats int64) error {
+	if seats < 0 {
+		return ierr.NewError("seats cannot be negative").
+			Mark(ierr.ErrValidation)
+	}
+	if c.MinSeats != nil && seats < *c.MinSeats {
+		return ierr.NewError("seats below the minimum").
+			WithHint(fmt.Sprintf("Seat count must be at least %d", *c.MinSeats)).
+			WithReportableDetails(map[string]any{
+				"seats":     seats,
+				"min_seats": *c.MinSeats,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	if c.MaxSeats != nil && seats > *c.MaxSeats {
+		return ierr.NewError("seats above the maximum").
+			WithHint(fmt.Sprintf("Seat count must be at most %d", *c.MaxSeats)).
+			WithReportableDetails(map[string]any{
+				"seats":     seats,
+				"max_seats": *c.MaxSeats,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ClampSeats bounds a seat count to the configured minimum and maximum
+func (c *SeatConfig) ClampSeats(seats int64) int64 {
+	if c.MinSeats != nil && seats < *c.MinSeats {
+		seats = *c.MinSeats
+	}
+	if c.MaxSeats != nil && seats > *c.MaxSeats {
+		seats = *c.MaxSeats
+	}
+	return seats
+}
+
+// BehaviorFor returns the proration behavior configured for a change between seat counts
+func (c *SeatConfig) BehaviorFor(previousSeats, newSeats int64) SeatProrationBehavior {
+	if newSeats < previousSeats {
+		return c.DecreaseBehavior
+	}
+	return c.IncreaseBehavior
+}
+
+// IsPerSeatFeature reports whether entitlements of the feature scale with the seat count
+func (c *SeatConfig) IsPerSeatFeature(featureID string) bool {
+	return c != nil && lo.Contains(c.PerSeatFeatureIDs, featureID)
+}
+
+// SubscriptionSeatChangeFilter defines filters for querying seat changes
+type SubscriptionSeatChangeFilter struct {
+	*QueryFilter
+
+	SubscriptionIDs []string `json:"subscription_ids,omitempty" form:"subscription_ids"`
+	// PendingTrueUp returns accrued seat increases that have not been invoiced yet
+	PendingTrueUp bool `json:"pending_true_up,omitempty" form:"pending_true_up"`
+}
+
+// NewSubscriptionSeatChangeFilter creates a new SubscriptionSeatChangeFilter with default values
+func NewSubscriptionSeatChangeFilter() *SubscriptionSeatChangeFilter {
+	return &SubscriptionSeatChangeFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+// NewNoLimitSubscriptionSeatChangeFilter creates a new SubscriptionSeatChangeFilter with no pagination limits
+func NewNoLimitSubscriptionSeatChangeFilter() *SubscriptionSeatChangeFilter {
+	return &SubscriptionSeatChangeFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+// Validate validates the seat change filter
+func (f *SubscriptionSeatChangeFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetLimit implements BaseFilter interface
+func (f *SubscriptionSeatChangeFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *SubscriptionSeatChangeFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetSort implements BaseFilter interface
+func (f *SubscriptionSeatChangeFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *SubscriptionSeatChangeFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *SubscriptionSeatChangeFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *SubscriptionSeatChangeFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+// IsUnlimited returns true if this is an unlimited query
+func (f *SubscriptionSeatChangeFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"net"
+	"strings"
+
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/samber/lo"
 )
@@ -63,6 +66,64 @@ func (p SecretProvider) Validate() error {
 	return nil
 }
 
+// API key scope actions. GET requests and searches read, every other request writes.
+const (
+	APIKeyScopeActionRead  = "read"
+	APIKeyScopeActionWrite = "write"
+)
+
+// ParseAPIKeyScope splits a scope such as events:write into its resource and action
+func ParseAPIKeyScope(scope string) (resource string, action string, err error) {
+	resource, action, found := strings.Cut(scope, ":")
+	if !found || resource == "" || !lo.Contains([]string{APIKeyScopeActionRead, APIKeyScopeActionWrite, "*"}, action) {
+		return "", "", ierr.NewErrorf("invalid scope %s", scope).
+			WithHintf("Scope %s must look like resource:action, e.g. events:write, with action read, write or *", scope).
+			WithReportableDetails(map[string]any{
+				"scope": scope,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return resource, action, nil
+}
+
+// ValidateAPIKeyScopes checks that every scope is a resource:action pair
+func ValidateAPIKeyScopes(scopes []string) error {
+	for _, scope := range scopes {
+		if _, _, err := ParseAPIKeyScope(scope); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateAllowedIPs checks that every entry is an IP address or a CIDR range
+func ValidateAllowedIPs(ips []string) error {
+	for _, ip := range ips {
+		if net.ParseIP(ip) != nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(ip); err != nil {
+			return ierr.NewErrorf("invalid allowed ip %s", ip).
+				WithHintf("%s is neither an IP address nor a CIDR range such as 10.0.0.0/24", ip).
+				WithReportableDetails(map[string]any{
+					"allowed_ip": ip,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+	return nil
+}
+
+// APIKeyRequest describes a request made with an API key, to check it against the
+// restrictions of the key
+type APIKeyRequest struct {
+	ClientIP string
+	// Resource is the top level route of the request, e.g. events
+	Resource string
+	// Action is read or write
+	Action string
+}
+
 // SecretFilter defines the filter criteria for secrets
 type SecretFilter struct {
 	*QueryFilter
@@ -29,6 +29,8 @@ const (
 	SettingKeyCustomAnalytics          SettingKey = "custom_analytics_config"
 	SettingKeyCustomerPortalConfig     SettingKey = "customer_portal_config"
 	SettingKeyEventIngestionFilter     SettingKey = "event_ingestion_filter"
+	SettingKeyUsageAnomalyConfig       SettingKey = "usage_anomaly_config"
+	SettingKeyUsageRetentionConfig     SettingKey = "usage_retention_config"
 )
 
 func (s *SettingKey) Validate() error {
@@ -44,6 +46,8 @@ func (s *SettingKey) Validate() error {
 		SettingKeyCustomAnalytics,
 		SettingKeyCustomerPortalConfig,
 		SettingKeyEventIngestionFilter,
+		SettingKeyUsageAnomalyConfig,
+		SettingKeyUsageRetentionConfig,
 	}
 
 	if !lo.Contains(allowedKeys, *s) {
@@ -513,6 +517,26 @@ func GetDefaultSettings() (map[SettingKey]DefaultSettingValue, error) {
 		return nil, err
 	}
 
+	defaultUsageAnomalyConfig := UsageAnomalyConfig{
+		Enabled:             false, // Disabled by default, users must explicitly enable
+		WindowHours:         1,
+		BaselineDays:        7,
+		SigmaThreshold:      decimal.NewFromInt(3),
+		PercentageThreshold: decimal.NewFromInt(200),
+		MinBaselineValue:    decimal.NewFromInt(1),
+		DetectDrops:         false,
+	}
+	defaultUsageAnomalyConfigMap, err := utils.ToMap(defaultUsageAnomalyConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultUsageRetentionConfig := UsageRetentionConfig{} // Keep everything until configured
+	defaultUsageRetentionConfigMap, err := utils.ToMap(defaultUsageRetentionConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	return map[SettingKey]DefaultSettingValue{
 		SettingKeyInvoiceConfig: {
 			Key:          SettingKeyInvoiceConfig,
@@ -566,6 +590,16 @@ func GetDefaultSettings() (map[SettingKey]DefaultSettingValue, error) {
 			DefaultValue: defaultEventIngestionFilterConfigMap,
 			Description:  "Controls which external customer IDs are forwarded from raw events to the events pipeline (pilot allowlist)",
 		},
+		SettingKeyUsageAnomalyConfig: {
+			Key:          SettingKeyUsageAnomalyConfig,
+			DefaultValue: defaultUsageAnomalyConfigMap,
+			Description:  "Configuration for usage anomaly detection (rolling baseline window and spike/drop thresholds per customer and meter)",
+		},
+		SettingKeyUsageRetentionConfig: {
+			Key:          SettingKeyUsageRetentionConfig,
+			DefaultValue: defaultUsageRetentionConfigMap,
+			Description:  "Retention in days of raw events, meter usage and usage rollups in ClickHouse (0 keeps data forever)",
+		},
 	}, nil
 }
 
@@ -672,6 +706,20 @@ func ValidateSettingValue(key SettingKey, value map[string]interface{}) error {
 		}
 		return config.Validate()
 
+	case SettingKeyUsageAnomalyConfig:
+		config, err := utils.ToStruct[UsageAnomalyConfig](value)
+		if err != nil {
+			return err
+		}
+		return config.Validate()
+
+	case SettingKeyUsageRetentionConfig:
+		config, err := utils.ToStruct[UsageRetentionConfig](value)
+		if err != nil {
+			return err
+		}
+		return config.Validate()
+
 	default:
 		return ierr.NewErrorf("unknown setting key: %s", key).
 			WithHintf("Unknown setting key: %s", key).
@@ -0,0 +1,32 @@
+package types
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// SSOProtocol is the protocol a single sign-on connection talks to its identity provider
+type SSOProtocol string
+
+const (
+	SSOProtocolOIDC SSOProtocol = "oidc"
+	SSOProtocolSAML SSOProtocol = "saml"
+)
+
+func (p SSOProtocol) Validate() error {
+	allowed := []SSOProtocol{SSOProtocolOIDC, SSOProtocolSAML}
+	if !lo.Contains(allowed, p) {
+		return ierr.NewError("invalid sso protocol").
+			WithHint("SSO protocol must be oidc or saml").
+			WithReportableDetails(map[string]any{
+				"protocol": p,
+				"allowed":  allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// DefaultSSORoleClaim is the claim or attribute read for the groups of a user when a
+// connection does not configure one
+const DefaultSSORoleClaim = "groups"
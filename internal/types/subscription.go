@@ -325,6 +325,10 @@ type SubscriptionFilter struct {
 	TrialEndDueLTE *time.Time `json:"trial_end_due_lte,omitempty" form:"trial_end_due_lte"`
 	// SubscriptionType filters by subscription type
 	SubscriptionTypes []SubscriptionType `json:"subscription_type,omitempty" form:"subscription_type"`
+	// SeatSources filters subscriptions by the source of their seat count
+	SeatSources []SeatSource `json:"seat_sources,omitempty" form:"seat_sources"`
+	// LookupKeys filters by subscription lookup key
+	LookupKeys []string `json:"lookup_keys,omitempty" form:"lookup_keys"`
 
 	// WithLineItems includes line items in the response
 	WithLineItems bool `json:"with_line_items,omitempty" form:"with_line_items"`
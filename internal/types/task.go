@@ -34,10 +34,14 @@ func (t TaskType) Validate() error {
 type EntityType string
 
 const (
-	EntityTypeEvents    EntityType = "EVENTS"
-	EntityTypePrices    EntityType = "PRICES"
-	EntityTypeCustomers EntityType = "CUSTOMERS"
-	EntityTypeFeatures  EntityType = "FEATURES"
+	EntityTypeEvents        EntityType = "EVENTS"
+	EntityTypePrices        EntityType = "PRICES"
+	EntityTypeCustomers     EntityType = "CUSTOMERS"
+	EntityTypeFeatures      EntityType = "FEATURES"
+	EntityTypeSubscriptions EntityType = "SUBSCRIPTIONS"
+	EntityTypePlans         EntityType = "PLANS"
+	EntityTypeWallets       EntityType = "WALLETS"
+	EntityTypeCoupons       EntityType = "COUPONS"
 )
 
 func (e EntityType) String() string {
@@ -50,6 +54,10 @@ func (e EntityType) Validate() error {
 		EntityTypePrices,
 		EntityTypeCustomers,
 		EntityTypeFeatures,
+		EntityTypeSubscriptions,
+		EntityTypePlans,
+		EntityTypeWallets,
+		EntityTypeCoupons,
 	}
 	if !lo.Contains(allowed, e) {
 		return ierr.NewError("invalid entity type").
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/shopspring/decimal"
+)
+
+// UsageAnomalyDirection describes whether usage moved above or below its baseline
+type UsageAnomalyDirection string
+
+const (
+	UsageAnomalyDirectionSpike UsageAnomalyDirection = "spike"
+	UsageAnomalyDirectionDrop  UsageAnomalyDirection = "drop"
+)
+
+// UsageAnomalyConfig is the per-environment setting controlling usage anomaly detection.
+// A customer × meter window is anomalous when it breaches the sigma threshold or the
+// percentage threshold, whichever is configured; zero disables that check.
+type UsageAnomalyConfig struct {
+	Enabled bool `json:"enabled"`
+	// WindowHours is the size of the evaluated usage window and of each baseline bucket.
+	// It must divide a day so windows stay aligned to UTC hour boundaries.
+	WindowHours int `json:"window_hours" validate:"oneof=1 2 3 4 6 8 12 24"`
+	// BaselineDays is how far back the rolling baseline looks
+	BaselineDays int `json:"baseline_days" validate:"min=1,max=30"`
+	// SigmaThreshold flags windows more than this many standard deviations from the mean
+	SigmaThreshold decimal.Decimal `json:"sigma_threshold" swaggertype:"string"`
+	// PercentageThreshold flags windows whose change versus the mean exceeds this percentage
+	PercentageThreshold decimal.Decimal `json:"percentage_threshold" swaggertype:"string"`
+	// MinBaselineValue skips customer × meter pairs whose baseline mean is below this value,
+	// to avoid alerting on noise from barely used meters
+	MinBaselineValue decimal.Decimal `json:"min_baseline_value" swaggertype:"string"`
+	// DetectDrops also alerts when usage falls below the baseline, not only on spikes
+	DetectDrops bool `json:"detect_drops"`
+}
+
+// Validate implements SettingConfig interface
+func (c UsageAnomalyConfig) Validate() error {
+	if c.SigmaThreshold.IsNegative() || c.PercentageThreshold.IsNegative() || c.MinBaselineValue.IsNegative() {
+		return ierr.NewError("usage anomaly thresholds cannot be negative").
+			WithHint("Please provide non-negative sigma, percentage and minimum baseline values").
+			Mark(ierr.ErrValidation)
+	}
+	if c.Enabled && c.SigmaThreshold.IsZero() && c.PercentageThreshold.IsZero() {
+		return ierr.NewError("at least one of sigma_threshold or percentage_threshold is required when enabled").
+			WithHint("Please configure a sigma or percentage threshold").
+			Mark(ierr.ErrValidation)
+	}
+	return validator.ValidateRequest(c)
+}
+
+// Window returns the evaluated window size
+func (c UsageAnomalyConfig) Window() time.Duration {
+	return time.Duration(c.WindowHours) * time.Hour
+}
+
+// BaselineBuckets returns the number of windows that make up the baseline
+func (c UsageAnomalyConfig) BaselineBuckets() int {
+	if c.WindowHours <= 0 {
+		return 0
+	}
+	return c.BaselineDays * 24 / c.WindowHours
+}
+
+// UsageAnomalyInfo captures the baseline comparison behind a usage_anomaly alert
+type UsageAnomalyInfo struct {
+	MeterID          string                `json:"meter_id"`
+	Direction        UsageAnomalyDirection `json:"direction,omitempty"`
+	WindowStart      time.Time             `json:"window_start"`
+	WindowEnd        time.Time             `json:"window_end"`
+	ObservedValue    decimal.Decimal       `json:"observed_value" swaggertype:"string"`
+	BaselineMean     decimal.Decimal       `json:"baseline_mean" swaggertype:"string"`
+	BaselineStdDev   decimal.Decimal       `json:"baseline_std_dev" swaggertype:"string"`
+	Sigma            decimal.Decimal       `json:"sigma" swaggertype:"string"`
+	PercentageChange decimal.Decimal       `json:"percentage_change" swaggertype:"string"`
+	BaselineBuckets  int                   `json:"baseline_buckets"`
+}
+
+// UsageAnomalyDetectionResult summarises one detection run over an environment
+type UsageAnomalyDetectionResult struct {
+	Evaluated int `json:"evaluated"`
+	Anomalies int `json:"anomalies"`
+	Failed    int `json:"failed"`
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// UsageLimitDecision is the outcome of a usage limit check
+type UsageLimitDecision string
+
+const (
+	UsageLimitDecisionAllowed UsageLimitDecision = "allowed"
+	UsageLimitDecisionDenied  UsageLimitDecision = "denied"
+)
+
+// UsageLimitIngestionMode controls how ingestion treats events of customers over a hard limit
+type UsageLimitIngestionMode string
+
+const (
+	UsageLimitIngestionModeOff  UsageLimitIngestionMode = "off"
+	UsageLimitIngestionModeTag  UsageLimitIngestionMode = "tag"
+	UsageLimitIngestionModeDrop UsageLimitIngestionMode = "drop"
+)
+
+// UsageLimitExceededProperty is the event property set on events ingested while the
+// customer is over a hard limit and the ingestion mode is tag
+const UsageLimitExceededProperty = "flexprice_usage_limit_exceeded"
+
+// UsageLimitStatus is the near real-time view of a customer's consumption against a
+// feature's entitlement limit for the current usage reset period
+type UsageLimitStatus struct {
+	CustomerID  string `json:"customer_id"`
+	FeatureID   string `json:"feature_id"`
+	IsEnabled   bool   `json:"is_enabled"`
+	IsUnlimited bool   `json:"is_unlimited"`
+	// UsageLimit is nil when the feature is unlimited
+	UsageLimit   *int64          `json:"usage_limit,omitempty"`
+	IsSoftLimit  bool            `json:"is_soft_limit"`
+	CurrentUsage decimal.Decimal `json:"current_usage" swaggertype:"string"`
+	// Remaining is nil when the feature is unlimited and never negative
+	Remaining        *decimal.Decimal `json:"remaining,omitempty" swaggertype:"string"`
+	NextUsageResetAt *time.Time       `json:"next_usage_reset_at,omitempty"`
+}
+
+// IsLimitReached reports whether current usage has reached a finite limit
+func (s *UsageLimitStatus) IsLimitReached() bool {
+	if s.IsUnlimited || s.UsageLimit == nil {
+		return false
+	}
+	return s.CurrentUsage.GreaterThanOrEqual(decimal.NewFromInt(*s.UsageLimit))
+}
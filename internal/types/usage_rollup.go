@@ -0,0 +1,93 @@
+package types
+
+import (
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/validator"
+)
+
+// UsageRollupGranularity is the bucket size of a meter usage rollup table
+type UsageRollupGranularity string
+
+const (
+	UsageRollupGranularityHour UsageRollupGranularity = "hour"
+	UsageRollupGranularityDay  UsageRollupGranularity = "day"
+)
+
+// Duration returns the bucket size of the granularity
+func (g UsageRollupGranularity) Duration() time.Duration {
+	switch g {
+	case UsageRollupGranularityHour:
+		return time.Hour
+	case UsageRollupGranularityDay:
+		return 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// UsageRollupSource is the usage table a rollup is built from. Each source keeps its own
+// rolled up range since its rollups may have started at a different time.
+type UsageRollupSource string
+
+const (
+	// UsageRollupSourceMeterUsage rolls up meter_usage for meter usage analytics
+	UsageRollupSourceMeterUsage UsageRollupSource = "meter_usage"
+	// UsageRollupSourceFeatureUsage rolls up feature_usage for usage analytics
+	UsageRollupSourceFeatureUsage UsageRollupSource = "feature_usage"
+)
+
+// UsageRetentionConfig is the per-environment setting controlling how long usage data is kept
+// in ClickHouse. Zero keeps the data forever. Feature usage is the billing source of truth and
+// is never expired.
+type UsageRetentionConfig struct {
+	// RawEventRetentionDays applies to the raw_events and events tables
+	RawEventRetentionDays int `json:"raw_event_retention_days" validate:"min=0,max=36500"`
+	// MeterUsageRetentionDays applies to the meter_usage table
+	MeterUsageRetentionDays int `json:"meter_usage_retention_days" validate:"min=0,max=36500"`
+	// RollupRetentionDays applies to the hourly and daily meter and feature usage rollups
+	RollupRetentionDays int `json:"rollup_retention_days" validate:"min=0,max=36500"`
+}
+
+const (
+	// minRawEventRetentionDays leaves room to reprocess events of the current billing period
+	minRawEventRetentionDays = 30
+	// minMeterUsageRetentionDays covers annual billing periods, which are invoiced from meter_usage
+	minMeterUsageRetentionDays = 400
+	minRollupRetentionDays     = 30
+)
+
+// Validate implements SettingConfig interface
+func (c UsageRetentionConfig) Validate() error {
+	if err := validator.ValidateRequest(c); err != nil {
+		return err
+	}
+	if c.RawEventRetentionDays > 0 && c.RawEventRetentionDays < minRawEventRetentionDays {
+		return ierr.NewErrorf("raw_event_retention_days must be 0 or at least %d", minRawEventRetentionDays).
+			WithHintf("Raw events must be kept for at least %d days so usage can be reprocessed", minRawEventRetentionDays).
+			Mark(ierr.ErrValidation)
+	}
+	if c.MeterUsageRetentionDays > 0 && c.MeterUsageRetentionDays < minMeterUsageRetentionDays {
+		return ierr.NewErrorf("meter_usage_retention_days must be 0 or at least %d", minMeterUsageRetentionDays).
+			WithHintf("Meter usage must be kept for at least %d days so annual billing periods can be invoiced", minMeterUsageRetentionDays).
+			Mark(ierr.ErrValidation)
+	}
+	if c.RollupRetentionDays > 0 && c.RollupRetentionDays < minRollupRetentionDays {
+		return ierr.NewErrorf("rollup_retention_days must be 0 or at least %d", minRollupRetentionDays).
+			WithHintf("Usage rollups must be kept for at least %d days", minRollupRetentionDays).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// RollupRetention returns how long rollups are kept, zero meaning forever
+func (c UsageRetentionConfig) RollupRetention() time.Duration {
+	return time.Duration(c.RollupRetentionDays) * 24 * time.Hour
+}
+
+// UsageRollupRunResult summarises one rollup run over an environment
+type UsageRollupRunResult struct {
+	HourlyBuckets int `json:"hourly_buckets"`
+	DailyBuckets  int `json:"daily_buckets"`
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// UsageStreamEventType represents the kind of message pushed on a usage stream
+type UsageStreamEventType string
+
+const (
+	// UsageStreamEventTypeUsage is emitted for every feature_usage record written by the tracking pipeline
+	UsageStreamEventTypeUsage UsageStreamEventType = "usage.delta"
+	// UsageStreamEventTypeWalletBalance is emitted when a customer's wallet real-time balance is recomputed
+	UsageStreamEventTypeWalletBalance UsageStreamEventType = "wallet.balance"
+	// UsageStreamEventTypeHeartbeat is a keep-alive frame with no payload, never buffered or replayed
+	UsageStreamEventTypeHeartbeat UsageStreamEventType = "heartbeat"
+)
+
+func (t UsageStreamEventType) Validate() error {
+	allowedTypes := []UsageStreamEventType{
+		UsageStreamEventTypeUsage,
+		UsageStreamEventTypeWalletBalance,
+	}
+	if !lo.Contains(allowedTypes, t) {
+		return ierr.NewError("invalid usage stream event type").
+			WithHint("Please provide a valid usage stream event type").
+			WithReportableDetails(map[string]any{
+				"allowed": allowedTypes,
+				"type":    t,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// UsageStreamEvent is a single delta pushed to usage stream subscribers.
+// Cursor is assigned by the node delivering the event and is the resume cursor (SSE Last-Event-ID).
+type UsageStreamEvent struct {
+	ID                 string               `json:"id"`
+	Cursor             string               `json:"cursor,omitempty"`
+	Type               UsageStreamEventType `json:"type"`
+	TenantID           string               `json:"tenant_id"`
+	EnvironmentID      string               `json:"environment_id"`
+	CustomerID         string               `json:"customer_id"`
+	ExternalCustomerID string               `json:"external_customer_id,omitempty"`
+	Timestamp          time.Time            `json:"timestamp"`
+
+	// Usage delta fields, set for usage.delta events
+	EventID        string           `json:"event_id,omitempty"`
+	EventName      string           `json:"event_name,omitempty"`
+	FeatureID      string           `json:"feature_id,omitempty"`
+	MeterID        string           `json:"meter_id,omitempty"`
+	SubscriptionID string           `json:"subscription_id,omitempty"`
+	PriceID        string           `json:"price_id,omitempty"`
+	Quantity       *decimal.Decimal `json:"quantity,omitempty" swaggertype:"string"`
+
+	// Wallet balance fields, set for wallet.balance events
+	WalletID              string           `json:"wallet_id,omitempty"`
+	Currency              string           `json:"currency,omitempty"`
+	RealTimeBalance       *decimal.Decimal `json:"real_time_balance,omitempty" swaggertype:"string"`
+	RealTimeCreditBalance *decimal.Decimal `json:"real_time_credit_balance,omitempty" swaggertype:"string"`
+	BalanceDelta          *decimal.Decimal `json:"balance_delta,omitempty" swaggertype:"string"`
+}
+
+// StreamKey returns the fan-out key for the event: tenant, environment and customer
+func (e *UsageStreamEvent) StreamKey() string {
+	return UsageStreamKey(e.TenantID, e.EnvironmentID, e.CustomerID)
+}
+
+// UsageStreamKey builds the per-customer fan-out key used by usage stream subscribers
+func UsageStreamKey(tenantID, environmentID, customerID string) string {
+	return strings.Join([]string{tenantID, environmentID, customerID}, ":")
+}
+
+// UsageStreamFilter restricts a usage stream subscription
+type UsageStreamFilter struct {
+	// CustomerID is resolved by the handler from the path, query or portal session
+	CustomerID string `json:"-" form:"-"`
+	// FeatureIDs limits usage.delta events to the given features
+	FeatureIDs []string `json:"feature_ids,omitempty" form:"feature_ids"`
+	// MeterIDs limits usage.delta events to the given meters
+	MeterIDs []string `json:"meter_ids,omitempty" form:"meter_ids"`
+	// Types limits the stream to the given event types, defaults to all
+	Types []UsageStreamEventType `json:"types,omitempty" form:"types"`
+	// Cursor resumes the stream after the event with the given cursor; the Last-Event-ID header takes precedence
+	Cursor string `json:"cursor,omitempty" form:"cursor"`
+}
+
+func (f *UsageStreamFilter) Validate() error {
+	if f.CustomerID == "" {
+		return ierr.NewError("customer_id is required").
+			WithHint("Please provide a customer to stream usage for").
+			Mark(ierr.ErrValidation)
+	}
+	for _, t := range f.Types {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Matches reports whether the event should be delivered to a subscriber using this filter.
+// Feature and meter filters only narrow usage deltas; wallet balance events always pass them.
+func (f *UsageStreamFilter) Matches(e *UsageStreamEvent) bool {
+	if e == nil {
+		return false
+	}
+	if len(f.Types) > 0 && !lo.Contains(f.Types, e.Type) {
+		return false
+	}
+	if e.Type != UsageStreamEventTypeUsage {
+		return true
+	}
+	if len(f.FeatureIDs) > 0 && !lo.Contains(f.FeatureIDs, e.FeatureID) {
+		return false
+	}
+	if len(f.MeterIDs) > 0 && !lo.Contains(f.MeterIDs, e.MeterID) {
+		return false
+	}
+	return true
+}
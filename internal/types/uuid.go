@@ -101,6 +101,19 @@ const (
 	UUID_PREFIX_COUPON                     = "coupon"
 	UUID_PREFIX_COUPON_ASSOCIATION         = "coupon_assoc"
 	UUID_PREFIX_COUPON_APPLICATION         = "coupon_app"
+	UUID_PREFIX_PROMOTION_CODE             = "promo"
+	UUID_PREFIX_FX_RATE                    = "fxr"
+	UUID_PREFIX_PRICE_BOOK                 = "pbook"
+	UUID_PREFIX_COMMITMENT_CONTRACT        = "cmtc"
+	UUID_PREFIX_COMMITMENT_DRAWDOWN        = "cmtd"
+	UUID_PREFIX_SUBSCRIPTION_SEAT_CHANGE   = "seat"
+	UUID_PREFIX_WEBHOOK_ENDPOINT           = "whep"
+	UUID_PREFIX_WEBHOOK_DELIVERY_ATTEMPT   = "whatt"
+	UUID_PREFIX_RBAC_ROLE                  = "role"
+	UUID_PREFIX_AUDIT_LOG                  = "audit"
+	UUID_PREFIX_SSO_CONNECTION             = "sso"
+	UUID_PREFIX_INVITATION                 = "invite"
+	UUID_PREFIX_TENANT_MEMBERSHIP          = "member"
 	UUID_PREFIX_PRICE_UNIT                 = "price_unit"
 	UUID_PREFIX_ADDON                      = "addon"
 	UUID_PREFIX_ADDON_ASSOCIATION          = "addon_assoc"
@@ -68,6 +68,9 @@ const (
 	TransactionReasonManualBalanceDebit      TransactionReason = "MANUAL_BALANCE_DEBIT"
 	TransactionReasonCreditAdjustment        TransactionReason = "CREDIT_ADJUSTMENT"
 	TransactionReasonInvoiceVoidRefund       TransactionReason = "INVOICE_VOID_REFUND"
+	// TransactionReasonCreditRollover tags both sides of a rollover: the debit of the unused
+	// credits of a grant period and the credit carrying them into the next period
+	TransactionReasonCreditRollover TransactionReason = "CREDIT_ROLLOVER"
 )
 
 func (t TransactionReason) Validate() error {
@@ -87,6 +90,7 @@ func (t TransactionReason) Validate() error {
 		string(TransactionReasonManualBalanceDebit),
 		string(TransactionReasonCreditAdjustment),
 		string(TransactionReasonInvoiceVoidRefund),
+		string(TransactionReasonCreditRollover),
 	}
 	if !lo.Contains(allowedValues, string(t)) {
 		return ierr.NewError("invalid transaction reason").
@@ -157,6 +161,8 @@ type WalletTransactionFilter struct {
 	TransactionReason  *TransactionReason `json:"transaction_reason,omitempty" form:"transaction_reason"`
 	Priority           *int               `json:"priority,omitempty" form:"priority"`
 	CreatedBy          *string            `json:"created_by,omitempty" form:"created_by"`
+	// FXConverted returns only debits that settled an amount in another currency
+	FXConverted *bool `json:"fx_converted,omitempty" form:"fx_converted"`
 }
 
 func NewWalletTransactionFilter() *WalletTransactionFilter {
@@ -281,6 +287,9 @@ type WalletConfig struct {
 	// AllowedPriceTypes is a list of price types that are allowed for the wallet
 	// nil means all price types are allowed
 	AllowedPriceTypes []WalletConfigPriceType `json:"allowed_price_types,omitempty"`
+	// FXConversionEnabled allows the wallet to pay invoices in other currencies,
+	// converting the debit at the FX rate effective at the time of payment
+	FXConversionEnabled bool `json:"fx_conversion_enabled,omitempty"`
 }
 
 func GetDefaultWalletConfig() *WalletConfig {
@@ -319,6 +328,9 @@ const (
 	CreditExpirySkipReasonNone               CreditExpirySkipReason = ""
 	CreditExpirySkipReasonActiveSubscription CreditExpirySkipReason = "active_subscription"
 	CreditExpirySkipReasonActiveInvoice      CreditExpirySkipReason = "active_invoice"
+	// CreditExpirySkipReasonRolloverPending means the credits can still roll into a grant period
+	// that has not been applied yet
+	CreditExpirySkipReasonRolloverPending CreditExpirySkipReason = "rollover_pending"
 )
 
 // ExpireCreditsResult is the result of attempting to expire a single credit transaction.
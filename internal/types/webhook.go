@@ -20,6 +20,21 @@ type WebhookEvent struct {
 	Payload       json.RawMessage  `json:"payload"`
 	EntityType    SystemEntityType `json:"entity_type,omitempty"`
 	EntityID      string           `json:"entity_id,omitempty"`
+	// SequenceNumber is the position of the event among the events of its entity, starting
+	// at 1, so receivers can order deliveries and detect gaps
+	SequenceNumber *int64 `json:"sequence_number,omitempty"`
+	// Snapshot is the payload built when the event was first delivered. It is loaded with
+	// persisted events and never published.
+	Snapshot json.RawMessage `json:"-"`
+}
+
+// PartitionKey returns the key webhook events are partitioned by, so the events of one entity
+// are consumed in the order they were published
+func (e *WebhookEvent) PartitionKey() string {
+	if e.EntityID == "" {
+		return e.TenantID
+	}
+	return e.TenantID + ":" + e.EnvironmentID + ":" + string(e.EntityType) + ":" + e.EntityID
 }
 
 // subscription event names
@@ -31,6 +46,7 @@ const (
 	WebhookEventSubscriptionPaused       WebhookEventName = "subscription.paused"
 	WebhookEventSubscriptionCancelled    WebhookEventName = "subscription.cancelled"
 	WebhookEventSubscriptionResumed      WebhookEventName = "subscription.resumed"
+	WebhookEventSubscriptionSeatsUpdated WebhookEventName = "subscription.seats.updated"
 )
 
 // subscription phase event names
@@ -53,6 +69,9 @@ const (
 	WebhookEventEntitlementCreated WebhookEventName = "entitlement.created"
 	WebhookEventEntitlementUpdated WebhookEventName = "entitlement.updated"
 	WebhookEventEntitlementDeleted WebhookEventName = "entitlement.deleted"
+	// WebhookEventEntitlementLimitReached fires once per usage reset period when a
+	// customer's consumption of a metered feature reaches its entitlement limit
+	WebhookEventEntitlementLimitReached WebhookEventName = "entitlement.limit.reached"
 )
 
 // wallet event names
@@ -98,6 +117,9 @@ const (
 
 	// cron driven webhook event names
 	WebhookEventSubscriptionRenewalDue WebhookEventName = "subscription.renewal.due"
+
+	WebhookEventUsageAnomalyDetected WebhookEventName = "usage.anomaly.detected"
+	WebhookEventUsageAnomalyResolved WebhookEventName = "usage.anomaly.resolved"
 )
 
 // communication event names
@@ -110,3 +132,10 @@ const (
 	WebhookEventCreditNoteCreated WebhookEventName = "credit_note.created"
 	WebhookEventCreditNoteUpdated WebhookEventName = "credit_note.updated"
 )
+
+// webhook endpoint event names
+const (
+	// WebhookEventWebhookEndpointDisabled fires when an endpoint is disabled after failing
+	// every delivery for longer than the configured auto-disable window
+	WebhookEventWebhookEndpointDisabled WebhookEventName = "webhook_endpoint.disabled"
+)
@@ -0,0 +1,118 @@
+package types
+
+// WebhookDeliveryAttemptFilter represents filters for webhook delivery attempt queries
+type WebhookDeliveryAttemptFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	Filters []*FilterCondition `json:"filters,omitempty" form:"filters" validate:"omitempty"`
+	Sort    []*SortCondition   `json:"sort,omitempty" form:"sort" validate:"omitempty"`
+
+	SystemEventIDs     []string `json:"system_event_ids,omitempty" form:"system_event_ids"`
+	WebhookEndpointIDs []string `json:"webhook_endpoint_ids,omitempty" form:"webhook_endpoint_ids"`
+	EventNames         []string `json:"event_names,omitempty" form:"event_names"`
+	Success            *bool    `json:"success,omitempty" form:"success"`
+}
+
+// NewWebhookDeliveryAttemptFilter creates a new WebhookDeliveryAttemptFilter with default values
+func NewWebhookDeliveryAttemptFilter() *WebhookDeliveryAttemptFilter {
+	return &WebhookDeliveryAttemptFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+// NewNoLimitWebhookDeliveryAttemptFilter creates a new WebhookDeliveryAttemptFilter with no pagination limits
+func NewNoLimitWebhookDeliveryAttemptFilter() *WebhookDeliveryAttemptFilter {
+	return &WebhookDeliveryAttemptFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+// Validate validates the webhook delivery attempt filter
+func (f *WebhookDeliveryAttemptFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, filter := range f.Filters {
+		if filter != nil {
+			if err := filter.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, sort := range f.Sort {
+		if sort != nil {
+			if err := sort.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetLimit implements BaseFilter interface
+func (f *WebhookDeliveryAttemptFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *WebhookDeliveryAttemptFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetSort implements BaseFilter interface
+func (f *WebhookDeliveryAttemptFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *WebhookDeliveryAttemptFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *WebhookDeliveryAttemptFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *WebhookDeliveryAttemptFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+// IsUnlimited returns true if this is an unlimited query
+func (f *WebhookDeliveryAttemptFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
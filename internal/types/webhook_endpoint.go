@@ -0,0 +1,230 @@
+package types
+
+import (
+	"strings"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// WebhookEventWildcard subscribes an endpoint to every event
+const WebhookEventWildcard = "*"
+
+// ValidateWebhookEventPattern validates an event subscription of a webhook endpoint. A pattern is
+// an event name such as invoice.update.finalized, a prefix wildcard such as invoice.* or *.
+func ValidateWebhookEventPattern(pattern string) error {
+	if pattern == WebhookEventWildcard {
+		return nil
+	}
+
+	name := strings.TrimSuffix(pattern, ".*")
+	if name == "" || strings.ContainsAny(name, "* \t\n") {
+		return ierr.NewError("invalid webhook event pattern").
+			WithHintf("Event %q must be an event name, a prefix ending in .* or *", pattern).
+			WithReportableDetails(map[string]any{
+				"event": pattern,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	for _, part := range strings.Split(name, ".") {
+		if part == "" {
+			return ierr.NewError("invalid webhook event pattern").
+				WithHintf("Event %q has an empty segment", pattern).
+				WithReportableDetails(map[string]any{
+					"event": pattern,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+	return nil
+}
+
+// MatchWebhookEventPattern reports whether an event name matches a subscription pattern.
+// invoice.* matches invoice.update and invoice.update.finalized but not invoice itself.
+func MatchWebhookEventPattern(pattern string, eventName WebhookEventName) bool {
+	if pattern == WebhookEventWildcard {
+		return true
+	}
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(eventName, prefix)
+	}
+	return pattern == eventName
+}
+
+// WebhookEndpointFilter represents filters for webhook endpoint queries
+type WebhookEndpointFilter struct {
+	*QueryFilter
+
+	Filters []*FilterCondition `json:"filters,omitempty" form:"filters" validate:"omitempty"`
+	Sort    []*SortCondition   `json:"sort,omitempty" form:"sort" validate:"omitempty"`
+
+	WebhookEndpointIDs []string `json:"webhook_endpoint_ids,omitempty" form:"webhook_endpoint_ids"`
+	Enabled            *bool    `json:"enabled,omitempty" form:"enabled"`
+}
+
+// NewWebhookEndpointFilter creates a new WebhookEndpointFilter with default values
+func NewWebhookEndpointFilter() *WebhookEndpointFilter {
+	return &WebhookEndpointFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+// NewNoLimitWebhookEndpointFilter creates a new WebhookEndpointFilter with no pagination limits
+func NewNoLimitWebhookEndpointFilter() *WebhookEndpointFilter {
+	return &WebhookEndpointFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+// Validate validates the webhook endpoint filter
+func (f *WebhookEndpointFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, filter := range f.Filters {
+		if filter != nil {
+			if err := filter.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, sort := range f.Sort {
+		if sort != nil {
+			if err := sort.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetLimit implements BaseFilter interface
+func (f *WebhookEndpointFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *WebhookEndpointFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetSort implements BaseFilter interface
+func (f *WebhookEndpointFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *WebhookEndpointFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *WebhookEndpointFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *WebhookEndpointFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+// IsUnlimited returns true if this is an unlimited query
+func (f *WebhookEndpointFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// WebhookPayloadVersion is the schema version webhook payloads are rendered in. An endpoint pins
+// a version so changes to the payload schema never reach it unannounced.
+type WebhookPayloadVersion string
+
+const (
+	// WebhookPayloadVersionV1 is the event type next to a snapshot of the entity
+	WebhookPayloadVersionV1 WebhookPayloadVersion = "v1"
+	// WebhookPayloadVersionV2 wraps the snapshot in an envelope with the event id, entity and sequence number
+	WebhookPayloadVersionV2 WebhookPayloadVersion = "v2"
+
+	// WebhookPayloadVersionLatest is the version new endpoints are pinned to
+	WebhookPayloadVersionLatest = WebhookPayloadVersionV2
+)
+
+func (v WebhookPayloadVersion) String() string {
+	return string(v)
+}
+
+func (v WebhookPayloadVersion) Validate() error {
+	allowed := []WebhookPayloadVersion{
+		WebhookPayloadVersionV1,
+		WebhookPayloadVersionV2,
+	}
+
+	if v != "" && !lo.Contains(allowed, v) {
+		return ierr.NewError("invalid webhook payload version").
+			WithHint("Webhook payload version must be one of the supported versions").
+			WithReportableDetails(map[string]any{
+				"payload_version":  v,
+				"allowed_versions": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// WebhookPayloadMode is how much of an event a webhook delivery carries
+type WebhookPayloadMode string
+
+const (
+	// WebhookPayloadModeFull delivers the snapshot of the entity with the event
+	WebhookPayloadModeFull WebhookPayloadMode = "full"
+	// WebhookPayloadModeThin delivers the ids of the event and entity only, the snapshot is
+	// fetched from GET /events/system/:id
+	WebhookPayloadModeThin WebhookPayloadMode = "thin"
+)
+
+func (m WebhookPayloadMode) String() string {
+	return string(m)
+}
+
+func (m WebhookPayloadMode) Validate() error {
+	allowed := []WebhookPayloadMode{
+		WebhookPayloadModeFull,
+		WebhookPayloadModeThin,
+	}
+
+	if m != "" && !lo.Contains(allowed, m) {
+		return ierr.NewError("invalid webhook payload mode").
+			WithHint("Webhook payload mode must be full or thin").
+			WithReportableDetails(map[string]any{
+				"payload_mode":  m,
+				"allowed_modes": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
@@ -6,11 +6,13 @@ import (
 )
 
 type InternalAlertEvent struct {
-	FeatureID   string `json:"feature_id,omitempty"`
-	WalletID    string `json:"wallet_id,omitempty"`
-	CustomerID  string `json:"customer_id,omitempty"`
-	AlertType   string `json:"alert_type"`
-	AlertStatus string `json:"alert_status"`
+	FeatureID    string                  `json:"feature_id,omitempty"`
+	WalletID     string                  `json:"wallet_id,omitempty"`
+	MeterID      string                  `json:"meter_id,omitempty"`
+	CustomerID   string                  `json:"customer_id,omitempty"`
+	AlertType    string                  `json:"alert_type"`
+	AlertStatus  string                  `json:"alert_status"`
+	UsageAnomaly *types.UsageAnomalyInfo `json:"usage_anomaly,omitempty"`
 }
 
 type AlertWebhookPayload struct {
@@ -22,6 +24,19 @@ type AlertWebhookPayload struct {
 	Customer    *dto.CustomerResponse  `json:"customer,omitempty"`
 }
 
+type UsageAnomalyWebhookPayload struct {
+	EventType    types.WebhookEventName  `json:"event_type"`
+	AlertType    string                  `json:"alert_type"`
+	AlertStatus  string                  `json:"alert_status"`
+	UsageAnomaly *types.UsageAnomalyInfo `json:"usage_anomaly,omitempty"`
+	Meter        *dto.MeterResponse      `json:"meter,omitempty"`
+	Customer     *dto.CustomerResponse   `json:"customer,omitempty"`
+}
+
+func NewUsageAnomalyWebhookPayload(meter *dto.MeterResponse, customer *dto.CustomerResponse, anomaly *types.UsageAnomalyInfo, alertType string, alertStatus string, eventType types.WebhookEventName) *UsageAnomalyWebhookPayload {
+	return &UsageAnomalyWebhookPayload{EventType: eventType, AlertType: alertType, AlertStatus: alertStatus, UsageAnomaly: anomaly, Meter: meter, Customer: customer}
+}
+
 func NewAlertWebhookPayload(feature *dto.FeatureResponse, wallet *dto.WalletResponse, customer *dto.CustomerResponse, alertType string, alertStatus string, eventType types.WebhookEventName) *AlertWebhookPayload {
 	return &AlertWebhookPayload{EventType: eventType, AlertType: alertType, AlertStatus: alertStatus, Feature: feature, Wallet: wallet, Customer: customer}
 }
@@ -10,6 +10,12 @@ type InternalEntitlementEvent struct {
 	TenantID      string `json:"tenant_id"`
 }
 
+// InternalEntitlementLimitEvent carries the usage snapshot that crossed a limit
+type InternalEntitlementLimitEvent struct {
+	TenantID string                  `json:"tenant_id"`
+	Status   *types.UsageLimitStatus `json:"status"`
+}
+
 type EntitlementWebhookPayload struct {
 	EventType   types.WebhookEventName   `json:"event_type"`
 	Entitlement *dto.EntitlementResponse `json:"entitlement"`
@@ -18,3 +24,14 @@ type EntitlementWebhookPayload struct {
 func NewEntitlementWebhookPayload(entitlement *dto.EntitlementResponse, eventType types.WebhookEventName) *EntitlementWebhookPayload {
 	return &EntitlementWebhookPayload{EventType: eventType, Entitlement: entitlement}
 }
+
+type EntitlementLimitReachedWebhookPayload struct {
+	EventType types.WebhookEventName  `json:"event_type"`
+	Customer  *dto.CustomerResponse   `json:"customer"`
+	Feature   *dto.FeatureResponse    `json:"feature"`
+	Usage     *types.UsageLimitStatus `json:"usage"`
+}
+
+func NewEntitlementLimitReachedWebhookPayload(customer *dto.CustomerResponse, feature *dto.FeatureResponse, usage *types.UsageLimitStatus, eventType types.WebhookEventName) *EntitlementLimitReachedWebhookPayload {
+	return &EntitlementLimitReachedWebhookPayload{EventType: eventType, Customer: customer, Feature: feature, Usage: usage}
+}
@@ -0,0 +1,36 @@
+package webhookDto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// ThinWebhookPayload is a v1 thin delivery. It identifies the event and its entity only, the
+// snapshot is fetched from GET /events/system/:id.
+type ThinWebhookPayload struct {
+	EventType      types.WebhookEventName `json:"event_type"`
+	EventID        string                 `json:"event_id"`
+	EntityType     types.SystemEntityType `json:"entity_type,omitempty"`
+	EntityID       string                 `json:"entity_id,omitempty"`
+	SequenceNumber *int64                 `json:"sequence_number,omitempty"`
+}
+
+// WebhookEnvelope is a v2 delivery. Data holds the snapshot of the entity and is left out of
+// thin deliveries.
+type WebhookEnvelope struct {
+	ID             string                      `json:"id"`
+	Type           types.WebhookEventName      `json:"type"`
+	Version        types.WebhookPayloadVersion `json:"version"`
+	CreatedAt      time.Time                   `json:"created_at"`
+	SequenceNumber *int64                      `json:"sequence_number,omitempty"`
+	Entity         *WebhookEnvelopeEntity      `json:"entity,omitempty"`
+	Data           json.RawMessage             `json:"data,omitempty"`
+}
+
+// WebhookEnvelopeEntity identifies the entity an event is about
+type WebhookEnvelopeEntity struct {
+	Type types.SystemEntityType `json:"type"`
+	ID   string                 `json:"id"`
+}
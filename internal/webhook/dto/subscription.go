@@ -24,3 +24,27 @@ func NewSubscriptionWebhookPayload(subscription *dto.SubscriptionResponse, event
 		Subscription: subscription,
 	}
 }
+
+// InternalSubscriptionSeatsEvent is published when the seat count of a subscription changes
+type InternalSubscriptionSeatsEvent struct {
+	EventType      types.WebhookEventName              `json:"event_type"`
+	SubscriptionID string                              `json:"subscription_id"`
+	SeatChange     *dto.SubscriptionSeatChangeResponse `json:"seat_change"`
+	TenantID       string                              `json:"tenant_id"`
+	EnvironmentID  string                              `json:"environment_id"`
+}
+
+// SubscriptionSeatsWebhookPayload represents the payload for subscription seat change webhooks
+type SubscriptionSeatsWebhookPayload struct {
+	EventType    types.WebhookEventName              `json:"event_type"`
+	Subscription *dto.SubscriptionResponse           `json:"subscription"`
+	SeatChange   *dto.SubscriptionSeatChangeResponse `json:"seat_change"`
+}
+
+func NewSubscriptionSeatsWebhookPayload(subscription *dto.SubscriptionResponse, seatChange *dto.SubscriptionSeatChangeResponse, eventType types.WebhookEventName) *SubscriptionSeatsWebhookPayload {
+	return &SubscriptionSeatsWebhookPayload{
+		EventType:    eventType,
+		Subscription: subscription,
+		SeatChange:   seatChange,
+	}
+}
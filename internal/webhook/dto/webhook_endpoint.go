@@ -0,0 +1,28 @@
+package webhookDto
+
+import (
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// InternalWebhookEndpointEvent is published when a webhook endpoint is disabled automatically.
+// It carries the endpoint as it was disabled, including its failure streak.
+type InternalWebhookEndpointEvent struct {
+	EventType       types.WebhookEventName       `json:"event_type"`
+	WebhookEndpoint *dto.WebhookEndpointResponse `json:"webhook_endpoint"`
+	TenantID        string                       `json:"tenant_id"`
+	EnvironmentID   string                       `json:"environment_id"`
+}
+
+// WebhookEndpointWebhookPayload represents the payload for webhook endpoint webhooks
+type WebhookEndpointWebhookPayload struct {
+	EventType       types.WebhookEventName       `json:"event_type"`
+	WebhookEndpoint *dto.WebhookEndpointResponse `json:"webhook_endpoint"`
+}
+
+func NewWebhookEndpointWebhookPayload(endpoint *dto.WebhookEndpointResponse, eventType types.WebhookEventName) *WebhookEndpointWebhookPayload {
+	return &WebhookEndpointWebhookPayload{
+		EventType:       eventType,
+		WebhookEndpoint: endpoint,
+	}
+}
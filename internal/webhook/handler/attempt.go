@@ -0,0 +1,348 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/webhookdeliveryattempt"
+	"github.com/flexprice/flexprice/internal/domain/webhookendpoint"
+	"github.com/flexprice/flexprice/internal/email"
+	"github.com/flexprice/flexprice/internal/httpclient"
+	"github.com/flexprice/flexprice/internal/rbac"
+	"github.com/flexprice/flexprice/internal/types"
+	webhookDto "github.com/flexprice/flexprice/internal/webhook/dto"
+	"github.com/flexprice/flexprice/internal/webhook/signature"
+	"github.com/samber/lo"
+)
+
+const (
+	// maxAttemptRequestBodyBytes caps the payload kept on a delivery attempt
+	maxAttemptRequestBodyBytes = 64 * 1024
+	// maxAttemptResponseBodyBytes caps the response excerpt kept on a delivery attempt
+	maxAttemptResponseBodyBytes = 4 * 1024
+
+	redactedHeaderValue = "[REDACTED]"
+)
+
+// nextAttemptNumber returns the number of the next delivery of the event to the endpoint
+func (h *handler) nextAttemptNumber(ctx context.Context, event *types.WebhookEvent, endpoint *webhookendpoint.WebhookEndpoint) int {
+	if h.attemptRepo == nil || event.ID == "" {
+		return 1
+	}
+
+	filter := types.NewNoLimitWebhookDeliveryAttemptFilter()
+	filter.SystemEventIDs = []string{event.ID}
+	filter.WebhookEndpointIDs = []string{endpoint.ID}
+
+	count, err := h.attemptRepo.Count(ctx, filter)
+	if err != nil {
+		h.logger.Warnw("failed to count webhook delivery attempts",
+			"error", err,
+			"event_id", event.ID,
+			"webhook_endpoint_id", endpoint.ID,
+		)
+		return 1
+	}
+	return count + 1
+}
+
+// undeliveredEndpoints drops the endpoints that already accepted the event. Endpoints whose
+// attempts cannot be loaded are kept, delivering twice is safer than not delivering.
+func (h *handler) undeliveredEndpoints(ctx context.Context, event *types.WebhookEvent, endpoints []*webhookendpoint.WebhookEndpoint) []*webhookendpoint.WebhookEndpoint {
+	if h.attemptRepo == nil || event.ID == "" {
+		return endpoints
+	}
+
+	filter := types.NewNoLimitWebhookDeliveryAttemptFilter()
+	filter.SystemEventIDs = []string{event.ID}
+	filter.WebhookEndpointIDs = lo.Map(endpoints, func(e *webhookendpoint.WebhookEndpoint, _ int) string { return e.ID })
+	filter.Success = lo.ToPtr(true)
+
+	delivered, err := h.attemptRepo.List(ctx, filter)
+	if err != nil {
+		h.logger.Warnw("failed to list successful webhook delivery attempts",
+			"error", err,
+			"event_id", event.ID,
+		)
+		return endpoints
+	}
+
+	deliveredIDs := lo.SliceToMap(delivered, func(a *webhookdeliveryattempt.WebhookDeliveryAttempt) (string, struct{}) {
+		return a.WebhookEndpointID, struct{}{}
+	})
+	return lo.Filter(endpoints, func(e *webhookendpoint.WebhookEndpoint, _ int) bool {
+		_, ok := deliveredIDs[e.ID]
+		return !ok
+	})
+}
+
+// recordAttempt persists a delivery attempt. Failures are logged and never fail the delivery.
+func (h *handler) recordAttempt(
+	ctx context.Context,
+	event *types.WebhookEvent,
+	endpoint *webhookendpoint.WebhookEndpoint,
+	req *httpclient.Request,
+	attemptNumber int,
+	resp *httpclient.Response,
+	sendErr error,
+	latency time.Duration,
+) {
+	if h.attemptRepo == nil || event.ID == "" {
+		return
+	}
+
+	attempt := &webhookdeliveryattempt.WebhookDeliveryAttempt{
+		ID:                types.GenerateUUIDWithPrefix(types.UUID_PREFIX_WEBHOOK_DELIVERY_ATTEMPT),
+		SystemEventID:     event.ID,
+		WebhookEndpointID: endpoint.ID,
+		EventName:         event.EventName,
+		AttemptNumber:     attemptNumber,
+		RequestURL:        req.URL,
+		RequestHeaders:    attemptRequestHeaders(endpoint, req.Headers),
+		RequestBody:       excerpt(req.Body, maxAttemptRequestBodyBytes),
+		LatencyMs:         latency.Milliseconds(),
+		Success:           sendErr == nil,
+		EnvironmentID:     event.EnvironmentID,
+		BaseModel:         types.GetDefaultBaseModel(ctx),
+	}
+
+	if resp != nil {
+		attempt.ResponseStatusCode = lo.ToPtr(resp.StatusCode)
+		attempt.ResponseBody = excerpt(resp.Body, maxAttemptResponseBodyBytes)
+	}
+
+	if sendErr != nil {
+		if httpErr, ok := httpclient.IsHTTPError(sendErr); ok {
+			attempt.ResponseStatusCode = lo.ToPtr(httpErr.StatusCode)
+			attempt.ResponseBody = excerpt(httpErr.Response, maxAttemptResponseBodyBytes)
+			attempt.ErrorMessage = lo.ToPtr(fmt.Sprintf("endpoint responded with status %d", httpErr.StatusCode))
+		} else {
+			attempt.ErrorMessage = lo.ToPtr(sendErr.Error())
+		}
+	}
+
+	if err := h.attemptRepo.Create(ctx, attempt); err != nil {
+		h.logger.Warnw("failed to record webhook delivery attempt",
+			"error", err,
+			"event_id", event.ID,
+			"webhook_endpoint_id", endpoint.ID,
+		)
+	}
+}
+
+// recordEndpointHealth updates the failure streak of the endpoint and disables it once it
+// has been failing for longer than the configured window
+func (h *handler) recordEndpointHealth(ctx context.Context, endpoint *webhookendpoint.WebhookEndpoint, success bool, at time.Time) {
+	if h.endpointRepo == nil {
+		return
+	}
+
+	if success {
+		if err := h.endpointRepo.RecordDeliverySuccess(ctx, endpoint.ID, at); err != nil {
+			h.logger.Warnw("failed to record webhook endpoint delivery",
+				"error", err,
+				"webhook_endpoint_id", endpoint.ID,
+			)
+		}
+		return
+	}
+
+	updated, err := h.endpointRepo.RecordDeliveryFailure(ctx, endpoint.ID, at)
+	if err != nil {
+		h.logger.Warnw("failed to record webhook endpoint delivery failure",
+			"error", err,
+			"webhook_endpoint_id", endpoint.ID,
+		)
+		return
+	}
+
+	window := h.config.EndpointAutoDisableAfter
+	if window <= 0 || !updated.Enabled || !updated.FailingLongerThan(window, at) {
+		return
+	}
+
+	h.disableEndpoint(ctx, updated, window)
+}
+
+// disableEndpoint disables a failing endpoint, emails the tenant's admins and publishes
+// webhook_endpoint.disabled so the environment's other endpoints are told about it. The
+// disabled endpoint itself gets no more deliveries, so the email is what reaches a tenant
+// with a single endpoint.
+func (h *handler) disableEndpoint(ctx context.Context, endpoint *webhookendpoint.WebhookEndpoint, window time.Duration) {
+	reason := fmt.Sprintf("Disabled automatically after every delivery failed for more than %s (%d consecutive failures since %s)",
+		window, endpoint.ConsecutiveFailures, endpoint.FailingSince.Format(time.RFC3339))
+
+	endpoint.Enabled = false
+	endpoint.DisabledReason = lo.ToPtr(reason)
+	if err := h.endpointRepo.Update(ctx, endpoint); err != nil {
+		h.logger.Errorw("failed to disable failing webhook endpoint",
+			"error", err,
+			"webhook_endpoint_id", endpoint.ID,
+		)
+		return
+	}
+
+	h.logger.Warnw("disabled failing webhook endpoint",
+		"webhook_endpoint_id", endpoint.ID,
+		"tenant_id", endpoint.TenantID,
+		"environment_id", endpoint.EnvironmentID,
+		"failing_since", endpoint.FailingSince,
+		"consecutive_failures", endpoint.ConsecutiveFailures,
+	)
+
+	h.notifyAdmins(ctx, endpoint, reason)
+
+	if h.publisher == nil {
+		return
+	}
+
+	webhookPayload, err := json.Marshal(webhookDto.InternalWebhookEndpointEvent{
+		EventType:       types.WebhookEventWebhookEndpointDisabled,
+		WebhookEndpoint: &dto.WebhookEndpointResponse{WebhookEndpoint: endpoint},
+		TenantID:        endpoint.TenantID,
+		EnvironmentID:   endpoint.EnvironmentID,
+	})
+	if err != nil {
+		h.logger.Errorw("failed to marshal webhook payload", "error", err)
+		return
+	}
+
+	webhookEvent := &types.WebhookEvent{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SYSTEM_EVENT),
+		EventName:     types.WebhookEventWebhookEndpointDisabled,
+		TenantID:      endpoint.TenantID,
+		EnvironmentID: endpoint.EnvironmentID,
+		UserID:        types.GetUserID(ctx),
+		Timestamp:     time.Now().UTC(),
+		Payload:       json.RawMessage(webhookPayload),
+		EntityType:    types.SystemEntityTypeWebhookEndpoint,
+		EntityID:      endpoint.ID,
+	}
+	if err := h.publisher.PublishWebhook(ctx, webhookEvent); err != nil {
+		h.logger.Errorw("failed to publish webhook event",
+			"error", err,
+			"event_name", webhookEvent.EventName,
+			"webhook_endpoint_id", endpoint.ID,
+		)
+	}
+}
+
+// notifyAdmins emails the admins of the endpoint's tenant that the endpoint was disabled.
+// Failures are logged, the endpoint stays disabled either way.
+func (h *handler) notifyAdmins(ctx context.Context, endpoint *webhookendpoint.WebhookEndpoint, reason string) {
+	if h.emailService == nil || h.userRepo == nil {
+		return
+	}
+
+	recipients, err := h.tenantAdminEmails(ctx)
+	if err != nil {
+		h.logger.Errorw("failed to list tenant admins to notify of disabled webhook endpoint",
+			"error", err,
+			"webhook_endpoint_id", endpoint.ID,
+			"tenant_id", endpoint.TenantID,
+		)
+		return
+	}
+	if len(recipients) == 0 {
+		h.logger.Warnw("no tenant admin to notify of disabled webhook endpoint",
+			"webhook_endpoint_id", endpoint.ID,
+			"tenant_id", endpoint.TenantID,
+		)
+		return
+	}
+
+	for _, recipient := range recipients {
+		resp, err := h.emailService.SendEmailWithTemplate(ctx, email.SendEmailWithTemplateRequest{
+			ToAddress:    recipient,
+			Subject:      "Your Flexprice webhook endpoint was disabled",
+			TemplatePath: "webhook-endpoint-disabled-email.html",
+			Data: map[string]interface{}{
+				"webhook_endpoint_id": endpoint.ID,
+				"endpoint_url":        endpoint.URL,
+				"environment_id":      endpoint.EnvironmentID,
+				"reason":              reason,
+			},
+		})
+		if err != nil {
+			h.logger.Errorw("failed to email tenant admin of disabled webhook endpoint",
+				"error", err,
+				"webhook_endpoint_id", endpoint.ID,
+			)
+			continue
+		}
+		if !resp.Success {
+			h.logger.Warnw("disabled webhook endpoint email was not sent",
+				"webhook_endpoint_id", endpoint.ID,
+				"error", resp.Error,
+			)
+		}
+	}
+}
+
+// tenantAdminEmails returns the emails of the admins of the tenant in the context, both users
+// of the tenant and members joined from other tenants. Principals without roles are admins.
+func (h *handler) tenantAdminEmails(ctx context.Context) ([]string, error) {
+	isAdmin := func(roles []string) bool {
+		return len(roles) == 0 || lo.Contains(roles, rbac.RoleAdmin)
+	}
+
+	users, _, err := h.userRepo.ListByFilter(ctx, &types.UserFilter{
+		QueryFilter: types.NewNoLimitQueryFilter(),
+		Type:        lo.ToPtr(types.UserTypeUser),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var emails []string
+	for _, u := range users {
+		if u.Email != "" && isAdmin(u.Roles) {
+			emails = append(emails, u.Email)
+		}
+	}
+
+	if h.membershipRepo != nil {
+		memberships, err := h.membershipRepo.ListByTenant(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range memberships {
+			if m.Email != "" && isAdmin(m.Roles) {
+				emails = append(emails, m.Email)
+			}
+		}
+	}
+
+	return lo.Uniq(emails), nil
+}
+
+// attemptRequestHeaders returns the headers of a delivery request with the values of the
+// endpoint's custom headers redacted, since those often carry credentials
+func attemptRequestHeaders(endpoint *webhookendpoint.WebhookEndpoint, headers map[string]string) map[string]string {
+	recorded := make(map[string]string, len(headers)+1)
+	recorded["Content-Type"] = "application/json"
+	for k, v := range headers {
+		if _, custom := endpoint.Headers[k]; custom && k != signature.HeaderName {
+			v = redactedHeaderValue
+		}
+		recorded[k] = v
+	}
+	return recorded
+}
+
+// excerpt returns at most max bytes of b as valid UTF-8 text that postgres can store
+func excerpt(b []byte, max int) string {
+	if len(b) > max {
+		b = b[:max]
+	}
+	s := strings.ReplaceAll(string(b), "\x00", "")
+	if !utf8.ValidString(s) {
+		s = strings.ToValidUTF8(s, "")
+	}
+	return s
+}
@@ -6,9 +6,20 @@ import (
 	"time"
 
 	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/membership"
+	"github.com/flexprice/flexprice/internal/domain/user"
+	"github.com/flexprice/flexprice/internal/domain/webhookdeliveryattempt"
+	"github.com/flexprice/flexprice/internal/domain/webhookendpoint"
+	"github.com/flexprice/flexprice/internal/email"
 	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/httpclient"
 	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/rbac"
+	"github.com/flexprice/flexprice/internal/security"
+	"github.com/flexprice/flexprice/internal/testutil"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/webhook/signature"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
@@ -49,16 +60,16 @@ func TestDeliverWebhook_NilEvent(t *testing.T) {
 	require.True(t, ierr.IsValidation(err))
 }
 
-func TestDeliverNative_TenantNotConfigured(t *testing.T) {
+func TestDeliverNative_NoEndpoints(t *testing.T) {
 	t.Parallel()
 
 	h := &handler{
 		config: &config.Webhook{
 			Enabled: true,
 			Svix:    config.Svix{Enabled: false},
-			Tenants: map[string]config.TenantWebhookConfig{},
 		},
-		logger: testLogger(t),
+		logger:       testLogger(t),
+		endpointRepo: testutil.NewInMemoryWebhookEndpointStore(),
 	}
 
 	err := h.deliverNative(context.Background(), &types.WebhookEvent{
@@ -68,11 +79,44 @@ func TestDeliverNative_TenantNotConfigured(t *testing.T) {
 		EventName:     types.WebhookEventCustomerCreated,
 		Timestamp:     time.Now().UTC(),
 		Payload:       []byte(`{"customer_id":"c1"}`),
-	}, "msg-uuid")
+	}, "msg-uuid", DeliveryOptions{})
 	require.Error(t, err)
 	require.True(t, ierr.IsNotFound(err))
 }
 
+func TestSubscribedEndpoints(t *testing.T) {
+	t.Parallel()
+
+	ctx := types.SetEnvironmentID(types.SetTenantID(context.Background(), "ten_1"), "env_1")
+	store := testutil.NewInMemoryWebhookEndpointStore()
+	endpoints := []*webhookendpoint.WebhookEndpoint{
+		{ID: "whep_invoices", URL: "https://example.com/invoices", EventTypes: []string{"invoice.*"}, Enabled: true},
+		{ID: "whep_all", URL: "https://example.com/all", EventTypes: []string{"*"}, Enabled: true},
+		{ID: "whep_customer", URL: "https://example.com/customers", EventTypes: []string{types.WebhookEventCustomerCreated}, Enabled: true},
+		{ID: "whep_disabled", URL: "https://example.com/disabled", EventTypes: []string{"*"}, Enabled: false},
+	}
+	for _, e := range endpoints {
+		e.EnvironmentID = "env_1"
+		e.BaseModel = types.GetDefaultBaseModel(ctx)
+		require.NoError(t, store.Create(ctx, e))
+	}
+
+	h := &handler{
+		logger:       testLogger(t),
+		endpointRepo: store,
+	}
+
+	ids := func(event types.WebhookEventName) []string {
+		subscribed, err := h.subscribedEndpoints(ctx, &types.WebhookEvent{EventName: event})
+		require.NoError(t, err)
+		return lo.Map(subscribed, func(e *webhookendpoint.WebhookEndpoint, _ int) string { return e.ID })
+	}
+
+	require.ElementsMatch(t, []string{"whep_invoices", "whep_all"}, ids(types.WebhookEventInvoiceUpdateFinalized))
+	require.ElementsMatch(t, []string{"whep_all", "whep_customer"}, ids(types.WebhookEventCustomerCreated))
+	require.ElementsMatch(t, []string{"whep_all"}, ids(types.WebhookEventWalletCreated))
+}
+
 func TestAbsorbDeliveryError_NilErrNoPanic(t *testing.T) {
 	t.Parallel()
 
@@ -111,3 +155,266 @@ func TestAbsorbDeliveryError_RealErrorNoPanicWithoutRepo(t *testing.T) {
 		}, "mid")
 	})
 }
+
+func TestDeliveryHeaders_SignsWithActiveSecrets(t *testing.T) {
+	t.Parallel()
+
+	encryption := newTestEncryption(t)
+	current, err := encryption.Encrypt("whsec_current")
+	require.NoError(t, err)
+	previous, err := encryption.Encrypt("whsec_previous")
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	endpoint := &webhookendpoint.WebhookEndpoint{
+		ID:                             "whep_1",
+		Headers:                        map[string]string{"X-Api-Key": "key"},
+		SigningSecret:                  current,
+		PreviousSigningSecret:          &previous,
+		PreviousSigningSecretExpiresAt: lo.ToPtr(now.Add(time.Hour)),
+	}
+	h := &handler{logger: testLogger(t), encryption: encryption}
+	body := []byte(`{"event_type":"customer.created"}`)
+
+	headers, err := h.deliveryHeaders(endpoint, body, now)
+	require.NoError(t, err)
+	require.Equal(t, "key", headers["X-Api-Key"])
+	require.NoError(t, signature.Verify("whsec_current", headers[signature.HeaderName], body, 0, now))
+	require.NoError(t, signature.Verify("whsec_previous", headers[signature.HeaderName], body, 0, now))
+
+	// Once the overlap window ends only the current secret signs
+	later := now.Add(2 * time.Hour)
+	headers, err = h.deliveryHeaders(endpoint, body, later)
+	require.NoError(t, err)
+	require.NoError(t, signature.Verify("whsec_current", headers[signature.HeaderName], body, 0, later))
+	require.Error(t, signature.Verify("whsec_previous", headers[signature.HeaderName], body, 0, later))
+	require.Empty(t, endpoint.Headers[signature.HeaderName], "endpoint headers are not modified")
+}
+
+func TestDeliveryHeaders_RefusesUnsignedDelivery(t *testing.T) {
+	t.Parallel()
+
+	h := &handler{logger: testLogger(t)}
+	endpoint := &webhookendpoint.WebhookEndpoint{ID: "whep_1"}
+
+	_, err := h.deliveryHeaders(endpoint, []byte(`{}`), time.Now().UTC())
+	require.Error(t, err)
+	require.True(t, ierr.IsValidation(err))
+}
+
+// statusClient answers every request with the next status code, like the default client
+// non-2xx statuses are returned as errors
+type statusClient struct {
+	statuses []int
+	requests []*httpclient.Request
+}
+
+func (c *statusClient) Send(_ context.Context, req *httpclient.Request) (*httpclient.Response, error) {
+	c.requests = append(c.requests, req)
+	status := c.statuses[0]
+	c.statuses = c.statuses[1:]
+	if status >= 400 {
+		return nil, httpclient.NewError(status, []byte(`{"error":"unavailable"}`))
+	}
+	return &httpclient.Response{StatusCode: status, Body: []byte("ok")}, nil
+}
+
+type capturingPublisher struct {
+	events []*types.WebhookEvent
+}
+
+func (p *capturingPublisher) PublishWebhook(_ context.Context, event *types.WebhookEvent) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *capturingPublisher) Close() error { return nil }
+
+type capturingNotifier struct {
+	requests []email.SendEmailWithTemplateRequest
+}
+
+func (n *capturingNotifier) SendEmailWithTemplate(_ context.Context, req email.SendEmailWithTemplateRequest) (*email.SendEmailWithTemplateResponse, error) {
+	n.requests = append(n.requests, req)
+	return &email.SendEmailWithTemplateResponse{Success: true}, nil
+}
+
+func newTestEncryption(t *testing.T) security.EncryptionService {
+	t.Helper()
+
+	encryption, err := security.NewEncryptionService(&config.Configuration{
+		Secrets: config.SecretsConfig{EncryptionKey: "test-encryption-key"},
+	}, testLogger(t))
+	require.NoError(t, err)
+	return encryption
+}
+
+func newAttemptTestEndpoint(t *testing.T, ctx context.Context, store *testutil.InMemoryWebhookEndpointStore, encryption security.EncryptionService, failingSince *time.Time) *webhookendpoint.WebhookEndpoint {
+	t.Helper()
+
+	secret, err := encryption.Encrypt("whsec_test")
+	require.NoError(t, err)
+
+	endpoint := &webhookendpoint.WebhookEndpoint{
+		ID:            "whep_1",
+		URL:           "https://example.com/hooks",
+		EventTypes:    []string{"*"},
+		Headers:       map[string]string{"X-Api-Key": "key"},
+		Enabled:       true,
+		SigningSecret: secret,
+		FailingSince:  failingSince,
+		EnvironmentID: "env_1",
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}
+	require.NoError(t, store.Create(ctx, endpoint))
+	return endpoint
+}
+
+func TestDeliverToEndpoint_RecordsAttemptsAndHealth(t *testing.T) {
+	t.Parallel()
+
+	ctx := types.SetEnvironmentID(types.SetTenantID(context.Background(), "ten_1"), "env_1")
+	endpoints := testutil.NewInMemoryWebhookEndpointStore()
+	attempts := testutil.NewInMemoryWebhookDeliveryAttemptStore()
+	client := &statusClient{statuses: []int{503, 200}}
+	h := &handler{
+		config:       &config.Webhook{Enabled: true, EndpointAutoDisableAfter: 72 * time.Hour},
+		client:       client,
+		logger:       testLogger(t),
+		encryption:   newTestEncryption(t),
+		endpointRepo: endpoints,
+		attemptRepo:  attempts,
+	}
+
+	endpoint := newAttemptTestEndpoint(t, ctx, endpoints, h.encryption, nil)
+	event := &types.WebhookEvent{ID: "sev_1", EventName: types.WebhookEventCustomerCreated, TenantID: "ten_1", EnvironmentID: "env_1"}
+	body := []byte(`{"event_type":"customer.created"}`)
+
+	err := h.deliverToEndpoint(ctx, event, endpoint, body, "mid")
+	require.Error(t, err)
+
+	failing, err := endpoints.Get(ctx, endpoint.ID)
+	require.NoError(t, err)
+	require.True(t, failing.Enabled, "endpoint is not disabled before the window passes")
+	require.Equal(t, 1, failing.ConsecutiveFailures)
+	require.NotNil(t, failing.FailingSince)
+
+	require.NoError(t, h.deliverToEndpoint(ctx, event, endpoint, body, "mid"))
+	require.Equal(t, "key", client.requests[1].Headers["X-Api-Key"], "custom headers are still sent")
+
+	recovered, err := endpoints.Get(ctx, endpoint.ID)
+	require.NoError(t, err)
+	require.Zero(t, recovered.ConsecutiveFailures)
+	require.Nil(t, recovered.FailingSince)
+	require.NotNil(t, recovered.LastSuccessAt)
+
+	filter := types.NewNoLimitWebhookDeliveryAttemptFilter()
+	filter.SystemEventIDs = []string{"sev_1"}
+	logged, err := attempts.List(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, logged, 2)
+
+	byNumber := lo.KeyBy(logged, func(a *webhookdeliveryattempt.WebhookDeliveryAttempt) int { return a.AttemptNumber })
+	first, second := byNumber[1], byNumber[2]
+	require.False(t, first.Success)
+	require.Equal(t, 503, lo.FromPtr(first.ResponseStatusCode))
+	require.Equal(t, `{"error":"unavailable"}`, first.ResponseBody)
+	require.Equal(t, "endpoint responded with status 503", lo.FromPtr(first.ErrorMessage))
+	require.Equal(t, string(body), first.RequestBody)
+	require.Equal(t, redactedHeaderValue, first.RequestHeaders["X-Api-Key"], "custom header values are redacted")
+	require.Equal(t, endpoint.URL, first.RequestURL)
+	require.True(t, second.Success)
+	require.Equal(t, 200, lo.FromPtr(second.ResponseStatusCode))
+	require.Nil(t, second.ErrorMessage)
+
+	// A retry that skips delivered endpoints has nothing left to send
+	require.Empty(t, h.undeliveredEndpoints(ctx, event, []*webhookendpoint.WebhookEndpoint{endpoint}))
+	require.Len(t, h.undeliveredEndpoints(ctx, &types.WebhookEvent{ID: "sev_2"}, []*webhookendpoint.WebhookEndpoint{endpoint}), 1)
+}
+
+func TestDeliverToEndpoint_DisablesEndpointFailingLongerThanWindow(t *testing.T) {
+	t.Parallel()
+
+	ctx := types.SetEnvironmentID(types.SetTenantID(context.Background(), "ten_1"), "env_1")
+	endpoints := testutil.NewInMemoryWebhookEndpointStore()
+	publisher := &capturingPublisher{}
+	notifier := &capturingNotifier{}
+	users := testutil.NewInMemoryUserStore()
+	memberships := testutil.NewInMemoryMembershipStore()
+	for _, u := range []*user.User{
+		{ID: "usr_admin", Email: "admin@example.com", Type: types.UserTypeUser, Roles: []string{rbac.RoleAdmin}},
+		{ID: "usr_default", Email: "default@example.com", Type: types.UserTypeUser},
+		{ID: "usr_viewer", Email: "viewer@example.com", Type: types.UserTypeUser, Roles: []string{"viewer"}},
+	} {
+		u.BaseModel = types.GetDefaultBaseModel(ctx)
+		require.NoError(t, users.Create(ctx, u))
+	}
+	require.NoError(t, memberships.Create(ctx, &membership.Membership{
+		ID:        "mem_1",
+		UserID:    "usr_member",
+		Email:     "member@example.com",
+		Roles:     []string{rbac.RoleAdmin},
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}))
+
+	h := &handler{
+		config:         &config.Webhook{Enabled: true, EndpointAutoDisableAfter: 72 * time.Hour},
+		client:         &statusClient{statuses: []int{500}},
+		logger:         testLogger(t),
+		endpointRepo:   endpoints,
+		attemptRepo:    testutil.NewInMemoryWebhookDeliveryAttemptStore(),
+		publisher:      publisher,
+		encryption:     newTestEncryption(t),
+		userRepo:       users,
+		membershipRepo: memberships,
+		emailService:   notifier,
+	}
+
+	endpoint := newAttemptTestEndpoint(t, ctx, endpoints, h.encryption, lo.ToPtr(time.Now().UTC().Add(-73*time.Hour)))
+	event := &types.WebhookEvent{ID: "sev_1", EventName: types.WebhookEventCustomerCreated, TenantID: "ten_1", EnvironmentID: "env_1"}
+
+	require.Error(t, h.deliverToEndpoint(ctx, event, endpoint, []byte(`{}`), "mid"))
+
+	disabled, err := endpoints.Get(ctx, endpoint.ID)
+	require.NoError(t, err)
+	require.False(t, disabled.Enabled)
+	require.NotNil(t, disabled.DisabledReason)
+
+	require.Len(t, publisher.events, 1)
+	require.Equal(t, types.WebhookEventWebhookEndpointDisabled, publisher.events[0].EventName)
+	require.Equal(t, endpoint.ID, publisher.events[0].EntityID)
+	require.Equal(t, types.SystemEntityTypeWebhookEndpoint, publisher.events[0].EntityType)
+
+	// Admins, including users without roles and members of other tenants, are emailed
+	recipients := lo.Map(notifier.requests, func(r email.SendEmailWithTemplateRequest, _ int) string { return r.ToAddress })
+	require.ElementsMatch(t, []string{"admin@example.com", "default@example.com", "member@example.com"}, recipients)
+	require.Equal(t, endpoint.URL, notifier.requests[0].Data["endpoint_url"])
+}
+
+func TestCheckOrdering_Disabled(t *testing.T) {
+	t.Parallel()
+
+	h := &handler{
+		config: &config.Webhook{Enabled: true, OrderedDelivery: false},
+		logger: testLogger(t),
+	}
+
+	err := h.checkOrdering(context.Background(), &types.WebhookEvent{
+		ID:             "sev_2",
+		EntityID:       "sub_1",
+		SequenceNumber: lo.ToPtr(int64(2)),
+	})
+	require.NoError(t, err)
+	require.False(t, IsDeliveryBlocked(err))
+	require.True(t, IsDeliveryBlocked(ierr.WithError(ErrDeliveryBlocked).Mark(ierr.ErrInvalidOperation)))
+}
+
+func TestPermanentDeliveryError(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, permanentDeliveryError(nil))
+	require.False(t, permanentDeliveryError(ierr.WithError(ErrDeliveryBlocked).Mark(ierr.ErrInvalidOperation)), "blocked events are retried")
+	require.False(t, permanentDeliveryError(httpclient.NewError(503, nil)), "endpoint failures are retried")
+	require.True(t, permanentDeliveryError(ierr.NewError("customer not found").Mark(ierr.ErrNotFound)))
+	require.True(t, permanentDeliveryError(ierr.NewError("svix application not found").Mark(ierr.ErrInvalidOperation)))
+}
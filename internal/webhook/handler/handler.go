@@ -9,16 +9,24 @@ import (
 	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
 	"github.com/flexprice/flexprice/ent"
 	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/domain/membership"
+	"github.com/flexprice/flexprice/internal/domain/user"
+	"github.com/flexprice/flexprice/internal/domain/webhookdeliveryattempt"
+	"github.com/flexprice/flexprice/internal/domain/webhookendpoint"
+	"github.com/flexprice/flexprice/internal/email"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/httpclient"
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/pubsub"
 	pubsubRouter "github.com/flexprice/flexprice/internal/pubsub/router"
 	repoent "github.com/flexprice/flexprice/internal/repository/ent"
+	"github.com/flexprice/flexprice/internal/security"
 	"github.com/flexprice/flexprice/internal/sentry"
 	"github.com/flexprice/flexprice/internal/svix"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/flexprice/flexprice/internal/webhook/payload"
+	"github.com/flexprice/flexprice/internal/webhook/publisher"
+	"github.com/flexprice/flexprice/internal/webhook/signature"
 	"github.com/samber/lo"
 )
 
@@ -28,6 +36,26 @@ type Handler interface {
 	// DeliverWebhook builds the outbound payload and delivers it (Svix or native HTTP)
 	// without publishing to Kafka. Used for synchronous retries (e.g. API retrigger).
 	DeliverWebhook(ctx context.Context, event *types.WebhookEvent) error
+	// RedeliverWebhook delivers like DeliverWebhook to the endpoints selected by opts.
+	// Used by the stale retry job and bulk redelivery.
+	RedeliverWebhook(ctx context.Context, event *types.WebhookEvent, opts DeliveryOptions) error
+	// BuildSnapshot returns the payload snapshot of the event, building and storing it on the
+	// system event when the event has none yet
+	BuildSnapshot(ctx context.Context, event *types.WebhookEvent) (json.RawMessage, error)
+}
+
+// DeliveryOptions narrows the native endpoints an event is delivered to. Svix tracks
+// deliveries per endpoint itself, so only WebhookEndpointID is honoured and rejected there.
+type DeliveryOptions struct {
+	// WebhookEndpointID delivers to this endpoint only
+	WebhookEndpointID string
+	// SkipDelivered skips endpoints that already accepted the event
+	SkipDelivered bool
+}
+
+// adminNotifier emails the tenant's admins, it is satisfied by *email.Email
+type adminNotifier interface {
+	SendEmailWithTemplate(ctx context.Context, req email.SendEmailWithTemplateRequest) (*email.SendEmailWithTemplateResponse, error)
 }
 
 // handler implements handler.Handler using watermill's gochannel
@@ -40,6 +68,15 @@ type handler struct {
 	sentry          *sentry.Service
 	svixClient      *svix.Client
 	systemEventRepo *repoent.SystemEventRepository
+	endpointRepo    webhookendpoint.Repository
+	attemptRepo     webhookdeliveryattempt.Repository
+	encryption      security.EncryptionService
+	publisher       publisher.WebhookPublisher
+	userRepo        user.Repository
+	membershipRepo  membership.Repository
+	emailService    adminNotifier
+	// maxDeliveryAttempts is when an undelivered event stops holding back later events of its entity
+	maxDeliveryAttempts int
 }
 
 // NewHandler creates a new memory-based handler
@@ -52,16 +89,31 @@ func NewHandler(
 	sentry *sentry.Service,
 	svixClient *svix.Client,
 	systemEventRepo *repoent.SystemEventRepository,
+	endpointRepo webhookendpoint.Repository,
+	attemptRepo webhookdeliveryattempt.Repository,
+	encryption security.EncryptionService,
+	publisher publisher.WebhookPublisher,
+	userRepo user.Repository,
+	membershipRepo membership.Repository,
+	emailService *email.Email,
 ) (Handler, error) {
 	return &handler{
-		pubSub:          pubSub,
-		config:          &cfg.Webhook,
-		factory:         factory,
-		client:          client,
-		logger:          logger,
-		sentry:          sentry,
-		svixClient:      svixClient,
-		systemEventRepo: systemEventRepo,
+		pubSub:              pubSub,
+		config:              &cfg.Webhook,
+		factory:             factory,
+		client:              client,
+		logger:              logger,
+		sentry:              sentry,
+		svixClient:          svixClient,
+		systemEventRepo:     systemEventRepo,
+		endpointRepo:        endpointRepo,
+		attemptRepo:         attemptRepo,
+		encryption:          encryption,
+		publisher:           publisher,
+		userRepo:            userRepo,
+		membershipRepo:      membershipRepo,
+		emailService:        emailService,
+		maxDeliveryAttempts: lo.Ternary(cfg.WebhookRetryJob.MaxAttempts > 0, cfg.WebhookRetryJob.MaxAttempts, defaultMaxDeliveryAttempts),
 	}, nil
 }
 
@@ -92,6 +144,11 @@ func (h *handler) RegisterHandler(router *pubsubRouter.Router) {
 
 // DeliverWebhook implements synchronous delivery for API-driven retries.
 func (h *handler) DeliverWebhook(ctx context.Context, event *types.WebhookEvent) error {
+	return h.RedeliverWebhook(ctx, event, DeliveryOptions{})
+}
+
+// RedeliverWebhook implements synchronous delivery to the endpoints selected by opts.
+func (h *handler) RedeliverWebhook(ctx context.Context, event *types.WebhookEvent, opts DeliveryOptions) error {
 	if !h.config.Enabled {
 		return ierr.NewError("webhook delivery is disabled").
 			WithHint("Enable webhooks in configuration to retrigger system events.").
@@ -114,11 +171,28 @@ func (h *handler) DeliverWebhook(ctx context.Context, event *types.WebhookEvent)
 		"event_id", event.ID,
 	)
 
-	var deliveryErr error
-	if h.config.Svix.Enabled {
-		deliveryErr = h.deliverSvix(ctx, event, messageUUID)
-	} else {
-		deliveryErr = h.deliverNative(ctx, event, messageUUID)
+	if h.config.Svix.Enabled && opts.WebhookEndpointID != "" {
+		return ierr.NewError("redelivery to a single endpoint is only supported for native webhooks").
+			WithHint("Svix retries failed deliveries per endpoint itself.").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	deliveryErr := h.checkOrdering(ctx, event)
+	if IsDeliveryBlocked(deliveryErr) {
+		h.logger.Infow("holding back webhook behind an earlier event of the same entity",
+			"error", deliveryErr,
+			"event_id", event.ID,
+			"event_name", event.EventName,
+			"entity_id", event.EntityID,
+		)
+		return deliveryErr
+	}
+	if deliveryErr == nil {
+		if h.config.Svix.Enabled {
+			deliveryErr = h.deliverSvix(ctx, event, messageUUID)
+		} else {
+			deliveryErr = h.deliverNative(ctx, event, messageUUID, opts)
+		}
 	}
 	if deliveryErr != nil {
 		h.logger.Errorw("failed to deliver webhook synchronously",
@@ -128,18 +202,32 @@ func (h *handler) DeliverWebhook(ctx context.Context, event *types.WebhookEvent)
 			"tenant_id", event.TenantID,
 			"message_uuid", messageUUID,
 		)
-		if h.systemEventRepo != nil && event.ID != "" {
-			if dbErr := h.systemEventRepo.OnFailed(ctx, event.ID, deliveryErr.Error()); dbErr != nil {
-				h.logger.Warnw("failed to persist webhook failure_reason",
-					"error", dbErr,
-					"event_id", event.ID,
-				)
-			}
-		}
+		h.recordDeliveryFailure(ctx, event, deliveryErr)
 	}
 	return deliveryErr
 }
 
+// recordDeliveryFailure persists the failure reason on the system_events row. Permanent failures
+// are dead-lettered, the others count towards the retries of the event.
+func (h *handler) recordDeliveryFailure(ctx context.Context, event *types.WebhookEvent, err error) {
+	if h.systemEventRepo == nil || event.ID == "" {
+		return
+	}
+
+	var dbErr error
+	if permanentDeliveryError(err) {
+		dbErr = h.systemEventRepo.OnDeadLettered(ctx, event.ID, err.Error(), h.maxDeliveryAttempts)
+	} else {
+		dbErr = h.systemEventRepo.OnFailed(ctx, event.ID, err.Error())
+	}
+	if dbErr != nil {
+		h.logger.Warnw("failed to persist webhook failure_reason",
+			"error", dbErr,
+			"event_id", event.ID,
+		)
+	}
+}
+
 // webhookMissingDataError is true when the failure is permanent (referenced entity missing).
 // Those cases should ack and not consume router-level retries or DLQ.
 func webhookMissingDataError(err error) bool {
@@ -155,6 +243,17 @@ func (h *handler) absorbDeliveryError(ctx context.Context, transport string, err
 	if err == nil {
 		return
 	}
+	if IsDeliveryBlocked(err) {
+		// Not a failure, the stale retry job delivers the event once the earlier one is done
+		h.logger.Infow("holding back webhook behind an earlier event of the same entity",
+			"transport", transport,
+			"error", err,
+			"message_uuid", messageUUID,
+			"event_name", event.EventName,
+			"entity_id", event.EntityID,
+		)
+		return
+	}
 	if webhookMissingDataError(err) {
 		h.logger.Errorw("skipping webhook; referenced data not found (ack, no retry)",
 			"transport", transport,
@@ -172,14 +271,7 @@ func (h *handler) absorbDeliveryError(ctx context.Context, transport string, err
 			"event", event.EventName,
 		)
 	}
-	if h.systemEventRepo != nil && event.ID != "" {
-		if dbErr := h.systemEventRepo.OnFailed(ctx, event.ID, err.Error()); dbErr != nil {
-			h.logger.Warnw("failed to persist webhook failure_reason",
-				"error", dbErr,
-				"event_id", event.ID,
-			)
-		}
-	}
+	h.recordDeliveryFailure(ctx, event, err)
 }
 
 // processMessage processes a single webhook message from the system_events topic:
@@ -212,12 +304,18 @@ func (h *handler) processMessage(msg *message.Message) error {
 		"tenant_id", event.TenantID,
 	)
 
+	transport := lo.Ternary(h.config.Svix.Enabled, "svix", "native")
+	if err := h.checkOrdering(ctx, &event); err != nil {
+		h.absorbDeliveryError(ctx, transport, err, &event, msg.UUID)
+		return nil
+	}
+
 	if h.config.Svix.Enabled {
-		h.absorbDeliveryError(ctx, "svix", h.deliverSvix(ctx, &event, msg.UUID), &event, msg.UUID)
+		h.absorbDeliveryError(ctx, transport, h.deliverSvix(ctx, &event, msg.UUID), &event, msg.UUID)
 		return nil
 	}
 
-	h.absorbDeliveryError(ctx, "native", h.deliverNative(ctx, &event, msg.UUID), &event, msg.UUID)
+	h.absorbDeliveryError(ctx, transport, h.deliverNative(ctx, &event, msg.UUID, DeliveryOptions{}), &event, msg.UUID)
 	return nil
 }
 
@@ -233,17 +331,13 @@ func (h *handler) deliverSvix(ctx context.Context, event *types.WebhookEvent, me
 		return err
 	}
 
-	builder, err := h.factory.GetBuilder(event.EventName)
+	snapshot, err := h.BuildSnapshot(ctx, event)
 	if err != nil {
 		return err
 	}
 
-	h.logger.Debugw("building webhook payload",
-		"event_name", event.EventName,
-		"builder", builder,
-	)
-
-	webHookPayload, err := builder.BuildPayload(ctx, event.EventName, event.Payload)
+	// Svix endpoints are not managed here and receive the original payload version
+	webHookPayload, err := h.factory.Render(types.WebhookPayloadVersionV1, types.WebhookPayloadModeFull, event, snapshot)
 	if err != nil {
 		return err
 	}
@@ -277,74 +371,267 @@ func (h *handler) deliverSvix(ctx context.Context, event *types.WebhookEvent, me
 	return nil
 }
 
-// deliverNative sends a webhook to the configured HTTP endpoint.
-func (h *handler) deliverNative(ctx context.Context, event *types.WebhookEvent, messageUUID string) error {
-	tenantCfg, ok := h.config.Tenants[event.TenantID]
-	if !ok {
-		return ierr.NewError("native webhook is not configured for this tenant").
-			WithHint("Add the tenant to webhook.tenants in configuration.").
-			Mark(ierr.ErrNotFound)
+// deliverNative sends a webhook to every enabled endpoint of the event's environment that
+// subscribes to the event, narrowed by opts. Every request is recorded as a delivery attempt.
+// The event counts as delivered only when every endpoint accepted it.
+func (h *handler) deliverNative(ctx context.Context, event *types.WebhookEvent, messageUUID string, opts DeliveryOptions) error {
+	endpoints, err := h.subscribedEndpoints(ctx, event)
+	if err != nil {
+		return err
 	}
 
-	if !tenantCfg.Enabled {
-		return ierr.NewError("webhooks are disabled for this tenant in native configuration").
-			Mark(ierr.ErrInvalidOperation)
+	if opts.WebhookEndpointID != "" {
+		endpoints = lo.Filter(endpoints, func(e *webhookendpoint.WebhookEndpoint, _ int) bool {
+			return e.ID == opts.WebhookEndpointID
+		})
+		if len(endpoints) == 0 {
+			return ierr.NewErrorf("webhook endpoint %s is disabled or does not subscribe to event %q", opts.WebhookEndpointID, event.EventName).
+				WithReportableDetails(map[string]any{
+					"webhook_endpoint_id": opts.WebhookEndpointID,
+					"event_name":          event.EventName,
+				}).
+				Mark(ierr.ErrInvalidOperation)
+		}
 	}
 
-	for _, excludedEvent := range tenantCfg.ExcludedEvents {
-		if excludedEvent == event.EventName {
-			return ierr.NewErrorf("event %q is excluded from native webhooks for this tenant", event.EventName).
-				Mark(ierr.ErrInvalidOperation)
+	if opts.SkipDelivered {
+		endpoints = h.undeliveredEndpoints(ctx, event, endpoints)
+		if len(endpoints) == 0 {
+			h.logger.Debugw("webhook already delivered to every endpoint",
+				"message_uuid", messageUUID,
+				"event_id", event.ID,
+				"event", event.EventName,
+			)
+			return h.markDelivered(ctx, event, opts)
 		}
 	}
 
-	builder, err := h.factory.GetBuilder(event.EventName)
+	snapshot, err := h.BuildSnapshot(ctx, event)
 	if err != nil {
 		return err
 	}
 
+	var failedEndpoints []string
+	var lastErr error
+	for _, endpoint := range endpoints {
+		body, err := h.factory.Render(endpoint.PayloadVersion, endpoint.PayloadMode, event, snapshot)
+		if err != nil {
+			h.logger.Errorw("failed to render webhook payload for endpoint",
+				"error", err,
+				"message_uuid", messageUUID,
+				"webhook_endpoint_id", endpoint.ID,
+				"payload_version", endpoint.PayloadVersion,
+			)
+			failedEndpoints = append(failedEndpoints, endpoint.ID)
+			lastErr = err
+			continue
+		}
+
+		if err := h.deliverToEndpoint(ctx, event, endpoint, body, messageUUID); err != nil {
+			failedEndpoints = append(failedEndpoints, endpoint.ID)
+			lastErr = err
+		}
+	}
+
+	if len(failedEndpoints) > 0 {
+		return ierr.WithError(lastErr).
+			WithHintf("Webhook delivery failed for %d of %d endpoints", len(failedEndpoints), len(endpoints)).
+			WithReportableDetails(map[string]any{
+				"webhook_endpoint_ids": failedEndpoints,
+			}).
+			Mark(ierr.ErrHTTPClient)
+	}
+
+	return h.markDelivered(ctx, event, opts)
+}
+
+// BuildSnapshot builds the payload of an event once. Later deliveries, retries and thin payload
+// receivers get the stored snapshot, so they all see the entity as it was first delivered.
+func (h *handler) BuildSnapshot(ctx context.Context, event *types.WebhookEvent) (json.RawMessage, error) {
+	if len(event.Snapshot) > 0 {
+		return event.Snapshot, nil
+	}
+
+	builder, err := h.factory.GetBuilder(event.EventName)
+	if err != nil {
+		return nil, err
+	}
+
 	h.logger.Debugw("building webhook payload",
 		"event_name", event.EventName,
 		"builder", builder,
 	)
 
-	webHookPayload, err := builder.BuildPayload(ctx, event.EventName, event.Payload)
+	snapshot, err := builder.BuildPayload(ctx, event.EventName, event.Payload)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	h.logger.Debugw("built webhook payload",
 		"event_name", event.EventName,
-		"payload", string(webHookPayload),
+		"payload", string(snapshot),
 	)
 
-	req := &httpclient.Request{
-		Method:  "POST",
-		URL:     tenantCfg.Endpoint,
-		Headers: tenantCfg.Headers,
-		Body:    webHookPayload,
+	if h.systemEventRepo != nil && event.ID != "" {
+		if err := h.systemEventRepo.OnSnapshot(ctx, event.ID, snapshot); err != nil {
+			h.logger.Warnw("system_events OnSnapshot failed",
+				"error", err,
+				"event_id", event.ID,
+				"event_name", event.EventName,
+			)
+		}
 	}
 
-	resp, err := h.client.Send(ctx, req)
+	event.Snapshot = snapshot
+	return snapshot, nil
+}
+
+// markDelivered stamps the system event as delivered. A redelivery to a single endpoint says
+// nothing about the other endpoints, so the event is left as it is.
+func (h *handler) markDelivered(ctx context.Context, event *types.WebhookEvent, opts DeliveryOptions) error {
+	if opts.WebhookEndpointID != "" {
+		return nil
+	}
+
+	if err := h.systemEventRepo.OnDelivered(ctx, event.ID, nil); err != nil {
+		h.logger.Warnw("system_events OnDelivered failed",
+			"error", err,
+			"event_id", event.ID,
+			"event_name", event.EventName,
+		)
+		return err
+	}
+
+	return nil
+}
+
+// deliverToEndpoint signs and sends the payload to one endpoint, then records the attempt
+// and the endpoint's health
+func (h *handler) deliverToEndpoint(ctx context.Context, event *types.WebhookEvent, endpoint *webhookendpoint.WebhookEndpoint, body []byte, messageUUID string) error {
+	now := time.Now().UTC()
+	headers, err := h.deliveryHeaders(endpoint, body, now)
 	if err != nil {
+		h.logger.Errorw("failed to sign webhook for endpoint",
+			"error", err,
+			"message_uuid", messageUUID,
+			"webhook_endpoint_id", endpoint.ID,
+			"event", event.EventName,
+		)
 		return err
 	}
 
+	req := &httpclient.Request{
+		Method:  "POST",
+		URL:     endpoint.URL,
+		Headers: headers,
+		Body:    body,
+	}
+
+	attemptNumber := h.nextAttemptNumber(ctx, event, endpoint)
+	start := time.Now()
+	resp, sendErr := h.client.Send(ctx, req)
+	latency := time.Since(start)
+
+	h.recordAttempt(ctx, event, endpoint, req, attemptNumber, resp, sendErr, latency)
+	h.recordEndpointHealth(ctx, endpoint, sendErr == nil, now)
+
+	if sendErr != nil {
+		h.logger.Warnw("failed to send webhook to endpoint",
+			"error", sendErr,
+			"message_uuid", messageUUID,
+			"webhook_endpoint_id", endpoint.ID,
+			"event", event.EventName,
+			"attempt_number", attemptNumber,
+		)
+		return sendErr
+	}
+
 	h.logger.Infow("webhook sent successfully",
 		"message_uuid", messageUUID,
 		"tenant_id", event.TenantID,
+		"webhook_endpoint_id", endpoint.ID,
 		"event", event.EventName,
 		"status_code", resp.StatusCode,
+		"attempt_number", attemptNumber,
 	)
+	return nil
+}
 
-	if err := h.systemEventRepo.OnDelivered(ctx, event.ID, nil); err != nil {
-		h.logger.Warnw("system_events OnDelivered failed",
-			"error", err,
-			"event_id", event.ID,
-			"event_name", event.EventName,
-		)
-		return err
+// deliveryHeaders returns the endpoint's custom headers with the FlexPrice-Signature header of the
+// body. The body is signed with the current secret and, during a rotation overlap, the previous one.
+// Endpoints without a signing secret are refused, receivers must always be able to verify deliveries.
+func (h *handler) deliveryHeaders(endpoint *webhookendpoint.WebhookEndpoint, body []byte, now time.Time) (map[string]string, error) {
+	headers := make(map[string]string, len(endpoint.Headers)+1)
+	for k, v := range endpoint.Headers {
+		headers[k] = v
 	}
 
-	return nil
+	if endpoint.SigningSecret == "" {
+		return nil, ierr.NewError("webhook endpoint has no signing secret").
+			WithHint("Rotate the signing secret of the webhook endpoint to resume deliveries").
+			WithReportableDetails(map[string]any{
+				"webhook_endpoint_id": endpoint.ID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if h.encryption == nil {
+		return nil, ierr.NewError("encryption service is not configured").
+			WithHint("Webhook signing secrets cannot be decrypted").
+			Mark(ierr.ErrSystem)
+	}
+
+	encryptedSecrets := []string{endpoint.SigningSecret}
+	if previous, ok := endpoint.ActivePreviousSigningSecret(now); ok {
+		encryptedSecrets = append(encryptedSecrets, previous)
+	}
+
+	secrets := make([]string, 0, len(encryptedSecrets))
+	for _, encrypted := range encryptedSecrets {
+		secret, err := h.encryption.Decrypt(encrypted)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, secret)
+	}
+
+	headers[signature.HeaderName] = signature.Header(secrets, now, body)
+	return headers, nil
+}
+
+// subscribedEndpoints returns the enabled endpoints of the event's environment that subscribe to the event
+func (h *handler) subscribedEndpoints(ctx context.Context, event *types.WebhookEvent) ([]*webhookendpoint.WebhookEndpoint, error) {
+	if h.endpointRepo == nil {
+		return nil, ierr.NewError("native webhook endpoints are not configured").
+			Mark(ierr.ErrNotFound)
+	}
+
+	filter := &types.WebhookEndpointFilter{
+		QueryFilter: types.NewNoLimitPublishedQueryFilter(),
+		Enabled:     lo.ToPtr(true),
+	}
+	endpoints, err := h.endpointRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(endpoints) == 0 {
+		return nil, ierr.NewError("no enabled webhook endpoint is configured for this environment").
+			WithHint("Create a webhook endpoint to receive native webhooks.").
+			WithReportableDetails(map[string]any{
+				"tenant_id":      event.TenantID,
+				"environment_id": event.EnvironmentID,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	subscribed := lo.Filter(endpoints, func(e *webhookendpoint.WebhookEndpoint, _ int) bool {
+		return e.Subscribes(event.EventName)
+	})
+	if len(subscribed) == 0 {
+		return nil, ierr.NewErrorf("no webhook endpoint subscribes to event %q", event.EventName).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	return subscribed, nil
 }
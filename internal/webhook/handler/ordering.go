@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// defaultMaxDeliveryAttempts matches the default attempts of the stale webhook retry job
+const defaultMaxDeliveryAttempts = 5
+
+// ErrDeliveryBlocked is returned when ordered delivery holds an event back behind an earlier
+// undelivered event of the same entity. It is not a delivery failure and is retried later.
+var ErrDeliveryBlocked = errors.New("webhook delivery is blocked by an earlier event of the same entity")
+
+// IsDeliveryBlocked reports whether err is ErrDeliveryBlocked
+func IsDeliveryBlocked(err error) bool {
+	return errors.Is(err, ErrDeliveryBlocked)
+}
+
+// permanentDeliveryError reports whether retrying a failed delivery cannot succeed, e.g. the
+// entity of the event or every endpoint is gone. Such events are dead-lettered right away so
+// ordered delivery does not hold back the later events of the entity behind them.
+func permanentDeliveryError(err error) bool {
+	if err == nil || IsDeliveryBlocked(err) {
+		return false
+	}
+	return webhookMissingDataError(err) || ierr.IsInvalidOperation(err)
+}
+
+// checkOrdering returns ErrDeliveryBlocked when ordered delivery is enabled and an earlier event
+// of the same entity was neither delivered nor dead-lettered, i.e. exhausted its retries
+func (h *handler) checkOrdering(ctx context.Context, event *types.WebhookEvent) error {
+	if !h.config.OrderedDelivery || h.systemEventRepo == nil || event.SequenceNumber == nil {
+		return nil
+	}
+
+	predecessor, err := h.systemEventRepo.GetUndeliveredPredecessor(ctx, event, h.maxDeliveryAttempts)
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to check earlier events of the same entity").
+			Mark(ierr.ErrDatabase)
+	}
+	if predecessor == nil {
+		return nil
+	}
+
+	return ierr.WithError(ErrDeliveryBlocked).
+		WithHintf("Event %s of the same entity has to be delivered first", predecessor.ID).
+		WithReportableDetails(map[string]any{
+			"event_id":                event.ID,
+			"sequence_number":         *event.SequenceNumber,
+			"blocking_event_id":       predecessor.ID,
+			"blocking_event_sequence": predecessor.SequenceNumber,
+		}).
+		Mark(ierr.ErrInvalidOperation)
+}
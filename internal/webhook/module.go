@@ -1,6 +1,8 @@
 package webhook
 
 import (
+	"context"
+
 	"github.com/flexprice/flexprice/internal/config"
 	kafkaProducerPkg "github.com/flexprice/flexprice/internal/kafka"
 	"github.com/flexprice/flexprice/internal/logger"
@@ -44,6 +46,7 @@ func providePayloadBuilderFactory(
 	paymentService service.PaymentService,
 	sentry *sentry.Service,
 	creditNoteService service.CreditNoteService,
+	meterService service.MeterService,
 ) payload.PayloadBuilderFactory {
 	services := payload.NewServices(
 		invoiceService,
@@ -57,6 +60,7 @@ func providePayloadBuilderFactory(
 		paymentService,
 		sentry,
 		creditNoteService,
+		meterService,
 	)
 	return payload.NewPayloadBuilderFactory(services)
 }
@@ -72,12 +76,22 @@ func providePubSub(
 	return pubSub
 }
 
-// provideWebhookPublisher returns a webhook publisher backed by shared Kafka producer.
+// provideWebhookPublisher returns a webhook publisher backed by its own Kafka producer, which keys
+// messages by entity so the events of an entity are consumed in order.
 func provideWebhookPublisher(
+	lc fx.Lifecycle,
 	cfg *config.Configuration,
 	logger *logger.Logger,
-	producer *kafkaProducerPkg.Producer,
 	systemEventRepo *repoent.SystemEventRepository,
 ) (publisher.WebhookPublisher, error) {
+	producer, err := kafkaProducerPkg.NewPartitionKeyProducer(cfg)
+	if err != nil {
+		return nil, err
+	}
+	lc.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			return producer.Close()
+		},
+	})
 	return publisher.NewPublisherFromProducer(producer, cfg, logger, systemEventRepo)
 }
@@ -66,6 +66,25 @@ func (b *AlertPayloadBuilder) BuildPayload(ctx context.Context, eventType types.
 		return json.Marshal(payload)
 	}
 
+	// Usage anomaly alert: needs the meter, customer is best effort
+	if internalEvent.MeterID != "" {
+		m, err := b.services.MeterService.GetMeter(ctx, internalEvent.MeterID)
+		if err != nil {
+			return nil, err
+		}
+
+		payload := webhookDto.NewUsageAnomalyWebhookPayload(
+			dto.ToMeterResponse(m),
+			customer,
+			internalEvent.UsageAnomaly,
+			internalEvent.AlertType,
+			internalEvent.AlertStatus,
+			eventType,
+		)
+
+		return json.Marshal(payload)
+	}
+
 	// If we get here, no valid combination found - return nil
 	return nil, nil
 }
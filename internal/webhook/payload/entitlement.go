@@ -47,3 +47,42 @@ func (b *EntitlementPayloadBuilder) BuildPayload(ctx context.Context, eventType
 
 	return json.Marshal(payload)
 }
+
+type EntitlementLimitPayloadBuilder struct {
+	services *Services
+}
+
+func NewEntitlementLimitPayloadBuilder(services *Services) PayloadBuilder {
+	return &EntitlementLimitPayloadBuilder{services: services}
+}
+
+func (b *EntitlementLimitPayloadBuilder) BuildPayload(ctx context.Context, eventType types.WebhookEventName, data json.RawMessage) (json.RawMessage, error) {
+	var parsedPayload webhookDto.InternalEntitlementLimitEvent
+
+	if err := json.Unmarshal(data, &parsedPayload); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Unable to unmarshal entitlement limit event payload").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	status := parsedPayload.Status
+	if status == nil || status.CustomerID == "" || status.FeatureID == "" {
+		return nil, ierr.NewError("invalid data type for entitlement limit event").
+			WithHint("Please provide a valid customer ID and feature ID").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	customer, err := b.services.CustomerService.GetCustomer(ctx, status.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	feature, err := b.services.FeatureService.GetFeature(ctx, status.FeatureID)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := webhookDto.NewEntitlementLimitReachedWebhookPayload(customer, feature, status, eventType)
+
+	return json.Marshal(payload)
+}
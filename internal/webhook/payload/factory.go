@@ -1,6 +1,7 @@
 package payload
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/flexprice/flexprice/internal/types"
@@ -9,20 +10,28 @@ import (
 // PayloadBuilderFactory interface for getting event-specific payload builders
 type PayloadBuilderFactory interface {
 	GetBuilder(eventType types.WebhookEventName) (PayloadBuilder, error)
+	// Render renders the payload built for an event in a payload version and mode
+	Render(version types.WebhookPayloadVersion, mode types.WebhookPayloadMode, event *types.WebhookEvent, built json.RawMessage) (json.RawMessage, error)
 }
 
 type payloadBuilderFactory struct {
-	builders map[types.WebhookEventName]func() PayloadBuilder
-	services *Services
+	builders  map[types.WebhookEventName]func() PayloadBuilder
+	renderers map[types.WebhookPayloadVersion]renderFunc
+	services  *Services
 }
 
 // NewPayloadBuilderFactory creates a new factory with registered builders
 func NewPayloadBuilderFactory(services *Services) PayloadBuilderFactory {
 	f := &payloadBuilderFactory{
-		builders: make(map[types.WebhookEventName]func() PayloadBuilder),
-		services: services,
+		builders:  make(map[types.WebhookEventName]func() PayloadBuilder),
+		renderers: make(map[types.WebhookPayloadVersion]renderFunc),
+		services:  services,
 	}
 
+	// Register payload version renderers
+	f.renderers[types.WebhookPayloadVersionV1] = renderV1
+	f.renderers[types.WebhookPayloadVersionV2] = renderV2
+
 	// Register invoice builders
 	f.builders[types.WebhookEventInvoiceUpdateFinalized] = func() PayloadBuilder {
 		return NewInvoicePayloadBuilder(f.services)
@@ -67,6 +76,9 @@ func NewPayloadBuilderFactory(services *Services) PayloadBuilderFactory {
 	f.builders[types.WebhookEventSubscriptionRenewalDue] = func() PayloadBuilder {
 		return NewSubscriptionPayloadBuilder(f.services)
 	}
+	f.builders[types.WebhookEventSubscriptionSeatsUpdated] = func() PayloadBuilder {
+		return NewSubscriptionSeatsPayloadBuilder(f.services)
+	}
 
 	// Register feature builders
 	f.builders[types.WebhookEventFeatureCreated] = func() PayloadBuilder {
@@ -89,6 +101,9 @@ func NewPayloadBuilderFactory(services *Services) PayloadBuilderFactory {
 	f.builders[types.WebhookEventEntitlementDeleted] = func() PayloadBuilder {
 		return NewEntitlementPayloadBuilder(f.services)
 	}
+	f.builders[types.WebhookEventEntitlementLimitReached] = func() PayloadBuilder {
+		return NewEntitlementLimitPayloadBuilder(f.services)
+	}
 
 	// wallet builders
 	f.builders[types.WebhookEventWalletCreated] = func() PayloadBuilder {
@@ -155,6 +170,17 @@ func NewPayloadBuilderFactory(services *Services) PayloadBuilderFactory {
 	f.builders[types.WebhookEventFeatureWalletBalanceAlert] = func() PayloadBuilder {
 		return NewAlertPayloadBuilder(f.services)
 	}
+	f.builders[types.WebhookEventUsageAnomalyDetected] = func() PayloadBuilder {
+		return NewAlertPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventUsageAnomalyResolved] = func() PayloadBuilder {
+		return NewAlertPayloadBuilder(f.services)
+	}
+
+	// webhook endpoint builders
+	f.builders[types.WebhookEventWebhookEndpointDisabled] = func() PayloadBuilder {
+		return NewWebhookEndpointPayloadBuilder(f.services)
+	}
 
 	return f
 }
@@ -0,0 +1,130 @@
+package payload
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// schemaFiles holds the frozen payload schema of every version, schemas/<version>.json
+//
+//go:embed schemas/*.json
+var schemaFiles embed.FS
+
+// payloadSchema is the frozen shape of the payloads of a version. Builders marshal the live API
+// DTOs, payloads are projected onto the schema of the endpoint's version so fields added to the
+// DTOs later never reach receivers of an existing version. TestPayloadSchemas fails when a field
+// of a frozen schema disappears from the DTOs.
+type payloadSchema struct {
+	// Payload maps the top level fields of built payloads to the type of their value
+	Payload map[string]string `json:"payload"`
+	// Types maps type names to their fields and the type of each field's value. Values of the
+	// empty type, e.g. strings, numbers, times and maps, are delivered as built. The fields of
+	// lists are the fields of their elements.
+	Types map[string]map[string]string `json:"types"`
+}
+
+// payloadSchemas caches the schemas read from schemaFiles by version
+var payloadSchemas sync.Map
+
+// getPayloadSchema returns the frozen schema of a version
+func getPayloadSchema(version types.WebhookPayloadVersion) (*payloadSchema, error) {
+	if schema, ok := payloadSchemas.Load(version); ok {
+		return schema.(*payloadSchema), nil
+	}
+
+	data, err := schemaFiles.ReadFile(schemaFileName(version))
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHintf("No payload schema is frozen for webhook payload version %s", version).
+			Mark(ierr.ErrSystem)
+	}
+	schema, err := parsePayloadSchema(version, data)
+	if err != nil {
+		return nil, err
+	}
+
+	payloadSchemas.Store(version, schema)
+	return schema, nil
+}
+
+func parsePayloadSchema(version types.WebhookPayloadVersion, data []byte) (*payloadSchema, error) {
+	var schema payloadSchema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, ierr.WithError(err).
+			WithHintf("Invalid payload schema of webhook payload version %s", version).
+			Mark(ierr.ErrSystem)
+	}
+	return &schema, nil
+}
+
+func schemaFileName(version types.WebhookPayloadVersion) string {
+	return fmt.Sprintf("schemas/%s.json", version)
+}
+
+// project drops the fields of a built payload that are not part of the schema. Payloads that are
+// not objects are returned as built.
+func (s *payloadSchema) project(built json.RawMessage) (json.RawMessage, error) {
+	fields, ok := payloadFields(built)
+	if !ok {
+		return built, nil
+	}
+
+	projected := make(map[string]json.RawMessage, len(fields))
+	for name, value := range fields {
+		typeName, ok := s.Payload[name]
+		if !ok {
+			continue
+		}
+		value, err := s.projectValue(value, typeName)
+		if err != nil {
+			return nil, err
+		}
+		projected[name] = value
+	}
+	return json.Marshal(projected)
+}
+
+// projectValue projects an object, or every element of a list, onto the fields of a type
+func (s *payloadSchema) projectValue(value json.RawMessage, typeName string) (json.RawMessage, error) {
+	typeFields := s.Types[typeName]
+	if typeName == "" || len(typeFields) == 0 {
+		return value, nil
+	}
+
+	var elements []json.RawMessage
+	if err := json.Unmarshal(value, &elements); err == nil && elements != nil {
+		for i, element := range elements {
+			projected, err := s.projectValue(element, typeName)
+			if err != nil {
+				return nil, err
+			}
+			elements[i] = projected
+		}
+		return json.Marshal(elements)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(value, &fields); err != nil || fields == nil {
+		// null or a value the schema does not describe as an object
+		return value, nil
+	}
+
+	projected := make(map[string]json.RawMessage, len(fields))
+	for name, fieldValue := range fields {
+		fieldType, ok := typeFields[name]
+		if !ok {
+			continue
+		}
+		fieldValue, err := s.projectValue(fieldValue, fieldType)
+		if err != nil {
+			return nil, err
+		}
+		projected[name] = fieldValue
+	}
+	return json.Marshal(projected)
+}
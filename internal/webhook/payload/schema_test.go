@@ -0,0 +1,221 @@
+package payload
+
+import (
+	"encoding"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/types"
+	webhookDto "github.com/flexprice/flexprice/internal/webhook/dto"
+	"github.com/stretchr/testify/require"
+)
+
+// updateSchemas freezes the payload schema of versions that have none yet:
+//
+//	go test ./internal/webhook/payload -run TestPayloadSchemas -update-schemas
+var updateSchemas = flag.Bool("update-schemas", false, "freeze the payload schema of versions without one")
+
+// builtPayloads are the payloads the builders marshal
+var builtPayloads = []any{
+	webhookDto.AlertWebhookPayload{},
+	webhookDto.UsageAnomalyWebhookPayload{},
+	webhookDto.CommunicationWebhookPayload{},
+	webhookDto.CreditNoteWebhookPayload{},
+	webhookDto.CustomerWebhookPayload{},
+	webhookDto.EntitlementWebhookPayload{},
+	webhookDto.EntitlementLimitReachedWebhookPayload{},
+	webhookDto.FeatureWebhookPayload{},
+	webhookDto.InvoiceWebhookPayload{},
+	webhookDto.PaymentWebhookPayload{},
+	webhookDto.SubscriptionWebhookPayload{},
+	webhookDto.SubscriptionSeatsWebhookPayload{},
+	webhookDto.SubscriptionPhaseWebhookPayload{},
+	webhookDto.WalletWebhookPayload{},
+	webhookDto.TransactionWebhookPayload{},
+	webhookDto.WebhookEndpointWebhookPayload{},
+}
+
+func TestPayloadSchemas(t *testing.T) {
+	current := currentPayloadSchema(t)
+
+	for _, version := range []types.WebhookPayloadVersion{types.WebhookPayloadVersionV1, types.WebhookPayloadVersionV2} {
+		path := filepath.FromSlash(schemaFileName(version))
+		if _, err := os.Stat(path); os.IsNotExist(err) && *updateSchemas {
+			data, err := json.MarshalIndent(current, "", "  ")
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(path, append(data, '\n'), 0o644))
+		}
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err, "freeze the schema with -update-schemas")
+		frozen, err := parsePayloadSchema(version, data)
+		require.NoError(t, err)
+
+		// Fields of a frozen schema are delivered to receivers of the version, they may not go away
+		for name, frozenType := range frozen.Payload {
+			currentType, ok := current.Payload[name]
+			require.Truef(t, ok, "%s: %s was removed from the webhook payloads", version, name)
+			requireFrozenFields(t, version, name, frozen, frozenType, current, currentType, map[string]bool{})
+		}
+	}
+
+	// Payloads of new events have to be added to the latest schema, or they are delivered empty
+	latest, err := getPayloadSchema(types.WebhookPayloadVersionLatest)
+	require.NoError(t, err)
+	for name := range current.Payload {
+		require.Containsf(t, latest.Payload, name, "%s is not part of the %s payload schema, add it to schemas/%s.json",
+			name, types.WebhookPayloadVersionLatest, types.WebhookPayloadVersionLatest)
+	}
+}
+
+func TestPayloadSchemaProject(t *testing.T) {
+	t.Parallel()
+
+	schema := &payloadSchema{
+		Payload: map[string]string{"event_type": "", "invoice": "dto.InvoiceResponse"},
+		Types: map[string]map[string]string{
+			"dto.InvoiceResponse":         {"id": "", "line_items": "dto.InvoiceLineItemResponse", "metadata": ""},
+			"dto.InvoiceLineItemResponse": {"id": "", "amount": ""},
+		},
+	}
+
+	out, err := schema.project(json.RawMessage(`{
+		"event_type": "invoice.update",
+		"invoice": {
+			"id": "inv_1",
+			"added_later": true,
+			"metadata": {"any": "key"},
+			"line_items": [{"id": "li_1", "amount": "1.50", "added_later": 1}]
+		},
+		"added_later": {}
+	}`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"event_type": "invoice.update",
+		"invoice": {
+			"id": "inv_1",
+			"metadata": {"any": "key"},
+			"line_items": [{"id": "li_1", "amount": "1.50"}]
+		}
+	}`, string(out))
+
+	out, err = schema.project(json.RawMessage(`{"invoice": null}`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"invoice": null}`, string(out))
+}
+
+// requireFrozenFields requires every field of a frozen type to still exist in the current type
+func requireFrozenFields(t *testing.T, version types.WebhookPayloadVersion, path string, frozen *payloadSchema, frozenType string, current *payloadSchema, currentType string, seen map[string]bool) {
+	t.Helper()
+
+	if frozenType == "" || len(frozen.Types[frozenType]) == 0 {
+		return
+	}
+	require.NotEmptyf(t, currentType, "%s: %s is no longer an object", version, path)
+	if seen[frozenType+"|"+currentType] {
+		return
+	}
+	seen[frozenType+"|"+currentType] = true
+
+	for field, frozenFieldType := range frozen.Types[frozenType] {
+		currentFieldType, ok := current.Types[currentType][field]
+		require.Truef(t, ok, "%s: %s.%s was removed from the webhook payloads", version, path, field)
+		requireFrozenFields(t, version, path+"."+field, frozen, frozenFieldType, current, currentFieldType, seen)
+	}
+}
+
+// currentPayloadSchema returns the schema of the payloads the builders marshal today
+func currentPayloadSchema(t *testing.T) *payloadSchema {
+	t.Helper()
+
+	schema := &payloadSchema{
+		Payload: map[string]string{},
+		Types:   map[string]map[string]string{},
+	}
+	for _, p := range builtPayloads {
+		fields := map[string]string{}
+		addStructFields(schema, reflect.TypeOf(p), fields)
+		for name, typeName := range fields {
+			schema.Payload[name] = typeName
+		}
+	}
+	return schema
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// schemaType returns the schema type of values of t, registering the fields of structs
+func schemaType(schema *payloadSchema, t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		if t.Elem().Kind() == reflect.Uint8 {
+			return ""
+		}
+		return schemaType(schema, t.Elem())
+	}
+	if t.Kind() != reflect.Struct || marshalsItself(t) {
+		return ""
+	}
+
+	name := t.String()
+	if _, ok := schema.Types[name]; ok {
+		return name
+	}
+
+	fields := map[string]string{}
+	schema.Types[name] = fields
+	addStructFields(schema, t, fields)
+	if len(fields) == 0 {
+		delete(schema.Types, name)
+		return ""
+	}
+	return name
+}
+
+// addStructFields adds the json fields of a struct, including the fields of embedded structs
+func addStructFields(schema *payloadSchema, t reflect.Type, fields map[string]string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct && !marshalsItself(fieldType) {
+			addStructFields(schema, fieldType, fields)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = schemaType(schema, field.Type)
+	}
+}
+
+func marshalsItself(t reflect.Type) bool {
+	pt := reflect.PointerTo(t)
+	return t.Implements(jsonMarshalerType) || pt.Implements(jsonMarshalerType) ||
+		t.Implements(textMarshalerType) || pt.Implements(textMarshalerType)
+}
@@ -0,0 +1,43 @@
+package payload
+
+import (
+	"context"
+	"encoding/json"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	webhookDto "github.com/flexprice/flexprice/internal/webhook/dto"
+)
+
+type SubscriptionSeatsPayloadBuilder struct {
+	services *Services
+}
+
+func NewSubscriptionSeatsPayloadBuilder(services *Services) PayloadBuilder {
+	return SubscriptionSeatsPayloadBuilder{
+		services: services,
+	}
+}
+
+func (b SubscriptionSeatsPayloadBuilder) BuildPayload(ctx context.Context, eventType types.WebhookEventName, data json.RawMessage) (json.RawMessage, error) {
+	var parsedPayload webhookDto.InternalSubscriptionSeatsEvent
+
+	err := json.Unmarshal(data, &parsedPayload)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Unable to unmarshal subscription seats event payload").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	subscriptionData, err := b.services.SubscriptionService.GetSubscription(ctx, parsedPayload.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: remove this once we have a proper way to handle too large json payloads
+	subscriptionData.Plan = nil
+
+	payload := webhookDto.NewSubscriptionSeatsWebhookPayload(subscriptionData, parsedPayload.SeatChange, eventType)
+
+	return json.Marshal(payload)
+}
@@ -0,0 +1,111 @@
+package payload
+
+import (
+	"encoding/json"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	webhookDto "github.com/flexprice/flexprice/internal/webhook/dto"
+	"github.com/samber/lo"
+)
+
+// renderFunc renders the payload built for an event in one payload version
+type renderFunc func(event *types.WebhookEvent, built json.RawMessage, mode types.WebhookPayloadMode) (json.RawMessage, error)
+
+// Render renders the payload built for an event in a payload version and mode. Builders produce
+// the v1 body from the live DTOs, it is projected onto the frozen schema of the version before
+// later versions change how it is wrapped.
+func (f *payloadBuilderFactory) Render(version types.WebhookPayloadVersion, mode types.WebhookPayloadMode, event *types.WebhookEvent, built json.RawMessage) (json.RawMessage, error) {
+	version = lo.CoalesceOrEmpty(version, types.WebhookPayloadVersionV1)
+	render, ok := f.renderers[version]
+	if !ok {
+		return nil, ierr.NewErrorf("no renderer registered for webhook payload version %s", version).
+			WithReportableDetails(map[string]any{
+				"payload_version": version,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	mode = lo.CoalesceOrEmpty(mode, types.WebhookPayloadModeFull)
+	if mode != types.WebhookPayloadModeThin {
+		schema, err := getPayloadSchema(version)
+		if err != nil {
+			return nil, err
+		}
+		if built, err = schema.project(built); err != nil {
+			return nil, err
+		}
+	}
+
+	return render(event, built, mode)
+}
+
+// renderV1 delivers the built body with the event's sequence number added
+func renderV1(event *types.WebhookEvent, built json.RawMessage, mode types.WebhookPayloadMode) (json.RawMessage, error) {
+	if mode == types.WebhookPayloadModeThin {
+		return json.Marshal(webhookDto.ThinWebhookPayload{
+			EventType:      event.EventName,
+			EventID:        event.ID,
+			EntityType:     event.EntityType,
+			EntityID:       event.EntityID,
+			SequenceNumber: event.SequenceNumber,
+		})
+	}
+
+	if event.SequenceNumber == nil {
+		return built, nil
+	}
+
+	fields, ok := payloadFields(built)
+	if !ok {
+		return built, nil
+	}
+
+	sequenceNumber, err := json.Marshal(*event.SequenceNumber)
+	if err != nil {
+		return nil, err
+	}
+	fields["sequence_number"] = sequenceNumber
+
+	return json.Marshal(fields)
+}
+
+// renderV2 wraps the built body, without its event_type, in an envelope
+func renderV2(event *types.WebhookEvent, built json.RawMessage, mode types.WebhookPayloadMode) (json.RawMessage, error) {
+	envelope := webhookDto.WebhookEnvelope{
+		ID:             event.ID,
+		Type:           event.EventName,
+		Version:        types.WebhookPayloadVersionV2,
+		CreatedAt:      event.Timestamp,
+		SequenceNumber: event.SequenceNumber,
+	}
+	if event.EntityID != "" {
+		envelope.Entity = &webhookDto.WebhookEnvelopeEntity{
+			Type: event.EntityType,
+			ID:   event.EntityID,
+		}
+	}
+
+	if mode != types.WebhookPayloadModeThin {
+		envelope.Data = built
+		if fields, ok := payloadFields(built); ok {
+			delete(fields, "event_type")
+			data, err := json.Marshal(fields)
+			if err != nil {
+				return nil, err
+			}
+			envelope.Data = data
+		}
+	}
+
+	return json.Marshal(envelope)
+}
+
+// payloadFields splits a built payload into its top level fields, false when it is not an object
+func payloadFields(built json.RawMessage) (map[string]json.RawMessage, bool) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(built, &fields); err != nil || fields == nil {
+		return nil, false
+	}
+	return fields, true
+}
@@ -0,0 +1,88 @@
+package payload
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func testEvent() *types.WebhookEvent {
+	return &types.WebhookEvent{
+		ID:             "sev_1",
+		EventName:      types.WebhookEventSubscriptionUpdated,
+		Timestamp:      time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		EntityType:     types.SystemEntityType("subscription"),
+		EntityID:       "sub_1",
+		SequenceNumber: lo.ToPtr(int64(7)),
+	}
+}
+
+var testBuilt = json.RawMessage(`{"event_type":"subscription.updated","subscription":{"id":"sub_1"}}`)
+
+func TestRender_V1(t *testing.T) {
+	t.Parallel()
+
+	f := NewPayloadBuilderFactory(nil)
+
+	// An empty version renders v1, the version of endpoints created before versioning
+	out, err := f.Render("", "", testEvent(), testBuilt)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"event_type":"subscription.updated","subscription":{"id":"sub_1"},"sequence_number":7}`, string(out))
+
+	unsequenced := testEvent()
+	unsequenced.SequenceNumber = nil
+	out, err = f.Render(types.WebhookPayloadVersionV1, types.WebhookPayloadModeFull, unsequenced, testBuilt)
+	require.NoError(t, err)
+	require.Equal(t, string(testBuilt), string(out))
+
+	// Payloads that are not objects are delivered as built
+	out, err = f.Render(types.WebhookPayloadVersionV1, types.WebhookPayloadModeFull, testEvent(), json.RawMessage(`[]`))
+	require.NoError(t, err)
+	require.Equal(t, `[]`, string(out))
+
+	out, err = f.Render(types.WebhookPayloadVersionV1, types.WebhookPayloadModeThin, testEvent(), testBuilt)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"event_type":"subscription.updated","event_id":"sev_1","entity_type":"subscription","entity_id":"sub_1","sequence_number":7}`, string(out))
+}
+
+func TestRender_V2(t *testing.T) {
+	t.Parallel()
+
+	f := NewPayloadBuilderFactory(nil)
+
+	out, err := f.Render(types.WebhookPayloadVersionV2, types.WebhookPayloadModeFull, testEvent(), testBuilt)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"id": "sev_1",
+		"type": "subscription.updated",
+		"version": "v2",
+		"created_at": "2026-01-02T03:04:05Z",
+		"sequence_number": 7,
+		"entity": {"type": "subscription", "id": "sub_1"},
+		"data": {"subscription": {"id": "sub_1"}}
+	}`, string(out))
+
+	out, err = f.Render(types.WebhookPayloadVersionV2, types.WebhookPayloadModeThin, testEvent(), testBuilt)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"id": "sev_1",
+		"type": "subscription.updated",
+		"version": "v2",
+		"created_at": "2026-01-02T03:04:05Z",
+		"sequence_number": 7,
+		"entity": {"type": "subscription", "id": "sub_1"}
+	}`, string(out))
+}
+
+func TestRender_UnknownVersion(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewPayloadBuilderFactory(nil).Render("v9", types.WebhookPayloadModeFull, testEvent(), testBuilt)
+	require.Error(t, err)
+	require.True(t, ierr.IsInvalidOperation(err))
+}
@@ -0,0 +1,42 @@
+package payload
+
+import (
+	"context"
+	"encoding/json"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	webhookDto "github.com/flexprice/flexprice/internal/webhook/dto"
+)
+
+type WebhookEndpointPayloadBuilder struct {
+	services *Services
+}
+
+func NewWebhookEndpointPayloadBuilder(services *Services) PayloadBuilder {
+	return WebhookEndpointPayloadBuilder{
+		services: services,
+	}
+}
+
+// BuildPayload uses the endpoint carried by the event, the endpoint may have been
+// re-enabled or changed by the time the event is delivered
+func (b WebhookEndpointPayloadBuilder) BuildPayload(ctx context.Context, eventType types.WebhookEventName, data json.RawMessage) (json.RawMessage, error) {
+	var parsedPayload webhookDto.InternalWebhookEndpointEvent
+
+	err := json.Unmarshal(data, &parsedPayload)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Unable to unmarshal webhook endpoint event payload").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	if parsedPayload.WebhookEndpoint == nil {
+		return nil, ierr.NewError("webhook endpoint event has no webhook endpoint").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	payload := webhookDto.NewWebhookEndpointWebhookPayload(parsedPayload.WebhookEndpoint, eventType)
+
+	return json.Marshal(payload)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/kafka"
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/pubsub"
 	repoent "github.com/flexprice/flexprice/internal/repository/ent"
@@ -14,7 +15,7 @@ import (
 )
 
 // MessagePublisher publishes messages to a topic (e.g. Kafka producer).
-// Used when webhook delivery is Kafka-backed so the publisher can use the shared producer.
+// Used when webhook delivery is Kafka-backed so the publisher can use a Kafka producer.
 // Signature matches watermill message.Publisher (variadic Publish).
 type MessagePublisher interface {
 	Publish(topic string, msg ...*message.Message) error
@@ -26,7 +27,7 @@ type WebhookPublisher interface {
 	Close() error
 }
 
-// webhookPublisher publishes webhook events to a topic (memory PubSub or shared Kafka producer).
+// webhookPublisher publishes webhook events to a topic (memory PubSub or Kafka producer).
 type webhookPublisher struct {
 	pubSub          pubsub.PubSub    // used when pubsub is memory
 	producer        MessagePublisher // used when pubsub is kafka (shared producer); Close is no-op
@@ -50,8 +51,8 @@ func NewPublisher(
 	}, nil
 }
 
-// NewPublisherFromProducer creates a webhook publisher backed by a shared message.Publisher (e.g. Kafka producer).
-// Close() is a no-op; the shared producer is closed by fx lifecycle.
+// NewPublisherFromProducer creates a webhook publisher backed by a message.Publisher (e.g. Kafka producer).
+// Close() is a no-op; the producer is closed by fx lifecycle.
 func NewPublisherFromProducer(
 	producer MessagePublisher,
 	cfg *config.Configuration,
@@ -67,6 +68,17 @@ func NewPublisherFromProducer(
 }
 
 func (p *webhookPublisher) PublishWebhook(ctx context.Context, event *types.WebhookEvent) error {
+	// The system_events row is written first since it assigns the event's sequence number
+	if p.systemEventRepo != nil {
+		if err := p.systemEventRepo.OnConsumed(ctx, event); err != nil {
+			p.logger.ErrorwCtx(ctx, "system_events OnConsumed failed",
+				"error", err,
+				"event_id", event.ID,
+				"event_name", event.EventName,
+			)
+		}
+	}
+
 	payload, err := json.Marshal(event)
 	if err != nil {
 		return err
@@ -81,6 +93,8 @@ func (p *webhookPublisher) PublishWebhook(ctx context.Context, event *types.Webh
 	msg.Metadata.Set("tenant_id", event.TenantID)
 	msg.Metadata.Set("environment_id", event.EnvironmentID)
 	msg.Metadata.Set("user_id", event.UserID)
+	// Events of an entity share a partition so they are consumed in order
+	msg.Metadata.Set(kafka.PartitionKeyMetadataKey, event.PartitionKey())
 
 	p.logger.Debugw("publishing webhook event",
 		"event_id", event.ID,
@@ -90,16 +104,6 @@ func (p *webhookPublisher) PublishWebhook(ctx context.Context, event *types.Webh
 		"payload", string(payload),
 	)
 
-	if p.systemEventRepo != nil {
-		if err := p.systemEventRepo.OnConsumed(ctx, event); err != nil {
-			p.logger.ErrorwCtx(ctx, "system_events OnConsumed failed",
-				"error", err,
-				"event_id", event.ID,
-				"event_name", event.EventName,
-			)
-		}
-	}
-
 	if p.producer != nil {
 		if err := p.producer.Publish(p.config.Topic, msg); err != nil {
 			p.logger.Errorw("failed to publish webhook event",
@@ -131,7 +135,7 @@ func (p *webhookPublisher) PublishWebhook(ctx context.Context, event *types.Webh
 	return nil
 }
 
-// Close closes the publisher. No-op when using shared Kafka producer (lifecycle-managed).
+// Close closes the publisher. No-op when using a Kafka producer (lifecycle-managed).
 func (p *webhookPublisher) Close() error {
 	if p.producer != nil {
 		return nil
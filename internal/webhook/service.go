@@ -7,6 +7,7 @@ import (
 	"time"
 
 	flexent "github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/config"
 	domainsystemevent "github.com/flexprice/flexprice/internal/domain/systemevent"
 	ierr "github.com/flexprice/flexprice/internal/errors"
@@ -17,6 +18,7 @@ import (
 	"github.com/flexprice/flexprice/internal/webhook/handler"
 	"github.com/flexprice/flexprice/internal/webhook/payload"
 	"github.com/flexprice/flexprice/internal/webhook/publisher"
+	"github.com/samber/lo"
 	"golang.org/x/time/rate"
 )
 
@@ -30,6 +32,8 @@ type RetryStalePendingWebhooksResult struct {
 	Total     int `json:"total"`
 	Succeeded int `json:"succeeded"`
 	Failed    int `json:"failed"`
+	// Blocked counts events held back behind an earlier undelivered event of the same entity
+	Blocked int `json:"blocked"`
 }
 
 // WebhookService orchestrates webhook operations
@@ -95,8 +99,157 @@ func (s *WebhookService) RetriggerSystemEvent(ctx context.Context, tenantID, env
 	return s.handler.DeliverWebhook(ctx, ev)
 }
 
+// GetSystemEvent returns a system event of the current tenant and environment with its payload
+// snapshot rendered in version, as a full delivery would carry it. Events that were never
+// delivered get their snapshot built and stored now.
+func (s *WebhookService) GetSystemEvent(ctx context.Context, systemEventID string, version types.WebhookPayloadVersion) (*dto.SystemEventResponse, error) {
+	if systemEventID == "" {
+		return nil, ierr.NewError("system event id is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	version = lo.CoalesceOrEmpty(version, types.WebhookPayloadVersionLatest)
+	if err := version.Validate(); err != nil {
+		return nil, err
+	}
+
+	se, err := s.systemEventRepo.GetByID(ctx, types.GetTenantID(ctx), types.GetEnvironmentID(ctx), systemEventID)
+	if err != nil {
+		if flexent.IsNotFound(err) {
+			return nil, ierr.NewError("system event not found").
+				WithHint("Verify the id and that it belongs to the current tenant and environment.").
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get system event").
+			Mark(ierr.ErrDatabase)
+	}
+
+	ev, err := SystemEventToWebhookEvent(se)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := s.handler.BuildSnapshot(ctx, ev)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := s.factory.Render(version, types.WebhookPayloadModeFull, ev, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.SystemEventResponse{
+		ID:             se.ID,
+		EventName:      se.EventName,
+		EntityType:     types.SystemEntityType(se.EntityType),
+		EntityID:       se.EntityID,
+		SequenceNumber: se.SequenceNumber,
+		CreatedAt:      se.CreatedAt,
+		PublishedAt:    se.PublishedAt,
+		PayloadVersion: version,
+		Payload:        payload,
+	}, nil
+}
+
+// redeliverSystemEvent delivers a persisted system_events row to the endpoints selected by opts
+func (s *WebhookService) redeliverSystemEvent(ctx context.Context, se *flexent.SystemEvent, opts handler.DeliveryOptions) error {
+	ev, err := SystemEventToWebhookEvent(se)
+	if err != nil {
+		return err
+	}
+
+	return s.handler.RedeliverWebhook(ctx, ev, opts)
+}
+
+// RedeliverWebhooks delivers the system events of the current tenant and environment created in
+// a time range again, oldest first and throttled like the stale retry job. Unless delivered events
+// are included, only undelivered events are sent and only to endpoints that have not accepted them.
+func (s *WebhookService) RedeliverWebhooks(ctx context.Context, req dto.RedeliverWebhooksRequest) (*dto.RedeliverWebhooksResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !s.config.Webhook.Enabled {
+		return nil, ierr.NewError("webhook delivery is disabled").
+			WithHint("Enable webhooks in configuration to redeliver system events.").
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	limit := req.GetLimit()
+	rows, err := s.systemEventRepo.ListWebhooksInRange(ctx, domainsystemevent.ListWebhooksInRangeParams{
+		TenantID:        types.GetTenantID(ctx),
+		EnvironmentID:   types.GetEnvironmentID(ctx),
+		From:            req.StartTime,
+		To:              req.EndTime,
+		EventNames:      req.EventNames,
+		UndeliveredOnly: !req.IncludeDelivered,
+		Limit:           limit + 1,
+	})
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list system events to redeliver").
+			Mark(ierr.ErrDatabase)
+	}
+
+	out := &dto.RedeliverWebhooksResponse{}
+	if len(rows) > limit {
+		out.HasMore = true
+		out.NextStartTime = lo.ToPtr(rows[limit].CreatedAt.UTC())
+		rows = rows[:limit]
+	}
+
+	opts := handler.DeliveryOptions{
+		WebhookEndpointID: req.WebhookEndpointID,
+		SkipDelivered:     !req.IncludeDelivered,
+	}
+
+	rps := s.config.WebhookRetryJob.RateLimit
+	if rps <= 0 {
+		rps = 5
+	}
+	limiter := rate.NewLimiter(rate.Limit(rps), rps)
+
+	for _, se := range rows {
+		out.Total++
+
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		if err := s.redeliverSystemEvent(ctx, se, opts); err != nil {
+			if ierr.IsInvalidOperation(err) {
+				out.Skipped++
+				continue
+			}
+			out.Failed++
+			s.logger.Warnw("webhook redelivery failed",
+				"error", err,
+				"system_event_id", se.ID,
+				"event_name", se.EventName,
+			)
+			continue
+		}
+		out.Succeeded++
+	}
+
+	s.logger.Infow("redelivered webhooks",
+		"start_time", req.StartTime,
+		"end_time", req.EndTime,
+		"webhook_endpoint_id", req.WebhookEndpointID,
+		"total", out.Total,
+		"succeeded", out.Succeeded,
+		"failed", out.Failed,
+		"skipped", out.Skipped,
+	)
+
+	return out, nil
+}
+
 // RetryStalePendingWebhooks loads undelivered system_events older than the grace period and
-// delivers each via RetriggerSystemEvent. Behaviour is controlled by cfg.WebhookRetryJob.
+// delivers each again, skipping native endpoints that already accepted the event.
+// Behaviour is controlled by cfg.WebhookRetryJob.
 func (s *WebhookService) RetryStalePendingWebhooks(ctx context.Context) (RetryStalePendingWebhooksResult, error) {
 	var out RetryStalePendingWebhooksResult
 
@@ -138,7 +291,11 @@ func (s *WebhookService) RetryStalePendingWebhooks(ctx context.Context) (RetrySt
 				return out, err
 			}
 
-			if err := s.RetriggerSystemEvent(ctx, se.TenantID, se.EnvironmentID, se.ID); err != nil {
+			if err := s.redeliverSystemEvent(ctx, se, handler.DeliveryOptions{SkipDelivered: true}); err != nil {
+				if handler.IsDeliveryBlocked(err) {
+					out.Blocked++
+					continue
+				}
 				out.Failed++
 				s.logger.Errorw("stale webhook retry failed",
 					"error", err,
@@ -210,15 +367,28 @@ func SystemEventToWebhookEvent(se *flexent.SystemEvent) (*types.WebhookEvent, er
 		payload = b
 	}
 
+	var snapshot json.RawMessage
+	if se.Snapshot != nil {
+		b, err := json.Marshal(se.Snapshot)
+		if err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Stored system event snapshot could not be serialized").
+				Mark(ierr.ErrInternal)
+		}
+		snapshot = b
+	}
+
 	return &types.WebhookEvent{
-		ID:            se.ID,
-		EventName:     se.EventName,
-		TenantID:      se.TenantID,
-		EnvironmentID: se.EnvironmentID,
-		UserID:        se.CreatedBy,
-		Timestamp:     se.CreatedAt.UTC(),
-		Payload:       payload,
-		EntityType:    types.SystemEntityType(se.EntityType),
-		EntityID:      se.EntityID,
+		ID:             se.ID,
+		EventName:      se.EventName,
+		TenantID:       se.TenantID,
+		EnvironmentID:  se.EnvironmentID,
+		UserID:         se.CreatedBy,
+		Timestamp:      se.CreatedAt.UTC(),
+		Payload:        payload,
+		EntityType:     types.SystemEntityType(se.EntityType),
+		EntityID:       se.EntityID,
+		SequenceNumber: se.SequenceNumber,
+		Snapshot:       snapshot,
 	}, nil
 }
@@ -0,0 +1,138 @@
+// Package signature signs native webhook deliveries so receivers can verify that a request
+// came from FlexPrice and reject replayed requests.
+//
+// Every delivery carries a FlexPrice-Signature header of the form
+//
+//	t=1700000000,v1=5257a869e7ecebeda32affa62cdca3fa51cad7e77a0e56ff536d0ce8e108d8bd
+//
+// where t is the Unix time the delivery was signed at and v1 is the hex encoded
+// HMAC-SHA256 of "{t}.{body}" keyed with the endpoint signing secret. While a rotated
+// secret is still valid the header carries one v1 entry per secret.
+package signature
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+)
+
+const (
+	// HeaderName is the header deliveries carry their signature in
+	HeaderName = "FlexPrice-Signature"
+
+	// SecretPrefix prefixes generated signing secrets so they are recognizable
+	SecretPrefix = "whsec_"
+
+	// DefaultTolerance is how old a signature may be before Verify rejects it as a replay
+	DefaultTolerance = 5 * time.Minute
+
+	secretBytes      = 32
+	timestampKey     = "t"
+	signatureVersion = "v1"
+)
+
+// GenerateSecret returns a new random signing secret
+func GenerateSecret() (string, error) {
+	buf := make([]byte, secretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", ierr.WithError(err).
+			WithHint("Failed to generate webhook signing secret").
+			Mark(ierr.ErrSystem)
+	}
+	return SecretPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// Compute returns the hex encoded v1 signature of a body signed at timestamp
+func Compute(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Header returns the FlexPrice-Signature header value for a body signed with each of the
+// secrets at timestamp. Empty secrets are skipped.
+func Header(secrets []string, timestamp time.Time, body []byte) string {
+	parts := []string{timestampKey + "=" + strconv.FormatInt(timestamp.Unix(), 10)}
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		parts = append(parts, signatureVersion+"="+Compute(secret, timestamp, body))
+	}
+	return strings.Join(parts, ",")
+}
+
+// Verify checks a FlexPrice-Signature header against a body. It fails when no v1 signature
+// matches the secret or the timestamp is further than tolerance from now. A tolerance of
+// zero or less uses DefaultTolerance.
+func Verify(secret, header string, body []byte, tolerance time.Duration, now time.Time) error {
+	if tolerance <= 0 {
+		tolerance = DefaultTolerance
+	}
+
+	timestamp, signatures, err := parseHeader(header)
+	if err != nil {
+		return err
+	}
+
+	if age := now.Sub(timestamp); age > tolerance || age < -tolerance {
+		return ierr.NewError("webhook signature timestamp is outside the tolerance").
+			WithHint("The delivery is too old or the clocks are out of sync").
+			WithReportableDetails(map[string]any{
+				"timestamp": timestamp.Unix(),
+				"tolerance": tolerance.String(),
+			}).
+			Mark(ierr.ErrPermissionDenied)
+	}
+
+	expected := []byte(Compute(secret, timestamp, body))
+	for _, sig := range signatures {
+		if hmac.Equal(expected, []byte(sig)) {
+			return nil
+		}
+	}
+
+	return ierr.NewError("webhook signature does not match").
+		WithHint("No signature in the header matches the signing secret").
+		Mark(ierr.ErrPermissionDenied)
+}
+
+func parseHeader(header string) (time.Time, []string, error) {
+	var timestamp time.Time
+	var signatures []string
+
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case timestampKey:
+			unix, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return time.Time{}, nil, ierr.NewError("invalid webhook signature timestamp").
+					Mark(ierr.ErrValidation)
+			}
+			timestamp = time.Unix(unix, 0)
+		case signatureVersion:
+			signatures = append(signatures, value)
+		}
+	}
+
+	if timestamp.IsZero() || len(signatures) == 0 {
+		return time.Time{}, nil, ierr.NewError("invalid webhook signature header").
+			WithHintf("%s must contain a timestamp and at least one %s signature", HeaderName, signatureVersion).
+			Mark(ierr.ErrValidation)
+	}
+
+	return timestamp, signatures, nil
+}
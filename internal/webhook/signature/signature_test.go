@@ -0,0 +1,53 @@
+package signature
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderAndVerify(t *testing.T) {
+	t.Parallel()
+
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(secret, SecretPrefix))
+
+	signedAt := time.Unix(1700000000, 0)
+	body := []byte(`{"event_type":"invoice.update.finalized"}`)
+	header := Header([]string{secret}, signedAt, body)
+	require.Equal(t, "t=1700000000,v1="+Compute(secret, signedAt, body), header)
+
+	require.NoError(t, Verify(secret, header, body, 0, signedAt.Add(time.Minute)))
+
+	// Tampered body
+	err = Verify(secret, header, []byte(`{"event_type":"invoice.update.voided"}`), 0, signedAt)
+	require.True(t, ierr.IsPermissionDenied(err))
+
+	// Wrong secret
+	err = Verify(SecretPrefix+"other", header, body, 0, signedAt)
+	require.True(t, ierr.IsPermissionDenied(err))
+
+	// Replayed outside the tolerance
+	err = Verify(secret, header, body, time.Minute, signedAt.Add(2*time.Minute))
+	require.True(t, ierr.IsPermissionDenied(err))
+
+	// Malformed header
+	err = Verify(secret, "v1=abc", body, 0, signedAt)
+	require.True(t, ierr.IsValidation(err))
+}
+
+func TestHeaderDuringRotation(t *testing.T) {
+	t.Parallel()
+
+	signedAt := time.Unix(1700000000, 0)
+	body := []byte(`{}`)
+	header := Header([]string{"whsec_new", "", "whsec_old"}, signedAt, body)
+	require.Equal(t, 3, len(strings.Split(header, ",")))
+
+	require.NoError(t, Verify("whsec_new", header, body, 0, signedAt))
+	require.NoError(t, Verify("whsec_old", header, body, 0, signedAt))
+}
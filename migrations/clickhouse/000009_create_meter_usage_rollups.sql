@@ -0,0 +1,60 @@
+-- Hourly and daily meter usage rollups read by the analytics query planner.
+-- Rows are written by the usage rollup job from meter_usage FINAL, never by materialized
+-- views, so deduplicated usage is rolled up and recent buckets can be rebuilt when events
+-- arrive late. Rebuilt buckets replace the previous ones through the version column.
+CREATE TABLE IF NOT EXISTS flexprice.meter_usage_hourly
+(
+    tenant_id             LowCardinality(String)   NOT NULL,
+    environment_id        LowCardinality(String)   NOT NULL,
+    external_customer_id  LowCardinality(String)   NOT NULL,
+    meter_id              LowCardinality(String)   NOT NULL,
+
+    -- bucket start, named timestamp so meter_usage window expressions apply unchanged
+    timestamp             DateTime                 NOT NULL  CODEC(DoubleDelta, ZSTD(1)),
+
+    qty_sum               Decimal(38, 8)           NOT NULL  CODEC(ZSTD(1)),
+    qty_max               Decimal(18, 8)           NOT NULL  CODEC(ZSTD(1)),
+    event_count           UInt64                   NOT NULL  CODEC(ZSTD(1)),
+
+    version               UInt64                   NOT NULL  DEFAULT toUnixTimestamp64Milli(now64())
+)
+ENGINE = ReplacingMergeTree(version)
+PARTITION BY toYYYYMM(timestamp)
+ORDER BY (tenant_id, environment_id, meter_id, external_customer_id, timestamp)
+SETTINGS
+    index_granularity = 8192;
+
+CREATE TABLE IF NOT EXISTS flexprice.meter_usage_daily
+(
+    tenant_id             LowCardinality(String)   NOT NULL,
+    environment_id        LowCardinality(String)   NOT NULL,
+    external_customer_id  LowCardinality(String)   NOT NULL,
+    meter_id              LowCardinality(String)   NOT NULL,
+
+    timestamp             DateTime                 NOT NULL  CODEC(DoubleDelta, ZSTD(1)),
+
+    qty_sum               Decimal(38, 8)           NOT NULL  CODEC(ZSTD(1)),
+    qty_max               Decimal(18, 8)           NOT NULL  CODEC(ZSTD(1)),
+    event_count           UInt64                   NOT NULL  CODEC(ZSTD(1)),
+
+    version               UInt64                   NOT NULL  DEFAULT toUnixTimestamp64Milli(now64())
+)
+ENGINE = ReplacingMergeTree(version)
+PARTITION BY toYear(timestamp)
+ORDER BY (tenant_id, environment_id, meter_id, external_customer_id, timestamp)
+SETTINGS
+    index_granularity = 8192;
+
+-- Time range covered by the rollups of each environment. Queries only read a rollup table
+-- inside [rolled_up_from, rolled_up_to) and fall back to meter_usage outside of it.
+CREATE TABLE IF NOT EXISTS flexprice.meter_usage_rollup_state
+(
+    tenant_id             LowCardinality(String)   NOT NULL,
+    environment_id        LowCardinality(String)   NOT NULL,
+    granularity           LowCardinality(String)   NOT NULL,
+    rolled_up_from        DateTime                 NOT NULL,
+    rolled_up_to          DateTime                 NOT NULL,
+    updated_at            DateTime64(3)            NOT NULL  DEFAULT now64(3)
+)
+ENGINE = ReplacingMergeTree(updated_at)
+ORDER BY (tenant_id, environment_id, granularity);
@@ -0,0 +1,55 @@
+-- Per-environment usage retention. The usage_retention_config setting is synced into
+-- usage_retention_policies and exposed to TTL expressions through a dictionary, so a
+-- retention change applies on the next merge without altering the tables again.
+-- Zero days, and environments without a policy, keep the data forever.
+-- feature_usage is the billing source of truth and is deliberately left without a TTL.
+CREATE TABLE IF NOT EXISTS flexprice.usage_retention_policies
+(
+    tenant_id             String                   NOT NULL,
+    environment_id        String                   NOT NULL,
+    raw_event_days        UInt16                   NOT NULL,
+    meter_usage_days      UInt16                   NOT NULL,
+    rollup_days           UInt16                   NOT NULL,
+    updated_at            DateTime64(3)            NOT NULL  DEFAULT now64(3)
+)
+ENGINE = ReplacingMergeTree(updated_at)
+ORDER BY (tenant_id, environment_id);
+
+CREATE DICTIONARY IF NOT EXISTS flexprice.usage_retention_dict
+(
+    tenant_id             String,
+    environment_id        String,
+    raw_event_days        UInt16  DEFAULT 0,
+    meter_usage_days      UInt16  DEFAULT 0,
+    rollup_days           UInt16  DEFAULT 0
+)
+PRIMARY KEY tenant_id, environment_id
+SOURCE(CLICKHOUSE(QUERY 'SELECT tenant_id, environment_id, raw_event_days, meter_usage_days, rollup_days FROM flexprice.usage_retention_policies FINAL'))
+LIFETIME(MIN 300 MAX 600)
+LAYOUT(COMPLEX_KEY_HASHED());
+
+-- Existing parts pick up the TTL as they merge instead of being rewritten all at once
+ALTER TABLE flexprice.events
+    MODIFY TTL toDateTime(timestamp) + toIntervalDay(dictGetOrDefault('flexprice.usage_retention_dict', 'raw_event_days', (tenant_id, environment_id), toUInt16(0)))
+        DELETE WHERE dictGetOrDefault('flexprice.usage_retention_dict', 'raw_event_days', (tenant_id, environment_id), toUInt16(0)) > 0
+    SETTINGS materialize_ttl_after_modify = 0;
+
+ALTER TABLE flexprice.raw_events
+    MODIFY TTL toDateTime(timestamp) + toIntervalDay(dictGetOrDefault('flexprice.usage_retention_dict', 'raw_event_days', (tenant_id, environment_id), toUInt16(0)))
+        DELETE WHERE dictGetOrDefault('flexprice.usage_retention_dict', 'raw_event_days', (tenant_id, environment_id), toUInt16(0)) > 0
+    SETTINGS materialize_ttl_after_modify = 0;
+
+ALTER TABLE flexprice.meter_usage
+    MODIFY TTL timestamp + toIntervalDay(dictGetOrDefault('flexprice.usage_retention_dict', 'meter_usage_days', (tenant_id, environment_id), toUInt16(0)))
+        DELETE WHERE dictGetOrDefault('flexprice.usage_retention_dict', 'meter_usage_days', (tenant_id, environment_id), toUInt16(0)) > 0
+    SETTINGS materialize_ttl_after_modify = 0;
+
+ALTER TABLE flexprice.meter_usage_hourly
+    MODIFY TTL timestamp + toIntervalDay(dictGetOrDefault('flexprice.usage_retention_dict', 'rollup_days', (tenant_id, environment_id), toUInt16(0)))
+        DELETE WHERE dictGetOrDefault('flexprice.usage_retention_dict', 'rollup_days', (tenant_id, environment_id), toUInt16(0)) > 0
+    SETTINGS materialize_ttl_after_modify = 0;
+
+ALTER TABLE flexprice.meter_usage_daily
+    MODIFY TTL timestamp + toIntervalDay(dictGetOrDefault('flexprice.usage_retention_dict', 'rollup_days', (tenant_id, environment_id), toUInt16(0)))
+        DELETE WHERE dictGetOrDefault('flexprice.usage_retention_dict', 'rollup_days', (tenant_id, environment_id), toUInt16(0)) > 0
+    SETTINGS materialize_ttl_after_modify = 0;
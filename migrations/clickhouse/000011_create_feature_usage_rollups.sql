@@ -0,0 +1,77 @@
+-- Hourly and daily feature usage rollups read by usage analytics (/events/analytics-v2).
+-- They keep the dimensions analytics price usage by, so costs can be computed from them.
+-- Like the meter usage rollups they are written by the usage rollup job from
+-- feature_usage FINAL and rebuilt buckets replace the previous ones through the version column.
+-- Event properties are not rolled up; analytics filtering or grouping by them read feature_usage.
+CREATE TABLE IF NOT EXISTS flexprice.feature_usage_hourly
+(
+    tenant_id             LowCardinality(String)   NOT NULL,
+    environment_id        LowCardinality(String)   NOT NULL,
+    customer_id           String                   NOT NULL,
+    feature_id            LowCardinality(String)   NOT NULL,
+    price_id              LowCardinality(String)   NOT NULL,
+    meter_id              LowCardinality(String)   NOT NULL,
+    sub_line_item_id      String                   NOT NULL,
+    source                LowCardinality(String)   NOT NULL,
+
+    -- bucket start, named timestamp so feature_usage window expressions apply unchanged
+    timestamp             DateTime                 NOT NULL  CODEC(DoubleDelta, ZSTD(1)),
+
+    qty_sum               Decimal(38, 15)          NOT NULL  CODEC(ZSTD(1)),
+    qty_max               Decimal(25, 15)          NOT NULL  CODEC(ZSTD(1)),
+    event_count           UInt64                   NOT NULL  CODEC(ZSTD(1)),
+
+    version               UInt64                   NOT NULL  DEFAULT toUnixTimestamp64Milli(now64())
+)
+ENGINE = ReplacingMergeTree(version)
+PARTITION BY toYYYYMM(timestamp)
+ORDER BY (tenant_id, environment_id, customer_id, feature_id, timestamp, price_id, sub_line_item_id, meter_id, source)
+SETTINGS
+    index_granularity = 8192;
+
+CREATE TABLE IF NOT EXISTS flexprice.feature_usage_daily
+(
+    tenant_id             LowCardinality(String)   NOT NULL,
+    environment_id        LowCardinality(String)   NOT NULL,
+    customer_id           String                   NOT NULL,
+    feature_id            LowCardinality(String)   NOT NULL,
+    price_id              LowCardinality(String)   NOT NULL,
+    meter_id              LowCardinality(String)   NOT NULL,
+    sub_line_item_id      String                   NOT NULL,
+    source                LowCardinality(String)   NOT NULL,
+
+    timestamp             DateTime                 NOT NULL  CODEC(DoubleDelta, ZSTD(1)),
+
+    qty_sum               Decimal(38, 15)          NOT NULL  CODEC(ZSTD(1)),
+    qty_max               Decimal(25, 15)          NOT NULL  CODEC(ZSTD(1)),
+    event_count           UInt64                   NOT NULL  CODEC(ZSTD(1)),
+
+    version               UInt64                   NOT NULL  DEFAULT toUnixTimestamp64Milli(now64())
+)
+ENGINE = ReplacingMergeTree(version)
+PARTITION BY toYear(timestamp)
+ORDER BY (tenant_id, environment_id, customer_id, feature_id, timestamp, price_id, sub_line_item_id, meter_id, source)
+SETTINGS
+    index_granularity = 8192;
+
+-- Time range covered by the feature usage rollups of each environment, kept apart from the
+-- meter usage rollup state since these rollups start from the time this migration runs
+CREATE TABLE IF NOT EXISTS flexprice.feature_usage_rollup_state
+(
+    tenant_id             LowCardinality(String)   NOT NULL,
+    environment_id        LowCardinality(String)   NOT NULL,
+    granularity           LowCardinality(String)   NOT NULL,
+    rolled_up_from        DateTime                 NOT NULL,
+    rolled_up_to          DateTime                 NOT NULL,
+    updated_at            DateTime64(3)            NOT NULL  DEFAULT now64(3)
+)
+ENGINE = ReplacingMergeTree(updated_at)
+ORDER BY (tenant_id, environment_id, granularity);
+
+ALTER TABLE flexprice.feature_usage_hourly
+    MODIFY TTL timestamp + toIntervalDay(dictGetOrDefault('flexprice.usage_retention_dict', 'rollup_days', (tenant_id, environment_id), toUInt16(0)))
+        DELETE WHERE dictGetOrDefault('flexprice.usage_retention_dict', 'rollup_days', (tenant_id, environment_id), toUInt16(0)) > 0;
+
+ALTER TABLE flexprice.feature_usage_daily
+    MODIFY TTL timestamp + toIntervalDay(dictGetOrDefault('flexprice.usage_retention_dict', 'rollup_days', (tenant_id, environment_id), toUInt16(0)))
+        DELETE WHERE dictGetOrDefault('flexprice.usage_retention_dict', 'rollup_days', (tenant_id, environment_id), toUInt16(0)) > 0;
@@ -151,7 +151,7 @@ func newCSVFeatureProcessor(tenantID, environmentID, userID string) (*CSVFeature
 	}
 
 	// Create service instances once for reuse
-	meterService := service.NewMeterService(meterRepo)
+	meterService := service.NewMeterService(meterRepo, serviceParams.AuditLogRepo, log)
 	featureService := service.NewFeatureService(serviceParams)
 	priceService := service.NewPriceService(serviceParams)
 	addonService := service.NewAddonService(serviceParams)
@@ -0,0 +1,216 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/flexprice/flexprice/ent/environment"
+	"github.com/flexprice/flexprice/ent/webhookendpoint"
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/config"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	entRepo "github.com/flexprice/flexprice/internal/repository/ent"
+	"github.com/flexprice/flexprice/internal/security"
+	"github.com/flexprice/flexprice/internal/sentry"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// legacyWebhookEvents are the events a webhook.tenants entry received unless excluded.
+// Endpoints only subscribe to events, so exclusions are imported as the remaining events.
+var legacyWebhookEvents = []types.WebhookEventName{
+	types.WebhookEventSubscriptionCreated,
+	types.WebhookEventSubscriptionDraftCreated,
+	types.WebhookEventSubscriptionActivated,
+	types.WebhookEventSubscriptionUpdated,
+	types.WebhookEventSubscriptionPaused,
+	types.WebhookEventSubscriptionCancelled,
+	types.WebhookEventSubscriptionResumed,
+	types.WebhookEventSubscriptionSeatsUpdated,
+	types.WebhookEventSubscriptionPhaseCreated,
+	types.WebhookEventSubscriptionPhaseUpdated,
+	types.WebhookEventSubscriptionPhaseDeleted,
+	types.WebhookEventFeatureCreated,
+	types.WebhookEventFeatureUpdated,
+	types.WebhookEventFeatureDeleted,
+	types.WebhookEventFeatureWalletBalanceAlert,
+	types.WebhookEventEntitlementCreated,
+	types.WebhookEventEntitlementUpdated,
+	types.WebhookEventEntitlementDeleted,
+	types.WebhookEventEntitlementLimitReached,
+	types.WebhookEventWalletCreated,
+	types.WebhookEventWalletUpdated,
+	types.WebhookEventWalletTerminated,
+	types.WebhookEventWalletTransactionCreated,
+	types.WebhookEventPaymentCreated,
+	types.WebhookEventPaymentUpdated,
+	types.WebhookEventPaymentFailed,
+	types.WebhookEventPaymentSuccess,
+	types.WebhookEventPaymentPending,
+	types.WebhookEventCustomerCreated,
+	types.WebhookEventCustomerUpdated,
+	types.WebhookEventCustomerDeleted,
+	types.WebhookEventInvoiceUpdateFinalized,
+	types.WebhookEventInvoiceUpdatePayment,
+	types.WebhookEventInvoiceUpdateVoided,
+	types.WebhookEventInvoiceUpdate,
+	types.WebhookEventInvoicePaymentOverdue,
+	types.WebhookEventWalletCreditBalanceDropped,
+	types.WebhookEventWalletCreditBalanceRecovered,
+	types.WebhookEventWalletOngoingBalanceDropped,
+	types.WebhookEventWalletOngoingBalanceRecovered,
+	types.WebhookEventSubscriptionRenewalDue,
+	types.WebhookEventUsageAnomalyDetected,
+	types.WebhookEventUsageAnomalyResolved,
+	types.WebhookEventInvoiceCommunicationTriggered,
+	types.WebhookEventCreditNoteCreated,
+	types.WebhookEventCreditNoteUpdated,
+	types.WebhookEventWebhookEndpointDisabled,
+}
+
+// ImportWebhookTenants creates a webhook endpoint in the production environment of every
+// tenant configured under the retired webhook.tenants key. Tenants that already have an
+// endpoint for the configured URL are skipped, so the script can be re-run safely.
+func ImportWebhookTenants() error {
+	isDryRun := os.Getenv("DRY_RUN") == "true"
+	onlyTenantID := os.Getenv("TENANT_ID")
+
+	cfg, err := config.NewConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	log, err := logger.NewLogger(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	entClient, err := postgres.NewEntClients(cfg, log)
+	if err != nil {
+		return fmt.Errorf("failed to connect to postgres: %w", err)
+	}
+	pgClient := postgres.NewClient(entClient, log, sentry.NewSentryService(cfg, log))
+
+	encryptionService, err := security.NewEncryptionService(cfg, log)
+	if err != nil {
+		return fmt.Errorf("failed to create encryption service: %w", err)
+	}
+
+	endpointService := service.NewWebhookEndpointService(service.ServiceParams{
+		Logger:              log,
+		Config:              cfg,
+		DB:                  pgClient,
+		WebhookEndpointRepo: entRepo.NewWebhookEndpointRepository(pgClient, log),
+	}, encryptionService)
+
+	var created, skipped int
+	for tenantID, conf := range cfg.Webhook.Tenants {
+		if onlyTenantID != "" && tenantID != onlyTenantID {
+			continue
+		}
+		if conf.Endpoint == "" {
+			log.Warnw("skipping tenant without an endpoint", "tenant_id", tenantID)
+			skipped++
+			continue
+		}
+
+		ctx := context.Background()
+		envs, err := entClient.Reader.Environment.Query().
+			Where(
+				environment.TenantID(tenantID),
+				environment.Type(string(types.EnvironmentProduction)),
+				environment.Status(string(types.StatusPublished)),
+			).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list environments of tenant %s: %w", tenantID, err)
+		}
+		if len(envs) != 1 {
+			log.Warnw("skipping tenant without a single production environment, create the endpoint manually",
+				"tenant_id", tenantID,
+				"production_environments", len(envs),
+			)
+			skipped++
+			continue
+		}
+		environmentID := envs[0].ID
+
+		exists, err := entClient.Reader.WebhookEndpoint.Query().
+			Where(
+				webhookendpoint.TenantID(tenantID),
+				webhookendpoint.EnvironmentID(environmentID),
+				webhookendpoint.URL(conf.Endpoint),
+				webhookendpoint.Status(string(types.StatusPublished)),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check webhook endpoints of tenant %s: %w", tenantID, err)
+		}
+		if exists {
+			log.Infow("webhook endpoint already imported", "tenant_id", tenantID, "url", conf.Endpoint)
+			skipped++
+			continue
+		}
+
+		req := dto.CreateWebhookEndpointRequest{
+			URL:            conf.Endpoint,
+			Description:    "Imported from the webhook.tenants configuration",
+			EventTypes:     legacyWebhookEventTypes(conf.ExcludedEvents),
+			Headers:        conf.Headers,
+			Enabled:        lo.ToPtr(conf.Enabled),
+			Metadata:       map[string]string{"imported_from": "webhook.tenants"},
+			PayloadVersion: types.WebhookPayloadVersionV1,
+		}
+
+		if isDryRun {
+			log.Infow("dry run: would import webhook endpoint",
+				"tenant_id", tenantID,
+				"environment_id", environmentID,
+				"url", req.URL,
+				"event_types", req.EventTypes,
+				"enabled", conf.Enabled,
+			)
+			created++
+			continue
+		}
+
+		ctx = context.WithValue(ctx, types.CtxTenantID, tenantID)
+		ctx = context.WithValue(ctx, types.CtxEnvironmentID, environmentID)
+		resp, err := endpointService.CreateWebhookEndpoint(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to import webhook endpoint of tenant %s: %w", tenantID, err)
+		}
+
+		log.Infow("imported webhook endpoint",
+			"tenant_id", tenantID,
+			"environment_id", environmentID,
+			"webhook_endpoint_id", resp.ID,
+		)
+		created++
+	}
+
+	log.Infow("webhook tenants import completed",
+		"imported", created,
+		"skipped", skipped,
+		"dry_run", isDryRun,
+	)
+	return nil
+}
+
+// legacyWebhookEventTypes returns the event types an imported endpoint subscribes to
+func legacyWebhookEventTypes(excluded []string) []string {
+	if len(excluded) == 0 {
+		return []string{types.WebhookEventWildcard}
+	}
+
+	eventTypes := make([]string, 0, len(legacyWebhookEvents))
+	for _, event := range legacyWebhookEvents {
+		if !lo.Contains(excluded, event) {
+			eventTypes = append(eventTypes, event)
+		}
+	}
+	return eventTypes
+}
@@ -124,6 +124,11 @@ var commands = []Command{
 		Description: "Create draft invoices for subscriptions from a JSON file and trigger processing workflows",
 		Run:         internal.SetupDraftInvoices,
 	},
+	{
+		Name:        "import-webhook-tenants",
+		Description: "Import the retired webhook.tenants configuration into webhook endpoints of each tenant's production environment",
+		Run:         internal.ImportWebhookTenants,
+	},
 	{
 		Name:        "setup-dummy-billing-customer",
 		Description: "Create CUSTOMER_COUNT demo customers (default 1), each with subscription, $100 wallet top-up, and 500 meter events (Postgres + Kafka)",